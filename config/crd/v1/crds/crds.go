@@ -29,17 +29,20 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VAo\xe46\x0f\xbdϯ \xf6;\xec\xe5\xb3g\xb7\xbd\x14\xbem\xd3\x16\b\x9a\x04A\x12\xe4Nۜ\x19mdI\x95\xa8I\xa7E\xff{A\xc9\xcexl'\xb3Y\xa0\xbaY\xa2\x1e\xc9G>ZEQ\xacЩG\xf2AYS\x01:E\x7f2\x19\xf9\n\xe5\xd3O\xa1Tv\xbd\xff\xbczR\xa6\xad\xe0\"\x06\xb6\xdd\x1d\x05\x1b}C\xbf\xd0F\x19\xc5ʚUG\x8c-2V+\x004\xc62\xcav\x90O\x80\xc6\x1a\xf6Vk\xf2ŖL\xf9\x14k\xaa\xa3\xd2-\xf9\x04>\xb8\xde\x7f*?\xffP~Z\x01\x18쨂\x1a\x9b\xa7\xe8<9\x1b\x14[\xaf(\x94{\xd2\xe4m\xa9\xec*8j\x04}\xebmt\x15\x1c\x0f\xf2\xed\xdes\x8e\xfa\xe7\x04t7\x00\x1dґV\x81\x7f_<\xbeR\x81\x93\x89\xd3ѣ^\n$\x1d\ae\xb6Q\xa3\x9f\x19\x88\x83\xd0XG\x15\xdcH,\x0e\x1bjW\x00}\xa6)\xb6\x02\xb0m\x13w\xa8o\xbd2L\xfe\xc2\xea\xd8\r\x9c\x15\xf05Xs\x8b\xbc\xab\xa0\x1c\xd8-\x1bO\x89\xd8\a\xd5Q`\xec\\\xb2\x1d\b\xfb\xb2\xa5\xfe\x9b\x0f\xe2\xbcE\xa69\x980W\x1ec}88:A9\x12\x01\xa3\xb3\x8c\x18\xd8+\xb3]\x1d\x8d\xf7\x9f3\x15͎:\xacz[\xeb\xc8|\xb9\xbd|\xfc\xf1\xfed\x1b\xc0y\xebȳ\x1aʓר\xfdF\xbb\x00-\x85\xc6+ǩ9>\n`\xb6\x82V\xfa\x8e\x02\xf0\x8e\x06N\xa9\xedc\x00\xbb\x01ީ\x00\x9e\x9c\xa7@&w\xe2\t0\x88\x11\x1a\xb0\xf5Wj\xb8\x84{\xf2\x02\x03ag\xa3n\xa5]\xf7\xe4\x19<5vk\xd4_/\xd8\x01\xd8&\xa7\x1a\x99\xfa\x1e9\xaeTC\x83\x1a\xf6\xa8#\xfd\x1fд\xd0\xe1\x01<\x89\x17\x88f\x84\x97LB\t\xd7\xd6\x13(\xb3\xb1\x15\xec\x98]\xa8\xd6\xeb\xad\xe2Av\x8d\xed\xbah\x14\x1f\xd6IA\xaa\x8el}X\xb7\xb4'\xbd\x0ej[\xa0ov\x8a\xa9\xe1\xe8i\x8dN\x15)t\x93\xa4Wv\xed\xff|/\xd4\xf0\xf1$\xd6Y-\xf3Jby\xa3\x02\xa2\x16P\x01\xb0\xbf\x9a\xb38\x12-[\xc2\xceݯ\xf7\x0f0\xb8NŘ\xb2\x9fx?^\f\xc7\x12\ba\xcal\xc8\xe7\"n\xbc\xed\x12&\x99\xd6Ye8}4Z\x91\x99\xd2\x1fb\xdd)\x96\xba\xff\x11)\xb0Ԫ\x84\x8b4\x8b\xa0&\x88N\xd4Жpi\xe0\x02;\xd2\x17\x18\xe8?/\x800\x1d\n!\xf6\xdbJ0\x1e\xa3S\xe3\xcc\xda\xe8`\x18\x81\xaf\xd4k:\xd6\xee\x1d5R>aP\xae\xaa\x8dj\x926`c=\xe0̾<\x81^\x96\xae\xac<\xfc\xee\xd9z\xdcҕ͘S\xa3\xc5\xd8&w\x86\xe0d\xb2d\x19Ӳ\xe1\f\x1b\x80w\xc8#\xfd2*\xf32\x06\x16\xf3y\xa3\b\xa9\x10(r6h\x1a\xfa-u\x94i\x0egr\xba^\xb8\")\xed\xec3\xd8\r\x93\x19\x83\xf6\xb1.dR\x13\xf8h\xde\x15\xec\xe90?\x13\xe6݉1(\xd3J\x1b\xf4\xd3T\x9c\f\xd4K]ɴ\xe0O\xff\x9b\xe3E&vsw\x05<Y\xa7pa\xdfS`\xd5,\x1c|\xf8\xf0\xbe|\x05\xe6\xb2\x15\xa1m\x14\xf9\xb3\x19\x9f\x9a\x0f}\xb6\x89Z\xf7XEc;\x87\xacjM\xcb.e\x89LTF9\xe4Y\xf7\xfd\xfd\xb5\x97\x7f=\xbd\xbc\x0e\xced\xf0xj=\x16J\xdeH\xa1d!\xbeU/\x18\xb4\x11\xc0ٶ\x0f\xa2\xbf\x17$\xbfw\xe4 -\xae<M\xfe\x18\xc5\xf28\x98\xd8,\xa9kb2\xad\xf1\xe4x\xc2\xdf7\x8dKF\x8e\xe1=\x033]\x18\xc8n\xa2\xf7d\xb8\x87I/\x88\xef\x1e\x99\x1a\x03\x8fƅ\xbc\xe6\xcet\xc0\xd5\xfc\xc6\x10\x98\x80\x01\xcb\xc6x\xbe<\xe3\xf4\xaf\x9b\x8a\xb64Y6\xd6w\xc8\xf9\xb9X\b\xd0\xcc\xc2D\xad\xb1\xd6T\x01\xfb8?~k\x8eR\b\xb8=\x97\xddu\xb6ʏ\x8b\xfe\n`m#\xbfB=\xef\xe6Q\xc0\x99r\x9c\x89\xd4\xed0\x9c\x8b\xf3Vl\x96\x1ab\xf2\xbfz+\x84\xd7f\xe6\r=/\xec\xde\x11\xb6s\x1d\x17pcy\xf9\xe8\xd5\f\x17U1\xdb\f\xf2\x0ekGu\x0eY\xc8\xe3\x9dX\xbf\xbc++\xf8\xfb\x9fտ\x01\x00\x00\xff\xff]6D7C\x0e\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=M\x93\xdb:rw\xff\n\x94r\xf0\xee\xd6H^'\x97\xd4ܼc;Q\xbd\xf7\xfc\xa6<\xb3\xdeK.\x10ْ\xf0\x06\x04\xb8\x00\xa8\xb16\x95\xff\x9eB\x03\xe0'H\x82\xb2\xe6ś2/\xf6P@\xa3\xd1\xdd\xe8\x0ft\x03\\\xafׯhɾ\x80\xd2L\x8a[BK\x06_\r\b\xfb\x97\xde<\xfd\xbb\xde0\xf9\xe6\xf4\xf6\xd5\x13\x13\xf9-\xb9\xab\xb4\x91\xc5gвR\x19\xbc\x87=\x13\xcc0)^\x15`hN\r\xbd}E\b\x15B\x1aj_k\xfb'!\x99\x14FI\xceA\xad\x0f 6O\xd5\x0ev\x15\xe39(\x04\x1e\x86>\xfdy\xf3\xf6_7\x7f~E\x88\xa0\x05ܒ\x1d͞\xaaRoN\xc0A\xc9\r\x93\xaft\t\x99\x05yP\xb2*oI\xf3\x83\xeb\xe2\x87s\xa8\xfe\x05{\xe3\vδ\xf9\xa9\xf5\xf2g\xa6\r\xfeP\xf2JQ^\x8f\x84\xef4\x13\x87\x8aS\x15\u07be\"Dg\xb2\x84[\xf2\xc9\x0eQ\xd2\f\xf2W\x84x\xacqȵG\xf8\xf4\xd6AȎPP\x87\v!\xb2\x04\xf1\xee~\xfb\xe5\xdf\x1e:\xaf\t\xc9Ag\x8a\x95\x06\xe7\xee\x10#L\x13J\xbeഈ\xf2T&\xe6H\rQP*\xd0 \x8c&\xe6\b$\xa3\xa5\xa9\x14\x10\xb9'?U;P\x02\f\xe8\x1a4!\x19\xaf\xb4\x01E\xb4\xa1\x06\b5\x84\x92R2a\b\x13İ\x02\xc8\x1f\xde\xddo\x89\xdc\xfd\x06\x99ф\x8a\x9cP\xadeƨ\x81\x9c\x9c$\xaf\np}\xff\xb8\xa9\xa1\x96J\x96\xa0\f\vtvOKxZo{\xd3{m)\xe0Z\x91\xdcJ\r\xb8ix*B\xee\x89f\xe7c\x8eL7\xd3E9\xea\x00&\xb6\x11\x15\x1e\xf9\ry\x00e\xc1\x10}\x94\x15ϭ\xb0\x9d@Y\x82e\xf2 \xd8?jؚ\x18\x89\x83rj\xc0\v@\xf30a@\t\xcaɉ\xf2\nn\x90$\x05=\x13\x05v\x14R\x89\x16<l\xa27\xe4\x17\xa9\x800\xb1\x97\xb7\xe4hL\xa9o\u07fc90\x13\x16M&\x8b\xa2\x12̜ߠ\xfc\xb3]e\xa4\xd2or8\x01\x7f\xa3\xd9aMUvd\x062\xcb\xc87\xb4dkD]\xe0\xc2\xd9\x14\xf9\xbf\x04\x01Я;\xb8\x9a\xb3\x15Fm\x14\x13\x87\xd6\x0f(\xf5\x13\x1c\xb0\v\xc0ɗ\xeb\xeaf\xd1\x10ھ\xb2\xd4\xf9\xfc\xe1\xe1\xb1-{L\xf7\xa9\x8fto\td\xc3\x02K0&\xf6\xa0\x1c\x13\xf7J\x16\b\x13D\xee\xa4\x0fE\x973\x10}\xf2\xebjW0c\xf9\xfe\xf7\n\xb4\x15r\xb9!w\xa8I\xc8\x0eHU\xe6V27d+\xc8\x1d-\x80\xdfQ\r/\xce\x00Ki\xbd\xb6\x84McA[\t\xf6\x1b;\xaa\xb5~\b\xbal\x84_N!<\x94\x90u\x16\x8c\xed\xc5\xf6,\xc3eA\xf6R5\xfa©\xabM\ad|\xc9\xda'\xd3\xecA\xd0R\x1f\xa5yd\x05\xc8\xca\xf4[\xf4\x10\xba{\xd8\xf6:\x04d<j\xa8V*\r\xb9]gϔ\x19\x8b\xde\x00&\xb1\x80\xc8\x17\xd40\x01\x1ej\x9aJ\x13S)\x81\xab\xf43\xd0\xfc\xfc(\xff\xaa\x81\xe4\x15\nk\xa6\x00\xa7|Cv\xb0\x97\n\"p\x15\xd8\xfe\xb61(e\t\xa3\x11%Y\x99\ry<\x82%#\xad\xb8\xf1r\xcf4y\xfbgR0Q\x19\xd8\f\xa0\x8d0\x18\x89B\r-\xe4\t\xd4\f\xbd\xdeSC\x7f\xb1\xedzd\xb2\xfd\t\x02\xb03\xddy\x92\xed\xce\xf6\xc7Ȭ<W\xc9v߂\xc84Y\xad\x88Td\xe5L\xe0\xea\x06A[\xa3j\xd6L\xb4ƈ@|f\x9c\x87q\x97\xcd\xdc\x11\xd0\xf1N?ʏ\xda\t\xe9\x1c!F\xba\xb5\xe8\xf2|\x04s\x04EJ\x19\x8cO\x04\xef=\xe3@\xf4Y\x1b(<U\x82\xca\x0fD\xc4\xe5\xc0\xb9\a\xa1-Q=\xce\xc3y\x8a\x8as\xba\xe3pK\x8c\xaa\x86\xc392\xec\xa4\xe4@\xfb\xe6\xa7O\x87Ϡ\r\xcbf\xa8\xb0\xea\x93\xc1\xf5\x8a\x10A\xf9\x1fpn1\x89hV\x99\xa1O@h\xa0\x865\x8b\x9c\xb7\x88ء\x00\xf9/A\xde[\x9d\x9dYM:Ėx\x9d̀\xa3\x9d\x10\x92p)\x0e\xa0\xdch\xd6\x1e\x06\xc9Q`e+'VU*\xe0V\xe7\x93}e\xb5\xe8\x90΄\xd8U<*\x03Lh\x034߬\xae\xc9 \xf8\x9a\xf1*\x87\xfc\xce9A\x0f\xd6}˃\xd3:Є=F}\x98\xec\xec-(g\x19\xfa^\xde\xcdZ\xa3\x87\x18cVcH\xcf%8'\xd52\xcec\xd8X\xc8\xd62\xd7`l\x93՟V7\x96\x9f\x11\xa0\xddQ\xbbchB\x15\xd4\x14\x88k\xbe\bH(Js\x1er\x8f\x19(\"\x04\x9bT\x13\x89\xac\xa3J\xd1\xf3\b\xe3jO\xfb2֍u\xef1O\x84f\xbf3\xfb\xfa\xe3.d`\x04\"\xd3\xdf+\x03\x17\xb3Lc\xb4H\x99\xb0\xac\xb2\x81[\x87S\x1a\x83\xa1\xc8t,ͬ\xafȄ\x83\x87qNØ\xef\x85.K%yLtk\x89\xf1\"i#D\x1a\xf5\x8a\xbec\xa2\x1c\xa5|\x9a#\xc4\x7f\xda6M\xacA2܀ ;8\xd2\x13\x93\xcaO\xbd\xf1\x03\xe0+d\x95\x89\xaeejH\xce\xf6{P\x16Ny\xa4\x1a\xb4\v7\xc7\t2\xee>\x93\x96r\x88\xfe؛G\xc3H+\xa98\xf31ԭ#зh᱈Z\x0f\x17-g\xceN,\xaf(G#JE\xe6\xe6Ck\xbcb\xc6x\x82\xc9\x03\x9c\x9d\x89\x0e\x98[Nt\xc2\x11)\xc0\xba\xa0\x85\x8d\xc1\x86McF\xc6=c\xd3\xdeQ\xebgH'\xa2\xaa\xe2\xa0\xfdPαkt\xc0\xcd(\xe8\x9a#.~\xe7t\a\x9ch\xe0\x90\x19\xa9\xe2\xe4\x98c\xb2{R\xf4\xda\b\x15#\x1a\xae\x1b\x104\x13\x9b\x00I0\xa8:\xb2\xec\xe8\xdc4+A\b\x87\xe4\x124\xaerZ\x96<b\x01\x9ag\x92\xf3~\x90\xa9\x85\xde<3K\xbe\x0f/\xb6\xf8\x9b'A76ό\x96\xecR\xb6\x16\ab\xe4\xe4\xb4\xff\x7f\x126\xa8\xfd\v\x84v;\xe8z]\xa1\xb5$e\xa0\xd1aB\xcf\xe5\x860\x13\xde\xceA\xb4AN3\xfe?1c\x96K\xfc\xb6\xdf\xf3\xaa\x12?ɕ9\x88\x96+\xf5\xf0\xff\x84LAc\xf1\xe0mE2C~n\xf7\xba!l_3$\xbf!{\xc6\r\xa8\x1eg\xbei\xbd\\\x83\x18)\xf6\xce>\x055\xd9\xf1\xc3W\xeby\xe9&\x97\x93H\x97~g\xe7\xbf\x06\x7f\xbek\x98g\xe0\x12\xdc\x14f\n\n\xb7\xd9\xfc\x88\xd4lޠG\xf5\xee\xd3\xfb\xd8nV\xf7I\x90\xbc\xc1D\xde\xf5\x90m\x0f\xed\x9d\xf2\xd4ixק\x8eo\\\x1a\xe1\x86P\xf2\x04g\xe7\xb1PA,s\xa8\x1dh$\xd2\x19\x12\a\xf3\x19(dOpF0>A1\xdb;U\x14\xdc\xf3\x04\xe7\x94f=\x02Z\x9c\x98\xf6\x89\x17KI\xfb\x02\t\x81\xfb\xd9\xe9\xc4#\x98l\n\xbah~r$]\x91\x84'\xd0\xfe\x82i\xd6lk%ꐱ\xaf\xb5c\x91]\x05GV&NԚ9\xdcJ\x90\xfb:\xdd\xf4\x85r\x96\xd7\x039\xb9ߊqo\xb8\xfb|\x92f+nȇ\xafL\xfb\x8c\xdf{\t\xfa\x934\xf8\xe6E\xc8\xe9\x10\xbf\x80\x98\xae#./\xe1Զ\xa5C;o\x95 \xdc\xeeٺ\b\xaff\x0f\xd3d+l\xdc\xe2\xe9\x81YH7ܴ}\xe8>E\xa511%\xa4X\xbb\xad\x97\xd8H\x8e؉ \xa5\xeapd\x88Z=\xe8\xc8^O\xfcy\xb4\x96\xc4\xf5wyUN3\xc8C^\x05\xb3\x81\xd4\xc0\x81e\xa4\x00u\x982\x1c\xed\xa7\xb4\xfa=\r\x85D\xad랅\x12\x96f\xda\xc3\xe3Uwt\xf3\xbb\xfb\xac\xed\xcaMh\x15\x98=\xdbt$\t8\xdet~Fhb\xd1\xff\x98\xa5.\xcds,Ӡ\xfc~\x81\xc6_\xc0\x8b\xa1\xedw\x889\vYPLN\xfc\xb75s(\xd0\xffCJ\xcaT\xc2\x1a~\x87\xe5\x18\x1c:}\xfd.V{\x18;\x02\xd3\xc4\xf2\xf7D\xf90\xbd\x1c\x99\x9c\xb4\xba\x05\xb83\xe4r?\xf0Xn\xc8\xf3QjgS1)2\v\x92i\xb2z\x82\xb3OƵ\xf5\xc0j+V\xce\xc0/V7\xb5\xb7 \x05?\x93\x15\xf6]}\x8b\x13\x94(\x89\x89;\xae\x9f\xea\xf2\x93uA˵\x97^#\v\x96\x8d\xf6\xc3r\x99T\x17\xdbƠ\xc1\x83\xb0\x1d\xeb\x1a\x11\xeb\x1eO\xcd6I~K\xa9#\x99\xef\x11T\xee\xa56nG\xb2\xe3\xce.\xd9\xfd\"N\xf6\xfc\xae\x17\xa1{W\xa5#U\xa8\xbf\xb0겷Qk\xb9\xad\xa75\xb3\xcb\x19\xf8\x9d4\a\xd4\x06d\xabf\xe5;=\xbcr9\v\x1c\x84f\xe8\x94\xcc\xc2-\x95\xcc@G\xb3\xc5͓\xa0\xe5g6\x17\xeb\x8dE\xea\x02\x1fW\xdc0\xbd\x99\x19\x9etG\xd6\x12ia\b\xf0\xe1kk\xd7\xd3*\r\xfb\xf7\x9c\xf0-ŋ\xe0Z/\nگ\xe2IB\xf1\xce\xf5\f\xcb\xc4\x03r!\x85:T\xa8\"\xd2=O/H߃y/\x98\xd8\xe2\x00\xe4\xed\xd5݁Z\xb9\xc6j9bO\x8f\xe4\xbeoC\xf4\xfa\x85\x18)\xe6\x88=\xa5\xc4\x1d\x7f\x05\x1d\xce\r\xf7ǭ\x83\x99\bRH\xd3ކ\xb0pK\x99\xbf\xd6dϔ6mDS\x85\"^+\x12{\x96F\\\xe2\x83R\x17\x05\\\xbf\xba\x9e\xad\r\xb0\xa3|\x0e\xb5P\xa3\xc5\x13\xb1\a\x93I@؞0C@d\xb2\x12\xb8mc\x97:\x0e\xe1X\xe0\x14t2\xc9\xd2\x14\x84}@TE\x1a\x01\xd6(uLL\xeeﴛ\x7f\xa4,\x96\x81\x1e>\v\xd9f\xc6J\xc6bO\x87m\xa1v\xac]\xd4VЯ\xac\xa8\nB\vK\xfa\xd4pi\xef*\xce:\x1c\xaf\xeb\xce\x10.\x9a\x11#\xed\xa2*9\x98\xd4\x15\xe9*\xcc\xec2\xd1,\x87\xda0{)\x90\x82P\xb2\xa7\x8c\x8f\x94\xbb\f\x9fE\xb4]\x12\xa3xeq\xbd\xe0#m\xf05\x92\"a\x037\xd1ɜ\xd6֥Jw\x15\xef\x15\xa4\xb9gs\x9b\xd9\xc1=+\x15\x93X\xa7we\x0f͋\x18\x15\xe7\x1f.\xda\xe0\xf9\xe1\xa2\xcd<?\\\xb4\xd1燋6\xff\xfcp\xd1\xfc\xf3\xc3E\v\xcf\x0f\x17퇋6\xd5lJ[\xcfa\xe4NǍ\xfc8\x8bEBZ{\n\xc5\t\xf8\xbe\n\xc3\xd7y\xa7Vfn\xe3\xbd\"u\xfcɵ\xe1\xbaeJ\xeaRM\xbb@\x82x\xbb\xc3>3ś\xdfP/\x1f\x06\xbd\xa8^~;\xd9\xf9J\xf5\xf2\x1eþ\xd7}\xa5j\xf90\xffe\xd5\xf27\xbeT\xa3\x00\x1a\xb6\xe7].>\x1f\x1b\xb27\xda\x00\xf0\xef\\\x7f;\xa8\x0f\xbb\x8c\xf1/^m?\xc2\xfc\xc4\xc2\xf8՟V\xdf\x1f\xa5\x17\xd3v\x94\x9a\x032E&\x15\x8e\xf9ڸ\xb2]\xdc\xd5-\xa4\xfb>\x85s\xa94\xa6V\xccO\xd1k\xa8eZ\x04\xfb^\x17\xb3\x81\xe2\xd7\xd2ۊ\xb4\x13\x9d\xdbH\x97\xb93\x9d\x91\xf9\xe0&\x80>\x8b쨤\x90\x95\xf6\xfb\x06\x16\xfa;ܾ\xf0\xa9P,\x03KT\xb0o\xc9QV\x91\x8a\xed\t\xda\xcd\xd4\xef\x8dW\xed\xf9\x1c5\x18zz\xbb\xe9\xfeb\xa4\xaf\xe1#\xcf\xcc\x1c#x>\x1fA`v]\x1c\xda\x05\xf9a\xc1\xf9\x03\xe7}A\"R\x11\xc1\xf8\x98\xc1\xaaO\xe5wLӯ\xa5\xdb$Zl\xf9\xa778Ҫ\xfc.\xae\xed\xeb\xd6\xee\x8d8\x81K\x93\xd9\xe9G\x18ҫ\xf7\xa6\xcb\xed\x96\xd4\xec\xf5+\xf2F\x81\xceW\xea\xa5\xecM\xcdT\xe5]P\x8b\x97X\x87\xfdͩ\xf7\x94j\xbb\x8bj\xecfK\x95\x13+\xeb\xba5s\xd3 \x17\xd4\xd3%\x11g\xbevnqŜ\xafP\x9b\x9cGr\x9d\\\xa4\x02n\x12\xf0hu\xdcT\xdd\xdb̾\xf7\xb0&.\xbd\xdam\x124V\xc2\xcd\u05f8]\xaf\x92\xfd\x1aQ\xf6\xb8\xaa\x99\xadS\x9b\x8d§\xf1\x9b\xadD[R\x7f6K\xb1\vk\xcd\xeaZ\xb2\x91q\x97V\x98u+\xc8F\x80\xa6ԕ\x8dԍ\x8d@\x9c\xac&K\xad\x16\x1b\x81=cv'\xa5d\xf2\xc7%Ub\xf1KTȬ5俗\xfc]J\x06\xa9:\xce\xe5\\@\xf3k\xaf\xb9\xe5|𱦝\u0558\x9f\xca\xccq\xb9\xb3ZTܰ\x92cz\xf1\xc4\xf2h\xccn\x8ep\xae/\x86\xf8M\xe2qMw\x99\t\xf9\xf5s-̛\x9e\xcbM5y\x06\xce\t\x8d\x89\xe2`晻\a(\x93k\xb0\x16\xc2.O\x7f兿.\xe8\xc6\xc9;\x9eH\x8de`\xcc\x11\n\ve\xfcړQU>\xedN:\xcf\x17\xdf\xfd\xbd\x02u&x=K\xed_̜\x87r\xcbR\xdbX((\n\xafm\xdc\xedS=7\xbbY\x9e\xe4\x9dp\x06/\n\xb6\x87#±\x1a\x82\u05fc\xb6\xca\xd0F\r#M\xe3\x1b\xb1\xb2\xee\x1d\xf9}\xceSM=L\xf4\xb2\x81\xc6\xf2Pc\xd6ȿH\xb8qy\xc01\x012\xf5pPZB|\xf60\xd0K\x05\x1es\xa1G\xb2ϕv\xd8\xe7%\x0e\xf9,8ܳ \x04Y\x16\x84$\x93)\xe5\x10ϋ\x84\"/\x18\x8c\xbcD8rY@2\x03\xb2w8'\xe5\xd8MR\xb1Gr\xbe3\xa5Xc>%9}\x9c&\xe1\x18MB\xb2r\x0eӄ\xe32ˎ\xc9$\xd0\xf0\x85B\x95\x17\nV^\"\\yـe6d\x99\x95\x9c\x99\x9f\x97\x1do\xb9x\xf3^\xaa\x1c\xd4d\xae#U4'\x85\xb2\x17_t\xc7\xec\xed\xfc\x87;\xe5l\xab\x8e+\x1b۰\xaeO\xbdg\xe4'&|\x1e\xd5\na\xcb\xeew\x120\x8d#\x12\xdf\xffo\xbc<\x7fۨ\xcb\xdah(\xa9\xc2\f\xeb\xee\xecJ+\xf4\x86|\xa0ٱ\a\xfd\x18\x8d+\xf6R\x15ԐU\x9d\xf2z\xe3\x80ۿW\x1bB>\xca:i߾IF\xb3\xa2\xe4g\x1b7D`\xae\xda .\x13\x88\xa8\xf0\x85\xf1\xef%gY\xc4ӊ^.\xe4\x1a\x0f\xae\x84\xc0+\x8f\xb2v껴\r\xe3\x8e\x16:e\xdd\xeb\x15\xf7\x92s\xf9\xbc0\x1c\xa7%\xfb\x0f\xbc\xa5y~\x0f\xe7\xdd\xfd\x16\x9b\x06I\xc1\u06dd\xeb\n\xa1\x1a\xe9\x1dX\x8b\xd9Lgl\xc5o\xf7\x1d\x88\x91J\xbb\xfaO\x94\xd6\xdab\xb3\xb1[\x97\\՟\xd54\xf7[\x87\xdd\x06\x85\x85\x8a3\x91X\xeba\x8eL\xe5\xeb\x92*svu\x0575\x0e\xe3\xfb8\xc1nN\xed\xb6\x8c\x9a\x97\xe1u\xbfQچ[\x7f1\x99w.\xbb\xa9\xd0>E/\xc1c\xfc(\xdf\xec!\xbe+\xe21\ue0ac\x91R\x91\xd7Ѣ\xa4\xab\xedbi\x7f\xb5\xed/\xf2\x04\uf8fbY\x1d\xf2<\xf4\x9aGʉ\x02Dw\xb9\xeb\xd4\xed\xa0x9\xe7e\xba(^\x1f\x14\x86\xf6\xd7w&\xceŷ\x8eL%\xdc\\\x1a\xe0\xea\xf8\xae\x8d]^\xf7_0\xb4\xaaU\x98wv|\xf0\x14\xb6\xae\xfa\x17\xdc\xfd\xe5\xfa5R\xdaHE\x0f\xf0\xb3tW/\xcfѠۺs\xef\xb6wyB\xcdbX\r\xb1P\xc0_\x02\xdd\x03֔\"\x0f\xae\xc1\xb5X.\xbc\xd5\xd7\x18>3\x99\xc7ǟ\xdd\x04\f+`\xf3\xber\xb9|\xab\xed4Xj\x86\x89\xb9N;\xfb\xdfc\xc4^\x10\xbcO\xb6ş\x16\xde\n\xb0\xdc\x19\xcb\xde\x16a\x7f\xea\\$\x1dH4'\xa2_\xe2\xbdZ\xfbK-&9\xd7#*\xa1cpZw\xe9\xe3\xce+\x1eW\xbe\ueb4bc\xce\xe4\xd8m\xe3x\xc3\xf6\xfc}\xe3\xee\"n\xffu\x01_4_)\xbc2\xd1_ҍW\f^t\xe5\xf8\xae\xae\v\xa9\xabN\xf4;cl\xa0\x1c\xd3\xdc\x11\xf4F\xfa\xd6\x06N\x1aʉ\xa8\x8a\x1d\xfa\xac1\x95Rw\xc1\x8a\x95\xc9R\x15\xe7\x80L0Α\x9a\t\x03\x87\xc1\xa6{l\xaew\xbe\xc6\xf9\x92\xb9\xd6}\xd3窫,\x03\xad\xf7\x15\xe7纾z\xc9\xc4c\xd6\xe5J\xa4\xf8H\x19\xbf\x88\x0e\xae\xe3\b\x11\xdc\xdcF\xf5h\x12\x9b}Q'\x88<,ށ)\xb0\x0f\x9e:XF\a\xcf\x02_k\xa5\r-\xe6nN\xbf\x1b\xf6\xc0\xcfZ\xa8\xbcU\x9dU_\xff\xfdLu\xc3\xe6\x98Oـs=\xd1\x05\xb5\xd0 'p\x02A\xa4\xc0\xaay\xbc\x8b\xd3}z\xa5\xdf'\x02\xb5\rŗ\xe5W%\x974\x0f\x06.D\x92\xfes\x1d\x8fh\xbe\xd5\t\xd4k=\x01\xb3\xbe\xd0=B\x84\xa1d\xba\xd0\xee\xd6\xfaF\xb0\x8e\x02M2\xfdQ]\x9bi\xd6\xd5\xf3\xc9J\xeb\xeea;\xd6sT\x82C\x83\x18\xff\x06\x1fN\xf8F%5\x9cY\xaa\x8a\x1a\xcelNAu\xd4Qdr\x8d\x82\xba\xfa4q\xad\xce\u07b8\x8c\x8d\x9c\a\x80ǋ\xc2u\xfa\xee|Q\x01Z\xd3C\xb8j\xf9\xd9:`\a\x10\x80\x9b\x11\x91\xd9\xf8\xed\xdd\xe6<J\xf7\xa2a\x97\x87\xa2\x99\xa9\xa8\x1f T\xfb\xb5Z\xbd\x8e)`.\x0f\xeeC\b,|\x00'x\xa6\vi\xf2\xb5d*œ\xfdP7\xb4\xb4\xc1\x1422\xa2\xf9`\x11pv`\xd6\r\xb4L:P\xb5\xa3\aXg\x92s@];\xc4\xeb%\x17\xab?\xf5\xf3\x19\xa8\x9e\x9d\xda\xc7v[\x9f\xafp\xdcv\xf7\xf4QW\xf8\x8a߯1LA\xf3A\xa8\x01B\x12\a^\xe4\xb9:*D?\x9d4Ĵ\xdd6,0\xafW\xfd\xae\x96\xff\x92ҍ\x8f\x85\xe2\xc1zA\x7f\x93\xea\x86\x14L\xd8\x7f\xa8\xc8]B!t^\x84?ޠ=\x83\xf7\xbdmS\x1f\xbel\xf9\x91\x10\x16\xc4X\xa4\x16?p\xb7&\x9f`\x18X\xb83t\x90c\n-\xf6\xbd(\xdbd+\xee\x95<(\xd0\xc3U\xb5&\x7f\xa3\xcc0q\xf8(\xd5=\xaf\x0eL4\xfeƢ\xc6\xf7T\x19F9?;|b\x882A9\xfbG\x8c;\xed\x1f\xe7\x01\xd5\xea6\xf2[\x02\x1ac?\xbc\akjGÍ\xb8 x\xba\xceɂo\xd6l\xf93\xe1d\x17\xcf\xeb\xedde:ʯQ\x9e\x11A\x0ecn\xc8'i d\x92Y\x17\xa65\x17\xa0\xcd\x1a\xf6{\xa9\x8c\xcb0\xacׄ\xed}\xf8\x12\xdb\"\xa6\x8cc%\x8c\xfb\xe0\x14a\xa6)\x1dl\xd6\x1b\xeeL(T\x1bxCnA\xcfn\xff\x90f\x99\x8d\x8e\xe1\x8d6\x94G4\xf27Ujc\x9ch\xd7\v\xe4\x7fM\xd9Vݶ\xdb\xd7{o\xb5=Fp\x8erx\xb6\xd6Y\xa3\xa8m&\xb8\xaf\x04\x82<+f\x8c\xb5\x00\xedR!b\xac\xce\xe7\x9ch\xab\x05G\xaeY\x9f\xb2E\xf8\xbb\xf5\x16\xb6\xe3\xa9\xcb\xee\x86D\xddx\xcc\xd9\xf0\x93\xc3\xef+\xed\x90\x04#\xd3r\a\x89\x98\x0e}-+\xb3#\x15\a+TJV\x87c\x90\xcb\x11[>\x027\xaf,R\xa4D\r\xa1C\x99\x86\xa9\x94h\xa5r\xea\xf3b\r\xba4{\x1a\xc5ԧ\xa2\xc3G\x0f\xdf\xf8\x8b\xd3\xd7{%\x8b\xb5\xe7\x05VW\xdc\xf8\xf4\x8ab\xd2\x06\xec\xe6\x18%9q\xdfT\xf27\x14\xa3\x18\x94%\bB\xb5\xc7'\xe1b\x89i\xb6N\xec\xa6jC\x95I\x8d\x83\x1e:\x8dgB \x84\x1c\xc7\xf7\xc1\xa7\x8f\xdc\x05\x1bw\xfe\x93b5\xe0\x1b\xa2\x99\b\xdf[t\xc9)'\n\xdaFF\nps-Z83\x88i:\x11L\x17\xfd\xdf7x9\xd56\xf1C\x8a\x17\xfc\xa5\u05fcw\x88\n?\xaeU7\xf1\x9ek\x84\x1e\x7f`{W˓Y\xac\xff\xf8\x7f~8\xea\x94\xe4e\xbd\x9et\xb0\xd0w\xaa=\xa5\x99Oi\xdds\xb0\x9e\x8f\x06\xe8\xfan\xaf\x179\xe9\xa7\xcb\xc2\xcekƜ\xe1S\xa0\u05c9\xc4N\x97E\x9b/\x16j^wv\xcf\x14??8\xb7\xc6\xfe\xe6\x9bEbM\x0f!\x12mF\xa6Qǟ\xb3\xd1f+\xd8\f8\x8e|-\xa8\x17\x80^)܌ځ\xc1KT\xa0ykm\xfb\x91\xfc\x9b\xff\r\x00\x00\xff\xff\x9a\xfbL\xe1\xa9x\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKs\xe3\xb8\x11\xbe\xebWt\xed\x1e|YQ3\x9bKJ\x97\x94FN\xaa\xa6\xe2\x89]#ǹ\xe4\xb0\x10Д\xb0\x06\x01\x06\x0fi\x94T\xfe{\xaa\x01\xf0!\x92\xb2\xe4\xa9$\x8b\x8bM\xb2\xd1\xe8\xfe\xfa\r\xcd\xe7\xf3\x19\xab\xe5\vZ'\x8d^\x02\xab%~\xf3\xa8\xe9\xc9\x15\xaf\xbfw\x854\x8b\xc3\xc7٫\xd4b\t\xeb༩\xbe\xa23\xc1r\xbc\xc7Rj\xe9\xa5ѳ\n=\x13̳\xe5\f\x80im<\xa3\u05ce\x1e\x01\xb8\xd1\xde\x1a\xa5\xd0\xcew\xa8\x8bװ\xc5m\x90J\xa0\x8d̛\xa3\x0f\x1f\x8a\x8f?\x17\x1ff\x00\x9aU\xb8\x84-㯡v\xdeX\xb6CexbY\x1cP\xa15\x8543W#\xa7\x13vքz\t݇\xc4!\x9f\x9e$\xff\x14\x99m\x12\xb3\x87\xcc,~W\xd2\xf9?_\xa6y\x90\xceG\xbaZ\x05\xcb\xd4%\xb1\"\x89\xdb\x1b\xeb\xff\xd2\x1d=\x87\xadS\xe9\x8bԻ\xa0\x98\xbd\xb0}\x06ษq\tqw\xcd8\x8a\x19@\x86&r\x9b\x03\x13\"\x82\xcdԓ\x95ڣ]\x1b\x15*ݞ%\xd0q+k\x1f\xc1L\xba@V\x06\x1am\xc0y\xe6\x83\x03\x17\xf8\x1e\x98\x83ՁIŶ\n\x17\x7fլ\xf9?\xf2\x03\xf8\xd5\x19\xfd\xc4\xfc~\tE\xdaU\xd4{暯\xc9FO\xbd7\xfeD\n8o\xa5\xdeM\x89\xf4\xc0\x9c\x7faJ\x8a(ɳ\xac\x10\xa4\x03\xbfGP\xccy\xf0\xf4\x82\x9e\x12B@\x10!4\b\xc1\x91\xb9|\x0e\xc0!q\x89\x18MK\xaaFg\x9d\x89M\xa2\xc0ˀK\x92\x9f\xded\xe9{l\x1b\xff.\xb8Ŗ\xa5\xf3\xac\xaa\xcf\xf8\xaevx\x89\xd9\x19\x14\xf7X\xb2\xa0|_U\xb2\x92\xea\xfb\xe5\xb9Z5\xf2B\xa4]g'ޟ\xbdK\xa7n\x8dQ\xc8\x12\x97Du\xf8\x98\xbc\x90\xef\xb1b\xcbLljԫ\xa7\xcf/\xbfۜ\xbd\x86)G\x1a\x04\x05\x19\x8e\xf5l\xb3G\x8b\xf0\x12\xe3/\xd9\xcde\xd5Z\x9e\x00f\xfb+r\xdf\x19\xb1\xb6\xa6F\xebe\x13,i\xf5rQ\xef\xed@\xa6;\x12;Q\x81\xa0$\x84ɏr\xbc\xa0Ț\x82)\xc1\xef\xa5\x03\x8b\xb5E\x87\xda\xf7\xe1m\x05+\x81\xe9,^\x01\x1b\xb4Ćb9(A\xb9\xeb\x80փEnvZ\xfe\xb3\xe5\xed\xc0\x9b\xec\xbc\x1e\x9d\x1f\xf0\x8c\xf1\xa9\x99\"W\r\xf8\x130-\xa0b'\xb0H\xa7@\xd0=~\x91\xc4\x15\xf0\x85\xfc]\xea\xd2,a\xef}햋\xc5N\xfa&\asSUAK\x7fZ\xc4t*\xb7\xc1\x1b\xeb\x16\x02\x0f\xa8\x16N\xee\xe6\xcc\xf2\xbd\xf4\xc8}\xb0\xb8`\xb5\x9cG\xd1uJ\x9a\x95\xf8\xd1\xe6\xac\xed\xee\xced\x1dEmZ1k\xbea\x01ʘ\xc9\v\xd2֤E\a4\xbd\"t\xbe\xfeq\xf3\f\xcd\xd1\xd1\x18C\xf4#\xee\xddFי\x80\x00\x93\xbaD\x9b\x8cXZSE\x9e\xa8Em\xa4\xf6\xf1\x81+\x89z\b\xbf\v\xdbJz\xb2\xfb?\x02:O\xb6*`\x1d\v\x13l\x11B\x1d㾀\xcf\x1a֬B\xb5f\x0e\xff\xe7\x06 \xa4ݜ\x80\xbd\xcd\x04\xfd\x9a:$N\xa8\xf5>4\xb5\xf0\x82\xbd&\xa3xS#?\x8b\x1f\x81NZ\xf2p\xcf<Ƹ\x18\xe0\x9aC\xfcr1m\xd6tp\xd3b\x9c\xa3s_\x8c\xc0ᗁȫ\x96\xf0L\xc6\x1am%],\x8bP\x1a;\xac\x18\xac\xcd\xc0\xfd\xd5d\xaab\xf4\ru\xa8Ƃ\xcc\xe1+2\xf1\xa8\xd5\xe9§\xbfY\xe9\xc7\a]0$\xad$\xe2\xe6\xa4\xf9\x13Zi\xc4\x15\xe5?\r\xc8[\b\xf6\xe6\betk\xedՉr\x90;i>ζ\xcdZ=}n2o\n\xa0\x1co\x19\xab\x02V9rM\t\x1f@HG\r\x80\x8bL\xc7`\xe9\xa0b\x83\xb0\x04oû\xd4\xe7F\x97r7V\xba\xdf\xd3\\\xf2\x98+\xac\aȭ\xe3I\x94\x9a\xc8;jk\x0eR\xa0\x9dS|\xc8R\xf2,I\xb0\xa9r\x95\x12\x95pcM/DYTŢ\xa0\xa8f\xea\x8a\r\xd7-a쀙\xd4Ƀ;\x061\xd9\xd8*\x97T\xedQ\x8b\xb6\x1b9\x93\xc6Ĭ\xe5P\xc0Q\xfa}J\x87j*\xee\xe0\xcdأ\xf5\x8a\xa7\xa9\xd7\x03ٟ\xf7H\x94\xa9\x80\"8\xe4\x16}\xf46T\xe4>\xe4J\x05\xc0\x97\xe0bB\x1d\xe6\x89f\xc5F\xad\xd9\xfd\x8a\xa71\xd0p\u0378\xb9\x85\xb9.\xf2\x1d\xb5\u038d\xc0\x16K\xb4\xa8\xfddR\xa7\x01\xc4j\xf4\x18\xf3\xba0\xdcQJ\xe7X{\xb70\a\xb4\a\x89\xc7\xc5\xd1\xd8W\xa9ws\x02|\x9e#h\x11Ǌŏ\xf1\xcf\x05\x95\x9f\x1f\xef\x1f\x97\xb0\x12\x02\x8cߣ%\xab\x95A5\x8e\xd6\xebo~\x8a5\xf6'\bR\xfc\xe1\xee{p1u\x8a\x9c\x1b\xb0\xd9D\xef?Q\xa3\x16\x85\"\x886\xc9*\xc6\x02UJ2v\x95\xad\x99r͔#Nu\x98\xfdE\x89\x89*\xc8TF}\xc5q2}#\xcc\x00\xbe\xcd;C\xcd+V\xcf\x135\xf3\xa6\x92|6\xd46\xb6\xc1W\"\xb2i\xbb\xa5\x16\x92S\xdbv\x1eI\xcd8\"κ\xf3\t\x18\x86\xfd\xfa\xa5\xfc1\rSR7W\xcf+\x12?\xf6i\xbb!.%\xb3\\\x11\x1dzj\xb7\x1ch\xa4\x8a\xc9\xec\x18\xe7\x98B\xb8њb\xd7\x1b`mb\xbcsÊ\xf0\xce|\xb2\r\xfc\x15'\x80\x1f\xa9\xf2)\x126\x18\xa7m$Kp\x18S\xf551\xe0zDp\xb6F{\x8b,\xeb\x15\x11\xb6E\x95\xc1z\x05۠\x85\xc2F\xa2\xe3\x1e5\xcd\x13\xb2<M\x9fE\xeb\xf9aӠ\x1a\xfb\x91<\x114\xd8N\xeb\x902\xfe\x12\xb6\xa7\x89\x0e\xe2\x06%k\x8b\xa5\xfcv\x83\x92O\x91\xb0\x01\xbcf~\x0fR;)\x10\xd8\x04\xfc\xa9\xb5\xbb\xa0h\xdb-<\xe6\x9c\xf3\x1d\xe6y+7$qޓ\x1e\x1a\x8c\xaf\xc4\xcfS&kQh\x9es\x9d8\xef\x1c/\xc5\xf1\xa4F\x87\xf6z\xe1O\xa9\x1f\xe3\x13\x85\xf5L\x98\x97\xf1\x8e7\xfa\xba\xe6\x92c*\x98\xa9\x8b0֢\xab\x8d\x164j\xdd\xd6\xd5u\"\xff\xf7z\xbbi\xb3\xceϳ\xdc\xe0[c\x85\x9b\x06\x9bx\xa1\xf3\xee\xd1&]s\xf5\a\a\xb3u4Wv\xd3\xcd@\xc7\xff\xcbP\xf3Co\xaa\xa1\xe9YCб\xaf\x8b\xfdA\x01\x7f\xd7pO\x930U'\xb1$\xb9\xed\x94\x03H\a\xda\x1ci{\x8f_d\x01F\xa7\xeaN\xb3\x1d\xd3\"\x8f\xce\xf1\xd3Q*E\xf5\xddbe\x0e\x93\xf5\x9d\xdaR\x8b\xea\x04̑\xeb\x1c~.>\x14?\xfcf3\x93b\xce\xd3\b\x84\xe2+\x1e\xe4\xf8Nh\x8c\xee\xc3hG\x13\xf8m8\xd0\xc3/\xcdh\xbd\xb0\x99\xec\x97\t0J\xa9\xa8s\x9c\xc8\x13]\xc70\xbe\xbd\xfc\xb4y\xb8s\xb1\xe1G\xed\xa7\x9a\xc4#Z\x8c\xf3\x15\n\xea\xf9M\xbe\xc5\bΣ\x9dp\x80\xd6z\xd1栌\xde\r\x02'\xad|\xa7A\xfd\\r(cA\xa0\xa7Ҥw\xc0\xf7Lﰻ\xb3\xca\xf2\xbf-)\xb9\xcf\xc0g:\x0f\x91\xfa\x92{\xdcd\xd1g9\xd5ԏ\xee\x8b;\xe2\xe9\xbb\xe2F\xfaƲ\x17\x87\xa2+\xb8\x8f\xe8\x9b*M\xa0\xce}w\x7fܭ\xef\x1f\x86Ǘ\xd37 \xf1ޛ\xf37nA\xe0\xc8\\w\x87\xfe\xdb\xe1PQ\xb7z\xb5\x05\xfe\x92\xa8\xd2ec\xde\x02lk\x82\x7f+2\xef\xa6\x1c:\xff8\xf0\x1e\x19\xe3O\x1eך\f\xa2i,\u0083\xa5\xc1\xb3\xbbC\x8bIa\xaa\xb6\xdc~\x19\xb5\x1a\xfc2\xd3\xff6\xfe\xdd\xe6\x06\xbd&k\xed\xe8e\xaa\x97=\xbbf\x90\xfbo¶\xbdW^¿\xfe=\xfbO\x00\x00\x00\xff\xff\x80.\x12\xd3P\x1c\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4\x96M\x93\xdb6\x0f\xc7\xef\xfe\x14\x98y\x0e\xb9<\x92\xb3\xed\xa5\xa3[\xb3\xc9a\xa7mƳ\x9bɝ&a\x8bY\x8ad\x01\xd0[\xb7\xd3\xef\xde!)\xf9E\xb67\xdbCy\x13\t\x02\x7f\xfe@\x80j\x9af\xa1\xa2\xfd\x8a\xc46\xf8\x0eT\xb4\xf8\x87\xa0\xcf_\xdc>\xffĭ\r\xcb\xdd\xdd\xe2\xd9z\xd3\xc1}b\t\xc3#rH\xa4\xf1#n\xac\xb7b\x83_\f(\xca(Q\xdd\x02@y\x1fD\xe5iΟ\x00:x\xa1\xe0\x1cR\xb3E\xdf>\xa75\xae\x93u\x06\xa98\x9fB\xef\u07b7w?\xb4\xef\x17\x00^\r\u0601A\x87\x82k\xa5\x9fS$\xfc=!\v\xb7;tH\xa1\xb5a\xc1\x11u\xf6\xbf\xa5\x90b\aǅ\xba\x7f\x8c]u\x7f,\xae>\x14W\x8f\xd5UYu\x96\xe5\x97[\x16\xbf\xda\xd1*\xbaD\xca]\x17T\f\xd8\xfamr\x8a\xae\x9a,\x00X\x87\x88\x1d|β\xa2\xd2h\x16\x00㱋\xcc\x06\x941\x05\xa4r+\xb2^\x90\xee\x83K\xc3\x04\xb0\x01\x83\xac\xc9F)\xa0\xbe\xf4X\x8e\ba\x03\xd2#\xd4p \x01\xd68*0e\x1f\xc07\x0e~\xa5\xa4\xef\xa0ͼ\xdaj\x9a\x85\x8c\x06\x15\xf5\x87\xf9\xb4\xec\xb3`\x16\xb2~{K\x02\x8b\x92ē\x88\x12\xd7\x06\x0ft\xc2\xf7\\@\xb1oc\xaf\xf8<\xfaSY\xb8\x15\xb9\xda\xec\xee*i\xdd㠺\xd16D\xf4?\xaf\x1e\xbe\xfe\xf8t6\r\xe7Z\xaf\xa4\x16,\x83\x9a\x94fp\x95\x1a\x04\x8f\x10\b\x86@\x13Un\x0fN#\x85\x88$v\xbaZu\x9c\x14\xcf\xc9\xecL»\xac\xb2Z\x81\xc9U\x83\\\xa0\x8d\x97\x00\xcdx\xb0\n\xd32\x10FBF_\xeb\xe8\xcc1d#\xe5!\xac\xbf\xa1\x96\x16\x9e\x90\xb2\x1b\xe0>$gr\xb1\xed\x90\x04\bu\xd8z\xfb\xe7\xc17\xe7s\xe6\xa0N\xc91?\xd3(\x97\xce+\a;\xe5\x12\xfe\x1f\x9470\xa8=\x10\xe6(\x90\xfc\x89\xbfb\xc2-\xfc\x961Y\xbf\t\x1d\xf4\"\x91\xbb\xe5rkej\x1a:\fC\xf2V\xf6\xcbR\xffv\x9d$\x10/\r\xee\xd0-\xd9n\x1bE\xba\xb7\x82Z\x12\xe1RE\xdb\x14\xe9\xbe4\x8ev0\xff\xa3\xb1\xcd\xf0\xbb3\xad\x17\x17\xa4\x8eR\xe8\xafd \x97yM{\xddZOq\x04\x9d\xa72\x9d\xc7OO_`\n]\x921\xa7_\xb8\x1f7\xf21\x05\x19\x98\xf5\x1b\xa4\x9a\xc4\r\x85\xa1\xf8Dob\xb0^ʇv\x16\xfd\x1c?\xa7\xf5`\x85\xa7+\x99s\xd5\xc2}餹\xa8S4Jд\xf0\xe0\xe1^\r\xe8\xee\x15\xe3\x7f\x9e\x80L\x9a\x9b\f\xf6m)8}\x04\xe6ƕ\xda\xc9\xc2Ծo\xe4\xebJ\xd1>E\xd49\x83\x19b\xdem7V\x97\xf2\x80M x\xe9\xad\ue9e2\x9d\xd1=\x14x{\xb6p\xbd\xa0\xf38\xb6\xc9\xf9\xca\xcd\xc3Cɝ%\x9c\xdd\xc2\x06.z\xee\xeb\\J3\xfc\x97dj'\x1e\xd9\xe8D\x84^N\xfa\xb3\xba\xb6\xe9\xad,\x90(\xd0\xc5\xecLԧbT^ze=\x83\xf2\xfbq#H\xaf\x04^\x90r\x19\xe8\x90r\x9fA\x03&]\xf0\x1b\xb1\x9c\xbe%\x91\x82F\xe6\xf6\xc2\xce\n\x0eW4\xbd\x92\x9d<|rN\xad\x1dv \x94\xf0Ff\x15\x91\xda\xcf\xd6ʛ\xf5\x1d\x04\xabls-\a\x87w\xfa\xbbI(\xb8}\x1a.#5\xf0\x19_\xae\xcc>\xf8\x15\x85-!ϯ|^\\Uz\x87\x9f\x817P\xbaz)/&9\xf7;sB\x91%\x90ڞr\xe5\xb4>\xf4\xef\x0e\xfe\xfa{\xf1O\x00\x00\x00\xff\xff\x045\f\xc6i\n\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VM\x93\xdb6\f\xbd\xfbW`\xa6\x87\xb43\x91\x9c\xb4\x97\x8eo\xad\x93\xc3N\xd24\xb3N\xf7NS\xb0\xc4.E\xb2\x04\xe8\xcd\xf6\xd7w@J\xfe\x94\xbd\xdeCu\x13\t\x82\x8f\x0f\x0f\x8f\xac\xaaj\xa6\x82y\xc0Hƻ\x05\xa8`\xf0;\xa3\x93?\xaa\x1f\x7f\xa5\xda\xf8\xf9\xf6\xfd\xecѸf\x01\xcbD\xec\xfb{$\x9f\xa2\xc6\x0f\xb81ΰ\xf1n\xd6#\xabF\xb1Z\xcc\x00\x94s\x9e\x95\f\x93\xfc\x02h\xef8zk1V-\xba\xfa1\xadq\x9d\x8cm0\xe6\xe4\xe3\xd6\xdbw\xf5\xfb\x9f\xebw3\x00\xa7z\\@㟜\xf5\xaa\x89\xf8OBb\xaa\xb7h1\xfa\xda\xf8\x19\x05Ԓ\xbb\x8d>\x85\x05\xec'\xca\xdaa߂\xf9Ð澤\xc93\xd6\x10\x7f\x9a\x9a\xfdl\x86\x88`ST\xf6\x1cD\x9e$\xe3\xdadU<\x9b\x9e\x01\x90\xf6\x01\x17\xf0E`\x04\xa5\xb1\x99\x01\fG̰\xaa\xe1t\xdb\xf7%\x95\xee\xb0W\x05/\x80\x0f\xe8~\xfbz\xf7\xf0\xcb\xeah\x18\xa0A\xd2\xd1\x04\xceD\x9d`\x06C\xa0`@\x00\xecw\xa0@9P\x91\xcdFi\x86M\xf4=\xac\x95~La\x97\x15\xc0\xaf\xffF\xcd@\xec\xa3j\xf1-P\xd2\x1d(\xc9WB\xc1\xfa\x166\xc6b\xbd[\x14\xa2\x0f\x18ٌ,\x97\xef@C\a\xa3'\xc0\xdf\xc8\xd9J\x144\"\x1e$\xe0\x0eG~\xb0\x19\xe8\x00\xbf\x01\xee\fA\xc4\x10\x91\xd0\x159\x1d%\x06\tRn8A\r+\x8c\x92\x06\xa8\xf3\xc96\xa2\xb9-F\x86\x88ڷ\xce\xfc\xbb\xcbM\u0090lj\x15\x8fr\xd8\x7f\xc61F\xa7,l\x95M\xf8\x16\x94k\xa0W\xcf\x101\xf3\x94\xdcA\xbe\x1cB5\xfc\xe1#\x82q\x1b\xbf\x80\x8e9\xd0b>o\r\x8f\xbd\xa3}\xdf'g\xf8y\x9e\xdb\xc0\xac\x13\xfbH\xf3\x06\xb7h\xe7d\xdaJE\xdd\x19F\xcd)\xe2\\\x05Se\xe8.\xf7O\xdd7?ġ\xdb\xe8\xcd\x11V~\x16\x99\x11G\xe3ڃ\x89\xac\xf9+\x15\x10\xd5\x17\xc1\x94\xa5\xe5\x14{\xa2eHع\xff\xb8\xfa\x06\xe3ֹ\x18\xa7\xec\x17\xe5\xec\x16Ҿ\x04B\x98q\x1b\x8c\xa5\x88Yy\x92\x13]\x13\xbcq\x9c\x7f\xb45\xe8N駴\xee\r\xd3(f\xa9U\r\xcbl(\xb0FH\xa1Q\x8cM\rw\x0e\x96\xaaG\xbbT\x84\xff{\x01\x84i\xaa\x84\xd8\xdbJp腧\xc1\x85\xb5\x83\x89\xd1\xc9.\xd4\xeb\xa4\xd5W\x01\xb5TO\b\x94\x95fctn\r\xd8\xf8\bj\xdf\xf9\x03\x81\xf5Q\xe6\xe9\xce\xcd\xe0Tl\x91OGO\xb0|\xcbA\xb2\xfdS\xa7\x8e\x8d\xe6G\xac\xdbZ\xbc\x82\x06 \xc5=~\xaa\xcf2^\xc6\x00\x93\xea\x9dD2\x8aXh\x10^\xc5\nĤ\x0e1\x9do-\x1f\xba\xd4OoP\xc1\xef\x19\xf3g\xdf^\x9d_z\xc7\"\xf7\xabA\x0fަ\x1eWN\x05\xea\xfc\v\xb1w\x8c\xfd\x9f\x01c\xb91\xaf\x86\x8e\x17\xef\ue5ba\x12\x98\xec\xc5}\xefQ\xfc\x1e/\x9ft\b\xb8)\xcb\r\x98\x86ț\x0e\xba\\ݽ\x86\xc2\v\xe1W\x8bt\xa1m\xc7/_\xcf/kP.\xf8Q\x83\xb2\xa4\xdcY\b\x9f\xd2\x1a\xa3CF\xda\xdb\xe7\x93\xe1n2#\xc0Sgt\x97\x17f\x01\x8b3\x13ym\xb2Ͻ\x1e\xbe\xf4\xbd\x898\xd1DUn\xae\x89a\x01\x7f6|\xc1\xad.mP\r\x0er\x93\xe3\xb1\xe2D\xaf\xf0\xbc\x1c?R\xadS\x8c\xe8xȒ\xdf\x00\xa7\vn5\xbd\xd1)\xfe\xba\xff\xfc\x82\xf3}\xd8G\xe6Ǭ2\xae\xa0\t\x11+2\xad\xbc\\dN\xbc/{\xd29\x19\xe5;~I\x1d\x135YQ\xfc\x1eLi\x98\x17 ~\xdc\x05\x16\x83FW.\xdfӷbN\x88\x94\x1f6Z\x9d>\xa9\xe4[#4h\x91\xb1\x81\xf5s\xb9i\x9e\x89\xb1?ǽ\xf1\xb1W\xbc\x00\xb9\x94+6\x132r\xc9Z\xb5\xb6\xb8\x00\x8e\xe9\x92\xca&\x0f\x1e:E\x13mxt\xe6\xaf\x123%\x8c]3^U\x06\\\xbc\x0f*\xf8\x82O\x13\xa3_\xa3\xd7H\x84\xe7mt\xf1$\x93Mp6H\xf2rj\x0eX\x1a\x1e\xe4\xc3\xc8\x7f\x01\x00\x00\xff\xff\xa7\x94\xfb\xf9\xa5\r\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKs\x1c\xb7\x11\xbe\xf3Wt\xc9\a\xc6U\x9aY[I\xa5R{\x93\xa88\xc5ĦX\xa2\xa4\x8b\xcb\a\xec\xa0g\x06\xe6\f\x80\x00\x98%7.\xff\xf7T\xe3\xb1;\x0f\xec.Ɋ\x1c\\\xc8ţ\xf1\xa1\xdf\xddS\x14\xc5\x05\xd3\xe2\v\x1a+\x94\\\x03\xd3\x02\x1f\x1dJ\xfae\xcb\xfb\xbf\xd9R\xa8\xd5\xf6\xfb\x8b{!\xf9\x1a\xae\x06\xebT\xff\x11\xad\x1aL\x85\xef\xb1\x16R8\xa1\xe4E\x8f\x8eq\xe6\xd8\xfa\x02\x80I\xa9\x1c\xa3iK?\x01*%\x9dQ]\x87\xa6hP\x96\xf7\xc3\x067\x83\xe88\x1aO<]\xbd\xfd\xae\xfc\xfeM\xf9\xdd\x05\x80d=\xaeA+\xbeU\xdd\xd0\xe3\x86U\xf7\x83\xb6\xe5\x16;4\xaa\x14\xea\xc2j\xac\x88vcԠ\xd7pX\bg\xe3\xbd\x01\xf3\xad\xe2_<\x99w\x9e\x8c_\xe9\x84u\xffʭ\xfe(\xac\xf3;t7\x18\xd6-A\xf8E+d3t\xcc,\x96/\x00l\xa54\xae\xe1\x86`hV!\xbf\x00\x88O\xf4\xb0\n`\x9c{\xa6\xb1\xee\xd6\b\xe9\xd0\\\x11\x85Ĭ\x028\xda\xca\b\xed<Sn\x15\x87\x00\x10\x02B\xb0\x8e\xb9\xc1\x82\x1d\xaa\x16\x98\x85\x1b|X]\xcb[\xa3\x1a\x836\xc0\x03\xf8\xd5*y\xcb\\\xbb\x862l/u\xcb,\xc6\xd5\xc0\xde;\xbf\x10\xa7\u070e@[g\x84lr0>\x89\x1e\xe1\xa1E\t\xae\x15\x16\xc2k\xe1\x81Y\x82c\x9c\x7fe\xfeb\xbfNǭc\xbd\x9e \xb82\xc8\x0eG\x03\x04\xce\x1c\xe6\x00\xec\xf9\t\xaa\x06\xd7\"q\xde+\x16\x13R\xc8\xc6O\x05I\x80S\xb0A\x0f\x119\f:\x83LcUj\xc5K\x99\x88N`\xdd\xccf\xcf\xf1\x86\xf6\xff\xafQM\x00\xdd*\xfe\x02(Ϻ7l\x9e\xdc\xfae<uV?Z\xf4{\xd2\xe5\x83\xee\x14\xe3h\xe8\xfa\x96I\xde!I\x96\x813L\xda\x1a\xcd\x11\x18\xe9ا\x9d\x9e\x82\xf9\x9c\xe8\x8dV\x9eÌh;wN\x19\xd6 \xfc\xa8*\xef\xa0H\xa5\rNtڶj\xe88l\xd2-\x00\xd6)\x93UpB\x1cNE\xba\x89\xec\xccΦw\x1eG?\xa2\x9d\xfciY\x91\x8d\b%\xf3\x16\xf4\xb6\xc1\xbc\xf5\x84\xe5\xed\xf7\xc1]U-\xf6l\x1dw*\x8d\xf2\xed\xed\xf5\x97?\xdfM\xa6\x01\xb4Q\x1a\x8d\x13\xc9}\x861\n\x0e\xa3Y\x98\xb2\xfa\x92\b\x86]\xc0)*\xa0\r:\x18\xe6\x90G\fA\x1c\u0082AmТtc\x96\xa4\xa1j`\x12\xd4\xe6W\xac\\\twh\x88L\x12L\xa5\xe4\x16\x8d\x03\x83\x95j\xa4\xf8Ϟ\xb6%]\xa3K;\xe60z\xf1\xc3\xf0\x8eV\xb2\x0e\xb6\xac\x1b\xf050ɡg;0H\xb7\xc0 G\xf4\xfc\x16[\xc2O\xca \bY\xab5\xb4\xcei\xbb^\xad\x1a\xe1RP\xacT\xdf\x0fR\xb8\xdd\xca\xc77\xb1\x19\x9c2v\xc5q\x8b\xddʊ\xa6`\xa6j\x85\xc3\xca\r\x06WL\x8b\xc2C\x97>0\x96=\xff\xc6\xc40j/'X\x17\x8a\x11\x86\x0ff'$@\xe1\f\x84\x05\x16\x8f\x86W\x1c\x18\x9d\xdc\xd1ǿ\xdf}\x82t\xb5\x17Ɯ\xfb\x9e\uf1c3\xf6 \x02b\x98\x905\x995\t\xb16\xaa\xf74Qr\xad\x84t\xfeG\xd5\t\x94s\xf6\xdba\xd3\vGr\xff\xf7\x80֑\xacJ\xb8\xf2\x99\x02\xb9\xa7A\x93\xe6\xf2\x12\xae%\\\xb1\x1e\xbb+f\xf1\xab\v\x808m\vb\xec\xd3D0Nr\xe6\x9b\x03\xd7F\v)E9\"\xafY\xdeq\xa7\xb1\"\xe9\x11\x03館E\xf4P\xb52\xc0\xe6\xdb\xcb\t\xe1\xbc\xe1\xd2\xc8z\xa7\xf9\xa6\x19\xb2w\xb93\t\x9b\x1c\xf9\xd4\xe40\xc3\xce\x05Q\x80n\xeee\xf7g\fje\x85SfG\x84\x83\x83-\x17\x14\x8e\x88\x81\x86T\x1cϼ\xe3Fq\xcc\xc1\xa6\xa3\xe0Z\x16\xb4\x95\xf2+\xf2G\x83\x94\xcb[h(\xf9,`Z\xf13\xb8\xe2\x8d\f\f\xd6hPV\x98\x1cש\xe4!\x83l\x1c֗\x18\x8f+\x05\x9c\xf0\xeaY\xc4oo\xaf\x93'OL\x8c\xd8\xdd\xf2\xde3\xfc\xa1Q\v\xec\xb8\x0ft\xe7ﾼ\xae\xc3eާ9\x05\f\xb4\xc0\x90\x06\xee\x83\x04\bi\x1d2\x0e\xaa\xceR\xa4\x9a\x04\xc8\xf0\r\xc6\x13\xaf\x83\a\x8b\xae\xf2\x10Z\x88\xf7\xc0\xc8w\n\x0e\xff\xbc\xfbp\xb3\xfaG\x8e\xf5\xfbW\x00\xab*\xb4>\vvأt\xaf\xf7\x899G+\frJ\xb3\xb1\xec\x99\x145ZW\xc6;\xd0؟\xdf\xfc\x92\xe7\x1e\xc0\x0f\xca\x00>\xb2^w\xf8\x1aD\xe0\xf8\xde-'\xa5\x116\xb0cO\x11\x1e\x84k\xc5<\x98\xee9@\xea\x15\x9f\xfd\xe0\x9f\xeb\xd8=\x82\x8a\xcf\x1d\x10:q\x8fkx\xe5Ӛ\x03\xcc\xdf\xc8v~\x7fu\x84Ꟃi\xbf\xa2M\xaf\x02\xb8}\x1c\x1e\x1b\xdd\x01d\xb0<#\x9a\x06\x0fY\xd5|\xf8\xa0B\xae\xfa[P\x868 Ո\x84'L\xd2\v\x8e\x12\xf9\x02\xf4\xcfo~9\x8ax\xca/\x10\x92\xe3#\xbc\x01\x11K\x1b\xad\xf8\xb7%|\xf2ڱ\x93\x8e=\xd2MU\xab,\x1e㬒\xdd.\xe4\xb9[\x04\xab\xa8P®+B\x1e\xc4\xe1\x81\xed\x88\vIp\xa4o\f43\ue936\xa6\xec\xe7Ӈ\xf7\x1f\xd6\x01\x19)T\xe3=1E\xcdZP6CiL\x88\xc5^\x1b\x17\xc1<\r;\x04\xf5q\n\xaa\x96\xc9\x06\xc3{\x11ꁢcy\xf9\x12;^\xa6$idR\x93\xb9\xe3\xf8\xbf\x05\xf7'>\xceg\xd0Oxܸ\xca8\xf9\xb8\xfba\x83F\xa2C\xff>\xae*KO\xabP;\xbbR[4[\x81\x0f\xab\ae\xee\x85l\nR\xcd\"\xe8\x80]\xf92u\xf5\x8d\xff\xf3\xe2\xb7\xf8\x8a\xf6\xa9\x0f\x9aT\xda_\xf3Ut\x8f]\xbd\xe8Q)\x87}z\x1c\xbb\xbc\x8b\x99\xd5\xfc,\x99\xc5C+\xaa6\x15'\xd1\xc7\x1e1&A\x990\x0f\xae\x99\xc9\xddWWeb\xe8`\bѮ\x88\xbd\xb4\x82IN\xff[a\x1dͿ\x88\x83\x83x\x92\xf9~\xbe~\xff\xc7(\xf8 ^d\xabG\x12\xf00\x1e\x8b\x03\xac\xa2g\xba\b\xbb\x99S\xbd\xa8f\xbb)+\xbd\xe6\xc4\xf8Z\xa09\x93\xc6}\x9clN\x89f&\xbf\xdd\xefyV\x1e\xe9X\x93I\xdcƭ\xc3S\xe9\xddI~M\x1b7\xac\xb1\xc0\f\x02\x83\x9ei\x92\xf3=\ue290\x10h&(\x9aS\xc0\xdewE\x80i݉l\xe0\x8ea?\xa6\xac\x91\x13T\x96\xb3\xc6\x1e{{Vj\xe3.\xd0\x19)|\x1emM28Ӈrmή'ݩ%Z\x94C\xbf\x84R\xc0\xbd҂e\xe6\rZ\xb7\xd0/Zx\xb5\xccKN\b+\xf0\xf2\f\x0fb{8S\xeaDQ\x84\xbcp_\xee\xf8\x8e`\xae\x9e8^L\x1c\x85H\xf5<e\xb9S\x88E\xbe\xf0\x9c\xed\xa1Bl6\xa5\x15\xbf\x983rlf\xb3\xc5I\xd7r\x8ctY\x8d\xfbf\xf43\xea\xf1\xd0d\x8f<\r\xdeץ\xd6;\x95\x1e/\xad\xc8+EY\xfd\xa4\xa3wF\xbcW\xcb\x13\xbe\xf9exTwѓ\xf5\x8eZ\xf2\xf1\x8e\\I\r#rᤏwD\r\xb9O\xb9\xa9\"\xa8\x99\xe8\x90C\xfa\xee2?\x93\xa1:\xa6\xb2\xc1\x9a\x82C0\xbdT\xc8Fx\xfb\xb4\xb6E\xb0\xbe\xabtiO\xd0\x1c,r\xdf\x01\xc90a\x99\xea\xd6\xca\xf4̅.h\x91%*\x87\xaec\x9b\x0e\xd7\xe0̰\\>a\x89=Z˚s\xa6\xf8S\xd8\x15\xea\xfbx\x04\xd8F\rn_\xe0O\xdc㥍:\xf5\xbc\x1eC\xb6t\x9e\xaa3\xa3\xd2\xc6\xc6\x14\xbf\xeb\xfc\x99\xb1#8|\x93\xf3\xa86\x98O\x11^\xe2\x13\x00\xfcǦs\biO\xce\xc0\xf6\xde뤅\xc1\t\xa7|\x83\x0f\x99\xd9\xc5G\xb2\xf1\xe2U2\x99\xcc\xda\x0f\xde\x1a\x9e\xf5\xfex\xd19\x16\xc4mЪ.\x19\xb3r\xac\x039\xf4\x1b4ć\xcdΡ\x9d\xba\xf3\\7\xc7W\x81\a6\x8e\xce'\xf9\x05J\xb1\xb0\xad\x98\xf4]W\xb2.\xa7\x80\v\xab;\xb6\xcb\x10N\x0f\xf1\x99\x1e\x19\x17\xb9\x80\x83>'\xa3\xd6h\xfc\xd2s\xbbP\x1e\xd3{%\x8f\xd4%ɞ\x85t\x7f\xfdˉ\xbcPH\x87\xcd,8\xc4ub\xe7;\xba\xe5\xeb\xdcp\"\x89\xb1\x92i\xdb*w\xfd\xfe\x8c\x16\xdc\xed7&k8\xa4\x8c\xde\xf7\xf9\x9ep\xdc\x14U!'\xaa\xbdoy\x96\xa9N?Ϟ\x83:\xd9|&\n\xc5\x0fù\x18t\x87\x9a\x19\xb2t\xff\x05\xe1j\xfe\x89\xeb5X\xe1ۢ\x94y\x86T44-,\x05'J\xad\x94\xc1\x8c˄eX\x99\x04\x91)\xfc?2~d\xf5d1\xe9\x91\xf3\x11\xed\xd8Z\x1f\xcf\f\x9b\xfdg\xa35\xfc\xf6\xfb\xc5\x7f\x03\x00\x00\xff\xffY\xc0\xfaX\xc0!\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_\x93۶\x11\x7fק\xd8q\x1e\xae\x991\xa9\xd8\xedt:z\xb3\xef\x9aε\xc9Yc\x9d\xfd\x92\xc9\x03D\xacHD$\x80\x02\xa0tj&߽\xb3\x00A\xf1\x9f\xa4\xd3M.\xe1\x8b}\xc0b\xf1\xc3\x0f\xfb\x0f\xab$IfL\x8b\xafh\xacPr\x01L\v|r(\xe9/\x9bn\xffaS\xa1\xe6\xbbw\xb3\xad\x90|\x01\xb7\xb5u\xaa\xfa\x8cV\xd5&\xc3;\xdc\b)\x9cPrV\xa1c\x9c9\xb6\x98\x010)\x95c4l\xe9O\x80LIgTY\xa2Ir\x94\xe9\xb6^\xe3\xba\x16%G\xe3\x95ǭwߥ\xefާ\xdf\xcd\x00$\xabp\x01Z\xf1\x9d*\xeb\n\rZ\xa7\f\xdat\x87%\x1a\x95\n5\xb3\x1a3R\x9e\x1bU\xeb\x05\x1c'\xc2\xe2f\xe3\x00z\xa9\xf8W\xaf\xe7s\xd0\xe3\xa7Ja\xdd\x7f&\xa7\x7f\x10\xd6y\x11]ֆ\x95\x138\xfc\xac\x152\xafKf\xc6\xf33\x00\x9b)\x8d\vx (\x9ae\xc8g\x00\xcd9=\xb4\x04\x18\xe7\x9e9V.\x8d\x90\x0e\xcd-\xa9\x88\x8c%\xc0\xd1fFh\xe7\x99i\xf5\x80ڀ+\x90\xb6\xf4\xac2!\x85\xcc\xfdP\x80\x00N\xc1\x1a\xa1A½2\x80_\xac\x92K\xe6\x8a\x05\xa4D\\\xaa\x15Oe\xd4\xd9\xc8\x04\xce\x1f\x06\xa3\xee@\xe7\xb0\xce\b\x99\x9fB\xf6;\x83\xea\xe1Y*\xfeL$\x8f\x05z\x99\x88\xa6֥b\x1c\rm^0\xc9K\x042Pp\x86I\xbbAs\x02E\\\xf6x\xd0}$_\xa2\xbe\xce\xcc5\xec\\CE\x90\xedm\xff\xb5;tiߥ\xe2\xcd\x02h\x8c\x1a\xacc\xae\xb6`\xeb\xac\x00f\xe1\x01\xf7\xf3{\xb94*7h\xed\x04\f/\x9e\xea\x82\xd9>\x8e\x95\x9fx]\x1c\x1be*\xe6\x16 \xa4\xfb\xfb\xdfNck\x16\xa5N9V~<8\xb4=\xa4\x8f\xc3ဖ\x9c-o\xae\xffO\x81\xbb&HwJ\xf6y\xfd8\x18\x9d\x02\xdbQ\x1a\xe3m\x9a\x19\xf4\xa1\xf6QTh\x1d\xabtO뇼\xaf\x8f3\x17\x06\xc2\xf4\xee]\beY\x81\x15[4\x92J\xa3\xfc\xb0\xbc\xff\xfa\xd7Uo\x18@\x1b\xa5\xd18\x11\xa3k\xf8:ɣ3\n}foHa\x90\x02NY\x03mp\x8a0\x86\xbc\xc1\x10\x9cEX0\xa8\rZ\x94!\x8f\xf4\x14\x03\t1\tj\xfd\vf.\x85\x15\x1aR\x03\xb6Pu\xe9#\xd0\x0e\x8d\x03\x83\x99ʥ\xf8_\xabے\xefѦ%s\u0604\xf8\xe3\xe7c\xb0d%\xecXY\xe3[`\x92C\xc5\x0e`\x90v\x81Zv\xf4y\x11\x9b\u008fd!Bn\xd4\x02\n\xe7\xb4]\xcc\xe7\xb9p1if\xaa\xaaj)\xdca\xee\xf3\x9fX\xd7N\x19;\xe7\xb8\xc3rnE\x9e0\x93\x15\xc2a\xe6j\x83s\xa6E\xe2\xa1K\x9f8ӊ\x7fc\x9a4kozXGN\x17>\x9f\xeb\xce\xdc\x00%;\x10\x16X\xb34\x9c\xe2Ht\fٟ\xff\xb9z\x84\xb8\xb5\xbf\x8c!\xfb\x9e\xf7\xe3B{\xbc\x02\"L\xc8\r\x05]\xbačQ\x95\u05c9\x92k%\xa4\xf3\x7fd\xa5@9\xa4\xdf\xd6\xebJ8\xba\xf7\xff\xd6h\x1d\xddU\n\xb7\xbe\x92\xa0xYk\xb2\\\x9e½\x84[Vay\xcb,\xbe\xfa\x05\x10\xd36!b\x9fw\x05\xdd\"h(\x1cX\xebL\xc4\n\xe6\xc4}\r\xab\x92\x95ƌ\xae\x8f\x18\xa4\xa5b#2\xef\x1b\x14~\x80\x8d\xe4Ӟ\xeaiץoͲm\xadWN\x19\x96\xe3\x0f*\xe8\x1c\n\r\xb0}\x9cZ\x13\xc1\xc9N\xce\v\xca\xc1\x06ɑR\x802.\xde\x17h\xb0\xbbƠVV8e\x0e\xa48d\xcbt\xa4\xe1\xc4E\xf8#+~\xe1\x18\x14\xee\xbdC\x18ܠA\x99a\x8c\x10\xe7*\x99\x89St\x12\xfa\x18\xe2i\xea\xe1L\xf4\x9c\x04\xfcay\x1f#fd\xb8\x81\xee\xc6\xfb^\xa0\x87\xbe\x8d\xc0\x92\xfb\x84ry\xef\x9b\xfbM\xd8\xcc\xc7\x0e\xa7\x80\x81\x16\x18*\xd26\x18\x83\x90\xd6!\xe3\xa06\x93\x1a\xe9m\x00\xe4`\x06\x9b\x15oC\xa4hB\xd21\x84\x13\xf5\xc0(F\t\x0e\xff^}z\x98\xffk\x8a\xf9\xf6\x14\xc0\xb2\f\xad\xf5\xf9\x1a+\x94\xeem\x9b\xb39Za\x90S\xe1\x82iŤؠui\xb3\a\x1a\xfb\xd3\xfb\x9f\xa7\xd9\x03\xf8^\x19\xc0'V\xe9\x12߂\b\x8c\xb7\xe1/ڌ\xb0\x81\x8eV#\xec\x85+\xc40i\xb5\f\x90u5\xc7\xde\xfb\xe3:\xb6EP\xcdqk\x84Rlq\x01o|%x\x84\xf9+9\xd6ooNh\xfdKp\xa07$\xf4&\x80k\xf3]\xd7#\x8f ]\xc1\x1c8#\xf2\x1c\x8f\x85\xe8\xf0\xf3\xc1\x9bBⷠ\f1 UG\x85WL\xb7\x17\xe2\x11\xf2\x11\xe8\x9f\xde\xff|\x12q\x9f/\x10\x92\xe3\x13\xbc\a!\x037Z\xf1oSx\xf4\xd6q\x90\x8e=\xd1NY\xa1,\x9ebV\xc9\xf2\x10\xaa\xfd\x1d\x82U\x15\xc2\x1e\xcb2\t\xf5\x06\x87=;\x10\v\xf1\xe2\xc8\xde\x18hf\xdcYk\x8dU\xc6㧻O\x8b\x80\x8c\f*\xf7\xf1\x8e\xb2\xd3FP\xd5@\xe5B\xc8y\xde\x1aGI3~\xb6\x0e\xe6\xe3\x14d\x05\x939\x86\xf3\"lj\xcaB\xe9\xcdK\xfcx\x9c\xfa\xe37Q\x02\f\x03ǟ\x96D\x9fy8_\xa9>\xe3pݷ\xd6\xd9\xc3m\xeb5\x1a\x89\x0e\xfd\xf9\xb8\xca,\x1d-C\xed\xec\\\xed\xd0\xec\x04\xee\xe7{e\xb6B\xe6\t\x99f\x12l\xc0\xce\xfd\x93y\xfe\x8d\xff\xe7\xc5g\xf1\xaf\xeb\xe7\x1e\xa8\xf7\xe8\x7f\xcdS\xd1>v\xfe\xa2C\xc5Z\xf1\xf9y\xecf\xd5\x140õ\xe4\x16\xfbBdE|\x0441\xf6\x843\t\xaa8y\b\xcdL\x1e^ݔ\x89\xd0\xda\x10\xa2C\xd2\xf4\xb4\x12&9\xfd\xdf\n\xebh\xfcE\f\xd6\xe2Y\xee\xfb\xe5\xfe\xee\x8f1\xf0Z\xbc\xc8WO\x14\xba\xe1{J\x8e\xb0\x92\x8a\xe9$H3\xa7*\x91\r\xa4\xa9\xf6\xbb\xe7D\xfcF\xa0\xb9P\xc5}\xee\t\xc7*t\xa2\x8ale\xae*#\xadd\xda\x16\xca\xdd\xdf]\xc0\xb1j\x05#\x86\xe3u5\xc5c\xd45h\x02]\x87\xc7\xfb\xcb\xc3\xe9@\xd2\a\u0557\x8eȔ\x11\xb9O[\xad\xef\xfbW\x84d\x15\xeb6\xff\xba_Ŵ\x162\xbf\nk\xb7\x97v\x01藎hDy\xa1\x9b\xe7\x8a)\x9c\xbd\x1e\xdf\x18-ʺ\x1aCI`\xab\xb4`\x13\xe3tG#\xfb\xa4\x897\xe3\xba\xe6\f\x13\xc1\x00.pд\x9e&\xdeQ\x8d\xfd\x84\xbaҏ\xd0\xdb\xc5[\xd1t@\xbe֮\xe8\xd9MEr\x1fa2\xfd:\x1c\xc8h\xc5gCҺ.9\x98<:\xd4p\xa2o\xab\x83\xd9^K\xb4{\x9a\xf1\xc3\xda\xf7ۮyZ\x87\x1e_\xc3{\x88\xf0.v\xfe\xe8y\xf3\xe2\xc7u\xa6\xe8\xe9\xd0k\xcf]\xb0\x81\xdb\xf1\n\xdf\xc92\xbc\xf1\tQ\xa1\x7f\xb1\x86\xf6\xe4\x9eٸ\xc9\xd4}CG_X\xea\xb3*\xa9C\xee\v{zwl\x98(\x91C\xfb+\x8bo\xa5[\xdfҹ\x99\xaac\xa3\xa2\xda\"\xf7qc\x02\xf4x]\xec\x92r\xe60!\x15#\tY\x97%[\x97\xb8\x00g\xea\xf1\xf4\x19\xf7\xaa\xd0Z\x96_\xf2\xaf\x1f\x83Tx\xf37K\x80\xadU\xed\xdaG\x7f\xe3h\r\x157\xb6\xb1\x82\xeb\x1a\x0f\x05\xb3\x97\xa0,If\xca\xe2Z\x97?orp&\x94=\xe0~btԵ\xeeN\xdeF\x13\x9a\x98\xfb\xde[\xc7U\x044\x1b]\xe2\xa0\x11\x83B\x95Ѻ\x95\xa3\xa4TWk4D\x84o\x95GFb\xe0\x98\xea\xa2\xf8\xd7בɣ\x86\x18\v\x83\xaa\xe6=\x991雊d\xbfN\x01\x17V\x97\xec0\xa17\x9e\xc4\x17Xd\xbe\xe4GG\x8b\x89^H\xee\xef\xe7\xae\xed\xfe\xb4?\x05L\x97\x7fS?,L\xddB\xf7W\x82\xc1|\xfb\x1b\xc8\xeb\xecp\xa6䳎\x19\xf7ܰ\xb7\xea\t_\x8ax^\xf5t\xbc놮q\xa0\xeao\xf3GƨI\xa2F\x83\x1e9\xef\xe8n:\xa7ݑz\xdd\xfe.\xb0\x80_\x7f\x9b\xfd?\x00\x00\xff\xffg\b\x17r\xc1\x1f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xdc]O\x93\xdb:r\xbfϧ@9\a'U#y\x9d\\Rs\x9b\xf8\xd9Ye\xf7\xd9Sc\x97\xdf\x19\"[\x12vH\x80\x0f\x005VR\xf9\xee\xa9n\x80\xe0\x1f\x81$\xa8\x19\xbd\xf5\x06\xb7\xa1\x80\x06\xd0\xddht7~\xc0\xacV\xab\x1b^\x89\uf80dP\xf2\x8e\xf1J\xc0\x0f\v\x12\xff2\xeb\xa7\x7f7k\xa1\xde\x1d\xdf\xdf<\t\x99߱\x0f\xb5\xb1\xaa|\x04\xa3j\x9d\xc1/\xb0\x13RX\xa1\xe4M\t\x96\xe7\xdc\xf2\xbb\x1bƸ\x94\xcar\xfcl\xf0O\xc62%\xadVE\x01z\xb5\a\xb9~\xaa\xb7\xb0\xadE\x91\x83&\xe2M\xd7\xc7?\xad\xdf\xff\xeb\xfaO7\x8cI^\xc2\x1d\xd3`\xac\xd2`\xd6G(@\xab\xb5P7\xa6\x82\fi\ued6a\xab;\xd6\xfe\xe0\xda\xf8\xfe\xdcX\x1f]s\xfaR\bc\xff\xd2\xfd\xfaWa,\xfdR\x15\xb5\xe6E\xdb\x19}4B\xee\xeb\x82\xeb\xf0\xf9\x861\x93\xa9\n\xee\xd8g\xec\xa6\xe2\x19\xe47\x8c\xf9\xa1S\xb7+?\xea\xe3{G\";@\xc9\xddx\x18S\x15\xc8\xfb\x87\xcd\xf7\x7f\xfb\xda\xfb\xccX\x0e&Ӣ\xb2\xc4\x00?6&\f\xe3\xec;\xcd\r\a@\xbcf\xf6\xc0-\xd3Pi0 \xada\xf6\x00\x8cWU!2bu\xa0ȘڅV\x86\xed\xb4*[j[\x9e=\xd5\x15\xb3\x8aqf\xb9ރe\x7f\xa9\xb7\xa0%X0,+jcA\xaf\x03\xadJ\xab\n\xb4\x15\rc]\xe9\xa8K\xe7\xeb`.oq\xba\xae\x16\xcbQO\xc0\rٳ\fr\xcf!\x1c\xad=\b\xd3Nm8\x1d?%.\x99\xda\xfe\r2\xbbf_A#\x19f\x0e\xaa.rT\xaf#hdN\xa6\xf6R\xfcw\xa0mp\xa2\xd8i\xc1-xy\xb7EH\vZ\xf2\x82\x1dyQ\xc3-\xe32g%?1\r\xd8\v\xabe\x87\x1eU1k\xf6+\x89G\xee\xd4\x1d;X[\x99\xbbw\xef\xf6\xc26\xcb$SeYKaO\xefH\xe3Ŷ\xb6J\x9bw9\x1c\xa1xg\xc4~\xc5uv\x10\x162[kx\xc7+\xb1\xa2\xa1KZ*\xeb2\xff\xa7 \xb6\xb7\xbd\xb1\xda\x13j\x9e\xb1Z\xc8}\xe7\aR\xf3\t\t\xa0\xc2;]rM\xdd,ZF\xe3'\xe4\xce\xe3ǯߺz&̐\xfb\xc4\xf7\x8e\xf2\xb5\"@\x86\t\xb9\x03\xed\x84Hچ4A\xe6\x95\x12\xd2\xd2\x1fY!@\x0e\xd9o\xeam),\xca\xfd\xf7\x1a\f*\xb4Z\xb3\x0fd;\xd8\x16X]\xe5\xdcB\xbef\x1b\xc9>\xf0\x12\x8a\x0f\xdc\xc0\xd5\x05\x80\x9c6+dl\x9a\b\xbafoX\xd9q\xad\xf3Cc\xbcF\xe4\xe5W\xff\xd7\n\xb2ފ\xc1fb\xe7\x979\xdb)\xdd3\x0e\xd8d\xdd#\x1a_\xb4X\xdc\xeaG\v6\xfce0\x94\xff\b\x15Q\x7fp\x10\xb5\x14\xbf\xd7@&έX83)g$Y3>R\x8b\xf5\xd9\xef#<\xc5\x02?\xb2\xa2\xce!\x0f\xd6\xf6l.\x83\x11\x7f<k@\xbb\x0e\x17\x12\xf5\x1f\xcd?\x0e[\xb6\xbf\xa29\x8d\x8c\x98k`\xa8\x81B:zLH\x9al\x94\xd3X\x84\x8522\xb8\xc9\xd91&\xeb\xa2\xe0\xdb\x02\xee\x98\xd55\x8cp\x86k\xcdO#\x8ci\xb6\xe0T\xbe\x84\xfa\xde \x14\"\x83\xeeF\xe1X\xe36\x19\xae\xcfG\xc4~r\xae\b\x83欙\xe5\x83*Dv\x9aeM\xacQ\xb3\xdc\xfc\xe2k4x\v\a~\x14JG\xa6\x84+\x12\xabv6\xd2֘*\xb4e\x9eH~ل\xa3\xcc:(\xf54'\xfb?c\x9d\xd6j\xb3\x8c\x9c\xb70\x15/m\xbf\x89n\x81\xc1\x0f\xc8j\x1b\x19&cyM\x1b\x88ҬRƎ\xcb}\xdc\xf60g\x0eƔ\x96M)\xcd\xd9̼\xa9l$\x87\x13\xed\x99M%\x01\xc7Z\xa2\xe4ںZծ\xeep\x7f\xebp<\xce\x11\xb6\xe5\x06r\xa6\xbc\xd6\xd7\x05\x18\xdfWN\xe2o\xed\xca\xed(\xe90y\xe7i\x14|\v\x053P@f\x95>\xe7d\n?]I\xb1\x95#|\x8cX;\xfa\xb7\x13\x9b \xc9P͟\x0f\";8'\x00u\x93\xe8\xb0\\\x81!Á\x8e\xeail\x92lN\xf6\xbe\x93)\xd3і\x9955\xa4\x173'mI0\xb7m\x991\xbcg\x86\xc5\x7f\x8f\xee\x9cm\xf9\xff\xc9\xd8f'\xb9@i7gM_Wi)\xa8Bg\x7f\xb3cPV\xf6t˄m\xbe\xceQ\xe4E\xd1\xe9\xff\x1fX0\xcb5~3l\xf9\xaa\x1a?)\x959\x8a(\x95\xd0\xfd?\xa0Ph\xb3\xf8\xea\xf7\x8ad\x81\xfc\xb5\xdbꖉ]\x10H~\xcbv\xa2\xb0\xa0\a\x92y\xd1zy\rf\xa4\xecwXJn\xb3\xc3\xc7\x1f\xe8٘6ϔȗac\xe7\x1271B\x7fc\x9e\xa1\xcb(|\x15\x1aJ\x17\x16\x7f#n\xb6_(\x9e\xb8\xff\xfc\v\xe4S\xecai\x9aw6\x91\xfb\xc1`\xbb]{??u\x1a\xde\xf5\t1\x93Kx\xdc2Ξ\xe0\xe4<\x16.\x19\n\x87[\xf2w\xa3\xd1\xd39s(\xf3BJ\xf6\x04'\"\xe3S)\xb3\xadSU\xc1\x95'\x88\xb8\xfb\xb1\xd2c \x8e\xc9\a\xb8\x8e\x93\xf8\x81\x18A\x81w:\xf3\x18\xa5\xc5\x1a[4?9\x96nH\x9a\xd2\xf0\xfe\x82i\x06\xb1u҇$طƉ\bW\xc1AT\x89\x13\xa5\xec\xa1\x01Z-Mb\xec;/D\x1e:rz\xbf\x91\xe3\xdep\xbf|Vv#o]DfHK~Q`>+K_\xae\xc2N7\xf0\v\x98\xe9\x1a\xd2\xf2\x92\xcel#\x1f\xba\x19\xb6\x04\xe5ve\xe3\x12)A<°\x8d\xc4\xc0\xc5\xf3\x83\U000a5bbb\xe9\xfd\xa1_\xca\xdaP\nM*\xb9\xa2\xadr\x1d\xeb\xc91;\x91\xa4\xd2=\x89\x9c\x0f-t\xea:L$\xfb\rw\x12\xd7\xdee\x80\v\x9eA\xdeD\x9b\x94\xb7\xe4\x16\xf6\"c%\xe8\xfd\xd4\xc6\xd1-\x15\xda\xf7\xb4!$Z]W\x16jX\xda\xd6\xde\x14o\xba\xf3\xf9\xc1\xacp\xe5&\xd4j\x84=[u$]9^u~F\xb4Œ\xff1\xcb]\x9e\xe7t\x84ċ\x87\x05\x16\x7f\x81,\xce\xf7~70\xb7C\x96\xbc\xc2\xf5\xfb?\xb8͑B\xff/\xab\xb8\xd0\tk\xf8\x9e\x8e\x89\n\xe8\xb5\xf5\x89\xb1n7\u06030\f\xe5{\xe4\xc5y\"<29\x85\xb6\x05\n\xb7\x91\xabݙ\xc7r˞\x0fʸ=u'\xa0\x88\xa5l\xfaE\x18\xf6\xe6\tNon\xcf\xec\xc0\x9b\x8d|\xe36\xf8\xc5\xe6&x\vJ\x16'\xf6\x86ھy\x89\x13\x94\xa8\x89\x89\xd5~\xac\x9eBJnU\xf2j\xe5\xb5תRd\xa3\xedd4=ޖ\x9e:uS\xe4mnܻ\xc7S\xb3M\xd2\xdfJ\x19\xfb\xe7x\xa2od<\x0fM\x8b\xbeO\x1bɗ\xcd\xfa\xfa>\xf7\x15\x8c1z\x80;\v\xda'\xff\x9c\x81n\"\x87\x17\xc6Tsɽ\x90\xd8\xe3!!\x8b\f\x9e\xd1&wT\x922\xc4%\xde&\xf2e\xa1\x9f\xfe\xf1G'7\x89+\x1b\xff\xeeN䵽\xe1L\x95%\x1f\x1e\x0e&\r\xf5\x83k\xd9\xe8\xb4'䤯\xf75\xad\xe7t7\xb1\xd1!:\x16|\x16\xf6 $\xe3\x8d\xd9\x00\xed\x15\x8a\xb3J\xcd[0W\x0eܰ-\x80\f9\xf5\x9fa\x9f/\x85\xdcP\a\xec\xfd\xab\xfb\x05\xace\xd7E\xe2lX\x1d\x04\x1a>\xd0N\x95\xeaR\xa9\x9c=\x1f@CO+\xce\x13\xe5\xe8i&\x92\x94\xcav\xf3\x11H\xb7R\xf9[\xc3vB\x1b\xdb\x1dh\xaa\xc2\xd5&U\x1d\x16J\x18g\xf7M\x94\xa0j{\x81\f>\xb6\xad{\xe7\xba%\xff!ʺd\xbcTu\x82S\xe0\n\xee/\xa2\f\x87\xaf^\x02\xcf\\\xd8p\x0eE\x99\x19\xabPJU\x016U\xc4[ء9ʔ4\"\a݀\x03\x9cd\x85\u0085\xbb㢨c\xc7>\xb1\xb24\xbc\x95\x1f\xb5\xbe(\xba\xfd\xe2Zv\xb2\x8d\a\xf5\xdcgP2\v\x0e\xfc\bL옰\fd\x86r\x01\xedL6u\xe1\x99A\xacIV\xcb4\x03\x8f\x05d]\xa61`E+[\xc8\xc9dZ\xb7\xfa'.\x8ak\x88\r5\xef\x93ҏ\xc0\xf3K\x120\xbfu\x9a3\x90\xa6\xd6tp\xef\xcc˳(\xd2ƌ\x92c\x05\xafev\x00\xb2S\xb2g>\x98#/\xa4\xb1\xc0Su\x01\xbd\xa6ZJ!\xf7i\xb2KNq\xb6űz\xabT\x01|\bx\x8a\x15\xe4\xf5\xe5f跶\xf5\x1fb\x86\x82\x04\xd2݅-xQy[ĭ\x85\xb2r\xebM1]\xcb\xee\xees\x05+\xb4$\x06\xf7\xa3x\xcd\xe0ZH\x91 \xd8\xc1\x99\x8b\xb0]\xcf\x12I\\ճ\xc4\x0e\x82SqI\xfal\xd3#\x80\xab\xb3\tRh\xecAk\x16x\x99[`<\xcf!w\x89ItU|\xcc\xe2\xe0e#P\x85\xe8얻\x89I\x92mJ/\"\xa5T\xac>ª\x96OR=\xcb\x15E\xf2f\xb1\x01IO\r\xbej\xf7\xf6bK\xf4GZ\xa1\xbe\xbe\xa6\xebT\xe3<]\xc1\xca$\xeb͢lȔ\x16\xcc\xd95\a]\x1e\xf9qv\x14S\xfdO4\xf6\a\xcd\x1f\x1c\xe68\x15϶\x89\xb7\xea8\x7f\xcf\a\xb0\a\xd0\r\x98yE\xb8혝nϣ\xdb8&\x00\xdcP\x7f\x1aW\xd8\x01/\a\x90\xb7x\xa0\x83^\xc0-*6\xaf\v\xeb\xe0Ǻ\x8e(Q\x12\xf0+\xee\x19\xa4 '\xe6\xf0\x12}\f`\xc0+4 @\xd5t\x12\x99\xa1\x93\xa5C\xfav\x0f\xe3\xfb\xc0\aJ\xf95#\xfd\xbb\xc3\x03\x130\r3H\x86i\xd0\xe4\x14\xbf\xceզ˱V\a}=\x8f\xa6\xfd\xb9\xd8g\xa1\xfcR\xf9u0\xea\x80\xf69\x18i2\x80\x83\x90\xe5Ɛ\x9d\x80\x05\\Č\v\xaeB\x9f\x0eD\x8a\xf7\x19\xadD\xd5\x106\x94j\xf6\xabͣۅa\xef\xd9A\xd5\x11H\xdd\x04wf\x00\x16\xe3\xb0\n\x7f\x88\x00\x96\x1f߯\xfb\xbfX\xe5A\x16\x94\xf9\x8a̎\x02\x956\x9b*d.\x8e\"\xafy\xd1[d\x1d\xb5h\xb5\x87)ͤ(b竨VM\xfb\x9e\x1a\xb1/\x95;gYl\x8e\xa6]\xc44,\xc6\xc5\b\x8c>\xc2bd\x93Zz\xe4\x90\x0e5M\xc7XL\x83\"\x96 +\x86\xb8\x89Q\xa2\xf3x\x8a\x14\xef~\x06;q\x01b\"\x11-\xf7\xe2\x03\x92\x14L\xc4EH\x88Y@Y\"\xfe\xa1\x8fl\x98&\xb9\x00\xf5\x90Ĝy\x84\xc3b\\\x83\xc7\x11L\xce#\x19\xcd\x10\xc1)L\x12\x1e\xc50L\xa1\x13\xa6Y\x1eA.\xa4c\x12&I\x13^a\x1e\x89\xf0zx\xc3\u05c8\x02\xc6M\xcd,\x9a\xe0EQB\x02^`\tJ`\x96c\x17\"\x02\u0089\xffH\xbfKq\x00\xfds\xfe\x11\xa2)\xa7\xff#\xa7\xfb#\x14'\xcf\xfcS\xcf\xf4Gh\xcfl\xbb\x93Z2\xf9㒳\xfc\x10\x86\xfcʫJ\xc8\xfd\xb9\x9e\xa4jӤ&\x9d\x01\x01\xba}\xf6T\xa9\x1b-\xf4\xe2\xacX\x97\xeeVn$&k\xd2zBZ\xb5f\xf7\xf2tF\x97\xae\x04Dc\x90\xfe\xb5-\x1cֳ(\x8a\xee\xdd$\"\xdb%\xe5o\xf9\x99xf\x00+\x8ey\xd8Q\x11*\xdd\xf3\x8e\xe7B\xb0/\x83\xea\xddDᴷ\x1ds\xb4\x85=\\\xe8m\x97uaE\x15]\xf2\x95VGAi\xc7\x03\x9c\x02?\xff\xa6\xe8V\xd0\xf6D\x94\xbe<\x86ո\x1e\x04\x0e<\xb6\x86\x9e\xa1(\x187\xe7\xd3\xcf\xdc\xc5\xd8L\xad\xe8\xae\x1bJ\xb2\xd1\a\x7f\x81\xf6\x96Vl,b\x97͕\xcd\x12\xc9\xd0\xe5Z\x13Ɉ\x8c\xeeE\xd3\xfe\xb0s\xdd\xe9\xdb\xef5\xe8\x13SG:\xd3\xf7\x0e\xd2\f\xec\xde\xd9\x15\x83\xf1[c鼹tױ\aqBk_ؽt;v\x94\xec`\x8cD\aM\\\x1b\x1b\xa15ǰg\xa4j\x94\xaaT\xa1u\\\x1f&7\xa6T\xcc\xfau#\xa5\xe5\xb1Ҭ\x97r\x95x\xe9\xf2\x88i\x82d*\x06=\xedLd\x16s~\xad\xc8i.vJv\x1a\xd30\xe5\xd7\xc0\x92/\xc0\x90/\x88\xa1\x96EQ\xc9lJ\xc1\x8a_%\x96\xbab4u\x8dx겈j\x86\xe4\x00\x03\x9e\x82\xeeN:\xc6K>\xb3I9e\x9b?9\x9eFm'\xa0\xb5\x13N\x83\xe6F\x9a\x80\xca^\x86\xc6N\xe0\xe1\x95b\xad+E[\u05c8\xb7\xae\x1bq\xcd\xc6\\\xb3\x9a3\xf3\xf32\x14\xf5Ň\f\xcdq\xf4g\x95Ã\xd2v.@x\x18֏\x1c\x01v\x82&U\xe4L6Uc'\r\xe8\xfb{\xbf\xff\xb2I\xc5O\xeb\x1a\xf7\xf7W\x95\xe3\xd8\xe6\xce\x16\x1e\a\xd5Ϯ\xd0\xee@\x83t\x0fK\xfc\xd7\xd7/\x9f\x03\xfd\x98?\xea\x9d\xde\xc1\x9b\x06\xce\xc1\xc8=s\xfc\xe9\x93\a\xdc8n\xd1\x1e\xfeʇ\x04\xbc\x12\xffIov\xcd'd\xee\x1f6T\xb5\xf1\x96譯p\xa0\x1f\xce\u07b6\x80\xbbG\xe0Ȩ\xf6ov=\x8a\x11\xd8i\xf8\x93ыI\xcd\xee%\xc60Y\x0e\x84\x84\xab\xeea\xe3F\xb7f\x9f\xd0u\x93'\xa6\x9c\xe2\x1d\x84\xceW\x15\xd7\xf6D\xdaan\xc3\x18Ɠ2\xcd\x1e2\x95:\x195\xb5\xe7oAEy\xdb<\tE\ap\xa7\xaa\x7f\x9a9\xe4\xe8%\xe3\x18\xbf=1{o\xe2\x15\xc71\xbe\x1d\xaf\x88S\x91\xcfQ\x04ī\xa5\xa4\xbc\x19z\xf8>g\xd6\x1eC\xc5i{\x86\x91l\x93։\xf0\aۓI3\x92W\xe6\x10\xc9\n\xbd̦\xd1CU\x96\xdb:q>\xaenoJ\";t\f\xd034&Jw\x9e\xed\x1b\x8c\tת#D\xdb0\xb9\xd0R\x14\xb7\x9d\xc0\xfc\x8f9\xf2L|\x16\xe4\xe2\aA\x1c{FL\x05e\x9aЌ5\xba\xd0\xf2\xe5\x82\xc3\xceY\x17.\x01\xd8:\xedw&\xbe(q\xf1[\x12\xf3̊0j\xec\x19\x89\x94\xa7\"\xfe\xae\xfc\x9c0I&;@^\x17\x90\xf0\xc0\xdb\xd7N\xd5\xf9'\xde\x1a±5\xa9\xfa\x8f\xbc!_;\xdb+:\xbc\xfd\xc7\xe4<\xd3=\xe5\x11\x88w\x97\xa4\xf3\xecݫS\x19\xfa\xf1\xa6\xce20fW\x17\rZ&\xd3\xc0-\xe4M\xf5(2\xbf\x99\xc3\x02XH|\x17Yu\x9eѻI\x90\x8c\x89\x98\xc9\t\x13\x99\xf1\xca\x12\n\x9e\xbc\x8cZk\x9a\xb2\xfbM\xedΞ\xfe\xeb\x91\x1d7Z\x1e\xce\xe8\xc18\xc6\xf22\xe2\x89\r/\x82\r[\xd0\x03\x9b:\xef\xc0w\xba/\xa4\x05TN,\xad\xcdM@T\xe6\xeb\x0emG\x86\x9c\x1f$\r9\x83#H\xa6$\xdd5\x81|\n\xbe\xfb\x8d\xd2f\xfa\b\xfa\xad\tt\bP\x84\xbe\xe2W˵\rC?\u05c8\x9d\xd2%\xb7w,\xe7\x16V\xd8\xfa\xb2\x1d2\xfez\xa1\xd6\xf3'\x1ctiŇ\xc1tӄ\xc4[\x14\xfe\xaaI\t\xc6\xf0}\xe3\xbe?\x83\x06\xb6\a\x89,\x9ez\xa0\xad\xbd\xad\xe3Wp\xc0\x9d!\xb7xfk\xee;p;e8\xfb\x89\x9d\x1b\xb8W?)\"؏\xae\x1b!-\xec\xcfN]\xfcM\xa1G\xe0f\xf8J\xec\x19#>u\xeb\xfa\x9c\x99\xe3\x81{\x92\x84;\x90\x18=*jE\x88RF\xac\x11\xf6\xbc\b\xfaU\x1d\xb8\x993\x97\x0fX'\\\xa1\xeb,\xca`)\x1fG\xc6\x14\xbfҳb\x9f\xe19\xf2\xf5\x13)=\xe5A\xe3Ki\xc56\xf2A\xab\xbd\x06s\xae\xd2+\xba\xe3!\xe4\xfe\x93\xd2\x0fE\xbd\x172@\xf0\x96U~\xe0\xda\n^\x14'7\x9eH\xdb\x0f\xcdb\x8e\xfc6\xdfz\xe4\x87)!\xf99\xcf&\x05\\\xb56\xa7\"\xa4[\xe8t\x81m\xabj\xdb]\x15oM\xbb`b\x01\xb4\xa7\xb6f\x9f\x95\x85&W/\xfaD\x05\x06\xcfƮ`\xb7Sں\x1c\xcej\xc5\xc4\xce\x1b\xeaX\xae\x81\x8b\x82|\r\xf7\xc6-: \x01]\x12v>\x1fOjZ\x15䤔\xfc\xe4\xc2R\x9ee5ځw\xc6\xf2؆\xf6\"ז\x9c\x1b\xaf\xcd)\x11\xe5\xa6[?\x84tu\xb9\x05M\x97:\xf0g\xc7:\xba[\xe7LP\xf4\x9c\x92\xd1=\xae\xce\xd5^fp9\xc7\xd3jSƇ~W\x96\x17\x9bqG\xad\x7f!!T\x0e\xb11~9\x9fF\xef5\xcfq,\xa20MS\x94Yv\xe0r\x8f\xea\xa3U\xbd?4*8f\xa9\xc7Ҩ5\xe5|*Z\xa9\xa69\U000f2d56\x9d\x94\xad?\x05\xcb\xdb\xe1N\x11\x9dfᄟ\xa9[Dnk3\xee\xdd]\xad\x98\xceļ\x9d\x91\xc6#\xfc\x8f%\x94B\x13nN2\x9b\x86\t\xbb䑘\xb8\r4Ō\xe8|\x83\x05\xbcd\xbe\xa1q\xfa|[\xaf\xb78\xb5\xbeԒɏ\xfbٯ\xc0\x0eg\xd2/\xe1\x85k9\xb6\xf0h~\x91\x91/\x12\xb7\xcf6\x80D\a\x93\xc0 \xd1\xfb\x96\xe4t,\xe3\x85\xe9y\x99sAW\xaf\xf2˼i\xea\x18}\xe9\x9f\xd7\v>\x067\xe6c\x8a?\xfc}P}p\xe7\x02=㖢\xf7a#\xcc\xf9g\xb1k\xfe-¶\x80\x7f9\xab\xf1\aߝx\xe6Z\n\xb9\x9f\x9b\xfco\xbeZ$\x1c\xf0\x14\"\x01Ad\x12!DX\x14\x104\x83\x1cy\xf9;\x04\t/\b\t\xa2\xdb\xc9\xd9GR\xe4\xbc\xc3dߓ\xff\xf2\x7f\x01\x00\x00\xff\xffT\xf5\x7f\x80\xacd\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=Mw$)rw\xfd\n\x9e|\x18\xdbOU=m_\xfctk\xab{\xecz;ӭ\xd7\xd2\xf6\xc9\x17*3J\xc5(\x13r\x81,uy\xdf\xfew\xbf\b \xbf*\xc9$K\x92gfW\\\xba\x95\x05A\x10\x11\xc4\a\x04\xb0Z\xad.x%\xbe\x816B\xc9k\xc6+\x01\xdf-H\xfcˬ\x1f\xffì\x85zwx\x7f\xf1(d~\xcdnjcU\xf9\x15\x8c\xaau\x06\x1fa'\xa4\xb0Bɋ\x12,Ϲ\xe5\xd7\x17\x8cq)\x95\xe5\xf8\xd9\xe0\x9f\x8ceJZ\xad\x8a\x02\xf4\xea\x01\xe4\xfa\xb1\xde¶\x16E\x0e\x9a\x80\x87\xae\x0f?\xae\xdf\xff\xdb\xfa\xc7\v\xc6$/ᚙl\x0fy]\x80Y\x1f\xa0\x00\xad\xd6B]\x98\n2\x04\xfa\xa0U]]\xb3\xf6\a\xd7\xc8w落\xf3\xed\xe9S!\x8c\xfdS\xef\xf3\xcf\xc2X\xfa\xa9*j͋N\x7f\xf4\xd5\b\xf9P\x17\\\xb7\xdf/\x183\x99\xaa\xe0\x9a}Ʈ*\x9eA~\xc1\x98ǟ\xba^1\x9e\xe7D\x11^\xdcj!-\xe8\x1bU\xd4e\xa0Ċ\xe5`2-*K#\xbe\xb3\xdcֆ\xa9\x1d\xb3{\xe8\xf6\x83\xe5W\xa3\xe4-\xb7\xfbk\xb66To]\xed\xb9\t\xbf:\x129\x00\xfe\x93=\"n\xc6j!\x1f\xc6z\xfb\xc0n\xb4\x92\f\xbeW\x1a\f\xa2\xccrb\xa0|`O{\x90\xcc*\xa6kI\xa8\xfc'\xcf\x1e\xebj\x04\x91\n\xb2\xf5\x00O\x8fI\xff\xe3\x1c.\xf7{`\x057\x96YQ\x02\xe3\xbeC\xf6\xc4\r\xe1\xb0S\x9aٽ0\xf34A =l\x1d:?\x0f?;\x84rn\xc1\xa3\xd3\x01\x15\x84w\x9di \xb9\xbd\x17%\x18\xcb\xcb>\xcc\x0f\x0f\x90\x00\x8cHT\xf1ڐp\xb4\xado\xbb\x9f\x1c\x80\xadR\x05py\xd1V:\xbcw\xb2\x97\xed\xa1\xe4\u05fe\xb2\xaa@~\xb8\xdd|\xfb\xf7\xbb\xdeg6\x90%O)&\f\xe3\xec\x1bM\f\xa6\xfdLev\xcf-Ӏ\x9c\ai\xb1F\xa5a\x15\xa8\x9b7 \x19S\x9aU\xa0\x85\xcaE\x16\xb8B\x8d\xcd^\xd5Eζ\x80\fZ7\r*\xad*\xd0V\x84\xa9\xe7JG\xa3t\xbe\x0e0\xfe\x01\a\xe5j9I\x04C\xc2\xe7'\x14\xe4\x9e\x0en~\b\xd3\xe2OL\xea\x01fX\x89K\xa6\xb6\xbfBf\xd7\xec\x0e4\x82\tXgJ\x1e@#\x052\xf5 \xc5\xff6\xb0\rJ\xbd%a\xb4\xe0\xf5A[h\x02K^\xb0\x03/j\xb8b\\\xe6\xac\xe4G\xa6\x01{a\xb5\xec\xc0\xa3*f\xcd~Q\x1a\x98\x90;u\xcd\xf6\xd6V\xe6\xfaݻ\aa\x83&\xcdTY\xd6R\xd8\xe3;R\x8ab[[\xa5ͻ\x1c\x0eP\xbc3\xe2a\xc5u\xb6\x17\x162[kx\xc7+\xb1\"\xd4%i\xd3u\x99\xffS\xe0\xa8\xf9\xa1\x87\xeb\xc9|s\x85\x14\xe1\x04\aP#:\x81qM\xdd(ZB\xe3'\xa4\xce\xd7Ow\xf7]a\x12fH}\xa2{G\xc2Z\x16 \xc1\x84܁\x9f\xd1;\xadJ\x82\t2\xaf\x94\x90\x96\xfe\xc8\n\x01rH~SoKa\x91\xef\x7f\xa9\xc1X\xe4՚ݐyA9\xac+\x9c\x81\xf9\x9am$\xbb\xe1%\x147\xdc\xc0\xab3\x00)mVH\xd84\x16t-㰲\xa3Z\xe7\x87`\xde\"\xfc\ns\xfc\xae\x82\xac7e\xb0\x9d؉\x8c&\x06i\xcfF\x05\f4\xa8+㳖~!55\xfc:\xc0\xc3\xe9\xb2\xd0+\x18\xb4\x1fvO\x1cn\xcd\x18ʕ\x83\x86:E\xaa!wǴ`\x87\x12\x1e\xca\f&}\xad\x97j\xdfN`2\xaf\xea\xd6\x11\x1cO\xb8J?AY\xa1ژA\xf1\xdeWC\x14\x91>y\xe35\x05\xc3\x1fԬ\xf2ڕ\x9d(7\xean\x0fȷ\x83Ƚ\xf6:\xe1*\x9b\xe4,\x96̈;\xc9+\xb3W\x16m\x9c\xaa\xedX\xad\xc1\x00n\xee6\x83F\x1d\xce#VdÉ\xd1V\xb1'.N9\xed\n\xca\xe5\xcd݆}C\x97\b\x02L\xe6,9\xb3\xb5\x96\xa4\x8e\xbf\x02Ϗ\xf7\xea\xcf\x06X^\x93V\nv\xf9*\x02x\v;\x9c\xf4\x1a\x10\x066\x00\xadq\x0e\x18BM\xd5vM\x0eG\x0e;^\x17\xd6+9a\xd8\xfb\x1fY)dm\xe1\x94\xefl\x9a\xf7D$ny\xa9\x0e\xa0\x13h\xf8\x91[\xfe\v\xd6\x1d\x90\x0ea0\x02\xe2\xd9Od\xdc\x1e#\x03\xc5&['\xa9l\xb3\xeb@\x15\x86]^\xe2<\xbbt.\xf1啫[\x8b®\x84\xa4~\"0]\xefO\xa2(B\xff\xe7Q\xc3\x11\xd7\xf1\xd6ܫ\x9f\x8c\x13\xeb\x14\xe2D\x9a\x8e(\x98J\xe5\xec@\xf5b2&\n`\xe6h,\x94\x9eR\x1dυ\x88Kڱ(<\x18öǀ\xfb\xf8\xb8e]\x14|[\xc05\xb3\xba\x1e\xefvJ\x91\x8d\xd1\xe6+\x18+\xb2\x04\xca\\\x0eI\xe3Z\x8e\x10F\xd3\x0f\x11\xa2\f(\x80.\x0f\x7fD\xb7\xdbS\b}\xa7\xa2\xe8\x10w\x9e*\x8c\xfd\x8fd\x1f\xd1\xdcgh\x84\xaf\xbdq\x17P\x90C!\x15+\x94|\x00\xedzD\xc7)H\x98\x06\x94\xb8<\x02\x15-\xad\x86\x02]\x06\xb6\xab\xd1\b\xaf\x19j\x82\xa8\x8c\bi,\xf0|}\xf9Z̃\xefYQ\xe7\x90\xdf\x14\xb5\xb1\xa0\xef0\x04\xccC\b<\xaae\aL\xfc4\t\xc0\xbb_\x85\xc8\x00\xf9\x90\xb9J+\x8a4cDj=\xb1c\x05.\xf0E\xa6zL[\x17\xab\xa3*\fX\xacr\xf9\xaf\x971%\x8a\x12\xd0\xef\xbdߏa\\CC\x8d\x9eF\x8d@l\xf4,\x94\x95=\x8eˑ\xb0PF\x888\xabr\x16\xb0\x97k\xcdǔj\x18N\x13џ\xcf\xde\x18\x88\x01\x83e\xa8\xf6\x1b\xb1x\xd8\xff?\"\x93\xcfb\xab\xa1u,.$\xb2\xb3\x10\xc6\xf6\xb89\f\x88\x1a\xcc0vF\x9ab\xd0\"\xa4\x83\x89ʭü\xdf3\xcdΙ\t1\xd1o$͋\xf3\x9eǄ\xea\x0fH\xb0\xbdR\x8f)D\xfao\xac\xd7\x06\xca,\xa3%U\xb6\x85=?\b\xa5\xcdp\xb5\x05\xbeCVۨ\x9e\xe0\x96\xe5b\xb7\x03\x8d\xb0h\x81\xb0YO\x9c\"\xd6t\x98\xc0:\n(Za0\xae\x96\xe9\xc8<\xa2Fl(\x14\x8eE\xa12B\x1c\xbdx\xb2\xee\xb98\x88\xbc\xe6\x05\x19z.37>\xde\xe0\x17sOf\x04\xe2\x04\x7f\xe7N\x84Q \x97zQ\xb6\x92\x80\xeeu\xa9t\xcc\xf3t\xe5\x14L\x9c\f[N\xc1q,$m\x8b\xae\v0\x1e\x15\xe7\xc0\xb6z\xe7\xaa\xe5\x94[\xa0*\xf8\x16\nf\xa0\x80\xcc*\x1d'O\x8a\x10\xb8\x92\xaa?#\x94\x1dѤ\xfd hV\x89\xb6\x05\x03̽\xc8\xf6\xce\xddD)#X,W`Hc\xf0\xaa*\"V\xa8-\xb3\x92\xe1;\x9bS\x1amIP\x1fC\xb81EҖD\x1dܖ\x19mܧz#6oD\xef\xa1)\x9f%웓\xe6//\xecHn\x01\x86\x9c>\U000bab98\xb0\xe1k\nԞ\x1fh\xfe\xce\x18w\xdel\xd9\f[\xbf\xf8ly\x11\xae5h\xfc\x9d0\x8d\x8c՝\xb7U\x8b\x18\xf6s\xb7\xe5\x15\x13\xbb\x86a\xf9\x15ۉ\xc2\x02\xf9Rs\x88v\x1c\x9dYν$\x81Rm/\x96\x92\xdbl\xff\xa9Y\xd6Nh1\xa0\xd5\x10\x80\xf3\xcbC\fC<H\x00\xc9\x1a\xa7\x82vd\x84\x86\xd2\xed\xf4\xdc\xd3\xfch\xbf\x90\a\xf8\xe1\xf3\xc7\xd8Jb\xbf$J\xeaɠ>\f<\x9d.\n4\xc0$\x90\x9dA\x91\x9b\xd6\xc4xn?\xef\x8aq\xf6\bG\xe7Y\x8d.\x0f\x8d\x15d-o@j\xa0\xcdER#\x8fp$P~\xb70\t\xde\x12Qq\xe5\x11\x8e\xa9U\aDE\xfc\xfc>\x85\xa3.~\xa0Q\xa4L\xa5\xb64D\xf5s\x87Y\x956X\xb6L)\x85\x12(~\xe6\xb0\x1b\x86\xf5\xb6\xc8\x1f\xe1\xf8\x83q\xec\xc3Y\xb3\x17\xd5\x02\n\xa0¦%\x19\xb5k\xf6\x86\xbf\xf1B\xe4Mg4O\x16@\xdc\xc8+\xf6YY\xfc\xe7\xd3wa\x10E\x99\xb3\x8f\n\xccge\xe9˫\x92\xd8\r\xe2L\x02\xbb\xc64-\xa53\vH\x97E\xfd\xb78\x90\tE\x11m\xd8&\f\xdbH\x8c\xcf\x1c}\x96\xb0i\x0f\x019\x87VY\x1b\xda]\x96J\xaeܒ\x96\xefm\x01\xd0.^\x9eUJ\xf78u\xb5\x10\xe2(\x8a\x1e\xbd{\xb4V\ue5d3}\xf9\xa9\xa2\xa1*x\x06y\xd8e\xa3$\x00n\xe1Ad\xac\x04\xfd\x00\xacB\xbb\x91.T\v4\xb9+gHa\xbak\x11\x8a7\v#{\xdace\x85\xb3>\xb1f`sR\xf5Ȏ\xfft\xf5\xb4Q\x92y'\x7f(\x89\xfa\xdd\x14\xb5e\x96e!\xbfN}\x10\x87\xa4s?JN\x1bO\x7fE\xf3J\xe2\xfd\xb74kȅ6k\xf6\x81\x12\xf4\n\xe8\xb6\x0f\xab\x84\x9d\xae\x92@\"&\xc20\x94\x93\x03/\xd0}@\xe5-\x19\x14ΙP\xbb\x13\x0f*M\xc5<\xed\x95q6\xbf\xd9\x18\xbb|\x84\xa3ߜ\xedj\x89ˍ\x8c\xae\xda\xf7\v\xea\xfc\x13\xa5\xd5x-J\x16GvI\xbf]\x92c\xb6d\x8a\x9c\xe1\xbc-\x90\xea\x05U\xbf\xaf\x1e\xeb-h\t\x16̪\xe4\xd5\xca\xcf\x06\xab\xca\xe8\x1e\xa7+\x94F\xb7$\x8c\xc08=x<ظI6C\xf7\x7f\x8e\x02\xc9\xf3\xa1R&\x92i\x11A\xebV\x19\xeb\x16\x0f{\xae\xfa\xc8\xeabJ\xe4\xe8W\x1c\x19\xdfY\xd0\xccX\xa5Cb\x17\xaa\xec\xc1\xe2:J\x8d\x99\x97\x1b\xb7O\xe4W2\x1d`\fP/[\xed\xe2\xec\xc1\xa5۫\xc2\xff\xcf\xc3\xcc\xc8\xd1\"ؕV\x19\x98h6B[\x12\xad\xce\xccbo\xb3\xd0\xcb]\xe0\xb7KR\xeb)\xcbС,s㑴g\x04E\x9f\xbew֬Q\x85\xe1\xdf)\xa2|\x0e\x8e\x8cr\xbb˒\x0f\x93\f\x93ѽq\xad\xc3\x04\xf4\xc0\\\xb0\xa5\x1fjRH\xcb|n/\x92\xbf7\xa7\xa5\x14rC\x1d\xb1\xf7\xaf\xe6\xe8\xb0`\x06b\x19Ice\xc0\x0e߾eH\xf3!5\xf6e!UM\xd1>\x8f\x86\x1egOwA\xd29\xc5\xd0\x11\x97\xcav\x17z|O?\x18\xb6\x13\xda\xd8\x16\xe1\x05P\x85\x99\xc8z\x1a\x1d\xde\x19\xf1\xa9\xfc\xa4\xf5\xd9\xe1\xe9\x17\u05fa\xb3$\xb9WO>\xc1sIP\x1e\x88\xbf\xe7\a`bǄe 3UKZ,Cu\x81\xdd,\x80\xe8\x98\xe8\x8cI\xa2\xcd\xec4\x96u\x99N\x90\x15I\xa7\x90\xb3+k\xdd&?q\x91\xb6\xb2\xc5\xcec\xab\x9dJ\xa2\x1c+\xfd\xccP\x9fM\xd9\xcd\xe4-\xf9wQ\xd6%\xe3%\xb2eI̹sy\x98!\xed\xd7\xf1\xfa\x89\v\xebOS\xb8M\xd9e\xda4SeU\x80\x85\x90a\x99)iD\x0e\x8d\xfb\xe0\xf9?\x9a\xaf\x1a+\x9c\xed\xb8(j\xbd@G/\xe6\xccҘϫ\xa7\x97\x0f\xe4\xd2\x11Y\x111\x13\x17\xec\x178\xdc\xf3\xf6\xa3\xd2\xcb\\\xe6[\r/\xef\x9aVZ(ʁ\x9d\xf1Nga\x92\xf7\xda\xf7N\xbd\xf0ry\x8c\xb9\xa7\xb3P\t\x937\xf7\xb4)o\xee\xe9\x9b{\xfa\xe6\x9e\x0eʛ{\xfa枾\xb9\xa7\xe3\xe5\xcd=\xed\x947\xf74\xd9~\xa4`\xb8\xa2\x95ۉ\nIX%\xa6o̡=ӗ\xcfR\xf2gA\x96dWo\xc6[\x8e\x9c\x05Zt\x86\xc4t\x8c^\x93n\x8dS2L&w\xa64\xc1\v\x7f\x81\xb36\x01\x81\xb3\xcf\xdal&\x01\xbc\xe0Y\x1b\x8f\xe9p\xed\xfc\x05O\xda\x04Z,?\x84q\xe5ӘJ\xe0aK\xc8\xe5\xa0\xe4\xb1nc^l\x0f\x8f\xd1:\xbfq\xd6\xfdI\xb6\xe6\xf9\"\xf3\xffr~'\"6'\xa7S#P\x85A\xb9\xfacp\xe2,\xdaG\xa9\xed\xfe\x17\x1b]KX\xa7x\xdd5\x03\xddT\xcb~\xca\xeb\x1fG\xb0ϑ\xe4\xd4\xf371\xe7<\xae\xdb:\xc4Խ\xf3\x1e\xbfoZZ(\xbfTޒ\xa5\x9f{ߌ4{\xc6\xc9wn\x8e2\xdbk%Um\xfc\n\x0f\xf6\xf0!sW\x01\x84\x8e\xcc\x12e\xf0\x9e\xedU\x1d9\xe31Cׄ\xcc\xdbx\xbe\xad\xcf\xe0\x00\xcb\x0f\xef\xd7\xfd_\xac\xf2ٷ\x11\xac\x9f\x84ݻ\xfb\x18x\x9e\xa3\xa3\xde9\xe2\x13&\xaf\xbf\x93e(x\x11\x88J3)\n'\x95\x01B߀~\xa9ܒ\xdf\xd9~\xcb\xfc\xc2Sz\x8e\xee\xd2\xcc\xdc&\x97r\xdeK~F>\xee\xb2\xc3R\xb3\xb9\xb7)H\xb3\x94\x8c\xdb\xf1\\\xda\x19\xa8K\xf2lS\xd7\x14\x13rj\xd33i\xd3\xc8\xc3\xe8&\xa5\xd4\xfc\xd9\xe4(45W\xf6u2d\x13\xf3b;ٮ\xb3 \xcf̆M&XZ\xe6kr\xbek'\x8bu\x9eZ\x13Y\xae㹫\xb3 \xc7r[S2V\x93pM\xceSm\xb2O\xe7wF\x9e\x95\x9d\xfa\xf2\xe7`^r\xddb:\xd74)\xc34imc\x1e\xe7\xa4\x1cҥ\x99\xa3IT]\x9a%\xdad\x80Nt\x9c\x94\x1bz\x9a\xf795\x94ٌ\xd0x\xb6\xe7\x14ر<Є\x1c\xcf\t\x90\xdd\xec\xcf\xc5n\xc0\xac4\xcdVX\x9a\xbb9~?Z(\xf3ֹ\xf8-d\xf6\xb9dR\xba\xe74\xa7\x04w_\x06MPZ\x82\x9f8\xe6\x88\xc7Ce瞟\xe1\x88G@nv\xac\xac\v+\xaa\xa2sA\x99\xddñ\xb9\xf2\xe7WE\a\u05f7G\x82\xf6\xe5k#\xf21\x90\xfd\x90\x82\x1b\xf6\x04E\x81\xff\x9eP!s\xd7\x01fj\x05h\xa5\xe2\x1b\x81\xfe\xaa#\x7f\x97\xe0\x95[\x16\xa3S\xfdd\x01K\x844}\x01֤)\x99v\x8f\x9dWO\xdf\xfeR\x83>2\xbas+\xf8AQ1kO{\xfa\xc9l0&\f\xca\xc7k1w)e_\x19\xc5gC\xa3\x02\xd8\a\xe9\f\xf3\x10W\x82\x85Z\xa7\r\xa7\xa6\x94-FO1\x10R5\x10\"\xedS\xbc\xef%\xc7\x1f_#\xb8z\x89\xf0*\xc9\x11y\x8d\x10뵂\xac\xa5a֒䍤㋯\x11l-\t\xb7\x16\xf9\x8c\xe9\xc7\x13_\xebX\xe2+\x84]g\a^\x8bH\x97z\xecpq\xf8\x950\xbe\x99c\x86'>Z\x02\xc8\xe8\xf1\xc2\xf1\x10,\x01\xe2ɱ\xc2\xd9 ,e\x1e\fôg\x1f\x12LNdZ\xb4\x9b\x9e\x9a\x84\x94\xb6\xd1=\x7f\xf8/\xf1\xd0_\xe26x\n\xf6\x89\x87\xfb\x96\x1f\xeaK\xa4\xf3\x99\xe1\xd9d\u05c9\x87\xf7\x16\x05hg\x86h\x93\x10\xa7\x0e\xebM\ai\xd3\vp\xc3Czg\xb8\x13\t\x12\x96Pe\xf9A\xbbgo\xc6(\x9d\x83\x9e\xdd\xd7Z\"γ\x82<\x88\xa3\xfa\xfd\x0fvt\u008d\xa8X\xab\xbbg\x16\xe3\xa8j\xee\x1d\xc9؟\x84\xf4\xbb\xf5(\xb8\x1d\x9f\xa4\xb7\xf1\xd6:L\xf1}\x9d\xd6K\xf5\x17\xab\xbb\x1d;\x03\x15״\x8f\xbf=\xba\xa4 \xb3f\x9fx\xb6oz\x88\x80\xa4~\xf7ܰ\x9d\xd2%\xb7\xec\xb2\xd9\n}\xe7:\xc0\xbf/\u05cc\xfd\xa4\x9a\xf4\x91νb\x11\xa8F\x94UqĈ\x89]v\xc1<Op\xa2\x02\x1b\xf0\xb9U\x85\xc8\"\x1e\xe2\xe8\x95t\xae\xc1Ʌ>ti^\xd6ɂ\x88\xc5(\xd8\\\xf8\v\v\a\x97\n\xefTQ\xa8\xa73\x97.x%\xfe\x8b\xde1I[\x1b\xfbp\xbb\xa1\xeaA\xaa\xe8\r\x94&{\xae\x91\xb1-L+\xf4v\xe0\xe4zt\xa1\x8ed\xaf6\x7fN@\xa4W\x04\x82\x9f\xe1\xd5x\xa6P\x8b\xddn\x1c\x96k\x12,.\x8fL\xf9{\xe2\x85\xceW\x15\xd7\xd1M=\xe6\xe5\xc1\\\xf50\fv|n\x05kҬ\x9d\xbe\x8a\xd0-=\x9a\x87\a\x12h\xb3\xf7X\xf5\xb7щ\xd2\x1dz>\a\xa7\xe9\x83˳G\x96_\x01\xa7i\x97iET\x8c\xfc\x14M\xc7{\xf1\xd5C\xe3/\x91\xffE\x1d\xe0ct\x15\xb1\xffd\xc0\xa0\xc9H\x02]\x80:umz\x9b5\x17\xbf\xce\xfa\x052\xe2\x02*\xfe\xe2\xeb\x05\xe3\xf3-\xc6^i\xf0\xf7\x7f\a\xd8\x13\xb6\r\xa7\xec\xed7\n7\x1bu\xe9g\xb8\x0f&\xc3R\xe1\xe0\xaa\xd6\b\xc8\xd83\v/E-\xab4\x7f\x80\x9f\x95{\t#\x85Z\xfd\x16\xbd\xc7P\xbc3\x17\xb2\x89\xfd\\\x8b)s?\xb6!\xc0\xf6\x90\xc1\xc9u\xf4\x88홷\xed[[$\f\xee\xfe\xfeg7 +JX\x7f\xac]\x86\t\xea]\x03H\xe90P\xd7h\x1b\xd7N{\xf5D\xf7\xb9w\x9f\xab\xe8<\b\x04t\xa8\x81\xd2F\xcf\x1a͡\xf7 D ]\x8a\xb0\x7f\x1bo\xd9\xf1\xa1:L\x9cJ!S\xbb(,n\x8c\xca\x04\xb9]\xb4fNg\t^\xef&\xe2)\xf7yB\x7f\xd6\x06\xbe<I\xd0_\xc3D5\x1b\x19{\x81\xa1G\xc2?\x9f4\x8c\xbe\xbe`\x159{\x83\xeacN\xbe\xf4\x042\xee펰\xf8/L\xf3b\xcb)\xe9f\xe6\x7f|\xee\x8f[\xaa\xd5\xf8#)\xab\xe6ݖ\x8b\x04ʺ\xb7IR\x9e\xe4q\x8f\x98d\xbc\xb2\xb5\xf6.fVk\xba\x96\x19\x81\x80\xbb\xb5\xf8\xbcGy\xdaG\xcefx\xd9>{\xd6.\x87\xce>\xb26¿\xe6Y\x9d\xe8;3.\x90p\x8f\xa0\xad\x10\xfey\xec\x1c\x9d\at\x8d\xf5\xdc\x13DX\xa79\a\xe6\tM\r\xc3\xf5\xd7w1\xd4\xc7\x0f\xf6\xac\xd8g8u\xe4W\xec\x93\xc4A\x9c\xdawwz\arZD\x1d{\x90lr\x88\x87\xa6\x15\x1d\x9d\x1a\xd1\x16}57\xa8>H\xec\xa4\xe7L\x9a*\xee\x98\xd4\x18[\xffY\xec\xdc\nw\x86c\xfa\x97\x93\x1aQ\xc55\xa9\xb4b\nktJ\x9d|4\xa0\x0f\xf4~H\x10\x12oû_\xeam{\x1b9\xfb\xeb\xdf.\xfe/\x00\x00\xff\xff\x80\xea<õr\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V\xcfo\xeb6\f\xbe\xe7\xaf \xb0û\xcc\xce\xebv\x19r\x1b\xba\x1d\x8am\x0fE\xf3л\"\xd3\tWY\xf2H*]\xf6\xd7\x0f\x92\xec&\xb1\x9d\xb5\x1b0\xdd\"\xf1\xc7Ǐ\xe4\xe7TU\xb52==#\v\x05\xbf\x01\xd3\x13\xfe\xa9\xe8\xd3/\xa9_~\x90\x9a\xc2\xfax\xb7z!\xdfl\xe0>\x8a\x86\xee\t%D\xb6\xf8\x13\xb6\xe4I)\xf8U\x87j\x1a\xa3f\xb3\x020\xde\a5\xe9Z\xd2O\x00\x1b\xbcrp\x0e\xb9ڣ\xaf_\xe2\x0ew\x91\\\x83\x9c\x83\x8f\xa9\x8f\x9f\xeb\xbb\xef\xea\xcf+\x00o:܀ \xa775\x1a\x85\U0004f222R\x1f\xd1!\x87\x9a\xc2Jz\xb4)\xfe\x9eC\xec7p~(\xfeC\xee\x82{\x9bCms\xa8\xa7\x12*\xbf:\x12\xfd\xe5\x96ů4X\xf5.\xb2qˀ\xb2\x81\x1c\x02\xeb\x97s\xd2\nD\xb8\xbc\x90\xdfGgx\xd1y\x05 6\xf4\xb8\x81\xec\xdb\x1b\x8b\xcd\n` $Ǫ\x06.\x8ew%\x9c=`gJ\x12\x80У\xff\xf1\xf1\xe1\xf9\xfb\xed\xd55@\x83b\x99zʹ.T\x06$``@\x01\x1a\xc0X\x8b\"`#3z\x85\x82\x12ȷ\x81\xbb\xdcɷ\xd0\x00f\x17\xa2\x82\x1e\x10\x9e3\xe5Ce\xf5\x9bIϡGV\x1a\xd9\x18\xdc\xceCvq;\xc1\xfa)\x95S\xac\xa0IӅ\x923\r\x94`30\x00\xa1\x05=\x90\x00c\xcf(\xe8u\x8a2\xf3ӂ\xf1\x10v\xbf\xa3\xd5z\xe0AR\xb3\xa2k\xd2P\x1e\x91\x15\x18m\xd8{\xfa\xeb-\xb6$BRRgt\x9c\x93\xf3!\xaf\xc8\xde88\x1a\x17\xf1[0\xbe\x81Μ\x801e\x81\xe8/\xe2e\x13\xa9\xe1\xb7\xc0\x98\xc9\xdc\xc0A\xb5\x97\xcdz\xbd'\x1d\x97ˆ\xae\x8b\x9e\xf4\xb4\xce{B\xbb\xa8\x81e\xdd\xe0\x11\xddZh_\x19\xb6\aR\xb4\x1a\x19צ\xa7*C\xf7y\xc1\xea\xae\xf9\x86\x87u\x94OWX\xf5\x94&K\x94\xc9\xef/\x1e\xf2B\xfcC\a\xd2:\x94\xf9(\xae\xa5\x8a3\xd1\xe9*\xb1\xf3\xf4\xf3\xf6+\x8c\xa9s3\xa6\xecg\xdeώrnA\"\x8c|\x8b\\\x9a\xd8r\xe8rL\xf4M\x1fȗ鲎\xd0O闸\xebHe\x9c\xddԫ\x1a\xee\xb3\xe2\xc0\x0e!\xf6\x8dQljx\xf0po:t\xf7F\xf0\x7fo@bZ\xaaD\xec\xc7Zp)\x96S\xe3\xc2\xda\xc5\xc3(s7\xfa\xb5\xb0\xdd\xdb\x1em\xea`\"1ySK6\xaf\a\xb4\x81\xc1,\xb9\xd4\x1fB\x92=\xfe%\x96AI\n\x9a\x89\xbe\xa4\xfd|\x1fͲ\x9c䗃\x11\x9c^N0=&\x9bi~G-ړuXB\x145\xc1\xf7\xa1\xa4\x83>v\xf3\x9c\x15|\xc1ׅ\xdbG\x0eIY\xb3\xae_\x9f\x1b\xb3\x01\xe5{\xb3'?+wZY\xb1\xca߰K\xa9\xbe\x10\xe8!\x10p\xf4>\xed\xedL!3\x90\xa9\x92\xcflH\xb1[@\xb3\x88\xe7\xc1\xb7!\x7f\xf0MJl\xb4\xec\x13\x0e\xcd\x1e\xf2\x14\\\v\x01o\xf7\xba\x9c\xb9x}\x88\xd0r\xf2\x97\xf4\xbf9'\xb9!\xc6\xc5\xdcUF\xb5\xf8\x902.1\xbe\xbc_\x03\xca\xe8\x9c\xd99܀r\x9c{\x17_\xc3lNө\x19G\xed+u(j\xba\xfe\xbd\x01\x9a9\xa4=y=\xa0\xbf\xb5\r\xf0j\xa6*\x7f\x95\x19v\xa7[\xae\xf7o\xff\x01\xe7+UFw\x03I\xbb+\xa5\x05\xce>D\xcab\xf7\xcaH/\xfe\xf3\x98\x11\xb2\xbd\xb4\x1d5\xe3j5\xc6?\"\xf3\x1anBXl\xf6\xec2\x87o.\xca\x13\rl\xf6c\xc1\x7f\a\x00\x00\xff\xff\xb1J-\xe7\xa6\v\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VA\x93\xdb6\x0f\xbd\xfbW`&\x87\xbdDr\xf2}\x97\x8e/\x9d̦\x87L\x93f'N\xf7N\x8b\x90\x8d\x9a\"U\x10\xd4\xc6\xfd\xf5\x1d\x90\xd2\xdak\xcb\xc9n\xa7\xd5\xc5c\n\x04\x1f\xde\xc3\x03UU\xd5\xc2\xf4t\x8f\x1c)\xf8\x15\x98\x9e\xf0\x9b\xa0\xd7\x7f\xb1\xde\xff\x14k\n\xcb\xe1\xedbOޮ\xe06E\t\xdd\x17\x8c!q\x83\xef\xb1%OB\xc1/:\x14c\x8d\x98\xd5\x02\xc0x\x1f\xc4\xe8rԿ\x00M\xf0\xc2\xc19\xe4j\x8b\xbeާ\rn\x129\x8b\x9c\x93OG\x0fo\xea\xb7\xff\xab\xdf,\x00\xbc\xe9p\x05Cp\xa9\xc3\xe8M\x1fwA\\hJ\xcez@\x87\x1cj\n\x8b\xd8c\xa3Gl9\xa4~\x05\xc7\x17%\xc5x|\x81~\x9f\xb3\xad\xc7l\x1f\xc7l9\xc0Q\x94_\xbf\x13\xf4\x91\xa2\xe4\xc0\xde%6\xee*\xb2\x1c\x13w\x81\xe5\xb7\xe3\xe9\x15\fѕ7\xe4\xb7\xc9\x19\xbe\xb6\x7f\x01\x10\x9b\xd0\xe3\n\xf2\xf6\xde4h\x17\x00#?9]5Q\xf3\xb6dlvؙr\x0e@\xe8ѿ\xbb\xfbp\xff\xff\xf5\x93e\x00\x8b\xb1a\xea%\xb3<_\"P\x04\x03\x13\x12x\xd8!#\xdcg>!J`\x8c#\xe8Ǥ\x00\x13\xfeX?.\xf6\x1czd\xa1\xa9\xf8\xf2\x9c\xf4\xd7\xc9\xea\x19\xae\x1b\x85^\xa2\xc0jca\x04\xd9\xe1T>ڱZ\b-Ȏ\"0\xf6\x8c\x11\xbd\x1c\x85<>\xa1\x05\xe3!l\xfe\xc0FjX#k\x1a\xd5&9\xab\xfd8 \v06a\xeb\xe9\xaf\xc7\xdc\x11$\xe4C\x9d\x11\x1c5?>\xe4\x05\xd9\x1b\a\x83q\t_\x83\xf1\x16:s\x00F=\x05\x92?ɗCb\r\x9f\x02#\x90o\xc3\nv\"}\\-\x97[\x92\xc9WM\xe8\xba\xe4I\x0e\xcbl\x11\xda$\t\x1c\x97\x16\at\xcbH\xdb\xcap\xb3#\xc1F\x12\xe3\xd2\xf4Te\xe8\xbe\xf8\xa0\xb3\xafxtb\xbcy\x82U\x0e\xdaEQ\x98\xfc\xf6\xe4E6\xc2w\x14P\x0f\x94F([K\x15G\xa2uI\xd9\xf9\xf2\xcb\xfa+LGg1\xce\xd9ϼ\x1f7ƣ\x04J\x18\xf9\x16\xb9\x88\xd8r\xe8rN\xf4\xb6\x0f\xe4%\xffi\x1c\xa1?\xa7?\xa6MG\xa2\xba\xff\x990\x8ajU\xc3m\x1e6\xb0AH\xbd5\x82\xb6\x86\x0f\x1enM\x87\xee\xd6D\xfc\xcf\x05P\xa6c\xa5\xc4>O\x82\xd39y\x1e\\X;5\xd88ޮ\xe85\xef\xe4u\x8f\xcd\x13\x03i\x16jitv\x1b\xf8\x8cW3\xf9|>_\xfd$|\xde\xe0P\x86|K\xdb\xf3U\x00cm\xbe\"\x8c\xbb\xbb\xba\xf7;\x84\xcd\xd4}\x9bO\xd2Fm\x03+\xa2\x81,r5\xd59\"I<\x16L\xe8l\xac/R^\xe1<\x97\xc2hUc\xe3.\x81>E\xf2\x18\x98\xef8C\xbeP~L\x90[\x8f\xbbq\xc6zAo\xf3P\xbf@\x13r\x0fG\xb4\xf0@\xb2+\xe6p\xa7\x97\xd4\xf3T\xd0g\x8f\x87\xb9\xe53\xec_w\xa8\x91e\x9c\"Dl\x18EqDtj^uf\r\xf0)\xc5l/3\x9b\x11tD\x90\x9dv\xef\xf1pI4\xfcH\xdc\xf1\xbe\xff1\xe4\x1b\xbd\x17'\xc0\x8c-2z\x99\xb5\xb8~b\xb0G\xc1\xecr\x1b\x9a\xa8\x06o\xb0\x97\xb8\f\x03\xf2@\xf8\xb0|\b\xbc'\xbf\xad\x94\xf0\xaa4B\\\xe6\xef\x86\xe5\xab\xfcs\xa5䯟\xdf\x7f^\xc1;k!\xc8\x0eYUk\x93\x9b\x1a\xed\xe4\xb6{\x9d'\xeekHd\x7f\xbe\xf9'\xbc\x84\xbe8\xe7\x19ܬs\xf7\x1f\xf4\xe6Π\x94\xa2uQ%0\xe8\xdcT\xb1\xbbQ\xcd2\x1f\xe6\x1aq´\t\xc1\xa1\xb9l=\x9d\xbe\xc4h/!Uz\xc2Kl\x06\xf0\xad:\nUu\xa6\xafJ\xb4\x91\xd0Qs\x16=\xf9\xfc\a\x96\xbc\x1b\xc3t<(\aӶ\xa9m\xcaWL\xfe\xa61[\xbc6\x16f\x14\x99/\xbcz<\xe0Y\x03]\x8c\xa4\xf8\U000917b7\x8d\x91\x9bq\xac7\x89\xb5\xfdǜ3\x9f?\xff\xceX\xefw&\xcex\xf3\x19\xa8\xeft\xe7$\x83\xa3\x16\x9bC\xe3\xb0$\x84\xd0\xce\xf4ދ \xeb\x83>us\x8d\xf8n0\xe4\xcc\xc6\xe1̻߽\xb9\xfa\xf6\xaa\xf8\xb3z^,F\xfdƱ+\x10N%\xf7\xd8e\xe3\xca\xdf\x01\x00\x00\xff\xff\xec\xa0\xe0\xa1k\r\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec[M\x93۸Ѿ\xebWt\xcd{\x98\x8b\xc4Y\xbf\xb9\xa4tsf'\xb5Sq\x9c)\xdb\xe5;D\xb6D\xac@\x80\x01@\xcd([\xfb\xdfS\r\x10\xfc\x92HB\xb2\x9dʦ$NծD\xe0A\xa3\xbb\xd1\x1f\x0f\xe9\xd5j\xb5`%\xff\x8a\xdap%\xd7\xc0J\x8eo\x16%}3\xc9\xfe\xcf&\xe1\xea\xe1\xf0n\xb1\xe72[\xc3ce\xac*>\xa1Q\x95N\xf1g\xdcr\xc9-WrQ\xa0e\x19\xb3l\xbd\x00`R*\xcb\xe8gC_\x01R%\xadVB\xa0^\xedP&\xfbj\x83\x9b\x8a\x8b\f\xb5\x03\x0fK\x1f~J\xde\xfd\x7f\xf2\xd3\x02@\xb2\x02װa\xe9\xbe*s\xa5\xf6\xa5\x12<\xe5h\x92\x03\n\xd4*\xe1jaJL\t}\xa7UU\xae\xa1\xbd\xe1g\xd7+{\xa9\xff\xe2\x80~Qj\xffB@GwKpc\xffv\xf6\xf6\an\xac\x1bR\x8aJ3qN\x10w\xdbp\xb9\xab\x04\xd3'\x03\x8e\v\x00\x93\xaa\x12\xd7\xf0\x91\x15hJ\x96b\xb6\x00\xa8w\xead[\x01\xcb2\xa7;&^4\x97\x16\xf5\xa3\x12U\x11t\xb6\x82_\x8d\x92/\xcc\xe6kHh\xb3\tʭ\xd2\x1e\x88.\xda\xe5\x1a\x9e\xfa?\xda#-\xbaQJ \x93\xa78\xc1JI\xaa\xd1\x19\xe8\v/\xd0XV\x94=\xd0\xf7;\xec\xe1e\xcc\xfa\x1f\xfc\x9a\x87w\xee\x8bIs,\x9c\xc1\xe9\x9b*Q\xbe\x7fy\xfe\xfa\xa7Ͻ\x9f\x0124\xa9\xe6%\xadv\xaaj\xe0\x06\x18|u\xc6\x03]\xbb\x15\u061cYȕ\xc8\f\xd8\x1ck\xed6\x80\x00\xa4hC\xfa\xc3\f\xac\x02&Dg\x9c\x01\x83\x02S\x8b\x19l\x8e\xc0\xed\x12\x8c\xf2\x884\xa6\x9e\xaa\x11\n&i_Ast\xa5(\xadfB\x1c\x81Kc\x91e\xa0\xb6\xb0A.w\xa0\xb1D\x1a\r\\\x02\xb24\xafw\x02Lf@;\xce*\x81I\x03UjU\xa2\xb6<\xb8\xa1\xbf:Ǭ\xf3\xeb@C\xf7\xa4D?\n2:_\xe8\x95P\xfb\x0ef\xb5\xdeI4\x9bsC\x92i4(\xfd\x89\xeb\x01\x03\rb\x12\xd4\xe6WLm\x02\x9fQ\x13\f\x98\\U\"\xa3cy@mAc\xaav\x92\xff\xab\xc16\xa4UZT0\x8b\xf5Yh/竒\t80Q\xe1ҩ\xa0`G\xd0H\xab@%;xn\x88I\xe0\xefJ#p\xb9Ukȭ-\xcd\xfa\xe1a\xc7m\b/\xa9*\x8aJr{|p\x91\x82o*\xab\xb4y\xc8\xf0\x80\xe2\xc1\xf0݊\xe94\xe7\x16S[i|`%_9\xd1%m\xd8$E\xf6\x7f\xc1s\xcc}OV\x7f\x1a\x8c\xd5\\\xee:7\\P\x98\xb0\x00E\x05\xef\x98~\xaa\xdfh\xabh\xf2\a\xd2Χ\xa7\xcf_\xbaN\xcbM\x0f\x14j\xbd\xb7\x13Mk\x02R\x18\x97[\xd4n\x1el\xb5*\x9c\x99Qf\xa5\xe2\xd2;k*8ʡ\xfaM\xb5)\xb8%\xbb\xff\xb3Bc\xc9V\t<\xba\x98\v\x1b\x84\xaat^\x9d\xc0\xb3\x84GV\xa0xd\x06\x7f\xb8\x01H\xd3fE\x8a\x8d3A7]\xb4\x1fBY\xd7Z\xeb\xdc\b\xa1~\xc4^Ø\xf2\xb9Ĵwt\xfc\x99W\xdb6ҜD\x94\x10U\xc0\xc7o\xe7\xd3ݐbs<\xdeklbN{\xd4Ǐ;]~\xfeg\x17\x90\x94\x1e\xde=\xbb\x910\xb8\x0ec\xdd\x10\xd8\xdd\x0e\vҜ`\x02\x9d\xde͑\xc6r\r\x82mP\x98%`\xb2K\xea3M?@\xaaJ\x8eY\xebx\xa6\x0eb&\x81\xe7\xed\x19L,J{\x04\xa5Ar\xb1<'G\x88ĵ\xa8}\r\xd1%+!\xd8F\xe0\x1a\xac\xaepѻ7\xa9D\xfa+\x98M\xf3\xa7\xb7R\xa3iR(\xc0\xa4:\x87S\xfc\x91\xa6\xb4Oa\xd1\xe9\xa5ֱ\xd2\xee4q\x8d\x85;\xa5g\xb1\x01\xbe\xe4\xd8\x1b\xe7\xf6\xfe\xfe\xe3Ϙ\x9d\x9f\xc1-\x16#\x82\x0eD}?!N\x1d\x89\xc2\x1d\xcad#\x90\xbe\xd0b\\\x1a\x1f\xb1\xcc\x12\x18\xec\xf1\xe8C4\xe5\x81\x125\v \xa0хwg\xcb=\x1eGA\x99l\xe2\xf8Și\xd3\xd5A\x17\x8f\xe37\a\xea\xd8\xe3\x91v\xddx+m\xa2MፒXY\x8aP\x8b\x8d}\x86G\x15 \":\xf5\xaf\xa0\xb5h\xf1\x1b5\xb7\x81\xdf\x1b➢\xb6py\xda\xe4\xbc\x04\xab& \xc1Y\xdd\xf9jȢ_\x99\xe0Y#\x8f\x8f\x01\xcfr\t\x1f\x95\xa5\xff<\xbdqc\xa7\xd5A\xb6\xfcY\xa1\xf9\xa8\xac\x1b\xfd\xcd\xca\xf1\xa2E\xab\xc6\x0f'\xe32\tLkv\xa4\xfduӬ\x8b?\xe4\x93\x13\x90\xadM\b\xe9YRX\xaau@\x0eR/\xe2\xe1\x8bʸ\xbc(\x95\\\xb9\x186\xb5e\xa8\xd7\xee\xe1;E\x19Z\xa3\xab\xb9\xeeR\x93\x88}1\xbc\b\xf0\x85\x92\xbe\xbf\xe3K8A-\x02d\x95S\x84+<\x98\xc5\x1dO'\xa1\v\xd4;\x84\x92\xe2\xdcԮ&\xe3\xd0\x05\xb6\x0eÜ\xdc#\xa3\xea\xc05\xa8\xaf\xdak5\x11jV\x8d\xdaG\x06\x8c\xd4\a\xb1\xf2\xb9\x84\xf0\x81\x02\xed\x886\xba\x1d\xd9\\D\x9b\xd5X\xcf\xef;K\x93\xcb2(XI\x9e\xff\x1b\x85g\xe7D\xbfCɸ6\t\xbcw}\xa5\x18\xf3\xff\xee\f.\x9d\x13v\xc1\t\x97\x1b +\x1c\x98\xa0\xf4AiY\x02\n\x97LF@\xd5\xf6$\xc1.\xe15W\x06\xc9\\\xb0\xe5(2\x92\xfbn\x8fǻe\uf10c \xd2\xe0gy\xb7l*\xa9ޡl\xf2\x94\x92\xe2\bw\xee\xde]r\x92`G\xb0g\xd2\ue917L\xde|[\x115\xa1%Z4\xab\x82\x95\xabڟ\xac*NNb\xe8\xc6\u05cbIÇ\xfe\xdc\x15\xb1|\xcb\xd1\xc0k\x8e6Gݩ\xa1\\+\x87\xa1\xf6\xac\xa3\xc1\t.\x9c\xce\be\xe1+\xb7\xb9\xbbkX\x81\xae\x89\xf7at˄\xc1N\xb5v\x06s\x80d\xd9\x1e\xa1Ԙb\x862EP\x87ZR%q \xe8\xa9\xf6Oه\xf6\xe3֟Q\xd6/ME\xd9\ued29-\xdbԏ\xd9x\x919]\x8a4}⹛\x03a\x02\xc9\xe5\x05\xf2¸\x1a\xa4\xee\x9e7\b\xf8\x86iE\xa4\xc0k\x8e\xc3\xfd\x86\x0f\x99\x88bzU\x02\x97\x19?\xf0\xacb\xc2\xf1\vL\x12\xb8kK\x82\\\xc9\xe2\xe2\xf8ݓٷBArRg\xaf\x19R\x12)\x8d\x15Ԍ\x9f\x0e\x1dOcc\xdb\xde0\x83\x19(\x1f\x85t%\xd0\xd4Ke\xb0\xa5N!p_f9\n\xddX\xc4G\x8a~\x19\xfc-\xf5&\xbe\xa5\xa2\xca0k\b\xb8\x89\xb1\x03->\x9dL\xed\x9c^\xdaj\xbb\xb1\tH\xaa=\xe15\xe7)\x9dLn\xdc\xc1u8\x90)4@\xed:\x15\xb0\xc7\xffL\xe6\x9ei\xbebSh_\xb7\xc1{.Wm3s\xa0\xd9\xc6\x1d\xe6j\xe3\xffM\xc5ry\xb5\xd3>\xcb\x1f\xeb\xb4u\xb3\xe5Ҋ+b\x97\xc0md\v\xe6\x18\xdav\xfd?\xb0a.\xf7\xf8\xe7\xe1\xcc\xef\xea\xf1\x93V\x99C$\xab4\xcb\xff\x01\x8d\xe2\x92\xc58\xb76b\x90\x0f\xddYK\xe0\xdb\xc6 \xd9\x12\xb6\\X\xd4\x03\xcb|\xd3y\xf9\x1eʈ\xc9w\xf1$و^.\xa1\xcbfp\x9b6\xd0U\xf1\xc9\xc5\xc4\xd9E\x9e\xf7\rd\xda,n]\xfa\\B\xabE`\x0e\x88\xb7\b\x82\xedrW\x88\"\xddF\x14\x18G\xbfE\xe1B'\x16\xcdo\xee\x82@\x12\xae\xa0\xfb+\xb6\x19K\xd3E!\xfb4\x17I\xd8E\"\xf6h\xbd\x8b\xa8\xbb\xab\xd59O\xe7\x8d(3\x86؋B=K\xc1MR|\x91\xb0\xa7D\xe08\xd9\x17\t9A\t\x9e\xa5\xfd\"a\xa3\xc9AO\x00F\xa2\xce҄\x17Gݫ<,.\xb5\x87\xcf\x1c\x9d\x18G,^@1F2E\xd7\xed\xa8C\xd4\xcdm\xe8\x12*\xf2*[\xf4No<=9+B\xa0//&*g\x91{Df\x14e9\vy\x9eҜ&/gA#\xc9\xcd\xf8\"(\xd2\x13#\x87]Br\xb6\x1f\xea\xde\u058bHw\xa2\xf65T\x104\xb1yW\x858\x90d\xf1\x8d\xfe[*c\xa3EyQ\xc6:r\xab_\xce^\xc2~վW\xb3^\xc0\xb6\x165\x18\xabtx\x0f\x84\xc2epq\xa2\xfb\xaaҕ\xbcf\xaa\xa4\ag\xfe\x86I\xf3\xa0Ԑݵ'߳\x14w\xfe\x19=\xfd?\xb0\x94\xeeL\x8bJ\xb8\xa5V)\x1a3\xedZ\x11Q\xbe\xa7\xcaS\x9d5\xc4\"\xf3\x8d\x0f\x91~sd\xe6\xe5\x85,)in\xcc@ԧ\xb7\x0e\xebɤ\x83\x98u\xbeK墋^\x9ca÷\x89\xa2D|\xf43\xc31\xa9\x81\\\x95\xc7\xf4\xae\x9az\xfe1\xee\x9c\xff\r\xe9\xbd\xe0\xf2\xd9y\x16\xbc\x8b\x1a\x1f\x9b<{\xc1\x15\xaf)\xf8\x1f\xc3\xdcV\xe9\xcd\x0fr\xf6\x91s\xfb)\x95c\xfc5\xf6,wʏ\x8f\xbcIs\xee\"ҲCC\x90p\xa5\xca\xee\rl\xb96M\x03\xea$\x8fD\xacfN\xff\xd5\x16V\xf2I\xeb\xab\x1a\xae\x7f\xf8\x99\xcdF\x89\x13\x7f\r\xafdy\xf5E\x81\x02l0g\a$\xee\x86[@\x99\xaa\x8a\xdeIt\xbd\a\xba%\xbc\t|\x80\x8eVY\\\x80\xa0\veU\xc4)`弎\xcbI~\xa7\xbdV\xf0W\xc6ŏ0\x9bF\xab#\x83\xda\xc0l\x9f\xfc\xccphdUlPS\x12\xb5\xf4\xf2pm\xbf(\xd8F\x8a\xe6\xf9^\x9dM\x19l\x19\x17\xf4,I;\xd4\fTe\x17\xb3h\xee\x8fYK\xed\x1clpKϵR%\rϰIε'(Y/R\xe9\xc8(\xe9\x1a\xd13璼\x04\xb8\x91\xf7\xb6\xdeM\xe41+\xb8\xe4EU\xac᧨\xe1\xfeTһ\xb6;\x8c\xe1ZH\x96\xe33\x1d\x83\x03\x13WZ\xb9\x99\x1fl\xcd\n:Y\xc1\xd6Q\xa0\x10\x0e\xf4+\xa3\xb7T7h_\x11]t\r\x96j\x1e#ǟ\xb7\v}\x9d\x84U\x95]G\f\x1dh\x81އW\x95mj\a\x12\xbb`od\xb8Z\x19Q\x98\x10T\x16\x94Q'\a҉{4\xda8\x92U\x94@J\x81\xf5\x1b\xf6\xf3\xd7\xf7\xf7s\xa2gk\x95u\xe8:\xff\x9a{8]j\xdbMv\x91\xc0\\\xf6\xd3\xec\x0f0\xf6%\x04A\xac\xf0\x91\x8dT\xec\xe2+g\x9b\xc5wX1\xa6T*u|\x9f\xf6\xa21\xae7\x9a{\x92TW<PjNέ\xbew{T\xfb<\x93\xc7[\x7ft\xeb\x8fn\xfdѭ?\xba\xf5G\xb7\xfe\xe8\xd6\x1f\xdd\xfa\xa3[\x7ft\xeb\x8fn\xfdѭ?\x8a\xec\x8f\xe6$Z\xb9\x97\x96\x17WJ\x11\xf1Bה\x88\xa3\xf8go\x9c\xfch\xe8_wg\x9d\xb5\xa9\tc\xbb\xae4\xa6ڄ\xeeì\xe1\xb7\xdf\x17\xff\x1e\x00\xddk\x10\xa9\xfeA\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xecZos۸\xd1\x7f\xafO\xb1\x93{f\x1c?g\xca\xceݵ\xd3\xeaM\xc6qr\x8d{q≝tz\xbet\x06\"\x97\x12N \xc0\x02\xa0\x15\xa5\xe9w\xef,\bP\x14\tRr\xeenz/\x1ai&\x16\xb9X\xee\xdf\xdf\xee\x02L\x92d\xc2J\xfe\x1e\xb5\xe1J\u0380\x95\x1c?Z\x94\xf4\xcbLW\x7f2S\xaeN\xef\x9fLV\\f3\xb8\xa8\x8cU\xc5[4\xaa\xd2)>ǜKn\xb9\x92\x93\x02-˘e\xb3\t\x00\x93RYF\x97\r\xfd\x04H\x95\xb4Z\t\x81:Y\xa0\x9c\xae\xaa9\xce+.2Ԏyx\xf4\xfd\xd9\xf4\xc97ӳ\t\x80d\x05\xce`\xce\xd2UUj,\x95\xe1Vi\x8efz\x8f\x02\xb5\x9ar51%\xa6\xc4}\xa1UU\xce`{\xa3^\xed\x9f\\K\xfd\xcc1z\x1b\x18m\xdc-\xc1\x8d\xfd!z\xfb\x157֑\x94\xa2\xd2L\xc4\x04q\xb7\r\x97\x8bJ0\xdd#\xd8L\x00L\xaaJ\x9c\xc1kV\xa0)Y\x8a\xd9\x04\xc0k\xeadK\x80e\x99\xb3\x1d\x13ךK\x8b\xfaB\x89\xaa\b6K\xe0g\xa3\xe45\xb3\xcb\x19L\x83u\xa7\xa9Fg\xd8[^\xa0\xb1\xac(\x9d \xc1`\xe7\v\xf4\xbf\xed\x86\x1e\x9e1\x8b}fd\xb9\xe9V\xd6\xdbM\x19V\xd5\\\xb6\x86\x80ֽ\x9a\xa3\xb1\x9a\xcb\xc5dK|\xff\xc4\xfd0\xe9\x12\v\xe7|\xfa\xa5J\x94\xe7ח�ٹ\fPjU\xa2\xb6<\xb8\xa7\xfe\xb4¯u\x15 C\x93j^\x92\xbe38\"\x865\x15d\x14wh\xc0.1\xd8\x143/\x03\xa8\x1c\xec\x92\x1b\xd0Xj4(\xebH\xdca\fD\xc4$\xa8\xf9Ϙ\xda)ܠ&6`\x96\xaa\x12\x19\x85\xeb=j\v\x1aS\xb5\x90\xfcS\xc3ۀU\ue842Y\xf41\xb2\xfd8\x1fJ&\xe0\x9e\x89\nO\x80\xc9\f\n\xb6\x01\x8d\xf4\x14\xa8d\x8b\x9f#1S\xb8R\x1a\x81\xcb\\\xcd`imif\xa7\xa7\vnCڥ\xaa(*\xc9\xed\xe6\xd4e\x10\x9fWVis\x9a\xe1=\x8aS\xc3\x17\t\xd3\xe9\x92[Lm\xa5\xf1\x94\x95<q\xa2KR\xd8L\x8b\xec+\xed\x13\xd5\x1c\xed\xc8\xda\xf3e\xfdu\xc92\xe2\x01\xca\x16\xe0\x06\x98_Z+\xba54]\"\xeb\xbc}qs\v\xe1\xd1\xce\x19;L\xc1\xdb}\xbb\xd0l]@\x06\xe32G\xed\xd6A\xaeU\xe1,\x8e2+\x15\x97\xd6\xfdH\x05G\xd95\xbf\xa9\xe6\x05\xb7\xe4\xf7\x7fVh,\xf9j\n\x17\x0e\x8b`\x8eP\x95\x94\r\xd9\x14.%\\\xb0\x02\xc5\x053\xf8\x9b;\x80,m\x122\xeca.h\xc3\xe8\xf6\x1fq\x99y\xab\xb5n\x04\b\x1c\xf0W\x17\xd6nJL\xc9}dAZ\xcas\x9e\xba܀\\i`=\x18\x9c\uec0e\xa7.}j\xf0\xbb\xb1J\xb3\x05\xbeR5\xcf.QT\xb6Κ \x1c\xc1\x10e(\xfd\x1d%\xec\xf1\x06\xb0Kf[\xf9k\x19\x97\r\fD\xf5\x19q\x02}W\xaa\xe4\xec\x9aiV\xa0Em\xf6\xa8\xf3\xc3.50\x8d.P\xcb\xed%B\x8eJ֗\x1d\xf3\x1eGh\xc9zBt\x1bǇ/\xa4Ҙ\xc1|C\xd7@\xd9%\xea\x16\xa5\x8b$\xd3\xd7MVB\xb0\xb9\xc0\x19X]\xe1d\xe7ި;雲t\x89\xafx\xc1\xedճ\xd8\xfd\x8e\xfa\x17-\xf2&\xc2\xf8'\x04A,\x80K(p\xc1\xe6\x1b\x8b\x86\xfc\x8a,]F\x99B\xf0\xbaP)\x13\x84\xc3\x16\xa5\xad\x81\xd4'F-\x9a\t\x84cޭ?\x97\x16,[\xa1\x01\xccs\x02\x9d\xf5\x12eg)\x89\x9c*)1\xad\x01\"\a\xc2\f\x83\xf6d\x80\xe77ggg\xb4\xa82\x98ş\x9b+]0;\x03.\xed\x1f\xbf\x8bR\x14\\\xf2\xa2*fp\x16\xbd\xbd\xc7}\xdb襪\xb3@\x1d\xa1HUA\x15\xb0_W\xe3>\xdcR\a\x172\xb1P\x9a\xdbeAe/ps\xb6#\x88\x8a\xb2\x04\xa8J\xa1X\x86Y(\x95[3\x9f\x00N\x17Sx\xf4\xc9\xd8,ə\xa1\x12\xfa\xe8\x10s\x87'\x92\\\xe4\x99 ʐ\xf1GҚ\xbe\x94\x94B\xa0x\xe7$5\a\xd8\xe6zwE\xb0\x8f\xac\x8a9j\nŜ\v4[\xd5y\xb7\xdd\xe8>\x9a\x92\x99A\xa92\xb8\xa7\x9e\x0f=\x86\xee\x18\xa3\xf3\x88\x8b\xebwf\x80\xebh$6q\xf6䷊3S\nn-\xea\xf3\x10.\aX\xf4\xa6\xbb&\x1as\x8e\xf3\xbe\x80㒢sYɕ\t\x11\xf6\xfc\xef\xafϯ./\x92ﮒg\xef~|y~\xf3\x92\xe2̂\x92b\xb3\x83\x06\x03,\x870\x82\x9a\xef\x0eB8\xb2\fsV\t\xdbXb\x80\xad\xcak\xe4\x1f\x87\x8e\xd1\xe8\x1d\xe8\x04\xe8[0\x82\x02\xc9d\x8a\u07fb\x1eH\xa6\x9b\xd9d\xd4\vW\x91%$\xdcR\xadA\xe5\x16e\x9b\xa9\xaf\xae=\x8e@ݕ\xae\xe4t\xf2\x00MZ|\xff\xaa\xe6a\x9e4\x87\xcb\xdb^Քۦ\xe7lz@&\xb3\x1eK\xa8\xcbRSC~Vs\x03\xba\x922\xf4\xafm\xa5[ӄ\x8f\x04r\x7f\x84\xe7N@8\x96Kv\x8f ն\x13&\xa9\xb8\xc6\xc2u\xbc\x93\af\xe2x\xc1\xae5\x8a݁\x9d9s\x8c\a}\x98ܼɇn&{\xa1\xa0M5\x10\xc1\x01\b)O\xe4\f\xfe\xf1\xf8\xa7\xaf?'\xc7O\x1f?\xbe;K\xfe\xfc\xe1\xeb\xc7?M\xdd\x1f\xff\x7f\xfc\xf4\xf8s\xf8\xf1\xf5\xf1\xf1\xe3\xc7w?\\\xfd\xe5\xf6\xfa\xc5\a~\xfc\xf9NVŪ\xfe\xf5\xf9\xf1\x1d\xbe\xf8p \x93\xe3\xe3\xa7\xff7 \xd0Ǆv%\xb4D\x8b&\xe1\xd2&J'\xb5\x06#ȸ\x13\x9cG\xae\x012>b\xe7~<-\xd8G*\xf3\xc0\nUIK!Gի\xf2sy\xff\x13\x82\xc5\x00\x13B\xad\tm\"#\xcaVV\x9aR2\x95\x1a\x9a\x10S,\xad\xfb#\xe7\x8bJ\xbbN\xf9\xb4`\x92-0i\xd8&\xbe9FmN\x8f&\x11\x01\xc6 \x86>!\xb5\xfe\x17k\xff\xcdX{\x1b\x00\xae\x13m\\~a\xb4yl\xaa\x8b[Ý\x1bP\x05\x15\xea\xccψM\xf4\f\xf5j܆j\xe8F\x1e\x9f\x13\x9cP\x94Y\xaa-\xf8\xb1\x14<\xe5Vl\xc2\x10\x8a\xd9I=լ\xb9\x19\x12\xd4*`\x12xQ\n\a\x9f.\xb6\x93z\x1b\xc8o\xa6\xfc\xbe\xf2d\xe4f\xab\xba\xfc\x8d\xcbL\xadg\x93Q_\xb7\x8a^M\x1fZ\xa5\x8cqjgx\x81\xb0\xf67$\xac\x97<:\\\xd1\x02\xda \xcb*\x81\xd9N\x85\xe3\rԐÌe\xda\xf6:\x9c\b\xc36\v\xb7\xc8\x00!\x10p{d \xab\xf0W.p\xd8ݚ\x8a\xda\xeaE\xbdAE\xca:\xbb\xa8\x1c2\xb6\xd9\xce|\xdeN\xa9P\x06\xcd`\b״\xf5\bG\x88\xfd\xf2\xe5\xec\xeaj:ك-wgO>\xb8\xe4\xff\xfc\xcd\xddY\xf2\xed\x87\xe3\xd9\xddY\xf2\x87\xfaR\x1c\t\xf6`\x97\xb3\xea\x01J\xdf\x10\xdd!jӶ\xec\xef^kR\xe0G%\xf1\x00\xc5o=i\xd0\xfd\xf2\xfc\xf5y\x9d\x0f\x9f\x94lv\x90\x9c\x19\a\x1aA\x1fYanxQQ\f\x9e>C-\xb8|\xb4\x9b\x05\xefn/~A\xdf\x1e൯U\x02\x18\x11-\xa9\xc5~\b\xaehd\xd9\x1b)\xf6\xf5\xfco=Y\x83\xbe\x86҃\xe072\xf1\x10τ\xa6\xa6\x98ʷ\x8e\xdcX\xa5\xd1oԲ\xed\x826#j\xcf\x03Ĭ\x97\\\xa0{\x92\xc4u\x84i=\b\xbb\xda\xc1\xadk\xec5\xe6dt\xef#\xab`\x85X\xfa\aS\xc7\xdel\x11\v\\\xb0t\xe3\xee\xf04º\x91\x88\xd3Ȑ[\xd4P\xa8{bALi0\xebkY;u\xae\x94@&'\x03\xfc6\x17\x1a3\xda\xf4eb\xaf\xf1\xfbKB\xf4j\xccQ#A\xa8\xdf91\x98j\xb4\xb0\xc2\xcdd0]\u07fbc/w\x16\xe3N\x99`\xa9D\x16昒\x19\xb3V:\x8b\r1\x11\x96\x1d\xcc\xdf.7K\xe67 \x99\x10\xbbQB\xa6\x1c̊_\x04\xf8+\x8cDrϠ\x14\x83+\xdc4\xb9^\x9b\x8c\xea\x18\n\xda\xed\xa3\xe0\x98\x02\\U\xc6Ҙ:\xb4\x87p\xcf\x04\xcf\xc2\xea\x15Fͳ'\xc3\xfd\x81\xd8~\x91\x8f^\xb7\xb6\xb7\xbd\xd3탻\x17u\x8f\xfa\x9e\xe3\xfat\xad\xf4\x8a\xcbE\xb2\xe6v\x99\xd4\r\x879%Q\xcc\xe9W\uefe8D\x00\xb7o\x9e\xbf\x99\xc1y\x96\xf9\x1d\xe5\xca`^\t\xc89\x8a\xccL[gr'@\xc7\x17'P\xf1\xec\xe9ї\xd8E9_1q\x80m舂\xe7\x9b\x1dD\xba\xa9\xbd\xa24\xd0\xccN\xce.\xbc7}\xff\x17e;\x96\xb8\xe3p\x1c˷\x11\xd8\xed\xb4\xf3\x05+\x93\x9a\x9aYU\xf4ph\x9b\x81\xb7D4\x19\xb5\xc6\x16-\x88\x18\xb8\xcc\xe8\xc0Ʒ\xfa\xf4\x90\x10E\x04\x9a(\xb3V~\xf7\x18\xa3\xac\"\xfbr\xc9\xc0QD2\x84\xa2\t<z4y\x80\xffk6\x97\x0e\x1ds\x8ez\xafƻ\xe4\x01\x1b\xf3J\b\xcf+\xa1\xf9\x99Y>\x178\x1cr4\xac\xf0\xfa\xa1\x9b\x1a\r\xf7\xa0߈\n\xf5\x0ems\x8e\xbfG\x83\xf7\xbb\xd4A\x81-@;Q\xc8aU9\xe6/\b\xa7X\xa6\xbfMlh\x18{\x80\x0e\xf1hO`\xbe\xf7l-\x81\"\xb2E\xd8!\xe9\xfa\xb8s\xbbc\xbf\xc9\x01ye,\xb3U\xa7*\xecX\xb9{Ty\xe3\x16\x04c\xa7\x95&L\xf5l(I\xbe\xfcpS0c[\x13\x18\xb5\x9c{\"\xe0U\x7fE\x10\x8c\x98\xd5\rj{zZ\xb3\xd8\xc6~tG5\x1c+\xd1QvB\x8c\x1eZsG\xe2\xbc@c\xd8b\x9fvW5\x15i\xc4\xc2\x12`sU\xd9\x01\xd3ǧ\xc7qw\xec\x91T\xe9r\xc9\xe4M\xca\xf6\x9d2\xbfi\b\x83\a4\x1aڨ\xf7\xb8Y\xbf\xc6\x01\x86\b\xfc%#Yi\x96\xca\xc6\\B\xb3@ӥ\xd5\xfd\x90\xdc\xf8,\x9a>\xd4\x13\xe3\xddϠ3\xc6\x1cB\n\xa2\xd6J\aer\xc6i\xda'\xfd\xfa\xf2\xed12}\v\x95\x1d$\x82ʚ\xe7ӒƖ)\x93'\x80\xdc\xf5\x17\xf4\xba\x15(\r\xa5\xae$~\x914\xb5\xdb1\xbb\t.:@\xb47\xdd5\xcdYA\xe0\xb6\xf58\xe4\xaa\x1a\x9c\x12\xfd\xe9;\x99\xf2d7P C\x81\xd6\xf7ǵzuD1\x8d\xf2\xc8\x02\x97\xa9\xa8\xb2\xa1\xa9\x91[,\x06\x14\xe9\xa8\xd2M\x99\xaej\xfe͜\xe6W\xbf\xeb\t\xffX\xab\xf0\xd4\x1bF\xc0\x8d<\x8a\x05w\xaf\xf6\f2U\xda\x1d\xd2\xf9Cи\xb2\xfb\xa2ޣ\xbfW\xe1\xf2\xf90M\xc76\xc1\x06\x97\xcfC\x1c^>o\xa2\xd0\xdf\x1b\x12\xe9\x80\xc8\xf3r\xb9\xad\xd2\xc3er\xe4A\x1e\x7f\x04\xf4\xab\xcbD\xbb\x04\xcdˀ\x87˶\xb3,\xc8H\x05eG\xbc\x81Ҵ\xfd\xac5m\x0e\x0f\x80ˡ%\xeb`\xc8|\x90m\x86[\xfcИ\x04-/\x9f\x0f\x90\x8cv\xfd[\x02\xa65\x8b5p\x0e\n\xb6\xc83\x9b\xecu\xcb\xf5\xee\x8a\xfe{\x06q\xe0\x8a2\x86!\\\x8a;k\xdfi\x8b\x1d\x8f\xb1\x1d5\x86\x03\xab\x8d;\xcc8q\xe4\x102\x1e\x168\a\x84\xcch\xb0\x8c\xf8\xb8\\2\x13)\x7f\xbb\x1e#\x9a\xa0f\xbb\xf9iR}\x7f\xa734\x9a\xbd\x8el\x90%n\xff\xae\x1fm\t\xbcV6~kD}\x8d)\xcav\xb3\xbaG۷]\xfa\xa0\xf9\x92\xd36`\xb3\rS(C\xc5$\xed\xbf\xa4\xd9=9Е4'\xed^\x8cZ\xe4\xe9\xe4\xe0*9Z![\x82\xee\x0e\bMw\x1a\xe1\xe8\xcac\xd5\U00103b48m\xc9=\x9d<\xbc\xb4\xd1\xdcJ\t\xd9dG\x9c\xac\xa3\xd3EwU\xd0\xc1\xb3\xa3\xd7&Þ\x7f\xbc\xd5\xee\x19}:\xf9eH}\x10J\x8f&\x1d}s\x8d\x98=\xdb\xd8!su\xec\xf0}C\xde8\x91^0\xf4^r\x9d\x87\xe3\xe86\xa2\a\x18\xfaS\xb0z\xde\r\xefS\xb6\rC\xef\b\xf9w\xcc\fZ\xe0\xbeX\xf3OCZ\x02\tSɕTk\xb9Ϭï\x02>Ȥc\a\xe2\xa3S\xc3\xc3\xe7\x86\x03bf\xaf\x9b\xeb\x81\xeb \x89\xde:\xd2\xf8\xa4v\x80(q\x18\r\xf0xS\xa5)b6\xb0[H\x14\xdf;\xa5\xbfT\xcf\xc3\x1a\xb1\x03\x9a0Ǩ\x9dҿ\xb7\xd4\x1d튆:\xa2\xe8\xa2\xdeE\x83\xfa\x1e\xb3\x96pTUآ-\xae\xa9\xe6\xcd\x19\xfd\f\xfe\xf5\xef\xc9\x7f\x06\x00\x9e1+\x95\xc04\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}\xebo#\xb7\xf5\xe8w\xfd\x15\x84\xef\a'\x85\xa5\xddm\xd3\xe2\xc2(\n8\xf6\xa65\x92&\xc6\xdaq\xbf\\\xe0\x82\x9e9\x92X\x8f\x86S\x92c\xaf6\xc8\xff\xfe\xc3\xe1k^\xe4\fG\xf6\xa6\xdb\xfed\x05\xc8JC\x9e\xe1y\xf0\xf0\xbcH.\x97\xcb\x05\xad\xd8=\b\xc9xyNh\xc5ࣂ\x12\xbf\xc9\xd5\xe3\xff\x95+\xc6\xdf<\xbd[<\xb22?'\x97\xb5T|\xf7\x01$\xafE\x06W\xb0f%S\x8c\x97\x8b\x1d(\x9aSE\xcf\x17\x84в\xe4\x8a\xe2\xcf\x12\xbf\x12\x92\xf1R\t^\x14 \x96\x1b(W\x8f\xf5\x03<Ԭ\xc8Ah\xe0\xee\xd5OoW\xef~\xbfz\xbb \xa4\xa4;8'\x0f4{\xac+\xb9z\x82\x02\x04_1\xbe\x90\x15d\br#x]\x9d\x93\xe6\x81\xe9b_g\x86\xfa\xad\xee\xad\x7f(\x98T߷~\xfc\x81I\xa5\x1fTE-h\xe1ߤ\x7f\x93\xac\xdc\xd4\x05\x15\xee\xd7\x05!2\xe3\x15\x9c\x93\x1f\xe9\x0edE3\xc8\x17\x84\xd8Q\xebW.퀟\xde\x19\b\xd9\x16v\x9a\x12\xf8\x8dWP^\xdc\\\xdf\xff\xe1\xb6\xf33!9\xc8L\xb0\n\xe9\xe4\x06F\x98$\x94\xdck\xb4\x88\xb0T&jK\x15\x11P\t\x90P*I\xd4\x16HF+U\v |M\xbe\xaf\x1f@\x94\xa0@zЄdE-\x15\b\"\x15U@\xa8\"\x94T\x9c\x95\x8a\xb0\x92(\xb6\x03\xf2\xd5\xc5\xcd5\xe1\x0f\xff\x84LIB˜P)yƨ\x82\x9c<\xf1\xa2ށ\xe9\xfb\xf5\xcaC\xad\x04\xaf@(\xe6\xe8l>-\xe1i\xfd\xdaC\xef\x14)`Z\x91\x1c\xa5\x06\f\x1a\x96\x8a\x90[\xa2!>j\xcbd\x83\xae\x96\xa3\x0e`\x82\x8dhi\a\xbf\"\xb7 \x10\f\x91[^\x179\n\xdb\x13\b$X\xc67%\xfb\xe4aK\xa2\xb8~iA\x15X\x01h>\xacT JZ\x90'Z\xd4p\xa6I\xb2\xa3{\"\x00ID\xea\xb2\x05O7\x91+\xf2w.\x80\xb0r\xcd\xcf\xc9V\xa9J\x9e\xbfy\xb3a\xcaM\x9a\x8c\xefvu\xc9\xd4\xfe\x8d\x96\x7f\xf6P+.\xe4\x9b\x1c\x9e\xa0x#\xd9fIE\xb6e\n2U\vxC+\xb6\xd4C/\x11a\xb9\xda\xe5\xff\xc7\t\x80<\xed\x8cU\xedQ\x18\xa5\x12\xacܴ\x1eh\xa9\x1f\xe1\x00N\x00#_\xa6\xabA\xb4!4+7\x9a:\x1f\xde\xdf\u07b5e\x8f\xb5\xc5\n?\x86\xeeMGٰ\x00\t\xc6\xca5\bݏ\xac\x05\xdfi\x98P\xe6F\xfa\xf0KV0(\xfb\xe4\x97\xf5Î)\xe4\xfb\xbfj\x90(\xe4|E.\xb5&!\x0f@\xea*G\xc9\\\x91\xeb\x92\\\xd2\x1d\x14\x97T\xc2gg\x00RZ.\x91\xb0i,h+\xc1\xe6\x0f\xa1\x9c[\xaa\xb5\x1e8]\x16\xe1\x97Q\b\xb7\x15d\x9d\t\x83\xbdؚezZ\x905\x17\x8d\xbe0ꪙ\xae\xf1)\xdbR\x10\xb7\xa8\xda\xf2\x1f\xe8\x03\x14\xb7P@\xa6\xb8\xe8\xb7\xec\r\xec2\xda\xd1H\x17\x12\xe1\xe9ݪ\xf3d\x00\x91\xe0\\\\\xb3\x02U\x94\x91\t\rt\xa95m\xee\xc5O\x92g\xa6\xb6+r\xbdv\x88C~\x16\xe8\x10\x80߀\xd8Q\x95mQ\xba\x99\"T\x80V됓\xba\"\x026T\xe4\x05H\x89*\x05\xc1\x96N\xc5\a \x9a\xe1J\xa3\x1a\xba\x88\xe3/?\x89\xceo\x92\xf0\xb2\xd8\x13ZU\xc5\xde*\x9e\x00L\xff\xbe\x01\xe6]>⧬\x8b\x82>\x14pN\x94\xa8a\xf08\xcej\xfch\"\xbc\xff\x88k\x88_\xb6\b\x19et\xbf\x8ba/\xae\xa5H\xad\x02\x91%\xd2Q\x00\xe7-\x13\xb0\xc3\x05j8t\xf3\xb9\xdbB\xa7\x9d\xe6\xc6ŏW\x90\x87{0\x05\xbb\xc8@{C\xbd\x18\x19\x8e\xd5y\xee\t.\xa6\x11\x90\xc6P\xa1\xac\x94F7\"\xab\xc9#\xec\r\xc7qũ@P\a\x84\b\xd0\v\x89\x16\xc7G\xd8G\x81\xd2ү\x18\x916㬳\xea\x1d\xf6\xf1\x87=r<\xc2\x1e\xb1Ɓ\x19\xba\xe0\x0fz\xcc\xf8\x93'\x12\xca&\xebX\rÏ\xe21n\x8e\xe8\xc1\xee\xc7Q-y\xf8\x9e\xcc\xcd\x12c\x18q\x8a\xebC\xa1U\x9fܲ\x8a(>\x02\x92h\xaekYu\xeb\xf5=-X\xee\xc7c\xe4\xef\xba<#?r\x85\xff{\xff\x91I5N\x0e\xe4\xe5\x15\a\xf9#W\xba\xf5\x8b\x89c\x86\x96L\x1a\xd3\x1c\x99KKB\x85\xa0{į\xbd\xa0K\xad-\xc3ڦ\xf9\xf3$f\x12\x97T.\x1c\rP@\xecK\f\xf8]-\xf5\n\\\xf2r\t\xbbJ\xed\xc7P&\xf6\xdd\x1d\xf8\x9aP\x92pѡ\\\xfbU\xa3\x10\xbb\xc30C wh^\x98'\xc6X,\xd0,'y\xad\t\xa1M\x1c\xaa`òQ\xd0;\x10\x1b \x15\xea\xb91\xacF\xf5\xd0\f^\xbbfzܑVVq\xf5,\xb9\xe6\xb3\x1cQ5KO\xf6H\x83\x88%\x92:>\xbd \xe8E.B\r\x9a\xe7\xda\x1b\xa4\xc5ͤF\x9b\xa4XG\xee[\xaf\xb6V\x06\xadP\xf2\x7fA\xf5\xac\x85\xe8WRQ&\xe4\x8a\\h\x0f\xae\x88\xc9\x7f\xbb\a\xfaB[h\xe3Ev\xb4\xc2\x17 \x17\x9eh\x81ˇ№\x04\n\xbd\x98D\x80\xf2\xf5`\x81=#\xcf[.\x01\xd9E\xd6\f\x8a\x1c\xc1\x9e<\xc2\xfe\xe4\xac3C\"\x10\xb1\xf1uyb\x96\x9e\xc1\xa4\xf4딶1N\xf4\xb3\x93\xd5`\x81\x8d\xc0\x9eXvG\xa5d\xf4\xe1\xc7\xe5\xa3\xf7E\x97;Z-\xad<)\xbe\x1b\xccDk\xc0\x193\xb2o;\x9d/F\xa5\xe1r\xac/\xd2\xd9\x19)\xafo\x8b\x9e\x91\x7frVBN\x1epE\x05\xf2\xd3\a\xcf\xc9\x105\xaf\x15y\xe6\xe2Q\x12*\xc7\f眃\xb5+\x11\xa6z\xe6$ӮO\x00bƗ\x80\n\x15\x1dy\xb4d\xb5\x19\xab}\xa6\xd5\"Yq\x8d\x1bOz\x82\x19\xc3\xe1_5\x88=\xe1O \x9a\xd5t\xc4Dm\xac<Y\x17\xbaq{n\xa1(\x0f\x8c\xcaF\x18\xc9Ei\xd4{\x10lo\x8c\x1a\x0eHB\x8b\xc2J\xa3\x9e\xfah#G\x9a\x06\xa1\x96\xdc\xf7^̷\xcb\xfaȄ[\xf5\xc8\xfd\xeaf\xf5|\xc3zrI\x1b\x97\x8f\x03\x8d\xeb\xc3\xcd\xeb\x11\x90\xa8^\xa7\r\xec4\x13{\xd2\xc8\xee\x11\xe6\x15\xcd\xec)C;a\xbd\xec\x1av3\xd0H5\xb7G!\"\x02\x9f\xc3\xe0\x9egr'\x93i\xda\xec\xee\x11\xe9\xb5\f\xef\xcfhz\x7f\x0e\xe3\xfb0\xf3{\x02\xa47\xceS\r\xf0I}5\x8b\xf7Sfn\x9a!>n\x8a'\x18\xe3\x13\xb6T\xdaH[\xcbkl\xa0s\x8c\xf2$\x1av\xe6\xc5\xeb\x19\xe6\x9f\xc94\xff\x1c\xc6\xf9\xe75\xcf'\r\xf4Iəx<\xc7L\x9f\f;\xc6%4\xe3;G\xf0\x8bb\xc3\x05S\xdb\xdd\xf9bT\x9a.\x03]|\xe4\xd7\x04\xb4\xa8\xff\xbd\x96\x90\x87C@\xeeͺ\x835\x92\x15\x15\x0f\xb4(tt\x84i\xbbE\xeb\xb23\xb2\xf9\xc4*\xf2̊\x02\xf5[-\xc3D\xbf\U000c0907\x0e\xb9\x8eN\x93OR\xe5\xa8ƋOߠ\xd9~\xaa\xc3%\x02\xa4\xe2\xc2\xf8\t\xbc\xc8!$J.\x85\x88\x12jr~\xc3WCY\a\x88\xb6ԣ\x0e\xfc\x8cc\t\xfc\\|\xfaf1c\xa6g\x92ݖ\xb4\x92[\xae\xee\xd8\x0ex\xad\xa6\xf8v{\xdd\xeb\xd0\xe3\x9aN9Z\x86\x91g\xca\x14\xa6.\x060\t\x02\"\xf7:\xfb\xe8\xe0\xe9,d-\x89\xaaE\x89Y!\xf2\x01h\xbe\xbf\xe3?Kp\xebM&@\xc7\x04\xcf\xc8\x03\xac\xb9\b)\x18\x01\xd8\x1f\x1b\x83\x10h\x93I\x9d\x05\xe5\xb52^s\x0ek\x8a\x1e\x8b^\xe6Q8\u07bd%;V\xd6\nVs\b\x87ɟ\x1dzK\x13\xf4\xba\xa2\x8a\xfe\x1d\xdb\xf5Ȅ\xfd\x89\x06\x80\x98Zy\xb4\xae\xe6\x00\"\xb1\x12\xa9E\xba\x81\x88\xaa\xe9\x04\xe5\xf1ĤǭJÄ\xbbZ\xb2\xb2\xf5\x8e\x00\xc4\xf1y0\x869\xe4uU\xb0\x8c*\xb0~\xae+\x12\x90S\xb4\x88\xf7lQ\xe7y\vj\x1bt\xd0\x17\x11k\xc1X\xe2\xa8J\xeb2\xdb\xd2r\x83\x89`V\xea\x9c&\x90J\xc0\x13㵴4t\xf9\x1fI1\xef\x9dm!\xaf\x83\v\x15\x82\xc3D\xb0\xc8!G!\x12\xb0\x06\x01%F\at\x8e\x87*\a\x90\x95R\x01\xcd\x11\xf0\x03\xa0\xe0\xd5U\xc1\xa9\ueda1\xac\x1c\x12W\a\vt<G\xd1G\x90\x04\xd6kL<c\x8a\xafQc\xd2\b\xbb\xd1+ԏtu\x98\xd6~\xe0\xbc\x00Z\xf6\x9eڹ`\xa6\xa1\xbc\xe3\xdfI\x93\x8b\x9c\xe4c\xb8[\x80\x89\x15w5\x06\x03\x90\x84\xacY\x01D\ue942\x9d\xa3\xa5\xcd\xec\xbb\xf9\x80$A\xbf߀\x90H\n;\xe6\xcfJ\x87\x0f \x15\xcb&\xa8p\xd2'\x83\xe9\x15 \x82\xb0\x0f4n\x03\xa0\xc4\xcf~\x94+\xfa\b\x84:j`\xf5CQ\xb4\x88ء\x00\xf9\x7f%\xb9BG\x0e'T\xd0z5\xa9yg\xf5\x94\x9c\x14\xbc܀0\xb4Eo\xcb)\x01\x01\xa8\x8ar\x82\x19q\x01\x05\xa6\xf6ɺ\xc6j\x85!\x9d\tA\x85\x1c\x95\x01;\x1bV'\xaf\xca \xb1\xffP\x97\x13\f\xb9ҍ\x02\xf4Wܘg\x80:\x1f\x8bdp\x96\xf9\xd8\xd6\xd9\x00*!\x15\xae\xd7Ra\xd8\xc3\x11\x1eɥ\x15\xaad\x9f\x10\x02U\xe4\xd9\xc9*+\xb3\xa2\xc6\tomY_N\xd4\xff\xa0\x15\x81K&\xcdTM\x8bb\xaf\x19mT\x06\xa1\xe5^a\xf2\xdaY\x8f:\xaef|..\xb0V\x87\xf5\xa9\x82\x9f\xe6u\xa7\xd2.\xa0\xab\\\x13\xe2\x03\xc8מ'\xf0\xd1\xe0i\xb5\xb7\x89\xe8\xa6j\xff\xf7\xa3\x9dmx\xa9`\x99\xaet\x9aT\xfc\x8e}z\xbc\xba(K\xebe;¦\x1e\xa5\xb5pbPSqr\xf2;4\xe6\x8b\"\x00\xb4\xfbV/\"\xfa\x1dh\xf1\x83\xa7@ؖ\b\x80\x8cx\xf3Q/wd\xe1}\x81\x81\xee\x86\xed\xeb\xda\x0ec]\xac{\x8fy\xfdR\x87ߊ}\xd1\x12\x8b4\x06\x06 2\xf9\xa52p6\xcbd\xe3\xab61hO1\x19\v\xe8\xa2\xd0ceVX\xc5}1t\x99+\xc91\xd1\xf5\x12cE\xd2\x1a\x96\x03\xa0\xe4K&ʖ\xf3\xc7)B\xfc\r\xdb4q`\x92\xe9r_\xf2\x00[\xfa\xc40\x80\x8b\xf2\xd02\xc7\xe0#d\xb5\n\xcee\xaaH\xce\xd6\xda:V\xa4\xdaR\t\xbe\xc8*F\x90\xf1\x18\xbdcB\xf0a\x0f\x8f\x86\x91(\xa9\x1a\xf3\xd8\xd0\xd1\x1e\b-\xa1ο\xb2\xeb0+s\xf6\xc4\xf2\x9a\x16ږ\xa1\xda\xe4GK̏k\x88\xcf(\x93\ac6\x96\x92\x1b9r\xa2S\xfc\xc7K@\xa7n\x87%\xa7æ\xf1XR\f\xed\a\x8a\xe6\x1e7\xf3V\xd4\x05H\xfb*c_7: d\t\xf58b28\xdd,\xd1jqx\"&E\xafE\xa8\x18\xd0p\x8d\xe9ש𓋉l\xc6\xf3\x96e[SȊ\x12\xa4MH\x9d\xa9ճ\x1c\x8b\xa7\x02+@\"\xe7\x13&z\xf2\x94O\x99\xfcC\xda:\xe9\x99OZ߳eTwl空\xac\xffN²\xb2/yɔ\xbd\x1et}]\xa1\xb5\x19Hm\xefڨ'SIyIL\xea\x15E\xeb\xfd\xff\xc1\x8c\x99/\xf1\xd7\xfd\x9e\xaf*\xf1\xa3\\\x99\x82\x88\xf1\x0f\xff\xfa\xff@\xa6\x14\xed\xfa\x97d\x86t\xaaf\xce\b딅\xdb\xfa\xec.g^4_^\x83\x18)\xebݜZ\x92 ]\xe6ԔL\xc0\xf5\x99O\x9d\xa2\x1a&\xad\xa6\x93S3$\xef\x05\xb5&\x93p\xad\xe9\xe3\xfd\x9b\x84\x9a\x93\x04\x98\xbd\xa2\xef\xa4ړ\xb9\xa2\x90X\x8b\x12$`ZMJ\x12\\\xd2\xd2E\xd3\xc8\xcdP$\xee\xe3h\x7f\x00\x9a\xafT\xb3r@\xedJ\"\xc4N\x85\xcb\xcc\x1a\x96\x03əR\xd3\x12$fJmK\x12\xd4`\x05\xcah\x8dK\"\xd8a%L\xbc\xd6%\x11\xe4HEL\xb0\xe6%\x11lra\xba\xa9}I\x84\x9aP!3S\xeb\x1e$aiK\xbb\xfb\x9b\xae\xa0I\xab\xa4\x99QQ\x93X\x00q\bF\xadJ\x94)\x84\xe6U\xdc\x1c\xc0\x8b\xce\xecM\xaf\xc0\x99\x1c\x82\xabЙ]\x893\t\xb9S\xa9\x93T\x913\t2\\\xb13^\x993\t4\xb1r'\xdd\bJ\x94\xc4\xc4f\xf3*w\xdc\x1fzo\xe7\x8bDqB\xf7\xd5Y\x10\xd8\xd1\xef\xc8Fwr\xb5x\xa1\xfcV\\\xaa\xf3\xe8\xd3\xdePn\xb8T:\xb8\xd55g\xe7D\xbf\xac\xec٨\x17\xa1k\xdcp\x8a\x959n\xb73\xaa\xcb^\xa0\x16\xb9-\xc753\x15\xadH\x9a\x01\x8a\x0e\xd9I3\xf3M\x94\xe2Ĥ\x9c\xf0߄f\xf8d|\xa8\b\xb7\x12<\xd3\xd5E\xabŋ\xb4|\x87\x94C\x9a\xf9\xc0\"5\x8e\x0f\x06\xfd\xa6\x82\x99\xf3\rY$\xd2T\x9b\xdeP\xdf\x7flE=\xb1\xbc\x0f\xbfO\t\xdf\xdcq\xd9*\xb1\x1d\xed\xef\x99O\x1a\xe2\xa5\xe9馉\x05\xa4\xad<*6\xf5xq_L8\xbf\x84\xe5}\xc7\xcak\x94\xdbs\xf2.\xa9}\xea\xe2\xd9Q\xae\xa1\xea\xa8\x04\x92۾\r\xd1\xfd\x0feBյ\xfbê\x89\xe7-\b\xe8pn\x18\x1f\xc7XY\"H\fZ\xb6\xc2\x10\b\xb7\xe2\xf9\xa9$k&\xa4w@A\x84S\xc1\xa1O\xac\n\xf1\xc5\x1c\xe6\xe5{,\x7f;\x80\xfe?\x99\x9e\x1eQ\f/>\xbb\x93\a\xa25,\xa1\x8fN&\x01\xc6n\x98\"Pf\xbcƓ7\xb4\xefaj\xf3\f\v\x8c\x82N&Y\x9a\x82\x88WT\x86\xfe\x96Z\xeaX9\x1a\xdfi>K\xf2\x1de\xc5b\xa2\xd5!l\x13\xa0D\xa2R\xeb\xb1\xed\x83\xe9\xe9&MY\xef\x1e@\xe0\"\x8aՏ\xd2\xf2/\t\xac\x1f\x85\x9e8Hn\xbb\x9aR\xb2\xa6\xac\xc0\\\x92\xd05\x959\xe1\xb5ZLB\xb3IB\x85\ue72d\xdbĩ\"Y\x0e~q\xb6\x92\xc0K\xfb\x92H\xe5Q\xe8s\xbd\x0e\xcdK=l&\xcbSe\xb1I\x9cf;V\xb2]\xbd;'o\x93\x9a\x9bY\x89'\xcal\x82U\x96\xfd\x0f\x8ee\x7f\x8d\xd3\xe0\x89\x16\ar\xd9\xf7w\xbc\xa6;\x9cY\x8e\xd7I@\x89\x9b\xd0X\xa1+\xc9\x03\xa8g\x00\xad]\x1d\xa7|\x0e7}\xbe͔u[\x96{\x00\x15\\屳\x1dp\xd8;\xfa\x11\x19g\x89\x91\x04\x938\x929b\xd8\xc5\xc1U-7\x82\xa4\xb8\xae\x05/@\xa5\x92\xf7\xf5\xe5\xfc\xce\x16W#\xe2M\xb8\x8e\x00Ͷ~v\xf1u{\xb1K\x04\xcc\xca\xee2\xfb\x19\x98='@\x90:\xf8DG*\xf5\xe5K͛\xc5+\xbc1\xc5T\xaaD\xba\x9fv# \xcd7\x9a\xca$Y\x8b\x87T\x82\xa1p\xf3\xd7v\x8f\xac\xcc\xd3r\x7f\xf4\x8f\x8e\xfe\xd1\xd1?:\xfaGG\xff\xe8\xe8\x1f\x1d\xfd\xa3\xa3\x7ft\xf4\x8f\x8e\xfe\xd1\xd1?:\xfaG\x89\xfe\xd1Ԉ\xcc)̋\x03G\x91P\xd056\xc4\x11\xf8\xb6\xfe\xd0\xeepr>F`\xb5\n\xd5\x1e\xf6{\x056\xb2%\xef\x8a\xf2G$\xb77\xa7a\xde\xc7\xcd7\xbd\x8b\xba\xe7\xee-f\x12jl\xa7\x98{\xa9Ej\xdev\xa3\xeb\xd1ν\x1d\x1b\x87\xee\x14\xb3#\xec\xd1\xe0\xb5\xf6\x899\xfc\xe7\xed\x13;\xb3E\x8a;\xa0.1\xadK\x9c \x8f\xbd\xb2\xf7\xb6E\xb2\x934\xaa\x9e\x92\x18\x1f\x9a\x1d\xac_\xde|\x18\xe3c\xdd{\xac\xf7\xb5ʖ*/f~▰\x93ߝ|y\x94\x9eM\xdb(5\ad\x1a\x00v'\x83K\x9d\xf4n\x975wKȿL\xe1\x9c+\x8d1\xf1\xf3\xb2\x95@\xaf\xa1\x96i\x11\xecK\x9d\xcc\nv?Uv\xad\xb0&\xe5\x14\xc9\x02]\xa6\xce\a\x19@$ڶ\xa4r_f[\xc1K<\xba\xc1\x145\\+\xd8]\xe8\xda\n[\x04\x84U\x16\xa9\n\xf6\x1d\xd9\xf2:`\xbb\x8d\xd0n\xa2r=^\xaf\x1e?\x1e\xbdu\x00%\xee\x05\x1f\xc0\xc4\r\x04P\x12\x8c\x9e\x96\x9b\xf6V47\xe1\x14\x0f\n\x12\xba\x9c%+b\v\x96\xebݑ/\xf2\x93\x1e;-Vsef<\xba\xd8/\xf8\n\xb5\xe9Q\xaf\xdfe\xac\xaa=\xe9\xa4Ĺe\\ѩ\xf5\x82\xba\xf5\xf1B\xf39\xd5\xea\xed\x13\x12G\v(\xa7k\xd4S\x02\xc3\x13\xf5\xe8\x1dr\xbc\xe2Ɉ\xe3\xb5\xe7\xa3:\xce}\x1cՒ\x87\xef\xc9<Q]>\xb9I'\xb1\xa6|\xc6y\x88s*ɓ\x883]5\xde!MJ\xad\xb8\xad\xcd^\xa4\xd4\xfe\xbf\xfa)\x88\xaf\x7f\x06\xe2!' \x1e\x0f ?\x1e@~<\x80\xfc\x8b>\x80<|Y\xcf\xf4jX\xfcV\xf27\x8a\xa9\xa9꾃]\x85\x91\x80\xf3i\x01\xfe\xb1\xd5ܭ\xcd\xca}\xb7\x11P\x04\xe9#\xd8\xf6\xc0\xb1 d\x12>\x86\xcc$\x11\x14\x7f\x84R\x92_~q?\xff\xfa\xeb\x19\xf9\xe5\x17\x1b\xab0_\xf06\xa7_\x7f\x8d\x1d-\xf0\xcb/\x18\xb2\xfd\xf5W-{\xe6\x8bTtW\xe1/\x02\x1am\xfb\xb0\uf5697g\xb7\xc5@\xb7Ώ\xeb\xf4s\xb1l}\x97X#\xf4?\xdf]\xe2\x81y@\xbe\xfa\xfd۷\x7fz\xfb\xee\xedￎBF\x17\xe6\xabw\x7f|\xfb\xcd\xdb?~m\x00\xb8q7\xbd\xdd\xe3\x86\xc0\xc8\vK\xcc\b`\xaaV\xe4Z\x9dڹ\xa6\x9d$V\x0e\xf8\xe7\x10\x97g-VZ\x0f(6bNN\xfe\xec\xfa\xfde\xf9g?\u07bf\x9c\x98\xf4\xe3i\xf4\xa0\x9bI\x01\x1e\x11^>\xef\xb0\xff\xb9\xe7\xfb7\xce\xd4\x00\xae9jk\xbe3\xb5\xab\vŪBמ<\xb1<\xc8)\xb5\x85\xbd?9-~G@\xff\xe2(I\x9e\xa1(\b\r\xa9\xca\x01\xe6\xe6R\x80\x91+\x00Ό\x8c\xe8\xb3BB\xe9y\xb5\x85\x1d\x9eQ\x1a?\xe21jj\x8c\xbb;\xc7+\x03\x8eW\x06\x1c\xaf\f8^\x19p\xbc2\xe0xe\xc0\xf1ʀ\xe3\x95\x01\xc7+\x03\x8eW\x06\x1cpe\x00\x179\x88\xd1\\\\\xaah\x8e\neG\x1c\x7f꽳\x97\x99\xb2\x06\xb6\x1eYǔ\r\xbc\x94\xfb\xf3\x882\x82\xd7-\x1b\xfeaD\xa7\xb5\xee;\x00\xc6\xfd\xf4\x86H8?\xd5Xy\xf6\xd6e\xec$\x89\x84\x8a\n\xe7\x7f\xeb\xd2\x1f\xb9\"ﱦ\xa9\v}\x1b\xf4+\xd6\\\xec\xa8\"'>%\xfb\xc6\x00\xc7\xef'+B\xbe㾨\xa4A\xf7\x8cH\xb6\xab\x8c\x03\x1a\x80y\xd2\x06q\x98@\x04\x85Ͻ\xff;}\xb8\xd3\r/X\xb6?\x1fg\xe8\x87@\x17G\xfc\xb6\xcb\x1fj\x17O\xd2\xda\x00\x81\xa7\x99U\a\xee\xd0)4SL}Y\xdew \xef\x82\xeb\xbb\xeb\x88\x1e\x12+ۢƔ\x84bm\x0e\xfd\xc6s\xbc!\xc7\x03\xe6\x8dG\x85#\xe1\xa5\x0f\xd2\x04\xe0V\x9aD\xabŌ\t\xe1h<\x8b\xba\x96\xae\xdd\xc9\xe2\x8f\xc3o\x95\xbf\xe8\x01\x85\x8d\xd9\xf6y\xf9\xb64i͋\x82?/\xe6\xd9\xe2\xb4b\x7f\x15<tB\xfd`\xf8\x177\u05fa\xa9\x13\x88\x8d\xfe\xe2\xca\x16\xfd\xa0͑\xfd\r:\xabE\xd4|jC\f\x94\xd4\xfa\xafZ#x\xab(xl\xb7u\xd1I\x86\x9b!\xf1~~=\xba\x95\x9e\x90\xb8\x7f\x86\xdb+\x10\x98ȗ\x15\x15j\xafU\xa9<\xf3c\x88\xc0\xd4\x19\n\xbd\x88D\x10\x19Ֆ\xa1\xab僴u7\xcc#\n\b\xb1\xad.\a\x14=d\x1c\xf1\x83,&\x8f\xb0x\xc5q8R\x0eG\xb2ԔZ$\x16&\xbeZ$[ګR\xf0\xfe\x8f\xab`D\xbbC\x9e\xdb^\xf3@I\xa1\x83h϶\x8fm_x\x00}\x91H~\x98\xbe\x0f\xd7\b\xb6\x91\xf9\x00\xfaJ\x91\x1f\xb8\xb9\xee~\x06^\xbd\x9e}i\xc0\xc0T\xc6\xcb\xdc*\x9f\x01\\t8\xb8\xa0\x1b \x85\x83л\x9e\xc5\r\xb3\x17.\xf7\xf1i}\x13J\x88f\xe8\xf3\xae\xedU\xa3{\xdd\xdc_\f\xd2Y7\x10\n\x97Lq\xb1\xef\xbe\xe2T&\fwE~\xc2@`\xe4\xe2\x97f\x84\x86,\x1e\x99\xd5b\xc6Lp\xbd\xecm\x0f\x89ܱ\xad\x03B\xe7.\xba\xf0\xa3\t\xc70Q\x11\xdeܟ\xb6\xee^\xf1\xf1p\x1bJ\xb0\xe19_\xd3\xe2\x1e\x7f\xfb\xfa\x15\xad\x96\xee\xa9\x12\xdamm#aZ\xdb9\a\xc0\x95\xbc{I\x1d@$\x16\x8f>\xb0f\xd7VwE}\x00-̐\xcfb\xaebz\x1b\xfe\x04Bw\xcc\x16\xe9\vZJ\x9dy\xed\x18͈\x13z-h\x11e\xfa\x16,'\xa8\x03\xb0\x84d\x05\x95\xadC\u00ad\xbdk\xdb\x13\xda\x01L7 g\xf3q܆h\xa1\x10z\xdcG\xbc\x850\xb5dwCu\x88\x04\b\x11\x04l\xf2\xa1\xcd\xfb\xb5&\xb0\xa94\xff\xa3\xc9\\\xe0o;.\x15\xc9\xe9^\x12(h%ݭF\x11\xd0vc\x03n\xc2@(\x1dM\xe2\u008c\xab\xc5\xec\xf8I\x87\x18F\x1eQ\x16\x1a\xb2\xb4\x86>\x87\x12.&\xd8&%\xe1\xee\xd2&\vcK\xa5\xdfXbo\x03jvnE\x01#\xc9\u0098N\x89F\xd3?\xfe\xb4G\x92+\xe4\xcf`S\x19\x82h\xb4\x7f\x8b/#`I\x8fg:y\x15 \xe8@\x88V\x8b\x17\xee\xd7JۥeYu\x89\x9cJ&\x8f\xd5]\xba\x93#S\x8f\xe7m-0\x02\xd6\x0f \x89&zb\xc1j\xb3\"\xb7w\x17?^]|\xb8\xfa\xff\xd7\x17\xa3й \x7f\xfd\xe1\xe2\xf2\xfa\xfd\a-h\x17\xff\xb8%\xb7\x7f8#\x97\x9c\x17\x18!\xbd\x10ٖ=\x81y\xf6\xa9Ƴ\xf9\v\xfe\xe0\x14\xfd\x18\vFtﴡ\xe9\xecJ\x14\xa8\xe8CK\x18M\xe4H\xa3Q\x13t<T3n\a7D\x97\x8b\x19/U*\xb0\xbd\xaf#9ww?\xa0\xc0P\x9d\\_]զ\xde\x17\xbd!\t\xa8\xfb-E\xad\xb8=\xe0?\xb7\x01\x7f\x92\xe8K\xafZVAk\xb5\x14\x80\v\xb1\xd1,\xab\xc5\f\xbeYCN\xdc!U\xc7\xd1\xf8\xb9մe\n\xb5='\xb5\xf5\xa6\xa1>\x96aK\xcb<\x18\t\xf5\x96\xa9&\xfa\xda\xec_mn\a\v\\\xa8&\a\xb7`F\xc06\xef\xc7qf\xbc\\\xb3M-\x9a{#:U\x13>\xfb\x1d\xce,\x87\xf7\x15/1P\xa0\x021\xc4%y\xe4\x15\xa3s\xe8\xffD\v\x96kyH\x8ad\xdc\xf7\x9a\xf7\xf8\xd02/\x1b\xc0\x93\xd1\f\xd4\xc2\xd9\x16\xb2GwџT\x03\xed\x8d\xfb$Y\xc9$&\xa3[W\x8c\x84\xe39z\x19^\xcc[\xb0\x8e\xf1\x90c<\xe4\x7fq<\xc4\xe8=-\x00\xce\xeb\xd4\xf9\xa0\xefC\xa9\xfa\x0e\xa5\xee\xe3=\xbd\xe0\xf6\xb3\xf7A\xeb\r\x9b\xdc\xdc_\xeab\"\x1d\xc4\xc3N;3\x03\xf0\x8e۶\x8f\xdb4\xf66\xbe\f\xd1Ǧ\xa0]\x0f3\x02\x86g\xa35AiT?\x188.\x89\xe2\x1bsc\xaa\xae\xd0\v \x16\x92\xfc\xfe\xdd\xc8\xee\x94\xfb\x97i\xfe\x11\xe9y\xea\xdc\xf5\xeb|Y\x99ĦA\xafVYL˛vE\x8d\x03\x90$\n\x87J\xc93\x86\x01\x1c\xc7\x12\xe6.\x8a]-\x92\xfd\xa4\xd1I\x133\xac\"\x93\xc0\xdc\xe1x\xbe\x88\x92\xc4\xc5\x04\xb0\x19\xc9h\xa5jaױ\xac\x16\xfa\x0e.{\x8f\xb2\xbe\xb3\xcar/\x84R|ey\xf0ۭ\xfcf.ya\x8e\x18\x83|\x82cߎ\xf5\xf5:\x92+Z\x8czrv\xc7>\xae\xad\xb8\x11\xcc\xdan\xe1\x1d`h\x92\x8f2n̿\t\xe1z\xe9\\\xce\x03p\xf5}\xd3q\x95u\x86\xe7\x00\xafk\xbc\x11\xb4qw\xd3\x11\x0f\xc0|-R\xe0A\x97\a\xd1\xc1t\x8c\x10\xc105\x1a\xf0Jb\xb3\xdd+\re\xee&\xef f\x87\xff\xe9\x93F\xe7\xd1\xc1fD]\xfeK\xb6\ueb5e\xa2\xc4\xe5HWG\x8b\x86\n\xf6EI\xd7[?\xc3\xcc\xfb\xadm\xb5o\xc8\xe0\x97\xbc\x89I7\xf6@s\xeb&PQ0\x10\x16\xa2\x8c\xdfp\x1d\x80\x1d\xb9\xf3z\x94\xde>:r\xe7꒧\xc8<\xeca/\xeb\xb6\xe2\xc6v\xadk\x94\x9f\xdbQ\xa4\xe1\xd0H\v\x9cv6\x91Q\xfe\xeaox\x82\x12\x97B{\xb4\x8fw\xabz}\x02P\xdbP\xecq'\x86n.\xf2\xeb\x18\xa6㟦\x82\xc0\xac\xb2\xa7r\x04\xa6\xbf\x18;@\x84\xa1&0\x15\x00\xe7\x98\x12\x80e\x10hRL<\xb8\xb6e\x92u\xd7\xd5\xe4E\xe2\xf2\xf6:\xd63\xaa1\\\x83\x01d\xa2\xed\xac\x1e\xbc\xbe\xb6\x98)\x91\x03\xcc,\xb1\x0f\xc0\xcc\xf7\x8ca\xd6V\xff\x03\xe0~v@\xfe\xfah\xb6/\x8a\x9e\xc0\xeb\xaa\xd5\xd4!\xd2\x14r7\xd2|*I.\xf6KQ\x97\xab\xb9\x926\xee\xe9b\xec`\x87j\x14\x13\x99\xb7\xec\x13|\xbbWᖽ\x91\xbf\x0fvt8x\xb0\xe6^\xefh\xb1Ec\xc2\xda\bL\xc2\x05\xe0\xee8\x0f\xdc\xf7A\x8b\xac.F\xb6~xݛъf\f\xa9\xe0\b;\xbc\x8c|H\xda\xf6Tg\xa5\xfa\xd37\xc1\x16c\xb2н\xf6<\x9a\xe9\x1b\x90\xf7\xa6\xdf\xc7Q֕3u\xb6\x1a5o\x18\xa5\xb1L\xa4/0\xed\xf8\xe4L@\xa6\x82\xb3\xc7Fv\xd5V\xf0z\x83\xf6}\v\u0600\xb0\x98\x85`\xbb\by\xa3\xd6\xff\x84\x96L\x92\xfd1G\xc1\xf9\xde֤8_\xbc\xb4\x90s\x14\x93\x04\\\xa6\x86ړ\x10o\f\xf5%ã\xd4\xe5v\xe4\x9d1\x19\xd0Nw\xb3\x05\a\x8bi\ue471x\x86XibOa\x86\x12SG\a\xa5\x12{\xb2\xb5i\xc7a\xc5\x1c\xfe\xeb\xe4\fS\x00\xba\x8c\xeeD\xab\\k\xb9E\xe0\xf6\x0f\xe9Y\xbdL$\"\x81\x92\x91\x87Pfb\xaf\xc9\xff=쯯\xce\x17\xa3\fz\xdfm\xed\xd8t}\xe5f\xadߺ`\xe1B\x1eђ֢\xd1\x1a҆\x0f\xb2\x82i\x9f\x94\xe5\xe0\xac \xa6\xb4I\xe6\x8c\xc8n\x15\xdd\"\x9e\xf7iJ\x1e\xdec\xd0\xc2\x1e\x93\xd4t5\x9a\xd9\x1c\xfa\xeeG\xbaZ̐o\xed,\\\xe2vn.\xd8ˋRc\x9a\xb6K\xfd\xee;\x91\xfa\x94d\xfe\xc4B<\x14\x0f[\x90\x1dH\x89\xd9\xf0\xb0\x0f\xa9\x8f\x01G\x0fe\xc3\x05^/\xba\x82\x15\xa9\x8az\xc3\xca3B+v\xc9\xcbu\xc12u֊p\x9fiz\xddP\xb5=s\a\xe7\x05\x00c٪\x8e\xbe\x9e\x11ɛ\r,\xb4V|\xa7\xc1\xe8]f9d\xc8`W\xe0A\t\x06i\x19\r[4\x1dSZ\xe3\x9beP)\x9c\v\xab\xc5\xccY26\t\x90l\xf2<\x81\xfa}\xa2㾱\x1e\xd1\x1b\x1fl\x03%\x88\xc8Jn\xf784\xa7\x18Z\x0eZ\xe3\xcc\xec\x7f\xa3\x99\xc2M\x88\xfa\x05\xeeH\x96\xa9\xaa\x9f\x82oLv\x87\x95\x16Y7+V\x8b9\xb2\a\x1f+&R\nX\xde\xfb\x86H\x1b\x9b\x8af\xee$\x1e\xfc\r\n\xb6a\x98\x87C}\xb8\xa1\xe2\x81n`\x99\xf1\x02761^\xae~SWĞ\x15\xf9\x01\xa8\x9cD\xed\xbbv[\xbbiG3\xc3^#J\xb5\x87\x85\f\x81R1\xe1\xf82\x00\xaa+\x18\xf0ūY#\xd5T\xb0+\xd4\xd4H\xdbm\t\xeb\xe8:\xbbP=\x99\x87g֪\x19\xbe\x0f?;\xfaO.\xceȎ\x95\xf8?\x9c\xd1zW\x8d\xeb<k\xfcx\xdc\xe9\x8dM\x8dM\f\xffo\xad\xa6\xbev\xa6c\x0eZ\\\x10\xe6X\xbe\xcd\x14`c+[~\x9d\x0f\x96\x8d\xd5\"\xd9\xca\x19A.Q\x04C\x86O\xb5\xa5r*\x17|\x83m\b\x1bFk}\x1a8V\xb8\x16K\xa9\xfe\bÌ\xb7\xb9\x9d\x06\xf2&\xeb\x19hr]\xde\b\xbe\xc1-/\x81\x87\xff\xa0\fO\x9d\xfe\x8e\x8b\x1b\xbd\x844Q\xbdY\x8do\xdc\x1a`\xc6\x13\xe8\xfb\x1d+i\xc1>\x85\x18\xd1~8\r\xc8;فg\tÈ=\xb8\x02\f\xb0\x04Gg\x1c\xe2\xf8{G\xa4LK\xfa\xfe\x9eq\xbb}rJjz\xcd{\xc7\xd0\xd9\rF\ti\xeb'\r\xa21\x95\xed\xec\xfb\x8a\xadM}X\x86\x82\xff\xf5\xbf\x7f&Y\xc1\x9c\"\x8bm\xd6l\xa8b\xa5Q\x8aH\x06\xfa\x80G\xcf5X\x9e\xcafU\x1e\xc0m\u07b9\xc2\x03\x9a\xc0\x999\xac\v\x13C\xcf \xd5\x12\xd6k.\x94ـ\xbf\\\xe2\x01\n\xd1\xd3\xc3qyЩ\xc1\xba\xc2\b\x1c\xe6\xdc\xdc>HG\xfe\xb5Mq\v\xbd\x1e\x9da\x93\x1d\xdd\x1b\xaf\x97f\x19ֽ\xc0\x1b\xa9h\x01\xaf\x1cQ\xd1lE\x85\x03\xf9\xcf)\x05\x04\xd7\xed\xf6N\x8b5a\xacV\xacZ\x1fko̜\xa8S\xfe\x80\xe7i?\v\xa6\x14\x94]UN\x14\x1a\x13E\x81\xf6\xe6\x9a\x06\x8e\xec\x9b2r\xf0\xa3\x83l\xd71\xc1\xedav\xe7\x1b\xc7bt\x169\x8ely\xd0$\vB%\x04\xad<\xbd\x01\xd6\xf6EV\x9a\x98\xbd\x8bA8\xb9\x8c\x18\x89\x11\xb8y\x8d\x83\xb2&\xbd%\xb3\x00U\x8b\xb2\xe5\x93\xd8m\xf1yk\xb84{\x8c\x8e\xd4n\xf4ղ\xbbb\xfc\r|\xd4\x1e\xf6\x12cQK\xcb\v\x9d{>\xb3\x9b\xd7\x04\xc3\xc3\x18\xb57\x10\x01\xea\xf6:Y1\xa8*<\xccP\xda\xf1$\xdc\xe92\xce\xd6\x11c_**\x94\x8f\x83\x9f/F\xf9}\xdbil\xa3\xf4\xb1́\x86\x1c\x1e\xef\xadݜg2'\x97x\xb6K;%q\xe6\xb34\xd4\x1dMiD\x01\xf7~bt\x00\x8b\xb5\x83\xc1\x81A*\xa0\x13\xf8\xef\x0e_\xfe\xa6\x86\xf6x\x11h\x8f\xcaMS7\xafFJ?ݳ\x01P\x92Z\xf0\xe9\xd65[\xd1\xde\t\x13̀j\xbd1w\xa6hp\xc8ѹ:\x88U\x1cJܦ\xc0:]\xaaG{\x0f\xc5|4\x86\xe3I\xf2\f\x01J\x0fx\xb9\xfaM\xa5\xb0\xb1wާ8\xf9\x8d%\xdcv\xf7\xbd\x05\x85\xee~˂Ҿ`\xc8~\xfa\xd2\f%\xeb\xbeM \x7f:\xea?j\xd7\xd0;\x82\xe4\n\x8f\xc1\x8c$\xba\t\xb9)\x00\x1d\x18\t\xd0uMO\x17s\xf4\xb8I\xa3\xd8}[\xe9E@\xed\x0eޛ4)(=-\xddN'\x97]\xc5\xe0\xd6\x00.\x19\xdf\xd4u*\x9b\x04\x84\x15r\xdbP\xf7s\xbb\xa9\x02`\xdd|\xc7s\x99t\xca\xc6\x02\x9a!$\x1d\x9c\xd1Ϊ\xab\x01\xe6\xc3\xd4[\x0f\xed\x00\\\xd2\xde\x0f\xe6\x10ǮԎ\xd1\xfc\xdb\xca\xc5L\xbc\x1ḃ\x98N\x19\xa0N\xd7X\xc5媲\xc2M\x83\xf4\xe9\xf5\xec\x97>\xb6\xc4\xdd*\xab\b\xe8\x06\x8b.\xf2\xf6\xfc\x12\xa4\xaf^aB8N\xce\xef\xe4\x12\x87\x00\x9a\x97\xc3~A=\xee\x87\x19vol\x18e\xaa\x0e\"Mm'i\xadD\xba\xa0d\xfe\xac\xf3\x00I\xe4\xb8\xf2\xcdC\xacn=Չ\xd7\bDt\x0e\xf8c\x87\xd1\a\xf3\xd5Ƈ\x93\x06\xffw\xd3\xd6\x1d\x0en\xbe4~j7\x9b\xde\xe2\xe7\xc1\x83\x8b\x84\xa4\xa6\x02S\xb3\a\x12\x0eN\xb9@\x89\xd3^Q\x97)\x1a\x7fIE\xf3)KC\xf2\xfe\xb2-5Mvϡzs\x7fi3\xf61E\xda\xde\f\xaba\xe9\x02\xdc\xe0\xee\x91\xc4\xc1;h\xd7WI8\xb8\u008fP\x96\xcer\xca}u\x90#`{w\x12D7!{3oL\xcf'\xa0\x1a\xaf\x1dG!\t.\x04\xc1\x96\x8d\xc6\b>\xd62\x1f~\xf2\x14*8\x1cq)_b\x99u\xabtRˢ\xee#\xddbQ\t_$;\x00\xeb\x86@\xe4\xebT\n\xf5\x10\xf2a\xcfy\b\xf9n/.\x85\xfa\x1c\xd8݃`k\xab\xead\x12b\x9d\x1e!\x9b\x14qĐ\xab>\xc1S\xc1F\xb0\xe0\x01\x96O\x1d8\xb6_\xc0h\x8bZ\xab\xa1\"\xcf\u05f7C\xdb\xe8\x0e\x17\v\x8d\xc4>\xa6\xe7\"\x18\xc5\xcc\xd0C\x8cI+\x1d\x9f\xcf\xc6j\xb3\xe9hd\xfd\x17\x18Ym\x86\xfe{\xad\xac\x94\x91\x8c\x9bYfrF\xad(L\x91\tQWG3\xecs\x9ba\xcd\xc0\xda\xf6U\x04*i\xd9]\x9fŰzesi٢\xd4ofM=S\x81\xfb\xbb\x02j\xbfÔ\x7f\xd8f\x81b\x1e\v\xc1)\x04\x9b\x9f\xc0\xc8\xe6\x00$i\n|\\\xaa.\x92\xa9Y\xb5\xaby\xdc\x18\t\r\xc2\xec\xc8©|\xa5z\x9e \xb9\a?\xeaDBޢ\xba}\xd39Q\xa2\x86\xc5\xff\f\x00\x89\"4\x14\x17\xce\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xd4Z_o\xe4\xb6\x11\x7f\xdfO1p\x1e\x9c\x00^\xf9\x92\xb6A\xb1/\x85\xcfv\x03#\xbe\xb3a_\x9c\x97>\x84+ή\x18S\xa4JR\xbb\xb7)\xfa\u074b\xe1\x1fI\xbb\x92V\xeb+\x92\xa2'\x03Ɋ\xe4p\xf8\x9b\xffC\xcd\xe7\xf3\x19\xab\xc4\v\x1a+\xb4Z\x00\xab\x04~v\xa8\xe8\x97\xcd^\xffj3\xa1/7\xdf\xce^\x85\xe2\v\xb8\xae\xad\xd3\xe5\x13Z]\x9b\x1cop%\x94pB\xabY\x89\x8eq\xe6\xd8b\x06\xc0\x94Ҏ\xd1kK?\x01r\xad\x9c\xd1R\xa2\x99\xafQe\xaf\xf5\x12\x97\xb5\x90\x1c\x8d'\x9e\xb6\u07bc˾\xfd.{7\x03P\xac\xc4\x05,Y\xfeZW\xd6i\xc3\xd6(u\x1eHf\x1b\x94ht&\xf4\xccV\x98\xd3\x0ek\xa3\xebj\x01\xed@\xa0\x10w\x0f\x9c\xbf\xf7Ğ\x03\xb1\xfbH̏Kaݏ\xe3s\xee\x85u~^%k\xc3\xe4\x18[~\x8a-\xb4q\x1fۭ簴2\x8c\b\xb5\xae%3#\xcbg\x006\xd7\x15.\xc0\xaf\xaeX\x8e|\x06\x10\xa1\xf1\a\x99\x03\xe3܃\xcd\xe4\xa3\x11ʡ\xb9ֲ.\x13\xc8s\xe0hs#*\x9a\x92\xce\x02\xf10\x90N\x03\xd61W[\xb0u^\x00\xb3p\xb5aB\xb2\xa5\xc4˟\x14K\xff\xef9\x06\xf8\xd5j\xf5\xc8\\\xb1\x80,\xacʪ\x82\xd94J\b/\xe0\xb1\xf3\xc6\xed\xe8\x00\xd6\x19\xa1\xd6C,\xdd3\xeb^\x98\x14\xdc\x1f\xf9\x93(\x11\x84\x05W Hf\x1d8zA\xbf\x02B@\x10!$\x84`\xcbl\xdc\a`\x13\xa8 \x1f\xe5T\xf6\xf6\x8aS\x03\xdb\xc4\n\xbc\x1cP\t\xfcӛ\xc8}\x87l\xd2\xef,7ؐ\xb4\x8e\x95\xd5\x1eݫ5\x8e\x11ۃ\xe2\x06W\xac\x96\xae{T\xb6n\x0f;p\xac\n\xf3\x8c\x87Uq4\x9c\xe4f\xef]\xd8u\xa9\xb5D\xa6f\xed\xacͷ\xfe\x87\xcd\v,\xbd\x8d\xd2/]\xa1\xbaz\xbc{\xf9\xd3\xf3\xdek\x18R\xa4\x03\xa3 \xc1\xb1\x8el\n4\b/\xde\xfe\x82\xdcl<ZC\x13@/\x7f\xc5ܵB\xac\x8c\xae\xd08\x91\x8c%<\x1d_\xd4y{\xc0\xd39\xb1\x1df\x01''\x84A\x8f\xa2\xbd \x8f'\x05\xbd\x02W\b\v\x06+\x83\x16\x95\xeb\u009b\x1e\xbd\x02\xa6\"{\x19<\xa3!2`\v]KN\xbek\x83Ɓ\xc1\\\xaf\x95\xf8\xad\xa1m\xc1騼\x0e\xa3\x8bh\x1fo\x9f\x8aIR\xd5\x1a/\x80)\x0e%ہA\x02\x01jա\xe7\xa7\xd8\f>\x90\xbe\v\xb5\xd2\v(\x9c\xab\xec\xe2\xf2r-\\\xf2\xc1\xb9.\xcbZ\t\xb7\xbb\xf4\xeeT,k\xa7\x8d\xbd\xe4\xb8Ayi\xc5z\xceL^\b\x87\xb9\xab\r^\xb2J\xcc=\xeb\x8a\x0el\xb3\x92\x7fe\xa2\u05f6\xe7{\xbc\xf6\xac6\xfcy\xafyD\x02\xe41\x83\x16\x84\xa5\xe1\xa0-\xd0B\xad=:O\xb7ϟ m텱G4\xa9E\xbbж\" \xc0\x84Z\xa1\xf1\xeb`et\xe9i\xa2\xe2\x95\x16\xca\xf9\x1f\xb9\x14\xa8\x0e\xe1\xb7\xf5\xb2\x14\x8e\xe4\xfe\xcf\x1a\xad#Yep\xed\x03\x13,\x11\xea\x8a\f\x93gp\xa7\xe0\x9a\x95(\xaf\x99\xc5\xdf]\x00\x84\xb4\x9d\x13\xb0\xa7\x89\xa0\x1bS\xdb\x7fDe\x11Q\xeb\f\xa4X8\"\xafA+~\xae0߳\x1f\x8eV\x18\xd2p\xc7\x1c\x92\xf1\xb0=\x8a\x90L|\x90\xda\xde\xd4a㦇\xe59Z\xfbAs<\x1c9`\xf9\xaa\x99\xb8\xc7c\x85\xa6\x14\x96L\xdf\xc2J\x9bÈ\xc1\x1a\x0f\xdc}\x92\xa7\xcazc\xa8\xea\xb2\xcf\xc8\x1c\x9e\x90\xf1\a%w#C?\x1b\x11={\xf7\x99\xc3]Yis\xa8\x8d\xa3\x12\xa6\xbf\xc0\xfb\xf3N\xe5\x8fh\x84\xe6\x13\xa8\xbc?\x98\xde`S\xe8-\xac\xbc\xbe+'w\xe4\x9c\xecN\xe5\x91|\x8f&\xc0\xd5\xe3]ԢhY\xd1\x10#\x88\x19\\E\x93\xd6+x\a\\X\xca\f\xac'\xdaGQ\xd5\xd2g\x11\vp\xa6Ʒ\x1c?\xd7j%\xd6\xfdCw\x93\x9d1U\x9a }\x80ܵ߉|\x16\xa9Me\xf4Fp4s2\x1c\xb1\x129y\xfa\x95X\xd7\xc6+3\xac\x04Jn\xfb'\x1d1?\xfa\xcb\rrTN0\xb9\x98ङH\x9b:&T\b_-\x01\xef\x85L\x19c\xadr\xa8x\x93\xa6t\x1f\xa7\xbd;\xb3\xc8a+\\\x11\xfcdR\xf6\xde\xfcq\xa3\xa4\xe7\x15wC\xaf\x0fx\xffT \xbc⎜\x03\xb1l17輶\xa1\xa4\xc8F\xaa\x94\x01|\xa8\xad#\xd6\x0e\x1dH\xfa\xe73\xb8\xb4\xfa\x15w}\xa0'\x85\x1bs\x9bi\x96\xcf)\xa7N\f\x1b\\\xa1A\xe5\x06\xbd=U&F\xa1C_\xf5p\x9d[\n\xb69V\xce^\xea\r\x9a\x8d\xc0\xed\xe5V\x9bW\xa1\xd6s\x02|\x1e-\xe8\x92X\xb1\x97_\xf9\xff\fr\x04\xf0\xe9\xe1\xe6a\x01W\x9c\x83v\x05\x1a\xa8-\xaej\x99\x14\xad\x93\xf8\\\x00ň\v\xa8\x05\xff\xdb\xf9l\x80\xd2\x14.\xdaˊ\xc9\x13\xb0\xa1\x10 V;\xd8\x16\xe8\x99\"\x88\x9e\x83T\xb4\x01\n\xa1$\xec2J3\xf8\x1a~DV\xddԳ\xfb\x8f\x1c\x13\x85\x96>KsR\xa7\xb7\x98\x19\xc0\xe7y+\xa8yɪy؛9]\x8a\xfc`v̙\x17\xb3\xa30\xa4|\\(.r\xe6\xd0\xee[R\xaaS\"\xb1q\xa7\x1a\x9dg\xb30\x9b\xbd\x05\xa6\xa0L1\xacNp\xfcН\x9bB0Dg\x16C\xa5E\xe7\x84Z[PH\xa1\x94\x99>\xceޅ\xe4Z)\xb2]\xa7\x815\x8e\xf1\xdcF~ҡ\xb27\xfa\x93e\x9d\xbf\xa2\x1b\x1a98\xca{?1a\x1c\x96\x11[\xb5E\x1f\xe1\xa7\xd88\xc1\"rv\x8d\xe6\x14^\xae\xafhb\x13T\x19\\_\xc1\xb2V\\b\xe2h[\xa0\xa2\xc2\\\xacv\xc3{\xd1\xf3\xe9\xfe9\xa1\xea\x13\x95X*$l\x87\xcf\x10<\xfe\x02\x96;\x87_tȂ)҅\xf5)\xe7Ls\xa1D\x16\xc3OU;\xeb\v\x15\x8e\x12i\x9aMN3\xf6,\x06\xc9\x020Cn5׆#\a\xa1\x80EN@\xea5\xfdn\xa5z\xb1\x97\xadQz\xa2\x87R\xac\x98\xb3\t\x9f\xb8S\x8a\xef\xc9\xf1\xc4\x06,w\x9d\xd7~\x17\xe2\x9a\xfa8\x16\x98\x9448B3Q(tm\xe4.\x83\xab0\xbb)]c\xe1\xb15\x82\f\xa7\xd9\xd0\xe9c4\x83\xc2z\x1fi\xeb\x8a\xf2\xbf\x03\xf6\xb2/p\x98\x00\xa8r\xb3\v&2-\xcf\xdbfr\xa3\xbbmq4\xb7\x82c\x87\x1e\xe8\xd5 E\xe8\x8a\xc71\xb3dR\xda\v\x028\xe8ES\xc6\x05\xa8\x97\xb8\xa2\x8a\xd5\x15\xb8#\x1d\x18!YWR3Ҍh\x03A\x13\x86!\x99H#\xa7}N\xccc\xeen\xc6\x06\x0f`\xfb\x11ww7\xc9\xf3\xdc\xdd$}\xa7\x98'T7\xc1\xa9\xedH؋\xb8\xe9\x04/(\xdcF\xc7i3\xf8\xa4\xc1P/\x14\x13\xd9\v\xea\xe2\x01\xf3\xb3\x86\xe2^\xfb\xcf\xe9\xee\xfe\x04\x7f\xa8Z#\xab\xc1\x94\xe2Fi\xf3\x98\x06\x1e\xa1ʠ2\xb8\x11\xba\x0e\x81\x9dL\xd7:!%pL\x14\x18\xc5=\xb5\xa6\xee\xa0+\x98\xcfΠW\xb5w\x9fW\xac\xdc>\\\xc3\xd2=\xc1\x85E\xf9\x85\f\xe4t\x19ƌ%\xcaQuҽ\b_\xe4.\x98\xf9(\xd9\xd86\xa6\xeek8z\xa1%\xb7\xb1\xe5\xd0\x18\xcf+\xeel\x06\xb7,/:\x89\xf0\x11\x9a\x91\x05\xaa\xe4IӘ_uw\xe3-\x8a\x12\xacPe\xf9\x91\xef\xfe\xf2\xfd|)\x1c\\\xdd>\x8f'\xc5'\xc18\x9eo59\xd7\xdd\xcd\xf8X\x00tp\xfchf\x06\x80\x9f+\x11J\xa8\xe1\x12\xbf'\xbe۽\x05\x8d\xf7\xa2r\x96\x80\xf7\xb0Eaz\xda\xc8'|\xbbo\xf7\x95z\x83\xbc\xed\x18E\xa7\x03gA\x03\xce\xe0\xebN:\xf7M\x88\x81#dch\xf01\x11\xed\x9e\xd5\xed\xb3\x95\x18\xc8\xe0\xec^\xac0\xdf\xe5\x12\xcfF\x88\xb6A\xf7\x80V:\x04\x19\xa6c\xebu[ء0\x1dpG\xe8\xfa\x1e\xba\xcfP\x92W\xf6\xb1̡\n-H\xdaQ&\xe6\xa0\xd2R\xe4\x02\xd3\xe6\xc7\xe3[\xc0\x94\xe6\x95@5Q:\xf6E\n\x9e\x14\xca\xf7`\xca\xfde\xd1\b\xd5\x14KFQ\x1c\\7ܭ\xa1g\x1e\xd9\x18\x19l$2\xfb\x02s\n2\xba\xd7\xf9\xeb\t\xfa\xfc\xd0Lދĩt\x88ɬ\xd4\xf9+|\xfd\xf3\xc3Ӈo\x06IR\xc5\xe4\xa8\x17\xa0U\x02(\xa5P\xdbB\xe4E\xe7\r\xb0\xaa\x92$E\xa7\xbb\x1au\\\x9dI+\x90\x02TK\xc6'0\x05\xdb$\n\x81GT\x14\x90[-\x1c!kYٞ\xb1a\xbd\xd1\rNUw\x02B\xe2ޱ\x8e\xe7\x969\xe5\x95B\xad\xe5\xeewL\x19\xcaQW\xd5\x13/y\xb5\x940\xb4\"\"\x02\x03\"\x99\x0e\xefQX\xe3\x9e~\\\xe3\xe9\x99\xc3\x0f\x0f/\xb7O\x1f\xaf>^\xdf\x1e\x99t\xfd\xf0\xe1\xf1\xfe\xee\xe8\xa4Ɉ\xd2\xd1ȱF\xe5 `O\xfb\xab\b;\xf2\xed>\xc5\xe8 \xe0}\x15i\xdc\xd1<\x8b\xad\x1c\x9a\x9eo\vj<\xa2X\xb4\xa1W?s\x94r\xad\x9c\x90\xd1\xcdvY\xaaU`*\xfbr\xe4\xa6b1)\xcf\xc8\xd0\x01\xe4_\x12\x90+\x83+\xf1y1\x9b\x14ԣ\x9f\x98t\xbbb\xae\x00\xa1|\xe5\xc0\x06\x8a\xf2\xa3\xa9T*\xd5\xe1!v\xa2\xb2ٛ\x91\x1bGm\x1eٙ\xbd\x01\x89Ty/f\x13\x18\x84i\r\nqپN\x8d\xb7\"\x8e\x9c(\xdeY\v\xad\xfeNGC\x95\xef&\x98y\xe9\xaf8\xd2\xedOw\xe2=\x9a\xa1\xaa˵1h+\xad8\x15\xb6\xd1\xc3O\xf4\xfa[\x96\xb3\xd9\x1b\xfd\xee(\x10\xc3b\x9d\x83\xee\xf6\xb3\x0eƒ\xf0f'\b;\xdc\xff/f\xa3\xa8\x0e\xde]=\xfbU{\x01[/}7\xa0\xbd\f\xdb#\t\x7f\xcc\x1d\xd8Y\xe7\x12\x8c*\x04\x05\xb5\xa2R4t\x8d3\xf8\x87\x82\x1b\xba8\xa5\x9e%_\x10߃u\xb8\xb0\xa0\xf4\x96\x96w\xe8y\x12\xa0CeD}\xe0\x98!\xd2\x05\x89\x1f\xdaR]\xb8ĔM\x0f\xd0%\xc7nP\xee\xa8V\xd4+\xd8|\x97\xbd\xcb\xcef\xa7\x85\xb0?\xf0\x8a\x8d\xd5\\8\xe4?\xa0\xc2P\x9aL\xa0~u8?\xb9\x83u\xfbf\xd0!\x1c\xb9y\f߸t\xdad1\x01\xf0\xbc\r7\x89R?P(\xf7\xfd\x9f{\xa3\xe1\xbc\xf4\x9d\xc1\xfa\xc02\xc0\xefEW\x84ȟp#\xec\xf4\x89\xcf\xee{+ҙ\x1b\xc7@?~Iwҗ&N\xfb\xa5G\x18`%$\xa6\x86\xc0>@-\x1c\xfd\xcf~\xde?ߟ[\xea\x9aR\xc8\x1b\xca\a\xb7\xf4\x91\t\xdd?v\xf1\xcbem\x1d\x9a\x01Sh\xf4\xd8k\xbf\xefi\U001008bf\xf81\x00h\x7f%\xc3}t\xe3H\xf7\xf8\xe4)C+\xb3I\xae\x13\xff\xc79e\xaag=\xad\xad\b5f(GT\xb8\x95(}\xcb4!\xcdV\x98\xe3\x1fY%\xee\x93d\xd3\xc1ފ\xfb\xa8\xd6\x12\xa8s\xd7~x\xf5߇\x8e\xa0\xd7mT<\x11\x89\xfd\x05\xc3ht\xb4\xf4\xa8\x11oY\x13\x15\x91\xff\xefp(\xd1\xda\xe9+\xa2\x0fa\x16\x9d\x98\xa5%\xc0\x96\xbav\xc7,\xf3|H\xa1\xe3Wuo\xe1\xd1\x7f+8\xc1\xa1\xffz0I$\xaf\r]\xcc6\xf1\xd639\x18e\xb3\x93CL\xf3y\xe3\xc0X\xff\x83Ǔ\xceU\x9f\x80\xfcO\tw:\x02\xab*\xa3?\x8b\x92\xb2\x88\x04v\xae\x95\xadK\xeav\xec\xf6\xac\xef\xa2G\x17@\xb8s\xdb8\xa9\xd4\xdb\x19\xb5_`}c\x1d :\xaa\xb6\x13Jy\xbc\x96\x0eft\xadku\xca]\xdb\xfbvv\xc2J\xd5\xe5\xf20۶)\x94\xc8Q\xf1O\xc5Cz\xe8V͞\xc2\x15\xcdK\xfc8\xed\x98\x04+~k\x142ՆBM\n\x8e\xfe\x84\xcae\xcd\xd3\xc7p\xd1\xe4\fV\xda\n\xa7\x8d\xa0\x16̝\x03a\xd59%\atwDN\xb6\xbb\xd5\ba\xd2$\x84J\xd6k\xa1\xe2z\x12\x1b\xc5)\xba\x9d\xf1\x04\x88o{\xc0\xf80x\xc7S\x8d\x13\xf4\xe2\x14\t(ܢuA\xeaþ\xbb'\x8c\x8f\aK\x1a\xb9$\xc7\x1dhFM\x89B\x19${\xe0\xc4S\xe7\xe0(\x1a\xe3\xae\xfbM\x88\f\xba\x11\xfaӒ\xbf\x15\x90\aɏ\x03\x12h\xfe_\x022Z\xbe\x0f\x0e\xf4^\x86r\xad\xb3yt\xb6\xdd7\xf5\xb2\xe9;/\xe0_\xff\x9e\xfdg\x00\xbag\xc8\\\xfe0\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4U=s\xdc6\x13\xee\xf9+v\xe6-ܼ\xe4YI\x93a\x17\xcb.4I<7\x92\xc7=\x0e\xd8#a\x81\x00\xb2\v\x9c\xa2d\xf2\xdf3\v\x92\"\xefKV\x8a\x90l\b\xecǃ\xe7\xd9]\xd4u]\xa9h\xbf\"\xb1\r\xbe\x05\x15-\xfe\x91\xd0\xcb\x1f7\x8f?qc\xc3\xe6pS=ZoZ\xb8͜\xc2p\x8f\x1c2i\xfc\x88{\xebm\xb2\xc1W\x03&eTRm\x05\xa0\xbc\x0fI\xc92\xcb/\x80\x0e>Qp\x0e\xa9\xee\xd07\x8fy\x87\xbbl\x9dA*\xc1\xe7ԇ\xf7\xcd\xcd\x0f\xcd\xfb\n\xc0\xab\x01[0\xe80\xe1N\xe9\xc7\x1c\t\x7f\xcfȉ\x9b\x03:\xa4\xd0\xd8PqD-\xf1;\n9\xb6\xb0l\x8c\xfeS\xee\x11\xf7\xc7\x12\xeaC\tu?\x86*\xbb\xcer\xfa\xe5\x9aův\xb2\x8a.\x93r\x97\x01\x15\x03\xb6\xbe\xcbN\xd1E\x93\n\x80u\x88\xd8\xc2g5 G\xa5\xd1T\x00ӱ\v\xcc\x1a\x941\x85H\xe5\xb6d}B\xba\r.\x0f3\x815\x18dM6\x8aI\v_z,G\x84\xb0\x87\xd4#\x8c\xe9 \x05\xd8\xe1\x84@2\xc8\xfb\x8d\x83ߪԷ\xd0\b_\xcdh*@&\x03\x89\xd3\u0087\xd3\xe5\xf4,\x809\x91\xf5\xdd5\b\x9cT\xca<\x83(ym\xf0\xb0\x1c\xfb\x14@\xb1ob\xaf\xf88\xfbCٸ\x96y\xb49ܔ}\xd6=\x0e\xa5\xca\xe4/D\xf4?o\xef\xbe\xfe\xf8p\xb4\f\xc7X/H\v\x96A\xcdH\x85\xb8\x82\x1e!x\x84@0\x04\x9aY\xe5\xe6%h\xa4\x10\x91\x92\x9dKk|WͳZ=\x81\xf0NP\x8eV`\xa4k\x90\x8brS\x11\xa0\x99\x0e6\x92i\x19\b#!\xa3\x1f\xfb\xe8(0\x88\x91\xf2\x10v\xdfP\xa7\x06\x1e\x90$\fp\x1f\xb23\xd2l\a\xa4\x04\x84:t\xde\xfe\xf9\x12\x9b園ԩ\xb4\xe83?\xa5\xe8\xbcrpP.\xe3\xffAy\x03\x83z\x06B\xc9\x02ٯ\xe2\x15\x13n\xe07\xa1\xc9\xfa}h\xa1O)r\xbb\xd9t6\xcdCC\x87a\xc8ަ\xe7M\xe9\x7f\xbb\xcb)\x10o\f\x1e\xd0m\xd8v\xb5\"\xddۄ:e\u008d\x8a\xb6.н\x1c\x98\x9b\xc1\xfc\x8f\xa61\xc3\uf3b0\x9e\x15\xc8\xf8\x95F\x7fE\x01i\xf3Q\xf6\xd1u<\xe8B\xb4\xf5]\x91\xe4\xfe\xd3\xc3\x17\x98S\x171\x8e\x82\xc2\xc4\xfb\xe2ȋ\x04B\x98\xf5{\xa4\xe2\a{\nC\x89\x89\xde\xc4`}*?\xdaY\xf4\xa7\xf4s\xde\r6\xf1\\\x92\xa2U\x03\xb7e\x92JS\xe7hTB\xd3\xc0\x9d\x87[5\xa0\xbbU\x8c\xff\xb9\x00\xc24\xd7B\xec\xdb$X_\x02\xcb#Qډ\xb5\xd5\xc6<\xbe\xaf\xe8u\xa1i\x1f\"jQPH\x14o\xbb\xb7\xba\xb4\a\xec\x03\xc1Sou?7\xedQ\\X\x1a|i\xe6\xeb\r-\xef2&Ow\xae\x1e\x1e\x8av\x96\xf0\xa4\n\xebU\xb07\xf1R\x86\xe1\xbfd\xa6\xf8\xcc\xdc\xe8L\x84>\xad泺\xe4\xf4V.\x90(\xd0\xd9\xea\t\xa8O\xc5H\x86OR\xd63(\xff<9B\xeaU\x82'$\x04\xf4:d\x993h\xc0\xe43\xfe&Z\xd6wI\xa4\xa0\x91W3x~m\xc2\xe1\x02\xa6Wԑ\xcfg\xe7\xd4\xcea\v\x892VG{/\x8a(\"\xf5|\xb2W\xee\xac\xefP\xb0\x15\x9bK\x1a\xe0|E~W\x04\xf9\xd0\xe7\xe1<S\r\x9f\xf1\xe9\xc2\xea\x9d\xdfR\xe8\b\xf9\xb4\xe4\xc5e;\xb2\x87\xa6:\xdax\x8d\xa5\x8bEy\xb6\xc8r\xe5\x98\x15\x8b\x9c\x02\xa9n\xcd+\xe7\xdd\xcb\xfcn᯿\xab\x7f\x06\x00\x045\f\xc6i\n\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xccXK\x8f\xdb8\x12\xbe\xfbW\x14\xb0\x87\xec\x02\xb6:\xbd{Y\xf8\x96q2@#=\x99Fw\x92;-\x95\xa5\x9a\xa6H\x0e\x8bl\xc7\xf3\xeb\aEQ\xf2Kr;\x01f0Q.\x16\x8b\xf5\xf8\uaac7z\xb1X̔\xa3\xaf虬Y\x82r\x84\xdf\x02\x1a\xf9\xc5\xc5\xf3\xff\xb9 {\xf3r;{&S-a\x159\xd8\xf6\x11\xd9F_\xe2{ܐ\xa1@\xd6\xccZ\f\xaaRA-g\x00\xca\x18\x1b\x94\xbcf\xf9\tPZ\x13\xbc\xd5\x1a\xfd\xa2FS<\xc75\xae#\xe9\n}Rޛ~y[\xdc\xfe\xb7x;\x030\xaa\xc5%Tvk\xb4U\x95\xc7\xdf#r\xe0\xe2\x055z[\x90\x9d\xb1\xc3Rt\xd7\xdeF\xb7\x84\xfdAw7\xdb\xed|~\x9f\xd5<vj҉&\x0e\x1f\xc7N\xef)K8\x1d\xbd\xd2\xe7N\xa4C&SG\xad\xfc\xd9\xf1\f\x80K\xebp\t\x9fT\x8b\xecT\x89\xd5\f \x87\x98\xdcZ\xe4\xe8^n;Ue\x83m\x82M~Y\x87\xe6\xdd\xc3\xdd\xd7\xff=\x1d\xbd\x06\xa8\x90KON@=\xf3\x19\x88AA\xf6\x00\x82\x1d\x9c\x02e@\xf9@\x1bU\x06\xd8x\xdb\xc2Z\x95\xcf\xd1\rZ\x01\xec\xfa7,\x03p\xb0^\xd58\a\x8ee\x03J\xf4u\xa2\xa0m\r\x1b\xd2X\f\x97\x9c\xb7\x0e}\xa0\x1e\xe5\xee9\xe0\xd0\xc1\xdb\x13\xc7\xdfHl\x9d\x14TB\x1ed\b\r\xf6\xf8`\x95\xe1\x00\xbb\x81\xd0\x10\x83G\xe7\x91\xd1tt:R\f\"\xa4L\x8e\xa0\x80'\xf4\xa2\x06\xb8\xb1QW¹\x17\xf4\x01<\x96\xb66\xf4Ǡ\x9b\x05!1\xaaU\xe8\xe9\xb0\xffG&\xa07JË\xd2\x11\xe7\xa0L\x05\xadځǄS4\a\xfa\x92\b\x17\xf0\x8b\xf5\bd6v\tM\b\x8e\x97775\x85\xbevJ۶\xd1P\xd8ݤ2\xa0u\f\xd6\xf3M\x85/\xa8o\x98\xea\x85\xf2eC\x01\xcb\x10=\xde(G\x8b云\x80\xb9h\xab\x7f\xf9\\m\xfc\xe6\xc8װ\x13\x9aq\xf0dꃃ\xc4\xf9\v\x19\x10\xd6w\x84\xe9\xaev\x81\xee\x81&S\xa7\x94<~x\xfa\f\xbd锌#\xa5\x03s\x86\x8b\xbcO\x81\x00Ff\x83>\xdd\xeb\x98':\xd1TΒ\t\xc9@\xa9\t\xcd)\xfc\x1c\xd7-\x05\xee\xc9,\xb9*`\x95\x1a\n\xac\x11\xa2\xabT\xc0\xaa\x80;\x03+բ^)ƿ<\x01\x824/\x04\xd8\xebRp\xd8\v\xf7\xffD\xcb2\xa3vp\xd0w\xb2\x89|\x9d\x94\xfa\x93\xc3R\xb2'\x00\xcaM\xdaP\x99J\x036փ\xdaW~\x06p_\xb5ӕ+OP\xbe\xc6p\xfa\xf6ė\xcfIH\xcco\x1bu\xdch\xfe\x8dE]H\xaf\xe0\xecH\xd7=\xfesl\xff\xb2\x0f\xf2\x90)u\xac\xb0\x1a\xba\xe7\xa8ԉ_wg\x972\xc15\x95(]\xc2\xf4\a\xa9\xf5\xf2\xa8F\x90x\xf0[\xf0C\xaf\x14\x8cs\x13\x14\xe2\b\xc5\xe7`\x8d\xdeI\xc9P\x95\x02\x15\x99\x9f\x92\xcc*\x8bL(\x17\xf6\x14p\xb7\x01Ɛ\xb5\xc8\xdd\xc1\xb3E\x1a\x1b\x15P\xc0\x96\x81\xcc\xf1\xe9\x94\xcb\xcac\xef3V\xe7X˓\x14\x8e\x838I\xe0\xfdc\xa2\xd6j\xadq\t\xc1G\x1c\x15\xe9t(\xef\xd5\xeeBB\xfb\x95\xe1{\xf29\xdc9I\xe7Е\x12z\x10\xec\xa8J\xf8۲)\xd7Z\x15\xcaFzg\xc2\xfb81\xb0ޥtr\x9aP\x13*\xc9\x04\v\n\x18\x9d\xf2* \x04\xe5\xd7Jk\xd86T6\x02@_kX\x01\x19\x0e\xa8*\xa1\xb6\xe8\xdd6V\x8f\xe7\x06NC\xfeGr\xe4|d\x8dҢ\x9f\\\x12\xb2\x90N\u0097\xcd\xe4\xb0\x11\x8dǇ&\xb6\xe3\x06\x169\xdf\xf7\xb6\xbex~\x91\x0f\xbd\xd0W\xabc\x8bOF9n\xec+\xb2w\x01\xdb_\x1d\xfaԼ/\x8b\xf6e0\xac\xa6\x17\x04\xa3\x9e\xb4\xfb\x88\xb2\xe4\xe1t\xa4Y\xe0*-W\xf8\x94%\xaf\nt\xf5t\xf7=\x10N\x88_\x95\xa4U\x83\xe53\xc7\xf6\xb2Խ\xadWM4\xcf\x13B\xefbE\xe15\xcet\xb1ܙ\x8d\xbdl\xeb陜\xc3J\x80\xe2\xd9\x0fT\xa0t\xc1+\xcaGFj_>r\xa5\xef\x1e\x1f\xe3\x1a\xbd\xc1\x80\xbc_\xf7\xb6\x14\x9aQ\x8d\x90\xfb\x91\\L\xb5'\x9d\x99ٖ\xd4\xede?\xe7.\xda\x03\x94:\xe5\x1c(\xbcI\x86't\x1e\xba\x93\x1bV\xfe \x01m\xbb\xfd\xa6\xf8\x11d\x9c\xaa\xafA\xe6A\xd5\x032r\xa5w崹ts{T\x1f\x8cN\x91c\xee\xcfG\xeay~Ε\xf9\x84\x81s\xb2\xa4\x8f\x93c\xbe&\xbc\xb9\x80\xcf\xd9}>\x86\x95Sf\xa1Uf\xd7m\x1a\x13\xb6d|\xb1\xd3\x14\xba\xb1$\xa00\x98خ\xd1c\xd5\xcd\xd3\xdby\xc6\xc8s\x00\x97\x11\xec\x91J\x1f\xbdc\x0fm@Vyư\xe7\xcaH\x04{\xc6d\xab9\x8c\t\xade\xba\x98#\x95\x0f\xd6\xe8\xf2\xa4\xdc6)\x87{^QZ\xae\x9c\xb7\xb5G\x9e\x18\x87-\x19jc\xbb\x84\xb7\xa3\xc7\x1d\xe9\xe4C\xb1\x1e\x99\xe6\xb2x\x93Ǒ\x81\xb6H\xa1\x8d\xbc\x16\xfa\x9f\xbd\x9e\xf8\\\x982\xb0\xc8+\xfc\xec\n\x1d\x1cT\x88'\xf3\xfe\xe2GG\x92\xef+\xa4\x8cޣ\tY\x8b$F\x9d^(f\xd7m\xfc=]\xbe<\xde/g\x17K\xb47\xf0\xe5\xf1>\xado\x8aL\xaeW\x8f\v\xa6\xda`\x05r\xd6\xd7\xdf\b\x18\xdd\xff\xe3?e\\\xd1G\xf0\x9b\xa3nJ\xbf\xe2\xe2\x87AP\x90\xda6(;<\xf1)6\x9dB슷T\xa7\x7fӐg\x8dP\xa1\xc6\xc3\xcdq\xc7\x01\xdbs\xbf7ַ*,A\xbe\x8a\x17\x81Fh\xf4\xcarv!p\xd7(\xc6Wb~\x10\x991b\f=\xf4$\xfabv\xddn\xb6\x80O\xb8\x1dy\xfb\xe0m\x89\xccX]\x1f\xc9h\x11\x9c\xbdL\xbbyu\x80R\x1e@K\b>\xe2\xec\xcf\x01\x00\xa8c\xfdD&\x15\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Z[o\xe36\xf6\x7f\xf7\xa78H\x1f\xf2/\x10\xc9\xed\xfc\x17\x8b\x85\xdf:\xc9v\x91\xddv\x1a4\xe9\xbc\x14}8\x16\x8fl6\x12\xc9%)gܢ\xdf}qx\xb1%K\xb6\xe3l\xdb\x1d\x1bh,\x92\x87\xbfs\xbf\xa8EQ\xcc\xd0ȏd\x9d\xd4j\x01h$}\xf2\xa4\xf8\x97+\x9f\xff\xe6J\xa9\xe7\x9b/g\xcfR\x89\x05\xdcv\xce\xeb\xf6{r\xba\xb3\x15\xddQ-\x95\xf4R\xabYK\x1e\x05z\\\xcc\x00P)\xed\x91\x1f;\xfe\tPi\xe5\xadn\x1a\xb2ŊT\xf9\xdc-i\xd9\xc9F\x90\r\xc4\xf3՛/\xca/ߕ_\xcc\x00\x14\xb6\xb4\x00\xa3\xc5F7]KK\xac\x9e;\xe3\xca\r5du)\xf5\xcc\x19\xaa\x98\xf6\xca\xea\xce,`\xbf\x10Ϧ{#\xe6\a->\x062\xef\x03\x99\xb0\xd2H\xe7\xff5\xb5\xfa\x8dt>\xec0Mg\xb1\x19\x83\b\x8bN\xaaUנ\x1d-\xcf\x00\\\xa5\r-\xe0\x03\xb6\xe4\fV$f\x00\x89\xc5\x00\xab\x00\x14\"\b\r\x9b\a+\x95'{\xcb\x14\xb2\xb0\n\x10\xe4*+\ro\t\xe8!\x02\x84\x88\x10\x9cG\xdf9p]\xb5\x06t\xf0\x81^\xe6\xf7\xea\xc1\xea\x95%\x17\xe1\x01\xfc\xec\xb4z@\xbf^@\x19\xb7\x97f\x8d\x8e\xd2*\x8bh\x01\x8fa!=\xf2[\x06\xed\xbc\x95j5\x05\xe3I\xb6\x04/kR\xe0\xd7\xd2A\xd4\b\xbc\xa0c8֓8zqX\xe7\xe3\xceckҶ\x88\xe0\xd6\x12\xee\x8fF\b\x02=M\x01\xd8\xc9\x13t\r~M,\xf9`X(\x95T\xab\xf0(Z\vx\rK\n\x10I@g&\x90\x19\xaaJ\xa3E\xa92Ѵ\x87\x7f\xf7\xaez\xa5lx\xff\xef\x8d*-\xf3\x9f\xc1\x06\xde\x00\xe5\xa2{\xe3\xe6\xb4\x18o\xfd\xd8\x7ft\xee\xe2\xa75\x05p\xf9\xf2\xce4\x1a\x05Y\xbe~\x8dJ4\x04\x1c\x1e\xc0[T\xae&{\x04F>\xf6\xb45C0?dz\xbd\x95K\x84\x91|\xe7\xd1k\x8b+\x82ot\x15\x02\x14\x9b\xb4\xa5\x81M\xbb\xb5\xee\x1a\x01\xcb|\v\x80\xf3\xdaN\x1a8+,\x9eJt3\xd9\x03?\x1b\xdey\x1c}\x8fv\x8e\xa7e\xc5>\"\xb5\x9a\xf6\xa0\xafV4\xed=qy\xf3e\xf8\xe1\xaa5\xb5!4\xf3/mH}\xf5p\xff\xf1\xff\x1f\a\x8f\x01\x8cՆ\xac\x979|\xc6O/9\xf4\x9e\xc2P\xd4\xd7L0\xee\x02\xc1Y\x81\\\xb4\xc1\xf8\x8cD\xc2\x10\xd5!\x1dX2\x96\x1c)\xdf\x17I\xfe\xe8\x1aP\x81^\xfeL\x95/\xe1\x91,\xc7Ϭ\x98J\xab\rY\x0f\x96*\xbdR\xf2\x97\x1dmǶƗ6\xe8)E\xf1\xfd'\x04Z\x85\rl\xb0\xe9\xe8\x06P\thq\v\x96\xf8\x16\xe8T\x8f^\xd8\xe2J\xf8V[\x02\xa9j\xbd\x80\xb5\xf7\xc6-\xe6\xf3\x95\xf49)V\xbam;%\xfdv\xce\x0eo\xe5\xb2\xf3ں\xb9\xa0\r5s'W\x05\xdaj-=U\xbe\xb34G#\x8b\x00]1îl\xc5g6\xa5Qw=\xc0:2\x8c\xf8\r\xc9\xec\x84\x068\x9d\x81t\x80\xe9hdt/\xe8\x1c\x8e\xbe\xff\xfb\xe3\x13䫃\xe5\x0f\x88B\x92\xfb\xfe\xa0۫\x80\x05&U\xcdn\xcd\x1eS[\xdd\x065\x93\x12FK\xe5Ï\xaa\x91\xa4\x0e\xc5\xef\xbae+=\xeb\xfd\xdf\x1d9Ϻ*\xe16T\n\x1c\x16;Ö+J\xb8Wp\x8b-5\xb7\xe8\xe8\x0fW\x00K\xda\x15,\xd8ש\xa0_\xe4\xec\xff1\x95E\x92Zo!\x97(G\xf4uPw<\x1a\xaaX{,@>)k\x99\"T\xad-\xe0a\x99R\x0e\bO;.\x7f&\xa3\xd3\xe1\xa6\x03d\xef\xa7\xcedl\xaa\x17Ss\xc0\x8c\xb1oD\x14\xa0ɇs\x94ݝ\xb1d\xb4\x93^\xdb-\x13\x8e\x01v\xc8\xd3\t5\xf0W\xaa\xcaR\xcb\xf1\xa3y\x8f\x8eΰt?ܽ\x13\xb4B\xe3\xd6\xda\xc3\xfd\x1d\xf3\x84`,m\xa4\xee\x1c\x17\x17#\x8a\x903y\xe2\xc1k\xe8\x1cq\tƴ\x96\xe8\x92`v\xa9\xa4\x84\xfb\x1a\xa4\xe7ۨ5~{3A\x92\x8f\xb6څpF\xcaC\xa5[Ӑ'\xc1\x10\x0e.Lbw\xac\x82\x87\x8f\xb7L\xb7s\xbb\xc4\xd4\xff\xf40]$U\xa5\xc59Q~Ђ\xa6\x8c\x81\x8f\x82_c\x8c\x01\\\xb5r\x94\xef\x94\x1a\xdf\xc2_\xad.\x02f\xb48\x83+݈`\xa9&K\x8ac\x9b>[\x92\x8dh\u00a0X\x1ac<\xeej\xa7r\xe5$\xe2\xaf\x1e\xees~\xccBL\xd8\xfd\xf8\xde3\xf2\xe1o-\xa9\x11\xa1|8\x7f\xf7\xf5}\x1d\x05ŴXP\bFRE\x83\xd4\vR9O(@ד\x14\xb9\xd3\x03\x0e\xa7\x96҉\x9bh\xfe)\x01\xed\x13\xb6G\xa9\x009#I\x01\xff|\xfc\xee\xc3\xfc\x1fS\xa2\xdfq\x01XU\xe4\x98\x10\xfa\xe0\xe57\xbbvG\x90\x93\x96\x047/T\xb6\xa8dMΗ\xe9\x0e\xb2\xee\xc7w?MK\x0f\xe0km\x81>!;\xd8\r\xc8(\xf1]\xb2\xcbFæ\xcd\xe2\xd8Q\x84\x17\xe9\xd7R\xcd&I\x02\xb2\x9f&\xb6_\x02\xbb\x1e\x9f\ttb\xb7#h\xe43-\xe0\x8a\x83z\x0f\xe6\xaf\x1cH\x7f\xbb:B\xf5\xffb\xc0\xbc\xe2MW\x11ܮ\xba\xe9;\xdd\x1ed\xf4<+W+\xdaת\x87\xff\xf8\bmH\xf9\xcfA[\x96\x80\xd2=\x12\x810G\xe3\x98~H\x8c@\xff\xf8\ue9e3\x88\xf7tX^ \x95\xa0O\xf0\x0edj\x18\x8d\x16\x9f\x97\xf0\x14\xacc\xab<~\xe2\x18R\xad\xb5\xa3c\x92ժ\xd92\xcfk\xdc\x108\xcd\xed'5M\x11\xabK\x01/\xb8e)dű\x19#\x18\xb4\xfe\xa4\xb5\xe6\x9a\xf2黻\xef\x16\x11\x19\x1b\xd4J1\x1c\xaeEj\xc95\"\x17\x87a1Z\xa3tG(\xba.\xd0c\x98\xd5\x1aՊ\xabŠ\xa4\xba㢯\xbc\x9eM\x1c:\xe7\xc7\xe3BoڅC\xc1w\x188\xfeg%\xd3+\x99c#{\rs\xfd\xde\xed$s<L\xb2\x8a<\x05\xfe\x84\xae\x1c\xb3V\x91\xf1n\xae7d7\x92^\xe6/\xda>K\xb5*\xd84\x8bh\x03n\xceP\xdc\xfc\xb3\xf0\x9f7\xf3\x12\xe6\x04\xafeh0\xbf\xf8#\xb9\xe2{\xdc\xfcML\xe5\xce\xe0\xf5y\xec\xfa1ի\x87g\xd9-^ֲZ\xe7\x96/\xc5\xd8I\x92\xc0\x1eآ\x88\xa1\x19\xd5\xf6\x0f7e\x16hg\x19ѶH\x13\xca\x02\x95\u0fddt\x9e\x9f\xbfI\x82\x9d|\x95\xfb\xfep\x7f\xf7g\x98\xc2\xfc\xb3N\xbe\xc9W\x8f\xb45\xf1\xfb\xa9\xd8\xc3*Z4E܍^\xb7\xb2:\xd8͵\xfe\xbd`\xc1ג\xecbvR,\xdf\x0f6\xe7Bs\xa2k\xd8\xed)g\x17\xb0\xe5q5Q\xb8\xf5\a\xb2\xa7ʻ\x93\xf2\x1a\xb0\xf1\x84+\ah\t\x10Z4\xac\xe7g\xda\x16\xb1 0(-\xb3\x85>\x8f4\x96\x04hL#'\x13\xb7\xd7\xfd\x925I\x82\v|\\\xb9\xf2\x12\xad\xf5gk\x8b\xd3\xf0\U000f4377f\x1d\x9c\x99\xee\xf9\xf5T\a8\x98\xf9\x8dђ\xea\xda1\x94\x02\x9e\xb5\x918\xf1ܒ\xf3#\xfb\xe2\x03WW\xb3\v\x94\x15۪32HCw\xe9FUWR\x05\xfbZJ\xf7\xdc|\x84\xf9\xee\x88$\x9cj&\x8eB\xe4)\tW\xb9C\x88\x05,\xa7Z\xf3\x83=܈\x1d<:\xecf\x8b\x03\x9f<X\x1ĉO\x9a\x15\xd7\xe7݁\xab\x9c\x9cr\x84\xfd٢b\xf4\xf5\xf9\x85\x86\xae\xdf>\xe7Hms\x7fNzF\xbd\xb7\xe3\x13܃k+\x92\xb9\xf3\v\x0f\xcc\xfe\xc6/:v\xad\xf9X\x91\xd0#\x17Or\xf3\x1b\xa8\x91\b%7w\x045ʆD\"\xe9\xca\xc33\x13T\xfbT\x96Tsi\x17]/7\xb2\tޮ\xac\xe5\xf1@\x98\xd5]\xbb\x134yj\x10\xe6J\x13B\x18\x97\xba\xb5\xb6-\xfa8[.&\x89\xaa\xaeip\xd9\xd0\x02\xbc\xed\xe8\xf5f\xce\x135\xe7pu\xce\x15\xbf\x8d\xbb\xd8n0\x1f\x01\\\xea\xce\xef\x1a\xfcAx\xbcvɦ\xcaK\xb0\x98\xc9\xd6y\x00\x84\xbb\xebl\xbdu\xd74\xe1Lj\x10w\rY|\xd3\xc9}!,i|\xcd[c\x02@x\x85w\x0e\xe1\xba7\xdf\xea;\xd8.z\x9d\xf4\xb0SA\xf9\x03\xbdL<\x1d\xbdz\xdc\x7f\x8al_\x13y\xad\x80\xaf\x837\\\xc4\x7f\xba\xe8\x9c\b\xd26X\xeb&;\xb3\xf6\u0600\xea\xda%Y\x96\xc3r\xeb\xc9\r\xc3\xf9\x88&\xa4.p/\xc6\xde\xf9\xac\xbfH)5\xb6\x15*\x9e\x1e\x05\xef\xf2\x1a\x84t\xa6\xc1\xed\x04a\x93\x11r\x9f\xc6\xce\xc5!`o\xcf٩\rٰt\xe9\x14*`\xba\xd3j\u00ad\xfa\xfe,\x95\xff\xeb_&wD'\xe17&\xab\x83\xe4\x90\xd6Y\x9c\xef\xb7~\xfa\xfa\xff\xfe\x86\x13EL\x1e\xd9\xdeߝ\xb1\x82\xc7\xdd\xc6\xec\rr\x97\xef\x18\xe0p\x00\x1cMaD\x11z\xb1\xa5\xbc\xc4T\x87/\xbd\xcfA\x1dl>\x93\x85\xd2\xeb\xf61\x1a\x80G2h\xd9\xd3\xc3{\x99\xdb\xc3\x17\x877\xe0$O\xb8B\xe5\x19K\xd18\xb4p\x9c\x9c\xb8\xb4Җ&B&\x8c\xd3\xca \x89\f\xe1\xff\xb9\xf9#fC\x1e\x06\xba3B\xfea\xbf\xb3\x17\x18\x1a\xbd\x92\x156\xe0\xe4/\x87\xe5\x1d\xaaqԊ#\xb4\xfe\xe6P\xe0\xa6rX\xe4\x11ݨ]\xb9\x01\xe9\xafY\xcc\xcdT8\xe0b\x8c\xff\x17\nXn\xc3\xf1P\xfd&\xd6\xc8^\xea\xfd\x89\xa5\x13\xee9\x90\xcb7\xbd\xed\xd9SF\f\xa6\xbf\x8f9\xc2\xef\xe1\xf3\xbb\xfeD\xbc\x16yjR\xc4i\xe8/VzOjz֟5:R\x18`\xedɂ љ&\xbf\x89\xe3|\xc0E '\xbbɰ\xfc{\xc8\xe1h\xec\x9b\\\x18=\fn*z\x8e\x94\xde\xce\xf5\x9ft\xcb<\xa8q\v\xf8\xf5\xb7\xd9\x7f\x06\x00\xbf\x1da\xb2\x03&\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Z\xd1o\xe36\xf2~\xf7_1H\x1f\xf2+\x10\xc9\xdd\xfd\x1d\x0e\a\xbf\xb5\xd9\xf6\x9a\xbbv7ؤy)\xfa0\x16G\x16\x1b\x89ԑ\x94\x13_\xd1\xff\xfd0\x14iK6e;\xb9Kk-\xb0\xb1H~\xfc\xf8qf8$\x9de\xd9\f[\xf9@\xc6J\xad\x16\x80\xad\xa4gG\x8a\xbf\xd9\xfc\xf1o6\x97z\xbe~7{\x94J,ຳN7\x9f\xc9\xea\xce\x14\xf4\x81J\xa9\xa4\x93Z\xcd\x1ar(\xd0\xe1b\x06\x80Ji\x87\xfc\xda\xf2W\x80B+gt]\x93\xc9V\xa4\xf2\xc7nI\xcbNւ\x8c\a\x8f]\xaf\xbf\xca߽Ͽ\x9a\x01(lh\x01\xad\x16k]w\r\x19\xb2N\x1b\xb2\xf9\x9aj2:\x97zf[*\x18|et\xd7.`W\xd07\x0e\x1d\xf7\xa4o\xb5x\xf08\x9f{\x1c_TK\xeb\xfe\x99,\xfeAZ竴ug\xb0N\xf0\xf0\xa5V\xaaUW\xa39,\x9f\x01\xd8B\xb7\xb4\x80\x8fؐm\xb1 1\x03\b\xe3\xf4\xd42@!\xbcrX\xdf\x1a\xa9\x1c\x99k\x86\x88\x8ae \xc8\x16F\xb6\\e\x80\x03\xba\x04W\x11w\xe9UE\xa9\xa4Z\xf9W\xbdT\xe04,\t\x02\x13\ue59f_\xadV\xb7\xe8\xaa\x05\xe4,\\\xdej\x91\xab\x88\x19\xea\xf0\xf7AO\xe1\xad\xdb\xf08\xac3R\xad\xa6\x98\xfd\x8fI\x85\xe2\x9eϭ\x16g2\xb9\xaf\xc8\u05c9l\xba\xb6\xd6(Ȱ\"\x15*Q\x13\xb0\x81\x823\xa8lIf\x82Elv\xbfi)T\xe9\x99\xfc\x14\xf1\x06%/Q\xe7%R\xf4uCa\xdf\xfd\xc3\xf0թ~o\xb5\b\r \x185X\x87\xae\xb3`\xbb\xa2\x02\xb4\xf0\x91\x9e\xe67\xea\xd6\xe8\x95!k\x134|\xf5\xbc\xadЎy\xdc\xf9\x82\xb7\xe5QjӠ[\x80T\xee\xaf\x7f\x99\xe6\x16\x1a\xe5N;\xac\xbf\xd98\xb2#\xa6\xf7\xfb\xaf{\xd5\xd8\xd9Vd\xfe<\xbaKf\xfaA\xab\xb1\xae\xdf\xec\xbdM\x91\x1d\x80\xc6x\x9b\x17\x86|\xa8\xbd\x97\rY\x87M;B\xfdz5\xc6\x13\xe8\xfa\x17}\xa7\xebw\xfe\x8b-*j|\xe8\xe6o\xba%\xf5\xf5\xed\xcd\xc3\xffߍ^\x03\xb4F\xb7d\x9c\x8cѵ\x7f\x06\x8b\xc7\xe0-\x8c\x95\xbdd\xc0\xbe\x16\b^5\xc8\xf6\xf1\xa1\x7fG\"p\xe8\x9dEZ0\xd4\x1a\xb2\xa4\xfaud\x04\f\\\t\x15\xe8\xe5\xafT\xb8\x1c\xee\xc8ph\x05[\xe9\xae\xf6\x11hMƁ\xa1B\xaf\x94\xfc\xf7\x16۲\xefq\xa75:\n!~\xf7\xb0\xd2Fa\rk\xac;\xba\x02T\x02\x1a܀!\xee\x05:5\xc0\xf3Ul\x0e?\xb2AKU\xea\x05Tεv1\x9f\xaf\xa4\x8b\x8bf\xa1\x9b\xa6S\xd2m\xe6\x1c\x14\x8d\\vN\x1b;\x17\xb4\xa6zn\xe5*CST\xd2Q\xe1:Csle\xe6\xa9+\x1e\xb0\xcd\x1b\xf1\x85\tˬ\xbd\x1cq=p\xba\xfe\x9f_\xeb\x8e\xcc\x00/v -`h\xda\x0ft't\fٟ\xbf\xbd\xbb\x87ص\x9f\x8c\x11(\x04\xddw\r\xedn\nX0\xa9J\x0e\xba\x95\xb4P\x1a\xdd\xf8i&%Z-\x95\xf3_\x8aZ\x92ڗ\xdfv\xcbF:\x9e\xf7\x7fud\x1d\xcfU\x0e\xd7>\x93ॣk\xd9rE\x0e7\n\xae\xb1\xa1\xfa\x1a-\xbd\xf9\x04\xb0\xd26caϛ\x82a\x12\xb4\xfb0\xca\"\xa86(\x88\x19\xcc\xc4|\xedg%w-\x15<}\xac 7\x95\xa5,\xbcop\xf8\x01<\xc8b\xf2\x11t\xdau\xf9Yb\xf1صwN\x1b\\\xd1\x0f\xba\xc7ܯ\xb4\xc7\xed\x9bT\x9bHN\rּ\x1e\x1c\x98\x10n#\xd1\xf0\xa9c㧊\f\r\xdb\x18j\xb5\x95N\x9b\r\x033\x02\x89\xf1\x98\x8eL\x04\xff\xab\xb4u\xdfk\xfdhO\f\xe6\xfbX\x0f00\xe0\x96q\x85\x86\xca\x17\xd13\x15\x9d#\x01Zq\x95\x03D\x88\xcb;\x96\x8e\f\xb0%\xb3!0uCS䥣&\xc1.\xc1/\xcc)\x0f\x87!ѳ\x02W\xa1\x039 \xb7\xdc09PZP\x86\xabC'\v\xfe\xabv\xa3\xe4H\xbae\xc8Y\xe5\x15H\x05\bEe\xb4vq\x1a\x854T\xf8\xa9\xd0e\x12r\x80\xd1\xebp\x15\x84\xf0\xadY\x88q\x124\x90\x85\x03m\x12sI\xa5\x0e\x13\x12\x92J\x8e0\x01\x87SM\xebи\xdc\xeb\xb37[Z՛$\xa6\xe9zw\xd9Q\x19\x109P\xaf\x8f_\xa5\xac\t\xec\xc6:j\xd2<\xbd\xbd\xda\xc3\xe9=\xe6u\xfd\xc3\x01\n\x95H\x17\xee\x99\xc1u_7zYh\xca\xea\x01\x9aUא\xf2A3\x1a\xc3\x04$\xa4\xa6w\xab@\x98\x9d\xedt\xa7\xc6t\xd4pO:e|\x1a\xa9n\xbc\xf5û\x89\x1a=\b\x1a\x83\xe9\xa9\xd4\xea[c\xb49K\xbbO}\xdd\x187\xc9B\xa5\x9f\xe0\xc1\xef\x19cڰ\xa4\n\xd7\x04\xb2\x04\x99v\x1b~H\x15\xba\xe3D\xc1\x02* \x0f\xda\vޯ\x9e\x92\x91\xf5\xe3\x94n\xa4\xbaf\x8ap\x06ך\x17\xe1nj\xea2\xf8\x0ee={\xa5\xe4N6\xa4;w\x96\\\x9cJ\xea\u038d\x12\xb5\x06\x9fe\xd35\x80\r\v\xe0\x03\x83l(h8\x01\nQ\xdb'\x94n\xebw,\x108\r\x85nښ\x1cEG/\xb4\xb2R\x90\ty\xc8$f\xd0[s\x98*Q\xd6\xdd\xfeZ\xf7\x02UB\f8K\x95\x87\x10/\xe2*\xc1\xeb\xdc(\x1eŀ\xe2W3YT\x13\xa00\xf2߭\xf5m\xa3x\x0e7%p\xda\x13\xadU\\\r[L\xa2\x0e\x17\x02\x16\xa7\xaeS\xce=d\xfcv\ueb7a\xba\xc6eM\vp\xa6\xa3Wy8'\x82\xd2P28fGĘȶ\xce\xe25ͨ\xd5\t\"#\xf3ལ_\x9a\r\x95dH\x15\x14\xb7\x1bǎE\x0e0ax:\x90\xcf^\xb6\xa2LmŒ\x84\xbf\xbe\xbd\x89ۯh\x13\x81\xba\xcbg\xaf\x98\xf7RR-\xfc\xee\xf4tߗ7e\xdf\x19c\xb1N\b\xad\xa4\x82F;;\x90\xca:B1\x95t\xf0A#p\xb6n(\xb4`G\xf1ɢ\x87\r\xae\x15\xa4\a\xe4\r\x8f\x14\xf0\x8f\xbbO\x1f\xe7\x7fO)\xbf\x1d\x05`Q\x90e t\xc4+\xeb\xd5\xf6\x00@\x90e\xab\xe4S\x10\xca\x1bT\xb2$\xeb\xf2\xd0\a\x19\xfb\xf3\xfb_\xd2\xea\x01|\xe7\x17\v\xe4\xa8w\xc5\xeb\f+\xbe\xddKE\x9b\xe1\xe5\x9d\xe5\xd8\"\u0093t\x95T\xb3$$ \xfbq\x18\xf6\x93\x1f\xae\xc3G\xe2,\x15\xc3\xfe\xae\x96\x8f\xb4\x80\v\x0e&\x03\x9a\xbfq\xf4\xfa\xfdb\x02\xf5\xff\xfal\xfc\x82+]\xf4䶛\xe7az\xbf#\xe9SQg\xe4jE\xbbS\xad\xfd\x0f7\xa15)\xf7%h\xc3\n(=\x80\xf0\xc0\xd2\xee\xc2\xde\x01\xe9\x9f\xdf\xff2\xc9x\x87\xc3z\x81T\x82\x9e\xe1=g;^\x9bV\x8b/s\xb8\xe7?\xedF9|\xe6<\xaa\xa8\xb4\xa5)e9\x87\xe41\xfb\xac\xc0\xea\x86\xe0\x89\xea:\xeb\xd7D^\xd26\xacB\x9c8\xb67\x84\x16\x8d;j\xad\xf1\xc8\xe2\xfeӇO\x8b~\xd6ؠV\x8a\xe9p\xcc/%\x1fApR\xe7\v{k\x94v\x02\xd1v\x1e\x8fi\x16\x15\xaa\x15\x1fF\xf8I*;>S\xc8/g\x89F\xa7\xfc\xf8\xf0\x1c!\xed\xc2\xfe<a?p\xfci;\xf23\a\xc7Fv\xce\xe0\x86\a\xb7G\a\xc7w\x19F\x91#?>\xa1\v\xcbC+\xa8uv\xae\xd7d֒\x9e\xe6O\xda<J\xb5\xca\xd84\xb3\xde\x06윩\xd8\xf9\x17\xfe\xbfW\x8f\xc5\x1f՟;\xa0\xd1\r\xc2[\x8e\x8a\xfb\xb1\xf3W\r*\x1e<\x9d\xbf\x8e]ޅӐ\xfd\xb6\xec\x16>\x11\x8b'\x8a!\xc6&!\x81=\xb0AчfT\x9b77e\x16\xb43\xcch\x93\x85\v\xb2\f\x95\u0fed\xb4\x8e߿J\xc1N\x9e\xe5\xbe?\xdd|\xf8c\f\xbc\x93\xaf\xf2գy\xdcs\xb6\xa3\x955\xd8f!ss\xba\x91\xc5^m>H\xba\x11,|))\xb1_\x1c\xc9\xf2yT9n\xb6\x13GR\xdb:\xf9\xec\x05ò\n[[iw\xf3\xe1\x04\x8f\xbbm\xc5\xc8a7]!y\x8cX{7J/\xe3\xe3\xfde\x1b\x1bN\x91\x1a\u05ce̴\x91+\xbflm}\xdf\xef\xf5\x1468\xbcI\x1c~\x1al[\xa9V/\xe2:\xbc\x98;A4^\xd5q\xd5\xc8\xf2\xc4\xd5`z\xc79\xba0\xccg\xe7\xed\xe73xԭ\xc4\xc4{\xce\xeb\x0f\xec\x93\x1b\\\\\xbcD\x89\xde\x00Nh\x10\uec64=\xc8ڂ\xfd\xb0\xaf\x86t\x81\xf7.ފ\x0e \xe15v\x95\u07bae\xb0L\x1d\x1b\xef\xd5i\xb5\xd8{3\xf6߽\u009dC\xed\x17\x8cmu\xaft=\xbcL=\x1aox\v\xd0\xedm\xb6\x8e\x9f\xd3\xfb\x06\xd1\xea\xfa\b\xef\xe25\xa2.\xff\x8b\x93\xfap`2\xbc\xeb;a\x03ׇ-\xfc\xb5\x98\x11\xc1'\xf8\bg{v\vOh\xb7\xa72\x89\xf9\x86\x01\x9e?O\xe2A\xf6p$|b\xcf\xfb\x0e>\x92!\x111-'\xdd\x04\xd6\xdf\x0f]\xa6\xf2\xd8\b\xd4Y\x12>n$H\x1f\xb6\x8bW\xae|+\x941\xc4\xeb\xb6\xfaI\xf7j\xc8Z\\\x9d\xf2\xaf\x1f\xfbZL\x1dc\x13\xc0%\x9f\x9b\xc5M\x7fp\xb4 ť\rV\x90\xbf\x84\x8c\xbf\x80?A\xe5\x96\xeb\xa4,n\xeb\xf2\xc7M\xeeX(\xfbHO\x89\xb7\aW\xe0\xbb'\x8bV\x92\xd8\x06\xf6G\x98$^$@\xe8\xe8\x94\x06\xa1\x1aT\xba\x8e\xd6\xcd\xf7\xff\xa0\xbafI\x86\x85\xf0\xf7\xeeQ\x91\x188\x0eP!\xec\xbevJ\xee\x10\xc2L\x8a\x1e*\xec'\vT|f\xe3\xed\xd7i\x10Ҷu\xf2D+\xfe\x00\xc0'Xl\xbe|ﵳ\x98\x00\x0e|\xc0\xe3\xcb^z\xfa\xb3\xfd]A\xaa0\xfd+\x85\xf1\xe7\xf0'\a\xe3\xcf\xeew\x16o\xd3Ñ\x94\xcf\xdf\xf5l\xe3\xc1\t[\xb8\x1bU>\x15\xf1<tj}\x83Q\xe8:\fT\xe3n\xfe\xc8\x18\x95\x14\xea\xe0\xa5\x0f\xbab\x80\x1d\xaea\x87o\xbae\xdc4\xd9\x05\xfc\xf6\xfb\xec?\x03\x00\x1d\x04ob\x0e(\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec[\xdfo\xdb8\xf2\x7f\xf7_1\xc8\xf7\xa1\xc0\"V\xb6\xdf{9\xf8-\x97\xed\x01\xc1\xed\xb5ES\xf4\x9d\x16\xc76\x1b\x8a\xd4r(\xa7\xbe\xc5\xfe\xef\x87!\xf5ے,\xa7\xc9!\x0f\x8e\x16XX$\x87\xc3\xcf\fg>\xa4\xa6\xcb\xe5r!r\xf5\r\x1d)kV r\x85?<\x1a\xfeE\xc9\xe3\xdf)Q\xf6f\xff~\xf1\xa8\x8c\\\xc1]A\xdef_\x90l\xe1R\xfc\r7\xca(\xaf\xacYd\xe8\x85\x14^\xac\x16\x00\xc2\x18\xeb\x05\xbf&\xfe\t\x90Z\xe3\x9d\xd5\x1a\xddr\x8b&y,ָ.\x94\x96\xe8\x82\xf0j\xea\xfd\xaf\xc9\xfb\xffO~]\x00\x18\x91\xe1\n\\9\xcdFi\x8f.\xb7Z\xa5\n)٣Fg\x13e\x17\x94c\xca3l\x9d-\xf2\x154\rQB9{Լ\xd2\xf9\x9fA\xd8g\x16v\b\xcdZ\x91\xff\xd7h\x97\xdf\x15\xf9\xd0-ׅ\x13zL\xa9Ѕ\x94\xd9\x16Z\xb8\xc1N<\x19\xa56\xc7\x15|\x14\x19R.R\x94\v\x80r\xf5A\xd7%\b)\x03\x9eB\x7fv\xcaxtwV\x17Y\x85\xe3\x12\xbe\x935\x9f\x85߭ \xa9\x10OR\x87\x01\xec\xaf*C\xf2\"˃2\x15\x88\xb7[,\x7f\xfb\x03O.\x85\x8f/b\xf3\xfe}\xf8A\xe9\x0e\xb3`<\xfees4\xb7\x9f\xef\xbf\xfd\xed\xa1\xf3\x1a@\"\xa5N\xe5<\xdb0\\\xa0\b\x04|\v\x86\xa8Q\x00\xbf\x13\x1evVKntX\x90X\xebJ+~\b=\xd8M\xd3?\xc2F\xd7\xf0\xb4S\xe9\x0e\x84Cp\xb8A\x87&E\t\xebCX\x1al\x9c\xcd\xc0\xef\x10\xfe!\xd2\xc7\"'\x10F\xb6\x84r\v\xeb/\v\x8d\x04ʐG!\xe349\x03f\xb6ap9\x17(\x03(\xd2\x1dw\xf0;̒ZR\xeel\x8eΫʝ\xe2\xd3\xda2\xad\xb7=\x84\xde1\x88\xb1\x17H\xde+Ha\xce\xd2\xe6(K\xdc㤊\xc0a\xee\x90\xd0\xc4\xdd\xd3\x11\f\xdcI\x18\xb0\xeb\xef\x98\xfa\x04\x1eб\x18\xa0\x9d-\xb4\xe4-\xb6G\xe7\xc1aj\xb7F\xfd\xa7\x96M\xe0m\x98T\v\x8f\xa5/7O\xf01#4\xec\x85.\xf0\x9a!\x84L\x1c\xc0!\xcf\x02\x85i\xc9\v](\x81\x7f[\x87\xa0\xccƮ`\xe7}N\xab\x9b\x9b\xad\xf2U\xa8Hm\x96\x15F\xf9\xc3M\xd8\xf5j]x\xeb\xe8F\xe2\x1e\xf5\r\xa9\xedR\xb8t\xa7<\xa6\xbepx#r\xb5\f\xaa\x1b^0%\x99\xfc\xbf\xca\v\xe8]G\xd7\xe8\xbd\xe4\x9d2\xdbVC\xd8\xdc\x13\x16\xe0\x9d\x1d\x9d2\x0e\x8d\vm\x80\xae\xdc\xe0ˇ\x87\xafm\x87U\xd4\x11\n%\xee\xcd@jL\xc0\x80)\xb3A\x17\xc65~\x89F\xe6V\x19\x1f\xe0O\xb5BӇ\x9f\x8au\xa6<\xdb\xfd\x8f\x02ɳ\xad\x12\xb8\v\xf1\x13\xd6\bEλU&po\xe0Nd\xa8\xef\x04\xe1\xab\x1b\x80\x91\xa6%\x03;\xcf\x04\xed\xd0\xdf\xfc\xb1\x94U\x89Z\xab\xa1\n\xd9#\xf6\x1a\x8a)\x0f9\xa6\x9d\xedӏ\x13`7\x1d\x890\x10\x82\xe26\x87\x18\x8a\x13\xf8\xba\xc3\x03\xecĞM\x8d@\x1cN2\x14\x86\xbdA\xc4=jM\x19՛\xc7nZᆵ\x8a\xfb\x85Ó5\xfa\x00\"ϵBYm\xb8u\x19\x96b\x10\x93ּ\xf3@\xd8w\x01\xee\x99Z\xe7\x90rk$+P\xad\x8a\xc3\x10\xa1\xde#5\xc1h< \xf1\x93\xea\x82<\xba\aN3\xf2w\xb1F\xfd\x80\x1aSo]\xbfg\x0f\xf6\xbbсqﰉ\xf7\xef\x93NˑD\xe0\x85\x978\x87UE\xa1ː\xf5dm\n\x82'\xe5w\t\xdco\x823\xa8\x8dBy=0`@~#\"\x13>\xdd1Xʇ\xf4\xc0X\xa3\x84\"\a\x87[\xe1\xa4F\xa2\xca^\xa6J\xb7\x03\x12K\xac\xa3!\xbb\v\xe77\x9f\\\xe7\x1d5v>\x94V\x1e\x90Y\xcfw\xb4\xf2\xae\x1d\xf91\x85֜\fW\xe0]\xd1N\x89\xa7L\xcdO\x00\xe1\xc3\x0fN\x195\x85\x00\x984t\x7fH4/S FK\xf3b\x81*\x048*)\x87Y\x88v\x83\xb2\x817R\xa7_\xb0\xc6\xed\xc7\xdfP\x0e\x8fP\x1e\xb3\x11E{\xaa\xdeN\xa8SF\xf4\xaa\x85\xf9ň\xc8H>\x852\x14#?]\x83\x80G<\x94[\xd7\x00\x03,*!\xe00\xa4\xc9\xe0\x8e\x8fx\x18\x15*L\x9d\x0fG\xfaL\x9b\xaeL^x\x18o\xec\xc1\xf1\x88\a^5+\x16q\xe1\x17Ag~U\x83ľY\xf1ѱ?oǬ9\x11\xe5\xbbO\x85\xdal\xf5k\x98\x9b\x04\x1a\r\U0004ecdf\x0e|\x87v*\ao'Drd/\xc9b\xc5F\xbe\t\xadd\xadO\xf4\xbf{s\r\x1f\xad\xe7\xff}\xf8\xa1\xc8O\xc3\xc1\xb6\xfc\xcd\"}\xb4>\xf4\xfeip\xa2j\xb3\xa1\x89\xddٸ\u0080pN\x1cx/\xb6\xe9\n\x85h9\x1cm\x9a\xbf\x1abEL\x18\xac\xab0`\a)'\x89Ⳃ\x02\xbf0\xd6,1\xcb\xfdaj\xc9P\xceݑ\x1f\x80\"\xb0\xae\x83\\{\xaaI\x89]5\xa2\n\xf0\x95\xc9Sl\x89TX\xf3\x11\td\x11\x80\b\x04NxܪtRt\x86n\x8b\x90s\x9c\x9bZ\xd5d\x1c:\xc3\xd6U\xb7\xa0\xf7H\xaf2p\xf5xj\xf3,'BͲ\x86}\xa4\xc3\bϚ\xab_H\b!ɍ\xa0\xd1>\x91\x9e\x8ah'\x11\xeb\xf8}k\xea\x92e\x88\x9c=\xffO\x0e\xcf\xc1\x89\xfe\x82\\(G\t܆\xb3u\xe7\xcc\xd8~\xda#\x94\tN\xd8\x16\x9e\x89\x9c'`+\xec\x85\xe6\xf4\xe1-\b\x03\xa8C2\x19\x11j7G\t\x96\x0f\xa4\x96\x90\xcd\x05\x1b\x85Z\xb2ثG<\\]wvȈD\xee|o\xaeb\xea9ڔu\x9e\n\x1c\xe3*\xb4]%G\tvD\xf6\x89\xb4;\xe9%\x93\x8d?\x96|]\xe3\fz\xa4e&\xf2e\xe9O\xdef*\x9d\xe2\x9f}\xee\xb4ZLz\xc3\xdd\xd4Xƹ\")/\xcfE\xaf\xe1\xbbU&\xde,\xb0Y>}\xa9-9\x84潇'\xeb\x1e\t\x04M\x11gi\x91\x1aS\xfb'\vi8\xd8\rHL\xed\x129\xa0\xf2%\x043\xd9@cÉ0Y\xcc\x0e\\\xd3\xe4)l\xb0H\x1c\xfe(\xd0\x1d\xc0\xee\xd15\xd9t\x82\xa26,\x8f\n\x1dRo{o\xb1+\x1f\x91\xca\xc6\x19\xe1\xd6\xc4\xf0>(\xb6\xa7c\x90\x83\x04B\xeb\xd2\x1b\xc3\xd6g\x8e<\xd2uP\xaa\xb1\xf5\xe8\xc5\xf9\xbc\xac\xbf\x98\xe1^=\xb8_\x9cV\x9fO\xacO\xa6\xb4i\xffx&\xb9~>\xbd\x9e\x10\xc9\xe1\xf54\xc1\x9eG\xb1O\x92\xec\x1e0/H\xb3O\x11\xed\x19\xf9\xb2K\xec\xceX\xc6\\\xba=)\x91\x17\xf0\x1a\x84\xfb<\xca=\x1b\xa6Ӵ\xbb\a\xd2K\x11\xefW\xa4ޯA\xbe\x9fG\xbfO\x88\xac\xc9\xf9\\\x02~2^\x9de\xfbS4w\x1e\x11\x9f\xa6\xe23\xc8\xf8\t.5O\xd3Vz\x1dS\xf4\x1cR>\v\xc3ξx9b\xfeJ\xd4\xfc5\xc8\xf9\xeb\xd2\xf3\x93\x04\xfd\xa4\xe7\x9ch>\x87\xa6\x9f\xbcv\x1c\xf7P\xfc\x91\xeaB\xa2\xec\xf0\xde\xea\xd2~\xc0\x05;~\xf5arpI;\xb4J\x91\xcd\xdbe\xec\x13\x97\xc1\x01\xb8\xf0E\x90?\x9fT\x1a6_a\"\xa1\x0e7(|u\xe4-\\\xfd\xc2F\xd6z@\xe8\xc89!\xcc\xc1\x9e\x805\x02\xf1d&q#\x98\x15\x87T\x02G\x1f\x8d\xf8\xbf\x91(?\x1a\xfd&w\xebO\x1b\xae\xfe\xf6\xfc<Ӎ\r\xef\x19\xaf\x7f\x05\xfe\xbf2\xdf\xe8\xd5\xfb<\x03\x0eHT\xf4V\rx\xb6ɨ\x89a\xcd٤F\x8cƈ>;=\x7f\x8fT&ʫ\xc2xi\x98\xb7\x82˹\x9e<溵ǔ.\xc9\xd4_\xb8c\x8d\xe0-\x83R\xaaU\x06ڹ\xd0\xdc\x0f\x8f\xaa?\xd3\x11<\xed\xd0\xef\xd0\xcd\x0f\xceu}\xc2\x1a\x1b\xac6ֱ+\x92\x92L\xa6\x945\xa7Л\x85\xc3\xdaZ\x8d\xc2\f#\xf1\xbc\x84u?9\xb8\xe78\xcfMX\xa5\x86=\f^*])3\x1a\xed&\xd2UUy\xc3\xdf\xc4K\xc6\x13N\x8d(Ǧ\xec\xcd\xf6F6@\x13\xfc\x9eg\xf8\xb1\xe1=\xd3\xf7\xd3\xceO\x1b\x7fff\xba\xfa\xe5\xea\xed!}6\xb6\xa3h\x1e\xc1t$\xb8*ˡ@\xf7\x02\xc7+\xfd\x97\xef\x10kAo\xd49\xcf\xf5\xc61\xf7\xab}k\x06^\xc7Q\xa6\x05\xd8\x1b\xdd̺}\xaf~\x02\xab\x99\xc5+\xad\xcf\x03\xfc\x05\xe0H&p\xaa3\xa1\x1c\x93\x8bL\x8cT{%\vQ\xdfKwk|\x1a\x1c\xf9\xea\xc7(=\x16\xaf\xab\xd1\x1dx\xe1S\xd0]\xe8\xe4\\Ȧ/@\xfb\a\xe4\xa1>=\xf4^\xfc\x1e\xfb\xdc[\xecQ\xcfz\xb5\x1b\xec\x9a\x13_\xcaC.\xe5!\x97\xf2\x90Kyȥ<\xe4R\x1er)\x0fy\x8d\xf2\x10{^EȹE \r\xa7;\x92\v\xa1\xe6\xf8|N\x97\x15ګ\\#\x7fj\xde+9x\xb2\xf3\\J\xfe\xa4\xb4\xe6\x909^Hү.&xB\xadA\fY\xech\xe5\xb1rd\xa2N\xe4:\x1eҭ\xc1\xe3b\xf8RɌ\xebOXǂ\x86\xdc`4\xe2M\xb3\xaeK]ɥ\xae\xe4RWr\xa9+\xb9ԕ\\\xeaJ.u%\x97\xba\x92K]ɥ\xae\xe4캒A\x1d\x8e^\x12\xff\x8bg\xd9\x12M\xde:\xb1mOFźf\x9c+\xf8\xf3\xaf\xc5\x7f\a\x00\xf7\x8b\xf57\xde@\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}ks\x1c\xb7\x95\xe8\xf7\xf9\x15\xa7xo\x95\xa4dfd9_\xeee\xa5\x9cR(١\x13K\\R\xabT\xad\x93\xad\xc2tcf\x10\xf6\x00-\x00Mj\xbc\xde\xff\xbeu\xf0\xe8'\x1a\x8d\x1e\x92\xb1\x9d\xf5\x8c>\x88\xd3\xe8\xd38O\x9c\x17Ы\xd5jAJ\xf6\x91J\xc5\x04?\aR2\xfaYS\x8e\x7f\xa9\xf5\xed\xffSk&^\u07bdZ\xdc2\x9e\x9f\xc3E\xa5\xb48\\S%*\x99\xd17t\xcb8\xd3L\xf0Łj\x92\x13M\xce\x17\x00\x84s\xa1\t\xfe\xac\xf0O\x80Lp-EQP\xb9\xdaQ\xbe\xbe\xad6tS\xb1\"\xa7\xd2\x00\xf7\x8f\xbe\xfbb\xfd\xea\xcb\xf5\x17\v\x00N\x0e\xf4\x1c$UZH\xaa\xd6w\xb4\xa0R\xac\x99X\xa8\x92f\bs'EU\x9eCs\xc1\xde\xe3\x9eg\xe7zmo7\xbf\x14L\xe9?\xb7\x7f\xfd\vS\xda\\)\x8bJ\x92\xa2y\x98\xf9Q1\xbe\xab\n\"\xeb\x9f\x17\x00*\x13%=\x87w\xe4@UI2\x9a/\x00\xdc\xd4\xcdcWn\xd6w\xaf,\x88lO\x0f\x86\x1c\xf8\x97()\x7f}u\xf9\xf1w7\x9d\x9f\x01r\xaa2\xc9J$V=7`\n\b|4\xb8\xe1\x04\f\xadA\xef\x89\x06IKI\x15\xe5Z\x81\xdeS eY\xb0̐\xba\x86\b \xb6\xf5]\n\xb6R\x1c\x1ah\x1b\x92\xddV%h\x01\x044\x91;\xaa\xe1\xcfՆJN5U\x90\x15\x95\xd2T\xaekX\xa5\x14%\x95\x9ay\xc2\xdaoK\\Z\xbf\xf6py\x86\xe8\xdaQ\x90\xa3\x9cP;eG2\x9a;\n\xe1l\xf5\x9e\xa9\x06\xb5>:\x0e%\xc2Al\xfeA3\xbd\x86\x1b*\x11\f\xa8\xbd\xa8\x8a\x1c\xc5\xeb\x8eJ$N&v\x9c\xfdP\xc3V\x88(>\xb4 \x9a:~7_\xc65\x95\x9c\x14pG\x8a\x8a.\x81\xf0\x1c\x0e\xe4\b\x92\xe2S\xa0\xe2-xf\x88Z\xc3w\x86=|+\xcea\xafu\xa9\xce_\xbe\xdc1\xed\xd5$\x13\x87Cř>\xbe4\x12\xcf6\x95\x16R\xbd\xcc\xe9\x1d-^*\xb6[\x11\x99홦\x99\xae$}IJ\xb62S爰Z\x1f\xf2\xffS\xb3\xedYg\xae\xfa\x88\x92\xa7\xb4d|\u05fa`\xc4<\xc2\x01\x14x+K\xf6V\x8bhCh\xc6w\x86%\xd7oo>\xb4匩\x0ePptonT\r\v\x90`\x8co\xa94\xf7YiC\x98\x94\xe7\xa5`\\\x9b\ad\x05\xa3\xbcO~Um\x0eL#\xdf?UT\xa1@\x8b5\\\x18\xdb\x01\x1b\nU\x99\x13M\xf35\\r\xb8 \aZ\\\x10E\x9f\x9c\x01Hi\xb5B¦\xb1\xa0m\xf6\x9a\x8f\x1dl\xa9ֺ\xe0\x8d\xd7\b\xbf\x9c\xf6ߔ4\xebh\f\xdeƶN\xcda+d\xc78\xa01k\x14v\\i\xf1k\xb5\x1f-X\xffJo*\x7f\xac\a\xa2\xfc \v+\xce>UԘ8\xab\xb1t`R\x06 \xc1\xcfψEw\x92\x11\x9a\xe2\xbf\\\x1e\xaf+>1\xcb7f\x90\xa7\x0fUp\xbf\xa7z\x8f\xa2(@\xf0\xe2\b\x998\x94D\xa2HS`\x9a\x1e\x14\xb0\xbea\xc1/^vX\xdc3\xbdw\"kL\xa1\xf9AT\x1aH\xa6+R\x14G\x87\x12\xaa\x0e\xe1G\xbdg|7D\f\xe0Þ\xe2Ȫ\xd0H@IK!5́q\x03ܑ\xe5\x99\x02\xa5\x89\xae\xd4ڢ{mn\x18\x82\xe3UQ\x90MA\xcfAˊ\x0e.[2n\x84((\xe9\xa3G?gE\x95Ӽ^\xb5\xd4\x04M\xdf\x0en@\xf3\xaa\t\xe3hGp\x19E\xf6\xf3\xe6*.K\x03\x90\x00Hv\xd4d\xc6-\xbc\x1e\xeaC$\r\x7f\x86\x93\x8bJI\"i\x88\x94\xe48B\x18\xefʤҥ\x1e\xef\fk\xc12\xda^p\x8d\x86\xa0\xca\x10\x8d4\x18\x00\x85\x9f9U\x98Ҍ\xef<\x96W\xa2`Y\xc0\x90\x00\x90<7\x8e\x1f)\xaeF\xcd̀\x88\x06\xdc\xf1ñ\xa4\xb0\xa7E\xa9\x9c\xea\x1e\r\rކ\x9e}\x9c\x8bz\x8fiatZ&\xa3E}\xd8\xd0=\xb9cB\x06\x9eYRٰ\x18'\xb0\x84[z\xa49l\x8e\x9e\x81\r\xfb=W\xb7B\x1e\x88\x06\xb1\r\x00\xfc\xbd\xbf\xe3\xab\xf5\xef\x8d3\xfb\xd5\x12\xe8z\xb7^\xc2Y&\xf8\x96\xed\x0e\xa4Tg $\x9c\xe5\xb4,\xc4\xf1\x80Nߚ\x94\xa5:[\xa3y\tMҐ\xb7F.wkE=7\x9c7hrK\x15\x94\x92f4\xa7\x1c\x85\xf7\x8e\xca0\xa5\x8e\xeb\xd3$k\xb0\xf0\x8d\x8a\xd6\xf1\xfc\x04\x06\x1e\xe7\xb3\x0f\t\x81\x1ci\xf9\xba\rU\x04lj \xf9i\x18\a\x85q/ĭ\x9a@\xf0O8\xa6q\xac 3\xf1U\x8d\x8a3$\xce\xcf\xddP\xa0\x9fiV\xe9\xc04\x01\xf2\n\xe7\x80\x12S\n\xa5\xc7Mʸ{\x80\xdf\x7f\x88M\xf0\xf7\u07bc\xbf\x15\x1b\x05~i5\x88\x82\xac8\xc7\t\x10\xf8Vl`C\xb7\xa8R\x84\x1f\xdd\xcaKd\x84\xc8\xce͔@\xb6\xb8趹\x8akx\x81\x1c[\xc2\xfd\x9ee{|*\x7f\xa6A3\x9a{\xf7\xdeÅR\xe4j\x88qԐ\x8e\xb9aߊ\r2ǚy\xc4вBVf!DG\x13\xe3\x05\xc6\x1d\xbeA\xc7\u0099\xcaf\xb9\xf4\xae\xd3 К\xc3\"\xfbu3\x18\x1f\xd0C\xeb\xc2\xcf\x18e\x8a\xfa\xdbM\xd0C\xe4\xae2\xe6\x05\xe9\xe9$\xccٰ\bt\xf0\x8e\x01\x95\x1e\xado\xc5f\f\xa5I\x1eL\xaaS\xfb{`\xfc\x12\xa5\xea\x1c^EF\x8d\xafs͇\x1dȎ\x9e\x8f^\xee\x11\xf1\x12G7$\xf4\xe8\x1b \x89DH\u0090\a]\xf4\x91I\xb5\xdd\xf4\xb6\x7f\x8eB\xbb\xf4S\xc2\x01x1\xb7*\x16\x81\r\x1d\xf5C\xf9\xf0\xc0\x1e\x05-\xe36\xce\xc2\xcd\xdc\xd1F\xd0\xfe\xe0\x113\x1a\xc9\xf8\x83''\xf8[)\x85L\x9e\xda{;\xbe\xb5\x18\xedŽ\x0f\x8aj\x8b\xbd'w\x14\xd8\x16\b.\xb9+G\xd5\xc8#\xc0P\x1a\xb6\x84\x15j\xd9\xe1\x84ҢTޮv\xa2\x10\x1f\xdbl\x81\xe9gj\x11\x81\r_\x13V\x18\xf7\xc1<\xa1\x92Tyi\xc1E\xc3\xcf\xcf\xcc\xc1\xdawRܓ\xa3\x02\x8a\xa8\xaa\xb0+\x13\x14\x9c\x18;(\xaf\x0e12\xaf\xe0B`v\"\xb0\xdc6ߕ\xc1\xe5\xa1L/\xf7D\xa5k\xda\x15\x8e\x0eśNAp\rT\x83\xa5/\x02\xdd\xc6I\x8ed\xf9\xc4\x02\xf80\x92^E\xc5n\x05Wb\x90 \x9bMME\xe5\x1d\xcb\xe8\xeb,\x13\x15\xd7\xe1<\xc3\bio\x06\xb7\x86L\x9a{\x00\x10;,\x02\x1c\xba\xf6\x81\xa85\\nM\xec\xe5ٗ[\xf5\xca\xe9\x96`\x98\xde\x03\x9d\"\xea\x8d-b\n*E\xf3\xf5C\t\xa8ف\x8aJ'S\xed\x83\x1d\xdfI\x17\x1d\xc8gv\xa8\x0e@\x0e\x0e\x0f\x03\xb5&\xc7!\xb2\x18\xe2?Yq/\xc1hMp\x91W,\xa7\x92\xe6\xc6d`F\xee\x8d%\x19f\xec\xe0\xd5\x17p`\xbc\x9a\x10\xcf\x04\xe41\r\xc8$\xed%4\x9b\xefʻ,\xa3\xd7\xcd*<z\x15\x99\x15\xbdh89:\xc2\x18\x8a\x91\xab\x91\xa8')\x86\x98\xf6U|\xb0\xa2\xce\x1fǹ\xf5\xf1\x14z\xb8\x9d|\xa3\xe0\x14\xad\xd0\x01\xd9ߌ\x95\xa2\xb2c\xc7m\xd9H\x9c\x02\x1b\xa2h\x0e¥9\xaa\x82*\xf7,\x1b\x9dքW\xcbQ\xd05\xf26E_\x90\r-@тfZ<ȅ\xa6\x83\\W\xb2\xe2\x05\xd2dͲб\rq\U000ef14bjL\xf6\x1c\x97]\xb3\xbc@.\xa82\xd6\n+<\x818<\x91\xf7\xc9ڗ(\xa5)\xb2ڥ\xad\x97\xb4\xf9\xa4\xad\xefl-\xb8n]t\xbfk\x11\x81\t\xff\xa2\x84e\xbc/yɔ\xbd\x1c\xdc\xfa\xb8B\x8b\xb2ʨ]i\xe9\xa1\xd4\xc7%0\xed\x7f\x9d\x82H\x8a\xa2\xf5\xfc_0c\xe6K\xfce\xff\xceG\x95\xf8(W\xa6 \"W\xea\xc7\xff\x02\x99b\x16\x8b\x1b\xb7V$3\xe4/\xed\xbb\x96\x18Xy\x86\xe4KزBS\xd9\xe3̃\xf4\xe51\x88\x91\xb2\xde\xe1\xf7@t\xb6\x7f\xfb\x19\xbb\b\xea\xce\x05\x80D\xba\xf4o\x06\xd6.\nu\x17\xe6\t\xb8\xb5\xc3g\x12OkW0k~1\x81\xd1\xebwo\xe2Nu\xa2\xe4\r\x10yݛl\xfbѮ\xb0\x93\x8a\x86s}\\2H\xd9\x1a\xbbZ\x02\xc1\x02\x81\xf5X\xb0s\xa1\xa4\x92\xe0\x83F\xcae\xfd\xaf\xa4\xa6e\xc1\xa8\xff-=\x1a0\xae\aa\xf2\xeeTQpM\x044\x90\x84\x9f$ \xce\xc9\xc5g\x96\x92\xf8\x03\xe2\xe6´d\xe2\xe1\xbf\xc6\x16M\xf1z\x96!\xf1_O\xfb\x13Ь\xd9ִ>X\xc6>âna*\xf2j\xcf\xca$\xc8f\xe1D\xc9\u0092\x90\x93\x935|$\x05\xcb\xeb9ڤ\xcb%_.\x92\x00\xc2;\xa1/\xf9\xd2\xd6I\x94\x91\x927\x82\xaawB\x9b_\x9e\x84\x9cv\xe2'\x10\xd3\xdehԋ[\xb3\x8dth\xb7\xa6$\b\xb7\xfdw\xb95rV\xb3\x87)l\x13\x11\xd2\xd3\x03/\xba\xc7\xc5ׇ\xee\xe7P)\x8d\xc5 .\xf8\xca,\x95\xebГ\fi\xd5\"\x01\x1ef\x15e\x87#é\xd5\x0f\xb5\x0fL\x04\xfb\x01=/\x83\x9a\xeb/(\xb0#\xcd׀L\xc3\x0f\xd1t\xc728P9\x1a\x15\xf7\xbf%\xda\xf7\xb4)$Zݓ$,mi\xf7\x9f\xa9\xc4A\xf3Y\xa1\xe6&\x8c\xf2̞\x1c:\x19\xf8\xcf\xc7\xc8,\xb1\xc6\xff\x98\xa4nj\t\xfed^t\xb4\xb751\x149\x02\aR\xa2\xfe\xfe\x17.sF\xa0\xff\x1bJ\xc2d\x82\x0e\xbf6\xfd\x95\x05\xed\xdc\xebj\xe6\xed\xc7\xe0\x13\x98\x02\xe4\xef\x1d)\x86\x1dd\xc3\x0f\x1aX\x0e\xb40>\x04ή\xef\xb1`\x11Q(\x8a\x82\x00[F\x8b|\x11\x85\x87\ue142\xb3[z<[\x0e\xec\xc0\xd9%?[\xd6\x05\x92Y\xe6\xa6\xf6\x16L\x9bҙ\xb9\xf7\xec!NP\xa2$&\x0e\xfb\xbc\xba\xad\v\xe5\xab\x03)WNz\xb58\xb0\xec)\x8bV\xce=^/\x1e(\xbfX\xcc\xf8S\xb8\xfc>2\x9f+\x7fGק\r\xe4\xcb&#Y_A\xf5Ƙ\xbb\x9a\x9b\xcb\xe8[\x03\xed#\x87\xf5\xe2A6\xb6\x83C`\xb2ub\x8f\xd4\xf5\x04\x8cף0\xa1\xd77\xb2^<\x8e\xb7\x89t\x99\x1a\xd3\xc3\xe8\xed\xe7Vn\x92p\x93V\xec \xf2\xd8ް\xcb1\xa7\f=\xa1\xbe\x9e\x04\xb5#C\xd88iz\x10M\xa3\x813\x1bԗ\x89\b6<$\x02\xdd\x13\xacLQ\xee\xc97iR\x92ep\xa6nί\xe3\xcf_E;V\x96\x9e\xe2\xf9_Ԥ\xae\x19Z\xff0\xdd\x19\xd1|J\x91c\x99PҎT\f\x13\xe5\xe8i&\x82\f\x14\xb1J\x91?S\xb0eRՑ\xa8\x99y\"ĩ\xda\xd5\xc9\x1cF\xec>L״Fx\xf0\xb6\xb9;R\xe1J\x82\vu\x1d\xac[\xa0\xbf'L\xd7\xddah\x19Q\xf9|\xc55\x11\xb2\xab\x96\xf9B\x99\xef\xaaG\xdc+\x14& \xbe\xd8\xfe$4N\xe8[\x18\xa1oB\aC\x12Ph\xf590\r\x94\x9b\n*\xe6\xc8\xd0dc\xe7\x80'\x06߅\xb6\x17\x8c}\xd2\f|J\xf9{fo\xc1\x8c.\x83\x93ن\x92\xf7\xb5\x90ה\xe4\xa7$`\xfeں\x1d(W\xa6\x95Û\x97{V\xa4\xcd\x199\a\x05\xa9x\xb6\xa7\xc6N\xf1\x8e\xf9\x00\v\x9eq\xa5)I]h\xc4\x16\xaem+`\x1a\xef\x92S\x9ci\x1d\xef\xa1\x0f\xd2\xda\x19\x92\x13I\xfd\xcf4C5\a\x12A\xda\x06V\xcb*g\x8b\x88֘N0\xa6H`WJ{\xf5Y?\xbe8ω\xc1\xdd,&G&\xc6*\xf8o/T\xc2\xfa\xd2aꟄj\xb8\x89\r\x9eJ\xff\xafr,\xad?\xb9\x97Bh\xdf\\\xe3\x1dC\xb8\x13E5\xda,\xd1\xff\xe6L\x9a\\\xef\xf1_ߟ\xfcu\xa5\xfdE\xae\xb4\tMU#l\xfb\xd5\xf9\x9cr>\xad\xa9P'\xd0\xf6\xa3\xbd\xb3\xdeD\x80I\xa0V3j\xaa>\xb8\t`\x87\x91\xaf\xb1662\x10f%\x82\r\xf7\nz\xb8L\xd5\x00a\xb0Uy싥\xf4\x80\x99m\xe3\xfcs0\xa1'\xbbciV\xf4'\xf6\x14\xf0\xb8\x82\xf3\xc5,A\xbd\xe4\xac\xe5)p\x03\xe2I]\x05|@\x9d~8E\xb5.;\x00\xd0q\xf0\xe9L\x04\xdd\xf8\x973܆\r\xc5\x1d\x7fvǍ\xc9:\xf9\xec\xa6\xdd\xc1=\xd9\xf2\xfd \xe9M\xe2l0wm\x8a\xb6\xf2\x8e\xae*~\xcb\xc5=_\x99\x9c\xbfz\"\xd9~\xf4\xc7\xff2V\xae\xae\xbc&\xc2m\xadt\xebţ\x1b\xb2d\xb9I\x1c8-\x05Sv-ڀ<9\x8b\xd8\xf3#7\xbb\x96\xb4\v\xbb\xdb\xcc\xd7\x05\x02\xda\xd73\x1f\xc1\xbb\x02\xfb\x1e\xdc6\xb6\x959\x1a%d\xa7}\t\xa1>\xaacC\x9b\xbd\xcf(?\xdeo1\x9d\x14.\xb3ZۓpJ\x14\x17\xa8\xa5o\xdbǦ\x11\xa3M\xeb\xc5̅,\x96C`\x83F\xc9\xf3\xc5\xdc\xce\xca\xee\xf6\xf0\xba\xb3\xd1\xef\x0f\x17\xfe!\x03\xc0\xfe\xb8\r{tK\xbbm\xaf\xdb\"i<'?\xd3\xf5\"\xd9\xceF\x15)\x89h!9\xf4\x13\x99)d\xc9\xfb\xe9c\xf4\x1a\x8aM\x9bb\x8d\f2\xde>\xea!N>\x80\xd7\xdd9@Fpc\x04lEQ\x88{\xbb\xf3\x9b\x18n®\x10\x9b\xfa\xcc\t\ar\xa4D`\xb8b\n:\xb64\x8dK(\xc2Pv\xf7\xf7p\xb3\xf7\xcb{\xbaY\xfd\xe6\xec\xa7篦\x87\xf7\xa5ST\xb7\xbaL\xb18pKˈ\xa0\xa6\x9b\xa5\x05\xab\x0fH>\xcc\xd2\r \xdab\xa4\xablbl\xff:Cp\xae\x10\x8f%}\xf8\xd0\xda\xc5c*\xe9(J\xaf`/\xaa\xc0\xee\x80\bu\x90\xa2\xd7T\xcbc\xd2\xd6\xf4\xcb\xee\xe8\x16b\x18ڷ\xe4ѝ]\x84\xd0qb\x92jɂ[\xa0\xdd&\t-\tWx^N\xb3\xe9\xce\xfc\xfe\xfa\xea\x12\x8c7!\x9d\xac\xe8\xbd\x14Z\x17\x8c\xef\x96@u\x96{w!\xb4\x04c\xd4RqrG\x98i'\x85{\xba\xc1\x80\xd3v\x05W\\Qי\x84\xb3T\xa6\\\xe1\xf7lآ\x92\x99\xcaz\xae8\xc5\xfd^\xf4\x1b\x18)\xf0\xac\x19\xb1݆F\xf4I\u07b9\xc1'\xc4\x10k㐠N\xdah\xb8\x997R\xbb/\xca\xfe\xd3\xf0\x05\xfbԟ)\xc8E\xb5)\xdc\xc2DI\xb6w\xba\x8e:j\xe0\xf4v>\x81\xa2\x99\x18K\xa7F\xb5\x10\xdb|>\xa7\xe3\xfd\x1d\xf9\xdc\xc3ٻr\x01\xdcI\x7f\xaeA\xf8Ʃ\xff\xdd\x17\x0e\x05u*\x0e\xa8\x00\xa3QM\x1f\a7\xd8\xe3`\xa6\t\x9b*\xc7C\xc8\xc4\xd6R\xdcqcM\xd7m<\x83\xe0Q\xfa\x0e\x1b\xbb\xf9\\:\xd0b[\x87٨.\x19)\n\x1f\x9e\xf8\xcc\b>!\x8c\xae=\xae\xe3\x1c\x18\u05ff\xfb28\xe2\xc08N\xe7\x1c\xbe\b^\xb6<\xc7c\xc5v\x81\xe4\xfd\xb8\x87\xb8j\x91r\x8e{7\xd1\xdc>\xdeҎ, \xe6쪻W\xeb\xee\x15-\\\x83\xbbY\xa4\x060q\x8fA\xddC\x80$e<gw,\xafH\xd1q[Z\vm\xb3\x1ec3$gE\xa8\xb7\x95\x14\xcd\xfd\x9d\x85\x19\xde\x1b\x04H\xf1\xc8Ƨ\xdf\x18\x96 \xc2s\xba\xdf}<`\x17\xf2 l\x98\xdd\xee5\xba\xe4?\xa0\xbf=ސ>\xa7\xab\xbd߳>\nt\xba\x97=κ\xa4\xbe\xf5\x0e9Һ\xd5}\x1fz\x04*L\xf4\xa8OXL\xb7\xee8\xaa%O?\xb5\v}r3Ob\xefy\xb7\xab<\x0erF\xc7y\x12q\xa6\xbb\xcb;\xa4I\xe9)w=܋\x94=\x02\x93\x9d\xe4\x81\x1e\xf1\xc5\xccNu\u05ec\x1f\xe9\f\x8fB\fu\x8d\xa7\xf7\x83GA\x9b^\xf1\xe9.\xf0\xa8\x1d\x9a\xc1\xebX\xbc\xe1?\xe3\xab\xe6tO\xf7d'wdmM\x99_\xabW\xf9|\xf1\xd0\x0e\xedI\x8au\xe4>\xbd\x1b\xbb\xee\xb6\x1ey\xee\xdc\x1e\xecn\x8f\xf5\bД\xce\xeb\x91\xce\xea\x11\x88\xd1~\xeb\xd4~\xea\x11\xd8\x13\xcbnTJ\xa2\x17;\xc9\xe0\x89>\xea:\xb1\xf3\x1d)K\xc6w\xe7\x8bS\xa5)*I\x1d)z\xd7{fG\x94\xda\xf9\x97N\xe6*\xf4H{\x94\xf0p\xac\xf3\x14ѡ\xd3b\r\xaf\xf9q\x00\xd7\xecs\x0f\xc0\xf4.`#\x95\xa5ilj\x9f\xd6f\xc0\xb6A\xb9XY\x85s\xad8p=\x87\x85Bv\xbccu\x1e\xa7\xe7\xfb\xde\xf0v\xe9%\xeem\x0f\xe0\x82\xf1\xbfO\xf4\xb6\x0fU\xa1Y\x19T\xf9R\x8a;\x86'O\xea==\xd6\xf4\xfc\x87`\xbc9\xcc\xf0\xfdu\xad\x8d\xeb^\xe0@B:tO\x8b\x02\x88\x1a\xa2\x9f\xd9\xd3|3\xb12\xa7\xff!'\xbd<\xb8S\x7f\x97\xe6\xa0\xd6\x00Ls\x10\x85a\xe6\xc1g\xde0O\xb4H^\x8b\xe2\xfe\xb0\x11t\xeb\xb2\x7f\xaa\xa8<\xdaS\x10\xeb\xcdyu\xce0l\x11Z\xa7\xbb\x8am\xc7\\\xa2o;\x88\x13\x1a\xfb\x02\xaf\xb9\r\x85\x82`{s4p\xa8j\xc7Fkxm\u009e\x91\xa1A\xa8\\\xd4w/\xe6\xbb\xda}d£z\xe4~\xf4Hi~\xac\x14\x91\x8c\x14\xf981^:=b\x8a\x80L\xdd\xff\x9b\x125%\xec\xf7\xed\x10\xe6\x11#\xa7\xa9\xd8ib\xe1j\xbe\x9e\x863\xd0H\x8d\xa0\x16\x8f\xb6\x7fwF\f5/\x8aJ&S\xca>\xdd\x0e\x91\x1e+\x96z\xc2h\xea)\xe2\xa9\xd3\"\xaa\t\x90\xbd\xfd\xb7\xd31դ\xbd\x9a\xc5\xfb\xa9\xc8%-\xb6\x9a\xda1\x9b\xb0S6\xea\x1e\xa7ʹ\xb5\xbc\x8eMtN\x9c\x95DÎ^<^\xac\xf5D\xd1\xd6S\xc4[O\x1bqM\xc6\\\x93\x923qyN\xe45\x99I\x1e\x97P\xdf\xe0\xf3N\xe4\xf4JH\x1d\x90\xba\x8e(]\xf5\xc7\a\x9a*ZA\x93(r\xe0~\xe8\x002X\xdf\xdf\xf9\xfd\xa7!\x15\xee\x7f(\xef\xb2\x1b\xf6\x03}\x7fG\xa5d9\x9d\xc4\xea\xe3Egx\v)\xed\xe4\x81*,Q\xa1\xeboO\xca\x1d\x00\xc4cT(\x94\xf8r\x18\xa5\xd1벍\x9d\x90\x15\x84\x1d\xea\x16\xb3ܢ|qs\xe9\xaf+NJ\xb5\x17:x~\x9c\xefPr\xe1\xa9\x7f<N\x88\xa1\xf6C\x81\x17\xa4\x83\x85e)bcͱ\x8d\xaf\x134\x8d{`;)\xee\xf5\xfe\x8aʌr=z\xeap\x87\xb2\xdf\xf4n\xf1\xbeX\xd9\xfcҡp\x10\"\xb4\xe8\x1e\xa72Sf\x92\x1c6GWc\xfe2\\\xe9\xb2Ƞ\x0f\xf5\xea\x8bo\x98\x7f>\x1a\xabW_~\xc3~\x92\xf2\x1a\x80b?\x84\t?o\x81 \xfc\xf8~\xa4*\x8bK\xde\xd4,ڣ\xa2kL\x89\x9bt$?\x87\xff|\xfe\xb7\xdf\xfe\xb8z\xf1\x87\xe7Ͽ\xffb\xf5\xff\xff\xfe\xdb\xe7\x7f[\x9b\xff\xfc\xe6\xc5\x1f^\xfc\xe8\xff\xf8\xed\x8b\x17ϟ\x7f\xff\xe7\xef\xbe\xf9p\xf5\xf6\xef\xecŏ\xdf\xf3\xeapk\xff\xfa\xf1\xf9\xf7\xf4\xed\xdf\x13\x81\xbcx\xf1\x87\xff;2\xa1\x8e\xcdd\\\xaf\x84\\Y\fF\xc4} \xaeh\x05̱\r**gKL\x16\x9c\xfd\xbeN\xdb|\xf5\xd2\xfc\xff\xab\xb3\x91\x89\xb9\x85\xd2\x1a\xba\xa5;\xf4\x9dɡaYå\x1e\xbc\xe0`\x04\xa8\t\xf8\xfb\xfa\x15\x96\xdc\t\xad\x9f\\\x8e\"\x17%%9\xeekU\xdf\x10\x1d\x12\xc9\x0ey\xaf;\x83\aV֧\xc40#\x12{\x01\x89\xeb\x170\xc6ѽ\xfb\x80\xe4^\xe1/\xae\xdf(\xa0J\x93M\xc1\x14n\n\xc4ؤ\x95`#\x99fw4dl]\xff\xc1\xb0ڎ'~\x96\x94\xe7\xf8\x9bm*9<\xb2ee\xfdf\xb2\xf3iY\x1d6\xa0\r\xe8\xe9~\xb7'\x86x\xfa.\"\u07bb\xf1j\x1a\xf2\xbaF\x863\xfbR\a\x0f0\xaf_\x9a\xa7Ζp\xd6\xd0\xf6,DU\xfc\x9e\x1d*|\x9d\x1e߹\xae\x1d\xfb\x82\x90\xca\xf5?\x9d\x19\a\r=0\x96GF\x85E\x1b\xc6N\x05\xb4Y\xa7\xed\b\xb7&\xe3\x95I\xfb\x97\xacSc\x81\x81kvJ\xe0\xb4o=sk'*>\"W\x9f\x8a\xec8k\xfah\x14\xf2m1y\xbay\xade\x8d\xfe\xac\xe1=\xbeMŜ\x99L?g\x94\xe6~\u05c8\xa4\a\xc2\xf8\xf8BЈN\rݿu\n\xa7\x84o\x98\xeaui\x19\x1fR>k^b26\xe3\x06\xf3\xf1-\xfeQVEM\x97\x95\xe7\xefD\x8eZ3Հr\xdd\x1b>P\xb7-\x95\x14)\xa8\x05|{\xf3\xfe]\f\xb7\xd2eF{\x87\x0e\xdb\xfa}\xee\xca\x0eN{;f\xc9\xe8\xc2z1S\x18\xe3Ƈ\x94\xec\x1b|\x81O\x82$\xbe\xbe\xba4C\xbd(\x9a\x17\xff\xd4}\xf4~ΰ\xa1h*k\x8a\x8c\x86H\x97\xdb\x0e\xc4\xc0\x86\xa5\xfaO0\xef\x02\xf4)\x8eї\x95\xe0\xa42\xd4\x05\xec\x9e2\xb3[\xc3ט\xdf\xe3G\x10\xee\xa8{&\xf3UI\xa4>\x1a\x05U\xcbz\x0e#0M\xf6\x04\x1d\xee\x93\x040\xf4\x96\xc3 m\xfd\xcb\x0e\x11\x05\x84\xd8i\"\xeeS\xf4\x94y\x8c\x1fo4y\xb0\xd1#\xceÓr8\x93\x95\xa1\xd4\"q\xe3AD\xb1\xe7E\xcf\x1e\xb7+Ʉda%\t\x1a\x82憘)p\x1b\xe2\xed\v\xb1ƪd8h\xcfv{\xb3\x12\x16\xe2\x1eJ\v\xfbX\xcf\xce\xd9\n\xe1BԎ\x15\r@u\x86\xb8\xbe\xdd\x03D\xe7\xc0\xaa+\xabc\xd2_\xcdɯ\xe6\xe4Ws\xf2\x88\xe6\x04\x95\xea\xeac\x82\x19q\x03\xe394t\xf5||0\x80\b\x80\xf7\x9b\x9c\x92O$\xcd\xd5\xe6X\x1e\xcd\xcd\xe1Ƽ\xe43\r\x1f;\xb6\x83\x12\xeej\xf6,WpO\xbd\xc7\xe3\xa0\x0f\xc0\xdaT\x9a}\xb3\xa8M\xfd\x9a\xa6\x00\xec\xbc\x05.\xfe\xb9m\xb6\x89\xaf\x018\xf9\x05\x00\x96<A\x98\xd8A\x81\x1b\xa6D\xb3[\xb7\xa1K\xd8t\xfc\xc4!\r\xe3=\xc4\x1f5\x8cM!V\x80P\xd1\x00\xb1\x86\xfe3\xa4g\xc4$\xa9\x8c\x14\xc1\x16\xab\x0eio\xec\xa8\x01A\xcd+\xd7k\xea\xa2\xd2\xe6\xf0\xa6ٶ5\x00\x8a)\xc5\x1cP\xb1\xe9\xb6*n\xa8\xd3=\x9c\x04\xf6\xe1\b\x97y\xc1]\xd6u\xde\xe4^\xc8\xdbB\x90\\AU§\x8aQ\x15\\\xb8\x1f\xa4\x9bO!n~ލ\xdc\x05ab/\x80%\x80ϑ\xb4\xf6\xbd\xb9\x84\x86r\x04ST\xab\xb3\xae\x18\x8e\xc0l\t\xe7F\xe8\xfd\xcfP&\xa1\x16\x9f\x04Z_\xbb\xa1\xf5\xf2\xdf\xda\xee\x12\x92\xc1Zf\x82\xa0\xa1+t\xb69RH\xb6c\x9c\x14!\xd8L\xc1--\xb5+S\x8e\xc0<\xbb3\xfb\xc2\xf1\xdd\xeb\x1e\xd6\xcaC@>r\x81\x99+ܯ\xb7\x1d\x99\xecOR,\x88\xb9=~\xfa\xb3\f\n\xcd$\xd5oy&\x8f\x96y\x8b(goz\xc3;JT\xf7\xd6p\xc50\xd7\xeaF\xab\x91l.\xf6\xcf5de\xdbv\xc1\xcb\xed\xcfF\x01\xba\xa3\x12\xb5\x14\xed\r\x1e\x1b\x84\x0f6#\x1d\xf0\x00`ӟ\xa4t?\xf7Ԛ\xcd0_\x85\x03쓲'I\xb5\xe0\xd6\xdb\x7f\xe7n\xfe4\xff\x80\xe5;\x9d\xa0J\xaf\x837\xc6\x1d\xc7q\xa1\x1f\xb2\x86\xdeQ>J{|[m\x80\xfc#\xc0;4\xb6,\xb0\x84\xc7M\x994\xef\x90\xd7u\xa6\xd8w\xcb\x13,\xc7\xdd\x13\x19\xcb4ڃ\xfcP\x19\x11\x9a_l\x1c\xc6aM\x8c\xf9\xb7\x1db|8\x96a\xae\r\x84\xbf}Co۷\x0f\xb7\x9aN^\x0f>\b\x18<\xa9\xdc\x1a\xda\xc4\x19h\x91t\xa1\xac\x99\xf3\xf4\xf4bJ\x8c.\xa3B\x8d\xbf\xe6\xaf\x0e\xfcޗ\xe4S\xd5\xd9U\xdefQk\xc7!\x8evK\xbb\x9f\xf4\xcfp\t\x8a\xbaE{\x9aW\x05\r\xbf\xb8\xb1\xcb\xc4\xd6P\xbf>U\x9c}\xaa\x9a(U\xef\x9bSC\xdc\xe8\x01Lh+\\}܁W\xcbܶM\xe2\xdeת\xf4Or<p\x90GN\xbal\x834\xbc9\xe0q\x7fX\x12\xe4\x1aT\x95eT\xa9mU\xb8\xde\t\xc8$%\xa8Bnx\xf0\xd84\x8f\xc3z1\x83c\xae.yQ\x10\xa5\\\x8f}\x80\xf1\xa9\xd5\xe9\xa8ht\xd9\x13xn\xa7w\xc9%\x10\xdc\xfc\xb0\xfe\xafBH\xd7m\xfc-\xc3ֹǍ\xa8T\xd3=\xeeh\x9fch\x1d\xaah]}\xbcP}\x8f\xb8S\x1f\x06<\xbbҼ\xfe\xc6j/v{\xe4\x92\xdd\xd5\xef\x99\x1egxn\x06cL\xcf\x14d{\xc2w\xcdK\xc2M\xe7;\xb6F5\xef\xab\xeeb\x14\x00kp\\\xcf\xd2!-Y\xa6\xff\xad\x12\x9a\x9cO\xf1\xa8\x1e\x19^\x88\xd0D\xb7)\xea*\xac\xa3ػ\xb7\x01\xeb=\xfe\x87m۷\xb6\xfc\xad\xbaY\xe3\x80\n\xe2;^\xc2@\xad\x90|Bd\xbcAf\xedw6\x829\x8b\xb0>U`\r\xefq\xee\xf7L\x8d\xad\x9e\xbe0\xe6a\xa2\xb1l/`n\xf9R\xb3\xfd\x86\xd8*\xf5\x83\xe0\xf4\x9f\xa9|\xff\xd1z^H\xe9\xb4(E!vG31\xaf]\xa1'Z鴣\xda\x1a\x86-a@\xb6\xa6\x8c|\xac\xdb\xf3p\x9cm\xd2\xf6\xbc\n\xc0\xac\xe5\xe1\xea\xe3\x1c\xb9\x0e\xfb\xcb+g?\xdf\xf53\x82#pT \x0f\x16Ɂe\xa4\xd4\xe6Xc\xc4.\xab\xa4Ć%\x97\xd6\xc2eׯ.\x0e\xa7E\x9a\x1fi\xa7lw\xdbߑ\xe2<\xce\xcb?vG\xfb\xa5N\xd6?\x88m\xfbL\x19\xec\x8a\x1c\xc9\x01\xd8c=\xd0̻S\xd4\xf0\x1dH\xd9\x1e\xbdȮEu\x10-\xf5\xfc\xb5\xe5h\xeeƟ\xa2UW:\xdb\x16\x03\xdf}\xfc\xc8>\xb8{\x9e;\xd2EirH)U\\\f\xef\x02I3!s\x97b\xf7\xc5\xf8iB\xe2\xf7\x9eʚ\t4\x8f\x87\x909\xd1t\x85\xc5\xfe\xe0\xa8\tZLj>6\xd7\x11\xa9\xe7\xd0\xe2\xa6sC\x98\f\x8d\x80\xdd\a\xb7\\\xd5\x0f\xfe\xe9\xb1o<\x8d$ܛ\xe1^\x99\xba\xe2\x9f.\x04\xa4-\x03\xa61t=\x1f\x83X&\xa0\x8d[\xba\xadLT\x91\xd3\xd4\xc3+v}\xfc\xd1\x00.\xf6w\xa9\xda*\xe4\xeb\x16l\v\xc6Č\xa8y4\xb7q\xab\xe0>\xb6t\xd0Cd\xfc\xd0n;\xf1p̢\x84\xf5ƮH\xab\xc5|i\x9c\x90\xc4\b\x0fsy\xbc\xae\xf8\xb5َ7A\xe67\xad\xa1\x8d)\xf7\xfb\xf8Z\xf4\xc5À\xe4q%\xab\xc7\xce`DB\xbf\xceL/q\x9c\x9f\xa2\xdf8\xd7\xea\xec\xbb\xf7=/n\xc2\xf9X\uf632Ɂ\xc677>Ȳq\xe2\xea\x06\xbf\x13b\xd5\xd0\xe2mi|\xe9N\xbbr\xa7\xe6\x96D2%x\xebȥE\xf4m\x9a\x03\xf4\x9a:\xbf˫\x84g;E~\xa7\x9f60\xf8\x1aKc\x91a=\xfc.\xdaw\xf5Y\x83\xff/\x89\xdeG\\\xaf\xe6kjr\x8e\x91h\xc4r\xb65\x8d)>\xd7\xeaqtI\x8d3\x8c\x0e\xd6uVu\x8cӞ_\xcfܞ\fܧ\xe6{\x01|(d\xb89F\xbaIvO\xaa\xe2\f5IKVL\x17\xcb\x03\x8c\n\x95\xcc\xe3'?\xcd@\xac֛Y\xd31w\xb4\xe7d\x7f\xf0\xb3\xaa\xe5=\x02\xb3\xe5\xbb3\xae\x85;\xb2\xccdCL\a\x99\x93\x19\xb0gqNs:\t[o/\x92\x91\xf5u!\x8f\xeb\x0e;9\x9aP\xb2ŉ\xb8\x18\x87\x0fC\f\x1c\x838\x13!\f\x1fұ\xc1@\xa4F\xc5\xdc\xdaƠ\xa7\xad\xeb\xc5\xe9\xa7\xe6\xaf\xe0;\xa6Tl\xe28fr/\xe9\xca\x1b\xa9\x87\x91i\xdc'\x8a\xf6\x80\xf8\x8b\x9eۣ\x03\f%G\xaeFܪd\xbb\x12\xb3(\x11\xf8\xe6\x8c\xc3\v|m\xaa\b\x1fj7/U0V{\xeaH\xd8\xdb\xee3Q\xd6\b\x98\xd7'yI3\xb3\x82\x03U\x8a\xec\xa8\n\xe7\xab\xcd\xd1y\x99\x85rt\x15ղ\xa8v\x8c/\xb1\x9f\xecB\xf0m\xc12\xbd\x04\xdf$.\xf8\x12r\xa2\xc9\x15\xd1\xfb\xa5o\x13\x0e\x00&<\xb7\x8dZKP\xa2\xa9M\x91J\x8b\x83\x01c\xcef\xc8i\xc6rZ\xa7\xae\b`{(#E\xf0`\x87\xae\xabi\x10\xce2ZↃ@nu\x82\xdbS\xdcT\xe7\t\xe4\xefS\x1d;\x86zT7\x98\x9b\xa0sG9z\xdeA\x15s{\x83\x9bw/8\x16:\\m6\x93d\x1a\x0f\xcbsg{\xe2\xf2_[\xf5\x00\xc8n\x16`\xbd\x98#j\xee\xbd\x0fה\xa8Ɋ\xe4\xd7\xed\xb1n\v\xb8\x99\xa2{\xbb91\xae\xbe\x91G\xaeYS\xfa\x1b@\x05\x9f\xb9\\/fX\x9erO\x14\x9d\x98\xe2\x15\x8e\x016L\a\xd5ˊ\xf3@\x17i\xd6w\x05\xef\xe8}\xe0W$\x05\xcd?֚\x12\x18rɯ\xa4\xd8\xe1\xe9\x16\x81\x8b\xf8f.\xc6w_\v\xd9K\x1eE\xc7^\x19u\xad\x8f\xcbU\xb3\x06_y}\xb3s\x0f\xdc\xeb\x82\xc0\xe0\xb5\xe9\xbbG/Xg\x7f\x1cx\x8c厂S\\wÚ\rǌ\xdbh\x12\x15\x8cl|\xd5\xd9\xc9\xe33\xfff\x8eph\xec\x1f\xba\xc6\xe3\x19\xa87h\xac\v\x94a*M\xe9\x15\xddn\x85\xd4֙^\xad\xb0\xbck\x13\x8e\x01\xb8\xa8j&\x93[\x95\x18\xe2b\xa7\x94?(\xc0\xcf\xcc8i\x84cK2\xea#\xfaop \xf8\xc6.`\x9cd\x19nԡ/\x95&\x05}\x8a\x98\xd3\xe9\xc6Ț\xde!\xf9e{\xbcW\xb8\xa6A\xa4\x15\x85\xda\xf4\xbf1hA[\x8f\xff:\xef\x1c\xc5UdK\x86k\xe2\x94)\xab\xd1ht\xa0\xde\f\x97\x8aQ\xe0\xd6!r~\xa2A\x98n\x0emyC\bn\x93N\x7fC\xdd`C\xdc\b\xcc\xc86\xb9\x93褅&\xc5\xe5x\x1cס̇z\xb0\xa7\x85\xb9}\xc8n\x87W\xfcձ\xe6\x14Bw+ʶ\r;\xcdA\xd5ծn\x10\x19[\x1fG\x80\xe6\x15N\xca\xf93N\xf0$Օ\xe4-\x87\xcc\x1d\xa5\xe3\xe2\x9eVU\xf9\x04\x12F\x9c\n\a\xb4s\xb2\xb9z\xad\xb1`\xa9C\xfer\x87\xd6\xd7ћG\xe8?\x00\t\xfe\xe5~\xa6bv\xe4Y\xfcp\xf4\xe9\xdd\n1b\x04\xf1\xad\xcd\xfd)\xf8\xd67\xa7\xe3\xdb\xd4\xeb\x8bc\x93\u061c\x83|\x00\xe8\xe3\x91\xc3.\x8e\xa7\xd0\xc2\xde9B\b\x8b\xdf\x00*\xa4a\xec\xa7\xea\xda\xc7)\xcf}\x0e-\xd8\xc43r\xbc{\x8c\x16Se\x8f\x13J\x1ei\xa9m_\xf6\xf8\x19\xa7\xa4\x9b0\xebmJ\x14\xd2\xf8\x9a\xedx\xa4\xee\x89\xc2x\xa4\x81\xe8\"\x87\x01D\x80\xe7lk7\xf0f\xe8*\xbcX$\xe7\xf6\"\x98$R!\x14k\xfbZ\xfe\x04\xf2\x7fu\xc3\x02A\x98\x83\x10\b\xc3\x06 \xa1\t̼\xe7\x95\x14\x86\xf9I\x8e4Wz\x1f\x88? \x10\v.'\x83\x1fMm%o\x11\xd9=\xe9\x1c\xb4\xac\xe8\xe2\x7f\x06\x00\x0f\x94\x80U\xa6\xad\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}\xebs\x1b9\x8e\xf8w\xfd\x15(\xff~UIn\xa5N2\xfb\xe5N\xb55[>'\x93\xf5<\x12\x9f\x9d\xcaU\xdd\xec\\\x15\xd5MI\x1cw\x93\x1d\x92-G\xb3\xb3\xff\xfb\x15\xf8\xe8\xf7\x83-۳\x99\x87\xe4\x0f\x89\x9aD\x83 \x00\x02 @\xaeV\xab\x05\xc9\xd9\a*\x15\x13|\r$g\xf4\x93\xa6\x1c\xff\xa7\xa2\xdb\x7fW\x11\x13\xcf\x0f/\x17\xb7\x8c'k\xb8(\x94\x16\xd95U\xa2\x901}E\xb7\x8c3\xcd\x04_dT\x93\x84h\xb2^\x00\x10΅&\xf8\xb3\xc2\xff\x02Ăk)Ҕ\xcaՎ\xf2\xe8\xb6\xd8\xd0M\xc1҄J\x03ܿ\xfa\xf0\"z\xf9E\xf4b\x01\xc0IF\xd7 \xa9\xd2BR\x15\xefiR\xa4TE\a\x9aR)\"&\x16*\xa71\xc2\xdeIQ\xe4k\xa8\x1eؾ\xee\xbd\x16\xe7k\v\xe6Ɓ1OR\xa6\xf47}O\xbfeJ\x9b\x16yZH\x92v\x910\x0f\x15\xe3\xbb\"%\xb2\xf3x\x01\xa0b\x91\xd35\xbc%\x19U9\x89i\xb2\x00pC4h\xad\x80$\x89!\x1aI\xaf$\xe3\x9a\xca\v\x91\x16\x99'\xd6\n\x12\xaab\xc9rl\xb2\x86\x1bMt\xa1@lA\xef\xa9\x7f\x1d\xd4އ}~T\x82_\x11\xbd_C\xa4L\xfb(\xdf\x13\xe5\x9f\"E< \xf7\x93>\"\x8eJK\xc6w}o=\x87\v)8\xd0O\xb9\xa4\nQ\x87\xc4\xcc5\xdf\xc1ݞr\xd0\x02d\xc1\rJ\x8e\x80=\x98\xe44\x8eZ\x88:T\x9a?N!\xf3~O\xc1\x8c\xc7S!%J\x03\xa2\xa3\xf64\xf13h\x1f2\x15N#\x04\xe3:_u\xc8\xf5m\xff\xc3\x10\\\x11.h\x96Q \x93\xc8A,\xb2<\xa5\x9a&\xa0\x8a8\xa6Jm\x8b4=\x8e\xe2|S6t\xd0;\x88\x0f\xb5\xb0\xd8'DS\x87{\xed\x05^\x82\xa3XR#\xbc\xefYF\x95&Y\xde\x00\x7f\xbe\v\x01\x86\xf2\x19\xe5\xa4P4i\xf4\xbe\xaa\xffd\x01l\x84H)ዪ\xd1\xe1\xa5\xf9\x0f\xb2Nf\x14\n\xfeO䔟_]~\xf8\xf3M\xe3gh\x92\xbf%\xcd\xc0\x14\x10\xf8`\xb4\x03\x92\xdeh-\xd0{\xa2ARdm\xca5\xb6ȩd\"aq\t\x14ʉ\xdbJ\x91\x19>τ\xc2^1\xe5\x1a6$\xbe-r\x9cTR2\xf3\x12h\xb4\x8bP24U\xba.\xad^\xe8\xbc*d\xbc\x10\x85J\x8f\xb0\x15ҴK\x98\"JS\x89\xe0Ł\xcacT\xf6ȥȩ\xd4̫4\xfb\xad)\xecگ-Z<Ar\xd9VVz\xa92os\xca\b9ΐ\xb2ƛ\x8e$f\xfa\x1b\x80\x01\x1b\x11\x0eb\xf3#\x8du\x047T\"\x18P{Q\xa4\t*\xf8\x03\x95\x86<b\xc7\xd9O%le\xe8ad\x02\xa9҂i\x94\x1f')\x1cHZ\xd0%\x10\x9e@F\x8e )\xbe\x05\n^\x83g\x9a\xa8\b\xbe\x13\x92\x02\xe3[\xb1\x86\xbdֹZ?\x7f\xbec\xda/T\xb1Ȳ\x823}|\x8e\x84\x96lSh!\xd5\xf3\x84\x1eh\xfa\\\xb1݊\xc8x\xcf4\x8du!\xe9s\x92\xb3\x95A\x9d\xe3\x80U\x94%\xff\xcf3\x89z\xd2\xc0\xb5#\xf6\xf6\xcf,0#3\x80K\x8c\xe5A\xdb\xd5\x0e\xb4\"4\xe3;3%ׯo\xde\xd7\xf9\x93\xd5Y\x06\xbf\x96\xeeUGUM\x01\x12\x8c\xf1-EVb\xaa\xe2Wʓ\\0n91N\x19\xe5m\xf2\xabb\x931\x8d\n\xf3cA\x95ƹ\x8a\xe0¬ް\xa1P\xe4(\xdbI\x04\x97\x1c.HF\xd3\v\xa2\xe8\xa3O\x00RZ\xad\x90\xb0aSP7<\xaa\x0fBY;\xaa\xd5\x1ex\xb3a`\xbeZ\xda\xe3&\xa7qCr\xb0;۲\xd8ȇ\x91^\xaf\\\x1a0\xa1\xa3\xe5\x1b\x8f\xfbE\x1a\xbfVa\xb6\x7fm!iU\xa8ǅ*\\\x90\xf5\x9eʺƩ\x96\x17\xa6\x1c\xd4\x0eP\x00!\x81\x8b6S\xf4\xa9\xe5\xce\xc0\xd4{\xf1\r\xa5\xf9\x04\xa6\u05cd\xc6(\aȊ\xbc\xc86T\x82\xd8v\xb4\xaa_\xd1;P\xab\xf7\xfan\x9dqj\x01\xb7\x94\xe6KC\x05\x81\xa6%\bN\x15\x10I!\xa1f\x81\x8d\x16-\xa0\x00p\x9e\xa6ux\xb6\xfd-\xcd5\xb0-0\rL\xf1'\x1a\x14\xd5\xdd\xce[!3\xa2\xd7\xc0\xb8\xfe\xf3\x17\x9d\xa7\x19\xe3,+\xb25\xbc\xec<\xe2E\x9a\x92MJנeA\x17\x8dg%\xf9Q5\xee\xa8l=\xf5Ý \xbcg`$9\t\xb6\xe4:0\xcbE\xb0;\xf8\x01a\xc4?M\xb3\x1c\xb5\xfd\x04\x8e\xef]3\xcf\x16I\xe9K\xf89v/G\f7Ԙ\x9b\x9dE\t\xff\xb0i.Ł%n\xd5\xc1\x91Gp\xa9U\xb94\xa3-\x0eY\xa1\x8cZST/A\xe1\xaaD4P\x12\xef\x87Ǎ\xb8\xe1{{m\x80\x8b\xd2p\xab\xac\x01\xc4\xc5\xfe\xaf\ah\x89[\xe7ٰF\xc0\xaf\x05\x88c\xe8{\xda\"\xeb\x7f\x96\x8d=a\v\xce>\x16ԘV\x1eEg\x109\xbcu[{\xf9\x8f\x172\x1c~\x97\x05&\xd8\x00\xff\x12y\xbc.Z&J/֯L\xc3>\x9d&@\xf0\xf4h\xacd\x14MĞi\x9a)`}\xbc\xe0\xf9\xc1\x8d\xec\x8e\xe9\xbd[\xfe\nc_\xe1\x0f\xa2\xd0@b]\x904=\xba!\xa20\x10~\xd4{\xc6w\xfd\x03\x05c\xd8K\xaa\x8a\x14\x95\x02.\xc4B\xa2\xd1\xcex]\x7f<Q\xe0\xect;\xf4kӡ\x1f\xe4\x84\x1a\x98\xd2\xc4\xf8\xa5\x9f\xe2\xb4HhR\xfa\x9a*\x80֯;\x9d\xd0|ӄqT\x16\xe8\x18#\x9b\xf0\xea)\nJ/X0\xda\x12\xad\x05\xc6-\xcc\x169\xfa\an\xe6\xaf\x1f\xd1I\x8e\x9aA6\"%9.\xba\x8f=\xd1|\x10c\x0e\xcd\xca>ΨKYL\x91Z\xa5\xe9f\xc8fU\v)\xbd\xae\xf6\xf7\xd7G1\xa64\xe3;?\xfa+\x91\xb2x@YA#\xba1\xa6\xd6:D6`\x8f\xef\x8f9\x85=Ms\xe5\xd4\xc1\xd1\b\xd8\xeb>\x1c\x8e\xa7\x92\xa45\xb9\xfdë\xa9\xa3\xda\f\xc1\x86\xeeɁ\x89\xf6\xd2\xec?9\x95\x15; \"K\xb8\xa5G\\%\x8e~\xa2+V\xf1\xb3om\t\x10\xdb\x01\xa0\x7f\U0007df8c\xfebB^_Zos\tg\xb1\xe0[\xb6\xcbH\xae\xceР;Kh\x9e\x8ac\x86\x8emD\xf2\\\x9dE\xa8\xba\x86\x905T,\a\x9a8\x9b\xb6\xc4\x11\xf1\aMn\xa9\x82\x1c\x97\xbd\x84rd\xf8\x03\x95\xfdT\xab9\xae'q`\xc7`\x1fe\xc1\xe3\xfa\xc4\xc9=\x9e6\xb5\xde]\xff\xa6\xd8Pɩ\xa6\xaa6\xd3\xd6H\xb1\x80\x92\xfbQa\x90q\xf7Bܪ\x80A\xff\r\xdbU\xce\"\xc4&j[\x0e\x0f\xed\x02\xa2\xbdﾡ@?Ѹ\xd0\x03h\x03$\x05\xe2\x83ܕ[\xfbgDU\x8d\x9b2\xf8\xfdQl\x06\x9f\xb5\xc6\xf1\xb5\xd8X\x8b\x1c\xa9n\x06\x8ff Gd\b|-6\xb0\xa1[\x14I\u008f\xce* rb\x12Jχlu\xcbg\xf2\x918\xb5\x84\xbb=\x8b\xf7\xf8f\xb4\xfe5\xa3\x89\x0fcxؐ\x8bD\xf5S`Raw\x86\xe9\xacͯ\xc5\x06'\xce.-8Z;M\xb20\v3:\xd6\x18\x1fa܍}\xd0\x00rj\xb8Z½\xd9笠a\xb4C\xa6χ\xb1\f6\xe3\x8dZü\xf0#@\xfe\xa3\x1e\x84\t\xfa\x10\xb9+\x8c\xcaB:;nt\xbaq\xe2\r\xe0\x8d\x97ʥ\xfcZlƆ\x184?A\xe2X\xfff\x8c_\"\a\xf69|s\xd6\xda\xea\xc32\xb2\xa3\xeb\xd1&-\x02_b\x8f\x8a\xbc\x9e,\x06\xd0\f\xe2\x04\x8f\x9a\x0f\xba$\x03\b\xd6ݒ\xba?\x82̾\xf4\xe8a\x03|\x98X\x11\x9d\x80\x0f\r\x11F^\xf2\x00\x1ft\x98\xc6\x14\x9e=Vӫ>`\xfb\x83\x1f\xa8\x91l\xc6\x1f\fQ\xc1_K)\xe4,4\xdf\xd9>\xb5\x05q/\uef03X\xae\x10{r\xa0\x18\x11!h\x06\xac\x1c\xb5'^\x03f\x16`KX\xaa\x96\x8dYRZ\xe4\xca\xeb\xee\x86\a\xe6};\x8c\xbd<Q\x8b\x11\xd8\xe6\xef+\xc2Rcޘ\xb7\x14\xb5\xf8\x10.T\x1eO\xb7r\xe0\xd2@\xd2;rT@q\xc8j\xd8\xdc\xeae\xae\xa9i\xa2\xbcȦH\xbf\x82\v\x81Q߁\xe5\xbf\xfa\xae\xcc\xd8\x1e\x8a1\xcc\xe6\xd9,\xb60[^}\xfe\xb8\x13.\\\x87Ug\xf9\x9dx\x83\xf5\x17\x1d9\x93\x89E\xf8a\xc8}5ɦ+\xb8\x12\x9d\x8d\x89\x93)\xad\xa8<\xb0\x98\x9eǱ(\xb8\x1e\x8e\xd9\f\x90\xfd\xa6ӽO]\xba\x97\x00\xb1\xcd&^\x00M}CT\x04\x97[\f\xfc\x96ӛ,}\x04\x8e`x\xa3\x05>TL*\xfd\xc6\x14`\x88:z(\xa2j\x96QQ\xe8Y\x94\xc4\xcdK\x8c\xf4\xd4\xc3\xf7\x19\xf9\x841Y \x99\x1b\x97ݤ\xf5\xe4\xc9&\x16d\xfc\xc3h\xa0\xe3z\xd4Ph|(\x96PI\x13\xa3\x82h\x12\xc1+KF\xdcM\x81\x97/ c\xbc\b`\xe7@b\xe0V\r\x93}{\x04\xd5g\xe5M\xab\xd16\xc6*\x18m\x81\x13:\xd9\xc0\xcc\xf8h\xabz&\xc2\t\x9e_\xb0\xef\x14f[y\x87M\xad\x1f\xd6x\xf7\xfe%Z\xf0\x8d}#\xc1)j\xba\f\x95[\xd5V\x8a¶\x1dי\x03~\x1al\x88\xa2\t\b\x17:´\x14\xf7>\xeb\xc1\x97\x13\xa3\x96\xa3\xe0Kbحהlh\n\x8a\xa64\xd6\xe2A\xdc\x04ډ5\xce\x12\xe2\x9ePe\xb5$5t\xce\xf4ң\x85\xf3\xea\xf4\x9e)\xbb\x86!,H\x04UF\x1b\x92<O\a\xe2\x173xc\x964\xcf\xe0\xecP\xfen\xd2\xdds\xe5id/{\xd7\f\x01\xb7V\xbbߵ\x98\x80\v\xbf#\xa23\xde\xe6\xd6YT\xbf\xect\x7fxfG\x1eg\xd4Z\x004\xcb\xf5q\x89\x9b\x9c\xee\xd7\x10\xa8$Mkx\xfc\xc6&\xee4i\xb9l\xf7~pi\x19\x9d\xb5\x10\xa88k%\x1a\xbf\x91I3\x8bՍ[\xabfMط\xf5\x9eKt6\xfd\x84%KزTSٚ\xb9{\xcb\xdbC\x12(t\xed\xc5oFt\xbc\x7f]n\xfd\a\xf4hѪ\r\x00X}\x93\xb0i0\x04\xc0.\rX\x13\xec\x8bܦj\xf5\x8bq\x10\xcf߾\x9av\x1efpjgP\xe7-\xc4\xeb(\xb8\x8d\xbd9Crf\x9a\v\xb8)\x9b㥖@p\xe3\xc7ZV\x989\x97SI\xf0e#۩\xed\xaf\xa4\x98Ha\x99\xf1\x96\x1e\r(\x97\a\x17\x04a\x0e\xab\xb8\x846:\xb0\xa92IT\xc4\xcf\xf9\xaa\x96\xba\xf8\x03\x8eչ\xac\xb3\b\x8a\x7f\x95~\v\xe1\x85\xd9J\xc9\x7f\xfd\xbc\x9c8\xecrZ\xab\xd4<;\xf1Ops(5\x99bj\xcf\xf2\xc5\x04\xd0\xdaW\vÁF\xc2|\xd6\xe3\a\x92\xb2\xa4\xc4\xd5n\x8a\\\xf2\xe5\"\x18(\xbc\x15\xfa\x92/\xed~\x982\x9c\xf4JP\xf5Vh\xf3ˣ\x92\xd8\x0e\xe2D\x02\xdb\xceF,\xb9]\x16\x90.\xf5t\xca@a\xb0\x7f\x97[Ï\xe5\xb41\x85\xe9\x8dBz\xfa\xe0C\xf7\xca\xe95\xa8\xf9\xf1\tF\\\xf0\x95Y\xa6\xa3\xbe\xb7\x19r\xabE L\x8c\xea\xca\xc6LuQ,_l_:\x03\xf4{\xb4\x0e\xcd0]NK\x8a\xf5\n~\xbf\xcf$\xad\x12Mw,\x86\x8c\xca\xd1hA\xfb\x9b\xe3\xba\x11\x8e\xca\fM~2\x17\x86\x9b\x16\xfe\x13\x12l\xa9>+\x94\xfa\xc0\x96\x9e!\x82\x9a\a\x05HN\x1b\xa5Yލ=\x14D\xfd9\xa9\x1d\xf7\x9a\xaf\x86\x06\xa8!\x89lJ #&\xf5\xfe\x1f\xb8\xbc\x1aA\xf8'\xe4\x84\xc9@=pn\xaawR\xda\xe8\xef\xf20\xea\xaf·0\x05\xc8\x03\a\x92v\xb3\xa7\xfb?\xa8\xbc9\xd0\xd4\xd83\x88eۂ\xc2\xcde\xa1\xecz\xbee4M\x16\x930\xd1\xd4QpvK\x8fgˎ>9\xbb\xe4g\xcbr\xe3k\xb6\xea*\xad\x16\x93fwf\xfa\x9f\xdd\xd70\x9b\xc1\xb13\x9a~Zݖ\x89\x17\xab\x8c\xe4+\xc7\xe9Zd\x8dʍGۨt\xa6}\xb4x ^\xc7M\xaa\xbf\r\xa7t\f\xe0v\xe5{5m\xf1\x9e8dP\x9c\xc0\xef\xb6{\xa5\xcfݾ\xabۑ\xb1\v\x81\xf7\x84\xa2Ń\xe8\xf1\xc6xz\x10/\x83\xa7\xa4\xdc\x17\xc2\x18\xc6$\\h\xe5/E\x8b\x87\xb5\x90\x91V!\xedZ#|\xfd\xa9\x16\x0f&\x98\x8bM\xe3\xc6\xc0\x1e˚w\xfb\x00\xa1\xcdO\xc8\xd7\b\x86\xdc\xe05L(6\xb9\xb8&\x99ũ \xea\xb7\x03\t&\xd7\xcc\x00\xbc'\xb8\x13I\xb9'i\x90\x8a\x9aů'\xc8\xf6i\xb9!\xa7\xad\xe2\rMNO\xf5d.\xcai('\xbc\xfc!,\x13\xa7\xfa\xe4\"\xc1-cI\x1b\x9c\xd3\xdd\xc8@\vy\x06؞M\xcb\\$O\x14l\x99T\xa5\anF0\x03j\xc8^\xe5\xbd8\x00G\xfb>l\x0fs`n^W\x10Fv4\x83aC\xb9\xf7\xd9L\xf4\xb8#L\x97Y\x8e\xa8qQh\xfd\x8e\xfc\f\xe8n\x87\xd4o\x8e\xfa*7\xa4C\x81\xcc\x06\xc4'l<*\xdd\x03\xf3a\x06h\x1e\x90\x19\x13\f\x18j94L\x03\xe5fg\x1d㌸\x1c`6\x8a'\x0e\xdf\xf5\x95\xff\x8d}\xc2\x17\x90д\x89\x13\xf2Uff\xae\xdckZ\x91K\xbf\x12\xf2\x9a\x92\xe4\xd4`\xd5\x7f\xd7@\x00\xe5ʤ\x0ey5u\xc7\xd2p\xfcqf!%\x05\xc7\xe2\x1e\xd4y\xbc\xa1\x86\xc0\xbe\x82q\xa5)\x99\xb3\xa8\x89-\\\xdbT\xd7\xf0\xb9\x9d\x15F\x0e\xaf2\xe9\xfb\xe0\x1c8\x85t\x8f)\xf8\xa5UZ933\xc0\xda\xea3;\x8dN\xaf\x11\x8d5nVV\xcb\xea9\xbf\xcaE\x8f\xc7\xfas\xe3\x11\x0e\xa3\xa0\xd63\xfc0\xfc\xdb\v\x15\xb8\x965&\xfdoBU\xb3\x8dI\xceJ\xff\xae\rak\xff\xee\xa5\x10\xda'wy#\x16\x0ex(H\xb8\x14\x03$L\x9a\x18\xfb\xf1\xf7i\xff\xfe\xb1\xda\xff&W\xfb\xc0俁i\xfd\xc3h\x9ek4[\xb5\xa3N\xa4\xf7\aۻ,\xda\xc1\xc8[-){\x8e\f9D0\xcb\xcdﭏ\xba\x923@\xf7\xe7\xbfz\xd8L\x95@\xfb+·\xbe$M\xfbTx}\xfc\x9f\x9bj\xbe\x97\xc9\x18\xae\x9d?#\xcb\x05\x0f,[/f3\xf6%g5˅\x1b0\xbf\x88\xe9\x82/*C3\xa7\x8a\xe5e\x03\b\x1a2>l\x8c\xe0+\xbbx\xa6\x19\xb3\xa1X\xedk\xab\xe3L\xd4\xceG\x91\xed\xa92Ae\x13\xf7\xe6\xf8\xe0\x99\xef\xddG0\x9b\xf6\xf2@W\x05\xbf\xe5⎯̞\x8czdyx\x144~]+e\x93\xafg\xc0\xae\xad\xae\xd1\xe2є\xe3,ޚ\xd18\x8cSB\xf4\xe5d\x82~\x10VS\xf8L\x00q\xa9\x93\x17\xb6\xb2\xd4\xef\xe1\fHqK%\xf5\xf6\xec\xa99re\xab+s\xb0\xe2К\xe0\xb7|\xca#\xc96\xb4:c\x01y\xce\xdbU&#\xc7\xef\xf7z\x1d5\x1c\x86\xc6\x05r\xe9Kc0\x19\xc9Hd\xb48q1\x9d\x8a\xb5\xb0N\x12\xf0zqJ\xe6p\xf3x\x8a2cןO!\xfc\x8bz\x81\xfb\xe3\xc6\xecab\xf5\xb4\xd3f\xfa\xaf\xb1\xf2<\xc6\xd1b\xb6N\x9f\x14\xca`\x82\x0e\xf1\xafG\xee\x04\xc6\f>\xebc\x8a\x96]V\xabS\xb3\xe2[\xc6\xebG\xd7|\xfe\xa4\xd54{\x97;yr\x8bG\bu{\xba\xd5d\x1e\x85Ҭ\x1c\xb8)\x83|\x8a\x81\xc6^\xa8v\xaf\xd7m\x1ecx\xe1<F\x90.7\x023-\xe0}\xad\xa8\xcd$6\xe0L\xbe\x84\xbd(\x06\x8a[&\xa8\x86\x14\xbf\xa6Z\x1e\x83O\x9f\xb8l\xf6\xa8\r\x14\xa3\v5\xb6p\xc7+\xe2\x1b\x10II\xb5d\x83'\x18\xb8\xba\x1f-\tWx\xac_U\xcfj~?\xbf\xba\x04cLH\x7f$\xe5^\n\xadS\xc6wK\xa0:N\xbc\xa50\xb4\xea\xa2\x13Tpr \xccd%\xc3\x1dݠ_k\x13\xd0\vn\x0eЪ\x0ebB\xa7ԗ\"\xd9}8\x83Nt*\xdbM\x9b\xc8h:0\x92\xe2QWb\xbb\x1dj՞\x8aF'\x1f\xcbCJ\x18\xbb\x04\x0fh\xb1\x0ex5\x0e\x9c\x85>\xd6\xf7\x9fjΰ\x8c≂D\x14\x9bԭ7x\xb2\x18lE\x9a\x8a;\xb4\x88\r\xacVq (\x1a\x8b\xb1\x88\xf1\xa4\x14c\x06קyt\xf8\x8e|j\xd1\xc0[y=\xb4 m\xbc\a\xdfa\xfc\x81?\xbfpCR\xf7\x1d\x13\nͨ\x93\xd4\x1e\x93\xeb\xe0\xc7dІM\x91쨉\xac\x9a\xd9p3\x15Ѩ>\xee\xc1W@\xed\xa8B\xe9\xc0\x8bm\xe9壘\xc5$M\xbd\xa7\xe3\x036\xf8\x96\xe1Ꮯ\x1a\xd89=\xf0\xc5b\xa0\xc5\xe81\x81!F\xe4\xaaF\xe6S,\xbf\x80\xfa\x8b\xe1\xaa\v\x9c&b\x8e\xee<\xbc\x8c\x9aO\xb4p5\x18&A\xa4\x17.\x96ɔ\xe9\x1eHv\xc6\x13v`IA҆\xe5R[O\xabe\x17\xf3i9K\x87R\xa7IZ\xc1h\xac\xc1\xf0\xce\f\x84\xa4\x8f\xa8\xd8ڹ\x82\x81\xec?\xa7H\xc3s\x85ٴ\x18fS8)\x03p\xd4$\xb9G\x19\xc6t\xcdĜ\xe2\x8bvY\xc5(ఒ\x8b\xe9\xa9\r.\xafh\x90(\xac\xa8\u0097JL@\x86\x80R\x8a\x00\xed\xec\xd6>G\xd1Y\xc3\t-\x96\b\xaai\v,\x91h\x16>L\x83\x9dY\x18\x11L\xb0\xb0\"\x88\x06\xb9BJ\x1f\\\x89\xc1\"\xb4\xcce\xb2ࡧ\x8caqBQ\x85\xab3\x19)^\x98\x84\xdaW\xdc\x10^\xb20\tޔ4\x84\x15*L굙\xbc0\xe5[\xf9\xcf\xf8\n\x1eVv\x10Tl0\xb1և\xe2\\K\x9f_/\x1e\xaa\x88 \x88\xaa\r\xb9\t/\x18(\x8b\x01F\xde?\xb7L\xa0Y\x020\x028\xa48` \xf1\x7f\x04\xeahI@h\xba\xff\b\xfc\x003`\x92\x9b&\x1b4b\xe3\x01\xa9\xfeeL\xea;\x92\xe7\x8c\xef\u058b\xfbr\xde$\xd758\xeem\xeb\xfd\r\xb6\xab\x87\x8b\x1aA\xb8\xa1W\x13\x89^K\xb7\xbd\xb3x\xd1(œ\xfa\xcf\xf9\xb1\x03[\xf5\x9e\xe8\xee8Ù\xb2\x15\x17\xe7&\u05ed~p\xa5\x01]\a\xe7b\nj8Ԍ\x8d\xa3S\xa6YȆ\xe5\xaf\xd6\xd3t~\xd7\xeaR\xdf\xc9\x1a\xf7&za\x83\xf11N\xf4&\xb2\"\xd5,\x1fT\x1d\xe5\x91\xe4zO\x8f%\x9d\x7f\x14\x8cWg\xc1\xbe\xbb.%:j9HdH\x06\xefh\x9a\x02Q]R\xc4\xf6\xe2\x86X\xac\xcca\xa98˞_\xdc\x05\x0fKs\x96\xf6\x00\\sN\x8d\x99\xec\fb\x82\xa7\x1c\xc1\xc0\xb5\x01\x13\xebḍo\x04ú$\x1f\v*\x8f\xf60ٲ\u07b5\f\x87\x0ek\x97\xdaA\xdcb\xdbP\xc5h\xabw|\xa1J_\xc19\xb7.\xe0 \xe8\x16\xae\x06\x16Uu\x9f0\x82s\xe3\xea\r4\x1d\x84\xccE\taq\xba\v\xd1\x1e\xdcp\xcb\xd64<\x8a\x87x\x9a\x8f8\xc1=!<t\x0f?\xf1tOq\x02\xec\x9c\xf2\xfcPo1\xb0\x1c\xbfA\xac\a\xf6\x18C|ƀŲ\xfaz\xfa\xce\x1cV\xa8\xe7\xb8x\xd0\xf2\xfa\x99\xbe\xe3|\xefq\x16\xe9B\xcb\xe8\x1b\x84{H\x1f\xf2\x91\xbd\xc8\xc7\xf2#O\xf3$\x03\xc0\xb6\xca\xe3\xc3|\xc9 \xfd7\x9b7B\xbc\xb3p\x9f2\xa4\x98=\xb0\x88}\xd2\xd4\x0fǾ\xb6ԏ!?\u05ff\f\xa6sC\xae\x1e\xd6\xc7|D/\xf3\xb1\xfc\xcc\xc7\xf74\x83|\xcd \x0e\vh2\xd7\xe3\f\x8a\xf8\x8fs\xb5\xcf\xf9z+\x12z%\xa4\x1e\xe0\xd2\x06\xdb]\xb5\xfb\xf4\xe4\xcb\xd4\x1cE\x91&\xc0}\xd3^\xe8`}\x1b\xe7\xd7\xdco\xa0\xc3i-\xf9!\xbea?\xd1w\a*%Kh\xd0H?\\4\xba\xd4\x06\xaa\x1d\x0fQ\x85[\x95\xe8\xe2\xd8\x03\xce{\x81\xba\v\xa2\xf0\xceC\xa5\xd1b\xb4y\xc5\x10\xa7\x84ee\x96bb\xc9pqs\xe9\x9f+Nr\xb5\x17z\xf0\bM\x9f\xc8\xe6\xdcu\x8f\x06\"\xc6P\x93@\x8a\x0f\xa4\x83\x87ے\xc4\xfa\xddc\xb5\xe9\x01\xb4\x9e\xb6\x1ewR\xdc\xe9\xfd\x15\x95xO\xd5\xe8!\xf2\r\x8a\xbfiu\xf3vd^\xfdҠ\xfc T\xa8\xcd\xc98\xf5\x992\xc8r\xd8\x1c]\xae\xc2\x17\xc3;\x9ev`h\xf7\xbd|\xf1\x86y<P\x11\xbe\xfc\xe2\r\xfb,\xb6\\\x01\x14\xfbixb\xe6/N\x84\x1fߍ\xec\xec\xe32\x1c\x82U\xbd\xe5\xe4:\x97c}\x9b\xe4k\xf8ߧ\x7f\xff\xd3ϫg\x7f}\xfa\xf4\xfb\x17\xab\xff\xf8\xe1OO\xff\x1e\x99\x7f\xfc۳\xbf>\xfb\xd9\xff\xe7OϞ=}\xfa\xfd7߽y\x7f\xf5\xfa\a\xf6\xec\xe7\xefy\x91\xdd\xda\xff\xfd\xfc\xf4{\xfa\xfa\x87@ Ϟ\xfd\xf5\xff\x8f \xd5\xd0ό땐+;\x92\x11Q\xe9\xb08j\x14s\xb2\x8b\x1a\xe5\xcb%\x06X\xce\xfeR\x86\xc1\xbe|n\xfe\xfd\xe5\xd9\b\x82nѶ\x8at\xe9\xee\va\xb2\xab\xa8\xf0j\xbaΝ:#\x80M\x80\xa4-\x9b\xc3\xdc\x1e\xa0A\x82\x96ĉ\x06\x92\x92\x04\xcb\xd0\xd5\x1b\xa2\x87X\xb8A\xfa\xebF\x87\x8e6\xf7\xe1G\xdci\x99\xba?\xcb\xe5\xa8\x18\x05\xec\xae\xdd!\x89W \x17ׯ\x14\xe0\xbdś\xd4\\&i\xac\x93Z@\x93Ě\x1d\xe8\x90Rwy/\u074c\x0e\x05\t\xcd)O\xf07\x9b\xec\x94=\xa2\x06g\xed\x1c\xc4u\x18\x7fws\x17;tv\xbf\xdbC\x88<\xdd\x17\x13ފ\xb1\xc4*\xb2\xbb$\x9a3{\xaf\x90\aZ\xdd\xe0\xa8ΖpV\xd1\xfcl\x88\xda\xf8=\xcb\n\xbc+\x9e\xef\\\x96\x99\xbdϪp9|gf\xfa\xd0zd\xc9H\xabaq\x80\xa1CSmTo;2\x93A\xbeZ\x90N\x9d%\x93cN\xd0d\x8a\x7f\x83\x1b|z\xa5[\xc7Q\x91\xe0\xa0\xcbC\xef\xdd웜/\x85\xf3\xba\b\xba\b\xa3\x94\xd2J\xf6\"x\x87\x17\x83\x99#\xf1駘\xd2\xc4\x17UI\x9a\x11s\xdbh\x10\x8b\x95o\xf0\x175\"jx)c+\xf3\xd0\xd8\xc0\xf2Iu\xff\xd6\x18\xe6\x15\x15\xc6O\xfa\x98\x9c\xcaI\x95h\xe5\xe0;\x91\xa0ą$E]\xb7\xbat\xc4uK%E\xcaj\x01_\u07fc{;5\xde\xdcE\xac[\xe7\xc6\xdb\xfc\x90\xc4m!9\r\xd0PuF\x8e\xa2ŉ\xcc;\xad\xd0H\xce\xde\xe0\xbdu\x81\x9c{~ui\x9a{\xd65wޕ\xa5#~\f\xb0\xa1\xa8\x8eK*\x8d\xba\x89\x97\xdb\x06Ԟ\xfa\xbf\xf2\xbf`\xae\xed\xf6\xe1\xa2\xd1{\xb6\x10\xb9\x18e\b\xb3\x01\r\x96\x11|\x85\xf1U~\x04\xe1nLa2Y\xe5D\xea\xa3\x11p\xb5,\xf1\x18\x81k\"R\xe8H܋a\xfb.(\x1f\xa4\xb9\xbf\xab\x1c\x87\x84\x90\x1b\xb9\xeemJ\xdf\a\xa7\xf1\xd3\xd8&\xcfa{\x04\x9c<\xa9\xfb\xb1Z\x19*.f\xd6\xe0L(\x8b\xf9\x11\a?\xee+Ʉd\xc3\xc2֫`\xaaNc*Ɲ\x91a\xef\x93\x1c\xdb1ņ{\xb6ۛ\xd59\x15w\x90[\xf8\xc7\x12K\xa7\x83\x84s\xdf\x1bZ{\x00\xb2S\xfe%\b\x0f\x14\x8d\x17+\xfa\xac\xf4\xd7\xffPU\x7f\xa8\xaa?T\xd5g\xac\xaaPH\xaf>\x04\xaa(\xd7x<\x96\x89\xa6\xab\xf7\x8bz\xa1\x02 \f\x13\xc7\xf3\xc1\xbbS\xb5\xc4T<\xd3\xe1tc\xee\xfa\x0e\x1f\xa3m\xdf\x18&\x1el\xe0\xd9D\xc1\x1d\xf5V\x9b{C/h\x1bҴ\x17\x8d\xdb\xf0\xbdIH\xc1\xacv\xe0\xe2_\x97\xbe>\xe3֙\x93\uf6f1$\x1b\x84\x8b\x19=X\xa3(\xaa\xe2\xfc\x8aV\xc3\xea\xe93s\xf3\x18o\x11\xe4\xc1\xdd\xffPB\xf6\x10qԡ.\xdf\xf0+\xa1\xf5\x84\xeaS1I\a\xd3\t\x1b\xa4\xbf\xb1-;\x04\xcfS\x16\x93\x92\xfa\xa8\x04\x12xU]\xd1\xdd\v\x18þ\t\xa0\xb2\xa0\xdb\"\xbd\xa1N\x96\x11\x19\xcc'\x13.҅\a0\x941\xaa;!oSA\x12\x05E\x0e\x1f\vFՠ\x91\xf1 \xb2\xfeX,\xea\xc7Q\xf1\xea \\\xccM\xb1D\xf1\xf1\xa8\xda\xdd\xe7.p\xa4\x1c\x11\x15\xd5\xea\xacɺ#pkL\xbd\x11z\xff+\xe1e(\xd9-p.\xae]\xf3\xd2l\xa9\x95\xbb\xf5\xf1m\xc9c\x83\xe0\xa1ɨ6\xb1XH\xb6c\x9c\xa4}\xf0\x99\x82[\x9ak\xb7\xe5=\x02\xf7\xec`\x8e\x92\x88\x98x\xee\xe1\xad<\x14\x9ck.0\x8a\x88\xf5\xbf\xdb\x01\xa4?\x8b\r\xa2)\xf3\xcd\x0f\xe94e\xb5\xa7I\x91\xd2\xe1[G\x1b\xb3\x7fSk\xee9\xa0\xe0\xeccQٯz_\x1d\xe1\xe1Z\xf7\u0085\xba\x9dV\x9e\x19\xe0\x05;\xb1\xc9uXuZ\xe4\xfemN\f\x1d\xf4\x91#7\xeb`\xcd\xccfx\x86 n\x9cp\r\xaa\x88c\xaaԶH\xdd\x0e6Ē\x12\xdclq\xcd\a\xcfV\xf3\xe3\x89\x16'\x88\xad\xdbɹH\x89R.\xe3{@\xe6\xe6\xec\xf7M\xea\x8a\xe6\xf4\xf5\xe0Зv\xeep\xc5\x1dW5D\x8c2\xc1\xbcg?\xdb\xf4s-\nU\xe5.\xbbyI\xd0\xe8\x1e\x8a\xef_}\xb8P\xed\xb5\xac\xb1\xd3\x06x\x90\xa6\xb9\xe3Ȫ\n\xdc\x7fO$;\x94\x97\xb9\x8f3Eb:\xa0\xd5\xcf\x14\xc4{\xc2w\xd5M\xfd&\xff\x1a\x93]\xaa\x8b\xe1\x9b#\x1b\x00m\xc6\x1b\x9d$\x7fZ\xb2X\xffW!4Y\x87\xcc_ٺ\xdf\xef1u\xf55J\xbb\xbd\xa9Q\x8a\xb8k\xb4\xf1`\xf5\x1d\x9e>د\t˭\xf3\f\xc1\xfa\xbc\x84a\xc0\x96\x91>\xe2\xc0|A\x00\xab_2\n\xe6\xb0\xc3\xf2\xbc\x80\b\xde\xe1\x18\ue622#p\xfd6\x81\x87\x8b\v\x87\xa4\xb9\x90(\xbdD\xc1\x1d\x91\xb8m\xa0N\xb6a\xa6|\xb8\x9f\x04\xa7\xff*\xe1\xfd\x9fڻ\xfb\x84V\x8b\\\xa4bw4Hz\xc9\x1cz\xb3\xe5j۲.\xa1\x98\xf8\x03dk6\xe5\x8ee\x92\x16\xb6\xb3\xa9\xc1~.\a\xe0\x96|s\xf5\xe1\x14y\x18^\xe9VNW\xbf\xed\x8bG\f\xc2쇷\xea_\x98V\xa5bY\x04\x00W=n|\xaf\xfb\xee^\xe5\xdc\xf8\x98\xe4\xda\x1c\x1c\x8dd\x8d\v)1\xbf\x05a\x9905q+碗\xaa\xfdh\x0f\x9b\xdd)Q\xdaa\xb1^\x8c\xb2ַUK\xbf\xaacg\xb3\r\x06\xc4\x0f\x05\xee\x882'\x17\xfb\xc0\xeab\x90\x01\xfaQ\xad\xdbM\t\xd1t\x85\xf0\x173\xc5tDhj\x03\xee\xb7g\x86\x06\xdd\x17\x87+\x89\x80\xb2`\x92\x02\\\xe3\x0eT(oz\x99\x1c}\x18\xf2\xe6\"\xfdp\xecMs\x8f\xbe\xb99\xfb\xd1\xf0\xef?\x1bv\x05o\xe9]ϯx\xa8;M>\xb8}\xf8\x9ec1Wpɯ\xa4\xd8a\x89I\xcfC<q\x9d\xf1\xddWBZ;О<q \xe9h۫\xb4\xd81^\x9e\x17\xa4f5\xbe\"\x12O|I\x8f\x16\xf7\x9e\xbe\x17\xee\x1c\xb9\xbegӽ\a\x1f\xbc\x92\xc7\xeb\x82\x0f\x03\x9f\xe0\x9c\x9bҨu\xb3\x1c\xc0>\x9d>c\xb2\xefX$@\xe4˓\xf6\x92\x9a\xa9\x9d\x1e\x7fQM\x90\aHPCl\xbc*n\x88\x8f\x1b\xbbwz\x06\x02\x00{\xe7\xf2b\xb9\xa3\xed\xaf\xc0\xfd\xe4{F\xf7\x14\xa4לl\xfa\xd9fR\xc4F\x88\xe4\x13d\xdc!\xd8j\x82^\xd5Kl\xf3\xd6\x11g\x18j\xaf \xba\xe3\xa5:\x10\x01\x9e\xb2\xadͨ\x89qL\xcf\x16\xc1ᑑ\x91\f\xc79zW\xefΏ&5$\xa9\xb1\x993\xf7\xeb\xbf\x14\x9b2\xba\xb4\x86\x7f\xfcs\xf1\x7f\x03\x00]#\xa3\x89\x04\xa3\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=ko\x1c\xb9\x91\xdf\xe7W\x10\xba\x0fN\x02M\xdb\xce\xe3p\x10\x82\x00Zٛ\xd3ű\x05I\xeb\xfbr\xc0\x81\xd3]3\xc3U7\xd9!\xd9\x1a\x8f\r\xff\xf7C\xf1ѯiv\xb3GR.\x9b̌\x81]\xf5\x90\xd5\xc5z\xb1\xaaX$\x97\xcb傖\xec3H\xc5\x04\xbf \xb4d\xf0E\x03ǿT\xf2\xf0\x1f*a\xe2\xf5\xe3\xdb\xc5\x03\xe3\xd9\x05\xb9\xaa\x94\x16\xc5-(Q\xc9\x14\xde\xc1\x9aq\xa6\x99\xe0\x8b\x024ͨ\xa6\x17\vB(\xe7BS|\xac\xf0OBR\xc1\xb5\x14y\x0er\xb9\x01\x9e<T+XU,\xcf@\x1a\xe0\xfeՏo\x92\xb7\xbfM\xde,\bᴀ\v\xa2\xd2-dU\x0e*y\x84\x1c\xa4H\x98X\xa8\x12R\x04\xba\x91\xa2*/H\xf3\x83\xed\xe4^h\x91\xbds\xfdͣ\x9c)\xfd\x97\xce\xe3\x0fLi\xf3S\x99W\x92\xe6\xad\xf7\x99\xa7\x8a\xf1M\x95S\xd9<_\x10\xa2RQ\xc2\x05\xf9H\vP%M![\x10\xe2\xf07\xaf^\x12\x9ae\x86\"4\xbf\x91\x8ck\x90W\"\xaf\nO\x89%\xc9@\xa5\x92\x95\xd8\xe4\x82\xdci\xaa+EĚ\xe8-\xb4߃ߟ\x95\xe07To/H\xa2L\xbb\xa4\xdcR\xe5\x7f\xc5\xd1z\x00\xee\x91\xde#nJK\xc67Co\xbb$WRp\x02_J\t\nQ&\x99a ߐ\xdd\x168тȊ\x1bT~\xa0\xe9CU\x0e RB\x9a\xf4\xf0t\x98t\x1fN\xe1r\xbf\x05\x92S\xa5\x89f\x05\x10\xea^HvT\x19\x1c\xd6B\x12\xbdej\x9a&\b\xa4\x83\xadE\xe7C\xff\xb1E(\xa3\x1a\x1c:-P^x\x93T\x82\x91\xdb{V\x80Ҵ\xe8¼\xdc@\x040\x94Ф\xa4\x95\x82\xac\xd3\xfb\xa6\xfd\xc8\x02X\t\x91\x03勦\xd1\xe3[\xf3\a\x8e\xba0\xba\x84\x7f\x89\x12\xf8\xe5\xcd\xf5\xe7\xdf\xddu\x1e\x93.E\xbdX\x13\xa6\b%\x9f\x8db\x10\xe94\x95\xe8-\xd5D\x02r\x1e\xb8\xc6\x16\xa5\x84\xa5\xa7\xaeG\v\xbfB\x92\x12$\x13\x19K=WLg\xb5\x15U\x9e\x91\x15 \x83\x92\xbaC)E\tR3\xafz\xf6۲(\xad\xa7=\x8c_\xe1\xa0l++\x89\xa0\x8c\xf09\x85\x82\xccp\xbf\xa0V?\x98j\xf07L\xea\x00&؈r\"V?C\xaa\x13r\a\x12\xc1x\xacS\xc1\x1fA\"\x05R\xb1\xe1\xeck\r[\xa1\xd4\xe3Ks\xaa\xc1ك\xe6k\x14\x98Ӝ<Ҽ\x82sByF\n\xba'\x12\xf0-\xa4\xe2-x\xa6\x89J\xc8_\x85\x04\xc2\xf8Z\\\x90\xad֥\xbax\xfdzô\xb7\xa4\xa9(\x8a\x8a3\xbd\x7fm\x8c\"[UZH\xf5:\x83G\xc8_+\xb6YR\x99n\x99\x86TW\x12^Ӓ-\r\xea\x1c\a\xac\x92\"\xfb7\xcfQ\xf5\xaa\x83끾\xd9\x7f\xc6\x10\x8ep\x00-\xa2\x15\x18\xdb\xd5\x0e\xb4!4\xe3\x1bÒ\xdb\xf7w\xf7mab\xde\xe6\xf8\x8f\xa5{\xd3Q5,@\x821\xbe\x06\xa7\xd1k)\n\x03\x13xV\nƵ\xf9#\xcd\x19\xf0>\xf9U\xb5*\x98F\xbe\xff\xad\x02\xa5\x91W\t\xb92\xd3\v\xcaaU\xa2\x06f\t\xb9\xe6\xe4\x8a\x16\x90_Q\x05/\xce\x00\xa4\xb4Z\"a\xe3XО\x19\x9b\x0fB\xb9pTk\xfd৷\x00\xbf\xbc\x8eߕ\x90vT\x06\xfb\xb15K\x8db\x18\xebY\x9b\x80\x9e\x05\x1d\xd3Z7W\xa7\x95\x94\xc0\xd3\xfd\x8d\xc8Y\xba\xef7\xe8\xa1t\xd5o\xefq\x01E\xb6bg\xd4\v\xad*\xa1\x84Î\xac\xda6\xb9\xfd\xe9́vF\xa2\xa4\x94\xf0\xc8\x04Α\x1cPP\x95fyN>\u008e\bI\xae\xf9\x8d\x14\x1b\x9c̒E\x0f\x1c!\xe43͙WKB%\x90\xcb<\x17\xbbs\xf2\xa3\x90+\x96\x19]\xbe\x852\xa7)\x9c#-i\x95\x1b\ts\xbf\x1fB\x04^\x15\x87\xc4XZ\xb0\x03\xcf-\x9c\x81\x1f\xdc[\x0f~\t\b\x10\xfe\xfb\x99i\rr\x82\x15\xffe\x1a!\x95P\xa3\n\xfa\x85H\xca3Q\x90\fr\xbaG\xcf\x042o\xee̴\v4\xdd\x1e\x80$\x8eG\b'C\xa3\xa7\xb0\a\xb5jj\x7f:\xf0X\x14\xd91\xbd\xb5\x8fh\xd1\x155\xfb\xed{\x1e\xc8\x0fUJ\xa0\x19\x11\x8f\x80\xe2j0\xda1\x9e\x89\x1da\\i\xf3Ӛ(M\xa5>$\b~\x1dN\x8a\x16v<\t\xb9nOS9(\xa4\x04\xb5\x1e\x8d1\xe5\x8f4\xf7\xa8#B\x030\x1b\x14\x0f\x05\x80WyNW9\\\x10-\xabY\xec\xb3\xee\xc0\x04\xfb\xac\x83\xd0R\x9f\xdd\x16\xf4\x16d\x87\xd2\xc8\x15\v\r\x15\x80\v\x1d@\xa3\xedZ4\x1f\x0fe\x02\x93\xae+\x11\xeb4\x1e\xc0$\xce\x7fH\xe6\x90\xca\xf3\xfb\x1d\xd0,g|\x12\xd5^sD\x19\xcdN.\xf8\x86еv\xe4\xcb*'\xf2ԉ\xf0\x01TBRʝyY\x81\x15;\xc8\b[\x13\xa6\x8d[Z0\xa5 ;'\x90l\x12\x1cnm_\x8d\xa7\x81M\x06`fb\xc7\x13\xe3\xec\xda\xee\xee툥z`e\x89l\xe4fF\x05\x92\xf9!\x94T)P\t\xb9^\x0f@ĹO\x81>'\xf4\x10$\xcdwt\xaf<\xee\xcf)\xc0\x1a\x8a\x12=\xa4\tnܻf\xde\x06eu\x80\xe8\xd5\xce{\x94\xc29\x92dP\v\xb1e)\xc5#\xcb \x1b\x9e\xc0\xc6'1\xfc\xa6y\xa54\xc8;\x8cز\x0ft\x05\xf9\x1d\xe4\x90j1`F\x0f\x06r\x15\xec\x8cC\xa3fR\x7f|\x9bt~\x19\x84Jp\xa8k\x96{AtX-M \x99\xd5.\x955\xa0\xc8\xf2Z\xff\xb3\xf3\x80R\xb5\x06w\b\xa6\xa0:ݢ\xd7ƴ\x99\xf3P8 #UI$l\xa8\xcc\xd0(\x06`:\x0eq\x1f\xdb:\xb4\x95u{\xbbD\xc0'\x9fd\xe7Y\x10,\xcf\xf7\x84\x96e\xbe\xf7sO\xfd\x86\x03\xf4\x0fE6Bl\xa7E\x01\xbf\x860\xefk+\x16l\xd7\x13\x84~7\xcb~L&\xa0D\xe7H\x00\xa2\x1c\x05\x82\x10\x89\xf1`\x99\x84\x02c/k\x0f\xdaO\f\xa7.?\xbe\x1b\xd2Y\xffa\x1a\x8a\x11\xa4{h_\xf6Pk\xbf\xce\xf9\xfb\xd3H\x13\x9c=\xb5\xc9\xdePƕs\xa5\xd0\xf2<\xc0\xdeJ\x05F\\%H\x8a\xafp!&\x9a\t5\x01\x15\xc8\x03\xec\r\x00\x175\x8d\xb4\x9ff\xad\vu`\xc0U\x1d!\x11b\xe0̔\xa5\x15>\xa8\x1d\x9d\b\x9e:'\xa4,s\x86^\xb8\b\xf3nҼv\xbf\x9e\xa2\xb3\x86S\xb3\xa1\t\xc1,\xa3^a\xfc\x94\x9b\xc0@mY\xb9\b\x82s_-\x8ct\x18\xf9\xf61\xadu\xa5\xfd+\xac\xbc^\xf3s\xf2Q\xe8k~>\t\xf2\xfd\x17\x86\xd1\x1b\xf2\xfb\x9d\x00\xf5Qh\xf3\xe4\xd9\bfќE.\x17\x16\xa0*\xa03*\xe9\x1e\xc7\xdb\x0e\x82C\x13p\xf7\x83\xb2\\\x93\x9e)\fE\x85tt1\x82T\xc7\x1f\xf8\x8a\xa2:H1\x1c~W@\xb8\xe0K(J\xbdG\x1c\x0e\xde\xe1\xc8)d\x87\x9a\xd3l\x18D\a\xe7a\xf7\xaa{L\xb8YZ\xd8dK\xee2\x9c㟬2D3)\x04\xaaa\xc3RR\x80ܠ\x1f\xa3\xd3\xed\x14\x93'\xed\xdaLY\xf0M\xcd8FZ:\x838\xe0\x947\xdf%\xea\xcf\xe8\xef\x9e-#\x8d\x02\x91\xfe\\\x9c\xcdDd&\xdc\x11j\xb5\x93\xcf1V3\x8a\xaa\x1d\xbdi\xa1\xe1<!Z\xa2\xe6|\xc3)\xc1\b\xd7wRR&UB.G^\x8c\xc9\xf5\x1c:\xbd\x18wak\U000c2096\xf8\x12\xe4\xd4#\xcd\x0f\xf3C\xed\x0f\x9a-N 73*bԟ\xb9\xcf\xc9n+\x94\x9dy\xd6\f\xf2\fA\x9f=\xc0\xfe\xec|\x11\xaf\xdfg\xd7\xfc\xccN}\a\xdaTϓ\x82\xe7cRsfz\x9d\x1d\xe7\x06LJ\xd3d\x83/K\\\x7f\x91\x1c4\xa8eA˥\x93=-\n\x96.\x82\xae\xa6u\x85\xfb>\xdf\xc5bRb\xae\xc6\xfa#I\xbd3\xf52>\xf59\xf9Y0\x8e\x91\x17\xce\xee@>\xdd\x06`z.\x9b,\xc2N\xc8\aE\xa8\x1a\v\x042\x01\xce7\x0e\xfb\xe9z'0\xaeĠ-\x15K@\xc3M\x18\xf7!\x9b\xcbk&\x8bنq\xdc\xd93\x8ai\x9d\x9a\xbfU \xf7>\xc5bg\xf5\x00H\xd2rÝh\xaa*oT\xc9\xe9$\x8a~_\xb5\x82\x10\x1b\x81&\x97\xdcN3}\\\r,\xc0\xd85wR;j:0\x16\b\x81ࢆ\xb08ޗ\xec\x0f.ܲǆg\n\x15\x9e#X\x88\x9aV\xc7e踀\xe1\xa5B\x86\xb9AC|\xd8\x10\x158\xf4\x88\xf5L\xa1Ü\xe0!r\xae\x9e\x17@\xf4\x86\xf5l!ċ\x04\x11G\x87\x11\xb3H\x17\x17J\xf4\b\x17\x13LLB$C\xae\xfeh8\x11\x01\xd2{\xf8\x91\x01E\x04\xc4N\xc8\x11\x15RD\x00=\b:\x9e\x18TDٿٲ\x11\xe3\xa6\xc7\a\x17\xd3\xe1Ed\x80\x11\xe1\xf3\xc5cߚ\xeaǐ\x9f\x1bhDӹ\xa3W\xf1\xc1\xc6\xe8\xab/_ \xdc82\xe0\x18\x85h\x83\x91cB\x8eQ\xb0\x18\x8e<-舒\xb0\x88&sC\x8f\xa8\xd4\xef\xb8T\xa7\xa2\xf0\f\xb9\xcc7B2\xbd\x1dX\xc4=\x90\xbc\xab\x81n\xad\x959d\x11\xad\x9f\xb7\xeaz\xfa_-j\fZ\xeb\xa7DS\xb9\xa2yn\xb2;\xcc\xf8W\xc6^\x9e\x93\xcdWV\x92\x1d.q\xafB!\x05\xbeͲ\xd1/\xc6\xfa7@fV\x11\xc8W\xa53\x9c6\xf2\xaf\xbf\xc7\xe0\xe3\x951\xc8\x12\x94\x162\x88\xe8jOD\x9e\x81\xac\xab\xd9P\xcf\xec\nװ\\\f\xaf\x86\xe3wiF\x11\xf8\tq\v\xfc\x94\x7f\xfd\xfd\xe2\bˑ*v\xc7i\xa9\xb6Bcٖ\xa8t\f\x7f\xef\xae{\x9dz\xdc5\x8b\x85Hj\xd4\xf3\x1de!\x91\xc6R\x8b\xab\xbbk\xf2\x19\xab\xfc\xc0\xc3\xc4%8,\xecӕ\xe4\xe8ݑ[\xa0\xd9\xfe^\xfc\xa4\xc0\xcfl\xbe\xd4,\xe4\xf8\xac`\x8d\x85D\x12\x10\x06N\x85 %\x96u(\xb3\x8e)*me\xc0\x15.\xb8\xba\x1d\xa6\xc8\xdb7\xa4`\xbcҐ\x1cCL,T)0Z\x8c\xa0\xe1;\xaa\xe9_\xb1m\x8ft\b\x83\x18 n\x99ϐq\x15\x9as\x1a\xb50\xea\xd0@E\xd3w\x86r|f\xab<\x9di\xc4\xcaQ\xbddܼ'\x00Ӿ\xdd\xe9\x91y\xffqԀ\xac*s\x96R\r.\x0f\xe0+_U\f}½\x03\x8b\xfc\xddD\xc6bĳ\xb1\xd1\x06z\xd0\x15O\xb7\x94op\x9d\x94\xf9\x95\xe4\xbahƙ\x1b\xb7\xb2g\n%\x82\xab\xa9\xde\x13\x03S$'q\xe5\x15\x8b1a\rX\f\x04\xaeP\x8ejǬv\x8d\xc6\nP@\xab2\x17\xd4t\xdbP6P>\xe1\x9c\\m'$M\x1f@\x11X\xaf\xb1\x90\x0e\xb5\xa8\x91\x03e\x95\xc3\b\r\xa15\xc6\xc9\xd3f\x88\xe1\x8a\b\xfc:\x1d\xb2*\xac\xeeŏʮRG\xf1x\xb8\xeb\x00\x83K\x91\x91G\xd3n\x10,\xc1\xe5V j\xaf4\x14\x9e\xc6M1\vʰ\xad\xeb\xcas\aF!y\x1c\xee\x7f7\xda܂ҬW\xa26H\x99\xb3>il\xcf\x01\xc2\xe0\xcc\x14\x98\xfeI\x9f\x02\xb8\x82L\x1f\x9a2\x0e\x94>\xcc\x1c5ĝ\xa6\n!\xff\xc3\xc9;\fqQ5\xb3\vW\x96\xe8S\xc2\\\x98\xe2\x11\x90\xf6\x8d\x98o\xf0\x86D\x02\x1a\x96\x90bb\x8d\xa0\x84\x1c\x8b\x1dɺ\xc2\xfä́\xa0\xc1\x0fʈӠ\xe4\xecŘ'\xf7\xb7U\xaf\x02w\x90Y\xefL\xc3\x01\xdeha\xb5\x15p~\xc1\xc2\x0e\xd4\xd2:O\x18\x9a\xbbJ\xf4!\x94F\xbf\xd83\x05Ɉ\x06\x9b(\xf6\x15\xa1PMv\x9e\xb3\x8c\xa7y\x85\x86\x83\x85\xea\x88ZUi\xe8\xe1\xe0tMS]\xd1<\xdf\x1bU\xb1\xe6\x87P\xbe\xd7X\xfc\xe0\xbd\\\x93\xbf\xb4Q\xa5\xc0j\x9e\x00d\x17\n \xa0\xaa|\xa5\xdc\xe4\x9dd\x86(\xb7\xa0^R\xbf\xe0\x8b\x1d\xbb\x9b!l\x96}\xce,\xf3~\x14\x80K\xdd\xe5,\x05T\x95\xa8\tƳ\xd7\xe0n\xeaҍ\xedw\x986\xf5\xbb\xadI\x1b\x13\xcbZ\x90\xb3\xdf\x04\xd7RPI\xbbo\xaf\xc5ȼ\a#\x1a\xa8\xa9\xd1\xf1m\x02\x10k\x8fǸ\xce\xc9bv\x16`b\xf2\x7f\x86\xe0\xc3\x0f\xa7\xde.r<{C z\f\xee\x97\xd6\xfc\xbdY\x1c,\xed\xf9\x17b\xf2QlUMμY3\xa8\xa9\x19\n\xf0k/\x10\x17\x98zf\xd43\xef\x1f\x99f\xc7hBH\xf4kIs⼥!\xa1\xfa\x05\x12l+\xc4C\f\x91\xfe\x13\xdb5\xf9{\x92\x9a\xfdzd\x05[\xfa\xc80\xe9\xde\xdb\xca\x03_ \xad\xc23#\xd5$ck\x13\x01hbv\x9fե\xdfcĚ^{\xf1\xcc\n6荫a:2\xcfP#4\x14\xf4]\x86fZ\xffi\xf9\v\x8cg\xec\x91e\x15\xcdM4CM\x98\x83\x1ee\x8d\xdf\xf0\xf8&\x05\xe2\x00\x7f\xeb\xf1\xf9Q \x97:[8\x04\a\ft\v!\x87\x85\xc3\x7f\x0e\xc1\x049JV\x14\xddW1\xe6R9^\x98\x02~S\xaa\xebb\x8c\xc6\xee\x9c7\x9c\xb2K\xdd\xddU\xc2d\xf1\xf4\x05\xb8X\xfb\x19\xa0\xec\x80%m\xdc\xd8N\xb5\xe9x\x96\xd4e\xebv[\x96\xe2F\x06SF.\x1e\x8cKlV\xfa\x8d\xc5\xc0\xf5\xba\xc0,4C2\"\x8d\xc6,\xf3\x11kH\x0e\xe9\xee\xa5\xe98\xb2\u05fd[\xc1C'F8\x11\xbdMt\xc6\xfb\xd2:\x8b\xea\xd7\aݟ_\xd8ݚ\xb4\xf1\xeb]V\x1ak\xc9\xed\xd3\x18\xa8\x1d?P\xfd\x931\xee8m\xb9\xee\xf7~vmy\x16\xae\xd5h\xfc\x930-o\xd7k\xcdbX\xa7\xd2\xeb\x1cw\x01y\x86e\xe7~_\xc4\xe4\xc4\xdaqt&9\xf7\x9c\x04\x8a\x9d{\xe7\xd6;\r\xd2*\xa2\xee)\x02$\xa9\x9d\x8a\xce\xf2\xe4\xb1\v\x963%u~=T\x14\xc8֠\"\xea\xa2\"A\x0eVOͮ\x8f:FTf\xd4K\r\x12u\xb4n*\x1ad\x8b\xa8s\ua9ce0J}\x8a\x1f9\xecg\xac\xab\x9aY_5\x03bS\x89u|\x9d\xd5\x13H\x1c[w5H\xe0\xb1\xfa\xabh\x88\x1e\x87d\xaa\x0ek\x06\xc4`y\xd4A=\xd6\f\xa0\x83\x95[\x1dN\x8d\xed\x1c\x1c\xfaLUp\xb9_\x98\x9a\x01\xf3\xd9*\xb9\x8e\xb0\xe4GKa\xbck\xe1?q\x95^\xf1\x15_3+\xbff\x14\xdf\x1c;\xcaV\x85T\xcc \xe7W\x86\x1dɯ\x8e\x05\x88\xa8\x14\x8b\xc2\xc1o]\x89\xab\x18\x8b\x02yPU\x16Q9\x16\x058\xb8\x9de\xb8\x82,\n\xe6h\x95\xd9a%\xd9\x1c\x159\xc2y\x9b!\xd53\x9aίB\xf3\x1f\x8cj/\x163\xc4\x12\xc3|\xef\xf1`\xe7\xfa$#\f\xb7\x93\xc53\xe9C)\x94\xbe\x18m\xd1C\xebF(m\x93\x87\x1dW} \xbb8\x01\xd58\".\xe3\xe8N[\xc0*3\x7fj\x10\x9a\xec^r\x1d\xa5\xa6>\xc3,\xfc\xa5\xb2\x95ɴ\x801\xadp\xd6X\x17\x9b88\xb3ˑ\xf8\xff\xd30S\xeciE\xb0\x94\"\x05\x15\xac\v\x9a=\xebt\xc8{H\xc7:\xd1Km\u0dce2\xeb1i\xe8\xe3\xdcx$mL\xbb\xde\xc0\xde\x7fi\xe5\xacф\xe1\xdf1\xa2|\f\x8e\xaez\xb3\xa0\xfd\x13\xac\xa2ѽ\xb2\xbd\xbd\x02:`&B\xa2rS\x19\x83\x14\r\xb9-\xea\xffhNK\xc1\xf85j\xc3\x05y\x1b\xddg\x8e\v\xe0\x99a\x16(C\xb5\x81\x11\xecp\xfd\x1b\x86\xd4\x0f\xf8\"\x12\xa2s\xaa\xb1\xdeg\xb7\x05\t\x1d\xce\x1e\xae\x82\xc4s\xcal\xbf\xc0ts+\xd1\xe3\xde\xf4\n\xab\x83\xa4\xaa\xc3w\x88\xf3ɜ\x04\xa8\x91\xfa\xc3g\x92\x00\xc1\xdfcq\xe8\x91|\xf9d{\xd7\x03\xc7d\xf0\xce\xd5\xfeFClUjm\xe9#\xb8ss\x80\xa7\xa2\u0083\x97Ldf*Xg@\xb4L\xb4\x93I\xe4\x9c\x19S\x9f<\xf4Y\x1a\xe9d|2\xb3\xd6|\x97\xe4G\xca\xf2\x97d\xab\x04-g\x18\xcb\x1e[omo\xafl\xbc*V \x8d\x03\x82\x87[F\xc3$N\x12<6F\xe1\xd0\xe6\xbb\xf9\x9e\x925e9\xae4\xce\xd1\n\xacaΈ\xa9\xe3\xd2x\xb6\x90\xf6\xf5Ω\xe0\x8ae\xe0]\x88\xf9\xd2\"\xf0\xd48D\xc9\xd4\xdf\r\xea\xf4\f\xa0f\xa0L\xf1Wڍ\x7f\x86\"\x17\x8c\xb3\xa2*.ț\xe8.V\xf7\xf1\xa8\xb2M\xb4\x91A\xbc\xf6\xd7\xeet\xb3'\xc8J\r\xc3K\f-Pw\xc76\f\x1f~\x90\xaf^`\xb0j^\x91\x15\xe8\x1d\xe0Ჸe\xc2\xf2Z̈́\xb9\x85\x99\xba\x7f\x84\xae\xb9\xa2\xfa#\xe9\xe7\xf7\x10x\xdfȝ\xbf\x87\xecwd\x8c\x86K\xbc\x8az2:\xbb\x8aԬ\xeb\xa4Q,g@t\xbbPr\xd0\x10гF{f\x80m\xe9ٽ\xdb2\x81Dh\x92\xb2\xe6\x80A\xcf\xf5\x19\x80ź3\xad3\xdeu\x17^P\x10\xe6\xa6s\x1c\x8aQ\xadg\x84\xa8s\x10Y\x1a\xde-\x9e\xf1\xed\xb1\xaea)\xe7E\xc37\x12\x9e?\xea,%C\xa5\x10S\x81\xe7$L\x13\x98v\x03O\xa7+\x94\xefC\x91\xe7$T\x83\xc9)\xf2<E\x9e\xa7\xc8\xf3\x14y\x9e\"\xcfS\xe4y\x8a<O\x91\xe7)\xf2<E\x9e\xa7\xc8\xf3\x14y\x9e\"ϣ\"\xcf\x18\f\x97\xa6\x14z\xf1D\xac\"\x8b.\xa7Оx\x97\xab->∁\xeb\xe1\x9e\x03\x1byg\xed\xfc\xac\xaf\xcdio\xce\xc5ܓ\xd7]s\x9apL\x80\xfd\f;d=\x02n\x90\xf3\xb7P^\x8f\x02\xe8\xed\"{\xca\x0eY\x87i\x8f.Ϲ?\xd6\xd3b\xfe\xd6\xc9sW|\\\x00\xf5\x85\x1c\xa6\xf4\x10\xb2\xd0kC\x8eZ\a\x8f\xc5\xec\xd0s\xd20F\x8bLH\xdfX\x7f\x93\xc4\xf1\"\x13\x02\xd1\x13\x9az\xb7\x83\xa3\u1cc8M\x8bö21\x00\x15\xeb{~s\xf6\xcb\xe0\xc4Q\xb4\x0fRےp\x10\"i\x13\xd6\x1d\njJE\xda\x1b$\xba\x1bU~9\x82}\x8c$\x87D\xb7\x96I/\x8e\x83 IHH\xbb\xc4\xf4\xc0~\t\xb4\xd4P|*\xddL\xe6\x9c\xe8\x18r\x0et{\xc2\xc9QT\xedy\xba\x95\x82\xe3\rW6\x11~\xad\xa1\xb84\xf9bW\xc2gj\x96f\x18\x83\xb7d+\xaa\x80\xa7:A\u05c8\xfd2\xe1]2\xe1\x8bQ\x9a\xe3\x9a\aA\x12{j\x19n\xdb\xc5;\xaa0\x87\xdfژ\xeb\x95W\x8bA\xc1\v@\xc4M\xac,?o\x9f*ܕI\xf2Ɍ\x81\xe6ɱ\xf25\x9dS\xee\x97u\x86\xda\xf5\xa8\xda\xef\xd6].\xe9nK\x99\xf6\x92\x9f\xb0\x8bfTE\xe7\uf609A\xfa\xa5\xce\x0f\x9e\xb7;&v\xb9 b'L\x87D\xcftnp\x13\xe6\x8e\r\"Bߛ\xaf\xa7\xe8\xac\xe1\xd4lx꾖\x178-\xf8\xc8=,\xd1\x04\x8bۯ\xd2!\xd7\xd8.\x95ӕ#\xa7+GNW\x8e\x9c\xae\x1c9]9\x82W\x8e\f_\x99\x1b?;\xe7\xff\x1f2;I\x05\xbb\x7f\xc4_\x91x\x11'\xfc\x1f[]\xbc\xef\xe0/c\xf4\xd9j\x04\xdb;\x97g\xcc\x1b\x1a<\\\xd3,O\x11-\x1e\x80+\xf2\xed\x9b\x7f\xfc\xfd\xfb9\xf9\xf6\xcde\x88\xec\x1fx\xe7\xf2\xf7\xefc\x02\xfc\xed\x1b&\xe4\xbf\x7f7\xb3\xaf\xfd\xc3\\\xa6\x8eO$4;\x03W\xfb\xdeƘ\xe6t\xd2)\xfdp\x18u\xfa\xfaU\vs\x1fg\xa30?\xdd_\xe1Q\xb1@~\xf5\xdb7o\xfe\xfd\xcd\xdb7\xbf\xfd\xf5(t\f\xdf~\xf5\xf6\x0fo~\xff\xe6\x0f\xbf\xb6@<\xfe\r\x04\xffsCp\xe4\x8d#\xec\bp\xaa\xf1v\x99W.\x01g\x82D\xc6\x0fxꉠ\xce[\xecu\xd1\xdf\x18悜\xfd\xd1\xf7\xfd\xd3\xf2\x8f5\xde\x7f:\xb3\x8b\xe2\xafF\x8fQ\x8b\x12\xf4\t!\x17\xf3\xaf\az\xf6\x1b\x81\xe6\a\x93\x01\x90\xd7kRT\xb9fe\u07ba\x8cToa_\x9f\aڿY\xa86\xdb!\x90\x9d\x91\xe0Y\xce;\xc8s\xfc\xef\x01\x15\x0e/\r\x1a?\x16\x13\x8d:\xe0\x8d\fF\xb2\xccyR\xc68\x14x\x02\xb8?>5Y\xccv\x87\xc6C\xbc\xd3EC\xa7\x8b\x86N\x17\r\x9d.\x1a:]4t\xbah\xe8t\xd1\xd0颡\xd3EC\xa7\x8b\x86\xfeE/\x1a\x122\x039\xb96;G\x9c'\x05\xb9#\u009fz\xef\xef\xadJ\xba0\xc1`\xd9^\xf7\rqT\xd4'ޥ\xe4/\x8c\xbb\x8a\x13<ˤ\xe5\x93x 6\f\xaf\x1d\xa6\x00Ȏ\x97j9\xecb[\x05%\x95>'a\n\xdbTB\xdec\x05\x9f\x7fC\x00$v'[\xaap1\xb5\xa0\x9a\x9c\xd5\xcb\xf9\xaf\xed\v\xf0ﳄ\x90\x1fE]\x02\xd5\f=\xe4\n(V\x946<'gm0O\x13\x9c\xa0\xc0z|~4\xc7\nވ\x9c\xa5\xfb\x8bi\x86\xdf\x0et\xf3\x8ci\xa7E\x86ڍ_\x84\xe0\x12)5-\x9dy\xf1\xc7\x1e\xa2;e\x97\xa0\xb2\xfe\xaal\xf8H\x18\xdf\x19#C\xc6\xdb\"ɴ\x82|m\xaf\xf5\xc0;9 \xc3\xebfl$\x89\xd8\b^'\xb7\x02\xb0KC\xb2dq\x84\x12y\xdaϦ\xba\xa3wW\xc9\xea\xcbr\x9a*\xaa1\x94m\xb7\xe6F\x1dWt\xb7\x16y.v\x8b\xe3b\rZ\xb2?K\x11\xba\xbb\xe6`8\x977צ\xb9\x17\x9c\x8d\xf9\xc3\x17\xfb\xfaA\xd8\v~\x82\x10Ikা\xa6\ru`cK\xfd\xe7\bD\xb49\xb5\x8f\xe7\x04&ō\xab\x977\xd7\x16\xcb\xc4(5\xee\xcd\x13\xee\x02%&\xb3eIe\xb0(\xc0˃:\xef`\xe8}\xa8d1\xd6i\xd4\x12\x13\xf2\xc0x\x16Is34Go\x84\xdc)\xc31\x94n\xd1\xf3)8\x8d\x1fW4yP\xd1\v\xe0\xe4I=\x8c\xd5\xd2Pq1\xb3\x9cw\xd2\x1d\x98\xeb\f(w\x89\x1b\xdeB\xf6.\xb8\n\xd1!\xdf]\xaf\xcb@\x01\xae\x87:vmYSu\x1b\xbeg\xe8\x19*j\xdb\x03\xbc\x05s\xc1\xd9\a\x91\x9a\xa8{\xe6X{\xbd\xfb\x12\x84\x89\xc0T\xf0\xcc\x19\xb8A\xd8\xc4lܥ\x1b \xb9\x87һDΣ\xdb[\xe2\xa8\xd7\x12X:\x92u\xc7\xfc\xc0\xda]\x14\xbf7]\xeak\xca:s\x17B\x12\x8ai!\xf7\xdd\u05fcR\x91h'\xe4\x13\xa6^\x0f\xae\xa8s\xa3\xa81\xb5$\xaa\auԴ\xe5{\xbb;\xa5fp\xcd\xf5\x18\x10P\x7f\xb5V\x8d\xd9 P\x82\xd4A\xa3{\xf3\xf9U\xebv\xb8z\x1dåb\\z\xb4\xae\xb7r?\a@\xfe\xf0\xb2\x15\xe4\x8eSsd\xbc\xdb\xc3\xe5%\x8d\xad\xf5\xa1\x90߾\xe2d}\x10&!\xd4U\xf5\xf5\x016;H\xbbs\xff\n\x8c:@v\x94Xhf\x0et\x88\x18\xe0=s\x1bp$\xe5\x8a\xe1\x18;a\x02\x8e\xd1\xc4lf\xffN\x9e\xb9s\xdd\xe8f\x98\x01\x84\xa49U\xad\xcbA\x9cw\xef\xfa\x10\xda\x01N7\xa0\x8e\xe6\xf5\xb4\a\xd4\x1aR\xa8I\x9f\x18-\"P\xc7\x16\x8f\xba\x1f\xd8\x00q\x82\xc0m\x8d|\x83\x87\xb15n\x81\xb5~h\x8b\x1e\xf1Y!\x94&\x19\xdd+\x029-\x95\xbf\xc9q\x04\xbcۢ\x84۪\x10R\xc7^\xf9To\xb28:c\xd5!\x8e\x95_\x94\x97\x86L\xadḁ\x8c\xcfض\xc9K\x84\xbf\xb8\xd2\xc1\xc1\x88\xceo\x1bs\xb7\x1e6\xbbHG\x81#\x19\xc3#\x8f\x11\x9f\x06\xcex\x8b\x1e\x99\xde!\xff\x0e6\xbc\"\x98f\xfei\xf1l\x11\xb9\x11\xcd\x11\xc4\xd4\xc6\x0e\x10\xfa@В\xc53\xed\f\x8d\xdf\x0f\xeaXy\x85\x9c\x9cE2g\x13MGO\xba\x9e\\\xb4\xad\xc9\x04\xe8\x1a\x91(:\x19\x85\x84d\x93\x90\xbb\xfbˏ\xef.o\xdf\xfd\xef\xf5\xe54S$\xf9\xf3\x87˫\xeb\xf7\xb7F(/\xff\xfb\x8e\xdc\xfd\xee\x9c\\\t\x91c\xf6\xfcR\xa6[\xf6\b\xf6\xb7\xaf\x95\x04\xf2C.V~2\x99b̈́m\x8fs\xa1\xbd\xb7\x8c\x827\xda\xc0\x11\xcb\x10\x7f\xa4\xe1\xa4s=\x9d\x18\x9b\xf6\xfa\x1bƨ\xc5\x11Hh\x1d\xd8|ܑ\xb6\xfb\xfb\x0f(dԔs$\xef*[Y\x8f\xf1\xa2\x02\x9cs\x1c坈\xae\xc2L\xc0#\n\xf0\x82P#g?\xf4go\t\xe8\x1c\xd8{\x94\x92\xc5\x11|v\uea7cG\xcaO\x0f\xeb\xa7V\xf3\x96S\u05ce-\xf5\xb6vzex\x83ǖ\xf2,\x87\xc6\xf76LY\xdb}\xfb\xcd\xed\xaa\x03\x97\xd4\x06\xedm\xffn\xf3.\x1e\x88o*\xf8\x9am*Y\xdfSU\xef<65<\x01\xb8\xbe\xfa\"\\\xd1\x10>\x81a9v\xdb\xec\x92<\x88\x92\xd1c\xb8\xf6Hs\x96\x19\x89\x8a\xce$}\xeeu\xe9q\xaf\xe5Z7\xc0\xeb\xbc\xd1bdM\x1c\xe7\x84t\v郿\x8aY郹\x04wt3\xce\x14\x16D\xb4.D\v\xbb\xe8\xc6iX\x1c7\xa5\xbe`N\xaa\x15\x81$\x8b\xd1\x05\xcf\u061cT?\xf34\x02uVN\xaa\x9fy\x1a\x81{\xcaI\x9drR\xbd\x9c\x94\xb5\xbeF-|$o\xd6\x12\xff\x12*)\xe9P\xf2s\xb8w-\xf8\xfd*\x93\xa0\x8f\x8a\xcdn>_\x99\":\x93\x88Ŏ\x855\xefWw\xd7M\xfe\xc0\xcf=\xa6q\x1d\xed\xa8\x10\xcd\\I\x84\xefe1ax\x9ag\xb3\x10\x81\xa6\r\x17\n8\xd1bc\xd3\x16\xa6\x8au`\x80sf%\x1c\xef\xc4\\\x141\xebLH\x9aeaM\x7f\x97\tP\xd1\xec;\xe8\xd9*\xfbj\xe5$\xc6\xf6Ċu\x10\x16UJ\xa4\f\x13i>0e\xca\xcd\x18\xc9bv\x149\xa9tc.\xe3\x88\xf2T\n>\xed8\x9et\xe0\xf4^]\xf3\xd0]\xfd\x1d\x12\xfet\xd0\xd1{nCy\xb0J\x01\xe95?\x00\x8f\xcbݎ@u寭\x04e\x8aܹ*\xdcd13\xc5\x11Ne\r\x9b\xa9e]1\xdd{\xec\xeb\x89\x17\x11\x94\xb5\xf7\x9d_,\x82\xd4\xf3ù3\rIJK]I秤\x954\xb7\xc3\"\x10\x97\xf4\xf5\x8a3\x84Y\xd8[ȩ\xd2Q\xbc\xfcP7\xf4\xb3\x03v5~}\x9do#;\xaa\x88\xac\xb8s\x1b\x06\xeb!\xfc\xa8\x86\x11u[t\v\xaa/0\x8d\vK\x84\x7f\x1c;\a\xf5\xc0ܦ;1\xd2\x1blCX\x97Ц\xa3\xb7\x92~\f\x8b8\x17xI>\xc2\xe1\xcaⒼ\xe7(\x93\x87Ӝ=\xe3\x1a\xb2\xc6W\x9d3\xc4\xc6o5Ǽ\xa9\x89\xd16/\xb1\xcd{;ձn\xb7\xe5\t\x9b\xc3ć\xd8\xfa+\xb6\xb69\xb0\x14\xc7\xf4\xebE\xb4\xe1\x1a\x19I\xd8`\r\xaa\xd4\xc1C3\x87d-!q\xe1w\xfbI\xb5\xf2\u038d\xba ߾/\xfeo\x00\xfc\x99\xdeϙ\xb1\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V\xc1\x8e\xe36\f\xbd\xe7+\b\xf4\xb0\x97\xda\xd9m/\x85oE\xdaà\xedb0Y\xcc]\xb1\xe9\x84\x1dYRI*\xd3\xf4\xeb\vI\xf6$\x13;۴@\x13_,\x89\xe4\xe3#\xf9\xac\xaa\xaaV&\xd03\xb2\x90w\r\x98@\xf8\xa7\xa2KoR\xbf\xfc 5\xf9\xf5\xf1\xd3\xea\x85\\\xd7\xc0&\x8a\xfa\xe1\t\xc5Gn\xf1'\xecɑ\x92w\xab\x01\xd5tFM\xb3\x020\xcey5iY\xd2+@띲\xb7\x16\xb9ڣ\xab_\xe2\x0ew\x91l\x87\x9c\x9dO\xa1\x8f\x1f\xebO\xdf\xd5\x1fW\x00\xce\f\u0600 \x1f\x91E\x8dFa\xfc#\xa2\xa8\xd4G\xb4Ⱦ&\xbf\x92\x80m\xf2\xbfg\x1fC\x03\xe7\x8db?\xc6.\xb8\xb7\xd9\xd56\xbbz*\xae\xf2\xae%\xd1_n\x9d\xf8\x95\xc6S\xc1F6v\x19P> \a\xcf\xfa\xf9\x1c\xb4\x02\x11.;\xe4\xf6\xd1\x1a^4^\x01H\xeb\x036\x90m\x83i\xb1[\x01\xa4\xa4'\U000aa44b\xe3\xa7\xe2\xae=\xe0\x90INo>\xa0\xfb\xf1\xf1\xe1\xf9\xfb\xed\xbbe\x80\x0e\xa5e\n\xa9\x04\x8b\x99\x01\t\x18\x18Q\x80z0m\x8b\"\xd0Fft\n\x05%\x90\xeb=\x0f\xb9\x92o\xae\x01\xcc\xceG\x05= <g\xca\xc7\xcc\xea\xb7#\x81}@V\x9a\xd8\x18\xcd\xceMv\xb1z\x85\xf5CJ\xa7\xa4\x0f]\xea.\x94\x1ci\xa4\x04\xbb\x91\x01\xf0=\xe8\x81\x04\x18\x03\xa3\xa0\xd3k\x94\xe9\xf1=\x18\a~\xf7;\xb6Z\x8f<\b\xc8\xc1Gۥ\xa6<\"+0\xb6~\xef\xe8\xaf7ߒ\bIA\xadѩO\xce?r\x8a쌅\xa3\xb1\x11\xbf\x05\xe3:\x18\xcc\t\x18S\x14\x88\xee\xc2_>\"5\xfc\xe6\x193\x99\r\x1cT\x834\xeb\xf5\x9et\x1a\xae\xd6\x0fCt\xa4\xa7u\x9e\x13\xdaE\xf5,\xeb\x0e\x8fh\xd7B\xfb\xcap{ \xc5V#\xe3\xda\x04\xaa2t\x97\x12\x96z\xe8\xbe\xe1q\x1c\xe5\xc3;\xaczJ\x9d%\xca\xe4\xf6\x17\x1by \xbeR\x814\x0e\xa5?\x8aiI\xf4L4\xb9}.\xc9\xd3\xcf\xdb/0\x85\xce\xc5x\xe7\x14F\xdeφr.A\"\x8c\\\x8f\x9c\xed\xa0g?d\x9f\xe8\xba\xe0ɕ\xeej-\xa1\xbb\xa6_\xe2n \x95\xa9wS\xadj\xd8dŁ\x1dB\f\x9dQ\xecjxp\xb01\x03ڍ\x11\xfc\xdf\v\x90\x98\x96*\x11{_\t.\xc5\xf2\xfcK^\x9a\x91\xb5\x8b\x8dI\xe6n\xd4ka\xba\xb7\x01\xdbT\xc1Db\xb2\xa6\x9e\xda<\x1e\xd0{\x06\xb3dR߅$[\xfcK,\xa3\x92\x144W\xfa\xe2\xfb{\xd0,\xcbI\xfa\x87\x83\x11\xbc^\xbc\xc2\xf4\x98\xce\\Ƿ\xd4c{j-\x16\x17EM🡤?\xba8\xcccV\xf0\x19_\x17V\x1f\xd9'eͺ\x0epGo\x8cߛ=M\x1f\xcfۙ\x95S\xf9\x1bv)\xd5\x17\x02=:\x02\x8eΥ\xb9\x9d)dzfJ>;C\x8a\xc3\x02\x9aE<\x0f\xae\xf7I[դ\xc0F\xcb<\xe1X\xec1N\xc1\xb5\xe0\xf0v\xado\x89\xd7]\x84\x96'\x7fI\xff\x9bq\x92\x1bb\\\x8c]eT\x8b\x1b)\xe2\xc2ƍ\xf9\x1aQFk\xcd\xceb\x03\xcaqn]l\r\xb39]텩վЀ\xa2f\b\xcd\xea\xeb\x05\x9b\x19\xa49y=\xa0\xbb5\r\xf0jd\xe6\xf3\"2\xecN\xb7L7ow\xc0\xf9H\x95[F\x03I\xbb+\xa5\x05\xce\xee\"e\xb1z\xe5r\xb2x\xf3\x98\x11\xb2\xbd<;iƻј\xeef\xf5\xfd\x10\x16\x8b=[\xcc0\xbb\x8b\xf4D=\x9b=6\xa0\x1cq\xf5\xf7\x00\xb1J-\xe7\xa6\v\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VQo\xdbF\f~ׯ Ї\xbcTr\xbb\xbd\fz\x19\x8at\x0f\xc5\xda5h\xb2\xbc\x9fu\x94u\xf3\xe9N#yJ\xbd_?\xf0$Ŏc'.\xb0E\x06\x02\xdd\x1d?\x92\xdfG\xf2T\x96ea\x06w\x8f\xc4.\x86\x1a\xcc\xe0\xf0\xbb`\xd07\xae\xb6\xbfp\xe5\xe2j|_l]\xb05\\'\x96\xd8\x7fC\x8e\x89\x1a\xfc\x88\xad\vN\\\fE\x8fb\xac\x11S\x17\x00&\x84(F\x97Y_\x01\x9a\x18\x84\xa2\xf7H\xe5\x06C\xb5Mk\\'\xe7-R\x06_\\\x8f\xef\xaa\xf7?U\xef\n\x80`z\xaca\x8c>\xf5\xc8\xc1\f\xdcE\xf1\xb1\x990\xab\x11=R\xac\\,x\xc0F]l(\xa6\xa1\x86\xfd\xc6\x041\xbb\x9fB\xbf\xcfh\xb73\xda\xe7\x19-\x1f\xf0\x8e\xe5\xf7\x17\x0e}v,\xf9\xe0\xe0\x13\x19\x7f6\xb2|\x86\xbbH\xf2\xc7\xde{\t#\xfbiǅM\xf2\x86\xce\xd9\x17\x00\xdc\xc4\x01k\xc8\xe6\x83i\xd0\x16\x003?9\x99r\xa1\xe6\xfd\x84\xd8t\xd8g\xce\xf5-\x0e\x18>\xdc|\xba\xff\xf9\xf6\xc92\x80En\xc8\r\xea\xe3\\\x8a\xe0\x18\f,\x91\xc0C\x87\x84p\x9f\xf9\x04\x96H\xc8sЏ\xa0\x00K\xfc\\=.\x0e\x14\a$qK\xf2\xd3sP_\a\xabGq]i\xe8\xd3)\xb0ZX\xc8 \x1d.飝\xb3\x85\u0602t\x8e\x81p d\f\xb2\x17r\xff\xc4\x16L\x80\xb8\xfe\v\x1b\xa9\xe0\x16Ia\x80\xbb\x98\xbc\xd5z\x1c\x91\x04\b\x9b\xb8\t\xee\x9fGl\x06\x89٩7\x82\xb3\xe6\xfb\xc7\x05A\n\xc6\xc3h|·`\x82\x85\xde\xec\x80P\xbd@\n\ax\xf9\bW\xf0%\x12\x82\vm\xac\xa1\x13\x19\xb8^\xad6N\x96\xbejbߧ\xe0d\xb7\xca-\xe2\xd6I\"\xf1\xca\xe2\x88~\xc5nS\x1aj:'\xd8H\"\\\x99\xc1\x959\xf4\xa0\ts\xd5\xdb74w\"_=\x89UvZE,\xe4\xc2\xe6`#7\xc2\v\nh\x0fL\x850\x99N\x89\xee\x89va\x93\xd9\xf9\xf6\xdb\xed\x1d,\xae\xb3\x18O@a\xe6}o\xc8{\t\x940\x17Z\xa4l\a-\xc5>cb\xb0CtA\xf2K\xe3\x1d\x86c\xfa9\xad{'\xaa\xfb\xdf\tYT\xab\n\xae\xf3\xb0\x815B\x1a\xac\x11\xb4\x15|\npmz\xf4׆\xf1\x7f\x17@\x99\xe6R\x89\xbdL\x82\xc39\xb9\xffS\x94zf\xed`c\x19og\xf4:\xddɷ\x036O\x1aHQ\\\xeb\xe6\xcen#=A\x040K\x9f\x9f\xc6\xdb7\xf7\xf9\x06\x9f\x87|\xeb6ǫ\x00\xc6\xda|E\x18\x7fs\xd6\xf6\x05\xc2N\xe4}\x1dC\xeb6Z\xa8m$\x18(\x8e\xce\"\x95K\x9es$\x89\xe6\x84\x1dz\xcb\xd53\xc83\x9c\xeb\xaf!\xb4\xaa\xb1\xf1\xf5+\x91<\x1eT\xa7b\\\x98f\xd6\x1e \x97\x1e\xf5\xf3\x8c\r\x82\xc1\xe6\xa1~\xfcH\xcc5\xcch\xe1\xc1I75\xc7\xc1\xc5\x00p\x99\n\xfalqwj\xf9(\xf6\xbb\x0ea\x8b\xbbi\x9c\"06\x84\xa2\xf3\x8f\xd1k\xf3jgV\x00_\x12\x8b\x86fN\"\x82\x8e\bg\x17\xeb-\xee\x9e\x13\xfd\xaa\xb8\xf3}\xffz\xc8Wz/.\x01\x13\xb6H\x18\xe4d\x8b\xeb'\x06\x05\x14̟/66\xac\x13\xb6\xc1Ax\x15G\xa4\xd1\xe1\xc3\xea!\xd2օM\xa9\x84\x97S!\xf0JC\xe1՛\xfc\xefdD\x00w_?~\xadჵ\x10\xa5C\x82\xc4\xd8&\xbf\x14\xda\xc1m\xf7\x16t0\xbc\x85\xe4\xec\xafW\xc5\t\xa4\xd7x\x89Y+\xe3/\xe0F\xdb\u07b5;\xbd\xb9sPJ\xd1\xed\xa4J$й\xa9b\xf7\xb3\x9a\xd3|\xb0/h\xb5\x8eѣy^z:}\x1d\xe1\xd1=\xa2\xbfR\xcb\xe9G\xda\f\xe0{\xb9\x17\xaa\xec\xcdPN\xbe\x8d\xc4\xde5G\xa7\x97>\xaf\x8b\x17y\xb8\x99\x8f\xe9xP\x0e\x16\xb3\xa5l\xa6\xaf\x98\xfcMc6X\x15\x17+r:\xf1\xf2\xd1AqA\xd6,F\xd2Q\xcf^2ҳٜ\xe7z\x1e\xebM\"-\xff\x19\xf3\t$h\xb2\xff\xd1X\x1f:\xc3\xf8\n\xe7\xa7=ܨ\xe5\"\x83w-6\xbb\xc6\xe3\x04\b\xb1}\x06\xf9\x837\x91\xfe0\xa4\xfeyl%|\x18\x8d\xf3f\xed\xf1\xc4ޟ\xc1\x9c\xdd=+\xfeI=\x9f-2҈\xb6\x06\xa14y\x9e\xab\xac\x06\xa1\x84ſ\x03\x00\xec\xa0\xe0\xa1k\r\x00\x00"),
 }
 
 var CRDs = crds()
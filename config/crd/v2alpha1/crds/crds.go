@@ -29,8 +29,13 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcY_s\xe3\xb8\r\x7fϧ\xc0l\x1f\xf2\xb2Rn\xaf\x9dN\xc7o\xbbN;\x93\xe9m\xea\xb9\xec\xe4\x9d\x12a\x99\x17\x8adI\xc8i\xda\xe9w\uf014d\xfd\xa1\xe3d\xafwz3\t\x82?\xfc\x00\x02 ]\x14ŕp\xea\x11}P\xd6l@8\x85\xff\"4\xfc+\x94O\x7f\t\xa5\xb27\xc7OWO\xca\xc8\rl\xbb@\xb6\xfd\x19\x83\xed|\x8d\xb7\xb8WF\x91\xb2\xe6\xaaE\x12R\x90\xd8\\\x01\bc,\t\x1e\x0e\xfc\x13\xa0\xb6\x86\xbc\xd5\x1a}Ѡ)\x9f\xba\n\xabNi\x89>*\x1f\xb6>\xfeP~\xfa\xb1\xfc\xe1\n\xc0\x88\x167\xc0\xfa\xa4}6\xda\n\x19\xca#j\xf4\xb6T\xf6*8\xacYq\xe3m\xe76p\x9aH\v\xfbM\x13\xe0[A\xe2\xb6\xd7\x11\x87\xb5\n\xf4\xf7\xd5\xd4O*P\x9cv\xba\xf3B/\xf6\x8e3A\x99\xa6\xd3\xc2\xcf\xe7\xae\x00Bm\x1dn\xe0\x9e\xb7v\xa2F\x1e\xebm\x8aP\n\x10RF\x96\x84\xdeye\b\xfd\xd6\xea\xae\x1d\xd8)@b\xa8\xbdr\x14Y\x98\u0082@\x82\xba\x00\xa1\xab\x0f \x02\xdc\xe3\xf3͝\xd9y\xdbx\f\t\x16\xc0/\xc1\x9a\x9d\xa0\xc3\x06\xca$^\xba\x83\b\xd8\xcf&*\x1f\xe2D?D/\x8c7\x90W\xa6\xc9!\xf8\xa6Z\x04\xd9\xf9\xe8B\xb6\xbbF\xa0\x83\nsh\xcf\"0<O(\xcf\x02\x89\xf3\xac.\x90h\xdd\x12\xd1di\x82$\x05a\x0e\xd0ֶN#\xa1\x84\xea\x85p0co}+h\x03\xcaП\xfft\x9e\x8b\x9e\xac2.\xbd\xb5fN\xcc\x17\x1e\x85\xc9pB\xc2^j\xd0gٱ$\xf4\xaf\x01B\xac\xe0\xcbd}B\x92\xf4N\xc7/B\xe1\x90\x03\xbb\a: |\x11\xf5S\xe7\xe0\x81\xac\x17\r\xc2O\xb6N\xee{>\xa0\xc7(Q%\t\x8e^P\xec;볮sX\x97I\xb6W6\xe8Z\xf8o\xbe\xd1\xff=\xb6j\x8f\"\x1b[C\xaa)\xa3\x84\xb2&\x1f`\x9f\x1b|SpMI4Vℱ\x19&\x15\xc0y[c\b\xaf\x04<+\x98\xa1\xb8?\r\xac\xa8I\x12\xc7\x1f\x85v\a\xf1)%\x99\xfa\x80\xad\xd8\xf4+\xacC\xf3yw\xf7\xf8Ǉ\xd90\xbc\x920DM\x813\x05\xc3wޒ\xad\xad\x86\n\xe9\x19\xd1$\u05f7\xf6\x88\x9e\xf3\\\xa3L\x185r֖S\x81S\xce\xe6\xf8\x8e\xfax6Mz\x8c\xd1\xc3\x00\xfd\xd4\xfb\xc0{:\xf4\xa4\x86,\xdc\xeb>\x15\x98\xc9\xe8\u008ek65I\x81\xe4ʂɌ>\x97\xa2\xec\xd9I\xceR\x01<:\x8f\x01\r\xcd!\xf4\xdc\xedA\x18\xb0\xd5/XS\t\x0f\xe8Y\r\x84\x83\xed\xb4d\xe3\x8e\xe8\t<ֶ1\xeaߣ\xee\x00d\xe3\xa6Z\x10\xf6%\xe1\xf4\xc5\xdcm\x84\x86\xa3\xd0\x1d~\x8c\x94\xb5\xe2\x05<\xf2.Й\x89\xbe(\x12J\xf8\xca<)\xb3\xb7\x1b8\x10\xb9\xb0\xb9\xb9i\x14\r\x85\xb5\xb6m\xdb\x19E/7\x91oUud}\xb8\x91xD}\x13TS\b_\x1f\x14aM\x9d\xc7\x1b\xe1T\x11\xa1\x9bX\\\xcbV\xfe\xc1\xf7\xa58\\ϰ\xaeb-}\xb1&\xbe\xe2\x01.\x8c\x1c\xe8\xa2_\x9a\xac8\x11\xcdC\xcc\xce\xcf\x7f}\xf8\x06\xc3\xd6\xd1\x19K\xf6#暈\xe1\xe4\x02&L\x99=\xfa\xe4Ľ\xb7mԉF:\xab\f\xc5\x1f\xb5Vh\x96\xf4\x87\xaej\x15\xb1\xdf\xff\xd9a \xf6U\t\xdb\xd8m@\x85\xd09>Ⲅ;\x03[Ѣފ\x80\xbf\xb9\x03\x98\xe9P0\xb1os\xc1\xb4QZ\n'\xd6&\x13C\xa7s\xc6_ӓ\xff\xe0\xb0f\xd71{\xbcL\xedU_\x01\xf8\xf8\x8a\x99l9S\x99?\xb2\xfce\xab\xc0Rh\x81\xe9Kn\xcd\x00\xccLrm_\x8eB\x92\\)\x05\xd0gK\x98Gg\x83\"\xeb_N\x85\xac\\i8\xe3\x00\xfejaj\xd4\x17,\xd9F!PF2\x938\xc6\x1d\xa7\x88\xa4 b\xb2\xa6\xb1|.\xce\x13\x9c\xbe;\xe2U\x1c\xa8\x01\x89m2\xd9\x1a\xa3\f\x9c:<\x98vrK\xcb*k5\x8ae\xde\xe3\xd8\xfa\xcaIzk\xcd^5k\x1b\xa7\xcd\xe89\xc7_\xa0/\x13\x86\x93-\xd9\n\x8e9FR\xc4zQ\f\x01ɉw\xaf\x9a\xce\xe726\x7f{\x85Z\x86s\xbe\\\x9d\x8f\xc1\xe0\xb8\xcb\x05w\x8e(\x87\xe3ї\x97I\xcd#\x1b\xf3H\x88\x8d&Of\x10\xa6\x10,\xe1n?Ѩ\x02|\xf8\x00\xd6Çt\x19\xf9\xf01\x85k\xa74\x15jZx3\x1a\x9f\x95\xd6þ\xef\x8a\xe2\xb1\xfar\x03d;\xba@\xc0?\x16\xe2\v\x1e\x88;\xb3h;Yx\x16\x8a\xc6r\x97\xc1<n\x1d>B\x85{N\xb1\x1e\xa9\xf3\x86O\x02z\xcf)'D\x95\xb6\xa3w\x19\x15\x8cp\xe1`\xe9\xee\xf6\x829\x0f\xa3\xe0\x90]\xeen\x87\xdc\xf2\x18\xbd0\xa6\x98^\x12\xc8\xe6\x1c\x8aC;#c1z\x1f\xdaX\x01ǫ\xdf%\xc8s\xe9\x01\xb7\xf5\xaaQ\xdcV\x98q\xe6\x94\xf2\x8e|U\xcc\x05\xa2\n\xd1>\x94й\x04\x9cS\fW\xd7\nA\xaa\xfd\x1e=\x1aJ\xf55m\xbc{\xdc^\x87\xd3&9\x9d\xfb\t\x86\xd8a\xb5\xc29\x94ܱ\xb3g{\xa2\xdeE\x11\t\xdf =F3.\xf0\xf3m\":\x90Õ\x9b\xafW\\\bz\xef&\x8d\xb0{\xdcr\a\x961c\xf7\xb8Fx\xbe\xcaAߊ\x9f\xf1\xe0\n\xe5\xca\x7f=\x9e\u05c8\xbd\x90N\x01\xdc\xf1\r;\xef\x1es\x85t\xa4\x03\xe8 \x88%\xfa\xab\x13T/Y\x9d0\x9c\x8fޝ߇\xb7~\x13\xe0\xed\xab\x88\xb7K\xc8g\xf0V/\xbf\x1a2\x17o\xe5Q\xaeQ\x17\xafx\xae\x00w\xcc\x0e\xd6o/Q\xf9\x9d\x8b|w\xb5\x90Y\xa6\xf8\xc5\xf4)Y.'\xe6\x99f1;=\x92ojC\xe3\xe5\xf6\xad\x8dhz\xb2\xea\xdd^w>\xa6\xa1\xfe!\x8boe\xdfՊ\xd6\xe9\thzۿԾ\xadW\xc4\xfb\x9e\x97\x93z'ƫl|r\x18ޙr\xfd\xdbI_Z\x1a\xd3#\xabC\txD\x03\xdcj\v\xa5Q\x0e:C\t߸\x1b\x8f\x17\x9f\xeb\xe5\x15)\xf2\xdd+\x8ae\x97{\xa6\f\xe8\xf5\xbaὉ\xaf;\x05\xabXI\x98NkQi\xdc\x00\xf9\xee\\\xff\x98=(-\x86 \x9aK\x89\xfak\x92JW\xc5~\t\x88\x8a\x9b\x8aeO{\x1dz߿\xabh\x18+/a\xb8\xb72\x020\xdf\xf1~\xf3.,\xb1\a\xbf\x00f\xc72\xb9\x98\x1f\xa1\xbd~=@ӵ\xb9\xcct\x8fϙ\xd1\xcfu\x8d.\x97-\v\xd8yt\xc2g\xa7V\x0f\xc7\xd3\xc9t\xd9\xc9%\xcea.\xabs|\x99\xcd\xcc\xfd-\x1e\x86w1\xdd\xe3\xbbD\xf6p;:X=\x1c\xe6\xf8xj\xba\xb6Bό\xc7\xe7ف\xfa!Kf\x0e\xa00r沓\x86\xb1'\x8c\xaa\xf8$s\x95J\x17\xb8\xa1K\x96*8-rEv\xb0d־\x9c\x0e\xc8\xea\xfd\xec\xbd\xfd\xca\xf8\x98\x9d/¹\x17\xe9\x9c\x17\xa6oˋ\xf9\xf1\x91\xfa\xb7\xd9\xe1\x95\v\xdd\xfcO\x83K-\xf5L\xf8R\x82\xef\xff\xafȥ\xf7i\xa6^\xe7\xe5\xf96\xbfgJ\xce\x12\xb5\x1a\x8c\xc8\xe5Dw\xff\xac2\x1d\xe9\xaa\xf1\xb1p\x03\xff\xf9\xef\xd5\xff\x02\x00\x00\xff\xffG\x0e\xcf\xec\xfa\x1b\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcYOsۺ\x11\xbf\xfbS줇\\\"\xfa\xe5\xb5\xd3\xe9\xe8\x96\xc8팦/\xae'J}\x87\xc8\x15\x89g\x10`\xf1G\xae\xdb\xe9w\xef,@P \t\x89R\xfaR\x1e<\x16\xfe,v\x17\xbb\xbf\xfd\x01X\xadVw\xac\xe3Ϩ\rWr\r\xac\xe3\xf8O\x8b\x92~\x99\xe2\xe5O\xa6\xe0\xea\xfe\xf8\xf1\xee\x85\xcbj\r\x1bg\xacj\xbf\xa2QN\x97\xf8\x80\a.\xb9\xe5J\u07b5hY\xc5,[\xdf\x010)\x95e\xd4l\xe8'@\xa9\xa4\xd5J\bԫ\x1ae\xf1\xe2\xf6\xb8w\\T\xa8\xbd\xf0\xb8\xf4\xf1\xa7\xe2\xe3\xcf\xc5Ow\x00\x92\xb5\xb8\x06\x92\xe7:\xa1Xe\x8a#\nԪ\xe0\xea\xcetX\x92\xd8Z+\u05ed\xe1\xd4\x11\xa6\xf5K\x06u\x1f\x98e\x7f\xf7\x12|\xa3\xe0\xc6\xfeu\xd2\xf1\v7\xd6wv\xc2i&F\xab\xfav\xc3e\xed\x04\xd3i\xcf\x1d\x80)U\x87kx\xa4%;V\"\xb5\xf5\x96x\x15V\xc0\xaa\xca\xfb\x86\x89'ͥE\xbdQµ\xd1'+\xa8Д\x9aw\xd6\xdb~R\b\x8ce\xd6\x190\xael\x80\x19x\xc4\xd7\xfb\xad|Ҫ\xd6h\x82J\x00\xbf\x1a%\x9f\x98m\xd6P\x84\xe1E\xd70\x83}op\xdf\xcew\xf4M\xf6\x8d\xb45VsY\xe7\xd6\xff\xc6[\x84\xcai\xbfmds\x89`\x1bnR\xc5^\x99!\xe5\xb4\xc5\xea\xac\x1a\xbe\x9f\x84\x19\xcb\xdan\xaaO25(T1\x8b9u6\xaa\xed\x04Z\xac`\xfff1\x1aqP\xbaev\r\\\xda?\xfe\xe1\xbc'zW\x15~ꃒc\xb7|\xa6VH\x9a\x83&\xb4C5\xea\xaco\x94e\xe2\x7fQĒ\x80\xcf\xc9\xfc\xa0I\x90\x9b\xb6/\xaaB\xe1\x06\xea\x00\xb6A\xf8\xcc\xca\x17\xd7\xc1\xce*\xcdj\x84_T\x196\xef\xb5A\xddo\xde>\f1\x8dr\xa2\x82}\xb4\x18\xc0X\xa5\xb3\xbb\xd8aY\x84Y\xbd\xdc(v\xb2\x95\xe35\x7f\xe3 +5\xb2l\x90E\x94)\xfc\b\xaed>\xd2>\xd5xU\x94\xa5ޔ\xaa\xc2\xc1u\x98j\xc4\rtZ\x95h̅\xb8\xa7\xe9#\x1d\x1eO\r3\xb7\x84\x11ǟ\x99\xe8\x1a\xf61\xa0L\xd9`\xcb\xd6\xfd\fա\xfc\xf4\xb4}\xfe\xfdn\xd4\fg1\x83\x95\xd6\x10X\x90\xea\x9dVV\x95J\xc0\x1e\xed+\xa2\xf4\xb8\x05\xad:\xa2&\x90\xab\xb94\xc0d5Ȅt\xc0\t\xaa)Ƚ<\xea\r\x9d}8\xa9\x0eu\xba\xed@Kv\xa8-\x8f\xe8\x1b\xbe\xa4\xac$\xad\x13#ޓ\x9da\x14TTO0X\xd1c)V\xbdk\xc2>q\x03\x1a;\x8d\x06\xa5\x1d\xab\xd0;\xee\x00L\x82\xda\xff\x8a\xa5-`\x87\x9a\xc4\xc4\xf8/\x95<\xa2\xb6\xa0\xb1T\xb5\xe4\xff\x1ad\x1b\xb0\xca/*\x98ž\x1c\x9c>\x8fݒ\t82\xe1\xf0\x03\xf9\x0eZ\xf6\x06\x1ai\x15p2\x91燘\x02\xbe(\x8d\xc0\xe5A\xad\xa1\xb1\xb63\xeb\xfb\xfb\x9a\xdbXNKնNr\xfbv\xef\xdd\xcd\xf7\xce*m\xee+<\xa2\xb87\xbc^1]6\xdcbi\x9d\xc6{\xd6\xf1\x95W]\xfa\x92Z\xb4\xd5\xeft_\x80\xcd\xfb\x91\xae\xb3@\v\x9f\xaf\x85\x17v\x80J\"E9\xeb\xa7\x06+N\x8e\xa6&\xf2\xce\xd7?\xef\xbeA\\\xdao\xc6\xd4\xfb\xde柳\xe6\xb4\x05\xe40.\x0f\xa8\xc3&\x1e\xb4j\xbdL\x94U\xa7\xb8\xb4\xfeG)8ʩ\xfb\x8d۷\xdcҾ\xffá\xb1\xb4W\x05l<ǀ=\x82\xeb(\xbb\xab\x02\xb6\x126\xacE\xb1a\x06\x7f\xf8\x06\x90\xa7͊\x1c{\xdd\x16\xa4\xf4h:8x-\xe9\x88\f\xe7\xcc~\x9d\xd2~\xd7aI\x1bG\xbe\xa3I\xfc\xc0\xfb\x1a@\xb9˒\x91\xc5H\\>]\xe9\xcbB\xfft\xd0D\x9fϹ9Q-\x99@l\xacFa\xe4L(\x80\x98\x96\xb0a\x8e\xc6N\x19n\x95~#\xc1\xa1z\x153\tg\x9cO_\xc9d\x89b\xc1\x92\x8d\x1f\x04\\V\xe4G\x1cb\x8e\xe0!\b\xf0:)Y+ʉs\xee\r\xdf\xd6\xd2\x1c\nQ\x83\x96,\x92\x99\xc2\xc2%\x9c\xb8\x1d\xa4\x1cnj\xd5^)\x81l\x8aw\xa5\xe1;\xc9:\xd3(\xbb`\xdb\xf6\x00q䷷\x0ei\xf1\xcdn\xfb\x81\xfe\xc4v\x8a\x8b#\xafz\x00\xa6\xe4!\x963\aY\b@K\x836\xbb-\x98~\xfa\xdc\t\xd2\t\xc1\xf6\x02\xd7`\xb5\x9b\x1bv>\f\xe9\x8bb7\x82\x99쀉\x81\xbbt|.\xfc\xa2@(\xfd\b۰)\xd4\f\x1e\xa7\xfaCd=\x99\xc4\aZ\x02\xaf\xdc6ٙ\x17\xe2\x0fz\xd2\xc5j\xbcڠdx֞\x9e\x84\x05s\xd4\xe1\x821O\xcf\x1bo\xef\x92e\x04\xcb\xdfcY\x10y>\x12g\xb6=\x8f&䬛hy\xce8E\tF \x81\x15\xb8\xeev\xdd)ù\xc6j\xae\xf3j\xb4_\x99\xee\xb1\xd1g\xd2v\x06\xee\xd0\xf3\xad/Ĩ6J\x1ex=_;=:^ʑ\x8b\xa6͊F\xb2$y\x9cj\x04i\xb2\xf2\xe4n\x15\v\bѤ\x03\xaf\x9d>\x97\xfa\a\x8e\xa227g\xfb\x82?\xbc\x12\v\x186\x18\x11\xab]\x0fU\t\x7f\r\x01\xe1\x8c?9RgƀPS\n\x82ēDn\xe0\xdd;P\x1aޅ\x1b\x85w\x1fB\xfdq\\\xd8\x15OItF\xe2+\x17\"\xae{SY\x1a\xa84\x1dd\x94[\x02\xf1\xbfM\x86O\xfc`\xe9|\xe5m\xb7\n^\x19\xb7\x03w\xcd!x\x94e>\xc0\x1e\x0fė4Z\xa7%\x956Ԛ\x18\x84\xf1\"\x95\xcb`\xfb\x05\xa3LRg\x16\f\x9a\x96$o\x05\xfd?\xc5\xec4\xd13Ƹ\xee6\r=\x85\x1d\xeen\x96\x94\x1c\x8f\x8ez*\xcdkN\xe7\x029\xf4\x9cxK\x00\x87\x8c\xa6\xfd\xa9\xdcÕ\xc7ۂ\x98B$q\x04\x80'q\x94\xa1aq\x02p:wlvی\xccaF\xd5\xe7W&;\x17\xbd\xf1\xf4\xbc\xb9\xca\x0f\xa4J\x06\xaf\xa9\xf9\xb5\xe1e3\u07b7\xd9\x19\xc1\xeb\xc2^\xd0s\xd4\x1b\xd4\xcc\x03\xf5*\xcfX'c\xa6Y6\xe9N\xe3u\xda5\xde\xfal\xef\xd3\xf3\xe6*V\xef/\n\xae\xe3\xf5\xe1\x06\xb0\xf7r\xe9\xb4Fi\xe3\xbd \x1dq\xbf\x83ٗ\xe1F-\xbd3Yb\xc3\xf3\x19\xfe謫\x04mXd\xe8\xfe\xde&\xde\xda\xe5\xf8\xf0I\\\x98\xe9\x8f\xf2$\r+\xc0#J\xa0c\vゐۋ4\xc5tN>\x9d\x06)=\x8a\x85+\xdaxh\x8dw\x16\xfd\x95\xc07\nN\x7f&}o.\xc8\xf4 J\xe9\x97q\xc2<\xa2\xe3u \x9dDWY\xa1W\xd5\xc6lr\x0e\\\xe1+\x1a'2\x05\xe2\ar\x85\xb0d8n\x99,W\xb8|H`\x06\x18\xe8 \xa4\x87\x89Kg\xa6\xef'\x10-\x1a\xc3\xea%\x1c\xff\x12F\x85\xab\x8e~\n\xb0=\xd5ѱj\xefM\x9fl7\xc1\xa8TՒ\x06\x8f\xaa\xf2\xcb˛/\x1eoҤc\xb6Y\xd0\xe4\x89\xd9&\x02\xcc\xc1\t\xe1\xe7̪nO\xc2\xf7H\xd9\xf4[\x15_\x7f\xca]R\x8f\xc6\xe4\x00\x10\xaf\t$\x94\xae\xcd\x11\xfaG|ʹ~*K\xeclƲ\x15<i\xec\x98\xcev͞d\xd2\xcep\x91\x90K\xb4ؗ\x959\xbczd\xfa\xfe\xe2\xa1\xf1&?\xf7\xfa-\xb9:\xde>4JDd\xf7\x0f\x13ҵ{\xd4\xe4o\xff\xf41>\x98\xe5\xb2]V\xa3\xedJ\xe6\x0f\x84\xc7K\"\x14\xa6Cg\xb8\x1d\x89|\xb5\xe2\xa6\x13\xec-#8\x1a\x92\xa2M\x92\xb7\xd3[\xe9y<\\\xbeh\x18\x9e\x89\xf2\xe7\xd6\xdc[On\x0f\xd2W\x9bI\xff\xf0\xfc\xf3cV\xb8\x00\x8c1\x93\xb7\x0fW\x12\xf1\xedC\xcc:^\xa1\xb4t\xb68\xbd\x04\x9cX\x9d\xbcx\xb4J\xae\xebn#\xa2\xa3\xc7\xc3%\x8dG\x83\x17\x98I\xffl\x99\xe3%;Jq\x02\x16\x7f'\xbd\x99>,}\x18ީ\x98\xed/\xc6ˆɚ\x12BRq\xf3\xc51'xF5F\xc4b\xac\xfe\xff\x93Sd\xc3e\xd6\xe85\xaf\x12\xd9\xfdmH\xda\xe2\xf6\xc3C\xc4\x1a\xfe\xfd\x9f\xbb\xff\x06\x00\x00\xff\xff?\xb7\xcf\xe0L \x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcWO\x8f۶\x12\xbf\xfbS\f\xf0\x0e\xb9Dr\x92wy\xf0-\xcfm\x81\xa0I\xb0\x88\x17{\x1fKc\x99Y\x8aT9C\xa7߽ۢ )Z\xb2$\xaf\xb7A\xdb\xe5^L\xfe\xe6\x0f\x7f\xf3\x8f*\x8ab\x85\x9dz \xc7ʚ\r`\xa7\xe8W!\x13~q\xf9\xf8?.\x95]\x9fޮ\x1e\x95\xa97\xb0\xf5,\xb6\xfdBl\xbd\xab\xe8\a:(\xa3DY\xb3jI\xb0F\xc1\xcd\n\x00\x8d\xb1\x82a\x9b\xc3O\x80\xca\x1aqVkrEC\xa6|\xf4{\xda{\xa5krQy6}zS\xbe}W\xbeY\x01\x18li\x03{\xac\x1e}W\xd9N\x11\x97'\xd2\xe4l\xa9\xec\x8a;\xaa\x82\xde\xc6Y\xdfm`8Hr\xbd\xcd\xe4\xef\xff\xa3\x8a\xad\xed\x9e\xe2\xa6V,?O\x0e>*\x96x\xd8i\xefP_\x9a\x8d\a\xacL\xe35\xba\xd1\xd1\xd3\n\x80+\xdb\xd1\x06>cK\xdcaE\xf5\n\xa0\xbfK\xf4\xa1\x00\xac\xeb\xc8\x0e\xea;\xa7\x8c\x90\xdbZ\xed\xdb\xccJ\x015q\xe5T\x17 I\x0f\xd8\x03ȑz; \x16z[\x01\xff\x95\xad\xb9C9n\xa0\f\x14\x94\t\x14\xc4z@\xb8}\xbeY\xbf%O\xc1E\x16\xa7L\xf3B\xa3,\xd6aC\xa0m\x15c8>S\x1c\x1cRT\x83\xd8+^\xf5\xe2\x1f{\xe9\x1e\x95\\\xdb\xf5\xaa'\x87\xb7\x9c\x1cb\x05,(\x9e\x81}u\x04d\xf8L\xdf\xd6\x1f̝\xb3\x8d#\xe6\x05\x8f\"\xbc\xec\x8eȗ$\xed\xe2\xc1\v\xed\x7f\xf6\xed\x9e\\\xa6\xa9'\xe0\x14BI\xc0\x06;>Z\xb9n<\xe1\x1f\"|7A'g\xb6\x11\xd1o%2B\xb64\xe4n{3u㙜I\xee\x88\x15\xd4\xcfys\x1f\x00/u\xe6^\xb5\x04\xb5w1\xd6\xc0\xcaT\x04rT<\x8e\xd97d\xa8\x1c\xa1P=\xf7*\xf7\x8d2\"\x945A#\v\xb69\x83\x93S\xef\x9b\x1c\xbf\xc4O\x8d\x926\xd2\xf1\xe9\x1d\xea\xee\x88o\xe3\x16WGjc#\n\xbflG\xe6\xfd݇\x87\xff\xee.\xb6\xe1j\x8a)\x06\x04G\xbfxb\xc9l\x02Be\xdbN\x93P\xdd\xd7\xc2kP\xa6Ҿ\xce9\x93\x96\x12\x86|%@S\xc7\xe2y\xb4\x9d\xc2Q\x88\xec!\xe2R\xe8\xf85\x1c\x9cm\x17*p\xa4\xf6\\\x8b\xf60\x06\x8a\x054V\x8e!\x1d\f\x95g\x81\xceَ\x9c\xa8\xdc\v\xd3\x1au\xf9\xd1\ue108W\x81\xab\x84\x82:\xb4w\xe2h\xb1olT\xf7\xf4\xa6\xfcS\f\x8e:GLF\xc6\x15\x9d\x97=\x00\x1a\xb0\xfb\xafTI\t;rA\r\xf0\xd1z]\x87\xa9p\"'ਲ\x8dQ\xbf\x9dus\xa0=\x18\xd5(\xd47\xe7a\x85\xd2p\x065\x9cP{z\x1dYn\xf1\t\x1c\x05+\xe0\xcdH_\x84p\t\x9f\xac#P\xe6`7p\x14\xe9x\xb3^7J\xf2t\xabl\xdbz\xa3\xe4i\x1d\a\x95\xda{\xb1\x8e\xd75\x9dH\xafY5\x05\xbaꨄ*\xf1\x8e\xd6ة\"\xban\u0085\xb9l\xeb\xff\xb8~\x1e\xf2\xab\v_g}%\xfd\xc7\xc9\xf4L\x04\u0080JY\x98D\xd3E\a\xa2\x95ibH\xbe\xfc\xb8\xbb\x87l:V݅R\xe8y\x1f\x04y\bA L\x99\x03\xb9(7d \x99\xba\xb3\xcaH4PiEfJ?\xfb}\x1b\xb2\xb7\xaf\x90\x10\xab\x12\xb6q\xe4Þ\xc0w\xa14\xeb\x12>\x18\xd8bKz\x8bL\xffx\x00\x02\xd3\\\x04b_\x16\x82\\\xa2\x9b\x05pbmt\x90\xdf\x1bW\xe25\xb4\x8e]GU\b\\\xe0.\b\xa9\x83\xea\xcb\xf6`\x1d\xe0\xa8\xc9\f\xa5z\xbd\\\xc3\x1aF\xfc\xf4dщ\x00\xcc\x0e\x98\xd1d\x9f\xf6\xae\xdc\xd6.\xddx\x86\xaf\xf0?\x19\xec7\x1c\xda]\xa2\x97\xbcJtL\x803\xadp\xed\xfd\xf1\x17\x9c\x0f\xb9\xaa\x1cM\xaa\xae\x80\xd9\v*\x1fL\xee\xfa\xa2,\x89\xe3u\xb3\xba\xca\xc8(O\"4SRy\xe7\xc8H~ڄ\x96\xf9\x1d\x99҇x<@oDh;\x97\x88\xad\xd8\xd5\xc9/\t\xa3\xbd\x7f\xf0\xf4C<\xa7ќz\x80\xfb\x90\xf4\xb1\xc1\xbf\xe2$\xab\x18<S\x1d\x93\x7f\xc1\x18\xaf.\xe4\x01\x02\xb0EIý\b*f\b\xe3\xb5ƽ\xa6\r\x88\xf3\xf4\xf2\x04\x00X|\x85\xdddhA\xe6\x9c\xc9\x17ϰɈ\x9f\xe9\x85\f\xec\x87\xfe\x90\xc6Q\xdcQgY\x89u\x8a8#\x05]C\x923\xbf\xcf\xc8\x05\xc5\xf9m0\x0f\xca\xfc\xf56\xfc\xb5Čͭ\xb6\xf2)\xa1\u009d1\x8b\x00\ueb57+\xa9+\xc7yT\xe0j:߈Y|\xb6\xdf\xf0\xf0.`\x96Ji\xd2f\x96\x8d\x93\xf1\xed\xdc@\x11\xbe+\x16vg_\x1a\xc3*r\x86\x9f߸\xc3*\xe0'T\xfa\x99\x83\aԪ^\xee~ϰÂN\xce\xd5t\x83\xa6\xdd\x05\xf8F\x9dG\xcd\xdfS\xe5\x97V\xfe\xdd\x02_\xfa\xae\xb9A\xca\xfd\x82\xc8\xdfT\xde\xf60\x1e[7\xc6\xedR\x85.N\x99\xd9f\fE=\"\xabo\x13\xe3\x1d\xbf??O7\xf0\xfb\x1f\xab?\a\x00\x15\x1d}\\\xf1\x11\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcWQo\xdb6\x10~\xf7\xaf8`\x0f}\xa9\xe5\xb6{\x19\xfc\xd6f\x1bP\xac)\x82&\xc8\xfbY:[l$R\xbb#\x9de\xc3\xfe\xfbp\xa4dY\x96d;\x18\xb6(/&\x8f\x1f\xef\xbe\xe3}<.\x97\xcb\x056\xe6\x91X\x8c\xb3k\xc0\xc6\xd0\x1f\x9e\xac\xfe\x92\xec\xe9'Ɍ[\xed\xdf/\x9e\x8c-\xd6p\x13Ļ\xfa\x1b\x89\v\x9c\xd3ϴ5\xd6x\xe3\xec\xa2&\x8f\x05z\\/\x00\xd0Z\xe7Q\x87E\x7f\x02\xe4\xcezvUE\xbcܑ͞\u00866\xc1T\x05q\x04\xef\xb6\u07bf\xcb\xde\x7f\xc8\xde-\x00,ִ\x86\r\xe6O\xa1aj\x9c\x18\xef\xf8\xa56;N\xb0ٞ*b\x97\x19\xb7\x90\x86r\xdde\xc7.4k\xe8'\x12J\xebA\xf2\xfeS\x04\xfcv\x00\xbc\xed\x00\xa3Me\xc4\xffv\xde\xee\x8b\x11\x1fm\x9b*0V\xe7\\\x8cfb\xec.T\xc8g\f\x17\x00\x92\xbb\x86\xd6\xf0\x15k\x92\x06s*\x16\x00-)\xd1\xfd%`QD\x9a\xb1\xbacc=\xf1\x8d\xabB\xddѻ\x84\x82$gӨ\xc9\x11\x0e<\x97N\b\x1aW\xc0^\xed\xa9\xf5B\x00\x99 y\x107S\x90\xef\xe2\xec\x1d\xfar\r\x99R\x9a\xa5\x15\a\xac\xd6J)]\xc3\xe9\xa8\x7fQ\xffų\xb1\xbb)\x8ff\xf9\x04\xf1胀\x84\xbc\x04\x14\xf8Jϫ\xcf\xf6\x8eݎId³h\x9e5%J\xb7w\xf2\xe8>N\\\xe9\xce\xd7Po\x88\xc1m\xc1\x97=\x0f\x13<\xcd:Э\xb9s\xc5c\\\xf1i\xb0 \xf9t\xdb\x1a\xb5\x83\x89$\xcdގ\xf8\xb2[\x13Y\xf3\xaesv\xd61\xef<Vg\xbdzP\x8bk]z05A\x11\xbad\x19\x9b\x13\xf8\xd2ș\x8c>\xa3@\xce4}\xb2:\x95Ȣ\x85qV7\x10\x8fu\xd3\xda&\xe6>\xee\xba\b\x13iE\x17r\x9a\xde\x7f\xc0\xaa)\xf1}\xb4\x91\xbc\xa4:ʎ\xfer\rُw\x9f\x1f\x7f\xbc\x1f\fõ\xe7\xd1\b 0\xfd\x1eH<x\aL\xcfl\xbc\x06M\xc0$\xde\xe4\aD\x00\xb1\xd8H鼜ə\xdb\x02F\xe6c\xbd(\xd7.\x82=\xb9\xc6\xe8N\x9d\vo\x8fq\xd5\x04}\xb4\xebp\x98j46\xfa\xe0\x187\x15\x01n=1ȳ\xf1yi\xec.Z\x87\xa6rX\x10\xc7\xcc\x1e!n\xd9խ\xff\x1aU\xdc<;\xcc7\xec\x1abo:\xb1L\xdfѥp4z\xc2\xe4\x1b%;YA\xa1\xb7\x01It\xa4\x95/*\xda\xfc$\x86\x8c\xc6\xd10\tY\xdf\xebn\xff)Y\x16\xdc\xe6;\xe5>\x83{b\x85\x01)]\xa8\n\xbdD\xf6\xc4\x1e\x98r\xb7\xb3\xe6\xcf\x03\xb6@\xcbi\x85\x9eZ}\xee?-8\xb6X\xc1\x1e\xab@o\x01m\x015\xbe\x00\x93\xee\x02\xc1\x1e\xe1E\x13\xc9\xe0\xd61\x81\xb1[\xb7\x86\xd2\xfbF֫\xd5\xce\xf8\xee2\xcc]]\ak\xfc\xcb*\xdekf\x13\xbccY\x15\xb4\xa7j%f\xb7D\xceK\xe3)\xf7\x81i\x85\x8dYF\u05ed\x06,Y]\xfc\xc0\xed\xf5)o\x06\xbe\x8ed+\xfdǫ\xebL\x06\xf4\xcaJ\xe76-M\x81\xf6Dwg\xe3\xdb/\xf7\x0f\xd0m\x1d\xabx\x00\n-\xef\xfdB\xe9S\xa0\x84\x19\xbbՃ\xa5\xd5\x1f\x8f\x93b\x92-\x1agl:\xaayeȞ\xd2/aS\x1b/]Mi\xae2\xb8\x89\x1d\x02l\bB\xa3\xb5]d\xf0\xd9\xc2\r\xd6Tݠ\xd0\x7f\x9e\x00eZ\x96J\xecu)8nn\xfa?EY\xb7\xac\x1dMt\r\xc9L\xbef\xb5羡\\\xf3\xa8T*\x86ٚ<N\xc0\xd61\xe0\x00\x11\xe6%\xac/\xeb\xf9\xd2\xd6/\t˽w\x8c;\xfa\xe2\xd2V\xa7F\x93\xbe\x9f\xac\xe9|V\x91\xeb\x84p\xd2p\x84\rI\xe64\x89h\xac\xb4\"\xdbFdH\x86\xa1\x9cɏ\xfe\x9f\xf4+\x17\"y\x1cZ\x1f\xc7p\xbeu\x1a\xc1\u00a0\x99\xeaK\xa3U\xdb^\xe1aF\xf9_\x11\xa5\x16\x91a:\x91\x83\xe5t.Ol\xa6\xfb\xb9\xf3'96U\xeb\xc5,\x8b\xf3g9\xae\xecX\xcd\x033Y\xdf5{n;@\x04\xc0y\xa0kOs\xeeꦢAGq\xe1\x04܌Wī\x85\x8b\xe4\xb4\xd7֧\xef\x0f\xdb\xd6f\x84yؚ\x8a\f\x1e\xb4t\xe3\xad\xf5F\x12\x80\x11\bBE,\xe1\x89\x1d\xc7\xc7i\xeb\xb8F\x9fZ\x9e\xa5B\x8c,l\xa8*m\x00\xd6\xe09\xd0\xf5\x87\a`\x8b\xa6\x1a\xf7\xac\x17x\xfaurѠ`\xc6\xed\xcf\b\xb2\xd3\x1bi\x9d\xd0b\xd8\xf4e3\xae\x01㩞p\xedl|Wr\x83\xcc\xf8r2W\x93\b\xee\xe8\x02\x17\xb7\xc9J\x83\xc7n\t\xe0\xc6\x05?}\xceut\x84x\xb5v_\x88\xb6\xe3\xee\x95\xf9\xbc\x9dYv\xc8\xe8\xcc+\xe4LJ\x93V\xf6\xdd\xf0@\x10_\xa9zǏ\x91\xfe/>\xf8.\x04v\xa76S\x92sr+]\xc5;\xd9P\x8f\xf7[\xea\x03ubt\xf4d\xed\xbfeW\xf6\x87\xe7P\xff-\xe1\x0e\xd9\x1b\xac\xaa\x97Te\x13\x163\x13g΅xd\x7fP\x98\v\xa4\xdd\x0f\x8c\xaf\x10@\x15q\x9e\xacX\xb8 \x7fí\xfe_\xe5\x9b|\x16_\xa0\xe6ajͿ\xa9\x12\xe3ˮ+\xe8k\xa5\x7fӿ\xa6\"&/\xec\xd1`\xccEqĖ\xa4\x0e\xe1x$l\x0e\xaf\x915\xfc\xf5\xf7\xe2\x9f\x01\x00L\xd2\xf3\x04\x0f\x14\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcYKo#\xb9\x11\xbe\xebW\x14&\a_\xa6\xdb;\x9b \bt\x9b\x91\x13\xc0\xc8\xceDX\x1b\xbe\xb3\xbb\xab[\\\xb3I\x86d\xcbq\x82\xfc\xf7\xa0\xf8P\xbf(˚ͮ\xe5\x8b\xf8(~\xf5ՓTQ\x14\x1b\xa6\xf9\x13\x1a˕\xdc\x02\xd3\x1c\xff\xe5P\xd27[>\xffŖ\\\xdd\x1e?m\x9e\xb9l\xb6\xb0\x1b\xacS\xfd\xcfh\xd5`j\xbcÖK\uee12\x9b\x1e\x1dk\x98c\xdb\r\x00\x93R9FÖ\xbe\x02\xd4J:\xa3\x84@St(\xcb\xe7\xa1\xc2j\xe0\xa2Aㅧ\xa3\x8f?\x94\x9f~,\x7f\xd8\x00H\xd6\xe3\x16H^\xa3^\xa4P\xac\xb1\xe5\x11\x05\x1aUr\xb5\xb1\x1ak\x12\xdc\x195\xe8-\x8c\x13ac<4\x00\xbec\x8e\xddE\x19~Xp\xeb\xfe\xbe\x9a\xfa\x89[秵\x18\f\x13\x8b\xb3\xfd\x8c\xe5\xb2\x1b\x043\xf3\xb9\r\x80\xad\x95\xc6-|c=Z\xcdjl6\x00Q'\x0f\xa5\x00\xd64\x9e%&\xf6\x86K\x87f\xa7\xc4\xd0'v\nh\xd0ֆkZ2\x87\x05\xd617X\xb0C}\x00f\xe1\x1b\xbe\xdc\xde˽Q\x9dA\x1b`\x01\xfcb\x95\xdc3w\xd8B\x19\x96\x97\xfa\xc0,\xc6Ybd\v\x0f~\"\x0e\xb9W\xc2k\x9d\xe1\xb2\xcb!x\xe4=B3\x18oB\xb0\\\xd6\b\xee\xc0\xed\x1c\xda\v\xb3\x04\xcf8l\xce\x02\xf1\xf3$\xce:\xd6\xeb%\xa2\xc9\xd6\x00\xa9a\x0es\x80v\xaa\xd7\x02\x1d6P\xbd:Lz\xb7\xca\xf4\xccm\x81K\xf7\xe7?\x9d\x85\xa0#Y\xa5\xdfz\xa7䜘/4\n\x93င\xacԡɲ\xa3\x1c\x13\xbf\x06\x88#\x01_&\xfb\x03\x92G\x1a\x86\xe9\xf8E(\xe4r\xa0Zp\a\x84/\xac~\x1e4<8eX\x87𓪃\xf9^\x0eh\xc8|\bUXA\xde\v\x9cl\xa7L\xd6t\x1a\xeb2\xac\x8d\u0092\xac\x85\xfd\xe6\a\xfd\xdf}\xab6Ȳ\xbe\x95RM\xe9Wp%\xf3\x0e\xf6\xb9\xc3w9הD\xa9\x1a\x9c06\xc3\xc4-h\xa3j\xb46˚\x0f\xb0\x92\x04\xc4ɀ\xe2\xdb8\xb0\xa2&\xac8\xfeȄ>\xb0O~\xc8\xd6\a\xec}\x12\xa5oJ\xa3\xfc\xbc\xbf\x7f\xfa\xe3\xc3l\x18\xe6\n\xccP\xb2\xdaY\xca\x14\xa4\x8d6ʩZ\t\xa8н J\x9f\xb8\xa0WG4\xa0\xc5\xd0q\x99<\x8d>L6\xd3\x05c\xce&\xff\xf6t\xd0l\x984\xe8\xbd\a\x94F3\xb5>\x10E\x1a\x8d\xe3)\vG\xd9c\x81\x99\x8c.\xf4\xb8!UCބ\x86*\v\x065b.\xc5&\xb2\x13\x8c\xc5-\x18\xd4\x06-J7\x87\x10\xb9k\x81IP\xd5/X\xbb\x12\x1eА\x18\xb0\a5\x88\x86\n\xd2\x11\x8d\x03\x83\xb5\xea$\xff\xf7I\xb6\x05\xa7\xfc\xa1\x829\x8c%a\xfcP(\x1a\xc9\x04\x1c\x99\x18𣧬g\xaf`\x90N\x81AN\xe4\xf9%\xb6\x84\xaf\xc4\x13\x97\xad\xda\xc2\xc19m\xb7\xb7\xb7\x1dw\xa9\xb0֪\xef\a\xc9\xdd\xeb\xad\xe7\x9bW\x83S\xc6\xde6xDqkyW0S\x1f\xb8\xc3\xda\r\x06o\x99慇.Ia[\xf6\xcd\x1fL,\xc5\xf6f\x86u\xe5k\xe1\xdf\xd7\xc47,@\x85\x91r\x03\x8b[\x83\xa2#\xd14D\xec\xfc\xfcׇGHG\xfb\xf8\x9d\t\x85\xc8\xfb\xb8ю& ¸l\xd1\xf8}\xd0\x1a\xd5{\xc6Q6Zq\xe9\xfc\x97Zp\x94K\xfa\xedP\xf5ܑ\xdd\xff9\xa0ud\xab\x12v\xbeۀ\na\xd0\x14\xe2M\t\xf7\x12v\xacG\xb1c\x16\x7fs\x03\x10Ӷ b\xdfg\x82i\xa34\xfe\x91\x94mdm2\x91:\x9d3\xf6\x9aF\xfe\x83ƚLG\xec\xd16\xde\xf2X\x01(|\xd9,K\x943\x91\xf9\x90\xa5O\xb6\n,\x17-0}\xc9\xedI\xc0\xe4$\xd7\xc6rD\x89\x84\x9dR\xf5\xf4#\xd2\xe6U\t3\xa8\x95\xe5N\x99ױ\x90\xcduz\xc3\x00\xf4_3Y\xa3\xb8\xa0\xc9\xce/\x02.\x1bb\x12O~G)\"\b\xf0\xae\xaad\xa7(.\xce\x13\x1c>\xf7\x0ej&\xc9Q-:*22[c\xb8\x84\xb1Ãi'7\xfe\x05\xcd*\xa5\x04\xb2e\xde#\xdf\xfaJIz\xa7d˻\xb5\x8e\xd3f\xf4\x9c\xe1/з \xean~$ل|\x8e\x90\x14\xbe^\x14\xc9!)\U00076f0b\xe5?sh\xcbQ4\xf6\x9c-W\xf1\x91\x14\xf6\xa7l߉2\x85G,/\x93\x9a\xe7\x14\x99g\xb0\xbeѤɕ\xc4\x14\x13%ܷ\x13\x89\xdc\u0087\x0f\xa0\f|\b\x97\x91\x0f\x1fi7\xd0%\xc7\x15|Zx3\x12_\xb8\x10\xe9\xdcrs\x85\x19N\u0557\x1a 5\xb8\v\x04\xfcc\xb1|\xc1\x83\xa3\xce\xcc\xeb\xee\x14\xbc0\xeeN\xe5n%vr\xb4\xfd\b\x15\xb6T\xe3\f\xba\xc1H\x8a\x044\x86R\x8e\xf5\"\xd5\xe0\xaeRJ3\xc2pA\x95\xbd_\x94\x0fM/\xe0w\x8ȁg\x9c\xe4d\xef\x1e\xa5\x8b9Jkl|\xc7`\xd0\x0e=6c\xe5\x13\xcc:\xa8\x0fX?\x87\x02\xa8d\x9d\x13JK[\xc1:\x92W\vdo$\xbd|j\xb0\x92i{P\xee\xfe\xee\x02\xbd\x0f\xa7\x85)q\xdfߥ\xb4\xfd\xe4\x1d<e\xe2$\x12\x9cZ\x89\x04r\xea\xd8)\x06m\xafr\x84\xd0\\\x9cn\u0557 \xcfW'\xdc\xca\xf0\x8eS\xc7&O3c59\xd2-<\xc74\xb7^?l`\xd0\x018\xf9\b5.\x15B\xc3\xdb\x16\rٕf\xe2\xc1\xfb\xa7ݍ\x1d\x0f\xc9\xc9l'\x18|\xf3\xda3\xef\x12\xcd@\xca'\xa2\xae\xa2\xc81ӡ{\xf2j\\\xe0\xe7q\xb24\x91C])]\xa1\xa9\xc6F\xeb\x06\x89\xb0\x7fڑ\xab\xaeD\x02\xec\x9f\xd6\b\xcf7\x10\xe9\x96sƂ+\x94+\xfbE<'\x19Y\x11o0D\xff\xfa\xf8\x8e\x93\xf7O\xb9\x1e\xe5D\a\xb8\x03\xf3\x81\x1co\xa5P\xbdfeB\x8a\x8fh\xce\xefû\xe8\xf9\xce\x00\u07bd\x89x\xb7\x84\x9c\x15\tT\xe8~-dꋸ\xc9%\xedb\xb4~fN\x1f\xb3\x83\xf5\xfb\xab\x7f\xfe\xe4\x02\xaa\\\x13\xbaX\xb3\xac\x9e\x8b\xe91Y.'\xe6\x99f1;\r\xc9\xcd;t\b\x8fC\xdb\xcdY;O\xabPx\xc5Kf\xaf\a\xe3\xd3P|#T\xedwv\xf9ux]\x8bL\xf8\x87\x94\xed\xe6M\xdfۭw\xf8\xab\xb4i&\xad\x04K\x0e\x15^s\xd2\x13\xde:}\xc0D\x9eo\x19H\xc1 \x0e\x1b\xc0#J\xa0[\f\xe3\x02\x9b$Ӗ\xf0H\x17\x1d\x7f\xad\xbf\xb1\x9b\x95ȓ \xdf(P;\x9a\x01\xbdޗ\x9e\xf2\xe8&Y\x90\x88\xd5\n9\b\xc1*\x81[pf\xc0\xcd\x15\x81ң\xb5\xac\xc3\v\xdc~\r\xab\x88\x03\x96\xb6\x00\xab\xa8_[6%76\xbaOy\r\fz\xa2\xba\x80\x81\x1e\xad\b\x80\xfc\x8e\xa7\xb1\xab\xb0\xf8&\xea\x02\x98=\xad\xc9\xf9\xfc\t\xda\x14\xcb\xfax\x94C\xbf>\xa2\xa0\xb7\xf4\xcc\xe8\xe7\xbaF\x9d˖\x05\xec\rj6>\x99\x8e\x9fb\xd2\x17\xe6\xf6QC\x9a\xdb\x15.\x98kVƹ\xfc\xb6\x14J\x99\xb9\xbf1\x9e\xdb\xf4\x96\t\"\xf0KV\x88\xcb\xe0\xa0D\x8ar\xff`-\x87\xbeBC\xa6\xf0O\xe2\xc9&)}\xae\xa4\x86\x87Ʃ-G\t1\xb8\xe33?\x858\x95\xafpiN7\x93\x86[-X\xae\xfa&Mf}\xcd\x189\xab7\xcbk\x1b\x99\xd3\x0f\b\xb9\xc9\xfc\xaf\x00\xf3\xbf\xf5{\xfe\xfco\xfca\xe0\xb79\xe1l\x19\x05\x98\xffPs\xc1\x17\x1ef\x8b/e\xfe\xf8\x1bњm\x98\xa5\xf0u\u009e\x1f\xf3{\xe6\xea,Q\xabA\x8f\xbc\x99ȎOYӑ\xa1:=\xd0n\xe1?\xff\xdd\xfco\x00K\xed\xd7|n\x1d\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4XKo\xdc8\x12\xbe\xf7\xaf(x\x0f\xbeD\xea$\xbbX,\xfa\x96\xb4w\x01c\x93\xa0\x11{|\xa7\xa4\xea\x16c\x89\u0530J\xed\xf1\f\xe6\xbf\x0f\x8a\x12[\xcf~e\x12+@\xd0d\xf1\xe3Ǫb=\x18E\xd1BU\xfa\t\x1dikV\xa0*\x8d\xbf1\x1a\xf9E\xf1\xf3\x7f(\xd6v\xb9\x7f\xb7x\xd6&[\xc1\xba&\xb6\xe5W$[\xbb\x14\xefp\xab\x8dfm͢DV\x99b\xb5Z\x00(c,+\x19&\xf9\t\x90Z\xc3\xce\x16\x05\xbah\x87&~\xae\x13Lj]d\xe8<x\xd8z\xff6~\xf7>~\xbb\x000\xaa\xc4\x15\b\x9eê\xd0i\x03\x16\xef\xb1@gcm\x17Ta*\xd8;g\xebj\x05\xddD\xb3\xb6ݷ\xe1|\xa7X}\xed`\xfcL\xa1\x89\xff?7\xfbI\x13{\x89\xaa\xa8\x9d*\xa6$\xfc$i\xb3\xab\v\xe5&\xd3\v\x00Jm\x85+\xf8\xa2J\xa4J\xa5\x98-\x00\xda#zZ\x11\xa8,\xf3JS\xc5\xc6i\xc3\xe8ֶ\xa8ˠ\xac\b2\xa4\xd4\xe9JD\x1a\x1c\xb0[\xe0\x1c!Q\xe9s]\xc1Kn\t\x81\x8c\xaa(\xb7\f\x9a 0\xf0\x9b\t\xc87\xb2f\xa38_A,\xaa\x8a\x9b\x95\x82\xd5\n\x88\x96V\xf0\xd1\x0f\xb7C\xfc*\xbc\x89\x9d6\xbb9&#M\x01\xb1⚀\xea4\aE\xf0\x05_\x96\xf7f\xe3\xec\xce!\xd1\f\x0f/\x1eW\xb9\xa2!\x89\a?q!\x89G]\"d\xb5k)h\x93\"p\xaei\xc2\xeeE\x910t\xf3:\xf1[\xca\x7f\x8e\x05\x91X\x95\u0558Toi\xa3\x9aL1\xceq\n\xdbb\x06\xc9+c8\xfbֺR\xf1\n\xb4\xe1\x7f\xff\xeb(\x87\xaaUX\xec\x97\xdeY3\xb2\x90\x8cBo\xb8\xa1\"n\xb3C7\xab!˪\xf8;DX\x00>\xf6\xd67L\x1ee\x18\xfa\xe3g\xa9\xf4}\xb7q5x`\xeb\xd4\x0e\xe1\x93m\xed\xc4\xf9\x19W\x06`;CZ\xbc\x9a\x95\xdb!7\xc8-p\xc0\x1dR\xf7r\x93\xbd\x7f\x86˥\x0e\xe7\xafa\b\x90\xb1\x97\xd0\xd6\xcc\xfb݇\x1d^\xe4s}\xd5\x1a\x9b!\xbc\xe4\xe8\xe4*\xe0\x84\x96&\xa8\x9cM\x91\b\xb3\xa3\xd6\x17\x8cv\xb2!\xf2\xa5\x1b\x98(\xa8\x91ؿWE\x95\xabw~\x88\xd2\x1cK\x1f\xfd嗭\xd0|\xd8\xdc?\xfd\xf3a0\f\xc33\xcc\x10U\xe0\xf0\xd7\x1a\x89\x81m\xe7\n\xfeX\xa2<(\xed\x1e\xdd\xc1_\x0e\xb0 \xaaP\xb0\x978\x8a\xb0u\xb6\xec\xc7K\x87\x95%\xcdֽ6b\xed0\xb5\xeeP\xb4\xee\xd0\xf9\x99|\xc7\xd7\x1b\xcb9:\xb0\x06߀\xe6\x81z!y=X$R;4\x1c\x1f +g+t\xacCrj\xbe^\xea퍎\x14u+\xbal\xa4 \x93\x9c\x8b\xe4\xb7i\xd3\nf\xad\xfa\xe5x>\x18:\xac\x1c\x12\x1a\xee\xfby\xf8\xfc\x19\xc0&\xdf0\xe5\x18\x1e\xd0\t\fPn\xeb\"\x93T\xbdG\xc7\xe00\xb5;\xa3\x7f?`\x93XD6-\x14c\x9b#\xbbO\x82\x803\xaa\x80\xbd*j|\x03\xcadP\xaaWp(\xbb@mzx^\x84b\xf8l\x1d\x826[\xbb\x82\x9c\xb9\xa2\xd5r\xb9\xd3\x1cJ\x8eԖem4\xbf.}\xf5\xa0\x93\x9a\xad\xa3e\x86{,\x96\xa4w\x91ri\xae\x19S\xae\x1d.U\xa5#O\xddȁ).\xb3\x7f\xb8\xb6H\xa1\xdb\x01\u05c937\xff|\xa9p\xc2\x02R,\x88\xa9U\xbb\xb49h\xa7h\x19\x12\xed|\xfd\xef\xc3#\x84\xad}\x98\x18\x80B\xab\xf7n!u&\x10\x85i\xb3E\xe7\xd7un\x8c&\xab\xac6\xecm\x9e\x16\x1a\xcdX\xfdT'\xa5f\n\x97Gl\x15\xc3\xda\xd7a\x90 ԕ\x84\x91,\x86{\x03kUb\xb1V\x84?\xdd\x00\xa2i\x8aD\xb1\x97\x99\xa0_Bv\x7f\x82\xb2j\xb5֛\b\x05\xe0\x11{\x8dB\xcbC\x85\xa9XO\x14(+\xf56Ĝ\xadu\xa0Ƒ\xa8\xbb\xb4\xc7/\xae|]U5\x9e\x19\xd1\xf9x\x10\f,L/~\xb7a\xa6)\xeb\xba07\x81\x84#\x89rH\xf7\x84\x86;Σly\x11\xfdњ\x13'i#\xeb\x04\x14\xbaXۥ\xabi\x90}ќO\xea\x02\xc1\xbc\xf2\xa8\xa2\xca_\xaaª\xec\xcc\xf9\xee\x0e\x82s\x87\xea;\xe4:́\xad}\x16~'\ft5O\x9f\xd8.\xa0\xf9Y\xe4\x82\x0f#\x8d3\xe3\x90\xe0\t:\x00\xf7\xdb\x1e\xa0&\xb8\xb9\x01\xeb\xe0\xa6i\xa5n\xde\xf8\xf5ҥq\xa4\xcd`\v]\x14\x90\xcc\x1d\xbe\xa6+\r\x14\xe8\xddߝ9\xf9\xc3A0\x18\xe8\xfe.\x98'\x80H~J\xb0w-&\x90\x92\xa6\xe6]\xee:\xd6>\xad\x1c\xfa\xbbsԇҁ\xbfuz\xa7%a\x9a\xc3Lw%\x9a:f\x82\v\xb2X\xae\vfPW>?|\a\xf1\xcd\xd3\xfa\"ʛ\xa7\xf5\xdcm\x90\xe1\xd6\xcb\xfaʟ\xe49\xf9\xc7\xea\x19}\x88\xbd\x8a扪\xfe\f\xf1\xc7\xe3+玒\xf4\xbb\xdf\xfe7\xa9\fOE\xab\v\x1a\x98\xeec\xfb]\xb1L\xf2\xbav8\x8ac\x11L\xda\xfa\xe1\xc4H\a#\x99.<\x8e&\xc2i\xee\xef\xc6\x13C_\x9e\x9d\xdd<\xadG\xe3维ә\xde\xf7\xea\xab\xc5Q\xab\x8fs\xbd\x97\x0f\xf6Nk\xe7\xd0px\xac\xb0\xdb\xef\xcf\xf6\xa9-\xab\x02\a\xad\xdb\x19\x7f\\OW\xf8\xc2\xdae\r9\x96Β\xf3.h\x8dU\xd2|\xbe\xafl\xa00\x8b{\xa8\r\x80\xaf\x05R\xeb2\xcc\x00\xf7h\xc0\x1a\xd8*]`\xd6ǥ\xa9\xbb\x01<\x8a\xef\xfa\x06\xe0\x96\x0e`\x12\xc6\xe5\xd2\xceџ\xde\xf2\xf0\xb8 \x15f$\x10\x13\tS\x17\x85J\n\\\x01\xbb\x1a/wz\xa9\b\x89\xd4\xee\\q\xf5\xb9\x91\x12\x8b\xab\xb0\x04Tbk\x9ek\x87o\xa9\xf5\x85\xf8\x1a&\xd2\x1e\x9f\xa1!\r\xb3p0\xd7t\xe6\x13H\xe8\x9aɫ\b\xfag\xb53\f7\"3w1\x0e|G\x04\xa7\f\xd0\xd4\xe5t\x97H\x1e\xfffF?\xa4)Vs\x810\x82\xc9Ka\xf7E\xc1\xf1f\x17\xfe\xcf{\xf6U\xaai7:\xa7\x9dV\fr[\x84\xfb韼L]&\xd2\xefo\x9bG\xb5\xa0\xab\x10%'\xa8\xf2\x00\x9e\rt\xdc!\xb8\xd1S\xe1T\xc3\xc7\xe3\x8f|\x87G¹\xc9\xf9\x97\xbe\xe1\xdf\xf4\xcdn\xf8\xd7=\xfe\xfd\x9c\x1d\x8e\x04\xf9\x1fV\r\xb6\n\x1e\x1b\t\xf4\\`\x9dO\xe8\xed\xd2&m\x8dZ\x9aCI\x10_\xe3\x81\xc3w\xe6s\xa7\x1b\b\x9f\xcd\x17\xf0\xa2\xa6W\xa8\xddR\xb2\xc5\xe9\x10?܌\x16\xc7\xcc\xfd\xe3\xa3\xfb\xac#L\x06=\xf3\xac\x87\xddڡ?R'\x87\xa7\x9e\x15\xfc\xf1\xe7\xe2\xaf\x01\x00\x88\xba\x05\x1a\xd2\x1a\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcYMs\xe3\xb8Ѿ\xebWt\xcd{\x98\x8bE\xef\xee\x9bJ\xa5tۑ\x93\x94*3\x13\xd5\xc8\xeb;D6)\xacA\x80\x01@9\xdeT\xfe{\xaa\xf1!\x82$$Y\xb3;#\xf9`\x01ݍ\xfe\u0083Fc\xb9\\.XǟP\x1b\xae\xe4\nX\xc7\xf1\xdf\x16%\xfd2\xc5\xf3_L\xc1\xd5\xfd\xf1\xc7\xc53\x97\xd5\nֽ\xb1\xaa\xfd\x82F\xf5\xba\xc4\a\xac\xb9\xe4\x96+\xb9hѲ\x8aY\xb6Z\x000)\x95e4l\xe8'@\xa9\xa4\xd5J\b\xd4\xcb\x06e\xf1\xdc\xefq\xdfsQ\xa1v\xc2\xe3\xd2\xc7\x1f\x8a\x1f\x7f*~X\x00H\xd6\xe2\nH^\xdf\t\xc5*S\x1cQ\xa0V\x05W\v\xd3aIb\x1b\xad\xfan\x05Äg\vKzu\x1f\x98e\xbf8\tnPpc\xff1\x99\xf8ȍu\x93\x9d\xe85\x13\xa3Uݸ\xe1\xb2\xe9\x05\xd3\xe9\xcc\x02\xc0\x94\xaa\xc3\x15|f-\x9a\x8e\x95X-\x00\x82%N\x85%\xb0\xaar\xbeab\xab\xb9\xb4\xa8\xd7J\xf4m\xf4\xc9\x12*4\xa5\xe6\x1d\x91\xa4\n\x81\xb1\xcc\xf6\x06L_\x1e\x80\x19\xf8\x8c/\xf7\x1b\xb9ժ\xd1h\xbcJ\x00\xbf\x1a%\xb7\xcc\x1eVPx\xf2\xa2;0\x83a\x96\xfc\xb0\x82\x9d\x9b\bC\xf6\x95\xb45Vs\xd9\xe4\xd6\x7f\xe4-B\xd5k\x1760\\\x96\b\xf6\xc0M\xaa\xd8\v3\xa4\x9c\xb6X\x9dU\xc3͓0cY\xdbM\xf5IX\xbdB\x15\xb3\x98Sg\xad\xdaN\xa0\xc5\n\xf6\xaf\x16\xa3յ\xd2-\xb3+\xe0\xd2\xfe\xf9OgU肫\n\xc7\xfa\xa0\xe4\xd8-\x1fh\x14\x92a\xaf\tE\xa8A\x9d\xf5\x8d\xb2L\xfc\x1eE,\t\xf8\x90\xf0{M\x1ei\x18\xd2\xf1\xab\xaaP\xba\x81\xaa\xc1\x1e\x10>\xb0\xf2\xb9\xef`g\x95f\r\xc2GU\xfa\xe0\xbd\x1cP\x87\xe0\xed=\x899\xa8^T\xb0\x8f\x16\x03\x18\xabt6\x8a\x1d\x96\x85\xe7\nr\xa3\xd8I(\xc7k\xfe\xc1IVjd\xd9$\x8b(S8\n\xaed>\xd3~n\xf0MY\x96zS\xaa\nO\xae\xc3T#n\xa0ӪDc\xb2\x1es\xbb\xac \xf60\xe9u\xf8<\f\xcc\xdc\xe2)\x8e?1\xd1\x1d؏nȔ\al\x1dz\xd2/ա\xfcy\xbby\xfa\xff\xddh\x18\xc6\xea':\xb2\xd2\x1a\x02\v\xb2\xa4\xd3ʪR\tأ}A\x94\x0e\xb7\xa0UG\xd4Љ\xbe\xe1\xd2\x00\x93\xd1\x14\xfa&\x04\x03TS\x92;WЬ\xe7\x0e\xe9\xa4:\xd4i\u0601\xfcӡ\xb6<\xa2\xaf\xff&\xc7J2:1\xe2=\xd9驠\xa2\xf3\x04\xbd\x15\x01K\xb1\n\xae\xf1q\xe2\x064v\x1a\rJ;V!8\xae\x06&A\xed\x7f\xc5\xd2\x16\xb0CMbb\xfe\x97J\x1eQ[\xd0X\xaaF\xf2\xdfN\xb2\rX\xe5\x16\x15\xccb8\x0e\x86/mG-\x99\x80#\x13=ޑ\xef\xa0e\xaf\xa0\x91V\x81^&\xf2\x1c\x89)\xe0\x93\xd2\b\\\xd6j\x05\ak;\xb3\xba\xbfo\xb8\x8d\xc7i\xa9ڶ\x97ܾ\xde;w\xf3}o\x956\xf7\x15\x1eQ\xdc\x1b\xde,\x99.\x0f\xdcbi{\x8d\xf7\xac\xe3K\xa7\xba$\x83M\xd1V\xff\xa7\xc3\x01lޏt\x9d%\x9a\xffsg\xe1\x85\bБ\b\xdc\x00\v\xac\xde\xd0\xc1\xd14D\xde\xf9\xf2\xd7\xdd#ĥ\xdd\xc6\x1d\t\x85\xe0\xf7\x81\xd1\f! \x87qY\xa3v|Pk\xd5:\x8f\xa3\xac:ťu?J\xc1QN\xddo\xfa}\xcb-\xc5\xfd_=\x1aK\xb1*`\xedj\f\xd8#\xf4\x1d\xed\uea80\x8d\x845kQ\xac\x99\xc1o\x1e\x00\xf2\xb4Y\x92c\xdf\x16\x82\xb4<\x1a>$e\x15\xbc\x96L\xc4\n\xe7L\xbc\x86m\xbf밤\xc0\x91\uf209\xd7<\x9c\x01\xb4wY\x02\x10\xc5H\\~\xbb\xd27\v\xfdS\xa2\x89>\x1fr<Q-\x99@l<\x8d\xfc\xc12\x13\n \"\xf3\x80ÁGc\xa7\f\xb7J\xbf\x92`\x7fz\x8dm\xba\xe0|\xfa+\x99,Q\\\xb1d툀ˊ\xfc\x88\xa7\x9c#x\xf0\x02\\\x9a*\xd9(\xda\x13\xe7\xdc\xeb\xbf\x1b\v%\x93\x94\xa2\x06-\x9d,2s\xb0p\tCm\ai\r7|\xbcU{\xa5\x04\xb2)ޕ\x86\xef$\xeb\xccA\xd9+\xb6mj\x88\x94\x8f\xaf\x1d\x92\x1b\u05fb\xcd\x1d\xacw\x9b8N0~\xe4U\x00`B/\xdd\xe6@6\x00-Y\xb3\xdem\xc0\x04\xf6\xb9\x13d/\x04\xdb\v\\\x81\xd5\xfdܰ\xf3iH\xdf(v-\x98\xc9\x12L\f\x8cV8\xfa\\\xfaE\x81P:\n{`S\xa8\x89\x1f\xa2>R\xb1\x9e0\xf1SY\x02/\xdc\x1e\xb2\x9c\x17\xf2/\x16]\xac\xc17\x1b\x94\x90g\xed\t\x85\x9f7G\xd5Y\x89ޘ\xed\xd3\xda\xd9{\xcd2\x82寱\xcc;\xeb\xef\xeeFvݰ\xa7\x81:\xda\xe5O\x1cU\xa7\n\xba\v\x1e\b\xb6G\x91\x95yJ\xc2\xed\xd3\xfa\x0ex}\xce8˞Q\x82U\r\xda\x03j\x17=\"=#3r\x9a(]9\xae\xed\xd3\xda\x00\xf7[\xd8+\xb6\x7f\x05\x96\x9a\x12\xf3\xef\xeb\xfd\x17%\xbc!7\x9eF\f\xb9\xec\x988\"+\x12\b\xd8\xf6\x1ed\xb1\x82\xbe[dH.\xebN\b\xc95N\xea\v\xfa[\x8e\xf2=3=6zFp\xe6p\x8c\xf5\xea'\xaaH\xd7Jּ\x99\xaf\x9d^\xbd/a\xccE\xd3F\x0e\x7f\x18/I\x1e\xa73\x964Y\xba\xe2x\x19\x0f`\xeavԼ\t\xb7\x9c̢5GQ\x99\x9b\xd1\xf2\x8a?\x9c\x12\xab7\x1a\x11\xab\x85\x00\xf5I\xfd\xef\x13\xa27\xee\xe6}f\x9bP\xba\xf4]\x01\x9b:\x91\xc8\r\xbc{\aJ\xc3;ߑywG\xdc@}\x1e\xbb\xe4\xe9%$#\xf1\x85\v\x11\xd7-\x167D\xe9t\x15\xa1\x8b\xa0\xea\xed\x15\a\xfcsB>\xf1\x83\xa5\xfb\xa9\xb3\xdd*xaܞj\xff\x99\xd8dis\a{\xac\xa9\xe0\xd7h{-\xa94@\xad\xa9\x023N\xa4\xea\xedMFu\x8ct\xb8b\xca\xd6\x11\xe5k\x15'\xe0;\x94*w\xa7\xec\xc9\b\xa5\x84lQ\xdaP\xb0u\x1dV\xee\xea\xa4\xd1\xf4m8k¥\xcbX(\x0fX>\xfb\x9b\x80\xf2\xbd\xa7\\\xe6Ղ5$\xaf\x14\xc8.T\x80\xf9Z)B\xe1#\xd1\\v\xee\xb4R\"5)`\x11\\\xa3\xa8\x11~\xceD\x02\xf4\xddM\x81\xf77\xabSK\xf1\x9a\x92cꨧҼ\xe1t]\x95\xa7\x99\xa1\x9c\xf6\x98;\x93\v\x10\x9aE\xee\x14p\xa1)(+\x82HCE\xfc \x8e\x80\xcf/Nu\x05\xc5t\xbd\xdbdd\x9e8\xaa\x00[\xe6+\xbc\xb1}Z\xbf\xc9\x0f\xa4J\xe6\x18\xa4\xe1\x97\x03/\x0f\xe3\xb8ͮ\xae\xf4\xe7\v\x85Z\xe9\x1b\xd4̟\x7fK\xd8\xe7.E\x13\x9a)xM\xa6\xa3\xb2\x94\x84өq賳ۧ\xf5\xe2\r\xe7\x87\xefU\xae\x16g\xdd;\xa0\x80o(G/\x97\xbdִ\xbdC\xbbZ\xd5_u\xe1,}\xa378\xc1\xb5\xf2\xae\x84{=\xe7p\x1d\x1d]% \xce\xe2őz\xd6a\x8d\x1c^@\"\xcea5Y\xe7\xa5a\x05xD\tt\x9bf\\Ё\xe8D\x9abʓ\x91\x9aJ\t\x87C\xef\xfc\x12{)A\xbdةz\xa4\xe4tݪ\xf7\xe6\x82L\a\xf9\xb4\xfd2N\x98gt\xecRS\x83d\x99\x15\xfa\xa6\x92#\xbb9O%\xd8\x174\xbd\xb0ߵ\x04\xf3Kҡ\xa2\xd1dK\xb0\xcbwWF\xad.\xed\x85\x04\x988\x97\xb8\xbf\xaf.k\xd1\x18\xd6\\;l>y*\x8a/\x8b,\xc0\xf6T\x9e\x8cU{o\xc2f+\x167x\x91:\xd3W4\xa0^5-/o\xee\x87ߤIG\x1d\xf3˚P\x9f?\x02L\xdd\v\xe1x\xa2J'\xf4\x0ew\x9b=\xd2n\xfa\xa3\x0e_W\xd1\\S\x8fhr\x00xr\xdb\xe0\xa7\xf9\xe2(\xfbv\xbe\xc0\x92^\xf82\xa3?\x97%v\xc3+\xc8\xf0Y\xc2Vcǆ\xf7\x9b\xe1\xbbLJ\xb4\x1c\x1fՅ9.\xdf\xf8\x9a\xfbd\x98˳E`\xcd\xcc\xfd\x8d\xf1\x1cӥ\x00\x04ů\xc5 \x90\xc1A\x89\b\xf9\xee!M\xf6\xed\x1e5\x05\xc2=\xd5ň\x9c-y\xa8pI\xe3\x98\xf0\x9f*!'\x89\xe0\x99\x8aN\xdf͋\xf7\x83\x8a\x9bN\xb0\u05cc\xe0hH\nCɆ\x8e\xd0\x1fO\xff\xe2\xc6\xc6\xd8\xe9Y37\x99\x7f\x9b\x1c\x7f毌\xe3\xcf\xf0\\\xf9mV\xb8\x80\x98q\x8bo\x1e\xaedA\xac\xd07\x0fq;\xf2\x8a\xfa\xf35O^\xaeN\x80\xe1\x1b7g\xaf\xb2I{\xb9\xb8%cǏ\xdd\xd74\x1e\x11_)Y\xc23\xfb\\\x1b\x80\x1d\xed}B\x1c\xaa\xd2a=}\b\xbd;\xbd\xab2\x1b\xdaj\xe5\x81\xc9\x06\rU2\x1a\xfd\xa9\x99\x13<\xabAF\x15\xc7X\xfd\xef[l\xf4\xa7\xf2\xd3\\q\xf2P\xa8\xa6\xf8 T\xc3K&\xc0\xf0ߦ\xfd\xb1\xf1Ch\xfcP>\xa5\xc4Tc\x04-\xfc\xe6\xcf>Mܺ\x91\x83Z\x17v\xdaȶ\x8f\tyL\xfa\x99\x92\xe1\x7f\x0fz\xc57پ1\x1eX\xbdU\xf3_R\xfa\xb3\xaa\xbfhn\xad\xeb\xd4f%B\xde\xe9\xc0j\x8b\x1a*\xac\xfaN\xc4\xe7/\x82v\xaa\xfa\t\x85\xb3\b\xfbMa,;1\x1bt[\xadJ6Ch\x97\xa6#\xfd\xfe\xf4һ\x82\xff\xfcw\xf1\xbf\x01\x00\\\x19a\x1f\xad%\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4XK\x8f#\xb9\r\xbe\xfbW\x10\x93\xc3\\\xc6\xd53\x9b \b\xea6\xe3\xde\x05\x1a\xd9\x19\x18ۓ\xbe\xcbU\xb4K\xdb*\xa9BJ\xeeu\x82\xfc\xf7\x80\xaa\xf7ïM\xb6\xab/\x16)\xea\xd3G\x91\x14\xb5^\xafW\xaa\xd2/H\xac\x9dMAU\x1a\x7f\xf3h\xe5\x17'\xaf\x7f\xe3D\xbb\x87\xe3\xa7ի\xb6y\n\x9b\xc0ޕ\xbf \xbb@\x19>\xe2^[\xed\xb5\xb3\xab\x12\xbdʕW\xe9\n@Y뼒a\x96\x9f\x00\x99\xb3\x9e\x9c1H\xeb\x03\xda\xe45\xecp\x17\xb4ɑ\xa2\xf1v\xe9\xe3\xc7\xe4\xd3\x0f\xc9\xc7\x15\x80U%\xa6 \xf6\x8eHz\xaf\x91\x93#\x1a$\x97h\xb7\xe2\n3\xb1{ \x17\xaa\x14zA=\xafY\xb3\xc6\xfb\xa8\xbcz\x11\x13\xa78h4\xfb\xbfO\x04?k\xf6QX\x99@ʌ\x97\x8d\x02\xd6\xf6\x10\x8c\xa2\x81H\xccq\xe6*L\xe1\x9b*\x91+\x95a\xbe\x02h\xf6\x121\xacA\xe5ydG\x99-i\xeb\x916΄\xb2ee\r9rF\xba\x12\x95\xda\x0e\xb8=\xf8\x02a\xa7\xb2\xd7P\xc1[\xe1\x18\x81\xad\xaa\xb8p\x1e4C\x83+\x8f\xb8\x00~eg\xb7\xca\x17)$\xc2JR\xcf\x13K\x8d\x82\x10\x92\u00978\xdc\f\xf9\x93\xa0fO\xda\x1e\x96p\xf4\xcc\x00{\xe5\x03\x03\x87\xac\x00\xc5\xf0\r\xdf\x1e\x9e\xec\x96܁\x90y\x01BTO\xaaB\xf1x\xfd\xe7(\xb8q\xfd\xef\xbaD\xc8\x03\xc5\x03\x04\xacm\x86\xe0\v\xcdC`o\x8a\x05\x1c\xf9E&\xe2jI\x94\x8b1\xf6\xaa\xac\xa6x\x06SkBr\xe5q\tN\xa4Bc\x0e\xbb\x93o\xce\x03\xc0\xdeQ\xa9|\n\xda\xfa\xbf\xfe\xe5,\x82\xaaa*\x89S\x1f\x9d\x9dxEFa0\\\x03\x91\x83r@Z\xa4\xc6ye\xfe\x17 ^\f|\x19̯\x91|\x97a\x18\x8e_\x87r\xa3\x972B\xb5\xe8\xa56a$QC;\xbb\xec\xaa\xcf\a\xbc\xc9M\xc3\xe8\xb1.Gx+\x90\xe4\xe0\xe0\x10\x91f\xa8\xc8eȌ\xf9Y\xb6dz#\xac1|\xeb\af\x87\xb7\xd68\xfe\xa0LU\xa8Oq\x88\xb3\x02˘\b嗫\xd0~\xde>\xbd\xfc\xf9y4\fc\xf8c\x8c\n\b\xff\x19\x90=xW\x87\xfc)\xee$\xfa\x83\xb4?\xb5;\x15\x02;\x83\x00\xa5;\"\xf5\xf9\xc2\xedA\xc1QR\x0e\x82\xb6\xc3\xc4BX9\xd6\xde\xd1\t\u07b4/\\\xf0@\xc8\xdeɶ@\xfb\x0f\x03\x9bڏX\x83ݩ\xe3x\xad\x0eh}\xd2)W\xe4*$\xaf\xdb\x14\\\x7f\x83\xe22\x18\x9d\xec\xff\xbdPTkA.U\x059.\xd3\xe4S\xcc\x1bV\xeb\x8dk\x06\u008a\x90\xd1\xd6ufd\x18DIYp\xbb_1\xf3\t<#\x89\x19\xe0\xc2\x05\x93K1:\"Ɇ3w\xb0\xfa_\x9dm\x16\xb6eQ\xa3<65\xa1\xff\x84{\xb2\xca\xc0Q\x99\x80\x1f@\xd9\x1cJu\x02BY\x05\x82\x1d؋*\x9c\xc0WG\xc2\xfcޥPx_q\xfa\xf0pо-\xaa\x99+\xcb`\xb5?=\xc4\xfa\xa8w\xc1;\xe2\x87\x1c\x8fh\x1eX\x1f֊\xb2B{\xcc| |P\x95^G\xe8V6\xccI\x99\xff\x89\x9a2\xcc\xefGXgg\xb4\xfe\x8f\x05\xf1\x82\a\xa4.\x8a\xabU3\xb5\xdehO\xb4\f\t;\xbf\xfc\xf8\xfc\x1dڥc̏\x8cB\xc3{?\x91{\x17\ba\xda\xee\x91\xe2<ؓ+\xa3\x9b\xd1\xe6\x95\xd3\xd6\xc7\x1f\x99\xd1h\xa7\xf4sؕ\xdas\x1b\x18\xe2\xab\x046\xf1\xa6\x01;\x84PIb\xc8\x13x\xb2\xb0Q%\x9a\x8db\xfc\xc3\x1d L\xf3Z\x88\xbd\xcd\x05\xc3KR\xff'V҆\xb5\x81\xa0\xbd\xe6\x9c\xf1W\x9f1\x9e+\xcc\xc4q\u009dL\xd2{\x9dŨ\x90\xea\x00j\x90[\xfaP=\x1f\xae\xf2\xf5\u05c8\xa9d\x02\xe2K\xa7\xd8\x02\xb0\xe7n1\xb2\xef:A\xcdL\xc2\xe2\x15g\f\xf6\x02\xab=\xe2g\xefH\x1d\xf0gW\xef\xff&\xf0\x939\x17\xf6!\xf9Qu\xe5h\xf8\x99vr_t\xe6\x89V˕\xc5ѝ\x1b\x13\xda\xfeQ\x19\xa7\xf2+\xbby\xec\x14\x97\xb60\x90\xbe\x15:+\xc0;\xf7*\xd1v\xc1\x19w\xe3\x8c\u07bd\x01\xe6W\xd1k\x8fj\x93\xe8\xfb\xe31\x01x\x01\x0e\xc0\xd3~`P3\xbc{\a\x8e\xe0]\xdd\x12\xbc\xfb\x10\xe7K\xa7\xe1\xd7ڎ\x96\xd0\xc6\xc0ni\xf3\x81\xeftP\v\xef\xe9\xf1\xcaΟ;\xc5\xd6AO\x8f\xad{Z#R\x81v\u0605\x00\xe8ie\x93o\xf1x݇9\xe6\xee\xaeq\xb9\x06|\xacݢw\xa4\x0fZ\n\xa2\xed$\xfd\xf1\xafo\x1d3\xbb \x93\x05;\xe6\x10\xaa\x98\xff\x7f\a\xf0\xed\xcb\xe6&\xc8ۗ\xcdR,\xc8psƆ\xd4\xcf\xea\x98\xfc{\xf5\x8a1\x8f\xde\x053\xfa\xef\xf4\x936\xc8[\xa4\f\xad\xbf\x82\xf7e6\xa1\x05^\xd5?ա\x83\xbf\x17\xb3\xe7OF\xb7\x9f\xbay\x94ʆ\xd63(B\xc8ݛ\x95\x1c\x80y\xbc\xbe\x1c\x95ѱlց\x92k\xc2L\xee\x80]\x170\xfcd\x82h\xb55L\xe0(c\x06\x90d\x05e\xdeԉ{\xcbs\xdaJ\xf5\x9b.C\x99§\x8f\x1f'\xb4\x01\x94\xda\xd6¹hޑ\xb4\x7fr%Є\x93\x04\xb9\x86Y3<\x16L\x12\xffD\xa7ϻ\x13A\xcb\xef\xd3\xe3T0\x0e\x93E\xe9\xf6esS\xe9\x8f\x1dl\xba:{^\x06\xc5?\xaa\xb6\xa7%\vDh}۸\xbb\xfd\xef*\xff\x99++\x83\xa3\x9e\xec\xca\xf9\xdd\xccg\xc4\xfb5\xe55./\xddbs\x9f\xef\xae'3\x93Pw\x8c\xb5-̓\x81\xd9\xdaB\xbc\xf7g\x8er\xcc\x01\x8fh\xc1Y\xd8+m0\x1f\x19\xe6\xf9\xa9\x03\xf8.\xb1\x1e;\x81\xf7\xdcY\x93l/ѽ\xb4\x81y\x10\xb4Ͷ\xc4\xccZL\xcc4l0F\xed\f\xa6\xe0)\xe0=)\x03\x89\x1c\xf1\x15\x9a\x7f\x8cJ1\x94\x85L\x94K+r\x9b\x18\xba\xc8o(\x19גaO\xd7\xfe\xf9\x02Oњ\xf4\x83\xf2\"\"]\xb3\x1d\x1b\xd3\f\x99#\n\xd5b4k\x8f\xe5\x02\xea\x8b[\xbd\x91&E\xa4N\x13Y\x89\xcc\xeap\xedV\xfa\xb5֒\xa0P\xed\x14P;\xe9r\xc7\xef\x01﹉\x94du\a~i}\xaf \x90\x17\x03Y\xde\xde\xfd*q\x17\x92\xf8\xd2v\x05\xcaVt\x96\xf2C\a\xacG2_\x1cm(\xe7\v\xac\xe5)pa\xf4s\x96aտ\xf6\xf4\xdf\x1af\xef\x86\xfd\xb7n\xa3oq\xe2O\xf10\xdf\xc5J\xb3\xd05b\x1a5(\x9ci\xd3T|\a\xb3\xa1\xdc!\t;\xf1\xa5m\x1a^\x17jcKoo\xa1\xbb\xc9ESs~\xcf'a\xf9\xbaw\xc3%\xe1\xf2\xe3߭E\xb3\x91w\xef\x81\x7f\xcc\ng\xea[S\xe3\x06ϳW|\xf5<R\xbe^Z\xa4\x90\xcc,6kJa\xb9\\\fƫ\xf1\xea\x1c+\xff\xff:\xb0\xc8\xd7l0\"\xcf\a\xb6\x9b\xcet8\x12v\xdd\xebP\n\xff\xfe\xcf\xea\xbf\x03\x00\xc17\x1c\xb6\xe7\x19\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xdcW͎\xdb6\x10\xbe\xfb)\x06\xe8!\x97\xb5\x9c\xa4\x97B\xb7t\xd3C\xd04\xd8\xee&\xb9\x8fűͬD2\x9c\xa1\xb7n\xd1w/\x86\x92lɒw7\x05\x8a\x02\x91|\xd1p8\xfc\xe6\x9b?z\xb9\\.0\xd8\xcf\x14\xd9zW\x02\x06K\x7f\b9\xfd\xe2\xe2\xfe'.\xac_\xed_-\xee\xad3%\\'\x16\xdf\xdc\x12\xfb\x14+zK\x1b\xeb\xacX\xef\x16\r\t\x1a\x14,\x17\x00\xe8\x9c\x17T1\xeb'@\xe5\x9dD_\xd7\x14\x97[r\xc5}Z\xd3:\xd9\xdaP\xcc\xc6\xfb\xa3\xf7/\x8bW\xaf\x8b\x97\v\x00\x87\r\x95\xe0\xbc!ܒ\x13\x16\x94\xc4\xc4Şj\x8a\xbe\xb0~\xc1\x81*5\xbe\x8d>\x85\x12N\v\xed\xe6\xee\xe0\x16\xf4\ao\xe8\x8dڹ\xcbv\xf2JmY~\x9d[}oY\xb2F\xa8S\xc4z\x06E^e붩\xc68Y_\x00p\xe5\x03\x95\xf0\x01\x1b\xe2\x80\x15\x99\x05@\xe7dƵ\x044&ӆ\xf5M\xb4N(^\xfb:5=]K0\xc4U\xb4AUJ\xf8\x90\x9a5E\xf0\x1bP\x82!\xa0\xec\xc0:\x16t\x151\xc8\x0e\x05*\x9fj\x0319\xa5\xbaJ1\x92\x93\xfa\x90\x81\x02|a\xefnPv%\x14-\x91\xc5I\xe9\xbdm\xactz\xca[\tC\x89\x1c\xd4\x0f\x05\xb8\xa58\a\xed\xa3m\bL\x8a9\xd8\xc0\xd6U\x04\xb2#hρ\adH\xc1\xa0\x90\xb9\x88\xa5F\x96OYG\x8d\x8d\xa1 \v\xb4k\x9d\xbc\x05t\x14\xb4\x88\xf7\xaf\xb1\x0e;|\x95E\\\xed\xa8\xc9Y\xa8_>\x90{s\xf3\xee\xf3\x8fw#1\x9c\x11<\xce\x00\x88\x14|\x14\xa5\x96\x06\x94+^\xd2(\xa8X\x83\xbe\xccQ?\x9a\x04\xe5\xdfY\xb7\x05\xef\x00sZ\\\x81\x15\xb0\x9c\xdd1\x80\x1b\xa1x\xdc]\x1c7\x86\xe8\x03E\xb1}Ҷ\xef\xa0&\a\xd23\xe4/ԹV\v\x8c\x16cN\b곍L\xc7G\v\xdbf\xd7\"1\xb9\xb6<G\x86A\x95Ё_\x7f\xa1J\n\xb8\xa3\xa8f\x80w9\xb9*\xef\xf6\x14\x05\"U~\xeb\xec\x9fG\xdb\f\xe2\xf3\xa15\nu\xb5sz5y\xa2\xc3\x1a\xf6X'\xba\x02t\x06\x1a<@$=\x05\x92\x1b\xd8\xcb*\\\xc0o>\x12X\xb7\xf1%\xecD\x02\x97\xab\xd5\xd6Jߋ*\xdf4\xc9Y9\xacr[\xb1\xeb$>\xf2\xcaО\xea\x15\xdb\xed\x12c\xb5\xb3B\x95\xa4H+\fv\x99\xa1;u\x98\x8b\xc6\xfc\x10\xbb\xee\xc5/FX\xdb\xdcb\x89\xd6m\a\v\xb9\x85<\x12\x01m\"\x1ac춶\x8e\x9e\x88V\x91\xb2s\xfb\xcb\xddG\xe8\x8f\xce\xc1\x18\x19\x85\x8e\xf7\xd3F>\x85@\t\xb3n\x93\xb3\xc72l\xa2o2\xe3\xe4L\xf0\xd6I\xfe\xa8j;NG}9\xad\x1b+\x1a\xf7\xaf\x89Xs\xda\x17p\x9d\x1b4\xac\xa9/\xce\x02\xde9\xb8Ɔ\xeakd\xfa\xcf\x03\xa0L\xf3R\x89}^\b\x86\xb3\xe5\xf4\xa8\x95\xb2cm\xb0\xa0e\x9a\xf8\x91\x88\x1d\xfb\xfd[\x14Ծ\xd8U\xbd\x9d/\xf8\xb6\x19\x90\x81u\xdfO\xfb\xa7\xaf\xe4\xb6\x0f\xe4\xea9\xab\xec\xcb\xd5\xddM\xc5a\x17>_>\x83}=\xd6\xee\xe1V\xdem\xec6E2\xe0\xa6sbb\x12\x9e79\xb4\x85M\x1b\x15\xc0\xa5\xb1pz\xc6\xed\xfc\t\x9fޏ\x94{\x97\xd4\x04\x88m\x9e\x18%\xc3w}8\x8b\xc6\x14\xf4\xc6\xc7\x06\xa5\x1d\x1eK9\x8d\x9a\xd3\xebR]㺦\x12$\xa6\xe9\xf2\x85\xe4\xd4_\xa0x\x1c\xf6gq\x9aD\x1dF\xc3\xffRn<E\xf3\x19\x917\x8f\x00\xb8\x98)3G~\xfb\x1dc\x98)\xb0\xf1S\x9cm\x9d\xe8u\xcdnl\x05]\xffs=Z.\xfe]\x1c&u\xaf\xbf\xaf\x89\x12\x19\xad\xeb\xb7\xfe\xc1\xd5\x1e\r\x97\x8f\x13\xf7\xfbtG\xcfW\x86\xd8\xcf\xfb\x10)\xa0\x16\xd9Hub\x1bFt\xe4\x02\xc3H\xf0\x806ρ\x8d\x8f\x803\fO9\xb0B\xcd#I1\x9b\x85\xcfd\x0ec\xc4\xc3E\xe2>\x85o\xa3\xedSx&i\x9d\xe2wFٍ7\x9f\xf5\xeaN?cu\x9f\xc2\xf3x;\xdf4K\x9e\xa3\a8W\xfcn\xc8\xebn\xca\xfd\x04~\x8a\xb6\xdb3\xf5Y\xc2\x06Iv5.ӫ\x89u\x98P\x9b/\xa6G\xe1-\xb1\xf8H\f\x0f;\xcf4\xc3\xe0\\(\x94\xf9\xc1_\x80>,\xff3ٳ\xedr\"d\xbd웁i\xf5\x1f\xb7\xc3\xc38\xad\x8f7\xe7\x12\xfe\xfa{\xf1\xcf\x00ё\x0ew:\x10\x00\x00"),
 }
 
 var CRDs = crds()
@@ -0,0 +1,115 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/compute/metadata"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/endpointcreds"
+	"github.com/pkg/errors"
+)
+
+// PreferAmbientIdentityConfigKey is the key of the BackupStorageLocation config preferring the
+// ambient identity of the Velero pods over the credentials file of the location.
+const PreferAmbientIdentityConfigKey = "preferAmbientIdentity"
+
+const (
+	// the env variables injected by the EKS pod identity webhook for IRSA
+	awsRoleARNEnvVar              = "AWS_ROLE_ARN"
+	awsWebIdentityTokenFileEnvVar = "AWS_WEB_IDENTITY_TOKEN_FILE"
+
+	// the env variables injected by the EKS Pod Identity agent
+	awsContainerCredentialsFullURIEnvVar     = "AWS_CONTAINER_CREDENTIALS_FULL_URI"
+	awsContainerAuthorizationTokenFileEnvVar = "AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE"
+)
+
+// AmbientIdentity is the identity the Velero pods get from the platform they run on, which is
+// used to access the object storage without the static keys.
+type AmbientIdentity string
+
+const (
+	// AmbientIdentityNone means no ambient identity is used, the credentials file is used instead.
+	AmbientIdentityNone AmbientIdentity = ""
+	// AmbientIdentityAWSIRSA is the IAM role of the service account of the Velero pods.
+	AmbientIdentityAWSIRSA AmbientIdentity = "AWS IRSA"
+	// AmbientIdentityAWSPodIdentity is the IAM role associated by EKS Pod Identity.
+	AmbientIdentityAWSPodIdentity AmbientIdentity = "EKS Pod Identity"
+	// AmbientIdentityGCPWorkloadIdentity is the identity served by the GCP metadata server, i.e.
+	// the IAM service account bound by GKE workload identity.
+	AmbientIdentityGCPWorkloadIdentity AmbientIdentity = "GCP workload identity"
+)
+
+// onGCE is replaced in the tests, the result is cached by the metadata package.
+var onGCE = metadata.OnGCE
+
+// PreferAmbientIdentity returns whether the config of the BackupStorageLocation prefers the
+// ambient identity over the credentials file.
+func PreferAmbientIdentity(config map[string]string) bool {
+	prefer, _ := strconv.ParseBool(config[PreferAmbientIdentityConfigKey])
+	return prefer
+}
+
+// GetAmbientIdentity returns the ambient identity the BackupStorageLocation of the provider uses
+// instead of its credentials file. AmbientIdentityNone is returned if the config doesn't prefer
+// the ambient identity or no ambient identity of the provider is available to the pod, in which
+// case the credentials file is still used.
+func GetAmbientIdentity(provider string, config map[string]string) AmbientIdentity {
+	if !PreferAmbientIdentity(config) {
+		return AmbientIdentityNone
+	}
+
+	switch strings.TrimPrefix(provider, "velero.io/") {
+	case "aws":
+		if os.Getenv(awsRoleARNEnvVar) != "" && os.Getenv(awsWebIdentityTokenFileEnvVar) != "" {
+			return AmbientIdentityAWSIRSA
+		}
+		if os.Getenv(awsContainerCredentialsFullURIEnvVar) != "" && os.Getenv(awsContainerAuthorizationTokenFileEnvVar) != "" {
+			return AmbientIdentityAWSPodIdentity
+		}
+	case "gcp":
+		if onGCE() {
+			return AmbientIdentityGCPWorkloadIdentity
+		}
+	}
+
+	return AmbientIdentityNone
+}
+
+// GetAWSPodIdentityCredentials retrieves the temporary credentials of EKS Pod Identity from the
+// agent. The token file is read on every call since it's rotated by the kubelet.
+func GetAWSPodIdentityCredentials(ctx context.Context) (aws.Credentials, error) {
+	token, err := os.ReadFile(os.Getenv(awsContainerAuthorizationTokenFileEnvVar))
+	if err != nil {
+		return aws.Credentials{}, errors.Wrap(err, "error reading the EKS Pod Identity token")
+	}
+
+	provider := endpointcreds.New(os.Getenv(awsContainerCredentialsFullURIEnvVar), func(o *endpointcreds.Options) {
+		o.AuthorizationToken = strings.TrimSpace(string(token))
+	})
+
+	creds, err := provider.Retrieve(ctx)
+	if err != nil {
+		return aws.Credentials{}, errors.Wrap(err, "error retrieving the EKS Pod Identity credentials")
+	}
+
+	return creds, nil
+}
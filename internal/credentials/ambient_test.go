@@ -0,0 +1,137 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAmbientIdentity(t *testing.T) {
+	prefer := map[string]string{PreferAmbientIdentityConfigKey: "true"}
+	irsa := map[string]string{
+		awsRoleARNEnvVar:              "arn:aws:iam::123456789012:role/velero",
+		awsWebIdentityTokenFileEnvVar: "/var/run/secrets/eks.amazonaws.com/serviceaccount/token",
+	}
+	podIdentity := map[string]string{
+		awsContainerCredentialsFullURIEnvVar:     "http://169.254.170.23/v1/credentials",
+		awsContainerAuthorizationTokenFileEnvVar: "/var/run/secrets/pods.eks.amazonaws.com/serviceaccount/eks-pod-identity-token",
+	}
+
+	tests := []struct {
+		name     string
+		provider string
+		config   map[string]string
+		env      map[string]string
+		onGCE    bool
+		expected AmbientIdentity
+	}{
+		{
+			name:     "ambient identity isn't preferred",
+			provider: "aws",
+			env:      irsa,
+			expected: AmbientIdentityNone,
+		},
+		{
+			name:     "IRSA is used",
+			provider: "velero.io/aws",
+			config:   prefer,
+			env:      irsa,
+			expected: AmbientIdentityAWSIRSA,
+		},
+		{
+			name:     "EKS Pod Identity is used",
+			provider: "aws",
+			config:   prefer,
+			env:      podIdentity,
+			expected: AmbientIdentityAWSPodIdentity,
+		},
+		{
+			name:     "no AWS ambient identity is available",
+			provider: "aws",
+			config:   prefer,
+			expected: AmbientIdentityNone,
+		},
+		{
+			name:     "GCP workload identity is used",
+			provider: "velero.io/gcp",
+			config:   prefer,
+			onGCE:    true,
+			expected: AmbientIdentityGCPWorkloadIdentity,
+		},
+		{
+			name:     "no GCP ambient identity is available",
+			provider: "gcp",
+			config:   prefer,
+			expected: AmbientIdentityNone,
+		},
+		{
+			name:     "provider without ambient identity support",
+			provider: "azure",
+			config:   prefer,
+			env:      irsa,
+			onGCE:    true,
+			expected: AmbientIdentityNone,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, key := range []string{awsRoleARNEnvVar, awsWebIdentityTokenFileEnvVar, awsContainerCredentialsFullURIEnvVar, awsContainerAuthorizationTokenFileEnvVar} {
+				t.Setenv(key, tc.env[key])
+			}
+			original := onGCE
+			onGCE = func() bool { return tc.onGCE }
+			defer func() { onGCE = original }()
+
+			assert.Equal(t, tc.expected, GetAmbientIdentity(tc.provider, tc.config))
+		})
+	}
+}
+
+func TestGetAWSPodIdentityCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "pod-identity-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"AccessKeyId":"key-id","SecretAccessKey":"secret-key","Token":"session-token"}`))
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "eks-pod-identity-token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("pod-identity-token\n"), 0600))
+	t.Setenv(awsContainerCredentialsFullURIEnvVar, server.URL)
+	t.Setenv(awsContainerAuthorizationTokenFileEnvVar, tokenFile)
+
+	creds, err := GetAWSPodIdentityCredentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "key-id", creds.AccessKeyID)
+	assert.Equal(t, "secret-key", creds.SecretAccessKey)
+	assert.Equal(t, "session-token", creds.SessionToken)
+
+	require.NoError(t, os.WriteFile(tokenFile, []byte("expired-token"), 0600))
+	_, err = GetAWSPodIdentityCredentials(context.Background())
+	require.Error(t, err)
+}
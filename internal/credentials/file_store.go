@@ -17,6 +17,7 @@ limitations under the License.
 package credentials
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -71,6 +72,12 @@ func (n *namespacedFileStore) Path(selector *corev1api.SecretKeySelector) (strin
 
 	keyFilePath := filepath.Join(n.fsRoot, fmt.Sprintf("%s-%s", selector.Name, selector.Key))
 
+	// the file is only rewritten when the secret changes, so that the modification time of the
+	// file tells the plugins initialized with it when the credentials are rotated
+	if existing, err := n.fs.ReadFile(keyFilePath); err == nil && bytes.Equal(existing, creds) {
+		return keyFilePath, nil
+	}
+
 	file, err := n.fs.OpenFile(keyFilePath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
 	if err != nil {
 		return "", errors.Wrap(err, "unable to open credentials file for writing")
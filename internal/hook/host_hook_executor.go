@@ -0,0 +1,103 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+const defaultHostHookTimeout = 30 * time.Second
+
+// HostHookExecutor is capable of executing a host restore hook on a restored volume.
+type HostHookExecutor interface {
+	// ExecuteHostHook executes the command of the hook in a chroot of the volume directory on the
+	// host. If the command takes longer than the hook's timeout, an error is returned.
+	ExecuteHostHook(ctx context.Context, log logrus.FieldLogger, volumePath string, hook *velerov1api.HostRestoreHook) error
+}
+
+type defaultHostHookExecutor struct {
+	// prepareCommand sets up the command to run in the volume directory.
+	prepareCommand func(cmd *exec.Cmd, volumePath string) error
+}
+
+// NewHostHookExecutor creates a new HostHookExecutor.
+func NewHostHookExecutor() HostHookExecutor {
+	return &defaultHostHookExecutor{
+		prepareCommand: chrootCommand,
+	}
+}
+
+func (e *defaultHostHookExecutor) ExecuteHostHook(ctx context.Context, log logrus.FieldLogger, volumePath string, hook *velerov1api.HostRestoreHook) error {
+	if volumePath == "" {
+		return errors.New("volume path is required")
+	}
+	if hook == nil {
+		return errors.New("hook is required")
+	}
+	if len(hook.Command) == 0 {
+		return errors.New("command is required")
+	}
+
+	timeout := hook.Timeout.Duration
+	if timeout == 0 {
+		timeout = defaultHostHookTimeout
+	}
+
+	hookLog := log.WithFields(
+		logrus.Fields{
+			"hookCommand": hook.Command,
+			"hookOnError": hook.OnError,
+			"hookTimeout": timeout,
+			"volumePath":  volumePath,
+		},
+	)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(timeoutCtx, hook.Command[0], hook.Command[1:]...) //nolint:gosec
+	// don't wait for the output of the processes left behind by a killed command
+	cmd.WaitDelay = time.Second
+	if err := e.prepareCommand(cmd, volumePath); err != nil {
+		return err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	hookLog.Info("running host hook")
+
+	err := cmd.Run()
+
+	hookLog.Infof("stdout: %s", stdout.String())
+	hookLog.Infof("stderr: %s", stderr.String())
+
+	if timeoutCtx.Err() == context.DeadlineExceeded {
+		return errors.Errorf("timed out after %v", timeout)
+	}
+
+	return errors.Wrap(err, "error running host hook command")
+}
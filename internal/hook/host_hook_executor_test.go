@@ -0,0 +1,118 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestExecuteHostHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("host hooks are not supported for Windows")
+	}
+
+	tests := []struct {
+		name          string
+		volumePath    bool
+		hook          *velerov1api.HostRestoreHook
+		expectedFile  string
+		expectedError string
+	}{
+		{
+			name:          "volume path is required",
+			hook:          &velerov1api.HostRestoreHook{Command: []string{"true"}},
+			expectedError: "volume path is required",
+		},
+		{
+			name:          "hook is required",
+			volumePath:    true,
+			expectedError: "hook is required",
+		},
+		{
+			name:          "command is required",
+			volumePath:    true,
+			hook:          &velerov1api.HostRestoreHook{},
+			expectedError: "command is required",
+		},
+		{
+			name:       "command runs in the volume directory",
+			volumePath: true,
+			hook: &velerov1api.HostRestoreHook{
+				Command: []string{"/bin/sh", "-c", "touch fixed"},
+			},
+			expectedFile: "fixed",
+		},
+		{
+			name:       "command fails",
+			volumePath: true,
+			hook: &velerov1api.HostRestoreHook{
+				Command: []string{"/bin/sh", "-c", "exit 1"},
+			},
+			expectedError: "error running host hook command: exit status 1",
+		},
+		{
+			name:       "command times out",
+			volumePath: true,
+			hook: &velerov1api.HostRestoreHook{
+				Command: []string{"/bin/sh", "-c", "sleep 10"},
+				Timeout: metav1.Duration{Duration: 100 * time.Millisecond},
+			},
+			expectedError: "timed out after 100ms",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			volumePath := ""
+			if test.volumePath {
+				volumePath = t.TempDir()
+			}
+
+			// chroot requires the binaries in the volume and root privilege, so the command
+			// runs in the volume directory instead
+			executor := &defaultHostHookExecutor{
+				prepareCommand: func(cmd *exec.Cmd, volumePath string) error {
+					cmd.Dir = volumePath
+					return nil
+				},
+			}
+
+			err := executor.ExecuteHostHook(context.Background(), velerotest.NewLogger(), volumePath, test.hook)
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			_, err = os.Stat(filepath.Join(volumePath, test.expectedFile))
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
@@ -52,10 +53,12 @@ const (
 
 const (
 	// Backup hook annotations
-	podBackupHookContainerAnnotationKey = "hook.backup.velero.io/container"
-	podBackupHookCommandAnnotationKey   = "hook.backup.velero.io/command"
-	podBackupHookOnErrorAnnotationKey   = "hook.backup.velero.io/on-error"
-	podBackupHookTimeoutAnnotationKey   = "hook.backup.velero.io/timeout"
+	podBackupHookContainerAnnotationKey     = "hook.backup.velero.io/container"
+	podBackupHookCommandAnnotationKey       = "hook.backup.velero.io/command"
+	podBackupHookOnErrorAnnotationKey       = "hook.backup.velero.io/on-error"
+	podBackupHookTimeoutAnnotationKey       = "hook.backup.velero.io/timeout"
+	podBackupHookRetriesAnnotationKey       = "hook.backup.velero.io/retries"
+	podBackupHookRetryIntervalAnnotationKey = "hook.backup.velero.io/retry-interval"
 
 	// Restore hook annotations
 	podRestoreHookContainerAnnotationKey            = "post.hook.restore.velero.io/container"
@@ -302,7 +305,7 @@ func getHookAnnotation(annotations map[string]string, key string, phase hookPhas
 
 // getPodExecHookFromAnnotations returns an ExecHook based on the annotations, as long as the
 // 'command' annotation is present. If it is absent, this returns nil.
-// If there is an error in parsing a supplied timeout, it is logged.
+// If there is an error in parsing a supplied timeout, retries or retry interval, it is logged.
 func getPodExecHookFromAnnotations(annotations map[string]string, phase hookPhase, log logrus.FieldLogger) *velerov1api.ExecHook {
 	commandValue := getHookAnnotation(annotations, podBackupHookCommandAnnotationKey, phase)
 	if commandValue == "" {
@@ -326,11 +329,33 @@ func getPodExecHookFromAnnotations(annotations map[string]string, phase hookPhas
 		}
 	}
 
+	var retries int
+	retriesString := getHookAnnotation(annotations, podBackupHookRetriesAnnotationKey, phase)
+	if retriesString != "" {
+		if temp, err := strconv.Atoi(retriesString); err == nil && temp >= 0 {
+			retries = temp
+		} else {
+			log.Warnf("Unable to parse provided retries %s, not retrying", retriesString)
+		}
+	}
+
+	var retryInterval time.Duration
+	retryIntervalString := getHookAnnotation(annotations, podBackupHookRetryIntervalAnnotationKey, phase)
+	if retryIntervalString != "" {
+		if temp, err := time.ParseDuration(retryIntervalString); err == nil {
+			retryInterval = temp
+		} else {
+			log.Warn(errors.Wrapf(err, "Unable to parse provided retry interval %s, ignoring", retryIntervalString))
+		}
+	}
+
 	return &velerov1api.ExecHook{
-		Container: container,
-		Command:   parseStringToCommand(commandValue),
-		OnError:   onError,
-		Timeout:   metav1.Duration{Duration: timeout},
+		Container:     container,
+		Command:       parseStringToCommand(commandValue),
+		OnError:       onError,
+		Timeout:       metav1.Duration{Duration: timeout},
+		Retries:       retries,
+		RetryInterval: metav1.Duration{Duration: retryInterval},
 	}
 }
 
@@ -571,6 +596,45 @@ func GroupRestoreExecHooks(
 	return byContainer, nil
 }
 
+// GroupRestoreHostHooks returns the applicable host hooks from the restore resource to be executed
+// on the volumes of a pod, grouped by volume name.
+func GroupRestoreHostHooks(
+	resourceRestoreHooks []ResourceRestoreHook,
+	pod *corev1api.Pod,
+) (map[string][]velerov1api.HostRestoreHook, error) {
+	byVolume := map[string][]velerov1api.HostRestoreHook{}
+
+	if pod == nil || len(pod.Spec.Volumes) == 0 {
+		return byVolume, nil
+	}
+	metadata, err := meta.Accessor(pod)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	labels := metadata.GetLabels()
+	namespace := metadata.GetNamespace()
+	for _, rrh := range resourceRestoreHooks {
+		if !rrh.Selector.applicableTo(kuberesource.Pods, namespace, labels) {
+			continue
+		}
+		for _, rh := range rrh.RestoreHooks {
+			if rh.Host == nil {
+				continue
+			}
+			volumes := sets.NewString(rh.Host.Volumes...)
+			for _, volume := range pod.Spec.Volumes {
+				if volumes.Len() > 0 && !volumes.Has(volume.Name) {
+					continue
+				}
+				byVolume[volume.Name] = append(byVolume[volume.Name], *rh.Host)
+			}
+		}
+	}
+
+	return byVolume, nil
+}
+
 // ValidateContainer validate whether a map contains mandatory k8s Container fields.
 // mandatory fields include name, image and commands.
 func ValidateContainer(raw []byte) error {
@@ -579,6 +579,30 @@ func TestGetPodExecHookFromAnnotations(t *testing.T) {
 					Command: []string{"/usr/bin/foo"},
 				},
 			},
+			{
+				name: "use the specified retries and retry interval",
+				annotations: map[string]string{
+					phasedKey(phase, podBackupHookCommandAnnotationKey):       "/usr/bin/foo",
+					phasedKey(phase, podBackupHookRetriesAnnotationKey):       "3",
+					phasedKey(phase, podBackupHookRetryIntervalAnnotationKey): "10s",
+				},
+				expectedHook: &velerov1api.ExecHook{
+					Command:       []string{"/usr/bin/foo"},
+					Retries:       3,
+					RetryInterval: metav1.Duration{Duration: 10 * time.Second},
+				},
+			},
+			{
+				name: "invalid retries and retry interval are logged",
+				annotations: map[string]string{
+					phasedKey(phase, podBackupHookCommandAnnotationKey):       "/usr/bin/foo",
+					phasedKey(phase, podBackupHookRetriesAnnotationKey):       "-1",
+					phasedKey(phase, podBackupHookRetryIntervalAnnotationKey): "invalid",
+				},
+				expectedHook: &velerov1api.ExecHook{
+					Command: []string{"/usr/bin/foo"},
+				},
+			},
 			{
 				name: "use the specified container",
 				annotations: map[string]string{
@@ -1201,6 +1225,73 @@ func TestGroupRestoreExecHooks(t *testing.T) {
 	}
 }
 
+func TestGroupRestoreHostHooks(t *testing.T) {
+	chown := velerov1api.HostRestoreHook{
+		Command: []string{"/bin/chown", "-R", "1000:1000", "/"},
+	}
+	relabel := velerov1api.HostRestoreHook{
+		Volumes: []string{"volume2"},
+		Command: []string{"/sbin/restorecon", "-R", "/"},
+		OnError: velerov1api.HookErrorModeContinue,
+	}
+
+	testCases := []struct {
+		name                 string
+		resourceRestoreHooks []ResourceRestoreHook
+		pod                  *corev1api.Pod
+		expected             map[string][]velerov1api.HostRestoreHook
+	}{
+		{
+			name:     "should return empty map when no spec hooks are set",
+			pod:      builder.ForPod("default", "my-pod").Volumes(builder.ForVolume("volume1").Result()).Result(),
+			expected: map[string][]velerov1api.HostRestoreHook{},
+		},
+		{
+			name: "should return empty map when pod has no volumes",
+			resourceRestoreHooks: []ResourceRestoreHook{
+				{
+					Name:         "hook1",
+					RestoreHooks: []velerov1api.RestoreResourceHook{{Host: &chown}},
+				},
+			},
+			pod:      builder.ForPod("default", "my-pod").Result(),
+			expected: map[string][]velerov1api.HostRestoreHook{},
+		},
+		{
+			name: "should group the applicable host hooks by volume",
+			resourceRestoreHooks: []ResourceRestoreHook{
+				{
+					Name: "hook1",
+					RestoreHooks: []velerov1api.RestoreResourceHook{
+						{Host: &chown},
+						{Exec: &velerov1api.ExecRestoreHook{Command: []string{"/usr/bin/foo"}}},
+						{Host: &relabel},
+					},
+				},
+				{
+					Name:         "namespace mismatch",
+					Selector:     ResourceHookSelector{Namespaces: collections.NewIncludesExcludes().Excludes("default")},
+					RestoreHooks: []velerov1api.RestoreResourceHook{{Host: &chown}},
+				},
+			},
+			pod: builder.ForPod("default", "my-pod").
+				Volumes(builder.ForVolume("volume1").Result(), builder.ForVolume("volume2").Result()).
+				Result(),
+			expected: map[string][]velerov1api.HostRestoreHook{
+				"volume1": {chown},
+				"volume2": {chown, relabel},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := GroupRestoreHostHooks(tc.resourceRestoreHooks, tc.pod)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
 func TestGetInitContainerFromAnnotations(t *testing.T) {
 	testCases := []struct {
 		name             string
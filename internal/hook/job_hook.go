@@ -0,0 +1,216 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// RestoreJobHookAction is the name of the action of the restore item operations which track the
+// post-restore job hooks. They're tracked by checking the Jobs instead of calling a plugin.
+const RestoreJobHookAction = "velero.io/restore-job-hook"
+
+const (
+	defaultJobHookTimeout = 10 * time.Minute
+	jobHookPollInterval   = time.Second
+	jobHookContainerName  = "hook"
+)
+
+// JobHookTimeout returns the timeout of the job hook.
+func JobHookTimeout(hook *velerov1api.RestoreJobHook) time.Duration {
+	if hook.Timeout.Duration > 0 {
+		return hook.Timeout.Duration
+	}
+	return defaultJobHookTimeout
+}
+
+// NewRestoreJobHookJob returns the Job running the job hook of the restore.
+func NewRestoreJobHookJob(restore *velerov1api.Restore, hook *velerov1api.RestoreJobHook) *batchv1api.Job {
+	// the Job isn't retried, the hook fails on the first failure of the command
+	backoffLimit := int32(0)
+	activeDeadlineSeconds := int64(JobHookTimeout(hook).Seconds())
+
+	return &batchv1api.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: hook.Namespace,
+			Name:      label.GetValidName(fmt.Sprintf("%s-%s", restore.Name, hook.Name)),
+			Labels: map[string]string{
+				velerov1api.RestoreNameLabel: label.GetValidName(restore.Name),
+				velerov1api.RestoreUIDLabel:  string(restore.UID),
+			},
+		},
+		Spec: batchv1api.JobSpec{
+			BackoffLimit:          &backoffLimit,
+			ActiveDeadlineSeconds: &activeDeadlineSeconds,
+			Template: corev1api.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						velerov1api.RestoreNameLabel: label.GetValidName(restore.Name),
+					},
+				},
+				Spec: corev1api.PodSpec{
+					RestartPolicy:      corev1api.RestartPolicyNever,
+					ServiceAccountName: hook.ServiceAccountName,
+					Containers: []corev1api.Container{
+						{
+							Name:    jobHookContainerName,
+							Image:   hook.Image,
+							Command: hook.Command,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// CreateRestoreJobHookJob creates the Job of the job hook of the restore, the Job created by a
+// previous attempt of the restore is reused.
+func CreateRestoreJobHookJob(ctx context.Context, client crclient.Client, restore *velerov1api.Restore, hook *velerov1api.RestoreJobHook) (*batchv1api.Job, error) {
+	job := NewRestoreJobHookJob(restore, hook)
+	if err := client.Create(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+		return nil, errors.Wrapf(err, "error creating job %s/%s of hook %s", job.Namespace, job.Name, hook.Name)
+	}
+	return job, nil
+}
+
+// RunRestoreJobHook runs the job hook of the restore and waits for its Job to finish, an error is
+// returned if the Job fails or doesn't finish within the timeout of the hook.
+func RunRestoreJobHook(ctx context.Context, client crclient.Client, restore *velerov1api.Restore, hook *velerov1api.RestoreJobHook, log logrus.FieldLogger) error {
+	job, err := CreateRestoreJobHookJob(ctx, client, restore, hook)
+	if err != nil {
+		return err
+	}
+	log = log.WithFields(logrus.Fields{"hookName": hook.Name, "job": job.Namespace + "/" + job.Name})
+	log.Info("Waiting for the job of the restore hook to finish")
+
+	var failure string
+	err = wait.PollImmediate(jobHookPollInterval, JobHookTimeout(hook), func() (bool, error) {
+		if err := client.Get(ctx, crclient.ObjectKeyFromObject(job), job); err != nil {
+			return false, errors.Wrapf(err, "error getting job %s/%s", job.Namespace, job.Name)
+		}
+		var completed bool
+		completed, failure = jobHookStatus(job)
+		return completed, nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error waiting for job %s/%s of hook %s", job.Namespace, job.Name, hook.Name)
+	}
+	if failure != "" {
+		return errors.Errorf("job %s/%s of hook %s failed: %s", job.Namespace, job.Name, hook.Name, failure)
+	}
+
+	log.Info("The job of the restore hook completed")
+	return nil
+}
+
+// jobHookStatus returns whether the Job of a job hook is finished, and the reason of its failure
+// if it failed.
+func jobHookStatus(job *batchv1api.Job) (bool, string) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1api.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1api.JobComplete:
+			return true, ""
+		case batchv1api.JobFailed:
+			return true, fmt.Sprintf("%s: %s", condition.Reason, condition.Message)
+		}
+	}
+	return false, ""
+}
+
+// RestoreJobHookOperationID returns the ID of the restore item operation tracking the Job.
+func RestoreJobHookOperationID(job *batchv1api.Job) string {
+	return job.Namespace + "/" + job.Name
+}
+
+// RestoreJobHookTracker tracks the Jobs of the post-restore job hooks as the restore item
+// operations, in place of the RestoreItemAction plugins tracking the other operations.
+type RestoreJobHookTracker struct {
+	client crclient.Client
+}
+
+// NewRestoreJobHookTracker creates a new RestoreJobHookTracker.
+func NewRestoreJobHookTracker(client crclient.Client) *RestoreJobHookTracker {
+	return &RestoreJobHookTracker{client: client}
+}
+
+// Progress returns the progress of the Job tracked by the operation.
+func (t *RestoreJobHookTracker) Progress(operationID string, restore *velerov1api.Restore) (velero.OperationProgress, error) {
+	job, err := t.getJob(operationID)
+	if err != nil {
+		return velero.OperationProgress{}, err
+	}
+
+	progress := velero.OperationProgress{NTotal: 1, Description: "Running", Updated: time.Now()}
+	if job.Status.StartTime != nil {
+		progress.Started = job.Status.StartTime.Time
+	}
+	if completed, failure := jobHookStatus(job); completed {
+		progress.Completed = true
+		progress.NCompleted = 1
+		progress.Description = "Completed"
+		if failure != "" {
+			progress.Description = "Failed"
+			progress.Err = fmt.Sprintf("job %s/%s failed: %s", job.Namespace, job.Name, failure)
+		}
+	}
+	return progress, nil
+}
+
+// Cancel deletes the Job tracked by the operation.
+func (t *RestoreJobHookTracker) Cancel(operationID string, restore *velerov1api.Restore) error {
+	job, err := t.getJob(operationID)
+	if err != nil {
+		return err
+	}
+	if err := t.client.Delete(context.Background(), job, crclient.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error deleting job %s/%s", job.Namespace, job.Name)
+	}
+	return nil
+}
+
+func (t *RestoreJobHookTracker) getJob(operationID string) (*batchv1api.Job, error) {
+	namespace, name, ok := strings.Cut(operationID, "/")
+	if !ok || namespace == "" || name == "" {
+		return nil, errors.Errorf("invalid operation ID %q of restore job hook", operationID)
+	}
+
+	job := &batchv1api.Job{}
+	if err := t.client.Get(context.Background(), crclient.ObjectKey{Namespace: namespace, Name: name}, job); err != nil {
+		return nil, errors.Wrapf(err, "error getting job %s/%s", namespace, name)
+	}
+	return job, nil
+}
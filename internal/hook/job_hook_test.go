@@ -0,0 +1,107 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestNewRestoreJobHookJob(t *testing.T) {
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ObjectMeta(builder.WithUID("uid-1")).Result()
+	jobHook := &velerov1api.RestoreJobHook{
+		Name:               "hook-1",
+		Phase:              velerov1api.RestoreJobHookPhasePre,
+		Namespace:          "ns-1",
+		ServiceAccountName: "sa-1",
+		Image:              "busybox",
+		Command:            []string{"/bin/sh", "-c", "echo hello"},
+	}
+
+	job := NewRestoreJobHookJob(restore, jobHook)
+	assert.Equal(t, "ns-1", job.Namespace)
+	assert.Equal(t, "restore-1-hook-1", job.Name)
+	assert.Equal(t, "restore-1", job.Labels[velerov1api.RestoreNameLabel])
+	assert.Equal(t, "uid-1", job.Labels[velerov1api.RestoreUIDLabel])
+	assert.Equal(t, int32(0), *job.Spec.BackoffLimit)
+	assert.Equal(t, int64(defaultJobHookTimeout.Seconds()), *job.Spec.ActiveDeadlineSeconds)
+	assert.Equal(t, corev1api.RestartPolicyNever, job.Spec.Template.Spec.RestartPolicy)
+	assert.Equal(t, "sa-1", job.Spec.Template.Spec.ServiceAccountName)
+	require.Len(t, job.Spec.Template.Spec.Containers, 1)
+	assert.Equal(t, "busybox", job.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, jobHook.Command, job.Spec.Template.Spec.Containers[0].Command)
+
+	jobHook.Timeout = metav1.Duration{Duration: time.Minute}
+	job = NewRestoreJobHookJob(restore, jobHook)
+	assert.Equal(t, int64(60), *job.Spec.ActiveDeadlineSeconds)
+}
+
+func TestRestoreJobHookTracker(t *testing.T) {
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result()
+	jobHook := &velerov1api.RestoreJobHook{Name: "hook-1", Namespace: "ns-1", Image: "busybox", Command: []string{"/bin/true"}}
+
+	client := velerotest.NewFakeControllerRuntimeClient(t)
+	job, err := CreateRestoreJobHookJob(context.Background(), client, restore, jobHook)
+	require.NoError(t, err)
+	// the Job created by a previous attempt is reused
+	_, err = CreateRestoreJobHookJob(context.Background(), client, restore, jobHook)
+	require.NoError(t, err)
+
+	tracker := NewRestoreJobHookTracker(client)
+	operationID := RestoreJobHookOperationID(job)
+	assert.Equal(t, "ns-1/restore-1-hook-1", operationID)
+
+	progress, err := tracker.Progress(operationID, restore)
+	require.NoError(t, err)
+	assert.False(t, progress.Completed)
+	assert.Equal(t, "Running", progress.Description)
+
+	job.Status.Conditions = []batchv1api.JobCondition{{Type: batchv1api.JobFailed, Status: corev1api.ConditionTrue, Reason: "BackoffLimitExceeded", Message: "Job has reached the specified backoff limit"}}
+	require.NoError(t, client.Update(context.Background(), job))
+	progress, err = tracker.Progress(operationID, restore)
+	require.NoError(t, err)
+	assert.True(t, progress.Completed)
+	assert.Equal(t, "job ns-1/restore-1-hook-1 failed: BackoffLimitExceeded: Job has reached the specified backoff limit", progress.Err)
+
+	job.Status.Conditions = []batchv1api.JobCondition{{Type: batchv1api.JobComplete, Status: corev1api.ConditionTrue}}
+	require.NoError(t, client.Update(context.Background(), job))
+	progress, err = tracker.Progress(operationID, restore)
+	require.NoError(t, err)
+	assert.True(t, progress.Completed)
+	assert.Empty(t, progress.Err)
+	assert.Equal(t, 1, int(progress.NCompleted))
+
+	require.NoError(t, tracker.Cancel(operationID, restore))
+	err = client.Get(context.Background(), crclient.ObjectKeyFromObject(job), &batchv1api.Job{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	_, err = tracker.Progress("restore-1-hook-1", restore)
+	assert.Error(t, err)
+}
@@ -0,0 +1,252 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package resourcemodifiers
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const defaultLookupPath = "/metadata/name"
+
+var (
+	lookupName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	// lookupReference matches the references to the looked up values in the patches, e.g. "{{ default-storage-class }}"
+	lookupReference = regexp.MustCompile(`\{\{\s*([a-zA-Z0-9_-]+)\s*\}\}`)
+)
+
+// ClusterLookup looks up a value from the objects in the cluster the resources are restored into.
+// The rule is applied only if the value is found, and the value can be referenced in the patches
+// of the rule by "{{ name }}".
+type ClusterLookup struct {
+	Name          string                `json:"name"`
+	GroupResource string                `json:"groupResource"`
+	Namespace     string                `json:"namespace,omitempty"`
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	Matches       []MatchRule           `json:"matches,omitempty"`
+	// Path is the JSON pointer of the value in the object, defaults to "/metadata/name"
+	Path string `json:"path,omitempty"`
+}
+
+// ClusterLister lists the objects of a group resource, e.g. "storageclasses.storage.k8s.io",
+// from the cluster the resources are restored into.
+type ClusterLister interface {
+	List(groupResource, namespace string, selector labels.Selector) ([]unstructured.Unstructured, error)
+}
+
+// lookup returns the value of the first object matching the lookup ordered by namespace and
+// name, false is returned if no object matches or the value doesn't exist in the object.
+func (l *ClusterLookup) lookup(lister ClusterLister) (string, bool, error) {
+	selector := labels.Everything()
+	if l.LabelSelector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(l.LabelSelector); err != nil {
+			return "", false, errors.Errorf("error in creating label selector of lookup %s: %s", l.Name, err.Error())
+		}
+	}
+
+	objs, err := lister.List(l.GroupResource, l.Namespace, selector)
+	if err != nil {
+		return "", false, errors.Wrapf(err, "error listing %s for lookup %s", l.GroupResource, l.Name)
+	}
+
+	sort.Slice(objs, func(i, j int) bool {
+		if objs[i].GetNamespace() != objs[j].GetNamespace() {
+			return objs[i].GetNamespace() < objs[j].GetNamespace()
+		}
+		return objs[i].GetName() < objs[j].GetName()
+	})
+
+	path := l.Path
+	if path == "" {
+		path = defaultLookupPath
+	}
+
+	for i := range objs {
+		match, err := l.matches(&objs[i])
+		if err != nil {
+			return "", false, errors.Wrapf(err, "error matching %s for lookup %s", l.GroupResource, l.Name)
+		}
+		if !match {
+			continue
+		}
+
+		value, found, err := valueAtPath(objs[i].Object, path)
+		if err != nil {
+			return "", false, errors.Wrapf(err, "error getting value of lookup %s", l.Name)
+		}
+		if found {
+			return value, true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// matches returns true if the values at the paths of the match rules equal to the values of the
+// rules, the values other than strings are compared in JSON, so "true" matches both the string
+// and the boolean.
+func (l *ClusterLookup) matches(obj *unstructured.Unstructured) (bool, error) {
+	for _, rule := range l.Matches {
+		value, found, err := valueAtPath(obj.Object, rule.Path)
+		if err != nil {
+			return false, err
+		}
+		if !found || value != rule.Value {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// valueAtPath returns the value at the JSON pointer in the object, the values other than strings
+// are returned in JSON.
+func valueAtPath(obj map[string]interface{}, path string) (string, bool, error) {
+	if !strings.HasPrefix(path, "/") {
+		return "", false, errors.Errorf("invalid path %s, it must start with /", path)
+	}
+
+	var current interface{} = obj
+	for _, token := range strings.Split(path[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		switch node := current.(type) {
+		case map[string]interface{}:
+			value, ok := node[token]
+			if !ok {
+				return "", false, nil
+			}
+			current = value
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(node) {
+				return "", false, nil
+			}
+			current = node[index]
+		default:
+			return "", false, nil
+		}
+	}
+
+	if value, ok := current.(string); ok {
+		return value, true, nil
+	}
+
+	data, err := json.Marshal(current)
+	if err != nil {
+		return "", false, errors.WithStack(err)
+	}
+	return string(data), true, nil
+}
+
+// lookupValues looks up the values of the lookups, false is returned if any of the values isn't found.
+func lookupValues(lookups []ClusterLookup, lister ClusterLister, log logrus.FieldLogger) (map[string]string, bool, error) {
+	if len(lookups) == 0 {
+		return nil, true, nil
+	}
+	if lister == nil {
+		return nil, false, fmt.Errorf("cluster lookups aren't supported")
+	}
+
+	values := map[string]string{}
+	for i := range lookups {
+		value, found, err := lookups[i].lookup(lister)
+		if err != nil {
+			return nil, false, err
+		}
+		if !found {
+			log.Infof("No value is found for lookup %s", lookups[i].Name)
+			return nil, false, nil
+		}
+		values[lookups[i].Name] = value
+	}
+
+	return values, true, nil
+}
+
+// substitute replaces the references to the looked up values in the data.
+func substitute(data string, values map[string]string) string {
+	if len(values) == 0 {
+		return data
+	}
+
+	return lookupReference.ReplaceAllStringFunc(data, func(reference string) string {
+		if value, ok := values[lookupReference.FindStringSubmatch(reference)[1]]; ok {
+			return value
+		}
+		return reference
+	})
+}
+
+// withLookupValues returns a copy of the rule whose patches reference the looked up values.
+func (r *ResourceModifierRule) withLookupValues(values map[string]string) *ResourceModifierRule {
+	if len(values) == 0 {
+		return r
+	}
+
+	rule := *r
+	rule.Patches = nil
+	for _, patch := range r.Patches {
+		patch.From = substitute(patch.From, values)
+		patch.Path = substitute(patch.Path, values)
+		patch.Value = substitute(patch.Value, values)
+		rule.Patches = append(rule.Patches, patch)
+	}
+	rule.MergePatches = nil
+	for _, patch := range r.MergePatches {
+		patch.PatchData = substitute(patch.PatchData, values)
+		rule.MergePatches = append(rule.MergePatches, patch)
+	}
+	rule.StrategicPatches = nil
+	for _, patch := range r.StrategicPatches {
+		patch.PatchData = substitute(patch.PatchData, values)
+		rule.StrategicPatches = append(rule.StrategicPatches, patch)
+	}
+
+	return &rule
+}
+
+// references returns the names of the looked up values referenced in the patches of the rule.
+func (r *ResourceModifierRule) references() []string {
+	var data []string
+	for _, patch := range r.Patches {
+		data = append(data, patch.From, patch.Path, patch.Value)
+	}
+	for _, patch := range r.MergePatches {
+		data = append(data, patch.PatchData)
+	}
+	for _, patch := range r.StrategicPatches {
+		data = append(data, patch.PatchData)
+	}
+
+	var names []string
+	for _, d := range data {
+		for _, match := range lookupReference.FindAllStringSubmatch(d, -1) {
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
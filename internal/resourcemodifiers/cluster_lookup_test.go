@@ -0,0 +1,226 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package resourcemodifiers
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+type fakeClusterLister struct {
+	objects map[string][]unstructured.Unstructured
+}
+
+func (l *fakeClusterLister) List(groupResource, namespace string, selector labels.Selector) ([]unstructured.Unstructured, error) {
+	var objects []unstructured.Unstructured
+	for _, obj := range l.objects[groupResource] {
+		if (namespace == "" || obj.GetNamespace() == namespace) && selector.Matches(labels.Set(obj.GetLabels())) {
+			objects = append(objects, obj)
+		}
+	}
+	return objects, nil
+}
+
+func storageClass(name string, annotations map[string]interface{}) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion":  "storage.k8s.io/v1",
+		"kind":        "StorageClass",
+		"metadata":    map[string]interface{}{"name": name, "annotations": annotations},
+		"provisioner": "ebs.csi.aws.com",
+	}}
+}
+
+func pvc(storageClassName string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "PersistentVolumeClaim",
+		"metadata":   map[string]interface{}{"name": "pvc-1", "namespace": "foo"},
+		"spec":       map[string]interface{}{"storageClassName": storageClassName},
+	}}
+}
+
+func TestApplyResourceModifierRulesWithClusterLookups(t *testing.T) {
+	defaultClassLookup := ClusterLookup{
+		Name:          "default-class",
+		GroupResource: "storageclasses.storage.k8s.io",
+		Matches:       []MatchRule{{Path: "/metadata/annotations/storageclass.kubernetes.io~1is-default-class", Value: "true"}},
+	}
+
+	tests := []struct {
+		name     string
+		rule     ResourceModifierRule
+		lister   ClusterLister
+		expected string
+		wantErr  string
+	}{
+		{
+			name: "json patch references the looked up value",
+			rule: ResourceModifierRule{
+				Conditions: Conditions{GroupResource: "persistentvolumeclaims", ClusterLookups: []ClusterLookup{defaultClassLookup}},
+				Patches:    []JSONPatch{{Operation: "replace", Path: "/spec/storageClassName", Value: "{{ default-class }}"}},
+			},
+			lister: &fakeClusterLister{objects: map[string][]unstructured.Unstructured{
+				"storageclasses.storage.k8s.io": {
+					storageClass("standard", nil),
+					storageClass("gp3", map[string]interface{}{"storageclass.kubernetes.io/is-default-class": "true"}),
+				},
+			}},
+			expected: "gp3",
+		},
+		{
+			name: "merge patch references the value at the path",
+			rule: ResourceModifierRule{
+				Conditions: Conditions{GroupResource: "persistentvolumeclaims", ClusterLookups: []ClusterLookup{
+					{Name: "provisioner", GroupResource: "storageclasses.storage.k8s.io", Path: "/provisioner"},
+				}},
+				MergePatches: []JSONMergePatch{{PatchData: "spec:\n  storageClassName: \"{{provisioner}}\"\n"}},
+			},
+			lister: &fakeClusterLister{objects: map[string][]unstructured.Unstructured{
+				"storageclasses.storage.k8s.io": {storageClass("standard", nil)},
+			}},
+			expected: "ebs.csi.aws.com",
+		},
+		{
+			name: "rule is skipped if the value isn't found",
+			rule: ResourceModifierRule{
+				Conditions: Conditions{GroupResource: "persistentvolumeclaims", ClusterLookups: []ClusterLookup{defaultClassLookup}},
+				Patches:    []JSONPatch{{Operation: "replace", Path: "/spec/storageClassName", Value: "{{ default-class }}"}},
+			},
+			lister: &fakeClusterLister{objects: map[string][]unstructured.Unstructured{
+				"storageclasses.storage.k8s.io": {storageClass("standard", nil)},
+			}},
+			expected: "standard",
+		},
+		{
+			name: "cluster lookups without lister",
+			rule: ResourceModifierRule{
+				Conditions: Conditions{GroupResource: "persistentvolumeclaims", ClusterLookups: []ClusterLookup{defaultClassLookup}},
+				Patches:    []JSONPatch{{Operation: "replace", Path: "/spec/storageClassName", Value: "{{ default-class }}"}},
+			},
+			expected: "standard",
+			wantErr:  "cluster lookups aren't supported",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &ResourceModifiers{Version: "v1", ResourceModifierRules: []ResourceModifierRule{tt.rule}}
+			require.NoError(t, p.Validate())
+
+			obj := pvc("standard")
+			errs := p.ApplyResourceModifierRules(obj, "persistentvolumeclaims", nil, tt.lister, logrus.New())
+			if tt.wantErr != "" {
+				require.Len(t, errs, 1)
+				assert.EqualError(t, errs[0], tt.wantErr)
+			} else {
+				assert.Empty(t, errs)
+			}
+
+			storageClassName, _, _ := unstructured.NestedString(obj.Object, "spec", "storageClassName")
+			assert.Equal(t, tt.expected, storageClassName)
+		})
+	}
+}
+
+func TestClusterLookupValidate(t *testing.T) {
+	rule := func(lookups []ClusterLookup, value string) ResourceModifierRule {
+		return ResourceModifierRule{
+			Conditions: Conditions{GroupResource: "persistentvolumeclaims", ClusterLookups: lookups},
+			Patches:    []JSONPatch{{Operation: "replace", Path: "/spec/storageClassName", Value: value}},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		rule    ResourceModifierRule
+		wantErr string
+	}{
+		{
+			name: "valid lookup",
+			rule: rule([]ClusterLookup{{Name: "sc", GroupResource: "storageclasses.storage.k8s.io"}}, "{{sc}}"),
+		},
+		{
+			name:    "invalid name",
+			rule:    rule([]ClusterLookup{{Name: "default class", GroupResource: "storageclasses.storage.k8s.io"}}, "premium"),
+			wantErr: `invalid lookup name "default class", it must consist of alphanumeric characters, '-' or '_'`,
+		},
+		{
+			name:    "duplicate name",
+			rule:    rule([]ClusterLookup{{Name: "sc", GroupResource: "storageclasses.storage.k8s.io"}, {Name: "sc", GroupResource: "storageclasses.storage.k8s.io"}}, "premium"),
+			wantErr: "duplicate lookup name sc",
+		},
+		{
+			name:    "empty group resource",
+			rule:    rule([]ClusterLookup{{Name: "sc"}}, "premium"),
+			wantErr: "groupResource of lookup sc cannot be empty",
+		},
+		{
+			name:    "relative path",
+			rule:    rule([]ClusterLookup{{Name: "sc", GroupResource: "storageclasses.storage.k8s.io", Path: "metadata/name"}}, "premium"),
+			wantErr: "path of lookup sc must start with /",
+		},
+		{
+			name:    "undefined reference",
+			rule:    rule([]ClusterLookup{{Name: "sc", GroupResource: "storageclasses.storage.k8s.io"}}, "{{ class }}"),
+			wantErr: "lookup class referenced in patches is not defined",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValueAtPath(t *testing.T) {
+	obj := map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": map[string]interface{}{"example.com/class": "gold"}},
+		"spec":     map[string]interface{}{"ports": []interface{}{map[string]interface{}{"port": int64(80)}}, "default": true},
+	}
+
+	value, found, err := valueAtPath(obj, "/metadata/annotations/example.com~1class")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "gold", value)
+
+	value, found, err = valueAtPath(obj, "/spec/ports/0/port")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "80", value)
+
+	value, found, err = valueAtPath(obj, "/spec/default")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "true", value)
+
+	_, found, err = valueAtPath(obj, "/spec/ports/1/port")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	_, _, err = valueAtPath(obj, "spec")
+	assert.EqualError(t, err, "invalid path spec, it must start with /")
+}
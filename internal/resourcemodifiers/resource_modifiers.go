@@ -49,6 +49,7 @@ type Conditions struct {
 	ResourceNameRegex string                `json:"resourceNameRegex,omitempty"`
 	LabelSelector     *metav1.LabelSelector `json:"labelSelector,omitempty"`
 	Matches           []MatchRule           `json:"matches,omitempty"`
+	ClusterLookups    []ClusterLookup       `json:"clusterLookups,omitempty"`
 }
 
 type ResourceModifierRule struct {
@@ -84,10 +85,12 @@ func GetResourceModifiersFromConfig(cm *v1.ConfigMap) (*ResourceModifiers, error
 	return resModifiers, nil
 }
 
-func (p *ResourceModifiers) ApplyResourceModifierRules(obj *unstructured.Unstructured, groupResource string, scheme *runtime.Scheme, log logrus.FieldLogger) []error {
+// ApplyResourceModifierRules applies the rules matching the object, the lister is used to look
+// up the values of the rules with cluster lookups.
+func (p *ResourceModifiers) ApplyResourceModifierRules(obj *unstructured.Unstructured, groupResource string, scheme *runtime.Scheme, lister ClusterLister, log logrus.FieldLogger) []error {
 	var errs []error
 	for _, rule := range p.ResourceModifierRules {
-		err := rule.apply(obj, groupResource, scheme, log)
+		err := rule.apply(obj, groupResource, scheme, lister, log)
 		if err != nil {
 			errs = append(errs, err)
 		}
@@ -96,7 +99,7 @@ func (p *ResourceModifiers) ApplyResourceModifierRules(obj *unstructured.Unstruc
 	return errs
 }
 
-func (r *ResourceModifierRule) apply(obj *unstructured.Unstructured, groupResource string, scheme *runtime.Scheme, log logrus.FieldLogger) error {
+func (r *ResourceModifierRule) apply(obj *unstructured.Unstructured, groupResource string, scheme *runtime.Scheme, lister ClusterLister, log logrus.FieldLogger) error {
 	ns := obj.GetNamespace()
 	if ns != "" {
 		namespaceInclusion := collections.NewIncludesExcludes().Includes(r.Conditions.Namespaces...)
@@ -143,8 +146,16 @@ func (r *ResourceModifierRule) apply(obj *unstructured.Unstructured, groupResour
 		return nil
 	}
 
+	values, found, err := lookupValues(r.Conditions.ClusterLookups, lister, log)
+	if err != nil {
+		return err
+	} else if !found {
+		log.Info("Cluster lookups do not match, skip it")
+		return nil
+	}
+
 	log.Infof("Applying resource modifier patch on %s/%s", obj.GetNamespace(), obj.GetName())
-	err = r.applyPatch(obj, scheme, log)
+	err = r.withLookupValues(values).applyPatch(obj, scheme, log)
 	if err != nil {
 		return err
 	}
@@ -44,6 +44,18 @@ func (r *ResourceModifierRule) Validate() error {
 			return err
 		}
 	}
+
+	if len(r.Conditions.ClusterLookups) > 0 {
+		names := map[string]bool{}
+		for _, lookup := range r.Conditions.ClusterLookups {
+			names[lookup.Name] = true
+		}
+		for _, name := range r.references() {
+			if !names[name] {
+				return fmt.Errorf("lookup %s referenced in patches is not defined", name)
+			}
+		}
+	}
 	return nil
 }
 
@@ -80,5 +92,34 @@ func (c *Conditions) Validate() error {
 	if c.GroupResource == "" {
 		return fmt.Errorf("groupkResource cannot be empty")
 	}
+
+	names := map[string]bool{}
+	for _, lookup := range c.ClusterLookups {
+		if err := lookup.Validate(); err != nil {
+			return err
+		}
+		if names[lookup.Name] {
+			return fmt.Errorf("duplicate lookup name %s", lookup.Name)
+		}
+		names[lookup.Name] = true
+	}
+	return nil
+}
+
+func (l *ClusterLookup) Validate() error {
+	if !lookupName.MatchString(l.Name) {
+		return fmt.Errorf("invalid lookup name %q, it must consist of alphanumeric characters, '-' or '_'", l.Name)
+	}
+	if l.GroupResource == "" {
+		return fmt.Errorf("groupResource of lookup %s cannot be empty", l.Name)
+	}
+	if l.Path != "" && !strings.HasPrefix(l.Path, "/") {
+		return fmt.Errorf("path of lookup %s must start with /", l.Name)
+	}
+	for _, match := range l.Matches {
+		if match.Path == "" {
+			return fmt.Errorf("path is required for match rule of lookup %s", l.Name)
+		}
+	}
 	return nil
 }
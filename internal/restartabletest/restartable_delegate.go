@@ -46,6 +46,11 @@ func (rp *MockRestartableProcess) ResetIfNeeded() error {
 	return args.Error(0)
 }
 
+func (rp *MockRestartableProcess) ResetIfUnhealthy() error {
+	args := rp.Called()
+	return args.Error(0)
+}
+
 func (rp *MockRestartableProcess) GetByKindAndName(key process.KindAndName) (interface{}, error) {
 	args := rp.Called(key)
 	return args.Get(0), args.Error(1)
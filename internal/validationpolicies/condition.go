@@ -0,0 +1,259 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package validationpolicies
+
+import (
+	"strconv"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// condition is a parsed boolean expression of a validation policy, e.g.
+// "persistentVolumes == 0 || (errors > 0 && !(warnings < 10))". It
+// supports comparisons of the facts and integer literals, which can be
+// combined with "&&", "||", "!" and parentheses.
+type condition interface {
+	eval(facts map[string]int) bool
+}
+
+type comparison struct {
+	op          string
+	left, right operand
+}
+
+type operand struct {
+	fact  string
+	value int
+}
+
+type logical struct {
+	op          string
+	left, right condition
+}
+
+type not struct {
+	cond condition
+}
+
+func (o operand) get(facts map[string]int) int {
+	if o.fact != "" {
+		return facts[o.fact]
+	}
+	return o.value
+}
+
+func (c *comparison) eval(facts map[string]int) bool {
+	left, right := c.left.get(facts), c.right.get(facts)
+	switch c.op {
+	case "==":
+		return left == right
+	case "!=":
+		return left != right
+	case ">":
+		return left > right
+	case ">=":
+		return left >= right
+	case "<":
+		return left < right
+	default:
+		return left <= right
+	}
+}
+
+func (l *logical) eval(facts map[string]int) bool {
+	if l.op == "&&" {
+		return l.left.eval(facts) && l.right.eval(facts)
+	}
+	return l.left.eval(facts) || l.right.eval(facts)
+}
+
+func (n *not) eval(facts map[string]int) bool {
+	return !n.cond.eval(facts)
+}
+
+// parseCondition parses the expression into a condition, all the facts
+// referenced by the expression must be known.
+func parseCondition(expression string) (condition, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, errors.New("empty condition")
+	}
+
+	p := &parser{tokens: tokens}
+	cond, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, errors.Errorf("unexpected %q in condition", p.tokens[p.pos])
+	}
+	return cond, nil
+}
+
+func tokenize(expression string) ([]string, error) {
+	var tokens []string
+	runes := []rune(expression)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, string(runes[start:i]))
+		case r == '(' || r == ')':
+			tokens = append(tokens, string(r))
+			i++
+		default:
+			if i+1 < len(runes) {
+				if op := string(runes[i : i+2]); op == "&&" || op == "||" || op == "==" || op == "!=" || op == ">=" || op == "<=" {
+					tokens = append(tokens, op)
+					i += 2
+					continue
+				}
+			}
+			if r == '!' || r == '>' || r == '<' {
+				tokens = append(tokens, string(r))
+				i++
+				continue
+			}
+			return nil, errors.Errorf("unexpected character %q in condition", r)
+		}
+	}
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *parser) next() string {
+	token := p.peek()
+	p.pos++
+	return token
+}
+
+func (p *parser) parseOr() (condition, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "||" {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logical{op: "||", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (condition, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "&&" {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &logical{op: "&&", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (condition, error) {
+	switch p.peek() {
+	case "!":
+		p.next()
+		cond, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &not{cond: cond}, nil
+	case "(":
+		p.next()
+		cond, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, errors.New("missing \")\" in condition")
+		}
+		return cond, nil
+	default:
+		return p.parseComparison()
+	}
+}
+
+func (p *parser) parseComparison() (condition, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	op := p.next()
+	switch op {
+	case "==", "!=", ">", ">=", "<", "<=":
+	case "":
+		return nil, errors.New("missing comparison operator at the end of condition")
+	default:
+		return nil, errors.Errorf("expected a comparison operator but got %q in condition", op)
+	}
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	return &comparison{op: op, left: left, right: right}, nil
+}
+
+func (p *parser) parseOperand() (operand, error) {
+	token := p.next()
+	if token == "" {
+		return operand{}, errors.New("missing operand at the end of condition")
+	}
+	if unicode.IsDigit([]rune(token)[0]) {
+		value, err := strconv.Atoi(token)
+		if err != nil {
+			return operand{}, errors.Errorf("invalid number %q in condition", token)
+		}
+		return operand{value: value}, nil
+	}
+	if first := []rune(token)[0]; !unicode.IsLetter(first) && first != '_' {
+		return operand{}, errors.Errorf("expected a fact or a number but got %q in condition", token)
+	}
+	if _, ok := knownFacts[token]; !ok {
+		return operand{}, errors.Errorf("unknown fact %q in condition", token)
+	}
+	return operand{fact: token}, nil
+}
@@ -0,0 +1,120 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package validationpolicies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCondition(t *testing.T) {
+	facts := map[string]int{
+		FactPersistentVolumes: 0,
+		FactErrors:            2,
+		FactWarnings:          12,
+	}
+
+	testCases := []struct {
+		name       string
+		expression string
+		expected   bool
+		wantErr    string
+	}{
+		{
+			name:       "equal",
+			expression: "persistentVolumes == 0",
+			expected:   true,
+		},
+		{
+			name:       "not equal",
+			expression: "persistentVolumes != 0",
+			expected:   false,
+		},
+		{
+			name:       "comparisons of facts and numbers in both orders",
+			expression: "errors >= 2 && 10 < warnings && errors <= warnings && !(errors > 2)",
+			expected:   true,
+		},
+		{
+			name:       "and binds tighter than or",
+			expression: "persistentVolumes > 0 && errors > 0 || warnings > 0",
+			expected:   true,
+		},
+		{
+			name:       "parentheses",
+			expression: "persistentVolumes > 0 && (errors > 0 || warnings > 0)",
+			expected:   false,
+		},
+		{
+			name:       "double negation",
+			expression: "!!(errors == 2)",
+			expected:   true,
+		},
+		{
+			name:    "empty condition",
+			wantErr: "empty condition",
+		},
+		{
+			name:       "unknown fact",
+			expression: "volumes == 0",
+			wantErr:    `unknown fact "volumes" in condition`,
+		},
+		{
+			name:       "missing operator",
+			expression: "errors",
+			wantErr:    "missing comparison operator at the end of condition",
+		},
+		{
+			name:       "unsupported operator",
+			expression: "errors = 0",
+			wantErr:    `unexpected character '=' in condition`,
+		},
+		{
+			name:       "missing operand",
+			expression: "errors > 0 &&",
+			wantErr:    "missing operand at the end of condition",
+		},
+		{
+			name:       "operator as operand",
+			expression: "errors > && 0",
+			wantErr:    `expected a fact or a number but got "&&" in condition`,
+		},
+		{
+			name:       "unbalanced parentheses",
+			expression: "(errors > 0",
+			wantErr:    `missing ")" in condition`,
+		},
+		{
+			name:       "trailing token",
+			expression: "errors > 0 warnings",
+			wantErr:    `unexpected "warnings" in condition`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cond, err := parseCondition(tc.expression)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, cond.eval(facts))
+		})
+	}
+}
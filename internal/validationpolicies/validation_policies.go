@@ -0,0 +1,179 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package validationpolicies
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+	v1 "k8s.io/api/core/v1"
+)
+
+type ActionType string
+
+const (
+	// currently only support configmap type of validation policies config
+	ConfigmapRefType string     = "configmap"
+	Fail             ActionType = "fail"
+	Warn             ActionType = "warn"
+
+	currentSupportDataVersion = "v1"
+)
+
+// The facts of a finished backup that can be referenced by the conditions.
+const (
+	FactItemsBackedUp          = "itemsBackedUp"
+	FactPersistentVolumes      = "persistentVolumes"
+	FactPersistentVolumeClaims = "persistentVolumeClaims"
+	FactVolumeSnapshots        = "volumeSnapshots"
+	FactPodVolumeBackups       = "podVolumeBackups"
+	FactEmptyNamespaces        = "emptyNamespaces"
+	FactErrors                 = "errors"
+	FactWarnings               = "warnings"
+)
+
+var knownFacts = map[string]struct{}{
+	FactItemsBackedUp:          {},
+	FactPersistentVolumes:      {},
+	FactPersistentVolumeClaims: {},
+	FactVolumeSnapshots:        {},
+	FactPodVolumeBackups:       {},
+	FactEmptyNamespaces:        {},
+	FactErrors:                 {},
+	FactWarnings:               {},
+}
+
+// Action defined the action taken when the condition of a validation policy is met
+type Action struct {
+	// Type defined specific type of action, currently support 'fail' and 'warn'
+	Type ActionType `yaml:"type"`
+}
+
+// validationPolicy defined a condition checked against a finished backup and the action to take when it is met
+type validationPolicy struct {
+	Name      string `yaml:"name"`
+	Condition string `yaml:"condition"`
+	Action    Action `yaml:"action"`
+	Message   string `yaml:"message,omitempty"`
+}
+
+type validationPolicies struct {
+	Version            string             `yaml:"version"`
+	ValidationPolicies []validationPolicy `yaml:"validationPolicies"`
+}
+
+type policy struct {
+	name      string
+	condition condition
+	action    Action
+	message   string
+}
+
+type Policies struct {
+	version  string
+	policies []policy
+}
+
+// Violations are the validation policies whose conditions are met by a backup
+type Violations struct {
+	// Failures are the violations of the policies with the 'fail' action
+	Failures []string
+	// Warnings are the violations of the policies with the 'warn' action
+	Warnings []string
+}
+
+func GetValidationPoliciesFromConfig(cm *v1.ConfigMap) (*Policies, error) {
+	if cm == nil {
+		return nil, fmt.Errorf("could not parse config from nil configmap")
+	}
+	if len(cm.Data) != 1 {
+		return nil, fmt.Errorf("illegal validation policies %s/%s configmap", cm.Namespace, cm.Name)
+	}
+
+	var yamlData string
+	for _, v := range cm.Data {
+		yamlData = v
+	}
+
+	valPolicies := &validationPolicies{}
+	dec := yaml.NewDecoder(strings.NewReader(yamlData))
+	dec.KnownFields(true)
+	if err := dec.Decode(valPolicies); err != nil {
+		return nil, fmt.Errorf("failed to decode yaml data into validation policies %v", err)
+	}
+
+	policies := &Policies{version: valPolicies.Version}
+	for _, vp := range valPolicies.ValidationPolicies {
+		cond, err := parseCondition(vp.Condition)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the condition of validation policy %q", vp.Name)
+		}
+		policies.policies = append(policies.policies, policy{
+			name:      vp.Name,
+			condition: cond,
+			action:    vp.Action,
+			message:   vp.Message,
+		})
+	}
+
+	return policies, nil
+}
+
+func (p *Policies) Validate() error {
+	if p.version != currentSupportDataVersion {
+		return fmt.Errorf("incompatible version number %s with supported version %s", p.version, currentSupportDataVersion)
+	}
+
+	names := map[string]struct{}{}
+	for _, policy := range p.policies {
+		if policy.name == "" {
+			return errors.New("validation policy must have a name")
+		}
+		if _, ok := names[policy.name]; ok {
+			return errors.Errorf("duplicated validation policy name %q", policy.name)
+		}
+		names[policy.name] = struct{}{}
+
+		if policy.action.Type != Fail && policy.action.Type != Warn {
+			return errors.Errorf("validation policy %q has unsupported action type %q, only %q and %q are supported", policy.name, policy.action.Type, Fail, Warn)
+		}
+	}
+	return nil
+}
+
+// Evaluate checks the facts of a backup against all the policies, and returns
+// the violations of the policies whose conditions are met.
+func (p *Policies) Evaluate(facts map[string]int) Violations {
+	violations := Violations{}
+	for _, policy := range p.policies {
+		if !policy.condition.eval(facts) {
+			continue
+		}
+
+		violation := fmt.Sprintf("validation policy %q is violated", policy.name)
+		if policy.message != "" {
+			violation = fmt.Sprintf("%s: %s", violation, policy.message)
+		}
+		if policy.action.Type == Fail {
+			violations.Failures = append(violations.Failures, violation)
+		} else {
+			violations.Warnings = append(violations.Warnings, violation)
+		}
+	}
+	return violations
+}
@@ -0,0 +1,170 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package validationpolicies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetValidationPoliciesFromConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		yamlData    string
+		wantErr     bool
+		validateErr string
+	}{
+		{
+			name: "valid policies",
+			yamlData: `version: v1
+validationPolicies:
+- name: require-volumes
+  condition: persistentVolumes == 0
+  action:
+    type: fail
+  message: no persistent volumes were backed up
+- name: empty-namespaces
+  condition: emptyNamespaces > 0
+  action:
+    type: warn`,
+		},
+		{
+			name: "unknown key in yaml",
+			yamlData: `version: v1
+validationPolicies:
+- name: require-volumes
+  condition: persistentVolumes == 0
+  unknown: {}
+  action:
+    type: fail`,
+			wantErr: true,
+		},
+		{
+			name: "invalid condition",
+			yamlData: `version: v1
+validationPolicies:
+- name: require-volumes
+  condition: persistentVolumes ==
+  action:
+    type: fail`,
+			wantErr: true,
+		},
+		{
+			name: "incompatible version",
+			yamlData: `version: v2
+validationPolicies: []`,
+			validateErr: "incompatible version number v2 with supported version v1",
+		},
+		{
+			name: "missing name",
+			yamlData: `version: v1
+validationPolicies:
+- condition: persistentVolumes == 0
+  action:
+    type: fail`,
+			validateErr: "validation policy must have a name",
+		},
+		{
+			name: "duplicated name",
+			yamlData: `version: v1
+validationPolicies:
+- name: require-volumes
+  condition: persistentVolumes == 0
+  action:
+    type: fail
+- name: require-volumes
+  condition: persistentVolumeClaims == 0
+  action:
+    type: fail`,
+			validateErr: `duplicated validation policy name "require-volumes"`,
+		},
+		{
+			name: "unsupported action",
+			yamlData: `version: v1
+validationPolicies:
+- name: require-volumes
+  condition: persistentVolumes == 0
+  action:
+    type: skip`,
+			validateErr: `validation policy "require-volumes" has unsupported action type "skip", only "fail" and "warn" are supported`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cm := &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "policies"},
+				Data:       map[string]string{"policies.yaml": tc.yamlData},
+			}
+			policies, err := GetValidationPoliciesFromConfig(cm)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			err = policies.Validate()
+			if tc.validateErr != "" {
+				assert.EqualError(t, err, tc.validateErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetValidationPoliciesFromIllegalConfig(t *testing.T) {
+	_, err := GetValidationPoliciesFromConfig(nil)
+	assert.Error(t, err)
+
+	_, err = GetValidationPoliciesFromConfig(&v1.ConfigMap{Data: map[string]string{"a": "", "b": ""}})
+	assert.Error(t, err)
+}
+
+func TestEvaluate(t *testing.T) {
+	cm := &v1.ConfigMap{
+		Data: map[string]string{"policies.yaml": `version: v1
+validationPolicies:
+- name: require-volumes
+  condition: persistentVolumes == 0
+  action:
+    type: fail
+  message: no persistent volumes were backed up
+- name: empty-namespaces
+  condition: emptyNamespaces > 0
+  action:
+    type: warn
+- name: too-many-warnings
+  condition: warnings > 10
+  action:
+    type: warn`},
+	}
+	policies, err := GetValidationPoliciesFromConfig(cm)
+	require.NoError(t, err)
+	require.NoError(t, policies.Validate())
+
+	violations := policies.Evaluate(map[string]int{FactPersistentVolumes: 0, FactEmptyNamespaces: 1, FactWarnings: 3})
+	assert.Equal(t, []string{`validation policy "require-volumes" is violated: no persistent volumes were backed up`}, violations.Failures)
+	assert.Equal(t, []string{`validation policy "empty-namespaces" is violated`}, violations.Warnings)
+
+	violations = policies.Evaluate(map[string]int{FactPersistentVolumes: 2, FactWarnings: 3})
+	assert.Empty(t, violations.Failures)
+	assert.Empty(t, violations.Warnings)
+}
@@ -27,3 +27,18 @@ type DataMoveOperationProgress struct {
 	// +optional
 	BytesDone int64 `json:"bytesDone,omitempty"`
 }
+
+// UploadStats represents the storage efficiency of the data
+// uploaded by a data movement operation
+
+// +k8s:deepcopy-gen=true
+type UploadStats struct {
+	// LogicalBytes is the size of the data of the volume.
+	// +optional
+	LogicalBytes int64 `json:"logicalBytes,omitempty"`
+
+	// UploadedBytes is the size of the data written to the backup
+	// repository after deduplication and compression.
+	// +optional
+	UploadedBytes int64 `json:"uploadedBytes,omitempty"`
+}
@@ -0,0 +1,75 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupHookPolicySpec defines the hooks of a Velero backup hook policy
+// and the backups they're added to.
+type BackupHookPolicySpec struct {
+	// BackupSelector selects the backups the hooks are added to by their
+	// labels, e.g. the labels copied from the schedules. If empty or nil,
+	// the hooks are added to all backups.
+	// +optional
+	// +nullable
+	BackupSelector *metav1.LabelSelector `json:"backupSelector,omitempty"`
+
+	// Enforced specifies whether the hooks of the policy replace the hooks
+	// of the backups with the same names. If false, the hooks of the backups
+	// take precedence over the ones of the policy.
+	// +optional
+	Enforced bool `json:"enforced,omitempty"`
+
+	// Hooks are the hooks added to the selected backups.
+	// +optional
+	Hooks BackupHooks `json:"hooks,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Enforced",type="boolean",JSONPath=".spec.enforced"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// BackupHookPolicy is a Velero resource that holds the backup hooks
+// added to all the backups selected by it, so that the hooks are
+// mandated centrally instead of being repeated in each Backup and
+// Schedule.
+type BackupHookPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata"`
+
+	// +optional
+	Spec BackupHookPolicySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+// +kubebuilder:object:root=true
+
+// BackupHookPolicyList is a list of BackupHookPolicies.
+type BackupHookPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []BackupHookPolicy `json:"items"`
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package v1
 
 import (
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -41,6 +42,80 @@ type BackupRepositorySpec struct {
 
 	// MaintenanceFrequency is how often maintenance should be run.
 	MaintenanceFrequency metav1.Duration `json:"maintenanceFrequency"`
+
+	// RepositoryCredential is the reference to the secret key in the Velero namespace
+	// holding the password of this repository. If not set, the password shared by all
+	// the repositories is used.
+	// +optional
+	// +nullable
+	RepositoryCredential *corev1api.SecretKeySelector `json:"repositoryCredential,omitempty"`
+
+	// KopiaParameters are the parameters to tune the kopia repository, they are ignored by
+	// the other repository types.
+	// +optional
+	// +nullable
+	KopiaParameters *KopiaRepositoryParameters `json:"kopiaParameters,omitempty"`
+
+	// MaintenanceWindow is the daily time window in which the scheduled maintenance is
+	// allowed to start. If not set, the maintenance starts once it's due.
+	// +optional
+	// +nullable
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// MaintenanceJobResources are the resource requests and limits of the jobs running the
+	// maintenance of this repository. If not set, the jobs have no resource requirements.
+	// +optional
+	// +nullable
+	MaintenanceJobResources *corev1api.ResourceRequirements `json:"maintenanceJobResources,omitempty"`
+
+	// ReadOnly specifies whether the repository is read-only. The restores from a read-only
+	// repository are allowed while the new backups to it are refused, e.g. to keep restoring
+	// from the legacy restic repositories after moving to kopia.
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
+}
+
+// MaintenanceWindow is a daily time window, it spans midnight if the end is before the start.
+type MaintenanceWindow struct {
+	// Start is the time of day when the window opens, in the format of HH:MM.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// End is the time of day when the window closes, in the format of HH:MM.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+
+	// TimeZone is the IANA time zone of the start and end, e.g. "Europe/Berlin".
+	// If not set, UTC is used.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// KopiaRepositoryParameters are the parameters to tune the dedup and CPU tradeoffs of a kopia repository.
+type KopiaRepositoryParameters struct {
+	// SplitterAlgorithm is the algorithm to split the data into chunks, e.g. "DYNAMIC-4M-BUZHASH".
+	// It only takes effect when the repository is created. If not set, the default splitter of kopia is used.
+	// +optional
+	SplitterAlgorithm string `json:"splitterAlgorithm,omitempty"`
+
+	// Compression is the algorithm to compress the data uploaded to the repository, e.g. "zstd-fastest".
+	// If not set, the data is not compressed.
+	// +optional
+	Compression string `json:"compression,omitempty"`
+
+	// CacheLimitMB is the size limit in megabytes of each of the local content and metadata caches
+	// of the repository. It takes effect when the repository is connected. If not set, 2000 is used.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	// +nullable
+	CacheLimitMB *int64 `json:"cacheLimitMB,omitempty"`
+
+	// ParallelUploads is the number of files uploaded in parallel by a pod volume backup.
+	// If not set, the number of CPUs is used.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	// +nullable
+	ParallelUploads *int `json:"parallelUploads,omitempty"`
 }
 
 // BackupRepositoryPhase represents the lifecycle phase of a BackupRepository.
@@ -70,6 +145,94 @@ type BackupRepositoryStatus struct {
 	// +optional
 	// +nullable
 	LastMaintenanceTime *metav1.Time `json:"lastMaintenanceTime,omitempty"`
+
+	// RecentMaintenance is the history of the most recent maintenance runs, the latest last.
+	// +optional
+	RecentMaintenance []BackupRepositoryMaintenanceStatus `json:"recentMaintenance,omitempty"`
+
+	// OrphanScan is the result of the latest scan of the snapshots not referenced by any backup.
+	// +optional
+	// +nullable
+	OrphanScan *BackupRepositoryOrphanScan `json:"orphanScan,omitempty"`
+}
+
+// BackupRepositoryMaintenanceResult represents the result of a repository maintenance.
+// +kubebuilder:validation:Enum=Succeeded;Failed
+type BackupRepositoryMaintenanceResult string
+
+const (
+	BackupRepositoryMaintenanceSucceeded BackupRepositoryMaintenanceResult = "Succeeded"
+	BackupRepositoryMaintenanceFailed    BackupRepositoryMaintenanceResult = "Failed"
+)
+
+// BackupRepositoryMaintenanceStatus is the status of a run of the repository maintenance.
+type BackupRepositoryMaintenanceStatus struct {
+	// Result is the result of the maintenance.
+	// +optional
+	Result BackupRepositoryMaintenanceResult `json:"result,omitempty"`
+
+	// StartTimestamp is the start time of the maintenance.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompleteTimestamp is the completion time of the maintenance.
+	// +optional
+	// +nullable
+	CompleteTimestamp *metav1.Time `json:"completeTimestamp,omitempty"`
+
+	// FreedBytes is the size of the data freed from the storage by the maintenance.
+	// It is not set if the size is unknown.
+	// +optional
+	// +nullable
+	FreedBytes *int64 `json:"freedBytes,omitempty"`
+
+	// Message is the error of the failed maintenance.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// BackupRepositoryOrphanScan is the result of a scan of the snapshots in a repository which
+// aren't referenced by any pod volume backup or data upload, e.g. the snapshots left by the
+// crashed backups.
+type BackupRepositoryOrphanScan struct {
+	// Mode is the mode of the scan, either list or prune.
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// Timestamp is the time the repository was scanned.
+	// +optional
+	// +nullable
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+
+	// OrphanedSnapshots are the orphaned snapshots found by the scan, the snapshots deleted
+	// by a prune scan aren't included.
+	// +optional
+	OrphanedSnapshots []BackupRepositoryOrphanedSnapshot `json:"orphanedSnapshots,omitempty"`
+
+	// PrunedSnapshots is the number of the orphaned snapshots deleted by a prune scan.
+	// +optional
+	PrunedSnapshots int `json:"prunedSnapshots,omitempty"`
+
+	// Message is the error of the failed scan.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// BackupRepositoryOrphanedSnapshot is a snapshot in a repository which isn't referenced by
+// any pod volume backup or data upload.
+type BackupRepositoryOrphanedSnapshot struct {
+	// SnapshotID is the ID of the snapshot.
+	SnapshotID string `json:"snapshotID"`
+
+	// Source is the source of the snapshot.
+	// +optional
+	Source string `json:"source,omitempty"`
+
+	// StartTimestamp is the time the snapshot was written.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
 }
 
 // TODO(2.0) After converting all resources to use the runtime-controller client,
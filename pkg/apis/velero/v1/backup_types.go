@@ -23,6 +23,14 @@ import (
 
 type Metadata struct {
 	Labels map[string]string `json:"labels,omitempty"`
+
+	// NameTemplate is the template of the names of the backups created by a schedule, the
+	// tokens {{schedule}}, {{cluster}}, {{date}}, {{time}} and {{timestamp}} are replaced by
+	// the name of the schedule, the cluster name of the Velero server, and the UTC date (20060102),
+	// time (150405) and timestamp (20060102150405) the backup is created at. It's only used
+	// in the template of schedules, the names default to "<schedule>-<timestamp>" if it's empty.
+	// +optional
+	NameTemplate string `json:"nameTemplate,omitempty"`
 }
 
 // BackupSpec defines the specification for a Velero backup.
@@ -101,6 +109,27 @@ type BackupSpec struct {
 	// +nullable
 	OrLabelSelectors []*metav1.LabelSelector `json:"orLabelSelectors,omitempty"`
 
+	// ClusterScopedLabelSelector is a metav1.LabelSelector to filter the
+	// cluster-scoped resources with. If specified, the cluster-scoped resources
+	// matching it are backed up regardless of the namespace filters, and
+	// LabelSelector and OrLabelSelectors only apply to the namespace-scoped resources.
+	// +optional
+	// +nullable
+	ClusterScopedLabelSelector *metav1.LabelSelector `json:"clusterScopedLabelSelector,omitempty"`
+
+	// ClusterScopedOrLabelSelectors is list of metav1.LabelSelector to filter
+	// the cluster-scoped resources with, joined by the OR operator. It works as
+	// ClusterScopedLabelSelector does, and the two cannot co-exist in backup request.
+	// +optional
+	// +nullable
+	ClusterScopedOrLabelSelectors []*metav1.LabelSelector `json:"clusterScopedOrLabelSelectors,omitempty"`
+
+	// ResourceFilterPolicy is the name of the ResourceFilterPolicy in the
+	// Velero namespace whose filters are applied to the backup. The filters
+	// set in the backup itself take precedence over the ones of the policy.
+	// +optional
+	ResourceFilterPolicy string `json:"resourceFilterPolicy,omitempty"`
+
 	// SnapshotVolumes specifies whether to take snapshots
 	// of any PV's referenced in the set of objects included
 	// in the Backup.
@@ -145,6 +174,13 @@ type BackupSpec struct {
 	// +nullable
 	DefaultVolumesToFsBackup *bool `json:"defaultVolumesToFsBackup,omitempty"`
 
+	// UploaderType specifies the type of the uploader to handle the data transfer
+	// of pod volume file system backups. If it is empty, the uploader type
+	// configured on the Velero server will be used.
+	// +kubebuilder:validation:Enum=restic;kopia
+	// +optional
+	UploaderType string `json:"uploaderType,omitempty"`
+
 	// OrderedResources specifies the backup order of resources of specific Kind.
 	// The map key is the resource name and value is a list of object names separated by commas.
 	// Each resource name has format "namespace/objectname".  For cluster resources, simply use "objectname".
@@ -158,6 +194,12 @@ type BackupSpec struct {
 	// +optional
 	CSISnapshotTimeout metav1.Duration `json:"csiSnapshotTimeout,omitempty"`
 
+	// VolumeGroupSnapshotLabelKey specifies the label key on the PVCs to group them, the CSI snapshots
+	// of the PVCs with the same value of the label in a namespace are taken together by a
+	// VolumeGroupSnapshot. If it is empty, the key configured on the Velero server will be used.
+	// +optional
+	VolumeGroupSnapshotLabelKey string `json:"volumeGroupSnapshotLabelKey,omitempty"`
+
 	// ItemOperationTimeout specifies the time used to wait for asynchronous BackupItemAction operations
 	// The default value is 1 hour.
 	// +optional
@@ -166,6 +208,11 @@ type BackupSpec struct {
 	// +optional
 	ResourcePolicy *v1.TypedLocalObjectReference `json:"resourcePolicy,omitempty"`
 
+	// ValidationPolicy specifies the referenced validation policies that are
+	// checked against the backup when it finishes backing up the items
+	// +optional
+	ValidationPolicy *v1.TypedLocalObjectReference `json:"validationPolicy,omitempty"`
+
 	// SnapshotMoveData specifies whether snapshot data should be moved
 	// +optional
 	// +nullable
@@ -175,6 +222,64 @@ type BackupSpec struct {
 	// If DataMover is "" or "velero", the built-in data mover will be used.
 	// +optional
 	DataMover string `json:"datamover,omitempty"`
+
+	// DryRun specifies whether to only evaluate the resources, persistent volumes and
+	// data size that would be included in the backup without actually backing up anything.
+	// The result is reported in the backup's status.dryRunResult.
+	// +optional
+	// +nullable
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// CompressionAlgorithm specifies the algorithm used to compress the backup tarball.
+	// If it is empty, gzip will be used. The backups compressed with zstd or lz4
+	// can't be restored by older versions of Velero.
+	// +kubebuilder:validation:Enum=gzip;zstd;lz4
+	// +optional
+	CompressionAlgorithm string `json:"compressionAlgorithm,omitempty"`
+
+	// Tiering transitions the backup contents to colder storage classes of the
+	// object storage as the backup ages.
+	// +optional
+	// +nullable
+	Tiering *BackupTiering `json:"tiering,omitempty"`
+
+	// SnapshotMoveReplicaLocation is the name of a second backup storage location the
+	// data mover snapshots of the backup are replicated to after they are uploaded to
+	// the backup repository of the backup's storage location. Only the built-in data
+	// mover replicates the snapshots.
+	// +optional
+	SnapshotMoveReplicaLocation string `json:"snapshotMoveReplicaLocation,omitempty"`
+
+	// DeduplicateClusterResources specifies whether the cluster-scoped items that are
+	// unchanged since the previous backup of the same schedule are recorded as references
+	// to that backup instead of being uploaded again. It only takes effect for the backups
+	// created by a schedule.
+	// +optional
+	// +nullable
+	DeduplicateClusterResources *bool `json:"deduplicateClusterResources,omitempty"`
+}
+
+// BackupTiering defines how the backup contents are transitioned to colder storage
+// classes of the object storage after the backup has completed.
+type BackupTiering struct {
+	// Transitions are the storage classes the backup contents are transitioned to,
+	// the transition with the most days elapsed since the completion of the backup
+	// applies.
+	Transitions []BackupTierTransition `json:"transitions"`
+}
+
+// BackupTierTransition transitions the backup contents to a storage class once the
+// backup has completed for a number of days.
+type BackupTierTransition struct {
+	// Days is the number of days after the completion of the backup when the backup
+	// contents are transitioned.
+	// +kubebuilder:validation:Minimum=0
+	Days int `json:"days"`
+
+	// StorageClass is the storage class of the object storage the backup contents
+	// are transitioned to, e.g. STANDARD_IA or GLACIER for AWS S3, Cool or Archive
+	// for Azure Blob Storage.
+	StorageClass string `json:"storageClass"`
 }
 
 // BackupHooks contains custom behaviors that should be executed at different phases of the backup.
@@ -251,9 +356,20 @@ type ExecHook struct {
 	OnError HookErrorMode `json:"onError,omitempty"`
 
 	// Timeout defines the maximum amount of time Velero should wait for the hook to complete before
-	// considering the execution a failure.
+	// considering the execution a failure. The timeout applies to each attempt of the command in the
+	// container.
 	// +optional
 	Timeout metav1.Duration `json:"timeout,omitempty"`
+
+	// Retries is the number of times Velero retries the hook after a failed or timed out attempt
+	// before considering the execution a failure. If not specified, the hook isn't retried.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Retries int `json:"retries,omitempty"`
+
+	// RetryInterval is the amount of time Velero waits between the attempts of the hook.
+	// +optional
+	RetryInterval metav1.Duration `json:"retryInterval,omitempty"`
 }
 
 // HookErrorMode defines how Velero should treat an error from a hook.
@@ -272,7 +388,7 @@ const (
 
 // BackupPhase is a string representation of the lifecycle phase
 // of a Velero backup.
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Finalizing;FinalizingPartiallyFailed;Completed;PartiallyFailed;Failed;Deleting
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Finalizing;FinalizingPartiallyFailed;Completed;PartiallyFailed;Failed;Deleting;DryRunCompleted
 type BackupPhase string
 
 const (
@@ -333,6 +449,11 @@ const (
 
 	// BackupPhaseDeleting means the backup and all its associated data are being deleted.
 	BackupPhaseDeleting BackupPhase = "Deleting"
+
+	// BackupPhaseDryRunCompleted means the dry-run of the backup has
+	// completed and the result is available in the backup's status.
+	// Nothing is persisted to the backup storage location.
+	BackupPhaseDryRunCompleted BackupPhase = "DryRunCompleted"
 )
 
 // BackupStatus captures the current status of a Velero backup.
@@ -361,6 +482,12 @@ type BackupStatus struct {
 	// +nullable
 	ValidationErrors []string `json:"validationErrors,omitempty"`
 
+	// PolicyViolations is a slice of the validation policies violated
+	// by the backup (if applicable).
+	// +optional
+	// +nullable
+	PolicyViolations []string `json:"policyViolations,omitempty"`
+
 	// StartTimestamp records the time a backup was started.
 	// Separate from CreationTimestamp, since that value changes
 	// on restores.
@@ -403,6 +530,13 @@ type BackupStatus struct {
 	// +optional
 	Errors int `json:"errors,omitempty"`
 
+	// ErrorCategories is a count of the error messages of each category, i.e.
+	// plugin, apiConflict, validation, dataPath, timeout and other, so that
+	// the automation can decide whether a partially failed backup is acceptable.
+	// +optional
+	// +nullable
+	ErrorCategories map[string]int `json:"errorCategories,omitempty"`
+
 	// Progress contains information about the backup's execution progress. Note
 	// that this information is best-effort only -- if Velero fails to update it
 	// during a backup for any reason, it may be inaccurate/stale.
@@ -434,6 +568,159 @@ type BackupStatus struct {
 	// BackupItemAction operations for this backup which ended with an error.
 	// +optional
 	BackupItemOperationsFailed int `json:"backupItemOperationsFailed,omitempty"`
+
+	// ClusterResourcesDeduplicated is the number of cluster-scoped items that were
+	// unchanged since the previous backup of the schedule, so they are referenced
+	// from the earlier backups instead of being uploaded again.
+	// +optional
+	ClusterResourcesDeduplicated int `json:"clusterResourcesDeduplicated,omitempty"`
+
+	// DryRunResult is the result of the backup's dry-run.
+	// +optional
+	// +nullable
+	DryRunResult *BackupDryRunResult `json:"dryRunResult,omitempty"`
+
+	// EncryptionKeyID is the ID of the key that encrypted the backup data on the client side
+	// before it was uploaded to the backup storage location. Empty means the backup data isn't
+	// encrypted.
+	// +optional
+	EncryptionKeyID string `json:"encryptionKeyID,omitempty"`
+
+	// VolumeVerifications are the results of the latest integrity verifications of the
+	// data mover snapshots of the backup's volumes, one per volume.
+	// +optional
+	// +nullable
+	VolumeVerifications []BackupVolumeVerification `json:"volumeVerifications,omitempty"`
+
+	// StorageClass is the storage class of the object storage the backup contents
+	// are transitioned to by the tiering. Empty means the backup contents are in the
+	// default storage class of the backup storage location.
+	// +optional
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// StorageClassTransitionTimestamp records the time the backup contents were
+	// transitioned to the storage class.
+	// +optional
+	// +nullable
+	StorageClassTransitionTimestamp *metav1.Time `json:"storageClassTransitionTimestamp,omitempty"`
+
+	// VolumeReplications are the results of replicating the data mover snapshots of the
+	// backup's volumes to the snapshot move replica location, one per volume.
+	// +optional
+	// +nullable
+	VolumeReplications []BackupVolumeReplication `json:"volumeReplications,omitempty"`
+
+	// HookPolicies are the names of the backup hook policies whose hooks are
+	// added to the backup.
+	// +optional
+	// +nullable
+	HookPolicies []string `json:"hookPolicies,omitempty"`
+}
+
+// BackupVolumeReplicationPhase is the result of replicating the data mover snapshot of a volume.
+// +kubebuilder:validation:Enum=Replicated;Failed
+type BackupVolumeReplicationPhase string
+
+const (
+	// BackupVolumeReplicationPhaseReplicated means the snapshot is replicated.
+	BackupVolumeReplicationPhaseReplicated BackupVolumeReplicationPhase = "Replicated"
+
+	// BackupVolumeReplicationPhaseFailed means the snapshot couldn't be replicated.
+	BackupVolumeReplicationPhaseFailed BackupVolumeReplicationPhase = "Failed"
+)
+
+// BackupVolumeReplication is the result of replicating the data mover snapshot of a volume
+// of a backup to the snapshot move replica location.
+type BackupVolumeReplication struct {
+	// DataUpload is the name of the DataUpload which took the snapshot.
+	DataUpload string `json:"dataUpload"`
+
+	// PVC is the namespace/name of the PVC which the snapshot is taken for.
+	PVC string `json:"pvc"`
+
+	// BackupStorageLocation is the name of the backup storage location the snapshot
+	// is replicated to.
+	BackupStorageLocation string `json:"backupStorageLocation"`
+
+	// SnapshotID is the ID of the replica of the snapshot in the backup repository of
+	// the backup storage location.
+	// +optional
+	SnapshotID string `json:"snapshotID,omitempty"`
+
+	// Phase is the result of the replication.
+	Phase BackupVolumeReplicationPhase `json:"phase"`
+
+	// Message is a message about the result of the replication.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// CompletionTimestamp records the time the replication was completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+}
+
+// BackupVolumeVerificationPhase is the result of verifying the data mover snapshot of a volume.
+// +kubebuilder:validation:Enum=Verified;Corrupted;Failed
+type BackupVolumeVerificationPhase string
+
+const (
+	// BackupVolumeVerificationPhaseVerified means the snapshot is intact.
+	BackupVolumeVerificationPhaseVerified BackupVolumeVerificationPhase = "Verified"
+
+	// BackupVolumeVerificationPhaseCorrupted means some entries of the snapshot are
+	// missing or can't be read from the backup repository.
+	BackupVolumeVerificationPhaseCorrupted BackupVolumeVerificationPhase = "Corrupted"
+
+	// BackupVolumeVerificationPhaseFailed means the snapshot couldn't be verified.
+	BackupVolumeVerificationPhaseFailed BackupVolumeVerificationPhase = "Failed"
+)
+
+// BackupVolumeVerification is the result of verifying the data mover snapshot of a volume
+// of a backup.
+type BackupVolumeVerification struct {
+	// DataUpload is the name of the DataUpload which took the snapshot.
+	DataUpload string `json:"dataUpload"`
+
+	// PVC is the namespace/name of the PVC which the snapshot is taken for.
+	PVC string `json:"pvc"`
+
+	// SnapshotID is the ID of the snapshot in the backup repository.
+	SnapshotID string `json:"snapshotID"`
+
+	// Phase is the result of the verification.
+	Phase BackupVolumeVerificationPhase `json:"phase"`
+
+	// Message is a message about the result of the verification.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// CompletionTimestamp records the time the verification was completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+}
+
+// BackupDryRunResult stores the resources, persistent volumes and estimated data
+// size that would be included in a backup.
+type BackupDryRunResult struct {
+	// Resources is the list of resources that would be included in the backup, grouped
+	// by the API Version and Kind. Each entry has the format "namespace/name", or "name"
+	// for cluster scoped resources.
+	// +optional
+	// +nullable
+	Resources map[string][]string `json:"resources,omitempty"`
+
+	// PersistentVolumes is the list of names of the persistent volumes that would be included
+	// in the backup, either directly or through the included persistent volume claims.
+	// +optional
+	// +nullable
+	PersistentVolumes []string `json:"persistentVolumes,omitempty"`
+
+	// EstimatedDataSizeBytes is the estimated size of the volume data that would be included
+	// in the backup, which is calculated from the capacities of the persistent volumes.
+	// +optional
+	EstimatedDataSizeBytes int64 `json:"estimatedDataSizeBytes,omitempty"`
 }
 
 // BackupProgress stores information about the progress of a Backup's execution.
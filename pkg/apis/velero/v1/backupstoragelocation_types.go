@@ -78,6 +78,17 @@ type BackupStorageLocationStatus struct {
 	// +optional
 	Message string `json:"message,omitempty"`
 
+	// Usage is the approximate storage consumed by the location, it's updated when
+	// the contents of the location are synced into the cluster.
+	// +optional
+	// +nullable
+	Usage *BackupStorageLocationUsage `json:"usage,omitempty"`
+
+	// AuditedGeneration is the generation of the backup storage location last recorded
+	// into the audit log.
+	// +optional
+	AuditedGeneration int64 `json:"auditedGeneration,omitempty"`
+
 	// LastSyncedRevision is the value of the `metadata/revision` file in the backup
 	// storage location the last time the BSL's contents were synced into the cluster.
 	//
@@ -94,6 +105,30 @@ type BackupStorageLocationStatus struct {
 	AccessMode BackupStorageLocationAccessMode `json:"accessMode,omitempty"`
 }
 
+// BackupStorageLocationUsage is the approximate storage consumed by a BackupStorageLocation.
+type BackupStorageLocationUsage struct {
+	// Bytes is the total size of the objects in the location, including the backup
+	// repositories. It isn't reported if the object store plugin isn't able to list
+	// the sizes of the objects.
+	// +optional
+	// +nullable
+	Bytes *int64 `json:"bytes,omitempty"`
+
+	// BackupCount is the number of the backups in the location.
+	// +optional
+	BackupCount int `json:"backupCount,omitempty"`
+
+	// OldestBackupTime is the time the oldest backup in the location was written.
+	// +optional
+	// +nullable
+	OldestBackupTime *metav1.Time `json:"oldestBackupTime,omitempty"`
+
+	// NewestBackupTime is the time the newest backup in the location was written.
+	// +optional
+	// +nullable
+	NewestBackupTime *metav1.Time `json:"newestBackupTime,omitempty"`
+}
+
 // TODO(2.0) After converting all resources to use the runtime-controller client,
 // the genclient and k8s:deepcopy markers will no longer be needed and should be removed.
 // +genclient
@@ -148,6 +183,84 @@ type ObjectStorageLocation struct {
 	// CACert defines a CA bundle to use when verifying TLS connections to the provider.
 	// +optional
 	CACert []byte `json:"caCert,omitempty"`
+
+	// ChangeLog means the puts and deletions of the backups are recorded in a change log in the
+	// bucket, the backup sync only lists the changed backups by the change log and lists all the
+	// backups hourly. All the Velero servers writing backups to the bucket must support the change log.
+	// +optional
+	ChangeLog bool `json:"changeLog,omitempty"`
+
+	// ObjectLock defines the default object lock (WORM) retention of the bucket, which the
+	// bucket applies to the objects Velero writes. The bucket must have object lock enabled
+	// with the same default retention, Velero defers the deletion of the backups accordingly.
+	// +optional
+	// +nullable
+	ObjectLock *ObjectLock `json:"objectLock,omitempty"`
+
+	// Encryption defines the client-side encryption of the backup tarballs, logs and
+	// resource lists before they are uploaded to the bucket.
+	// +optional
+	// +nullable
+	Encryption *ObjectStorageEncryption `json:"encryption,omitempty"`
+
+	// ExpirationMode defines how the data of the expired backups is removed from the bucket.
+	// "Velero" (the default) means Velero deletes the objects of the expired backups.
+	// "Lifecycle" means the objects of the backups are tagged with their expiration time when
+	// they are written, and the lifecycle policies of the bucket remove them once expired,
+	// Velero only deletes the custom resources of the expired backups.
+	// +optional
+	ExpirationMode ObjectStorageExpirationMode `json:"expirationMode,omitempty"`
+}
+
+// ObjectStorageExpirationMode is the mode in which the data of the expired backups is removed
+// from the object storage.
+// +kubebuilder:validation:Enum=Velero;Lifecycle
+type ObjectStorageExpirationMode string
+
+const (
+	// ObjectStorageExpirationModeVelero means Velero deletes the objects of the expired backups.
+	ObjectStorageExpirationModeVelero ObjectStorageExpirationMode = "Velero"
+
+	// ObjectStorageExpirationModeLifecycle means the objects of the expired backups are removed
+	// by the lifecycle policies of the bucket.
+	ObjectStorageExpirationModeLifecycle ObjectStorageExpirationMode = "Lifecycle"
+)
+
+// ObjectStorageEncryption defines the keys used to encrypt the backup data.
+type ObjectStorageEncryption struct {
+	// KeySecret is the name of the secret in the Velero namespace that holds the
+	// encryption keys. Each key of the secret data is a key ID and its value is a
+	// 256-bit AES key.
+	KeySecret string `json:"keySecret"`
+
+	// KeyID is the ID of the key in the secret used to encrypt new objects. To rotate
+	// the key, add a new key to the secret and update the ID, the objects encrypted
+	// with a previous key are still decrypted as long as that key is kept in the secret.
+	KeyID string `json:"keyID"`
+}
+
+// ObjectLockMode is the retention mode of the locked objects.
+// +kubebuilder:validation:Enum=GOVERNANCE;COMPLIANCE
+type ObjectLockMode string
+
+const (
+	// ObjectLockModeGovernance means the locked objects can only be deleted or overwritten
+	// by users with special permissions before the retention period expires.
+	ObjectLockModeGovernance ObjectLockMode = "GOVERNANCE"
+
+	// ObjectLockModeCompliance means the locked objects can't be deleted or overwritten
+	// by any user before the retention period expires.
+	ObjectLockModeCompliance ObjectLockMode = "COMPLIANCE"
+)
+
+// ObjectLock defines the default retention of an object storage with object lock enabled.
+type ObjectLock struct {
+	// Mode is the retention mode the bucket applies to the objects.
+	Mode ObjectLockMode `json:"mode"`
+
+	// RetentionPeriod is how long the objects are locked after they are written.
+	// The deletion of the backups is deferred until their objects are unlocked.
+	RetentionPeriod metav1.Duration `json:"retentionPeriod"`
 }
 
 // BackupStorageLocationPhase is the lifecycle phase of a Velero BackupStorageLocation.
@@ -164,7 +277,7 @@ const (
 )
 
 // BackupStorageLocationAccessMode represents the permissions for a BackupStorageLocation.
-// +kubebuilder:validation:Enum=ReadOnly;ReadWrite
+// +kubebuilder:validation:Enum=ReadOnly;ReadWrite;Import
 type BackupStorageLocationAccessMode string
 
 const (
@@ -173,7 +286,19 @@ const (
 
 	// BackupStorageLocationAccessModeReadWrite represents read and write access to a BackupStorageLocation.
 	BackupStorageLocationAccessModeReadWrite BackupStorageLocationAccessMode = "ReadWrite"
+
+	// BackupStorageLocationAccessModeImport represents read-only access to a BackupStorageLocation
+	// holding the backups written by another cluster, e.g. to restore them in a DR cluster. The
+	// backups synced from it are labeled as imported and their data is never deleted by this
+	// cluster, even if the access mode of the location is changed afterwards.
+	BackupStorageLocationAccessModeImport BackupStorageLocationAccessMode = "Import"
 )
 
+// IsReadOnly returns whether the backup storage location is read-only, the locations importing the
+// backups of other clusters are read-only as well.
+func (s *BackupStorageLocationSpec) IsReadOnly() bool {
+	return s.AccessMode == BackupStorageLocationAccessModeReadOnly || s.AccessMode == BackupStorageLocationAccessModeImport
+}
+
 // TODO(2.0): remove the AccessMode field from BackupStorageLocationStatus.
 // TODO(2.0): remove the LastSyncedRevision field from BackupStorageLocationStatus.
@@ -25,7 +25,7 @@ type DownloadRequestSpec struct {
 }
 
 // DownloadTargetKind represents what type of file to download.
-// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupItemOperations;BackupResourceList;BackupResults;RestoreLog;RestoreResults;RestoreResourceList;RestoreItemOperations;CSIBackupVolumeSnapshots;CSIBackupVolumeSnapshotContents
+// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupItemOperations;BackupResourceList;BackupResults;RestoreLog;RestoreResults;RestoreResourceList;RestoreItemOperations;CSIBackupVolumeSnapshots;CSIBackupVolumeSnapshotContents;BackupChecksums;BackupLogChunk;AuditLog;BackupVolumeInfos;BackupSkippedItems
 type DownloadTargetKind string
 
 const (
@@ -41,6 +41,11 @@ const (
 	DownloadTargetKindRestoreItemOperations           DownloadTargetKind = "RestoreItemOperations"
 	DownloadTargetKindCSIBackupVolumeSnapshots        DownloadTargetKind = "CSIBackupVolumeSnapshots"
 	DownloadTargetKindCSIBackupVolumeSnapshotContents DownloadTargetKind = "CSIBackupVolumeSnapshotContents"
+	DownloadTargetKindBackupChecksums                 DownloadTargetKind = "BackupChecksums"
+	DownloadTargetKindBackupLogChunk                  DownloadTargetKind = "BackupLogChunk"
+	DownloadTargetKindAuditLog                        DownloadTargetKind = "AuditLog"
+	DownloadTargetKindBackupVolumeInfos               DownloadTargetKind = "BackupVolumeInfos"
+	DownloadTargetKindBackupSkippedItems              DownloadTargetKind = "BackupSkippedItems"
 )
 
 // DownloadTarget is the specification for what kind of file to download, and the name of the
@@ -50,7 +55,38 @@ type DownloadTarget struct {
 	Kind DownloadTargetKind `json:"kind"`
 
 	// Name is the name of the Kubernetes resource with which the file is associated.
+	// For the AuditLog kind, it's the name of the backup storage location.
 	Name string `json:"name"`
+
+	// IncludedResources is a slice of resource names to extract from the
+	// backup contents, only valid for the BackupContents kind. If set, the
+	// matching items are extracted by the server into a separate tarball
+	// which is downloaded instead of the whole backup contents.
+	// +optional
+	// +nullable
+	IncludedResources []string `json:"includedResources,omitempty"`
+
+	// IncludedNamespaces is a slice of namespace names to extract from the
+	// backup contents, only valid for the BackupContents kind. If set, only
+	// the namespace-scoped items in the namespaces are extracted.
+	// +optional
+	// +nullable
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+
+	// Page is the page of the file to download, only valid for the
+	// BackupItemOperations, BackupResourceList, BackupVolumeInfos,
+	// BackupSkippedItems and BackupLogChunk kinds. The files of the backups with many items are
+	// split into pages numbered from 1, the first page is downloaded if not
+	// set. For the BackupLogChunk kind, it's the number of the chunk of the
+	// log uploaded while the backup is in progress.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Page int `json:"page,omitempty"`
+}
+
+// HasContentsFilter returns whether the items of the backup contents are filtered by the target.
+func (t DownloadTarget) HasContentsFilter() bool {
+	return t.Kind == DownloadTargetKindBackupContents && (len(t.IncludedResources) > 0 || len(t.IncludedNamespaces) > 0)
 }
 
 // DownloadRequestPhase represents the lifecycle phase of a DownloadRequest.
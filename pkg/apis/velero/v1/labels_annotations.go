@@ -29,6 +29,9 @@ const (
 	// ScheduleNameLabel is the label key used to identify a schedule by name.
 	ScheduleNameLabel = "velero.io/schedule-name"
 
+	// RestoreScheduleNameLabel is the label key used to identify a restore schedule by name.
+	RestoreScheduleNameLabel = "velero.io/restore-schedule-name"
+
 	// RestoreUIDLabel is the label key used to identify a restore by uid.
 	RestoreUIDLabel = "velero.io/restore-uid"
 
@@ -43,10 +46,24 @@ const (
 	// pod volume backups/restores).
 	PodVolumeOperationTimeoutAnnotation = "velero.io/pod-volume-timeout"
 
+	// PodVolumeIncrementalBaseAnnotation is the annotation key used to specify
+	// the name of a previous backup whose pod volume backups are used as the
+	// base of the backup's pod volume backups, rather than the most recent ones.
+	PodVolumeIncrementalBaseAnnotation = "velero.io/pod-volume-incremental-base"
+
+	// FSBackupUnmountedPVCsAnnotation is the annotation key used to specify
+	// whether the data of the bound PVCs that aren't mounted by any pod is backed
+	// up by pod volume backup through a pod created by the node-agent to mount them.
+	FSBackupUnmountedPVCsAnnotation = "velero.io/fs-backup-unmounted-pvcs"
+
 	// StorageLocationLabel is the label key used to identify the storage
 	// location of a backup.
 	StorageLocationLabel = "velero.io/storage-location"
 
+	// ImportedBackupLabel is the label key used to identify the backups synced from a
+	// backup storage location in the Import access mode, whose data is never deleted.
+	ImportedBackupLabel = "velero.io/imported"
+
 	// VolumeNamespaceLabel is the label key used to identify which
 	// namespace a repository stores backups for.
 	VolumeNamespaceLabel = "velero.io/volume-namespace"
@@ -54,6 +71,9 @@ const (
 	// RepositoryTypeLabel is the label key used to identify the type of a repository
 	RepositoryTypeLabel = "velero.io/repository-type"
 
+	// RepositoryNameLabel is the label key used to identify the repository of a maintenance job
+	RepositoryNameLabel = "velero.io/repo-name"
+
 	// DataUploadLabel is the label key used to identify the dataupload for snapshot backup pod
 	DataUploadLabel = "velero.io/data-upload"
 
@@ -76,10 +96,29 @@ const (
 	// minor version of the backup , i.e. 16
 	SourceClusterK8sMinorVersionAnnotation = "velero.io/source-cluster-k8s-minor-version"
 
-	// ResourceTimeoutAnnotation is the annotation key used to carry the global resource
-	// timeout value for backup to plugins.
+	// ResourceTimeoutAnnotation is the annotation key used to carry the timeout of creating
+	// the CSI snapshots of the backup to plugins, which is the global resource timeout
+	// unless the CSI snapshot creation timeout is set for the server.
 	ResourceTimeoutAnnotation = "velero.io/resource-timeout"
 
+	// VolumeGroupSnapshotVSAnnotation is the annotation key used to carry the VolumeSnapshot
+	// taken for a PVC by a VolumeGroupSnapshot to the CSI plugin, which uses it instead of
+	// taking a snapshot of the PVC alone.
+	VolumeGroupSnapshotVSAnnotation = "velero.io/volume-group-snapshot-vs"
+
+	// DefaultVGSLabelKey is the default label key on the PVCs to group them for the
+	// VolumeGroupSnapshots.
+	DefaultVGSLabelKey = "velero.io/volume-group"
+
+	// OriginalReplicasAnnotation is the annotation key used to keep the number of
+	// replicas of the workloads scaled during restore.
+	OriginalReplicasAnnotation = "velero.io/original-replicas"
+
+	// IgnoreChecksumMismatchAnnotation is the annotation key on a restore to report the
+	// files of the backup contents failing the checksum verification as warnings instead
+	// of failing the restore.
+	IgnoreChecksumMismatchAnnotation = "velero.io/ignore-checksum-mismatch"
+
 	// AsyncOperationIDLabel is the label key used to identify the async operation ID
 	AsyncOperationIDLabel = "velero.io/async-operation-id"
 
@@ -97,6 +136,23 @@ const (
 	// VolumesToExcludeAnnotation is the annotation on a pod whose mounted volumes
 	// should be excluded from pod volume backup.
 	VolumesToExcludeAnnotation = "backup.velero.io/backup-volumes-excludes"
+
+	// MaintenanceRequestedAnnotation is the annotation on a backup repository requesting
+	// a full maintenance, the maintenance runs regardless of its frequency and window.
+	MaintenanceRequestedAnnotation = "velero.io/maintenance-requested"
+
+	// OrphanScanRequestedAnnotation is the annotation on a backup repository requesting a
+	// scan of the snapshots not referenced by any backup, its value is either
+	// OrphanScanModeList or OrphanScanModePrune.
+	OrphanScanRequestedAnnotation = "velero.io/orphan-scan-requested"
+
+	// OrphanScanModeList requests the orphaned snapshots to be recorded in the status of
+	// the backup repository.
+	OrphanScanModeList = "list"
+
+	// OrphanScanModePrune requests the orphaned snapshots to be deleted from the backup
+	// repository.
+	OrphanScanModePrune = "prune"
 )
 
 type AsyncOperationIDPrefix string
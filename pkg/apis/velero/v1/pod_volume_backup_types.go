@@ -51,6 +51,12 @@ type PodVolumeBackupSpec struct {
 	// volume backup as tags.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+
+	// IncrementalBase is the snapshot ID of a previous pod volume backup to use as
+	// the base of this backup. If it is empty, the most recent completed pod volume
+	// backup of the same PVC is used as the base.
+	// +optional
+	IncrementalBase string `json:"incrementalBase,omitempty"`
 }
 
 // PodVolumeBackupPhase represents the lifecycle phase of a PodVolumeBackup.
@@ -103,6 +109,12 @@ type PodVolumeBackupStatus struct {
 	// about the backup operation.
 	// +optional
 	Progress shared.DataMoveOperationProgress `json:"progress,omitempty"`
+
+	// UploadStats holds the logical size of the volume and the size of the
+	// data uploaded to the backup repository, it's only reported by the
+	// kopia uploader.
+	// +optional
+	UploadStats shared.UploadStats `json:"uploadStats,omitempty"`
 }
 
 // TODO(2.0) After converting all resources to use the runttime-controller client,
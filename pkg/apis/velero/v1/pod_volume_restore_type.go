@@ -48,6 +48,11 @@ type PodVolumeRestoreSpec struct {
 
 	// SourceNamespace is the original namespace for namaspace mapping.
 	SourceNamespace string `json:"sourceNamespace"`
+
+	// HostHooks are the host restore hooks executed on the volume after its data is restored.
+	// +optional
+	// +nullable
+	HostHooks []HostRestoreHook `json:"hostHooks,omitempty"`
 }
 
 // PodVolumeRestorePhase represents the lifecycle phase of a PodVolumeRestore.
@@ -49,6 +49,9 @@ func CustomResources() map[string]typeInfo {
 		"Backup":                 newTypeInfo("backups", &Backup{}, &BackupList{}),
 		"Restore":                newTypeInfo("restores", &Restore{}, &RestoreList{}),
 		"Schedule":               newTypeInfo("schedules", &Schedule{}, &ScheduleList{}),
+		"RestoreSchedule":        newTypeInfo("restoreschedules", &RestoreSchedule{}, &RestoreScheduleList{}),
+		"ResourceFilterPolicy":   newTypeInfo("resourcefilterpolicies", &ResourceFilterPolicy{}, &ResourceFilterPolicyList{}),
+		"BackupHookPolicy":       newTypeInfo("backuphookpolicies", &BackupHookPolicy{}, &BackupHookPolicyList{}),
 		"DownloadRequest":        newTypeInfo("downloadrequests", &DownloadRequest{}, &DownloadRequestList{}),
 		"DeleteBackupRequest":    newTypeInfo("deletebackuprequests", &DeleteBackupRequest{}, &DeleteBackupRequestList{}),
 		"PodVolumeBackup":        newTypeInfo("podvolumebackups", &PodVolumeBackup{}, &PodVolumeBackupList{}),
@@ -0,0 +1,151 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceFilterPolicySpec defines the resource filters of a Velero
+// resource filter policy. They have the same meaning as the ones of
+// the BackupSpec, and are only applied to the backups which don't set
+// the corresponding filters themselves.
+type ResourceFilterPolicySpec struct {
+	// IncludedNamespaces is a slice of namespace names to include objects
+	// from. If empty, all namespaces are included.
+	// +optional
+	// +nullable
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+
+	// ExcludedNamespaces contains a list of namespaces that are not
+	// included in the backup.
+	// +optional
+	// +nullable
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// IncludedResources is a slice of resource names to include
+	// in the backup. If empty, all resources are included.
+	// +optional
+	// +nullable
+	IncludedResources []string `json:"includedResources,omitempty"`
+
+	// ExcludedResources is a slice of resource names that are not
+	// included in the backup.
+	// +optional
+	// +nullable
+	ExcludedResources []string `json:"excludedResources,omitempty"`
+
+	// IncludedClusterScopedResources is a slice of cluster-scoped
+	// resource type names to include in the backup.
+	// If set to "*", all cluster-scoped resource types are included.
+	// The default value is empty, which means only related
+	// cluster-scoped resources are included.
+	// +optional
+	// +nullable
+	IncludedClusterScopedResources []string `json:"includedClusterScopedResources,omitempty"`
+
+	// ExcludedClusterScopedResources is a slice of cluster-scoped
+	// resource type names to exclude from the backup.
+	// If set to "*", all cluster-scoped resource types are excluded.
+	// The default value is empty.
+	// +optional
+	// +nullable
+	ExcludedClusterScopedResources []string `json:"excludedClusterScopedResources,omitempty"`
+
+	// IncludedNamespaceScopedResources is a slice of namespace-scoped
+	// resource type names to include in the backup.
+	// The default value is "*".
+	// +optional
+	// +nullable
+	IncludedNamespaceScopedResources []string `json:"includedNamespaceScopedResources,omitempty"`
+
+	// ExcludedNamespaceScopedResources is a slice of namespace-scoped
+	// resource type names to exclude from the backup.
+	// If set to "*", all namespace-scoped resource types are excluded.
+	// The default value is empty.
+	// +optional
+	// +nullable
+	ExcludedNamespaceScopedResources []string `json:"excludedNamespaceScopedResources,omitempty"`
+
+	// LabelSelector is a metav1.LabelSelector to filter with
+	// when adding individual objects to the backup. If empty
+	// or nil, all objects are included. Optional.
+	// +optional
+	// +nullable
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// OrLabelSelectors is list of metav1.LabelSelector to filter with
+	// when adding individual objects to the backup. If multiple provided
+	// they will be joined by the OR operator. LabelSelector as well as
+	// OrLabelSelectors cannot co-exist in backup request, only one of them
+	// can be used.
+	// +optional
+	// +nullable
+	OrLabelSelectors []*metav1.LabelSelector `json:"orLabelSelectors,omitempty"`
+
+	// ClusterScopedLabelSelector is a metav1.LabelSelector to filter the
+	// cluster-scoped resources with. If specified, the cluster-scoped resources
+	// matching it are backed up regardless of the namespace filters, and
+	// LabelSelector and OrLabelSelectors only apply to the namespace-scoped resources.
+	// +optional
+	// +nullable
+	ClusterScopedLabelSelector *metav1.LabelSelector `json:"clusterScopedLabelSelector,omitempty"`
+
+	// ClusterScopedOrLabelSelectors is list of metav1.LabelSelector to filter
+	// the cluster-scoped resources with, joined by the OR operator. It works as
+	// ClusterScopedLabelSelector does, and the two cannot co-exist in backup request.
+	// +optional
+	// +nullable
+	ClusterScopedOrLabelSelectors []*metav1.LabelSelector `json:"clusterScopedOrLabelSelectors,omitempty"`
+
+	// IncludeClusterResources specifies whether cluster-scoped resources
+	// should be included for consideration in the backup.
+	// +optional
+	// +nullable
+	IncludeClusterResources *bool `json:"includeClusterResources,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ResourceFilterPolicy is a Velero resource that holds a reusable set
+// of resource filters, which are referenced by name from the Backups
+// and the Schedules instead of repeating the filters in each of them.
+type ResourceFilterPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata"`
+
+	// +optional
+	Spec ResourceFilterPolicySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+// +kubebuilder:object:root=true
+
+// ResourceFilterPolicyList is a list of ResourceFilterPolicies.
+type ResourceFilterPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ResourceFilterPolicy `json:"items"`
+}
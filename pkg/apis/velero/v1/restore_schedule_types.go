@@ -0,0 +1,128 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RestoreScheduleSpec defines the specification for a Velero restore schedule
+type RestoreScheduleSpec struct {
+	// Template is the definition of the Restore to be run on the
+	// provided schedule. Its ScheduleName must be set, so that each
+	// Restore is run from the most recent Completed backup of the
+	// backup schedule.
+	Template RestoreSpec `json:"template"`
+
+	// Schedule is a Cron expression defining when to run
+	// the Restore.
+	Schedule string `json:"schedule"`
+
+	// Paused specifies whether the restore schedule is paused or not
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// RestoresToKeep is the number of the most recent finished
+	// restores of the restore schedule to keep, the older ones are
+	// deleted. All the restores are kept if it isn't set.
+	// +optional
+	// +nullable
+	// +kubebuilder:validation:Minimum=1
+	RestoresToKeep *int32 `json:"restoresToKeep,omitempty"`
+}
+
+// RestoreScheduleStatus captures the current state of a Velero restore schedule
+type RestoreScheduleStatus struct {
+	// Phase is the current phase of the RestoreSchedule, it has the
+	// same phases as the Schedule.
+	// +optional
+	Phase SchedulePhase `json:"phase,omitempty"`
+
+	// LastRestore is the last time a Restore was run for this
+	// restore schedule
+	// +optional
+	// +nullable
+	LastRestore *metav1.Time `json:"lastRestore,omitempty"`
+
+	// LastRestoreName is the name of the last finished Restore of
+	// this restore schedule
+	// +optional
+	LastRestoreName string `json:"lastRestoreName,omitempty"`
+
+	// LastRestorePhase is the phase of the last finished Restore of
+	// this restore schedule
+	// +optional
+	LastRestorePhase RestorePhase `json:"lastRestorePhase,omitempty"`
+
+	// LastSuccessfulRestore is the last time a Restore of this
+	// restore schedule completed successfully
+	// +optional
+	// +nullable
+	LastSuccessfulRestore *metav1.Time `json:"lastSuccessfulRestore,omitempty"`
+
+	// ValidationErrors is a slice of all validation errors (if
+	// applicable)
+	// +optional
+	ValidationErrors []string `json:"validationErrors,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="Status of the restore schedule"
+// +kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.schedule",description="A Cron expression defining when to run the Restore"
+// +kubebuilder:printcolumn:name="LastRestorePhase",type="string",JSONPath=".status.lastRestorePhase",description="The phase of the last finished Restore of this restore schedule"
+// +kubebuilder:printcolumn:name="LastSuccessfulRestore",type="date",JSONPath=".status.lastSuccessfulRestore",description="The last time a Restore of this restore schedule completed successfully"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".spec.paused"
+
+// RestoreSchedule is a Velero resource that represents a periodic
+// Restore from the most recent backup of a Schedule, e.g. to test
+// the restores continuously for the disaster recovery.
+type RestoreSchedule struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata"`
+
+	// +optional
+	Spec RestoreScheduleSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status RestoreScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+// +kubebuilder:object:root=true
+
+// RestoreScheduleList is a list of RestoreSchedules.
+type RestoreScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []RestoreSchedule `json:"items"`
+}
+
+// TimestampedName returns the default restore name format based on the restore schedule
+func (s *RestoreSchedule) TimestampedName(timestamp time.Time) string {
+	return fmt.Sprintf("%s-%s", s.Name, timestamp.Format("20060102150405"))
+}
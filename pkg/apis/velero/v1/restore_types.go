@@ -18,6 +18,7 @@ package v1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -48,6 +49,8 @@ type RestoreSpec struct {
 
 	// IncludedResources is a slice of resource names to include
 	// in the restore. If empty, all resources in the backup are included.
+	// A resource name can be followed by a name glob to only include the
+	// items with matching names, e.g. "deployments.apps/web-*".
 	// +optional
 	// +nullable
 	IncludedResources []string `json:"includedResources,omitempty"`
@@ -65,6 +68,18 @@ type RestoreSpec struct {
 	// +optional
 	NamespaceMapping map[string]string `json:"namespaceMapping,omitempty"`
 
+	// StorageClassMappings is a map of source storage class names to
+	// the target storage class names used by the restored PVs, PVCs and
+	// StatefulSet volume claim templates. The CSI driver of the restored
+	// CSI PVs is changed to the provisioner of the target storage class.
+	// +optional
+	StorageClassMappings map[string]string `json:"storageClassMappings,omitempty"`
+
+	// ZoneMappings is a map of source topology zones to the target zones
+	// used by the node affinity and the zone labels of the restored PVs.
+	// +optional
+	ZoneMappings map[string]string `json:"zoneMappings,omitempty"`
+
 	// LabelSelector is a metav1.LabelSelector to filter with
 	// when restoring individual objects from the backup. If empty
 	// or nil, all objects are included. Optional.
@@ -114,6 +129,13 @@ type RestoreSpec struct {
 	// +nullable
 	ExistingResourcePolicy PolicyType `json:"existingResourcePolicy,omitempty"`
 
+	// ExistingResourcePolicies specifies the restore behavior per resource type, keyed by the resource name
+	// in the format of <resource>.<group>, e.g., "configmaps" or "deployments.apps". The policy specified for
+	// a resource type takes precedence over ExistingResourcePolicy.
+	// +optional
+	// +nullable
+	ExistingResourcePolicies map[string]PolicyType `json:"existingResourcePolicies,omitempty"`
+
 	// ItemOperationTimeout specifies the time used to wait for RestoreItemAction operations
 	// The default value is 1 hour.
 	// +optional
@@ -123,11 +145,203 @@ type RestoreSpec struct {
 	// +optional
 	// +nullable
 	ResourceModifier *v1.TypedLocalObjectReference `json:"resourceModifier,omitempty"`
+
+	// ResourcePriorities specifies the reference to the configmap with the high and low priority
+	// resources that override the server's restore resource priorities for this restore.
+	// +optional
+	// +nullable
+	ResourcePriorities *v1.TypedLocalObjectReference `json:"resourcePriorities,omitempty"`
+
+	// Scaling specifies the replicas the restored Deployments and StatefulSets
+	// are scaled to, e.g. to bring the workloads up quiesced.
+	// +optional
+	// +nullable
+	Scaling *RestoreScaling `json:"scaling,omitempty"`
+
+	// DryRun specifies whether to only compare the items in the backup with the cluster
+	// without actually restoring anything. The result is reported in the restore's
+	// status.dryRunResult.
+	// +optional
+	// +nullable
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// ReadinessGates specifies the restored items that are waited for to be ready,
+	// e.g. CRDs established and namespaces active, before restoring the items
+	// depending on them.
+	// +optional
+	// +nullable
+	ReadinessGates *RestoreReadinessGates `json:"readinessGates,omitempty"`
+
+	// StrictQuota specifies whether to fail the restore before restoring anything if
+	// the restored workloads request more than the resource quotas of their namespaces
+	// have available. Otherwise the exceeded quotas are reported as warnings.
+	// +optional
+	// +nullable
+	StrictQuota *bool `json:"strictQuota,omitempty"`
+
+	// PVCSizeOverrides specifies the requested storage of the persistent volume claims
+	// restored from CSI volume snapshots, for the target storage requiring larger volumes
+	// than the source.
+	// +optional
+	// +nullable
+	PVCSizeOverrides *RestorePVCSizeOverrides `json:"pvcSizeOverrides,omitempty"`
+
+	// ItemRetryPolicy specifies how the restore of an item is retried on the transient
+	// errors of the API server, e.g. throttling, etcd timeouts and unavailable webhooks.
+	// If unset, the items fail on the first error.
+	// +optional
+	// +nullable
+	ItemRetryPolicy *RestoreItemRetryPolicy `json:"itemRetryPolicy,omitempty"`
+
+	// SecretEncryption specifies that the sensitive Secrets are only restored if the
+	// target cluster is verified to encrypt the Secrets at rest. If unset, the Secrets
+	// are restored without the verification.
+	// +optional
+	// +nullable
+	SecretEncryption *RestoreSecretEncryption `json:"secretEncryption,omitempty"`
+}
+
+// RestoreSecretEncryption defines the verification of the encryption at rest of the
+// target cluster before restoring the sensitive Secrets.
+type RestoreSecretEncryption struct {
+	// SensitiveTypes is a slice of the types of the sensitive Secrets, e.g.
+	// kubernetes.io/tls. The Secrets without a type are of the type Opaque.
+	// If empty, the Secrets of all the types are sensitive.
+	// +optional
+	// +nullable
+	SensitiveTypes []string `json:"sensitiveTypes,omitempty"`
+
+	// AllowUnencryptedTarget specifies whether to restore the sensitive Secrets even
+	// if the target cluster isn't verified to encrypt the Secrets at rest, the failed
+	// verification is reported as a warning instead of failing the restore.
+	// +optional
+	AllowUnencryptedTarget bool `json:"allowUnencryptedTarget,omitempty"`
+}
+
+// RestoreItemRetryPolicy defines how the API calls restoring an item are retried with
+// exponential backoff on the transient errors of the API server.
+type RestoreItemRetryPolicy struct {
+	// MaxRetries is the retry budget of each item, i.e. the maximum number of retries of
+	// all the API calls restoring the item.
+	// +kubebuilder:validation:Minimum=0
+	MaxRetries int32 `json:"maxRetries"`
+
+	// InitialBackoff is the time waited before the first retry of an item, it's doubled
+	// for each following retry. Defaults to 1 second.
+	// +optional
+	InitialBackoff metav1.Duration `json:"initialBackoff,omitempty"`
+
+	// MaxBackoff is the maximum time waited before a retry. Defaults to 30 seconds.
+	// +optional
+	MaxBackoff metav1.Duration `json:"maxBackoff,omitempty"`
+}
+
+// RestorePVCSizeOverrides defines the requested storage of the persistent volume claims
+// restored from CSI volume snapshots. The requested storage is only ever grown, and only
+// if the storage class of the persistent volume claim allows volume expansion.
+type RestorePVCSizeOverrides struct {
+	// GrowthPercentage is the percentage the requested storage of the persistent volume
+	// claims is grown by, e.g. 20 grows a 10Gi request to 12Gi.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	GrowthPercentage int32 `json:"growthPercentage,omitempty"`
+
+	// Sizes maps the persistent volume claims, as "<namespace>/<name>" in the backup, to
+	// their requested storage. It takes precedence over GrowthPercentage.
+	// +optional
+	// +nullable
+	Sizes map[string]resource.Quantity `json:"sizes,omitempty"`
+}
+
+// RestoreReadinessGates defines the restored items the restore waits for to be ready.
+type RestoreReadinessGates struct {
+	// IncludedResources specifies the resources whose restored items are waited for,
+	// i.e. "customresourcedefinitions", "namespaces", "mutatingwebhookconfigurations"
+	// and "validatingwebhookconfigurations". If empty, it applies to all of them.
+	// +optional
+	// +nullable
+	IncludedResources []string `json:"includedResources,omitempty"`
+
+	// Timeout is the overall time the restore waits for the restored items to be
+	// ready. Once it's exceeded, the remaining items are restored without waiting.
+	// If unset, the server's resource timeout is used.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
+// RestoreScaling defines the replicas of the restored workloads.
+type RestoreScaling struct {
+	// Replicas is the number of replicas the restored workloads are scaled to.
+	// The original number of replicas is kept in the "velero.io/original-replicas"
+	// annotation of the restored workloads.
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// IncludedResources specifies the workload resources to scale, i.e.
+	// "deployments" and "statefulsets". If empty, it applies to both.
+	// +optional
+	// +nullable
+	IncludedResources []string `json:"includedResources,omitempty"`
 }
 
 // RestoreHooks contains custom behaviors that should be executed during or post restore.
 type RestoreHooks struct {
 	Resources []RestoreResourceHookSpec `json:"resources,omitempty"`
+
+	// Jobs are the hooks running a Job before any items are restored or after the
+	// restore completes, which aren't tied to the restored pods.
+	// +optional
+	// +nullable
+	Jobs []RestoreJobHook `json:"jobs,omitempty"`
+}
+
+// RestoreJobHookPhase is when a restore job hook runs.
+// +kubebuilder:validation:Enum=Pre;Post
+type RestoreJobHookPhase string
+
+const (
+	// RestoreJobHookPhasePre means the hook runs before any items are restored,
+	// and the restore waits for it to finish.
+	RestoreJobHookPhasePre RestoreJobHookPhase = "Pre"
+
+	// RestoreJobHookPhasePost means the hook runs after all items are restored,
+	// and it's tracked as an async operation of the restore.
+	RestoreJobHookPhasePost RestoreJobHookPhase = "Post"
+)
+
+// RestoreJobHook is a hook that runs a command in a Job in a namespace of the cluster.
+type RestoreJobHook struct {
+	// Name is the name of the hook, the Job is named after the restore and the hook.
+	Name string `json:"name"`
+
+	// Phase specifies whether the hook runs before any items are restored or after the restore completes.
+	Phase RestoreJobHookPhase `json:"phase"`
+
+	// Namespace is the namespace the Job runs in.
+	Namespace string `json:"namespace"`
+
+	// ServiceAccountName is the name of the service account the Job runs as. If not specified,
+	// the default service account of the namespace is used.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// Image is the container image of the Job.
+	Image string `json:"image"`
+
+	// Command is the command and arguments to execute in the container of the Job.
+	// +kubebuilder:validation:MinItems=1
+	Command []string `json:"command"`
+
+	// OnError specifies how Velero should behave if a pre-restore hook fails, the restore
+	// stops before restoring any items if it's Fail. The failures of the post-restore hooks
+	// are always errors of the restore.
+	// +optional
+	OnError HookErrorMode `json:"onError,omitempty"`
+
+	// Timeout defines the maximum amount of time the Job may run before it's considered
+	// failed. Defaults to 10 minutes.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
 }
 
 type RestoreStatusSpec struct {
@@ -188,6 +402,9 @@ type RestoreResourceHook struct {
 
 	// Init defines an init restore hook.
 	Init *InitRestoreHook `json:"init,omitempty"`
+
+	// Host defines a host restore hook.
+	Host *HostRestoreHook `json:"host,omitempty"`
 }
 
 // ExecRestoreHook is a hook that uses pod exec API to execute a command inside a container in a pod
@@ -234,9 +451,34 @@ type InitRestoreHook struct {
 	Timeout metav1.Duration `json:"timeout,omitempty"`
 }
 
+// HostRestoreHook is a hook that is executed by the node-agent on the host of a restored pod, in a chroot
+// of the directory of a restored volume, after the data of the volume is restored and before the containers
+// of the pod start. Host restore hooks only run for the volumes restored by the node-agent from file system
+// backups.
+type HostRestoreHook struct {
+	// Volumes are the names of the pod volumes on which the command should be executed. If not
+	// specified, the command is executed on all the restored volumes of the pod.
+	// +optional
+	// +nullable
+	Volumes []string `json:"volumes,omitempty"`
+
+	// Command is the command and arguments to execute in a chroot of the restored volume directory.
+	// +kubebuilder:validation:MinItems=1
+	Command []string `json:"command"`
+
+	// OnError specifies how Velero should behave if it encounters an error executing this hook.
+	// +optional
+	OnError HookErrorMode `json:"onError,omitempty"`
+
+	// Timeout defines the maximum amount of time Velero should wait for the hook to complete before
+	// considering the execution a failure.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
 // RestorePhase is a string representation of the lifecycle phase
 // of a Velero restore
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Completed;PartiallyFailed;Failed
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForBackupRetrieval;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Completed;PartiallyFailed;Failed;DryRunCompleted
 type RestorePhase string
 
 const (
@@ -251,6 +493,11 @@ const (
 	// RestorePhaseInProgress means the restore is currently executing.
 	RestorePhaseInProgress RestorePhase = "InProgress"
 
+	// RestorePhaseWaitingForBackupRetrieval means the backup contents
+	// are transitioned to an archive storage class of the object storage
+	// and the restore is waiting for them to be retrieved before it runs.
+	RestorePhaseWaitingForBackupRetrieval RestorePhase = "WaitingForBackupRetrieval"
+
 	// RestorePhaseWaitingForPluginOperations means the restore of
 	// Kubernetes resources and other async plugin operations was
 	// successful and plugin operations are still ongoing.  The
@@ -276,6 +523,10 @@ const (
 	// The failing error is recorded in status.FailureReason.
 	RestorePhaseFailed RestorePhase = "Failed"
 
+	// RestorePhaseDryRunCompleted means the dry-run of the restore has
+	// completed, the result is recorded in status.DryRunResult.
+	RestorePhaseDryRunCompleted RestorePhase = "DryRunCompleted"
+
 	// PolicyTypeNone means velero will not overwrite the resource
 	// in cluster with the one in backup whether changed/unchanged.
 	PolicyTypeNone PolicyType = "none"
@@ -283,6 +534,10 @@ const (
 	// PolicyTypeUpdate means velero will try to attempt a patch on
 	// the changed resources.
 	PolicyTypeUpdate PolicyType = "update"
+
+	// PolicyTypeRecreate means velero will delete the changed resources
+	// in cluster and create them again from the backup.
+	PolicyTypeRecreate PolicyType = "recreate"
 )
 
 // RestoreStatus captures the current status of a Velero restore
@@ -307,6 +562,13 @@ type RestoreStatus struct {
 	// +optional
 	Errors int `json:"errors,omitempty"`
 
+	// ErrorCategories is a count of the error messages of each category, i.e.
+	// plugin, apiConflict, validation, dataPath, timeout and other, so that
+	// the automation can decide whether a partially failed restore is acceptable.
+	// +optional
+	// +nullable
+	ErrorCategories map[string]int `json:"errorCategories,omitempty"`
+
 	// FailureReason is an error that caused the entire restore to fail.
 	// +optional
 	FailureReason string `json:"failureReason,omitempty"`
@@ -345,6 +607,87 @@ type RestoreStatus struct {
 	// RestoreItemAction operations for this restore which ended with an error.
 	// +optional
 	RestoreItemOperationsFailed int `json:"restoreItemOperationsFailed,omitempty"`
+
+	// DryRunResult is the result of the restore's dry-run.
+	// +optional
+	// +nullable
+	DryRunResult *RestoreDryRunResult `json:"dryRunResult,omitempty"`
+
+	// BackupRetrieval is the retrieval of the backup contents transitioned to an
+	// archive storage class of the object storage, which completes before the
+	// restore runs.
+	// +optional
+	// +nullable
+	BackupRetrieval *BackupRetrievalStatus `json:"backupRetrieval,omitempty"`
+}
+
+// BackupRetrievalStatus is the status of the retrieval of the backup contents from an
+// archive storage class of the object storage.
+type BackupRetrievalStatus struct {
+	// StorageClass is the storage class the backup contents are retrieved from.
+	StorageClass string `json:"storageClass"`
+
+	// StartTimestamp records the time the retrieval was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the backup contents were retrieved.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+}
+
+// RestoreDryRunItemState is the state of an item in the cluster compared with the item
+// that would be restored.
+// +kubebuilder:validation:Enum=Missing;Exists;Changed
+type RestoreDryRunItemState string
+
+const (
+	// RestoreDryRunItemStateMissing means the item doesn't exist in the cluster and
+	// would be created.
+	RestoreDryRunItemStateMissing RestoreDryRunItemState = "Missing"
+
+	// RestoreDryRunItemStateExists means the item exists in the cluster and is the same
+	// as the item that would be restored.
+	RestoreDryRunItemStateExists RestoreDryRunItemState = "Exists"
+
+	// RestoreDryRunItemStateChanged means the item exists in the cluster but is different
+	// from the item that would be restored.
+	RestoreDryRunItemStateChanged RestoreDryRunItemState = "Changed"
+)
+
+// RestoreDryRunResult stores the comparison of the items that would be restored with
+// the cluster.
+type RestoreDryRunResult struct {
+	// Items is the list of items that would be restored, sorted by the resource,
+	// namespace and name.
+	// +optional
+	// +nullable
+	Items []RestoreDryRunItem `json:"items,omitempty"`
+}
+
+// RestoreDryRunItem is the comparison of an item that would be restored with the cluster.
+type RestoreDryRunItem struct {
+	// Resource is the group resource of the item, e.g. "deployments.apps".
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace the item would be restored into, it's empty for
+	// cluster scoped items.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the item.
+	Name string `json:"name"`
+
+	// State is the state of the item in the cluster.
+	State RestoreDryRunItemState `json:"state"`
+
+	// ChangedFields is the list of the paths of the fields that are different in the
+	// cluster, e.g. "spec.replicas", it's only set for the changed items.
+	// +optional
+	// +nullable
+	ChangedFields []string `json:"changedFields,omitempty"`
 }
 
 // RestoreProgress stores information about the restore's execution progress
@@ -357,6 +700,10 @@ type RestoreProgress struct {
 	// ItemsRestored is the number of items that have actually been restored so far
 	// +optional
 	ItemsRestored int `json:"itemsRestored,omitempty"`
+	// ItemsWaitingForReadiness is the number of restored items the restore is waiting
+	// for to be ready before restoring the items depending on them
+	// +optional
+	ItemsWaitingForReadiness int `json:"itemsWaitingForReadiness,omitempty"`
 }
 
 // +genclient
@@ -18,6 +18,7 @@ package v1
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -42,8 +43,51 @@ type ScheduleSpec struct {
 	// Paused specifies whether the schedule is paused or not
 	// +optional
 	Paused bool `json:"paused,omitempty"`
+
+	// Jitter is the max random delay added to the time each backup
+	// is due, so that the backups of the schedules with the same Cron
+	// expression are spread over a time window instead of starting
+	// at the same time. It should be less than the interval of the
+	// Cron expression.
+	// +optional
+	// +nullable
+	Jitter *metav1.Duration `json:"jitter,omitempty"`
+
+	// StartingDeadline is how long after the due time a backup can
+	// still be started if it was missed, e.g. the Velero server was
+	// down. The missed backup is skipped once the deadline passes.
+	// If not set, a missed backup is always started.
+	// +optional
+	// +nullable
+	StartingDeadline *metav1.Duration `json:"startingDeadline,omitempty"`
+
+	// ConcurrencyPolicy specifies how to treat a new backup of the
+	// schedule when a previous one is still New or InProgress.
+	// Valid values are Allow, Forbid and Replace, defaults to Forbid.
+	// +optional
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
 }
 
+// ConcurrencyPolicy describes how a schedule treats a new backup when
+// a previous backup of the schedule is still running.
+// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+type ConcurrencyPolicy string
+
+const (
+	// ConcurrencyPolicyAllow allows the backups of the schedule to run
+	// concurrently.
+	ConcurrencyPolicyAllow ConcurrencyPolicy = "Allow"
+
+	// ConcurrencyPolicyForbid skips the new backup if a previous backup
+	// of the schedule is still running.
+	ConcurrencyPolicyForbid ConcurrencyPolicy = "Forbid"
+
+	// ConcurrencyPolicyReplace cancels the previous backups of the schedule
+	// which haven't been started and creates the new backup. The backups
+	// already InProgress can't be cancelled and keep running.
+	ConcurrencyPolicyReplace ConcurrencyPolicy = "Replace"
+)
+
 // SchedulePhase is a string representation of the lifecycle phase
 // of a Velero schedule
 // +kubebuilder:validation:Enum=New;Enabled;FailedValidation
@@ -122,7 +166,33 @@ type ScheduleList struct {
 	Items []Schedule `json:"items"`
 }
 
+// The tokens of the name template of the backups created by a schedule.
+const (
+	BackupNameTokenSchedule  = "{{schedule}}"
+	BackupNameTokenCluster   = "{{cluster}}"
+	BackupNameTokenDate      = "{{date}}"
+	BackupNameTokenTime      = "{{time}}"
+	BackupNameTokenTimestamp = "{{timestamp}}"
+)
+
 // TimestampedName returns the default backup name format based on the schedule
 func (s *Schedule) TimestampedName(timestamp time.Time) string {
 	return fmt.Sprintf("%s-%s", s.Name, timestamp.Format("20060102150405"))
 }
+
+// BackupName returns the name of the backup created by the schedule at the timestamp, it's rendered
+// from the name template of the schedule's backups, or is the timestamped name if there isn't one.
+func (s *Schedule) BackupName(timestamp time.Time, clusterName string) string {
+	if s.Spec.Template.NameTemplate == "" {
+		return s.TimestampedName(timestamp)
+	}
+
+	timestamp = timestamp.UTC()
+	return strings.NewReplacer(
+		BackupNameTokenSchedule, s.Name,
+		BackupNameTokenCluster, clusterName,
+		BackupNameTokenDate, timestamp.Format("20060102"),
+		BackupNameTokenTime, timestamp.Format("150405"),
+		BackupNameTokenTimestamp, timestamp.Format("20060102150405"),
+	).Replace(s.Spec.Template.NameTemplate)
+}
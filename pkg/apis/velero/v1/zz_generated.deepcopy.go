@@ -7,6 +7,7 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -38,6 +39,41 @@ func (in *Backup) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupDryRunResult) DeepCopyInto(out *BackupDryRunResult) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.PersistentVolumes != nil {
+		in, out := &in.PersistentVolumes, &out.PersistentVolumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupDryRunResult.
+func (in *BackupDryRunResult) DeepCopy() *BackupDryRunResult {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupDryRunResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupHooks) DeepCopyInto(out *BackupHooks) {
 	*out = *in
@@ -60,6 +96,85 @@ func (in *BackupHooks) DeepCopy() *BackupHooks {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupHookPolicy) DeepCopyInto(out *BackupHookPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupHookPolicy.
+func (in *BackupHookPolicy) DeepCopy() *BackupHookPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupHookPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupHookPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupHookPolicyList) DeepCopyInto(out *BackupHookPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackupHookPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupHookPolicyList.
+func (in *BackupHookPolicyList) DeepCopy() *BackupHookPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupHookPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupHookPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupHookPolicySpec) DeepCopyInto(out *BackupHookPolicySpec) {
+	*out = *in
+	if in.BackupSelector != nil {
+		in, out := &in.BackupSelector, &out.BackupSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Hooks.DeepCopyInto(&out.Hooks)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupHookPolicySpec.
+func (in *BackupHookPolicySpec) DeepCopy() *BackupHookPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupHookPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupList) DeepCopyInto(out *BackupList) {
 	*out = *in
@@ -112,7 +227,7 @@ func (in *BackupRepository) DeepCopyInto(out *BackupRepository) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -166,10 +281,103 @@ func (in *BackupRepositoryList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRepositoryMaintenanceStatus) DeepCopyInto(out *BackupRepositoryMaintenanceStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompleteTimestamp != nil {
+		in, out := &in.CompleteTimestamp, &out.CompleteTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.FreedBytes != nil {
+		in, out := &in.FreedBytes, &out.FreedBytes
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositoryMaintenanceStatus.
+func (in *BackupRepositoryMaintenanceStatus) DeepCopy() *BackupRepositoryMaintenanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRepositoryMaintenanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRepositoryOrphanScan) DeepCopyInto(out *BackupRepositoryOrphanScan) {
+	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.OrphanedSnapshots != nil {
+		in, out := &in.OrphanedSnapshots, &out.OrphanedSnapshots
+		*out = make([]BackupRepositoryOrphanedSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositoryOrphanScan.
+func (in *BackupRepositoryOrphanScan) DeepCopy() *BackupRepositoryOrphanScan {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRepositoryOrphanScan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRepositoryOrphanedSnapshot) DeepCopyInto(out *BackupRepositoryOrphanedSnapshot) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositoryOrphanedSnapshot.
+func (in *BackupRepositoryOrphanedSnapshot) DeepCopy() *BackupRepositoryOrphanedSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRepositoryOrphanedSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupRepositorySpec) DeepCopyInto(out *BackupRepositorySpec) {
 	*out = *in
 	out.MaintenanceFrequency = in.MaintenanceFrequency
+	if in.RepositoryCredential != nil {
+		in, out := &in.RepositoryCredential, &out.RepositoryCredential
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KopiaParameters != nil {
+		in, out := &in.KopiaParameters, &out.KopiaParameters
+		*out = new(KopiaRepositoryParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
+	if in.MaintenanceJobResources != nil {
+		in, out := &in.MaintenanceJobResources, &out.MaintenanceJobResources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositorySpec.
@@ -189,6 +397,18 @@ func (in *BackupRepositoryStatus) DeepCopyInto(out *BackupRepositoryStatus) {
 		in, out := &in.LastMaintenanceTime, &out.LastMaintenanceTime
 		*out = (*in).DeepCopy()
 	}
+	if in.RecentMaintenance != nil {
+		in, out := &in.RecentMaintenance, &out.RecentMaintenance
+		*out = make([]BackupRepositoryMaintenanceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OrphanScan != nil {
+		in, out := &in.OrphanScan, &out.OrphanScan
+		*out = new(BackupRepositoryOrphanScan)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositoryStatus.
@@ -275,6 +495,29 @@ func (in *BackupResourceHookSpec) DeepCopy() *BackupResourceHookSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRetrievalStatus) DeepCopyInto(out *BackupRetrievalStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRetrievalStatus.
+func (in *BackupRetrievalStatus) DeepCopy() *BackupRetrievalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRetrievalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 	*out = *in
@@ -335,6 +578,22 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 			}
 		}
 	}
+	if in.ClusterScopedLabelSelector != nil {
+		in, out := &in.ClusterScopedLabelSelector, &out.ClusterScopedLabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterScopedOrLabelSelectors != nil {
+		in, out := &in.ClusterScopedOrLabelSelectors, &out.ClusterScopedOrLabelSelectors
+		*out = make([]*metav1.LabelSelector, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(metav1.LabelSelector)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.SnapshotVolumes != nil {
 		in, out := &in.SnapshotVolumes, &out.SnapshotVolumes
 		*out = new(bool)
@@ -376,11 +635,31 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(corev1.TypedLocalObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ValidationPolicy != nil {
+		in, out := &in.ValidationPolicy, &out.ValidationPolicy
+		*out = new(corev1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SnapshotMoveData != nil {
 		in, out := &in.SnapshotMoveData, &out.SnapshotMoveData
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tiering != nil {
+		in, out := &in.Tiering, &out.Tiering
+		*out = new(BackupTiering)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeduplicateClusterResources != nil {
+		in, out := &in.DeduplicateClusterResources, &out.DeduplicateClusterResources
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSpec.
@@ -405,6 +684,11 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PolicyViolations != nil {
+		in, out := &in.PolicyViolations, &out.PolicyViolations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.StartTimestamp != nil {
 		in, out := &in.StartTimestamp, &out.StartTimestamp
 		*out = (*in).DeepCopy()
@@ -413,11 +697,46 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.ErrorCategories != nil {
+		in, out := &in.ErrorCategories, &out.ErrorCategories
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Progress != nil {
 		in, out := &in.Progress, &out.Progress
 		*out = new(BackupProgress)
 		**out = **in
 	}
+	if in.DryRunResult != nil {
+		in, out := &in.DryRunResult, &out.DryRunResult
+		*out = new(BackupDryRunResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeVerifications != nil {
+		in, out := &in.VolumeVerifications, &out.VolumeVerifications
+		*out = make([]BackupVolumeVerification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StorageClassTransitionTimestamp != nil {
+		in, out := &in.StorageClassTransitionTimestamp, &out.StorageClassTransitionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.VolumeReplications != nil {
+		in, out := &in.VolumeReplications, &out.VolumeReplications
+		*out = make([]BackupVolumeReplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HookPolicies != nil {
+		in, out := &in.HookPolicies, &out.HookPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStatus.
@@ -538,6 +857,11 @@ func (in *BackupStorageLocationStatus) DeepCopyInto(out *BackupStorageLocationSt
 		in, out := &in.LastValidationTime, &out.LastValidationTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(BackupStorageLocationUsage)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationStatus.
@@ -551,52 +875,153 @@ func (in *BackupStorageLocationStatus) DeepCopy() *BackupStorageLocationStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeleteBackupRequest) DeepCopyInto(out *DeleteBackupRequest) {
+func (in *BackupStorageLocationUsage) DeepCopyInto(out *BackupStorageLocationUsage) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Bytes != nil {
+		in, out := &in.Bytes, &out.Bytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.OldestBackupTime != nil {
+		in, out := &in.OldestBackupTime, &out.OldestBackupTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NewestBackupTime != nil {
+		in, out := &in.NewestBackupTime, &out.NewestBackupTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteBackupRequest.
-func (in *DeleteBackupRequest) DeepCopy() *DeleteBackupRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationUsage.
+func (in *BackupStorageLocationUsage) DeepCopy() *BackupStorageLocationUsage {
 	if in == nil {
 		return nil
 	}
-	out := new(DeleteBackupRequest)
+	out := new(BackupStorageLocationUsage)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DeleteBackupRequest) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupTierTransition) DeepCopyInto(out *BackupTierTransition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupTierTransition.
+func (in *BackupTierTransition) DeepCopy() *BackupTierTransition {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(BackupTierTransition)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeleteBackupRequestList) DeepCopyInto(out *DeleteBackupRequestList) {
+func (in *BackupTiering) DeepCopyInto(out *BackupTiering) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]DeleteBackupRequest, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = make([]BackupTierTransition, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteBackupRequestList.
-func (in *DeleteBackupRequestList) DeepCopy() *DeleteBackupRequestList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupTiering.
+func (in *BackupTiering) DeepCopy() *BackupTiering {
 	if in == nil {
 		return nil
 	}
-	out := new(DeleteBackupRequestList)
+	out := new(BackupTiering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVolumeReplication) DeepCopyInto(out *BackupVolumeReplication) {
+	*out = *in
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVolumeReplication.
+func (in *BackupVolumeReplication) DeepCopy() *BackupVolumeReplication {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVolumeReplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVolumeVerification) DeepCopyInto(out *BackupVolumeVerification) {
+	*out = *in
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVolumeVerification.
+func (in *BackupVolumeVerification) DeepCopy() *BackupVolumeVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVolumeVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeleteBackupRequest) DeepCopyInto(out *DeleteBackupRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteBackupRequest.
+func (in *DeleteBackupRequest) DeepCopy() *DeleteBackupRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(DeleteBackupRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeleteBackupRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeleteBackupRequestList) DeepCopyInto(out *DeleteBackupRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeleteBackupRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteBackupRequestList.
+func (in *DeleteBackupRequestList) DeepCopy() *DeleteBackupRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeleteBackupRequestList)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -649,7 +1074,7 @@ func (in *DownloadRequest) DeepCopyInto(out *DownloadRequest) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -706,7 +1131,7 @@ func (in *DownloadRequestList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DownloadRequestSpec) DeepCopyInto(out *DownloadRequestSpec) {
 	*out = *in
-	out.Target = in.Target
+	in.Target.DeepCopyInto(&out.Target)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadRequestSpec.
@@ -741,6 +1166,16 @@ func (in *DownloadRequestStatus) DeepCopy() *DownloadRequestStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DownloadTarget) DeepCopyInto(out *DownloadTarget) {
 	*out = *in
+	if in.IncludedResources != nil {
+		in, out := &in.IncludedResources, &out.IncludedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncludedNamespaces != nil {
+		in, out := &in.IncludedNamespaces, &out.IncludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadTarget.
@@ -762,6 +1197,7 @@ func (in *ExecHook) DeepCopyInto(out *ExecHook) {
 		copy(*out, *in)
 	}
 	out.Timeout = in.Timeout
+	out.RetryInterval = in.RetryInterval
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecHook.
@@ -801,6 +1237,32 @@ func (in *ExecRestoreHook) DeepCopy() *ExecRestoreHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostRestoreHook) DeepCopyInto(out *HostRestoreHook) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostRestoreHook.
+func (in *HostRestoreHook) DeepCopy() *HostRestoreHook {
+	if in == nil {
+		return nil
+	}
+	out := new(HostRestoreHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InitRestoreHook) DeepCopyInto(out *InitRestoreHook) {
 	*out = *in
@@ -824,6 +1286,46 @@ func (in *InitRestoreHook) DeepCopy() *InitRestoreHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KopiaRepositoryParameters) DeepCopyInto(out *KopiaRepositoryParameters) {
+	*out = *in
+	if in.CacheLimitMB != nil {
+		in, out := &in.CacheLimitMB, &out.CacheLimitMB
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ParallelUploads != nil {
+		in, out := &in.ParallelUploads, &out.ParallelUploads
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KopiaRepositoryParameters.
+func (in *KopiaRepositoryParameters) DeepCopy() *KopiaRepositoryParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KopiaRepositoryParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metadata) DeepCopyInto(out *Metadata) {
 	*out = *in
@@ -846,6 +1348,37 @@ func (in *Metadata) DeepCopy() *Metadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLock) DeepCopyInto(out *ObjectLock) {
+	*out = *in
+	out.RetentionPeriod = in.RetentionPeriod
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLock.
+func (in *ObjectLock) DeepCopy() *ObjectLock {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageEncryption) DeepCopyInto(out *ObjectStorageEncryption) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageEncryption.
+func (in *ObjectStorageEncryption) DeepCopy() *ObjectStorageEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectStorageLocation) DeepCopyInto(out *ObjectStorageLocation) {
 	*out = *in
@@ -854,6 +1387,16 @@ func (in *ObjectStorageLocation) DeepCopyInto(out *ObjectStorageLocation) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.ObjectLock != nil {
+		in, out := &in.ObjectLock, &out.ObjectLock
+		*out = new(ObjectLock)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(ObjectStorageEncryption)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageLocation.
@@ -992,7 +1535,7 @@ func (in *PodVolumeRestore) DeepCopyInto(out *PodVolumeRestore) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -1050,6 +1593,13 @@ func (in *PodVolumeRestoreList) DeepCopyObject() runtime.Object {
 func (in *PodVolumeRestoreSpec) DeepCopyInto(out *PodVolumeRestoreSpec) {
 	*out = *in
 	out.Pod = in.Pod
+	if in.HostHooks != nil {
+		in, out := &in.HostHooks, &out.HostHooks
+		*out = make([]HostRestoreHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodVolumeRestoreSpec.
@@ -1087,26 +1637,25 @@ func (in *PodVolumeRestoreStatus) DeepCopy() *PodVolumeRestoreStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Restore) DeepCopyInto(out *Restore) {
+func (in *ResourceFilterPolicy) DeepCopyInto(out *ResourceFilterPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Restore.
-func (in *Restore) DeepCopy() *Restore {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceFilterPolicy.
+func (in *ResourceFilterPolicy) DeepCopy() *ResourceFilterPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(Restore)
+	out := new(ResourceFilterPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Restore) DeepCopyObject() runtime.Object {
+func (in *ResourceFilterPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1114,53 +1663,31 @@ func (in *Restore) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RestoreHooks) DeepCopyInto(out *RestoreHooks) {
-	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = make([]RestoreResourceHookSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreHooks.
-func (in *RestoreHooks) DeepCopy() *RestoreHooks {
-	if in == nil {
-		return nil
-	}
-	out := new(RestoreHooks)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RestoreList) DeepCopyInto(out *RestoreList) {
+func (in *ResourceFilterPolicyList) DeepCopyInto(out *ResourceFilterPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Restore, len(*in))
+		*out = make([]ResourceFilterPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreList.
-func (in *RestoreList) DeepCopy() *RestoreList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceFilterPolicyList.
+func (in *ResourceFilterPolicyList) DeepCopy() *ResourceFilterPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(RestoreList)
+	out := new(ResourceFilterPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RestoreList) DeepCopyObject() runtime.Object {
+func (in *ResourceFilterPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1168,47 +1695,7 @@ func (in *RestoreList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RestoreProgress) DeepCopyInto(out *RestoreProgress) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreProgress.
-func (in *RestoreProgress) DeepCopy() *RestoreProgress {
-	if in == nil {
-		return nil
-	}
-	out := new(RestoreProgress)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RestoreResourceHook) DeepCopyInto(out *RestoreResourceHook) {
-	*out = *in
-	if in.Exec != nil {
-		in, out := &in.Exec, &out.Exec
-		*out = new(ExecRestoreHook)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Init != nil {
-		in, out := &in.Init, &out.Init
-		*out = new(InitRestoreHook)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreResourceHook.
-func (in *RestoreResourceHook) DeepCopy() *RestoreResourceHook {
-	if in == nil {
-		return nil
-	}
-	out := new(RestoreResourceHook)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RestoreResourceHookSpec) DeepCopyInto(out *RestoreResourceHookSpec) {
+func (in *ResourceFilterPolicySpec) DeepCopyInto(out *ResourceFilterPolicySpec) {
 	*out = *in
 	if in.IncludedNamespaces != nil {
 		in, out := &in.IncludedNamespaces, &out.IncludedNamespaces
@@ -1230,13 +1717,361 @@ func (in *RestoreResourceHookSpec) DeepCopyInto(out *RestoreResourceHookSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.LabelSelector != nil {
-		in, out := &in.LabelSelector, &out.LabelSelector
-		*out = new(metav1.LabelSelector)
-		(*in).DeepCopyInto(*out)
+	if in.IncludedClusterScopedResources != nil {
+		in, out := &in.IncludedClusterScopedResources, &out.IncludedClusterScopedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.PostHooks != nil {
-		in, out := &in.PostHooks, &out.PostHooks
+	if in.ExcludedClusterScopedResources != nil {
+		in, out := &in.ExcludedClusterScopedResources, &out.ExcludedClusterScopedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncludedNamespaceScopedResources != nil {
+		in, out := &in.IncludedNamespaceScopedResources, &out.IncludedNamespaceScopedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedNamespaceScopedResources != nil {
+		in, out := &in.ExcludedNamespaceScopedResources, &out.ExcludedNamespaceScopedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrLabelSelectors != nil {
+		in, out := &in.OrLabelSelectors, &out.OrLabelSelectors
+		*out = make([]*metav1.LabelSelector, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(metav1.LabelSelector)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ClusterScopedLabelSelector != nil {
+		in, out := &in.ClusterScopedLabelSelector, &out.ClusterScopedLabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterScopedOrLabelSelectors != nil {
+		in, out := &in.ClusterScopedOrLabelSelectors, &out.ClusterScopedOrLabelSelectors
+		*out = make([]*metav1.LabelSelector, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(metav1.LabelSelector)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.IncludeClusterResources != nil {
+		in, out := &in.IncludeClusterResources, &out.IncludeClusterResources
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceFilterPolicySpec.
+func (in *ResourceFilterPolicySpec) DeepCopy() *ResourceFilterPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceFilterPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Restore) DeepCopyInto(out *Restore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Restore.
+func (in *Restore) DeepCopy() *Restore {
+	if in == nil {
+		return nil
+	}
+	out := new(Restore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Restore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreDryRunItem) DeepCopyInto(out *RestoreDryRunItem) {
+	*out = *in
+	if in.ChangedFields != nil {
+		in, out := &in.ChangedFields, &out.ChangedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreDryRunItem.
+func (in *RestoreDryRunItem) DeepCopy() *RestoreDryRunItem {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreDryRunItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreDryRunResult) DeepCopyInto(out *RestoreDryRunResult) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RestoreDryRunItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreDryRunResult.
+func (in *RestoreDryRunResult) DeepCopy() *RestoreDryRunResult {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreDryRunResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreHooks) DeepCopyInto(out *RestoreHooks) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]RestoreResourceHookSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Jobs != nil {
+		in, out := &in.Jobs, &out.Jobs
+		*out = make([]RestoreJobHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreHooks.
+func (in *RestoreHooks) DeepCopy() *RestoreHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreItemRetryPolicy) DeepCopyInto(out *RestoreItemRetryPolicy) {
+	*out = *in
+	out.InitialBackoff = in.InitialBackoff
+	out.MaxBackoff = in.MaxBackoff
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreItemRetryPolicy.
+func (in *RestoreItemRetryPolicy) DeepCopy() *RestoreItemRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreItemRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreJobHook) DeepCopyInto(out *RestoreJobHook) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreJobHook.
+func (in *RestoreJobHook) DeepCopy() *RestoreJobHook {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreJobHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreList) DeepCopyInto(out *RestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Restore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreList.
+func (in *RestoreList) DeepCopy() *RestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestorePVCSizeOverrides) DeepCopyInto(out *RestorePVCSizeOverrides) {
+	*out = *in
+	if in.Sizes != nil {
+		in, out := &in.Sizes, &out.Sizes
+		*out = make(map[string]resource.Quantity, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestorePVCSizeOverrides.
+func (in *RestorePVCSizeOverrides) DeepCopy() *RestorePVCSizeOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(RestorePVCSizeOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreProgress) DeepCopyInto(out *RestoreProgress) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreProgress.
+func (in *RestoreProgress) DeepCopy() *RestoreProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreReadinessGates) DeepCopyInto(out *RestoreReadinessGates) {
+	*out = *in
+	if in.IncludedResources != nil {
+		in, out := &in.IncludedResources, &out.IncludedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreReadinessGates.
+func (in *RestoreReadinessGates) DeepCopy() *RestoreReadinessGates {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreReadinessGates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreResourceHook) DeepCopyInto(out *RestoreResourceHook) {
+	*out = *in
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(ExecRestoreHook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Init != nil {
+		in, out := &in.Init, &out.Init
+		*out = new(InitRestoreHook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(HostRestoreHook)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreResourceHook.
+func (in *RestoreResourceHook) DeepCopy() *RestoreResourceHook {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreResourceHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreResourceHookSpec) DeepCopyInto(out *RestoreResourceHookSpec) {
+	*out = *in
+	if in.IncludedNamespaces != nil {
+		in, out := &in.IncludedNamespaces, &out.IncludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncludedResources != nil {
+		in, out := &in.IncludedResources, &out.IncludedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedResources != nil {
+		in, out := &in.ExcludedResources, &out.ExcludedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostHooks != nil {
+		in, out := &in.PostHooks, &out.PostHooks
 		*out = make([]RestoreResourceHook, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
@@ -1254,6 +2089,154 @@ func (in *RestoreResourceHookSpec) DeepCopy() *RestoreResourceHookSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreScaling) DeepCopyInto(out *RestoreScaling) {
+	*out = *in
+	if in.IncludedResources != nil {
+		in, out := &in.IncludedResources, &out.IncludedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreScaling.
+func (in *RestoreScaling) DeepCopy() *RestoreScaling {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreScaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreSchedule) DeepCopyInto(out *RestoreSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSchedule.
+func (in *RestoreSchedule) DeepCopy() *RestoreSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RestoreSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreScheduleList) DeepCopyInto(out *RestoreScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RestoreSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreScheduleList.
+func (in *RestoreScheduleList) DeepCopy() *RestoreScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RestoreScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreScheduleSpec) DeepCopyInto(out *RestoreScheduleSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	if in.RestoresToKeep != nil {
+		in, out := &in.RestoresToKeep, &out.RestoresToKeep
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreScheduleSpec.
+func (in *RestoreScheduleSpec) DeepCopy() *RestoreScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreScheduleStatus) DeepCopyInto(out *RestoreScheduleStatus) {
+	*out = *in
+	if in.LastRestore != nil {
+		in, out := &in.LastRestore, &out.LastRestore
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulRestore != nil {
+		in, out := &in.LastSuccessfulRestore, &out.LastSuccessfulRestore
+		*out = (*in).DeepCopy()
+	}
+	if in.ValidationErrors != nil {
+		in, out := &in.ValidationErrors, &out.ValidationErrors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreScheduleStatus.
+func (in *RestoreScheduleStatus) DeepCopy() *RestoreScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreSecretEncryption) DeepCopyInto(out *RestoreSecretEncryption) {
+	*out = *in
+	if in.SensitiveTypes != nil {
+		in, out := &in.SensitiveTypes, &out.SensitiveTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSecretEncryption.
+func (in *RestoreSecretEncryption) DeepCopy() *RestoreSecretEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreSecretEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 	*out = *in
@@ -1284,6 +2267,20 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.StorageClassMappings != nil {
+		in, out := &in.StorageClassMappings, &out.StorageClassMappings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ZoneMappings != nil {
+		in, out := &in.ZoneMappings, &out.ZoneMappings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.LabelSelector != nil {
 		in, out := &in.LabelSelector, &out.LabelSelector
 		*out = new(metav1.LabelSelector)
@@ -1321,12 +2318,59 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		**out = **in
 	}
 	in.Hooks.DeepCopyInto(&out.Hooks)
+	if in.ExistingResourcePolicies != nil {
+		in, out := &in.ExistingResourcePolicies, &out.ExistingResourcePolicies
+		*out = make(map[string]PolicyType, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	out.ItemOperationTimeout = in.ItemOperationTimeout
 	if in.ResourceModifier != nil {
 		in, out := &in.ResourceModifier, &out.ResourceModifier
 		*out = new(corev1.TypedLocalObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ResourcePriorities != nil {
+		in, out := &in.ResourcePriorities, &out.ResourcePriorities
+		*out = new(corev1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scaling != nil {
+		in, out := &in.Scaling, &out.Scaling
+		*out = new(RestoreScaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReadinessGates != nil {
+		in, out := &in.ReadinessGates, &out.ReadinessGates
+		*out = new(RestoreReadinessGates)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StrictQuota != nil {
+		in, out := &in.StrictQuota, &out.StrictQuota
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PVCSizeOverrides != nil {
+		in, out := &in.PVCSizeOverrides, &out.PVCSizeOverrides
+		*out = new(RestorePVCSizeOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ItemRetryPolicy != nil {
+		in, out := &in.ItemRetryPolicy, &out.ItemRetryPolicy
+		*out = new(RestoreItemRetryPolicy)
+		**out = **in
+	}
+	if in.SecretEncryption != nil {
+		in, out := &in.SecretEncryption, &out.SecretEncryption
+		*out = new(RestoreSecretEncryption)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSpec.
@@ -1347,6 +2391,13 @@ func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ErrorCategories != nil {
+		in, out := &in.ErrorCategories, &out.ErrorCategories
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.StartTimestamp != nil {
 		in, out := &in.StartTimestamp, &out.StartTimestamp
 		*out = (*in).DeepCopy()
@@ -1360,6 +2411,16 @@ func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
 		*out = new(RestoreProgress)
 		**out = **in
 	}
+	if in.DryRunResult != nil {
+		in, out := &in.DryRunResult, &out.DryRunResult
+		*out = new(RestoreDryRunResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackupRetrieval != nil {
+		in, out := &in.BackupRetrieval, &out.BackupRetrieval
+		*out = new(BackupRetrievalStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreStatus.
@@ -1465,6 +2526,16 @@ func (in *ScheduleSpec) DeepCopyInto(out *ScheduleSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Jitter != nil {
+		in, out := &in.Jitter, &out.Jitter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.StartingDeadline != nil {
+		in, out := &in.StartingDeadline, &out.StartingDeadline
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleSpec.
@@ -0,0 +1,114 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupCopySpec is the specification for a BackupCopy.
+type BackupCopySpec struct {
+	// BackupName is the name of the completed backup to copy.
+	BackupName string `json:"backupName"`
+
+	// StorageLocation is the name of the BackupStorageLocation
+	// the backup is copied to.
+	StorageLocation string `json:"storageLocation"`
+}
+
+// BackupCopyPhase represents the lifecycle phase of a BackupCopy.
+// +kubebuilder:validation:Enum=New;InProgress;Completed;Failed;FailedValidation
+type BackupCopyPhase string
+
+const (
+	BackupCopyPhaseNew              BackupCopyPhase = "New"
+	BackupCopyPhaseInProgress       BackupCopyPhase = "InProgress"
+	BackupCopyPhaseCompleted        BackupCopyPhase = "Completed"
+	BackupCopyPhaseFailed           BackupCopyPhase = "Failed"
+	BackupCopyPhaseFailedValidation BackupCopyPhase = "FailedValidation"
+)
+
+// BackupCopyStatus is the current status of a BackupCopy.
+type BackupCopyStatus struct {
+	// Phase is the current state of the BackupCopy.
+	// +optional
+	Phase BackupCopyPhase `json:"phase,omitempty"`
+
+	// Message is a message about the current status of the BackupCopy.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// TotalVolumeSnapshots is the number of the kopia snapshots of the volumes of the backup to copy.
+	// +optional
+	TotalVolumeSnapshots int `json:"totalVolumeSnapshots,omitempty"`
+
+	// CopiedVolumeSnapshots is the number of the kopia snapshots of the volumes copied to the
+	// repositories of the target backup storage location.
+	// +optional
+	CopiedVolumeSnapshots int `json:"copiedVolumeSnapshots,omitempty"`
+
+	// StartTimestamp records the time the copy was started.
+	// The server's time is used for StartTimestamps
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the copy was completed.
+	// The server's time is used for CompletionTimestamps
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Backup",type="string",JSONPath=".spec.backupName",description="Name of the backup to copy"
+// +kubebuilder:printcolumn:name="Storage Location",type="string",JSONPath=".spec.storageLocation",description="Name of the backup storage location the backup is copied to"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="BackupCopy status such as New/InProgress"
+// +kubebuilder:printcolumn:name="Copied",type="integer",JSONPath=".status.copiedVolumeSnapshots",description="Number of the copied volume snapshots"
+// +kubebuilder:printcolumn:name="Total",type="integer",JSONPath=".status.totalVolumeSnapshots",description="Number of the volume snapshots to copy"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since this BackupCopy was created"
+
+// BackupCopy is a request to copy a completed backup, including its metadata and the kopia
+// snapshots of its volumes, from the backup storage location of the backup to another one.
+type BackupCopy struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec BackupCopySpec `json:"spec,omitempty"`
+
+	// +optional
+	Status BackupCopyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:rbac:groups=velero.io,resources=backupcopies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=backupcopies/status,verbs=get;update;patch
+
+// BackupCopyList is a list of BackupCopies.
+type BackupCopyList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []BackupCopy `json:"items"`
+}
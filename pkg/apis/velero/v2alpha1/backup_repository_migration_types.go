@@ -0,0 +1,120 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupRepositoryMigrationSpec is the specification for a BackupRepositoryMigration.
+type BackupRepositoryMigrationSpec struct {
+	// VolumeNamespace is the namespace whose pod volume backups are migrated
+	// from the restic repository to the kopia repository.
+	VolumeNamespace string `json:"volumeNamespace"`
+
+	// BackupStorageLocation is the name of the BackupStorageLocation
+	// that contains the repositories.
+	BackupStorageLocation string `json:"backupStorageLocation"`
+}
+
+// BackupRepositoryMigrationPhase represents the lifecycle phase of a BackupRepositoryMigration.
+// +kubebuilder:validation:Enum=New;InProgress;Completed;PartiallyFailed;Failed
+type BackupRepositoryMigrationPhase string
+
+const (
+	BackupRepositoryMigrationPhaseNew             BackupRepositoryMigrationPhase = "New"
+	BackupRepositoryMigrationPhaseInProgress      BackupRepositoryMigrationPhase = "InProgress"
+	BackupRepositoryMigrationPhaseCompleted       BackupRepositoryMigrationPhase = "Completed"
+	BackupRepositoryMigrationPhasePartiallyFailed BackupRepositoryMigrationPhase = "PartiallyFailed"
+	BackupRepositoryMigrationPhaseFailed          BackupRepositoryMigrationPhase = "Failed"
+)
+
+// BackupRepositoryMigrationStatus is the current status of a BackupRepositoryMigration.
+type BackupRepositoryMigrationStatus struct {
+	// Phase is the current state of the BackupRepositoryMigration.
+	// +optional
+	Phase BackupRepositoryMigrationPhase `json:"phase,omitempty"`
+
+	// Message is a message about the current status of the BackupRepositoryMigration.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// TotalPodVolumeBackups is the number of the pod volume backups with restic snapshots to migrate.
+	// +optional
+	TotalPodVolumeBackups int `json:"totalPodVolumeBackups,omitempty"`
+
+	// MigratedPodVolumeBackups is the number of the pod volume backups whose snapshots are
+	// migrated to the kopia repository.
+	// +optional
+	MigratedPodVolumeBackups int `json:"migratedPodVolumeBackups,omitempty"`
+
+	// FailedPodVolumeBackups is the names of the pod volume backups failed to be migrated.
+	// +optional
+	// +nullable
+	FailedPodVolumeBackups []string `json:"failedPodVolumeBackups,omitempty"`
+
+	// StartTimestamp records the time the migration was started.
+	// The server's time is used for StartTimestamps
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the migration was completed.
+	// The server's time is used for CompletionTimestamps
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Namespace",type="string",JSONPath=".spec.volumeNamespace",description="Namespace whose pod volume backups are migrated"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="BackupRepositoryMigration status such as New/InProgress"
+// +kubebuilder:printcolumn:name="Migrated",type="integer",JSONPath=".status.migratedPodVolumeBackups",description="Number of the migrated pod volume backups"
+// +kubebuilder:printcolumn:name="Total",type="integer",JSONPath=".status.totalPodVolumeBackups",description="Number of the pod volume backups to migrate"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since this BackupRepositoryMigration was created"
+
+// BackupRepositoryMigration is a request to rewrite the restic snapshots of the pod volume backups
+// of a namespace into the kopia repository, so that the backups remain restorable after switching
+// the uploader type from restic to kopia.
+type BackupRepositoryMigration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec BackupRepositoryMigrationSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status BackupRepositoryMigrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:rbac:groups=velero.io,resources=backuprepositorymigrations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=backuprepositorymigrations/status,verbs=get;update;patch
+
+// BackupRepositoryMigrationList is a list of BackupRepositoryMigrations.
+type BackupRepositoryMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []BackupRepositoryMigration `json:"items"`
+}
@@ -51,6 +51,12 @@ type DataDownloadSpec struct {
 	// when the DataDownload is in InProgress phase
 	Cancel bool `json:"cancel,omitempty"`
 
+	// Paused indicates request to pause the ongoing DataDownload. It can be set
+	// when the DataDownload is in InProgress phase, the data movement is stopped
+	// and resumed from the last checkpoint once the flag is cleared.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
 	// OperationTimeout specifies the time used to wait internal operations,
 	// before returning error as timeout.
 	OperationTimeout metav1.Duration `json:"operationTimeout"`
@@ -69,7 +75,7 @@ type TargetVolumeSpec struct {
 }
 
 // DataDownloadPhase represents the lifecycle phase of a DataDownload.
-// +kubebuilder:validation:Enum=New;Accepted;Prepared;InProgress;Canceling;Canceled;Completed;Failed
+// +kubebuilder:validation:Enum=New;Accepted;Prepared;InProgress;Paused;Canceling;Canceled;Completed;Failed
 type DataDownloadPhase string
 
 const (
@@ -77,6 +83,7 @@ const (
 	DataDownloadPhaseAccepted   DataDownloadPhase = "Accepted"
 	DataDownloadPhasePrepared   DataDownloadPhase = "Prepared"
 	DataDownloadPhaseInProgress DataDownloadPhase = "InProgress"
+	DataDownloadPhasePaused     DataDownloadPhase = "Paused"
 	DataDownloadPhaseCanceling  DataDownloadPhase = "Canceling"
 	DataDownloadPhaseCanceled   DataDownloadPhase = "Canceled"
 	DataDownloadPhaseCompleted  DataDownloadPhase = "Completed"
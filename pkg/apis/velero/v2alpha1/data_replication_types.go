@@ -0,0 +1,146 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
+)
+
+// DataReplicationSpec is the specification for a DataReplication.
+type DataReplicationSpec struct {
+	// BackupName is the name of the backup whose data mover snapshot is replicated.
+	BackupName string `json:"backupName"`
+
+	// DataUpload is the name of the DataUpload which took the snapshot.
+	DataUpload string `json:"dataUpload"`
+
+	// SourcePVC is the name of the PVC which the snapshot is taken for.
+	SourcePVC string `json:"sourcePVC"`
+
+	// SourceNamespace is the original namespace where the volume is backed up from.
+	SourceNamespace string `json:"sourceNamespace"`
+
+	// BackupStorageLocation is the name of the backup storage location
+	// where the backup repository with the snapshot is stored.
+	BackupStorageLocation string `json:"backupStorageLocation"`
+
+	// TargetBackupStorageLocation is the name of the backup storage location
+	// where the backup repository the snapshot is replicated to is stored.
+	TargetBackupStorageLocation string `json:"targetBackupStorageLocation"`
+
+	// DataMover specifies the data mover which took the snapshot.
+	// If DataMover is "" or "velero", the built-in data mover will be used.
+	// +optional
+	DataMover string `json:"datamover,omitempty"`
+
+	// SnapshotID is the ID of the snapshot to be replicated in the backup repository.
+	SnapshotID string `json:"snapshotID"`
+}
+
+// DataReplicationPhase represents the lifecycle phase of a DataReplication.
+// +kubebuilder:validation:Enum=New;Accepted;InProgress;Completed;Failed
+type DataReplicationPhase string
+
+const (
+	DataReplicationPhaseNew        DataReplicationPhase = "New"
+	DataReplicationPhaseAccepted   DataReplicationPhase = "Accepted"
+	DataReplicationPhaseInProgress DataReplicationPhase = "InProgress"
+	DataReplicationPhaseCompleted  DataReplicationPhase = "Completed"
+	DataReplicationPhaseFailed     DataReplicationPhase = "Failed"
+)
+
+// DataReplicationStatus is the current status of a DataReplication.
+type DataReplicationStatus struct {
+	// Phase is the current state of the DataReplication.
+	// +optional
+	Phase DataReplicationPhase `json:"phase,omitempty"`
+
+	// Message is a message about the DataReplication's status.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// SnapshotID is the ID of the replica of the snapshot in the backup repository
+	// of the target backup storage location.
+	// +optional
+	SnapshotID string `json:"snapshotID,omitempty"`
+
+	// StartTimestamp records the time the replication was started.
+	// The server's time is used for StartTimestamps
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the replication was completed.
+	// Completion time is recorded even on failed replications.
+	// The server's time is used for CompletionTimestamps
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Progress holds the total number of bytes of the snapshot and the current
+	// number of replicated bytes.
+	// +optional
+	Progress shared.DataMoveOperationProgress `json:"progress,omitempty"`
+
+	// Node is name of the node where the DataReplication is processed.
+	// +optional
+	Node string `json:"node,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Backup",type="string",JSONPath=".spec.backupName",description="Name of the backup whose snapshot is replicated"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="DataReplication status such as New/InProgress"
+// +kubebuilder:printcolumn:name="Started",type="date",JSONPath=".status.startTimestamp",description="Time duration since this DataReplication was started"
+// +kubebuilder:printcolumn:name="Bytes Done",type="integer",format="int64",JSONPath=".status.progress.bytesDone",description="Replicated bytes"
+// +kubebuilder:printcolumn:name="Total Bytes",type="integer",format="int64",JSONPath=".status.progress.totalBytes",description="Total bytes"
+// +kubebuilder:printcolumn:name="Target Storage Location",type="string",JSONPath=".spec.targetBackupStorageLocation",description="Name of the Backup Storage Location the snapshot is replicated to"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since this DataReplication was created"
+// +kubebuilder:printcolumn:name="Node",type="string",JSONPath=".status.node",description="Name of the node where the DataReplication is processed"
+
+// DataReplication is a request to replicate the data mover snapshot of a volume from the backup
+// repository of a backup storage location to the backup repository of another one, it is
+// processed by the node-agent.
+type DataReplication struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec DataReplicationSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status DataReplicationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:rbac:groups=velero.io,resources=datareplications,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=datareplications/status,verbs=get;update;patch
+
+// DataReplicationList is a list of DataReplications.
+type DataReplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []DataReplication `json:"items"`
+}
@@ -57,6 +57,12 @@ type DataUploadSpec struct {
 	// when the DataUpload is in InProgress phase
 	Cancel bool `json:"cancel,omitempty"`
 
+	// Paused indicates request to pause the ongoing DataUpload. It can be set
+	// when the DataUpload is in InProgress phase, the data movement is stopped
+	// and resumed from the last checkpoint once the flag is cleared.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
 	// OperationTimeout specifies the time used to wait internal operations,
 	// before returning error as timeout.
 	OperationTimeout metav1.Duration `json:"operationTimeout"`
@@ -79,10 +85,15 @@ type CSISnapshotSpec struct {
 	// SnapshotClass is the name of the snapshot class that the volume snapshot is created with
 	// +optional
 	SnapshotClass string `json:"snapshotClass"`
+
+	// VolumeGroup is the value of the volume group label of the PVC, if the volume snapshot is taken
+	// together with the snapshots of the other PVCs in the group by a VolumeGroupSnapshot
+	// +optional
+	VolumeGroup string `json:"volumeGroup,omitempty"`
 }
 
 // DataUploadPhase represents the lifecycle phase of a DataUpload.
-// +kubebuilder:validation:Enum=New;Accepted;Prepared;InProgress;Canceling;Canceled;Completed;Failed
+// +kubebuilder:validation:Enum=New;Accepted;Prepared;InProgress;Paused;Canceling;Canceled;Completed;Failed
 type DataUploadPhase string
 
 const (
@@ -90,6 +101,7 @@ const (
 	DataUploadPhaseAccepted   DataUploadPhase = "Accepted"
 	DataUploadPhasePrepared   DataUploadPhase = "Prepared"
 	DataUploadPhaseInProgress DataUploadPhase = "InProgress"
+	DataUploadPhasePaused     DataUploadPhase = "Paused"
 	DataUploadPhaseCanceling  DataUploadPhase = "Canceling"
 	DataUploadPhaseCanceled   DataUploadPhase = "Canceled"
 	DataUploadPhaseCompleted  DataUploadPhase = "Completed"
@@ -141,6 +153,11 @@ type DataUploadStatus struct {
 	// +optional
 	Progress shared.DataMoveOperationProgress `json:"progress,omitempty"`
 
+	// UploadStats holds the logical size of the volume and the size of the
+	// data uploaded to the backup repository.
+	// +optional
+	UploadStats shared.UploadStats `json:"uploadStats,omitempty"`
+
 	// Node is name of the node where the DataUpload is processed.
 	// +optional
 	Node string `json:"node,omitempty"`
@@ -212,4 +229,9 @@ type DataUploadResult struct {
 	// +optional
 	// +nullable
 	DataMoverResult *map[string]string `json:"dataMoverResult,omitempty"`
+
+	// VolumeGroup is the volume group of the PVC the DataUpload is for, the PVCs in the same
+	// group are restored together.
+	// +optional
+	VolumeGroup string `json:"volumeGroup,omitempty"`
 }
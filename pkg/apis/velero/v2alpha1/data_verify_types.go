@@ -0,0 +1,148 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
+)
+
+// DataVerifySpec is the specification for a DataVerify.
+type DataVerifySpec struct {
+	// BackupName is the name of the backup whose data mover snapshot is verified.
+	BackupName string `json:"backupName"`
+
+	// DataUpload is the name of the DataUpload which took the snapshot.
+	DataUpload string `json:"dataUpload"`
+
+	// SourcePVC is the name of the PVC which the snapshot is taken for.
+	SourcePVC string `json:"sourcePVC"`
+
+	// SourceNamespace is the original namespace where the volume is backed up from.
+	SourceNamespace string `json:"sourceNamespace"`
+
+	// BackupStorageLocation is the name of the backup storage location
+	// where the backup repository is stored.
+	BackupStorageLocation string `json:"backupStorageLocation"`
+
+	// DataMover specifies the data mover which took the snapshot.
+	// If DataMover is "" or "velero", the built-in data mover will be used.
+	// +optional
+	DataMover string `json:"datamover,omitempty"`
+
+	// SnapshotID is the ID of the snapshot to be verified in the backup repository.
+	SnapshotID string `json:"snapshotID"`
+
+	// VerifyFilesPercent is the percentage of the files in the snapshot whose contents are
+	// downloaded and validated, the directories and the metadata of all the files are always validated.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	VerifyFilesPercent int `json:"verifyFilesPercent,omitempty"`
+}
+
+// DataVerifyPhase represents the lifecycle phase of a DataVerify.
+// +kubebuilder:validation:Enum=New;Accepted;InProgress;Completed;Failed
+type DataVerifyPhase string
+
+const (
+	DataVerifyPhaseNew        DataVerifyPhase = "New"
+	DataVerifyPhaseAccepted   DataVerifyPhase = "Accepted"
+	DataVerifyPhaseInProgress DataVerifyPhase = "InProgress"
+	DataVerifyPhaseCompleted  DataVerifyPhase = "Completed"
+	DataVerifyPhaseFailed     DataVerifyPhase = "Failed"
+)
+
+// DataVerifyStatus is the current status of a DataVerify.
+type DataVerifyStatus struct {
+	// Phase is the current state of the DataVerify.
+	// +optional
+	Phase DataVerifyPhase `json:"phase,omitempty"`
+
+	// Message is a message about the DataVerify's status.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Errors are the entries of the snapshot failed to be verified, they are reported
+	// when the snapshot is corrupted.
+	// +optional
+	// +nullable
+	Errors []string `json:"errors,omitempty"`
+
+	// StartTimestamp records the time the verification was started.
+	// The server's time is used for StartTimestamps
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the verification was completed.
+	// Completion time is recorded even on failed verifications.
+	// The server's time is used for CompletionTimestamps
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Progress holds the total number of bytes of the snapshot and the current
+	// number of verified bytes.
+	// +optional
+	Progress shared.DataMoveOperationProgress `json:"progress,omitempty"`
+
+	// Node is name of the node where the DataVerify is processed.
+	// +optional
+	Node string `json:"node,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Backup",type="string",JSONPath=".spec.backupName",description="Name of the backup whose snapshot is verified"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="DataVerify status such as New/InProgress"
+// +kubebuilder:printcolumn:name="Started",type="date",JSONPath=".status.startTimestamp",description="Time duration since this DataVerify was started"
+// +kubebuilder:printcolumn:name="Bytes Done",type="integer",format="int64",JSONPath=".status.progress.bytesDone",description="Verified bytes"
+// +kubebuilder:printcolumn:name="Total Bytes",type="integer",format="int64",JSONPath=".status.progress.totalBytes",description="Total bytes"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since this DataVerify was created"
+// +kubebuilder:printcolumn:name="Node",type="string",JSONPath=".status.node",description="Name of the node where the DataVerify is processed"
+
+// DataVerify is a request to verify the integrity of the data mover snapshot of a volume in the
+// backup repository without restoring it, it is processed by the node-agent.
+type DataVerify struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec DataVerifySpec `json:"spec,omitempty"`
+
+	// +optional
+	Status DataVerifyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:rbac:groups=velero.io,resources=dataverifies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=dataverifies/status,verbs=get;update;patch
+
+// DataVerifyList is a list of DataVerifies.
+type DataVerifyList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []DataVerify `json:"items"`
+}
@@ -0,0 +1,96 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeAgentDataPathStatus is the data path state reported by the node-agent of a node.
+type NodeAgentDataPathStatus struct {
+	// ConcurrentLimit is the configured number of data path instances that could run
+	// concurrently on the node.
+	// +optional
+	ConcurrentLimit int `json:"concurrentLimit,omitempty"`
+
+	// PerNamespaceConcurrentLimits is the configured number of data path instances that
+	// could run concurrently on the node for the specific source namespaces.
+	// +optional
+	// +nullable
+	PerNamespaceConcurrentLimits map[string]int `json:"perNamespaceConcurrentLimits,omitempty"`
+
+	// RunningDataPaths is the names of the DataUploads, DataDownloads, PodVolumeBackups
+	// and PodVolumeRestores whose data path instances are running on the node.
+	// +optional
+	// +nullable
+	RunningDataPaths []string `json:"runningDataPaths,omitempty"`
+
+	// QueuedDataUploads is the names of the prepared DataUploads on the node that are
+	// waiting for a data path instance.
+	// +optional
+	// +nullable
+	QueuedDataUploads []string `json:"queuedDataUploads,omitempty"`
+
+	// QueuedDataDownloads is the names of the prepared DataDownloads on the node that are
+	// waiting for a data path instance.
+	// +optional
+	// +nullable
+	QueuedDataDownloads []string `json:"queuedDataDownloads,omitempty"`
+
+	// QueuedPodVolumeBackups is the names of the new PodVolumeBackups on the node that are
+	// waiting for a data path instance.
+	// +optional
+	// +nullable
+	QueuedPodVolumeBackups []string `json:"queuedPodVolumeBackups,omitempty"`
+
+	// LastUpdateTime is the last time the status was updated by the node-agent.
+	// +optional
+	// +nullable
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Limit",type="integer",JSONPath=".status.concurrentLimit",description="Number of data path instances that could run concurrently"
+// +kubebuilder:printcolumn:name="Last Update",type="date",JSONPath=".status.lastUpdateTime",description="Time duration since the status was updated"
+
+// NodeAgentStatus reports the data path state of the node-agent running on a node, it is
+// named after the node.
+type NodeAgentStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Status NodeAgentDataPathStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:rbac:groups=velero.io,resources=nodeagentstatuses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=nodeagentstatuses/status,verbs=get;update;patch
+
+// NodeAgentStatusList is a list of NodeAgentStatuses.
+type NodeAgentStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []NodeAgentStatus `json:"items"`
+}
@@ -46,8 +46,13 @@ func newTypeInfo(pluralName string, itemType, itemListType runtime.Object) typeI
 // API group, keyed on Kind.
 func CustomResources() map[string]typeInfo {
 	return map[string]typeInfo{
-		"DataUpload":   newTypeInfo("datauploads", &DataUpload{}, &DataUploadList{}),
-		"DataDownload": newTypeInfo("datadownloads", &DataDownload{}, &DataDownloadList{}),
+		"DataUpload":                newTypeInfo("datauploads", &DataUpload{}, &DataUploadList{}),
+		"DataDownload":              newTypeInfo("datadownloads", &DataDownload{}, &DataDownloadList{}),
+		"DataVerify":                newTypeInfo("dataverifies", &DataVerify{}, &DataVerifyList{}),
+		"DataReplication":           newTypeInfo("datareplications", &DataReplication{}, &DataReplicationList{}),
+		"NodeAgentStatus":           newTypeInfo("nodeagentstatuses", &NodeAgentStatus{}, &NodeAgentStatusList{}),
+		"BackupRepositoryMigration": newTypeInfo("backuprepositorymigrations", &BackupRepositoryMigration{}, &BackupRepositoryMigrationList{}),
+		"BackupCopy":                newTypeInfo("backupcopies", &BackupCopy{}, &BackupCopyList{}),
 	}
 }
 
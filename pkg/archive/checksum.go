@@ -0,0 +1,155 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// Checksums maps the paths of the files in a backup tarball to the hex encoded SHA256
+// checksums of their contents.
+type Checksums map[string]string
+
+// ChecksumWriter is a tar writer which computes the checksums of the regular files
+// written to it.
+type ChecksumWriter struct {
+	*tar.Writer
+	checksums Checksums
+	name      string
+	hash      hash.Hash
+}
+
+// NewChecksumWriter returns a ChecksumWriter writing to the tar writer.
+func NewChecksumWriter(tw *tar.Writer) *ChecksumWriter {
+	return &ChecksumWriter{
+		Writer:    tw,
+		checksums: make(Checksums),
+	}
+}
+
+func (w *ChecksumWriter) WriteHeader(hdr *tar.Header) error {
+	w.finish()
+	if hdr.Typeflag == tar.TypeReg {
+		w.name = hdr.Name
+		w.hash = sha256.New()
+	}
+	return w.Writer.WriteHeader(hdr)
+}
+
+func (w *ChecksumWriter) Write(b []byte) (int, error) {
+	n, err := w.Writer.Write(b)
+	if w.hash != nil {
+		w.hash.Write(b[:n])
+	}
+	return n, err
+}
+
+// Checksums returns the checksums of the files written so far.
+func (w *ChecksumWriter) Checksums() Checksums {
+	w.finish()
+	return w.checksums
+}
+
+// finish records the checksum of the file being written.
+func (w *ChecksumWriter) finish() {
+	if w.hash == nil {
+		return
+	}
+	w.checksums[w.name] = hex.EncodeToString(w.hash.Sum(nil))
+	w.name, w.hash = "", nil
+}
+
+// ComputeChecksums computes the checksums of the regular files in the compressed tarball,
+// the compression algorithm of the tarball is detected automatically.
+func ComputeChecksums(src io.Reader) (Checksums, error) {
+	dr, _, err := NewDecompressReader(src)
+	if err != nil {
+		return nil, err
+	}
+	defer dr.Close()
+
+	checksums := make(Checksums)
+	tr := tar.NewReader(dr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading tarball")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		h := sha256.New()
+		if _, err := io.Copy(h, tr); err != nil {
+			return nil, errors.Wrapf(err, "error reading file %s from tarball", header.Name)
+		}
+		checksums[header.Name] = hex.EncodeToString(h.Sum(nil))
+	}
+
+	return checksums, nil
+}
+
+// ChecksumVerification is the result of verifying the files of a backup tarball against the
+// checksums recorded when the backup was taken.
+type ChecksumVerification struct {
+	// Mismatched are the files whose contents don't match their checksums
+	Mismatched []string
+	// Missing are the files with checksums which aren't in the tarball
+	Missing []string
+	// Unexpected are the files in the tarball which don't have checksums
+	Unexpected []string
+}
+
+// Corrupted returns true if any file of the tarball is modified, removed or added.
+func (v *ChecksumVerification) Corrupted() bool {
+	return len(v.Mismatched) > 0 || len(v.Missing) > 0 || len(v.Unexpected) > 0
+}
+
+// VerifyChecksums compares the actual checksums of the files of a tarball against the
+// expected ones.
+func VerifyChecksums(expected, actual Checksums) *ChecksumVerification {
+	verification := &ChecksumVerification{}
+	for name, checksum := range expected {
+		actualChecksum, ok := actual[name]
+		switch {
+		case !ok:
+			verification.Missing = append(verification.Missing, name)
+		case actualChecksum != checksum:
+			verification.Mismatched = append(verification.Mismatched, name)
+		}
+	}
+	for name := range actual {
+		if _, ok := expected[name]; !ok {
+			verification.Unexpected = append(verification.Unexpected, name)
+		}
+	}
+
+	sort.Strings(verification.Mismatched)
+	sort.Strings(verification.Missing)
+	sort.Strings(verification.Unexpected)
+	return verification
+}
@@ -0,0 +1,109 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// writeChecksumTarball writes the files into a gzip compressed tarball with a ChecksumWriter,
+// and returns the tarball and the checksums of the files.
+func writeChecksumTarball(t *testing.T, files map[string]string) (*bytes.Buffer, Checksums) {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	cw, err := NewCompressWriter(buf, CompressionAlgorithmGzip)
+	require.NoError(t, err)
+	tw := NewChecksumWriter(tar.NewWriter(cw))
+
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "resources", Typeflag: tar.TypeDir, Mode: 0755}))
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Size: int64(len(content)), Typeflag: tar.TypeReg, Mode: 0755}))
+		// write the content in two parts to check the checksum covers all of them
+		_, err := tw.Write([]byte(content[:len(content)/2]))
+		require.NoError(t, err)
+		_, err = tw.Write([]byte(content[len(content)/2:]))
+		require.NoError(t, err)
+	}
+	checksums := tw.Checksums()
+	require.NoError(t, tw.Close())
+	require.NoError(t, cw.Close())
+
+	return buf, checksums
+}
+
+func TestChecksums(t *testing.T) {
+	files := map[string]string{
+		"metadata/version": "1.1.0",
+		"resources/configmaps/namespaces/ns-1/cm-1.json": `{"kind":"ConfigMap"}`,
+	}
+	tarball, checksums := writeChecksumTarball(t, files)
+
+	assert.Equal(t, Checksums{
+		"metadata/version": "7fbd210ebec11f65a97190ef900795c4b8da3805af3f5a1b8d1d272556b292ca",
+		"resources/configmaps/namespaces/ns-1/cm-1.json": "9f611dd1eda4df64199b2c282aa07c439782eee0894b3f28619680127d24a60d",
+	}, checksums)
+
+	// the checksums computed from the tarball and while extracting it are the same as the written ones
+	computed, err := ComputeChecksums(bytes.NewReader(tarball.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, checksums, computed)
+
+	extractor := NewExtractor(test.NewLogger(), test.NewFakeFileSystem())
+	_, err = extractor.UnzipAndExtractBackup(bytes.NewReader(tarball.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, checksums, extractor.Checksums())
+}
+
+func TestVerifyChecksums(t *testing.T) {
+	expected := Checksums{
+		"metadata/version": "a",
+		"resources/configmaps/namespaces/ns-1/cm-1.json": "b",
+		"resources/configmaps/namespaces/ns-1/cm-2.json": "c",
+	}
+
+	verification := VerifyChecksums(expected, Checksums{
+		"metadata/version": "a",
+		"resources/configmaps/namespaces/ns-1/cm-1.json": "b",
+		"resources/configmaps/namespaces/ns-1/cm-2.json": "c",
+	})
+	assert.False(t, verification.Corrupted())
+	assert.Equal(t, &ChecksumVerification{}, verification)
+
+	verification = VerifyChecksums(expected, Checksums{
+		"metadata/version": "a",
+		"resources/configmaps/namespaces/ns-1/cm-1.json": "x",
+		"resources/configmaps/namespaces/ns-1/cm-3.json": "d",
+	})
+	assert.True(t, verification.Corrupted())
+	assert.Equal(t, &ChecksumVerification{
+		Mismatched: []string{"resources/configmaps/namespaces/ns-1/cm-1.json"},
+		Missing:    []string{"resources/configmaps/namespaces/ns-1/cm-2.json"},
+		Unexpected: []string{"resources/configmaps/namespaces/ns-1/cm-3.json"},
+	}, verification)
+
+	// the files without checksums are added to the contents
+	verification = VerifyChecksums(Checksums{"metadata/version": "a"}, Checksums{"metadata/version": "a", "resources/x.json": "b"})
+	assert.True(t, verification.Corrupted())
+}
@@ -0,0 +1,48 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import "sort"
+
+// ClusterResource records a cluster-scoped item of a backup which deduplicates the
+// cluster-scoped items across the backups of a schedule.
+type ClusterResource struct {
+	// ResourceVersion is the resourceVersion of the item when it was backed up.
+	ResourceVersion string `json:"resourceVersion"`
+	// Backup is the name of the backup whose contents store the item, it's an earlier
+	// backup of the schedule if the item was unchanged since that backup.
+	Backup string `json:"backup"`
+}
+
+// ClusterResources maps the paths of the cluster-scoped items in a backup tarball to
+// the backups storing them.
+type ClusterResources map[string]ClusterResource
+
+// ReferencedPaths returns the sorted paths of the items stored in the contents of other
+// backups than the given one, grouped by the names of those backups.
+func (r ClusterResources) ReferencedPaths(backup string) map[string][]string {
+	paths := map[string][]string{}
+	for path, resource := range r {
+		if resource.Backup != backup {
+			paths[resource.Backup] = append(paths[resource.Backup], path)
+		}
+	}
+	for _, p := range paths {
+		sort.Strings(p)
+	}
+	return paths
+}
@@ -0,0 +1,42 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterResourcesReferencedPaths(t *testing.T) {
+	resources := ClusterResources{
+		"resources/namespaces/cluster/ns-1.json":                             {ResourceVersion: "1", Backup: "backup-3"},
+		"resources/storageclasses.storage.k8s.io/cluster/sc-2.json":          {ResourceVersion: "2", Backup: "backup-1"},
+		"resources/storageclasses.storage.k8s.io/cluster/sc-1.json":          {ResourceVersion: "3", Backup: "backup-1"},
+		"resources/clusterroles.rbac.authorization.k8s.io/cluster/cr-1.json": {ResourceVersion: "4", Backup: "backup-2"},
+	}
+
+	assert.Equal(t, map[string][]string{
+		"backup-1": {
+			"resources/storageclasses.storage.k8s.io/cluster/sc-1.json",
+			"resources/storageclasses.storage.k8s.io/cluster/sc-2.json",
+		},
+		"backup-2": {"resources/clusterroles.rbac.authorization.k8s.io/cluster/cr-1.json"},
+	}, resources.ReferencedPaths("backup-3"))
+
+	assert.Empty(t, ClusterResources(nil).ReferencedPaths("backup-3"))
+}
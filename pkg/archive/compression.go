@@ -0,0 +1,118 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4"
+	"github.com/pkg/errors"
+)
+
+const (
+	// CompressionAlgorithmGzip compresses the backup tarball with gzip, which is the default
+	CompressionAlgorithmGzip = "gzip"
+	// CompressionAlgorithmZstd compresses the backup tarball with zstd
+	CompressionAlgorithmZstd = "zstd"
+	// CompressionAlgorithmLz4 compresses the backup tarball with lz4
+	CompressionAlgorithmLz4 = "lz4"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	lz4Magic  = []byte{0x04, 0x22, 0x4d, 0x18}
+)
+
+// ValidateCompressionAlgorithm returns an error if the compression algorithm isn't supported.
+// An empty algorithm is valid and means gzip.
+func ValidateCompressionAlgorithm(algorithm string) error {
+	switch algorithm {
+	case "", CompressionAlgorithmGzip, CompressionAlgorithmZstd, CompressionAlgorithmLz4:
+		return nil
+	default:
+		return fmt.Errorf("invalid compression algorithm '%s', valid compression algorithms are: '%s', '%s', '%s'",
+			algorithm, CompressionAlgorithmGzip, CompressionAlgorithmZstd, CompressionAlgorithmLz4)
+	}
+}
+
+// FileExtension returns the file extension of the tarball compressed with the algorithm,
+// e.g. ".tar.gz" for gzip.
+func FileExtension(algorithm string) string {
+	switch algorithm {
+	case CompressionAlgorithmZstd:
+		return ".tar.zst"
+	case CompressionAlgorithmLz4:
+		return ".tar.lz4"
+	default:
+		return ".tar.gz"
+	}
+}
+
+// NewCompressWriter returns a writer which compresses the data written to it with the algorithm
+// and writes it to w. An empty algorithm means gzip. The returned writer must be closed to flush
+// the compressed data, closing it doesn't close w.
+func NewCompressWriter(w io.Writer, algorithm string) (io.WriteCloser, error) {
+	switch algorithm {
+	case "", CompressionAlgorithmGzip:
+		return gzip.NewWriter(w), nil
+	case CompressionAlgorithmZstd:
+		zw, err := zstd.NewWriter(w)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating zstd writer")
+		}
+		return zw, nil
+	case CompressionAlgorithmLz4:
+		return lz4.NewWriter(w), nil
+	default:
+		return nil, ValidateCompressionAlgorithm(algorithm)
+	}
+}
+
+// NewDecompressReader returns a reader which decompresses the data read from r, and the
+// compression algorithm of the data, which is detected from its magic number.
+func NewDecompressReader(r io.Reader) (io.ReadCloser, string, error) {
+	br := bufio.NewReader(r)
+	header, err := br.Peek(len(zstdMagic))
+	if err != nil && err != io.EOF {
+		return nil, "", errors.Wrap(err, "error reading compression header")
+	}
+
+	switch {
+	case bytes.HasPrefix(header, gzipMagic):
+		gzr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "error creating gzip reader")
+		}
+		return gzr, CompressionAlgorithmGzip, nil
+	case bytes.HasPrefix(header, zstdMagic):
+		zr, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "error creating zstd reader")
+		}
+		return zr.IOReadCloser(), CompressionAlgorithmZstd, nil
+	case bytes.HasPrefix(header, lz4Magic):
+		return io.NopCloser(lz4.NewReader(br)), CompressionAlgorithmLz4, nil
+	default:
+		return nil, "", errors.New("unknown compression algorithm")
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompression(t *testing.T) {
+	tests := []struct {
+		name              string
+		algorithm         string
+		expectedAlgorithm string
+	}{
+		{
+			name:              "empty algorithm uses gzip",
+			expectedAlgorithm: CompressionAlgorithmGzip,
+		},
+		{
+			name:              "gzip",
+			algorithm:         CompressionAlgorithmGzip,
+			expectedAlgorithm: CompressionAlgorithmGzip,
+		},
+		{
+			name:              "zstd",
+			algorithm:         CompressionAlgorithmZstd,
+			expectedAlgorithm: CompressionAlgorithmZstd,
+		},
+		{
+			name:              "lz4",
+			algorithm:         CompressionAlgorithmLz4,
+			expectedAlgorithm: CompressionAlgorithmLz4,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data := bytes.Repeat([]byte("velero backup data "), 1000)
+
+			buf := new(bytes.Buffer)
+			cw, err := NewCompressWriter(buf, tc.algorithm)
+			require.NoError(t, err)
+			_, err = cw.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, cw.Close())
+			assert.Less(t, buf.Len(), len(data))
+
+			dr, algorithm, err := NewDecompressReader(buf)
+			require.NoError(t, err)
+			defer dr.Close()
+			assert.Equal(t, tc.expectedAlgorithm, algorithm)
+
+			got, err := io.ReadAll(dr)
+			require.NoError(t, err)
+			assert.Equal(t, data, got)
+		})
+	}
+}
+
+func TestNewCompressWriterInvalidAlgorithm(t *testing.T) {
+	_, err := NewCompressWriter(new(bytes.Buffer), "foo")
+	assert.EqualError(t, err, "invalid compression algorithm 'foo', valid compression algorithms are: 'gzip', 'zstd', 'lz4'")
+}
+
+func TestNewDecompressReaderUnknownAlgorithm(t *testing.T) {
+	_, _, err := NewDecompressReader(bytes.NewBufferString("not compressed"))
+	assert.EqualError(t, err, "unknown compression algorithm")
+
+	_, _, err = NewDecompressReader(new(bytes.Buffer))
+	assert.EqualError(t, err, "unknown compression algorithm")
+}
+
+func TestFileExtension(t *testing.T) {
+	assert.Equal(t, ".tar.gz", FileExtension(""))
+	assert.Equal(t, ".tar.gz", FileExtension(CompressionAlgorithmGzip))
+	assert.Equal(t, ".tar.zst", FileExtension(CompressionAlgorithmZstd))
+	assert.Equal(t, ".tar.lz4", FileExtension(CompressionAlgorithmLz4))
+}
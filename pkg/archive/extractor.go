@@ -18,7 +18,8 @@ package archive
 
 import (
 	"archive/tar"
-	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
 	"io"
 	"path/filepath"
 
@@ -30,8 +31,9 @@ import (
 // Extractor unzips/extracts a backup tarball to a local
 // temp directory.
 type Extractor struct {
-	log logrus.FieldLogger
-	fs  filesystem.Interface
+	log       logrus.FieldLogger
+	fs        filesystem.Interface
+	checksums Checksums
 }
 
 func NewExtractor(log logrus.FieldLogger, fs filesystem.Interface) *Extractor {
@@ -41,28 +43,37 @@ func NewExtractor(log logrus.FieldLogger, fs filesystem.Interface) *Extractor {
 	}
 }
 
-// UnzipAndExtractBackup extracts a reader on a gzipped tarball to a local temp directory
+// UnzipAndExtractBackup extracts a reader on a compressed tarball to a local temp directory,
+// the compression algorithm of the tarball is detected automatically.
 func (e *Extractor) UnzipAndExtractBackup(src io.Reader) (string, error) {
-	gzr, err := gzip.NewReader(src)
+	dr, algorithm, err := NewDecompressReader(src)
 	if err != nil {
-		e.log.Infof("error creating gzip reader: %v", err)
+		e.log.Infof("error creating decompress reader: %v", err)
 		return "", err
 	}
-	defer gzr.Close()
+	defer dr.Close()
+	e.log.Debugf("Extracting the backup tarball compressed with %s", algorithm)
 
-	return e.readBackup(tar.NewReader(gzr))
+	return e.readBackup(tar.NewReader(dr))
 }
 
-func (e *Extractor) writeFile(target string, tarRdr *tar.Reader) error {
+// Checksums returns the checksums of the files extracted from the backup tarball.
+func (e *Extractor) Checksums() Checksums {
+	return e.checksums
+}
+
+func (e *Extractor) writeFile(name, target string, tarRdr *tar.Reader) error {
 	file, err := e.fs.Create(target)
 	if err != nil {
 		return err
 	}
 	defer file.Close()
 
-	if _, err := io.Copy(file, tarRdr); err != nil {
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(file, h), tarRdr); err != nil {
 		return err
 	}
+	e.checksums[name] = hex.EncodeToString(h.Sum(nil))
 	return nil
 }
 
@@ -73,6 +84,7 @@ func (e *Extractor) readBackup(tarRdr *tar.Reader) (string, error) {
 		return "", err
 	}
 
+	e.checksums = make(Checksums)
 	for {
 		header, err := tarRdr.Next()
 
@@ -103,7 +115,7 @@ func (e *Extractor) readBackup(tarRdr *tar.Reader) (string, error) {
 			}
 
 			// create the file
-			if err := e.writeFile(target, tarRdr); err != nil {
+			if err := e.writeFile(header.Name, target, tarRdr); err != nil {
 				e.log.Infof("error copying: %v", err)
 				return "", err
 			}
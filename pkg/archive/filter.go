@@ -0,0 +1,124 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// Filter copies the items of the resources in the namespaces from the compressed backup
+// tarball in src to a new tarball compressed with the same algorithm written to dst. An empty resources or namespaces
+// slice matches everything, and the cluster-scoped items are only copied if namespaces is
+// empty. A resource matches the items of its group resource name (e.g. "deployments.apps")
+// or of the resource name without the group (e.g. "deployments"). The files which aren't
+// items of resources, e.g. the metadata, are always copied.
+func Filter(src io.Reader, dst io.Writer, resources, namespaces []string) error {
+	dr, algorithm, err := NewDecompressReader(src)
+	if err != nil {
+		return err
+	}
+	defer dr.Close()
+
+	cw, err := NewCompressWriter(dst, algorithm)
+	if err != nil {
+		return err
+	}
+	tw := tar.NewWriter(cw)
+
+	tr := tar.NewReader(dr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "error reading tar")
+		}
+
+		if !matchesFilter(header.Name, resources, namespaces) {
+			continue
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return errors.Wrapf(err, "error writing tar header of %s", header.Name)
+		}
+		if _, err := io.Copy(tw, tr); err != nil { //nolint:gosec // the size is limited by the tar header
+			return errors.Wrapf(err, "error writing %s", header.Name)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return errors.Wrap(err, "error closing tar writer")
+	}
+
+	return errors.Wrapf(cw.Close(), "error closing %s writer", algorithm)
+}
+
+// matchesFilter returns whether the file in the backup tarball matches the resources and namespaces.
+// The items are stored as resources/<resource>[/<version>-preferredversion]/{namespaces/<namespace>,cluster}/<name>.json.
+func matchesFilter(name string, resources, namespaces []string) bool {
+	parts := strings.Split(strings.Trim(name, "/"), "/")
+	if len(parts) < 2 || parts[0] != velerov1api.ResourcesDir {
+		return true
+	}
+
+	if len(resources) > 0 && !matchesResource(parts[1], resources) {
+		return false
+	}
+
+	if len(namespaces) == 0 {
+		return true
+	}
+
+	for i := 2; i < len(parts); i++ {
+		switch parts[i] {
+		case velerov1api.ClusterScopedDir:
+			return false
+		case velerov1api.NamespaceScopedDir:
+			// the directories above the namespaces are kept to preserve the layout
+			return i+1 >= len(parts) || contains(namespaces, parts[i+1])
+		}
+	}
+
+	// the directories above the scope directories
+	return true
+}
+
+func matchesResource(groupResource string, resources []string) bool {
+	resource := strings.SplitN(groupResource, ".", 2)[0]
+	for _, r := range resources {
+		if r == groupResource || r == resource {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestFilter(t *testing.T) {
+	files := []string{
+		"metadata/version",
+		"resources/configmaps/namespaces/ns-1/cm-1.json",
+		"resources/configmaps/namespaces/ns-2/cm-2.json",
+		"resources/deployments.apps/v1-preferredversion/namespaces/ns-1/deploy-1.json",
+		"resources/deployments.apps/namespaces/ns-1/deploy-1.json",
+		"resources/persistentvolumes/cluster/pv-1.json",
+	}
+
+	tests := []struct {
+		name       string
+		resources  []string
+		namespaces []string
+		expected   []string
+	}{
+		{
+			name:     "no filter",
+			expected: files,
+		},
+		{
+			name:      "filter by resource",
+			resources: []string{"configmaps"},
+			expected: []string{
+				"metadata/version",
+				"resources/configmaps/namespaces/ns-1/cm-1.json",
+				"resources/configmaps/namespaces/ns-2/cm-2.json",
+			},
+		},
+		{
+			name:      "filter by resource without group",
+			resources: []string{"deployments"},
+			expected: []string{
+				"metadata/version",
+				"resources/deployments.apps/v1-preferredversion/namespaces/ns-1/deploy-1.json",
+				"resources/deployments.apps/namespaces/ns-1/deploy-1.json",
+			},
+		},
+		{
+			name:       "filter by namespace excludes cluster-scoped items",
+			namespaces: []string{"ns-1"},
+			expected: []string{
+				"metadata/version",
+				"resources/configmaps/namespaces/ns-1/cm-1.json",
+				"resources/deployments.apps/v1-preferredversion/namespaces/ns-1/deploy-1.json",
+				"resources/deployments.apps/namespaces/ns-1/deploy-1.json",
+			},
+		},
+		{
+			name:       "filter by resource and namespace",
+			resources:  []string{"configmaps"},
+			namespaces: []string{"ns-2"},
+			expected: []string{
+				"metadata/version",
+				"resources/configmaps/namespaces/ns-2/cm-2.json",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tw := test.NewTarWriter(t)
+			for _, file := range files {
+				tw.Add(file, []byte(file))
+			}
+
+			out := new(bytes.Buffer)
+			require.NoError(t, Filter(tw.Done(), out, tc.resources, tc.namespaces))
+
+			gzr, err := gzip.NewReader(out)
+			require.NoError(t, err)
+			tr := tar.NewReader(gzr)
+
+			var names []string
+			for {
+				header, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+
+				data, err := io.ReadAll(tr)
+				require.NoError(t, err)
+				assert.Equal(t, header.Name, string(data))
+
+				names = append(names, header.Name)
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}
+
+func TestFilterKeepsCompressionAlgorithm(t *testing.T) {
+	in := new(bytes.Buffer)
+	cw, err := NewCompressWriter(in, CompressionAlgorithmZstd)
+	require.NoError(t, err)
+	tw := tar.NewWriter(cw)
+	data := []byte("data")
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "resources/configmaps/namespaces/ns-1/cm-1.json", Size: int64(len(data)), Typeflag: tar.TypeReg}))
+	_, err = tw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, cw.Close())
+
+	out := new(bytes.Buffer)
+	require.NoError(t, Filter(in, out, []string{"configmaps"}, nil))
+
+	dr, algorithm, err := NewDecompressReader(out)
+	require.NoError(t, err)
+	defer dr.Close()
+	assert.Equal(t, CompressionAlgorithmZstd, algorithm)
+
+	header, err := tar.NewReader(dr).Next()
+	require.NoError(t, err)
+	assert.Equal(t, "resources/configmaps/namespaces/ns-1/cm-1.json", header.Name)
+}
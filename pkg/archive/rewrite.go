@@ -0,0 +1,134 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ReadItems returns the contents of the items of the group resource, e.g. "datauploads.velero.io",
+// in the compressed backup tarball keyed by their paths in the tarball. An item backed up in
+// multiple versions is returned once for each version.
+func ReadItems(src io.Reader, groupResource string) (map[string][]byte, error) {
+	dr, _, err := NewDecompressReader(src)
+	if err != nil {
+		return nil, err
+	}
+	defer dr.Close()
+
+	items := map[string][]byte{}
+	tr := tar.NewReader(dr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading tar")
+		}
+
+		if !isItemOf(header, groupResource) {
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading %s", header.Name)
+		}
+		items[header.Name] = data
+	}
+
+	return items, nil
+}
+
+// RewriteItems copies the compressed backup tarball in src to a new tarball compressed with the
+// same algorithm written to dst, the contents of the items of the group resource are replaced by
+// the ones returned by rewrite. The checksums of the files in the new tarball are returned.
+func RewriteItems(src io.Reader, dst io.Writer, groupResource string, rewrite func(item []byte) ([]byte, error)) (Checksums, error) {
+	dr, algorithm, err := NewDecompressReader(src)
+	if err != nil {
+		return nil, err
+	}
+	defer dr.Close()
+
+	cw, err := NewCompressWriter(dst, algorithm)
+	if err != nil {
+		return nil, err
+	}
+	tw := NewChecksumWriter(tar.NewWriter(cw))
+
+	tr := tar.NewReader(dr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading tar")
+		}
+
+		if !isItemOf(header, groupResource) {
+			if err := tw.WriteHeader(header); err != nil {
+				return nil, errors.Wrapf(err, "error writing tar header of %s", header.Name)
+			}
+			if _, err := io.Copy(tw, tr); err != nil { //nolint:gosec // the size is limited by the tar header
+				return nil, errors.Wrapf(err, "error writing %s", header.Name)
+			}
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading %s", header.Name)
+		}
+		if data, err = rewrite(data); err != nil {
+			return nil, errors.Wrapf(err, "error rewriting %s", header.Name)
+		}
+
+		header.Size = int64(len(data))
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, errors.Wrapf(err, "error writing tar header of %s", header.Name)
+		}
+		if _, err := tw.Write(data); err != nil {
+			return nil, errors.Wrapf(err, "error writing %s", header.Name)
+		}
+	}
+
+	checksums := tw.Checksums()
+	if err := tw.Close(); err != nil {
+		return nil, errors.Wrap(err, "error closing tar writer")
+	}
+
+	return checksums, errors.Wrapf(cw.Close(), "error closing %s writer", algorithm)
+}
+
+// isItemOf returns whether the file in the backup tarball is an item of the group resource.
+// The items are stored as resources/<resource>[/<version>-preferredversion]/{namespaces/<namespace>,cluster}/<name>.json.
+func isItemOf(header *tar.Header, groupResource string) bool {
+	if header.Typeflag != tar.TypeReg || !strings.HasSuffix(header.Name, ".json") {
+		return false
+	}
+
+	parts := strings.Split(strings.Trim(header.Name, "/"), "/")
+	return len(parts) > 2 && parts[0] == velerov1api.ResourcesDir && parts[1] == groupResource
+}
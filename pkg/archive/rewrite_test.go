@@ -0,0 +1,78 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newRewriteTestTarball(t *testing.T) *bytes.Buffer {
+	return test.NewTarWriter(t).
+		Add("metadata/version", []byte("1")).
+		Add("resources/configmaps/namespaces/ns-1/cm-1.json", []byte("cm-1")).
+		Add("resources/datauploads.velero.io/namespaces/velero/du-1.json", []byte("du-1")).
+		Add("resources/datauploads.velero.io/v2alpha1-preferredversion/namespaces/velero/du-1.json", []byte("du-1")).
+		Done()
+}
+
+func TestReadItems(t *testing.T) {
+	items, err := ReadItems(newRewriteTestTarball(t), "datauploads.velero.io")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"resources/datauploads.velero.io/namespaces/velero/du-1.json":                           []byte("du-1"),
+		"resources/datauploads.velero.io/v2alpha1-preferredversion/namespaces/velero/du-1.json": []byte("du-1"),
+	}, items)
+
+	items, err = ReadItems(newRewriteTestTarball(t), "datadownloads.velero.io")
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
+
+func TestRewriteItems(t *testing.T) {
+	out := new(bytes.Buffer)
+	checksums, err := RewriteItems(newRewriteTestTarball(t), out, "datauploads.velero.io", func(item []byte) ([]byte, error) {
+		return []byte(strings.ToUpper(string(item)) + "-copy"), nil
+	})
+	require.NoError(t, err)
+
+	expected, err := ComputeChecksums(bytes.NewReader(out.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, expected, checksums)
+	assert.Len(t, checksums, 4)
+
+	items, err := ReadItems(bytes.NewReader(out.Bytes()), "datauploads.velero.io")
+	require.NoError(t, err)
+	for _, item := range items {
+		assert.Equal(t, "DU-1-copy", string(item))
+	}
+	items, err = ReadItems(bytes.NewReader(out.Bytes()), "configmaps")
+	require.NoError(t, err)
+	assert.Equal(t, "cm-1", string(items["resources/configmaps/namespaces/ns-1/cm-1.json"]))
+
+	_, err = RewriteItems(newRewriteTestTarball(t), new(bytes.Buffer), "datauploads.velero.io", func(item []byte) ([]byte, error) {
+		return nil, errors.New("fake-error")
+	})
+	assert.EqualError(t, err, "error rewriting resources/datauploads.velero.io/namespaces/velero/du-1.json: fake-error")
+}
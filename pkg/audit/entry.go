@@ -0,0 +1,203 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// Action is the operation recorded by an audit entry.
+type Action string
+
+const (
+	ActionBackupCreate                Action = "BackupCreate"
+	ActionBackupDelete                Action = "BackupDelete"
+	ActionRestoreCreate               Action = "RestoreCreate"
+	ActionBackupStorageLocationCreate Action = "BackupStorageLocationCreate"
+	ActionBackupStorageLocationUpdate Action = "BackupStorageLocationUpdate"
+	ActionBackupStorageLocationDelete Action = "BackupStorageLocationDelete"
+)
+
+// Actions are all the actions recorded in the audit log.
+var Actions = []Action{
+	ActionBackupCreate,
+	ActionBackupDelete,
+	ActionRestoreCreate,
+	ActionBackupStorageLocationCreate,
+	ActionBackupStorageLocationUpdate,
+	ActionBackupStorageLocationDelete,
+}
+
+// unknownUser is the user of the entries whose objects have no managed fields, e.g. the
+// deleted backup storage locations.
+const unknownUser = "<unknown>"
+
+// Entry is an entry of the audit log, it records who did what and when.
+type Entry struct {
+	Time      time.Time `json:"time"`
+	Action    Action    `json:"action"`
+	Kind      string    `json:"kind"`
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	// User is the field manager recorded by the API server in the managed fields of the object
+	// for the change, e.g. "velero" for the Velero CLI and server or "kubectl-client-side-apply".
+	User string `json:"user"`
+	// Result is the outcome of the action as processed by the Velero server, e.g. the phase of
+	// the backup.
+	Result  string            `json:"result,omitempty"`
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// ForBackup returns the entry of the creation of the backup.
+func ForBackup(backup *velerov1api.Backup) Entry {
+	entry := Entry{
+		Action:    ActionBackupCreate,
+		Kind:      "Backup",
+		Namespace: backup.Namespace,
+		Name:      backup.Name,
+		User:      creator(backup),
+		Result:    string(backup.Status.Phase),
+		Details: map[string]string{
+			"storageLocation": backup.Spec.StorageLocation,
+		},
+	}
+	if schedule := backup.Labels[velerov1api.ScheduleNameLabel]; schedule != "" {
+		entry.Details["schedule"] = schedule
+	}
+	return entry
+}
+
+// ForBackupDeletion returns the entry of the deletion of the backup requested by the delete
+// backup request, the errors are the ones the deletion failed with.
+func ForBackupDeletion(request *velerov1api.DeleteBackupRequest, backup *velerov1api.Backup, errs []string) Entry {
+	entry := Entry{
+		Action:    ActionBackupDelete,
+		Kind:      "Backup",
+		Namespace: backup.Namespace,
+		Name:      backup.Name,
+		User:      creator(request),
+		Result:    "Deleted",
+		Details: map[string]string{
+			"deleteBackupRequest": request.Name,
+			"storageLocation":     backup.Spec.StorageLocation,
+		},
+	}
+	if len(errs) > 0 {
+		entry.Result = "Failed"
+		entry.Details["errors"] = strings.Join(errs, "; ")
+	}
+	return entry
+}
+
+// ForRestore returns the entry of the creation of the restore.
+func ForRestore(restore *velerov1api.Restore) Entry {
+	entry := Entry{
+		Action:    ActionRestoreCreate,
+		Kind:      "Restore",
+		Namespace: restore.Namespace,
+		Name:      restore.Name,
+		User:      creator(restore),
+		Result:    string(restore.Status.Phase),
+		Details:   map[string]string{},
+	}
+	if restore.Spec.BackupName != "" {
+		entry.Details["backup"] = restore.Spec.BackupName
+	}
+	if restore.Spec.ScheduleName != "" {
+		entry.Details["schedule"] = restore.Spec.ScheduleName
+	}
+	return entry
+}
+
+// ForBackupStorageLocation returns the entry of the creation or the update of the spec of the
+// backup storage location. The creation is recorded at the creation time of the location.
+func ForBackupStorageLocation(action Action, location *velerov1api.BackupStorageLocation) Entry {
+	entry := Entry{
+		Action:    action,
+		Kind:      "BackupStorageLocation",
+		Namespace: location.Namespace,
+		Name:      location.Name,
+		User:      specModifier(location),
+		Details: map[string]string{
+			"provider":   location.Spec.Provider,
+			"accessMode": string(location.Spec.AccessMode),
+		},
+	}
+	if action == ActionBackupStorageLocationCreate {
+		entry.Time = location.CreationTimestamp.Time
+		entry.User = creator(location)
+	}
+	if location.Spec.ObjectStorage != nil {
+		entry.Details["bucket"] = location.Spec.ObjectStorage.Bucket
+		entry.Details["prefix"] = location.Spec.ObjectStorage.Prefix
+	}
+	return entry
+}
+
+// ForBackupStorageLocationDeletion returns the entry of the deletion of the backup storage
+// location, the deleted location is no longer available to tell who deleted it.
+func ForBackupStorageLocationDeletion(namespace, name string) Entry {
+	return Entry{
+		Action:    ActionBackupStorageLocationDelete,
+		Kind:      "BackupStorageLocation",
+		Namespace: namespace,
+		Name:      name,
+		User:      unknownUser,
+	}
+}
+
+// creator returns the field manager of the earliest managed fields of the object, which is the
+// one creating the object.
+func creator(obj metav1.Object) string {
+	var earliest *metav1.ManagedFieldsEntry
+	for i, entry := range obj.GetManagedFields() {
+		if entry.Time == nil {
+			continue
+		}
+		if earliest == nil || entry.Time.Before(earliest.Time) {
+			earliest = &obj.GetManagedFields()[i]
+		}
+	}
+	if earliest == nil {
+		return unknownUser
+	}
+	return earliest.Manager
+}
+
+// specModifier returns the field manager of the latest managed fields of the object which own
+// fields of the spec, which is the one changing the spec most recently.
+func specModifier(obj metav1.Object) string {
+	var latest *metav1.ManagedFieldsEntry
+	for i, entry := range obj.GetManagedFields() {
+		if entry.Time == nil || entry.FieldsV1 == nil || !bytes.Contains(entry.FieldsV1.Raw, []byte(`"f:spec"`)) {
+			continue
+		}
+		if latest == nil || !entry.Time.Before(latest.Time) {
+			latest = &obj.GetManagedFields()[i]
+		}
+	}
+	if latest == nil {
+		return unknownUser
+	}
+	return latest.Manager
+}
@@ -0,0 +1,193 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+)
+
+// entryTimeFormat is the format of the time prefixing the names of the entries, so that the
+// entries are sorted by time when sorted by name.
+const entryTimeFormat = "20060102T150405.000000000Z"
+
+// Recorder writes the audit entries into the audit log of the backup storage locations. Each
+// entry is written as a separate object which is never modified, so the audit log is append-only.
+type Recorder struct {
+	client            kbclient.Client
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+	log               logrus.FieldLogger
+}
+
+// NewRecorder creates a recorder writing the audit entries with the backup stores of the backup
+// storage locations. Nil is returned if the audit log isn't enabled.
+func NewRecorder(
+	enabled bool,
+	cli kbclient.Client,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	log logrus.FieldLogger,
+) *Recorder {
+	if !enabled {
+		return nil
+	}
+
+	return &Recorder{
+		client:            cli,
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+		log:               log.WithField("audit", true),
+	}
+}
+
+// Record writes the entry into the audit log of the backup storage location asynchronously, the
+// failures are logged without affecting the caller. The entry is written into the default backup
+// storage location if the location is empty, doesn't exist or is read-only. It's a no-op for a
+// nil recorder.
+func (r *Recorder) Record(namespace, location string, entry Entry) {
+	if r == nil {
+		return
+	}
+
+	if entry.Time.IsZero() {
+		entry.Time = time.Now().UTC()
+	}
+
+	go func() {
+		if err := r.record(context.Background(), namespace, location, entry); err != nil {
+			r.log.WithError(err).WithFields(logrus.Fields{
+				"action": entry.Action,
+				"name":   entry.Namespace + "/" + entry.Name,
+			}).Error("Error recording audit entry")
+		}
+	}()
+}
+
+func (r *Recorder) record(ctx context.Context, namespace, location string, entry Entry) error {
+	bsl, err := r.getLocation(ctx, namespace, location)
+	if err != nil {
+		return err
+	}
+
+	log := r.log.WithField("backupStorageLocation", bsl.Name)
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(bsl, pluginManager, log)
+	if err != nil {
+		return errors.Wrapf(err, "error getting the backup store of backup storage location %s", bsl.Name)
+	}
+
+	data, err := json.Marshal(&entry)
+	if err != nil {
+		return errors.Wrap(err, "error marshaling audit entry")
+	}
+
+	name := fmt.Sprintf("%s-%s-%s", entry.Time.UTC().Format(entryTimeFormat), entry.Action, uuid.New().String()[:8])
+	if err := backupStore.PutAuditEntry(name, bytes.NewReader(data)); err != nil {
+		return errors.Wrapf(err, "error writing audit entry into backup storage location %s", bsl.Name)
+	}
+
+	log.WithField("entry", name).Debug("Audit entry is recorded")
+	return nil
+}
+
+// getLocation returns the backup storage location the entry is written into.
+func (r *Recorder) getLocation(ctx context.Context, namespace, name string) (*velerov1api.BackupStorageLocation, error) {
+	if name != "" {
+		location := &velerov1api.BackupStorageLocation{}
+		err := r.client.Get(ctx, kbclient.ObjectKey{Namespace: namespace, Name: name}, location)
+		if err == nil && !location.Spec.IsReadOnly() {
+			return location, nil
+		}
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "error getting backup storage location %s", name)
+		}
+	}
+
+	list := &velerov1api.BackupStorageLocationList{}
+	if err := r.client.List(ctx, list, kbclient.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrap(err, "error listing backup storage locations")
+	}
+	for i := range list.Items {
+		if list.Items[i].Spec.Default && !list.Items[i].Spec.IsReadOnly() {
+			return &list.Items[i], nil
+		}
+	}
+
+	return nil, errors.Errorf("backup storage location %q is unavailable for writing and there is no writable default backup storage location", name)
+}
+
+// ExportLog aggregates the entries of the audit log of the backup store into a single file, with
+// an entry per line sorted by time, which is stored as the audit log with the name.
+func ExportLog(backupStore persistence.BackupStore, log string) error {
+	entries, err := backupStore.ListAuditEntries()
+	if err != nil {
+		return err
+	}
+
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	for _, entry := range entries {
+		if err := writeEntry(backupStore, entry, gzw); err != nil {
+			return err
+		}
+	}
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return backupStore.PutAuditLog(log, buf)
+}
+
+func writeEntry(backupStore persistence.BackupStore, entry string, w io.Writer) error {
+	rc, err := backupStore.GetAuditEntry(entry)
+	if err != nil {
+		return errors.Wrapf(err, "error getting audit entry %s", entry)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return errors.Wrapf(err, "error reading audit entry %s", entry)
+	}
+
+	line := new(bytes.Buffer)
+	if err := json.Compact(line, data); err != nil {
+		return errors.Wrapf(err, "error compacting audit entry %s", entry)
+	}
+	line.WriteByte('\n')
+
+	_, err = w.Write(line.Bytes())
+	return err
+}
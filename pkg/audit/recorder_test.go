@@ -0,0 +1,193 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type fakeBackupStoreGetter struct {
+	stores map[string]*persistencemocks.BackupStore
+}
+
+func (f *fakeBackupStoreGetter) Get(loc *velerov1api.BackupStorageLocation, _ persistence.ObjectStoreGetter, _ logrus.FieldLogger) (persistence.BackupStore, error) {
+	return f.stores[loc.Name], nil
+}
+
+func TestEntryUser(t *testing.T) {
+	t1 := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	t2 := metav1.NewTime(t1.Add(time.Hour))
+	t3 := metav1.NewTime(t1.Add(2 * time.Hour))
+
+	location := builder.ForBackupStorageLocation("velero", "default").Provider("aws").Bucket("bucket").Result()
+	location.ManagedFields = []metav1.ManagedFieldsEntry{
+		{Manager: "velero-server", Time: &t3, FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:phase":{}}}`)}},
+		{Manager: "kubectl-edit", Time: &t2, FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:default":{}}}`)}},
+		{Manager: "velero", Time: &t1, FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:provider":{}}}`)}},
+	}
+
+	created := ForBackupStorageLocation(ActionBackupStorageLocationCreate, location)
+	assert.Equal(t, "velero", created.User)
+	assert.Equal(t, map[string]string{"provider": "aws", "accessMode": "", "bucket": "bucket", "prefix": ""}, created.Details)
+
+	updated := ForBackupStorageLocation(ActionBackupStorageLocationUpdate, location)
+	assert.Equal(t, "kubectl-edit", updated.User)
+
+	backup := builder.ForBackup("velero", "backup-1").Result()
+	assert.Equal(t, unknownUser, ForBackup(backup).User)
+}
+
+func TestRecord(t *testing.T) {
+	entry := Entry{
+		Time:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Action:    ActionBackupCreate,
+		Kind:      "Backup",
+		Namespace: "velero",
+		Name:      "backup-1",
+		User:      "velero",
+	}
+
+	tests := []struct {
+		name             string
+		location         string
+		locations        []runtime.Object
+		expectedLocation string
+		expectedErr      string
+	}{
+		{
+			name:     "entry is written into the location",
+			location: "location-1",
+			locations: []runtime.Object{
+				builder.ForBackupStorageLocation("velero", "location-1").Result(),
+				builder.ForBackupStorageLocation("velero", "default").Default(true).Result(),
+			},
+			expectedLocation: "location-1",
+		},
+		{
+			name:     "entry is written into the default location if the location is read-only",
+			location: "location-1",
+			locations: []runtime.Object{
+				builder.ForBackupStorageLocation("velero", "location-1").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+				builder.ForBackupStorageLocation("velero", "default").Default(true).Result(),
+			},
+			expectedLocation: "default",
+		},
+		{
+			name:     "entry is written into the default location if the location doesn't exist",
+			location: "location-1",
+			locations: []runtime.Object{
+				builder.ForBackupStorageLocation("velero", "default").Default(true).Result(),
+			},
+			expectedLocation: "default",
+		},
+		{
+			name:     "no writable location",
+			location: "location-1",
+			locations: []runtime.Object{
+				builder.ForBackupStorageLocation("velero", "location-1").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+			},
+			expectedErr: `backup storage location "location-1" is unavailable for writing and there is no writable default backup storage location`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stores := map[string]*persistencemocks.BackupStore{}
+			var written []byte
+			for _, name := range []string{"location-1", "default"} {
+				store := new(persistencemocks.BackupStore)
+				if name == tc.expectedLocation {
+					store.On("PutAuditEntry", mock.MatchedBy(func(name string) bool {
+						return strings.HasPrefix(name, "20240101T000000.000000000Z-BackupCreate-")
+					}), mock.Anything).Run(func(args mock.Arguments) {
+						written, _ = io.ReadAll(args.Get(1).(io.Reader))
+					}).Return(nil)
+				}
+				stores[name] = store
+			}
+
+			pluginManager := new(pluginmocks.Manager)
+			pluginManager.On("CleanupClients").Return()
+
+			r := NewRecorder(
+				true,
+				velerotest.NewFakeControllerRuntimeClient(t, tc.locations...),
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				&fakeBackupStoreGetter{stores: stores},
+				velerotest.NewLogger(),
+			)
+
+			err := r.record(context.Background(), "velero", tc.location, entry)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			recorded := Entry{}
+			require.NoError(t, json.Unmarshal(written, &recorded))
+			assert.Equal(t, entry, recorded)
+			for _, store := range stores {
+				store.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+func TestExportLog(t *testing.T) {
+	store := new(persistencemocks.BackupStore)
+	store.On("ListAuditEntries").Return([]string{"entry-1", "entry-2"}, nil)
+	store.On("GetAuditEntry", "entry-1").Return(io.NopCloser(strings.NewReader("{\n  \"action\": \"BackupCreate\"\n}")), nil)
+	store.On("GetAuditEntry", "entry-2").Return(io.NopCloser(strings.NewReader(`{"action": "BackupDelete"}`)), nil)
+
+	var exported []byte
+	store.On("PutAuditLog", "log-1", mock.Anything).Run(func(args mock.Arguments) {
+		gzr, err := gzip.NewReader(args.Get(1).(io.Reader))
+		require.NoError(t, err)
+		exported, err = io.ReadAll(gzr)
+		require.NoError(t, err)
+	}).Return(nil)
+
+	require.NoError(t, ExportLog(store, "log-1"))
+	assert.Equal(t, "{\"action\":\"BackupCreate\"}\n{\"action\":\"BackupDelete\"}\n", string(exported))
+
+	// a nil recorder is a no-op
+	var r *Recorder
+	r.Record("velero", "default", Entry{})
+	assert.Nil(t, NewRecorder(false, nil, nil, nil, velerotest.NewLogger()))
+}
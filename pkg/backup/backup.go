@@ -18,7 +18,7 @@ package backup
 
 import (
 	"archive/tar"
-	"compress/gzip"
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -29,6 +29,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/mod/semver"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -37,11 +38,13 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/itemgraph"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
@@ -60,7 +63,37 @@ import (
 const BackupVersion = 1
 
 // BackupFormatVersion is the current backup version for Velero, including major, minor, and patch.
-const BackupFormatVersion = "1.1.0"
+// The patch version 1 indicates the checksums of the backup contents are recorded.
+const BackupFormatVersion = "1.1.1"
+
+// CompressedBackupFormatVersion is the backup version for the backups whose tarball is compressed
+// with an algorithm other than gzip, which can't be read by older versions of Velero.
+const CompressedBackupFormatVersion = "1.2.1"
+
+// FormatVersion returns the backup version of the backups compressed with the compression algorithm.
+func FormatVersion(compressionAlgorithm string) string {
+	if compressionAlgorithm == "" || compressionAlgorithm == archive.CompressionAlgorithmGzip {
+		return BackupFormatVersion
+	}
+	return CompressedBackupFormatVersion
+}
+
+// ChecksumsRecorded returns true if the checksums of the contents of the backups with the format
+// version are recorded, so a missing checksums file means it's removed from the storage location.
+func ChecksumsRecorded(formatVersion string) bool {
+	v := "v" + formatVersion
+	if !semver.IsValid(v) {
+		return false
+	}
+
+	switch semver.MajorMinor(v) {
+	case semver.MajorMinor("v" + BackupFormatVersion):
+		return semver.Compare(v, "v"+BackupFormatVersion) >= 0
+	case semver.MajorMinor("v" + CompressedBackupFormatVersion):
+		return semver.Compare(v, "v"+CompressedBackupFormatVersion) >= 0
+	}
+	return semver.Compare(v, "v"+CompressedBackupFormatVersion) > 0
+}
 
 // Backupper performs backups.
 type Backupper interface {
@@ -71,6 +104,9 @@ type Backupper interface {
 	FinalizeBackup(log logrus.FieldLogger, backupRequest *Request, inBackupFile io.Reader, outBackupFile io.Writer,
 		backupItemActionResolver framework.BackupItemActionResolverV2,
 		asyncBIAOperations []*itemoperation.BackupOperation) error
+	// DryRun evaluates the resources, persistent volumes and data size that would be included
+	// in the backup using the specification in the velerov1api.Backup, without backing up anything.
+	DryRun(log logrus.FieldLogger, backupRequest *Request) (*velerov1api.BackupDryRunResult, error)
 }
 
 // kubernetesBackupper implements Backupper.
@@ -172,8 +208,8 @@ type VolumeSnapshotterGetter interface {
 	GetVolumeSnapshotter(name string) (vsv1.VolumeSnapshotter, error)
 }
 
-// Backup backs up the items specified in the Backup, placing them in a gzip-compressed tar file
-// written to backupFile. The finalized velerov1api.Backup is written to metadata. Any error that represents
+// Backup backs up the items specified in the Backup, placing them in a tar file compressed with
+// the compression algorithm of the Backup written to backupFile. The finalized velerov1api.Backup is written to metadata. Any error that represents
 // a complete backup failure is returned. Errors that constitute partial failures (i.e. failures to
 // back up individual resources that don't prevent the backup from continuing to be processed) are logged
 // to the backup log.
@@ -188,40 +224,24 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	backupFile io.Writer,
 	backupItemActionResolver framework.BackupItemActionResolverV2,
 	volumeSnapshotterGetter VolumeSnapshotterGetter) error {
-	gzippedData := gzip.NewWriter(backupFile)
-	defer gzippedData.Close()
+	compressedData, err := archive.NewCompressWriter(backupFile, backupRequest.Spec.CompressionAlgorithm)
+	if err != nil {
+		return err
+	}
+	defer compressedData.Close()
 
-	tw := tar.NewWriter(gzippedData)
+	tw := archive.NewChecksumWriter(tar.NewWriter(compressedData))
 	defer tw.Close()
 
 	log.Info("Writing backup version file")
-	if err := kb.writeBackupVersion(tw); err != nil {
+	if err := kb.writeBackupVersion(tw, FormatVersion(backupRequest.Spec.CompressionAlgorithm)); err != nil {
 		return errors.WithStack(err)
 	}
 
-	backupRequest.NamespaceIncludesExcludes = getNamespaceIncludesExcludes(backupRequest.Backup)
-	log.Infof("Including namespaces: %s", backupRequest.NamespaceIncludesExcludes.IncludesString())
-	log.Infof("Excluding namespaces: %s", backupRequest.NamespaceIncludesExcludes.ExcludesString())
-
-	if collections.UseOldResourceFilters(backupRequest.Spec) {
-		backupRequest.ResourceIncludesExcludes = collections.GetGlobalResourceIncludesExcludes(kb.discoveryHelper, log,
-			backupRequest.Spec.IncludedResources,
-			backupRequest.Spec.ExcludedResources,
-			backupRequest.Spec.IncludeClusterResources,
-			*backupRequest.NamespaceIncludesExcludes)
-	} else {
-		backupRequest.ResourceIncludesExcludes = collections.GetScopeResourceIncludesExcludes(kb.discoveryHelper, log,
-			backupRequest.Spec.IncludedNamespaceScopedResources,
-			backupRequest.Spec.ExcludedNamespaceScopedResources,
-			backupRequest.Spec.IncludedClusterScopedResources,
-			backupRequest.Spec.ExcludedClusterScopedResources,
-			*backupRequest.NamespaceIncludesExcludes,
-		)
-	}
+	kb.setResourceFilters(log, backupRequest)
 
 	log.Infof("Backing up all volumes using pod volume backup: %t", boolptr.IsSetToTrue(backupRequest.Backup.Spec.DefaultVolumesToFsBackup))
 
-	var err error
 	backupRequest.ResourceHooks, err = getResourceHooks(backupRequest.Spec.Hooks.Resources, kb.discoveryHelper)
 	if err != nil {
 		log.WithError(errors.WithStack(err)).Debugf("Error from getResourceHooks")
@@ -235,6 +255,7 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	}
 
 	backupRequest.BackedUpItems = map[itemKey]struct{}{}
+	backupRequest.DependencyGraph = itemgraph.NewGraph()
 
 	podVolumeTimeout := kb.podVolumeTimeout
 	if val := backupRequest.Annotations[velerov1api.PodVolumeOperationTimeoutAnnotation]; val != "" {
@@ -249,9 +270,15 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	ctx, cancelFunc := context.WithTimeout(context.Background(), podVolumeTimeout)
 	defer cancelFunc()
 
+	// the uploader type specified in the backup takes precedence over the server's one
+	uploaderType := kb.uploaderType
+	if backupRequest.Spec.UploaderType != "" {
+		uploaderType = backupRequest.Spec.UploaderType
+	}
+
 	var podVolumeBackupper podvolume.Backupper
 	if kb.podVolumeBackupperFactory != nil {
-		podVolumeBackupper, err = kb.podVolumeBackupperFactory.NewBackupper(ctx, backupRequest.Backup, kb.uploaderType)
+		podVolumeBackupper, err = kb.podVolumeBackupperFactory.NewBackupper(ctx, backupRequest.Backup, uploaderType)
 		if err != nil {
 			log.WithError(errors.WithStack(err)).Debugf("Error from NewBackupper")
 			return errors.WithStack(err)
@@ -276,6 +303,8 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 		pageSize:              kb.clientPageSize,
 	}
 
+	defer collector.close()
+
 	items := collector.getAllItems()
 	log.WithField("progress", "").Infof("Collected %d items matching the backup spec from the Kubernetes API (actual number of items backed up may be more or less depending on velero.io/exclude-from-backup annotation, plugins returning additional related items to back up, etc.)", len(items))
 
@@ -363,25 +392,16 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 			"name":      item.name,
 		}).Infof("Processing item")
 
-		// use an anonymous func so we can defer-close/remove the file
-		// as soon as we're done with it
+		// use an anonymous func so we can return as soon as the item
+		// can't be read
 		func() {
-			var unstructured unstructured.Unstructured
-
-			f, err := os.Open(item.path)
+			unstructured, err := collector.readItem(item)
 			if err != nil {
-				log.WithError(errors.WithStack(err)).Error("Error opening file containing item")
+				log.WithError(err).Error("Error reading item")
 				return
 			}
-			defer f.Close()
-			defer os.Remove(f.Name())
 
-			if err := json.NewDecoder(f).Decode(&unstructured); err != nil {
-				log.WithError(errors.WithStack(err)).Error("Error decoding JSON from file")
-				return
-			}
-
-			if backedUp := kb.backupItem(log, item.groupResource, itemBackupper, &unstructured, item.preferredGVR); backedUp {
+			if backedUp := kb.backupItem(log, item.groupResource, itemBackupper, unstructured, item.preferredGVR); backedUp {
 				backedUpGroupResources[item.groupResource] = true
 			}
 		}()
@@ -418,6 +438,11 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 		}
 	}
 
+	if err := kb.writeDependencyGraph(tw, backupRequest.DependencyGraph); err != nil {
+		return err
+	}
+	backupRequest.Checksums = tw.Checksums()
+
 	// do a final update on progress since we may have just added some CRDs and may not have updated
 	// for the last few processed items.
 	updated = backupRequest.Backup.DeepCopy()
@@ -432,12 +457,60 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	}
 	skippedPVSummary, _ := json.Marshal(backupRequest.SkippedPVTracker.Summary())
 	log.Infof("Summary for skipped PVs: %s", skippedPVSummary)
+	log.Infof("Skipped a total of %d items", len(backupRequest.SkippedItemTracker.Summary()))
 	backupRequest.Status.Progress = &velerov1api.BackupProgress{TotalItems: len(backupRequest.BackedUpItems), ItemsBackedUp: len(backupRequest.BackedUpItems)}
 	log.WithField("progress", "").Infof("Backed up a total of %d items", len(backupRequest.BackedUpItems))
+	if backupRequest.ClusterResources != nil {
+		backupRequest.Status.ClusterResourcesDeduplicated = backupRequest.clusterResourcesDeduplicated
+		log.Infof("Referenced %d unchanged cluster-scoped items from the previous backups of the schedule", backupRequest.clusterResourcesDeduplicated)
+	}
 
 	return nil
 }
 
+// setResourceFilters sets the namespace and resource includes/excludes of the backup request
+// according to the backup spec.
+func (kb *kubernetesBackupper) setResourceFilters(log logrus.FieldLogger, backupRequest *Request) {
+	backupRequest.NamespaceIncludesExcludes = getNamespaceIncludesExcludes(backupRequest.Backup)
+	log.Infof("Including namespaces: %s", backupRequest.NamespaceIncludesExcludes.IncludesString())
+	log.Infof("Excluding namespaces: %s", backupRequest.NamespaceIncludesExcludes.ExcludesString())
+
+	clusterScopedLabelSelector := hasClusterScopedLabelSelector(backupRequest.Spec)
+	if clusterScopedLabelSelector {
+		log.Info("Including cluster-scoped resources by the cluster-scoped label selectors")
+	}
+
+	if collections.UseOldResourceFilters(backupRequest.Spec) {
+		resourceIncludesExcludes := collections.GetGlobalResourceIncludesExcludes(kb.discoveryHelper, log,
+			backupRequest.Spec.IncludedResources,
+			backupRequest.Spec.ExcludedResources,
+			backupRequest.Spec.IncludeClusterResources,
+			*backupRequest.NamespaceIncludesExcludes)
+		if clusterScopedLabelSelector {
+			resourceIncludesExcludes.WithClusterScopedLabelSelector()
+		}
+		backupRequest.ResourceIncludesExcludes = resourceIncludesExcludes
+	} else {
+		resourceIncludesExcludes := collections.GetScopeResourceIncludesExcludes(kb.discoveryHelper, log,
+			backupRequest.Spec.IncludedNamespaceScopedResources,
+			backupRequest.Spec.ExcludedNamespaceScopedResources,
+			backupRequest.Spec.IncludedClusterScopedResources,
+			backupRequest.Spec.ExcludedClusterScopedResources,
+			*backupRequest.NamespaceIncludesExcludes,
+		)
+		if clusterScopedLabelSelector {
+			resourceIncludesExcludes.WithClusterScopedLabelSelector()
+		}
+		backupRequest.ResourceIncludesExcludes = resourceIncludesExcludes
+	}
+}
+
+// hasClusterScopedLabelSelector returns true if the cluster-scoped resources are filtered
+// by their own label selectors.
+func hasClusterScopedLabelSelector(spec velerov1api.BackupSpec) bool {
+	return spec.ClusterScopedLabelSelector != nil || len(spec.ClusterScopedOrLabelSelectors) > 0
+}
+
 func (kb *kubernetesBackupper) backupItem(log logrus.FieldLogger, gr schema.GroupResource, itemBackupper *itemBackupper, unstructured *unstructured.Unstructured, preferredGVR schema.GroupVersionResource) bool {
 	backedUpItem, _, err := itemBackupper.backupItem(log, unstructured, gr, preferredGVR, false, false)
 	if aggregate, ok := err.(kubeerrs.Aggregate); ok {
@@ -515,9 +588,9 @@ func (kb *kubernetesBackupper) backupCRD(log logrus.FieldLogger, gr schema.Group
 	kb.backupItem(log, gvr.GroupResource(), itemBackupper, unstructured, gvr)
 }
 
-func (kb *kubernetesBackupper) writeBackupVersion(tw *tar.Writer) error {
+func (kb *kubernetesBackupper) writeBackupVersion(tw tarWriter, formatVersion string) error {
 	versionFile := filepath.Join(velerov1api.MetadataDir, "version")
-	versionString := fmt.Sprintf("%s\n", BackupFormatVersion)
+	versionString := fmt.Sprintf("%s\n", formatVersion)
 
 	hdr := &tar.Header{
 		Name:     versionFile,
@@ -535,24 +608,56 @@ func (kb *kubernetesBackupper) writeBackupVersion(tw *tar.Writer) error {
 	return nil
 }
 
+// writeDependencyGraph writes the dependencies between the backed up items into the
+// metadata directory of the tarball, it's skipped if there is no dependency.
+func (kb *kubernetesBackupper) writeDependencyGraph(tw tarWriter, graph *itemgraph.Graph) error {
+	if len(graph.Dependencies()) == 0 {
+		return nil
+	}
+
+	buf := new(bytes.Buffer)
+	if err := graph.Write(buf); err != nil {
+		return err
+	}
+
+	hdr := &tar.Header{
+		Name:     filepath.Join(velerov1api.MetadataDir, itemgraph.FileName),
+		Size:     int64(buf.Len()),
+		Typeflag: tar.TypeReg,
+		Mode:     0755,
+		ModTime:  time.Now(),
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := tw.Write(buf.Bytes()); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
 func (kb *kubernetesBackupper) FinalizeBackup(log logrus.FieldLogger,
 	backupRequest *Request,
 	inBackupFile io.Reader,
 	outBackupFile io.Writer,
 	backupItemActionResolver framework.BackupItemActionResolverV2,
 	asyncBIAOperations []*itemoperation.BackupOperation) error {
-	gzw := gzip.NewWriter(outBackupFile)
-	defer gzw.Close()
-	tw := tar.NewWriter(gzw)
-	defer tw.Close()
+	dr, algorithm, err := archive.NewDecompressReader(inBackupFile)
+	if err != nil {
+		log.Infof("error creating decompress reader: %v", err)
+		return err
+	}
+	defer dr.Close()
+	tr := tar.NewReader(dr)
 
-	gzr, err := gzip.NewReader(inBackupFile)
+	// keep the compression algorithm of the backup tarball being finalized
+	cw, err := archive.NewCompressWriter(outBackupFile, algorithm)
 	if err != nil {
-		log.Infof("error creating gzip reader: %v", err)
 		return err
 	}
-	defer gzr.Close()
-	tr := tar.NewReader(gzr)
+	defer cw.Close()
+	tw := archive.NewChecksumWriter(tar.NewWriter(cw))
+	defer tw.Close()
 
 	backupRequest.ResolvedActions, err = backupItemActionResolver.ResolveActions(kb.discoveryHelper, log)
 	if err != nil {
@@ -587,6 +692,8 @@ func (kb *kubernetesBackupper) FinalizeBackup(log logrus.FieldLogger,
 			resourceIDs = append(resourceIDs, operation.Spec.PostOperationItems...)
 		}
 	}
+	defer collector.close()
+
 	items := collector.getItemsFromResourceIdentifiers(resourceIDs)
 	log.WithField("progress", "").Infof("Collected %d items from the async BIA operations PostOperationItems list", len(items))
 
@@ -610,25 +717,16 @@ func (kb *kubernetesBackupper) FinalizeBackup(log logrus.FieldLogger,
 			"name":      item.name,
 		}).Infof("Processing item")
 
-		// use an anonymous func so we can defer-close/remove the file
-		// as soon as we're done with it
+		// use an anonymous func so we can return as soon as the item
+		// can't be read
 		func() {
-			var unstructured unstructured.Unstructured
-
-			f, err := os.Open(item.path)
+			unstructured, err := collector.readItem(item)
 			if err != nil {
-				log.WithError(errors.WithStack(err)).Error("Error opening file containing item")
-				return
-			}
-			defer f.Close()
-			defer os.Remove(f.Name())
-
-			if err := json.NewDecoder(f).Decode(&unstructured); err != nil {
-				log.WithError(errors.WithStack(err)).Error("Error decoding JSON from file")
+				log.WithError(err).Error("Error reading item")
 				return
 			}
 
-			backedUp, itemFiles := kb.finalizeItem(log, item.groupResource, itemBackupper, &unstructured, item.preferredGVR)
+			backedUp, itemFiles := kb.finalizeItem(log, item.groupResource, itemBackupper, unstructured, item.preferredGVR)
 			if backedUp {
 				backedUpGroupResources[item.groupResource] = true
 				for _, itemFile := range itemFiles {
@@ -654,13 +752,14 @@ func (kb *kubernetesBackupper) FinalizeBackup(log logrus.FieldLogger,
 		log.Errorf("Error building final tarball: %s", err.Error())
 		return err
 	}
+	backupRequest.Checksums = tw.Checksums()
 
 	log.WithField("progress", "").Infof("Updated a total of %d items", len(backupRequest.BackedUpItems))
 
 	return nil
 }
 
-func buildFinalTarball(tr *tar.Reader, tw *tar.Writer, updateFiles map[string]FileForArchive) error {
+func buildFinalTarball(tr *tar.Reader, tw tarWriter, updateFiles map[string]FileForArchive) error {
 	for {
 		header, err := tr.Next()
 		if err == io.EOF {
@@ -35,6 +35,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -43,17 +45,21 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/itemgraph"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
 	vsv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/volumesnapshotter/v1"
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
+	"github.com/vmware-tanzu/velero/pkg/secretpolicy"
 	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -120,6 +126,226 @@ func TestBackedUpItemsMatchesTarballContents(t *testing.T) {
 	assertTarballContents(t, backupFile, append(expectedFiles, "metadata/version")...)
 }
 
+func TestChecksumsRecorded(t *testing.T) {
+	tests := map[string]bool{
+		"":       false,
+		"1.0.0":  false,
+		"1.1.0":  false,
+		"1.1.1":  true,
+		"1.2.0":  false,
+		"1.2.1":  true,
+		"1.3.0":  true,
+		"2.0.0":  true,
+		"broken": false,
+	}
+	for version, expected := range tests {
+		assert.Equal(t, expected, ChecksumsRecorded(version), "format version %q", version)
+	}
+}
+
+// TestBackupCompressionAlgorithm verifies that the backup tarball is compressed with
+// the compression algorithm of the backup, and that the backup version file matches it.
+func TestBackupCompressionAlgorithm(t *testing.T) {
+	tests := []struct {
+		name              string
+		algorithm         string
+		expectedAlgorithm string
+		expectedVersion   string
+	}{
+		{
+			name:              "empty compression algorithm uses gzip",
+			expectedAlgorithm: archive.CompressionAlgorithmGzip,
+			expectedVersion:   BackupFormatVersion,
+		},
+		{
+			name:              "zstd",
+			algorithm:         archive.CompressionAlgorithmZstd,
+			expectedAlgorithm: archive.CompressionAlgorithmZstd,
+			expectedVersion:   CompressedBackupFormatVersion,
+		},
+		{
+			name:              "lz4",
+			algorithm:         archive.CompressionAlgorithmLz4,
+			expectedAlgorithm: archive.CompressionAlgorithmLz4,
+			expectedVersion:   CompressedBackupFormatVersion,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			req := &Request{
+				Backup:           defaultBackup().CompressionAlgorithm(tc.algorithm).Result(),
+				SkippedPVTracker: NewSkipPVTracker(),
+			}
+			backupFile := bytes.NewBuffer([]byte{})
+
+			h.addItems(t, test.Pods(builder.ForPod("foo", "bar").Result()))
+
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+			dr, algorithm, err := archive.NewDecompressReader(backupFile)
+			require.NoError(t, err)
+			defer dr.Close()
+			assert.Equal(t, tc.expectedAlgorithm, algorithm)
+
+			files := map[string]string{}
+			tr := tar.NewReader(dr)
+			for {
+				hdr, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+
+				data, err := io.ReadAll(tr)
+				require.NoError(t, err)
+				files[hdr.Name] = string(data)
+			}
+			assert.Equal(t, tc.expectedVersion+"\n", files["metadata/version"])
+			assert.Contains(t, files, "resources/pods/namespaces/foo/bar.json")
+		})
+	}
+}
+
+// TestBackupDependencyGraph verifies that the dependencies between the backed up items
+// are written into the metadata directory of the backup tarball.
+func TestBackupDependencyGraph(t *testing.T) {
+	h := newHarness(t)
+	req := &Request{
+		Backup:           defaultBackup().Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	apiResources := []*test.APIResource{
+		test.Deployments(
+			builder.ForDeployment("foo", "app").Result(),
+		),
+		test.Pods(
+			builder.ForPod("foo", "bar").
+				ObjectMeta(builder.WithOwnerReference([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "app"}})).
+				Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result()).
+				Result(),
+		),
+		test.PVCs(
+			builder.ForPersistentVolumeClaim("foo", "pvc-1").VolumeName("pv-1").Result(),
+		),
+		test.PVs(
+			builder.ForPersistentVolume("pv-1").Result(),
+		),
+	}
+	for _, resource := range apiResources {
+		h.addItems(t, resource)
+	}
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+	dr, _, err := archive.NewDecompressReader(backupFile)
+	require.NoError(t, err)
+	defer dr.Close()
+
+	var data []byte
+	tr := tar.NewReader(dr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		if hdr.Name == "metadata/"+itemgraph.FileName {
+			data, err = io.ReadAll(tr)
+			require.NoError(t, err)
+		}
+	}
+	require.NotNil(t, data)
+
+	graph, err := itemgraph.Read(data)
+	require.NoError(t, err)
+	assert.Equal(t, []itemgraph.Dependency{
+		{
+			Item:      itemgraph.Item{Resource: "deployments.apps", Namespace: "foo", Name: "app"},
+			DependsOn: itemgraph.Item{Resource: "pods", Namespace: "foo", Name: "bar"},
+			Reason:    itemgraph.ReasonOwnerReference,
+		},
+		{
+			Item:      itemgraph.Item{Resource: "persistentvolumeclaims", Namespace: "foo", Name: "pvc-1"},
+			DependsOn: itemgraph.Item{Resource: "persistentvolumes", Name: "pv-1"},
+			Reason:    itemgraph.ReasonPersistentVolume,
+		},
+		{
+			Item:      itemgraph.Item{Resource: "pods", Namespace: "foo", Name: "bar"},
+			DependsOn: itemgraph.Item{Resource: "persistentvolumeclaims", Namespace: "foo", Name: "pvc-1"},
+			Reason:    itemgraph.ReasonPersistentVolumeClaim,
+		},
+	}, graph.Dependencies())
+}
+
+// TestBackupChecksums verifies that the checksums of the files written into the backup
+// tarball are recorded in the backup request.
+func TestBackupChecksums(t *testing.T) {
+	h := newHarness(t)
+	req := &Request{
+		Backup:           defaultBackup().Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	h.addItems(t, test.Pods(
+		builder.ForPod("foo", "bar").Result(),
+		builder.ForPod("zoo", "raz").Result(),
+	))
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+	assert.Contains(t, req.Checksums, "metadata/version")
+	assert.Contains(t, req.Checksums, "resources/pods/namespaces/foo/bar.json")
+	assert.Contains(t, req.Checksums, "resources/pods/v1-preferredversion/namespaces/zoo/raz.json")
+
+	checksums, err := archive.ComputeChecksums(backupFile)
+	require.NoError(t, err)
+	assert.Equal(t, checksums, req.Checksums)
+}
+
+// TestBackupDeduplicatesClusterResources verifies that the cluster-scoped items unchanged
+// since the previous backup of the schedule are referenced instead of being written to
+// the backup tarball again.
+func TestBackupDeduplicatesClusterResources(t *testing.T) {
+	h := newHarness(t)
+	req := &Request{
+		Backup:           defaultBackup().IncludedNamespaces("foo").IncludeClusterResources(true).Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+		ClusterResources: archive.ClusterResources{},
+		PreviousClusterResources: archive.ClusterResources{
+			"resources/storageclasses.storage.k8s.io/cluster/sc-1.json":                     {ResourceVersion: "1", Backup: "backup-0"},
+			"resources/storageclasses.storage.k8s.io/v1-preferredversion/cluster/sc-1.json": {ResourceVersion: "1", Backup: "backup-0"},
+			"resources/storageclasses.storage.k8s.io/cluster/sc-2.json":                     {ResourceVersion: "1", Backup: "backup-0"},
+			"resources/storageclasses.storage.k8s.io/v1-preferredversion/cluster/sc-2.json": {ResourceVersion: "1", Backup: "backup-0"},
+		},
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	h.addItems(t, test.Pods(builder.ForPod("foo", "bar").Result()))
+	h.addItems(t, test.StorageClasses(
+		builder.ForStorageClass("sc-1").ObjectMeta(builder.WithResourceVersion("1")).Result(),
+		builder.ForStorageClass("sc-2").ObjectMeta(builder.WithResourceVersion("2")).Result(),
+	))
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+	assert.Equal(t, 1, req.Status.ClusterResourcesDeduplicated)
+	assert.Equal(t, archive.ClusterResource{ResourceVersion: "1", Backup: "backup-0"}, req.ClusterResources["resources/storageclasses.storage.k8s.io/cluster/sc-1.json"])
+	assert.Equal(t, archive.ClusterResource{ResourceVersion: "2", Backup: "backup-1"}, req.ClusterResources["resources/storageclasses.storage.k8s.io/cluster/sc-2.json"])
+	assertTarballContents(t, backupFile,
+		"metadata/version",
+		"resources/pods/namespaces/foo/bar.json",
+		"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+		"resources/storageclasses.storage.k8s.io/cluster/sc-2.json",
+		"resources/storageclasses.storage.k8s.io/v1-preferredversion/cluster/sc-2.json",
+	)
+}
+
 // TestBackupProgressIsUpdated verifies that after a backup has run, its
 // status.progress fields are updated to reflect the total number of items
 // backed up. It validates this by comparing their values to the length of
@@ -370,6 +596,54 @@ func TestBackupOldResourceFiltering(t *testing.T) {
 				"resources/persistentvolumes/v1-preferredversion/cluster/bar.json",
 			},
 		},
+		{
+			name: "cluster-scoped label selector backs up matching cluster-scoped resources when specific namespaces are included",
+			backup: defaultBackup().
+				IncludedNamespaces("foo").
+				LabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"a": "b"}}).
+				ClusterScopedLabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"c": "d"}}).
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").ObjectMeta(builder.WithLabels("a", "b")).Result(),
+					builder.ForPod("zoo", "raz").ObjectMeta(builder.WithLabels("a", "b")).Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("bar").ObjectMeta(builder.WithLabels("a", "b")).Result(),
+					builder.ForPersistentVolume("baz").ObjectMeta(builder.WithLabels("c", "d")).Result(),
+				),
+			},
+			want: []string{
+				"resources/pods/namespaces/foo/bar.json",
+				"resources/persistentvolumes/cluster/baz.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+				"resources/persistentvolumes/v1-preferredversion/cluster/baz.json",
+			},
+		},
+		{
+			name: "cluster-scoped OrLabelSelector only backs up matching cluster-scoped resources",
+			backup: defaultBackup().
+				ClusterScopedOrLabelSelector([]*metav1.LabelSelector{{MatchLabels: map[string]string{"c1": "d1"}}, {MatchLabels: map[string]string{"c2": "d2"}}}).
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("bar").ObjectMeta(builder.WithLabels("c1", "d1")).Result(),
+					builder.ForPersistentVolume("baz").ObjectMeta(builder.WithLabels("c2", "d2")).Result(),
+					builder.ForPersistentVolume("qux").Result(),
+				),
+			},
+			want: []string{
+				"resources/pods/namespaces/foo/bar.json",
+				"resources/persistentvolumes/cluster/bar.json",
+				"resources/persistentvolumes/cluster/baz.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+				"resources/persistentvolumes/v1-preferredversion/cluster/bar.json",
+				"resources/persistentvolumes/v1-preferredversion/cluster/baz.json",
+			},
+		},
 		{
 			name: "resources with velero.io/exclude-from-backup=true label are not included",
 			backup: defaultBackup().
@@ -788,6 +1062,7 @@ func TestBackupOldResourceFiltering(t *testing.T) {
 				),
 			},
 			want: []string{
+				"metadata/dependencies.json",
 				"resources/deployments.apps/namespaces/foo/bar.json",
 				"resources/deployments.apps/v1-preferredversion/namespaces/foo/bar.json",
 				"resources/persistentvolumeclaims/namespaces/foo/test-1.json",
@@ -1721,6 +1996,40 @@ func (a *appliesToErrorAction) Name() string {
 	return ""
 }
 
+// timedOutAction is a backup item action whose Execute() always
+// returns the error of a timed out plugin call.
+type timedOutAction struct {
+	appliesToErrorAction
+}
+
+func (a *timedOutAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{}, nil
+}
+
+func (a *timedOutAction) Execute(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, string, []velero.ResourceIdentifier, error) {
+	return nil, nil, "", nil, status.Error(codes.DeadlineExceeded, "context deadline exceeded")
+}
+
+// TestBackupWithTimedOutAction verifies that the items are still backed up when
+// a backup item action times out.
+func TestBackupWithTimedOutAction(t *testing.T) {
+	var (
+		h   = newHarness(t)
+		req = &Request{
+			Backup:           defaultBackup().Result(),
+			SkippedPVTracker: NewSkipPVTracker(),
+		}
+		backupFile = bytes.NewBuffer([]byte{})
+	)
+
+	h.addItems(t, test.Pods(builder.ForPod("ns-1", "pod-1").Result()))
+
+	err := h.backupper.Backup(h.log, req, backupFile, []biav2.BackupItemAction{&timedOutAction{}}, nil)
+	assert.NoError(t, err)
+
+	assertTarballContents(t, backupFile, "metadata/version", "resources/pods/namespaces/ns-1/pod-1.json", "resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json")
+}
+
 // TestBackupActionModifications runs backups with backup item actions that make modifications
 // to items in their Execute(...) methods and verifies that these modifications are
 // persisted to the backup tarball. Verification is done by inspecting the file contents
@@ -1846,6 +2155,45 @@ func TestBackupActionModifications(t *testing.T) {
 	}
 }
 
+// TestBackupSecretPolicy runs backups with a secret policy, and verifies that the excluded Secrets
+// aren't in the backup tarball and the redacted Secrets are backed up without their data.
+func TestBackupSecretPolicy(t *testing.T) {
+	policy, err := secretpolicy.New(secretpolicy.DefaultExcludedTypes, []string{"helm.sh/release.v1"})
+	require.NoError(t, err)
+
+	var (
+		h   = newHarness(t)
+		req = &Request{
+			Backup:           defaultBackup().Result(),
+			SkippedPVTracker: NewSkipPVTracker(),
+			SecretPolicy:     policy,
+		}
+		backupFile = bytes.NewBuffer([]byte{})
+	)
+
+	h.addItems(t, test.Secrets(
+		builder.ForSecret("ns-1", "sa-token").Type(corev1.SecretTypeServiceAccountToken).Data(map[string][]byte{"token": []byte("fake-token")}).Result(),
+		builder.ForSecret("ns-1", "helm-release").Type("helm.sh/release.v1").Data(map[string][]byte{"release": []byte("fake-release")}).Result(),
+		builder.ForSecret("ns-1", "opaque").Data(map[string][]byte{"key": []byte("fake-value")}).Result(),
+	))
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+	data := backupFile.Bytes()
+	assertTarballContents(t, bytes.NewReader(data),
+		"metadata/version",
+		"resources/secrets/namespaces/ns-1/helm-release.json",
+		"resources/secrets/namespaces/ns-1/opaque.json",
+		"resources/secrets/v1-preferredversion/namespaces/ns-1/helm-release.json",
+		"resources/secrets/v1-preferredversion/namespaces/ns-1/opaque.json",
+	)
+	assertTarballFileContents(t, bytes.NewReader(data), map[string]unstructuredObject{
+		"resources/secrets/namespaces/ns-1/helm-release.json": toUnstructuredOrFail(t, builder.ForSecret("ns-1", "helm-release").Type("helm.sh/release.v1").
+			ObjectMeta(builder.WithAnnotations(secretpolicy.RedactedAnnotation, "true")).Result()),
+		"resources/secrets/namespaces/ns-1/opaque.json": toUnstructuredOrFail(t, builder.ForSecret("ns-1", "opaque").Data(map[string][]byte{"key": []byte("fake-value")}).Result()),
+	})
+}
+
 // TestBackupActionAdditionalItems runs backups with backup item actions that return
 // additional items to be backed up, and verifies that those items are included in the
 // backup tarball as appropriate. Verification is done by looking at the files that exist
@@ -3046,9 +3394,13 @@ func TestBackupWithHooks(t *testing.T) {
 	}
 }
 
-type fakePodVolumeBackupperFactory struct{}
+type fakePodVolumeBackupperFactory struct {
+	// uploaderType records the uploader type the last backupper was created with
+	uploaderType string
+}
 
-func (f *fakePodVolumeBackupperFactory) NewBackupper(context.Context, *velerov1.Backup, string) (podvolume.Backupper, error) {
+func (f *fakePodVolumeBackupperFactory) NewBackupper(_ context.Context, _ *velerov1.Backup, uploaderType string) (podvolume.Backupper, error) {
+	f.uploaderType = uploaderType
 	return &fakePodVolumeBackupper{}, nil
 }
 
@@ -3073,6 +3425,11 @@ func (b *fakePodVolumeBackupper) BackupPodVolumes(backup *velerov1.Backup, pod *
 	return res, pvcSummary, nil
 }
 
+// BackupPVC returns a pod volume backup with namespace "velero" and name "pvb-<pvc-namespace>-<pvc-name>".
+func (b *fakePodVolumeBackupper) BackupPVC(backup *velerov1.Backup, pvc *corev1.PersistentVolumeClaim, _ *resourcepolicies.Policies, _ logrus.FieldLogger) (*velerov1.PodVolumeBackup, error) {
+	return builder.ForPodVolumeBackup("velero", fmt.Sprintf("pvb-%s-%s", pvc.Namespace, pvc.Name)).Volume(pvc.Name).Result(), nil
+}
+
 // TestBackupWithPodVolume runs backups of pods that are annotated for PodVolume backup,
 // and ensures that the pod volume backupper is called, that the returned PodVolumeBackups
 // are added to the Request object, and that when PVCs are backed up with PodVolume, the
@@ -3176,6 +3533,26 @@ func TestBackupWithPodVolume(t *testing.T) {
 				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-vol-2").Volume("vol-2").Result(),
 			},
 		},
+		{
+			name:   "when the backup requires unmounted PVCs to be backed up using pod volume backup, the bound ones are backed up and their PVs are not snapshotted",
+			backup: defaultBackup().ObjectMeta(builder.WithAnnotations(velerov1.FSBackupUnmountedPVCsAnnotation, "true")).Result(),
+			apiResources: []*test.APIResource{
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-2").Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").Result(),
+				),
+			},
+			vsl: newSnapshotLocation("velero", "default", "default"),
+			snapshotterGetter: map[string]vsv1.VolumeSnapshotter{
+				"default": new(fakeVolumeSnapshotter).WithVolume("pv-1", "vol-1", "", "type-1", 100, false),
+			},
+			want: []*velerov1.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pvc-1").Volume("pvc-1").Result(),
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -3206,6 +3583,48 @@ func TestBackupWithPodVolume(t *testing.T) {
 	}
 }
 
+func TestBackupWithPodVolumeUploaderType(t *testing.T) {
+	tests := []struct {
+		name               string
+		backup             *velerov1.Backup
+		serverUploaderType string
+		want               string
+	}{
+		{
+			name:               "uploader type is not specified in the backup, the server's one is used",
+			backup:             defaultBackup().Result(),
+			serverUploaderType: uploader.ResticType,
+			want:               uploader.ResticType,
+		},
+		{
+			name:               "uploader type specified in the backup overrides the server's one",
+			backup:             defaultBackup().UploaderType(uploader.KopiaType).Result(),
+			serverUploaderType: uploader.ResticType,
+			want:               uploader.KopiaType,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h   = newHarness(t)
+				req = &Request{
+					Backup:           tc.backup,
+					SkippedPVTracker: NewSkipPVTracker(),
+				}
+				backupFile = bytes.NewBuffer([]byte{})
+				factory    = new(fakePodVolumeBackupperFactory)
+			)
+
+			h.backupper.podVolumeBackupperFactory = factory
+			h.backupper.uploaderType = tc.serverUploaderType
+
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+			assert.Equal(t, tc.want, factory.uploaderType)
+		})
+	}
+}
+
 // pluggableAction is a backup item action that can be plugged with Execute
 // and Progress function bodies at runtime.
 type pluggableAction struct {
@@ -3643,6 +4062,7 @@ func TestBackupNewResourceFiltering(t *testing.T) {
 				),
 			},
 			want: []string{
+				"metadata/dependencies.json",
 				"resources/persistentvolumeclaims/namespaces/foo/test-1.json",
 				"resources/persistentvolumeclaims/v1-preferredversion/namespaces/foo/test-1.json",
 				"resources/persistentvolumes/cluster/test1.json",
@@ -3683,6 +4103,7 @@ func TestBackupNewResourceFiltering(t *testing.T) {
 				),
 			},
 			want: []string{
+				"metadata/dependencies.json",
 				"resources/persistentvolumeclaims/namespaces/foo/test-1.json",
 				"resources/persistentvolumeclaims/v1-preferredversion/namespaces/foo/test-1.json",
 				"resources/persistentvolumes/cluster/test1.json",
@@ -3727,6 +4148,7 @@ func TestBackupNewResourceFiltering(t *testing.T) {
 				),
 			},
 			want: []string{
+				"metadata/dependencies.json",
 				"resources/customresourcedefinitions.apiextensions.k8s.io/cluster/backups.velero.io.json",
 				"resources/customresourcedefinitions.apiextensions.k8s.io/v1beta1-preferredversion/cluster/backups.velero.io.json",
 				"resources/deployments.apps/namespaces/foo/bar.json",
@@ -3775,6 +4197,7 @@ func TestBackupNewResourceFiltering(t *testing.T) {
 				),
 			},
 			want: []string{
+				"metadata/dependencies.json",
 				"resources/customresourcedefinitions.apiextensions.k8s.io/cluster/backups.velero.io.json",
 				"resources/customresourcedefinitions.apiextensions.k8s.io/v1beta1-preferredversion/cluster/backups.velero.io.json",
 				"resources/persistentvolumeclaims/namespaces/foo/test-1.json",
@@ -3819,6 +4242,7 @@ func TestBackupNewResourceFiltering(t *testing.T) {
 				),
 			},
 			want: []string{
+				"metadata/dependencies.json",
 				"resources/customresourcedefinitions.apiextensions.k8s.io/cluster/backups.velero.io.json",
 				"resources/customresourcedefinitions.apiextensions.k8s.io/v1beta1-preferredversion/cluster/backups.velero.io.json",
 				"resources/persistentvolumeclaims/namespaces/foo/test-1.json",
@@ -3865,6 +4289,7 @@ func TestBackupNewResourceFiltering(t *testing.T) {
 				),
 			},
 			want: []string{
+				"metadata/dependencies.json",
 				"resources/persistentvolumeclaims/namespaces/foo/test-1.json",
 				"resources/persistentvolumeclaims/v1-preferredversion/namespaces/foo/test-1.json",
 				"resources/persistentvolumes/cluster/test1.json",
@@ -3908,6 +4333,7 @@ func TestBackupNewResourceFiltering(t *testing.T) {
 				),
 			},
 			want: []string{
+				"metadata/dependencies.json",
 				"resources/deployments.apps/namespaces/foo/bar.json",
 				"resources/deployments.apps/v1-preferredversion/namespaces/foo/bar.json",
 				"resources/persistentvolumeclaims/namespaces/foo/test-1.json",
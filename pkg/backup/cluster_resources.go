@@ -0,0 +1,47 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// deduplicateClusterResource records the cluster-scoped item written to the path of the
+// backup contents if the backup deduplicates the cluster-scoped items. It returns true
+// if the item is unchanged since the previous backup of the schedule, in which case the
+// item is referenced from the backup storing it instead of being written again.
+func (r *Request) deduplicateClusterResource(path string, groupResource schema.GroupResource, obj metav1.Object) bool {
+	if r.ClusterResources == nil || obj.GetNamespace() != "" {
+		return false
+	}
+
+	resourceVersion := obj.GetResourceVersion()
+	// the persistent volumes are always written, as each backup takes their own snapshots
+	if groupResource != kuberesource.PersistentVolumes && resourceVersion != "" {
+		if previous, ok := r.PreviousClusterResources[path]; ok && previous.ResourceVersion == resourceVersion {
+			r.ClusterResources[path] = previous
+			return true
+		}
+	}
+
+	r.ClusterResources[path] = archive.ClusterResource{ResourceVersion: resourceVersion, Backup: r.Name}
+	return false
+}
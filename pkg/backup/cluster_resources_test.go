@@ -0,0 +1,107 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+func TestDeduplicateClusterResource(t *testing.T) {
+	scPath := "resources/storageclasses.storage.k8s.io/cluster/sc-1.json"
+	storageClasses := schema.GroupResource{Group: "storage.k8s.io", Resource: "storageclasses"}
+	pvPath := "resources/persistentvolumes/cluster/pv-1.json"
+	podPath := "resources/pods/namespaces/ns-1/pod-1.json"
+	previous := archive.ClusterResources{
+		scPath: {ResourceVersion: "1", Backup: "backup-1"},
+		pvPath: {ResourceVersion: "1", Backup: "backup-1"},
+	}
+
+	tests := []struct {
+		name             string
+		clusterResources archive.ClusterResources
+		path             string
+		groupResource    schema.GroupResource
+		obj              metav1.Object
+		want             bool
+		wantRecorded     map[string]archive.ClusterResource
+	}{
+		{
+			name:          "items aren't deduplicated if the backup doesn't deduplicate them",
+			path:          scPath,
+			groupResource: storageClasses,
+			obj:           builder.ForStorageClass("sc-1").ObjectMeta(builder.WithResourceVersion("1")).Result(),
+		},
+		{
+			name:             "unchanged cluster-scoped item references the backup storing it",
+			clusterResources: archive.ClusterResources{},
+			path:             scPath,
+			groupResource:    storageClasses,
+			obj:              builder.ForStorageClass("sc-1").ObjectMeta(builder.WithResourceVersion("1")).Result(),
+			want:             true,
+			wantRecorded:     map[string]archive.ClusterResource{scPath: {ResourceVersion: "1", Backup: "backup-1"}},
+		},
+		{
+			name:             "changed cluster-scoped item is written",
+			clusterResources: archive.ClusterResources{},
+			path:             scPath,
+			groupResource:    storageClasses,
+			obj:              builder.ForStorageClass("sc-1").ObjectMeta(builder.WithResourceVersion("2")).Result(),
+			wantRecorded:     map[string]archive.ClusterResource{scPath: {ResourceVersion: "2", Backup: "backup-2"}},
+		},
+		{
+			name:             "persistent volumes are always written",
+			clusterResources: archive.ClusterResources{},
+			path:             pvPath,
+			groupResource:    kuberesource.PersistentVolumes,
+			obj:              builder.ForPersistentVolume("pv-1").ObjectMeta(builder.WithResourceVersion("1")).Result(),
+			wantRecorded:     map[string]archive.ClusterResource{pvPath: {ResourceVersion: "1", Backup: "backup-2"}},
+		},
+		{
+			name:             "namespaced items aren't recorded",
+			clusterResources: archive.ClusterResources{},
+			path:             podPath,
+			groupResource:    kuberesource.Pods,
+			obj:              builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithResourceVersion("1")).Result(),
+			wantRecorded:     map[string]archive.ClusterResource{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := &Request{
+				Backup:                   builder.ForBackup("velero", "backup-2").Result(),
+				ClusterResources:         tc.clusterResources,
+				PreviousClusterResources: previous,
+			}
+
+			assert.Equal(t, tc.want, request.deduplicateClusterResource(tc.path, tc.groupResource, tc.obj))
+			if tc.wantRecorded != nil {
+				assert.Equal(t, archive.ClusterResources(tc.wantRecorded), request.ClusterResources)
+			} else {
+				assert.Nil(t, request.ClusterResources)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// DryRun collects the items matching the backup spec from the Kubernetes API and reports
+// the resources, persistent volumes and estimated data size that would be included in the
+// backup. Backup item actions are not invoked, so the items returned by plugins as additional
+// items are not reported.
+func (kb *kubernetesBackupper) DryRun(log logrus.FieldLogger, backupRequest *Request) (*velerov1api.BackupDryRunResult, error) {
+	kb.setResourceFilters(log, backupRequest)
+
+	// set up a temp dir for the itemCollector to use to temporarily
+	// store items as they're scraped from the API.
+	tempDir, err := os.MkdirTemp("", "")
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating temp dir for backup dry-run")
+	}
+	defer os.RemoveAll(tempDir)
+
+	collector := &itemCollector{
+		log:                   log,
+		backupRequest:         backupRequest,
+		discoveryHelper:       kb.discoveryHelper,
+		dynamicFactory:        kb.dynamicFactory,
+		cohabitatingResources: cohabitatingResources(),
+		dir:                   tempDir,
+		pageSize:              kb.clientPageSize,
+	}
+
+	defer collector.close()
+
+	items := collector.getAllItems()
+	log.Infof("Collected %d items matching the backup spec from the Kubernetes API", len(items))
+
+	result := &velerov1api.BackupDryRunResult{
+		Resources: map[string][]string{},
+	}
+
+	// PV capacities are keyed by the PV name, so that a PV included both directly
+	// and through its PVC is only counted once
+	pvCapacities := map[string]int64{}
+	pvs := sets.NewString()
+
+	for _, item := range items {
+		obj, err := collector.readItem(item)
+		if err != nil {
+			log.WithError(err).WithField("name", item.name).Warn("Error reading item, skip it")
+			continue
+		}
+
+		if obj.GetLabels()[excludeFromBackupLabel] == "true" {
+			continue
+		}
+
+		entry := item.name
+		if item.namespace != "" {
+			entry = fmt.Sprintf("%s/%s", item.namespace, item.name)
+		}
+		key := resourceKey(obj)
+		result.Resources[key] = append(result.Resources[key], entry)
+
+		switch item.groupResource {
+		case kuberesource.PersistentVolumeClaims:
+			pvc := new(corev1api.PersistentVolumeClaim)
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
+				log.WithError(err).WithField("name", entry).Warn("Error converting item to PVC, skip it")
+				continue
+			}
+
+			if pvc.Spec.VolumeName == "" {
+				continue
+			}
+
+			pvs.Insert(pvc.Spec.VolumeName)
+			if _, exist := pvCapacities[pvc.Spec.VolumeName]; !exist {
+				pvCapacities[pvc.Spec.VolumeName] = pvcCapacity(pvc)
+			}
+		case kuberesource.PersistentVolumes:
+			pv := new(corev1api.PersistentVolume)
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pv); err != nil {
+				log.WithError(err).WithField("name", entry).Warn("Error converting item to PV, skip it")
+				continue
+			}
+
+			pvs.Insert(pv.Name)
+			if quantity, exist := pv.Spec.Capacity[corev1api.ResourceStorage]; exist {
+				// the PV's capacity is more accurate than the PVC's request
+				pvCapacities[pv.Name] = quantity.Value()
+			}
+		}
+	}
+
+	// sort namespace/name entries for each GVK
+	for _, v := range result.Resources {
+		sort.Strings(v)
+	}
+
+	result.PersistentVolumes = pvs.List()
+	for _, capacity := range pvCapacities {
+		result.EstimatedDataSizeBytes += capacity
+	}
+
+	return result, nil
+}
+
+// pvcCapacity returns the capacity of the PVC in bytes. The requested size is used if
+// the PVC is not bound yet.
+func pvcCapacity(pvc *corev1api.PersistentVolumeClaim) int64 {
+	if quantity, exist := pvc.Status.Capacity[corev1api.ResourceStorage]; exist {
+		return quantity.Value()
+	}
+
+	if quantity, exist := pvc.Spec.Resources.Requests[corev1api.ResourceStorage]; exist {
+		return quantity.Value()
+	}
+
+	return 0
+}
@@ -0,0 +1,126 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupDryRun(t *testing.T) {
+	pvWithCapacity := builder.ForPersistentVolume("pv-2").Result()
+	pvWithCapacity.Spec.Capacity = corev1api.ResourceList{corev1api.ResourceStorage: resource.MustParse("2Gi")}
+
+	tests := []struct {
+		name         string
+		backup       *velerov1.Backup
+		apiResources []*test.APIResource
+		want         *velerov1.BackupDryRunResult
+	}{
+		{
+			name:   "no filters includes everything",
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").Result(),
+					builder.ForPod("zoo", "raz").Result(),
+				),
+				test.Deployments(
+					builder.ForDeployment("foo", "bar").Result(),
+				),
+			},
+			want: &velerov1.BackupDryRunResult{
+				Resources: map[string][]string{
+					"v1/Pod":             {"foo/bar", "zoo/raz"},
+					"apps/v1/Deployment": {"foo/bar"},
+				},
+				PersistentVolumes: []string{},
+			},
+		},
+		{
+			name:   "resource filters and exclude label are respected",
+			backup: defaultBackup().IncludedNamespaces("foo").IncludedResources("pods").Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").Result(),
+					builder.ForPod("foo", "baz").ObjectMeta(builder.WithLabels(excludeFromBackupLabel, "true")).Result(),
+					builder.ForPod("zoo", "raz").Result(),
+				),
+				test.Deployments(
+					builder.ForDeployment("foo", "bar").Result(),
+				),
+			},
+			want: &velerov1.BackupDryRunResult{
+				Resources: map[string][]string{
+					"v1/Pod": {"foo/bar"},
+				},
+				PersistentVolumes: []string{},
+			},
+		},
+		{
+			name:   "persistent volumes and data size are estimated from PVCs and PVs",
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("foo", "pvc-1").VolumeName("pv-1").
+						RequestResource(corev1api.ResourceList{corev1api.ResourceStorage: resource.MustParse("1Gi")}).Result(),
+					builder.ForPersistentVolumeClaim("foo", "pvc-2").VolumeName("pv-2").
+						RequestResource(corev1api.ResourceList{corev1api.ResourceStorage: resource.MustParse("1Gi")}).Result(),
+					builder.ForPersistentVolumeClaim("foo", "pvc-3").
+						RequestResource(corev1api.ResourceList{corev1api.ResourceStorage: resource.MustParse("1Gi")}).Result(),
+				),
+				test.PVs(
+					pvWithCapacity,
+				),
+			},
+			want: &velerov1.BackupDryRunResult{
+				Resources: map[string][]string{
+					"v1/PersistentVolumeClaim": {"foo/pvc-1", "foo/pvc-2", "foo/pvc-3"},
+					"v1/PersistentVolume":      {"pv-2"},
+				},
+				PersistentVolumes:      []string{"pv-1", "pv-2"},
+				EstimatedDataSizeBytes: 3 << 30,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h   = newHarness(t)
+				req = &Request{Backup: tc.backup}
+			)
+
+			for _, resource := range tc.apiResources {
+				h.addItems(t, resource)
+			}
+
+			res, err := h.backupper.DryRun(h.log, req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
@@ -46,13 +46,17 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/itemgraph"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	vsv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/volumesnapshotter/v1"
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
+	"github.com/vmware-tanzu/velero/pkg/secretpolicy"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	csiutil "github.com/vmware-tanzu/velero/pkg/util/csi"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
 	pdvolumeutil "github.com/vmware-tanzu/velero/pkg/util/podvolume"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -97,7 +101,16 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 	if !selectedForBackup || err != nil || len(files) == 0 || finalize {
 		return selectedForBackup, files, err
 	}
+	metadata, err := meta.Accessor(obj)
+	if err != nil {
+		return false, []FileForArchive{}, errors.WithStack(err)
+	}
+	deduplicated := false
 	for _, file := range files {
+		if ib.backupRequest.deduplicateClusterResource(file.FilePath, groupResource, metadata) {
+			deduplicated = true
+			continue
+		}
 		if err := ib.tarWriter.WriteHeader(file.Header); err != nil {
 			return false, []FileForArchive{}, errors.WithStack(err)
 		}
@@ -106,6 +119,9 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 			return false, []FileForArchive{}, errors.WithStack(err)
 		}
 	}
+	if deduplicated {
+		ib.backupRequest.clusterResourcesDeduplicated++
+	}
 	return true, []FileForArchive{}, nil
 }
 
@@ -131,12 +147,14 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 		if metadata.GetLabels()[excludeFromBackupLabel] == "true" {
 			log.Infof("Excluding item because it has label %s=true", excludeFromBackupLabel)
 			ib.trackSkippedPV(obj, groupResource, "", fmt.Sprintf("item has label %s=true", excludeFromBackupLabel), log)
+			ib.backupRequest.SkippedItemTracker.Track(groupResource, namespace, name, ItemSkipReasonExcludedByLabel, fmt.Sprintf("item has label %s=true", excludeFromBackupLabel))
 			return false, itemFiles, nil
 		}
 		// NOTE: we have to re-check namespace & resource includes/excludes because it's possible that
 		// backupItem can be invoked by a custom action.
 		if namespace != "" && !ib.backupRequest.NamespaceIncludesExcludes.ShouldInclude(namespace) {
 			log.Info("Excluding item because namespace is excluded")
+			ib.backupRequest.SkippedItemTracker.Track(groupResource, namespace, name, ItemSkipReasonExcludedByFilter, "namespace is excluded")
 			return false, itemFiles, nil
 		}
 
@@ -147,6 +165,7 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 		if namespace == "" && groupResource != kuberesource.Namespaces &&
 			ib.backupRequest.ResourceIncludesExcludes.ShouldExclude(groupResource.String()) {
 			log.Info("Excluding item because resource is cluster-scoped and is excluded by cluster filter.")
+			ib.backupRequest.SkippedItemTracker.Track(groupResource, namespace, name, ItemSkipReasonExcludedByFilter, "cluster-scoped resource is excluded")
 			return false, itemFiles, nil
 		}
 
@@ -154,12 +173,31 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 		// are not specified in included list.
 		if namespace != "" && !ib.backupRequest.ResourceIncludesExcludes.ShouldInclude(groupResource.String()) {
 			log.Info("Excluding item because resource is excluded")
+			ib.backupRequest.SkippedItemTracker.Track(groupResource, namespace, name, ItemSkipReasonExcludedByFilter, "resource is excluded")
 			return false, itemFiles, nil
 		}
 	}
 
+	// the secret policy applies even to the items which must be included, so that the secrets
+	// it excludes or redacts never get into the backup
+	if groupResource == kuberesource.Secrets {
+		secretType := secretpolicy.SecretType(obj)
+		switch ib.backupRequest.SecretPolicy.ActionFor(secretType) {
+		case secretpolicy.ActionExclude:
+			log.Infof("Excluding item because its secret type %s is excluded by the secret policy", secretType)
+			ib.backupRequest.SkippedItemTracker.Track(groupResource, namespace, name, ItemSkipReasonExcludedBySecretPolicy, fmt.Sprintf("secret type %s is excluded", secretType))
+			return false, itemFiles, nil
+		case secretpolicy.ActionRedact:
+			log.Infof("Redacting the data of the item because its secret type %s is redacted by the secret policy", secretType)
+			if err := secretpolicy.Redact(obj); err != nil {
+				return false, itemFiles, err
+			}
+		}
+	}
+
 	if metadata.GetDeletionTimestamp() != nil {
 		log.Info("Skipping item because it's being deleted.")
+		ib.backupRequest.SkippedItemTracker.Track(groupResource, namespace, name, ItemSkipReasonBeingDeleted, "item is being deleted")
 		return false, itemFiles, nil
 	}
 
@@ -175,8 +213,17 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 		return true, itemFiles, nil
 	}
 	ib.backupRequest.BackedUpItems[key] = struct{}{}
+	// the item may be skipped before, e.g. when it's returned by a plugin as an additional item
+	// which must be included
+	ib.backupRequest.SkippedItemTracker.Untrack(groupResource, namespace, name)
 	log.Info("Backing up item")
 
+	// the dependency graph is already in the tarball when finalizing the backup
+	if !finalize && ib.backupRequest.DependencyGraph != nil {
+		item := itemgraph.Item{Resource: groupResource.String(), Namespace: namespace, Name: name}
+		ib.backupRequest.DependencyGraph.AddItem(item, itemgraph.DependenciesOf(item, obj, ib.resolveResource)...)
+	}
+
 	var (
 		backupErrs []error
 		pod        *corev1api.Pod
@@ -224,6 +271,38 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 		}
 	}
 
+	// the PVC which isn't mounted by any pod is backed up before the item actions, so that its PV,
+	// which is backed up as an additional item of the PVC, isn't also snapshotted.
+	if groupResource == kuberesource.PersistentVolumeClaims && ib.shouldBackupUnmountedPVC(log, namespace, name) {
+		pvc := new(corev1api.PersistentVolumeClaim)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
+			backupErrs = append(backupErrs, errors.WithStack(err))
+		} else if pvc.Spec.VolumeName != "" {
+			pvb, err := ib.podVolumeBackupper.BackupPVC(ib.backupRequest.Backup, pvc, ib.backupRequest.ResPolicies, log)
+			if pvb != nil {
+				ib.backupRequest.PodVolumeBackups = append(ib.backupRequest.PodVolumeBackups, pvb)
+			}
+			if err != nil {
+				backupErrs = append(backupErrs, err)
+			} else if pvb != nil {
+				ib.podVolumeSnapshotTracker.TakePVC(namespace, name)
+				ib.unTrackSkippedPV(obj, groupResource, log)
+			}
+		}
+	}
+
+	// the snapshot of the PVC in a volume group is taken with the other PVCs of the group, it's
+	// passed to the CSI plugin by the annotation instead of being taken by the plugin.
+	groupSnapshotted := false
+	if groupResource == kuberesource.PersistentVolumeClaims && !finalize {
+		if vs, err := ib.groupVolumeSnapshot(log, obj); err != nil {
+			backupErrs = append(backupErrs, err)
+		} else if vs != "" {
+			setAnnotation(obj, velerov1api.VolumeGroupSnapshotVSAnnotation, vs)
+			groupSnapshotted = true
+		}
+	}
+
 	// capture the version of the object before invoking plugin actions as the plugin may update
 	// the group version of the object.
 	versionPath := resourceVersion(obj)
@@ -242,6 +321,11 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 
 	itemFiles = append(itemFiles, additionalItemFiles...)
 	obj = updatedObj
+	if groupSnapshotted {
+		// the annotation is for communication between the CSI plugin and velero server, we don't
+		// want the resource be restored with it.
+		removeAnnotation(obj, velerov1api.VolumeGroupSnapshotVSAnnotation)
+	}
 	if metadata, err = meta.Accessor(obj); err != nil {
 		return false, itemFiles, errors.WithStack(err)
 	}
@@ -313,6 +397,26 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 	return true, itemFiles, nil
 }
 
+func setAnnotation(obj runtime.Unstructured, key, value string) {
+	u := &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+	annotations := u.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[key] = value
+	u.SetAnnotations(annotations)
+}
+
+func removeAnnotation(obj runtime.Unstructured, key string) {
+	u := &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+	annotations := u.GetAnnotations()
+	if _, ok := annotations[key]; !ok {
+		return
+	}
+	delete(annotations, key)
+	u.SetAnnotations(annotations)
+}
+
 func getFileForArchive(namespace, name, groupResource, versionPath string, itemBytes []byte) FileForArchive {
 	filePath := archive.GetVersionedItemFilePath("", groupResource, namespace, name, versionPath)
 	hdr := &tar.Header{
@@ -340,6 +444,45 @@ func (ib *itemBackupper) backupPodVolumes(log logrus.FieldLogger, pod *corev1api
 	return ib.podVolumeBackupper.BackupPodVolumes(ib.backupRequest.Backup, pod, volumes, ib.backupRequest.ResPolicies, log)
 }
 
+// shouldBackupUnmountedPVC returns true if the backup requires the PVCs not mounted by any pod to be
+// backed up by pod volume backup, and the PVC isn't mounted by any pod that is not terminated.
+func (ib *itemBackupper) shouldBackupUnmountedPVC(log logrus.FieldLogger, namespace, name string) bool {
+	if ib.backupRequest.Annotations[velerov1api.FSBackupUnmountedPVCsAnnotation] != "true" {
+		return false
+	}
+
+	if ib.podVolumeBackupper == nil {
+		log.Warn("No pod volume backupper, not backing up unmounted PVC")
+		return false
+	}
+
+	if ib.podVolumeSnapshotTracker.Has(namespace, name) {
+		return false
+	}
+	if optedOut, _ := ib.podVolumeSnapshotTracker.OptedoutByPod(namespace, name); optedOut {
+		return false
+	}
+
+	pods := new(corev1api.PodList)
+	if err := ib.kbClient.List(context.Background(), pods, kbClient.InNamespace(namespace)); err != nil {
+		log.WithError(err).Warn("Failed to list pods to check if PVC is mounted, not backing up unmounted PVC")
+		return false
+	}
+
+	for i := range pods.Items {
+		if kube.IsPodTerminated(&pods.Items[i]) {
+			continue
+		}
+		for _, volume := range pods.Items[i].Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == name {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 func (ib *itemBackupper) executeActions(
 	log logrus.FieldLogger,
 	obj runtime.Unstructured,
@@ -372,6 +515,11 @@ func (ib *itemBackupper) executeActions(
 
 		updatedItem, additionalItemIdentifiers, operationID, postOperationItems, err := action.Execute(obj, ib.backupRequest.Backup)
 		if err != nil {
+			// a timed out action doesn't fail the item, it's recorded as a warning of the backup
+			if common.IsCallTimeout(err) {
+				log.WithError(err).Warnf("Custom action %s timed out, skip it for the item", actionName)
+				continue
+			}
 			return nil, itemFiles, errors.Wrapf(err, "error executing custom action (groupResource=%s, namespace=%s, name=%s)", groupResource.String(), namespace, name)
 		}
 		u := &unstructured.Unstructured{Object: updatedItem.UnstructuredContent()}
@@ -438,6 +586,8 @@ func (ib *itemBackupper) executeActions(
 					"namespace":     additionalItem.Namespace,
 					"name":          additionalItem.Name,
 				}).Warnf("Additional item was not found in Kubernetes API, can't back it up")
+				ib.backupRequest.SkippedItemTracker.Track(gvr.GroupResource(), additionalItem.Namespace, additionalItem.Name, ItemSkipReasonNotFound,
+					fmt.Sprintf("additional item returned by backup item action %s isn't found", actionName))
 				continue
 			}
 			if err != nil {
@@ -732,6 +882,15 @@ func resourceKey(obj runtime.Unstructured) string {
 	return fmt.Sprintf("%s/%s", gvk.GroupVersion().String(), gvk.Kind)
 }
 
+// resolveResource resolves the kind to its group resource and whether it's namespaced.
+func (ib *itemBackupper) resolveResource(gvk schema.GroupVersionKind) (schema.GroupResource, bool, error) {
+	gvr, resource, err := ib.discoveryHelper.KindFor(gvk)
+	if err != nil {
+		return schema.GroupResource{}, false, err
+	}
+	return gvr.GroupResource(), resource.Namespaced, nil
+}
+
 // resourceVersion returns a string representing the object's API Version (e.g.
 // v1 if item belongs to apps/v1
 func resourceVersion(obj runtime.Unstructured) string {
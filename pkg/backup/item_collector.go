@@ -17,7 +17,6 @@ limitations under the License.
 package backup
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -26,12 +25,10 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
-	"k8s.io/apimachinery/pkg/api/meta"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/client-go/tools/pager"
 
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
@@ -40,8 +37,12 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 )
 
+// defaultItemsMemoryLimit is the max total size in bytes of the collected items
+// kept in memory, the items beyond it are spilled over to a file.
+const defaultItemsMemoryLimit = 64 * 1024 * 1024
+
 // itemCollector collects items from the Kubernetes API according to
-// the backup spec and writes them to files inside dir.
+// the backup spec and keeps them in memory or in a spillover file inside dir.
 type itemCollector struct {
 	log                   logrus.FieldLogger
 	backupRequest         *Request
@@ -50,12 +51,20 @@ type itemCollector struct {
 	cohabitatingResources map[string]*cohabitatingResource
 	dir                   string
 	pageSize              int
+	// memoryLimit is the max total size in bytes of the items kept in
+	// memory, defaultItemsMemoryLimit is used if it's 0.
+	memoryLimit int64
+	store       *itemStore
 }
 
 type kubernetesResource struct {
-	groupResource         schema.GroupResource
-	preferredGVR          schema.GroupVersionResource
-	namespace, name, path string
+	groupResource   schema.GroupResource
+	preferredGVR    schema.GroupVersionResource
+	namespace, name string
+	// data is the JSON of the item if it's kept in memory, otherwise
+	// the JSON is in the spillover file at offset.
+	data         []byte
+	offset, size int64
 }
 
 // getItemsFromResourceIdentifiers converts ResourceIdentifiers to
@@ -233,19 +242,18 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 				continue
 			}
 
-			path, err := r.writeToFile(unstructured)
-			if err != nil {
-				log.WithError(err).Error("Error writing item to file")
-				continue
-			}
-
-			items = append(items, &kubernetesResource{
+			item := &kubernetesResource{
 				groupResource: gr,
 				preferredGVR:  preferredGVR,
 				namespace:     resourceID.Namespace,
 				name:          resourceID.Name,
-				path:          path,
-			})
+			}
+			if err := r.writeItem(unstructured, item); err != nil {
+				log.WithError(err).Error("Error writing item")
+				continue
+			}
+
+			items = append(items, item)
 		}
 
 		return items, nil
@@ -311,95 +319,210 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 			continue
 		}
 
-		var orLabelSelectors []string
-		if r.backupRequest.Spec.OrLabelSelectors != nil {
-			for _, s := range r.backupRequest.Spec.OrLabelSelectors {
-				orLabelSelectors = append(orLabelSelectors, metav1.FormatLabelSelector(s))
-			}
-		} else {
-			orLabelSelectors = []string{}
+		labelSelector, orLabelSelectors := r.getLabelSelectors(clusterScoped)
+		// Listing items for labelSelector (singular) if there is no orLabelSelectors
+		labels := orLabelSelectors
+		if len(labels) == 0 {
+			labels = []string{labelSelector}
 		}
 
 		log.Info("Listing items")
-		unstructuredItems := make([]unstructured.Unstructured, 0)
 
-		// Listing items for orLabelSelectors
+		// Collect items in included Namespaces as soon as their page is listed
+		var namespaceItems []*kubernetesResource
+		collect := func(unstructuredItem *unstructured.Unstructured) {
+			item := &kubernetesResource{
+				groupResource: gr,
+				preferredGVR:  preferredGVR,
+				namespace:     unstructuredItem.GetNamespace(),
+				name:          unstructuredItem.GetName(),
+			}
+			if err := r.writeItem(unstructuredItem, item); err != nil {
+				log.WithError(err).Error("Error writing item")
+				return
+			}
+			namespaceItems = append(namespaceItems, item)
+		}
+
 		errListingForNS := false
-		for _, label := range orLabelSelectors {
-			unstructuredItems, err = r.listItemsForLabel(unstructuredItems, gr, label, resourceClient)
-			if err != nil {
+		for _, label := range labels {
+			if err := r.listItemsForLabel(gr, label, resourceClient, collect); err != nil {
+				log.WithError(err).Error("Error listing items")
 				errListingForNS = true
 			}
 		}
 
 		if errListingForNS {
-			log.WithError(err).Error("Error listing items")
+			// the items of the pages listed before the error won't be backed up
+			if err := r.releaseItems(namespaceItems); err != nil {
+				log.WithError(err).Error("Error releasing items")
+			}
 			continue
 		}
 
-		var labelSelector string
-		if selector := r.backupRequest.Spec.LabelSelector; selector != nil {
-			labelSelector = metav1.FormatLabelSelector(selector)
-		}
+		log.Infof("Retrieved %d items", len(namespaceItems))
+		items = append(items, namespaceItems...)
+	}
+	if len(orders) > 0 {
+		items = sortResourcesByOrder(r.log, items, orders)
+	}
 
-		// Listing items for labelSelector (singular)
-		if len(orLabelSelectors) == 0 {
-			unstructuredItems, err = r.listItemsForLabel(unstructuredItems, gr, labelSelector, resourceClient)
-			if err != nil {
-				log.WithError(err).Error("Error listing items")
-				continue
-			}
-		}
+	return items, nil
+}
 
-		log.Infof("Retrieved %d items", len(unstructuredItems))
+// getLabelSelectors returns the label selector and the or label selectors to list the items with.
+// The cluster-scoped resources are listed with the cluster-scoped label selectors if any of them
+// is specified, otherwise the same label selectors as the namespace-scoped resources are used.
+func (r *itemCollector) getLabelSelectors(clusterScoped bool) (string, []string) {
+	selector, orSelectors := r.backupRequest.Spec.LabelSelector, r.backupRequest.Spec.OrLabelSelectors
+	if clusterScoped && hasClusterScopedLabelSelector(r.backupRequest.Spec) {
+		selector, orSelectors = r.backupRequest.Spec.ClusterScopedLabelSelector, r.backupRequest.Spec.ClusterScopedOrLabelSelectors
+	}
 
-		// Collect items in included Namespaces
-		for i := range unstructuredItems {
-			item := &unstructuredItems[i]
+	var labelSelector string
+	if selector != nil {
+		labelSelector = metav1.FormatLabelSelector(selector)
+	}
 
-			path, err := r.writeToFile(item)
-			if err != nil {
-				log.WithError(err).Error("Error writing item to file")
-				continue
-			}
+	orLabelSelectors := []string{}
+	for _, s := range orSelectors {
+		orLabelSelectors = append(orLabelSelectors, metav1.FormatLabelSelector(s))
+	}
 
-			items = append(items, &kubernetesResource{
-				groupResource: gr,
-				preferredGVR:  preferredGVR,
-				namespace:     item.GetNamespace(),
-				name:          item.GetName(),
-				path:          path,
-			})
+	return labelSelector, orLabelSelectors
+}
+
+// writeItem keeps the JSON of the item in memory or in the spillover file.
+func (r *itemCollector) writeItem(unstructuredItem *unstructured.Unstructured, item *kubernetesResource) error {
+	if r.store == nil {
+		memoryLimit := r.memoryLimit
+		if memoryLimit == 0 {
+			memoryLimit = defaultItemsMemoryLimit
 		}
+		r.store = &itemStore{dir: r.dir, memoryLimit: memoryLimit}
 	}
-	if len(orders) > 0 {
-		items = sortResourcesByOrder(r.log, items, orders)
+
+	return r.store.write(unstructuredItem, item)
+}
+
+// readItem reads the item kept by writeItem, the memory of the item is
+// released after it's read, so each item can only be read once.
+func (r *itemCollector) readItem(item *kubernetesResource) (*unstructured.Unstructured, error) {
+	if r.store == nil {
+		return nil, errors.New("no item is collected")
 	}
 
-	return items, nil
+	return r.store.read(item)
 }
 
-func (r *itemCollector) writeToFile(item *unstructured.Unstructured) (string, error) {
-	f, err := os.CreateTemp(r.dir, "")
-	if err != nil {
-		return "", errors.Wrap(err, "error creating temp file")
+// releaseItems drops the items kept by writeItem which won't be read, they
+// must be the items written last.
+func (r *itemCollector) releaseItems(items []*kubernetesResource) error {
+	if r.store == nil {
+		return nil
+	}
+
+	return r.store.release(items)
+}
+
+// close closes the spillover file, the file is removed with dir.
+func (r *itemCollector) close() {
+	if r.store != nil {
+		r.store.close()
 	}
-	defer f.Close()
+}
+
+// itemStore keeps the JSON of the collected items in memory until the total
+// size reaches the memory limit, the items beyond it are appended to a
+// spillover file, so that the memory used to back up the clusters with lots
+// of items is bounded.
+type itemStore struct {
+	dir         string
+	memoryLimit int64
+	memoryUsed  int64
+	spillFile   *os.File
+	spillSize   int64
+}
 
-	jsonBytes, err := json.Marshal(item)
+func (s *itemStore) write(unstructuredItem *unstructured.Unstructured, item *kubernetesResource) error {
+	jsonBytes, err := json.Marshal(unstructuredItem)
 	if err != nil {
-		return "", errors.Wrap(err, "error converting item to JSON")
+		return errors.Wrap(err, "error converting item to JSON")
 	}
+	size := int64(len(jsonBytes))
 
-	if _, err := f.Write(jsonBytes); err != nil {
-		return "", errors.Wrap(err, "error writing JSON to file")
+	if s.memoryUsed+size <= s.memoryLimit {
+		item.data = jsonBytes
+		item.size = size
+		s.memoryUsed += size
+		return nil
 	}
 
-	if err := f.Close(); err != nil {
-		return "", errors.Wrap(err, "error closing file")
+	if s.spillFile == nil {
+		if s.spillFile, err = os.CreateTemp(s.dir, "items-"); err != nil {
+			return errors.Wrap(err, "error creating spillover file")
+		}
+	}
+	if _, err := s.spillFile.WriteAt(jsonBytes, s.spillSize); err != nil {
+		return errors.Wrap(err, "error writing JSON to spillover file")
 	}
+	item.offset = s.spillSize
+	item.size = size
+	s.spillSize += size
 
-	return f.Name(), nil
+	return nil
+}
+
+func (s *itemStore) read(item *kubernetesResource) (*unstructured.Unstructured, error) {
+	jsonBytes := item.data
+	if jsonBytes != nil {
+		item.data = nil
+		s.memoryUsed -= item.size
+	} else {
+		if s.spillFile == nil {
+			return nil, errors.New("item isn't in memory or spillover file")
+		}
+		jsonBytes = make([]byte, item.size)
+		if _, err := s.spillFile.ReadAt(jsonBytes, item.offset); err != nil {
+			return nil, errors.Wrap(err, "error reading JSON from spillover file")
+		}
+	}
+
+	unstructuredItem := new(unstructured.Unstructured)
+	if err := json.Unmarshal(jsonBytes, unstructuredItem); err != nil {
+		return nil, errors.Wrap(err, "error decoding JSON of item")
+	}
+
+	return unstructuredItem, nil
+}
+
+// release frees the memory of the items kept in memory and truncates the
+// spillover file to drop the spilled items, which must be the last ones
+// appended to the file.
+func (s *itemStore) release(items []*kubernetesResource) error {
+	for i := len(items) - 1; i >= 0; i-- {
+		item := items[i]
+		if item.data != nil {
+			item.data = nil
+			s.memoryUsed -= item.size
+			continue
+		}
+		if s.spillFile != nil && item.offset+item.size == s.spillSize {
+			s.spillSize = item.offset
+		}
+	}
+
+	if s.spillFile == nil {
+		return nil
+	}
+	return errors.Wrap(s.spillFile.Truncate(s.spillSize), "error truncating spillover file")
+}
+
+func (s *itemStore) close() {
+	if s.spillFile != nil {
+		s.spillFile.Close()
+		s.spillFile = nil
+	}
 }
 
 // sortCoreGroup sorts the core API group.
@@ -474,61 +597,39 @@ func newCohabitatingResource(resource, group1, group2 string) *cohabitatingResou
 	}
 }
 
-// function to process pager client calls when the pageSize is specified
-func (r *itemCollector) processPagerClientCalls(gr schema.GroupResource, label string, resourceClient client.Dynamic) (runtime.Object, error) {
-	// If limit is positive, use a pager to split list over multiple requests
-	// Use Velero's dynamic list function instead of the default
-	listPager := pager.New(pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
-		return resourceClient.List(opts)
-	}))
-	// Use the page size defined in the server config
-	// TODO allow configuration of page buffer size
-	listPager.PageSize = int64(r.pageSize)
-	// Add each item to temporary slice
-	list, paginated, err := listPager.List(context.Background(), metav1.ListOptions{LabelSelector: label})
-
-	if err != nil {
-		r.log.WithError(errors.WithStack(err)).Error("Error listing resources")
-		return list, err
-	}
-
-	if !paginated {
-		r.log.Infof("list for groupResource %s was not paginated", gr)
+// listItemsForLabel lists the items with the label selector and calls fn for
+// each of them. If the page size is positive, the items are listed in pages
+// and only the page being processed is kept in memory.
+func (r *itemCollector) listItemsForLabel(gr schema.GroupResource, label string, resourceClient client.Dynamic, fn func(*unstructured.Unstructured)) error {
+	options := metav1.ListOptions{LabelSelector: label}
+	if r.pageSize > 0 {
+		options.Limit = int64(r.pageSize)
 	}
 
-	return list, nil
-}
-
-func (r *itemCollector) listItemsForLabel(unstructuredItems []unstructured.Unstructured, gr schema.GroupResource, label string, resourceClient client.Dynamic) ([]unstructured.Unstructured, error) {
-	if r.pageSize > 0 {
-		// process pager client calls
-		list, err := r.processPagerClientCalls(gr, label, resourceClient)
+	for {
+		unstructuredList, err := resourceClient.List(options)
 		if err != nil {
-			return unstructuredItems, err
+			// The continue token expires if listing all the pages takes too long, the
+			// error carries an inconsistent continue token to list the remaining items
+			// from the latest resource version.
+			var statusErr *apierrors.StatusError
+			if options.Continue != "" && apierrors.IsResourceExpired(err) && errors.As(err, &statusErr) && statusErr.ErrStatus.ListMeta.Continue != "" {
+				r.log.Warnf("The continue token of listing %s expired, continue listing from the latest resource version", gr)
+				options.Continue = statusErr.ErrStatus.ListMeta.Continue
+				continue
+			}
+			return errors.Wrapf(err, "error listing %s", gr)
 		}
 
-		err = meta.EachListItem(list, func(object runtime.Object) error {
-			u, ok := object.(*unstructured.Unstructured)
-			if !ok {
-				r.log.WithError(errors.WithStack(fmt.Errorf("expected *unstructured.Unstructured but got %T", u))).Error("unable to understand entry in the list")
-				return fmt.Errorf("expected *unstructured.Unstructured but got %T", u)
-			}
-			unstructuredItems = append(unstructuredItems, *u)
-			return nil
-		})
-		if err != nil {
-			r.log.WithError(errors.WithStack(err)).Error("unable to understand paginated list")
-			return unstructuredItems, err
+		for i := range unstructuredList.Items {
+			fn(&unstructuredList.Items[i])
 		}
-	} else {
-		unstructuredList, err := resourceClient.List(metav1.ListOptions{LabelSelector: label})
-		if err != nil {
-			r.log.WithError(errors.WithStack(err)).Error("Error listing items")
-			return unstructuredItems, err
+
+		if unstructuredList.GetContinue() == "" {
+			return nil
 		}
-		unstructuredItems = append(unstructuredItems, unstructuredList.Items...)
+		options.Continue = unstructuredList.GetContinue()
 	}
-	return unstructuredItems, nil
 }
 
 // backupNamespaces process namespace resource according to namespace filters.
@@ -540,18 +641,17 @@ func (r *itemCollector) backupNamespaces(unstructuredList *unstructured.Unstruct
 		if ie.ShouldInclude(unstructured.GetName()) {
 			log.Debugf("Backup namespace %s due to namespace filters setting.", unstructured.GetName())
 
-			path, err := r.writeToFile(&unstructuredList.Items[index])
-			if err != nil {
-				log.WithError(err).Error("Error writing item to file")
-				continue
-			}
-
-			items = append(items, &kubernetesResource{
+			item := &kubernetesResource{
 				groupResource: gr,
 				preferredGVR:  preferredGVR,
 				name:          unstructured.GetName(),
-				path:          path,
-			})
+			}
+			if err := r.writeItem(&unstructuredList.Items[index], item); err != nil {
+				log.WithError(err).Error("Error writing item")
+				continue
+			}
+
+			items = append(items, item)
 		}
 	}
 
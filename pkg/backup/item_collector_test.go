@@ -17,11 +17,23 @@ limitations under the License.
 package backup
 
 import (
+	"fmt"
+	"os"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
 )
 
 func TestSortCoreGroup(t *testing.T) {
@@ -78,3 +90,146 @@ func TestSortOrderedResource(t *testing.T) {
 	assert.Equal(t, sortedPvResources, expectedPvResources)
 
 }
+
+func TestListItemsForLabel(t *testing.T) {
+	newList := func(continueToken string, names ...string) *unstructured.UnstructuredList {
+		list := &unstructured.UnstructuredList{}
+		list.SetContinue(continueToken)
+		for _, name := range names {
+			item := unstructured.Unstructured{}
+			item.SetName(name)
+			list.Items = append(list.Items, item)
+		}
+		return list
+	}
+	expiredErr := &apierrors.StatusError{ErrStatus: metav1.Status{
+		Status:   metav1.StatusFailure,
+		Reason:   metav1.StatusReasonExpired,
+		ListMeta: metav1.ListMeta{Continue: "inconsistent"},
+	}}
+
+	resourceClient := &velerotest.FakeDynamicClient{}
+	resourceClient.On("List", metav1.ListOptions{LabelSelector: "app=foo", Limit: 2}).Return(newList("page-2", "item-1", "item-2"), nil)
+	resourceClient.On("List", metav1.ListOptions{LabelSelector: "app=foo", Limit: 2, Continue: "page-2"}).Return(&unstructured.UnstructuredList{}, expiredErr)
+	resourceClient.On("List", metav1.ListOptions{LabelSelector: "app=foo", Limit: 2, Continue: "inconsistent"}).Return(newList("", "item-3"), nil)
+
+	collector := &itemCollector{log: logrus.New(), pageSize: 2}
+	var names []string
+	err := collector.listItemsForLabel(kuberesource.Pods, "app=foo", resourceClient, func(item *unstructured.Unstructured) {
+		names = append(names, item.GetName())
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"item-1", "item-2", "item-3"}, names)
+
+	// the error of the first page isn't retried
+	resourceClient = &velerotest.FakeDynamicClient{}
+	resourceClient.On("List", metav1.ListOptions{}).Return(&unstructured.UnstructuredList{}, expiredErr)
+	collector = &itemCollector{log: logrus.New()}
+	err = collector.listItemsForLabel(kuberesource.Pods, "", resourceClient, func(item *unstructured.Unstructured) {})
+	assert.Error(t, err)
+}
+
+func TestItemStoreSpillsOver(t *testing.T) {
+	dir := t.TempDir()
+	collector := &itemCollector{dir: dir, memoryLimit: 100}
+	defer collector.close()
+
+	var items []*kubernetesResource
+	for i := 0; i < 5; i++ {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("v1")
+		obj.SetKind("ConfigMap")
+		obj.SetName(fmt.Sprintf("item-%d", i))
+		item := &kubernetesResource{name: obj.GetName()}
+		require.NoError(t, collector.writeItem(obj, item))
+		items = append(items, item)
+	}
+
+	// the first item is kept in memory and the others are spilled over
+	assert.NotNil(t, items[0].data)
+	for _, item := range items[1:] {
+		assert.Nil(t, item.data)
+	}
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	for _, item := range items {
+		obj, err := collector.readItem(item)
+		require.NoError(t, err)
+		assert.Equal(t, item.name, obj.GetName())
+	}
+	assert.Nil(t, items[0].data)
+	assert.Equal(t, int64(0), collector.store.memoryUsed)
+}
+
+// includeAll includes all the resources
+type includeAll struct{}
+
+func (includeAll) ShouldInclude(string) bool { return true }
+func (includeAll) ShouldExclude(string) bool { return false }
+
+func TestGetResourceItemsReleasesItemsOfFailedNamespace(t *testing.T) {
+	newList := func(namespace, continueToken string, names ...string) *unstructured.UnstructuredList {
+		list := &unstructured.UnstructuredList{}
+		list.SetContinue(continueToken)
+		for _, name := range names {
+			item := unstructured.Unstructured{}
+			item.SetAPIVersion("v1")
+			item.SetKind("ConfigMap")
+			item.SetNamespace(namespace)
+			item.SetName(name)
+			list.Items = append(list.Items, item)
+		}
+		return list
+	}
+
+	gv := schema.GroupVersion{Version: "v1"}
+	resource := metav1.APIResource{Name: "configmaps", Namespaced: true}
+
+	// the second page of ns-1 fails after the items of its first page are written
+	ns1Client := &velerotest.FakeDynamicClient{}
+	ns1Client.On("List", metav1.ListOptions{Limit: 2}).Return(newList("ns-1", "page-2", "cm-1", "cm-2"), nil)
+	ns1Client.On("List", metav1.ListOptions{Limit: 2, Continue: "page-2"}).Return(&unstructured.UnstructuredList{}, errors.New("list error"))
+	ns2Client := &velerotest.FakeDynamicClient{}
+	ns2Client.On("List", metav1.ListOptions{Limit: 2}).Return(newList("ns-2", "", "cm-3", "cm-4"), nil)
+
+	dynamicFactory := &velerotest.FakeDynamicFactory{}
+	dynamicFactory.On("ClientForGroupVersionResource", gv, resource, "ns-1").Return(ns1Client, nil)
+	dynamicFactory.On("ClientForGroupVersionResource", gv, resource, "ns-2").Return(ns2Client, nil)
+
+	collector := &itemCollector{
+		log: logrus.New(),
+		backupRequest: &Request{
+			Backup:                    builder.ForBackup("velero", "backup-1").Result(),
+			NamespaceIncludesExcludes: collections.NewIncludesExcludes().Includes("ns-1", "ns-2"),
+			ResourceIncludesExcludes:  includeAll{},
+		},
+		discoveryHelper: velerotest.NewFakeDiscoveryHelper(true, nil),
+		dynamicFactory:  dynamicFactory,
+		dir:             t.TempDir(),
+		pageSize:        2,
+		// the first item of each namespace is kept in memory and the other is spilled over
+		memoryLimit: 100,
+	}
+	defer collector.close()
+
+	items, err := collector.getResourceItems(logrus.New(), gv, resource, nil)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	// only the items of ns-2 are kept in memory or in the spillover file
+	assert.Equal(t, items[0].size, collector.store.memoryUsed)
+	assert.Equal(t, items[1].size, collector.store.spillSize)
+	info, err := collector.store.spillFile.Stat()
+	require.NoError(t, err)
+	assert.Equal(t, items[1].size, info.Size())
+
+	for i, name := range []string{"cm-3", "cm-4"} {
+		obj, err := collector.readItem(items[i])
+		require.NoError(t, err)
+		assert.Equal(t, "ns-2", obj.GetNamespace())
+		assert.Equal(t, name, obj.GetName())
+	}
+	assert.Equal(t, int64(0), collector.store.memoryUsed)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ItemSkipReason is the machine-readable reason why an item is skipped by the backup.
+type ItemSkipReason string
+
+const (
+	// ItemSkipReasonExcludedByFilter means the item is excluded by the namespace or resource
+	// filters of the backup, e.g. an additional item returned by a plugin in an excluded namespace.
+	ItemSkipReasonExcludedByFilter ItemSkipReason = "ExcludedByFilter"
+	// ItemSkipReasonExcludedByLabel means the item has the velero.io/exclude-from-backup=true label.
+	ItemSkipReasonExcludedByLabel ItemSkipReason = "ExcludedByLabel"
+	// ItemSkipReasonExcludedBySecretPolicy means the type of the Secret is excluded by the secret policy.
+	ItemSkipReasonExcludedBySecretPolicy ItemSkipReason = "ExcludedBySecretPolicy"
+	// ItemSkipReasonBeingDeleted means the item is being deleted when it's backed up.
+	ItemSkipReasonBeingDeleted ItemSkipReason = "BeingDeleted"
+	// ItemSkipReasonNotFound means an additional item returned by a plugin doesn't exist in the cluster.
+	ItemSkipReasonNotFound ItemSkipReason = "NotFound"
+)
+
+// SkippedItem is an item skipped by the backup.
+type SkippedItem struct {
+	Resource  string         `json:"resource"`
+	Namespace string         `json:"namespace,omitempty"`
+	Name      string         `json:"name"`
+	Reason    ItemSkipReason `json:"reason"`
+	Message   string         `json:"message,omitempty"`
+}
+
+type skippedItemKey struct {
+	resource  string
+	namespace string
+	name      string
+}
+
+// skipItemTracker keeps track of the items skipped by the backup and the reasons why they are skipped.
+// A nil tracker tracks nothing.
+type skipItemTracker struct {
+	*sync.RWMutex
+	items map[skippedItemKey]SkippedItem
+}
+
+func NewSkipItemTracker() *skipItemTracker {
+	return &skipItemTracker{
+		RWMutex: &sync.RWMutex{},
+		items:   make(map[skippedItemKey]SkippedItem),
+	}
+}
+
+// Track tracks the item skipped for the reason, the latest reason of an item wins.
+func (it *skipItemTracker) Track(groupResource schema.GroupResource, namespace, name string, reason ItemSkipReason, message string) {
+	if it == nil || name == "" {
+		return
+	}
+	it.Lock()
+	defer it.Unlock()
+	it.items[skippedItemKey{groupResource.String(), namespace, name}] = SkippedItem{
+		Resource:  groupResource.String(),
+		Namespace: namespace,
+		Name:      name,
+		Reason:    reason,
+		Message:   message,
+	}
+}
+
+// Untrack removes the item, e.g. when it's backed up after being skipped.
+func (it *skipItemTracker) Untrack(groupResource schema.GroupResource, namespace, name string) {
+	if it == nil {
+		return
+	}
+	it.Lock()
+	defer it.Unlock()
+	delete(it.items, skippedItemKey{groupResource.String(), namespace, name})
+}
+
+// Summary returns the tracked items sorted by the resource, namespace and name.
+func (it *skipItemTracker) Summary() []SkippedItem {
+	res := []SkippedItem{}
+	if it == nil {
+		return res
+	}
+	it.RLock()
+	defer it.RUnlock()
+	for _, item := range it.items {
+		res = append(res, item)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Resource != res[j].Resource {
+			return res[i].Resource < res[j].Resource
+		}
+		if res[i].Namespace != res[j].Namespace {
+			return res[i].Namespace < res[j].Namespace
+		}
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
@@ -0,0 +1,48 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+func TestSkipItemTrackerSummary(t *testing.T) {
+	tracker := NewSkipItemTracker()
+	tracker.Track(kuberesource.Secrets, "ns-2", "secret-1", ItemSkipReasonExcludedBySecretPolicy, "secret type kubernetes.io/service-account-token is excluded")
+	tracker.Track(kuberesource.Pods, "ns-1", "pod-2", ItemSkipReasonBeingDeleted, "item is being deleted")
+	tracker.Track(kuberesource.Pods, "ns-1", "pod-1", ItemSkipReasonExcludedByFilter, "namespace is excluded")
+	// the latest reason wins
+	tracker.Track(kuberesource.Pods, "ns-1", "pod-1", ItemSkipReasonExcludedByLabel, "item has label velero.io/exclude-from-backup=true")
+	// shouldn't be added
+	tracker.Track(kuberesource.Pods, "ns-1", "", ItemSkipReasonBeingDeleted, "")
+	tracker.Track(kuberesource.PersistentVolumes, "", "pv-1", ItemSkipReasonExcludedByFilter, "cluster-scoped resource is excluded")
+	tracker.Untrack(kuberesource.PersistentVolumes, "", "pv-1")
+
+	assert.Equal(t, []SkippedItem{
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-1", Reason: ItemSkipReasonExcludedByLabel, Message: "item has label velero.io/exclude-from-backup=true"},
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-2", Reason: ItemSkipReasonBeingDeleted, Message: "item is being deleted"},
+		{Resource: "secrets", Namespace: "ns-2", Name: "secret-1", Reason: ItemSkipReasonExcludedBySecretPolicy, Message: "secret type kubernetes.io/service-account-token is excluded"},
+	}, tracker.Summary())
+
+	var nilTracker *skipItemTracker
+	nilTracker.Track(kuberesource.Pods, "ns-1", "pod-1", ItemSkipReasonBeingDeleted, "")
+	assert.Equal(t, []SkippedItem{}, nilTracker.Summary())
+}
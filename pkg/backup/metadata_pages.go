@@ -0,0 +1,105 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"sort"
+
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// MetadataPageSize is the maximum number of items in a page of the per-resource metadata of a
+// backup, i.e. the resource list, the item operations and the volume information. The metadata
+// of the backups with more items is split into multiple files in the object store, so that the
+// readers don't have to hold it in a single object and can load the pages one by one.
+const MetadataPageSize = 10000
+
+// PaginateResourceList splits the resource list into pages of at most pageSize items, the items
+// of a resource may span multiple pages. There is always at least one page, the whole list is
+// the only page if pageSize isn't positive.
+func PaginateResourceList(resourceList map[string][]string, pageSize int) []map[string][]string {
+	if pageSize <= 0 {
+		return []map[string][]string{resourceList}
+	}
+
+	resources := make([]string, 0, len(resourceList))
+	for resource := range resourceList {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	pages := []map[string][]string{{}}
+	count := 0
+	for _, resource := range resources {
+		items := resourceList[resource]
+		for len(items) > 0 {
+			if count == pageSize {
+				pages = append(pages, map[string][]string{})
+				count = 0
+			}
+
+			n := len(items)
+			if n > pageSize-count {
+				n = pageSize - count
+			}
+
+			page := pages[len(pages)-1]
+			page[resource] = append(page[resource], items[:n]...)
+			items = items[n:]
+			count += n
+		}
+	}
+
+	return pages
+}
+
+// PaginateItemOperations splits the item operations into pages of at most pageSize operations.
+// There is always at least one page.
+func PaginateItemOperations(operations []*itemoperation.BackupOperation, pageSize int) [][]*itemoperation.BackupOperation {
+	pages := [][]*itemoperation.BackupOperation{}
+	for pageSize > 0 && len(operations) > pageSize {
+		pages = append(pages, operations[:pageSize])
+		operations = operations[pageSize:]
+	}
+
+	return append(pages, operations)
+}
+
+// PaginateVolumeInfos splits the volume information into pages of at most pageSize volumes.
+// There is always at least one page.
+func PaginateVolumeInfos(volumeInfos []volume.VolumeInfo, pageSize int) []volume.VolumeInfos {
+	pages := []volume.VolumeInfos{}
+	for pageSize > 0 && len(volumeInfos) > pageSize {
+		pages = append(pages, volume.VolumeInfos{VolumeInfos: volumeInfos[:pageSize]})
+		volumeInfos = volumeInfos[pageSize:]
+	}
+
+	return append(pages, volume.VolumeInfos{VolumeInfos: volumeInfos})
+}
+
+// PaginateSkippedItems splits the skipped items into pages of at most pageSize items. There is
+// always at least one page.
+func PaginateSkippedItems(items []SkippedItem, pageSize int) [][]SkippedItem {
+	pages := [][]SkippedItem{}
+	for pageSize > 0 && len(items) > pageSize {
+		pages = append(pages, items[:pageSize])
+		items = items[pageSize:]
+	}
+
+	return append(pages, items)
+}
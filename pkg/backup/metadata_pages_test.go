@@ -0,0 +1,107 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+func TestPaginateResourceList(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceList map[string][]string
+		pageSize     int
+		expected     []map[string][]string
+	}{
+		{
+			name:     "empty list",
+			pageSize: 2,
+			expected: []map[string][]string{{}},
+		},
+		{
+			name: "list fits in a page",
+			resourceList: map[string][]string{
+				"v1/Pod": {"ns-1/pod-1"},
+			},
+			pageSize: 2,
+			expected: []map[string][]string{
+				{"v1/Pod": {"ns-1/pod-1"}},
+			},
+		},
+		{
+			name: "items of a resource span pages",
+			resourceList: map[string][]string{
+				"v1/Pod":       {"ns-1/pod-1", "ns-1/pod-2", "ns-1/pod-3"},
+				"v1/Namespace": {"ns-1"},
+			},
+			pageSize: 2,
+			expected: []map[string][]string{
+				{"v1/Namespace": {"ns-1"}, "v1/Pod": {"ns-1/pod-1"}},
+				{"v1/Pod": {"ns-1/pod-2", "ns-1/pod-3"}},
+			},
+		},
+		{
+			name: "no page size",
+			resourceList: map[string][]string{
+				"v1/Pod": {"ns-1/pod-1", "ns-1/pod-2", "ns-1/pod-3"},
+			},
+			expected: []map[string][]string{
+				{"v1/Pod": {"ns-1/pod-1", "ns-1/pod-2", "ns-1/pod-3"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, PaginateResourceList(test.resourceList, test.pageSize))
+		})
+	}
+}
+
+func TestPaginateItemOperations(t *testing.T) {
+	operations := []*itemoperation.BackupOperation{
+		{Spec: itemoperation.BackupOperationSpec{OperationID: "1"}},
+		{Spec: itemoperation.BackupOperationSpec{OperationID: "2"}},
+		{Spec: itemoperation.BackupOperationSpec{OperationID: "3"}},
+	}
+
+	assert.Equal(t, [][]*itemoperation.BackupOperation{{}}, PaginateItemOperations([]*itemoperation.BackupOperation{}, 2))
+	assert.Equal(t, [][]*itemoperation.BackupOperation{operations[:2], operations[2:]}, PaginateItemOperations(operations, 2))
+	assert.Equal(t, [][]*itemoperation.BackupOperation{operations}, PaginateItemOperations(operations, 3))
+}
+
+func TestPaginateSkippedItems(t *testing.T) {
+	items := []SkippedItem{{Name: "item-1"}, {Name: "item-2"}, {Name: "item-3"}}
+
+	assert.Equal(t, [][]SkippedItem{{}}, PaginateSkippedItems([]SkippedItem{}, 2))
+	assert.Equal(t, [][]SkippedItem{items[:2], items[2:]}, PaginateSkippedItems(items, 2))
+}
+
+func TestPaginateVolumeInfos(t *testing.T) {
+	volumeInfos := []volume.VolumeInfo{{PVCName: "pvc-1"}, {PVCName: "pvc-2"}, {PVCName: "pvc-3"}}
+
+	assert.Equal(t, []volume.VolumeInfos{{VolumeInfos: []volume.VolumeInfo{}}}, PaginateVolumeInfos([]volume.VolumeInfo{}, 2))
+	assert.Equal(t, []volume.VolumeInfos{
+		{VolumeInfos: volumeInfos[:2]},
+		{VolumeInfos: volumeInfos[2:]},
+	}, PaginateVolumeInfos(volumeInfos, 2))
+}
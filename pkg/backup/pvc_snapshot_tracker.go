@@ -56,6 +56,11 @@ func (t *pvcSnapshotTracker) Take(pod *corev1api.Pod, volumeName string) {
 	t.recordStatus(pod, volumeName, pvcSnapshotStatusTaken, pvcSnapshotStatusTracked)
 }
 
+// TakePVC indicates a PVC which isn't mounted by any pod has been taken by pod volume backup.
+func (t *pvcSnapshotTracker) TakePVC(namespace, name string) {
+	t.pvcs[key(namespace, name)] = pvcSnapshotStatusTaken
+}
+
 // Optout indicates a volume from a pod has been opted out by pod's annotation
 func (t *pvcSnapshotTracker) Optout(pod *corev1api.Pod, volumeName string) {
 	t.recordStatus(pod, volumeName, pvcSnapshotStatusOptedout, pvcSnapshotStatusNotTracked)
@@ -20,11 +20,17 @@ import (
 	"fmt"
 	"sort"
 
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	"github.com/vmware-tanzu/velero/internal/hook"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
+	"github.com/vmware-tanzu/velero/internal/validationpolicies"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/itemgraph"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/secretpolicy"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -49,9 +55,21 @@ type Request struct {
 	VolumeSnapshots           []*volume.Snapshot
 	PodVolumeBackups          []*velerov1api.PodVolumeBackup
 	BackedUpItems             map[itemKey]struct{}
+	DependencyGraph           *itemgraph.Graph
+	Checksums                 archive.Checksums
 	itemOperationsList        *[]*itemoperation.BackupOperation
 	ResPolicies               *resourcepolicies.Policies
+	ValidationPolicies        *validationpolicies.Policies
+	SecretPolicy              *secretpolicy.Policy
 	SkippedPVTracker          *skipPVTracker
+	SkippedItemTracker        *skipItemTracker
+	volumeGroupSnapshots      map[string]*volumeGroupSnapshot
+
+	// ClusterResources records the cluster-scoped items of the backup if it deduplicates
+	// them, the items unchanged since PreviousClusterResources aren't written again
+	ClusterResources             archive.ClusterResources
+	PreviousClusterResources     archive.ClusterResources
+	clusterResourcesDeduplicated int
 }
 
 // GetItemOperationsList returns ItemOperationsList, initializing it if necessary
@@ -82,3 +100,15 @@ func (r *Request) BackupResourceList() map[string][]string {
 
 	return resources
 }
+
+// BackedUpNamespaces returns the namespaces that have at least one namespaced
+// item backed up
+func (r *Request) BackedUpNamespaces() sets.String {
+	namespaces := sets.NewString()
+	for i := range r.BackedUpItems {
+		if i.namespace != "" {
+			namespaces.Insert(i.namespace)
+		}
+	}
+	return namespaces
+}
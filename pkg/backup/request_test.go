@@ -80,3 +80,13 @@ func TestRequest_BackupResourceListEntriesSorted(t *testing.T) {
 		"v1/Pod": {"ns1/pod1", "ns2/pod2"},
 	}, req.BackupResourceList())
 }
+
+func TestRequest_BackedUpNamespaces(t *testing.T) {
+	req := Request{BackedUpItems: map[itemKey]struct{}{
+		{resource: "v1/Pod", name: "pod1", namespace: "ns1"}:      {},
+		{resource: "v1/Pod", name: "pod2", namespace: "ns1"}:      {},
+		{resource: "v1/Secret", name: "secret", namespace: "ns2"}: {},
+		{resource: "v1/Namespace", name: "ns3"}:                   {},
+	}}
+	assert.ElementsMatch(t, []string{"ns1", "ns2"}, req.BackedUpNamespaces().List())
+}
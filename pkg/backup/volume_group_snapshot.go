@@ -0,0 +1,115 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	csiutil "github.com/vmware-tanzu/velero/pkg/util/csi"
+)
+
+// volumeGroupSnapshot is the result of the VolumeGroupSnapshot taken for a group of PVCs.
+type volumeGroupSnapshot struct {
+	// volumeSnapshots are the names of the VolumeSnapshots of the group keyed by the PVC names
+	volumeSnapshots map[string]string
+	err             error
+}
+
+// groupVolumeSnapshot returns the VolumeSnapshot taken for the PVC by the VolumeGroupSnapshot of
+// its volume group, the group snapshot is taken when the first PVC of the group is backed up.
+// An empty name is returned if the PVC doesn't belong to a group or its snapshot isn't taken by
+// the CSI plugin.
+func (ib *itemBackupper) groupVolumeSnapshot(log logrus.FieldLogger, obj runtime.Unstructured) (string, error) {
+	labelKey := ib.backupRequest.Spec.VolumeGroupSnapshotLabelKey
+	if labelKey == "" || !features.IsEnabled(velerov1api.CSIFeatureFlag) || boolptr.IsSetToFalse(ib.backupRequest.Spec.SnapshotVolumes) {
+		return "", nil
+	}
+
+	pvc := &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+	group, ok := pvc.GetLabels()[labelKey]
+	if !ok || group == "" {
+		return "", nil
+	}
+
+	if ib.podVolumeSnapshotTracker.Has(pvc.GetNamespace(), pvc.GetName()) {
+		log.Infof("Skipping volume group snapshot of PVC because it's backed up with pod volume backup")
+		return "", nil
+	}
+
+	if ib.backupRequest.volumeGroupSnapshots == nil {
+		ib.backupRequest.volumeGroupSnapshots = map[string]*volumeGroupSnapshot{}
+	}
+
+	key := fmt.Sprintf("%s/%s", pvc.GetNamespace(), group)
+	snapshot, taken := ib.backupRequest.volumeGroupSnapshots[key]
+	if !taken {
+		snapshot = ib.takeVolumeGroupSnapshot(log.WithField("volumeGroup", group), pvc.GetNamespace(), labelKey, group)
+		ib.backupRequest.volumeGroupSnapshots[key] = snapshot
+	}
+
+	if snapshot.err != nil {
+		return "", errors.Wrapf(snapshot.err, "error taking snapshot of volume group %s", key)
+	}
+
+	vs, ok := snapshot.volumeSnapshots[pvc.GetName()]
+	if !ok {
+		log.Warnf("PVC isn't in the volume group snapshot of %s, it's snapshotted alone", key)
+	}
+	return vs, nil
+}
+
+func (ib *itemBackupper) takeVolumeGroupSnapshot(log logrus.FieldLogger, namespace, labelKey, group string) *volumeGroupSnapshot {
+	ctx := context.Background()
+	backup := ib.backupRequest.Backup
+
+	log.Info("Taking volume group snapshot")
+	vgs, err := csiutil.CreateVolumeGroupSnapshot(ctx, ib.kbClient, namespace, fmt.Sprintf("velero-%s-", label.GetValidName(group)),
+		map[string]string{labelKey: group},
+		map[string]string{
+			velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
+			velerov1api.BackupUIDLabel:  string(backup.UID),
+		})
+	if err != nil {
+		return &volumeGroupSnapshot{err: err}
+	}
+
+	volumeSnapshots, err := csiutil.WaitVolumeGroupSnapshotReady(ctx, ib.kbClient, namespace, vgs.GetName(), backup.Spec.CSISnapshotTimeout.Duration, log)
+	if err != nil {
+		return &volumeGroupSnapshot{err: err}
+	}
+
+	var names []string
+	for _, name := range volumeSnapshots {
+		names = append(names, name)
+	}
+	if err := csiutil.ReleaseVolumeGroupSnapshot(ctx, ib.kbClient, namespace, vgs.GetName(), names, log); err != nil {
+		return &volumeGroupSnapshot{err: err}
+	}
+
+	log.Infof("Volume group snapshot %s/%s is taken for %d PVCs", namespace, vgs.GetName(), len(volumeSnapshots))
+	return &volumeGroupSnapshot{volumeSnapshots: volumeSnapshots}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"testing"
+
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	csiutil "github.com/vmware-tanzu/velero/pkg/util/csi"
+)
+
+// groupSnapshotClient acts as the snapshot controller, the VolumeGroupSnapshots are created
+// ready with the snapshots of the PVCs.
+type groupSnapshotClient struct {
+	kbclient.Client
+	snapshots map[string]string
+	created   int
+}
+
+func (c *groupSnapshotClient) Create(ctx context.Context, obj kbclient.Object, opts ...kbclient.CreateOption) error {
+	if u, ok := obj.(*unstructured.Unstructured); ok && u.GroupVersionKind() == csiutil.VolumeGroupSnapshotGVK {
+		c.created++
+		var refs []interface{}
+		for pvc, vs := range c.snapshots {
+			refs = append(refs, map[string]interface{}{
+				"persistentVolumeClaimRef": map[string]interface{}{"name": pvc},
+				"volumeSnapshotRef":        map[string]interface{}{"name": vs},
+			})
+		}
+		u.SetName(u.GetGenerateName() + "1")
+		u.Object["status"] = map[string]interface{}{
+			"readyToUse":                          true,
+			"boundVolumeGroupSnapshotContentName": "vgsc-1",
+			"pvcVolumeSnapshotRefList":            refs,
+		}
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func TestGroupVolumeSnapshot(t *testing.T) {
+	features.NewFeatureFlagSet("EnableCSI")
+	defer func() {
+		features.NewFeatureFlagSet("")
+	}()
+
+	content := &unstructured.Unstructured{}
+	content.SetGroupVersionKind(csiutil.VolumeGroupSnapshotContentGVK)
+	content.SetName("vgsc-1")
+	ownedVS := func(name string) *snapshotv1api.VolumeSnapshot {
+		vs := builder.ForVolumeSnapshot("ns-1", name).Result()
+		vs.OwnerReferences = []metav1.OwnerReference{{Kind: "VolumeGroupSnapshot", Name: "velero-db-1"}}
+		return vs
+	}
+
+	client := &groupSnapshotClient{
+		Client:    velerotest.NewFakeControllerRuntimeClient(t, content, ownedVS("vs-data"), ownedVS("vs-log")),
+		snapshots: map[string]string{"pvc-data": "vs-data", "pvc-log": "vs-log"},
+	}
+	ib := &itemBackupper{
+		backupRequest: &Request{
+			Backup: builder.ForBackup("velero", "backup-1").VolumeGroupSnapshotLabelKey(velerov1api.DefaultVGSLabelKey).Result(),
+		},
+		kbClient:                 client,
+		podVolumeSnapshotTracker: newPVCSnapshotTracker(),
+	}
+	ib.podVolumeSnapshotTracker.TakePVC("ns-1", "pvc-fs")
+
+	pvc := func(name string, labels ...string) runtime.Unstructured {
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.ForPersistentVolumeClaim("ns-1", name).
+			ObjectMeta(builder.WithLabels(labels...)).Result())
+		require.NoError(t, err)
+		return &unstructured.Unstructured{Object: obj}
+	}
+
+	tests := []struct {
+		name     string
+		pvc      runtime.Unstructured
+		expected string
+	}{
+		{
+			name: "PVC without the group label",
+			pvc:  pvc("pvc-1"),
+		},
+		{
+			name: "PVC backed up with pod volume backup",
+			pvc:  pvc("pvc-fs", velerov1api.DefaultVGSLabelKey, "db"),
+		},
+		{
+			name:     "first PVC of the group",
+			pvc:      pvc("pvc-data", velerov1api.DefaultVGSLabelKey, "db"),
+			expected: "vs-data",
+		},
+		{
+			name:     "second PVC of the group",
+			pvc:      pvc("pvc-log", velerov1api.DefaultVGSLabelKey, "db"),
+			expected: "vs-log",
+		},
+		{
+			name: "PVC labeled after the group snapshot",
+			pvc:  pvc("pvc-new", velerov1api.DefaultVGSLabelKey, "db"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vs, err := ib.groupVolumeSnapshot(logrus.New(), test.pvc)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, vs)
+		})
+	}
+
+	// the group snapshot is taken once and released, the VolumeSnapshots are kept
+	assert.Equal(t, 1, client.created)
+	vgs := &unstructured.Unstructured{}
+	vgs.SetGroupVersionKind(csiutil.VolumeGroupSnapshotGVK)
+	err := client.Get(context.Background(), kbclient.ObjectKey{Namespace: "ns-1", Name: "velero-db-1"}, vgs)
+	assert.True(t, apierrors.IsNotFound(err))
+	for _, name := range []string{"vs-data", "vs-log"} {
+		vs := &snapshotv1api.VolumeSnapshot{}
+		require.NoError(t, client.Get(context.Background(), kbclient.ObjectKey{Namespace: "ns-1", Name: name}, vs))
+		assert.Empty(t, vs.OwnerReferences)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/podvolume"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// PodVolumeBackupVolumeInfos returns the volume information of the completed pod volume backups.
+func PodVolumeBackupVolumeInfos(podVolumeBackups []*velerov1api.PodVolumeBackup) []volume.VolumeInfo {
+	volumeInfos := []volume.VolumeInfo{}
+	for _, pvb := range podVolumeBackups {
+		if pvb.Status.Phase != velerov1api.PodVolumeBackupPhaseCompleted {
+			continue
+		}
+
+		volumeInfo := volume.VolumeInfo{
+			BackupMethod:   volume.PodVolumeBackup,
+			StartTimestamp: pvb.Status.StartTimestamp,
+			PVBInfo: volume.PodVolumeBackupInfo{
+				SnapshotHandle: pvb.Status.SnapshotID,
+				Size:           pvb.Status.Progress.TotalBytes,
+				UploaderType:   pvb.Spec.UploaderType,
+				VolumeName:     pvb.Spec.Volume,
+				PodName:        fmt.Sprintf("%s/%s", pvb.Spec.Pod.Namespace, pvb.Spec.Pod.Name),
+				NodeName:       pvb.Spec.Node,
+				UploadStats:    volume.NewUploadStats(pvb.Status.UploadStats.LogicalBytes, pvb.Status.UploadStats.UploadedBytes),
+			},
+		}
+		if pvcName := pvb.Annotations[podvolume.PVCNameAnnotation]; pvcName != "" {
+			volumeInfo.PVCName = pvcName
+			volumeInfo.PVCNamespace = pvb.Spec.Pod.Namespace
+		}
+		volumeInfos = append(volumeInfos, volumeInfo)
+	}
+	return volumeInfos
+}
+
+// DataUploadVolumeInfos returns the volume information of the completed data uploads.
+func DataUploadVolumeInfos(dataUploads []velerov2alpha1api.DataUpload) []volume.VolumeInfo {
+	volumeInfos := []volume.VolumeInfo{}
+	for _, du := range dataUploads {
+		if du.Status.Phase != velerov2alpha1api.DataUploadPhaseCompleted {
+			continue
+		}
+
+		volumeInfos = append(volumeInfos, volume.VolumeInfo{
+			PVCName:           du.Spec.SourcePVC,
+			PVCNamespace:      du.Spec.SourceNamespace,
+			BackupMethod:      volume.CSISnapshot,
+			SnapshotDataMoved: true,
+			StartTimestamp:    du.Status.StartTimestamp,
+			OperationID:       du.Labels[velerov1api.AsyncOperationIDLabel],
+			SnapshotDataMovementInfo: volume.SnapshotDataMovementInfo{
+				DataMover:      du.Spec.DataMover,
+				UploaderType:   uploader.KopiaType,
+				SnapshotHandle: du.Status.SnapshotID,
+				UploadStats:    volume.NewUploadStats(du.Status.UploadStats.LogicalBytes, du.Status.UploadStats.UploadedBytes),
+			},
+		})
+	}
+	return volumeInfos
+}
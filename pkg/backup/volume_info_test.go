@@ -0,0 +1,52 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/podvolume"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+func TestPodVolumeBackupVolumeInfos(t *testing.T) {
+	completed := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").
+		PodNamespace("ns-1").PodName("pod-1").Volume("data").
+		SnapshotID("snapshot-1").UploaderType("kopia").Phase(velerov1api.PodVolumeBackupPhaseCompleted).Result()
+	completed.Annotations = map[string]string{podvolume.PVCNameAnnotation: "pvc-1"}
+	completed.Status.UploadStats = shared.UploadStats{LogicalBytes: 1024, UploadedBytes: 512}
+
+	failed := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-2").
+		PodNamespace("ns-1").PodName("pod-1").Volume("cache").
+		Phase(velerov1api.PodVolumeBackupPhaseFailed).Result()
+
+	volumeInfos := PodVolumeBackupVolumeInfos([]*velerov1api.PodVolumeBackup{completed, failed})
+	require.Len(t, volumeInfos, 1)
+	assert.Equal(t, volume.PodVolumeBackup, volumeInfos[0].BackupMethod)
+	assert.Equal(t, "pvc-1", volumeInfos[0].PVCName)
+	assert.Equal(t, "ns-1", volumeInfos[0].PVCNamespace)
+	assert.Equal(t, "snapshot-1", volumeInfos[0].PVBInfo.SnapshotHandle)
+	assert.Equal(t, "ns-1/pod-1", volumeInfos[0].PVBInfo.PodName)
+	assert.Equal(t, "data", volumeInfos[0].PVBInfo.VolumeName)
+	assert.Equal(t, &volume.UploadStats{LogicalBytes: 1024, UploadedBytes: 512, DedupRatio: 2}, volumeInfos[0].PVBInfo.UploadStats)
+}
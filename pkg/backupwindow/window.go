@@ -0,0 +1,189 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backupwindow implements the daily time window in which the backups and their data
+// uploads are allowed to start, the window is configured by a configmap in the Velero namespace.
+package backupwindow
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// the keys of the backup window configmap
+	keyStart            = "start"
+	keyEnd              = "end"
+	keyTimeZone         = "timeZone"
+	keySuspendDataPaths = "suspendDataPaths"
+
+	// SuspendedAnnotation is added to the data uploads paused by the node-agent when the backup
+	// window closes, so that only they are resumed when the window opens again.
+	SuspendedAnnotation = "velero.io/suspended-by-backup-window"
+
+	// maxQueueInterval is the max interval to check a queued item against the window again, so
+	// that the changes of the configmap take effect in time.
+	maxQueueInterval = 5 * time.Minute
+
+	day = 24 * time.Hour
+)
+
+// Window is a daily time window, it spans midnight if the end is before the start. A nil
+// window is always open.
+type Window struct {
+	// start and end are the offsets from the midnight
+	start    time.Duration
+	end      time.Duration
+	location *time.Location
+
+	// SuspendDataPaths indicates the in-progress data uploads are paused when the window
+	// closes and resumed when it opens again.
+	SuspendDataPaths bool
+}
+
+// Get returns the backup window from the configmap in the namespace. Nil is returned if the
+// configmap name is empty or the configmap doesn't exist.
+func Get(ctx context.Context, cli client.Client, namespace, configMapName string) (*Window, error) {
+	if configMapName == "" {
+		return nil, nil
+	}
+
+	configMap := &corev1api.ConfigMap{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: configMapName}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "error getting backup window configmap %s/%s", namespace, configMapName)
+	}
+
+	window, err := parse(configMap.Data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid backup window configmap %s/%s", namespace, configMapName)
+	}
+
+	return window, nil
+}
+
+func parse(data map[string]string) (*Window, error) {
+	window, err := New(data[keyStart], data[keyEnd], data[keyTimeZone])
+	if err != nil {
+		return nil, err
+	}
+
+	if suspend := data[keySuspendDataPaths]; suspend != "" {
+		if window.SuspendDataPaths, err = strconv.ParseBool(suspend); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", keySuspendDataPaths)
+		}
+	}
+
+	return window, nil
+}
+
+// New returns the window from the start and end in the format of "HH:MM" in the time zone,
+// UTC is used if the time zone is empty.
+func New(startTime, endTime, timeZone string) (*Window, error) {
+	start, err := parseTimeOfDay(startTime)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", keyStart)
+	}
+
+	end, err := parseTimeOfDay(endTime)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", keyEnd)
+	}
+
+	if start == end {
+		return nil, errors.Errorf("%s and %s are the same", keyStart, keyEnd)
+	}
+
+	window := &Window{start: start, end: end, location: time.UTC}
+
+	if timeZone != "" {
+		if window.location, err = time.LoadLocation(timeZone); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", keyTimeZone)
+		}
+	}
+
+	return window, nil
+}
+
+// parseTimeOfDay parses the time in the format of "HH:MM" into the offset from the midnight.
+func parseTimeOfDay(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, errors.Errorf("%q isn't in the format of HH:MM", value)
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// IsOpen returns true if the time is in the window.
+func (w *Window) IsOpen(now time.Time) bool {
+	if w == nil {
+		return true
+	}
+
+	offset := w.offset(now)
+	if w.start < w.end {
+		return offset >= w.start && offset < w.end
+	}
+	return offset >= w.start || offset < w.end
+}
+
+// UntilOpen returns how long it is until the window opens, zero is returned if it's open.
+func (w *Window) UntilOpen(now time.Time) time.Duration {
+	if w.IsOpen(now) {
+		return 0
+	}
+
+	until := w.start - w.offset(now)
+	if until < 0 {
+		until += day
+	}
+	return until
+}
+
+// QueueInterval returns the interval after which the items queued for the window are checked
+// again, zero is returned if the window is open.
+func (w *Window) QueueInterval(now time.Time) time.Duration {
+	until := w.UntilOpen(now)
+	if until > maxQueueInterval {
+		return maxQueueInterval
+	}
+	return until
+}
+
+func (w *Window) String() string {
+	if w == nil {
+		return "always"
+	}
+
+	return fmt.Sprintf("%02d:%02d-%02d:%02d %s", int(w.start.Hours()), int(w.start.Minutes())%60,
+		int(w.end.Hours()), int(w.end.Minutes())%60, w.location)
+}
+
+func (w *Window) offset(now time.Time) time.Duration {
+	local := now.In(w.location)
+	return time.Duration(local.Hour())*time.Hour + time.Duration(local.Minute())*time.Minute +
+		time.Duration(local.Second())*time.Second + time.Duration(local.Nanosecond())
+}
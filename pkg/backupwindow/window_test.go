@@ -0,0 +1,172 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupwindow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGet(t *testing.T) {
+	configMap := &corev1api.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "backup-window"},
+		Data: map[string]string{
+			"start":            "22:00",
+			"end":              "06:00",
+			"timeZone":         "Europe/Berlin",
+			"suspendDataPaths": "true",
+		},
+	}
+	cli := velerotest.NewFakeControllerRuntimeClient(t, configMap)
+
+	window, err := Get(context.Background(), cli, "velero", "")
+	require.NoError(t, err)
+	assert.Nil(t, window)
+
+	window, err = Get(context.Background(), cli, "velero", "not-exist")
+	require.NoError(t, err)
+	assert.Nil(t, window)
+
+	window, err = Get(context.Background(), cli, "velero", "backup-window")
+	require.NoError(t, err)
+	assert.Equal(t, "22:00-06:00 Europe/Berlin", window.String())
+	assert.True(t, window.SuspendDataPaths)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        map[string]string
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:     "UTC by default",
+			data:     map[string]string{"start": "01:30", "end": "05:00"},
+			expected: "01:30-05:00 UTC",
+		},
+		{
+			name:        "missing start",
+			data:        map[string]string{"end": "05:00"},
+			expectedErr: `invalid start: "" isn't in the format of HH:MM`,
+		},
+		{
+			name:        "invalid end",
+			data:        map[string]string{"start": "01:30", "end": "25:00"},
+			expectedErr: `invalid end: "25:00" isn't in the format of HH:MM`,
+		},
+		{
+			name:        "empty window",
+			data:        map[string]string{"start": "01:30", "end": "01:30"},
+			expectedErr: "start and end are the same",
+		},
+		{
+			name:        "invalid time zone",
+			data:        map[string]string{"start": "01:30", "end": "05:00", "timeZone": "Mars/Olympus"},
+			expectedErr: "invalid timeZone: unknown time zone Mars/Olympus",
+		},
+		{
+			name:        "invalid suspendDataPaths",
+			data:        map[string]string{"start": "01:30", "end": "05:00", "suspendDataPaths": "yes"},
+			expectedErr: `invalid suspendDataPaths: strconv.ParseBool: parsing "yes": invalid syntax`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			window, err := parse(test.data)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, window.String())
+		})
+	}
+}
+
+func TestIsOpen(t *testing.T) {
+	daytime, err := parse(map[string]string{"start": "09:00", "end": "17:00"})
+	require.NoError(t, err)
+	overnight, err := parse(map[string]string{"start": "22:00", "end": "06:00", "timeZone": "Asia/Tokyo"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name              string
+		window            *Window
+		now               time.Time
+		expectedOpen      bool
+		expectedUntilOpen time.Duration
+		expectedInterval  time.Duration
+	}{
+		{
+			name:         "nil window is always open",
+			now:          time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC),
+			expectedOpen: true,
+		},
+		{
+			name:         "in the window",
+			window:       daytime,
+			now:          time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+			expectedOpen: true,
+		},
+		{
+			name:              "the end isn't in the window",
+			window:            daytime,
+			now:               time.Date(2024, 1, 1, 17, 0, 0, 0, time.UTC),
+			expectedUntilOpen: 16 * time.Hour,
+			expectedInterval:  maxQueueInterval,
+		},
+		{
+			name:              "shortly before the window",
+			window:            daytime,
+			now:               time.Date(2024, 1, 1, 8, 58, 0, 0, time.UTC),
+			expectedUntilOpen: 2 * time.Minute,
+			expectedInterval:  2 * time.Minute,
+		},
+		{
+			name:         "overnight window after midnight in its time zone",
+			window:       overnight,
+			now:          time.Date(2024, 1, 1, 18, 0, 0, 0, time.UTC), // 03:00 in Tokyo
+			expectedOpen: true,
+		},
+		{
+			name:              "overnight window in the daytime of its time zone",
+			window:            overnight,
+			now:               time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC), // 12:00 in Tokyo
+			expectedUntilOpen: 10 * time.Hour,
+			expectedInterval:  maxQueueInterval,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedOpen, test.window.IsOpen(test.now))
+			assert.Equal(t, test.expectedUntilOpen, test.window.UntilOpen(test.now))
+			assert.Equal(t, test.expectedInterval, test.window.QueueInterval(test.now))
+		})
+	}
+}
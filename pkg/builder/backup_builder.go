@@ -24,6 +24,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
+	"github.com/vmware-tanzu/velero/internal/validationpolicies"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 
 	"github.com/sirupsen/logrus"
@@ -198,6 +199,18 @@ func (b *BackupBuilder) OrLabelSelector(orSelectors []*metav1.LabelSelector) *Ba
 	return b
 }
 
+// ClusterScopedLabelSelector sets the Backup's cluster-scoped label selector.
+func (b *BackupBuilder) ClusterScopedLabelSelector(selector *metav1.LabelSelector) *BackupBuilder {
+	b.object.Spec.ClusterScopedLabelSelector = selector
+	return b
+}
+
+// ClusterScopedOrLabelSelector sets the Backup's cluster-scoped orLabelSelector set.
+func (b *BackupBuilder) ClusterScopedOrLabelSelector(orSelectors []*metav1.LabelSelector) *BackupBuilder {
+	b.object.Spec.ClusterScopedOrLabelSelectors = orSelectors
+	return b
+}
+
 // SnapshotVolumes sets the Backup's "snapshot volumes" flag.
 func (b *BackupBuilder) SnapshotVolumes(val bool) *BackupBuilder {
 	b.object.Spec.SnapshotVolumes = &val
@@ -222,6 +235,12 @@ func (b *BackupBuilder) Phase(phase velerov1api.BackupPhase) *BackupBuilder {
 	return b
 }
 
+// ItemOperationsAttempted sets the Backup's number of attempted backup item operations.
+func (b *BackupBuilder) ItemOperationsAttempted(attempted int) *BackupBuilder {
+	b.object.Status.BackupItemOperationsAttempted = attempted
+	return b
+}
+
 // StorageLocation sets the Backup's storage location.
 func (b *BackupBuilder) StorageLocation(location string) *BackupBuilder {
 	b.object.Spec.StorageLocation = location
@@ -240,6 +259,12 @@ func (b *BackupBuilder) TTL(ttl time.Duration) *BackupBuilder {
 	return b
 }
 
+// NameTemplate sets the template of the names of the backups created by a schedule from the Backup's spec.
+func (b *BackupBuilder) NameTemplate(template string) *BackupBuilder {
+	b.object.Spec.NameTemplate = template
+	return b
+}
+
 // Expiration sets the Backup's expiration.
 func (b *BackupBuilder) Expiration(val time.Time) *BackupBuilder {
 	b.object.Status.Expiration = &metav1.Time{Time: val}
@@ -276,18 +301,36 @@ func (b *BackupBuilder) CSISnapshotTimeout(timeout time.Duration) *BackupBuilder
 	return b
 }
 
+// VolumeGroupSnapshotLabelKey sets the Backup's VolumeGroupSnapshotLabelKey
+func (b *BackupBuilder) VolumeGroupSnapshotLabelKey(key string) *BackupBuilder {
+	b.object.Spec.VolumeGroupSnapshotLabelKey = key
+	return b
+}
+
 // ItemOperationTimeout sets the Backup's ItemOperationTimeout
 func (b *BackupBuilder) ItemOperationTimeout(timeout time.Duration) *BackupBuilder {
 	b.object.Spec.ItemOperationTimeout.Duration = timeout
 	return b
 }
 
+// ResourceFilterPolicy sets the Backup's resource filter policy.
+func (b *BackupBuilder) ResourceFilterPolicy(name string) *BackupBuilder {
+	b.object.Spec.ResourceFilterPolicy = name
+	return b
+}
+
 // ResourcePolicies sets the Backup's resource polices.
 func (b *BackupBuilder) ResourcePolicies(name string) *BackupBuilder {
 	b.object.Spec.ResourcePolicy = &v1.TypedLocalObjectReference{Kind: resourcepolicies.ConfigmapRefType, Name: name}
 	return b
 }
 
+// ValidationPolicies sets the Backup's validation policies.
+func (b *BackupBuilder) ValidationPolicies(name string) *BackupBuilder {
+	b.object.Spec.ValidationPolicy = &v1.TypedLocalObjectReference{Kind: validationpolicies.ConfigmapRefType, Name: name}
+	return b
+}
+
 // SnapshotMoveData sets the Backup's "snapshot move data" flag.
 func (b *BackupBuilder) SnapshotMoveData(val bool) *BackupBuilder {
 	b.object.Spec.SnapshotMoveData = &val
@@ -300,6 +343,48 @@ func (b *BackupBuilder) DataMover(name string) *BackupBuilder {
 	return b
 }
 
+// CompressionAlgorithm sets the Backup's compression algorithm.
+func (b *BackupBuilder) CompressionAlgorithm(algorithm string) *BackupBuilder {
+	b.object.Spec.CompressionAlgorithm = algorithm
+	return b
+}
+
+// UploaderType sets the type of uploader to use for the Backup's pod volume backups.
+func (b *BackupBuilder) UploaderType(uploaderType string) *BackupBuilder {
+	b.object.Spec.UploaderType = uploaderType
+	return b
+}
+
+// DryRun sets the Backup's dry-run flag.
+func (b *BackupBuilder) DryRun(val bool) *BackupBuilder {
+	b.object.Spec.DryRun = &val
+	return b
+}
+
+// Tiering sets the storage class transitions of the Backup's contents.
+func (b *BackupBuilder) Tiering(transitions ...velerov1api.BackupTierTransition) *BackupBuilder {
+	b.object.Spec.Tiering = &velerov1api.BackupTiering{Transitions: transitions}
+	return b
+}
+
+// SnapshotMoveReplicaLocation sets the backup storage location the Backup's data mover snapshots are replicated to.
+func (b *BackupBuilder) SnapshotMoveReplicaLocation(location string) *BackupBuilder {
+	b.object.Spec.SnapshotMoveReplicaLocation = location
+	return b
+}
+
+// DeduplicateClusterResources sets the Backup's "deduplicate cluster resources" flag.
+func (b *BackupBuilder) DeduplicateClusterResources(val bool) *BackupBuilder {
+	b.object.Spec.DeduplicateClusterResources = &val
+	return b
+}
+
+// ClusterResourcesDeduplicated sets the Backup's number of deduplicated cluster-scoped items.
+func (b *BackupBuilder) ClusterResourcesDeduplicated(count int) *BackupBuilder {
+	b.object.Status.ClusterResourcesDeduplicated = count
+	return b
+}
+
 // WithStatus sets the Backup's status.
 func (b *BackupBuilder) WithStatus(status velerov1api.BackupStatus) *BackupBuilder {
 	b.object.Status = status
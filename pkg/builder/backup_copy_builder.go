@@ -0,0 +1,76 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+)
+
+// BackupCopyBuilder builds BackupCopy objects.
+type BackupCopyBuilder struct {
+	object *velerov2alpha1api.BackupCopy
+}
+
+// ForBackupCopy is the constructor for a BackupCopyBuilder.
+func ForBackupCopy(ns, name string) *BackupCopyBuilder {
+	return &BackupCopyBuilder{
+		object: &velerov2alpha1api.BackupCopy{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov2alpha1api.SchemeGroupVersion.String(),
+				Kind:       "BackupCopy",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built BackupCopy.
+func (b *BackupCopyBuilder) Result() *velerov2alpha1api.BackupCopy {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the BackupCopy's ObjectMeta.
+func (b *BackupCopyBuilder) ObjectMeta(opts ...ObjectMetaOpt) *BackupCopyBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// BackupName sets the name of the backup to copy.
+func (b *BackupCopyBuilder) BackupName(name string) *BackupCopyBuilder {
+	b.object.Spec.BackupName = name
+	return b
+}
+
+// StorageLocation sets the backup storage location the backup is copied to.
+func (b *BackupCopyBuilder) StorageLocation(location string) *BackupCopyBuilder {
+	b.object.Spec.StorageLocation = location
+	return b
+}
+
+// Phase sets the BackupCopy's phase.
+func (b *BackupCopyBuilder) Phase(phase velerov2alpha1api.BackupCopyPhase) *BackupCopyBuilder {
+	b.object.Status.Phase = phase
+	return b
+}
@@ -0,0 +1,76 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// BackupHookPolicyBuilder builds BackupHookPolicy objects.
+type BackupHookPolicyBuilder struct {
+	object *velerov1api.BackupHookPolicy
+}
+
+// ForBackupHookPolicy is the constructor for a BackupHookPolicyBuilder.
+func ForBackupHookPolicy(ns, name string) *BackupHookPolicyBuilder {
+	return &BackupHookPolicyBuilder{
+		object: &velerov1api.BackupHookPolicy{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "BackupHookPolicy",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built BackupHookPolicy.
+func (b *BackupHookPolicyBuilder) Result() *velerov1api.BackupHookPolicy {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the BackupHookPolicy's ObjectMeta.
+func (b *BackupHookPolicyBuilder) ObjectMeta(opts ...ObjectMetaOpt) *BackupHookPolicyBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// BackupSelector sets the BackupHookPolicy's backup selector.
+func (b *BackupHookPolicyBuilder) BackupSelector(selector *metav1.LabelSelector) *BackupHookPolicyBuilder {
+	b.object.Spec.BackupSelector = selector
+	return b
+}
+
+// Enforced sets the BackupHookPolicy's enforced flag.
+func (b *BackupHookPolicyBuilder) Enforced(enforced bool) *BackupHookPolicyBuilder {
+	b.object.Spec.Enforced = enforced
+	return b
+}
+
+// Hooks appends to the BackupHookPolicy's resource hooks.
+func (b *BackupHookPolicyBuilder) Hooks(hooks ...velerov1api.BackupResourceHookSpec) *BackupHookPolicyBuilder {
+	b.object.Spec.Hooks.Resources = append(b.object.Spec.Hooks.Resources, hooks...)
+	return b
+}
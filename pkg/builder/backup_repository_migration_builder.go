@@ -0,0 +1,76 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+)
+
+// BackupRepositoryMigrationBuilder builds BackupRepositoryMigration objects.
+type BackupRepositoryMigrationBuilder struct {
+	object *velerov2alpha1api.BackupRepositoryMigration
+}
+
+// ForBackupRepositoryMigration is the constructor for a BackupRepositoryMigrationBuilder.
+func ForBackupRepositoryMigration(ns, name string) *BackupRepositoryMigrationBuilder {
+	return &BackupRepositoryMigrationBuilder{
+		object: &velerov2alpha1api.BackupRepositoryMigration{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov2alpha1api.SchemeGroupVersion.String(),
+				Kind:       "BackupRepositoryMigration",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built BackupRepositoryMigration.
+func (b *BackupRepositoryMigrationBuilder) Result() *velerov2alpha1api.BackupRepositoryMigration {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the BackupRepositoryMigration's ObjectMeta.
+func (b *BackupRepositoryMigrationBuilder) ObjectMeta(opts ...ObjectMetaOpt) *BackupRepositoryMigrationBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// VolumeNamespace sets the BackupRepositoryMigration's volume namespace.
+func (b *BackupRepositoryMigrationBuilder) VolumeNamespace(ns string) *BackupRepositoryMigrationBuilder {
+	b.object.Spec.VolumeNamespace = ns
+	return b
+}
+
+// BackupStorageLocation sets the BackupRepositoryMigration's backup storage location.
+func (b *BackupRepositoryMigrationBuilder) BackupStorageLocation(location string) *BackupRepositoryMigrationBuilder {
+	b.object.Spec.BackupStorageLocation = location
+	return b
+}
+
+// Phase sets the BackupRepositoryMigration's phase.
+func (b *BackupRepositoryMigrationBuilder) Phase(phase velerov2alpha1api.BackupRepositoryMigrationPhase) *BackupRepositoryMigrationBuilder {
+	b.object.Status.Phase = phase
+	return b
+}
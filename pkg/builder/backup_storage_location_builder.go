@@ -66,6 +66,12 @@ func (b *BackupStorageLocationBuilder) Provider(name string) *BackupStorageLocat
 	return b
 }
 
+// Config sets the BackupStorageLocation's config.
+func (b *BackupStorageLocationBuilder) Config(config map[string]string) *BackupStorageLocationBuilder {
+	b.object.Spec.Config = config
+	return b
+}
+
 // Bucket sets the BackupStorageLocation's object storage bucket.
 func (b *BackupStorageLocationBuilder) Bucket(val string) *BackupStorageLocationBuilder {
 	if b.object.Spec.StorageType.ObjectStorage == nil {
@@ -93,6 +99,39 @@ func (b *BackupStorageLocationBuilder) CACert(val []byte) *BackupStorageLocation
 	return b
 }
 
+// ObjectLock sets the BackupStorageLocation's object storage object lock.
+func (b *BackupStorageLocationBuilder) ObjectLock(mode velerov1api.ObjectLockMode, retentionPeriod time.Duration) *BackupStorageLocationBuilder {
+	if b.object.Spec.StorageType.ObjectStorage == nil {
+		b.object.Spec.StorageType.ObjectStorage = new(velerov1api.ObjectStorageLocation)
+	}
+	b.object.Spec.ObjectStorage.ObjectLock = &velerov1api.ObjectLock{
+		Mode:            mode,
+		RetentionPeriod: metav1.Duration{Duration: retentionPeriod},
+	}
+	return b
+}
+
+// Encryption sets the BackupStorageLocation's object storage encryption.
+func (b *BackupStorageLocationBuilder) Encryption(keySecret, keyID string) *BackupStorageLocationBuilder {
+	if b.object.Spec.StorageType.ObjectStorage == nil {
+		b.object.Spec.StorageType.ObjectStorage = new(velerov1api.ObjectStorageLocation)
+	}
+	b.object.Spec.ObjectStorage.Encryption = &velerov1api.ObjectStorageEncryption{
+		KeySecret: keySecret,
+		KeyID:     keyID,
+	}
+	return b
+}
+
+// ExpirationMode sets the BackupStorageLocation's object storage expiration mode.
+func (b *BackupStorageLocationBuilder) ExpirationMode(mode velerov1api.ObjectStorageExpirationMode) *BackupStorageLocationBuilder {
+	if b.object.Spec.StorageType.ObjectStorage == nil {
+		b.object.Spec.StorageType.ObjectStorage = new(velerov1api.ObjectStorageLocation)
+	}
+	b.object.Spec.ObjectStorage.ExpirationMode = mode
+	return b
+}
+
 // Default sets the BackupStorageLocation's is default or not
 func (b *BackupStorageLocationBuilder) Default(isDefault bool) *BackupStorageLocationBuilder {
 	b.object.Spec.Default = isDefault
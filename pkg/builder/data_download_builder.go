@@ -60,6 +60,12 @@ func (d *DataDownloadBuilder) Phase(phase velerov2alpha1api.DataDownloadPhase) *
 	return d
 }
 
+// Node sets the DataDownload's Node.
+func (d *DataDownloadBuilder) Node(node string) *DataDownloadBuilder {
+	d.object.Status.Node = node
+	return d
+}
+
 // SnapshotID sets the DataDownload's SnapshotID.
 func (d *DataDownloadBuilder) SnapshotID(id string) *DataDownloadBuilder {
 	d.object.Spec.SnapshotID = id
@@ -90,6 +96,12 @@ func (d *DataDownloadBuilder) Cancel(cancel bool) *DataDownloadBuilder {
 	return d
 }
 
+// Paused sets the DataDownload's Paused.
+func (d *DataDownloadBuilder) Paused(paused bool) *DataDownloadBuilder {
+	d.object.Spec.Paused = paused
+	return d
+}
+
 // OperationTimeout sets the DataDownload's OperationTimeout.
 func (d *DataDownloadBuilder) OperationTimeout(timeout metav1.Duration) *DataDownloadBuilder {
 	d.object.Spec.OperationTimeout = timeout
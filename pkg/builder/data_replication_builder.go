@@ -0,0 +1,130 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+)
+
+// DataReplicationBuilder builds DataReplication objects.
+type DataReplicationBuilder struct {
+	object *velerov2alpha1api.DataReplication
+}
+
+// ForDataReplication is the constructor for a DataReplicationBuilder.
+func ForDataReplication(ns, name string) *DataReplicationBuilder {
+	return &DataReplicationBuilder{
+		object: &velerov2alpha1api.DataReplication{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov2alpha1api.SchemeGroupVersion.String(),
+				Kind:       "DataReplication",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built DataReplication.
+func (d *DataReplicationBuilder) Result() *velerov2alpha1api.DataReplication {
+	return d.object
+}
+
+// ObjectMeta applies functional options to the DataReplication's ObjectMeta.
+func (d *DataReplicationBuilder) ObjectMeta(opts ...ObjectMetaOpt) *DataReplicationBuilder {
+	for _, opt := range opts {
+		opt(d.object)
+	}
+
+	return d
+}
+
+// BackupName sets the DataReplication's backup name.
+func (d *DataReplicationBuilder) BackupName(name string) *DataReplicationBuilder {
+	d.object.Spec.BackupName = name
+	return d
+}
+
+// DataUpload sets the DataReplication's DataUpload.
+func (d *DataReplicationBuilder) DataUpload(name string) *DataReplicationBuilder {
+	d.object.Spec.DataUpload = name
+	return d
+}
+
+// SourcePVC sets the DataReplication's source PVC.
+func (d *DataReplicationBuilder) SourcePVC(name string) *DataReplicationBuilder {
+	d.object.Spec.SourcePVC = name
+	return d
+}
+
+// SourceNamespace sets the DataReplication's source namespace.
+func (d *DataReplicationBuilder) SourceNamespace(ns string) *DataReplicationBuilder {
+	d.object.Spec.SourceNamespace = ns
+	return d
+}
+
+// BackupStorageLocation sets the DataReplication's backup storage location.
+func (d *DataReplicationBuilder) BackupStorageLocation(location string) *DataReplicationBuilder {
+	d.object.Spec.BackupStorageLocation = location
+	return d
+}
+
+// DataMover sets the DataReplication's data mover.
+func (d *DataReplicationBuilder) DataMover(dataMover string) *DataReplicationBuilder {
+	d.object.Spec.DataMover = dataMover
+	return d
+}
+
+// SnapshotID sets the DataReplication's snapshot ID.
+func (d *DataReplicationBuilder) SnapshotID(id string) *DataReplicationBuilder {
+	d.object.Spec.SnapshotID = id
+	return d
+}
+
+// TargetBackupStorageLocation sets the DataReplication's target backup storage location.
+func (d *DataReplicationBuilder) TargetBackupStorageLocation(location string) *DataReplicationBuilder {
+	d.object.Spec.TargetBackupStorageLocation = location
+	return d
+}
+
+// Phase sets the DataReplication's phase.
+func (d *DataReplicationBuilder) Phase(phase velerov2alpha1api.DataReplicationPhase) *DataReplicationBuilder {
+	d.object.Status.Phase = phase
+	return d
+}
+
+// Node sets the node where the DataReplication is processed.
+func (d *DataReplicationBuilder) Node(node string) *DataReplicationBuilder {
+	d.object.Status.Node = node
+	return d
+}
+
+// Message sets the DataReplication's message.
+func (d *DataReplicationBuilder) Message(msg string) *DataReplicationBuilder {
+	d.object.Status.Message = msg
+	return d
+}
+
+// ReplicaSnapshotID sets the ID of the replica of the snapshot.
+func (d *DataReplicationBuilder) ReplicaSnapshotID(id string) *DataReplicationBuilder {
+	d.object.Status.SnapshotID = id
+	return d
+}
@@ -60,6 +60,12 @@ func (d *DataUploadBuilder) Phase(phase velerov2alpha1api.DataUploadPhase) *Data
 	return d
 }
 
+// Node sets the DataUpload's Node.
+func (d *DataUploadBuilder) Node(node string) *DataUploadBuilder {
+	d.object.Status.Node = node
+	return d
+}
+
 // SnapshotID sets the DataUpload's SnapshotID.
 func (d *DataUploadBuilder) SnapshotID(id string) *DataUploadBuilder {
 	d.object.Status.SnapshotID = id
@@ -96,6 +102,12 @@ func (d *DataUploadBuilder) Cancel(cancel bool) *DataUploadBuilder {
 	return d
 }
 
+// Paused sets the DataUpload's Paused.
+func (d *DataUploadBuilder) Paused(paused bool) *DataUploadBuilder {
+	d.object.Spec.Paused = paused
+	return d
+}
+
 // OperationTimeout sets the DataUpload's OperationTimeout.
 func (d *DataUploadBuilder) OperationTimeout(timeout metav1.Duration) *DataUploadBuilder {
 	d.object.Spec.OperationTimeout = timeout
@@ -0,0 +1,130 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+)
+
+// DataVerifyBuilder builds DataVerify objects.
+type DataVerifyBuilder struct {
+	object *velerov2alpha1api.DataVerify
+}
+
+// ForDataVerify is the constructor for a DataVerifyBuilder.
+func ForDataVerify(ns, name string) *DataVerifyBuilder {
+	return &DataVerifyBuilder{
+		object: &velerov2alpha1api.DataVerify{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov2alpha1api.SchemeGroupVersion.String(),
+				Kind:       "DataVerify",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built DataVerify.
+func (d *DataVerifyBuilder) Result() *velerov2alpha1api.DataVerify {
+	return d.object
+}
+
+// ObjectMeta applies functional options to the DataVerify's ObjectMeta.
+func (d *DataVerifyBuilder) ObjectMeta(opts ...ObjectMetaOpt) *DataVerifyBuilder {
+	for _, opt := range opts {
+		opt(d.object)
+	}
+
+	return d
+}
+
+// BackupName sets the DataVerify's backup name.
+func (d *DataVerifyBuilder) BackupName(name string) *DataVerifyBuilder {
+	d.object.Spec.BackupName = name
+	return d
+}
+
+// DataUpload sets the DataVerify's DataUpload.
+func (d *DataVerifyBuilder) DataUpload(name string) *DataVerifyBuilder {
+	d.object.Spec.DataUpload = name
+	return d
+}
+
+// SourcePVC sets the DataVerify's source PVC.
+func (d *DataVerifyBuilder) SourcePVC(name string) *DataVerifyBuilder {
+	d.object.Spec.SourcePVC = name
+	return d
+}
+
+// SourceNamespace sets the DataVerify's source namespace.
+func (d *DataVerifyBuilder) SourceNamespace(ns string) *DataVerifyBuilder {
+	d.object.Spec.SourceNamespace = ns
+	return d
+}
+
+// BackupStorageLocation sets the DataVerify's backup storage location.
+func (d *DataVerifyBuilder) BackupStorageLocation(location string) *DataVerifyBuilder {
+	d.object.Spec.BackupStorageLocation = location
+	return d
+}
+
+// DataMover sets the DataVerify's data mover.
+func (d *DataVerifyBuilder) DataMover(dataMover string) *DataVerifyBuilder {
+	d.object.Spec.DataMover = dataMover
+	return d
+}
+
+// SnapshotID sets the DataVerify's snapshot ID.
+func (d *DataVerifyBuilder) SnapshotID(id string) *DataVerifyBuilder {
+	d.object.Spec.SnapshotID = id
+	return d
+}
+
+// VerifyFilesPercent sets the percentage of the files whose contents are verified.
+func (d *DataVerifyBuilder) VerifyFilesPercent(percent int) *DataVerifyBuilder {
+	d.object.Spec.VerifyFilesPercent = percent
+	return d
+}
+
+// Phase sets the DataVerify's phase.
+func (d *DataVerifyBuilder) Phase(phase velerov2alpha1api.DataVerifyPhase) *DataVerifyBuilder {
+	d.object.Status.Phase = phase
+	return d
+}
+
+// Node sets the node where the DataVerify is processed.
+func (d *DataVerifyBuilder) Node(node string) *DataVerifyBuilder {
+	d.object.Status.Node = node
+	return d
+}
+
+// Message sets the DataVerify's message.
+func (d *DataVerifyBuilder) Message(msg string) *DataVerifyBuilder {
+	d.object.Status.Message = msg
+	return d
+}
+
+// Errors sets the DataVerify's errors.
+func (d *DataVerifyBuilder) Errors(errors ...string) *DataVerifyBuilder {
+	d.object.Status.Errors = errors
+	return d
+}
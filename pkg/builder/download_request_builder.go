@@ -60,3 +60,16 @@ func (b *DownloadRequestBuilder) Target(targetKind velerov1api.DownloadTargetKin
 	b.object.Spec.Target.Name = targetName
 	return b
 }
+
+// Page sets the page of the DownloadRequest's target.
+func (b *DownloadRequestBuilder) Page(page int) *DownloadRequestBuilder {
+	b.object.Spec.Target.Page = page
+	return b
+}
+
+// ContentsFilter sets the resources and namespaces to extract from the DownloadRequest's backup contents target.
+func (b *DownloadRequestBuilder) ContentsFilter(resources, namespaces []string) *DownloadRequestBuilder {
+	b.object.Spec.Target.IncludedResources = resources
+	b.object.Spec.Target.IncludedNamespaces = namespaces
+	return b
+}
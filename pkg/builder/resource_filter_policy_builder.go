@@ -0,0 +1,94 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ResourceFilterPolicyBuilder builds ResourceFilterPolicy objects.
+type ResourceFilterPolicyBuilder struct {
+	object *velerov1api.ResourceFilterPolicy
+}
+
+// ForResourceFilterPolicy is the constructor for a ResourceFilterPolicyBuilder.
+func ForResourceFilterPolicy(ns, name string) *ResourceFilterPolicyBuilder {
+	return &ResourceFilterPolicyBuilder{
+		object: &velerov1api.ResourceFilterPolicy{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "ResourceFilterPolicy",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built ResourceFilterPolicy.
+func (b *ResourceFilterPolicyBuilder) Result() *velerov1api.ResourceFilterPolicy {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the ResourceFilterPolicy's ObjectMeta.
+func (b *ResourceFilterPolicyBuilder) ObjectMeta(opts ...ObjectMetaOpt) *ResourceFilterPolicyBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// IncludedNamespaces appends to the ResourceFilterPolicy's included namespaces.
+func (b *ResourceFilterPolicyBuilder) IncludedNamespaces(namespaces ...string) *ResourceFilterPolicyBuilder {
+	b.object.Spec.IncludedNamespaces = append(b.object.Spec.IncludedNamespaces, namespaces...)
+	return b
+}
+
+// ExcludedNamespaces appends to the ResourceFilterPolicy's excluded namespaces.
+func (b *ResourceFilterPolicyBuilder) ExcludedNamespaces(namespaces ...string) *ResourceFilterPolicyBuilder {
+	b.object.Spec.ExcludedNamespaces = append(b.object.Spec.ExcludedNamespaces, namespaces...)
+	return b
+}
+
+// IncludedResources appends to the ResourceFilterPolicy's included resources.
+func (b *ResourceFilterPolicyBuilder) IncludedResources(resources ...string) *ResourceFilterPolicyBuilder {
+	b.object.Spec.IncludedResources = append(b.object.Spec.IncludedResources, resources...)
+	return b
+}
+
+// ExcludedResources appends to the ResourceFilterPolicy's excluded resources.
+func (b *ResourceFilterPolicyBuilder) ExcludedResources(resources ...string) *ResourceFilterPolicyBuilder {
+	b.object.Spec.ExcludedResources = append(b.object.Spec.ExcludedResources, resources...)
+	return b
+}
+
+// IncludeClusterResources sets the ResourceFilterPolicy's "include cluster resources" flag.
+func (b *ResourceFilterPolicyBuilder) IncludeClusterResources(val bool) *ResourceFilterPolicyBuilder {
+	b.object.Spec.IncludeClusterResources = &val
+	return b
+}
+
+// LabelSelector sets the ResourceFilterPolicy's label selector.
+func (b *ResourceFilterPolicyBuilder) LabelSelector(selector *metav1.LabelSelector) *ResourceFilterPolicyBuilder {
+	b.object.Spec.LabelSelector = selector
+	return b
+}
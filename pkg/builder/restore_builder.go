@@ -19,6 +19,7 @@ package builder
 import (
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -71,6 +72,21 @@ func (b *RestoreBuilder) Schedule(name string) *RestoreBuilder {
 	return b
 }
 
+// FromRestoreSchedule sets the Restore's spec to the template of the restore schedule and
+// labels the Restore with the name of the restore schedule.
+func (b *RestoreBuilder) FromRestoreSchedule(schedule *velerov1api.RestoreSchedule) *RestoreBuilder {
+	labels := make(map[string]string)
+	for k, v := range schedule.Labels {
+		labels[k] = v
+	}
+	labels[velerov1api.RestoreScheduleNameLabel] = schedule.Name
+
+	b.object.Spec = *schedule.Spec.Template.DeepCopy()
+	b.ObjectMeta(WithLabelsMap(labels))
+
+	return b
+}
+
 // IncludedNamespaces appends to the Restore's included namespaces.
 func (b *RestoreBuilder) IncludedNamespaces(namespaces ...string) *RestoreBuilder {
 	b.object.Spec.IncludedNamespaces = append(b.object.Spec.IncludedNamespaces, namespaces...)
@@ -101,6 +117,12 @@ func (b *RestoreBuilder) ExistingResourcePolicy(policy string) *RestoreBuilder {
 	return b
 }
 
+// ExistingResourcePolicies sets the Restore's resource policies per resource.
+func (b *RestoreBuilder) ExistingResourcePolicies(policies map[string]velerov1api.PolicyType) *RestoreBuilder {
+	b.object.Spec.ExistingResourcePolicies = policies
+	return b
+}
+
 // IncludeClusterResources sets the Restore's "include cluster resources" flag.
 func (b *RestoreBuilder) IncludeClusterResources(val bool) *RestoreBuilder {
 	b.object.Spec.IncludeClusterResources = &val
@@ -136,6 +158,97 @@ func (b *RestoreBuilder) NamespaceMappings(mapping ...string) *RestoreBuilder {
 	return b
 }
 
+// StorageClassMappings sets the Restore's storage class mappings.
+func (b *RestoreBuilder) StorageClassMappings(mapping ...string) *RestoreBuilder {
+	b.object.Spec.StorageClassMappings = addMappings(b.object.Spec.StorageClassMappings, mapping...)
+	return b
+}
+
+// ZoneMappings sets the Restore's zone mappings.
+func (b *RestoreBuilder) ZoneMappings(mapping ...string) *RestoreBuilder {
+	b.object.Spec.ZoneMappings = addMappings(b.object.Spec.ZoneMappings, mapping...)
+	return b
+}
+
+func addMappings(m map[string]string, mapping ...string) map[string]string {
+	if m == nil {
+		m = make(map[string]string)
+	}
+
+	if len(mapping)%2 != 0 {
+		panic("mapping must contain an even number of values")
+	}
+
+	for i := 0; i < len(mapping); i += 2 {
+		m[mapping[i]] = mapping[i+1]
+	}
+
+	return m
+}
+
+// Scaling sets the Restore's scaling of the restored workloads.
+func (b *RestoreBuilder) Scaling(replicas int32, resources ...string) *RestoreBuilder {
+	b.object.Spec.Scaling = &velerov1api.RestoreScaling{
+		Replicas:          replicas,
+		IncludedResources: resources,
+	}
+	return b
+}
+
+// ReadinessGates sets the Restore's readiness gates of the restored items.
+func (b *RestoreBuilder) ReadinessGates(timeout time.Duration, resources ...string) *RestoreBuilder {
+	b.object.Spec.ReadinessGates = &velerov1api.RestoreReadinessGates{
+		IncludedResources: resources,
+		Timeout:           metav1.Duration{Duration: timeout},
+	}
+	return b
+}
+
+// StrictQuota sets the Restore's strict quota flag.
+func (b *RestoreBuilder) StrictQuota(val bool) *RestoreBuilder {
+	b.object.Spec.StrictQuota = &val
+	return b
+}
+
+// PVCSizeOverrides sets the Restore's PVC size overrides, the sizes are pairs of the PVC,
+// as "<namespace>/<name>", and its requested storage.
+func (b *RestoreBuilder) PVCSizeOverrides(growthPercentage int32, sizes ...string) *RestoreBuilder {
+	overrides := &velerov1api.RestorePVCSizeOverrides{GrowthPercentage: growthPercentage}
+	for pvc, size := range addMappings(nil, sizes...) {
+		if overrides.Sizes == nil {
+			overrides.Sizes = make(map[string]resource.Quantity)
+		}
+		overrides.Sizes[pvc] = resource.MustParse(size)
+	}
+	b.object.Spec.PVCSizeOverrides = overrides
+	return b
+}
+
+// ItemRetryPolicy sets the Restore's retry policy of the restored items.
+func (b *RestoreBuilder) ItemRetryPolicy(maxRetries int32, initialBackoff, maxBackoff time.Duration) *RestoreBuilder {
+	b.object.Spec.ItemRetryPolicy = &velerov1api.RestoreItemRetryPolicy{
+		MaxRetries:     maxRetries,
+		InitialBackoff: metav1.Duration{Duration: initialBackoff},
+		MaxBackoff:     metav1.Duration{Duration: maxBackoff},
+	}
+	return b
+}
+
+// SecretEncryption sets the Restore's verification of the secret encryption of the target cluster.
+func (b *RestoreBuilder) SecretEncryption(allowUnencryptedTarget bool, sensitiveTypes ...string) *RestoreBuilder {
+	b.object.Spec.SecretEncryption = &velerov1api.RestoreSecretEncryption{
+		SensitiveTypes:         sensitiveTypes,
+		AllowUnencryptedTarget: allowUnencryptedTarget,
+	}
+	return b
+}
+
+// DryRun sets the Restore's dry-run flag.
+func (b *RestoreBuilder) DryRun(val bool) *RestoreBuilder {
+	b.object.Spec.DryRun = &val
+	return b
+}
+
 // Phase sets the Restore's phase.
 func (b *RestoreBuilder) Phase(phase velerov1api.RestorePhase) *RestoreBuilder {
 	b.object.Status.Phase = phase
@@ -0,0 +1,96 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// RestoreScheduleBuilder builds RestoreSchedule objects.
+type RestoreScheduleBuilder struct {
+	object *velerov1api.RestoreSchedule
+}
+
+// ForRestoreSchedule is the constructor for a RestoreScheduleBuilder.
+func ForRestoreSchedule(ns, name string) *RestoreScheduleBuilder {
+	return &RestoreScheduleBuilder{
+		object: &velerov1api.RestoreSchedule{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "RestoreSchedule",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built RestoreSchedule.
+func (b *RestoreScheduleBuilder) Result() *velerov1api.RestoreSchedule {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the RestoreSchedule's ObjectMeta.
+func (b *RestoreScheduleBuilder) ObjectMeta(opts ...ObjectMetaOpt) *RestoreScheduleBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// Phase sets the RestoreSchedule's phase.
+func (b *RestoreScheduleBuilder) Phase(phase velerov1api.SchedulePhase) *RestoreScheduleBuilder {
+	b.object.Status.Phase = phase
+	return b
+}
+
+// CronSchedule sets the RestoreSchedule's cron schedule.
+func (b *RestoreScheduleBuilder) CronSchedule(expression string) *RestoreScheduleBuilder {
+	b.object.Spec.Schedule = expression
+	return b
+}
+
+// LastRestoreTime sets the RestoreSchedule's last restore time.
+func (b *RestoreScheduleBuilder) LastRestoreTime(val time.Time) *RestoreScheduleBuilder {
+	b.object.Status.LastRestore = &metav1.Time{Time: val}
+	return b
+}
+
+// Template sets the RestoreSchedule's template.
+func (b *RestoreScheduleBuilder) Template(spec velerov1api.RestoreSpec) *RestoreScheduleBuilder {
+	b.object.Spec.Template = spec
+	return b
+}
+
+// Paused sets the RestoreSchedule's paused flag.
+func (b *RestoreScheduleBuilder) Paused(val bool) *RestoreScheduleBuilder {
+	b.object.Spec.Paused = val
+	return b
+}
+
+// RestoresToKeep sets the RestoreSchedule's number of restores to keep.
+func (b *RestoreScheduleBuilder) RestoresToKeep(val int32) *RestoreScheduleBuilder {
+	b.object.Spec.RestoresToKeep = &val
+	return b
+}
@@ -89,3 +89,21 @@ func (b *ScheduleBuilder) Template(spec velerov1api.BackupSpec) *ScheduleBuilder
 	b.object.Spec.Template = spec
 	return b
 }
+
+// Jitter sets the Schedule's jitter.
+func (b *ScheduleBuilder) Jitter(jitter time.Duration) *ScheduleBuilder {
+	b.object.Spec.Jitter = &metav1.Duration{Duration: jitter}
+	return b
+}
+
+// StartingDeadline sets the Schedule's starting deadline.
+func (b *ScheduleBuilder) StartingDeadline(deadline time.Duration) *ScheduleBuilder {
+	b.object.Spec.StartingDeadline = &metav1.Duration{Duration: deadline}
+	return b
+}
+
+// ConcurrencyPolicy sets the Schedule's concurrency policy.
+func (b *ScheduleBuilder) ConcurrencyPolicy(policy velerov1api.ConcurrencyPolicy) *ScheduleBuilder {
+	b.object.Spec.ConcurrencyPolicy = policy
+	return b
+}
@@ -61,3 +61,9 @@ func (b *SecretBuilder) Data(data map[string][]byte) *SecretBuilder {
 	b.object.Data = data
 	return b
 }
+
+// Type sets the Secret type.
+func (b *SecretBuilder) Type(secretType corev1api.SecretType) *SecretBuilder {
+	b.object.Type = secretType
+	return b
+}
@@ -31,8 +31,20 @@ const (
 	ConfigKeyFeatures  = "features"
 	ConfigKeyCACert    = "cacert"
 	ConfigKeyColorized = "colorized"
+
+	ConfigKeyKubeContext    = "kubecontext"
+	ConfigKeyProfiles       = "profiles"
+	ConfigKeyCurrentProfile = "current-profile"
 )
 
+// Profile is a named set of client settings for a cluster. The settings of the current
+// profile take precedence over the top level ones in the client configuration file.
+type Profile struct {
+	Namespace   string `json:"namespace,omitempty"`
+	KubeContext string `json:"kubecontext,omitempty"`
+	CACert      string `json:"cacert,omitempty"`
+}
+
 // VeleroConfig is a map of strings to interface{} for deserializing Velero client config options.
 // The alias is a way to attach type-asserting convenience methods.
 type VeleroConfig map[string]interface{}
@@ -86,6 +98,10 @@ func SaveConfig(config VeleroConfig) error {
 }
 
 func (c VeleroConfig) Namespace() string {
+	if profile := c.currentProfile(); profile != nil && profile.Namespace != "" {
+		return profile.Namespace
+	}
+
 	val, ok := c[ConfigKeyNamespace]
 	if !ok {
 		return ""
@@ -133,6 +149,10 @@ func (c VeleroConfig) Colorized() bool {
 }
 
 func (c VeleroConfig) CACertFile() string {
+	if profile := c.currentProfile(); profile != nil && profile.CACert != "" {
+		return profile.CACert
+	}
+
 	val, ok := c[ConfigKeyCACert]
 	if !ok {
 		return ""
@@ -145,6 +165,99 @@ func (c VeleroConfig) CACertFile() string {
 	return caCertFile
 }
 
+func (c VeleroConfig) KubeContext() string {
+	if profile := c.currentProfile(); profile != nil && profile.KubeContext != "" {
+		return profile.KubeContext
+	}
+
+	val, ok := c[ConfigKeyKubeContext]
+	if !ok {
+		return ""
+	}
+	kubeContext, ok := val.(string)
+	if !ok {
+		return ""
+	}
+
+	return kubeContext
+}
+
+// CurrentProfile returns the name of the current profile, an empty string is returned if
+// no profile is in use.
+func (c VeleroConfig) CurrentProfile() string {
+	val, ok := c[ConfigKeyCurrentProfile]
+	if !ok {
+		return ""
+	}
+	name, ok := val.(string)
+	if !ok {
+		return ""
+	}
+
+	return name
+}
+
+// Profiles returns the profiles in the config by name.
+func (c VeleroConfig) Profiles() (map[string]Profile, error) {
+	profiles := map[string]Profile{}
+
+	val, ok := c[ConfigKeyProfiles]
+	if !ok {
+		return profiles, nil
+	}
+
+	// the profiles are decoded as generic maps from the config file, convert them by a
+	// round trip through JSON
+	data, err := json.Marshal(val)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := json.Unmarshal(data, &profiles); err != nil {
+		return nil, errors.Wrapf(err, "invalid %q in the client config", ConfigKeyProfiles)
+	}
+
+	return profiles, nil
+}
+
+// SetProfiles replaces the profiles in the config.
+func (c VeleroConfig) SetProfiles(profiles map[string]Profile) {
+	if len(profiles) == 0 {
+		delete(c, ConfigKeyProfiles)
+		return
+	}
+
+	c[ConfigKeyProfiles] = profiles
+}
+
+// UseProfile sets the current profile, an empty name stops using profiles.
+func (c VeleroConfig) UseProfile(name string) {
+	if name == "" {
+		delete(c, ConfigKeyCurrentProfile)
+		return
+	}
+
+	c[ConfigKeyCurrentProfile] = name
+}
+
+func (c VeleroConfig) currentProfile() *Profile {
+	name := c.CurrentProfile()
+	if name == "" {
+		return nil
+	}
+
+	profiles, err := c.Profiles()
+	if err != nil {
+		return nil
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		return nil
+	}
+
+	return &profile
+}
+
 func configFileName() string {
 	return filepath.Join(os.Getenv("HOME"), ".config", "velero", "config.json")
 }
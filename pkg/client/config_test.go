@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestVeleroConfig(t *testing.T) {
@@ -36,6 +37,48 @@ func TestVeleroConfig(t *testing.T) {
 	assert.Equal(t, true, c.Colorized())
 }
 
+func TestVeleroConfigProfiles(t *testing.T) {
+	c := VeleroConfig{
+		"namespace":   "foo",
+		"kubecontext": "context-1",
+		"cacert":      "ca-1",
+	}
+
+	profiles, err := c.Profiles()
+	require.NoError(t, err)
+	assert.Empty(t, profiles)
+
+	c.SetProfiles(map[string]Profile{
+		"prod": {Namespace: "velero-prod", KubeContext: "prod"},
+	})
+
+	// the top level values are used without a current profile
+	assert.Equal(t, "foo", c.Namespace())
+	assert.Equal(t, "context-1", c.KubeContext())
+	assert.Equal(t, "ca-1", c.CACertFile())
+
+	// the values of the current profile take precedence, the unset ones fall back to the top level
+	c.UseProfile("prod")
+	assert.Equal(t, "prod", c.CurrentProfile())
+	assert.Equal(t, "velero-prod", c.Namespace())
+	assert.Equal(t, "prod", c.KubeContext())
+	assert.Equal(t, "ca-1", c.CACertFile())
+
+	// the profiles decoded from the config file are generic maps
+	c[ConfigKeyProfiles] = map[string]interface{}{
+		"prod": map[string]interface{}{"namespace": "velero-decoded"},
+	}
+	assert.Equal(t, "velero-decoded", c.Namespace())
+
+	c.UseProfile("")
+	assert.Equal(t, "", c.CurrentProfile())
+	assert.Equal(t, "foo", c.Namespace())
+
+	c[ConfigKeyProfiles] = "invalid"
+	_, err = c.Profiles()
+	assert.Error(t, err)
+}
+
 func removeConfigfileName() error {
 	// Remove config file if it exist
 	configFile := configFileName()
@@ -0,0 +1,40 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+)
+
+func NewCommand(f client.Factory) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "audit",
+		Short: "Work with the audit log",
+		Long: `Work with the audit log of the backup storage locations.
+
+The Velero server started with --audit-log records the backup creations and deletions, the restores and the
+changes to the backup storage locations into the audit/ prefix of the backup storage locations.`,
+	}
+
+	c.AddCommand(
+		NewGetCommand(f, "get"),
+	)
+
+	return c
+}
@@ -0,0 +1,232 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgaudit "github.com/vmware-tanzu/velero/pkg/audit"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+)
+
+// streamLog downloads the audit log of the backup storage location, it's replaced in the tests.
+var streamLog = downloadrequest.StreamTarget
+
+func NewGetCommand(f client.Factory, use string) *cobra.Command {
+	o := NewGetOptions()
+
+	c := &cobra.Command{
+		Use:   use,
+		Short: "Get the entries of the audit log",
+		Long: `Get the entries of the audit log of a backup storage location, sorted by time.
+
+The user of an entry is the field manager recorded by the API server for the change, e.g. "velero" for the
+Velero CLI, so it tells the client rather than the identity making the change.`,
+		Example: `  # Get the entries of the audit log of the default backup storage location.
+  velero audit get
+
+  # Get the backup deletions of the last day recorded in the backup storage location "secondary".
+  velero audit get --storage-location secondary --since 24h --action BackupDelete
+
+  # Get the entries as JSON lines.
+  velero audit get -o json`,
+		Args: cobra.NoArgs,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(f))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type GetOptions struct {
+	StorageLocation       string
+	Since                 time.Duration
+	Actions               []string
+	Output                string
+	Timeout               time.Duration
+	InsecureSkipTLSVerify bool
+	CaCertFile            string
+
+	client kbclient.Client
+	out    io.Writer
+	now    func() time.Time
+}
+
+func NewGetOptions() *GetOptions {
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+
+	return &GetOptions{
+		Output:     "table",
+		Timeout:    time.Minute,
+		CaCertFile: config.CACertFile(),
+		out:        os.Stdout,
+		now:        time.Now,
+	}
+}
+
+func (o *GetOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.StorageLocation, "storage-location", o.StorageLocation, "The backup storage location to get the audit log of. The default backup storage location is used if it's empty.")
+	flags.DurationVar(&o.Since, "since", o.Since, "Only get the entries recorded within the duration, e.g. 24h.")
+	flags.StringSliceVar(&o.Actions, "action", o.Actions, fmt.Sprintf("Only get the entries of the actions, one of %v.", pkgaudit.Actions))
+	flags.StringVarP(&o.Output, "output", "o", o.Output, "Output format, table or json.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "How long to wait to receive the audit log.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.CaCertFile, "cacert", o.CaCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+}
+
+func (o *GetOptions) Complete(f client.Factory) error {
+	client, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.client = client
+
+	return nil
+}
+
+func (o *GetOptions) Validate() error {
+	if o.Output != "table" && o.Output != "json" {
+		return errors.Errorf("invalid output format %q, valid values are table and json", o.Output)
+	}
+	if o.Since < 0 {
+		return errors.New("--since must be positive")
+	}
+	for _, action := range o.Actions {
+		if !slices.Contains(pkgaudit.Actions, pkgaudit.Action(action)) {
+			return errors.Errorf("invalid action %q, valid values are %v", action, pkgaudit.Actions)
+		}
+	}
+
+	return nil
+}
+
+func (o *GetOptions) Run(f client.Factory) error {
+	location := o.StorageLocation
+	if location == "" {
+		var err error
+		if location, err = getDefaultBackupStorageLocation(o.client, f.Namespace()); err != nil {
+			return err
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	target := velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindAuditLog, Name: location}
+	if err := streamLog(context.Background(), o.client, f.Namespace(), target, buf, o.Timeout, o.InsecureSkipTLSVerify, o.CaCertFile); err != nil {
+		return err
+	}
+
+	entries, err := o.filterEntries(buf)
+	if err != nil {
+		return err
+	}
+
+	if o.Output == "json" {
+		encoder := json.NewEncoder(o.out)
+		for i := range entries {
+			if err := encoder.Encode(&entries[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	o.printEntries(entries)
+	return nil
+}
+
+// filterEntries decodes the entries of the audit log, one per line, and returns the ones
+// matching the options.
+func (o *GetOptions) filterEntries(r io.Reader) ([]pkgaudit.Entry, error) {
+	var entries []pkgaudit.Entry
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		entry := pkgaudit.Entry{}
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, errors.Wrap(err, "error decoding audit entry")
+		}
+		if o.Since > 0 && entry.Time.Before(o.now().Add(-o.Since)) {
+			continue
+		}
+		if len(o.Actions) > 0 && !slices.Contains(o.Actions, string(entry.Action)) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "error reading audit log")
+	}
+
+	return entries, nil
+}
+
+func (o *GetOptions) printEntries(entries []pkgaudit.Entry) {
+	w := tabwriter.NewWriter(o.out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "TIME\tACTION\tKIND\tNAME\tUSER\tRESULT")
+	for _, entry := range entries {
+		result := entry.Result
+		if result == "" {
+			result = "<none>"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s/%s\t%s\t%s\n", entry.Time.UTC().Format(time.RFC3339), entry.Action, entry.Kind, entry.Namespace, entry.Name, entry.User, result)
+	}
+	w.Flush()
+}
+
+func getDefaultBackupStorageLocation(kbClient kbclient.Client, namespace string) (string, error) {
+	locations := &velerov1api.BackupStorageLocationList{}
+	if err := kbClient.List(context.Background(), locations, kbclient.InNamespace(namespace)); err != nil {
+		return "", errors.Wrap(err, "error listing backup storage locations")
+	}
+
+	for _, location := range locations.Items {
+		if location.Spec.Default {
+			return location.Name, nil
+		}
+	}
+
+	return "", errors.New("no default backup storage location found, please specify one with --storage-location")
+}
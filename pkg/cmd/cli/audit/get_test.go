@@ -0,0 +1,117 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+const auditLog = `{"time":"2024-01-01T00:00:00Z","action":"BackupStorageLocationCreate","kind":"BackupStorageLocation","namespace":"velero","name":"default","user":"velero"}
+{"time":"2024-01-02T00:00:00Z","action":"BackupCreate","kind":"Backup","namespace":"velero","name":"backup-1","user":"velero","result":"InProgress"}
+{"time":"2024-01-02T12:00:00Z","action":"BackupDelete","kind":"Backup","namespace":"velero","name":"backup-1","user":"kubectl-create","result":"Deleted"}
+`
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		name             string
+		storageLocation  string
+		since            time.Duration
+		actions          []string
+		output           string
+		expectedLocation string
+		expectedOutput   string
+	}{
+		{
+			name:             "all entries of the default location",
+			output:           "table",
+			expectedLocation: "default",
+			expectedOutput: `TIME                  ACTION                       KIND                   NAME             USER            RESULT
+2024-01-01T00:00:00Z  BackupStorageLocationCreate  BackupStorageLocation  velero/default   velero          <none>
+2024-01-02T00:00:00Z  BackupCreate                 Backup                 velero/backup-1  velero          InProgress
+2024-01-02T12:00:00Z  BackupDelete                 Backup                 velero/backup-1  kubectl-create  Deleted
+`,
+		},
+		{
+			name:             "entries filtered by time and action",
+			storageLocation:  "secondary",
+			since:            24 * time.Hour,
+			actions:          []string{"BackupDelete", "BackupStorageLocationCreate"},
+			output:           "json",
+			expectedLocation: "secondary",
+			expectedOutput:   `{"time":"2024-01-02T12:00:00Z","action":"BackupDelete","kind":"Backup","namespace":"velero","name":"backup-1","user":"kubectl-create","result":"Deleted"}` + "\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			kbClient := velerotest.NewFakeControllerRuntimeClient(t,
+				builder.ForBackupStorageLocation(cmdtest.VeleroNameSpace, "default").Default(true).Result(),
+				builder.ForBackupStorageLocation(cmdtest.VeleroNameSpace, "secondary").Result(),
+			)
+			f := &factorymocks.Factory{}
+			f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+			f.On("KubebuilderClient").Return(kbClient, nil)
+
+			var location string
+			streamLog = func(_ context.Context, _ kbclient.Client, _ string, target velerov1api.DownloadTarget, w io.Writer, _ time.Duration, _ bool, _ string) error {
+				assert.Equal(t, velerov1api.DownloadTargetKindAuditLog, target.Kind)
+				location = target.Name
+				_, err := w.Write([]byte(auditLog))
+				return err
+			}
+
+			out := new(bytes.Buffer)
+			o := NewGetOptions()
+			o.StorageLocation = tc.storageLocation
+			o.Since = tc.since
+			o.Actions = tc.actions
+			o.Output = tc.output
+			o.out = out
+			o.now = func() time.Time { return time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC) }
+
+			require.NoError(t, o.Complete(f))
+			require.NoError(t, o.Validate())
+			require.NoError(t, o.Run(f))
+			assert.Equal(t, tc.expectedLocation, location)
+			assert.Equal(t, tc.expectedOutput, out.String())
+		})
+	}
+}
+
+func TestGetValidate(t *testing.T) {
+	o := NewGetOptions()
+	o.Output = "yaml"
+	require.EqualError(t, o.Validate(), `invalid output format "yaml", valid values are table and json`)
+
+	o = NewGetOptions()
+	o.Actions = []string{"BackupUpdate"}
+	require.ErrorContains(t, o.Validate(), `invalid action "BackupUpdate"`)
+}
@@ -36,6 +36,10 @@ func NewCommand(f client.Factory) *cobra.Command {
 		NewDescribeCommand(f, "describe"),
 		NewDownloadCommand(f),
 		NewDeleteCommand(f, "delete"),
+		NewWatchCommand(f),
+		NewVerifyCommand(f),
+		NewCopyCommand(f, "copy"),
+		NewOperationsCommand(f),
 	)
 
 	return c
@@ -0,0 +1,179 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewCopyCommand(f client.Factory, use string) *cobra.Command {
+	o := NewCopyOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Copy a backup to another backup storage location",
+		Long: `Copy a completed backup to another backup storage location.
+
+The Velero server copies the metadata and the contents of the backup to the backup storage location, and
+copies the kopia snapshots of its pod volume backups and data mover backups to the backup repositories of
+the backup storage location. The copied backup has the same name, and is synced into the clusters using the
+backup storage location, from where it can be restored even if the original location is lost.
+
+The native and CSI snapshots of the backup aren't copied, the copied backup still references them.`,
+		Example: `  # Copy the backup "backup-1" to the backup storage location "offsite".
+  velero backup copy backup-1 --to-location offsite
+
+  # Copy the backup "backup-1" to the backup storage location "offsite" and wait for it to complete.
+  velero backup copy backup-1 --to-location offsite --wait`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate(f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type CopyOptions struct {
+	BackupName string
+	ToLocation string
+	Wait       bool
+
+	client       kbclient.Client
+	out          io.Writer
+	pollInterval time.Duration
+}
+
+func NewCopyOptions() *CopyOptions {
+	return &CopyOptions{
+		out:          os.Stdout,
+		pollInterval: time.Second,
+	}
+}
+
+func (o *CopyOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.ToLocation, "to-location", o.ToLocation, "Backup storage location to copy the backup to.")
+	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "Wait for the copy to complete.")
+}
+
+func (o *CopyOptions) Complete(args []string, f client.Factory) error {
+	o.BackupName = args[0]
+
+	client, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.client = client
+
+	return nil
+}
+
+func (o *CopyOptions) Validate(f client.Factory) error {
+	if o.ToLocation == "" {
+		return errors.New("--to-location is required")
+	}
+
+	backup := &velerov1api.Backup{}
+	if err := o.client.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: o.BackupName}, backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			return errors.Errorf("backup %q does not exist", o.BackupName)
+		}
+		return errors.WithStack(err)
+	}
+	if backup.Spec.StorageLocation == o.ToLocation {
+		return errors.Errorf("backup %q is already in backup storage location %q", o.BackupName, o.ToLocation)
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := o.client.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: o.ToLocation}, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			return errors.Errorf("backup storage location %q does not exist", o.ToLocation)
+		}
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (o *CopyOptions) Run(c *cobra.Command, f client.Factory) error {
+	backupCopy := builder.ForBackupCopy(f.Namespace(), "").
+		ObjectMeta(builder.WithGenerateName(o.BackupName + "-")).
+		BackupName(o.BackupName).
+		StorageLocation(o.ToLocation).
+		Result()
+
+	if err := client.CreateRetryGenerateName(o.client, context.Background(), backupCopy); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.out, "Backup copy %q submitted successfully.\n", backupCopy.Name)
+	if !o.Wait {
+		fmt.Fprintf(o.out, "Run `kubectl -n %s get backupcopies %s -o yaml` for more details.\n", f.Namespace(), backupCopy.Name)
+		return nil
+	}
+
+	fmt.Fprintln(o.out, "Waiting for the copy to complete. You may safely press ctrl-c to stop waiting - your copy will continue in the background.")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key := kbclient.ObjectKey{Namespace: f.Namespace(), Name: backupCopy.Name}
+	wait.Until(func() {
+		updated := &velerov2alpha1api.BackupCopy{}
+		if err := o.client.Get(ctx, key, updated); err != nil {
+			return
+		}
+
+		fmt.Fprint(o.out, ".")
+
+		switch updated.Status.Phase {
+		case velerov2alpha1api.BackupCopyPhaseCompleted, velerov2alpha1api.BackupCopyPhaseFailed,
+			velerov2alpha1api.BackupCopyPhaseFailedValidation:
+			backupCopy = updated
+			cancel()
+		}
+	}, o.pollInterval, ctx.Done())
+
+	fmt.Fprintf(o.out, "\nBackup copy completed with status: %s. %d of %d volume snapshots are copied.\n",
+		backupCopy.Status.Phase, backupCopy.Status.CopiedVolumeSnapshots, backupCopy.Status.TotalVolumeSnapshots)
+	if backupCopy.Status.Message != "" {
+		fmt.Fprintf(o.out, "Message: %s\n", backupCopy.Status.Message)
+	}
+
+	return nil
+}
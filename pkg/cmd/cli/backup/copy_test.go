@@ -0,0 +1,143 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// copyingClient completes the BackupCopies once they are created, as the Velero server does
+type copyingClient struct {
+	kbclient.Client
+}
+
+func (c *copyingClient) Create(ctx context.Context, obj kbclient.Object, opts ...kbclient.CreateOption) error {
+	if backupCopy, ok := obj.(*velerov2alpha1api.BackupCopy); ok {
+		backupCopy.Status = velerov2alpha1api.BackupCopyStatus{
+			Phase:                 velerov2alpha1api.BackupCopyPhaseCompleted,
+			TotalVolumeSnapshots:  2,
+			CopiedVolumeSnapshots: 2,
+		}
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func TestCopy(t *testing.T) {
+	tests := []struct {
+		name        string
+		backupName  string
+		toLocation  string
+		wait        bool
+		expectedOut string
+		expectedErr string
+	}{
+		{
+			name:        "to location isn't specified",
+			backupName:  "backup-1",
+			expectedErr: "--to-location is required",
+		},
+		{
+			name:        "backup doesn't exist",
+			backupName:  "backup-2",
+			toLocation:  "offsite",
+			expectedErr: "backup \"backup-2\" does not exist",
+		},
+		{
+			name:        "backup is already in the location",
+			backupName:  "backup-1",
+			toLocation:  "default",
+			expectedErr: "backup \"backup-1\" is already in backup storage location \"default\"",
+		},
+		{
+			name:        "location doesn't exist",
+			backupName:  "backup-1",
+			toLocation:  "unknown",
+			expectedErr: "backup storage location \"unknown\" does not exist",
+		},
+		{
+			name:       "copy is submitted",
+			backupName: "backup-1",
+			toLocation: "offsite",
+			expectedOut: "Backup copy \"%[1]s\" submitted successfully.\n" +
+				"Run `kubectl -n velero-test get backupcopies %[1]s -o yaml` for more details.\n",
+		},
+		{
+			name:       "copy is waited for",
+			backupName: "backup-1",
+			toLocation: "offsite",
+			wait:       true,
+			expectedOut: "Backup copy \"%[1]s\" submitted successfully.\n" +
+				"Waiting for the copy to complete. You may safely press ctrl-c to stop waiting - your copy will continue in the background.\n" +
+				".\nBackup copy completed with status: Completed. 2 of 2 volume snapshots are copied.\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			kbClient := &copyingClient{velerotest.NewFakeControllerRuntimeClient(t,
+				builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").StorageLocation("default").Result(),
+				builder.ForBackupStorageLocation(cmdtest.VeleroNameSpace, "default").Result(),
+				builder.ForBackupStorageLocation(cmdtest.VeleroNameSpace, "offsite").Result(),
+			)}
+
+			f := &factorymocks.Factory{}
+			f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+			f.On("KubebuilderClient").Return(kbClient, nil)
+
+			c := NewCopyCommand(f, "copy")
+			assert.Equal(t, "Copy a backup to another backup storage location", c.Short)
+
+			out := new(bytes.Buffer)
+			o := NewCopyOptions()
+			o.ToLocation = tc.toLocation
+			o.Wait = tc.wait
+			o.out = out
+			o.pollInterval = time.Millisecond
+			require.NoError(t, o.Complete([]string{tc.backupName}, f))
+
+			err := o.Validate(f)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			require.NoError(t, o.Run(c, f))
+
+			copies := &velerov2alpha1api.BackupCopyList{}
+			require.NoError(t, kbClient.List(context.Background(), copies))
+			require.Len(t, copies.Items, 1)
+			assert.Equal(t, "backup-1", copies.Items[0].Spec.BackupName)
+			assert.Equal(t, tc.toLocation, copies.Items[0].Spec.StorageLocation)
+			assert.Equal(t, fmt.Sprintf(tc.expectedOut, copies.Items[0].Name), out.String())
+		})
+	}
+}
@@ -22,22 +22,33 @@ import (
 	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/cache"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
+const (
+	// DryRunNone means the backup is created and run normally
+	DryRunNone = "none"
+	// DryRunServer means the backup is evaluated by the Velero server without backing up anything
+	DryRunServer = "server"
+)
+
 func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 	o := NewCreateOptions()
 
@@ -66,12 +77,18 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
   velero backup create backup3 --snapshot-volumes=false -o yaml
 
   # Wait for a backup to complete before returning from the command.
-  velero backup create backup4 --wait`,
+  velero backup create backup4 --wait
+
+  # Show the resources, persistent volumes and estimated data size that would be included in a backup, without backing up anything.
+  velero backup create backup5 --include-namespaces nginx --dry-run=server`,
 	}
 
 	o.BindFlags(c.Flags())
 	o.BindWait(c.Flags())
+	o.BindDryRun(c.Flags())
 	o.BindFromSchedule(c.Flags())
+	o.BindIncrementalBase(c.Flags())
+	o.BindFSBackupUnmountedPVCs(c.Flags())
 	output.BindFlags(c.Flags())
 	output.ClearOutputFlagDefault(c)
 
@@ -83,7 +100,14 @@ type CreateOptions struct {
 	TTL                             time.Duration
 	SnapshotVolumes                 flag.OptionalBool
 	SnapshotMoveData                flag.OptionalBool
+	SnapshotMoveReplicaLocation     string
+	DeduplicateClusterResources     bool
 	DataMover                       string
+	UploaderType                    string
+	CompressionAlgorithm            string
+	DryRun                          string
+	IncrementalBase                 string
+	FSBackupUnmountedPVCs           bool
 	DefaultVolumesToFsBackup        flag.OptionalBool
 	IncludeNamespaces               flag.StringArray
 	ExcludeNamespaces               flag.StringArray
@@ -96,6 +120,8 @@ type CreateOptions struct {
 	Labels                          flag.Map
 	Selector                        flag.LabelSelector
 	OrSelector                      flag.OrLabelSelector
+	ClusterScopedSelector           flag.LabelSelector
+	ClusterScopedOrSelector         flag.OrLabelSelector
 	IncludeClusterResources         flag.OptionalBool
 	Wait                            bool
 	StorageLocation                 string
@@ -103,8 +129,11 @@ type CreateOptions struct {
 	FromSchedule                    string
 	OrderedResources                string
 	CSISnapshotTimeout              time.Duration
+	VolumeGroupSnapshotLabelKey     string
 	ItemOperationTimeout            time.Duration
 	ResPoliciesConfigmap            string
+	ValidationPoliciesConfigmap     string
+	ResourceFilterPolicy            string
 	client                          kbclient.WithWatch
 }
 
@@ -114,6 +143,7 @@ func NewCreateOptions() *CreateOptions {
 		Labels:                  flag.NewMap(),
 		SnapshotVolumes:         flag.NewOptionalBool(nil),
 		IncludeClusterResources: flag.NewOptionalBool(nil),
+		DryRun:                  DryRunNone,
 	}
 }
 
@@ -132,8 +162,11 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringSliceVar(&o.SnapshotLocations, "volume-snapshot-locations", o.SnapshotLocations, "List of locations (at most one per provider) where volume snapshots should be stored.")
 	flags.VarP(&o.Selector, "selector", "l", "Only back up resources matching this label selector.")
 	flags.Var(&o.OrSelector, "or-selector", "Backup resources matching at least one of the label selector from the list. Label selectors should be separated by ' or '. For example, foo=bar or app=nginx")
+	flags.Var(&o.ClusterScopedSelector, "cluster-scoped-selector", "Only back up cluster-scoped resources matching this label selector. If not set, cluster-scoped resources are filtered by the selector or or-selector. Cluster-scoped resources matching it are backed up even if only specific namespaces are included.")
+	flags.Var(&o.ClusterScopedOrSelector, "cluster-scoped-or-selector", "Back up cluster-scoped resources matching at least one of the label selector from the list. Label selectors should be separated by ' or '. For example, foo=bar or app=nginx")
 	flags.StringVar(&o.OrderedResources, "ordered-resources", "", "Mapping Kinds to an ordered list of specific resources of that Kind.  Resource names are separated by commas and their names are in format 'namespace/resourcename'. For cluster scope resource, simply use resource name. Key-value pairs in the mapping are separated by semi-colon.  Example: 'pods=ns1/pod1,ns1/pod2;persistentvolumeclaims=ns1/pvc4,ns1/pvc8'.  Optional.")
 	flags.DurationVar(&o.CSISnapshotTimeout, "csi-snapshot-timeout", o.CSISnapshotTimeout, "How long to wait for CSI snapshot creation before timeout.")
+	flags.StringVar(&o.VolumeGroupSnapshotLabelKey, "volume-group-snapshot-label-key", "", "The label key on the PVCs to group them, the CSI snapshots of the PVCs with the same value of the label in a namespace are taken together by a VolumeGroupSnapshot. If not set, the key configured on the Velero server is used.")
 	flags.DurationVar(&o.ItemOperationTimeout, "item-operation-timeout", o.ItemOperationTimeout, "How long to wait for async plugin operations before timeout.")
 	f := flags.VarPF(&o.SnapshotVolumes, "snapshot-volumes", "", "Take snapshots of PersistentVolumes as part of the backup. If the parameter is not set, it is treated as setting to 'true'.")
 	// this allows the user to just specify "--snapshot-volumes" as shorthand for "--snapshot-volumes=true"
@@ -142,6 +175,8 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 
 	f = flags.VarPF(&o.SnapshotMoveData, "snapshot-move-data", "", "Specify whether snapshot data should be moved")
 	f.NoOptDefVal = cmd.TRUE
+	flags.BoolVar(&o.DeduplicateClusterResources, "deduplicate-cluster-resources", false, "Reference the cluster-scoped items unchanged since the previous backup of the same schedule instead of uploading them again. Only takes effect for the backups created by a schedule")
+	flags.StringVar(&o.SnapshotMoveReplicaLocation, "snapshot-move-replica-location", "", "The backup storage location the moved snapshot data is additionally replicated to. Only the snapshots moved by the built-in data mover are replicated")
 
 	f = flags.VarPF(&o.IncludeClusterResources, "include-cluster-resources", "", "Include cluster-scoped resources in the backup. Cannot work with include-cluster-scoped-resources, exclude-cluster-scoped-resources, include-namespace-scoped-resources and exclude-namespace-scoped-resources.")
 	f.NoOptDefVal = cmd.TRUE
@@ -150,7 +185,11 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f.NoOptDefVal = cmd.TRUE
 
 	flags.StringVar(&o.ResPoliciesConfigmap, "resource-policies-configmap", "", "Reference to the resource policies configmap that backup using")
+	flags.StringVar(&o.ResourceFilterPolicy, "resource-filter-policy", "", "Name of the ResourceFilterPolicy whose filters are applied to the backup. The filters set by the flags take precedence over the ones of the policy.")
+	flags.StringVar(&o.ValidationPoliciesConfigmap, "validation-policies-configmap", "", "Reference to the validation policies configmap that is checked against the backup when it finishes backing up the items")
 	flags.StringVar(&o.DataMover, "data-mover", "", "Specify the data mover to be used by the backup. If the parameter is not set or set as 'velero', the built-in data mover will be used")
+	flags.StringVar(&o.UploaderType, "uploader-type", "", fmt.Sprintf("The type of uploader to transfer the data of pod volumes, the supported values are '%s', '%s'. If the parameter is not set, the uploader type configured on the Velero server will be used", uploader.ResticType, uploader.KopiaType))
+	flags.StringVar(&o.CompressionAlgorithm, "compression-algorithm", "", fmt.Sprintf("The algorithm to compress the backup tarball, the supported values are '%s', '%s', '%s'. If the parameter is not set, '%s' will be used. The backups compressed with '%s' or '%s' can't be restored by older versions of Velero", archive.CompressionAlgorithmGzip, archive.CompressionAlgorithmZstd, archive.CompressionAlgorithmLz4, archive.CompressionAlgorithmGzip, archive.CompressionAlgorithmZstd, archive.CompressionAlgorithmLz4))
 }
 
 // BindWait binds the wait flag separately so it is not called by other create
@@ -159,6 +198,24 @@ func (o *CreateOptions) BindWait(flags *pflag.FlagSet) {
 	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "Wait for the operation to complete.")
 }
 
+// BindDryRun binds the dry-run flag separately so it is not called
+// by other create commands that reuse CreateOptions's BindFlags method.
+func (o *CreateOptions) BindDryRun(flags *pflag.FlagSet) {
+	flags.StringVar(&o.DryRun, "dry-run", o.DryRun, fmt.Sprintf("Must be '%s' or '%s'. If '%s', the Velero server evaluates the resources, persistent volumes and estimated data size that would be included in the backup without backing up anything, and the backup is deleted once the result is printed", DryRunNone, DryRunServer, DryRunServer))
+}
+
+// BindIncrementalBase binds the incremental-base flag separately so it is not called
+// by other create commands that reuse CreateOptions's BindFlags method.
+func (o *CreateOptions) BindIncrementalBase(flags *pflag.FlagSet) {
+	flags.StringVar(&o.IncrementalBase, "incremental-base", "", "Name of a previous backup whose pod volume backups are used as the base of this backup's incremental pod volume backups. If not set, the most recent pod volume backups are used as the base.")
+}
+
+// BindFSBackupUnmountedPVCs binds the fs-backup-unmounted-pvcs flag separately so it is not called
+// by other create commands that reuse CreateOptions's BindFlags method.
+func (o *CreateOptions) BindFSBackupUnmountedPVCs(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.FSBackupUnmountedPVCs, "fs-backup-unmounted-pvcs", o.FSBackupUnmountedPVCs, "Back up the data of the bound PVCs that aren't mounted by any running pod with pod volume file system backup, the PVCs are mounted by pods created by the node-agent for the backup.")
+}
+
 // BindFromSchedule binds the from-schedule flag separately so it is not called
 // by other create commands that reuse CreateOptions's BindFlags method.
 func (o *CreateOptions) BindFromSchedule(flags *pflag.FlagSet) {
@@ -174,11 +231,20 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return fmt.Errorf("either a 'selector' or an 'or-selector' can be specified, but not both")
 	}
 
+	if o.ClusterScopedSelector.LabelSelector != nil && o.ClusterScopedOrSelector.OrLabelSelectors != nil {
+		return fmt.Errorf("either a 'cluster-scoped-selector' or a 'cluster-scoped-or-selector' can be specified, but not both")
+	}
+
 	// Ensure that unless FromSchedule is set, args contains a backup name
 	if o.FromSchedule == "" && len(args) != 1 {
 		return fmt.Errorf("a backup name is required, unless you are creating based on a schedule")
 	}
 
+	// don't include all the namespaces by default, so that the namespaces of the resource filter policy are used
+	if o.ResourceFilterPolicy != "" && !c.Flags().Changed("include-namespaces") {
+		o.IncludeNamespaces = nil
+	}
+
 	errs := collections.ValidateNamespaceIncludesExcludes(o.IncludeNamespaces, o.ExcludeNamespaces)
 	if len(errs) > 0 {
 		return kubeerrs.NewAggregate(errs)
@@ -190,6 +256,16 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 			"They cannot be used together")
 	}
 
+	if o.ResourceFilterPolicy != "" {
+		policy := &velerov1api.ResourceFilterPolicy{}
+		if err := o.client.Get(context.Background(), kbclient.ObjectKey{
+			Namespace: f.Namespace(),
+			Name:      o.ResourceFilterPolicy,
+		}, policy); err != nil {
+			return err
+		}
+	}
+
 	if o.StorageLocation != "" {
 		location := &velerov1api.BackupStorageLocation{}
 		if err := o.client.Get(context.Background(), kbclient.ObjectKey{
@@ -200,6 +276,20 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		}
 	}
 
+	if o.DryRun != DryRunNone && o.DryRun != DryRunServer {
+		return fmt.Errorf("invalid dry-run value %q, valid values are '%s', '%s'", o.DryRun, DryRunNone, DryRunServer)
+	}
+
+	if o.UploaderType != "" {
+		if err := uploader.ValidateUploaderType(o.UploaderType); err != nil {
+			return err
+		}
+	}
+
+	if err := archive.ValidateCompressionAlgorithm(o.CompressionAlgorithm); err != nil {
+		return err
+	}
+
 	for _, loc := range o.SnapshotLocations {
 		snapshotLocation := new(velerov1api.VolumeSnapshotLocation)
 		if err := o.client.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: loc}, snapshotLocation); err != nil {
@@ -237,8 +327,10 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		fmt.Println("Creating backup from schedule, all other filters are ignored.")
 	}
 
+	dryRun := o.DryRun == DryRunServer
+
 	var updates chan *velerov1api.Backup
-	if o.Wait {
+	if o.Wait || dryRun {
 		stop := make(chan struct{})
 		defer close(stop)
 
@@ -287,6 +379,11 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		return err
 	}
 
+	if dryRun {
+		fmt.Printf("Backup dry-run request %q submitted successfully.\n", backup.Name)
+		return o.waitForDryRun(backup, updates)
+	}
+
 	fmt.Printf("Backup request %q submitted successfully.\n", backup.Name)
 	if o.Wait {
 		fmt.Println("Waiting for backup to complete. You may safely press ctrl-c to stop waiting - your backup will continue in the background.")
@@ -319,6 +416,39 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 	return nil
 }
 
+// waitForDryRun waits for the dry-run of the backup to complete, prints the result and then
+// deletes the backup, since nothing is persisted to the backup storage location for it.
+func (o *CreateOptions) waitForDryRun(backup *velerov1api.Backup, updates chan *velerov1api.Backup) error {
+	fmt.Println("Waiting for backup dry-run to complete.")
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			fmt.Print(".")
+		case updated, ok := <-updates:
+			if !ok {
+				fmt.Println("\nError waiting: unable to watch backups.")
+				return nil
+			}
+
+			if updated.Status.Phase != velerov1api.BackupPhaseDryRunCompleted && updated.Status.Phase != velerov1api.BackupPhaseFailedValidation &&
+				updated.Status.Phase != velerov1api.BackupPhaseFailed {
+				continue
+			}
+
+			fmt.Printf("\n\n%s\n", output.DescribeBackupDryRun(updated))
+
+			if err := o.client.Delete(context.TODO(), backup, &kbclient.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return errors.Wrapf(err, "error deleting backup %q for dry-run", backup.Name)
+			}
+
+			return nil
+		}
+	}
+}
+
 // ParseOrderedResources converts to map of Kinds to an ordered list of specific resources of that Kind.
 // Resource names in the list are in format 'namespace/resourcename' and separated by commas.
 // Key-value pairs in the mapping are separated by semi-colon.
@@ -351,7 +481,13 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 			return nil, err
 		}
 		if o.Name == "" {
-			o.Name = schedule.TimestampedName(time.Now().UTC())
+			// the cluster name is only known by the server, so the timestamped name is used
+			// if the name template of the schedule contains it
+			if strings.Contains(schedule.Spec.Template.NameTemplate, velerov1api.BackupNameTokenCluster) {
+				o.Name = schedule.TimestampedName(time.Now().UTC())
+			} else {
+				o.Name = schedule.BackupName(time.Now().UTC(), "")
+			}
 		}
 		backupBuilder = builder.ForBackup(namespace, o.Name).
 			FromSchedule(schedule)
@@ -367,12 +503,18 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 			ExcludedNamespaceScopedResources(o.ExcludeNamespaceScopedResources...).
 			LabelSelector(o.Selector.LabelSelector).
 			OrLabelSelector(o.OrSelector.OrLabelSelectors).
+			ClusterScopedLabelSelector(o.ClusterScopedSelector.LabelSelector).
+			ClusterScopedOrLabelSelector(o.ClusterScopedOrSelector.OrLabelSelectors).
 			TTL(o.TTL).
 			StorageLocation(o.StorageLocation).
 			VolumeSnapshotLocations(o.SnapshotLocations...).
 			CSISnapshotTimeout(o.CSISnapshotTimeout).
+			VolumeGroupSnapshotLabelKey(o.VolumeGroupSnapshotLabelKey).
 			ItemOperationTimeout(o.ItemOperationTimeout).
-			DataMover(o.DataMover)
+			DataMover(o.DataMover).
+			SnapshotMoveReplicaLocation(o.SnapshotMoveReplicaLocation).
+			UploaderType(o.UploaderType).
+			CompressionAlgorithm(o.CompressionAlgorithm)
 		if len(o.OrderedResources) > 0 {
 			orders, err := ParseOrderedResources(o.OrderedResources)
 			if err != nil {
@@ -387,6 +529,9 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 		if o.SnapshotMoveData.Value != nil {
 			backupBuilder.SnapshotMoveData(*o.SnapshotMoveData.Value)
 		}
+		if o.DeduplicateClusterResources {
+			backupBuilder.DeduplicateClusterResources(true)
+		}
 		if o.IncludeClusterResources.Value != nil {
 			backupBuilder.IncludeClusterResources(*o.IncludeClusterResources.Value)
 		}
@@ -396,6 +541,24 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 		if o.ResPoliciesConfigmap != "" {
 			backupBuilder.ResourcePolicies(o.ResPoliciesConfigmap)
 		}
+		if o.ValidationPoliciesConfigmap != "" {
+			backupBuilder.ValidationPolicies(o.ValidationPoliciesConfigmap)
+		}
+		if o.ResourceFilterPolicy != "" {
+			backupBuilder.ResourceFilterPolicy(o.ResourceFilterPolicy)
+		}
+	}
+
+	if o.DryRun == DryRunServer {
+		backupBuilder.DryRun(true)
+	}
+
+	if o.IncrementalBase != "" {
+		backupBuilder.ObjectMeta(builder.WithAnnotations(velerov1api.PodVolumeIncrementalBaseAnnotation, o.IncrementalBase))
+	}
+
+	if o.FSBackupUnmountedPVCs {
+		backupBuilder.ObjectMeta(builder.WithAnnotations(velerov1api.FSBackupUnmountedPVCsAnnotation, "true"))
 	}
 
 	backup := backupBuilder.ObjectMeta(builder.WithLabelsMap(o.Labels.Data())).Result()
@@ -38,6 +38,7 @@ import (
 	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
 	"github.com/vmware-tanzu/velero/pkg/test"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
 
 func TestCreateOptions_BuildBackup(t *testing.T) {
@@ -56,20 +57,23 @@ func TestCreateOptions_BuildBackup(t *testing.T) {
 		},
 	}
 	o.OrSelector.OrLabelSelectors = orLabelSelectors
+	clusterScopedLabelSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"c1": "d1"}}
+	o.ClusterScopedSelector.LabelSelector = clusterScopedLabelSelector
 	assert.NoError(t, err)
 
 	backup, err := o.BuildBackup(cmdtest.VeleroNameSpace)
 	assert.NoError(t, err)
 
 	assert.Equal(t, velerov1api.BackupSpec{
-		TTL:                     metav1.Duration{Duration: o.TTL},
-		IncludedNamespaces:      []string(o.IncludeNamespaces),
-		SnapshotVolumes:         o.SnapshotVolumes.Value,
-		IncludeClusterResources: o.IncludeClusterResources.Value,
-		OrderedResources:        orders,
-		OrLabelSelectors:        orLabelSelectors,
-		CSISnapshotTimeout:      metav1.Duration{Duration: o.CSISnapshotTimeout},
-		ItemOperationTimeout:    metav1.Duration{Duration: o.ItemOperationTimeout},
+		TTL:                        metav1.Duration{Duration: o.TTL},
+		IncludedNamespaces:         []string(o.IncludeNamespaces),
+		SnapshotVolumes:            o.SnapshotVolumes.Value,
+		IncludeClusterResources:    o.IncludeClusterResources.Value,
+		OrderedResources:           orders,
+		OrLabelSelectors:           orLabelSelectors,
+		ClusterScopedLabelSelector: clusterScopedLabelSelector,
+		CSISnapshotTimeout:         metav1.Duration{Duration: o.CSISnapshotTimeout},
+		ItemOperationTimeout:       metav1.Duration{Duration: o.ItemOperationTimeout},
 	}, backup.Spec)
 
 	assert.Equal(t, map[string]string{
@@ -81,6 +85,38 @@ func TestCreateOptions_BuildBackup(t *testing.T) {
 	}, backup.Spec.OrderedResources)
 }
 
+func TestCreateOptions_BuildBackupDryRun(t *testing.T) {
+	o := NewCreateOptions()
+	backup, err := o.BuildBackup(cmdtest.VeleroNameSpace)
+	assert.NoError(t, err)
+	assert.Nil(t, backup.Spec.DryRun)
+
+	o.DryRun = DryRunServer
+	backup, err = o.BuildBackup(cmdtest.VeleroNameSpace)
+	assert.NoError(t, err)
+	assert.Equal(t, boolptr.True(), backup.Spec.DryRun)
+}
+
+func TestCreateOptions_BuildBackupIncrementalBase(t *testing.T) {
+	o := NewCreateOptions()
+	o.IncrementalBase = "base-backup"
+	o.Labels.Set("velero.io/test=true")
+
+	backup, err := o.BuildBackup(cmdtest.VeleroNameSpace)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{velerov1api.PodVolumeIncrementalBaseAnnotation: "base-backup"}, backup.GetAnnotations())
+	assert.Equal(t, map[string]string{"velero.io/test": "true"}, backup.GetLabels())
+}
+
+func TestCreateOptions_BuildBackupFSBackupUnmountedPVCs(t *testing.T) {
+	o := NewCreateOptions()
+	o.FSBackupUnmountedPVCs = true
+
+	backup, err := o.BuildBackup(cmdtest.VeleroNameSpace)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{velerov1api.FSBackupUnmountedPVCsAnnotation: "true"}, backup.GetAnnotations())
+}
+
 func TestCreateOptions_BuildBackupFromSchedule(t *testing.T) {
 	o := NewCreateOptions()
 	o.FromSchedule = "test"
@@ -181,10 +217,12 @@ func TestCreateCommand(t *testing.T) {
 		itemOperationTimeout := "99h1m6s"
 		snapshotVolumes := "false"
 		snapshotMoveData := "true"
+		snapshotMoveReplicaLocation := "bsl-replica"
 		includeClusterResources := "true"
 		defaultVolumesToFsBackup := "true"
 		resPoliciesConfigmap := "cm-name-2"
 		dataMover := "velero"
+		uploaderType := "kopia"
 
 		flags := new(flag.FlagSet)
 		o := NewCreateOptions()
@@ -209,10 +247,12 @@ func TestCreateCommand(t *testing.T) {
 		flags.Parse([]string{"--item-operation-timeout", itemOperationTimeout})
 		flags.Parse([]string{fmt.Sprintf("--snapshot-volumes=%s", snapshotVolumes)})
 		flags.Parse([]string{fmt.Sprintf("--snapshot-move-data=%s", snapshotMoveData)})
+		flags.Parse([]string{"--snapshot-move-replica-location", snapshotMoveReplicaLocation})
 		flags.Parse([]string{"--include-cluster-resources", includeClusterResources})
 		flags.Parse([]string{"--default-volumes-to-fs-backup", defaultVolumesToFsBackup})
 		flags.Parse([]string{"--resource-policies-configmap", resPoliciesConfigmap})
 		flags.Parse([]string{"--data-mover", dataMover})
+		flags.Parse([]string{"--uploader-type", uploaderType})
 		//flags.Parse([]string{"--wait"})
 
 		client := velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch)
@@ -257,10 +297,12 @@ func TestCreateCommand(t *testing.T) {
 		require.Equal(t, itemOperationTimeout, o.ItemOperationTimeout.String())
 		require.Equal(t, snapshotVolumes, o.SnapshotVolumes.String())
 		require.Equal(t, snapshotMoveData, o.SnapshotMoveData.String())
+		require.Equal(t, snapshotMoveReplicaLocation, o.SnapshotMoveReplicaLocation)
 		require.Equal(t, includeClusterResources, o.IncludeClusterResources.String())
 		require.Equal(t, defaultVolumesToFsBackup, o.DefaultVolumesToFsBackup.String())
 		require.Equal(t, resPoliciesConfigmap, o.ResPoliciesConfigmap)
 		require.Equal(t, dataMover, o.DataMover)
+		require.Equal(t, uploaderType, o.UploaderType)
 		//assert.Equal(t, true, o.Wait)
 
 		// verify oldAndNewFilterParametersUsedTogether
@@ -295,6 +337,27 @@ func TestCreateCommand(t *testing.T) {
 		assert.Contains(t, e.Error(), fmt.Sprintf("backupstoragelocations.velero.io \"%s\" not found", bsl))
 	})
 
+	t.Run("create a backup create command with invalid dry-run setting", func(t *testing.T) {
+		f := &factorymocks.Factory{}
+		cmd := NewCreateCommand(f, "")
+		kbclient := velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch)
+
+		f.On("Namespace").Return(mock.Anything)
+		f.On("KubebuilderWatchClient").Return(kbclient, nil)
+
+		flags := new(flag.FlagSet)
+		o := NewCreateOptions()
+		o.BindFlags(flags)
+		o.BindDryRun(flags)
+		flags.Parse([]string{"--dry-run", "client"})
+
+		e := o.Complete(args, f)
+		assert.NoError(t, e)
+
+		e = o.Validate(cmd, args, f)
+		assert.EqualError(t, e, "invalid dry-run value \"client\", valid values are 'none', 'server'")
+	})
+
 	t.Run("create a backup create command with specific volume-snapshot-locations setting", func(t *testing.T) {
 		vslName := "vsl-1"
 		// create a factory
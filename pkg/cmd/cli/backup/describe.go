@@ -40,6 +40,7 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 	var (
 		listOptions           metav1.ListOptions
 		details               bool
+		showSkipped           bool
 		insecureSkipTLSVerify bool
 		outputFormat          = "plaintext"
 	)
@@ -65,8 +66,12 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 				cmd.CheckError(err)
 			}
 
-			if outputFormat != "plaintext" && outputFormat != "json" {
-				cmd.CheckError(fmt.Errorf("invalid output format '%s'. valid value are 'plaintext, json'", outputFormat))
+			if outputFormat != "plaintext" && outputFormat != "json" && outputFormat != "yaml" {
+				cmd.CheckError(fmt.Errorf("invalid output format '%s'. valid value are 'plaintext, json, yaml'", outputFormat))
+			}
+
+			if showSkipped && !details {
+				cmd.CheckError(fmt.Errorf("--show-skipped requires --details"))
 			}
 
 			backups := new(velerov1api.BackupList)
@@ -117,10 +122,10 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 				// structured output only applies to a single backup in case of OOM
 				// To describe the list of backups in structured format, users could iterate over the list and describe backup one after another.
 				if len(backups.Items) == 1 && outputFormat != "plaintext" {
-					s := output.DescribeBackupInSF(context.Background(), kbClient, &backups.Items[i], deleteRequestList.Items, podVolumeBackupList.Items, vscList.Items, details, insecureSkipTLSVerify, caCertFile, outputFormat)
+					s := output.DescribeBackupInSF(context.Background(), kbClient, &backups.Items[i], deleteRequestList.Items, podVolumeBackupList.Items, vscList.Items, details, showSkipped, insecureSkipTLSVerify, caCertFile, outputFormat)
 					fmt.Print(s)
 				} else {
-					s := output.DescribeBackup(context.Background(), kbClient, &backups.Items[i], deleteRequestList.Items, podVolumeBackupList.Items, vscList.Items, details, insecureSkipTLSVerify, caCertFile)
+					s := output.DescribeBackup(context.Background(), kbClient, &backups.Items[i], deleteRequestList.Items, podVolumeBackupList.Items, vscList.Items, details, showSkipped, insecureSkipTLSVerify, caCertFile)
 					if first {
 						first = false
 						fmt.Print(s)
@@ -135,9 +140,10 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 
 	c.Flags().StringVarP(&listOptions.LabelSelector, "selector", "l", listOptions.LabelSelector, "Only show items matching this label selector.")
 	c.Flags().BoolVar(&details, "details", details, "Display additional detail in the command output.")
+	c.Flags().BoolVar(&showSkipped, "show-skipped", showSkipped, "Display the items skipped by the backup with their reasons. Requires --details.")
 	c.Flags().BoolVar(&insecureSkipTLSVerify, "insecure-skip-tls-verify", insecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
 	c.Flags().StringVar(&caCertFile, "cacert", caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
-	c.Flags().StringVarP(&outputFormat, "output", "o", outputFormat, "Output display format. Valid formats are 'plaintext, json'. 'json' only applies to a single backup")
+	c.Flags().StringVarP(&outputFormat, "output", "o", outputFormat, "Output display format. Valid formats are 'plaintext, json, yaml'. 'json' and 'yaml' only apply to a single backup")
 
 	return c
 }
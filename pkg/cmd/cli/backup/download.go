@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -29,6 +30,7 @@ import (
 	controllerclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
@@ -65,7 +67,10 @@ type DownloadOptions struct {
 	Force                 bool
 	Timeout               time.Duration
 	InsecureSkipTLSVerify bool
+	Resources             []string
+	ResourceNamespaces    []string
 	writeOptions          int
+	defaultOutput         bool
 	caCertFile            string
 }
 
@@ -76,11 +81,13 @@ func NewDownloadOptions() *DownloadOptions {
 }
 
 func (o *DownloadOptions) BindFlags(flags *pflag.FlagSet) {
-	flags.StringVarP(&o.Output, "output", "o", o.Output, "Path to output file. Defaults to <NAME>-data.tar.gz in the current directory.")
+	flags.StringVarP(&o.Output, "output", "o", o.Output, "Path to output file. Defaults to <NAME>-data.tar.gz in the current directory, or <NAME>-data.tar.zst and <NAME>-data.tar.lz4 if the backup is compressed with zstd and lz4.")
 	flags.BoolVar(&o.Force, "force", o.Force, "Forces the download and will overwrite file if it exists already.")
 	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait to process download request.")
 	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
 	flags.StringVar(&o.caCertFile, "cacert", o.caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+	flags.StringSliceVar(&o.Resources, "resource", o.Resources, "Resources to extract from the backup, formatted as resource or resource.group (e.g. configmaps,deployments.apps). Only the matching items are extracted by the server and downloaded. Optional.")
+	flags.StringSliceVar(&o.ResourceNamespaces, "resource-namespace", o.ResourceNamespaces, "Namespaces of the items to extract from the backup. Only the namespace-scoped items in the namespaces are extracted by the server and downloaded. Optional.")
 }
 
 func (o *DownloadOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -92,6 +99,11 @@ func (o *DownloadOptions) Validate(c *cobra.Command, args []string, f client.Fac
 		return err
 	}
 
+	// name the default output file after the compression algorithm of the backup tarball
+	if o.defaultOutput {
+		o.Output = strings.TrimSuffix(o.Output, archive.FileExtension(archive.CompressionAlgorithmGzip)) + archive.FileExtension(backup.Spec.CompressionAlgorithm)
+	}
+
 	return nil
 }
 
@@ -108,7 +120,8 @@ func (o *DownloadOptions) Complete(args []string) error {
 		if err != nil {
 			return errors.Wrapf(err, "error getting current directory")
 		}
-		o.Output = filepath.Join(path, fmt.Sprintf("%s-data.tar.gz", o.Name))
+		o.Output = filepath.Join(path, fmt.Sprintf("%s-data%s", o.Name, archive.FileExtension(archive.CompressionAlgorithmGzip)))
+		o.defaultOutput = true
 	}
 
 	return nil
@@ -124,7 +137,13 @@ func (o *DownloadOptions) Run(c *cobra.Command, f client.Factory) error {
 	}
 	defer backupDest.Close()
 
-	err = downloadrequest.Stream(context.Background(), kbClient, f.Namespace(), o.Name, velerov1api.DownloadTargetKindBackupContents, backupDest, o.Timeout, o.InsecureSkipTLSVerify, o.caCertFile)
+	target := velerov1api.DownloadTarget{
+		Kind:               velerov1api.DownloadTargetKindBackupContents,
+		Name:               o.Name,
+		IncludedResources:  o.Resources,
+		IncludedNamespaces: o.ResourceNamespaces,
+	}
+	err = downloadrequest.StreamTarget(context.Background(), kbClient, f.Namespace(), target, backupDest, o.Timeout, o.InsecureSkipTLSVerify, o.caCertFile)
 	if err != nil {
 		os.Remove(o.Output)
 		cmd.CheckError(err)
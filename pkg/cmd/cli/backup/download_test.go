@@ -70,6 +70,8 @@ func TestNewDownloadCommand(t *testing.T) {
 	flags.Parse([]string{"--timeout", timeout})
 	flags.Parse([]string{fmt.Sprintf("--insecure-skip-tls-verify=%s", strconv.FormatBool(insecureSkipTlsVerify))})
 	flags.Parse([]string{"--cacert", cacert})
+	flags.Parse([]string{"--resource", "configmaps,deployments.apps"})
+	flags.Parse([]string{"--resource-namespace", "ns-1"})
 
 	args := []string{backupName, "arg2"}
 
@@ -85,6 +87,8 @@ func TestNewDownloadCommand(t *testing.T) {
 	assert.Equal(t, timeout, o.Timeout.String())
 	assert.Equal(t, insecureSkipTlsVerify, o.InsecureSkipTLSVerify)
 	assert.Equal(t, cacert, o.caCertFile)
+	assert.Equal(t, []string{"configmaps", "deployments.apps"}, o.Resources)
+	assert.Equal(t, []string{"ns-1"}, o.ResourceNamespaces)
 
 	if os.Getenv(cmdtest.CaptureFlag) == "1" {
 		e = c.Execute()
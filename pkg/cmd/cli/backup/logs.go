@@ -17,8 +17,10 @@ limitations under the License.
 package backup
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
@@ -39,8 +41,16 @@ type LogsOptions struct {
 	CaCertFile            string
 	Client                kbclient.Client
 	BackupName            string
+	Follow                bool
 }
 
+// followInterval is how often the new chunks of the log are checked when following the logs of
+// a backup in progress.
+var followInterval = 5 * time.Second
+
+// streamLogs downloads the logs of the backup, it's replaced in the tests.
+var streamLogs = downloadrequest.StreamTarget
+
 func NewLogsOptions() LogsOptions {
 	config, err := client.LoadConfig()
 	if err != nil {
@@ -58,6 +68,7 @@ func (l *LogsOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.DurationVar(&l.Timeout, "timeout", l.Timeout, "How long to wait to receive logs.")
 	flags.BoolVar(&l.InsecureSkipTLSVerify, "insecure-skip-tls-verify", l.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
 	flags.StringVar(&l.CaCertFile, "cacert", l.CaCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+	flags.BoolVarP(&l.Follow, "follow", "f", l.Follow, "Stream the logs of a backup in progress until it's finished processing.")
 }
 
 func (l *LogsOptions) Run(c *cobra.Command, f client.Factory) error {
@@ -72,6 +83,11 @@ func (l *LogsOptions) Run(c *cobra.Command, f client.Factory) error {
 	switch backup.Status.Phase {
 	case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseWaitingForPluginOperations, velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed:
 		// terminal and waiting for plugin operations phases, do nothing.
+	case "", velerov1api.BackupPhaseNew, velerov1api.BackupPhaseInProgress, velerov1api.BackupPhaseFinalizing, velerov1api.BackupPhaseFinalizingPartiallyFailed:
+		if l.Follow {
+			return l.followLogs(context.Background(), f.Namespace(), backup, os.Stdout)
+		}
+		fallthrough
 	default:
 		return fmt.Errorf("logs for backup %q are not available until it's finished processing, please wait "+
 			"until the backup has a phase of Completed or Failed and try again", l.BackupName)
@@ -81,6 +97,65 @@ func (l *LogsOptions) Run(c *cobra.Command, f client.Factory) error {
 	return err
 }
 
+// followLogs prints the chunks of the log uploaded by the server while the backup is in progress,
+// then prints the rest of the log persisted with the backup once it's finished processing.
+func (l *LogsOptions) followLogs(ctx context.Context, namespace string, backup *velerov1api.Backup, w io.Writer) error {
+	var printed int64
+	chunk := 1
+	for {
+		switch backup.Status.Phase {
+		case "", velerov1api.BackupPhaseNew, velerov1api.BackupPhaseInProgress:
+			buf := new(bytes.Buffer)
+			target := velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupLogChunk, Name: backup.Name, Page: chunk}
+			err := streamLogs(ctx, l.Client, namespace, target, buf, l.Timeout, l.InsecureSkipTLSVerify, l.CaCertFile)
+			if err == nil {
+				n, err := w.Write(buf.Bytes())
+				if err != nil {
+					return err
+				}
+				printed += int64(n)
+				chunk++
+				continue
+			}
+			if err != downloadrequest.ErrNotFound {
+				return err
+			}
+		case velerov1api.BackupPhaseFinalizing, velerov1api.BackupPhaseFinalizingPartiallyFailed,
+			velerov1api.BackupPhaseWaitingForPluginOperations, velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed,
+			velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed:
+			// the chunks are the beginning of the persisted log, skip the part already printed
+			target := velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupLog, Name: backup.Name}
+			return streamLogs(ctx, l.Client, namespace, target, &skipWriter{w: w, skip: printed}, l.Timeout, l.InsecureSkipTLSVerify, l.CaCertFile)
+		default:
+			return fmt.Errorf("logs for backup %q are not available in phase %s", backup.Name, backup.Status.Phase)
+		}
+
+		time.Sleep(followInterval)
+		if err := l.Client.Get(ctx, kbclient.ObjectKey{Namespace: namespace, Name: backup.Name}, backup); err != nil {
+			return fmt.Errorf("error checking for backup %q: %v", backup.Name, err)
+		}
+	}
+}
+
+// skipWriter discards the first skip bytes written to it.
+type skipWriter struct {
+	w    io.Writer
+	skip int64
+}
+
+func (s *skipWriter) Write(p []byte) (int, error) {
+	if s.skip >= int64(len(p)) {
+		s.skip -= int64(len(p))
+		return len(p), nil
+	}
+
+	if _, err := s.w.Write(p[s.skip:]); err != nil {
+		return 0, err
+	}
+	s.skip = 0
+	return len(p), nil
+}
+
 func (l *LogsOptions) Complete(args []string, f client.Factory) error {
 	if len(args) > 0 {
 		l.BackupName = args[0]
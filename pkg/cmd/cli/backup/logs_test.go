@@ -17,8 +17,10 @@ limitations under the License.
 package backup
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"strconv"
 	"testing"
 	"time"
@@ -32,6 +34,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
 	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -48,10 +51,12 @@ func TestNewLogsCommand(t *testing.T) {
 		flags.Parse([]string{"--timeout", timeout})
 		flags.Parse([]string{"--insecure-skip-tls-verify", insecureSkipTLSVerify})
 		flags.Parse([]string{"--cacert", caCertFile})
+		flags.Parse([]string{"-f"})
 
 		require.Equal(t, timeout, l.Timeout.String())
 		require.Equal(t, insecureSkipTLSVerify, strconv.FormatBool(l.InsecureSkipTLSVerify))
 		require.Equal(t, caCertFile, l.CaCertFile)
+		require.True(t, l.Follow)
 	})
 
 	t.Run("Backup not complete test", func(t *testing.T) {
@@ -166,3 +171,77 @@ func TestNewLogsCommand(t *testing.T) {
 		require.Equal(t, "test error", err.Error())
 	})
 }
+
+func TestFollowLogs(t *testing.T) {
+	originalStreamLogs, originalFollowInterval := streamLogs, followInterval
+	defer func() {
+		streamLogs, followInterval = originalStreamLogs, originalFollowInterval
+	}()
+	followInterval = time.Millisecond
+
+	tests := []struct {
+		name        string
+		chunks      []string
+		log         string
+		finalPhase  velerov1api.BackupPhase
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:       "the chunks are printed until the backup is finished",
+			chunks:     []string{"line-1\n", "line-2\nline-3\n"},
+			log:        "line-1\nline-2\nline-3\nline-4\n",
+			finalPhase: velerov1api.BackupPhaseCompleted,
+			expected:   "line-1\nline-2\nline-3\nline-4\n",
+		},
+		{
+			name:       "the server doesn't upload the chunks",
+			log:        "line-1\nline-2\n",
+			finalPhase: velerov1api.BackupPhasePartiallyFailed,
+			expected:   "line-1\nline-2\n",
+		},
+		{
+			name:        "the backup fails validation",
+			finalPhase:  velerov1api.BackupPhaseFailedValidation,
+			expectedErr: "logs for backup \"bk-logs-1\" are not available in phase FailedValidation",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kbClient := velerotest.NewFakeControllerRuntimeClient(t)
+			backup := builder.ForBackup(cmdtest.VeleroNameSpace, "bk-logs-1").Phase(velerov1api.BackupPhaseInProgress).Result()
+			require.NoError(t, kbClient.Create(context.Background(), backup))
+
+			// the backup is finished once all the chunks are downloaded
+			streamLogs = func(ctx context.Context, _ kbclient.Client, _ string, target velerov1api.DownloadTarget, w io.Writer, _ time.Duration, _ bool, _ string) error {
+				switch target.Kind {
+				case velerov1api.DownloadTargetKindBackupLogChunk:
+					if target.Page <= len(test.chunks) {
+						_, err := w.Write([]byte(test.chunks[target.Page-1]))
+						return err
+					}
+					updated := backup.DeepCopy()
+					updated.Status.Phase = test.finalPhase
+					require.NoError(t, kbClient.Update(ctx, updated))
+					return downloadrequest.ErrNotFound
+				case velerov1api.DownloadTargetKindBackupLog:
+					_, err := w.Write([]byte(test.log))
+					return err
+				}
+				return fmt.Errorf("unexpected download target %s", target.Kind)
+			}
+
+			l := NewLogsOptions()
+			l.Client = kbClient
+			out := new(bytes.Buffer)
+			err := l.followLogs(context.Background(), cmdtest.VeleroNameSpace, backup.DeepCopy(), out)
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, out.String())
+		})
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+)
+
+// downloadItemOperations downloads the item operations of the backup, it's replaced in the tests.
+var downloadItemOperations = output.DownloadBackupItemOperations
+
+func NewOperationsCommand(f client.Factory) *cobra.Command {
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+	o := NewOperationsOptions()
+	o.CaCertFile = config.CACertFile()
+
+	c := &cobra.Command{
+		Use:   "operations NAME",
+		Short: "List the async item operations of a backup",
+		Long: `List the async item operations started by the backup item action plugins of a backup, e.g. the
+data movements of the CSI snapshots, with their progress.
+
+The operations are read from the backup storage location, the Velero server uploads the latest progress of
+the operations in progress when they're requested.`,
+		Example: `  # List the item operations of the backup "backup-1".
+  velero backup operations backup-1
+
+  # List the item operations of the backup "backup-1" still in progress.
+  velero backup operations backup-1 --phase InProgress`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type OperationsOptions struct {
+	BackupName            string
+	Phases                []string
+	Output                string
+	InsecureSkipTLSVerify bool
+	CaCertFile            string
+
+	client kbclient.Client
+	out    io.Writer
+}
+
+func NewOperationsOptions() *OperationsOptions {
+	return &OperationsOptions{
+		Output: "table",
+		out:    os.Stdout,
+	}
+}
+
+func (o *OperationsOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringSliceVar(&o.Phases, "phase", o.Phases, "Only list the operations in the phases, New, InProgress, Completed and/or Failed.")
+	flags.StringVarP(&o.Output, "output", "o", o.Output, "Output format, table or json.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.CaCertFile, "cacert", o.CaCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+}
+
+func (o *OperationsOptions) Complete(args []string, f client.Factory) error {
+	o.BackupName = args[0]
+
+	client, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.client = client
+
+	return nil
+}
+
+func (o *OperationsOptions) Validate() error {
+	if o.Output != "table" && o.Output != "json" {
+		return errors.Errorf("invalid output format %q, valid values are table and json", o.Output)
+	}
+
+	phases := []string{
+		string(itemoperation.OperationPhaseNew),
+		string(itemoperation.OperationPhaseInProgress),
+		string(itemoperation.OperationPhaseCompleted),
+		string(itemoperation.OperationPhaseFailed),
+	}
+	for _, phase := range o.Phases {
+		if !slices.Contains(phases, phase) {
+			return errors.Errorf("invalid phase %q, valid values are %v", phase, phases)
+		}
+	}
+
+	return nil
+}
+
+func (o *OperationsOptions) Run(f client.Factory) error {
+	backup := new(velerov1api.Backup)
+	err := o.client.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: o.BackupName}, backup)
+	if apierrors.IsNotFound(err) {
+		return errors.Errorf("backup %q does not exist", o.BackupName)
+	} else if err != nil {
+		return errors.Wrapf(err, "error getting backup %q", o.BackupName)
+	}
+
+	if backup.Status.BackupItemOperationsAttempted == 0 {
+		fmt.Fprintf(o.out, "Backup %q has no item operations.\n", o.BackupName)
+		return nil
+	}
+
+	operations, err := downloadItemOperations(context.Background(), o.client, backup, o.InsecureSkipTLSVerify, o.CaCertFile)
+	if err != nil {
+		return errors.Wrapf(err, "error getting the item operations of backup %q", o.BackupName)
+	}
+
+	filtered := make([]*itemoperation.BackupOperation, 0, len(operations))
+	for _, operation := range operations {
+		if len(o.Phases) == 0 || slices.Contains(o.Phases, string(operation.Status.Phase)) {
+			filtered = append(filtered, operation)
+		}
+	}
+
+	if o.Output == "json" {
+		encoder := json.NewEncoder(o.out)
+		encoder.SetIndent("", "    ")
+		return encoder.Encode(filtered)
+	}
+
+	o.printOperations(filtered)
+	return nil
+}
+
+func (o *OperationsOptions) printOperations(operations []*itemoperation.BackupOperation) {
+	w := tabwriter.NewWriter(o.out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "RESOURCE\tPLUGIN\tOPERATION ID\tPHASE\tPROGRESS\tSTARTED\tUPDATED")
+	for _, operation := range operations {
+		resource := operation.Spec.ResourceIdentifier
+		name := resource.Name
+		if resource.Namespace != "" {
+			name = resource.Namespace + "/" + name
+		}
+
+		phase := string(operation.Status.Phase)
+		if operation.Status.Error != "" {
+			phase += ": " + operation.Status.Error
+		}
+
+		progress := output.ItemOperationProgress(operation.Status)
+		if progress == "" {
+			progress = "<none>"
+		}
+
+		fmt.Fprintf(w, "%s %s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			resource.GroupResource, name,
+			operation.Spec.BackupItemAction,
+			operation.Spec.OperationID,
+			phase,
+			progress,
+			formatOperationTime(operation.Status.Started),
+			formatOperationTime(operation.Status.Updated),
+		)
+	}
+	w.Flush()
+}
+
+func formatOperationTime(t *metav1.Time) string {
+	if t == nil {
+		return "<none>"
+	}
+	return t.UTC().Format("2006-01-02T15:04:05Z")
+}
@@ -0,0 +1,147 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestOperations(t *testing.T) {
+	started := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	updated := metav1.NewTime(time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC))
+	operations := []*itemoperation.BackupOperation{
+		{
+			Spec: itemoperation.BackupOperationSpec{
+				BackupItemAction: "velero.io/csi-pvc-backupper",
+				ResourceIdentifier: velero.ResourceIdentifier{
+					GroupResource: schema.GroupResource{Resource: "persistentvolumeclaims"},
+					Namespace:     "ns-1",
+					Name:          "pvc-1",
+				},
+				OperationID: "du-1",
+			},
+			Status: itemoperation.OperationStatus{
+				Phase:          itemoperation.OperationPhaseInProgress,
+				NCompleted:     512 * 1024 * 1024,
+				NTotal:         1024 * 1024 * 1024,
+				OperationUnits: "Bytes",
+				Started:        &started,
+				Updated:        &updated,
+			},
+		},
+		{
+			Spec: itemoperation.BackupOperationSpec{
+				BackupItemAction: "velero.io/csi-pvc-backupper",
+				ResourceIdentifier: velero.ResourceIdentifier{
+					GroupResource: schema.GroupResource{Resource: "persistentvolumeclaims"},
+					Namespace:     "ns-1",
+					Name:          "pvc-2",
+				},
+				OperationID: "du-2",
+			},
+			Status: itemoperation.OperationStatus{
+				Phase: itemoperation.OperationPhaseFailed,
+				Error: "data path failed",
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		backup         *velerov1api.Backup
+		phases         []string
+		output         string
+		expectedOutput string
+	}{
+		{
+			name:   "all operations",
+			backup: builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").ItemOperationsAttempted(2).Result(),
+			output: "table",
+			expectedOutput: `RESOURCE                           PLUGIN                       OPERATION ID  PHASE                     PROGRESS                     STARTED               UPDATED
+persistentvolumeclaims ns-1/pvc-1  velero.io/csi-pvc-backupper  du-1          InProgress                512Mi of 1Gi complete (50%)  2024-01-01T00:00:00Z  2024-01-01T00:05:00Z
+persistentvolumeclaims ns-1/pvc-2  velero.io/csi-pvc-backupper  du-2          Failed: data path failed  <none>                       <none>                <none>
+`,
+		},
+		{
+			name:   "operations filtered by phase",
+			backup: builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").ItemOperationsAttempted(2).Result(),
+			phases: []string{"Failed"},
+			output: "table",
+			expectedOutput: `RESOURCE                           PLUGIN                       OPERATION ID  PHASE                     PROGRESS  STARTED  UPDATED
+persistentvolumeclaims ns-1/pvc-2  velero.io/csi-pvc-backupper  du-2          Failed: data path failed  <none>    <none>   <none>
+`,
+		},
+		{
+			name:           "backup without operations",
+			backup:         builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").Result(),
+			output:         "table",
+			expectedOutput: "Backup \"backup-1\" has no item operations.\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			kbClient := velerotest.NewFakeControllerRuntimeClient(t, tc.backup)
+			f := &factorymocks.Factory{}
+			f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+			f.On("KubebuilderClient").Return(kbClient, nil)
+
+			downloadItemOperations = func(_ context.Context, _ kbclient.Client, backup *velerov1api.Backup, _ bool, _ string) ([]*itemoperation.BackupOperation, error) {
+				assert.Equal(t, "backup-1", backup.Name)
+				return operations, nil
+			}
+
+			out := new(bytes.Buffer)
+			o := NewOperationsOptions()
+			o.Phases = tc.phases
+			o.Output = tc.output
+			o.out = out
+
+			require.NoError(t, o.Complete([]string{"backup-1"}, f))
+			require.NoError(t, o.Validate())
+			require.NoError(t, o.Run(f))
+			assert.Equal(t, tc.expectedOutput, out.String())
+		})
+	}
+}
+
+func TestOperationsValidate(t *testing.T) {
+	o := NewOperationsOptions()
+	o.Output = "yaml"
+	require.EqualError(t, o.Validate(), `invalid output format "yaml", valid values are table and json`)
+
+	o = NewOperationsOptions()
+	o.Phases = []string{"Canceled"}
+	require.ErrorContains(t, o.Validate(), `invalid phase "Canceled"`)
+}
@@ -0,0 +1,307 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/label"
+)
+
+func NewVerifyCommand(f client.Factory) *cobra.Command {
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+	o := NewVerifyOptions()
+	o.caCertFile = config.CACertFile()
+
+	c := &cobra.Command{
+		Use:   "verify NAME",
+		Short: "Verify the integrity of the contents of a backup",
+		Long: `Verify the integrity of the contents of a backup.
+
+The backup contents are downloaded from the backup storage location and the checksums of the files
+in them are compared against the checksums recorded when the backup was taken. The command fails if
+any file is modified or missing.
+
+The snapshots taken by the data mover for the volumes of the backup are then verified by the node-agent
+in the backup repository without restoring them, the results are also recorded in the backup status.
+The directories and the metadata of all the files in the snapshots are verified, the contents of a
+sampled percentage of the files are also downloaded and validated.`,
+		Example: `  # Verify the contents and the data mover snapshots of the backup "backup-1".
+  velero backup verify backup-1
+
+  # Verify the backup "backup-1" and validate the contents of all the files in its data mover snapshots.
+  velero backup verify backup-1 --verify-files-percent 100
+
+  # Verify only the contents of the backup "backup-1".
+  velero backup verify backup-1 --data-mover-snapshots=false`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type VerifyOptions struct {
+	Name                  string
+	Timeout               time.Duration
+	InsecureSkipTLSVerify bool
+	caCertFile            string
+	DataMoverSnapshots    bool
+	VerifyFilesPercent    int
+	DataMoverTimeout      time.Duration
+
+	client       kbclient.Client
+	out          io.Writer
+	pollInterval time.Duration
+	// stream writes the file of the download target to w
+	stream func(ctx context.Context, namespace string, target velerov1api.DownloadTarget, w io.Writer) error
+}
+
+func NewVerifyOptions() *VerifyOptions {
+	return &VerifyOptions{
+		Timeout:            time.Minute,
+		DataMoverSnapshots: true,
+		DataMoverTimeout:   4 * time.Hour,
+		out:                os.Stdout,
+		pollInterval:       5 * time.Second,
+	}
+}
+
+func (o *VerifyOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait to process download request.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.caCertFile, "cacert", o.caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+	flags.BoolVar(&o.DataMoverSnapshots, "data-mover-snapshots", o.DataMoverSnapshots, "Whether to verify the data mover snapshots of the volumes in the backup repository.")
+	flags.IntVar(&o.VerifyFilesPercent, "verify-files-percent", o.VerifyFilesPercent, "Percentage of the files in the data mover snapshots whose contents are downloaded and validated, the metadata of all the files is always validated.")
+	flags.DurationVar(&o.DataMoverTimeout, "data-mover-timeout", o.DataMoverTimeout, "Maximum time to wait for the data mover snapshots to be verified.")
+}
+
+func (o *VerifyOptions) Complete(args []string, f client.Factory) error {
+	o.Name = args[0]
+
+	if o.VerifyFilesPercent < 0 || o.VerifyFilesPercent > 100 {
+		return errors.Errorf("--verify-files-percent must be between 0 and 100")
+	}
+
+	client, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.client = client
+
+	if o.stream == nil {
+		o.stream = func(ctx context.Context, namespace string, target velerov1api.DownloadTarget, w io.Writer) error {
+			return downloadrequest.StreamTarget(ctx, o.client, namespace, target, w, o.Timeout, o.InsecureSkipTLSVerify, o.caCertFile)
+		}
+	}
+
+	return nil
+}
+
+func (o *VerifyOptions) Run(c *cobra.Command, f client.Factory) error {
+	ctx := context.Background()
+
+	backup := new(velerov1api.Backup)
+	if err := o.client.Get(ctx, kbclient.ObjectKey{Namespace: f.Namespace(), Name: o.Name}, backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			return errors.Errorf("backup %q does not exist", o.Name)
+		}
+		return errors.WithStack(err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := o.stream(ctx, f.Namespace(), velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupChecksums, Name: o.Name}, buf); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			if pkgbackup.ChecksumsRecorded(backup.Status.FormatVersion) {
+				return errors.Errorf("backup %q is corrupted or tampered with, its checksums are missing", o.Name)
+			}
+			return errors.Errorf("backup %q doesn't have checksums, it was taken by a Velero version not recording them", o.Name)
+		}
+		return errors.Wrap(err, "error downloading backup checksums")
+	}
+
+	var expected archive.Checksums
+	if err := json.NewDecoder(buf).Decode(&expected); err != nil {
+		return errors.Wrap(err, "error decoding backup checksums")
+	}
+	if expected == nil {
+		return errors.Errorf("backup %q doesn't have checksums", o.Name)
+	}
+
+	// compute the checksums while the backup contents are being downloaded
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(o.stream(ctx, f.Namespace(), velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupContents, Name: o.Name}, pw))
+	}()
+	actual, err := archive.ComputeChecksums(pr)
+	pr.Close()
+	if err != nil {
+		return errors.Wrap(err, "error computing checksums of backup contents")
+	}
+
+	verification := archive.VerifyChecksums(expected, actual)
+	for _, name := range verification.Mismatched {
+		fmt.Fprintf(o.out, "Modified:\t%s\n", name)
+	}
+	for _, name := range verification.Missing {
+		fmt.Fprintf(o.out, "Missing:\t%s\n", name)
+	}
+	for _, name := range verification.Unexpected {
+		fmt.Fprintf(o.out, "Unexpected:\t%s\n", name)
+	}
+
+	if verification.Corrupted() {
+		return errors.Errorf("backup %q is corrupted or tampered with, %d files are modified, %d files are missing and %d files are unexpected",
+			o.Name, len(verification.Mismatched), len(verification.Missing), len(verification.Unexpected))
+	}
+
+	fmt.Fprintf(o.out, "Backup %q is verified, the checksums of %d files match\n", o.Name, len(expected))
+
+	if !o.DataMoverSnapshots {
+		return nil
+	}
+
+	return o.verifyDataMoverSnapshots(ctx, backup)
+}
+
+// verifyDataMoverSnapshots creates a DataVerify for each volume snapshot taken by the data mover for the backup
+// and waits for the node-agent to verify them
+func (o *VerifyOptions) verifyDataMoverSnapshots(ctx context.Context, backup *velerov1api.Backup) error {
+	selector := labels.SelectorFromSet(map[string]string{velerov1api.BackupNameLabel: label.GetValidName(backup.Name)})
+	dataUploads := new(velerov2alpha1api.DataUploadList)
+	if err := o.client.List(ctx, dataUploads, &kbclient.ListOptions{Namespace: backup.Namespace, LabelSelector: selector}); err != nil {
+		return errors.Wrapf(err, "error listing data uploads of backup %q", backup.Name)
+	}
+
+	var dataVerifies []*velerov2alpha1api.DataVerify
+	for _, du := range dataUploads.Items {
+		if du.Status.Phase != velerov2alpha1api.DataUploadPhaseCompleted || du.Status.SnapshotID == "" {
+			continue
+		}
+
+		dv := builder.ForDataVerify(backup.Namespace, fmt.Sprintf("%s-%s", du.Name, utilrand.String(5))).
+			ObjectMeta(
+				builder.WithLabels(velerov1api.BackupNameLabel, label.GetValidName(backup.Name), velerov1api.DataUploadLabel, du.Name),
+			).
+			BackupName(backup.Name).
+			DataUpload(du.Name).
+			SourcePVC(du.Spec.SourcePVC).
+			SourceNamespace(du.Spec.SourceNamespace).
+			BackupStorageLocation(du.Spec.BackupStorageLocation).
+			DataMover(du.Spec.DataMover).
+			SnapshotID(du.Status.SnapshotID).
+			VerifyFilesPercent(o.VerifyFilesPercent).
+			Result()
+		dv.OwnerReferences = []metav1.OwnerReference{
+			{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "Backup",
+				Name:       backup.Name,
+				UID:        backup.UID,
+			},
+		}
+
+		if err := o.client.Create(ctx, dv); err != nil {
+			return errors.Wrapf(err, "error creating data verify for data upload %q", du.Name)
+		}
+		dataVerifies = append(dataVerifies, dv)
+	}
+
+	if len(dataVerifies) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(o.out, "Waiting for the data mover snapshots of %d volumes to be verified...\n", len(dataVerifies))
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, o.DataMoverTimeout)
+	defer cancel()
+
+	err := wait.PollImmediateUntilWithContext(timeoutCtx, o.pollInterval, func(ctx context.Context) (bool, error) {
+		for _, dv := range dataVerifies {
+			if err := o.client.Get(ctx, kbclient.ObjectKeyFromObject(dv), dv); err != nil {
+				return false, errors.Wrapf(err, "error getting data verify %q", dv.Name)
+			}
+			if dv.Status.Phase != velerov2alpha1api.DataVerifyPhaseCompleted && dv.Status.Phase != velerov2alpha1api.DataVerifyPhaseFailed {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return errors.Errorf("timed out waiting for the data mover snapshots of backup %q to be verified", backup.Name)
+	} else if err != nil {
+		return err
+	}
+
+	corrupted, failed := 0, 0
+	for _, dv := range dataVerifies {
+		pvc := fmt.Sprintf("%s/%s", dv.Spec.SourceNamespace, dv.Spec.SourcePVC)
+		switch {
+		case dv.Status.Phase == velerov2alpha1api.DataVerifyPhaseCompleted:
+			fmt.Fprintf(o.out, "Verified:\t%s\n", pvc)
+		case len(dv.Status.Errors) > 0:
+			corrupted++
+			fmt.Fprintf(o.out, "Corrupted:\t%s: %s\n", pvc, dv.Status.Message)
+			for _, e := range dv.Status.Errors {
+				fmt.Fprintf(o.out, "\t%s\n", e)
+			}
+		default:
+			failed++
+			fmt.Fprintf(o.out, "Failed:\t%s: %s\n", pvc, dv.Status.Message)
+		}
+	}
+
+	if corrupted > 0 || failed > 0 {
+		return errors.Errorf("data mover snapshots of backup %q aren't verified, %d snapshots are corrupted and %d snapshots failed to be verified",
+			backup.Name, corrupted, failed)
+	}
+
+	fmt.Fprintf(o.out, "Data mover snapshots of backup %q are verified\n", backup.Name)
+	return nil
+}
@@ -0,0 +1,263 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestVerify(t *testing.T) {
+	tarball := velerotest.NewTarWriter(t).
+		AddItems("pods",
+			builder.ForPod("ns-1", "pod-1").Result(),
+			builder.ForPod("ns-1", "pod-2").Result(),
+		).
+		Done().Bytes()
+	checksums, err := archive.ComputeChecksums(bytes.NewReader(tarball))
+	require.NoError(t, err)
+
+	corrupted := archive.Checksums{}
+	for name, checksum := range checksums {
+		corrupted[name] = checksum
+	}
+	corrupted["resources/pods/namespaces/ns-1/pod-1.json"] = "modified"
+
+	tests := []struct {
+		name        string
+		backupName  string
+		checksums   archive.Checksums
+		expectedOut string
+		expectedErr string
+	}{
+		{
+			name:        "backup contents match the checksums",
+			backupName:  "backup-1",
+			checksums:   checksums,
+			expectedOut: "Backup \"backup-1\" is verified, the checksums of 2 files match\n",
+		},
+		{
+			name:        "backup contents are modified",
+			backupName:  "backup-1",
+			checksums:   corrupted,
+			expectedOut: "Modified:\tresources/pods/namespaces/ns-1/pod-1.json\n",
+			expectedErr: "backup \"backup-1\" is corrupted or tampered with, 1 files are modified, 0 files are missing and 0 files are unexpected",
+		},
+		{
+			name:        "backup without checksums",
+			backupName:  "backup-1",
+			expectedErr: "backup \"backup-1\" doesn't have checksums, it was taken by a Velero version not recording them",
+		},
+		{
+			name:        "backup doesn't exist",
+			backupName:  "backup-2",
+			expectedErr: "backup \"backup-2\" does not exist",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			backup := builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").Phase(velerov1api.BackupPhaseCompleted).Result()
+			kbClient := velerotest.NewFakeControllerRuntimeClient(t, backup)
+
+			f := &factorymocks.Factory{}
+			f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+			f.On("KubebuilderClient").Return(kbClient, nil)
+
+			c := NewVerifyCommand(f)
+			assert.Equal(t, "Verify the integrity of the contents of a backup", c.Short)
+
+			out := new(bytes.Buffer)
+			o := NewVerifyOptions()
+			o.out = out
+			o.stream = func(_ context.Context, _ string, target velerov1api.DownloadTarget, w io.Writer) error {
+				switch target.Kind {
+				case velerov1api.DownloadTargetKindBackupChecksums:
+					if tc.checksums == nil {
+						return downloadrequest.ErrNotFound
+					}
+					return json.NewEncoder(w).Encode(tc.checksums)
+				case velerov1api.DownloadTargetKindBackupContents:
+					_, err := w.Write(tarball)
+					return err
+				default:
+					return errors.Errorf("unexpected download target kind %s", target.Kind)
+				}
+			}
+			require.NoError(t, o.Complete([]string{tc.backupName}, f))
+
+			err := o.Run(c, f)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+			assert.Equal(t, tc.expectedOut, out.String())
+		})
+	}
+}
+
+// verifyingClient completes the DataVerifies once they are created, as the node-agent does
+type verifyingClient struct {
+	kbclient.Client
+	statuses map[string]velerov2alpha1api.DataVerifyStatus
+}
+
+func (c *verifyingClient) Create(ctx context.Context, obj kbclient.Object, opts ...kbclient.CreateOption) error {
+	if dv, ok := obj.(*velerov2alpha1api.DataVerify); ok {
+		dv.Status = c.statuses[dv.Spec.DataUpload]
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func TestVerifyDataMoverSnapshots(t *testing.T) {
+	tarball := velerotest.NewTarWriter(t).
+		AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+		Done().Bytes()
+	checksums, err := archive.ComputeChecksums(bytes.NewReader(tarball))
+	require.NoError(t, err)
+
+	dataUpload := func(name, pvc string, phase velerov2alpha1api.DataUploadPhase) *velerov2alpha1api.DataUpload {
+		return builder.ForDataUpload(cmdtest.VeleroNameSpace, name).
+			Labels(map[string]string{velerov1api.BackupNameLabel: "backup-1"}).
+			BackupStorageLocation("bsl-1").
+			SourcePVC(pvc).
+			SourceNamespace("ns-1").
+			SnapshotID("snapshot-" + name).
+			Phase(phase).
+			Result()
+	}
+
+	tests := []struct {
+		name               string
+		dataMoverSnapshots bool
+		statuses           map[string]velerov2alpha1api.DataVerifyStatus
+		expectedOut        string
+		expectedErr        string
+		expectedVerifies   int
+	}{
+		{
+			name:               "data mover snapshots are verified",
+			dataMoverSnapshots: true,
+			statuses: map[string]velerov2alpha1api.DataVerifyStatus{
+				"du-1": {Phase: velerov2alpha1api.DataVerifyPhaseCompleted},
+			},
+			expectedOut: "Backup \"backup-1\" is verified, the checksums of 1 files match\n" +
+				"Waiting for the data mover snapshots of 1 volumes to be verified...\n" +
+				"Verified:\tns-1/pvc-1\n" +
+				"Data mover snapshots of backup \"backup-1\" are verified\n",
+			expectedVerifies: 1,
+		},
+		{
+			name:               "data mover snapshot is corrupted",
+			dataMoverSnapshots: true,
+			statuses: map[string]velerov2alpha1api.DataVerifyStatus{
+				"du-1": {Phase: velerov2alpha1api.DataVerifyPhaseFailed, Message: "snapshot is corrupted, 1 entries failed to be verified", Errors: []string{"file-1: error reading file: invalid checksum"}},
+			},
+			expectedOut: "Backup \"backup-1\" is verified, the checksums of 1 files match\n" +
+				"Waiting for the data mover snapshots of 1 volumes to be verified...\n" +
+				"Corrupted:\tns-1/pvc-1: snapshot is corrupted, 1 entries failed to be verified\n" +
+				"\tfile-1: error reading file: invalid checksum\n",
+			expectedErr:      "data mover snapshots of backup \"backup-1\" aren't verified, 1 snapshots are corrupted and 0 snapshots failed to be verified",
+			expectedVerifies: 1,
+		},
+		{
+			name:               "data mover snapshot fails to be verified",
+			dataMoverSnapshots: true,
+			statuses: map[string]velerov2alpha1api.DataVerifyStatus{
+				"du-1": {Phase: velerov2alpha1api.DataVerifyPhaseFailed, Message: "error to initialize data path"},
+			},
+			expectedOut: "Backup \"backup-1\" is verified, the checksums of 1 files match\n" +
+				"Waiting for the data mover snapshots of 1 volumes to be verified...\n" +
+				"Failed:\tns-1/pvc-1: error to initialize data path\n",
+			expectedErr:      "data mover snapshots of backup \"backup-1\" aren't verified, 0 snapshots are corrupted and 1 snapshots failed to be verified",
+			expectedVerifies: 1,
+		},
+		{
+			name:        "data mover snapshots are skipped",
+			expectedOut: "Backup \"backup-1\" is verified, the checksums of 1 files match\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			backup := builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").Phase(velerov1api.BackupPhaseCompleted).Result()
+			kbClient := &verifyingClient{
+				Client: velerotest.NewFakeControllerRuntimeClient(t, backup,
+					dataUpload("du-1", "pvc-1", velerov2alpha1api.DataUploadPhaseCompleted),
+					dataUpload("du-2", "pvc-2", velerov2alpha1api.DataUploadPhaseFailed),
+				),
+				statuses: tc.statuses,
+			}
+
+			f := &factorymocks.Factory{}
+			f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+			f.On("KubebuilderClient").Return(kbClient, nil)
+
+			out := new(bytes.Buffer)
+			o := NewVerifyOptions()
+			o.out = out
+			o.pollInterval = time.Millisecond
+			o.DataMoverSnapshots = tc.dataMoverSnapshots
+			o.stream = func(_ context.Context, _ string, target velerov1api.DownloadTarget, w io.Writer) error {
+				if target.Kind == velerov1api.DownloadTargetKindBackupChecksums {
+					return json.NewEncoder(w).Encode(checksums)
+				}
+				_, err := w.Write(tarball)
+				return err
+			}
+			require.NoError(t, o.Complete([]string{"backup-1"}, f))
+
+			err := o.Run(NewVerifyCommand(f), f)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+			assert.Equal(t, tc.expectedOut, out.String())
+
+			dataVerifies := new(velerov2alpha1api.DataVerifyList)
+			require.NoError(t, kbClient.List(context.Background(), dataVerifies))
+			require.Len(t, dataVerifies.Items, tc.expectedVerifies)
+			for _, dv := range dataVerifies.Items {
+				assert.Equal(t, "backup-1", dv.Spec.BackupName)
+				assert.Equal(t, "du-1", dv.Spec.DataUpload)
+				assert.Equal(t, "snapshot-du-1", dv.Spec.SnapshotID)
+				assert.Equal(t, "bsl-1", dv.Spec.BackupStorageLocation)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/label"
+)
+
+func NewWatchCommand(f client.Factory) *cobra.Command {
+	o := NewWatchOptions()
+
+	c := &cobra.Command{
+		Use:   "watch BACKUP",
+		Short: "Watch the progress of a backup",
+		Long: `Watch the progress of a backup until it's finished processing.
+
+The number of items backed up and the number of bytes uploaded by each pod volume backup and data upload
+are printed whenever they change.`,
+		Example: `  # Watch the progress of the backup "backup-1".
+  velero backup watch backup-1`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type WatchOptions struct {
+	Interval   time.Duration
+	BackupName string
+
+	client kbclient.Client
+	out    io.Writer
+}
+
+func NewWatchOptions() *WatchOptions {
+	return &WatchOptions{
+		Interval: time.Second,
+		out:      os.Stdout,
+	}
+}
+
+func (o *WatchOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.DurationVar(&o.Interval, "interval", o.Interval, "How often to check the progress of the backup.")
+}
+
+func (o *WatchOptions) Complete(args []string, f client.Factory) error {
+	o.BackupName = args[0]
+
+	client, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.client = client
+
+	return nil
+}
+
+func (o *WatchOptions) Run(c *cobra.Command, f client.Factory) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		watchErr error
+		printed  = make(map[string]string)
+	)
+	wait.Until(func() {
+		progress, finished, err := o.getProgress(ctx, f.Namespace())
+		if err != nil {
+			watchErr = err
+			cancel()
+			return
+		}
+
+		for _, p := range progress {
+			if printed[p.name] == p.status {
+				continue
+			}
+			printed[p.name] = p.status
+			fmt.Fprintf(o.out, "%s\t%s: %s\n", time.Now().Format(time.TimeOnly), p.name, p.status)
+		}
+
+		if finished {
+			cancel()
+		}
+	}, o.Interval, ctx.Done())
+
+	return watchErr
+}
+
+// progress is the progress of the backup or one of its volumes.
+type progress struct {
+	name   string
+	status string
+}
+
+// getProgress returns the progress of the backup followed by the progress of its pod volume backups and
+// data uploads, and whether the backup is finished processing.
+func (o *WatchOptions) getProgress(ctx context.Context, namespace string) ([]progress, bool, error) {
+	backup := new(velerov1api.Backup)
+	if err := o.client.Get(ctx, kbclient.ObjectKey{Namespace: namespace, Name: o.BackupName}, backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, false, errors.Errorf("backup %q does not exist", o.BackupName)
+		}
+		return nil, false, errors.Wrapf(err, "error getting backup %q", o.BackupName)
+	}
+
+	selector := labels.SelectorFromSet(map[string]string{velerov1api.BackupNameLabel: label.GetValidName(backup.Name)})
+
+	podVolumeBackups := new(velerov1api.PodVolumeBackupList)
+	if err := o.client.List(ctx, podVolumeBackups, &kbclient.ListOptions{Namespace: namespace, LabelSelector: selector}); err != nil {
+		return nil, false, errors.Wrapf(err, "error listing pod volume backups of backup %q", backup.Name)
+	}
+
+	dataUploads := new(velerov2alpha1api.DataUploadList)
+	if err := o.client.List(ctx, dataUploads, &kbclient.ListOptions{Namespace: namespace, LabelSelector: selector}); err != nil {
+		return nil, false, errors.Wrapf(err, "error listing data uploads of backup %q", backup.Name)
+	}
+
+	phase := backup.Status.Phase
+	if phase == "" {
+		phase = velerov1api.BackupPhaseNew
+	}
+	status := string(phase)
+	if backup.Status.Progress != nil {
+		status = fmt.Sprintf("%s, %d of %d items backed up", phase, backup.Status.Progress.ItemsBackedUp, backup.Status.Progress.TotalItems)
+	}
+	result := []progress{{name: "backup " + backup.Name, status: status}}
+
+	var volumes []progress
+	for _, pvb := range podVolumeBackups.Items {
+		volumes = append(volumes, progress{
+			name:   fmt.Sprintf("pod volume backup %s/%s/%s", pvb.Spec.Pod.Namespace, pvb.Spec.Pod.Name, pvb.Spec.Volume),
+			status: volumeStatus(string(pvb.Status.Phase), pvb.Status.Progress),
+		})
+	}
+	for _, du := range dataUploads.Items {
+		volumes = append(volumes, progress{
+			name:   fmt.Sprintf("data upload %s/%s", du.Spec.SourceNamespace, du.Spec.SourcePVC),
+			status: volumeStatus(string(du.Status.Phase), du.Status.Progress),
+		})
+	}
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].name < volumes[j].name
+	})
+	result = append(result, volumes...)
+
+	switch phase {
+	case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed,
+		velerov1api.BackupPhaseFailedValidation, velerov1api.BackupPhaseDryRunCompleted:
+		return result, true, nil
+	}
+	return result, false, nil
+}
+
+func volumeStatus(phase string, progress shared.DataMoveOperationProgress) string {
+	if phase == "" {
+		phase = "New"
+	}
+	if progress.TotalBytes == 0 {
+		return phase
+	}
+	return fmt.Sprintf("%s, %d of %d bytes uploaded (%.2f%%)", phase, progress.BytesDone, progress.TotalBytes,
+		float64(progress.BytesDone)/float64(progress.TotalBytes)*100)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestWatch(t *testing.T) {
+	backup := builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").Phase(velerov1api.BackupPhaseInProgress).Result()
+	backup.Status.Progress = &velerov1api.BackupProgress{TotalItems: 10, ItemsBackedUp: 4}
+
+	pvb := builder.ForPodVolumeBackup(cmdtest.VeleroNameSpace, "pvb-1").
+		ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")).
+		PodNamespace("ns-1").PodName("pod-1").Volume("data").
+		Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result()
+	pvb.Status.Progress = shared.DataMoveOperationProgress{TotalBytes: 200, BytesDone: 50}
+
+	du := builder.ForDataUpload(cmdtest.VeleroNameSpace, "du-1").
+		Labels(map[string]string{velerov1api.BackupNameLabel: "backup-1"}).
+		SourceNamespace("ns-1").SourcePVC("pvc-1").
+		Phase(velerov2alpha1api.DataUploadPhaseAccepted).Result()
+
+	otherPVB := builder.ForPodVolumeBackup(cmdtest.VeleroNameSpace, "pvb-2").
+		ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-2")).
+		PodNamespace("ns-1").PodName("pod-2").Volume("data").Result()
+
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t, backup, pvb, du, otherPVB)
+
+	f := &factorymocks.Factory{}
+	f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+	f.On("KubebuilderClient").Return(kbClient, nil)
+
+	c := NewWatchCommand(f)
+	assert.Equal(t, "Watch the progress of a backup", c.Short)
+
+	out := new(bytes.Buffer)
+	o := NewWatchOptions()
+	o.Interval = 10 * time.Millisecond
+	o.out = out
+	require.NoError(t, o.Complete([]string{"backup-1"}, f))
+
+	result, finished, err := o.getProgress(context.Background(), cmdtest.VeleroNameSpace)
+	require.NoError(t, err)
+	assert.False(t, finished)
+	assert.Equal(t, []progress{
+		{name: "backup backup-1", status: "InProgress, 4 of 10 items backed up"},
+		{name: "data upload ns-1/pvc-1", status: "Accepted"},
+		{name: "pod volume backup ns-1/pod-1/data", status: "InProgress, 50 of 200 bytes uploaded (25.00%)"},
+	}, result)
+
+	backup.Status.Phase = velerov1api.BackupPhaseCompleted
+	backup.Status.Progress.ItemsBackedUp = 10
+	require.NoError(t, kbClient.Update(context.Background(), backup))
+
+	require.NoError(t, o.Run(c, f))
+	assert.Contains(t, out.String(), "backup backup-1: Completed, 10 of 10 items backed up\n")
+	assert.Contains(t, out.String(), "pod volume backup ns-1/pod-1/data: InProgress, 50 of 200 bytes uploaded (25.00%)\n")
+	assert.NotContains(t, out.String(), "pod-2")
+
+	o.BackupName = "not-exist"
+	assert.EqualError(t, o.Run(c, f), `backup "not-exist" does not exist`)
+}
@@ -72,6 +72,11 @@ type CreateOptions struct {
 	Labels                                flag.Map
 	CACertFile                            string
 	AccessMode                            *flag.Enum
+	ObjectLockMode                        string
+	ObjectLockRetentionPeriod             time.Duration
+	EncryptionKeySecret                   string
+	EncryptionKeyID                       string
+	ExpirationMode                        *flag.Enum
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -83,6 +88,12 @@ func NewCreateOptions() *CreateOptions {
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadOnly),
+			string(velerov1api.BackupStorageLocationAccessModeImport),
+		),
+		ExpirationMode: flag.NewEnum(
+			"",
+			string(velerov1api.ObjectStorageExpirationModeVelero),
+			string(velerov1api.ObjectStorageExpirationModeLifecycle),
 		),
 	}
 }
@@ -103,6 +114,15 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 		"access-mode",
 		fmt.Sprintf("Access mode for the backup storage location. Valid values are %s", strings.Join(o.AccessMode.AllowedValues(), ",")),
 	)
+	flags.StringVar(&o.ObjectLockMode, "object-lock-mode", o.ObjectLockMode, "Default object lock retention mode of the bucket, the deletion of the backups is deferred until their objects are unlocked. Valid values are GOVERNANCE,COMPLIANCE. Optional, the bucket must have object lock enabled with the same default retention.")
+	flags.DurationVar(&o.ObjectLockRetentionPeriod, "object-lock-retention-period", o.ObjectLockRetentionPeriod, "Default object lock retention period of the bucket. Required if --object-lock-mode is specified.")
+	flags.StringVar(&o.EncryptionKeySecret, "encryption-key-secret", o.EncryptionKeySecret, "Name of the secret in the Velero namespace holding the keys to encrypt the backup data. Optional.")
+	flags.StringVar(&o.EncryptionKeyID, "encryption-key-id", o.EncryptionKeyID, "ID of the key in the encryption key secret to encrypt the backup data. Required if --encryption-key-secret is specified.")
+	flags.Var(
+		o.ExpirationMode,
+		"expiration-mode",
+		fmt.Sprintf("How the data of the expired backups is removed from the bucket. Valid values are %s. With Lifecycle, the objects are tagged with the expiration time of their backups and removed by the lifecycle policies of the bucket. Optional.", strings.Join(o.ExpirationMode.AllowedValues(), ",")),
+	)
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -126,6 +146,22 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if o.ObjectLockMode != "" {
+		if o.ObjectLockMode != string(velerov1api.ObjectLockModeGovernance) && o.ObjectLockMode != string(velerov1api.ObjectLockModeCompliance) {
+			return errors.Errorf("invalid --object-lock-mode %s, valid values are %s,%s", o.ObjectLockMode, velerov1api.ObjectLockModeGovernance, velerov1api.ObjectLockModeCompliance)
+		}
+
+		if o.ObjectLockRetentionPeriod <= 0 {
+			return errors.New("--object-lock-retention-period must be positive when --object-lock-mode is specified")
+		}
+	} else if o.ObjectLockRetentionPeriod != 0 {
+		return errors.New("--object-lock-retention-period can only be specified with --object-lock-mode")
+	}
+
+	if (o.EncryptionKeySecret == "") != (o.EncryptionKeyID == "") {
+		return errors.New("--encryption-key-secret and --encryption-key-id must be specified together")
+	}
+
 	return nil
 }
 
@@ -176,6 +212,22 @@ func (o *CreateOptions) BuildBackupStorageLocation(namespace string, setBackupSy
 		backupStorageLocation.Spec.ValidationFrequency = &metav1.Duration{Duration: o.ValidationFrequency}
 	}
 
+	if o.ObjectLockMode != "" {
+		backupStorageLocation.Spec.ObjectStorage.ObjectLock = &velerov1api.ObjectLock{
+			Mode:            velerov1api.ObjectLockMode(o.ObjectLockMode),
+			RetentionPeriod: metav1.Duration{Duration: o.ObjectLockRetentionPeriod},
+		}
+	}
+
+	if o.EncryptionKeySecret != "" {
+		backupStorageLocation.Spec.ObjectStorage.Encryption = &velerov1api.ObjectStorageEncryption{
+			KeySecret: o.EncryptionKeySecret,
+			KeyID:     o.EncryptionKeyID,
+		}
+	}
+
+	backupStorageLocation.Spec.ObjectStorage.ExpirationMode = velerov1api.ObjectStorageExpirationMode(o.ExpirationMode.String())
+
 	for secretName, secretKey := range o.Credential.Data() {
 		backupStorageLocation.Spec.Credential = builder.ForSecretKeySelector(secretName, secretKey).Result()
 		break
@@ -29,6 +29,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
 	veleroflag "github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -97,6 +98,57 @@ func TestBuildBackupStorageLocationSetsLabels(t *testing.T) {
 	assert.Equal(t, map[string]string{"key": "value"}, bsl.Labels)
 }
 
+func TestBuildBackupStorageLocationSetsObjectLock(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.ObjectStorage.ObjectLock)
+
+	o.ObjectLockMode = string(velerov1api.ObjectLockModeGovernance)
+	o.ObjectLockRetentionPeriod = 24 * time.Hour
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.ObjectLock{
+		Mode:            velerov1api.ObjectLockModeGovernance,
+		RetentionPeriod: metav1.Duration{Duration: 24 * time.Hour},
+	}, bsl.Spec.ObjectStorage.ObjectLock)
+}
+
+func TestBuildBackupStorageLocationSetsEncryption(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.ObjectStorage.Encryption)
+
+	o.EncryptionKeySecret = "velero-encryption"
+	o.EncryptionKeyID = "key-1"
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.ObjectStorageEncryption{
+		KeySecret: "velero-encryption",
+		KeyID:     "key-1",
+	}, bsl.Spec.ObjectStorage.Encryption)
+}
+
+func TestBuildBackupStorageLocationSetsExpirationMode(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Empty(t, bsl.Spec.ObjectStorage.ExpirationMode)
+
+	assert.Error(t, o.ExpirationMode.Set("Unknown"))
+	assert.NoError(t, o.ExpirationMode.Set("Lifecycle"))
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, velerov1api.ObjectStorageExpirationModeLifecycle, bsl.Spec.ObjectStorage.ExpirationMode)
+}
+
 func TestCreateCommand_Run(t *testing.T) {
 	// create a factory
 	f := &factorymocks.Factory{}
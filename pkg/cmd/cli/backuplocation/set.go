@@ -21,10 +21,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -59,11 +62,21 @@ type SetOptions struct {
 	CACertFile                   string
 	Credential                   flag.Map
 	DefaultBackupStorageLocation bool
+	EncryptionKeySecret          string
+	EncryptionKeyID              string
+	ExpirationMode               *flag.Enum
+	ObjectLockMode               string
+	ObjectLockRetentionPeriod    time.Duration
 }
 
 func NewSetOptions() *SetOptions {
 	return &SetOptions{
 		Credential: flag.NewMap(),
+		ExpirationMode: flag.NewEnum(
+			"",
+			string(velerov1api.ObjectStorageExpirationModeVelero),
+			string(velerov1api.ObjectStorageExpirationModeLifecycle),
+		),
 	}
 }
 
@@ -71,6 +84,15 @@ func (o *SetOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "File containing a certificate bundle to use when verifying TLS connections to the object store. Optional.")
 	flags.Var(&o.Credential, "credential", "Sets the credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
 	flags.BoolVar(&o.DefaultBackupStorageLocation, "default", o.DefaultBackupStorageLocation, "Sets this new location to be the new default backup storage location. Optional.")
+	flags.StringVar(&o.EncryptionKeySecret, "encryption-key-secret", o.EncryptionKeySecret, "Sets the name of the secret in the Velero namespace holding the keys to encrypt the backup data. Optional.")
+	flags.StringVar(&o.EncryptionKeyID, "encryption-key-id", o.EncryptionKeyID, "Sets the ID of the key in the encryption key secret to encrypt new backup data, set it to a new key to rotate the encryption key. Optional.")
+	flags.Var(
+		o.ExpirationMode,
+		"expiration-mode",
+		fmt.Sprintf("Sets how the data of the expired backups is removed from the bucket. Valid values are %s. Optional.", strings.Join(o.ExpirationMode.AllowedValues(), ",")),
+	)
+	flags.StringVar(&o.ObjectLockMode, "object-lock-mode", o.ObjectLockMode, "Sets the default object lock retention mode of the bucket. Valid values are GOVERNANCE,COMPLIANCE. Optional.")
+	flags.DurationVar(&o.ObjectLockRetentionPeriod, "object-lock-retention-period", o.ObjectLockRetentionPeriod, "Sets the default object lock retention period of the bucket. Optional.")
 }
 
 func (o *SetOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -78,6 +100,14 @@ func (o *SetOptions) Validate(c *cobra.Command, args []string, f client.Factory)
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if o.ObjectLockMode != "" && o.ObjectLockMode != string(velerov1api.ObjectLockModeGovernance) && o.ObjectLockMode != string(velerov1api.ObjectLockModeCompliance) {
+		return errors.Errorf("invalid --object-lock-mode %s, valid values are %s,%s", o.ObjectLockMode, velerov1api.ObjectLockModeGovernance, velerov1api.ObjectLockModeCompliance)
+	}
+
+	if o.ObjectLockRetentionPeriod < 0 {
+		return errors.New("--object-lock-retention-period must be positive")
+	}
+
 	return nil
 }
 
@@ -144,6 +174,44 @@ func (o *SetOptions) Run(c *cobra.Command, f client.Factory) error {
 		break
 	}
 
+	if o.EncryptionKeySecret != "" || o.EncryptionKeyID != "" {
+		encryption := location.Spec.StorageType.ObjectStorage.Encryption
+		if encryption == nil {
+			encryption = new(velerov1api.ObjectStorageEncryption)
+		}
+		if o.EncryptionKeySecret != "" {
+			encryption.KeySecret = o.EncryptionKeySecret
+		}
+		if o.EncryptionKeyID != "" {
+			encryption.KeyID = o.EncryptionKeyID
+		}
+		if encryption.KeySecret == "" || encryption.KeyID == "" {
+			return errors.New("both --encryption-key-secret and --encryption-key-id are required to enable the encryption")
+		}
+		location.Spec.StorageType.ObjectStorage.Encryption = encryption
+	}
+
+	if o.ObjectLockMode != "" || o.ObjectLockRetentionPeriod != 0 {
+		objectLock := location.Spec.StorageType.ObjectStorage.ObjectLock
+		if objectLock == nil {
+			objectLock = new(velerov1api.ObjectLock)
+		}
+		if o.ObjectLockMode != "" {
+			objectLock.Mode = velerov1api.ObjectLockMode(o.ObjectLockMode)
+		}
+		if o.ObjectLockRetentionPeriod != 0 {
+			objectLock.RetentionPeriod = metav1.Duration{Duration: o.ObjectLockRetentionPeriod}
+		}
+		if objectLock.Mode == "" || objectLock.RetentionPeriod.Duration == 0 {
+			return errors.New("both --object-lock-mode and --object-lock-retention-period are required to enable the object lock")
+		}
+		location.Spec.StorageType.ObjectStorage.ObjectLock = objectLock
+	}
+
+	if o.ExpirationMode.String() != "" {
+		location.Spec.StorageType.ObjectStorage.ExpirationMode = velerov1api.ObjectStorageExpirationMode(o.ExpirationMode.String())
+	}
+
 	if err := kbClient.Update(context.Background(), location, &kbclient.UpdateOptions{}); err != nil {
 		return errors.WithStack(err)
 	}
@@ -17,16 +17,23 @@ limitations under the License.
 package backuplocation
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
 	"reflect"
 	"testing"
+	"time"
 
 	flag "github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
 	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
 	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
 	veleroflag "github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
@@ -79,6 +86,72 @@ func TestNewSetCommand(t *testing.T) {
 	assert.Contains(t, e.Error(), fmt.Sprintf("%s: no such file or directory", cacert))
 }
 
+func TestSetObjectLock(t *testing.T) {
+	tests := []struct {
+		name        string
+		objectLock  *velerov1api.ObjectLock
+		flags       []string
+		expected    *velerov1api.ObjectLock
+		expectedErr string
+	}{
+		{
+			name:     "enable the object lock",
+			flags:    []string{"--object-lock-mode", "COMPLIANCE", "--object-lock-retention-period", "24h"},
+			expected: &velerov1api.ObjectLock{Mode: velerov1api.ObjectLockModeCompliance, RetentionPeriod: metav1.Duration{Duration: 24 * time.Hour}},
+		},
+		{
+			name:        "enable the object lock without the retention period",
+			flags:       []string{"--object-lock-mode", "COMPLIANCE"},
+			expectedErr: "both --object-lock-mode and --object-lock-retention-period are required to enable the object lock",
+		},
+		{
+			name:       "change the retention period of the object lock",
+			objectLock: &velerov1api.ObjectLock{Mode: velerov1api.ObjectLockModeGovernance, RetentionPeriod: metav1.Duration{Duration: 24 * time.Hour}},
+			flags:      []string{"--object-lock-retention-period", "48h"},
+			expected:   &velerov1api.ObjectLock{Mode: velerov1api.ObjectLockModeGovernance, RetentionPeriod: metav1.Duration{Duration: 48 * time.Hour}},
+		},
+		{
+			name:        "invalid object lock mode",
+			flags:       []string{"--object-lock-mode", "invalid"},
+			expectedErr: "invalid --object-lock-mode invalid, valid values are GOVERNANCE,COMPLIANCE",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			location := builder.ForBackupStorageLocation("velero", "bsl-1").Provider("aws").Bucket("bucket").Result()
+			location.Spec.ObjectStorage.ObjectLock = tc.objectLock
+			kbClient := velerotest.NewFakeControllerRuntimeClient(t, location)
+
+			f := &factorymocks.Factory{}
+			f.On("Namespace").Return("velero")
+			f.On("KubebuilderClient").Return(kbClient, nil)
+
+			c := NewSetCommand(f, "")
+			o := NewSetOptions()
+			flags := new(flag.FlagSet)
+			o.BindFlags(flags)
+			require.NoError(t, flags.Parse(tc.flags))
+
+			args := []string{"bsl-1"}
+			require.NoError(t, o.Complete(args, f))
+			err := o.Validate(c, args, f)
+			if err == nil {
+				err = o.Run(c, f)
+			}
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			updated := &velerov1api.BackupStorageLocation{}
+			require.NoError(t, kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: "velero", Name: "bsl-1"}, updated))
+			assert.Equal(t, tc.expected, updated.Spec.ObjectStorage.ObjectLock)
+		})
+	}
+}
+
 func TestSetCommand_Execute(t *testing.T) {
 	bsl := "bsl-1"
 	if os.Getenv(cmdtest.CaptureFlag) == "1" {
@@ -0,0 +1,217 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewProfileCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "profile",
+		Short: "Manage client configuration profiles",
+		Long: `Manage client configuration profiles. A profile stores the namespace, the kubecontext and the
+CA certificate file of a cluster, the settings of the profile in use apply to all the commands unless
+they are overridden by the flags.`,
+	}
+
+	c.AddCommand(
+		NewProfileAddCommand(),
+		NewProfileUseCommand(),
+		NewProfileGetCommand(),
+		NewProfileDeleteCommand(),
+	)
+
+	return c
+}
+
+func NewProfileAddCommand() *cobra.Command {
+	var use bool
+
+	c := &cobra.Command{
+		Use:   "add NAME [KEY=VALUE]...",
+		Short: "Add or update a client configuration profile",
+		Long: `Add or update a client configuration profile. The valid keys are namespace, kubecontext and cacert,
+a key with an empty value is removed from an existing profile.`,
+		Example: `  velero client config profile add prod namespace=velero kubecontext=prod-cluster --use`,
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			config, err := client.LoadConfig()
+			cmd.CheckError(err)
+
+			profiles, err := config.Profiles()
+			cmd.CheckError(err)
+
+			name := args[0]
+			profile := profiles[name]
+			for _, arg := range args[1:] {
+				cmd.CheckError(setProfileValue(&profile, arg))
+			}
+			profiles[name] = profile
+			config.SetProfiles(profiles)
+
+			if use {
+				config.UseProfile(name)
+			}
+
+			cmd.CheckError(client.SaveConfig(config))
+			fmt.Printf("Profile %q is saved.\n", name)
+		},
+	}
+
+	c.Flags().BoolVar(&use, "use", use, "Use the profile after it's saved")
+
+	return c
+}
+
+func setProfileValue(profile *client.Profile, arg string) error {
+	key, value, found := strings.Cut(arg, "=")
+	if !found {
+		return errors.Errorf("invalid KEY=VALUE: %q", arg)
+	}
+
+	switch key {
+	case client.ConfigKeyNamespace:
+		profile.Namespace = value
+	case client.ConfigKeyKubeContext:
+		profile.KubeContext = value
+	case client.ConfigKeyCACert:
+		profile.CACert = value
+	default:
+		return errors.Errorf("invalid key %q, valid keys are %s, %s, %s", key, client.ConfigKeyNamespace, client.ConfigKeyKubeContext, client.ConfigKeyCACert)
+	}
+
+	return nil
+}
+
+func NewProfileUseCommand() *cobra.Command {
+	var none bool
+
+	c := &cobra.Command{
+		Use:   "use NAME",
+		Short: "Use a client configuration profile",
+		Args: func(c *cobra.Command, args []string) error {
+			if none {
+				return cobra.NoArgs(c, args)
+			}
+			return cobra.ExactArgs(1)(c, args)
+		},
+		Run: func(c *cobra.Command, args []string) {
+			config, err := client.LoadConfig()
+			cmd.CheckError(err)
+
+			if none {
+				config.UseProfile("")
+				cmd.CheckError(client.SaveConfig(config))
+				fmt.Println("No profile is in use.")
+				return
+			}
+
+			profiles, err := config.Profiles()
+			cmd.CheckError(err)
+
+			name := args[0]
+			if _, found := profiles[name]; !found {
+				cmd.CheckError(errors.Errorf("profile %q is not found", name))
+			}
+
+			config.UseProfile(name)
+			cmd.CheckError(client.SaveConfig(config))
+			fmt.Printf("Profile %q is in use.\n", name)
+		},
+	}
+
+	c.Flags().BoolVar(&none, "none", none, "Stop using profiles")
+
+	return c
+}
+
+func NewProfileGetCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "get [NAME]...",
+		Short: "Get client configuration profiles",
+		Run: func(c *cobra.Command, args []string) {
+			config, err := client.LoadConfig()
+			cmd.CheckError(err)
+
+			profiles, err := config.Profiles()
+			cmd.CheckError(err)
+
+			names := args
+			if len(names) == 0 {
+				for name := range profiles {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+			}
+
+			for _, name := range names {
+				profile, found := profiles[name]
+				if !found {
+					fmt.Printf("%s: <NOT FOUND>\n", name)
+					continue
+				}
+
+				current := ""
+				if name == config.CurrentProfile() {
+					current = " (current)"
+				}
+				fmt.Printf("%s%s:\n", name, current)
+				fmt.Printf("  %s: %s\n", client.ConfigKeyNamespace, profile.Namespace)
+				fmt.Printf("  %s: %s\n", client.ConfigKeyKubeContext, profile.KubeContext)
+				fmt.Printf("  %s: %s\n", client.ConfigKeyCACert, profile.CACert)
+			}
+		},
+	}
+
+	return c
+}
+
+func NewProfileDeleteCommand() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "delete NAME [NAME]...",
+		Short: "Delete client configuration profiles",
+		Args:  cobra.MinimumNArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			config, err := client.LoadConfig()
+			cmd.CheckError(err)
+
+			profiles, err := config.Profiles()
+			cmd.CheckError(err)
+
+			for _, name := range args {
+				delete(profiles, name)
+				if name == config.CurrentProfile() {
+					config.UseProfile("")
+				}
+			}
+			config.SetProfiles(profiles)
+
+			cmd.CheckError(client.SaveConfig(config))
+		},
+	}
+
+	return c
+}
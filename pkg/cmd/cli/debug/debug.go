@@ -43,6 +43,9 @@ import (
 //go:embed cshd-scripts/velero.cshd
 var scriptBytes []byte
 
+// crashdWorkdir is the workdir of crashd set by the script, relative to the base dir
+const crashdWorkdir = "velero-bundle"
+
 type option struct {
 	// currCmd the velero command
 	currCmd string
@@ -62,12 +65,18 @@ type option struct {
 	restore string
 	// optional, it controls whether to print the debug log messages when calling crashd
 	verbose bool
+	// optional, it controls whether to collect the debug info and the pprof profiles of the node-agent pods
+	nodeAgent bool
+	// optional, the duration of the CPU profiles of the node-agent pods, no CPU profile is collected if it's 0
+	nodeAgentCPUProfileDuration time.Duration
 }
 
 func (o *option) bindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.outputPath, "output", "", "The path of the bundle tarball, by default it's ./bundle-<YYYY>-<MM>-<DD>-<HH>-<MM>-<SS>.tar.gz. Optional")
 	flags.StringVar(&o.backup, "backup", "", "The name of the backup resource whose log will be collected, no backup logs will be collected if it's not set. Optional")
 	flags.StringVar(&o.restore, "restore", "", "The name of the restore resource whose log will be collected, no restore logs will be collected if it's not set. Optional")
+	flags.BoolVar(&o.nodeAgent, "node-agent", true, "When it's set to true the debug info, the goroutine dumps and the pprof profiles of the node-agent pods will be collected. Default value is true.")
+	flags.DurationVar(&o.nodeAgentCPUProfileDuration, "node-agent-cpu-profile-duration", 10*time.Second, "The duration of the CPU profiles of the node-agent pods, no CPU profile will be collected if it's 0. Optional")
 	flags.BoolVar(&o.verbose, "verbose", false, "When it's set to true the debug messages by crashd will be printed during execution.  Default value is false.")
 }
 
@@ -152,7 +161,8 @@ func NewCommand(f client.Factory) *cobra.Command {
 		Use:   "debug",
 		Short: "Generate debug bundle",
 		Long: `Generate a tarball containing the logs of velero deployment, plugin logs, node-agent DaemonSet, 
-specs of resources created by velero server, and optionally the logs of backup and restore.`,
+specs of resources created by velero server, the configs, goroutine dumps and pprof profiles of node-agent pods,
+and optionally the logs of backup and restore.`,
 		Run: func(c *cobra.Command, args []string) {
 			flags := c.Flags()
 			err := o.complete(f, flags)
@@ -166,6 +176,10 @@ specs of resources created by velero server, and optionally the logs of backup a
 			}(o)
 			err = o.validate(f)
 			cmd.CheckError(err)
+			if o.nodeAgent {
+				err = o.collectNodeAgent(f)
+				cmd.CheckError(err)
+			}
 			err = runCrashd(o)
 			cmd.CheckError(err)
 		},
@@ -174,6 +188,17 @@ specs of resources created by velero server, and optionally the logs of backup a
 	return c
 }
 
+// collectNodeAgent collects the debug info of the node-agent pods into the workdir of crashd, so that they're
+// archived into the bundle with the others
+func (o *option) collectNodeAgent(f client.Factory) error {
+	kubeClient, err := f.KubeClient()
+	if err != nil {
+		return err
+	}
+	dir := filepath.Join(o.baseDir, crashdWorkdir, "node-agent")
+	return collectNodeAgentDebugInfo(context.TODO(), kubeClient, o.namespace, dir, o.nodeAgentCPUProfileDuration, os.Stdout)
+}
+
 func runCrashd(o *option) error {
 	pwd, err := os.Getwd()
 	if err != nil {
@@ -0,0 +1,114 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// nodeAgentDebugFile is a file collected from the debug endpoints of a node-agent pod
+type nodeAgentDebugFile struct {
+	name   string
+	path   string
+	params map[string]string
+}
+
+func nodeAgentDebugFiles(cpuProfileDuration time.Duration) []nodeAgentDebugFile {
+	files := []nodeAgentDebugFile{
+		{name: "info.json", path: nodeagent.DebugInfoPath},
+		{name: "goroutines.txt", path: nodeagent.DebugPProfPath + "goroutine", params: map[string]string{"debug": "2"}},
+		{name: "heap.pprof", path: nodeagent.DebugPProfPath + "heap"},
+	}
+	if seconds := int(cpuProfileDuration.Seconds()); seconds > 0 {
+		files = append(files, nodeAgentDebugFile{name: "cpu.pprof", path: nodeagent.DebugPProfPath + "profile", params: map[string]string{"seconds": strconv.Itoa(seconds)}})
+	}
+	return files
+}
+
+// collectNodeAgentDebugInfo collects the debug info, the goroutine dumps and the pprof profiles of the running
+// node-agent pods through the pod proxy of the API server into "<dir>/<pod name>". The pods are collected in
+// parallel, and the failures are recorded in the "errors.txt" of the pods instead of failing the bundle
+func collectNodeAgentDebugInfo(ctx context.Context, kubeClient kubernetes.Interface, namespace string, dir string, cpuProfileDuration time.Duration, w io.Writer) error {
+	pods, err := nodeagent.GetPods(ctx, kubeClient, namespace)
+	if err != nil {
+		return err
+	}
+
+	files := nodeAgentDebugFiles(cpuProfileDuration)
+	wg := new(sync.WaitGroup)
+	for i := range pods {
+		pod := pods[i]
+		if kube.IsPodRunning(&pod) != nil {
+			fmt.Fprintf(w, "Skipping node-agent pod %s which isn't running\n", pod.Name)
+			continue
+		}
+
+		fmt.Fprintf(w, "Collecting debug info of node-agent pod %s on node %s\n", pod.Name, pod.Spec.NodeName)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := collectNodeAgentPodDebugInfo(ctx, kubeClient, &pod, filepath.Join(dir, pod.Name), files); err != nil {
+				fmt.Fprintf(w, "Failed to collect debug info of node-agent pod %s: %v\n", pod.Name, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return nil
+}
+
+func collectNodeAgentPodDebugInfo(ctx context.Context, kubeClient kubernetes.Interface, pod *corev1api.Pod, dir string, files []nodeAgentDebugFile) error {
+	if err := os.MkdirAll(dir, 0744); err != nil {
+		return errors.Wrapf(err, "error creating directory %s", dir)
+	}
+
+	port := strconv.Itoa(nodeagent.GetHTTPPort(pod))
+	var failures []string
+	for _, file := range files {
+		data, err := kubeClient.CoreV1().Pods(pod.Namespace).ProxyGet("http", pod.Name, port, file.path, file.params).DoRaw(ctx)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", file.path, err))
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(dir, file.name), data, 0644); err != nil {
+			return errors.Wrapf(err, "error writing %s", file.name)
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+	if err := os.WriteFile(filepath.Join(dir, "errors.txt"), []byte(strings.Join(failures, "\n")+"\n"), 0644); err != nil {
+		return errors.Wrap(err, "error writing errors.txt")
+	}
+	return errors.Errorf("%d of %d files failed, see errors.txt", len(failures), len(files))
+}
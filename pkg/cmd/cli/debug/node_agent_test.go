@@ -0,0 +1,85 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	restclient "k8s.io/client-go/rest"
+	clientgotesting "k8s.io/client-go/testing"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+type fakeResponseWrapper struct {
+	data []byte
+	err  error
+}
+
+func (w *fakeResponseWrapper) DoRaw(context.Context) ([]byte, error) {
+	return w.data, w.err
+}
+
+func (w *fakeResponseWrapper) Stream(context.Context) (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(w.data)), w.err
+}
+
+func TestCollectNodeAgentDebugInfo(t *testing.T) {
+	runningPod := builder.ForPod("velero", "node-agent-1").Labels(map[string]string{"name": "node-agent"}).NodeName("node-1").
+		Phase(corev1api.PodRunning).Containers(&corev1api.Container{Name: "node-agent", Ports: []corev1api.ContainerPort{{Name: "metrics", ContainerPort: 9095}}}).Result()
+	pendingPod := builder.ForPod("velero", "node-agent-2").Labels(map[string]string{"name": "node-agent"}).NodeName("node-2").Phase(corev1api.PodPending).Result()
+	kubeClient := fake.NewSimpleClientset(runningPod, pendingPod)
+
+	var paths []string
+	kubeClient.PrependProxyReactor("pods", func(action clientgotesting.Action) (bool, restclient.ResponseWrapper, error) {
+		proxy := action.(clientgotesting.ProxyGetAction)
+		assert.Equal(t, "node-agent-1", proxy.GetName())
+		assert.Equal(t, "9095", proxy.GetPort())
+		paths = append(paths, proxy.GetPath())
+		if proxy.GetPath() == "/debug/pprof/heap" {
+			return true, &fakeResponseWrapper{err: errors.New("fake-proxy-error")}, nil
+		}
+		return true, &fakeResponseWrapper{data: []byte(proxy.GetPath())}, nil
+	})
+
+	dir := t.TempDir()
+	output := new(bytes.Buffer)
+	require.NoError(t, collectNodeAgentDebugInfo(context.Background(), kubeClient, "velero", dir, time.Second, output))
+	assert.Equal(t, []string{"/debug/info", "/debug/pprof/goroutine", "/debug/pprof/heap", "/debug/pprof/profile"}, paths)
+	assert.Contains(t, output.String(), "Skipping node-agent pod node-agent-2 which isn't running")
+	assert.Contains(t, output.String(), "Failed to collect debug info of node-agent pod node-agent-1: 1 of 4 files failed, see errors.txt")
+
+	data, err := os.ReadFile(filepath.Join(dir, "node-agent-1", "goroutines.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "/debug/pprof/goroutine", string(data))
+	data, err = os.ReadFile(filepath.Join(dir, "node-agent-1", "errors.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "/debug/pprof/heap: fake-proxy-error\n", string(data))
+	_, err = os.Stat(filepath.Join(dir, "node-agent-2"))
+	assert.True(t, os.IsNotExist(err))
+}
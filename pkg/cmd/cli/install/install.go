@@ -17,8 +17,10 @@ limitations under the License.
 package install
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 	"time"
@@ -37,6 +39,8 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	"github.com/vmware-tanzu/velero/pkg/install"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -82,6 +86,17 @@ type Options struct {
 	UploaderType                    string
 	DefaultSnapshotMoveData         bool
 	DisableInformerCache            bool
+	ImageMirror                     string
+	PluginTarballs                  flag.StringArray
+	BundleOutput                    string
+	BundlePlatform                  string
+	VeleroPodTolerations            flag.StringArray
+	VeleroPodTopologySpread         flag.StringArray
+	VeleroPodPriorityClassName      string
+	NodeAgentPodTolerations         flag.StringArray
+	NodeAgentPodTopologySpread      flag.StringArray
+	NodeAgentPodPriorityClassName   string
+	KubeletRootDir                  string
 }
 
 // BindFlags adds command line values to the options struct.
@@ -106,12 +121,19 @@ func (o *Options) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.NodeAgentPodMemRequest, "node-agent-pod-mem-request", o.NodeAgentPodMemRequest, `Memory request for node-agent pod. A value of "0" is treated as unbounded. Optional.`)
 	flags.StringVar(&o.NodeAgentPodCPULimit, "node-agent-pod-cpu-limit", o.NodeAgentPodCPULimit, `CPU limit for node-agent pod. A value of "0" is treated as unbounded. Optional.`)
 	flags.StringVar(&o.NodeAgentPodMemLimit, "node-agent-pod-mem-limit", o.NodeAgentPodMemLimit, `Memory limit for node-agent pod. A value of "0" is treated as unbounded. Optional.`)
+	flags.Var(&o.VeleroPodTolerations, "velero-pod-tolerations", "Tolerations of Velero pod in the format of key[=value][:effect]. The operator is Equal if the value is specified, otherwise Exists. Optional.")
+	flags.Var(&o.VeleroPodTopologySpread, "velero-pod-topology-spread-constraints", "Topology spread constraints of Velero pod in the format of topologyKey[:maxSkew[:whenUnsatisfiable]], maxSkew defaults to 1 and whenUnsatisfiable defaults to ScheduleAnyway. Optional.")
+	flags.StringVar(&o.VeleroPodPriorityClassName, "velero-pod-priority-class-name", o.VeleroPodPriorityClassName, "Priority class name of Velero pod. Optional.")
+	flags.Var(&o.NodeAgentPodTolerations, "node-agent-pod-tolerations", "Tolerations of node-agent pod in the format of key[=value][:effect]. The operator is Equal if the value is specified, otherwise Exists. Optional.")
+	flags.Var(&o.NodeAgentPodTopologySpread, "node-agent-pod-topology-spread-constraints", "Topology spread constraints of node-agent pod in the format of topologyKey[:maxSkew[:whenUnsatisfiable]], maxSkew defaults to 1 and whenUnsatisfiable defaults to ScheduleAnyway. Optional.")
+	flags.StringVar(&o.NodeAgentPodPriorityClassName, "node-agent-pod-priority-class-name", o.NodeAgentPodPriorityClassName, "Priority class name of node-agent pod. Optional.")
 	flags.Var(&o.BackupStorageConfig, "backup-location-config", "Configuration to use for the backup storage location. Format is key1=value1,key2=value2")
 	flags.Var(&o.VolumeSnapshotConfig, "snapshot-location-config", "Configuration to use for the volume snapshot location. Format is key1=value1,key2=value2")
 	flags.BoolVar(&o.UseVolumeSnapshots, "use-volume-snapshots", o.UseVolumeSnapshots, "Whether or not to create snapshot location automatically. Set to false if you do not plan to create volume snapshots via a storage provider.")
 	flags.BoolVar(&o.RestoreOnly, "restore-only", o.RestoreOnly, "Run the server in restore-only mode. Optional.")
 	flags.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Generate resources, but don't send them to the cluster. Use with -o. Optional.")
 	flags.BoolVar(&o.UseNodeAgent, "use-node-agent", o.UseNodeAgent, "Create Velero node-agent daemonset. Optional. Velero node-agent hosts Velero modules that need to run in one or more nodes(i.e. Restic, Kopia).")
+	flags.StringVar(&o.KubeletRootDir, "kubelet-root-dir", o.KubeletRootDir, "Root directory of the kubelet on the nodes, e.g. /var/snap/microk8s/common/var/lib/kubelet for microk8s. The pods and plugins directories under it are mounted to the node-agent pods. Optional.")
 	flags.BoolVar(&o.PrivilegedNodeAgent, "privileged-node-agent", o.PrivilegedNodeAgent, "Use privileged mode for the node agent. Optional. Required to backup block devices.")
 	flags.BoolVar(&o.Wait, "wait", o.Wait, "Wait for Velero deployment to be ready. Optional.")
 	flags.DurationVar(&o.DefaultRepoMaintenanceFrequency, "default-repo-maintain-frequency", o.DefaultRepoMaintenanceFrequency, "How often 'maintain' is run for backup repositories by default. Optional.")
@@ -123,6 +145,10 @@ func (o *Options) BindFlags(flags *pflag.FlagSet) {
 	flags.BoolVar(&o.DefaultVolumesToFsBackup, "default-volumes-to-fs-backup", o.DefaultVolumesToFsBackup, "Bool flag to configure Velero server to use pod volume file system backup by default for all volumes on all backups. Optional.")
 	flags.StringVar(&o.UploaderType, "uploader-type", o.UploaderType, fmt.Sprintf("The type of uploader to transfer the data of pod volumes, the supported values are '%s', '%s'", uploader.ResticType, uploader.KopiaType))
 	flags.BoolVar(&o.DefaultSnapshotMoveData, "default-snapshot-move-data", o.DefaultSnapshotMoveData, "Bool flag to configure Velero server to move data by default for all snapshots supporting data movement. Optional.")
+	flags.StringVar(&o.ImageMirror, "image-mirror", o.ImageMirror, "Registry (optionally with a path) mirroring the Velero and plugin images for air-gapped installs, e.g. registry.example.com/mirror. The registries of the images are replaced by it in the generated resources. Optional.")
+	flags.Var(&o.PluginTarballs, "plugin-tarball", "Local tarball of plugin images, either an OCI image layout or created by 'docker save'. The images in it are installed as plugins from the image mirror. Optional.")
+	flags.StringVar(&o.BundleOutput, "bundle-output", o.BundleOutput, "File to write an OCI image layout tarball of the Velero and plugin images into, for copying them into the image mirror of an air-gapped environment. Optional.")
+	flags.StringVar(&o.BundlePlatform, "bundle-platform", o.BundlePlatform, "Platform of the images written into the bundle, in the format of os/arch[/variant]. Optional.")
 	flags.BoolVar(&o.DisableInformerCache, "disable-informer-cache", o.DisableInformerCache, "Disable informer cache for Get calls on restore. With this enabled, it will speed up restore in cases where there are backup resources which already exist in the cluster, but for very large clusters this will increase velero memory usage. Default is false (don't disable). Optional.")
 }
 
@@ -152,6 +178,8 @@ func NewInstallOptions() *Options {
 		UploaderType:             uploader.KopiaType,
 		DefaultSnapshotMoveData:  false,
 		DisableInformerCache:     true,
+		BundlePlatform:           "linux/amd64",
+		KubeletRootDir:           nodeagent.DefaultKubeletRootDir,
 	}
 }
 
@@ -188,9 +216,26 @@ func (o *Options) AsVeleroOptions() (*install.VeleroOptions, error) {
 		return nil, err
 	}
 
+	veleroPodScheduling, err := parsePodScheduling(o.VeleroPodTolerations, o.VeleroPodTopologySpread, o.VeleroPodPriorityClassName)
+	if err != nil {
+		return nil, err
+	}
+	nodeAgentPodScheduling, err := parsePodScheduling(o.NodeAgentPodTolerations, o.NodeAgentPodTopologySpread, o.NodeAgentPodPriorityClassName)
+	if err != nil {
+		return nil, err
+	}
+
+	plugins, err := o.pluginImages()
+	if err != nil {
+		return nil, err
+	}
+	for i := range plugins {
+		plugins[i] = install.MirrorImage(plugins[i], o.ImageMirror)
+	}
+
 	return &install.VeleroOptions{
 		Namespace:                       o.Namespace,
-		Image:                           o.Image,
+		Image:                           install.MirrorImage(o.Image, o.ImageMirror),
 		ProviderName:                    o.ProviderName,
 		Bucket:                          o.BucketName,
 		Prefix:                          o.Prefix,
@@ -209,7 +254,7 @@ func (o *Options) AsVeleroOptions() (*install.VeleroOptions, error) {
 		VSLConfig:                       o.VolumeSnapshotConfig.Data(),
 		DefaultRepoMaintenanceFrequency: o.DefaultRepoMaintenanceFrequency,
 		GarbageCollectionFrequency:      o.GarbageCollectionFrequency,
-		Plugins:                         o.Plugins,
+		Plugins:                         plugins,
 		NoDefaultBackupLocation:         o.NoDefaultBackupLocation,
 		CACertData:                      caCertData,
 		Features:                        strings.Split(o.Features, ","),
@@ -217,9 +262,44 @@ func (o *Options) AsVeleroOptions() (*install.VeleroOptions, error) {
 		UploaderType:                    o.UploaderType,
 		DefaultSnapshotMoveData:         o.DefaultSnapshotMoveData,
 		DisableInformerCache:            o.DisableInformerCache,
+		VeleroPodScheduling:             veleroPodScheduling,
+		NodeAgentPodScheduling:          nodeAgentPodScheduling,
+		KubeletRootDir:                  o.KubeletRootDir,
 	}, nil
 }
 
+// pluginImages returns the plugin images including the ones in the plugin tarballs.
+func (o *Options) pluginImages() ([]string, error) {
+	plugins := append([]string{}, o.Plugins...)
+	for _, tarball := range o.PluginTarballs {
+		images, err := install.ImagesFromTarball(tarball)
+		if err != nil {
+			return nil, err
+		}
+		plugins = append(plugins, images...)
+	}
+
+	return plugins, nil
+}
+
+// writeBundle writes the Velero and plugin images into the bundle file. The images in the
+// plugin tarballs are available locally already, so they aren't pulled into the bundle.
+func (o *Options) writeBundle() error {
+	images := append([]string{o.Image}, o.Plugins...)
+
+	file, err := os.Create(o.BundleOutput)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer file.Close()
+
+	if err := install.NewImageBundler().WriteBundle(context.Background(), images, o.BundlePlatform, o.ImageMirror, file); err != nil {
+		return errors.Wrap(err, "error writing image bundle")
+	}
+
+	return errors.WithStack(file.Close())
+}
+
 // NewCommand creates a cobra command.
 func NewCommand(f client.Factory) *cobra.Command {
 	o := NewInstallOptions()
@@ -244,6 +324,9 @@ Use '--wait' to wait for the Velero Deployment to be ready before proceeding.
 
 Use '-o yaml' or '-o json' with '--dry-run' to output all generated resources as text instead of sending the resources to the server.
 This is useful as a starting point for more customized installations.
+
+Use '--image-mirror' to reference the images from a mirror registry in air-gapped environments, and '--bundle-output'
+to write the images into a tarball for copying them into the mirror registry.
 		`,
 		Example: `  # velero install --provider gcp --plugins velero/velero-plugin-for-gcp:v1.0.0 --bucket mybucket --secret-file ./gcp-service-account.json
 
@@ -259,6 +342,8 @@ This is useful as a starting point for more customized installations.
 
   # velero install --provider gcp --plugins velero/velero-plugin-for-gcp:v1.0.0 --bucket gcp-backups --secret-file ./gcp-creds.json --node-agent-pod-cpu-request=1000m --node-agent-pod-cpu-limit=5000m --node-agent-pod-mem-request=512Mi --node-agent-pod-mem-limit=1024Mi
 
+  # velero install --provider aws --plugins velero/velero-plugin-for-aws:v1.0.0 --bucket backups --secret-file ./aws-iam-creds --image-mirror registry.example.com/mirror --bundle-output velero-images.tar --dry-run -o yaml
+
   # velero install --provider azure --plugins velero/velero-plugin-for-microsoft-azure:v1.0.0 --bucket $BLOB_CONTAINER --secret-file ./credentials-velero --backup-location-config resourceGroup=$AZURE_BACKUP_RESOURCE_GROUP,storageAccount=$AZURE_STORAGE_ACCOUNT_ID[,subscriptionId=$AZURE_BACKUP_SUBSCRIPTION_ID] --snapshot-location-config apiTimeout=<YOUR_TIMEOUT>[,resourceGroup=$AZURE_BACKUP_RESOURCE_GROUP,subscriptionId=$AZURE_BACKUP_SUBSCRIPTION_ID]`,
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Validate(c, args, f))
@@ -276,6 +361,12 @@ This is useful as a starting point for more customized installations.
 
 // Run executes a command in the context of the provided arguments.
 func (o *Options) Run(c *cobra.Command, f client.Factory) error {
+	if o.BundleOutput != "" && !o.CRDsOnly {
+		if err := o.writeBundle(); err != nil {
+			return err
+		}
+	}
+
 	var resources *unstructured.UnstructuredList
 	if o.CRDsOnly {
 		resources = install.AllCRDs()
@@ -288,7 +379,7 @@ func (o *Options) Run(c *cobra.Command, f client.Factory) error {
 		resources = install.AllResources(vo)
 	}
 
-	if _, err := output.PrintWithFormat(c, resources); err != nil {
+	if err := printResources(c, resources); err != nil {
 		return err
 	}
 
@@ -337,6 +428,25 @@ func (o *Options) Run(c *cobra.Command, f client.Factory) error {
 	return nil
 }
 
+// printResources prints the resources in the output format. The resources are printed as separate
+// YAML documents in the install order, so the output can be customized by tools like kustomize.
+func printResources(c *cobra.Command, resources *unstructured.UnstructuredList) error {
+	if output.GetOutputFlagValue(c) != "yaml" {
+		_, err := output.PrintWithFormat(c, resources)
+		return err
+	}
+
+	for i := range resources.Items {
+		encoded, err := encode.Encode(&resources.Items[i], "yaml")
+		if err != nil {
+			return err
+		}
+		fmt.Printf("---\n%s", encoded)
+	}
+
+	return nil
+}
+
 // Complete completes options for a command.
 func (o *Options) Complete(args []string, f client.Factory) error {
 	o.Namespace = f.Namespace()
@@ -402,11 +512,17 @@ func (o *Options) Validate(c *cobra.Command, args []string, f client.Factory) er
 			return errors.New("--provider must be empty when using --no-default-backup-location and --use-volume-snapshots=false")
 		}
 	} else {
-		if len(o.Plugins) == 0 {
+		// the object store of OCI is built into Velero, so no plugin is needed if volume snapshots aren't used
+		builtIn := !o.UseVolumeSnapshots && (o.ProviderName == "oci" || o.ProviderName == "velero.io/oci")
+		if len(o.Plugins) == 0 && len(o.PluginTarballs) == 0 && !builtIn {
 			return errors.New("--plugins flag is required")
 		}
 	}
 
+	if o.KubeletRootDir != "" && !path.IsAbs(o.KubeletRootDir) {
+		return errors.New("--kubelet-root-dir must be an absolute path")
+	}
+
 	if o.DefaultVolumesToFsBackup && !o.UseNodeAgent {
 		return errors.New("--use-node-agent is required when using --default-volumes-to-fs-backup")
 	}
@@ -0,0 +1,115 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	"github.com/vmware-tanzu/velero/pkg/install"
+)
+
+// parseTolerations parses the tolerations in the format of key[=value][:effect]. The
+// operator is Equal if the value is specified, otherwise Exists.
+func parseTolerations(values []string) ([]corev1.Toleration, error) {
+	var tolerations []corev1.Toleration
+	for _, value := range values {
+		toleration := corev1.Toleration{Operator: corev1.TolerationOpExists}
+
+		keyValue, effect, found := strings.Cut(value, ":")
+		if found {
+			switch corev1.TaintEffect(effect) {
+			case corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+				toleration.Effect = corev1.TaintEffect(effect)
+			default:
+				return nil, errors.Errorf("invalid toleration %q, the effect must be one of NoSchedule, PreferNoSchedule, NoExecute", value)
+			}
+		}
+
+		key, val, found := strings.Cut(keyValue, "=")
+		if key == "" {
+			return nil, errors.Errorf("invalid toleration %q, the key is required", value)
+		}
+		toleration.Key = key
+		if found {
+			toleration.Operator = corev1.TolerationOpEqual
+			toleration.Value = val
+		}
+
+		tolerations = append(tolerations, toleration)
+	}
+
+	return tolerations, nil
+}
+
+// parseTopologySpreadConstraints parses the topology spread constraints in the format of
+// topologyKey[:maxSkew[:whenUnsatisfiable]], maxSkew defaults to 1 and whenUnsatisfiable
+// defaults to ScheduleAnyway.
+func parseTopologySpreadConstraints(values []string) ([]corev1.TopologySpreadConstraint, error) {
+	var constraints []corev1.TopologySpreadConstraint
+	for _, value := range values {
+		parts := strings.Split(value, ":")
+		if len(parts) > 3 || parts[0] == "" {
+			return nil, errors.Errorf("invalid topology spread constraint %q, it must be in the format of topologyKey[:maxSkew[:whenUnsatisfiable]]", value)
+		}
+
+		constraint := corev1.TopologySpreadConstraint{
+			TopologyKey:       parts[0],
+			MaxSkew:           1,
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+		}
+
+		if len(parts) > 1 {
+			maxSkew, err := strconv.ParseInt(parts[1], 10, 32)
+			if err != nil || maxSkew < 1 {
+				return nil, errors.Errorf("invalid topology spread constraint %q, maxSkew must be a positive integer", value)
+			}
+			constraint.MaxSkew = int32(maxSkew)
+		}
+
+		if len(parts) > 2 {
+			switch corev1.UnsatisfiableConstraintAction(parts[2]) {
+			case corev1.DoNotSchedule, corev1.ScheduleAnyway:
+				constraint.WhenUnsatisfiable = corev1.UnsatisfiableConstraintAction(parts[2])
+			default:
+				return nil, errors.Errorf("invalid topology spread constraint %q, whenUnsatisfiable must be one of DoNotSchedule, ScheduleAnyway", value)
+			}
+		}
+
+		constraints = append(constraints, constraint)
+	}
+
+	return constraints, nil
+}
+
+func parsePodScheduling(tolerations, topologySpreadConstraints flag.StringArray, priorityClassName string) (install.PodScheduling, error) {
+	scheduling := install.PodScheduling{PriorityClassName: priorityClassName}
+
+	var err error
+	if scheduling.Tolerations, err = parseTolerations(tolerations); err != nil {
+		return scheduling, err
+	}
+	if scheduling.TopologySpreadConstraints, err = parseTopologySpreadConstraints(topologySpreadConstraints); err != nil {
+		return scheduling, err
+	}
+
+	return scheduling, nil
+}
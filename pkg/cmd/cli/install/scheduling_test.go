@@ -0,0 +1,114 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestParseTolerations(t *testing.T) {
+	tests := []struct {
+		name        string
+		values      []string
+		expected    []corev1.Toleration
+		expectedErr string
+	}{
+		{
+			name: "no toleration",
+		},
+		{
+			name:   "key, value and effect",
+			values: []string{"node-role=infra:NoSchedule", "dedicated", "example.com/gpu:NoExecute"},
+			expected: []corev1.Toleration{
+				{Key: "node-role", Operator: corev1.TolerationOpEqual, Value: "infra", Effect: corev1.TaintEffectNoSchedule},
+				{Key: "dedicated", Operator: corev1.TolerationOpExists},
+				{Key: "example.com/gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+			},
+		},
+		{
+			name:        "invalid effect",
+			values:      []string{"node-role=infra:Never"},
+			expectedErr: `invalid toleration "node-role=infra:Never", the effect must be one of NoSchedule, PreferNoSchedule, NoExecute`,
+		},
+		{
+			name:        "missing key",
+			values:      []string{"=infra"},
+			expectedErr: `invalid toleration "=infra", the key is required`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tolerations, err := parseTolerations(test.values)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, tolerations)
+		})
+	}
+}
+
+func TestParseTopologySpreadConstraints(t *testing.T) {
+	tests := []struct {
+		name        string
+		values      []string
+		expected    []corev1.TopologySpreadConstraint
+		expectedErr string
+	}{
+		{
+			name:   "defaults",
+			values: []string{"kubernetes.io/hostname", "topology.kubernetes.io/zone:2:DoNotSchedule"},
+			expected: []corev1.TopologySpreadConstraint{
+				{TopologyKey: "kubernetes.io/hostname", MaxSkew: 1, WhenUnsatisfiable: corev1.ScheduleAnyway},
+				{TopologyKey: "topology.kubernetes.io/zone", MaxSkew: 2, WhenUnsatisfiable: corev1.DoNotSchedule},
+			},
+		},
+		{
+			name:        "invalid max skew",
+			values:      []string{"kubernetes.io/hostname:0"},
+			expectedErr: `invalid topology spread constraint "kubernetes.io/hostname:0", maxSkew must be a positive integer`,
+		},
+		{
+			name:        "invalid action",
+			values:      []string{"kubernetes.io/hostname:1:Never"},
+			expectedErr: `invalid topology spread constraint "kubernetes.io/hostname:1:Never", whenUnsatisfiable must be one of DoNotSchedule, ScheduleAnyway`,
+		},
+		{
+			name:        "too many parts",
+			values:      []string{"kubernetes.io/hostname:1:DoNotSchedule:foo"},
+			expectedErr: `invalid topology spread constraint "kubernetes.io/hostname:1:DoNotSchedule:foo", it must be in the format of topologyKey[:maxSkew[:whenUnsatisfiable]]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			constraints, err := parseTopologySpreadConstraints(test.values)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, constraints)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeagent
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/pprof"
+
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+)
+
+// registerDebugHandlers registers the pprof profiles and the debug info of the node-agent, which are collected by
+// "velero debug" through the pod proxy of the API server
+func (s *nodeAgentServer) registerDebugHandlers(mux *http.ServeMux) {
+	mux.HandleFunc(nodeagent.DebugPProfPath, pprof.Index)
+	mux.HandleFunc(nodeagent.DebugPProfPath+"cmdline", pprof.Cmdline)
+	mux.HandleFunc(nodeagent.DebugPProfPath+"profile", pprof.Profile)
+	mux.HandleFunc(nodeagent.DebugPProfPath+"symbol", pprof.Symbol)
+	mux.HandleFunc(nodeagent.DebugPProfPath+"trace", pprof.Trace)
+	mux.HandleFunc(nodeagent.DebugInfoPath, s.serveDebugInfo)
+}
+
+func (s *nodeAgentServer) serveDebugInfo(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(s.getDebugInfo()); err != nil {
+		s.logger.WithError(err).Warn("Failed to write the debug info")
+	}
+}
+
+func (s *nodeAgentServer) getDebugInfo() *nodeagent.DebugInfo {
+	info := &nodeagent.DebugInfo{NodeName: s.nodeName}
+
+	// the ConfigMap is read again, so that the changes not applied yet are visible
+	configs, err := getConfigsFunc(s.ctx, s.namespace, s.kubeClient)
+	if err != nil {
+		info.ConfigsError = err.Error()
+	}
+	info.Configs = configs
+
+	info.ConcurrentNum, info.PerNamespaceConcurrentNum = s.dataPathMgr.ConcurrentLimits()
+	info.TaskTypeConcurrentNum = s.dataPathMgr.TaskTypeConcurrentLimits()
+	info.ThrottledConcurrentNum = s.dataPathMgr.ThrottledConcurrentNum()
+	info.RunningJobs = s.dataPathMgr.GetRunningJobs()
+
+	return info
+}
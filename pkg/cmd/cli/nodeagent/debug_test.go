@@ -0,0 +1,94 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeagent
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/vmware-tanzu/velero/pkg/datapath"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+	testutil "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+)
+
+func Test_serveDebugInfo(t *testing.T) {
+	tests := []struct {
+		name      string
+		getFunc   func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error)
+		throttled int
+		expected  nodeagent.DebugInfo
+	}{
+		{
+			name: "configs and effective concurrency",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{DataPathConcurrency: &nodeagent.DataPathConcurrency{GlobalConfig: 5}}, nil
+			},
+			throttled: 2,
+			expected: nodeagent.DebugInfo{
+				NodeName:                  "node-1",
+				Configs:                   &nodeagent.Configs{DataPathConcurrency: &nodeagent.DataPathConcurrency{GlobalConfig: 5}},
+				ConcurrentNum:             5,
+				PerNamespaceConcurrentNum: map[string]int{"ns-1": 1},
+				ThrottledConcurrentNum:    2,
+			},
+		},
+		{
+			name: "failed to get configs",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return nil, errors.New("fake-get-error")
+			},
+			expected: nodeagent.DebugInfo{
+				NodeName:                  "node-1",
+				ConfigsError:              "fake-get-error",
+				ConcurrentNum:             5,
+				PerNamespaceConcurrentNum: map[string]int{"ns-1": 1},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &nodeAgentServer{
+				logger:      testutil.NewLogger(),
+				ctx:         context.Background(),
+				nodeName:    "node-1",
+				dataPathMgr: datapath.NewManager(5, map[string]int{"ns-1": 1}, uploader.BandwidthLimits{}),
+			}
+			s.dataPathMgr.SetThrottledConcurrentNum(test.throttled)
+			getConfigsFunc = test.getFunc
+
+			mux := http.NewServeMux()
+			s.registerDebugHandlers(mux)
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, nodeagent.DebugInfoPath, nil))
+			require.Equal(t, http.StatusOK, recorder.Code)
+
+			info := nodeagent.DebugInfo{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &info))
+			assert.Equal(t, test.expected, info)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeagent
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// serveLiveness reports the node-agent as unhealthy when the data path manager is deadlocked, so that the node-agent
+// pod is restarted by the liveness probe
+func (s *nodeAgentServer) serveLiveness(w http.ResponseWriter, r *http.Request) {
+	if err := s.dataPathMgr.CheckSlotAcquisition(slotAcquisitionTimeout); err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	fmt.Fprint(w, "ok")
+}
+
+// serveReadiness reports the node-agent as ready once the host paths are validated, the clients are set up and the
+// controllers are set up
+func (s *nodeAgentServer) serveReadiness(w http.ResponseWriter, r *http.Request) {
+	if !s.ready.Load() {
+		http.Error(w, "node-agent is not ready", http.StatusServiceUnavailable)
+		return
+	}
+	fmt.Fprint(w, "ok")
+}
+
+// runSlotAcquisitionWatchdog probes the slot acquisition of the data path manager periodically, the liveness probe
+// fails if a probe is blocked for longer than the timeout
+func (s *nodeAgentServer) runSlotAcquisitionWatchdog() {
+	ticker := time.NewTicker(slotAcquisitionProbeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.dataPathMgr.CheckSlotAcquisition(slotAcquisitionTimeout); err != nil {
+				s.logger.WithError(err).Error("Data path manager is deadlocked")
+			}
+			s.dataPathMgr.ProbeSlotAcquisition()
+		}
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeagent
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/velero/pkg/datapath"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+)
+
+func Test_serveHealth(t *testing.T) {
+	s := &nodeAgentServer{
+		dataPathMgr: datapath.NewManager(1, nil, uploader.BandwidthLimits{}),
+	}
+
+	recorder := httptest.NewRecorder()
+	s.serveReadiness(recorder, httptest.NewRequest(http.MethodGet, nodeagent.ReadinessPath, nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+
+	s.ready.Store(true)
+	recorder = httptest.NewRecorder()
+	s.serveReadiness(recorder, httptest.NewRequest(http.MethodGet, nodeagent.ReadinessPath, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	s.dataPathMgr.ProbeSlotAcquisition()
+	recorder = httptest.NewRecorder()
+	s.serveLiveness(recorder, httptest.NewRequest(http.MethodGet, nodeagent.LivenessPath, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
@@ -31,6 +31,7 @@ func NewCommand(f client.Factory) *cobra.Command {
 
 	c.AddCommand(
 		NewServerCommand(f),
+		NewStatusCommand(f),
 	)
 
 	return c
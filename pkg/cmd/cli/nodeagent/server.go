@@ -22,10 +22,14 @@ import (
 	"math"
 	"net/http"
 	"os"
+	"path/filepath"
+	goruntime "runtime"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -50,6 +54,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/backupwindow"
 	"github.com/vmware-tanzu/velero/pkg/buildinfo"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
@@ -59,6 +64,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
@@ -78,12 +84,32 @@ const (
 	defaultResourceTimeout         = 10 * time.Minute
 	defaultDataMoverPrepareTimeout = 30 * time.Minute
 	defaultDataPathConcurrentNum   = 1
+
+	// memoryWatermarkCheckInterval is the interval to check the memory usage of the node-agent against the watermark
+	memoryWatermarkCheckInterval = 10 * time.Second
+
+	// kopiaCacheMetricsInterval is the interval to update the kopia cache hit ratio of the data paths
+	kopiaCacheMetricsInterval = 30 * time.Second
+
+	// slotAcquisitionProbeInterval is the interval to probe the slot acquisition of the data path manager, and
+	// slotAcquisitionTimeout is how long a probe can be blocked before the node-agent is reported as unhealthy
+	slotAcquisitionProbeInterval = 10 * time.Second
+	slotAcquisitionTimeout       = 2 * time.Minute
+
+	// backupWindowCheckInterval is the interval to check if the data uploads need to be suspended or resumed
+	// for the backup window
+	backupWindowCheckInterval = time.Minute
 )
 
 type nodeAgentServerConfig struct {
 	metricsAddress          string
+	debugEndpoints          bool
 	resourceTimeout         time.Duration
 	dataMoverPrepareTimeout time.Duration
+	snapshotReadyTimeout    time.Duration
+	backupWindowConfigMap   string
+	hostPodsPath            string
+	hostPluginsPath         string
 }
 
 func NewServerCommand(f client.Factory) *cobra.Command {
@@ -91,6 +117,7 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 	formatFlag := logging.NewFormatFlag()
 	config := nodeAgentServerConfig{
 		metricsAddress:          defaultMetricsAddress,
+		debugEndpoints:          true,
 		resourceTimeout:         defaultResourceTimeout,
 		dataMoverPrepareTimeout: defaultDataMoverPrepareTimeout,
 	}
@@ -119,7 +146,12 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 	command.Flags().Var(formatFlag, "log-format", fmt.Sprintf("The format for log output. Valid values are %s.", strings.Join(formatFlag.AllowedValues(), ", ")))
 	command.Flags().DurationVar(&config.resourceTimeout, "resource-timeout", config.resourceTimeout, "How long to wait for resource processes which are not covered by other specific timeout parameters. Default is 10 minutes.")
 	command.Flags().DurationVar(&config.dataMoverPrepareTimeout, "data-mover-prepare-timeout", config.dataMoverPrepareTimeout, "How long to wait for preparing a DataUpload/DataDownload. Default is 30 minutes.")
+	command.Flags().DurationVar(&config.snapshotReadyTimeout, "volume-snapshot-ready-timeout", config.snapshotReadyTimeout, "How long to wait for the VolumeSnapshot of a DataUpload to be ready. If not set, the data mover prepare timeout is used.")
 	command.Flags().StringVar(&config.metricsAddress, "metrics-address", config.metricsAddress, "The address to expose prometheus metrics")
+	command.Flags().BoolVar(&config.debugEndpoints, "debug-endpoints", config.debugEndpoints, "Whether to expose the pprof profiles and the debug info of the node-agent at the metrics address, which are collected by 'velero debug'.")
+	command.Flags().StringVar(&config.backupWindowConfigMap, "backup-window-configmap", config.backupWindowConfigMap, "The name of the configmap in the Velero namespace with the daily time window in which the pod volume backups and data uploads are allowed to start. They aren't restricted if it's empty.")
+	command.Flags().StringVar(&config.hostPodsPath, "host-pods-path", config.hostPodsPath, "The path in the node-agent where the pods directory of the kubelet root directory is mounted. If not set, the default path for the OS of the node is used.")
+	command.Flags().StringVar(&config.hostPluginsPath, "host-plugins-path", config.hostPluginsPath, "The path in the node-agent where the plugins directory of the kubelet root directory is mounted, it must be the same as the path on the host to access the block volumes. If not set, the default path for the OS of the node is used.")
 
 	return command
 }
@@ -134,10 +166,13 @@ type nodeAgentServer struct {
 	metricsAddress    string
 	namespace         string
 	nodeName          string
+	nodeOS            string
 	config            nodeAgentServerConfig
 	kubeClient        kubernetes.Interface
 	csiSnapshotClient *snapshotv1client.Clientset
 	dataPathMgr       *datapath.Manager
+	memoryWatermark   *nodeagent.MemoryWatermark
+	ready             atomic.Bool
 }
 
 func newNodeAgentServer(logger logrus.FieldLogger, factory client.Factory, config nodeAgentServerConfig) (*nodeAgentServer, error) {
@@ -188,6 +223,9 @@ func newNodeAgentServer(logger logrus.FieldLogger, factory client.Factory, confi
 			&velerov2alpha1api.DataDownload{}: {
 				Field: fields.Set{"metadata.namespace": factory.Namespace()}.AsSelector(),
 			},
+			&velerov2alpha1api.NodeAgentStatus{}: {
+				Field: fields.Set{"metadata.namespace": factory.Namespace()}.AsSelector(),
+			},
 		},
 	}
 	mgr, err := ctrl.NewManager(clientConfig, ctrl.Options{
@@ -227,7 +265,12 @@ func newNodeAgentServer(logger logrus.FieldLogger, factory client.Factory, confi
 	}
 
 	dataPathConcurrentNum := s.getDataPathConcurrentNum(defaultDataPathConcurrentNum)
-	s.dataPathMgr = datapath.NewManager(dataPathConcurrentNum)
+	dataPathPerNamespaceNum := s.getDataPathPerNamespaceConcurrentNum()
+	dataPathBandwidthLimits := s.getDataPathBandwidthLimits()
+	s.dataPathMgr = datapath.NewManager(dataPathConcurrentNum, dataPathPerNamespaceNum, dataPathBandwidthLimits)
+	s.dataPathMgr.SetTaskTypeConcurrentNum(s.getDataPathTaskTypeConcurrentNum())
+	s.dataPathMgr.SetCacheOptions(s.getKopiaCacheOptions())
+	s.memoryWatermark = s.getMemoryWatermark()
 
 	return s, nil
 }
@@ -238,6 +281,11 @@ func (s *nodeAgentServer) run() {
 	go func() {
 		metricsMux := http.NewServeMux()
 		metricsMux.Handle("/metrics", promhttp.Handler())
+		metricsMux.HandleFunc(nodeagent.LivenessPath, s.serveLiveness)
+		metricsMux.HandleFunc(nodeagent.ReadinessPath, s.serveReadiness)
+		if s.config.debugEndpoints {
+			s.registerDebugHandlers(metricsMux)
+		}
 		s.logger.Infof("Starting metric server for node agent at address [%s]", s.metricsAddress)
 		server := &http.Server{
 			Addr:              s.metricsAddress,
@@ -251,6 +299,7 @@ func (s *nodeAgentServer) run() {
 	s.metrics = metrics.NewNodeMetrics()
 	s.metrics.RegisterAllMetrics()
 	s.metrics.InitMetricsForNode(s.nodeName)
+	s.dataPathMgr.SetMetrics(s.nodeName, s.metrics)
 
 	s.markInProgressCRsFailed()
 
@@ -273,8 +322,8 @@ func (s *nodeAgentServer) run() {
 
 	credentialGetter := &credentials.CredentialGetter{FromFile: credentialFileStore, FromSecret: credSecretStore}
 	repoEnsurer := repository.NewEnsurer(s.mgr.GetClient(), s.logger, s.config.resourceTimeout)
-	pvbReconciler := controller.NewPodVolumeBackupReconciler(s.mgr.GetClient(), s.dataPathMgr, repoEnsurer,
-		credentialGetter, s.nodeName, s.mgr.GetScheme(), s.metrics, s.logger)
+	pvbReconciler := controller.NewPodVolumeBackupReconciler(s.mgr.GetClient(), s.kubeClient, s.dataPathMgr, repoEnsurer,
+		credentialGetter, s.nodeName, s.config.backupWindowConfigMap, s.mgr.GetScheme(), s.metrics, s.logger)
 
 	if err := pvbReconciler.SetupWithManager(s.mgr); err != nil {
 		s.logger.Fatal(err, "unable to create controller", "controller", controller.PodVolumeBackup)
@@ -284,18 +333,47 @@ func (s *nodeAgentServer) run() {
 		s.logger.WithError(err).Fatal("Unable to create the pod volume restore controller")
 	}
 
-	dataUploadReconciler := controller.NewDataUploadReconciler(s.mgr.GetClient(), s.kubeClient, s.csiSnapshotClient.SnapshotV1(), s.dataPathMgr, repoEnsurer, clock.RealClock{}, credentialGetter, s.nodeName, s.fileSystem, s.config.dataMoverPrepareTimeout, s.logger, s.metrics)
+	exposeTimeout, snapshotReadyTimeout := s.getResourceTimeouts()
+	dataUploadReconciler := controller.NewDataUploadReconciler(s.mgr.GetClient(), s.kubeClient, s.csiSnapshotClient.SnapshotV1(), s.dataPathMgr, repoEnsurer, clock.RealClock{}, credentialGetter, s.nodeName, s.fileSystem, exposeTimeout, snapshotReadyTimeout, s.config.backupWindowConfigMap, s.getBackupPodConfig(), s.logger, s.metrics)
 	s.markDataUploadsCancel(dataUploadReconciler)
 	if err = dataUploadReconciler.SetupWithManager(s.mgr); err != nil {
 		s.logger.WithError(err).Fatal("Unable to create the data upload controller")
 	}
 
-	dataDownloadReconciler := controller.NewDataDownloadReconciler(s.mgr.GetClient(), s.kubeClient, s.dataPathMgr, repoEnsurer, credentialGetter, s.nodeName, s.config.dataMoverPrepareTimeout, s.logger, s.metrics)
+	dataDownloadReconciler := controller.NewDataDownloadReconciler(s.mgr.GetClient(), s.kubeClient, s.dataPathMgr, repoEnsurer, credentialGetter, s.nodeName, exposeTimeout, s.logger, s.metrics)
 	s.markDataDownloadsCancel(dataDownloadReconciler)
 	if err = dataDownloadReconciler.SetupWithManager(s.mgr); err != nil {
 		s.logger.WithError(err).Fatal("Unable to create the data download controller")
 	}
 
+	if err = controller.NewDataVerifyReconciler(s.mgr.GetClient(), s.dataPathMgr, repoEnsurer, credentialGetter, s.nodeName, s.logger).SetupWithManager(s.mgr); err != nil {
+		s.logger.WithError(err).Fatal("Unable to create the data verify controller")
+	}
+
+	if err = controller.NewDataReplicationReconciler(s.mgr.GetClient(), s.dataPathMgr, repoEnsurer, credentialGetter, s.nodeName, s.logger).SetupWithManager(s.mgr); err != nil {
+		s.logger.WithError(err).Fatal("Unable to create the data replication controller")
+	}
+
+	s.createNodeAgentStatus()
+	if err = controller.NewNodeAgentStatusReconciler(s.mgr.GetClient(), s.dataPathMgr, s.nodeName, s.logger).SetupWithManager(s.mgr); err != nil {
+		s.logger.WithError(err).Fatal("Unable to create the node agent status controller")
+	}
+
+	if s.memoryWatermark != nil {
+		go s.runMemoryWatermarkMonitor()
+	}
+
+	go s.runKopiaCacheMetricsUpdater()
+	go s.runSlotAcquisitionWatchdog()
+
+	if s.config.backupWindowConfigMap != "" {
+		go s.runBackupWindowMonitor()
+	}
+
+	// the host paths are validated and the clients are set up when the server is created, so the node-agent is ready
+	// once the controllers are set up
+	s.ready.Store(true)
+
 	s.logger.Info("Controllers starting...")
 
 	if err := s.mgr.Start(ctrl.SetupSignalHandler()); err != nil {
@@ -303,12 +381,34 @@ func (s *nodeAgentServer) run() {
 	}
 }
 
-// validatePodVolumesHostPath validates that the pod volumes path contains a
+// validatePodVolumesHostPath detects the OS of this node to decide the
+// default host paths, and validates that the pod volumes path contains a
 // directory for each Pod running on this node
 func (s *nodeAgentServer) validatePodVolumesHostPath(client kubernetes.Interface) error {
-	files, err := s.fileSystem.ReadDir("/host_pods/")
+	s.nodeOS = goruntime.GOOS
+	if node, err := client.CoreV1().Nodes().Get(s.ctx, s.nodeName, metav1.GetOptions{}); err != nil {
+		s.logger.WithError(err).Warnf("Failed to get node info for %s, assume the node OS is %s", s.nodeName, s.nodeOS)
+	} else {
+		s.nodeOS = nodeagent.GetNodeOS(node)
+	}
+	if s.nodeOS != goruntime.GOOS {
+		return errors.Errorf("the node-agent built for %s cannot run in the %s node %s, ensure that the node-agent for %s is deployed to the node", goruntime.GOOS, s.nodeOS, s.nodeName, s.nodeOS)
+	}
+
+	hostPodsPath, hostPluginsPath := nodeagent.DefaultHostPaths(s.nodeOS)
+	if s.config.hostPodsPath != "" {
+		hostPodsPath = s.config.hostPodsPath
+	}
+	if s.config.hostPluginsPath != "" {
+		hostPluginsPath = s.config.hostPluginsPath
+	}
+	nodeagent.SetHostPaths(hostPodsPath, hostPluginsPath)
+	s.logger.Infof("Node OS is %s, using the host pods path %s and the host plugins path %s", s.nodeOS, nodeagent.HostPodsPath(), nodeagent.HostPluginsPath())
+
+	hostPodsPath = nodeagent.HostPodsPath()
+	files, err := s.fileSystem.ReadDir(hostPodsPath)
 	if err != nil {
-		return errors.Wrap(err, "could not read pod volumes host path")
+		return errors.Wrapf(err, "could not read pod volumes host path %s", hostPodsPath)
 	}
 
 	// create a map of directory names inside the pod volumes path
@@ -338,13 +438,18 @@ func (s *nodeAgentServer) validatePodVolumesHostPath(client kubernetes.Interface
 			valid = false
 			s.logger.WithFields(logrus.Fields{
 				"pod":  fmt.Sprintf("%s/%s", pod.GetNamespace(), pod.GetName()),
-				"path": "/host_pods/" + dirName,
+				"path": filepath.Join(hostPodsPath, dirName),
 			}).Debug("could not find volumes for pod in host path")
 		}
 	}
 
 	if !valid {
-		return errors.New("unexpected directory structure for host-pods volume, ensure that the host-pods volume corresponds to the pods subdirectory of the kubelet root directory")
+		return errors.Errorf("unexpected directory structure for host-pods volume mounted at %s, ensure that the host-pods volume corresponds to the pods subdirectory of the kubelet root directory", hostPodsPath)
+	}
+
+	// the plugins directory is only required by the block volumes, so don't fail the node-agent without it
+	if exists, err := s.fileSystem.DirExists(nodeagent.HostPluginsPath()); err != nil || !exists {
+		s.logger.Warnf("Could not find the host plugins path %s, the block volumes can't be backed up or restored, ensure that the host-plugins volume corresponds to the plugins subdirectory of the kubelet root directory", nodeagent.HostPluginsPath())
 	}
 
 	return nil
@@ -363,6 +468,10 @@ func (s *nodeAgentServer) markInProgressCRsFailed() {
 	s.markInProgressPVBsFailed(client)
 
 	s.markInProgressPVRsFailed(client)
+
+	s.markInProgressDataVerifiesFailed(client)
+
+	s.markInProgressDataReplicationsFailed(client)
 }
 
 func (s *nodeAgentServer) markDataUploadsCancel(r *controller.DataUploadReconciler) {
@@ -427,6 +536,19 @@ func (s *nodeAgentServer) markDataDownloadsCancel(r *controller.DataDownloadReco
 	}
 }
 
+func (s *nodeAgentServer) createNodeAgentStatus() {
+	// the function is called before starting the controller manager, the embedded client isn't ready to use, so create a new one here
+	client, err := ctrlclient.New(s.mgr.GetConfig(), ctrlclient.Options{Scheme: s.mgr.GetScheme()})
+	if err != nil {
+		s.logger.WithError(errors.WithStack(err)).Error("failed to create client")
+		return
+	}
+
+	if err := controller.EnsureNodeAgentStatus(s.ctx, client, s.namespace, s.nodeName); err != nil {
+		s.logger.WithError(err).Error("failed to create node agent status")
+	}
+}
+
 func (s *nodeAgentServer) markInProgressPVBsFailed(client ctrlclient.Client) {
 	pvbs := &velerov1api.PodVolumeBackupList{}
 	if err := client.List(s.ctx, pvbs, &ctrlclient.MatchingFields{"metadata.namespace": s.namespace}); err != nil {
@@ -489,6 +611,58 @@ func (s *nodeAgentServer) markInProgressPVRsFailed(client ctrlclient.Client) {
 	}
 }
 
+func (s *nodeAgentServer) markInProgressDataVerifiesFailed(client ctrlclient.Client) {
+	dataVerifies := &velerov2alpha1api.DataVerifyList{}
+	if err := client.List(s.ctx, dataVerifies, &ctrlclient.MatchingFields{"metadata.namespace": s.namespace}); err != nil {
+		s.logger.WithError(errors.WithStack(err)).Error("failed to list dataverifies")
+		return
+	}
+	for i, dv := range dataVerifies.Items {
+		if dv.Status.Phase != velerov2alpha1api.DataVerifyPhaseAccepted && dv.Status.Phase != velerov2alpha1api.DataVerifyPhaseInProgress {
+			s.logger.Debugf("the status of dataverify %q is %q, skip", dv.GetName(), dv.Status.Phase)
+			continue
+		}
+		if dv.Status.Node != s.nodeName {
+			s.logger.Debugf("the node of dataverify %q is %q, not %q, skip", dv.GetName(), dv.Status.Node, s.nodeName)
+			continue
+		}
+
+		if err := controller.UpdateDataVerifyStatusToFailed(s.ctx, client, &dataVerifies.Items[i],
+			fmt.Sprintf("get a dataverify with status %q during the server starting, mark it as %q", dv.Status.Phase, velerov2alpha1api.DataVerifyPhaseFailed),
+			time.Now(), s.logger); err != nil {
+			s.logger.WithError(errors.WithStack(err)).Errorf("failed to patch dataverify %q", dv.GetName())
+			continue
+		}
+		s.logger.WithField("dataverify", dv.GetName()).Warn(dataVerifies.Items[i].Status.Message)
+	}
+}
+
+func (s *nodeAgentServer) markInProgressDataReplicationsFailed(client ctrlclient.Client) {
+	dataReplications := &velerov2alpha1api.DataReplicationList{}
+	if err := client.List(s.ctx, dataReplications, &ctrlclient.MatchingFields{"metadata.namespace": s.namespace}); err != nil {
+		s.logger.WithError(errors.WithStack(err)).Error("failed to list datareplications")
+		return
+	}
+	for i, dr := range dataReplications.Items {
+		if dr.Status.Phase != velerov2alpha1api.DataReplicationPhaseAccepted && dr.Status.Phase != velerov2alpha1api.DataReplicationPhaseInProgress {
+			s.logger.Debugf("the status of datareplication %q is %q, skip", dr.GetName(), dr.Status.Phase)
+			continue
+		}
+		if dr.Status.Node != s.nodeName {
+			s.logger.Debugf("the node of datareplication %q is %q, not %q, skip", dr.GetName(), dr.Status.Node, s.nodeName)
+			continue
+		}
+
+		if err := controller.UpdateDataReplicationStatusToFailed(s.ctx, client, &dataReplications.Items[i],
+			fmt.Sprintf("get a datareplication with status %q during the server starting, mark it as %q", dr.Status.Phase, velerov2alpha1api.DataReplicationPhaseFailed),
+			time.Now(), s.logger); err != nil {
+			s.logger.WithError(errors.WithStack(err)).Errorf("failed to patch datareplication %q", dr.GetName())
+			continue
+		}
+		s.logger.WithField("datareplication", dr.GetName()).Warn(dataReplications.Items[i].Status.Message)
+	}
+}
+
 var getConfigsFunc = nodeagent.GetConfigs
 
 func (s *nodeAgentServer) getDataPathConcurrentNum(defaultNum int) int {
@@ -550,3 +724,434 @@ func (s *nodeAgentServer) getDataPathConcurrentNum(defaultNum int) int {
 
 	return concurrentNum
 }
+
+func (s *nodeAgentServer) getDataPathPerNamespaceConcurrentNum() map[string]int {
+	configs, err := getConfigsFunc(s.ctx, s.namespace, s.kubeClient)
+	if err != nil {
+		s.logger.WithError(err).Warn("Failed to get node agent configs")
+		return nil
+	}
+
+	if configs == nil || configs.DataPathConcurrency == nil || len(configs.DataPathConcurrency.PerNamespaceConfig) == 0 {
+		return nil
+	}
+
+	perNamespaceNum := map[string]int{}
+
+	for _, rule := range configs.DataPathConcurrency.PerNamespaceConfig {
+		if rule.Number <= 0 {
+			s.logger.Warnf("Rule with namespaces %v is with an invalid number %v, skip it", rule.Namespaces, rule.Number)
+			continue
+		}
+
+		for _, ns := range rule.Namespaces {
+			// if multiple rules match the namespace, the smallest number wins
+			if num, exist := perNamespaceNum[ns]; !exist || num > rule.Number {
+				perNamespaceNum[ns] = rule.Number
+			}
+		}
+	}
+
+	if len(perNamespaceNum) > 0 {
+		s.logger.Infof("Use the per namespace number %v", perNamespaceNum)
+	}
+
+	return perNamespaceNum
+}
+
+func (s *nodeAgentServer) getDataPathTaskTypeConcurrentNum() (int, int) {
+	configs, err := getConfigsFunc(s.ctx, s.namespace, s.kubeClient)
+	if err != nil {
+		s.logger.WithError(err).Warn("Failed to get node agent configs")
+		return 0, 0
+	}
+
+	if configs == nil || configs.DataPathConcurrency == nil {
+		return 0, 0
+	}
+
+	backupNum, restoreNum := configs.DataPathConcurrency.BackupConcurrency, configs.DataPathConcurrency.RestoreConcurrency
+	if backupNum < 0 {
+		s.logger.Warnf("Backup number %v is invalid, ignore it", backupNum)
+		backupNum = 0
+	}
+	if restoreNum < 0 {
+		s.logger.Warnf("Restore number %v is invalid, ignore it", restoreNum)
+		restoreNum = 0
+	}
+
+	if backupNum > 0 || restoreNum > 0 {
+		s.logger.Infof("Use the backup number %v and the restore number %v", backupNum, restoreNum)
+	}
+
+	return backupNum, restoreNum
+}
+
+func (s *nodeAgentServer) getDataPathBandwidthLimits() uploader.BandwidthLimits {
+	configs, err := getConfigsFunc(s.ctx, s.namespace, s.kubeClient)
+	if err != nil {
+		s.logger.WithError(err).Warn("Failed to get node agent configs")
+		return uploader.BandwidthLimits{}
+	}
+
+	if configs == nil || configs.DataPathThrottling == nil {
+		s.logger.Info("Throttling configs are not found, data path bandwidth is unlimited")
+		return uploader.BandwidthLimits{}
+	}
+
+	global := configs.DataPathThrottling.GlobalConfig
+	if global.UploadMBps < 0 || global.DownloadMBps < 0 {
+		s.logger.Warnf("Global bandwidth %v is invalid, data path bandwidth is unlimited", global)
+		global = nodeagent.Bandwidth{}
+	}
+
+	if len(configs.DataPathThrottling.PerNodeConfig) == 0 {
+		return toBandwidthLimits(global)
+	}
+
+	curNode, err := s.kubeClient.CoreV1().Nodes().Get(s.ctx, s.nodeName, metav1.GetOptions{})
+	if err != nil {
+		s.logger.WithError(err).Warnf("Failed to get node info for %s, use the global bandwidth %v", s.nodeName, global)
+		return toBandwidthLimits(global)
+	}
+
+	var perNode *nodeagent.Bandwidth
+
+	for _, rule := range configs.DataPathThrottling.PerNodeConfig {
+		selector, err := metav1.LabelSelectorAsSelector(&rule.NodeSelector)
+		if err != nil {
+			s.logger.WithError(err).Warnf("Failed to parse rule with label selector %s, skip it", rule.NodeSelector.String())
+			continue
+		}
+
+		if rule.UploadMBps < 0 || rule.DownloadMBps < 0 {
+			s.logger.Warnf("Rule with label selector %s is with an invalid bandwidth %v, skip it", rule.NodeSelector.String(), rule.Bandwidth)
+			continue
+		}
+
+		if !selector.Matches(labels.Set(curNode.GetLabels())) {
+			continue
+		}
+
+		// if multiple rules match the node, the most restrictive limit wins for each direction
+		if perNode == nil {
+			perNode = &nodeagent.Bandwidth{}
+			*perNode = rule.Bandwidth
+		} else {
+			perNode.UploadMBps = minBandwidth(perNode.UploadMBps, rule.UploadMBps)
+			perNode.DownloadMBps = minBandwidth(perNode.DownloadMBps, rule.DownloadMBps)
+		}
+	}
+
+	if perNode == nil {
+		s.logger.Infof("Per node bandwidth for node %s is not found, use the global bandwidth %v", s.nodeName, global)
+		return toBandwidthLimits(global)
+	}
+
+	s.logger.Infof("Use the per node bandwidth %v over global bandwidth %v for node %s", *perNode, global, s.nodeName)
+
+	return toBandwidthLimits(*perNode)
+}
+
+func (s *nodeAgentServer) getKopiaCacheOptions() uploader.CacheOptions {
+	configs, err := getConfigsFunc(s.ctx, s.namespace, s.kubeClient)
+	if err != nil {
+		s.logger.WithError(err).Warn("Failed to get node agent configs")
+		return uploader.CacheOptions{}
+	}
+
+	if configs == nil || configs.KopiaCache == nil {
+		s.logger.Info("Kopia cache configs are not found, use the default kopia cache")
+		return uploader.CacheOptions{}
+	}
+
+	global := configs.KopiaCache.GlobalConfig
+	if !validKopiaCache(global) {
+		s.logger.Warnf("Global kopia cache %v is invalid, use the default kopia cache", global)
+		global = nodeagent.KopiaCache{}
+	}
+
+	if len(configs.KopiaCache.PerNodeConfig) == 0 {
+		return toCacheOptions(global)
+	}
+
+	curNode, err := s.kubeClient.CoreV1().Nodes().Get(s.ctx, s.nodeName, metav1.GetOptions{})
+	if err != nil {
+		s.logger.WithError(err).Warnf("Failed to get node info for %s, use the global kopia cache %v", s.nodeName, global)
+		return toCacheOptions(global)
+	}
+
+	for _, rule := range configs.KopiaCache.PerNodeConfig {
+		selector, err := metav1.LabelSelectorAsSelector(&rule.NodeSelector)
+		if err != nil {
+			s.logger.WithError(err).Warnf("Failed to parse rule with label selector %s, skip it", rule.NodeSelector.String())
+			continue
+		}
+
+		if !validKopiaCache(rule.KopiaCache) {
+			s.logger.Warnf("Rule with label selector %s is with an invalid kopia cache %v, skip it", rule.NodeSelector.String(), rule.KopiaCache)
+			continue
+		}
+
+		if selector.Matches(labels.Set(curNode.GetLabels())) {
+			s.logger.Infof("Use the per node kopia cache %v over global kopia cache %v for node %s", rule.KopiaCache, global, s.nodeName)
+			return toCacheOptions(rule.KopiaCache)
+		}
+	}
+
+	s.logger.Infof("Per node kopia cache for node %s is not found, use the global kopia cache %v", s.nodeName, global)
+
+	return toCacheOptions(global)
+}
+
+func (s *nodeAgentServer) getBackupPodConfig() *nodeagent.BackupPodConfig {
+	configs, err := getConfigsFunc(s.ctx, s.namespace, s.kubeClient)
+	if err != nil {
+		s.logger.WithError(err).Warn("Failed to get node agent configs")
+		return nil
+	}
+
+	if configs == nil || configs.BackupPodConfig == nil {
+		return nil
+	}
+
+	s.logger.Infof("Use the backup pod config %v", *configs.BackupPodConfig)
+
+	return configs.BackupPodConfig
+}
+
+// getResourceTimeouts returns the timeouts of exposing the data uploads/data downloads and of waiting for the
+// VolumeSnapshots to be ready, the timeouts in the node-agent configs override the ones set by the flags
+func (s *nodeAgentServer) getResourceTimeouts() (time.Duration, time.Duration) {
+	exposeTimeout := s.config.dataMoverPrepareTimeout
+	snapshotReadyTimeout := s.config.snapshotReadyTimeout
+
+	configs, err := getConfigsFunc(s.ctx, s.namespace, s.kubeClient)
+	if err != nil {
+		s.logger.WithError(err).Warn("Failed to get node agent configs")
+	} else if configs != nil && configs.ResourceTimeouts != nil {
+		if timeout := configs.ResourceTimeouts.DataMoverExpose; timeout != nil && timeout.Duration > 0 {
+			exposeTimeout = timeout.Duration
+		}
+		if timeout := configs.ResourceTimeouts.VolumeSnapshotReady; timeout != nil && timeout.Duration > 0 {
+			snapshotReadyTimeout = timeout.Duration
+		}
+	}
+
+	if snapshotReadyTimeout <= 0 {
+		snapshotReadyTimeout = exposeTimeout
+	}
+
+	s.logger.Infof("Use the data mover expose timeout %v and the VolumeSnapshot ready timeout %v", exposeTimeout, snapshotReadyTimeout)
+
+	return exposeTimeout, snapshotReadyTimeout
+}
+
+var getMemoryUsageFunc = nodeagent.GetMemoryUsage
+
+func (s *nodeAgentServer) getMemoryWatermark() *nodeagent.MemoryWatermark {
+	configs, err := getConfigsFunc(s.ctx, s.namespace, s.kubeClient)
+	if err != nil {
+		s.logger.WithError(err).Warn("Failed to get node agent configs")
+		return nil
+	}
+
+	if configs == nil || configs.MemoryWatermark == nil {
+		s.logger.Info("Memory watermark configs are not found, data path concurrency is not throttled by memory usage")
+		return nil
+	}
+
+	watermark := *configs.MemoryWatermark
+	if watermark.HighPercent <= 0 || watermark.HighPercent > 100 {
+		s.logger.Warnf("High memory watermark %v is invalid, data path concurrency is not throttled by memory usage", watermark.HighPercent)
+		return nil
+	}
+
+	if watermark.LowPercent <= 0 || watermark.LowPercent >= watermark.HighPercent {
+		if watermark.LowPercent != 0 {
+			s.logger.Warnf("Low memory watermark %v is invalid, use the default value", watermark.LowPercent)
+		}
+		watermark.LowPercent = watermark.HighPercent - 10
+		if watermark.LowPercent <= 0 {
+			watermark.LowPercent = watermark.HighPercent / 2
+		}
+	}
+
+	if watermark.ThrottledConcurrency <= 0 {
+		watermark.ThrottledConcurrency = 1
+	}
+
+	s.logger.Infof("Use the memory watermark %v", watermark)
+
+	return &watermark
+}
+
+// runMemoryWatermarkMonitor checks the memory usage of the node-agent periodically and throttles the data path
+// concurrency while the memory usage is above the watermark, to avoid the node-agent being OOMKilled
+func (s *nodeAgentServer) runMemoryWatermarkMonitor() {
+	ticker := time.NewTicker(memoryWatermarkCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.checkMemoryWatermark()
+		}
+	}
+}
+
+// runKopiaCacheMetricsUpdater updates the kopia cache hit ratio of the data paths periodically from the cache metrics
+// kopia registers in the default prometheus registry
+func (s *nodeAgentServer) runKopiaCacheMetricsUpdater() {
+	ticker := time.NewTicker(kopiaCacheMetricsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.metrics.UpdateKopiaCacheHitRatio(s.nodeName, prometheus.DefaultGatherer); err != nil {
+				s.logger.WithError(err).Debug("Failed to update kopia cache hit ratio")
+			}
+		}
+	}
+}
+
+func (s *nodeAgentServer) checkMemoryWatermark() {
+	usage, err := getMemoryUsageFunc(s.fileSystem)
+	if err != nil {
+		s.logger.WithError(err).Debug("Failed to get memory usage")
+		return
+	}
+
+	if usage.Limit == 0 {
+		s.logger.Debug("Memory of node-agent is unlimited, skip checking memory watermark")
+		return
+	}
+
+	percent := usage.Percent()
+	throttled := s.dataPathMgr.ThrottledConcurrentNum() > 0
+
+	if !throttled && percent >= s.memoryWatermark.HighPercent {
+		s.dataPathMgr.SetThrottledConcurrentNum(s.memoryWatermark.ThrottledConcurrency)
+		s.logger.Warnf("Memory usage %d%% (%d of %d bytes) crosses the high watermark %d%%, throttle data path concurrency to %d",
+			percent, usage.WorkingSet, usage.Limit, s.memoryWatermark.HighPercent, s.memoryWatermark.ThrottledConcurrency)
+	} else if throttled && percent < s.memoryWatermark.LowPercent {
+		s.dataPathMgr.SetThrottledConcurrentNum(0)
+		s.logger.Infof("Memory usage %d%% (%d of %d bytes) drops below the low watermark %d%%, resume data path concurrency",
+			percent, usage.WorkingSet, usage.Limit, s.memoryWatermark.LowPercent)
+	}
+}
+
+// minBandwidth returns the smaller one of the two bandwidth values, 0 means unlimited
+func minBandwidth(a, b int) int {
+	if a == 0 {
+		return b
+	}
+
+	if b == 0 || a < b {
+		return a
+	}
+
+	return b
+}
+
+func toBandwidthLimits(bandwidth nodeagent.Bandwidth) uploader.BandwidthLimits {
+	return uploader.BandwidthLimits{
+		UploadBytesPerSecond:   int64(bandwidth.UploadMBps) << 20,
+		DownloadBytesPerSecond: int64(bandwidth.DownloadMBps) << 20,
+	}
+}
+
+func validKopiaCache(cache nodeagent.KopiaCache) bool {
+	if cache.ContentCacheLimitMB < 0 || cache.MetadataCacheLimitMB < 0 {
+		return false
+	}
+
+	if cache.CacheDir != "" && !filepath.IsAbs(cache.CacheDir) {
+		return false
+	}
+
+	return cache.MinEvictionAge == nil || cache.MinEvictionAge.Duration >= 0
+}
+
+func toCacheOptions(cache nodeagent.KopiaCache) uploader.CacheOptions {
+	options := uploader.CacheOptions{
+		CacheDir:                cache.CacheDir,
+		ContentCacheLimitBytes:  cache.ContentCacheLimitMB << 20,
+		MetadataCacheLimitBytes: cache.MetadataCacheLimitMB << 20,
+	}
+	if cache.MinEvictionAge != nil {
+		options.MinEvictionAge = cache.MinEvictionAge.Duration
+	}
+
+	return options
+}
+
+// runBackupWindowMonitor suspends the in-progress data uploads of the node periodically while the backup window
+// is closed if it's required by the window, and resumes them once the window opens
+func (s *nodeAgentServer) runBackupWindowMonitor() {
+	ticker := time.NewTicker(backupWindowCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.checkBackupWindow(s.mgr.GetClient(), time.Now())
+		}
+	}
+}
+
+func (s *nodeAgentServer) checkBackupWindow(client ctrlclient.Client, now time.Time) {
+	window, err := backupwindow.Get(s.ctx, client, s.namespace, s.config.backupWindowConfigMap)
+	if err != nil {
+		s.logger.WithError(err).Warn("Failed to get the backup window")
+		return
+	}
+	suspend := window != nil && window.SuspendDataPaths && !window.IsOpen(now)
+
+	dataUploads := &velerov2alpha1api.DataUploadList{}
+	if err := client.List(s.ctx, dataUploads, ctrlclient.InNamespace(s.namespace)); err != nil {
+		s.logger.WithError(errors.WithStack(err)).Warn("Failed to list data uploads")
+		return
+	}
+
+	for i := range dataUploads.Items {
+		du := dataUploads.Items[i]
+		if du.Status.Node != s.nodeName {
+			continue
+		}
+
+		log := s.logger.WithField("dataupload", du.Name)
+		_, suspended := du.Annotations[backupwindow.SuspendedAnnotation]
+
+		var updateFunc func(*velerov2alpha1api.DataUpload)
+		if suspend && !suspended && du.Status.Phase == velerov2alpha1api.DataUploadPhaseInProgress && !du.Spec.Paused && !du.Spec.Cancel {
+			log.Infof("Suspend the data upload as the backup window %s is closed", window)
+			updateFunc = func(dataUpload *velerov2alpha1api.DataUpload) {
+				dataUpload.Spec.Paused = true
+				if dataUpload.Annotations == nil {
+					dataUpload.Annotations = map[string]string{}
+				}
+				dataUpload.Annotations[backupwindow.SuspendedAnnotation] = "true"
+			}
+		} else if !suspend && suspended {
+			log.Info("Resume the data upload suspended for the backup window")
+			updateFunc = func(dataUpload *velerov2alpha1api.DataUpload) {
+				dataUpload.Spec.Paused = false
+				delete(dataUpload.Annotations, backupwindow.SuspendedAnnotation)
+			}
+		} else {
+			continue
+		}
+
+		if err := controller.UpdateDataUploadWithRetry(s.ctx, client, types.NamespacedName{Namespace: du.Namespace, Name: du.Name}, log, updateFunc); err != nil {
+			log.WithError(err).Error("Failed to update the data upload for the backup window")
+		}
+	}
+}
@@ -22,26 +22,37 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/backupwindow"
 
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/datapath"
 	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	testutil "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
 
 func Test_validatePodVolumesHostPath(t *testing.T) {
 	tests := []struct {
-		name    string
-		pods    []*corev1.Pod
-		dirs    []string
-		wantErr bool
+		name         string
+		pods         []*corev1.Pod
+		dirs         []string
+		hostPodsPath string
+		nodeOS       string
+		wantErr      bool
 	}{
 		{
 			name: "no error when pod volumes are present",
@@ -79,13 +90,42 @@ func Test_validatePodVolumesHostPath(t *testing.T) {
 			dirs:    []string{"foo"},
 			wantErr: true,
 		},
+		{
+			name: "no error when pod volumes are present in the configured host pods path",
+			pods: []*corev1.Pod{
+				builder.ForPod("foo", "bar").ObjectMeta(builder.WithUID("foo")).Result(),
+			},
+			dirs:         []string{"foo"},
+			hostPodsPath: "/var/snap/microk8s/common/var/lib/kubelet/pods",
+			wantErr:      false,
+		},
+		{
+			name: "no error when the node is a linux node",
+			pods: []*corev1.Pod{
+				builder.ForPod("foo", "bar").ObjectMeta(builder.WithUID("foo")).Result(),
+			},
+			dirs:    []string{"foo"},
+			nodeOS:  nodeagent.NodeOSLinux,
+			wantErr: false,
+		},
+		{
+			name:    "error when the node OS isn't the OS of the node-agent",
+			nodeOS:  "fake-os",
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			fs := testutil.NewFakeFileSystem()
 
+			hostPodsPath := nodeagent.DefaultHostPodsPath
+			if tt.hostPodsPath != "" {
+				hostPodsPath = tt.hostPodsPath
+			}
+			defer nodeagent.SetHostPaths(nodeagent.DefaultHostPodsPath, nodeagent.DefaultHostPluginsPath)
+
 			for _, dir := range tt.dirs {
-				err := fs.MkdirAll(filepath.Join("/host_pods/", dir), os.ModePerm)
+				err := fs.MkdirAll(filepath.Join(hostPodsPath, dir), os.ModePerm)
 				if err != nil {
 					t.Error(err)
 				}
@@ -99,9 +139,20 @@ func Test_validatePodVolumesHostPath(t *testing.T) {
 				}
 			}
 
+			if tt.nodeOS != "" {
+				node := builder.ForNode("fake-node").Labels(map[string]string{corev1.LabelOSStable: tt.nodeOS}).Result()
+				_, err := kubeClient.CoreV1().Nodes().Create(context.TODO(), node, metav1.CreateOptions{})
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
 			s := &nodeAgentServer{
+				ctx:        context.TODO(),
 				logger:     testutil.NewLogger(),
 				fileSystem: fs,
+				nodeName:   "fake-node",
+				config:     nodeAgentServerConfig{hostPodsPath: tt.hostPodsPath},
 			}
 
 			err := s.validatePodVolumesHostPath(kubeClient)
@@ -369,3 +420,876 @@ func Test_getDataPathConcurrentNum(t *testing.T) {
 		})
 	}
 }
+
+func Test_getDataPathBandwidthLimits(t *testing.T) {
+	nodeName := "node-agent-node"
+	node1 := builder.ForNode("node-agent-node").Result()
+	node2 := builder.ForNode("node-agent-node").Labels(map[string]string{
+		"host-name": "node-1",
+		"xxxx":      "yyyyy",
+	}).Result()
+
+	invalidLabelSelector := metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			"inva/lid": "inva/lid",
+		},
+	}
+	validLabelSelector1 := metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			"host-name": "node-1",
+		},
+	}
+	validLabelSelector2 := metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			"xxxx": "yyyyy",
+		},
+	}
+
+	global := nodeagent.Bandwidth{UploadMBps: 10, DownloadMBps: 20}
+	globalLimits := uploader.BandwidthLimits{UploadBytesPerSecond: 10 << 20, DownloadBytesPerSecond: 20 << 20}
+
+	tests := []struct {
+		name          string
+		getFunc       func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error)
+		setKubeClient bool
+		kubeClientObj []runtime.Object
+		expectLimits  uploader.BandwidthLimits
+		expectLog     string
+	}{
+		{
+			name: "failed to get configs",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return nil, errors.New("fake-get-error")
+			},
+			expectLog: "Failed to get node agent configs",
+		},
+		{
+			name: "configs cm not found",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return nil, nil
+			},
+			expectLog: "Throttling configs are not found, data path bandwidth is unlimited",
+		},
+		{
+			name: "configs cm's data path throttling is nil",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{}, nil
+			},
+			expectLog: "Throttling configs are not found, data path bandwidth is unlimited",
+		},
+		{
+			name: "global bandwidth is invalid",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					DataPathThrottling: &nodeagent.DataPathThrottling{
+						GlobalConfig: nodeagent.Bandwidth{UploadMBps: -1},
+					},
+				}, nil
+			},
+			expectLog: "Global bandwidth {-1 0} is invalid, data path bandwidth is unlimited",
+		},
+		{
+			name: "global bandwidth only",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					DataPathThrottling: &nodeagent.DataPathThrottling{
+						GlobalConfig: global,
+					},
+				}, nil
+			},
+			expectLimits: globalLimits,
+		},
+		{
+			name: "node is not found",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					DataPathThrottling: &nodeagent.DataPathThrottling{
+						GlobalConfig: global,
+						PerNodeConfig: []nodeagent.RuledBandwidth{
+							{
+								Bandwidth: nodeagent.Bandwidth{UploadMBps: 1},
+							},
+						},
+					},
+				}, nil
+			},
+			setKubeClient: true,
+			expectLog:     fmt.Sprintf("Failed to get node info for %s, use the global bandwidth %v", nodeName, global),
+			expectLimits:  globalLimits,
+		},
+		{
+			name: "failed to get selector",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					DataPathThrottling: &nodeagent.DataPathThrottling{
+						GlobalConfig: global,
+						PerNodeConfig: []nodeagent.RuledBandwidth{
+							{
+								NodeSelector: invalidLabelSelector,
+								Bandwidth:    nodeagent.Bandwidth{UploadMBps: 1},
+							},
+						},
+					},
+				}, nil
+			},
+			setKubeClient: true,
+			kubeClientObj: []runtime.Object{node1},
+			expectLog:     fmt.Sprintf("Failed to parse rule with label selector %s, skip it", invalidLabelSelector.String()),
+			expectLimits:  globalLimits,
+		},
+		{
+			name: "rule bandwidth is invalid",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					DataPathThrottling: &nodeagent.DataPathThrottling{
+						GlobalConfig: global,
+						PerNodeConfig: []nodeagent.RuledBandwidth{
+							{
+								NodeSelector: validLabelSelector1,
+								Bandwidth:    nodeagent.Bandwidth{DownloadMBps: -1},
+							},
+						},
+					},
+				}, nil
+			},
+			setKubeClient: true,
+			kubeClientObj: []runtime.Object{node2},
+			expectLog:     fmt.Sprintf("Rule with label selector %s is with an invalid bandwidth %v, skip it", validLabelSelector1.String(), nodeagent.Bandwidth{DownloadMBps: -1}),
+			expectLimits:  globalLimits,
+		},
+		{
+			name: "label doesn't match",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					DataPathThrottling: &nodeagent.DataPathThrottling{
+						GlobalConfig: global,
+						PerNodeConfig: []nodeagent.RuledBandwidth{
+							{
+								NodeSelector: validLabelSelector1,
+								Bandwidth:    nodeagent.Bandwidth{UploadMBps: 1},
+							},
+						},
+					},
+				}, nil
+			},
+			setKubeClient: true,
+			kubeClientObj: []runtime.Object{node1},
+			expectLog:     fmt.Sprintf("Per node bandwidth for node %s is not found, use the global bandwidth %v", nodeName, global),
+			expectLimits:  globalLimits,
+		},
+		{
+			name: "match one rule",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					DataPathThrottling: &nodeagent.DataPathThrottling{
+						GlobalConfig: global,
+						PerNodeConfig: []nodeagent.RuledBandwidth{
+							{
+								NodeSelector: validLabelSelector1,
+								Bandwidth:    nodeagent.Bandwidth{UploadMBps: 5},
+							},
+						},
+					},
+				}, nil
+			},
+			setKubeClient: true,
+			kubeClientObj: []runtime.Object{node2},
+			expectLog:     fmt.Sprintf("Use the per node bandwidth %v over global bandwidth %v for node %s", nodeagent.Bandwidth{UploadMBps: 5}, global, nodeName),
+			expectLimits:  uploader.BandwidthLimits{UploadBytesPerSecond: 5 << 20},
+		},
+		{
+			name: "match multiple rules",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					DataPathThrottling: &nodeagent.DataPathThrottling{
+						GlobalConfig: global,
+						PerNodeConfig: []nodeagent.RuledBandwidth{
+							{
+								NodeSelector: validLabelSelector1,
+								Bandwidth:    nodeagent.Bandwidth{UploadMBps: 5, DownloadMBps: 30},
+							},
+							{
+								NodeSelector: validLabelSelector2,
+								Bandwidth:    nodeagent.Bandwidth{UploadMBps: 8, DownloadMBps: 3},
+							},
+						},
+					},
+				}, nil
+			},
+			setKubeClient: true,
+			kubeClientObj: []runtime.Object{node2},
+			expectLog:     fmt.Sprintf("Use the per node bandwidth %v over global bandwidth %v for node %s", nodeagent.Bandwidth{UploadMBps: 5, DownloadMBps: 3}, global, nodeName),
+			expectLimits:  uploader.BandwidthLimits{UploadBytesPerSecond: 5 << 20, DownloadBytesPerSecond: 3 << 20},
+		},
+		{
+			name: "match multiple rules with unlimited",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					DataPathThrottling: &nodeagent.DataPathThrottling{
+						GlobalConfig: global,
+						PerNodeConfig: []nodeagent.RuledBandwidth{
+							{
+								NodeSelector: validLabelSelector1,
+								Bandwidth:    nodeagent.Bandwidth{DownloadMBps: 30},
+							},
+							{
+								NodeSelector: validLabelSelector2,
+								Bandwidth:    nodeagent.Bandwidth{UploadMBps: 8},
+							},
+						},
+					},
+				}, nil
+			},
+			setKubeClient: true,
+			kubeClientObj: []runtime.Object{node2},
+			expectLog:     fmt.Sprintf("Use the per node bandwidth %v over global bandwidth %v for node %s", nodeagent.Bandwidth{UploadMBps: 8, DownloadMBps: 30}, global, nodeName),
+			expectLimits:  uploader.BandwidthLimits{UploadBytesPerSecond: 8 << 20, DownloadBytesPerSecond: 30 << 20},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(test.kubeClientObj...)
+
+			logBuffer := ""
+
+			s := &nodeAgentServer{
+				nodeName: nodeName,
+				logger:   testutil.NewSingleLogger(&logBuffer),
+			}
+
+			if test.setKubeClient {
+				s.kubeClient = fakeKubeClient
+			}
+
+			getConfigsFunc = test.getFunc
+
+			limits := s.getDataPathBandwidthLimits()
+			assert.Equal(t, test.expectLimits, limits)
+			if test.expectLog == "" {
+				assert.Equal(t, "", logBuffer)
+			} else {
+				assert.True(t, strings.Contains(logBuffer, test.expectLog))
+			}
+		})
+	}
+}
+
+func Test_getKopiaCacheOptions(t *testing.T) {
+	nodeName := "node-agent-node"
+	node1 := builder.ForNode("node-agent-node").Result()
+	node2 := builder.ForNode("node-agent-node").Labels(map[string]string{
+		"disk": "nvme",
+	}).Result()
+
+	validLabelSelector := metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			"disk": "nvme",
+		},
+	}
+	otherLabelSelector := metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			"disk": "ssd",
+		},
+	}
+
+	global := nodeagent.KopiaCache{ContentCacheLimitMB: 500, MetadataCacheLimitMB: 100}
+	globalOptions := uploader.CacheOptions{ContentCacheLimitBytes: 500 << 20, MetadataCacheLimitBytes: 100 << 20}
+	nvme := nodeagent.KopiaCache{CacheDir: "/nvme/kopia", ContentCacheLimitMB: 20000, MinEvictionAge: &metav1.Duration{Duration: time.Hour}}
+
+	tests := []struct {
+		name          string
+		getFunc       func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error)
+		setKubeClient bool
+		kubeClientObj []runtime.Object
+		expectOptions uploader.CacheOptions
+		expectLog     string
+	}{
+		{
+			name: "failed to get configs",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return nil, errors.New("fake-get-error")
+			},
+			expectLog: "Failed to get node agent configs",
+		},
+		{
+			name: "kopia cache configs are not found",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{}, nil
+			},
+			expectLog: "Kopia cache configs are not found, use the default kopia cache",
+		},
+		{
+			name: "global kopia cache is invalid",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					KopiaCache: &nodeagent.KopiaCacheConfigs{
+						GlobalConfig: nodeagent.KopiaCache{CacheDir: "relative/dir"},
+					},
+				}, nil
+			},
+			expectLog: "is invalid, use the default kopia cache",
+		},
+		{
+			name: "global kopia cache only",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					KopiaCache: &nodeagent.KopiaCacheConfigs{
+						GlobalConfig: global,
+					},
+				}, nil
+			},
+			expectOptions: globalOptions,
+		},
+		{
+			name: "node is not found",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					KopiaCache: &nodeagent.KopiaCacheConfigs{
+						GlobalConfig:  global,
+						PerNodeConfig: []nodeagent.RuledKopiaCache{{NodeSelector: validLabelSelector, KopiaCache: nvme}},
+					},
+				}, nil
+			},
+			setKubeClient: true,
+			expectLog:     "Failed to get node info for node-agent-node, use the global kopia cache",
+			expectOptions: globalOptions,
+		},
+		{
+			name: "rule doesn't match the node",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					KopiaCache: &nodeagent.KopiaCacheConfigs{
+						GlobalConfig:  global,
+						PerNodeConfig: []nodeagent.RuledKopiaCache{{NodeSelector: validLabelSelector, KopiaCache: nvme}},
+					},
+				}, nil
+			},
+			setKubeClient: true,
+			kubeClientObj: []runtime.Object{node1},
+			expectLog:     "Per node kopia cache for node node-agent-node is not found, use the global kopia cache",
+			expectOptions: globalOptions,
+		},
+		{
+			name: "invalid rule is skipped",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					KopiaCache: &nodeagent.KopiaCacheConfigs{
+						GlobalConfig: global,
+						PerNodeConfig: []nodeagent.RuledKopiaCache{
+							{NodeSelector: validLabelSelector, KopiaCache: nodeagent.KopiaCache{ContentCacheLimitMB: -1}},
+						},
+					},
+				}, nil
+			},
+			setKubeClient: true,
+			kubeClientObj: []runtime.Object{node2},
+			expectLog:     "is with an invalid kopia cache",
+			expectOptions: globalOptions,
+		},
+		{
+			name: "first matched rule is used",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					KopiaCache: &nodeagent.KopiaCacheConfigs{
+						GlobalConfig: global,
+						PerNodeConfig: []nodeagent.RuledKopiaCache{
+							{NodeSelector: otherLabelSelector, KopiaCache: nodeagent.KopiaCache{ContentCacheLimitMB: 1000}},
+							{NodeSelector: validLabelSelector, KopiaCache: nvme},
+							{NodeSelector: validLabelSelector, KopiaCache: nodeagent.KopiaCache{ContentCacheLimitMB: 2000}},
+						},
+					},
+				}, nil
+			},
+			setKubeClient: true,
+			kubeClientObj: []runtime.Object{node2},
+			expectLog:     "Use the per node kopia cache",
+			expectOptions: uploader.CacheOptions{CacheDir: "/nvme/kopia", ContentCacheLimitBytes: 20000 << 20, MinEvictionAge: time.Hour},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(test.kubeClientObj...)
+
+			logBuffer := ""
+
+			s := &nodeAgentServer{
+				nodeName: nodeName,
+				logger:   testutil.NewSingleLogger(&logBuffer),
+			}
+
+			if test.setKubeClient {
+				s.kubeClient = fakeKubeClient
+			}
+
+			getConfigsFunc = test.getFunc
+
+			options := s.getKopiaCacheOptions()
+			assert.Equal(t, test.expectOptions, options)
+			if test.expectLog == "" {
+				assert.Equal(t, "", logBuffer)
+			} else {
+				assert.True(t, strings.Contains(logBuffer, test.expectLog))
+			}
+		})
+	}
+}
+
+func Test_getDataPathPerNamespaceConcurrentNum(t *testing.T) {
+	tests := []struct {
+		name      string
+		getFunc   func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error)
+		expectNum map[string]int
+		expectLog string
+	}{
+		{
+			name: "failed to get configs",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return nil, errors.New("fake-get-error")
+			},
+			expectLog: "Failed to get node agent configs",
+		},
+		{
+			name: "configs cm not found",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return nil, nil
+			},
+		},
+		{
+			name: "per namespace configs are not found",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					DataPathConcurrency: &nodeagent.DataPathConcurrency{
+						GlobalConfig: 5,
+					},
+				}, nil
+			},
+		},
+		{
+			name: "rule number is invalid",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					DataPathConcurrency: &nodeagent.DataPathConcurrency{
+						PerNamespaceConfig: []nodeagent.RuledNamespaceConfigs{
+							{
+								Namespaces: []string{"ns-1"},
+								Number:     0,
+							},
+						},
+					},
+				}, nil
+			},
+			expectNum: map[string]int{},
+			expectLog: fmt.Sprintf("Rule with namespaces %v is with an invalid number %v, skip it", []string{"ns-1"}, 0),
+		},
+		{
+			name: "match multiple rules",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					DataPathConcurrency: &nodeagent.DataPathConcurrency{
+						PerNamespaceConfig: []nodeagent.RuledNamespaceConfigs{
+							{
+								Namespaces: []string{"ns-1", "ns-2"},
+								Number:     3,
+							},
+							{
+								Namespaces: []string{"ns-2", "ns-3"},
+								Number:     1,
+							},
+						},
+					},
+				}, nil
+			},
+			expectNum: map[string]int{"ns-1": 3, "ns-2": 1, "ns-3": 1},
+			expectLog: fmt.Sprintf("Use the per namespace number %v", map[string]int{"ns-1": 3, "ns-2": 1, "ns-3": 1}),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			logBuffer := ""
+
+			s := &nodeAgentServer{
+				logger: testutil.NewSingleLogger(&logBuffer),
+			}
+
+			getConfigsFunc = test.getFunc
+
+			num := s.getDataPathPerNamespaceConcurrentNum()
+			assert.Equal(t, test.expectNum, num)
+			if test.expectLog == "" {
+				assert.Equal(t, "", logBuffer)
+			} else {
+				assert.True(t, strings.Contains(logBuffer, test.expectLog))
+			}
+		})
+	}
+}
+
+func Test_getDataPathTaskTypeConcurrentNum(t *testing.T) {
+	tests := []struct {
+		name             string
+		getFunc          func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error)
+		expectBackupNum  int
+		expectRestoreNum int
+		expectLog        string
+	}{
+		{
+			name: "failed to get configs",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return nil, errors.New("fake-get-error")
+			},
+			expectLog: "Failed to get node agent configs",
+		},
+		{
+			name: "configs cm not found",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return nil, nil
+			},
+		},
+		{
+			name: "backup and restore numbers are not found",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					DataPathConcurrency: &nodeagent.DataPathConcurrency{
+						GlobalConfig: 5,
+					},
+				}, nil
+			},
+		},
+		{
+			name: "backup number is invalid",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					DataPathConcurrency: &nodeagent.DataPathConcurrency{
+						BackupConcurrency:  -1,
+						RestoreConcurrency: 8,
+					},
+				}, nil
+			},
+			expectRestoreNum: 8,
+			expectLog:        "Backup number -1 is invalid, ignore it",
+		},
+		{
+			name: "backup and restore numbers",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{
+					DataPathConcurrency: &nodeagent.DataPathConcurrency{
+						GlobalConfig:       2,
+						BackupConcurrency:  1,
+						RestoreConcurrency: 8,
+					},
+				}, nil
+			},
+			expectBackupNum:  1,
+			expectRestoreNum: 8,
+			expectLog:        "Use the backup number 1 and the restore number 8",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			logBuffer := ""
+
+			s := &nodeAgentServer{
+				logger: testutil.NewSingleLogger(&logBuffer),
+			}
+
+			getConfigsFunc = test.getFunc
+
+			backupNum, restoreNum := s.getDataPathTaskTypeConcurrentNum()
+			assert.Equal(t, test.expectBackupNum, backupNum)
+			assert.Equal(t, test.expectRestoreNum, restoreNum)
+			if test.expectLog == "" {
+				assert.Equal(t, "", logBuffer)
+			} else {
+				assert.True(t, strings.Contains(logBuffer, test.expectLog))
+			}
+		})
+	}
+}
+
+func Test_getMemoryWatermark(t *testing.T) {
+	tests := []struct {
+		name            string
+		getFunc         func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error)
+		expectWatermark *nodeagent.MemoryWatermark
+		expectLog       string
+	}{
+		{
+			name: "failed to get configs",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return nil, errors.New("fake-get-error")
+			},
+			expectLog: "Failed to get node agent configs",
+		},
+		{
+			name: "memory watermark configs are not found",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{}, nil
+			},
+			expectLog: "Memory watermark configs are not found, data path concurrency is not throttled by memory usage",
+		},
+		{
+			name: "high watermark is invalid",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{MemoryWatermark: &nodeagent.MemoryWatermark{HighPercent: 120}}, nil
+			},
+			expectLog: "High memory watermark 120 is invalid, data path concurrency is not throttled by memory usage",
+		},
+		{
+			name: "defaults are applied",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{MemoryWatermark: &nodeagent.MemoryWatermark{HighPercent: 80}}, nil
+			},
+			expectWatermark: &nodeagent.MemoryWatermark{HighPercent: 80, LowPercent: 70, ThrottledConcurrency: 1},
+			expectLog:       "Use the memory watermark",
+		},
+		{
+			name: "low watermark is invalid",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{MemoryWatermark: &nodeagent.MemoryWatermark{HighPercent: 8, LowPercent: 9, ThrottledConcurrency: 2}}, nil
+			},
+			expectWatermark: &nodeagent.MemoryWatermark{HighPercent: 8, LowPercent: 4, ThrottledConcurrency: 2},
+			expectLog:       "Low memory watermark 9 is invalid, use the default value",
+		},
+		{
+			name: "valid watermark",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{MemoryWatermark: &nodeagent.MemoryWatermark{HighPercent: 85, LowPercent: 60, ThrottledConcurrency: 2}}, nil
+			},
+			expectWatermark: &nodeagent.MemoryWatermark{HighPercent: 85, LowPercent: 60, ThrottledConcurrency: 2},
+			expectLog:       "Use the memory watermark",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			logBuffer := ""
+
+			s := &nodeAgentServer{
+				logger: testutil.NewSingleLogger(&logBuffer),
+			}
+
+			getConfigsFunc = test.getFunc
+
+			watermark := s.getMemoryWatermark()
+			assert.Equal(t, test.expectWatermark, watermark)
+			assert.True(t, strings.Contains(logBuffer, test.expectLog))
+		})
+	}
+}
+
+func Test_getResourceTimeouts(t *testing.T) {
+	tests := []struct {
+		name                       string
+		getFunc                    func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error)
+		config                     nodeAgentServerConfig
+		expectExposeTimeout        time.Duration
+		expectSnapshotReadyTimeout time.Duration
+	}{
+		{
+			name: "failed to get configs",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return nil, errors.New("fake-get-error")
+			},
+			config:                     nodeAgentServerConfig{dataMoverPrepareTimeout: 30 * time.Minute},
+			expectExposeTimeout:        30 * time.Minute,
+			expectSnapshotReadyTimeout: 30 * time.Minute,
+		},
+		{
+			name: "timeouts from the flags",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{}, nil
+			},
+			config:                     nodeAgentServerConfig{dataMoverPrepareTimeout: 30 * time.Minute, snapshotReadyTimeout: 5 * time.Minute},
+			expectExposeTimeout:        30 * time.Minute,
+			expectSnapshotReadyTimeout: 5 * time.Minute,
+		},
+		{
+			name: "timeouts from the configs override the flags",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{ResourceTimeouts: &nodeagent.ResourceTimeouts{
+					VolumeSnapshotReady: &metav1.Duration{Duration: 2 * time.Hour},
+					DataMoverExpose:     &metav1.Duration{Duration: 3 * time.Hour},
+				}}, nil
+			},
+			config:                     nodeAgentServerConfig{dataMoverPrepareTimeout: 30 * time.Minute, snapshotReadyTimeout: 5 * time.Minute},
+			expectExposeTimeout:        3 * time.Hour,
+			expectSnapshotReadyTimeout: 2 * time.Hour,
+		},
+		{
+			name: "snapshot ready timeout defaults to the expose timeout",
+			getFunc: func(context.Context, string, kubernetes.Interface) (*nodeagent.Configs, error) {
+				return &nodeagent.Configs{ResourceTimeouts: &nodeagent.ResourceTimeouts{
+					DataMoverExpose: &metav1.Duration{Duration: time.Hour},
+				}}, nil
+			},
+			config:                     nodeAgentServerConfig{dataMoverPrepareTimeout: 30 * time.Minute},
+			expectExposeTimeout:        time.Hour,
+			expectSnapshotReadyTimeout: time.Hour,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &nodeAgentServer{
+				logger: testutil.NewLogger(),
+				config: test.config,
+			}
+
+			getConfigsFunc = test.getFunc
+
+			exposeTimeout, snapshotReadyTimeout := s.getResourceTimeouts()
+			assert.Equal(t, test.expectExposeTimeout, exposeTimeout)
+			assert.Equal(t, test.expectSnapshotReadyTimeout, snapshotReadyTimeout)
+		})
+	}
+}
+
+func Test_checkMemoryWatermark(t *testing.T) {
+	tests := []struct {
+		name            string
+		usage           *nodeagent.MemoryUsage
+		usageErr        error
+		throttled       int
+		expectThrottled int
+		expectLog       string
+	}{
+		{
+			name:     "failed to get memory usage",
+			usageErr: errors.New("fake-usage-error"),
+		},
+		{
+			name:            "memory is unlimited",
+			usage:           &nodeagent.MemoryUsage{WorkingSet: 100},
+			throttled:       2,
+			expectThrottled: 2,
+		},
+		{
+			name:            "memory usage crosses the high watermark",
+			usage:           &nodeagent.MemoryUsage{WorkingSet: 85, Limit: 100},
+			expectThrottled: 2,
+			expectLog:       "Memory usage 85% (85 of 100 bytes) crosses the high watermark 80%, throttle data path concurrency to 2",
+		},
+		{
+			name:            "memory usage is between the watermarks",
+			usage:           &nodeagent.MemoryUsage{WorkingSet: 65, Limit: 100},
+			throttled:       2,
+			expectThrottled: 2,
+		},
+		{
+			name:      "memory usage drops below the low watermark",
+			usage:     &nodeagent.MemoryUsage{WorkingSet: 50, Limit: 100},
+			throttled: 2,
+			expectLog: "Memory usage 50% (50 of 100 bytes) drops below the low watermark 60%, resume data path concurrency",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			logBuffer := ""
+
+			s := &nodeAgentServer{
+				logger:          testutil.NewSingleLogger(&logBuffer),
+				dataPathMgr:     datapath.NewManager(5, nil, uploader.BandwidthLimits{}),
+				memoryWatermark: &nodeagent.MemoryWatermark{HighPercent: 80, LowPercent: 60, ThrottledConcurrency: 2},
+			}
+			s.dataPathMgr.SetThrottledConcurrentNum(test.throttled)
+
+			getMemoryUsageFunc = func(filesystem.Interface) (*nodeagent.MemoryUsage, error) {
+				return test.usage, test.usageErr
+			}
+
+			s.checkMemoryWatermark()
+			assert.Equal(t, test.expectThrottled, s.dataPathMgr.ThrottledConcurrentNum())
+			assert.True(t, strings.Contains(logBuffer, test.expectLog))
+		})
+	}
+}
+
+func Test_checkBackupWindow(t *testing.T) {
+	tests := []struct {
+		name         string
+		window       map[string]string
+		now          time.Time
+		dataUpload   *velerov2alpha1api.DataUpload
+		suspended    bool
+		expectPaused bool
+		expectMarked bool
+	}{
+		{
+			name:       "no window",
+			now:        time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			dataUpload: builder.ForDataUpload("velero", "du-1").Node("node-1").Phase(velerov2alpha1api.DataUploadPhaseInProgress).Result(),
+		},
+		{
+			name:       "window closed without suspending data paths",
+			window:     map[string]string{"start": "22:00", "end": "06:00"},
+			now:        time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			dataUpload: builder.ForDataUpload("velero", "du-1").Node("node-1").Phase(velerov2alpha1api.DataUploadPhaseInProgress).Result(),
+		},
+		{
+			name:         "window closed, in-progress data upload is suspended",
+			window:       map[string]string{"start": "22:00", "end": "06:00", "suspendDataPaths": "true"},
+			now:          time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			dataUpload:   builder.ForDataUpload("velero", "du-1").Node("node-1").Phase(velerov2alpha1api.DataUploadPhaseInProgress).Result(),
+			expectPaused: true,
+			expectMarked: true,
+		},
+		{
+			name:       "window closed, data upload of other node is skipped",
+			window:     map[string]string{"start": "22:00", "end": "06:00", "suspendDataPaths": "true"},
+			now:        time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			dataUpload: builder.ForDataUpload("velero", "du-1").Node("node-2").Phase(velerov2alpha1api.DataUploadPhaseInProgress).Result(),
+		},
+		{
+			name:         "window closed, data upload paused by user isn't marked",
+			window:       map[string]string{"start": "22:00", "end": "06:00", "suspendDataPaths": "true"},
+			now:          time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			dataUpload:   builder.ForDataUpload("velero", "du-1").Node("node-1").Phase(velerov2alpha1api.DataUploadPhasePaused).Paused(true).Result(),
+			expectPaused: true,
+		},
+		{
+			name:       "window opens, suspended data upload is resumed",
+			window:     map[string]string{"start": "22:00", "end": "06:00", "suspendDataPaths": "true"},
+			now:        time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC),
+			dataUpload: builder.ForDataUpload("velero", "du-1").Node("node-1").Phase(velerov2alpha1api.DataUploadPhasePaused).Paused(true).Result(),
+			suspended:  true,
+		},
+		{
+			name:         "window opens, data upload paused by user isn't resumed",
+			window:       map[string]string{"start": "22:00", "end": "06:00", "suspendDataPaths": "true"},
+			now:          time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC),
+			dataUpload:   builder.ForDataUpload("velero", "du-1").Node("node-1").Phase(velerov2alpha1api.DataUploadPhasePaused).Paused(true).Result(),
+			expectPaused: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.suspended {
+				test.dataUpload.Annotations = map[string]string{backupwindow.SuspendedAnnotation: "true"}
+			}
+
+			objs := []runtime.Object{test.dataUpload}
+			if test.window != nil {
+				objs = append(objs, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "backup-window"},
+					Data:       test.window,
+				})
+			}
+			client := testutil.NewFakeControllerRuntimeClient(t, objs...)
+
+			logBuffer := ""
+			s := &nodeAgentServer{
+				ctx:       context.Background(),
+				logger:    testutil.NewSingleLogger(&logBuffer),
+				namespace: "velero",
+				nodeName:  "node-1",
+				config:    nodeAgentServerConfig{backupWindowConfigMap: "backup-window"},
+			}
+
+			s.checkBackupWindow(client, test.now)
+
+			du := &velerov2alpha1api.DataUpload{}
+			require.NoError(t, client.Get(context.Background(), ctrlclient.ObjectKeyFromObject(test.dataUpload), du))
+			assert.Equal(t, test.expectPaused, du.Spec.Paused)
+			_, marked := du.Annotations[backupwindow.SuspendedAnnotation]
+			assert.Equal(t, test.expectMarked, marked)
+		})
+	}
+}
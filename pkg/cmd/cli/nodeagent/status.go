@@ -0,0 +1,128 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeagent
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewStatusCommand(f client.Factory) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "status [NODE...]",
+		Short: "Print the data path status of node-agents",
+		Long:  "Print the running and queued data path instances as well as the configured concurrent limits of node-agents, so that it's clear why backups and restores are queued",
+		Run: func(c *cobra.Command, args []string) {
+			kbClient, err := f.KubebuilderClient()
+			cmd.CheckError(err)
+
+			statuses, err := getNodeAgentStatuses(context.Background(), kbClient, f.Namespace(), args)
+			cmd.CheckError(err)
+
+			printNodeAgentStatuses(os.Stdout, statuses, time.Now())
+		},
+	}
+
+	return c
+}
+
+func getNodeAgentStatuses(ctx context.Context, kbClient kbclient.Client, namespace string, nodes []string) ([]velerov2alpha1api.NodeAgentStatus, error) {
+	list := &velerov2alpha1api.NodeAgentStatusList{}
+	if err := kbClient.List(ctx, list, kbclient.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	selected := sets.NewString(nodes...)
+	found := sets.NewString()
+	var statuses []velerov2alpha1api.NodeAgentStatus
+	for _, status := range list.Items {
+		if selected.Len() > 0 && !selected.Has(status.Name) {
+			continue
+		}
+		statuses = append(statuses, status)
+		found.Insert(status.Name)
+	}
+
+	if missing := selected.Difference(found); missing.Len() > 0 {
+		return nil, fmt.Errorf("node-agent status of node %s is not found", strings.Join(missing.List(), ", "))
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+
+	return statuses, nil
+}
+
+func printNodeAgentStatuses(w io.Writer, statuses []velerov2alpha1api.NodeAgentStatus, now time.Time) {
+	if len(statuses) == 0 {
+		fmt.Fprintln(w, "No node-agent status found")
+		return
+	}
+
+	for i, status := range statuses {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+
+		fmt.Fprintf(w, "Node: %s\n", status.Name)
+
+		lastUpdate := "<never>"
+		if status.Status.LastUpdateTime != nil {
+			lastUpdate = fmt.Sprintf("%s (%s ago)", status.Status.LastUpdateTime.Time.Format(time.RFC3339),
+				now.Sub(status.Status.LastUpdateTime.Time).Truncate(time.Second))
+		}
+		fmt.Fprintf(w, "\tLast update: %s\n", lastUpdate)
+
+		fmt.Fprintf(w, "\tConcurrent limit: %d\n", status.Status.ConcurrentLimit)
+		if len(status.Status.PerNamespaceConcurrentLimits) > 0 {
+			var limits []string
+			for ns, limit := range status.Status.PerNamespaceConcurrentLimits {
+				limits = append(limits, fmt.Sprintf("%s=%d", ns, limit))
+			}
+			sort.Strings(limits)
+			fmt.Fprintf(w, "\tPer namespace concurrent limits: %s\n", strings.Join(limits, ", "))
+		}
+
+		printNames(w, "Running data paths", status.Status.RunningDataPaths)
+		printNames(w, "Queued DataUploads", status.Status.QueuedDataUploads)
+		printNames(w, "Queued DataDownloads", status.Status.QueuedDataDownloads)
+		printNames(w, "Queued PodVolumeBackups", status.Status.QueuedPodVolumeBackups)
+	}
+}
+
+func printNames(w io.Writer, title string, names []string) {
+	if len(names) == 0 {
+		fmt.Fprintf(w, "\t%s: <none>\n", title)
+		return
+	}
+
+	fmt.Fprintf(w, "\t%s (%d): %s\n", title, len(names), strings.Join(names, ", "))
+}
@@ -0,0 +1,140 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeagent
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func nodeAgentStatus(node string) *velerov2alpha1api.NodeAgentStatus {
+	return &velerov2alpha1api.NodeAgentStatus{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      node,
+		},
+	}
+}
+
+func TestGetNodeAgentStatuses(t *testing.T) {
+	objs := []runtime.Object{nodeAgentStatus("node-2"), nodeAgentStatus("node-1"), nodeAgentStatus("node-3")}
+
+	tests := []struct {
+		name          string
+		nodes         []string
+		expectedNodes []string
+		expectedErr   string
+	}{
+		{
+			name:          "all nodes",
+			expectedNodes: []string{"node-1", "node-2", "node-3"},
+		},
+		{
+			name:          "selected nodes",
+			nodes:         []string{"node-3", "node-1"},
+			expectedNodes: []string{"node-1", "node-3"},
+		},
+		{
+			name:        "node not found",
+			nodes:       []string{"node-1", "node-4"},
+			expectedErr: "node-agent status of node node-4 is not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kbClient := velerotest.NewFakeControllerRuntimeClient(t, objs...)
+
+			statuses, err := getNodeAgentStatuses(context.Background(), kbClient, "velero", test.nodes)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var nodes []string
+			for _, status := range statuses {
+				nodes = append(nodes, status.Name)
+			}
+			assert.Equal(t, test.expectedNodes, nodes)
+		})
+	}
+}
+
+func TestPrintNodeAgentStatuses(t *testing.T) {
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	busy := nodeAgentStatus("node-1")
+	busy.Status = velerov2alpha1api.NodeAgentDataPathStatus{
+		ConcurrentLimit:              2,
+		PerNamespaceConcurrentLimits: map[string]int{"ns-2": 1, "ns-1": 1},
+		RunningDataPaths:             []string{"du-1", "pvb-1"},
+		QueuedDataUploads:            []string{"du-2", "du-3"},
+		LastUpdateTime:               &metav1.Time{Time: now.Add(-10 * time.Second)},
+	}
+	idle := nodeAgentStatus("node-2")
+
+	tests := []struct {
+		name     string
+		statuses []velerov2alpha1api.NodeAgentStatus
+		expected string
+	}{
+		{
+			name:     "no status",
+			expected: "No node-agent status found\n",
+		},
+		{
+			name:     "multiple nodes",
+			statuses: []velerov2alpha1api.NodeAgentStatus{*busy, *idle},
+			expected: `Node: node-1
+	Last update: 2023-10-01T11:59:50Z (10s ago)
+	Concurrent limit: 2
+	Per namespace concurrent limits: ns-1=1, ns-2=1
+	Running data paths (2): du-1, pvb-1
+	Queued DataUploads (2): du-2, du-3
+	Queued DataDownloads: <none>
+	Queued PodVolumeBackups: <none>
+
+Node: node-2
+	Last update: <never>
+	Concurrent limit: 0
+	Running data paths: <none>
+	Queued DataUploads: <none>
+	Queued DataDownloads: <none>
+	Queued PodVolumeBackups: <none>
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			printNodeAgentStatuses(buf, test.statuses, now)
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewMaintenanceCommand(f client.Factory, use string) *cobra.Command {
+	c := &cobra.Command{
+		Use:   use,
+		Short: "Work with the maintenance of repositories",
+		Long:  "Work with the maintenance of repositories",
+	}
+
+	c.AddCommand(
+		NewMaintenanceRunCommand(f, "run"),
+	)
+
+	return c
+}
+
+func NewMaintenanceRunCommand(f client.Factory, use string) *cobra.Command {
+	o := NewMaintenanceRunOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Run the full maintenance of a repository",
+		Long: `Run the full maintenance of a repository on demand.
+
+The maintenance runs once the repository is ready, regardless of its maintenance frequency and window.
+The next scheduled maintenance is due after the maintenance frequency from then.`,
+		Example: `  # Run the full maintenance of the repository "app-default-kopia-abcde".
+  velero repo maintenance run app-default-kopia-abcde
+
+  # Run the full maintenance of the repository "app-default-kopia-abcde" and wait for it to complete.
+  velero repo maintenance run app-default-kopia-abcde --wait`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type MaintenanceRunOptions struct {
+	Name string
+	Wait bool
+
+	client kbclient.Client
+}
+
+func NewMaintenanceRunOptions() *MaintenanceRunOptions {
+	return &MaintenanceRunOptions{}
+}
+
+func (o *MaintenanceRunOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "Wait for the maintenance to complete.")
+}
+
+func (o *MaintenanceRunOptions) Complete(args []string, f client.Factory) error {
+	o.Name = args[0]
+
+	client, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.client = client
+
+	return nil
+}
+
+func (o *MaintenanceRunOptions) Run(c *cobra.Command, f client.Factory) error {
+	requestTime := time.Now()
+	repo, err := requestMaintenance(o.client, f.Namespace(), o.Name, requestTime)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Maintenance of backup repository %q requested successfully.\n", repo.Name)
+	if repo.Status.Phase != velerov1api.BackupRepositoryPhaseReady {
+		fmt.Printf("The backup repository is %s, the maintenance runs once it's ready.\n", repo.Status.Phase)
+	}
+	if !o.Wait {
+		fmt.Printf("Run `velero repo get %s` for the last maintenance time.\n", repo.Name)
+		return nil
+	}
+
+	fmt.Println("Waiting for the maintenance to complete. You may safely press ctrl-c to stop waiting - your maintenance will continue in the background.")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key := kbclient.ObjectKey{Namespace: f.Namespace(), Name: o.Name}
+	wait.Until(func() {
+		updated := &velerov1api.BackupRepository{}
+		if err := o.client.Get(ctx, key, updated); err != nil {
+			return
+		}
+
+		fmt.Print(".")
+
+		// the annotation is removed by the controller once the maintenance completes
+		if _, ok := updated.Annotations[velerov1api.MaintenanceRequestedAnnotation]; !ok {
+			repo = updated
+			cancel()
+		}
+	}, time.Second, ctx.Done())
+
+	if repo.Status.LastMaintenanceTime != nil && !repo.Status.LastMaintenanceTime.Time.Before(requestTime.Truncate(time.Second)) {
+		fmt.Println("\nMaintenance of backup repository completed.")
+		return nil
+	}
+
+	return errors.Errorf("maintenance of backup repository %s failed: %s", repo.Name, repo.Status.Message)
+}
+
+// requestMaintenance annotates the backup repository to request a full maintenance.
+func requestMaintenance(kbClient kbclient.Client, namespace, name string, now time.Time) (*velerov1api.BackupRepository, error) {
+	repo := &velerov1api.BackupRepository{}
+	if err := kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: namespace, Name: name}, repo); err != nil {
+		return nil, errors.Wrapf(err, "error getting backup repository %s", name)
+	}
+
+	original := repo.DeepCopy()
+	if repo.Annotations == nil {
+		repo.Annotations = map[string]string{}
+	}
+	repo.Annotations[velerov1api.MaintenanceRequestedAnnotation] = now.UTC().Format(time.RFC3339)
+	if err := kbClient.Patch(context.Background(), repo, kbclient.MergeFrom(original)); err != nil {
+		return nil, errors.Wrapf(err, "error requesting maintenance of backup repository %s", name)
+	}
+
+	return repo, nil
+}
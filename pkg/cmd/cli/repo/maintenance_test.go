@@ -0,0 +1,48 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRequestMaintenance(t *testing.T) {
+	client := velerotest.NewFakeControllerRuntimeClient(t, &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "repo-1"},
+	})
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	_, err := requestMaintenance(client, velerov1api.DefaultNamespace, "not-exist", now)
+	assert.Error(t, err)
+
+	_, err = requestMaintenance(client, velerov1api.DefaultNamespace, "repo-1", now)
+	require.NoError(t, err)
+
+	repo := &velerov1api.BackupRepository{}
+	require.NoError(t, client.Get(context.Background(), kbclient.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: "repo-1"}, repo))
+	assert.Equal(t, "2024-01-01T12:00:00Z", repo.Annotations[velerov1api.MaintenanceRequestedAnnotation])
+}
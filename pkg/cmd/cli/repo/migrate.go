@@ -0,0 +1,166 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewMigrateCommand(f client.Factory, use string) *cobra.Command {
+	o := NewMigrateOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAMESPACE",
+		Short: "Migrate the restic snapshots of a namespace to the kopia repository",
+		Long: `Migrate the restic snapshots of the pod volume backups of a namespace to the kopia repository.
+
+The Velero server restores each restic snapshot into a temporary directory and uploads it into the kopia repository,
+then updates the PodVolumeBackup to reference the kopia snapshot, so that the existing backups remain restorable
+after switching the uploader type from restic to kopia. The restic repository isn't changed.`,
+		Example: `  # Migrate the restic snapshots of the namespace "app" in the default backup storage location.
+  velero repo migrate app
+
+  # Migrate the restic snapshots of the namespace "app" in the backup storage location "secondary" and wait for it to complete.
+  velero repo migrate app --backup-storage-location secondary --wait`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type MigrateOptions struct {
+	VolumeNamespace       string
+	BackupStorageLocation string
+	Wait                  bool
+
+	client kbclient.Client
+}
+
+func NewMigrateOptions() *MigrateOptions {
+	return &MigrateOptions{}
+}
+
+func (o *MigrateOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.BackupStorageLocation, "backup-storage-location", "", "Backup storage location of the repositories. If not set, the default backup storage location is used.")
+	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "Wait for the migration to complete.")
+}
+
+func (o *MigrateOptions) Complete(args []string, f client.Factory) error {
+	o.VolumeNamespace = args[0]
+
+	client, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.client = client
+
+	if o.BackupStorageLocation == "" {
+		location, err := getDefaultBackupStorageLocation(o.client, f.Namespace())
+		if err != nil {
+			return err
+		}
+		o.BackupStorageLocation = location
+	}
+
+	return nil
+}
+
+func (o *MigrateOptions) Run(c *cobra.Command, f client.Factory) error {
+	migration := builder.ForBackupRepositoryMigration(f.Namespace(), "").
+		ObjectMeta(builder.WithGenerateName(o.VolumeNamespace + "-")).
+		VolumeNamespace(o.VolumeNamespace).
+		BackupStorageLocation(o.BackupStorageLocation).
+		Result()
+
+	if err := client.CreateRetryGenerateName(o.client, context.Background(), migration); err != nil {
+		return err
+	}
+
+	fmt.Printf("Backup repository migration %q submitted successfully.\n", migration.Name)
+	if !o.Wait {
+		fmt.Printf("Run `kubectl -n %s get backuprepositorymigrations %s -o yaml` for more details.\n", f.Namespace(), migration.Name)
+		return nil
+	}
+
+	fmt.Println("Waiting for the migration to complete. You may safely press ctrl-c to stop waiting - your migration will continue in the background.")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key := kbclient.ObjectKey{Namespace: f.Namespace(), Name: migration.Name}
+	wait.Until(func() {
+		updated := &velerov2alpha1api.BackupRepositoryMigration{}
+		if err := o.client.Get(ctx, key, updated); err != nil {
+			return
+		}
+
+		fmt.Print(".")
+
+		switch updated.Status.Phase {
+		case velerov2alpha1api.BackupRepositoryMigrationPhaseCompleted, velerov2alpha1api.BackupRepositoryMigrationPhasePartiallyFailed,
+			velerov2alpha1api.BackupRepositoryMigrationPhaseFailed:
+			migration = updated
+			cancel()
+		}
+	}, time.Second, ctx.Done())
+
+	fmt.Printf("\nBackup repository migration completed with status: %s. %d of %d pod volume backups are migrated.\n",
+		migration.Status.Phase, migration.Status.MigratedPodVolumeBackups, migration.Status.TotalPodVolumeBackups)
+	if migration.Status.Message != "" {
+		fmt.Printf("Message: %s\n", migration.Status.Message)
+	}
+	if len(migration.Status.FailedPodVolumeBackups) > 0 {
+		fmt.Printf("Failed pod volume backups: %v\n", migration.Status.FailedPodVolumeBackups)
+	}
+
+	return nil
+}
+
+func getDefaultBackupStorageLocation(kbClient kbclient.Client, namespace string) (string, error) {
+	locations := &velerov1api.BackupStorageLocationList{}
+	if err := kbClient.List(context.Background(), locations, kbclient.InNamespace(namespace)); err != nil {
+		return "", errors.Wrap(err, "error listing backup storage locations")
+	}
+
+	for _, location := range locations.Items {
+		if location.Spec.Default {
+			return location.Name, nil
+		}
+	}
+
+	return "", errors.New("no default backup storage location found, please specify one with --backup-storage-location")
+}
@@ -0,0 +1,46 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetDefaultBackupStorageLocation(t *testing.T) {
+	client := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "primary").Result(),
+	)
+
+	_, err := getDefaultBackupStorageLocation(client, velerov1api.DefaultNamespace)
+	assert.EqualError(t, err, "no default backup storage location found, please specify one with --backup-storage-location")
+
+	client = velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "primary").Result(),
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "secondary").Default(true).Result(),
+	)
+
+	location, err := getDefaultBackupStorageLocation(client, velerov1api.DefaultNamespace)
+	require.NoError(t, err)
+	assert.Equal(t, "secondary", location)
+}
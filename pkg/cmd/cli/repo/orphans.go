@@ -0,0 +1,260 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewOrphansCommand(f client.Factory, use string) *cobra.Command {
+	c := &cobra.Command{
+		Use:   use,
+		Short: "Work with the orphaned snapshots of repositories",
+		Long: `Work with the orphaned snapshots of kopia repositories.
+
+A snapshot is orphaned if it isn't referenced by any pod volume backup or data upload in the cluster, or by any
+backup in the backup storage location of the repository, e.g. the snapshots left by the backups interrupted by
+a crash of the node-agent or the Velero server. The snapshots written in the last hour are never orphaned, as
+the backups writing them may still be in progress.`,
+	}
+
+	c.AddCommand(
+		NewOrphansListCommand(f, "list"),
+		NewOrphansPruneCommand(f, "prune"),
+	)
+
+	return c
+}
+
+func NewOrphansListCommand(f client.Factory, use string) *cobra.Command {
+	o := NewOrphansOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "List the orphaned snapshots of a repository",
+		Long: `List the orphaned snapshots of a kopia repository.
+
+The Velero server scans the repository once it's ready, the command waits for the scan to complete.`,
+		Example: `  # List the orphaned snapshots of the repository "app-default-kopia-abcde".
+  velero repo orphans list app-default-kopia-abcde`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.RunList(c, f))
+		},
+	}
+
+	return c
+}
+
+func NewOrphansPruneCommand(f client.Factory, use string) *cobra.Command {
+	o := NewOrphansOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Delete the orphaned snapshots of a repository",
+		Long: `Delete the orphaned snapshots of a kopia repository.
+
+Without --confirm, the orphaned snapshots are only listed. With --confirm, the Velero server scans the repository
+again and deletes the orphaned snapshots it finds. The data of the deleted snapshots is freed by the next maintenance
+of the repository.`,
+		Example: `  # List the orphaned snapshots of the repository "app-default-kopia-abcde" to be deleted.
+  velero repo orphans prune app-default-kopia-abcde
+
+  # Delete the orphaned snapshots of the repository "app-default-kopia-abcde".
+  velero repo orphans prune app-default-kopia-abcde --confirm`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.RunPrune(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type OrphansOptions struct {
+	Name    string
+	Confirm bool
+
+	client       kbclient.Client
+	out          io.Writer
+	pollInterval time.Duration
+}
+
+func NewOrphansOptions() *OrphansOptions {
+	return &OrphansOptions{
+		out:          os.Stdout,
+		pollInterval: time.Second,
+	}
+}
+
+func (o *OrphansOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.Confirm, "confirm", o.Confirm, "Confirm the deletion of the orphaned snapshots.")
+}
+
+func (o *OrphansOptions) Complete(args []string, f client.Factory) error {
+	o.Name = args[0]
+
+	client, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.client = client
+
+	return nil
+}
+
+func (o *OrphansOptions) RunList(c *cobra.Command, f client.Factory) error {
+	scan, err := o.scan(f.Namespace(), velerov1api.OrphanScanModeList)
+	if err != nil {
+		return err
+	}
+
+	o.printOrphanedSnapshots(scan.OrphanedSnapshots)
+	return nil
+}
+
+func (o *OrphansOptions) RunPrune(c *cobra.Command, f client.Factory) error {
+	if !o.Confirm {
+		scan, err := o.scan(f.Namespace(), velerov1api.OrphanScanModeList)
+		if err != nil {
+			return err
+		}
+
+		o.printOrphanedSnapshots(scan.OrphanedSnapshots)
+		if len(scan.OrphanedSnapshots) > 0 {
+			fmt.Fprintf(o.out, "Run `velero repo orphans prune %s --confirm` to delete them.\n", o.Name)
+		}
+		return nil
+	}
+
+	scan, err := o.scan(f.Namespace(), velerov1api.OrphanScanModePrune)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.out, "%d orphaned snapshots are deleted from backup repository %q.\n", scan.PrunedSnapshots, o.Name)
+	if len(scan.OrphanedSnapshots) > 0 {
+		fmt.Fprintln(o.out, "The orphaned snapshots failed to be deleted:")
+		o.printOrphanedSnapshots(scan.OrphanedSnapshots)
+	}
+	if scan.Message != "" {
+		return errors.Errorf("pruning backup repository %s failed: %s", o.Name, scan.Message)
+	}
+
+	return nil
+}
+
+// scan requests a scan of the orphaned snapshots of the backup repository and waits for the
+// scan to complete. An error is returned if the scan fails, except for the failed deletions of
+// a prune scan, which are returned along with the result.
+func (o *OrphansOptions) scan(namespace, mode string) (*velerov1api.BackupRepositoryOrphanScan, error) {
+	if _, err := requestOrphanScan(o.client, namespace, o.Name, mode); err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(o.out, "Scanning the orphaned snapshots of backup repository %q. You may safely press ctrl-c to stop waiting - the scan will continue in the background.\n", o.Name)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var repo *velerov1api.BackupRepository
+	key := kbclient.ObjectKey{Namespace: namespace, Name: o.Name}
+	wait.Until(func() {
+		updated := &velerov1api.BackupRepository{}
+		if err := o.client.Get(ctx, key, updated); err != nil {
+			return
+		}
+
+		fmt.Fprint(o.out, ".")
+
+		// the annotation is removed by the controller once the scan completes
+		if _, ok := updated.Annotations[velerov1api.OrphanScanRequestedAnnotation]; !ok {
+			repo = updated
+			cancel()
+		}
+	}, o.pollInterval, ctx.Done())
+	fmt.Fprintln(o.out)
+
+	scan := repo.Status.OrphanScan
+	if scan == nil {
+		return nil, errors.Errorf("scanning backup repository %s failed: no scan result is recorded", o.Name)
+	}
+	if scan.Message != "" && mode == velerov1api.OrphanScanModeList {
+		return nil, errors.Errorf("scanning backup repository %s failed: %s", o.Name, scan.Message)
+	}
+
+	return scan, nil
+}
+
+func (o *OrphansOptions) printOrphanedSnapshots(snapshots []velerov1api.BackupRepositoryOrphanedSnapshot) {
+	if len(snapshots) == 0 {
+		fmt.Fprintf(o.out, "No orphaned snapshots found in backup repository %q.\n", o.Name)
+		return
+	}
+
+	w := tabwriter.NewWriter(o.out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "SNAPSHOT ID\tSTART TIME\tSOURCE")
+	for _, snapshot := range snapshots {
+		startTime := "<unknown>"
+		if snapshot.StartTimestamp != nil {
+			startTime = snapshot.StartTimestamp.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", snapshot.SnapshotID, startTime, snapshot.Source)
+	}
+	w.Flush()
+}
+
+// requestOrphanScan annotates the backup repository to request a scan of its orphaned snapshots.
+func requestOrphanScan(kbClient kbclient.Client, namespace, name, mode string) (*velerov1api.BackupRepository, error) {
+	repo := &velerov1api.BackupRepository{}
+	if err := kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: namespace, Name: name}, repo); err != nil {
+		return nil, errors.Wrapf(err, "error getting backup repository %s", name)
+	}
+	if repo.Spec.RepositoryType != velerov1api.BackupRepositoryTypeKopia {
+		return nil, errors.Errorf("backup repository %s is a %s repository, only the orphaned snapshots of kopia repositories can be scanned", name, repo.Spec.RepositoryType)
+	}
+
+	original := repo.DeepCopy()
+	if repo.Annotations == nil {
+		repo.Annotations = map[string]string{}
+	}
+	repo.Annotations[velerov1api.OrphanScanRequestedAnnotation] = mode
+	if err := kbClient.Patch(context.Background(), repo, kbclient.MergeFrom(original)); err != nil {
+		return nil, errors.Wrapf(err, "error requesting orphan scan of backup repository %s", name)
+	}
+
+	return repo, nil
+}
@@ -0,0 +1,204 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// scanningClient completes the requested orphan scans once they are requested, as the Velero
+// server does
+type scanningClient struct {
+	kbclient.Client
+	orphans     []velerov1api.BackupRepositoryOrphanedSnapshot
+	pruneFailed bool
+	modes       []string
+}
+
+func (c *scanningClient) Patch(ctx context.Context, obj kbclient.Object, patch kbclient.Patch, opts ...kbclient.PatchOption) error {
+	if err := c.Client.Patch(ctx, obj, patch, opts...); err != nil {
+		return err
+	}
+
+	repo := obj.(*velerov1api.BackupRepository)
+	mode, ok := repo.Annotations[velerov1api.OrphanScanRequestedAnnotation]
+	if !ok {
+		return nil
+	}
+	c.modes = append(c.modes, mode)
+
+	scan := &velerov1api.BackupRepositoryOrphanScan{Mode: mode}
+	switch {
+	case mode == velerov1api.OrphanScanModeList:
+		scan.OrphanedSnapshots = c.orphans
+	case c.pruneFailed:
+		scan.OrphanedSnapshots = c.orphans[:1]
+		scan.PrunedSnapshots = len(c.orphans) - 1
+		scan.Message = "1 of 2 orphaned snapshots failed to be deleted"
+	default:
+		scan.PrunedSnapshots = len(c.orphans)
+	}
+	repo.Status.OrphanScan = scan
+	delete(repo.Annotations, velerov1api.OrphanScanRequestedAnnotation)
+	return c.Client.Update(ctx, repo)
+}
+
+func TestOrphans(t *testing.T) {
+	orphans := []velerov1api.BackupRepositoryOrphanedSnapshot{
+		{
+			SnapshotID:     "snapshot-1",
+			Source:         "default@default:/host_pods/pod-1/volumes/vol-1",
+			StartTimestamp: &metav1.Time{Time: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)},
+		},
+		{
+			SnapshotID: "snapshot-2",
+			Source:     "default@default:/ns-1/pvc-1",
+		},
+	}
+	scanning := "Scanning the orphaned snapshots of backup repository \"repo-1\". You may safely press ctrl-c to stop waiting - the scan will continue in the background.\n.\n"
+	table := "SNAPSHOT ID  START TIME            SOURCE\n" +
+		"snapshot-1   2024-01-01T12:00:00Z  default@default:/host_pods/pod-1/volumes/vol-1\n" +
+		"snapshot-2   <unknown>             default@default:/ns-1/pvc-1\n"
+
+	tests := []struct {
+		name          string
+		repoName      string
+		prune         bool
+		confirm       bool
+		orphans       []velerov1api.BackupRepositoryOrphanedSnapshot
+		pruneFailed   bool
+		expectedModes []string
+		expectedOut   string
+		expectedErr   string
+	}{
+		{
+			name:        "repository doesn't exist",
+			repoName:    "repo-2",
+			expectedErr: "error getting backup repository repo-2: backuprepositories.velero.io \"repo-2\" not found",
+		},
+		{
+			name:        "repository isn't kopia",
+			repoName:    "repo-restic",
+			expectedErr: "backup repository repo-restic is a restic repository, only the orphaned snapshots of kopia repositories can be scanned",
+		},
+		{
+			name:          "no orphaned snapshots are listed",
+			repoName:      "repo-1",
+			expectedModes: []string{velerov1api.OrphanScanModeList},
+			expectedOut:   scanning + "No orphaned snapshots found in backup repository \"repo-1\".\n",
+		},
+		{
+			name:          "orphaned snapshots are listed",
+			repoName:      "repo-1",
+			orphans:       orphans,
+			expectedModes: []string{velerov1api.OrphanScanModeList},
+			expectedOut:   scanning + table,
+		},
+		{
+			name:          "prune isn't confirmed",
+			repoName:      "repo-1",
+			prune:         true,
+			orphans:       orphans,
+			expectedModes: []string{velerov1api.OrphanScanModeList},
+			expectedOut:   scanning + table + "Run `velero repo orphans prune repo-1 --confirm` to delete them.\n",
+		},
+		{
+			name:          "orphaned snapshots are pruned",
+			repoName:      "repo-1",
+			prune:         true,
+			confirm:       true,
+			orphans:       orphans,
+			expectedModes: []string{velerov1api.OrphanScanModePrune},
+			expectedOut:   scanning + "2 orphaned snapshots are deleted from backup repository \"repo-1\".\n",
+		},
+		{
+			name:          "orphaned snapshots fail to be pruned",
+			repoName:      "repo-1",
+			prune:         true,
+			confirm:       true,
+			orphans:       orphans,
+			pruneFailed:   true,
+			expectedModes: []string{velerov1api.OrphanScanModePrune},
+			expectedOut: scanning + "1 orphaned snapshots are deleted from backup repository \"repo-1\".\n" +
+				"The orphaned snapshots failed to be deleted:\n" +
+				"SNAPSHOT ID  START TIME            SOURCE\n" +
+				"snapshot-1   2024-01-01T12:00:00Z  default@default:/host_pods/pod-1/volumes/vol-1\n",
+			expectedErr: "pruning backup repository repo-1 failed: 1 of 2 orphaned snapshots failed to be deleted",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			kbClient := &scanningClient{
+				Client: velerotest.NewFakeControllerRuntimeClient(t,
+					&velerov1api.BackupRepository{
+						ObjectMeta: metav1.ObjectMeta{Namespace: cmdtest.VeleroNameSpace, Name: "repo-1"},
+						Spec:       velerov1api.BackupRepositorySpec{RepositoryType: velerov1api.BackupRepositoryTypeKopia},
+					},
+					&velerov1api.BackupRepository{
+						ObjectMeta: metav1.ObjectMeta{Namespace: cmdtest.VeleroNameSpace, Name: "repo-restic"},
+						Spec:       velerov1api.BackupRepositorySpec{RepositoryType: velerov1api.BackupRepositoryTypeRestic},
+					},
+				),
+				orphans:     tc.orphans,
+				pruneFailed: tc.pruneFailed,
+			}
+
+			f := &factorymocks.Factory{}
+			f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+			f.On("KubebuilderClient").Return(kbClient, nil)
+
+			c := NewOrphansCommand(f, "orphans")
+			assert.Equal(t, "Work with the orphaned snapshots of repositories", c.Short)
+
+			out := new(bytes.Buffer)
+			o := NewOrphansOptions()
+			o.Confirm = tc.confirm
+			o.out = out
+			o.pollInterval = time.Millisecond
+			require.NoError(t, o.Complete([]string{tc.repoName}, f))
+
+			var err error
+			if tc.prune {
+				err = o.RunPrune(c, f)
+			} else {
+				err = o.RunList(c, f)
+			}
+
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedModes, kbClient.modes)
+			assert.Equal(t, tc.expectedOut, out.String())
+		})
+	}
+}
@@ -31,6 +31,9 @@ func NewCommand(f client.Factory) *cobra.Command {
 
 	c.AddCommand(
 		NewGetCommand(f, "get"),
+		NewMigrateCommand(f, "migrate"),
+		NewMaintenanceCommand(f, "maintenance"),
+		NewOrphansCommand(f, "orphans"),
 	)
 
 	return c
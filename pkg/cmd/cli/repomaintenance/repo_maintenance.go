@@ -0,0 +1,136 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repomaintenance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	corev1api "k8s.io/api/core/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+	"github.com/vmware-tanzu/velero/pkg/util/logging"
+)
+
+const (
+	defaultCredentialsDirectory = "/tmp/credentials"
+	defaultResourceTimeout      = 10 * time.Minute
+)
+
+type Options struct {
+	RepoName        string
+	Full            bool
+	ResourceTimeout time.Duration
+	ResultPath      string
+	logLevelFlag    *logging.LevelFlag
+	formatFlag      *logging.FormatFlag
+}
+
+func (o *Options) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.RepoName, "repo-name", o.RepoName, "The name of the backup repository to maintain.")
+	flags.BoolVar(&o.Full, "full", o.Full, "Run the full maintenance, otherwise the repository decides the mode of the maintenance.")
+	flags.DurationVar(&o.ResourceTimeout, "resource-timeout", o.ResourceTimeout, "How long to wait for the repository to be ready.")
+	flags.StringVar(&o.ResultPath, "result-path", o.ResultPath, "The file the result of the maintenance is written to.")
+	flags.Var(o.logLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(o.logLevelFlag.AllowedValues(), ", ")))
+	flags.Var(o.formatFlag, "log-format", fmt.Sprintf("The format for log output. Valid values are %s.", strings.Join(o.formatFlag.AllowedValues(), ", ")))
+}
+
+// NewCommand returns the command run by the repository maintenance jobs.
+func NewCommand(f client.Factory) *cobra.Command {
+	o := &Options{
+		ResourceTimeout: defaultResourceTimeout,
+		ResultPath:      corev1api.TerminationMessagePathDefault,
+		logLevelFlag:    logging.LogLevelFlag(logrus.InfoLevel),
+		formatFlag:      logging.NewFormatFlag(),
+	}
+
+	c := &cobra.Command{
+		Use:    "repo-maintenance",
+		Hidden: true,
+		Short:  "INTERNAL COMMAND ONLY - not intended to be run directly by users",
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+	return c
+}
+
+func (o *Options) Run(f client.Factory) error {
+	if o.RepoName == "" {
+		return errors.New("--repo-name is required")
+	}
+
+	logger := logging.DefaultLogger(o.logLevelFlag.Parse(), o.formatFlag.Parse()).WithField("backupRepo", o.RepoName)
+
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	credentialFileStore, err := credentials.NewNamespacedFileStore(kbClient, f.Namespace(), defaultCredentialsDirectory, filesystem.NewFileSystem())
+	if err != nil {
+		return err
+	}
+
+	credentialSecretStore, err := credentials.NewNamespacedSecretStore(kbClient, f.Namespace())
+	if err != nil {
+		return err
+	}
+
+	repo := &velerov1api.BackupRepository{}
+	if err := kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: o.RepoName}, repo); err != nil {
+		return errors.Wrapf(err, "error getting backup repository %s", o.RepoName)
+	}
+
+	repoManager := repository.NewManager(f.Namespace(), kbClient, repository.NewRepoLocker(),
+		repository.NewEnsurer(kbClient, logger, o.ResourceTimeout), credentialFileStore, credentialSecretStore, logger)
+
+	logger.WithField("full", o.Full).Info("Running maintenance on backup repository")
+	freedBytes, err := repoManager.PruneRepo(repo, o.Full)
+	if err != nil {
+		return errors.Wrapf(err, "error running maintenance on backup repository %s", o.RepoName)
+	}
+
+	logger.WithField("freedBytes", freedBytes).Info("Maintenance on backup repository is completed")
+
+	// the result is read by the server from the termination message of the pod
+	result, err := json.Marshal(repository.MaintenanceResult{FreedBytes: freedBytes})
+	if err != nil {
+		return errors.Wrap(err, "error marshaling the maintenance result")
+	}
+	if err := os.WriteFile(o.ResultPath, result, 0644); err != nil {
+		logger.WithError(err).Warn("Failed to write the maintenance result")
+	}
+
+	return nil
+}
@@ -26,6 +26,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
@@ -60,7 +62,10 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
   velero restore create --from-schedule schedule-1 --allow-partially-failed
 
   # Create a restore for only persistentvolumeclaims and persistentvolumes within a backup.
-  velero restore create --from-backup backup-2 --include-resources persistentvolumeclaims,persistentvolumes`,
+  velero restore create --from-backup backup-2 --include-resources persistentvolumeclaims,persistentvolumes
+
+  # Create a restore for only the deployments whose names start with "web-" within a backup.
+  velero restore create --from-backup backup-3 --include-resources 'deployments.apps/web-*'`,
 		Args: cobra.MaximumNArgs(1),
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args, f))
@@ -77,55 +82,75 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 }
 
 type CreateOptions struct {
-	BackupName                string
-	ScheduleName              string
-	RestoreName               string
-	RestoreVolumes            flag.OptionalBool
-	PreserveNodePorts         flag.OptionalBool
-	Labels                    flag.Map
-	IncludeNamespaces         flag.StringArray
-	ExcludeNamespaces         flag.StringArray
-	ExistingResourcePolicy    string
-	IncludeResources          flag.StringArray
-	ExcludeResources          flag.StringArray
-	StatusIncludeResources    flag.StringArray
-	StatusExcludeResources    flag.StringArray
-	NamespaceMappings         flag.Map
-	Selector                  flag.LabelSelector
-	OrSelector                flag.OrLabelSelector
-	IncludeClusterResources   flag.OptionalBool
-	Wait                      bool
-	AllowPartiallyFailed      flag.OptionalBool
-	ItemOperationTimeout      time.Duration
-	ResourceModifierConfigMap string
-	client                    kbclient.WithWatch
+	BackupName                  string
+	ScheduleName                string
+	RestoreName                 string
+	RestoreVolumes              flag.OptionalBool
+	PreserveNodePorts           flag.OptionalBool
+	Labels                      flag.Map
+	IncludeNamespaces           flag.StringArray
+	ExcludeNamespaces           flag.StringArray
+	ExistingResourcePolicy      string
+	ExistingResourcePolicies    flag.Map
+	IncludeResources            flag.StringArray
+	ExcludeResources            flag.StringArray
+	StatusIncludeResources      flag.StringArray
+	StatusExcludeResources      flag.StringArray
+	NamespaceMappings           flag.Map
+	StorageClassMappings        flag.Map
+	ZoneMappings                flag.Map
+	Selector                    flag.LabelSelector
+	OrSelector                  flag.OrLabelSelector
+	IncludeClusterResources     flag.OptionalBool
+	Wait                        bool
+	AllowPartiallyFailed        flag.OptionalBool
+	ItemOperationTimeout        time.Duration
+	ResourceModifierConfigMap   string
+	ResourcePrioritiesConfigMap string
+	ScaleReplicas               int32
+	ScaleResources              flag.StringArray
+	WaitForReady                bool
+	WaitForReadyResources       flag.StringArray
+	WaitForReadyTimeout         time.Duration
+	StrictQuota                 bool
+	PVCSizeGrowthPercentage     int32
+	PVCSizes                    flag.Map
+	ItemMaxRetries              int32
+	ItemRetryInitialBackoff     time.Duration
+	ItemRetryMaxBackoff         time.Duration
+	VerifySecretEncryption      bool
+	SensitiveSecretTypes        flag.StringArray
+	AllowUnencryptedTarget      bool
+	client                      kbclient.WithWatch
+	// dryRun is set by the preview command to only diff the backup against the cluster
+	dryRun bool
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		Labels:                  flag.NewMap(),
-		IncludeNamespaces:       flag.NewStringArray("*"),
-		NamespaceMappings:       flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
-		RestoreVolumes:          flag.NewOptionalBool(nil),
-		PreserveNodePorts:       flag.NewOptionalBool(nil),
-		IncludeClusterResources: flag.NewOptionalBool(nil),
+		Labels:                   flag.NewMap(),
+		IncludeNamespaces:        flag.NewStringArray("*"),
+		NamespaceMappings:        flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
+		StorageClassMappings:     flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
+		ZoneMappings:             flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
+		ExistingResourcePolicies: flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
+		PVCSizes:                 flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
+		RestoreVolumes:           flag.NewOptionalBool(nil),
+		PreserveNodePorts:        flag.NewOptionalBool(nil),
+		IncludeClusterResources:  flag.NewOptionalBool(nil),
+		ScaleReplicas:            -1,
 	}
 }
 
 func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.BackupName, "from-backup", "", "Backup to restore from")
-	flags.StringVar(&o.ScheduleName, "from-schedule", "", "Schedule to restore from")
-	flags.Var(&o.IncludeNamespaces, "include-namespaces", "Namespaces to include in the restore (use '*' for all namespaces)")
-	flags.Var(&o.ExcludeNamespaces, "exclude-namespaces", "Namespaces to exclude from the restore.")
-	flags.Var(&o.NamespaceMappings, "namespace-mappings", "Namespace mappings from name in the backup to desired restored name in the form src1:dst1,src2:dst2,...")
+	flags.StringVar(&o.ScheduleName, "from-schedule", "", "Schedule to restore from, the most recent Completed backup of the schedule is restored")
+	o.BindFilterFlags(flags)
 	flags.Var(&o.Labels, "labels", "Labels to apply to the restore.")
-	flags.Var(&o.IncludeResources, "include-resources", "Resources to include in the restore, formatted as resource.group, such as storageclasses.storage.k8s.io (use '*' for all resources).")
-	flags.Var(&o.ExcludeResources, "exclude-resources", "Resources to exclude from the restore, formatted as resource.group, such as storageclasses.storage.k8s.io.")
-	flags.StringVar(&o.ExistingResourcePolicy, "existing-resource-policy", "", "Restore Policy to be used during the restore workflow, can be - none or update")
+	flags.StringVar(&o.ExistingResourcePolicy, "existing-resource-policy", "", "Restore Policy to be used during the restore workflow, can be - none, update or recreate")
+	flags.Var(&o.ExistingResourcePolicies, "existing-resource-policies", "Restore Policies per resource type in the form resource1:policy1,resource2:policy2,..., resources are formatted as resource.group, such as deployments.apps. It overrides --existing-resource-policy for the listed resources.")
 	flags.Var(&o.StatusIncludeResources, "status-include-resources", "Resources to include in the restore status, formatted as resource.group, such as storageclasses.storage.k8s.io.")
 	flags.Var(&o.StatusExcludeResources, "status-exclude-resources", "Resources to exclude from the restore status, formatted as resource.group, such as storageclasses.storage.k8s.io.")
-	flags.VarP(&o.Selector, "selector", "l", "Only restore resources matching this label selector.")
-	flags.Var(&o.OrSelector, "or-selector", "Restore resources matching at least one of the label selector from the list. Label selectors should be separated by ' or '. For example, foo=bar or app=nginx")
 	flags.DurationVar(&o.ItemOperationTimeout, "item-operation-timeout", o.ItemOperationTimeout, "How long to wait for async plugin operations before timeout.")
 	f := flags.VarPF(&o.RestoreVolumes, "restore-volumes", "", "Whether to restore volumes from snapshots.")
 	// this allows the user to just specify "--restore-volumes" as shorthand for "--restore-volumes=true"
@@ -137,14 +162,43 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	// like a normal bool flag
 	f.NoOptDefVal = cmd.TRUE
 
-	f = flags.VarPF(&o.IncludeClusterResources, "include-cluster-resources", "", "Include cluster-scoped resources in the restore.")
-	f.NoOptDefVal = cmd.TRUE
-
 	f = flags.VarPF(&o.AllowPartiallyFailed, "allow-partially-failed", "", "If using --from-schedule, whether to consider PartiallyFailed backups when looking for the most recent one. This flag has no effect if not using --from-schedule.")
 	f.NoOptDefVal = cmd.TRUE
 
 	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "Wait for the operation to complete.")
 
+	flags.StringVar(&o.ResourcePrioritiesConfigMap, "resource-priorities-configmap", "", "Reference to the configmap with the resource priorities that override the server's restore resource priorities for this restore")
+	flags.Int32Var(&o.ScaleReplicas, "scale-replicas", o.ScaleReplicas, "Number of replicas the restored deployments and statefulsets are scaled to, e.g. 0 to restore them quiesced. The original number of replicas is kept in the velero.io/original-replicas annotation. If negative, the replicas aren't changed.")
+	flags.Var(&o.ScaleResources, "scale-resources", "Workload resources to scale with --scale-replicas, deployments and/or statefulsets. If unset, both are scaled.")
+	flags.BoolVar(&o.WaitForReady, "wait-for-ready", o.WaitForReady, "Wait for the restored CRDs to be established, namespaces to be active and webhooks to be served before restoring the items depending on them.")
+	flags.Var(&o.WaitForReadyResources, "wait-for-ready-resources", "Resources to wait for with --wait-for-ready, customresourcedefinitions, namespaces, mutatingwebhookconfigurations and/or validatingwebhookconfigurations. If unset, all of them are waited for.")
+	flags.DurationVar(&o.WaitForReadyTimeout, "wait-for-ready-timeout", o.WaitForReadyTimeout, "Overall time to wait for the restored items to be ready with --wait-for-ready. If unset, the server's resource timeout is used.")
+	flags.BoolVar(&o.StrictQuota, "strict-quota", o.StrictQuota, "Fail the restore before restoring anything if the restored workloads request more than the resource quotas of their namespaces have available. Otherwise the exceeded quotas are reported as warnings.")
+	flags.Int32Var(&o.PVCSizeGrowthPercentage, "pvc-size-growth-percentage", o.PVCSizeGrowthPercentage, "Percentage the requested storage of the PVCs restored from CSI volume snapshots is grown by, e.g. 20 grows a 10Gi request to 12Gi. The PVCs are only grown if their storage classes allow volume expansion.")
+	flags.Var(&o.PVCSizes, "pvc-sizes", "Requested storage of the PVCs restored from CSI volume snapshots in the form namespace1/pvc1:size1,namespace2/pvc2:size2,..., the PVCs are named as in the backup. It takes precedence over --pvc-size-growth-percentage.")
+	flags.Int32Var(&o.ItemMaxRetries, "item-max-retries", o.ItemMaxRetries, "Maximum number of retries of the API calls restoring each item on the transient errors of the API server, e.g. throttling, etcd timeouts and unavailable webhooks. If 0, the items fail on the first error.")
+	flags.DurationVar(&o.ItemRetryInitialBackoff, "item-retry-initial-backoff", o.ItemRetryInitialBackoff, "Time waited before the first retry of an item with --item-max-retries, it's doubled for each following retry. If unset, 1 second is used.")
+	flags.DurationVar(&o.ItemRetryMaxBackoff, "item-retry-max-backoff", o.ItemRetryMaxBackoff, "Maximum time waited before a retry of an item with --item-max-retries. If unset, 30 seconds is used.")
+	flags.BoolVar(&o.VerifySecretEncryption, "verify-secret-encryption", o.VerifySecretEncryption, "Fail the restore before restoring anything if it includes sensitive secrets and the target cluster isn't verified to encrypt the secrets at rest.")
+	flags.Var(&o.SensitiveSecretTypes, "sensitive-secret-types", "Types of the sensitive secrets with --verify-secret-encryption, e.g. kubernetes.io/tls. If unset, the secrets of all the types are sensitive.")
+	flags.BoolVar(&o.AllowUnencryptedTarget, "allow-unencrypted-target", o.AllowUnencryptedTarget, "Restore the sensitive secrets with --verify-secret-encryption even if the target cluster isn't verified to encrypt the secrets at rest, the failed verification is reported as a warning.")
+}
+
+// BindFilterFlags binds the flags deciding which items are restored and how they look in the
+// cluster, they are shared by the create and preview commands.
+func (o *CreateOptions) BindFilterFlags(flags *pflag.FlagSet) {
+	flags.Var(&o.IncludeNamespaces, "include-namespaces", "Namespaces to include in the restore (use '*' for all namespaces)")
+	flags.Var(&o.ExcludeNamespaces, "exclude-namespaces", "Namespaces to exclude from the restore.")
+	flags.Var(&o.NamespaceMappings, "namespace-mappings", "Namespace mappings from name in the backup to desired restored name in the form src1:dst1,src2:dst2,...")
+	flags.Var(&o.StorageClassMappings, "storage-class-mappings", "Storage class mappings from name in the backup to the storage class in the cluster in the form src1:dst1,src2:dst2,...")
+	flags.Var(&o.ZoneMappings, "zone-mappings", "Zone mappings of the persistent volumes from zone in the backup to the zone in the cluster in the form src1:dst1,src2:dst2,...")
+	flags.Var(&o.IncludeResources, "include-resources", "Resources to include in the restore, formatted as resource.group, such as storageclasses.storage.k8s.io (use '*' for all resources). A resource can be followed by a name glob to only include the items with matching names, such as deployments.apps/web-*.")
+	flags.Var(&o.ExcludeResources, "exclude-resources", "Resources to exclude from the restore, formatted as resource.group, such as storageclasses.storage.k8s.io.")
+	flags.VarP(&o.Selector, "selector", "l", "Only restore resources matching this label selector.")
+	flags.Var(&o.OrSelector, "or-selector", "Restore resources matching at least one of the label selector from the list. Label selectors should be separated by ' or '. For example, foo=bar or app=nginx")
+	f := flags.VarPF(&o.IncludeClusterResources, "include-cluster-resources", "", "Include cluster-scoped resources in the restore.")
+	f.NoOptDefVal = cmd.TRUE
+
 	flags.StringVar(&o.ResourceModifierConfigMap, "resource-modifier-configmap", "", "Reference to the resource modifier configmap that restore will use")
 }
 
@@ -192,7 +246,43 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 	}
 
 	if len(o.ExistingResourcePolicy) > 0 && !isResourcePolicyValid(o.ExistingResourcePolicy) {
-		return errors.New("existing-resource-policy has invalid value, it accepts only none, update as value")
+		return errors.New("existing-resource-policy has invalid value, it accepts only none, update, recreate as value")
+	}
+
+	for resource, policy := range o.ExistingResourcePolicies.Data() {
+		if !isResourcePolicyValid(policy) {
+			return errors.Errorf("existing-resource-policies has invalid value %q for resource %s, it accepts only none, update, recreate as value", policy, resource)
+		}
+	}
+
+	if len(o.ScaleResources) > 0 && o.ScaleReplicas < 0 {
+		return errors.New("--scale-resources requires --scale-replicas to be set")
+	}
+
+	if (len(o.WaitForReadyResources) > 0 || o.WaitForReadyTimeout != 0) && !o.WaitForReady {
+		return errors.New("--wait-for-ready-resources and --wait-for-ready-timeout require --wait-for-ready to be set")
+	}
+
+	if o.PVCSizeGrowthPercentage < 0 {
+		return errors.New("--pvc-size-growth-percentage must not be negative")
+	}
+
+	if o.ItemMaxRetries < 0 {
+		return errors.New("--item-max-retries must not be negative")
+	}
+
+	if (o.ItemRetryInitialBackoff != 0 || o.ItemRetryMaxBackoff != 0) && o.ItemMaxRetries == 0 {
+		return errors.New("--item-retry-initial-backoff and --item-retry-max-backoff require --item-max-retries to be set")
+	}
+
+	if (len(o.SensitiveSecretTypes) > 0 || o.AllowUnencryptedTarget) && !o.VerifySecretEncryption {
+		return errors.New("--sensitive-secret-types and --allow-unencrypted-target require --verify-secret-encryption to be set")
+	}
+
+	for pvc, size := range o.PVCSizes.Data() {
+		if _, err := resource.ParseQuantity(size); err != nil {
+			return errors.Errorf("pvc-sizes has invalid size %q for PVC %s: %v", size, pvc, err)
+		}
 	}
 
 	switch {
@@ -213,11 +303,26 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		if len(backupList.Items) == 0 {
 			return errors.Errorf("No backups found for the schedule %s", o.ScheduleName)
 		}
+		if mostRecentBackup(backupList.Items, o.allowedSchedulePhases()...) == nil {
+			if boolptr.IsSetToTrue(o.AllowPartiallyFailed.Value) {
+				return errors.Errorf("No Completed or PartiallyFailed backups found for the schedule %s", o.ScheduleName)
+			}
+			return errors.Errorf("No Completed backups found for the schedule %s, use --allow-partially-failed to also consider the PartiallyFailed backups", o.ScheduleName)
+		}
 	}
 
 	return nil
 }
 
+// allowedSchedulePhases returns the phases of the backups of the schedule which can be restored
+// from, only the Completed backups are successful unless --allow-partially-failed is specified.
+func (o *CreateOptions) allowedSchedulePhases() []api.BackupPhase {
+	if boolptr.IsSetToTrue(o.AllowPartiallyFailed.Value) {
+		return []api.BackupPhase{api.BackupPhaseCompleted, api.BackupPhasePartiallyFailed}
+	}
+	return []api.BackupPhase{api.BackupPhaseCompleted}
+}
+
 // mostRecentBackup returns the backup with the most recent start timestamp that has a phase that's
 // in the provided list of allowed phases.
 func mostRecentBackup(backups []api.Backup, allowedPhases ...api.BackupPhase) *api.Backup {
@@ -294,6 +399,17 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		}
 	}
 
+	var resPriorities *corev1.TypedLocalObjectReference
+
+	if o.ResourcePrioritiesConfigMap != "" {
+		resPriorities = &corev1.TypedLocalObjectReference{
+			// Group for core API is ""
+			APIGroup: &corev1.SchemeGroupVersion.Group,
+			Kind:     resourcemodifiers.ConfigmapRefType,
+			Name:     o.ResourcePrioritiesConfigMap,
+		}
+	}
+
 	restore := &api.Restore{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: f.Namespace(),
@@ -301,20 +417,24 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			Labels:    o.Labels.Data(),
 		},
 		Spec: api.RestoreSpec{
-			BackupName:              o.BackupName,
-			ScheduleName:            o.ScheduleName,
-			IncludedNamespaces:      o.IncludeNamespaces,
-			ExcludedNamespaces:      o.ExcludeNamespaces,
-			IncludedResources:       o.IncludeResources,
-			ExcludedResources:       o.ExcludeResources,
-			ExistingResourcePolicy:  api.PolicyType(o.ExistingResourcePolicy),
-			NamespaceMapping:        o.NamespaceMappings.Data(),
-			LabelSelector:           o.Selector.LabelSelector,
-			OrLabelSelectors:        o.OrSelector.OrLabelSelectors,
-			RestorePVs:              o.RestoreVolumes.Value,
-			PreserveNodePorts:       o.PreserveNodePorts.Value,
-			IncludeClusterResources: o.IncludeClusterResources.Value,
-			ResourceModifier:        resModifiers,
+			BackupName:               o.BackupName,
+			ScheduleName:             o.ScheduleName,
+			IncludedNamespaces:       o.IncludeNamespaces,
+			ExcludedNamespaces:       o.ExcludeNamespaces,
+			IncludedResources:        o.IncludeResources,
+			ExcludedResources:        o.ExcludeResources,
+			ExistingResourcePolicy:   api.PolicyType(o.ExistingResourcePolicy),
+			ExistingResourcePolicies: existingResourcePolicies(o.ExistingResourcePolicies.Data()),
+			NamespaceMapping:         o.NamespaceMappings.Data(),
+			StorageClassMappings:     o.StorageClassMappings.Data(),
+			ZoneMappings:             o.ZoneMappings.Data(),
+			LabelSelector:            o.Selector.LabelSelector,
+			OrLabelSelectors:         o.OrSelector.OrLabelSelectors,
+			RestorePVs:               o.RestoreVolumes.Value,
+			PreserveNodePorts:        o.PreserveNodePorts.Value,
+			IncludeClusterResources:  o.IncludeClusterResources.Value,
+			ResourceModifier:         resModifiers,
+			ResourcePriorities:       resPriorities,
 			ItemOperationTimeout: metav1.Duration{
 				Duration: o.ItemOperationTimeout,
 			},
@@ -328,12 +448,61 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		}
 	}
 
+	if o.dryRun {
+		restore.Spec.DryRun = boolptr.True()
+	}
+
+	if o.ScaleReplicas >= 0 {
+		restore.Spec.Scaling = &api.RestoreScaling{
+			Replicas:          o.ScaleReplicas,
+			IncludedResources: o.ScaleResources,
+		}
+	}
+
+	if o.StrictQuota {
+		restore.Spec.StrictQuota = boolptr.True()
+	}
+
+	if o.PVCSizeGrowthPercentage > 0 || len(o.PVCSizes.Data()) > 0 {
+		restore.Spec.PVCSizeOverrides = &api.RestorePVCSizeOverrides{
+			GrowthPercentage: o.PVCSizeGrowthPercentage,
+		}
+		for pvc, size := range o.PVCSizes.Data() {
+			if restore.Spec.PVCSizeOverrides.Sizes == nil {
+				restore.Spec.PVCSizeOverrides.Sizes = make(map[string]resource.Quantity)
+			}
+			restore.Spec.PVCSizeOverrides.Sizes[pvc] = resource.MustParse(size)
+		}
+	}
+
+	if o.ItemMaxRetries > 0 {
+		restore.Spec.ItemRetryPolicy = &api.RestoreItemRetryPolicy{
+			MaxRetries:     o.ItemMaxRetries,
+			InitialBackoff: metav1.Duration{Duration: o.ItemRetryInitialBackoff},
+			MaxBackoff:     metav1.Duration{Duration: o.ItemRetryMaxBackoff},
+		}
+	}
+
+	if o.VerifySecretEncryption {
+		restore.Spec.SecretEncryption = &api.RestoreSecretEncryption{
+			SensitiveTypes:         o.SensitiveSecretTypes,
+			AllowUnencryptedTarget: o.AllowUnencryptedTarget,
+		}
+	}
+
+	if o.WaitForReady {
+		restore.Spec.ReadinessGates = &api.RestoreReadinessGates{
+			IncludedResources: o.WaitForReadyResources,
+			Timeout:           metav1.Duration{Duration: o.WaitForReadyTimeout},
+		}
+	}
+
 	if printed, err := output.PrintWithFormat(c, restore); printed || err != nil {
 		return err
 	}
 
 	var updates chan *api.Restore
-	if o.Wait {
+	if o.Wait || o.dryRun {
 		stop := make(chan struct{})
 		defer close(stop)
 
@@ -381,6 +550,11 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		return err
 	}
 
+	if o.dryRun {
+		fmt.Printf("Restore preview request %q submitted successfully.\n", restore.Name)
+		return o.waitForDryRun(restore, updates)
+	}
+
 	fmt.Printf("Restore request %q submitted successfully.\n", restore.Name)
 	if o.Wait {
 		fmt.Println("Waiting for restore to complete. You may safely press ctrl-c to stop waiting - your restore will continue in the background.")
@@ -413,9 +587,55 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 	return nil
 }
 
+// waitForDryRun waits for the dry-run of the restore to complete, prints the result and then
+// deletes the restore, since nothing is restored or persisted for it.
+func (o *CreateOptions) waitForDryRun(restore *api.Restore, updates chan *api.Restore) error {
+	fmt.Println("Waiting for restore preview to complete.")
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			fmt.Print(".")
+		case updated, ok := <-updates:
+			if !ok {
+				fmt.Println("\nError waiting: unable to watch restores.")
+				return nil
+			}
+
+			if updated.Status.Phase != api.RestorePhaseDryRunCompleted && updated.Status.Phase != api.RestorePhaseFailedValidation &&
+				updated.Status.Phase != api.RestorePhaseFailed {
+				continue
+			}
+
+			fmt.Printf("\n\n%s\n", output.DescribeRestoreDryRun(updated))
+
+			if err := o.client.Delete(context.TODO(), restore, &kbclient.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return errors.Wrapf(err, "error deleting restore %q for preview", restore.Name)
+			}
+
+			return nil
+		}
+	}
+}
+
 func isResourcePolicyValid(resourcePolicy string) bool {
-	if resourcePolicy == string(api.PolicyTypeNone) || resourcePolicy == string(api.PolicyTypeUpdate) {
+	switch api.PolicyType(resourcePolicy) {
+	case api.PolicyTypeNone, api.PolicyTypeUpdate, api.PolicyTypeRecreate:
 		return true
 	}
 	return false
 }
+
+func existingResourcePolicies(policies map[string]string) map[string]api.PolicyType {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	result := make(map[string]api.PolicyType, len(policies))
+	for resource, policy := range policies {
+		result[resource] = api.PolicyType(policy)
+	}
+	return result
+}
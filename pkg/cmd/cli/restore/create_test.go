@@ -37,6 +37,8 @@ import (
 func TestIsResourcePolicyValid(t *testing.T) {
 	require.True(t, isResourcePolicyValid(string(velerov1api.PolicyTypeNone)))
 	require.True(t, isResourcePolicyValid(string(velerov1api.PolicyTypeUpdate)))
+	require.True(t, isResourcePolicyValid(string(velerov1api.PolicyTypeRecreate)))
+	require.False(t, isResourcePolicyValid("replace"))
 	require.False(t, isResourcePolicyValid(""))
 }
 
@@ -75,15 +77,23 @@ func TestCreateCommand(t *testing.T) {
 		includeNamespaces := "app1,app2"
 		excludeNamespaces := "pod1,pod2,pod3"
 		existingResourcePolicy := "none"
+		existingResourcePolicies := "configmaps:update,deployments.apps:recreate"
 		includeResources := "sc,sts"
 		excludeResources := "job"
 		statusIncludeResources := "sc,sts"
 		statusExcludeResources := "job"
 		namespaceMappings := "a:b"
+		storageClassMappings := "standard:gp3"
+		zoneMappings := "zone-a:us-east-1a"
 		selector := "foo=bar"
 		includeClusterResources := "true"
 		allowPartiallyFailed := "true"
 		itemOperationTimeout := "10m0s"
+		resourcePrioritiesConfigMap := "priorities-cm"
+		scaleReplicas := "0"
+		scaleResources := "deployments"
+		waitForReadyResources := "namespaces"
+		waitForReadyTimeout := "5m0s"
 
 		flags := new(pflag.FlagSet)
 		o := NewCreateOptions()
@@ -95,6 +105,7 @@ func TestCreateCommand(t *testing.T) {
 		flags.Parse([]string{"--preserve-nodeports", preserveNodePorts})
 		flags.Parse([]string{"--labels", labels})
 		flags.Parse([]string{"--existing-resource-policy", existingResourcePolicy})
+		flags.Parse([]string{"--existing-resource-policies", existingResourcePolicies})
 		flags.Parse([]string{"--include-namespaces", includeNamespaces})
 		flags.Parse([]string{"--exclude-namespaces", excludeNamespaces})
 		flags.Parse([]string{"--include-resources", includeResources})
@@ -102,10 +113,27 @@ func TestCreateCommand(t *testing.T) {
 		flags.Parse([]string{"--status-include-resources", statusIncludeResources})
 		flags.Parse([]string{"--status-exclude-resources", statusExcludeResources})
 		flags.Parse([]string{"--namespace-mappings", namespaceMappings})
+		flags.Parse([]string{"--storage-class-mappings", storageClassMappings})
+		flags.Parse([]string{"--zone-mappings", zoneMappings})
 		flags.Parse([]string{"--selector", selector})
 		flags.Parse([]string{"--include-cluster-resources", includeClusterResources})
 		flags.Parse([]string{"--allow-partially-failed", allowPartiallyFailed})
 		flags.Parse([]string{"--item-operation-timeout", itemOperationTimeout})
+		flags.Parse([]string{"--resource-priorities-configmap", resourcePrioritiesConfigMap})
+		flags.Parse([]string{"--scale-replicas", scaleReplicas})
+		flags.Parse([]string{"--scale-resources", scaleResources})
+		flags.Parse([]string{"--wait-for-ready"})
+		flags.Parse([]string{"--wait-for-ready-resources", waitForReadyResources})
+		flags.Parse([]string{"--wait-for-ready-timeout", waitForReadyTimeout})
+		flags.Parse([]string{"--strict-quota"})
+		flags.Parse([]string{"--pvc-size-growth-percentage", "20"})
+		flags.Parse([]string{"--pvc-sizes", "ns-1/pvc-1:100Gi"})
+		flags.Parse([]string{"--item-max-retries", "5"})
+		flags.Parse([]string{"--item-retry-initial-backoff", "2s"})
+		flags.Parse([]string{"--item-retry-max-backoff", "1m"})
+		flags.Parse([]string{"--verify-secret-encryption"})
+		flags.Parse([]string{"--sensitive-secret-types", "kubernetes.io/tls,Opaque"})
+		flags.Parse([]string{"--allow-unencrypted-target"})
 
 		client := velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch)
 
@@ -132,16 +160,34 @@ func TestCreateCommand(t *testing.T) {
 		require.Equal(t, includeNamespaces, o.IncludeNamespaces.String())
 		require.Equal(t, excludeNamespaces, o.ExcludeNamespaces.String())
 		require.Equal(t, existingResourcePolicy, o.ExistingResourcePolicy)
+		require.Equal(t, map[string]string{"configmaps": "update", "deployments.apps": "recreate"}, o.ExistingResourcePolicies.Data())
 		require.Equal(t, includeResources, o.IncludeResources.String())
 		require.Equal(t, excludeResources, o.ExcludeResources.String())
 
 		require.Equal(t, statusIncludeResources, o.StatusIncludeResources.String())
 		require.Equal(t, statusExcludeResources, o.StatusExcludeResources.String())
 		require.Equal(t, namespaceMappings, o.NamespaceMappings.String())
+		require.Equal(t, storageClassMappings, o.StorageClassMappings.String())
+		require.Equal(t, zoneMappings, o.ZoneMappings.String())
 		require.Equal(t, selector, o.Selector.String())
 		require.Equal(t, includeClusterResources, o.IncludeClusterResources.String())
 		require.Equal(t, allowPartiallyFailed, o.AllowPartiallyFailed.String())
 		require.Equal(t, itemOperationTimeout, o.ItemOperationTimeout.String())
+		require.Equal(t, resourcePrioritiesConfigMap, o.ResourcePrioritiesConfigMap)
+		require.Equal(t, int32(0), o.ScaleReplicas)
+		require.Equal(t, scaleResources, o.ScaleResources.String())
+		require.True(t, o.WaitForReady)
+		require.Equal(t, waitForReadyResources, o.WaitForReadyResources.String())
+		require.Equal(t, waitForReadyTimeout, o.WaitForReadyTimeout.String())
+		require.True(t, o.StrictQuota)
+		require.Equal(t, int32(20), o.PVCSizeGrowthPercentage)
+		require.Equal(t, map[string]string{"ns-1/pvc-1": "100Gi"}, o.PVCSizes.Data())
+		require.Equal(t, int32(5), o.ItemMaxRetries)
+		require.Equal(t, 2*time.Second, o.ItemRetryInitialBackoff)
+		require.Equal(t, time.Minute, o.ItemRetryMaxBackoff)
+		require.True(t, o.VerifySecretEncryption)
+		require.Equal(t, "kubernetes.io/tls,Opaque", o.SensitiveSecretTypes.String())
+		require.True(t, o.AllowUnencryptedTarget)
 
 	})
 
@@ -170,6 +216,58 @@ func TestCreateCommand(t *testing.T) {
 		require.NoError(t, o.Run(c, f))
 	})
 
+	t.Run("create a restore from schedule without successful backups", func(t *testing.T) {
+		f := &factorymocks.Factory{}
+		c := NewCreateCommand(f, "")
+		flags := new(pflag.FlagSet)
+		o := NewCreateOptions()
+		o.BindFlags(flags)
+
+		fromSchedule := "schedule-name-1"
+		flags.Parse([]string{"--from-schedule", fromSchedule})
+
+		kbclient := velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch)
+		schedule := builder.ForSchedule(cmdtest.VeleroNameSpace, fromSchedule).Result()
+		backup := builder.ForBackup(cmdtest.VeleroNameSpace, "test-backup").FromSchedule(schedule).Phase(velerov1api.BackupPhasePartiallyFailed).Result()
+		require.NoError(t, kbclient.Create(context.Background(), backup, &controllerclient.CreateOptions{}))
+
+		f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+		f.On("KubebuilderWatchClient").Return(kbclient, nil)
+
+		require.NoError(t, o.Complete(args, f))
+		err := o.Validate(c, []string{}, f)
+		require.EqualError(t, err, "No Completed backups found for the schedule schedule-name-1, use --allow-partially-failed to also consider the PartiallyFailed backups")
+	})
+
+	t.Run("create a restore from schedule allowing partially failed backups", func(t *testing.T) {
+		f := &factorymocks.Factory{}
+		c := NewCreateCommand(f, "")
+		flags := new(pflag.FlagSet)
+		o := NewCreateOptions()
+		o.BindFlags(flags)
+
+		fromSchedule := "schedule-name-1"
+		flags.Parse([]string{"--from-schedule", fromSchedule, "--allow-partially-failed"})
+
+		kbclient := velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch)
+		schedule := builder.ForSchedule(cmdtest.VeleroNameSpace, fromSchedule).Result()
+		completed := builder.ForBackup(cmdtest.VeleroNameSpace, "completed-backup").FromSchedule(schedule).
+			StartTimestamp(time.Now().Add(-time.Hour)).Phase(velerov1api.BackupPhaseCompleted).Result()
+		partiallyFailed := builder.ForBackup(cmdtest.VeleroNameSpace, "partially-failed-backup").FromSchedule(schedule).
+			StartTimestamp(time.Now()).Phase(velerov1api.BackupPhasePartiallyFailed).Result()
+		require.NoError(t, kbclient.Create(context.Background(), completed, &controllerclient.CreateOptions{}))
+		require.NoError(t, kbclient.Create(context.Background(), partiallyFailed, &controllerclient.CreateOptions{}))
+
+		f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+		f.On("KubebuilderWatchClient").Return(kbclient, nil)
+
+		require.NoError(t, o.Complete(args, f))
+		require.NoError(t, o.Validate(c, []string{}, f))
+		require.NoError(t, o.Run(c, f))
+		require.Equal(t, "partially-failed-backup", o.BackupName)
+		require.Empty(t, o.ScheduleName)
+	})
+
 	t.Run("create a restore from not-existed backup", func(t *testing.T) {
 		f := &factorymocks.Factory{}
 		c := NewCreateCommand(f, "")
@@ -191,4 +289,23 @@ func TestCreateCommand(t *testing.T) {
 		err := o.Validate(c, []string{}, f)
 		require.Equal(t, "backups.velero.io \"not-exist\" not found", err.Error())
 	})
+	t.Run("create a restore with invalid existing resource policies", func(t *testing.T) {
+		f := &factorymocks.Factory{}
+		c := NewCreateCommand(f, "")
+		flags := new(pflag.FlagSet)
+		o := NewCreateOptions()
+		o.BindFlags(flags)
+
+		flags.Parse([]string{"--from-backup", "backup-1"})
+		flags.Parse([]string{"--existing-resource-policies", "configmaps:update,secrets:replace"})
+
+		kbclient := velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch)
+
+		f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+		f.On("KubebuilderWatchClient").Return(kbclient, nil)
+
+		require.NoError(t, o.Complete(nil, f))
+		err := o.Validate(c, []string{}, f)
+		require.EqualError(t, err, "existing-resource-policies has invalid value \"replace\" for resource secrets, it accepts only none, update, recreate as value")
+	})
 }
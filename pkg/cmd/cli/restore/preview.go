@@ -0,0 +1,62 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+// NewPreviewCommand returns the command which diffs the items of a backup against the cluster
+// by running the restore in dry-run mode, nothing is created or changed in the cluster.
+func NewPreviewCommand(f client.Factory, use string) *cobra.Command {
+	o := NewCreateOptions()
+
+	c := &cobra.Command{
+		Use:   use + " BACKUP_NAME",
+		Short: "Preview the changes a restore would make to the cluster",
+		Long: `Preview the changes a restore would make to the cluster without restoring anything.
+
+Each item of the backup which would be restored is compared with the cluster and reported as
+missing in the cluster (+), changed in the cluster (~) or the same as the cluster (=). For the
+changed items, the paths of the changed fields are listed. Restore item actions aren't run and
+no volume is restored for the preview.`,
+		Example: `  # Preview the restore of backup "backup-1".
+  velero restore preview backup-1
+
+  # Preview the restore of the namespace "nginx" of backup "backup-1" into namespace "nginx-2".
+  velero restore preview backup-1 --include-namespaces nginx --namespace-mappings nginx:nginx-2`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			o.BackupName = args[0]
+			o.dryRun = true
+			name := fmt.Sprintf("%s-preview-%s", o.BackupName, time.Now().Format("20060102150405"))
+			cmd.CheckError(o.Complete([]string{name}, f))
+			cmd.CheckError(o.Validate(c, args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFilterFlags(c.Flags())
+
+	return c
+}
@@ -0,0 +1,44 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+)
+
+func TestNewPreviewCommand(t *testing.T) {
+	c := NewPreviewCommand(&factorymocks.Factory{}, "preview")
+	require.Equal(t, "preview BACKUP_NAME", c.Use)
+
+	// the filter flags are shared with the create command
+	for _, name := range []string{"include-namespaces", "namespace-mappings", "selector", "resource-modifier-configmap"} {
+		assert.NotNil(t, c.Flags().Lookup(name), name)
+	}
+
+	// the flags which don't affect the preview aren't bound
+	for _, name := range []string{"from-backup", "from-schedule", "wait", "restore-volumes", "labels"} {
+		assert.Nil(t, c.Flags().Lookup(name), name)
+	}
+
+	require.Error(t, c.Args(c, []string{}))
+	require.NoError(t, c.Args(c, []string{"backup-1"}))
+}
@@ -31,6 +31,7 @@ func NewCommand(f client.Factory) *cobra.Command {
 
 	c.AddCommand(
 		NewCreateCommand(f, "create"),
+		NewPreviewCommand(f, "preview"),
 		NewGetCommand(f, "get"),
 		NewLogsCommand(f),
 		NewDescribeCommand(f, "describe"),
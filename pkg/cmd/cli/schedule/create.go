@@ -19,6 +19,7 @@ package schedule
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -27,11 +28,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
+	"github.com/vmware-tanzu/velero/internal/validationpolicies"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/backup"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
 
 func NewCreateCommand(f client.Factory, use string) *cobra.Command {
@@ -85,6 +88,10 @@ type CreateOptions struct {
 	Schedule                   string
 	UseOwnerReferencesInBackup bool
 	Paused                     bool
+	Jitter                     time.Duration
+	StartingDeadline           time.Duration
+	ConcurrencyPolicy          string
+	BackupNameTemplate         string
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -98,6 +105,10 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.Schedule, "schedule", o.Schedule, "A cron expression specifying a recurring schedule for this backup to run")
 	flags.BoolVar(&o.UseOwnerReferencesInBackup, "use-owner-references-in-backup", o.UseOwnerReferencesInBackup, "Specifies whether to use OwnerReferences on backups created by this Schedule. Notice: if set to true, when schedule is deleted, backups will be deleted too.")
 	flags.BoolVar(&o.Paused, "paused", o.Paused, "Specifies whether the newly created schedule is paused or not.")
+	flags.DurationVar(&o.Jitter, "jitter", o.Jitter, "The max random delay added to the time each backup is due, to spread the backups of the schedules with the same cron expression over a time window. Optional.")
+	flags.StringVar(&o.ConcurrencyPolicy, "concurrency-policy", o.ConcurrencyPolicy, "How to treat a new backup when a previous backup of the schedule is still New or InProgress. Valid values are Allow, Forbid, Replace. Optional, defaults to Forbid.")
+	flags.StringVar(&o.BackupNameTemplate, "backup-name-template", o.BackupNameTemplate, "The template of the names of the backups, e.g. \"{{cluster}}-{{schedule}}-{{date}}-{{time}}\". The {{schedule}}, {{cluster}}, {{date}}, {{time}} and {{timestamp}} tokens are supported. Optional, the names are \"<schedule>-<timestamp>\" if not set.")
+	flags.DurationVar(&o.StartingDeadline, "starting-deadline", o.StartingDeadline, "How long after the due time a missed backup can still be started. Optional, a missed backup is always started if not set.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -105,6 +116,20 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--schedule is required")
 	}
 
+	if o.Jitter < 0 {
+		return errors.New("--jitter must not be negative")
+	}
+
+	if o.StartingDeadline < 0 {
+		return errors.New("--starting-deadline must not be negative")
+	}
+
+	switch api.ConcurrencyPolicy(o.ConcurrencyPolicy) {
+	case "", api.ConcurrencyPolicyAllow, api.ConcurrencyPolicyForbid, api.ConcurrencyPolicyReplace:
+	default:
+		return errors.Errorf("invalid --concurrency-policy %q, valid values are Allow, Forbid, Replace", o.ConcurrencyPolicy)
+	}
+
 	return o.BackupOptions.Validate(c, args, f)
 }
 
@@ -135,6 +160,7 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		},
 		Spec: api.ScheduleSpec{
 			Template: api.BackupSpec{
+				Metadata:                         api.Metadata{NameTemplate: o.BackupNameTemplate},
 				IncludedNamespaces:               o.BackupOptions.IncludeNamespaces,
 				ExcludedNamespaces:               o.BackupOptions.ExcludeNamespaces,
 				IncludedResources:                o.BackupOptions.IncludeResources,
@@ -146,6 +172,8 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 				IncludeClusterResources:          o.BackupOptions.IncludeClusterResources.Value,
 				LabelSelector:                    o.BackupOptions.Selector.LabelSelector,
 				OrLabelSelectors:                 o.BackupOptions.OrSelector.OrLabelSelectors,
+				ClusterScopedLabelSelector:       o.BackupOptions.ClusterScopedSelector.LabelSelector,
+				ClusterScopedOrLabelSelectors:    o.BackupOptions.ClusterScopedOrSelector.OrLabelSelectors,
 				SnapshotVolumes:                  o.BackupOptions.SnapshotVolumes.Value,
 				TTL:                              metav1.Duration{Duration: o.BackupOptions.TTL},
 				StorageLocation:                  o.BackupOptions.StorageLocation,
@@ -153,20 +181,42 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 				DefaultVolumesToFsBackup:         o.BackupOptions.DefaultVolumesToFsBackup.Value,
 				OrderedResources:                 orders,
 				CSISnapshotTimeout:               metav1.Duration{Duration: o.BackupOptions.CSISnapshotTimeout},
+				VolumeGroupSnapshotLabelKey:      o.BackupOptions.VolumeGroupSnapshotLabelKey,
 				ItemOperationTimeout:             metav1.Duration{Duration: o.BackupOptions.ItemOperationTimeout},
 				DataMover:                        o.BackupOptions.DataMover,
+				UploaderType:                     o.BackupOptions.UploaderType,
+				CompressionAlgorithm:             o.BackupOptions.CompressionAlgorithm,
 				SnapshotMoveData:                 o.BackupOptions.SnapshotMoveData.Value,
+				SnapshotMoveReplicaLocation:      o.BackupOptions.SnapshotMoveReplicaLocation,
+				ResourceFilterPolicy:             o.BackupOptions.ResourceFilterPolicy,
 			},
 			Schedule:                   o.Schedule,
 			UseOwnerReferencesInBackup: &o.UseOwnerReferencesInBackup,
 			Paused:                     o.Paused,
+			ConcurrencyPolicy:          api.ConcurrencyPolicy(o.ConcurrencyPolicy),
 		},
 	}
 
+	if o.Jitter > 0 {
+		schedule.Spec.Jitter = &metav1.Duration{Duration: o.Jitter}
+	}
+
+	if o.StartingDeadline > 0 {
+		schedule.Spec.StartingDeadline = &metav1.Duration{Duration: o.StartingDeadline}
+	}
+
 	if o.BackupOptions.ResPoliciesConfigmap != "" {
 		schedule.Spec.Template.ResourcePolicy = &v1.TypedLocalObjectReference{Kind: resourcepolicies.ConfigmapRefType, Name: o.BackupOptions.ResPoliciesConfigmap}
 	}
 
+	if o.BackupOptions.DeduplicateClusterResources {
+		schedule.Spec.Template.DeduplicateClusterResources = boolptr.True()
+	}
+
+	if o.BackupOptions.ValidationPoliciesConfigmap != "" {
+		schedule.Spec.Template.ValidationPolicy = &v1.TypedLocalObjectReference{Kind: validationpolicies.ConfigmapRefType, Name: o.BackupOptions.ValidationPoliciesConfigmap}
+	}
+
 	if printed, err := output.PrintWithFormat(c, schedule); printed || err != nil {
 		return err
 	}
@@ -28,6 +28,7 @@ import (
 	"github.com/spf13/pflag"
 
 	appsv1api "k8s.io/api/apps/v1"
+	batchv1api "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
@@ -57,14 +58,28 @@ var resToDelete = []kbclient.ObjectList{}
 
 // uninstallOptions collects all the options for uninstalling Velero from a Kubernetes cluster.
 type uninstallOptions struct {
-	wait  bool // deprecated
-	force bool
+	wait        bool // deprecated
+	force       bool
+	keepCRDs    bool
+	keepBackups bool
+	purgeRepos  bool
 }
 
 // BindFlags adds command line values to the options struct.
 func (o *uninstallOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.BoolVar(&o.wait, "wait", o.wait, "Wait for Velero uninstall to be ready. Optional. Deprecated.")
 	flags.BoolVar(&o.force, "force", o.force, "Forces the Velero uninstall. Optional.")
+	flags.BoolVar(&o.keepCRDs, "keep-crds", o.keepCRDs, "Keep the Velero CRDs in the cluster. Optional.")
+	flags.BoolVar(&o.keepBackups, "keep-backups", o.keepBackups, "Keep the Velero namespace along with the backups, restores, schedules, backup storage locations and the other Velero resources in it, only the Velero workloads and RBAC are removed. Requires --keep-crds. Optional.")
+	flags.BoolVar(&o.purgeRepos, "purge-repos", o.purgeRepos, "Also tear down the backup repositories, which deletes the BackupRepository resources and their maintenance jobs. Optional.")
+}
+
+// Validate validates the options.
+func (o *uninstallOptions) Validate() error {
+	if o.keepBackups && !o.keepCRDs {
+		return errors.New("--keep-backups requires --keep-crds, the backups are removed along with the CRDs")
+	}
+	return nil
 }
 
 // NewCommand creates a cobra command.
@@ -78,9 +93,21 @@ func NewCommand(f client.Factory) *cobra.Command {
 
 The '--namespace' flag can be used to specify the namespace where velero is installed (default: velero).
 Use '--force' to skip the prompt confirming if you want to uninstall Velero.
+Use '--keep-crds' to keep the Velero CRDs, and '--keep-backups' to also keep the Velero namespace
+with the backups and the other Velero resources in it, so that a later install picks them up again.
+Use '--purge-repos' to also tear down the backup repositories.
 		`,
-		Example: ` # velero uninstall --namespace staging`,
+		Example: `  # Uninstall Velero from the staging namespace.
+  velero uninstall --namespace staging
+
+  # Remove the Velero workloads and RBAC but keep the CRDs and the backups.
+  velero uninstall --keep-crds --keep-backups
+
+  # Keep the backups but tear down the backup repositories.
+  velero uninstall --keep-crds --keep-backups --purge-repos`,
 		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Validate())
+
 			if o.wait {
 				fmt.Println("Warning: the \"--wait\" option is deprecated and will be removed in a future release. The uninstall command always waits for the uninstall to complete.")
 			}
@@ -92,11 +119,17 @@ Use '--force' to skip the prompt confirming if you want to uninstall Velero.
 					// Don't do anything unless we get confirmation
 					return
 				}
+				if o.purgeRepos {
+					fmt.Println("You are about to purge the backup repositories, the file system backups and the data movement backups in them can no longer be restored.")
+					if !cli.GetConfirmation() {
+						return
+					}
+				}
 			}
 
 			kbClient, err := f.KubebuilderClient()
 			cmd.CheckError(err)
-			cmd.CheckError(Run(context.Background(), kbClient, f.Namespace()))
+			cmd.CheckError(run(context.Background(), kbClient, f.Namespace(), o))
 		},
 	}
 
@@ -106,8 +139,27 @@ Use '--force' to skip the prompt confirming if you want to uninstall Velero.
 
 // Run removes all components that were deployed using the Velero install command
 func Run(ctx context.Context, kbClient kbclient.Client, namespace string) error {
-	// The CRDs cannot be removed until the namespace is deleted to avoid the problem in issue #3974 so if the namespace deletion fails we error out here
-	if err := deleteNamespace(ctx, kbClient, namespace); err != nil {
+	return run(ctx, kbClient, namespace, &uninstallOptions{})
+}
+
+func run(ctx context.Context, kbClient kbclient.Client, namespace string, o *uninstallOptions) error {
+	// The backup repositories are torn down before the namespace is deleted so that the BackupRepository
+	// resources are removed even if the namespace is kept
+	if o.purgeRepos {
+		if err := purgeBackupRepositories(ctx, kbClient, namespace); err != nil {
+			fmt.Printf("Errors while attempting to uninstall Velero: %q \n", err)
+			return err
+		}
+	}
+
+	if o.keepBackups {
+		// Only the workloads are removed, the namespace is kept along with the backups in it
+		if err := deleteWorkloads(ctx, kbClient, namespace); err != nil {
+			fmt.Printf("Errors while attempting to uninstall Velero: %q \n", err)
+			return err
+		}
+	} else if err := deleteNamespace(ctx, kbClient, namespace); err != nil {
+		// The CRDs cannot be removed until the namespace is deleted to avoid the problem in issue #3974 so if the namespace deletion fails we error out here
 		fmt.Printf("Errors while attempting to uninstall Velero: %q \n", err)
 		return err
 	}
@@ -129,6 +181,16 @@ func Run(ctx context.Context, kbClient kbclient.Client, namespace string) error
 		}
 	}
 
+	if o.keepCRDs {
+		fmt.Println("Velero CRDs are kept.")
+		if err := kubeerrs.NewAggregate(errs); err != nil {
+			fmt.Printf("Errors while attempting to uninstall Velero: %q \n", err)
+			return err
+		}
+		fmt.Println("Velero uninstalled ⛵")
+		return nil
+	}
+
 	// CRDs
 	veleroLabelSelector := labels.SelectorFromSet(install.Labels())
 	opts := []kbclient.DeleteAllOfOption{
@@ -223,6 +285,61 @@ func deleteNamespace(ctx context.Context, kbClient kbclient.Client, namespace st
 	return nil
 }
 
+// deleteWorkloads removes the Velero deployment, the node-agent daemonset and the Velero service account
+// but keeps the namespace along with the Velero resources in it.
+func deleteWorkloads(ctx context.Context, kbClient kbclient.Client, namespace string) error {
+	objs := []kbclient.Object{
+		&appsv1api.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "velero"}},
+		&appsv1api.DaemonSet{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "node-agent"}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "velero"}},
+	}
+
+	for _, obj := range objs {
+		kind := reflect.TypeOf(obj).Elem().Name()
+		if err := kbClient.Delete(ctx, obj, kbclient.PropagationPolicy(metav1.DeletePropagationForeground)); err != nil {
+			if apierrors.IsNotFound(err) {
+				fmt.Printf("Velero %s %q does not exist, skipping.\n", kind, obj.GetName())
+				continue
+			}
+			return errors.Wrapf(err, "error deleting Velero %s %q", kind, obj.GetName())
+		}
+		fmt.Printf("Velero %s %q deleted\n", kind, obj.GetName())
+	}
+
+	fmt.Printf("Velero namespace %q is kept along with the backups in it.\n", namespace)
+	return nil
+}
+
+// purgeBackupRepositories tears down the backup repositories by deleting the BackupRepository resources
+// and the maintenance jobs of them. The repository data in the object storage isn't touched.
+func purgeBackupRepositories(ctx context.Context, kbClient kbclient.Client, namespace string) error {
+	repos := &velerov1api.BackupRepositoryList{}
+	if err := kbClient.List(ctx, repos, kbclient.InNamespace(namespace)); err != nil {
+		if meta.IsNoMatchError(err) {
+			fmt.Println("Velero BackupRepository CRD not found, skipping...")
+			return nil
+		}
+		return errors.Wrap(err, "error listing backup repositories")
+	}
+
+	for i := range repos.Items {
+		if err := kbClient.Delete(ctx, &repos.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error deleting backup repository %q", repos.Items[i].Name)
+		}
+	}
+
+	if err := kbClient.DeleteAllOf(ctx, &batchv1api.Job{},
+		kbclient.InNamespace(namespace),
+		kbclient.HasLabels{velerov1api.RepositoryNameLabel},
+		kbclient.PropagationPolicy(metav1.DeletePropagationBackground),
+	); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrap(err, "error deleting repository maintenance jobs")
+	}
+
+	fmt.Printf("%d backup repositories purged\n", len(repos.Items))
+	return nil
+}
+
 // A few things needed to be noticed here:
 // 1. When we delete resources with attached finalizers, the corresponding controller will deal with the finalizer then resources can be deleted successfully.
 // So it is important to delete these resources before deleting the pod that runs that controller.
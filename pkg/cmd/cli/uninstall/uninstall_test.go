@@ -0,0 +1,120 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uninstall
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1api "k8s.io/api/apps/v1"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/install"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, (&uninstallOptions{}).Validate())
+	assert.NoError(t, (&uninstallOptions{keepCRDs: true}).Validate())
+	assert.NoError(t, (&uninstallOptions{keepCRDs: true, keepBackups: true}).Validate())
+	assert.EqualError(t, (&uninstallOptions{keepBackups: true}).Validate(), "--keep-backups requires --keep-crds, the backups are removed along with the CRDs")
+}
+
+func TestRunKeepBackups(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, velerov1api.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, appsv1api.AddToScheme(scheme))
+	require.NoError(t, batchv1api.AddToScheme(scheme))
+	require.NoError(t, rbacv1.AddToScheme(scheme))
+
+	namespace := "velero"
+	newObjects := func() []kbclient.Object {
+		return []kbclient.Object{
+			install.Namespace(namespace),
+			install.ServiceAccount(namespace, nil),
+			install.ClusterRoleBinding(namespace),
+			install.Deployment(namespace),
+			install.DaemonSet(namespace),
+			builder.ForBackup(namespace, "backup-1").Result(),
+			&velerov1api.BackupRepository{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "repo-1",
+				},
+			},
+			&batchv1api.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "repo-1-maintain-job",
+					Labels:    map[string]string{velerov1api.RepositoryNameLabel: "repo-1"},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		purgeRepos bool
+	}{
+		{
+			name: "keep the backup repositories",
+		},
+		{
+			name:       "purge the backup repositories",
+			purgeRepos: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newObjects()...).Build()
+
+			err := run(ctx, client, namespace, &uninstallOptions{keepCRDs: true, keepBackups: true, purgeRepos: test.purgeRepos})
+			require.NoError(t, err)
+
+			exists := func(obj kbclient.Object, namespace, name string) bool {
+				err := client.Get(ctx, kbclient.ObjectKey{Namespace: namespace, Name: name}, obj)
+				if apierrors.IsNotFound(err) {
+					return false
+				}
+				require.NoError(t, err)
+				return true
+			}
+
+			assert.True(t, exists(&corev1.Namespace{}, "", namespace))
+			assert.True(t, exists(&velerov1api.Backup{}, namespace, "backup-1"))
+			assert.False(t, exists(&appsv1api.Deployment{}, namespace, "velero"))
+			assert.False(t, exists(&appsv1api.DaemonSet{}, namespace, "node-agent"))
+			assert.False(t, exists(&corev1.ServiceAccount{}, namespace, "velero"))
+			assert.False(t, exists(&rbacv1.ClusterRoleBinding{}, "", install.ClusterRoleBinding(namespace).Name))
+			assert.Equal(t, !test.purgeRepos, exists(&velerov1api.BackupRepository{}, namespace, "repo-1"))
+			assert.Equal(t, !test.purgeRepos, exists(&batchv1api.Job{}, namespace, "repo-1-maintain-job"))
+		})
+	}
+}
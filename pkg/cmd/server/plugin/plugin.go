@@ -28,6 +28,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	velerodiscovery "github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/objectstore/oci"
 	veleroplugin "github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	plugincommon "github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	"github.com/vmware-tanzu/velero/pkg/restore"
@@ -62,7 +63,8 @@ func NewCommand(f client.Factory) *cobra.Command {
 				RegisterRestoreItemAction("velero.io/admission-webhook-configuration", newAdmissionWebhookConfigurationAction).
 				RegisterRestoreItemAction("velero.io/secret", newSecretRestoreItemAction(f)).
 				RegisterRestoreItemAction("velero.io/dataupload", newDataUploadRetrieveAction(f)).
-				RegisterDeleteItemAction("velero.io/dataupload-delete", newDateUploadDeleteItemAction(f))
+				RegisterDeleteItemAction("velero.io/dataupload-delete", newDateUploadDeleteItemAction(f)).
+				RegisterObjectStore("velero.io/oci", newOCIObjectStore)
 
 			if !features.IsEnabled(velerov1api.APIGroupVersionsFeatureFlag) {
 				// Do not register crd-remap-version BIA if the API Group feature flag is enabled, so that the v1 CRD can be backed up
@@ -75,6 +77,10 @@ func NewCommand(f client.Factory) *cobra.Command {
 	return c
 }
 
+func newOCIObjectStore(logger logrus.FieldLogger) (interface{}, error) {
+	return oci.NewObjectStore(logger), nil
+}
+
 func newPVBackupItemAction(logger logrus.FieldLogger) (interface{}, error) {
 	return backup.NewPVCAction(logger), nil
 }
@@ -18,6 +18,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
@@ -28,6 +29,7 @@ import (
 	"time"
 
 	logrusr "github.com/bombsimon/logrusr/v3"
+	"github.com/google/uuid"
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	snapshotv1client "github.com/kubernetes-csi/external-snapshotter/client/v4/clientset/versioned"
 	snapshotv1informers "github.com/kubernetes-csi/external-snapshotter/client/v4/informers/externalversions"
@@ -36,7 +38,9 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	batchv1api "k8s.io/api/batch/v1"
 	corev1api "k8s.io/api/core/v1"
+	storagev1api "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -57,6 +61,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/audit"
 	"github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/buildinfo"
 	"github.com/vmware-tanzu/velero/pkg/client"
@@ -69,6 +74,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/itemoperationmap"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+	"github.com/vmware-tanzu/velero/pkg/notification"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
@@ -104,6 +110,8 @@ const (
 	defaultCSISnapshotTimeout   = 10 * time.Minute
 	defaultItemOperationTimeout = 4 * time.Hour
 
+	defaultRepoMaintenanceJobTimeout = 4 * time.Hour
+
 	resourceTimeout = 10 * time.Minute
 
 	// defaultCredentialsDirectory is the path on disk where credential
@@ -112,24 +120,43 @@ const (
 
 	defaultMaxConcurrentK8SConnections = 30
 	defaultDisableInformerCache        = false
+
+	defaultRestoreStreamingBufferSize = 32 * 1024 * 1024
+
+	defaultBackupDeletionConcurrency = 10
+
+	defaultLeaderElectLeaseDuration = 15 * time.Second
+	defaultLeaderElectRenewDeadline = 10 * time.Second
+	defaultLeaderElectRetryPeriod   = 2 * time.Second
 )
 
 type serverConfig struct {
 	// TODO(2.0) Deprecate defaultBackupLocation
 	pluginDir, metricsAddress, defaultBackupLocation                        string
 	backupSyncPeriod, podVolumeOperationTimeout, resourceTerminatingTimeout time.Duration
+	backupSyncNotificationAddress, backupSyncNotificationTokenFile          string
+	clusterName                                                             string
 	defaultBackupTTL, storeValidationFrequency, defaultCSISnapshotTimeout   time.Duration
 	defaultItemOperationTimeout, resourceTimeout                            time.Duration
+	csiSnapshotCreateTimeout, podVolumeWaitTimeout                          time.Duration
+	pluginCallTimeout                                                       time.Duration
 	restoreResourcePriorities                                               restore.Priorities
 	defaultVolumeSnapshotLocations                                          map[string]string
 	restoreOnly                                                             bool
 	disabledControllers                                                     []string
 	clientQPS                                                               float32
 	clientBurst                                                             int
+	controllerConfigMap                                                     string
 	clientPageSize                                                          int
 	profilerAddress                                                         string
 	formatFlag                                                              *logging.FormatFlag
-	repoMaintenanceFrequency                                                time.Duration
+	repoMaintenanceFrequency, repoMaintenanceJobTimeout                     time.Duration
+	repoTenantConfigMap                                                     string
+	notificationConfigMap                                                   string
+	auditLog                                                                bool
+	backupWindowConfigMap                                                   string
+	secretPolicyConfigMap                                                   string
+	volumeGroupSnapshotLabelKey                                             string
 	garbageCollectionFrequency                                              time.Duration
 	itemOperationSyncFrequency                                              time.Duration
 	defaultVolumesToFsBackup                                                bool
@@ -137,6 +164,13 @@ type serverConfig struct {
 	maxConcurrentK8SConnections                                             int
 	defaultSnapshotMoveData                                                 bool
 	disableInformerCache                                                    bool
+	restoreStreaming                                                        bool
+	restoreStreamingBufferSize                                              int
+	secretsEncryptedAtRest                                                  bool
+	backupDeletionConcurrency                                               int
+	leaderElect                                                             bool
+	leaderElectLeaseDuration, leaderElectRenewDeadline                      time.Duration
+	leaderElectRetryPeriod                                                  time.Duration
 }
 
 func NewCommand(f client.Factory) *cobra.Command {
@@ -152,6 +186,7 @@ func NewCommand(f client.Factory) *cobra.Command {
 			defaultBackupTTL:               defaultBackupTTL,
 			defaultCSISnapshotTimeout:      defaultCSISnapshotTimeout,
 			defaultItemOperationTimeout:    defaultItemOperationTimeout,
+			repoMaintenanceJobTimeout:      defaultRepoMaintenanceJobTimeout,
 			resourceTimeout:                resourceTimeout,
 			storeValidationFrequency:       defaultStoreValidationFrequency,
 			podVolumeOperationTimeout:      defaultPodVolumeOperationTimeout,
@@ -166,7 +201,13 @@ func NewCommand(f client.Factory) *cobra.Command {
 			uploaderType:                   uploader.ResticType,
 			maxConcurrentK8SConnections:    defaultMaxConcurrentK8SConnections,
 			defaultSnapshotMoveData:        false,
+			volumeGroupSnapshotLabelKey:    velerov1api.DefaultVGSLabelKey,
 			disableInformerCache:           defaultDisableInformerCache,
+			restoreStreamingBufferSize:     defaultRestoreStreamingBufferSize,
+			backupDeletionConcurrency:      defaultBackupDeletionConcurrency,
+			leaderElectLeaseDuration:       defaultLeaderElectLeaseDuration,
+			leaderElectRenewDeadline:       defaultLeaderElectRenewDeadline,
+			leaderElectRetryPeriod:         defaultLeaderElectRetryPeriod,
 		}
 	)
 
@@ -216,6 +257,9 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().StringVar(&config.pluginDir, "plugin-dir", config.pluginDir, "Directory containing Velero plugins")
 	command.Flags().StringVar(&config.metricsAddress, "metrics-address", config.metricsAddress, "The address to expose prometheus metrics")
 	command.Flags().DurationVar(&config.backupSyncPeriod, "backup-sync-period", config.backupSyncPeriod, "How often to ensure all Velero backups in object storage exist as Backup API objects in the cluster. This is the default sync period if none is explicitly specified for a backup storage location.")
+	command.Flags().StringVar(&config.backupSyncNotificationAddress, "backup-sync-notification-address", config.backupSyncNotificationAddress, "The address to receive the object store notifications (e.g. the webhook notifications of MinIO) of the backups created in or removed from the backup storage locations, which are synced as soon as they're reported. With --leader-elect, the address is only served by the replica running the backup sync controller. The backups are only synced periodically if it's empty.")
+	command.Flags().StringVar(&config.backupSyncNotificationTokenFile, "backup-sync-notification-token-file", config.backupSyncNotificationTokenFile, "The file containing the token the object store notifications must carry in the Authorization header, e.g. the auth_token of the webhook targets of MinIO. The notifications aren't authenticated if it's empty.")
+	command.Flags().StringVar(&config.clusterName, "cluster-name", config.clusterName, "The name of the cluster, which replaces the {{cluster}} token in the name templates of the backups created by the schedules.")
 	command.Flags().DurationVar(&config.podVolumeOperationTimeout, "fs-backup-timeout", config.podVolumeOperationTimeout, "How long pod volume file system backups/restores should be allowed to run before timing out.")
 	command.Flags().BoolVar(&config.restoreOnly, "restore-only", config.restoreOnly, "Run in a mode where only restores are allowed; backups, schedules, and garbage-collection are all disabled. DEPRECATED: this flag will be removed in v2.0. Use read-only backup storage locations instead.")
 	command.Flags().StringSliceVar(&config.disabledControllers, "disable-controllers", config.disabledControllers, fmt.Sprintf("List of controllers to disable on startup. Valid values are %s", strings.Join(controller.DisableableControllers, ",")))
@@ -225,20 +269,39 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().Var(&volumeSnapshotLocations, "default-volume-snapshot-locations", "List of unique volume providers and default volume snapshot location (provider1:location-01,provider2:location-02,...)")
 	command.Flags().Float32Var(&config.clientQPS, "client-qps", config.clientQPS, "Maximum number of requests per second by the server to the Kubernetes API once the burst limit has been reached.")
 	command.Flags().IntVar(&config.clientBurst, "client-burst", config.clientBurst, "Maximum number of requests by the server to the Kubernetes API in a short period of time.")
+	command.Flags().StringVar(&config.controllerConfigMap, "controller-configmap", config.controllerConfigMap, "The name of the configmap in the Velero namespace with the per controller client QPS, client burst and work queue rate limiter, which override the global settings for the controllers.")
 	command.Flags().IntVar(&config.clientPageSize, "client-page-size", config.clientPageSize, "Page size of requests by the server to the Kubernetes API when listing objects during a backup. Set to 0 to disable paging.")
 	command.Flags().StringVar(&config.profilerAddress, "profiler-address", config.profilerAddress, "The address to expose the pprof profiler.")
-	command.Flags().DurationVar(&config.resourceTerminatingTimeout, "terminating-resource-timeout", config.resourceTerminatingTimeout, "How long to wait on persistent volumes and namespaces to terminate during a restore before timing out.")
+	command.Flags().DurationVar(&config.resourceTerminatingTimeout, "terminating-resource-timeout", config.resourceTerminatingTimeout, "How long to wait on persistent volumes, namespaces and the resources recreated by the restores to terminate during a restore before timing out.")
 	command.Flags().DurationVar(&config.defaultBackupTTL, "default-backup-ttl", config.defaultBackupTTL, "How long to wait by default before backups can be garbage collected.")
 	command.Flags().DurationVar(&config.repoMaintenanceFrequency, "default-repo-maintain-frequency", config.repoMaintenanceFrequency, "How often 'maintain' is run for backup repositories by default.")
+	command.Flags().DurationVar(&config.repoMaintenanceJobTimeout, "repo-maintenance-job-timeout", config.repoMaintenanceJobTimeout, "How long to wait for the jobs running the maintenance of the backup repositories to complete.")
+	command.Flags().StringVar(&config.repoTenantConfigMap, "backup-repository-tenant-configmap", config.repoTenantConfigMap, "The name of the configmap in the Velero namespace with the per namespace credentials and maintenance frequencies of the backup repositories.")
+	command.Flags().StringVar(&config.volumeGroupSnapshotLabelKey, "volume-group-snapshot-label-key", config.volumeGroupSnapshotLabelKey, "The label key on the PVCs to group them, the CSI snapshots of the PVCs with the same value of the label in a namespace are taken together by a VolumeGroupSnapshot. Used by the backups that don't specify their own key.")
+	command.Flags().StringVar(&config.backupWindowConfigMap, "backup-window-configmap", config.backupWindowConfigMap, "The name of the configmap in the Velero namespace with the daily time window in which backups are allowed to start, the backups created outside the window are queued until it opens. Backups aren't restricted if it's empty.")
+	command.Flags().BoolVar(&config.auditLog, "audit-log", config.auditLog, "Write an audit trail of the backup creations and deletions, the restores and the changes to the backup storage locations into the audit/ prefix of the backup storage locations.")
+	command.Flags().StringVar(&config.secretPolicyConfigMap, "secret-policy-configmap", config.secretPolicyConfigMap, "The name of the configmap in the Velero namespace with the types of the secrets excluded from or redacted in the backups, e.g. the service account tokens. The secrets are backed up as they are if it's empty.")
+	command.Flags().StringVar(&config.notificationConfigMap, "notification-configmap", config.notificationConfigMap, "The name of the configmap in the Velero namespace with the webhooks the backup, restore and repository maintenance events are sent to. The notification is disabled if it's empty.")
 	command.Flags().DurationVar(&config.garbageCollectionFrequency, "garbage-collection-frequency", config.garbageCollectionFrequency, "How often garbage collection is run for expired backups.")
 	command.Flags().DurationVar(&config.itemOperationSyncFrequency, "item-operation-sync-frequency", config.itemOperationSyncFrequency, "How often to check status on backup/restore operations after backup/restore processing. Default is 10 seconds")
 	command.Flags().BoolVar(&config.defaultVolumesToFsBackup, "default-volumes-to-fs-backup", config.defaultVolumesToFsBackup, "Backup all volumes with pod volume file system backup by default.")
 	command.Flags().StringVar(&config.uploaderType, "uploader-type", config.uploaderType, "Type of uploader to handle the transfer of data of pod volumes")
 	command.Flags().DurationVar(&config.defaultItemOperationTimeout, "default-item-operation-timeout", config.defaultItemOperationTimeout, "How long to wait on asynchronous BackupItemActions and RestoreItemActions to complete before timing out. Default is 4 hours")
 	command.Flags().DurationVar(&config.resourceTimeout, "resource-timeout", config.resourceTimeout, "How long to wait for resource processes which are not covered by other specific timeout parameters. Default is 10 minutes.")
+	command.Flags().DurationVar(&config.csiSnapshotCreateTimeout, "csi-snapshot-create-timeout", config.csiSnapshotCreateTimeout, "How long the CSI plugin waits for the CSI snapshots to be created. If not set, the resource timeout is used.")
+	command.Flags().DurationVar(&config.podVolumeWaitTimeout, "pod-volume-wait-timeout", config.podVolumeWaitTimeout, "How long to wait for the restored pods with the pod volumes to restore to be scheduled. If not set, the resource timeout is used.")
+	command.Flags().DurationVar(&config.pluginCallTimeout, "plugin-call-timeout", config.pluginCallTimeout, "How long to wait for a call to a backup or restore item action plugin before canceling it. The timed out calls of backup item actions are recorded as backup warnings. No timeout if it's 0.")
 	command.Flags().IntVar(&config.maxConcurrentK8SConnections, "max-concurrent-k8s-connections", config.maxConcurrentK8SConnections, "Max concurrent connections number that Velero can create with kube-apiserver. Default is 30.")
 	command.Flags().BoolVar(&config.defaultSnapshotMoveData, "default-snapshot-move-data", config.defaultSnapshotMoveData, "Move data by default for all snapshots supporting data movement.")
 	command.Flags().BoolVar(&config.disableInformerCache, "disable-informer-cache", config.disableInformerCache, "Disable informer cache for Get calls on restore. With this enabled, it will speed up restore in cases where there are backup resources which already exist in the cluster, but for very large clusters this will increase velero memory usage. Default is false (don't disable).")
+	command.Flags().BoolVar(&config.restoreStreaming, "restore-streaming", config.restoreStreaming, "Stream the backup contents from the backup storage location into the extraction of the restore instead of downloading the whole backup tarball to a temp file first. The whole tarball is still extracted to a temp directory before the items are restored, so this doesn't lower the disk space the Velero server pod needs for a restore.")
+	command.Flags().IntVar(&config.restoreStreamingBufferSize, "restore-streaming-buffer-size", config.restoreStreamingBufferSize, "The size in bytes of the read-ahead buffer used when streaming the backup contents into the restore.")
+	command.Flags().BoolVar(&config.secretsEncryptedAtRest, "secrets-encrypted-at-rest", config.secretsEncryptedAtRest, "Acknowledge that the cluster encrypts the secrets at rest, e.g. a managed cluster whose kube-apiserver pods can't be inspected. The restores verifying the secret encryption of the cluster trust this instead of checking the kube-apiserver pods.")
+	command.Flags().IntVar(&config.backupDeletionConcurrency, "backup-deletion-concurrency", config.backupDeletionConcurrency, "Max number of object deletions, or batch deletions if the object store supports them, running in parallel when deleting the data of a backup or restore from the backup storage location.")
+	command.Flags().BoolVar(&config.leaderElect, "leader-elect", config.leaderElect, "Run a leader election per controller group with a lease in the Velero namespace, so that multiple replicas of the Velero server can share the controllers. Each controller group is only run by the replica holding its lease.")
+	command.Flags().DurationVar(&config.leaderElectLeaseDuration, "leader-elect-lease-duration", config.leaderElectLeaseDuration, "How long the non-leader replicas wait before trying to acquire a lease which isn't renewed. Only used when --leader-elect is set.")
+	command.Flags().DurationVar(&config.leaderElectRenewDeadline, "leader-elect-renew-deadline", config.leaderElectRenewDeadline, "How long the leader replica retries renewing a lease before giving it up. Only used when --leader-elect is set.")
+	command.Flags().DurationVar(&config.leaderElectRetryPeriod, "leader-elect-retry-period", config.leaderElectRetryPeriod, "How long the replicas wait between tries of acquiring or renewing a lease. Only used when --leader-elect is set.")
 
 	return command
 }
@@ -268,6 +331,12 @@ type server struct {
 	credentialFileStore   credentials.FileStore
 	credentialSecretStore credentials.SecretStore
 	featureVerifier       features.Verifier
+	// leaderElectedManagers are the managers of the leader election groups, keyed by the group name
+	leaderElectedManagers map[string]manager.Manager
+	// controllerConfigs are the per controller configs, keyed by the controller name
+	controllerConfigs map[string]controller.ControllerConfig
+	// controllerClients are the clients of the controllers with their own client QPS and burst
+	controllerClients map[string]ctrlclient.Client
 }
 
 func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*server, error) {
@@ -304,6 +373,12 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		return nil, err
 	}
 
+	controllerConfigs, err := getControllerConfigs(context.Background(), kubeClient, f.Namespace(), config.controllerConfigMap)
+	if err != nil {
+		return nil, err
+	}
+	controller.SetWorkQueueConfigs(controllerConfigs)
+
 	pluginRegistry := process.NewRegistry(config.pluginDir, logger, logger.Level)
 	if err := pluginRegistry.DiscoverPlugins(); err != nil {
 		return nil, err
@@ -344,6 +419,14 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		cancelFunc()
 		return nil, err
 	}
+	if err := storagev1api.AddToScheme(scheme); err != nil {
+		cancelFunc()
+		return nil, err
+	}
+	if err := batchv1api.AddToScheme(scheme); err != nil {
+		cancelFunc()
+		return nil, err
+	}
 
 	ctrl.SetLogger(logrusr.New(logger))
 
@@ -397,6 +480,9 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		credentialFileStore:   credentialFileStore,
 		credentialSecretStore: credentialSecretStore,
 		featureVerifier:       featureVerifier,
+		leaderElectedManagers: map[string]manager.Manager{},
+		controllerConfigs:     controllerConfigs,
+		controllerClients:     map[string]ctrlclient.Client{},
 	}
 
 	// Setup CSI snapshot client and lister
@@ -447,7 +533,11 @@ func (s *server) run() error {
 		return err
 	}
 
-	markInProgressCRsFailed(s.ctx, s.mgr.GetConfig(), s.mgr.GetScheme(), s.namespace, s.logger)
+	// With the leader election, the in progress CRs may be being processed by another replica,
+	// they are marked as failed when the lease of their controller group is acquired instead.
+	if !s.config.leaderElect {
+		markInProgressCRsFailed(s.ctx, s.mgr.GetConfig(), s.mgr.GetScheme(), s.namespace, s.logger)
+	}
 
 	if err := s.runControllers(s.config.defaultVolumeSnapshotLocations); err != nil {
 		return err
@@ -671,40 +761,53 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	s.metrics.InitSchedule("")
 
 	newPluginManager := func(logger logrus.FieldLogger) clientmgmt.Manager {
-		return clientmgmt.NewManager(logger, s.logLevel, s.pluginRegistry)
+		return clientmgmt.NewManager(logger, s.logLevel, s.pluginRegistry, s.config.pluginCallTimeout)
 	}
 
-	backupStoreGetter := persistence.NewObjectBackupStoreGetter(s.credentialFileStore)
+	backupStoreGetter := persistence.NewObjectBackupStoreGetter(s.credentialFileStore, s.config.backupDeletionConcurrency)
 
 	backupTracker := controller.NewBackupTracker()
 
+	notifier := notification.NewDispatcher(s.mgr.GetClient(), s.namespace, s.config.notificationConfigMap, s.logger)
+
+	auditRecorder := audit.NewRecorder(s.config.auditLog, s.mgr.GetClient(), newPluginManager, backupStoreGetter, s.logger)
+
 	// By far, PodVolumeBackup, PodVolumeRestore, BackupStorageLocation controllers
 	// are not included in --disable-controllers list.
 	// This is because of PVB and PVR are used by node agent DaemonSet,
 	// and BSL controller is mandatory for Velero to work.
 	// Note: all runtime type controllers that can be disabled are grouped separately, below:
 	enabledRuntimeControllers := map[string]struct{}{
-		controller.Backup:              {},
-		controller.BackupDeletion:      {},
-		controller.BackupFinalizer:     {},
-		controller.BackupOperations:    {},
-		controller.BackupRepo:          {},
-		controller.BackupSync:          {},
-		controller.DownloadRequest:     {},
-		controller.GarbageCollection:   {},
-		controller.Restore:             {},
-		controller.RestoreOperations:   {},
-		controller.Schedule:            {},
-		controller.ServerStatusRequest: {},
+		controller.Backup:                          {},
+		controller.BackupCopy:                      {},
+		controller.BackupDeletion:                  {},
+		controller.BackupFinalizer:                 {},
+		controller.BackupOperations:                {},
+		controller.BackupRepo:                      {},
+		controller.BackupRepoMigration:             {},
+		controller.BackupRepoOrphan:                {},
+		controller.BackupStorageLocationCredential: {},
+		controller.BackupSync:                      {},
+		controller.BackupTiering:                   {},
+		controller.DownloadRequest:                 {},
+		controller.GarbageCollection:               {},
+		controller.Restore:                         {},
+		controller.RestoreOperations:               {},
+		controller.RestoreSchedule:                 {},
+		controller.Schedule:                        {},
+		controller.ServerStatusRequest:             {},
 	}
 
 	if s.config.restoreOnly {
 		s.logger.Info("Restore only mode - not starting the backup, schedule, delete-backup, or GC controllers")
 		s.config.disabledControllers = append(s.config.disabledControllers,
 			controller.Backup,
+			controller.BackupCopy,
 			controller.BackupDeletion,
 			controller.BackupFinalizer,
 			controller.BackupOperations,
+			controller.BackupRepoOrphan,
+			controller.BackupTiering,
 			controller.GarbageCollection,
 			controller.Schedule,
 		)
@@ -719,19 +822,28 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	// Enable BSL controller. No need to check whether it's enabled or not.
 	bslr := controller.NewBackupStorageLocationReconciler(
 		s.ctx,
-		s.mgr.GetClient(),
+		s.clientFor(controller.BackupStorageLocation),
 		storage.DefaultBackupLocationInfo{
 			StorageLocation:           s.config.defaultBackupLocation,
 			ServerValidationFrequency: s.config.storeValidationFrequency,
 		},
 		newPluginManager,
 		backupStoreGetter,
+		auditRecorder,
 		s.logger,
 	)
-	if err := bslr.SetupWithManager(s.mgr); err != nil {
+	if err := bslr.SetupWithManager(s.managerFor(controller.BackupStorageLocation)); err != nil {
 		s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupStorageLocation)
 	}
 
+	if _, ok := enabledRuntimeControllers[controller.BackupStorageLocationCredential]; ok {
+		r := controller.NewBackupStorageLocationCredentialReconciler(s.clientFor(controller.BackupStorageLocationCredential), s.credentialFileStore, s.logger)
+		// the credentials files are on the local disk of each replica, so the controller isn't leader elected
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupStorageLocationCredential)
+		}
+	}
+
 	pvbInformer, err := s.mgr.GetCache().GetInformer(s.ctx, &velerov1api.PodVolumeBackup{})
 	if err != nil {
 		s.logger.Fatal(err, "fail to get controller-runtime informer from manager for PVB")
@@ -739,9 +851,9 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 
 	if _, ok := enabledRuntimeControllers[controller.Backup]; ok {
 		backupper, err := backup.NewKubernetesBackupper(
-			s.mgr.GetClient(),
+			s.clientFor(controller.Backup),
 			s.discoveryHelper,
-			client.NewDynamicFactory(s.dynamicClient),
+			client.NewDynamicFactory(s.dynamicClientFor(controller.Backup)),
 			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
 			podvolume.NewBackupperFactory(
 				s.repoLocker,
@@ -764,12 +876,12 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.logLevel,
 			newPluginManager,
 			backupTracker,
-			s.mgr.GetClient(),
+			s.clientFor(controller.Backup),
 			s.config.defaultBackupLocation,
 			s.config.defaultVolumesToFsBackup,
 			s.config.defaultBackupTTL,
 			s.config.defaultCSISnapshotTimeout,
-			s.config.resourceTimeout,
+			resourceTimeoutOr(s.config.csiSnapshotCreateTimeout, s.config.resourceTimeout),
 			s.config.defaultItemOperationTimeout,
 			defaultVolumeSnapshotLocations,
 			s.metrics,
@@ -779,7 +891,12 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.credentialFileStore,
 			s.config.maxConcurrentK8SConnections,
 			s.config.defaultSnapshotMoveData,
-		).SetupWithManager(s.mgr); err != nil {
+			notifier,
+			s.config.backupWindowConfigMap,
+			s.config.secretPolicyConfigMap,
+			s.config.volumeGroupSnapshotLabelKey,
+			auditRecorder,
+		).SetupWithManager(s.managerFor(controller.Backup)); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.Backup)
 		}
 	}
@@ -787,7 +904,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	if _, ok := enabledRuntimeControllers[controller.BackupDeletion]; ok {
 		if err := controller.NewBackupDeletionReconciler(
 			s.logger,
-			s.mgr.GetClient(),
+			s.clientFor(controller.BackupDeletion),
 			backupTracker,
 			s.repoManager,
 			s.metrics,
@@ -795,7 +912,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			newPluginManager,
 			backupStoreGetter,
 			s.credentialFileStore,
-		).SetupWithManager(s.mgr); err != nil {
+			auditRecorder,
+		).SetupWithManager(s.managerFor(controller.BackupDeletion)); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupDeletion)
 		}
 	}
@@ -804,23 +922,23 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	if _, ok := enabledRuntimeControllers[controller.BackupOperations]; ok {
 		r := controller.NewBackupOperationsReconciler(
 			s.logger,
-			s.mgr.GetClient(),
+			s.clientFor(controller.BackupOperations),
 			s.config.itemOperationSyncFrequency,
 			newPluginManager,
 			backupStoreGetter,
 			s.metrics,
 			backupOpsMap,
 		)
-		if err := r.SetupWithManager(s.mgr); err != nil {
+		if err := r.SetupWithManager(s.managerFor(controller.BackupOperations)); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupOperations)
 		}
 	}
 
 	if _, ok := enabledRuntimeControllers[controller.BackupFinalizer]; ok {
 		backupper, err := backup.NewKubernetesBackupper(
-			s.mgr.GetClient(),
+			s.clientFor(controller.BackupFinalizer),
 			s.discoveryHelper,
-			client.NewDynamicFactory(s.dynamicClient),
+			client.NewDynamicFactory(s.dynamicClientFor(controller.BackupFinalizer)),
 			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
 			podvolume.NewBackupperFactory(
 				s.repoLocker,
@@ -836,7 +954,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		)
 		cmd.CheckError(err)
 		r := controller.NewBackupFinalizerReconciler(
-			s.mgr.GetClient(),
+			s.clientFor(controller.BackupFinalizer),
 			s.csiSnapshotLister,
 			clock.RealClock{},
 			backupper,
@@ -845,18 +963,42 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			backupStoreGetter,
 			s.logger,
 			s.metrics,
+			notifier,
 		)
-		if err := r.SetupWithManager(s.mgr); err != nil {
+		if err := r.SetupWithManager(s.managerFor(controller.BackupFinalizer)); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupFinalizer)
 		}
 	}
 
 	if _, ok := enabledRuntimeControllers[controller.BackupRepo]; ok {
-		if err := controller.NewBackupRepoReconciler(s.namespace, s.logger, s.mgr.GetClient(), s.config.repoMaintenanceFrequency, s.repoManager).SetupWithManager(s.mgr); err != nil {
+		if err := controller.NewBackupRepoReconciler(s.namespace, s.logger, s.clientFor(controller.BackupRepo), s.config.repoMaintenanceFrequency, s.config.repoTenantConfigMap, s.repoManager,
+			repository.NewMaintenanceJobRunner(s.kubeClient, s.namespace, s.config.repoMaintenanceJobTimeout, s.logger), notifier).SetupWithManager(s.managerFor(controller.BackupRepo)); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupRepo)
 		}
 	}
 
+	if _, ok := enabledRuntimeControllers[controller.BackupRepoMigration]; ok {
+		credentialGetter := &credentials.CredentialGetter{FromFile: s.credentialFileStore, FromSecret: s.credentialSecretStore}
+		if err := controller.NewBackupRepositoryMigrationReconciler(s.namespace, s.clientFor(controller.BackupRepoMigration), s.repoLocker, s.repoEnsurer, credentialGetter, s.logger).SetupWithManager(s.managerFor(controller.BackupRepoMigration)); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupRepoMigration)
+		}
+	}
+
+	if _, ok := enabledRuntimeControllers[controller.BackupRepoOrphan]; ok {
+		r := controller.NewBackupRepoOrphanReconciler(s.namespace, s.clientFor(controller.BackupRepoOrphan), s.repoManager, newPluginManager, backupStoreGetter, s.logger)
+		if err := r.SetupWithManager(s.managerFor(controller.BackupRepoOrphan)); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupRepoOrphan)
+		}
+	}
+
+	if _, ok := enabledRuntimeControllers[controller.BackupCopy]; ok {
+		credentialGetter := &credentials.CredentialGetter{FromFile: s.credentialFileStore, FromSecret: s.credentialSecretStore}
+		r := controller.NewBackupCopyReconciler(s.namespace, s.clientFor(controller.BackupCopy), newPluginManager, backupStoreGetter, s.repoLocker, s.repoEnsurer, credentialGetter, s.logger)
+		if err := r.SetupWithManager(s.managerFor(controller.BackupCopy)); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupCopy)
+		}
+	}
+
 	if _, ok := enabledRuntimeControllers[controller.BackupSync]; ok {
 		syncPeriod := s.config.backupSyncPeriod
 		if syncPeriod <= 0 {
@@ -864,16 +1006,32 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 
 		backupSyncReconciler := controller.NewBackupSyncReconciler(
-			s.mgr.GetClient(),
+			s.clientFor(controller.BackupSync),
 			s.namespace,
 			syncPeriod,
 			newPluginManager,
 			backupStoreGetter,
 			s.logger,
 		)
-		if err := backupSyncReconciler.SetupWithManager(s.mgr); err != nil {
+		if err := backupSyncReconciler.SetupWithManager(s.managerFor(controller.BackupSync)); err != nil {
 			s.logger.Fatal(err, " unable to create controller ", "controller ", controller.BackupSync)
 		}
+
+		if s.config.backupSyncNotificationAddress != "" {
+			authToken, err := readBackupSyncNotificationToken(s.config.backupSyncNotificationTokenFile)
+			if err != nil {
+				s.logger.Fatal(err, " unable to read the backup sync notification token")
+			}
+			if authToken == "" {
+				s.logger.Warn("The backup sync notifications aren't authenticated, restrict the access to the notification address")
+			}
+			// the notifications are only drained by the backup sync controller, so with the leader
+			// election they're only received by the replica running it
+			if err := s.managerFor(controller.BackupSync).Add(newBackupSyncNotificationServer(
+				s.config.backupSyncNotificationAddress, backupSyncReconciler.NotificationHandler(authToken), s.logger)); err != nil {
+				s.logger.Fatal(err, " unable to add the backup sync notification server")
+			}
+		}
 	}
 
 	restoreOpsMap := itemoperationmap.NewRestoreItemOperationsMap()
@@ -881,21 +1039,22 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		r := controller.NewRestoreOperationsReconciler(
 			s.logger,
 			s.namespace,
-			s.mgr.GetClient(),
+			s.clientFor(controller.RestoreOperations),
 			s.config.itemOperationSyncFrequency,
 			newPluginManager,
 			backupStoreGetter,
 			s.metrics,
 			restoreOpsMap,
+			notifier,
 		)
-		if err := r.SetupWithManager(s.mgr); err != nil {
+		if err := r.SetupWithManager(s.managerFor(controller.RestoreOperations)); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.RestoreOperations)
 		}
 	}
 
 	if _, ok := enabledRuntimeControllers[controller.DownloadRequest]; ok {
 		r := controller.NewDownloadRequestReconciler(
-			s.mgr.GetClient(),
+			s.clientFor(controller.DownloadRequest),
 			clock.RealClock{},
 			newPluginManager,
 			backupStoreGetter,
@@ -903,18 +1062,30 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			backupOpsMap,
 			restoreOpsMap,
 		)
-		if err := r.SetupWithManager(s.mgr); err != nil {
+		if s.config.leaderElect {
+			if _, ok := enabledRuntimeControllers[controller.RestoreOperations]; ok {
+				r.WithRestoreItemOperationsLeading(s.managerFor(controller.RestoreOperations).(*controller.LeaderElectedManager).Leading)
+			}
+		}
+		if err := r.SetupWithManager(s.managerFor(controller.DownloadRequest)); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.DownloadRequest)
 		}
 	}
 
 	if _, ok := enabledRuntimeControllers[controller.GarbageCollection]; ok {
-		r := controller.NewGCReconciler(s.logger, s.mgr.GetClient(), s.config.garbageCollectionFrequency)
-		if err := r.SetupWithManager(s.mgr); err != nil {
+		r := controller.NewGCReconciler(s.logger, s.clientFor(controller.GarbageCollection), s.config.garbageCollectionFrequency)
+		if err := r.SetupWithManager(s.managerFor(controller.GarbageCollection)); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.GarbageCollection)
 		}
 	}
 
+	if _, ok := enabledRuntimeControllers[controller.BackupTiering]; ok {
+		r := controller.NewBackupTieringReconciler(s.logger, s.clientFor(controller.BackupTiering), newPluginManager, backupStoreGetter)
+		if err := r.SetupWithManager(s.managerFor(controller.BackupTiering)); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupTiering)
+		}
+	}
+
 	pvrInformer, err := s.mgr.GetCache().GetInformer(s.ctx, &velerov1api.PodVolumeRestore{})
 	if err != nil {
 		s.logger.Fatal(err, "fail to get controller-runtime informer from manager for PVR")
@@ -923,7 +1094,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	if _, ok := enabledRuntimeControllers[controller.Restore]; ok {
 		restorer, err := restore.NewKubernetesRestorer(
 			s.discoveryHelper,
-			client.NewDynamicFactory(s.dynamicClient),
+			client.NewDynamicFactory(s.dynamicClientFor(controller.Restore)),
 			s.config.restoreResourcePriorities,
 			s.kubeClient.CoreV1().Namespaces(),
 			podvolume.NewRestorerFactory(
@@ -932,6 +1103,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 				s.kubeClient,
 				s.crClient,
 				pvrInformer,
+				resourceTimeoutOr(s.config.podVolumeWaitTimeout, s.config.resourceTimeout),
 				s.logger,
 			),
 			s.config.podVolumeOperationTimeout,
@@ -941,17 +1113,23 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
 			s.kubeClient.CoreV1().RESTClient(),
 			s.credentialFileStore,
-			s.mgr.GetClient(),
+			s.clientFor(controller.Restore),
 			s.featureVerifier,
+			s.config.secretsEncryptedAtRest,
 		)
 
 		cmd.CheckError(err)
 
+		var restoreStreamingBufferSize int
+		if s.config.restoreStreaming {
+			restoreStreamingBufferSize = s.config.restoreStreamingBufferSize
+		}
+
 		r := controller.NewRestoreReconciler(
 			s.ctx,
 			s.namespace,
 			restorer,
-			s.mgr.GetClient(),
+			s.clientFor(controller.Restore),
 			s.logger,
 			s.logLevel,
 			newPluginManager,
@@ -960,27 +1138,36 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.config.formatFlag.Parse(),
 			s.config.defaultItemOperationTimeout,
 			s.config.disableInformerCache,
+			restoreStreamingBufferSize,
+			notifier,
+			auditRecorder,
 		)
 
-		if err = r.SetupWithManager(s.mgr); err != nil {
+		if err = r.SetupWithManager(s.managerFor(controller.Restore)); err != nil {
 			s.logger.Fatal(err, "fail to create controller", "controller", controller.Restore)
 		}
 	}
 
 	if _, ok := enabledRuntimeControllers[controller.Schedule]; ok {
-		if err := controller.NewScheduleReconciler(s.namespace, s.logger, s.mgr.GetClient(), s.metrics).SetupWithManager(s.mgr); err != nil {
+		if err := controller.NewScheduleReconciler(s.namespace, s.config.clusterName, s.logger, s.clientFor(controller.Schedule), s.metrics).SetupWithManager(s.managerFor(controller.Schedule)); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.Schedule)
 		}
 	}
 
+	if _, ok := enabledRuntimeControllers[controller.RestoreSchedule]; ok {
+		if err := controller.NewRestoreScheduleReconciler(s.namespace, s.logger, s.clientFor(controller.RestoreSchedule), s.metrics).SetupWithManager(s.managerFor(controller.RestoreSchedule)); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.RestoreSchedule)
+		}
+	}
+
 	if _, ok := enabledRuntimeControllers[controller.ServerStatusRequest]; ok {
 		if err := controller.NewServerStatusRequestReconciler(
 			s.ctx,
-			s.mgr.GetClient(),
+			s.clientFor(controller.ServerStatusRequest),
 			s.pluginRegistry,
 			clock.RealClock{},
 			s.logger,
-		).SetupWithManager(s.mgr); err != nil {
+		).SetupWithManager(s.managerFor(controller.ServerStatusRequest)); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.ServerStatusRequest)
 		}
 	}
@@ -1009,6 +1196,174 @@ func removeControllers(disabledControllers []string, enabledRuntimeControllers m
 	return nil
 }
 
+// managerFor returns the manager to set up the controller with. When the leader election is
+// enabled, the controller is started only when the replica holds the lease of its group.
+func (s *server) managerFor(controllerName string) manager.Manager {
+	if !s.config.leaderElect {
+		return s.mgr
+	}
+
+	group := controller.LeaderElectionGroup(controllerName)
+	if mgr, ok := s.leaderElectedManagers[group]; ok {
+		return mgr
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		s.logger.Fatal(err, "unable to get hostname for the leader election identity")
+	}
+
+	mgr, err := controller.NewLeaderElectedManager(s.mgr, group, s.kubeClient.CoordinationV1(), controller.LeaderElectionConfig{
+		Namespace:     s.namespace,
+		Identity:      hostname + "_" + uuid.New().String(),
+		LeaseDuration: s.config.leaderElectLeaseDuration,
+		RenewDeadline: s.config.leaderElectRenewDeadline,
+		RetryPeriod:   s.config.leaderElectRetryPeriod,
+	}, s.markInProgressCRsFailedOnLeading(group), s.logger)
+	if err != nil {
+		s.logger.Fatal(err, "unable to create leader election group", "group", group)
+	}
+	s.leaderElectedManagers[group] = mgr
+
+	return mgr
+}
+
+// resourceTimeoutOr returns the timeout of an operation type, or the resource timeout if it is not set
+func resourceTimeoutOr(timeout time.Duration, resourceTimeout time.Duration) time.Duration {
+	if timeout > 0 {
+		return timeout
+	}
+	return resourceTimeout
+}
+
+// getControllerConfigs reads the per controller configs from the configmap, whose only data entry
+// is the JSON of the configs keyed by the controller name.
+func getControllerConfigs(ctx context.Context, kubeClient kubernetes.Interface, namespace string, name string) (map[string]controller.ControllerConfig, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	cm, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting controller configmap %s", name)
+	}
+	if len(cm.Data) != 1 {
+		return nil, errors.Errorf("controller configmap %s should have exactly one data entry", name)
+	}
+
+	configs := map[string]controller.ControllerConfig{}
+	for _, data := range cm.Data {
+		if err := json.Unmarshal([]byte(data), &configs); err != nil {
+			return nil, errors.Wrapf(err, "error unmarshalling controller configs from %s", name)
+		}
+	}
+
+	configurable := sets.NewString(controller.ConfigurableControllers...)
+	for controllerName, config := range configs {
+		if !configurable.Has(controllerName) {
+			return nil, errors.Errorf("invalid controller %s in configmap %s, valid values are %s", controllerName, name, strings.Join(controller.ConfigurableControllers, ","))
+		}
+		if err := config.Validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid config of controller %s", controllerName)
+		}
+	}
+
+	return configs, nil
+}
+
+// restConfigFor returns the REST config of the clients used by the controller, with the client
+// QPS and burst of the controller config if any.
+// QPS and burst of the controller config if any. The returned bool is false if the controller
+// uses the global client settings.
+func (s *server) restConfigFor(controllerName string) (*rest.Config, bool) {
+	controllerConfig, ok := s.controllerConfigs[controllerName]
+	if !ok || (controllerConfig.ClientQPS == 0 && controllerConfig.ClientBurst == 0) {
+		return s.kubeClientConfig, false
+	}
+
+	config := rest.CopyConfig(s.kubeClientConfig)
+	if controllerConfig.ClientQPS > 0 {
+		config.QPS = controllerConfig.ClientQPS
+	}
+	if controllerConfig.ClientBurst > 0 {
+		config.Burst = controllerConfig.ClientBurst
+	}
+	return config, true
+}
+
+// clientFor returns the client used by the controller. The controller with its own client QPS
+// and burst gets a client with its own rate limiter, which reads from the cache of the manager
+// and writes to the Kubernetes API directly as the client of the manager does.
+func (s *server) clientFor(controllerName string) ctrlclient.Client {
+	if client, ok := s.controllerClients[controllerName]; ok {
+		return client
+	}
+
+	config, ok := s.restConfigFor(controllerName)
+	if !ok {
+		return s.mgr.GetClient()
+	}
+
+	client, err := ctrlclient.New(config, ctrlclient.Options{Scheme: s.mgr.GetScheme(), Mapper: s.mgr.GetRESTMapper()})
+	if err != nil {
+		s.logger.Fatal(err, "unable to create client", "controller", controllerName)
+	}
+	delegatingClient, err := ctrlclient.NewDelegatingClient(ctrlclient.NewDelegatingClientInput{
+		CacheReader: s.mgr.GetCache(),
+		Client:      client,
+	})
+	if err != nil {
+		s.logger.Fatal(err, "unable to create client", "controller", controllerName)
+	}
+
+	s.logger.Infof("Controller %s uses client QPS %v and burst %d", controllerName, config.QPS, config.Burst)
+	s.controllerClients[controllerName] = delegatingClient
+	return delegatingClient
+}
+
+// dynamicClientFor returns the dynamic client used by the controller to back up and restore the
+// resources, with the client QPS and burst of the controller config if any.
+func (s *server) dynamicClientFor(controllerName string) dynamic.Interface {
+	config, ok := s.restConfigFor(controllerName)
+	if !ok {
+		return s.dynamicClient
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		s.logger.Fatal(err, "unable to create dynamic client", "controller", controllerName)
+	}
+	return dynamicClient
+}
+
+// markInProgressCRsFailedOnLeading returns the func marking the in progress CRs processed by the
+// leader election group as failed, it's called when the lease of the group is acquired because
+// the CRs were left in progress by the previous leader.
+func (s *server) markInProgressCRsFailedOnLeading(group string) func(context.Context) {
+	var mark func(context.Context, ctrlclient.Client, string, logrus.FieldLogger)
+	switch group {
+	case controller.Backup:
+		mark = markInProgressBackupsFailed
+	case controller.Restore:
+		mark = markInProgressRestoresFailed
+	case controller.BackupRepoMigration:
+		mark = markInProgressBackupRepositoryMigrationsFailed
+	case controller.BackupCopy:
+		mark = markInProgressBackupCopiesFailed
+	default:
+		return nil
+	}
+
+	return func(ctx context.Context) {
+		client, err := ctrlclient.New(s.mgr.GetConfig(), ctrlclient.Options{Scheme: s.mgr.GetScheme()})
+		if err != nil {
+			s.logger.WithError(errors.WithStack(err)).Error("failed to create client")
+			return
+		}
+		mark(ctx, client, s.namespace, s.logger)
+	}
+}
+
 func (s *server) runProfiler() {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/debug/pprof/", pprof.Index)
@@ -1027,6 +1382,51 @@ func (s *server) runProfiler() {
 	}
 }
 
+// readBackupSyncNotificationToken reads the token of the backup sync notifications from the file, e.g.
+// a mounted secret, the token is empty if the file isn't set.
+func readBackupSyncNotificationToken(file string) (string, error) {
+	if file == "" {
+		return "", nil
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", errors.Wrapf(err, "error reading the backup sync notification token file %s", file)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", errors.Errorf("the backup sync notification token file %s is empty", file)
+	}
+	return token, nil
+}
+
+// newBackupSyncNotificationServer returns the runnable serving the backup sync notifications at
+// the address until the context is done.
+func newBackupSyncNotificationServer(address string, handler http.Handler, logger logrus.FieldLogger) manager.Runnable {
+	return manager.RunnableFunc(func(ctx context.Context) error {
+		mux := http.NewServeMux()
+		mux.Handle(controller.BackupSyncNotificationPath, handler)
+
+		logger.Infof("Starting backup sync notification server at address [%s]", address)
+		server := &http.Server{
+			Addr:              address,
+			Handler:           mux,
+			ReadHeaderTimeout: 3 * time.Second,
+		}
+		go func() {
+			<-ctx.Done()
+			if err := server.Shutdown(context.Background()); err != nil {
+				logger.WithError(errors.WithStack(err)).Error("error shutting down backup sync notification http server")
+			}
+		}()
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.WithError(errors.WithStack(err)).Error("error running backup sync notification http server")
+		}
+		return nil
+	})
+}
+
 // CSIInformerFactoryWrapper is a proxy around the CSI SharedInformerFactory that checks the CSI feature flag before performing operations.
 type CSIInformerFactoryWrapper struct {
 	factory snapshotv1informers.SharedInformerFactory
@@ -1071,6 +1471,10 @@ func markInProgressCRsFailed(ctx context.Context, cfg *rest.Config, scheme *runt
 	markInProgressBackupsFailed(ctx, client, namespace, log)
 
 	markInProgressRestoresFailed(ctx, client, namespace, log)
+
+	markInProgressBackupRepositoryMigrationsFailed(ctx, client, namespace, log)
+
+	markInProgressBackupCopiesFailed(ctx, client, namespace, log)
 }
 
 func markInProgressBackupsFailed(ctx context.Context, client ctrlclient.Client, namespace string, log logrus.FieldLogger) {
@@ -1122,6 +1526,52 @@ func markInProgressRestoresFailed(ctx context.Context, client ctrlclient.Client,
 	}
 }
 
+func markInProgressBackupRepositoryMigrationsFailed(ctx context.Context, client ctrlclient.Client, namespace string, log logrus.FieldLogger) {
+	migrations := &velerov2alpha1api.BackupRepositoryMigrationList{}
+	if err := client.List(ctx, migrations, &ctrlclient.MatchingFields{"metadata.namespace": namespace}); err != nil {
+		log.WithError(errors.WithStack(err)).Error("failed to list backup repository migrations")
+		return
+	}
+	for i, migration := range migrations.Items {
+		if migration.Status.Phase != velerov2alpha1api.BackupRepositoryMigrationPhaseInProgress {
+			log.Debugf("the status of backup repository migration %q is %q, skip", migration.GetName(), migration.Status.Phase)
+			continue
+		}
+		updated := migration.DeepCopy()
+		updated.Status.Phase = velerov2alpha1api.BackupRepositoryMigrationPhaseFailed
+		updated.Status.Message = fmt.Sprintf("found a backup repository migration with status %q during the server starting, mark it as %q", velerov2alpha1api.BackupRepositoryMigrationPhaseInProgress, updated.Status.Phase)
+		updated.Status.CompletionTimestamp = &metav1.Time{Time: time.Now()}
+		if err := client.Patch(ctx, updated, ctrlclient.MergeFrom(&migrations.Items[i])); err != nil {
+			log.WithError(errors.WithStack(err)).Errorf("failed to patch backup repository migration %q", migration.GetName())
+			continue
+		}
+		log.WithField("backupRepositoryMigration", migration.GetName()).Warn(updated.Status.Message)
+	}
+}
+
+func markInProgressBackupCopiesFailed(ctx context.Context, client ctrlclient.Client, namespace string, log logrus.FieldLogger) {
+	copies := &velerov2alpha1api.BackupCopyList{}
+	if err := client.List(ctx, copies, &ctrlclient.MatchingFields{"metadata.namespace": namespace}); err != nil {
+		log.WithError(errors.WithStack(err)).Error("failed to list backup copies")
+		return
+	}
+	for i, backupCopy := range copies.Items {
+		if backupCopy.Status.Phase != velerov2alpha1api.BackupCopyPhaseInProgress {
+			log.Debugf("the status of backup copy %q is %q, skip", backupCopy.GetName(), backupCopy.Status.Phase)
+			continue
+		}
+		updated := backupCopy.DeepCopy()
+		updated.Status.Phase = velerov2alpha1api.BackupCopyPhaseFailed
+		updated.Status.Message = fmt.Sprintf("found a backup copy with status %q during the server starting, mark it as %q", velerov2alpha1api.BackupCopyPhaseInProgress, updated.Status.Phase)
+		updated.Status.CompletionTimestamp = &metav1.Time{Time: time.Now()}
+		if err := client.Patch(ctx, updated, ctrlclient.MergeFrom(&copies.Items[i])); err != nil {
+			log.WithError(errors.WithStack(err)).Errorf("failed to patch backup copy %q", backupCopy.GetName())
+			continue
+		}
+		log.WithField("backupCopy", backupCopy.GetName()).Warn(updated.Status.Message)
+	}
+}
+
 func markDataUploadsCancel(ctx context.Context, client ctrlclient.Client, backup velerov1api.Backup, log logrus.FieldLogger) {
 	dataUploads := &velerov2alpha1api.DataUploadList{}
 
@@ -1134,7 +1584,8 @@ func markDataUploadsCancel(ctx context.Context, client ctrlclient.Client, backup
 		du := dataUploads.Items[i]
 		if du.Status.Phase == velerov2alpha1api.DataUploadPhaseAccepted ||
 			du.Status.Phase == velerov2alpha1api.DataUploadPhasePrepared ||
-			du.Status.Phase == velerov2alpha1api.DataUploadPhaseInProgress {
+			du.Status.Phase == velerov2alpha1api.DataUploadPhaseInProgress ||
+			du.Status.Phase == velerov2alpha1api.DataUploadPhasePaused {
 			err := controller.UpdateDataUploadWithRetry(ctx, client, types.NamespacedName{Namespace: du.Namespace, Name: du.Name}, log.WithField("dataupload", du.Name),
 				func(dataUpload *velerov2alpha1api.DataUpload) {
 					dataUpload.Spec.Cancel = true
@@ -1162,7 +1613,8 @@ func markDataDownloadsCancel(ctx context.Context, client ctrlclient.Client, rest
 		dd := dataDownloads.Items[i]
 		if dd.Status.Phase == velerov2alpha1api.DataDownloadPhaseAccepted ||
 			dd.Status.Phase == velerov2alpha1api.DataDownloadPhasePrepared ||
-			dd.Status.Phase == velerov2alpha1api.DataDownloadPhaseInProgress {
+			dd.Status.Phase == velerov2alpha1api.DataDownloadPhaseInProgress ||
+			dd.Status.Phase == velerov2alpha1api.DataDownloadPhasePaused {
 			err := controller.UpdateDataDownloadWithRetry(ctx, client, types.NamespacedName{Namespace: dd.Namespace, Name: dd.Name}, log.WithField("datadownload", dd.Name),
 				func(dataDownload *velerov2alpha1api.DataDownload) {
 					dataDownload.Spec.Cancel = true
@@ -19,7 +19,13 @@ package server
 import (
 	"context"
 	"errors"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -29,6 +35,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -238,6 +245,103 @@ func Test_newServer(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func Test_getControllerConfigs(t *testing.T) {
+	newConfigMap := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "controller-configs"}, Data: data}
+	}
+
+	tests := []struct {
+		name        string
+		configMap   string
+		objects     []runtime.Object
+		expected    map[string]controller.ControllerConfig
+		expectedErr string
+	}{
+		{
+			name: "no configmap",
+		},
+		{
+			name:        "configmap not found",
+			configMap:   "controller-configs",
+			expectedErr: "error getting controller configmap controller-configs",
+		},
+		{
+			name:        "more than one data entry",
+			configMap:   "controller-configs",
+			objects:     []runtime.Object{newConfigMap(map[string]string{"a": "{}", "b": "{}"})},
+			expectedErr: "controller configmap controller-configs should have exactly one data entry",
+		},
+		{
+			name:        "invalid json",
+			configMap:   "controller-configs",
+			objects:     []runtime.Object{newConfigMap(map[string]string{"configs": "{"})},
+			expectedErr: "error unmarshalling controller configs from controller-configs",
+		},
+		{
+			name:        "unknown controller",
+			configMap:   "controller-configs",
+			objects:     []runtime.Object{newConfigMap(map[string]string{"configs": `{"fake-controller": {"clientQPS": 10}}`})},
+			expectedErr: "invalid controller fake-controller in configmap controller-configs",
+		},
+		{
+			name:        "negative client QPS",
+			configMap:   "controller-configs",
+			objects:     []runtime.Object{newConfigMap(map[string]string{"configs": `{"restore": {"clientQPS": -1}}`})},
+			expectedErr: "invalid config of controller restore: clientQPS must not be negative",
+		},
+		{
+			name:      "valid configs",
+			configMap: "controller-configs",
+			objects:   []runtime.Object{newConfigMap(map[string]string{"configs": `{"restore": {"clientQPS": 50, "clientBurst": 100}, "backup-sync": {"workQueue": {"maxDelay": "1m", "qps": 1}}}`})},
+			expected: map[string]controller.ControllerConfig{
+				controller.Restore: {ClientQPS: 50, ClientBurst: 100},
+				controller.BackupSync: {WorkQueue: &controller.WorkQueueConfig{
+					MaxDelay: metav1.Duration{Duration: time.Minute},
+					QPS:      1,
+				}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kubeClient := kubefake.NewSimpleClientset(test.objects...)
+			configs, err := getControllerConfigs(context.Background(), kubeClient, "velero", test.configMap)
+			if test.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, configs)
+		})
+	}
+}
+
+func Test_restConfigFor(t *testing.T) {
+	kubeClientConfig := &rest.Config{Host: "https://localhost", QPS: 20, Burst: 30}
+	s := &server{
+		kubeClientConfig: kubeClientConfig,
+		controllerConfigs: map[string]controller.ControllerConfig{
+			controller.Restore:    {ClientQPS: 50},
+			controller.BackupSync: {WorkQueue: &controller.WorkQueueConfig{QPS: 1}},
+		},
+	}
+
+	config, ok := s.restConfigFor(controller.Restore)
+	assert.True(t, ok)
+	assert.Equal(t, float32(50), config.QPS)
+	assert.Equal(t, 30, config.Burst)
+	assert.Equal(t, float32(20), kubeClientConfig.QPS)
+
+	config, ok = s.restConfigFor(controller.BackupSync)
+	assert.False(t, ok)
+	assert.Equal(t, float32(20), config.QPS)
+
+	_, ok = s.restConfigFor(controller.Backup)
+	assert.False(t, ok)
+}
+
 func Test_namespaceExists(t *testing.T) {
 	client := kubefake.NewSimpleClientset(&corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
@@ -284,6 +388,9 @@ func Test_veleroResourcesExist(t *testing.T) {
 				{Kind: "BackupStorageLocation"},
 				{Kind: "VolumeSnapshotLocation"},
 				{Kind: "ServerStatusRequest"},
+				{Kind: "RestoreSchedule"},
+				{Kind: "ResourceFilterPolicy"},
+				{Kind: "BackupHookPolicy"},
 			},
 		},
 		{
@@ -291,6 +398,11 @@ func Test_veleroResourcesExist(t *testing.T) {
 			APIResources: []metav1.APIResource{
 				{Kind: "DataUpload"},
 				{Kind: "DataDownload"},
+				{Kind: "NodeAgentStatus"},
+				{Kind: "BackupRepositoryMigration"},
+				{Kind: "DataVerify"},
+				{Kind: "DataReplication"},
+				{Kind: "BackupCopy"},
 			},
 		},
 	})
@@ -376,3 +488,143 @@ func Test_markInProgressRestoresFailed(t *testing.T) {
 	require.Nil(t, c.Get(context.Background(), client.ObjectKey{Namespace: "velero", Name: "restore02"}, restore02))
 	assert.Equal(t, velerov1api.RestorePhaseCompleted, restore02.Status.Phase)
 }
+
+func Test_markInProgressBackupRepositoryMigrationsFailed(t *testing.T) {
+	scheme := runtime.NewScheme()
+	velerov2alpha1api.AddToScheme(scheme)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithLists(&velerov2alpha1api.BackupRepositoryMigrationList{
+			Items: []velerov2alpha1api.BackupRepositoryMigration{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "velero",
+						Name:      "migration01",
+					},
+					Status: velerov2alpha1api.BackupRepositoryMigrationStatus{
+						Phase: velerov2alpha1api.BackupRepositoryMigrationPhaseInProgress,
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "velero",
+						Name:      "migration02",
+					},
+					Status: velerov2alpha1api.BackupRepositoryMigrationStatus{
+						Phase: velerov2alpha1api.BackupRepositoryMigrationPhaseCompleted,
+					},
+				},
+			},
+		}).
+		Build()
+	markInProgressBackupRepositoryMigrationsFailed(context.Background(), c, "velero", logrus.New())
+
+	migration01 := &velerov2alpha1api.BackupRepositoryMigration{}
+	require.Nil(t, c.Get(context.Background(), client.ObjectKey{Namespace: "velero", Name: "migration01"}, migration01))
+	assert.Equal(t, velerov2alpha1api.BackupRepositoryMigrationPhaseFailed, migration01.Status.Phase)
+
+	migration02 := &velerov2alpha1api.BackupRepositoryMigration{}
+	require.Nil(t, c.Get(context.Background(), client.ObjectKey{Namespace: "velero", Name: "migration02"}, migration02))
+	assert.Equal(t, velerov2alpha1api.BackupRepositoryMigrationPhaseCompleted, migration02.Status.Phase)
+}
+
+func Test_markInProgressBackupCopiesFailed(t *testing.T) {
+	scheme := runtime.NewScheme()
+	velerov2alpha1api.AddToScheme(scheme)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithLists(&velerov2alpha1api.BackupCopyList{
+			Items: []velerov2alpha1api.BackupCopy{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "velero",
+						Name:      "copy01",
+					},
+					Status: velerov2alpha1api.BackupCopyStatus{
+						Phase: velerov2alpha1api.BackupCopyPhaseInProgress,
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "velero",
+						Name:      "copy02",
+					},
+					Status: velerov2alpha1api.BackupCopyStatus{
+						Phase: velerov2alpha1api.BackupCopyPhaseCompleted,
+					},
+				},
+			},
+		}).
+		Build()
+	markInProgressBackupCopiesFailed(context.Background(), c, "velero", logrus.New())
+
+	copy01 := &velerov2alpha1api.BackupCopy{}
+	require.Nil(t, c.Get(context.Background(), client.ObjectKey{Namespace: "velero", Name: "copy01"}, copy01))
+	assert.Equal(t, velerov2alpha1api.BackupCopyPhaseFailed, copy01.Status.Phase)
+
+	copy02 := &velerov2alpha1api.BackupCopy{}
+	require.Nil(t, c.Get(context.Background(), client.ObjectKey{Namespace: "velero", Name: "copy02"}, copy02))
+	assert.Equal(t, velerov2alpha1api.BackupCopyPhaseCompleted, copy02.Status.Phase)
+}
+
+func Test_readBackupSyncNotificationToken(t *testing.T) {
+	token, err := readBackupSyncNotificationToken("")
+	require.NoError(t, err)
+	assert.Empty(t, token)
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "token")
+	require.NoError(t, os.WriteFile(file, []byte("token-1\n"), 0600))
+	token, err = readBackupSyncNotificationToken(file)
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	emptyFile := filepath.Join(dir, "empty")
+	require.NoError(t, os.WriteFile(emptyFile, []byte("\n"), 0600))
+	_, err = readBackupSyncNotificationToken(emptyFile)
+	assert.Error(t, err)
+
+	_, err = readBackupSyncNotificationToken(filepath.Join(dir, "nonexistent"))
+	assert.Error(t, err)
+}
+
+func Test_newBackupSyncNotificationServer(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+	runnable := newBackupSyncNotificationServer(address, handler, logrus.New())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- runnable.Start(ctx)
+	}()
+
+	url := "http://" + address + controller.BackupSyncNotificationPath
+	require.Eventually(t, func() bool {
+		res, err := http.Post(url, "application/json", strings.NewReader("{}"))
+		if err != nil {
+			return false
+		}
+		res.Body.Close()
+		return res.StatusCode == http.StatusAccepted
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the server is shut down when the context is done, e.g. when the lease is lost
+	cancel()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("backup sync notification server isn't shut down")
+	}
+	_, err = http.Post(url, "application/json", strings.NewReader("{}"))
+	assert.Error(t, err)
+}
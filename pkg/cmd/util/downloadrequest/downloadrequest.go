@@ -30,11 +30,14 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/persistence/encryption"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
 // ErrNotFound is exported for external packages to check for when a file is
@@ -43,13 +46,21 @@ var ErrNotFound = errors.New("file not found")
 var ErrDownloadRequestDownloadURLTimeout = errors.New("download request download url timeout, check velero server logs for errors. backup storage location may not be available")
 
 func Stream(ctx context.Context, kbClient kbclient.Client, namespace, name string, kind velerov1api.DownloadTargetKind, w io.Writer, timeout time.Duration, insecureSkipTLSVerify bool, caCertFile string) error {
+	return StreamTarget(ctx, kbClient, namespace, velerov1api.DownloadTarget{Kind: kind, Name: name}, w, timeout, insecureSkipTLSVerify, caCertFile)
+}
+
+// StreamTarget is the same as Stream but accepts the whole download target, e.g. to extract
+// part of the backup contents.
+func StreamTarget(ctx context.Context, kbClient kbclient.Client, namespace string, target velerov1api.DownloadTarget, w io.Writer, timeout time.Duration, insecureSkipTLSVerify bool, caCertFile string) error {
 	uuid, err := uuid.NewRandom()
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
+	name, kind := target.Name, target.Kind
 	reqName := fmt.Sprintf("%s-%s", name, uuid.String())
-	created := builder.ForDownloadRequest(namespace, reqName).Target(kind, name).Result()
+	created := builder.ForDownloadRequest(namespace, reqName).Target(kind, name).
+		ContentsFilter(target.IncludedResources, target.IncludedNamespaces).Page(target.Page).Result()
 
 	if err := kbClient.Create(context.Background(), created, &kbclient.CreateOptions{}); err != nil {
 		return errors.WithStack(err)
@@ -152,10 +163,20 @@ func Stream(ctx context.Context, kbClient kbclient.Client, namespace, name strin
 		return errors.Errorf("request failed: %v", string(body))
 	}
 
-	reader := resp.Body
+	// decrypt the data if it's encrypted by the backup storage location
+	reader, err := encryption.NewDecryptReader(resp.Body, func(secret, id string) ([]byte, error) {
+		return kube.GetSecretKey(kbClient, namespace, &corev1api.SecretKeySelector{
+			LocalObjectReference: corev1api.LocalObjectReference{Name: secret},
+			Key:                  id,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
 	if kind != velerov1api.DownloadTargetKindBackupContents {
 		// need to decompress logs
-		gzipReader, err := gzip.NewReader(resp.Body)
+		gzipReader, err := gzip.NewReader(reader)
 		if err != nil {
 			return err
 		}
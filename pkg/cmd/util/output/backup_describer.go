@@ -22,9 +22,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
@@ -34,10 +36,12 @@ import (
 
 	veleroapishared "github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
 	"github.com/vmware-tanzu/velero/pkg/volume"
@@ -52,6 +56,7 @@ func DescribeBackup(
 	podVolumeBackups []velerov1api.PodVolumeBackup,
 	volumeSnapshotContents []snapshotv1api.VolumeSnapshotContent,
 	details bool,
+	showSkipped bool,
 	insecureSkipTLSVerify bool,
 	caCertFile string,
 ) string {
@@ -67,7 +72,7 @@ func DescribeBackup(
 		switch phase {
 		case velerov1api.BackupPhaseFailedValidation, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed:
 			phaseString = color.RedString(phaseString)
-		case velerov1api.BackupPhaseCompleted:
+		case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhaseDryRunCompleted:
 			phaseString = color.GreenString(phaseString)
 		case velerov1api.BackupPhaseDeleting:
 		case velerov1api.BackupPhaseWaitingForPluginOperations, velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed:
@@ -88,6 +93,11 @@ func DescribeBackup(
 			DescribeResourcePolicies(d, backup.Spec.ResourcePolicy)
 		}
 
+		if backup.Spec.ValidationPolicy != nil {
+			d.Println()
+			DescribeValidationPolicies(d, backup.Spec.ValidationPolicy)
+		}
+
 		status := backup.Status
 		if len(status.ValidationErrors) > 0 {
 			d.Println()
@@ -97,6 +107,19 @@ func DescribeBackup(
 			}
 		}
 
+		if len(status.PolicyViolations) > 0 {
+			d.Println()
+			d.Printf("Policy violations:\n")
+			for _, pv := range status.PolicyViolations {
+				d.Printf("\t%s\n", color.YellowString(pv))
+			}
+		}
+
+		if len(status.HookPolicies) > 0 {
+			d.Println()
+			d.Printf("Hook policies:\t%s\n", strings.Join(status.HookPolicies, ", "))
+		}
+
 		d.Println()
 		DescribeBackupResults(ctx, kbClient, d, backup, insecureSkipTLSVerify, caCertFile)
 
@@ -104,7 +127,7 @@ func DescribeBackup(
 		DescribeBackupSpec(d, backup.Spec)
 
 		d.Println()
-		DescribeBackupStatus(ctx, kbClient, d, backup, details, insecureSkipTLSVerify, caCertFile)
+		DescribeBackupStatus(ctx, kbClient, d, backup, details, showSkipped, insecureSkipTLSVerify, caCertFile)
 
 		if len(deleteRequests) > 0 {
 			d.Println()
@@ -120,6 +143,16 @@ func DescribeBackup(
 			d.Println()
 			DescribePodVolumeBackups(d, podVolumeBackups, details)
 		}
+
+		if len(backup.Status.VolumeVerifications) > 0 {
+			d.Println()
+			DescribeVolumeVerifications(d, backup.Status.VolumeVerifications)
+		}
+
+		if len(backup.Status.VolumeReplications) > 0 {
+			d.Println()
+			DescribeVolumeReplications(d, backup.Status.VolumeReplications)
+		}
 	})
 }
 
@@ -130,8 +163,20 @@ func DescribeResourcePolicies(d *Describer, resPolicies *v1.TypedLocalObjectRefe
 	d.Printf("\tName:\t%s\n", resPolicies.Name)
 }
 
+// DescribeValidationPolicies describes validation policies in human-readable format
+func DescribeValidationPolicies(d *Describer, valPolicies *v1.TypedLocalObjectReference) {
+	d.Printf("Validation policies:\n")
+	d.Printf("\tType:\t%s\n", valPolicies.Kind)
+	d.Printf("\tName:\t%s\n", valPolicies.Name)
+}
+
 // DescribeBackupSpec describes a backup spec in human-readable format.
 func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
+	if spec.ResourceFilterPolicy != "" {
+		d.Printf("Resource filter policy:\t%s\n", spec.ResourceFilterPolicy)
+		d.Println()
+	}
+
 	// TODO make a helper for this and use it in all the describers.
 	d.Printf("Namespaces:\n")
 	var s string
@@ -211,6 +256,11 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 	}
 	d.Printf("Or label selector:\t%s\n", s)
 
+	if spec.ClusterScopedLabelSelector != nil || len(spec.ClusterScopedOrLabelSelectors) > 0 {
+		d.Println()
+		d.Printf("Cluster-scoped label selector:\t%s\n", formatClusterScopedLabelSelectors(spec))
+	}
+
 	d.Println()
 	d.Printf("Storage Location:\t%s\n", spec.StorageLocation)
 
@@ -223,12 +273,30 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 		s = spec.DataMover
 	}
 	d.Printf("Data Mover:\t%s\n", s)
+	if spec.SnapshotMoveReplicaLocation != "" {
+		d.Printf("Snapshot Move Replica Location:\t%s\n", spec.SnapshotMoveReplicaLocation)
+	}
+	if boolptr.IsSetToTrue(spec.DeduplicateClusterResources) {
+		d.Printf("Deduplicate Cluster Resources:\ttrue\n")
+	}
+
+	s = emptyDisplay
+	if spec.UploaderType != "" {
+		s = spec.UploaderType
+	}
+	d.Printf("Uploader Type:\t%s\n", s)
+	if spec.CompressionAlgorithm != "" {
+		d.Printf("Compression Algorithm:\t%s\n", spec.CompressionAlgorithm)
+	}
 
 	d.Println()
 	d.Printf("TTL:\t%s\n", spec.TTL.Duration)
 
 	d.Println()
 	d.Printf("CSISnapshotTimeout:\t%s\n", spec.CSISnapshotTimeout.Duration)
+	if spec.VolumeGroupSnapshotLabelKey != "" {
+		d.Printf("VolumeGroupSnapshotLabelKey:\t%s\n", spec.VolumeGroupSnapshotLabelKey)
+	}
 	d.Printf("ItemOperationTimeout:\t%s\n", spec.ItemOperationTimeout.Duration)
 
 	d.Println()
@@ -310,12 +378,31 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 }
 
 // DescribeBackupStatus describes a backup status in human-readable format.
-func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, details bool, insecureSkipTLSVerify bool, caCertPath string) {
+// formatClusterScopedLabelSelectors formats the cluster-scoped label selector or the
+// cluster-scoped or label selectors of the backup spec.
+func formatClusterScopedLabelSelectors(spec velerov1api.BackupSpec) string {
+	if spec.ClusterScopedLabelSelector != nil {
+		return metav1.FormatLabelSelector(spec.ClusterScopedLabelSelector)
+	}
+	orLabelSelectors := []string{}
+	for _, v := range spec.ClusterScopedOrLabelSelectors {
+		orLabelSelectors = append(orLabelSelectors, metav1.FormatLabelSelector(v))
+	}
+	return strings.Join(orLabelSelectors, " or ")
+}
+
+func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, details bool, showSkipped bool, insecureSkipTLSVerify bool, caCertPath string) {
 	status := backup.Status
 
 	// Status.Version has been deprecated, use Status.FormatVersion
 	d.Printf("Backup Format Version:\t%s\n", status.FormatVersion)
 
+	if status.EncryptionKeyID != "" {
+		d.Printf("Encryption:\tenabled (key ID: %s)\n", status.EncryptionKeyID)
+	} else {
+		d.Printf("Encryption:\tdisabled\n")
+	}
+
 	d.Println()
 	// "<n/a>" output should only be applicable for backups that failed validation
 	if status.StartTimestamp == nil || status.StartTimestamp.Time.IsZero() {
@@ -336,6 +423,11 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 	d.Printf("Expiration:\t%s\n", status.Expiration)
 	d.Println()
 
+	if status.StorageClass != "" {
+		d.Printf("Storage Class:\t%s (since %s)\n", status.StorageClass, status.StorageClassTransitionTimestamp)
+		d.Println()
+	}
+
 	if backup.Status.Progress != nil {
 		if backup.Status.Phase == velerov1api.BackupPhaseInProgress {
 			d.Printf("Estimated total items to be backed up:\t%d\n", backup.Status.Progress.TotalItems)
@@ -344,6 +436,9 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 			d.Printf("Total items to be backed up:\t%d\n", backup.Status.Progress.TotalItems)
 			d.Printf("Items backed up:\t%d\n", backup.Status.Progress.ItemsBackedUp)
 		}
+		if backup.Status.ClusterResourcesDeduplicated > 0 {
+			d.Printf("Cluster-scoped items referenced from previous backups:\t%d\n", backup.Status.ClusterResourcesDeduplicated)
+		}
 
 		d.Println()
 	}
@@ -353,6 +448,13 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 	if details {
 		describeBackupResourceList(ctx, kbClient, d, backup, insecureSkipTLSVerify, caCertPath)
 		d.Println()
+
+		describeBackupUploadStats(ctx, kbClient, d, backup, insecureSkipTLSVerify, caCertPath)
+
+		if showSkipped {
+			describeBackupSkippedItems(ctx, kbClient, d, backup, insecureSkipTLSVerify, caCertPath)
+			d.Println()
+		}
 	}
 
 	if status.VolumeSnapshotsAttempted > 0 {
@@ -391,15 +493,13 @@ func describeBackupItemOperations(ctx context.Context, kbClient kbclient.Client,
 			return
 		}
 
-		buf := new(bytes.Buffer)
-		if err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupItemOperations, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
-			d.Printf("Backup Item Operations:\t<error getting operation info: %v>\n", err)
-			return
-		}
-
-		var operations []*itemoperation.BackupOperation
-		if err := json.NewDecoder(buf).Decode(&operations); err != nil {
-			d.Printf("Backup Item Operations:\t<error reading operation info: %v>\n", err)
+		operations, err := DownloadBackupItemOperations(ctx, kbClient, backup, insecureSkipTLSVerify, caCertPath)
+		if err != nil {
+			if _, ok := err.(*metadataDecodeError); ok {
+				d.Printf("Backup Item Operations:\t<error reading operation info: %v>\n", err)
+			} else {
+				d.Printf("Backup Item Operations:\t<error getting operation info: %v>\n", err)
+			}
 			return
 		}
 
@@ -411,9 +511,11 @@ func describeBackupItemOperations(ctx context.Context, kbClient kbclient.Client,
 }
 
 func describeBackupResourceList(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
-	buf := new(bytes.Buffer)
-	if err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResourceList, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
-		if err == downloadrequest.ErrNotFound {
+	resourceList, err := downloadBackupResourceList(ctx, kbClient, backup, insecureSkipTLSVerify, caCertPath)
+	if err != nil {
+		if _, ok := err.(*metadataDecodeError); ok {
+			d.Printf("Resource List:\t<error reading backup resource list: %v>\n", err)
+		} else if err == downloadrequest.ErrNotFound {
 			// the backup resource list could be missing if (other reasons may exist as well):
 			//	- the backup was taken prior to v1.1; or
 			//	- the backup hasn't completed yet; or
@@ -426,12 +528,132 @@ func describeBackupResourceList(ctx context.Context, kbClient kbclient.Client, d
 		return
 	}
 
-	var resourceList map[string][]string
-	if err := json.NewDecoder(buf).Decode(&resourceList); err != nil {
-		d.Printf("Resource List:\t<error reading backup resource list: %v>\n", err)
+	describeResourceList(d, resourceList)
+}
+
+// describeBackupUploadStats describes the storage efficiency of the volume data uploaded by the
+// backup, nothing is printed if no uploader reports it.
+func describeBackupUploadStats(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
+	volumeInfos, err := DownloadBackupVolumeInfos(ctx, kbClient, backup, insecureSkipTLSVerify, caCertPath)
+	if err != nil {
+		if _, ok := err.(*metadataDecodeError); ok {
+			d.Printf("Volume Upload Stats:\t<error reading backup volumes information: %v>\n", err)
+			d.Println()
+		} else if err != downloadrequest.ErrNotFound {
+			d.Printf("Volume Upload Stats:\t<error getting backup volumes information: %v>\n", err)
+			d.Println()
+		}
+		return
+	}
+
+	describeUploadStats(d, volumeInfos)
+}
+
+func describeUploadStats(d *Describer, volumeInfos []volume.VolumeInfo) {
+	uploadStats := uploadStatsByVolume(volumeInfos)
+	if len(uploadStats) == 0 {
 		return
 	}
 
+	names := make([]string, 0, len(uploadStats))
+	for name := range uploadStats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	d.Printf("Volume Upload Stats:\n")
+	for _, name := range names {
+		d.Printf("\t%s:\t%s\n", name, formatUploadStats(uploadStats[name]))
+	}
+	d.Printf("\tTotal:\t%s\n", formatUploadStats(totalUploadStats(uploadStats)))
+	d.Println()
+}
+
+// uploadStatsByVolume returns the upload stats of the volumes which report them, keyed by
+// "<namespace>/<pod>/<volume>" for the pod volume backups and "<namespace>/<pvc>" for the
+// snapshot data movements.
+func uploadStatsByVolume(volumeInfos []volume.VolumeInfo) map[string]*volume.UploadStats {
+	uploadStats := map[string]*volume.UploadStats{}
+	for _, info := range volumeInfos {
+		if info.SnapshotDataMoved {
+			if info.SnapshotDataMovementInfo.UploadStats != nil {
+				uploadStats[info.PVCNamespace+"/"+info.PVCName] = info.SnapshotDataMovementInfo.UploadStats
+			}
+		} else if info.PVBInfo.UploadStats != nil {
+			uploadStats[info.PVBInfo.PodName+"/"+info.PVBInfo.VolumeName] = info.PVBInfo.UploadStats
+		}
+	}
+	return uploadStats
+}
+
+func totalUploadStats(uploadStats map[string]*volume.UploadStats) *volume.UploadStats {
+	var logicalBytes, uploadedBytes int64
+	for _, stats := range uploadStats {
+		logicalBytes += stats.LogicalBytes
+		uploadedBytes += stats.UploadedBytes
+	}
+	return volume.NewUploadStats(logicalBytes, uploadedBytes)
+}
+
+// formatUploadStats returns the upload stats in human-readable format, e.g.
+// "1Gi logical, 256Mi uploaded (dedup ratio 4.00)".
+func formatUploadStats(stats *volume.UploadStats) string {
+	formatted := fmt.Sprintf("%s logical, %s uploaded",
+		resource.NewQuantity(stats.LogicalBytes, resource.BinarySI).String(),
+		resource.NewQuantity(stats.UploadedBytes, resource.BinarySI).String())
+	if stats.DedupRatio > 0 {
+		formatted += fmt.Sprintf(" (dedup ratio %.2f)", stats.DedupRatio)
+	}
+	return formatted
+}
+
+func describeBackupSkippedItems(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
+	skippedItems, err := DownloadBackupSkippedItems(ctx, kbClient, backup, insecureSkipTLSVerify, caCertPath)
+	if err != nil {
+		if _, ok := err.(*metadataDecodeError); ok {
+			d.Printf("Skipped Items:\t<error reading backup skipped items: %v>\n", err)
+		} else if err == downloadrequest.ErrNotFound {
+			// the skipped items are missing if the backup was taken by an older version of Velero
+			// or the backup hasn't completed yet
+			d.Println("Skipped Items:\t<backup skipped items not found>")
+		} else {
+			d.Printf("Skipped Items:\t<error getting backup skipped items: %v>\n", err)
+		}
+		return
+	}
+
+	describeSkippedItems(d, skippedItems)
+}
+
+// describeSkippedItems describes the skipped items grouped by their resources, the items are
+// already sorted when they're recorded.
+func describeSkippedItems(d *Describer, skippedItems []pkgbackup.SkippedItem) {
+	if len(skippedItems) == 0 {
+		d.Println("Skipped Items:\t<none>")
+		return
+	}
+
+	d.Println("Skipped Items:")
+	resource := ""
+	for _, item := range skippedItems {
+		if item.Resource != resource {
+			resource = item.Resource
+			d.Printf("\t%s:\n", resource)
+		}
+
+		name := item.Name
+		if item.Namespace != "" {
+			name = item.Namespace + "/" + item.Name
+		}
+		if item.Message != "" {
+			d.Printf("\t\t- %s (%s: %s)\n", name, item.Reason, item.Message)
+		} else {
+			d.Printf("\t\t- %s (%s)\n", name, item.Reason)
+		}
+	}
+}
+
+func describeResourceList(d *Describer, resourceList map[string][]string) {
 	d.Println("Resource List:")
 
 	// Sort GVKs in output
@@ -446,6 +668,49 @@ func describeBackupResourceList(ctx context.Context, kbClient kbclient.Client, d
 	}
 }
 
+// DescribeBackupDryRun describes the dry-run result of a backup in human-readable format.
+func DescribeBackupDryRun(backup *velerov1api.Backup) string {
+	return Describe(func(d *Describer) {
+		phaseString := string(backup.Status.Phase)
+		if backup.Status.Phase == velerov1api.BackupPhaseDryRunCompleted {
+			phaseString = color.GreenString(phaseString)
+		} else {
+			phaseString = color.RedString(phaseString)
+		}
+		d.Printf("Phase:\t%s\n", phaseString)
+
+		if len(backup.Status.ValidationErrors) > 0 {
+			d.Println()
+			d.Printf("Validation errors:")
+			for _, ve := range backup.Status.ValidationErrors {
+				d.Printf("\t%s\n", color.RedString(ve))
+			}
+		}
+
+		if backup.Status.FailureReason != "" {
+			d.Printf("Failure Reason:\t%s\n", backup.Status.FailureReason)
+		}
+
+		result := backup.Status.DryRunResult
+		if result == nil {
+			return
+		}
+
+		d.Println()
+		describeResourceList(d, result.Resources)
+
+		d.Println()
+		if len(result.PersistentVolumes) == 0 {
+			d.Printf("Persistent Volumes:\t<none included>\n")
+		} else {
+			d.Printf("Persistent Volumes:\n\t- %s\n", strings.Join(result.PersistentVolumes, "\n\t- "))
+		}
+
+		d.Println()
+		d.Printf("Estimated Data Size:\t%s\n", resource.NewQuantity(result.EstimatedDataSizeBytes, resource.BinarySI).String())
+	})
+}
+
 func describeSnapshot(d *Describer, pvName, snapshotID, volumeType, volumeAZ string, iops *int64) {
 	d.Printf("\t%s:\n", pvName)
 	d.Printf("\t\tSnapshot ID:\t%s\n", snapshotID)
@@ -458,6 +723,37 @@ func describeSnapshot(d *Describer, pvName, snapshotID, volumeType, volumeAZ str
 	d.Printf("\t\tIOPS:\t%s\n", iopsString)
 }
 
+// ItemOperationProgress returns the progress of the item operation in human-readable format,
+// e.g. "512Mi of 1Gi complete (50%)" for the operations measured in bytes. It's empty if the
+// operation reports no progress.
+func ItemOperationProgress(status itemoperation.OperationStatus) string {
+	if status.NTotal <= 0 && status.NCompleted <= 0 {
+		return ""
+	}
+
+	completed, total := strconv.FormatInt(status.NCompleted, 10), strconv.FormatInt(status.NTotal, 10)
+	units := status.OperationUnits
+	if strings.EqualFold(units, "bytes") {
+		completed = resource.NewQuantity(status.NCompleted, resource.BinarySI).String()
+		total = resource.NewQuantity(status.NTotal, resource.BinarySI).String()
+		units = ""
+	}
+
+	// the total may be unknown until the operation progresses
+	progress := completed
+	if status.NTotal > 0 {
+		progress += " of " + total
+	}
+	if units != "" {
+		progress += " " + units
+	}
+	progress += " complete"
+	if status.NTotal > 0 {
+		progress += fmt.Sprintf(" (%d%%)", status.NCompleted*100/status.NTotal)
+	}
+	return progress
+}
+
 func describeBackupItemOperation(d *Describer, operation *itemoperation.BackupOperation) {
 	d.Printf("\tOperation for %s %s/%s:\n", operation.Spec.ResourceIdentifier, operation.Spec.ResourceIdentifier.Namespace, operation.Spec.ResourceIdentifier.Name)
 	d.Printf("\t\tBackup Item Action Plugin:\t%s\n", operation.Spec.BackupItemAction)
@@ -472,11 +768,8 @@ func describeBackupItemOperation(d *Describer, operation *itemoperation.BackupOp
 	if operation.Status.Error != "" {
 		d.Printf("\t\tOperation Error:\t%s\n", operation.Status.Error)
 	}
-	if operation.Status.NTotal > 0 || operation.Status.NCompleted > 0 {
-		d.Printf("\t\tProgress:\t%v of %v complete (%s)\n",
-			operation.Status.NCompleted,
-			operation.Status.NTotal,
-			operation.Status.OperationUnits)
+	if progress := ItemOperationProgress(operation.Status); progress != "" {
+		d.Printf("\t\tProgress:\t%s\n", progress)
 	}
 	if operation.Status.Description != "" {
 		d.Printf("\t\tProgress description:\t%s\n", operation.Status.Description)
@@ -492,6 +785,57 @@ func describeBackupItemOperation(d *Describer, operation *itemoperation.BackupOp
 	}
 }
 
+// DescribeVolumeVerifications describes the results of verifying the data mover snapshots of the volumes in human-readable format.
+func DescribeVolumeVerifications(d *Describer, verifications []velerov1api.BackupVolumeVerification) {
+	d.Printf("Volume Verifications:\n")
+	for _, v := range verifications {
+		phase := string(v.Phase)
+		if v.Phase == velerov1api.BackupVolumeVerificationPhaseVerified {
+			phase = color.GreenString(phase)
+		} else {
+			phase = color.RedString(phase)
+		}
+
+		completed := "<n/a>"
+		if v.CompletionTimestamp != nil {
+			completed = v.CompletionTimestamp.Time.String()
+		}
+
+		d.Printf("\t%s:\t%s (snapshot %s, completed %s)\n", v.PVC, phase, v.SnapshotID, completed)
+		if v.Message != "" {
+			d.Printf("\t\tMessage:\t%s\n", v.Message)
+		}
+	}
+}
+
+// DescribeVolumeReplications describes the results of replicating the data mover snapshots of the volumes in human-readable format.
+func DescribeVolumeReplications(d *Describer, replications []velerov1api.BackupVolumeReplication) {
+	d.Printf("Volume Replications:\n")
+	for _, r := range replications {
+		phase := string(r.Phase)
+		if r.Phase == velerov1api.BackupVolumeReplicationPhaseReplicated {
+			phase = color.GreenString(phase)
+		} else {
+			phase = color.RedString(phase)
+		}
+
+		completed := "<n/a>"
+		if r.CompletionTimestamp != nil {
+			completed = r.CompletionTimestamp.Time.String()
+		}
+
+		snapshot := "<none>"
+		if r.SnapshotID != "" {
+			snapshot = r.SnapshotID
+		}
+
+		d.Printf("\t%s:\t%s (location %s, snapshot %s, completed %s)\n", r.PVC, phase, r.BackupStorageLocation, snapshot, completed)
+		if r.Message != "" {
+			d.Printf("\t\tMessage:\t%s\n", r.Message)
+		}
+	}
+}
+
 // DescribeDeleteBackupRequests describes delete backup requests in human-readable format.
 func DescribeDeleteBackupRequests(d *Describer, requests []velerov1api.DeleteBackupRequest) {
 	d.Printf("Deletion Attempts")
@@ -2,6 +2,8 @@ package output
 
 import (
 	"bytes"
+	"context"
+	"strings"
 	"testing"
 	"text/tabwriter"
 	"time"
@@ -13,11 +15,14 @@ import (
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/volume"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 )
 
 func TestDescribeResourcePolicies(t *testing.T) {
@@ -50,6 +55,7 @@ func TestDescribeBackupSpec(t *testing.T) {
 		TTL(72 * time.Hour).
 		CSISnapshotTimeout(10 * time.Minute).
 		DataMover("mover").
+		UploaderType("kopia").
 		Hooks(velerov1api.BackupHooks{
 			Resources: []velerov1api.BackupResourceHookSpec{
 				{
@@ -98,6 +104,7 @@ Storage Location:  backup-location
 Velero-Native Snapshot PVs:  auto
 Snapshot Move Data:          auto
 Data Mover:                  mover
+Uploader Type:               kopia
 
 TTL:  72h0m0s
 
@@ -162,6 +169,7 @@ Storage Location:  backup-location
 Velero-Native Snapshot PVs:  auto
 Snapshot Move Data:          auto
 Data Mover:                  mover
+Uploader Type:               <none>
 
 TTL:  72h0m0s
 
@@ -219,6 +227,7 @@ Storage Location:  backup-location
 Velero-Native Snapshot PVs:  auto
 Snapshot Move Data:          auto
 Data Mover:                  velero
+Uploader Type:               <none>
 
 TTL:  0s
 
@@ -254,6 +263,44 @@ OrderedResources:
   kind1: rs1-1, rs1-2
 `
 
+	input4 := builder.ForBackup("test-ns", "test-backup-4").
+		IncludedNamespaces("inc-ns-1").
+		StorageLocation("backup-location").
+		ClusterScopedOrLabelSelector([]*metav1.LabelSelector{
+			{MatchLabels: map[string]string{"a1": "b1"}},
+			{MatchLabels: map[string]string{"a2": "b2"}},
+		}).Result().Spec
+
+	expect4 := `Namespaces:
+  Included:  inc-ns-1
+  Excluded:  <none>
+
+Resources:
+  Included:        *
+  Excluded:        <none>
+  Cluster-scoped:  auto
+
+Label selector:  <none>
+
+Or label selector:  <none>
+
+Cluster-scoped label selector:  a1=b1 or a2=b2
+
+Storage Location:  backup-location
+
+Velero-Native Snapshot PVs:  auto
+Snapshot Move Data:          auto
+Data Mover:                  velero
+Uploader Type:               <none>
+
+TTL:  0s
+
+CSISnapshotTimeout:    0s
+ItemOperationTimeout:  0s
+
+Hooks:  <none>
+`
+
 	testcases := []struct {
 		name   string
 		input  velerov1api.BackupSpec
@@ -274,6 +321,11 @@ OrderedResources:
 			input:  input3,
 			expect: expect3,
 		},
+		{
+			name:   "cluster-scoped or label selectors",
+			input:  input4,
+			expect: expect4,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -488,6 +540,287 @@ func TestDescribeDeleteBackupRequests(t *testing.T) {
 	}
 }
 
+func TestDescribeVolumeVerifications(t *testing.T) {
+	completed := metav1.NewTime(time.Date(2023, 6, 26, 0, 0, 0, 0, time.UTC))
+	verifications := []velerov1api.BackupVolumeVerification{
+		{
+			DataUpload:          "du-1",
+			PVC:                 "ns-1/pvc-1",
+			SnapshotID:          "snapshot-1",
+			Phase:               velerov1api.BackupVolumeVerificationPhaseVerified,
+			CompletionTimestamp: &completed,
+		},
+		{
+			DataUpload: "du-2",
+			PVC:        "ns-1/pvc-2",
+			SnapshotID: "snapshot-2",
+			Phase:      velerov1api.BackupVolumeVerificationPhaseCorrupted,
+			Message:    "snapshot is corrupted, 1 entries failed to be verified",
+		},
+	}
+
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+	DescribeVolumeVerifications(d, verifications)
+	d.out.Flush()
+	assert.Equal(t, `Volume Verifications:
+  ns-1/pvc-1:  Verified (snapshot snapshot-1, completed 2023-06-26 00:00:00 +0000 UTC)
+  ns-1/pvc-2:  Corrupted (snapshot snapshot-2, completed <n/a>)
+               Message:  snapshot is corrupted, 1 entries failed to be verified
+`, d.buf.String())
+}
+
+func TestDescribeVolumeReplications(t *testing.T) {
+	completed := metav1.NewTime(time.Date(2023, 6, 26, 0, 0, 0, 0, time.UTC))
+	replications := []velerov1api.BackupVolumeReplication{
+		{
+			DataUpload:            "du-1",
+			PVC:                   "ns-1/pvc-1",
+			BackupStorageLocation: "bsl-2",
+			SnapshotID:            "replica-1",
+			Phase:                 velerov1api.BackupVolumeReplicationPhaseReplicated,
+			CompletionTimestamp:   &completed,
+		},
+		{
+			DataUpload:            "du-2",
+			PVC:                   "ns-1/pvc-2",
+			BackupStorageLocation: "bsl-2",
+			Phase:                 velerov1api.BackupVolumeReplicationPhaseFailed,
+			Message:               "data path replicate failed: storage unavailable",
+		},
+	}
+
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+	DescribeVolumeReplications(d, replications)
+	d.out.Flush()
+	assert.Equal(t, `Volume Replications:
+  ns-1/pvc-1:  Replicated (location bsl-2, snapshot replica-1, completed 2023-06-26 00:00:00 +0000 UTC)
+  ns-1/pvc-2:  Failed (location bsl-2, snapshot <none>, completed <n/a>)
+               Message:  data path replicate failed: storage unavailable
+`, d.buf.String())
+}
+
+func TestDescribeUploadStats(t *testing.T) {
+	volumeInfos := []volume.VolumeInfo{
+		{
+			BackupMethod: volume.PodVolumeBackup,
+			PVBInfo: volume.PodVolumeBackupInfo{
+				PodName:     "ns-1/pod-1",
+				VolumeName:  "data",
+				UploadStats: volume.NewUploadStats(1<<30, 256<<20),
+			},
+		},
+		{
+			BackupMethod:      volume.CSISnapshot,
+			PVCName:           "pvc-1",
+			PVCNamespace:      "ns-2",
+			SnapshotDataMoved: true,
+			SnapshotDataMovementInfo: volume.SnapshotDataMovementInfo{
+				UploadStats: volume.NewUploadStats(1<<30, 768<<20),
+			},
+		},
+		{
+			// restic doesn't report the upload stats
+			BackupMethod: volume.PodVolumeBackup,
+			PVBInfo: volume.PodVolumeBackupInfo{
+				PodName:    "ns-1/pod-2",
+				VolumeName: "data",
+			},
+		},
+	}
+
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+	describeUploadStats(d, volumeInfos)
+	d.out.Flush()
+	assert.Equal(t, `Volume Upload Stats:
+  ns-1/pod-1/data:  1Gi logical, 256Mi uploaded (dedup ratio 4.00)
+  ns-2/pvc-1:       1Gi logical, 768Mi uploaded (dedup ratio 1.33)
+  Total:            2Gi logical, 1Gi uploaded (dedup ratio 2.00)
+
+`, d.buf.String())
+
+	d.buf.Reset()
+	describeUploadStats(d, volumeInfos[2:])
+	d.out.Flush()
+	assert.Empty(t, d.buf.String())
+}
+
+func TestDescribeSkippedItems(t *testing.T) {
+	testcases := []struct {
+		name   string
+		input  []pkgbackup.SkippedItem
+		expect string
+	}{
+		{
+			name: "no skipped items",
+			expect: `Skipped Items:  <none>
+`,
+		},
+		{
+			name: "skipped items grouped by resources",
+			input: []pkgbackup.SkippedItem{
+				{Resource: "persistentvolumes", Name: "pv-1", Reason: pkgbackup.ItemSkipReasonExcludedByFilter, Message: "cluster-scoped resource is excluded"},
+				{Resource: "pods", Namespace: "ns-1", Name: "pod-1", Reason: pkgbackup.ItemSkipReasonExcludedByLabel},
+				{Resource: "pods", Namespace: "ns-1", Name: "pod-2", Reason: pkgbackup.ItemSkipReasonBeingDeleted, Message: "item is being deleted"},
+			},
+			expect: `Skipped Items:
+  persistentvolumes:
+    - pv-1 (ExcludedByFilter: cluster-scoped resource is excluded)
+  pods:
+    - ns-1/pod-1 (ExcludedByLabel)
+    - ns-1/pod-2 (BeingDeleted: item is being deleted)
+`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			d := &Describer{
+				Prefix: "",
+				out:    &tabwriter.Writer{},
+				buf:    &bytes.Buffer{},
+			}
+			d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+			describeSkippedItems(d, tc.input)
+			d.out.Flush()
+			assert.Equal(tt, tc.expect, d.buf.String())
+		})
+	}
+}
+
+func TestDescribeBackupDryRun(t *testing.T) {
+	testcases := []struct {
+		name   string
+		backup *velerov1api.Backup
+		expect string
+	}{
+		{
+			name: "dry-run completed",
+			backup: builder.ForBackup("velero", "backup-1").WithStatus(velerov1api.BackupStatus{
+				Phase: velerov1api.BackupPhaseDryRunCompleted,
+				DryRunResult: &velerov1api.BackupDryRunResult{
+					Resources: map[string][]string{
+						"v1/Pod":                   {"ns-1/pod-1", "ns-1/pod-2"},
+						"v1/PersistentVolumeClaim": {"ns-1/pvc-1"},
+					},
+					PersistentVolumes:      []string{"pv-1"},
+					EstimatedDataSizeBytes: 2 << 30,
+				},
+			}).Result(),
+			expect: `Phase:  DryRunCompleted
+
+Resource List:
+  v1/PersistentVolumeClaim:
+    - ns-1/pvc-1
+  v1/Pod:
+    - ns-1/pod-1
+    - ns-1/pod-2
+
+Persistent Volumes:
+  - pv-1
+
+Estimated Data Size:  2Gi
+`,
+		},
+		{
+			name: "dry-run completed without volumes",
+			backup: builder.ForBackup("velero", "backup-1").WithStatus(velerov1api.BackupStatus{
+				Phase: velerov1api.BackupPhaseDryRunCompleted,
+				DryRunResult: &velerov1api.BackupDryRunResult{
+					Resources: map[string][]string{
+						"v1/Pod": {"ns-1/pod-1"},
+					},
+				},
+			}).Result(),
+			expect: `Phase:  DryRunCompleted
+
+Resource List:
+  v1/Pod:
+    - ns-1/pod-1
+
+Persistent Volumes:  <none included>
+
+Estimated Data Size:  0
+`,
+		},
+		{
+			name: "dry-run failed",
+			backup: builder.ForBackup("velero", "backup-1").WithStatus(velerov1api.BackupStatus{
+				Phase:         velerov1api.BackupPhaseFailed,
+				FailureReason: "some error",
+			}).Result(),
+			expect: `Phase:           Failed
+Failure Reason:  some error
+`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, tc.expect, DescribeBackupDryRun(tc.backup))
+		})
+	}
+}
+
+func TestDescribeBackupStatusEncryption(t *testing.T) {
+	expiration := metav1.NewTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	testcases := []struct {
+		name   string
+		status velerov1api.BackupStatus
+		expect string
+	}{
+		{
+			name:   "backup is encrypted",
+			status: velerov1api.BackupStatus{FormatVersion: "1.1.0", Expiration: &expiration, EncryptionKeyID: "key-1"},
+			expect: `Backup Format Version:  1.1.0
+Encryption:             enabled (key ID: key-1)
+`,
+		},
+		{
+			name:   "backup is not encrypted",
+			status: velerov1api.BackupStatus{FormatVersion: "1.1.0", Expiration: &expiration},
+			expect: `Backup Format Version:  1.1.0
+Encryption:             disabled
+`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			d := &Describer{
+				Prefix: "",
+				out:    &tabwriter.Writer{},
+				buf:    &bytes.Buffer{},
+			}
+			d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+			backup := builder.ForBackup("velero", "backup-1").WithStatus(tc.status).Result()
+			DescribeBackupStatus(context.Background(), nil, d, backup, false, false, false, "")
+			d.out.Flush()
+			assert.True(tt, strings.HasPrefix(d.buf.String(), tc.expect), d.buf.String())
+		})
+	}
+}
+
+func TestItemOperationProgress(t *testing.T) {
+	assert.Empty(t, ItemOperationProgress(itemoperation.OperationStatus{}))
+	assert.Equal(t, "512Mi of 1Gi complete (50%)", ItemOperationProgress(itemoperation.OperationStatus{NCompleted: 512 * 1024 * 1024, NTotal: 1024 * 1024 * 1024, OperationUnits: "Bytes"}))
+	assert.Equal(t, "3 of 10 items complete (30%)", ItemOperationProgress(itemoperation.OperationStatus{NCompleted: 3, NTotal: 10, OperationUnits: "items"}))
+	assert.Equal(t, "3 complete", ItemOperationProgress(itemoperation.OperationStatus{NCompleted: 3}))
+}
+
 func TestDescribeBackupItemOperation(t *testing.T) {
 	t1, err1 := time.Parse("2006-Jan-02", "2023-Jun-26")
 	require.Nil(t, err1)
@@ -514,7 +847,7 @@ func TestDescribeBackupItemOperation(t *testing.T) {
     Operation ID:               op-1
     Phase:                      Failed
     Operation Error:            operation error
-    Progress:                   50 of 100 complete (bytes)
+    Progress:                   50 of 100 complete (50%)
     Progress description:       operation description
     Created:                    2023-06-24 00:00:00 +0000 UTC
     Started:                    2023-06-25 00:00:00 +0000 UTC
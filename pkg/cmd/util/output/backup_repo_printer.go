@@ -17,6 +17,7 @@ limitations under the License.
 package output
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -30,6 +31,9 @@ var (
 		{Name: "Name", Type: "string", Format: "name"},
 		{Name: "Status"},
 		{Name: "Last Maintenance"},
+		{Name: "Maintenance Result", Priority: 1},
+		{Name: "Freed", Priority: 1},
+		{Name: "Message", Priority: 1},
 	}
 )
 
@@ -59,10 +63,23 @@ func printBackupRepo(repo *v1.BackupRepository) []metav1.TableRow {
 		lastMaintenance = repo.Status.LastMaintenanceTime.String()
 	}
 
+	// the wide columns show the result of the latest maintenance
+	maintenanceResult, freed, message := "<none>", "<none>", repo.Status.Message
+	if count := len(repo.Status.RecentMaintenance); count > 0 {
+		latest := repo.Status.RecentMaintenance[count-1]
+		maintenanceResult = string(latest.Result)
+		if latest.FreedBytes != nil {
+			freed = resource.NewQuantity(*latest.FreedBytes, resource.BinarySI).String()
+		}
+	}
+
 	row.Cells = append(row.Cells,
 		repo.Name,
 		status,
 		lastMaintenance,
+		maintenanceResult,
+		freed,
+		message,
 	)
 
 	return []metav1.TableRow{row}
@@ -0,0 +1,77 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestPrintBackupRepo(t *testing.T) {
+	freedBytes := int64(3 * 1024 * 1024)
+
+	tests := []struct {
+		name     string
+		status   v1.BackupRepositoryStatus
+		expected []interface{}
+	}{
+		{
+			name:     "never maintained",
+			expected: []interface{}{"repo", v1.BackupRepositoryPhaseNew, "<never>", "<none>", "<none>", ""},
+		},
+		{
+			name: "latest maintenance succeeded",
+			status: v1.BackupRepositoryStatus{
+				Phase: v1.BackupRepositoryPhaseReady,
+				RecentMaintenance: []v1.BackupRepositoryMaintenanceStatus{
+					{Result: v1.BackupRepositoryMaintenanceFailed, Message: "fake-error"},
+					{Result: v1.BackupRepositoryMaintenanceSucceeded, FreedBytes: &freedBytes},
+				},
+			},
+			expected: []interface{}{"repo", v1.BackupRepositoryPhaseReady, "<never>", "Succeeded", "3Mi", ""},
+		},
+		{
+			name: "latest maintenance failed",
+			status: v1.BackupRepositoryStatus{
+				Phase:   v1.BackupRepositoryPhaseReady,
+				Message: "fake-error",
+				RecentMaintenance: []v1.BackupRepositoryMaintenanceStatus{
+					{Result: v1.BackupRepositoryMaintenanceFailed, Message: "fake-error"},
+				},
+			},
+			expected: []interface{}{"repo", v1.BackupRepositoryPhaseReady, "<never>", "Failed", "<none>", "fake-error"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := &v1.BackupRepository{
+				ObjectMeta: metav1.ObjectMeta{Name: "repo"},
+				Status:     test.status,
+			}
+
+			rows := printBackupRepo(repo)
+			assert.Len(t, rows, 1)
+			assert.Equal(t, test.expected, rows[0].Cells)
+			assert.Len(t, backupRepoColumns, len(rows[0].Cells))
+		})
+	}
+}
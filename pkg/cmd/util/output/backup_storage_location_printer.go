@@ -17,6 +17,9 @@ limitations under the License.
 package output
 
 import (
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -35,6 +38,10 @@ var (
 		{Name: "Last Validated"},
 		{Name: "Access Mode"},
 		{Name: "Default"},
+		{Name: "Backups", Priority: 1},
+		{Name: "Size", Priority: 1},
+		{Name: "Oldest Backup", Priority: 1},
+		{Name: "Newest Backup", Priority: 1},
 	}
 )
 
@@ -78,6 +85,21 @@ func printBackupStorageLocation(location *velerov1api.BackupStorageLocation) []m
 		LastValidatedStr = lastValidated.String()
 	}
 
+	// the wide columns show the usage recorded by the latest sync of the location
+	backups, size, oldest, newest := "<unknown>", "<unknown>", "<none>", "<none>"
+	if usage := location.Status.Usage; usage != nil {
+		backups = strconv.Itoa(usage.BackupCount)
+		if usage.Bytes != nil {
+			size = resource.NewQuantity(*usage.Bytes, resource.BinarySI).String()
+		}
+		if usage.OldestBackupTime != nil {
+			oldest = usage.OldestBackupTime.String()
+		}
+		if usage.NewestBackupTime != nil {
+			newest = usage.NewestBackupTime.String()
+		}
+	}
+
 	row.Cells = append(row.Cells,
 		location.Name,
 		location.Spec.Provider,
@@ -86,6 +108,10 @@ func printBackupStorageLocation(location *velerov1api.BackupStorageLocation) []m
 		LastValidatedStr,
 		accessMode,
 		isDefault,
+		backups,
+		size,
+		oldest,
+		newest,
 	)
 
 	return []metav1.TableRow{row}
@@ -0,0 +1,68 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestPrintBackupStorageLocation(t *testing.T) {
+	bytes := int64(5 * 1024 * 1024 * 1024)
+	oldest := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	newest := metav1.NewTime(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name     string
+		usage    *v1.BackupStorageLocationUsage
+		expected []interface{}
+	}{
+		{
+			name:     "location isn't synced",
+			expected: []interface{}{"default", "aws", "bucket/prefix", v1.BackupStorageLocationPhaseAvailable, "Unknown", v1.BackupStorageLocationAccessModeReadWrite, "", "<unknown>", "<unknown>", "<none>", "<none>"},
+		},
+		{
+			name:     "object store doesn't report the sizes of the objects",
+			usage:    &v1.BackupStorageLocationUsage{BackupCount: 3},
+			expected: []interface{}{"default", "aws", "bucket/prefix", v1.BackupStorageLocationPhaseAvailable, "Unknown", v1.BackupStorageLocationAccessModeReadWrite, "", "3", "<unknown>", "<none>", "<none>"},
+		},
+		{
+			name:     "usage is reported",
+			usage:    &v1.BackupStorageLocationUsage{Bytes: &bytes, BackupCount: 3, OldestBackupTime: &oldest, NewestBackupTime: &newest},
+			expected: []interface{}{"default", "aws", "bucket/prefix", v1.BackupStorageLocationPhaseAvailable, "Unknown", v1.BackupStorageLocationAccessModeReadWrite, "", "3", "5Gi", oldest.String(), newest.String()},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			location := builder.ForBackupStorageLocation("velero", "default").Provider("aws").Bucket("bucket").Prefix("prefix").
+				Phase(v1.BackupStorageLocationPhaseAvailable).Result()
+			location.Status.Usage = test.usage
+
+			rows := printBackupStorageLocation(location)
+			assert.Len(t, rows, 1)
+			assert.Equal(t, test.expected, rows[0].Cells)
+			assert.Len(t, backupStorageLocationColumns, len(rows[0].Cells))
+		})
+	}
+}
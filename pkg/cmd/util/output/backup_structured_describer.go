@@ -33,6 +33,8 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -46,6 +48,7 @@ func DescribeBackupInSF(
 	podVolumeBackups []velerov1api.PodVolumeBackup,
 	volumeSnapshotContents []snapshotv1api.VolumeSnapshotContent,
 	details bool,
+	showSkipped bool,
 	insecureSkipTLSVerify bool,
 	caCertFile string,
 	outputFormat string,
@@ -59,16 +62,28 @@ func DescribeBackupInSF(
 			DescribeResourcePoliciesInSF(d, backup.Spec.ResourcePolicy)
 		}
 
+		if backup.Spec.ValidationPolicy != nil {
+			DescribeValidationPoliciesInSF(d, backup.Spec.ValidationPolicy)
+		}
+
 		status := backup.Status
 		if len(status.ValidationErrors) > 0 {
 			d.Describe("validationErrors", status.ValidationErrors)
 		}
 
+		if len(status.PolicyViolations) > 0 {
+			d.Describe("policyViolations", status.PolicyViolations)
+		}
+
+		if len(status.HookPolicies) > 0 {
+			d.Describe("hookPolicies", status.HookPolicies)
+		}
+
 		DescribeBackupResultsInSF(ctx, kbClient, d, backup, insecureSkipTLSVerify, caCertFile)
 
 		DescribeBackupSpecInSF(d, backup.Spec)
 
-		DescribeBackupStatusInSF(ctx, kbClient, d, backup, details, insecureSkipTLSVerify, caCertFile)
+		DescribeBackupStatusInSF(ctx, kbClient, d, backup, details, showSkipped, insecureSkipTLSVerify, caCertFile)
 
 		if len(deleteRequests) > 0 {
 			DescribeDeleteBackupRequestsInSF(d, deleteRequests)
@@ -81,6 +96,14 @@ func DescribeBackupInSF(
 		if len(podVolumeBackups) > 0 {
 			DescribePodVolumeBackupsInSF(d, podVolumeBackups, details)
 		}
+
+		if len(backup.Status.VolumeVerifications) > 0 {
+			d.Describe("volumeVerifications", backup.Status.VolumeVerifications)
+		}
+
+		if len(backup.Status.VolumeReplications) > 0 {
+			d.Describe("volumeReplications", backup.Status.VolumeReplications)
+		}
 	}, outputFormat)
 }
 
@@ -89,6 +112,11 @@ func DescribeBackupSpecInSF(d *StructuredDescriber, spec velerov1api.BackupSpec)
 	backupSpecInfo := make(map[string]interface{})
 	var s string
 
+	// describe resource filter policy
+	if spec.ResourceFilterPolicy != "" {
+		backupSpecInfo["resourceFilterPolicy"] = spec.ResourceFilterPolicy
+	}
+
 	// describe namespaces
 	namespaceInfo := make(map[string]interface{})
 	if len(spec.IncludedNamespaces) == 0 {
@@ -130,6 +158,11 @@ func DescribeBackupSpecInSF(d *StructuredDescriber, spec velerov1api.BackupSpec)
 	}
 	backupSpecInfo["labelSelector"] = s
 
+	// describe cluster-scoped label selector
+	if spec.ClusterScopedLabelSelector != nil || len(spec.ClusterScopedOrLabelSelectors) > 0 {
+		backupSpecInfo["clusterScopedLabelSelector"] = formatClusterScopedLabelSelectors(spec)
+	}
+
 	// describe storage location
 	backupSpecInfo["storageLocation"] = spec.StorageLocation
 
@@ -144,12 +177,34 @@ func DescribeBackupSpecInSF(d *StructuredDescriber, spec velerov1api.BackupSpec)
 		s = spec.DataMover
 	}
 	backupSpecInfo["dataMover"] = s
+	if spec.SnapshotMoveReplicaLocation != "" {
+		backupSpecInfo["snapshotMoveReplicaLocation"] = spec.SnapshotMoveReplicaLocation
+	}
+	if boolptr.IsSetToTrue(spec.DeduplicateClusterResources) {
+		backupSpecInfo["deduplicateClusterResources"] = true
+	}
+
+	// describe uploader type
+	if len(spec.UploaderType) == 0 {
+		s = emptyDisplay
+	} else {
+		s = spec.UploaderType
+	}
+	backupSpecInfo["uploaderType"] = s
+
+	// describe compression algorithm
+	if spec.CompressionAlgorithm != "" {
+		backupSpecInfo["compressionAlgorithm"] = spec.CompressionAlgorithm
+	}
 
 	// describe TTL
 	backupSpecInfo["TTL"] = spec.TTL.Duration.String()
 
 	// describe CSI snapshot timeout
 	backupSpecInfo["CSISnapshotTimeout"] = spec.CSISnapshotTimeout.Duration.String()
+	if spec.VolumeGroupSnapshotLabelKey != "" {
+		backupSpecInfo["volumeGroupSnapshotLabelKey"] = spec.VolumeGroupSnapshotLabelKey
+	}
 
 	// describe hooks
 	hooksInfo := make(map[string]interface{})
@@ -234,13 +289,24 @@ func DescribeBackupSpecInSF(d *StructuredDescriber, spec velerov1api.BackupSpec)
 }
 
 // DescribeBackupStatusInSF describes a backup status in structured format.
-func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *StructuredDescriber, backup *velerov1api.Backup, details bool, insecureSkipTLSVerify bool, caCertPath string) {
+func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *StructuredDescriber, backup *velerov1api.Backup, details bool, showSkipped bool, insecureSkipTLSVerify bool, caCertPath string) {
 	status := backup.Status
 	backupStatusInfo := make(map[string]interface{})
 
 	// Status.Version has been deprecated, use Status.FormatVersion
 	backupStatusInfo["backupFormatVersion"] = status.FormatVersion
 
+	if status.EncryptionKeyID != "" {
+		backupStatusInfo["encryption"] = map[string]interface{}{
+			"enabled": true,
+			"keyID":   status.EncryptionKeyID,
+		}
+	} else {
+		backupStatusInfo["encryption"] = map[string]interface{}{
+			"enabled": false,
+		}
+	}
+
 	// "<n/a>" output should only be applicable for backups that failed validation
 	if status.StartTimestamp == nil || status.StartTimestamp.Time.IsZero() {
 		backupStatusInfo["started"] = "<n/a>"
@@ -269,9 +335,18 @@ func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *
 			backupStatusInfo["itemsBackedUp"] = backup.Status.Progress.ItemsBackedUp
 		}
 	}
+	if backup.Status.ClusterResourcesDeduplicated > 0 {
+		backupStatusInfo["clusterResourcesDeduplicated"] = backup.Status.ClusterResourcesDeduplicated
+	}
+
+	describeBackupItemOperationsInSF(ctx, kbClient, backupStatusInfo, backup, details, insecureSkipTLSVerify, caCertPath)
 
 	if details {
 		describeBackupResourceListInSF(ctx, kbClient, backupStatusInfo, backup, insecureSkipTLSVerify, caCertPath)
+		describeBackupUploadStatsInSF(ctx, kbClient, backupStatusInfo, backup, insecureSkipTLSVerify, caCertPath)
+		if showSkipped {
+			describeBackupSkippedItemsInSF(ctx, kbClient, backupStatusInfo, backup, insecureSkipTLSVerify, caCertPath)
+		}
 	}
 
 	// In consideration of decoding structured output conveniently, the three separate fields were created here
@@ -305,13 +380,90 @@ func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *
 	}
 }
 
+func describeBackupItemOperationsInSF(ctx context.Context, kbClient kbclient.Client, backupStatusInfo map[string]interface{}, backup *velerov1api.Backup, details bool, insecureSkipTLSVerify bool, caCertPath string) {
+	status := backup.Status
+	if status.BackupItemOperationsAttempted == 0 {
+		return
+	}
+
+	// the field of "backupItemOperations" displays the count of operations
+	// the field of "errorGettingBackupItemOperations" displays the error message if it fails to get operation info
+	// the field of "backupItemOperationsDetail" displays the detailed operations info
+	backupStatusInfo["backupItemOperations"] = map[string]int{
+		"attempted": status.BackupItemOperationsAttempted,
+		"completed": status.BackupItemOperationsCompleted,
+		"failed":    status.BackupItemOperationsFailed,
+	}
+	if !details {
+		return
+	}
+
+	operations, err := DownloadBackupItemOperations(ctx, kbClient, backup, insecureSkipTLSVerify, caCertPath)
+	if err != nil {
+		if _, ok := err.(*metadataDecodeError); ok {
+			backupStatusInfo["errorGettingBackupItemOperations"] = fmt.Sprintf("<error reading operation info: %v>", err)
+		} else {
+			backupStatusInfo["errorGettingBackupItemOperations"] = fmt.Sprintf("<error getting operation info: %v>", err)
+		}
+		return
+	}
+
+	operationsDetail := make([]map[string]interface{}, 0, len(operations))
+	for _, operation := range operations {
+		operationInfo := describeItemOperationStatusInSF(operation.Status)
+		operationInfo["resource"] = fmt.Sprintf("%s %s/%s", operation.Spec.ResourceIdentifier, operation.Spec.ResourceIdentifier.Namespace, operation.Spec.ResourceIdentifier.Name)
+		operationInfo["backupItemAction"] = operation.Spec.BackupItemAction
+		operationInfo["operationID"] = operation.Spec.OperationID
+		if len(operation.Spec.PostOperationItems) > 0 {
+			items := make([]string, 0, len(operation.Spec.PostOperationItems))
+			for _, item := range operation.Spec.PostOperationItems {
+				items = append(items, fmt.Sprintf("%s %s/%s", item, item.Namespace, item.Name))
+			}
+			operationInfo["itemsToUpdate"] = items
+		}
+		operationsDetail = append(operationsDetail, operationInfo)
+	}
+	backupStatusInfo["backupItemOperationsDetail"] = operationsDetail
+}
+
+// describeItemOperationStatusInSF describes the status shared by backup and restore item operations.
+func describeItemOperationStatusInSF(status itemoperation.OperationStatus) map[string]interface{} {
+	operationInfo := make(map[string]interface{})
+	operationInfo["phase"] = status.Phase
+	if status.Error != "" {
+		operationInfo["operationError"] = status.Error
+	}
+	if status.NTotal > 0 || status.NCompleted > 0 {
+		operationInfo["progress"] = map[string]interface{}{
+			"completed": status.NCompleted,
+			"total":     status.NTotal,
+			"units":     status.OperationUnits,
+		}
+	}
+	if status.Description != "" {
+		operationInfo["progressDescription"] = status.Description
+	}
+	if status.Created != nil {
+		operationInfo["created"] = status.Created.String()
+	}
+	if status.Started != nil {
+		operationInfo["started"] = status.Started.String()
+	}
+	if status.Updated != nil {
+		operationInfo["updated"] = status.Updated.String()
+	}
+	return operationInfo
+}
+
 func describeBackupResourceListInSF(ctx context.Context, kbClient kbclient.Client, backupStatusInfo map[string]interface{}, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
 	// In consideration of decoding structured output conveniently, the two separate fields were created here(in func describeBackupResourceList, there is only one field describing either error message or resource list)
 	// the field of 'errorGettingResourceList' gives specific error message when it fails to get resources list
 	// the field of 'resourceList' lists the rearranged resources
-	buf := new(bytes.Buffer)
-	if err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResourceList, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
-		if err == downloadrequest.ErrNotFound {
+	resourceList, err := downloadBackupResourceList(ctx, kbClient, backup, insecureSkipTLSVerify, caCertPath)
+	if err != nil {
+		if _, ok := err.(*metadataDecodeError); ok {
+			backupStatusInfo["errorGettingResourceList"] = fmt.Sprintf("<error reading backup resource list: %v>\n", err)
+		} else if err == downloadrequest.ErrNotFound {
 			// the backup resource list could be missing if (other reasons may exist as well):
 			//	- the backup was taken prior to v1.1; or
 			//	- the backup hasn't completed yet; or
@@ -323,13 +475,42 @@ func describeBackupResourceListInSF(ctx context.Context, kbClient kbclient.Clien
 		}
 		return
 	}
+	backupStatusInfo["resourceList"] = resourceList
+}
 
-	var resourceList map[string][]string
-	if err := json.NewDecoder(buf).Decode(&resourceList); err != nil {
-		backupStatusInfo["errorGettingResourceList"] = fmt.Sprintf("<error reading backup resource list: %v>\n", err)
+func describeBackupSkippedItemsInSF(ctx context.Context, kbClient kbclient.Client, backupStatusInfo map[string]interface{}, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
+	skippedItems, err := DownloadBackupSkippedItems(ctx, kbClient, backup, insecureSkipTLSVerify, caCertPath)
+	if err != nil {
+		if _, ok := err.(*metadataDecodeError); ok {
+			backupStatusInfo["errorGettingSkippedItems"] = fmt.Sprintf("<error reading backup skipped items: %v>", err)
+		} else if err == downloadrequest.ErrNotFound {
+			backupStatusInfo["errorGettingSkippedItems"] = "<backup skipped items not found>"
+		} else {
+			backupStatusInfo["errorGettingSkippedItems"] = fmt.Sprintf("<error getting backup skipped items: %v>", err)
+		}
 		return
 	}
-	backupStatusInfo["resourceList"] = resourceList
+	backupStatusInfo["skippedItems"] = skippedItems
+}
+
+func describeBackupUploadStatsInSF(ctx context.Context, kbClient kbclient.Client, backupStatusInfo map[string]interface{}, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
+	volumeInfos, err := DownloadBackupVolumeInfos(ctx, kbClient, backup, insecureSkipTLSVerify, caCertPath)
+	if err != nil {
+		if _, ok := err.(*metadataDecodeError); ok {
+			backupStatusInfo["errorGettingUploadStats"] = fmt.Sprintf("<error reading backup volumes information: %v>", err)
+		} else if err != downloadrequest.ErrNotFound {
+			backupStatusInfo["errorGettingUploadStats"] = fmt.Sprintf("<error getting backup volumes information: %v>", err)
+		}
+		return
+	}
+
+	uploadStats := uploadStatsByVolume(volumeInfos)
+	if len(uploadStats) == 0 {
+		return
+	}
+
+	uploadStats["total"] = totalUploadStats(uploadStats)
+	backupStatusInfo["uploadStats"] = uploadStats
 }
 
 func describeSnapshotInSF(pvName, snapshotID, volumeType, volumeAZ string, iops *int64, snapshotDetails map[string]interface{}) {
@@ -490,14 +671,14 @@ func DescribeBackupResultsInSF(ctx context.Context, kbClient kbclient.Client, d
 		warnings["count"] = backup.Status.Warnings
 		return
 	} else if err != nil {
-		errors["errorGettingErrors"] = fmt.Errorf("<error getting errors: %v>", err)
-		warnings["errorGettingWarnings"] = fmt.Errorf("<error getting warnings: %v>", err)
+		errors["errorGettingErrors"] = fmt.Sprintf("<error getting errors: %v>", err)
+		warnings["errorGettingWarnings"] = fmt.Sprintf("<error getting warnings: %v>", err)
 		return
 	}
 
 	if err := json.NewDecoder(&buf).Decode(&resultMap); err != nil {
-		errors["errorGettingErrors"] = fmt.Errorf("<error decoding errors: %v>", err)
-		warnings["errorGettingWarnings"] = fmt.Errorf("<error decoding warnings: %v>", err)
+		errors["errorGettingErrors"] = fmt.Sprintf("<error decoding errors: %v>", err)
+		warnings["errorGettingWarnings"] = fmt.Sprintf("<error decoding warnings: %v>", err)
 		return
 	}
 
@@ -517,6 +698,14 @@ func DescribeResourcePoliciesInSF(d *StructuredDescriber, resPolicies *v1.TypedL
 	d.Describe("resourcePolicies", policiesInfo)
 }
 
+// DescribeValidationPoliciesInSF describes validation policies in structured format.
+func DescribeValidationPoliciesInSF(d *StructuredDescriber, valPolicies *v1.TypedLocalObjectReference) {
+	policiesInfo := make(map[string]interface{})
+	policiesInfo["type"] = valPolicies.Kind
+	policiesInfo["name"] = valPolicies.Name
+	d.Describe("validationPolicies", policiesInfo)
+}
+
 func describeResultInSF(m map[string]interface{}, result results.Result) {
 	m["velero"], m["cluster"], m["namespace"] = []string{}, []string{}, []string{}
 
@@ -529,4 +718,7 @@ func describeResultInSF(m map[string]interface{}, result results.Result) {
 	if len(result.Namespaces) > 0 {
 		m["namespace"] = result.Namespaces
 	}
+	if len(result.Categories) > 0 {
+		m["categories"] = result.CategoryCounts()
+	}
 }
@@ -0,0 +1,175 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	customColumnsPrefix = "custom-columns="
+	jsonPathPrefix      = "jsonpath="
+)
+
+// customColumn is a column of the custom-columns output, its value is
+// the result of the JSONPath template evaluated on each object.
+type customColumn struct {
+	header   string
+	template string
+	parser   *jsonpath.JSONPath
+}
+
+// parseCustomColumns parses a custom-columns spec in the form of
+// "<header>:<json-path-expr>,<header>:<json-path-expr>...".
+func parseCustomColumns(spec string) ([]customColumn, error) {
+	if spec == "" {
+		return nil, errors.New("custom-columns format specified but no custom columns given")
+	}
+
+	var columns []customColumn
+	for _, part := range strings.Split(spec, ",") {
+		colSpec := strings.SplitN(part, ":", 2)
+		if len(colSpec) != 2 || colSpec[0] == "" || colSpec[1] == "" {
+			return nil, errors.Errorf("unexpected custom-columns spec: %s, expected <header>:<json-path-expr>", part)
+		}
+
+		template, err := relaxedJSONPathExpression(colSpec[1])
+		if err != nil {
+			return nil, err
+		}
+		parser := jsonpath.New(colSpec[0]).AllowMissingKeys(true)
+		if err := parser.Parse(template); err != nil {
+			return nil, errors.Wrapf(err, "error parsing the JSONPath template of column %s", colSpec[0])
+		}
+
+		columns = append(columns, customColumn{header: colSpec[0], template: template, parser: parser})
+	}
+	return columns, nil
+}
+
+// relaxedJSONPathExpression turns the relaxed JSONPath syntax accepted by kubectl, like
+// "metadata.name" or ".metadata.name", into the "{.metadata.name}" template syntax.
+func relaxedJSONPathExpression(expr string) (string, error) {
+	if strings.HasPrefix(expr, "{") {
+		if !strings.HasSuffix(expr, "}") {
+			return "", errors.Errorf("unexpected path string, expected a 'name1.name2' or '.name1.name2' or '{name1.name2}' or '{.name1.name2}': %s", expr)
+		}
+		expr = strings.TrimSuffix(strings.TrimPrefix(expr, "{"), "}")
+	}
+	if !strings.HasPrefix(expr, ".") {
+		expr = "." + expr
+	}
+	return "{" + expr + "}", nil
+}
+
+// printCustomColumns prints the object, or each item of the list object, as a row
+// of a table with the columns in the custom-columns spec.
+func printCustomColumns(w io.Writer, obj runtime.Object, spec string) error {
+	columns, err := parseCustomColumns(spec)
+	if err != nil {
+		return err
+	}
+
+	objs := []runtime.Object{obj}
+	if meta.IsListType(obj) {
+		if objs, err = meta.ExtractList(obj); err != nil {
+			return errors.Wrap(err, "error extracting the items of the list")
+		}
+	}
+
+	tw := printers.GetNewTabWriter(w)
+	headers := make([]string, 0, len(columns))
+	for _, column := range columns {
+		headers = append(headers, strings.ToUpper(column.header))
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+
+	for _, o := range objs {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+		if err != nil {
+			return errors.Wrap(err, "error converting the object")
+		}
+
+		values := make([]string, 0, len(columns))
+		for _, column := range columns {
+			results, err := column.parser.FindResults(content)
+			if err != nil {
+				return errors.Wrapf(err, "error evaluating the JSONPath template %s", column.template)
+			}
+
+			var strs []string
+			for _, result := range results {
+				for _, value := range result {
+					if str := printableValue(value); str != "" {
+						strs = append(strs, str)
+					}
+				}
+			}
+			if len(strs) == 0 {
+				values = append(values, emptyDisplay)
+			} else {
+				values = append(values, strings.Join(strs, ","))
+			}
+		}
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+func printableValue(value reflect.Value) string {
+	for value.Kind() == reflect.Interface || value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return ""
+		}
+		value = value.Elem()
+	}
+	return fmt.Sprintf("%v", value.Interface())
+}
+
+// printJSONPath prints the result of the JSONPath template evaluated on the object.
+// Unlike the json and yaml formats, a list object is never replaced with its only item,
+// so that the same template works no matter how many items are returned.
+func printJSONPath(w io.Writer, obj runtime.Object, template string) error {
+	if template == "" {
+		return errors.New("jsonpath format specified but no template given")
+	}
+
+	parser := jsonpath.New("output").AllowMissingKeys(true)
+	if err := parser.Parse(template); err != nil {
+		return errors.Wrapf(err, "error parsing the JSONPath template %s", template)
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return errors.Wrap(err, "error converting the object")
+	}
+
+	if err := parser.Execute(w, content); err != nil {
+		return errors.Wrapf(err, "error executing the JSONPath template %s", template)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestPrintCustomColumns(t *testing.T) {
+	backups := &velerov1api.BackupList{
+		Items: []velerov1api.Backup{
+			*builder.ForBackup("velero", "backup-1").Phase(velerov1api.BackupPhaseCompleted).IncludedNamespaces("ns-1", "ns-2").Result(),
+			*builder.ForBackup("velero", "backup-2").Result(),
+		},
+	}
+
+	tests := []struct {
+		name        string
+		obj         runtime.Object
+		spec        string
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:     "list is printed with a row per item",
+			obj:      backups,
+			spec:     "NAME:.metadata.name,PHASE:.status.phase,NAMESPACES:.spec.includedNamespaces[*]",
+			expected: "NAME       PHASE       NAMESPACES\nbackup-1   Completed   ns-1,ns-2\nbackup-2   <none>      <none>\n",
+		},
+		{
+			name:     "single object is printed as one row with relaxed paths",
+			obj:      builder.ForBackup("velero", "backup-1").Result(),
+			spec:     "name:metadata.name,namespace:{.metadata.namespace}",
+			expected: "NAME       NAMESPACE\nbackup-1   velero\n",
+		},
+		{
+			name:        "column without a path is invalid",
+			obj:         backups,
+			spec:        "NAME",
+			expectedErr: "unexpected custom-columns spec: NAME, expected <header>:<json-path-expr>",
+		},
+		{
+			name:        "empty spec is invalid",
+			obj:         backups,
+			expectedErr: "custom-columns format specified but no custom columns given",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := printCustomColumns(buf, tc.obj, tc.spec)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
+
+func TestPrintJSONPath(t *testing.T) {
+	tests := []struct {
+		name        string
+		obj         runtime.Object
+		template    string
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name: "list with a single item is kept as a list",
+			obj: &velerov1api.RestoreList{
+				Items: []velerov1api.Restore{*builder.ForRestore("velero", "restore-1").Result()},
+			},
+			template: "{.items[*].metadata.name}",
+			expected: "restore-1",
+		},
+		{
+			name: "range over the items of a list",
+			obj: &velerov1api.ScheduleList{
+				Items: []velerov1api.Schedule{
+					*builder.ForSchedule("velero", "schedule-1").CronSchedule("@daily").Result(),
+					*builder.ForSchedule("velero", "schedule-2").CronSchedule("@hourly").Result(),
+				},
+			},
+			template: `{range .items[*]}{.metadata.name}{"\t"}{.spec.schedule}{"\n"}{end}`,
+			expected: "schedule-1\t@daily\nschedule-2\t@hourly\n",
+		},
+		{
+			name:     "missing keys are printed empty",
+			obj:      builder.ForBackupStorageLocation("velero", "default").Result(),
+			template: "{.metadata.name}:{.status.phase}",
+			expected: "default:",
+		},
+		{
+			name:        "invalid template",
+			obj:         builder.ForBackupStorageLocation("velero", "default").Result(),
+			template:    "{.metadata.name",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := printJSONPath(buf, tc.obj, tc.template)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/fatih/color"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 )
 
 type Describer struct {
@@ -136,10 +137,13 @@ func NewStructuredDescriber(format string) *StructuredDescriber {
 
 // DescribeInSF returns the structured output based on the func
 // that applies StructuredDescriber to collect outputs.
-// This function takes arg 'format' for future format extension.
+// The outputs are encoded to yaml if format is "yaml", otherwise to json.
 func DescribeInSF(fn func(d *StructuredDescriber), format string) string {
 	d := NewStructuredDescriber(format)
 	fn(d)
+	if d.format == "yaml" {
+		return d.YAMLEncode()
+	}
 	return d.JSONEncode()
 }
 
@@ -167,3 +171,9 @@ func (d *StructuredDescriber) JSONEncode() string {
 	_ = encoder.Encode(d.output)
 	return byteBuffer.String()
 }
+
+// YAMLEncode encodes d.output to yaml
+func (d *StructuredDescriber) YAMLEncode() string {
+	out, _ := yaml.Marshal(d.output)
+	return string(out)
+}
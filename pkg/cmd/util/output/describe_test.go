@@ -134,6 +134,24 @@ func TestStructuredDescriber_JSONEncode(t *testing.T) {
 	}
 }
 
+func TestStructuredDescriber_YAMLEncode(t *testing.T) {
+	d := &StructuredDescriber{
+		output: map[string]interface{}{
+			"k1": "v1",
+			"k2": map[string]interface{}{"k3": []string{"v3"}},
+		},
+	}
+	assert.Equal(t, "k1: v1\nk2:\n  k3:\n  - v3\n", d.YAMLEncode())
+}
+
+func TestDescribeInSF(t *testing.T) {
+	fn := func(d *StructuredDescriber) {
+		d.Describe("k1", "v1")
+	}
+	assert.Equal(t, "{\n    \"k1\": \"v1\"\n}\n", DescribeInSF(fn, "json"))
+	assert.Equal(t, "k1: v1\n", DescribeInSF(fn, "yaml"))
+}
+
 func TestStructuredDescriber_DescribeMetadata(t *testing.T) {
 	d := NewStructuredDescriber("")
 	input := metav1.ObjectMeta{
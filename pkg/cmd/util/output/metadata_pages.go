@@ -0,0 +1,149 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// metadataDecodeError is returned when a downloaded page of the backup metadata can't be decoded.
+type metadataDecodeError struct {
+	err error
+}
+
+func (e *metadataDecodeError) Error() string {
+	return e.err.Error()
+}
+
+// streamMetadataPage downloads a page of the paginated metadata file of the backup.
+var streamMetadataPage = func(ctx context.Context, kbClient kbclient.Client, backupObj *velerov1api.Backup, kind velerov1api.DownloadTargetKind, page int, w io.Writer, insecureSkipTLSVerify bool, caCertPath string) error {
+	target := velerov1api.DownloadTarget{Kind: kind, Name: backupObj.Name, Page: page}
+	return downloadrequest.StreamTarget(ctx, kbClient, backupObj.Namespace, target, w, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath)
+}
+
+// downloadMetadataPages downloads the pages of the paginated metadata file of the backup one by
+// one and passes them to decode, which returns the number of items in the page. The pages are
+// loaded lazily: the next page is only downloaded if the page is full, so the metadata of the
+// small backups, and of the backups taken before the metadata was paginated, is a single download.
+func downloadMetadataPages(ctx context.Context, kbClient kbclient.Client, backupObj *velerov1api.Backup, kind velerov1api.DownloadTargetKind, insecureSkipTLSVerify bool, caCertPath string, decode func(page io.Reader) (int, error)) error {
+	var previous []byte
+	for page := 1; ; page++ {
+		buf := new(bytes.Buffer)
+		if err := streamMetadataPage(ctx, kbClient, backupObj, kind, page, buf, insecureSkipTLSVerify, caCertPath); err != nil {
+			if page > 1 && err == downloadrequest.ErrNotFound {
+				return nil
+			}
+			return err
+		}
+
+		// the servers which don't paginate the metadata return the whole file for any page
+		if bytes.Equal(previous, buf.Bytes()) {
+			return nil
+		}
+		previous = buf.Bytes()
+
+		items, err := decode(bytes.NewReader(previous))
+		if err != nil {
+			return &metadataDecodeError{err}
+		}
+		if items < backup.MetadataPageSize {
+			return nil
+		}
+	}
+}
+
+// downloadBackupResourceList downloads all the pages of the resource list of the backup.
+func downloadBackupResourceList(ctx context.Context, kbClient kbclient.Client, backupObj *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) (map[string][]string, error) {
+	var resourceList map[string][]string
+	err := downloadMetadataPages(ctx, kbClient, backupObj, velerov1api.DownloadTargetKindBackupResourceList, insecureSkipTLSVerify, caCertPath, func(page io.Reader) (int, error) {
+		var pageList map[string][]string
+		if err := json.NewDecoder(page).Decode(&pageList); err != nil {
+			return 0, err
+		}
+
+		if resourceList == nil {
+			resourceList = map[string][]string{}
+		}
+		items := 0
+		for resource, names := range pageList {
+			resourceList[resource] = append(resourceList[resource], names...)
+			items += len(names)
+		}
+		return items, nil
+	})
+
+	return resourceList, err
+}
+
+// DownloadBackupItemOperations downloads all the pages of the item operations of the backup.
+func DownloadBackupItemOperations(ctx context.Context, kbClient kbclient.Client, backupObj *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) ([]*itemoperation.BackupOperation, error) {
+	var operations []*itemoperation.BackupOperation
+	err := downloadMetadataPages(ctx, kbClient, backupObj, velerov1api.DownloadTargetKindBackupItemOperations, insecureSkipTLSVerify, caCertPath, func(page io.Reader) (int, error) {
+		var pageOperations []*itemoperation.BackupOperation
+		if err := json.NewDecoder(page).Decode(&pageOperations); err != nil {
+			return 0, err
+		}
+
+		operations = append(operations, pageOperations...)
+		return len(pageOperations), nil
+	})
+
+	return operations, err
+}
+
+// DownloadBackupVolumeInfos downloads all the pages of the volume information of the backup.
+func DownloadBackupVolumeInfos(ctx context.Context, kbClient kbclient.Client, backupObj *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) ([]volume.VolumeInfo, error) {
+	var volumeInfos []volume.VolumeInfo
+	err := downloadMetadataPages(ctx, kbClient, backupObj, velerov1api.DownloadTargetKindBackupVolumeInfos, insecureSkipTLSVerify, caCertPath, func(page io.Reader) (int, error) {
+		var pageInfos volume.VolumeInfos
+		if err := json.NewDecoder(page).Decode(&pageInfos); err != nil {
+			return 0, err
+		}
+
+		volumeInfos = append(volumeInfos, pageInfos.VolumeInfos...)
+		return len(pageInfos.VolumeInfos), nil
+	})
+
+	return volumeInfos, err
+}
+
+// DownloadBackupSkippedItems downloads all the pages of the skipped items of the backup.
+func DownloadBackupSkippedItems(ctx context.Context, kbClient kbclient.Client, backupObj *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) ([]backup.SkippedItem, error) {
+	var skippedItems []backup.SkippedItem
+	err := downloadMetadataPages(ctx, kbClient, backupObj, velerov1api.DownloadTargetKindBackupSkippedItems, insecureSkipTLSVerify, caCertPath, func(page io.Reader) (int, error) {
+		var pageItems []backup.SkippedItem
+		if err := json.NewDecoder(page).Decode(&pageItems); err != nil {
+			return 0, err
+		}
+
+		skippedItems = append(skippedItems, pageItems...)
+		return len(pageItems), nil
+	})
+
+	return skippedItems, err
+}
@@ -0,0 +1,143 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+)
+
+// fakeMetadataPages serves the pages of the metadata files and records the downloaded pages.
+func fakeMetadataPages(t *testing.T, pages []string, ignorePage bool) *[]int {
+	downloaded := []int{}
+	original := streamMetadataPage
+	t.Cleanup(func() { streamMetadataPage = original })
+
+	streamMetadataPage = func(_ context.Context, _ kbclient.Client, _ *velerov1api.Backup, _ velerov1api.DownloadTargetKind, page int, w io.Writer, _ bool, _ string) error {
+		downloaded = append(downloaded, page)
+		if ignorePage {
+			page = 1
+		}
+		if page > len(pages) {
+			return downloadrequest.ErrNotFound
+		}
+		_, err := w.Write([]byte(pages[page-1]))
+		return err
+	}
+	return &downloaded
+}
+
+func fullResourceListPage(t *testing.T) (string, []string) {
+	names := make([]string, backup.MetadataPageSize)
+	for i := range names {
+		names[i] = fmt.Sprintf("ns-1/pod-%d", i)
+	}
+	page, err := json.Marshal(map[string][]string{"v1/Pod": names})
+	require.NoError(t, err)
+	return string(page), names
+}
+
+func TestDownloadBackupResourceList(t *testing.T) {
+	backupObj := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+	fullPage, names := fullResourceListPage(t)
+
+	tests := []struct {
+		name               string
+		pages              []string
+		ignorePage         bool
+		expected           map[string][]string
+		expectedDownloaded []int
+		expectedErr        string
+		expectedDecodeErr  bool
+	}{
+		{
+			name:               "single page",
+			pages:              []string{`{"v1/Pod":["ns-1/pod-1"]}`},
+			expected:           map[string][]string{"v1/Pod": {"ns-1/pod-1"}},
+			expectedDownloaded: []int{1},
+		},
+		{
+			name:               "the page after a full page is downloaded",
+			pages:              []string{fullPage, `{"v1/Pod":["ns-1/pod-x"],"v1/Service":["ns-1/svc-1"]}`},
+			expected:           map[string][]string{"v1/Pod": append(append([]string{}, names...), "ns-1/pod-x"), "v1/Service": {"ns-1/svc-1"}},
+			expectedDownloaded: []int{1, 2},
+		},
+		{
+			name:               "the pages end with a full page",
+			pages:              []string{fullPage},
+			expected:           map[string][]string{"v1/Pod": names},
+			expectedDownloaded: []int{1, 2},
+		},
+		{
+			name:               "the server doesn't support the pages",
+			pages:              []string{fullPage},
+			ignorePage:         true,
+			expected:           map[string][]string{"v1/Pod": names},
+			expectedDownloaded: []int{1, 2},
+		},
+		{
+			name:        "resource list not found",
+			expectedErr: downloadrequest.ErrNotFound.Error(),
+		},
+		{
+			name:              "invalid page",
+			pages:             []string{"foo"},
+			expectedErr:       "invalid character 'o' in literal false (expecting 'a')",
+			expectedDecodeErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			downloaded := fakeMetadataPages(t, test.pages, test.ignorePage)
+
+			resourceList, err := downloadBackupResourceList(context.Background(), nil, backupObj, false, "")
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+				_, decodeErr := err.(*metadataDecodeError)
+				assert.Equal(t, test.expectedDecodeErr, decodeErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, resourceList)
+			assert.Equal(t, test.expectedDownloaded, *downloaded)
+		})
+	}
+}
+
+func TestDownloadBackupItemOperations(t *testing.T) {
+	backupObj := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+	downloaded := fakeMetadataPages(t, []string{`[{"spec":{"operationID":"1"}},{"spec":{"operationID":"2"}}]`}, false)
+
+	operations, err := DownloadBackupItemOperations(context.Background(), nil, backupObj, false, "")
+	require.NoError(t, err)
+	require.Len(t, operations, 2)
+	assert.Equal(t, "2", operations[1].Spec.OperationID)
+	assert.Equal(t, []int{1}, *downloaded)
+}
@@ -19,6 +19,7 @@ package output
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -43,7 +44,7 @@ const (
 // BindFlags defines a set of output-specific flags within the provided
 // FlagSet.
 func BindFlags(flags *pflag.FlagSet) {
-	flags.StringP("output", "o", "table", "Output display format. For create commands, display the object but do not send it to the server. Valid formats are 'table', 'json', and 'yaml'. 'table' is not valid for the install command.")
+	flags.StringP("output", "o", "table", "Output display format. For create commands, display the object but do not send it to the server. Valid formats are 'table', 'wide', 'json', 'yaml', 'custom-columns=<header>:<json-path-expr>,...' and 'jsonpath=<template>'. 'wide' is the 'table' with additional columns. Only 'json' and 'yaml' are valid for the install command.")
 	labelColumns := flag.NewStringArray()
 	flags.VarP(&labelColumns, "label-columns", "L", "Accepts a comma separated list of labels that are going to be presented as columns. Names are case-sensitive. You can also use multiple flag options like -L label1 -L label2...")
 	flags.Bool("show-labels", false, "Show labels in the last column")
@@ -51,7 +52,7 @@ func BindFlags(flags *pflag.FlagSet) {
 
 // BindFlagsSimple defines the output format flag only.
 func BindFlagsSimple(flags *pflag.FlagSet) {
-	flags.StringP("output", "o", "table", "Output display format. For create commands, display the object but do not send it to the server. Valid formats are 'table', 'json', and 'yaml'. 'table' is not valid for the install command.")
+	flags.StringP("output", "o", "table", "Output display format. For create commands, display the object but do not send it to the server. Valid formats are 'table', 'wide', 'json', 'yaml', 'custom-columns=<header>:<json-path-expr>,...' and 'jsonpath=<template>'. 'wide' is the 'table' with additional columns. Only 'json' and 'yaml' are valid for the install command.")
 }
 
 // ClearOutputFlagDefault sets the current and default value
@@ -96,14 +97,28 @@ func ValidateFlags(cmd *cobra.Command) error {
 
 func validateOutputFlag(cmd *cobra.Command) error {
 	output := GetOutputFlagValue(cmd)
-	switch output {
-	case "", "json", "yaml":
-	case "table":
+	switch {
+	case output == "", output == "json", output == "yaml":
+	case output == "table", output == "wide":
 		if cmd.Name() == "install" {
-			return errors.New("'table' format is not supported with 'install' command")
+			return errors.Errorf("'%s' format is not supported with 'install' command", output)
+		}
+	case strings.HasPrefix(output, customColumnsPrefix):
+		if cmd.Name() == "install" {
+			return errors.New("'custom-columns' format is not supported with 'install' command")
+		}
+		if _, err := parseCustomColumns(strings.TrimPrefix(output, customColumnsPrefix)); err != nil {
+			return err
+		}
+	case strings.HasPrefix(output, jsonPathPrefix):
+		if cmd.Name() == "install" {
+			return errors.New("'jsonpath' format is not supported with 'install' command")
+		}
+		if strings.TrimPrefix(output, jsonPathPrefix) == "" {
+			return errors.New("jsonpath format specified but no template given")
 		}
 	default:
-		return errors.Errorf("invalid output format %q - valid values are 'table', 'json', and 'yaml'", output)
+		return errors.Errorf("invalid output format %q - valid values are 'table', 'wide', 'json', 'yaml', 'custom-columns=...' and 'jsonpath=...'", output)
 	}
 	return nil
 }
@@ -117,13 +132,26 @@ func PrintWithFormat(c *cobra.Command, obj runtime.Object) (bool, error) {
 	}
 
 	switch format {
-	case "table":
+	case "table", "wide":
 		return printTable(c, obj)
 	case "json", "yaml":
 		return printEncoded(obj, format)
 	}
 
-	return false, errors.Errorf("unsupported output format %q; valid values are 'table', 'json', and 'yaml'", format)
+	if strings.HasPrefix(format, customColumnsPrefix) {
+		if err := printCustomColumns(os.Stdout, obj, strings.TrimPrefix(format, customColumnsPrefix)); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	if strings.HasPrefix(format, jsonPathPrefix) {
+		if err := printJSONPath(os.Stdout, obj, strings.TrimPrefix(format, jsonPathPrefix)); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	return false, errors.Errorf("unsupported output format %q; valid values are 'table', 'wide', 'json', 'yaml', 'custom-columns=...' and 'jsonpath=...'", format)
 }
 
 func printEncoded(obj runtime.Object, format string) (bool, error) {
@@ -242,6 +270,7 @@ func NewPrinter(cmd *cobra.Command) (printers.ResourcePrinter, error) {
 	options := printers.PrintOptions{
 		ShowLabels:   GetShowLabelsValue(cmd),
 		ColumnLabels: GetLabelColumnsValues(cmd),
+		Wide:         GetOutputFlagValue(cmd) == "wide",
 	}
 
 	printer := printers.NewTablePrinter(options)
@@ -82,6 +82,41 @@ func TestValidateFlags(t *testing.T) {
 			input:  cmdWithFormat("other", "table"),
 			hasErr: false,
 		},
+		{
+			name:   "install with wide format",
+			input:  cmdWithFormat("install", "wide"),
+			hasErr: true,
+		},
+		{
+			name:   "other with wide format",
+			input:  cmdWithFormat("other", "wide"),
+			hasErr: false,
+		},
+		{
+			name:   "custom-columns format",
+			input:  cmdWithFormat("other", "custom-columns=NAME:.metadata.name"),
+			hasErr: false,
+		},
+		{
+			name:   "invalid custom-columns format",
+			input:  cmdWithFormat("other", "custom-columns=NAME"),
+			hasErr: true,
+		},
+		{
+			name:   "install with custom-columns format",
+			input:  cmdWithFormat("install", "custom-columns=NAME:.metadata.name"),
+			hasErr: true,
+		},
+		{
+			name:   "jsonpath format",
+			input:  cmdWithFormat("other", "jsonpath={.items[*].metadata.name}"),
+			hasErr: false,
+		},
+		{
+			name:   "jsonpath format without template",
+			input:  cmdWithFormat("other", "jsonpath="),
+			hasErr: true,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -24,6 +24,7 @@ import (
 	"sort"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -32,6 +33,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
 
@@ -52,7 +54,7 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 		}
 
 		switch phase {
-		case velerov1api.RestorePhaseCompleted:
+		case velerov1api.RestorePhaseCompleted, velerov1api.RestorePhaseDryRunCompleted:
 			phaseString = color.GreenString(phaseString)
 		case velerov1api.RestorePhaseFailedValidation, velerov1api.RestorePhasePartiallyFailed, velerov1api.RestorePhaseFailed:
 			phaseString = color.RedString(phaseString)
@@ -68,6 +70,9 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			if restore.Status.Phase == velerov1api.RestorePhaseInProgress {
 				d.Printf("Estimated total items to be restored:\t%d\n", restore.Status.Progress.TotalItems)
 				d.Printf("Items restored so far:\t%d\n", restore.Status.Progress.ItemsRestored)
+				if restore.Status.Progress.ItemsWaitingForReadiness > 0 {
+					d.Printf("Items waiting for readiness:\t%d\n", restore.Status.Progress.ItemsWaitingForReadiness)
+				}
 			} else {
 				d.Printf("Total items to be restored:\t%d\n", restore.Status.Progress.TotalItems)
 				d.Printf("Items restored:\t%d\n", restore.Status.Progress.ItemsRestored)
@@ -88,6 +93,18 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			d.Printf("Completed:\t%s\n", restore.Status.CompletionTimestamp)
 		}
 
+		if retrieval := restore.Status.BackupRetrieval; retrieval != nil {
+			d.Println()
+			d.Printf("Backup Retrieval:\n")
+			d.Printf("\tStorage Class:\t%s\n", retrieval.StorageClass)
+			d.Printf("\tStarted:\t%s\n", retrieval.StartTimestamp)
+			if retrieval.CompletionTimestamp == nil {
+				d.Printf("\tCompleted:\t%s\n", "<n/a>")
+			} else {
+				d.Printf("\tCompleted:\t%s\n", retrieval.CompletionTimestamp)
+			}
+		}
+
 		if len(restore.Status.ValidationErrors) > 0 {
 			d.Println()
 			d.Printf("Validation errors:")
@@ -139,6 +156,12 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 		d.Println()
 		d.DescribeMap("Namespace mappings", restore.Spec.NamespaceMapping)
 
+		d.Println()
+		d.DescribeMap("Storage class mappings", restore.Spec.StorageClassMappings)
+
+		d.Println()
+		d.DescribeMap("Zone mappings", restore.Spec.ZoneMappings)
+
 		d.Println()
 		s = emptyDisplay
 		if restore.Spec.LabelSelector != nil {
@@ -172,10 +195,67 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			s = string(restore.Spec.ExistingResourcePolicy)
 		}
 		d.Printf("Existing Resource Policy: \t%s\n", s)
+		if len(restore.Spec.ExistingResourcePolicies) > 0 {
+			d.DescribeMap("Existing Resource Policies", existingResourcePoliciesToMap(restore.Spec.ExistingResourcePolicies))
+		}
 		d.Printf("ItemOperationTimeout:\t%s\n", restore.Spec.ItemOperationTimeout.Duration)
 
 		d.Println()
 		d.Printf("Preserve Service NodePorts:\t%s\n", BoolPointerString(restore.Spec.PreserveNodePorts, "false", "true", "auto"))
+		if boolptr.IsSetToTrue(restore.Spec.StrictQuota) {
+			d.Printf("Strict Quota:\ttrue\n")
+		}
+
+		if overrides := restore.Spec.PVCSizeOverrides; overrides != nil {
+			d.Println()
+			d.Printf("PVC Size Growth Percentage:\t%d%%\n", overrides.GrowthPercentage)
+			if len(overrides.Sizes) > 0 {
+				d.DescribeMap("PVC Sizes", pvcSizesToMap(overrides.Sizes))
+			}
+		}
+
+		if policy := restore.Spec.ItemRetryPolicy; policy != nil {
+			d.Println()
+			initialBackoff, maxBackoff := "1s", "30s"
+			if policy.InitialBackoff.Duration > 0 {
+				initialBackoff = policy.InitialBackoff.Duration.String()
+			}
+			if policy.MaxBackoff.Duration > 0 {
+				maxBackoff = policy.MaxBackoff.Duration.String()
+			}
+			d.Printf("Item Retry Policy:\t%d retries (backoff %s, max %s)\n", policy.MaxRetries, initialBackoff, maxBackoff)
+		}
+
+		if secretEncryption := restore.Spec.SecretEncryption; secretEncryption != nil {
+			d.Println()
+			s = "all"
+			if len(secretEncryption.SensitiveTypes) > 0 {
+				s = strings.Join(secretEncryption.SensitiveTypes, ", ")
+			}
+			d.Printf("Secret Encryption Verification:\tsensitive types %s (allow unencrypted target: %t)\n", s, secretEncryption.AllowUnencryptedTarget)
+		}
+
+		if scaling := restore.Spec.Scaling; scaling != nil {
+			d.Println()
+			s = "deployments, statefulsets"
+			if len(scaling.IncludedResources) > 0 {
+				s = strings.Join(scaling.IncludedResources, ", ")
+			}
+			d.Printf("Scaling:\t%d replicas (%s)\n", scaling.Replicas, s)
+		}
+
+		if gates := restore.Spec.ReadinessGates; gates != nil {
+			d.Println()
+			s = "customresourcedefinitions, namespaces, mutatingwebhookconfigurations, validatingwebhookconfigurations"
+			if len(gates.IncludedResources) > 0 {
+				s = strings.Join(gates.IncludedResources, ", ")
+			}
+			timeout := "server's resource timeout"
+			if gates.Timeout.Duration > 0 {
+				timeout = gates.Timeout.Duration.String()
+			}
+			d.Printf("Readiness Gates:\t%s (timeout: %s)\n", s, timeout)
+		}
 
 		d.Println()
 		describeRestoreItemOperations(ctx, kbClient, d, restore, details, insecureSkipTLSVerify, caCertFile)
@@ -187,6 +267,74 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 	})
 }
 
+// dryRunItemMarks are the marks of the items in the different states in the dry-run result.
+var dryRunItemMarks = map[velerov1api.RestoreDryRunItemState]string{
+	velerov1api.RestoreDryRunItemStateMissing: "+",
+	velerov1api.RestoreDryRunItemStateChanged: "~",
+	velerov1api.RestoreDryRunItemStateExists:  "=",
+}
+
+// DescribeRestoreDryRun describes the dry-run result of a restore as a diff between the items
+// in the backup and the cluster in human-readable format.
+func DescribeRestoreDryRun(restore *velerov1api.Restore) string {
+	return Describe(func(d *Describer) {
+		phaseString := string(restore.Status.Phase)
+		if restore.Status.Phase == velerov1api.RestorePhaseDryRunCompleted {
+			phaseString = color.GreenString(phaseString)
+		} else {
+			phaseString = color.RedString(phaseString)
+		}
+		d.Printf("Phase:\t%s\n", phaseString)
+
+		if len(restore.Status.ValidationErrors) > 0 {
+			d.Println()
+			d.Printf("Validation errors:")
+			for _, ve := range restore.Status.ValidationErrors {
+				d.Printf("\t%s\n", color.RedString(ve))
+			}
+		}
+
+		if restore.Status.FailureReason != "" {
+			d.Printf("Failure Reason:\t%s\n", restore.Status.FailureReason)
+		}
+
+		if restore.Status.Warnings > 0 || restore.Status.Errors > 0 {
+			d.Printf("Warnings:\t%d (see the Velero server log for details)\n", restore.Status.Warnings)
+			d.Printf("Errors:\t%d (see the Velero server log for details)\n", restore.Status.Errors)
+		}
+
+		result := restore.Status.DryRunResult
+		if result == nil {
+			return
+		}
+
+		d.Println()
+		if len(result.Items) == 0 {
+			d.Printf("Items:\t<none>\n")
+			return
+		}
+
+		counts := map[velerov1api.RestoreDryRunItemState]int{}
+		d.Println("Items (+ missing in the cluster, ~ changed in the cluster, = same as the cluster):")
+		for _, item := range result.Items {
+			counts[item.State]++
+
+			name := item.Name
+			if item.Namespace != "" {
+				name = fmt.Sprintf("%s/%s", item.Namespace, item.Name)
+			}
+			d.Printf("\t%s %s %s\n", dryRunItemMarks[item.State], item.Resource, name)
+			for _, field := range item.ChangedFields {
+				d.Printf("\t\t%s\n", field)
+			}
+		}
+
+		d.Println()
+		d.Printf("Summary:\t%d missing, %d changed, %d same\n", counts[velerov1api.RestoreDryRunItemStateMissing],
+			counts[velerov1api.RestoreDryRunItemStateChanged], counts[velerov1api.RestoreDryRunItemStateExists])
+	})
+}
+
 func describeRestoreItemOperations(ctx context.Context, kbClient kbclient.Client, d *Describer, restore *velerov1api.Restore, details bool, insecureSkipTLSVerify bool, caCertPath string) {
 	status := restore.Status
 	if status.RestoreItemOperationsAttempted > 0 {
@@ -254,6 +402,20 @@ func describeResult(d *Describer, name string, result results.Result) {
 			d.DescribeSlice(2, ns, warnings)
 		}
 	}
+	if len(result.Categories) > 0 {
+		d.Printf("\tCategories: %s\n", formatErrorCategories(result.CategoryCounts()))
+	}
+}
+
+// formatErrorCategories formats the counts of the error categories as "<category>=<count>" sorted
+// by category.
+func formatErrorCategories(categories map[string]int) string {
+	var parts []string
+	for category, count := range categories {
+		parts = append(parts, fmt.Sprintf("%s=%d", category, count))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ", ")
 }
 
 func describeRestoreItemOperation(d *Describer, operation *itemoperation.RestoreOperation) {
@@ -264,11 +426,8 @@ func describeRestoreItemOperation(d *Describer, operation *itemoperation.Restore
 	if operation.Status.Error != "" {
 		d.Printf("\t\tOperation Error:\t%s\n", operation.Status.Error)
 	}
-	if operation.Status.NTotal > 0 || operation.Status.NCompleted > 0 {
-		d.Printf("\t\tProgress:\t%v of %v complete (%s)\n",
-			operation.Status.NCompleted,
-			operation.Status.NTotal,
-			operation.Status.OperationUnits)
+	if progress := ItemOperationProgress(operation.Status); progress != "" {
+		d.Printf("\t\tProgress:\t%s\n", progress)
 	}
 	if operation.Status.Description != "" {
 		d.Printf("\t\tProgress description:\t%s\n", operation.Status.Description)
@@ -387,3 +546,19 @@ func describeRestoreResourceList(ctx context.Context, kbClient kbclient.Client,
 		d.Printf("\t%s:\n\t\t- %s\n", gvk, strings.Join(resourceList[gvk], "\n\t\t- "))
 	}
 }
+
+func existingResourcePoliciesToMap(policies map[string]velerov1api.PolicyType) map[string]string {
+	m := make(map[string]string, len(policies))
+	for resource, policy := range policies {
+		m[resource] = string(policy)
+	}
+	return m
+}
+
+func pvcSizesToMap(sizes map[string]resource.Quantity) map[string]string {
+	m := make(map[string]string, len(sizes))
+	for pvc, size := range sizes {
+		m[pvc] = size.String()
+	}
+	return m
+}
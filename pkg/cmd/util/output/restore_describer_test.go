@@ -56,6 +56,23 @@ func TestDescribeResult(t *testing.T) {
   Namespaces:
     ns-1:  ns-1-warn-1
            ns-1-warn-2
+`,
+		},
+		{
+			name:      "result with categories",
+			inputName: "restore-3",
+			inputResult: results.Result{
+				Velero:     []string{"velero-msg-1"},
+				Namespaces: map[string][]string{"ns-1": {"ns-1-err-1", "ns-1-err-2"}},
+				Categories: map[results.ErrorCategory]int{results.ErrorCategoryTimeout: 1, results.ErrorCategoryAPIConflict: 2},
+			},
+			expect: `restore-3:
+  Velero:   velero-msg-1
+  Cluster:    <none>
+  Namespaces:
+    ns-1:  ns-1-err-1
+           ns-1-err-2
+  Categories: apiConflict=2, timeout=1
 `,
 		},
 	}
@@ -74,6 +91,65 @@ func TestDescribeResult(t *testing.T) {
 	}
 }
 
+func TestDescribeRestoreDryRun(t *testing.T) {
+	testcases := []struct {
+		name    string
+		restore *velerov1api.Restore
+		expect  string
+	}{
+		{
+			name:    "dry-run completed",
+			restore: builder.ForRestore("velero", "restore-1").Phase(velerov1api.RestorePhaseDryRunCompleted).Result(),
+			expect: `Phase:  DryRunCompleted
+
+Items (+ missing in the cluster, ~ changed in the cluster, = same as the cluster):
+  + namespaces ns-2
+  ~ persistentvolumeclaims ns-1/pvc-1
+    spec.resources.requests.storage
+    spec.storageClassName
+  = pods ns-1/pod-1
+
+Summary:  1 missing, 1 changed, 1 same
+`,
+		},
+		{
+			name:    "dry-run completed with errors and without items",
+			restore: builder.ForRestore("velero", "restore-1").Phase(velerov1api.RestorePhaseDryRunCompleted).Result(),
+			expect: `Phase:     DryRunCompleted
+Warnings:  0 (see the Velero server log for details)
+Errors:    1 (see the Velero server log for details)
+
+Items:  <none>
+`,
+		},
+		{
+			name:    "dry-run failed",
+			restore: builder.ForRestore("velero", "restore-1").Phase(velerov1api.RestorePhaseFailed).Result(),
+			expect: `Phase:           Failed
+Failure Reason:  some error
+`,
+		},
+	}
+
+	testcases[0].restore.Status.DryRunResult = &velerov1api.RestoreDryRunResult{
+		Items: []velerov1api.RestoreDryRunItem{
+			{Resource: "namespaces", Name: "ns-2", State: velerov1api.RestoreDryRunItemStateMissing},
+			{Resource: "persistentvolumeclaims", Namespace: "ns-1", Name: "pvc-1", State: velerov1api.RestoreDryRunItemStateChanged,
+				ChangedFields: []string{"spec.resources.requests.storage", "spec.storageClassName"}},
+			{Resource: "pods", Namespace: "ns-1", Name: "pod-1", State: velerov1api.RestoreDryRunItemStateExists},
+		},
+	}
+	testcases[1].restore.Status.Errors = 1
+	testcases[1].restore.Status.DryRunResult = &velerov1api.RestoreDryRunResult{}
+	testcases[2].restore.Status.FailureReason = "some error"
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, DescribeRestoreDryRun(tc.restore))
+		})
+	}
+}
+
 func TestDescribeRestoreItemOperation(t *testing.T) {
 	t1, err1 := time.Parse("2006-Jan-02", "2023-Jun-26")
 	require.Nil(t, err1)
@@ -100,7 +176,7 @@ func TestDescribeRestoreItemOperation(t *testing.T) {
     Operation ID:                op-1
     Phase:                       Failed
     Operation Error:             operation error
-    Progress:                    50 of 100 complete (bytes)
+    Progress:                    50 of 100 complete (50%)
     Progress description:        operation description
     Created:                     2023-06-24 00:00:00 +0000 UTC
     Started:                     2023-06-25 00:00:00 +0000 UTC
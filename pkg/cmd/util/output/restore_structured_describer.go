@@ -0,0 +1,391 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// DescribeRestoreInSF describes a restore in structured format.
+func DescribeRestoreInSF(
+	ctx context.Context,
+	kbClient kbclient.Client,
+	restore *velerov1api.Restore,
+	podVolumeRestores []velerov1api.PodVolumeRestore,
+	details bool,
+	insecureSkipTLSVerify bool,
+	caCertFile string,
+	outputFormat string,
+) string {
+	return DescribeInSF(func(d *StructuredDescriber) {
+		d.DescribeMetadata(restore.ObjectMeta)
+
+		phase := restore.Status.Phase
+		if phase == "" {
+			phase = velerov1api.RestorePhaseNew
+		}
+		d.Describe("phase", phase)
+
+		if len(restore.Status.ValidationErrors) > 0 {
+			d.Describe("validationErrors", restore.Status.ValidationErrors)
+		}
+
+		DescribeRestoreResultsInSF(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
+
+		DescribeRestoreSpecInSF(d, restore.Spec)
+
+		DescribeRestoreStatusInSF(ctx, kbClient, d, restore, details, insecureSkipTLSVerify, caCertFile)
+
+		if len(podVolumeRestores) > 0 {
+			DescribePodVolumeRestoresInSF(d, podVolumeRestores, details)
+		}
+	}, outputFormat)
+}
+
+// DescribeRestoreSpecInSF describes a restore spec in structured format.
+func DescribeRestoreSpecInSF(d *StructuredDescriber, spec velerov1api.RestoreSpec) {
+	restoreSpecInfo := make(map[string]interface{})
+	var s string
+
+	restoreSpecInfo["backup"] = spec.BackupName
+
+	// describe namespaces
+	namespaceInfo := make(map[string]string)
+	if len(spec.IncludedNamespaces) == 0 || (len(spec.IncludedNamespaces) == 1 && spec.IncludedNamespaces[0] == "*") {
+		s = "all namespaces found in the backup"
+	} else {
+		s = strings.Join(spec.IncludedNamespaces, ", ")
+	}
+	namespaceInfo["included"] = s
+	if len(spec.ExcludedNamespaces) == 0 {
+		s = emptyDisplay
+	} else {
+		s = strings.Join(spec.ExcludedNamespaces, ", ")
+	}
+	namespaceInfo["excluded"] = s
+	restoreSpecInfo["namespaces"] = namespaceInfo
+
+	// describe resources
+	resourcesInfo := make(map[string]string)
+	if len(spec.IncludedResources) == 0 {
+		s = "*"
+	} else {
+		s = strings.Join(spec.IncludedResources, ", ")
+	}
+	resourcesInfo["included"] = s
+	if len(spec.ExcludedResources) == 0 {
+		s = emptyDisplay
+	} else {
+		s = strings.Join(spec.ExcludedResources, ", ")
+	}
+	resourcesInfo["excluded"] = s
+	resourcesInfo["clusterScoped"] = BoolPointerString(spec.IncludeClusterResources, "excluded", "included", "auto")
+	restoreSpecInfo["resources"] = resourcesInfo
+
+	// describe mappings
+	if len(spec.NamespaceMapping) > 0 {
+		restoreSpecInfo["namespaceMappings"] = spec.NamespaceMapping
+	}
+	if len(spec.StorageClassMappings) > 0 {
+		restoreSpecInfo["storageClassMappings"] = spec.StorageClassMappings
+	}
+	if len(spec.ZoneMappings) > 0 {
+		restoreSpecInfo["zoneMappings"] = spec.ZoneMappings
+	}
+
+	// describe label selectors
+	s = emptyDisplay
+	if spec.LabelSelector != nil {
+		s = metav1.FormatLabelSelector(spec.LabelSelector)
+	}
+	restoreSpecInfo["labelSelector"] = s
+
+	if len(spec.OrLabelSelectors) > 0 {
+		orLabelSelectors := make([]string, 0, len(spec.OrLabelSelectors))
+		for _, v := range spec.OrLabelSelectors {
+			orLabelSelectors = append(orLabelSelectors, metav1.FormatLabelSelector(v))
+		}
+		restoreSpecInfo["orLabelSelectors"] = orLabelSelectors
+	}
+
+	restoreSpecInfo["restorePVs"] = BoolPointerString(spec.RestorePVs, "false", "true", "auto")
+
+	s = emptyDisplay
+	if spec.ExistingResourcePolicy != "" {
+		s = string(spec.ExistingResourcePolicy)
+	}
+	restoreSpecInfo["existingResourcePolicy"] = s
+	if len(spec.ExistingResourcePolicies) > 0 {
+		restoreSpecInfo["existingResourcePolicies"] = existingResourcePoliciesToMap(spec.ExistingResourcePolicies)
+	}
+	restoreSpecInfo["itemOperationTimeout"] = spec.ItemOperationTimeout.Duration.String()
+	restoreSpecInfo["preserveServiceNodePorts"] = BoolPointerString(spec.PreserveNodePorts, "false", "true", "auto")
+	if boolptr.IsSetToTrue(spec.StrictQuota) {
+		restoreSpecInfo["strictQuota"] = "true"
+	}
+
+	if spec.PVCSizeOverrides != nil {
+		pvcSizeOverridesInfo := map[string]interface{}{
+			"growthPercentage": spec.PVCSizeOverrides.GrowthPercentage,
+		}
+		if len(spec.PVCSizeOverrides.Sizes) > 0 {
+			pvcSizeOverridesInfo["sizes"] = pvcSizesToMap(spec.PVCSizeOverrides.Sizes)
+		}
+		restoreSpecInfo["pvcSizeOverrides"] = pvcSizeOverridesInfo
+	}
+
+	if spec.ItemRetryPolicy != nil {
+		itemRetryPolicyInfo := map[string]interface{}{
+			"maxRetries": spec.ItemRetryPolicy.MaxRetries,
+		}
+		if spec.ItemRetryPolicy.InitialBackoff.Duration > 0 {
+			itemRetryPolicyInfo["initialBackoff"] = spec.ItemRetryPolicy.InitialBackoff.Duration.String()
+		}
+		if spec.ItemRetryPolicy.MaxBackoff.Duration > 0 {
+			itemRetryPolicyInfo["maxBackoff"] = spec.ItemRetryPolicy.MaxBackoff.Duration.String()
+		}
+		restoreSpecInfo["itemRetryPolicy"] = itemRetryPolicyInfo
+	}
+
+	if spec.SecretEncryption != nil {
+		secretEncryptionInfo := map[string]interface{}{
+			"sensitiveTypes":         "all",
+			"allowUnencryptedTarget": spec.SecretEncryption.AllowUnencryptedTarget,
+		}
+		if len(spec.SecretEncryption.SensitiveTypes) > 0 {
+			secretEncryptionInfo["sensitiveTypes"] = strings.Join(spec.SecretEncryption.SensitiveTypes, ", ")
+		}
+		restoreSpecInfo["secretEncryption"] = secretEncryptionInfo
+	}
+
+	if spec.Scaling != nil {
+		scalingInfo := map[string]interface{}{
+			"replicas":          spec.Scaling.Replicas,
+			"includedResources": "deployments, statefulsets",
+		}
+		if len(spec.Scaling.IncludedResources) > 0 {
+			scalingInfo["includedResources"] = strings.Join(spec.Scaling.IncludedResources, ", ")
+		}
+		restoreSpecInfo["scaling"] = scalingInfo
+	}
+
+	if spec.ReadinessGates != nil {
+		readinessGatesInfo := map[string]interface{}{
+			"includedResources": "customresourcedefinitions, namespaces, mutatingwebhookconfigurations, validatingwebhookconfigurations",
+		}
+		if len(spec.ReadinessGates.IncludedResources) > 0 {
+			readinessGatesInfo["includedResources"] = strings.Join(spec.ReadinessGates.IncludedResources, ", ")
+		}
+		if spec.ReadinessGates.Timeout.Duration > 0 {
+			readinessGatesInfo["timeout"] = spec.ReadinessGates.Timeout.Duration.String()
+		}
+		restoreSpecInfo["readinessGates"] = readinessGatesInfo
+	}
+
+	d.Describe("spec", restoreSpecInfo)
+}
+
+// DescribeRestoreStatusInSF describes a restore status in structured format.
+func DescribeRestoreStatusInSF(ctx context.Context, kbClient kbclient.Client, d *StructuredDescriber, restore *velerov1api.Restore, details bool, insecureSkipTLSVerify bool, caCertPath string) {
+	status := restore.Status
+	restoreStatusInfo := make(map[string]interface{})
+
+	// "<n/a>" output should only be applicable for restore that failed validation
+	if status.StartTimestamp == nil || status.StartTimestamp.IsZero() {
+		restoreStatusInfo["started"] = "<n/a>"
+	} else {
+		restoreStatusInfo["started"] = status.StartTimestamp.Time.String()
+	}
+	if status.CompletionTimestamp == nil || status.CompletionTimestamp.IsZero() {
+		restoreStatusInfo["completed"] = "<n/a>"
+	} else {
+		restoreStatusInfo["completed"] = status.CompletionTimestamp.Time.String()
+	}
+
+	if status.Progress != nil {
+		if status.Phase == velerov1api.RestorePhaseInProgress {
+			restoreStatusInfo["estimatedTotalItemsToBeRestored"] = status.Progress.TotalItems
+			restoreStatusInfo["itemsRestoredSoFar"] = status.Progress.ItemsRestored
+			if status.Progress.ItemsWaitingForReadiness > 0 {
+				restoreStatusInfo["itemsWaitingForReadiness"] = status.Progress.ItemsWaitingForReadiness
+			}
+		} else {
+			restoreStatusInfo["totalItemsToBeRestored"] = status.Progress.TotalItems
+			restoreStatusInfo["itemsRestored"] = status.Progress.ItemsRestored
+		}
+	}
+
+	describeRestoreItemOperationsInSF(ctx, kbClient, restoreStatusInfo, restore, details, insecureSkipTLSVerify, caCertPath)
+
+	if details {
+		describeRestoreResourceListInSF(ctx, kbClient, restoreStatusInfo, restore, insecureSkipTLSVerify, caCertPath)
+	}
+
+	d.Describe("status", restoreStatusInfo)
+}
+
+func describeRestoreItemOperationsInSF(ctx context.Context, kbClient kbclient.Client, restoreStatusInfo map[string]interface{}, restore *velerov1api.Restore, details bool, insecureSkipTLSVerify bool, caCertPath string) {
+	status := restore.Status
+	if status.RestoreItemOperationsAttempted == 0 {
+		return
+	}
+
+	restoreStatusInfo["restoreItemOperations"] = map[string]int{
+		"attempted": status.RestoreItemOperationsAttempted,
+		"completed": status.RestoreItemOperationsCompleted,
+		"failed":    status.RestoreItemOperationsFailed,
+	}
+	if !details {
+		return
+	}
+
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreItemOperations, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		restoreStatusInfo["errorGettingRestoreItemOperations"] = fmt.Sprintf("<error getting operation info: %v>", err)
+		return
+	}
+
+	var operations []*itemoperation.RestoreOperation
+	if err := json.NewDecoder(buf).Decode(&operations); err != nil {
+		restoreStatusInfo["errorGettingRestoreItemOperations"] = fmt.Sprintf("<error reading operation info: %v>", err)
+		return
+	}
+
+	operationsDetail := make([]map[string]interface{}, 0, len(operations))
+	for _, operation := range operations {
+		operationInfo := describeItemOperationStatusInSF(operation.Status)
+		operationInfo["resource"] = fmt.Sprintf("%s %s/%s", operation.Spec.ResourceIdentifier, operation.Spec.ResourceIdentifier.Namespace, operation.Spec.ResourceIdentifier.Name)
+		operationInfo["restoreItemAction"] = operation.Spec.RestoreItemAction
+		operationInfo["operationID"] = operation.Spec.OperationID
+		operationsDetail = append(operationsDetail, operationInfo)
+	}
+	restoreStatusInfo["restoreItemOperationsDetail"] = operationsDetail
+}
+
+func describeRestoreResourceListInSF(ctx context.Context, kbClient kbclient.Client, restoreStatusInfo map[string]interface{}, restore *velerov1api.Restore, insecureSkipTLSVerify bool, caCertPath string) {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreResourceList, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			restoreStatusInfo["errorGettingResourceList"] = "<restore resource list not found>"
+		} else {
+			restoreStatusInfo["errorGettingResourceList"] = fmt.Sprintf("<error getting restore resource list: %v>", err)
+		}
+		return
+	}
+
+	var resourceList map[string][]string
+	if err := json.NewDecoder(buf).Decode(&resourceList); err != nil {
+		restoreStatusInfo["errorGettingResourceList"] = fmt.Sprintf("<error reading restore resource list: %v>", err)
+		return
+	}
+	restoreStatusInfo["resourceList"] = resourceList
+}
+
+// DescribeRestoreResultsInSF describes errors and warnings of a restore in structured format.
+func DescribeRestoreResultsInSF(ctx context.Context, kbClient kbclient.Client, d *StructuredDescriber, restore *velerov1api.Restore, insecureSkipTLSVerify bool, caCertPath string) {
+	if restore.Status.Warnings == 0 && restore.Status.Errors == 0 {
+		return
+	}
+
+	var buf bytes.Buffer
+	var resultMap map[string]results.Result
+
+	errors, warnings := make(map[string]interface{}), make(map[string]interface{})
+	defer func() {
+		d.Describe("errors", errors)
+		d.Describe("warnings", warnings)
+	}()
+
+	if err := downloadrequest.Stream(ctx, kbClient, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreResults, &buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		errors["errorGettingErrors"] = fmt.Sprintf("<error getting errors: %v>", err)
+		warnings["errorGettingWarnings"] = fmt.Sprintf("<error getting warnings: %v>", err)
+		return
+	}
+
+	if err := json.NewDecoder(&buf).Decode(&resultMap); err != nil {
+		errors["errorGettingErrors"] = fmt.Sprintf("<error decoding errors: %v>", err)
+		warnings["errorGettingWarnings"] = fmt.Sprintf("<error decoding warnings: %v>", err)
+		return
+	}
+
+	if restore.Status.Warnings > 0 {
+		describeResultInSF(warnings, resultMap["warnings"])
+	}
+	if restore.Status.Errors > 0 {
+		describeResultInSF(errors, resultMap["errors"])
+	}
+}
+
+// DescribePodVolumeRestoresInSF describes pod volume restores in structured format.
+func DescribePodVolumeRestoresInSF(d *StructuredDescriber, restores []velerov1api.PodVolumeRestore, details bool) {
+	// Get the type of pod volume uploader. Since the uploader only comes from a single source, we can
+	// take the uploader type from the first element of the array.
+	if len(restores) == 0 {
+		return
+	}
+	podVolumeRestoresInfo := make(map[string]interface{})
+	podVolumeRestoresInfo["type"] = restores[0].Spec.UploaderType
+
+	podVolumeRestoresDetails := make(map[string]interface{})
+	// separate restores by phase (combining <none> and New into a single group)
+	restoresByPhase := groupRestoresByPhase(restores)
+
+	// go through phases in a specific order
+	for _, phase := range []string{
+		string(velerov1api.PodVolumeRestorePhaseCompleted),
+		string(velerov1api.PodVolumeRestorePhaseFailed),
+		"In Progress",
+		string(velerov1api.PodVolumeRestorePhaseNew),
+	} {
+		if len(restoresByPhase[phase]) == 0 {
+			continue
+		}
+		// if we're not printing details, just report the phase and count
+		if !details {
+			podVolumeRestoresDetails[phase] = len(restoresByPhase[phase])
+			continue
+		}
+		// group the restores in the current phase by pod (i.e. "ns/name")
+		restoresByPod := new(volumesByPod)
+		for _, restore := range restoresByPhase[phase] {
+			restoresByPod.Add(restore.Spec.Pod.Namespace, restore.Spec.Pod.Name, restore.Spec.Volume, phase, restore.Status.Progress)
+		}
+
+		restoresByPods := make([]map[string]string, 0)
+		for _, restoreGroup := range restoresByPod.Sorted() {
+			sort.Strings(restoreGroup.volumes)
+			restoresByPods = append(restoresByPods, map[string]string{restoreGroup.label: strings.Join(restoreGroup.volumes, ", ")})
+		}
+		podVolumeRestoresDetails[phase] = restoresByPods
+	}
+	podVolumeRestoresInfo["podVolumeRestoresDetails"] = podVolumeRestoresDetails
+	d.Describe("podVolumeRestores", podVolumeRestoresInfo)
+}
@@ -0,0 +1,170 @@
+package output
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestDescribeRestoreSpecInSF(t *testing.T) {
+	restore := builder.ForRestore("velero", "restore-1").
+		Backup("backup-1").
+		IncludedNamespaces("inc-ns-1", "inc-ns-2").
+		ExcludedResources("exc-res-1").
+		NamespaceMappings("ns-1", "ns-2").
+		LabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"a": "b"}}).
+		OrLabelSelector([]*metav1.LabelSelector{
+			{MatchLabels: map[string]string{"c": "d"}},
+			{MatchLabels: map[string]string{"e": "f"}},
+		}).
+		RestorePVs(true).
+		ExistingResourcePolicy("update").
+		ExistingResourcePolicies(map[string]velerov1api.PolicyType{"secrets": velerov1api.PolicyTypeNone}).
+		ItemOperationTimeout(time.Hour).
+		Scaling(0, "deployments").
+		ReadinessGates(time.Minute, "namespaces").
+		StrictQuota(true).
+		SecretEncryption(false, "kubernetes.io/tls", "Opaque").
+		Result()
+
+	expect := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"backup": "backup-1",
+			"namespaces": map[string]string{
+				"included": "inc-ns-1, inc-ns-2",
+				"excluded": "<none>",
+			},
+			"resources": map[string]string{
+				"included":      "*",
+				"excluded":      "exc-res-1",
+				"clusterScoped": "auto",
+			},
+			"namespaceMappings":        map[string]string{"ns-1": "ns-2"},
+			"labelSelector":            "a=b",
+			"orLabelSelectors":         []string{"c=d", "e=f"},
+			"restorePVs":               "true",
+			"existingResourcePolicy":   "update",
+			"existingResourcePolicies": map[string]string{"secrets": "none"},
+			"itemOperationTimeout":     "1h0m0s",
+			"preserveServiceNodePorts": "auto",
+			"strictQuota":              "true",
+			"scaling": map[string]interface{}{
+				"replicas":          int32(0),
+				"includedResources": "deployments",
+			},
+			"readinessGates": map[string]interface{}{
+				"includedResources": "namespaces",
+				"timeout":           "1m0s",
+			},
+			"secretEncryption": map[string]interface{}{
+				"sensitiveTypes":         "kubernetes.io/tls, Opaque",
+				"allowUnencryptedTarget": false,
+			},
+		},
+	}
+
+	sd := NewStructuredDescriber("")
+	DescribeRestoreSpecInSF(sd, restore.Spec)
+	assert.Equal(t, expect, sd.output)
+}
+
+func TestDescribeRestoreStatusInSF(t *testing.T) {
+	restore := builder.ForRestore("velero", "restore-1").
+		Phase(velerov1api.RestorePhaseInProgress).
+		StartTimestamp(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)).
+		Result()
+	restore.Status.Progress = &velerov1api.RestoreProgress{TotalItems: 10, ItemsRestored: 3, ItemsWaitingForReadiness: 1}
+	restore.Status.RestoreItemOperationsAttempted = 2
+	restore.Status.RestoreItemOperationsCompleted = 1
+
+	expect := map[string]interface{}{
+		"status": map[string]interface{}{
+			"started":                         "2023-01-01 00:00:00 +0000 UTC",
+			"completed":                       "<n/a>",
+			"estimatedTotalItemsToBeRestored": 10,
+			"itemsRestoredSoFar":              3,
+			"itemsWaitingForReadiness":        1,
+			"restoreItemOperations": map[string]int{
+				"attempted": 2,
+				"completed": 1,
+				"failed":    0,
+			},
+		},
+	}
+
+	sd := NewStructuredDescriber("")
+	DescribeRestoreStatusInSF(nil, nil, sd, restore, false, false, "")
+	assert.Equal(t, expect, sd.output)
+}
+
+func TestDescribePodVolumeRestoresInSF(t *testing.T) {
+	pvr1 := builder.ForPodVolumeRestore("velero", "pvr-1").
+		UploaderType("kopia").
+		Phase(velerov1api.PodVolumeRestorePhaseCompleted).
+		Volume("vol-1").
+		PodName("pod-1").
+		PodNamespace("pod-ns-1").Result()
+	pvr2 := builder.ForPodVolumeRestore("velero", "pvr-2").
+		UploaderType("kopia").
+		Phase(velerov1api.PodVolumeRestorePhaseFailed).
+		Volume("vol-2").
+		PodName("pod-2").
+		PodNamespace("pod-ns-1").Result()
+
+	testcases := []struct {
+		name         string
+		inputPVRList []velerov1api.PodVolumeRestore
+		inputDetails bool
+		expect       map[string]interface{}
+	}{
+		{
+			name:         "empty list",
+			inputPVRList: []velerov1api.PodVolumeRestore{},
+			expect:       map[string]interface{}{},
+		},
+		{
+			name:         "without details",
+			inputPVRList: []velerov1api.PodVolumeRestore{*pvr1, *pvr2},
+			expect: map[string]interface{}{
+				"podVolumeRestores": map[string]interface{}{
+					"podVolumeRestoresDetails": map[string]interface{}{
+						"Completed": 1,
+						"Failed":    1,
+					},
+					"type": "kopia",
+				},
+			},
+		},
+		{
+			name:         "with details",
+			inputPVRList: []velerov1api.PodVolumeRestore{*pvr1, *pvr2},
+			inputDetails: true,
+			expect: map[string]interface{}{
+				"podVolumeRestores": map[string]interface{}{
+					"podVolumeRestoresDetails": map[string]interface{}{
+						"Completed": []map[string]string{
+							{"pod-ns-1/pod-1": "vol-1"},
+						},
+						"Failed": []map[string]string{
+							{"pod-ns-1/pod-2": "vol-2"},
+						},
+					},
+					"type": "kopia",
+				},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			sd := NewStructuredDescriber("")
+			DescribePodVolumeRestoresInSF(sd, tc.inputPVRList, tc.inputDetails)
+			assert.True(tt, reflect.DeepEqual(sd.output, tc.expect))
+		})
+	}
+}
@@ -45,6 +45,7 @@ Backup Template:
   Velero-Native Snapshot PVs:  auto
   Snapshot Move Data:          auto
   Data Mover:                  velero
+  Uploader Type:               <none>
   
   TTL:  0s
   
@@ -91,6 +92,7 @@ Backup Template:
   Velero-Native Snapshot PVs:  auto
   Snapshot Move Data:          auto
   Data Mover:                  velero
+  Uploader Type:               <none>
   
   TTL:  0s
   
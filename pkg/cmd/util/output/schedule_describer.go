@@ -70,6 +70,9 @@ func DescribeSchedule(schedule *v1.Schedule) string {
 
 func DescribeScheduleSpec(d *Describer, spec v1.ScheduleSpec) {
 	d.Printf("Schedule:\t%s\n", spec.Schedule)
+	if spec.ConcurrencyPolicy != "" {
+		d.Printf("Concurrency Policy:\t%s\n", spec.ConcurrencyPolicy)
+	}
 
 	d.Println()
 	d.Println("Backup Template:")
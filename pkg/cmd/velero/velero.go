@@ -28,6 +28,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/debug"
 
 	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/audit"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/backup"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/backuplocation"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/bug"
@@ -40,6 +41,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/install"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/plugin"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/repo"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/repomaintenance"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/restore"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/schedule"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/snapshotlocation"
@@ -118,10 +120,12 @@ operations can also be performed as 'velero backup get' and 'velero schedule cre
 		cliclient.NewCommand(),
 		completion.NewCommand(),
 		repo.NewCommand(f),
+		repomaintenance.NewCommand(f),
 		bug.NewCommand(),
 		backuplocation.NewCommand(f),
 		snapshotlocation.NewCommand(f),
 		debug.NewCommand(f),
+		audit.NewCommand(f),
 	)
 
 	// init and add the klog flags
@@ -18,9 +18,12 @@ package controller
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -34,6 +37,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -42,15 +46,22 @@ import (
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	"github.com/vmware-tanzu/velero/internal/storage"
+	"github.com/vmware-tanzu/velero/internal/validationpolicies"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/audit"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/backupwindow"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/notification"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/secretpolicy"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
@@ -62,6 +73,9 @@ import (
 
 const (
 	backupResyncPeriod = time.Minute
+
+	// backupLogChunkInterval is how often the log of a backup in progress is uploaded in chunks
+	backupLogChunkInterval = 30 * time.Second
 )
 
 type backupReconciler struct {
@@ -78,7 +92,7 @@ type backupReconciler struct {
 	defaultVolumesToFsBackup    bool
 	defaultBackupTTL            time.Duration
 	defaultCSISnapshotTimeout   time.Duration
-	resourceTimeout             time.Duration
+	csiSnapshotCreateTimeout    time.Duration
 	defaultItemOperationTimeout time.Duration
 	defaultSnapshotLocations    map[string]string
 	metrics                     *metrics.ServerMetrics
@@ -89,6 +103,11 @@ type backupReconciler struct {
 	credentialFileStore         credentials.FileStore
 	maxConcurrentK8SConnections int
 	defaultSnapshotMoveData     bool
+	notifier                    *notification.Dispatcher
+	backupWindowConfigMap       string
+	secretPolicyConfigMap       string
+	volumeGroupSnapshotLabelKey string
+	auditRecorder               *audit.Recorder
 }
 
 func NewBackupReconciler(
@@ -104,7 +123,7 @@ func NewBackupReconciler(
 	defaultVolumesToFsBackup bool,
 	defaultBackupTTL time.Duration,
 	defaultCSISnapshotTimeout time.Duration,
-	resourceTimeout time.Duration,
+	csiSnapshotCreateTimeout time.Duration,
 	defaultItemOperationTimeout time.Duration,
 	defaultSnapshotLocations map[string]string,
 	metrics *metrics.ServerMetrics,
@@ -114,6 +133,11 @@ func NewBackupReconciler(
 	credentialStore credentials.FileStore,
 	maxConcurrentK8SConnections int,
 	defaultSnapshotMoveData bool,
+	notifier *notification.Dispatcher,
+	backupWindowConfigMap string,
+	secretPolicyConfigMap string,
+	volumeGroupSnapshotLabelKey string,
+	auditRecorder *audit.Recorder,
 ) *backupReconciler {
 	b := &backupReconciler{
 		ctx:                         ctx,
@@ -129,7 +153,7 @@ func NewBackupReconciler(
 		defaultVolumesToFsBackup:    defaultVolumesToFsBackup,
 		defaultBackupTTL:            defaultBackupTTL,
 		defaultCSISnapshotTimeout:   defaultCSISnapshotTimeout,
-		resourceTimeout:             resourceTimeout,
+		csiSnapshotCreateTimeout:    csiSnapshotCreateTimeout,
 		defaultItemOperationTimeout: defaultItemOperationTimeout,
 		defaultSnapshotLocations:    defaultSnapshotLocations,
 		metrics:                     metrics,
@@ -139,6 +163,11 @@ func NewBackupReconciler(
 		credentialFileStore:         credentialStore,
 		maxConcurrentK8SConnections: maxConcurrentK8SConnections,
 		defaultSnapshotMoveData:     defaultSnapshotMoveData,
+		notifier:                    notifier,
+		backupWindowConfigMap:       backupWindowConfigMap,
+		secretPolicyConfigMap:       secretPolicyConfigMap,
+		volumeGroupSnapshotLabelKey: volumeGroupSnapshotLabelKey,
+		auditRecorder:               auditRecorder,
 	}
 	b.updateTotalBackupMetric()
 	return b
@@ -147,6 +176,7 @@ func NewBackupReconciler(
 func (b *backupReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&velerov1api.Backup{}).
+		WithOptions(controllerOptions(Backup)).
 		Complete(b)
 }
 
@@ -241,6 +271,17 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, nil
 	}
 
+	// the dry-run backups don't back up anything, so they aren't queued for the backup window
+	if !boolptr.IsSetToTrue(original.Spec.DryRun) {
+		window, err := backupwindow.Get(ctx, b.kbClient, original.Namespace, b.backupWindowConfigMap)
+		if err != nil {
+			log.WithError(err).Warn("Failed to get the backup window, the backup isn't queued for it")
+		} else if interval := window.QueueInterval(b.clock.Now()); interval > 0 {
+			log.Debugf("Backup is queued until the backup window %s opens", window)
+			return ctrl.Result{RequeueAfter: interval}, nil
+		}
+	}
+
 	log.Debug("Preparing backup request")
 	request := b.prepareBackupRequest(original, log)
 	if len(request.Status.ValidationErrors) > 0 {
@@ -263,10 +304,23 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		log.Debug("failed to validate backup status")
 		b.metrics.RegisterBackupValidationFailure(backupScheduleName)
 		b.metrics.RegisterBackupLastStatus(backupScheduleName, metrics.BackupLastStatusFailure)
+		b.notifier.Notify(notification.ForBackup(notification.EventBackupFailed, request.Backup))
+		b.auditRecorder.Record(request.Namespace, request.Spec.StorageLocation, audit.ForBackup(request.Backup))
 
 		return ctrl.Result{}, nil
 	}
 
+	if boolptr.IsSetToTrue(request.Spec.DryRun) {
+		log.Debug("Running backup dry-run")
+		b.runDryRun(request)
+
+		log.Info("Updating backup's dry-run status")
+		if err := kubeutil.PatchResource(original, request.Backup, b.kbClient); err != nil {
+			log.WithError(err).Error("error updating backup's dry-run status")
+		}
+		return ctrl.Result{}, nil
+	}
+
 	b.backupTracker.Add(request.Namespace, request.Name)
 	defer func() {
 		switch request.Status.Phase {
@@ -278,6 +332,8 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	log.Debug("Running backup")
 
 	b.metrics.RegisterBackupAttempt(backupScheduleName)
+	b.notifier.Notify(notification.ForBackup(notification.EventBackupStarted, request.Backup))
+	b.auditRecorder.Record(request.Namespace, request.Spec.StorageLocation, audit.ForBackup(request.Backup))
 
 	// execution & upload of backup
 	if err := b.runBackup(request); err != nil {
@@ -310,20 +366,25 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	if err := kubeutil.PatchResource(original, request.Backup, b.kbClient); err != nil {
 		log.WithError(err).Error("error updating backup's final status")
 	}
+	// the backups waiting for the async operations or finalizing are notified by the finalizer controller
+	if eventType, ok := notification.BackupEventType(request.Status.Phase); ok {
+		b.notifier.Notify(notification.ForBackup(eventType, request.Backup))
+	}
 	return ctrl.Result{}, nil
 }
 
 func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logger logrus.FieldLogger) *pkgbackup.Request {
 	request := &pkgbackup.Request{
-		Backup:           backup.DeepCopy(), // don't modify items in the cache
-		SkippedPVTracker: pkgbackup.NewSkipPVTracker(),
+		Backup:             backup.DeepCopy(), // don't modify items in the cache
+		SkippedPVTracker:   pkgbackup.NewSkipPVTracker(),
+		SkippedItemTracker: pkgbackup.NewSkipItemTracker(),
 	}
 
 	// set backup major version - deprecated, use Status.FormatVersion
 	request.Status.Version = pkgbackup.BackupVersion
 
-	// set backup major, minor, and patch version
-	request.Status.FormatVersion = pkgbackup.BackupFormatVersion
+	// set backup major, minor, and patch version, which depends on the compression algorithm of the backup tarball
+	request.Status.FormatVersion = pkgbackup.FormatVersion(request.Spec.CompressionAlgorithm)
 
 	if request.Spec.TTL.Duration == 0 {
 		// set default backup TTL
@@ -335,11 +396,42 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 		request.Spec.CSISnapshotTimeout.Duration = b.defaultCSISnapshotTimeout
 	}
 
+	if request.Spec.VolumeGroupSnapshotLabelKey == "" {
+		// set default label key of the volume groups
+		request.Spec.VolumeGroupSnapshotLabelKey = b.volumeGroupSnapshotLabelKey
+	}
+
 	if request.Spec.ItemOperationTimeout.Duration == 0 {
 		// set default item operation timeout
 		request.Spec.ItemOperationTimeout.Duration = b.defaultItemOperationTimeout
 	}
 
+	// apply the filters of the resource filter policy before the filters are validated
+	if request.Spec.ResourceFilterPolicy != "" {
+		policy := &velerov1api.ResourceFilterPolicy{}
+		if err := b.kbClient.Get(context.Background(), kbclient.ObjectKey{
+			Namespace: request.Namespace,
+			Name:      request.Spec.ResourceFilterPolicy,
+		}, policy); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("error getting resource filter policy %s: %v", request.Spec.ResourceFilterPolicy, err))
+		} else {
+			applyResourceFilterPolicy(&request.Spec, &policy.Spec)
+		}
+	}
+
+	// add the hooks of the backup hook policies selecting the backup
+	if err := b.applyBackupHookPolicies(request); err != nil {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, err.Error())
+	}
+
+	// the backup fails validation if the secret policy can't be loaded, rather than backing up
+	// the secrets the policy is supposed to exclude or redact
+	if policy, err := secretpolicy.Get(context.Background(), b.kbClient, request.Namespace, b.secretPolicyConfigMap); err != nil {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, err.Error())
+	} else {
+		request.SecretPolicy = policy
+	}
+
 	// calculate expiration
 	request.Status.Expiration = &metav1.Time{Time: b.clock.Now().Add(request.Spec.TTL.Duration)}
 
@@ -396,10 +488,35 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 	} else {
 		request.StorageLocation = storageLocation
 
-		if request.StorageLocation.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		if request.StorageLocation.Spec.IsReadOnly() {
 			request.Status.ValidationErrors = append(request.Status.ValidationErrors,
 				fmt.Sprintf("backup can't be created because backup storage location %s is currently in read-only mode", request.StorageLocation.Name))
 		}
+
+		// record the key that encrypts the backup data, the backup storage location's
+		// encryption key may be rotated later
+		if objectStorage := request.StorageLocation.Spec.ObjectStorage; objectStorage != nil && objectStorage.Encryption != nil {
+			request.Status.EncryptionKeyID = objectStorage.Encryption.KeyID
+		}
+	}
+
+	// validate the backup storage location the data mover snapshots are replicated to
+	if replicaLocation := request.Spec.SnapshotMoveReplicaLocation; replicaLocation != "" {
+		if replicaLocation == request.Spec.StorageLocation {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors,
+				fmt.Sprintf("snapshot move replica location %s can't be the storage location of the backup", replicaLocation))
+		} else {
+			replicaStorageLocation := &velerov1api.BackupStorageLocation{}
+			if err := b.kbClient.Get(context.Background(), kbclient.ObjectKey{
+				Namespace: request.Namespace,
+				Name:      replicaLocation,
+			}, replicaStorageLocation); err != nil {
+				request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("error getting snapshot move replica location %s: %v", replicaLocation, err))
+			} else if replicaStorageLocation.Spec.IsReadOnly() {
+				request.Status.ValidationErrors = append(request.Status.ValidationErrors,
+					fmt.Sprintf("snapshots can't be replicated because backup storage location %s is currently in read-only mode", replicaLocation))
+			}
+		}
 	}
 
 	// add the storage location as a label for easy filtering later.
@@ -427,7 +544,7 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 	request.Annotations[velerov1api.SourceClusterK8sGitVersionAnnotation] = b.discoveryHelper.ServerVersion().String()
 	request.Annotations[velerov1api.SourceClusterK8sMajorVersionAnnotation] = b.discoveryHelper.ServerVersion().Major
 	request.Annotations[velerov1api.SourceClusterK8sMinorVersionAnnotation] = b.discoveryHelper.ServerVersion().Minor
-	request.Annotations[velerov1api.ResourceTimeoutAnnotation] = b.resourceTimeout.String()
+	request.Annotations[velerov1api.ResourceTimeoutAnnotation] = b.csiSnapshotCreateTimeout.String()
 
 	// Add namespaces with label velero.io/exclude-from-backup=true into request.Spec.ExcludedNamespaces
 	// Essentially, adding the label velero.io/exclude-from-backup=true to a namespace would be equivalent to setting spec.ExcludedNamespaces
@@ -469,11 +586,32 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
 	}
 
+	// validate the uploader type if it's specified in the backup
+	if request.Spec.UploaderType != "" {
+		if err := uploader.ValidateUploaderType(request.Spec.UploaderType); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, err.Error())
+		}
+	}
+
+	// validate the compression algorithm if it's specified in the backup
+	if err := archive.ValidateCompressionAlgorithm(request.Spec.CompressionAlgorithm); err != nil {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, err.Error())
+	}
+
 	// validate that only one exists orLabelSelector or just labelSelector (singular)
 	if request.Spec.OrLabelSelectors != nil && request.Spec.LabelSelector != nil {
 		request.Status.ValidationErrors = append(request.Status.ValidationErrors, "encountered labelSelector as well as orLabelSelectors in backup spec, only one can be specified")
 	}
 
+	// validate the same for the cluster-scoped label selectors, which can't be used if cluster-scoped resources are excluded
+	if request.Spec.ClusterScopedOrLabelSelectors != nil && request.Spec.ClusterScopedLabelSelector != nil {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, "encountered clusterScopedLabelSelector as well as clusterScopedOrLabelSelectors in backup spec, only one can be specified")
+	}
+	if (request.Spec.ClusterScopedLabelSelector != nil || request.Spec.ClusterScopedOrLabelSelectors != nil) &&
+		request.Spec.IncludeClusterResources != nil && !*request.Spec.IncludeClusterResources {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, "clusterScopedLabelSelector and clusterScopedOrLabelSelectors can't be specified when includeClusterResources is false")
+	}
+
 	if request.Spec.ResourcePolicy != nil && strings.EqualFold(request.Spec.ResourcePolicy.Kind, resourcepolicies.ConfigmapRefType) {
 		policiesConfigmap := &corev1api.ConfigMap{}
 		err := b.kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: request.Namespace, Name: request.Spec.ResourcePolicy.Name}, policiesConfigmap)
@@ -489,9 +627,118 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 		request.ResPolicies = res
 	}
 
+	if request.Spec.ValidationPolicy != nil && strings.EqualFold(request.Spec.ValidationPolicy.Kind, validationpolicies.ConfigmapRefType) {
+		policiesConfigmap := &corev1api.ConfigMap{}
+		err := b.kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: request.Namespace, Name: request.Spec.ValidationPolicy.Name}, policiesConfigmap)
+		if err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("failed to get validation policies %s/%s configmap with err %v", request.Namespace, request.Spec.ValidationPolicy.Name, err))
+		} else if policies, err := validationpolicies.GetValidationPoliciesFromConfig(policiesConfigmap); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, errors.Wrapf(err, "validation policies %s/%s", request.Namespace, request.Spec.ValidationPolicy.Name).Error())
+		} else if err = policies.Validate(); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, errors.Wrapf(err, "validation policies %s/%s", request.Namespace, request.Spec.ValidationPolicy.Name).Error())
+		} else {
+			request.ValidationPolicies = policies
+		}
+	}
+
 	return request
 }
 
+// applyResourceFilterPolicy applies the filters of the resource filter policy to the backup spec. The filters
+// are applied in groups, i.e. the namespace filters, the resource filters, the label selectors and the
+// cluster-scoped label selectors, and a group is only applied if the backup doesn't set any filter of it,
+// so that the filters of the backup and the policy aren't mixed up.
+func applyResourceFilterPolicy(spec *velerov1api.BackupSpec, policy *velerov1api.ResourceFilterPolicySpec) {
+	policy = policy.DeepCopy()
+
+	if len(spec.IncludedNamespaces) == 0 && len(spec.ExcludedNamespaces) == 0 {
+		spec.IncludedNamespaces = policy.IncludedNamespaces
+		spec.ExcludedNamespaces = policy.ExcludedNamespaces
+	}
+
+	if len(spec.IncludedResources) == 0 && len(spec.ExcludedResources) == 0 && spec.IncludeClusterResources == nil &&
+		len(spec.IncludedClusterScopedResources) == 0 && len(spec.ExcludedClusterScopedResources) == 0 &&
+		len(spec.IncludedNamespaceScopedResources) == 0 && len(spec.ExcludedNamespaceScopedResources) == 0 {
+		spec.IncludedResources = policy.IncludedResources
+		spec.ExcludedResources = policy.ExcludedResources
+		spec.IncludeClusterResources = policy.IncludeClusterResources
+		spec.IncludedClusterScopedResources = policy.IncludedClusterScopedResources
+		spec.ExcludedClusterScopedResources = policy.ExcludedClusterScopedResources
+		spec.IncludedNamespaceScopedResources = policy.IncludedNamespaceScopedResources
+		spec.ExcludedNamespaceScopedResources = policy.ExcludedNamespaceScopedResources
+	}
+
+	if spec.LabelSelector == nil && len(spec.OrLabelSelectors) == 0 {
+		spec.LabelSelector = policy.LabelSelector
+		spec.OrLabelSelectors = policy.OrLabelSelectors
+	}
+
+	if spec.ClusterScopedLabelSelector == nil && len(spec.ClusterScopedOrLabelSelectors) == 0 {
+		spec.ClusterScopedLabelSelector = policy.ClusterScopedLabelSelector
+		spec.ClusterScopedOrLabelSelectors = policy.ClusterScopedOrLabelSelectors
+	}
+}
+
+// applyBackupHookPolicies adds the hooks of the backup hook policies selecting the backup to its hooks, and
+// records the names of the policies in its status. The hooks are identified by their names: the hooks of the
+// enforced policies replace the hooks of the backup with the same names, which in turn replace the hooks of
+// the other policies. The policies are applied in the order of their names, so a hook of a policy is skipped
+// if a policy applied before has a hook with the same name.
+func (b *backupReconciler) applyBackupHookPolicies(request *pkgbackup.Request) error {
+	policies := &velerov1api.BackupHookPolicyList{}
+	if err := b.kbClient.List(context.Background(), policies, &kbclient.ListOptions{Namespace: request.Namespace}); err != nil {
+		return errors.Wrap(err, "error listing backup hook policies")
+	}
+	sort.Slice(policies.Items, func(i, j int) bool {
+		return policies.Items[i].Name < policies.Items[j].Name
+	})
+
+	var enforcedHooks, defaultHooks []velerov1api.BackupResourceHookSpec
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		if policy.Spec.BackupSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(policy.Spec.BackupSelector)
+			if err != nil {
+				return errors.Wrapf(err, "invalid backup selector of backup hook policy %s", policy.Name)
+			}
+			if !selector.Matches(labels.Set(request.Labels)) {
+				continue
+			}
+		}
+
+		if policy.Spec.Enforced {
+			enforcedHooks = append(enforcedHooks, policy.Spec.Hooks.Resources...)
+		} else {
+			defaultHooks = append(defaultHooks, policy.Spec.Hooks.Resources...)
+		}
+		request.Status.HookPolicies = append(request.Status.HookPolicies, policy.Name)
+	}
+
+	if len(request.Status.HookPolicies) > 0 {
+		request.Spec.Hooks.Resources = mergeBackupHooks(enforcedHooks, request.Spec.Hooks.Resources, defaultHooks)
+	}
+	return nil
+}
+
+// mergeBackupHooks merges the hooks in the order of precedence, a hook is skipped if a hook with the same name
+// is merged before it. The hooks without names never conflict.
+func mergeBackupHooks(hookSets ...[]velerov1api.BackupResourceHookSpec) []velerov1api.BackupResourceHookSpec {
+	var merged []velerov1api.BackupResourceHookSpec
+	names := sets.NewString()
+	for _, hooks := range hookSets {
+		for _, hook := range hooks {
+			if hook.Name != "" {
+				if names.Has(hook.Name) {
+					continue
+				}
+				names.Insert(hook.Name)
+			}
+			merged = append(merged, *hook.DeepCopy())
+		}
+	}
+	return merged
+}
+
 // validateAndGetSnapshotLocations gets a collection of VolumeSnapshotLocation objects that
 // this backup will use (returned as a map of provider name -> VSL), and ensures:
 //   - each location name in .spec.volumeSnapshotLocations exists as a location
@@ -606,6 +853,24 @@ func (b *backupReconciler) validateAndGetSnapshotLocations(backup *velerov1api.B
 // causes the backup to be Failed; if no error is returned, the backup's status's Errors
 // field is checked to see if the backup was a partial failure.
 
+// runDryRun evaluates what would be included in the backup and records the result in the
+// backup's status. Nothing is uploaded to the backup storage location.
+func (b *backupReconciler) runDryRun(backup *pkgbackup.Request) {
+	backupLog := b.logger.WithField(Backup, kubeutil.NamespaceAndName(backup))
+
+	result, err := b.backupper.DryRun(backupLog, backup)
+	if err != nil {
+		backupLog.WithError(err).Error("backup dry-run failed")
+		backup.Status.Phase = velerov1api.BackupPhaseFailed
+		backup.Status.FailureReason = err.Error()
+	} else {
+		backup.Status.Phase = velerov1api.BackupPhaseDryRunCompleted
+		backup.Status.DryRunResult = result
+	}
+
+	backup.Status.CompletionTimestamp = &metav1.Time{Time: b.clock.Now()}
+}
+
 func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	b.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Info("Setting up backup log")
 
@@ -650,8 +915,16 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 		return errors.Errorf("backup already exists in object storage")
 	}
 
+	// upload the log in chunks while the backup is in progress so that it can be followed
+	// before the whole log is persisted
+	logChunks := &logging.LogChunkBuffer{}
+	backupLog.TeeOutput(logChunks)
+	stopLogChunks := uploadBackupLogChunks(backupStore, backup.Name, logChunks, backupLogChunkInterval, b.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)))
+
 	backupItemActionsResolver := framework.NewBackupItemActionResolverV2(actions)
 
+	b.prepareClusterResourcesDeduplication(backup, backupStore, backupLog)
+
 	var fatalErrs []error
 	if err := b.backupper.BackupWithResolvers(backupLog, backup, backupFile, backupItemActionsResolver, pluginManager); err != nil {
 		fatalErrs = append(fatalErrs, err)
@@ -682,16 +955,22 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	backup.Status.BackupItemOperationsCompleted = opsCompleted
 	backup.Status.BackupItemOperationsFailed = opsFailed
 
+	if backup.ValidationPolicies != nil && len(fatalErrs) == 0 {
+		checkValidationPolicies(backup, logCounter, backupLog)
+	}
+
 	backup.Status.Warnings = logCounter.GetCount(logrus.WarnLevel)
 	backup.Status.Errors = logCounter.GetCount(logrus.ErrorLevel)
 
 	backupWarnings := logCounter.GetEntries(logrus.WarnLevel)
 	backupErrors := logCounter.GetEntries(logrus.ErrorLevel)
+	backup.Status.ErrorCategories = backupErrors.CategoryCounts()
 	results := map[string]results.Result{
 		"warnings": backupWarnings,
 		"errors":   backupErrors,
 	}
 
+	stopLogChunks()
 	backupLog.DoneForPersist(b.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)))
 
 	// Assign finalize phase as close to end as possible so that any errors
@@ -736,6 +1015,11 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	} else {
 		if errs := persistBackup(backup, backupFile, logFile, backupStore, volumeSnapshots, volumeSnapshotContents, volumeSnapshotClasses, results); len(errs) > 0 {
 			fatalErrs = append(fatalErrs, errs...)
+		} else {
+			if err := backupStore.DeleteBackupLogChunks(backup.Name); err != nil {
+				b.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).WithError(err).Warn("Error deleting the chunks of the backup log")
+			}
+			b.retainReferencedBackups(backup, b.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)))
 		}
 	}
 
@@ -746,6 +1030,167 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	return kerrors.NewAggregate(fatalErrs)
 }
 
+// checkValidationPolicies checks the validation policies of the backup against the facts of
+// the backed up items. The violations are recorded in the backup status and logged as errors
+// for the policies with the 'fail' action, so that the backup ends up PartiallyFailed, or as
+// warnings for the policies with the 'warn' action.
+func checkValidationPolicies(backup *pkgbackup.Request, logCounter *logging.LogHook, log logrus.FieldLogger) {
+	backedUpNamespaces := backup.BackedUpNamespaces()
+	var emptyNamespaces []string
+	for _, ns := range backup.Spec.IncludedNamespaces {
+		// only the namespaces selected by name are checked, a wildcard may match nothing by design
+		if strings.ContainsAny(ns, "*?[") || backedUpNamespaces.Has(ns) {
+			continue
+		}
+		emptyNamespaces = append(emptyNamespaces, ns)
+	}
+	if len(emptyNamespaces) > 0 {
+		log.Infof("No items were backed up from the included namespaces: %s", strings.Join(emptyNamespaces, ", "))
+	}
+
+	resources := backup.BackupResourceList()
+	facts := map[string]int{
+		validationpolicies.FactItemsBackedUp:          len(backup.BackedUpItems),
+		validationpolicies.FactPersistentVolumes:      len(resources["v1/PersistentVolume"]),
+		validationpolicies.FactPersistentVolumeClaims: len(resources["v1/PersistentVolumeClaim"]),
+		validationpolicies.FactVolumeSnapshots:        backup.Status.VolumeSnapshotsCompleted + backup.Status.CSIVolumeSnapshotsCompleted,
+		validationpolicies.FactPodVolumeBackups:       len(backup.PodVolumeBackups),
+		validationpolicies.FactEmptyNamespaces:        len(emptyNamespaces),
+		validationpolicies.FactErrors:                 logCounter.GetCount(logrus.ErrorLevel),
+		validationpolicies.FactWarnings:               logCounter.GetCount(logrus.WarnLevel),
+	}
+
+	violations := backup.ValidationPolicies.Evaluate(facts)
+	for _, violation := range violations.Failures {
+		log.Error(violation)
+	}
+	for _, violation := range violations.Warnings {
+		log.Warn(violation)
+	}
+	backup.Status.PolicyViolations = append(violations.Failures, violations.Warnings...)
+}
+
+// prepareClusterResourcesDeduplication loads the cluster-scoped items recorded by the previous
+// completed backup of the schedule if the backup deduplicates them. The backup records its
+// cluster-scoped items even if there is no previous backup to deduplicate against, so that the
+// next backup of the schedule can deduplicate them.
+func (b *backupReconciler) prepareClusterResourcesDeduplication(backup *pkgbackup.Request, backupStore persistence.BackupStore, log logrus.FieldLogger) {
+	schedule := backup.Labels[velerov1api.ScheduleNameLabel]
+	if !boolptr.IsSetToTrue(backup.Spec.DeduplicateClusterResources) || schedule == "" {
+		return
+	}
+	backup.ClusterResources = archive.ClusterResources{}
+
+	backups := &velerov1api.BackupList{}
+	if err := b.kbClient.List(context.Background(), backups, kbclient.InNamespace(backup.Namespace), kbclient.MatchingLabels{velerov1api.ScheduleNameLabel: schedule}); err != nil {
+		log.WithError(err).Warn("Error listing the backups of the schedule, the cluster-scoped items aren't deduplicated")
+		return
+	}
+
+	// the references are resolved from the same backup storage location on restore
+	var previous *velerov1api.Backup
+	for i := range backups.Items {
+		candidate := &backups.Items[i]
+		if candidate.Name == backup.Name || candidate.Status.Phase != velerov1api.BackupPhaseCompleted ||
+			candidate.Spec.StorageLocation != backup.Spec.StorageLocation || candidate.Status.StartTimestamp == nil {
+			continue
+		}
+		if previous == nil || candidate.Status.StartTimestamp.After(previous.Status.StartTimestamp.Time) {
+			previous = candidate
+		}
+	}
+	if previous == nil {
+		log.Info("No previous completed backup of the schedule to deduplicate the cluster-scoped items against")
+		return
+	}
+
+	clusterResources, err := backupStore.GetBackupClusterResources(previous.Name)
+	if err != nil {
+		log.WithError(err).Warnf("Error getting the cluster-scoped items of the previous backup %s, the cluster-scoped items aren't deduplicated", previous.Name)
+		return
+	}
+	if clusterResources == nil {
+		log.Infof("The previous backup %s of the schedule didn't record its cluster-scoped items, the cluster-scoped items aren't deduplicated", previous.Name)
+		return
+	}
+
+	log.Infof("Deduplicating the cluster-scoped items unchanged since the previous backup %s of the schedule", previous.Name)
+	backup.PreviousClusterResources = clusterResources
+}
+
+// retainReferencedBackups extends the expiration of the backups storing the cluster-scoped items
+// deduplicated by the backup to the backup's expiration, so that the references can be resolved
+// as long as the backup is kept.
+func (b *backupReconciler) retainReferencedBackups(backup *pkgbackup.Request, log logrus.FieldLogger) {
+	if backup.Status.Expiration == nil {
+		return
+	}
+
+	for name := range backup.ClusterResources.ReferencedPaths(backup.Name) {
+		referenced := &velerov1api.Backup{}
+		if err := b.kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: backup.Namespace, Name: name}, referenced); err != nil {
+			log.WithError(err).Warnf("Error getting the backup %s referenced by the deduplicated cluster-scoped items", name)
+			continue
+		}
+		if referenced.Status.Expiration != nil && !referenced.Status.Expiration.Before(backup.Status.Expiration) {
+			continue
+		}
+
+		updated := referenced.DeepCopy()
+		updated.Status.Expiration = backup.Status.Expiration.DeepCopy()
+		if err := kubeutil.PatchResource(referenced, updated, b.kbClient); err != nil {
+			log.WithError(err).Warnf("Error extending the expiration of the backup %s referenced by the deduplicated cluster-scoped items", name)
+			continue
+		}
+		log.Infof("Extended the expiration of the backup %s referenced by the deduplicated cluster-scoped items to %s", name, backup.Status.Expiration)
+	}
+}
+
+// uploadBackupLogChunks uploads the logs buffered in chunks to the backup store every interval,
+// so that the logs of the backup in progress can be followed. The returned function stops the
+// uploading and uploads the remaining logs as the last chunk.
+func uploadBackupLogChunks(backupStore persistence.BackupStore, backupName string, chunks *logging.LogChunkBuffer, interval time.Duration, log logrus.FieldLogger) func() {
+	var pending []byte
+	chunk := 1
+	upload := func() {
+		pending = append(pending, chunks.TakeChunk()...)
+		if len(pending) == 0 {
+			return
+		}
+
+		data := new(bytes.Buffer)
+		gzw := gzip.NewWriter(data)
+		_, err := gzw.Write(pending)
+		if err == nil {
+			err = gzw.Close()
+		}
+		if err == nil {
+			err = backupStore.PutBackupLogChunk(backupName, chunk, data)
+		}
+		if err != nil {
+			// the pending logs are retried with the next chunk
+			log.WithError(err).Warnf("Error uploading chunk %d of the backup log", chunk)
+			return
+		}
+
+		pending = nil
+		chunk++
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		wait.Until(upload, interval, stop)
+	}()
+
+	return func() {
+		close(stop)
+		<-done
+		upload()
+	}
+}
+
 func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, backupFile *os.File, serverMetrics *metrics.ServerMetrics, finalize bool) {
 	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
 
@@ -779,6 +1224,7 @@ func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, bac
 			serverMetrics.RegisterBackupItemsTotalGauge(backupScheduleName, backup.Status.Progress.TotalItems)
 		}
 		serverMetrics.RegisterBackupItemsErrorsGauge(backupScheduleName, backup.Status.Errors)
+		serverMetrics.RegisterBackupErrorCategories(backupScheduleName, backup.Status.ErrorCategories)
 
 		if backup.Status.Warnings > 0 {
 			serverMetrics.RegisterBackupWarning(backupScheduleName)
@@ -796,7 +1242,7 @@ func persistBackup(backup *pkgbackup.Request,
 ) []error {
 	persistErrs := []error{}
 	backupJSON := new(bytes.Buffer)
-	volumeInfos := make([]volume.VolumeInfo, 0)
+	volumeInfos := pkgbackup.PodVolumeBackupVolumeInfos(backup.PodVolumeBackups)
 
 	if err := encode.To(backup.Backup, "json", backupJSON); err != nil {
 		persistErrs = append(persistErrs, errors.Wrap(err, "error encoding backup"))
@@ -808,10 +1254,14 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
-	var backupItemOperations *bytes.Buffer
-	backupItemOperations, errs = encode.ToJSONGzip(backup.GetItemOperationsList(), "backup item operations list")
-	if errs != nil {
-		persistErrs = append(persistErrs, errs...)
+	// the per-resource metadata is split into pages for the backups with many items
+	var backupItemOperations []io.Reader
+	for _, page := range pkgbackup.PaginateItemOperations(*backup.GetItemOperationsList(), pkgbackup.MetadataPageSize) {
+		encoded, errs := encode.ToJSONGzip(page, "backup item operations list")
+		if errs != nil {
+			persistErrs = append(persistErrs, errs...)
+		}
+		backupItemOperations = append(backupItemOperations, encoded)
 	}
 
 	podVolumeBackups, errs := encode.ToJSONGzip(backup.PodVolumeBackups, "pod volume backups list")
@@ -833,9 +1283,22 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
-	backupResourceList, errs := encode.ToJSONGzip(backup.BackupResourceList(), "backup resources list")
-	if errs != nil {
-		persistErrs = append(persistErrs, errs...)
+	var backupResourceList []io.Reader
+	for _, page := range pkgbackup.PaginateResourceList(backup.BackupResourceList(), pkgbackup.MetadataPageSize) {
+		encoded, errs := encode.ToJSONGzip(page, "backup resources list")
+		if errs != nil {
+			persistErrs = append(persistErrs, errs...)
+		}
+		backupResourceList = append(backupResourceList, encoded)
+	}
+
+	var backupSkippedItems []io.Reader
+	for _, page := range pkgbackup.PaginateSkippedItems(backup.SkippedItemTracker.Summary(), pkgbackup.MetadataPageSize) {
+		encoded, errs := encode.ToJSONGzip(page, "backup skipped items")
+		if errs != nil {
+			persistErrs = append(persistErrs, errs...)
+		}
+		backupSkippedItems = append(backupSkippedItems, encoded)
 	}
 
 	backupResult, errs := encode.ToJSONGzip(results, "backup results")
@@ -843,11 +1306,31 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
-	volumeInfoJSON, errs := encode.ToJSONGzip(volumeInfos, "backup volumes information")
+	var volumeInfoJSON []io.Reader
+	for _, page := range pkgbackup.PaginateVolumeInfos(volumeInfos, pkgbackup.MetadataPageSize) {
+		encoded, errs := encode.ToJSONGzip(page, "backup volumes information")
+		if errs != nil {
+			persistErrs = append(persistErrs, errs...)
+		}
+		volumeInfoJSON = append(volumeInfoJSON, encoded)
+	}
+
+	checksumsJSON, errs := encode.ToJSONGzip(backup.Checksums, "backup checksums")
 	if errs != nil {
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	// the cluster resources are only recorded by the backups deduplicating them
+	var clusterResourcesJSON io.Reader
+	if backup.ClusterResources != nil {
+		encoded, errs := encode.ToJSONGzip(backup.ClusterResources, "backup cluster resources")
+		if errs != nil {
+			persistErrs = append(persistErrs, errs...)
+		} else {
+			clusterResourcesJSON = encoded
+		}
+	}
+
 	if len(persistErrs) > 0 {
 		// Don't upload the JSON files or backup tarball if encoding to json fails.
 		backupJSON = nil
@@ -855,11 +1338,14 @@ func persistBackup(backup *pkgbackup.Request,
 		nativeVolumeSnapshots = nil
 		backupItemOperations = nil
 		backupResourceList = nil
+		backupSkippedItems = nil
 		csiSnapshotJSON = nil
 		csiSnapshotContentsJSON = nil
 		csiSnapshotClassesJSON = nil
 		backupResult = nil
 		volumeInfoJSON = nil
+		checksumsJSON = nil
+		clusterResourcesJSON = nil
 	}
 
 	backupInfo := persistence.BackupInfo{
@@ -872,10 +1358,13 @@ func persistBackup(backup *pkgbackup.Request,
 		VolumeSnapshots:           nativeVolumeSnapshots,
 		BackupItemOperations:      backupItemOperations,
 		BackupResourceList:        backupResourceList,
+		BackupSkippedItems:        backupSkippedItems,
 		CSIVolumeSnapshots:        csiSnapshotJSON,
 		CSIVolumeSnapshotContents: csiSnapshotContentsJSON,
 		CSIVolumeSnapshotClasses:  csiSnapshotClassesJSON,
 		BackupVolumeInfo:          volumeInfoJSON,
+		BackupChecksums:           checksumsJSON,
+		ClusterResources:          clusterResourcesJSON,
 	}
 	if err := backupStore.PutBackup(backupInfo); err != nil {
 		persistErrs = append(persistErrs, err)
@@ -18,12 +18,14 @@ package controller
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
 	"reflect"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -49,7 +51,9 @@ import (
 
 	fakeClient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/vmware-tanzu/velero/internal/validationpolicies"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
@@ -89,6 +93,14 @@ func (b *fakeBackupper) FinalizeBackup(logger logrus.FieldLogger, backup *pkgbac
 	return args.Error(0)
 }
 
+func (b *fakeBackupper) DryRun(logger logrus.FieldLogger, backup *pkgbackup.Request) (*velerov1api.BackupDryRunResult, error) {
+	args := b.Called(logger, backup)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*velerov1api.BackupDryRunResult), args.Error(1)
+}
+
 func defaultBackup() *builder.BackupBuilder {
 	return builder.ForBackup(velerov1api.DefaultNamespace, "backup-1")
 }
@@ -148,6 +160,29 @@ func TestProcessBackupNonProcessedItems(t *testing.T) {
 	}
 }
 
+func TestProcessBackupQueuedForBackupWindow(t *testing.T) {
+	window := builder.ForConfigMap(velerov1api.DefaultNamespace, "backup-window").Data("start", "09:00", "end", "17:00").Result()
+	backup := defaultBackup().Result()
+
+	formatFlag := logging.FormatText
+	c := &backupReconciler{
+		kbClient:              velerotest.NewFakeControllerRuntimeClient(t, window, backup),
+		clock:                 testclocks.NewFakeClock(time.Date(2024, 1, 1, 8, 58, 0, 0, time.UTC)),
+		formatFlag:            formatFlag,
+		logger:                logging.DefaultLogger(logrus.DebugLevel, formatFlag),
+		backupWindowConfigMap: "backup-window",
+	}
+
+	result, err := c.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}})
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{RequeueAfter: 2 * time.Minute}, result)
+
+	// the backup is left as is until the window opens
+	res := &velerov1api.Backup{}
+	require.NoError(t, c.kbClient.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, res))
+	assert.Empty(t, res.Status.Phase)
+}
+
 func TestProcessBackupValidationFailures(t *testing.T) {
 	defaultBackupLocation := builder.ForBackupStorageLocation("velero", "loc-1").Result()
 
@@ -187,12 +222,55 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			backupLocation: defaultBackupLocation,
 			expectedErrs:   []string{"encountered labelSelector as well as orLabelSelectors in backup spec, only one can be specified"},
 		},
+		{
+			name: "clusterScopedLabelSelector as well as clusterScopedOrLabelSelectors both are specified in backup request fails validation",
+			backup: defaultBackup().ClusterScopedLabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"a": "b"}}).
+				ClusterScopedOrLabelSelector([]*metav1.LabelSelector{{MatchLabels: map[string]string{"a1": "b1"}}, {MatchLabels: map[string]string{"a2": "b2"}}}).Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"encountered clusterScopedLabelSelector as well as clusterScopedOrLabelSelectors in backup spec, only one can be specified"},
+		},
+		{
+			name:           "clusterScopedLabelSelector is specified with cluster resources excluded fails validation",
+			backup:         defaultBackup().IncludeClusterResources(false).ClusterScopedLabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"a": "b"}}).Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"clusterScopedLabelSelector and clusterScopedOrLabelSelectors can't be specified when includeClusterResources is false"},
+		},
 		{
 			name:           "use old filter parameters and new filter parameters together",
 			backup:         defaultBackup().IncludeClusterResources(true).IncludedNamespaceScopedResources("Deployment").IncludedNamespaces("default").Result(),
 			backupLocation: defaultBackupLocation,
 			expectedErrs:   []string{"include-resources, exclude-resources and include-cluster-resources are old filter parameters.\ninclude-cluster-scoped-resources, exclude-cluster-scoped-resources, include-namespace-scoped-resources and exclude-namespace-scoped-resources are new filter parameters.\nThey cannot be used together"},
 		},
+		{
+			name:           "invalid uploader type fails validation",
+			backup:         defaultBackup().UploaderType("foo").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"invalid uploader type 'foo', valid upload types are: 'restic', 'kopia'"},
+		},
+		{
+			name:           "invalid compression algorithm fails validation",
+			backup:         defaultBackup().CompressionAlgorithm("foo").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"invalid compression algorithm 'foo', valid compression algorithms are: 'gzip', 'zstd', 'lz4'"},
+		},
+		{
+			name:           "snapshot move replica location is the backup storage location fails validation",
+			backup:         defaultBackup().StorageLocation("loc-1").SnapshotMoveReplicaLocation("loc-1").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"snapshot move replica location loc-1 can't be the storage location of the backup"},
+		},
+		{
+			name:           "non-existent snapshot move replica location fails validation",
+			backup:         defaultBackup().SnapshotMoveReplicaLocation("nonexistent").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"error getting snapshot move replica location nonexistent: backupstoragelocations.velero.io \"nonexistent\" not found"},
+		},
+		{
+			name:           "non-existent validation policies configmap fails validation",
+			backup:         defaultBackup().ValidationPolicies("nonexistent").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"failed to get validation policies velero/nonexistent configmap with err configmaps \"nonexistent\" not found"},
+		},
 	}
 
 	for _, test := range tests {
@@ -244,6 +322,82 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 	}
 }
 
+func TestProcessBackupDryRun(t *testing.T) {
+	defaultBackupLocation := builder.ForBackupStorageLocation("velero", "loc-1").Result()
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+	now = now.Local()
+
+	tests := []struct {
+		name           string
+		dryRunResult   *velerov1api.BackupDryRunResult
+		dryRunErr      error
+		expectedPhase  velerov1api.BackupPhase
+		expectedReason string
+	}{
+		{
+			name: "dry-run succeeds",
+			dryRunResult: &velerov1api.BackupDryRunResult{
+				Resources:              map[string][]string{"v1/Pod": {"ns-1/pod-1"}},
+				PersistentVolumes:      []string{"pv-1"},
+				EstimatedDataSizeBytes: 1024,
+			},
+			expectedPhase: velerov1api.BackupPhaseDryRunCompleted,
+		},
+		{
+			name:           "dry-run fails",
+			dryRunErr:      errors.New("fake-dry-run-error"),
+			expectedPhase:  velerov1api.BackupPhaseFailed,
+			expectedReason: "fake-dry-run-error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			formatFlag := logging.FormatText
+			logger := logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+
+			apiServer := velerotest.NewAPIServer(t)
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+			require.NoError(t, err)
+
+			backupper := new(fakeBackupper)
+			backupper.On("DryRun", mock.Anything, mock.Anything).Return(test.dryRunResult, test.dryRunErr)
+
+			c := &backupReconciler{
+				logger:                logger,
+				discoveryHelper:       discoveryHelper,
+				kbClient:              velerotest.NewFakeControllerRuntimeClient(t, defaultBackupLocation),
+				defaultBackupLocation: defaultBackupLocation.Name,
+				clock:                 testclocks.NewFakeClock(now),
+				formatFlag:            formatFlag,
+				metrics:               metrics.NewServerMetrics(),
+				backupper:             backupper,
+				backupTracker:         NewBackupTracker(),
+			}
+
+			backup := defaultBackup().DryRun(true).Result()
+			require.NoError(t, c.kbClient.Create(context.Background(), backup))
+
+			actualResult, err := c.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}})
+			assert.Equal(t, ctrl.Result{}, actualResult)
+			assert.Nil(t, err)
+
+			res := &velerov1api.Backup{}
+			require.NoError(t, c.kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: backup.Namespace, Name: backup.Name}, res))
+
+			assert.Equal(t, test.expectedPhase, res.Status.Phase)
+			assert.Equal(t, test.expectedReason, res.Status.FailureReason)
+			assert.Equal(t, test.dryRunResult, res.Status.DryRunResult)
+			assert.NotNil(t, res.Status.CompletionTimestamp)
+			assert.False(t, c.backupTracker.Contains(backup.Namespace, backup.Name))
+
+			// nothing else should be invoked on the backupper, e.g., no data is written to the backup storage location
+			backupper.AssertExpectations(t)
+		})
+	}
+}
+
 func TestBackupLocationLabel(t *testing.T) {
 	tests := []struct {
 		name                   string
@@ -309,6 +463,7 @@ func Test_prepareBackupRequest_BackupStorageLocation(t *testing.T) {
 		backupLocationInAPIServer        *velerov1api.BackupStorageLocation
 		defaultBackupLocationInAPIServer *velerov1api.BackupStorageLocation
 		expectedBackupLocation           string
+		expectedEncryptionKeyID          string
 		expectedSuccess                  bool
 		expectedValidationError          string
 	}{
@@ -321,6 +476,16 @@ func Test_prepareBackupRequest_BackupStorageLocation(t *testing.T) {
 			expectedBackupLocation:           "test-backup-location",
 			expectedSuccess:                  true,
 		},
+		{
+			name:                             "BackupLocation with encryption records the encryption key",
+			backup:                           builder.ForBackup("velero", "backup-1").Result(),
+			backupLocationNameInBackup:       "test-backup-location",
+			backupLocationInAPIServer:        builder.ForBackupStorageLocation("velero", "test-backup-location").Encryption("velero-encryption", "key-2").Result(),
+			defaultBackupLocationInAPIServer: builder.ForBackupStorageLocation("velero", "default-location").Result(),
+			expectedBackupLocation:           "test-backup-location",
+			expectedEncryptionKeyID:          "key-2",
+			expectedSuccess:                  true,
+		},
 		{
 			name:                             "BackupLocation is specified in backup CR'spec and it can't be found in ApiServer",
 			backup:                           builder.ForBackup("velero", "backup-1").Result(),
@@ -389,6 +554,7 @@ func Test_prepareBackupRequest_BackupStorageLocation(t *testing.T) {
 			// Assert
 			if test.expectedSuccess {
 				assert.Equal(t, test.expectedBackupLocation, res.Spec.StorageLocation)
+				assert.Equal(t, test.expectedEncryptionKeyID, res.Status.EncryptionKeyID)
 				assert.NotNil(t, res)
 			} else {
 				// in every test case, we only trigger one error at once
@@ -466,6 +632,188 @@ func TestDefaultBackupTTL(t *testing.T) {
 	}
 }
 
+func TestResourceFilterPolicy(t *testing.T) {
+	policy := builder.ForResourceFilterPolicy(velerov1api.DefaultNamespace, "policy-1").
+		IncludedNamespaces("ns-1", "ns-2").
+		ExcludedResources("secrets").
+		IncludeClusterResources(false).
+		LabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}).
+		Result()
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "loc-1").Result()
+
+	tests := []struct {
+		name                     string
+		backup                   *velerov1api.Backup
+		expectedSpec             velerov1api.BackupSpec
+		expectedValidationErrors []string
+	}{
+		{
+			name:   "filters of the policy are applied to the backup",
+			backup: defaultBackup().StorageLocation("loc-1").ResourceFilterPolicy("policy-1").Result(),
+			expectedSpec: velerov1api.BackupSpec{
+				IncludedNamespaces:      []string{"ns-1", "ns-2"},
+				ExcludedResources:       []string{"secrets"},
+				IncludeClusterResources: boolptr.False(),
+				LabelSelector:           &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			},
+		},
+		{
+			name: "filters of the backup take precedence over the ones of the policy",
+			backup: defaultBackup().StorageLocation("loc-1").ResourceFilterPolicy("policy-1").
+				ExcludedNamespaces("ns-3").
+				IncludedNamespaceScopedResources("deployments").
+				OrLabelSelector([]*metav1.LabelSelector{{MatchLabels: map[string]string{"app": "db"}}}).
+				Result(),
+			expectedSpec: velerov1api.BackupSpec{
+				ExcludedNamespaces:               []string{"ns-3"},
+				IncludedNamespaceScopedResources: []string{"deployments"},
+				OrLabelSelectors:                 []*metav1.LabelSelector{{MatchLabels: map[string]string{"app": "db"}}},
+			},
+		},
+		{
+			name:                     "policy not found",
+			backup:                   defaultBackup().StorageLocation("loc-1").ResourceFilterPolicy("policy-2").Result(),
+			expectedValidationErrors: []string{`error getting resource filter policy policy-2: resourcefilterpolicies.velero.io "policy-2" not found`},
+		},
+	}
+
+	for _, test := range tests {
+		formatFlag := logging.FormatText
+		logger := logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+
+		t.Run(test.name, func(t *testing.T) {
+			apiServer := velerotest.NewAPIServer(t)
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+			require.NoError(t, err)
+
+			c := &backupReconciler{
+				logger:          logger,
+				discoveryHelper: discoveryHelper,
+				kbClient:        velerotest.NewFakeControllerRuntimeClient(t, policy, location),
+				clock:           testclocks.NewFakeClock(time.Now()),
+				formatFlag:      formatFlag,
+			}
+
+			res := c.prepareBackupRequest(test.backup.DeepCopy(), logger)
+			require.NotNil(t, res)
+			assert.Equal(t, test.expectedValidationErrors, res.Status.ValidationErrors)
+			if test.expectedValidationErrors != nil {
+				return
+			}
+			assert.Equal(t, test.expectedSpec.IncludedNamespaces, res.Spec.IncludedNamespaces)
+			assert.Equal(t, test.expectedSpec.ExcludedNamespaces, res.Spec.ExcludedNamespaces)
+			assert.Equal(t, test.expectedSpec.ExcludedResources, res.Spec.ExcludedResources)
+			assert.Equal(t, test.expectedSpec.IncludeClusterResources, res.Spec.IncludeClusterResources)
+			assert.Equal(t, test.expectedSpec.IncludedNamespaceScopedResources, res.Spec.IncludedNamespaceScopedResources)
+			assert.Equal(t, test.expectedSpec.LabelSelector, res.Spec.LabelSelector)
+			assert.Equal(t, test.expectedSpec.OrLabelSelectors, res.Spec.OrLabelSelectors)
+		})
+	}
+}
+
+func TestBackupHookPolicies(t *testing.T) {
+	hook := func(name, command string) velerov1api.BackupResourceHookSpec {
+		return velerov1api.BackupResourceHookSpec{
+			Name:     name,
+			PreHooks: []velerov1api.BackupResourceHook{{Exec: &velerov1api.ExecHook{Command: []string{command}}}},
+		}
+	}
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "loc-1").Result()
+
+	tests := []struct {
+		name                     string
+		backup                   *velerov1api.Backup
+		policies                 []*velerov1api.BackupHookPolicy
+		expectedHooks            []velerov1api.BackupResourceHookSpec
+		expectedPolicies         []string
+		expectedValidationErrors []string
+	}{
+		{
+			name:          "no policies",
+			backup:        defaultBackup().StorageLocation("loc-1").Hooks(velerov1api.BackupHooks{Resources: []velerov1api.BackupResourceHookSpec{hook("quiesce", "backup")}}).Result(),
+			expectedHooks: []velerov1api.BackupResourceHookSpec{hook("quiesce", "backup")},
+		},
+		{
+			name:   "hooks of the policies selecting the backup are added",
+			backup: defaultBackup().StorageLocation("loc-1").ObjectMeta(builder.WithLabels("tier", "db")).Result(),
+			policies: []*velerov1api.BackupHookPolicy{
+				builder.ForBackupHookPolicy(velerov1api.DefaultNamespace, "db").
+					BackupSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"tier": "db"}}).
+					Hooks(hook("quiesce", "db")).Result(),
+				builder.ForBackupHookPolicy(velerov1api.DefaultNamespace, "all").Hooks(hook("sync", "all")).Result(),
+				builder.ForBackupHookPolicy(velerov1api.DefaultNamespace, "web").
+					BackupSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"tier": "web"}}).
+					Hooks(hook("flush", "web")).Result(),
+			},
+			expectedHooks:    []velerov1api.BackupResourceHookSpec{hook("sync", "all"), hook("quiesce", "db")},
+			expectedPolicies: []string{"all", "db"},
+		},
+		{
+			name:   "hooks of the backup take precedence over the ones of the policies",
+			backup: defaultBackup().StorageLocation("loc-1").Hooks(velerov1api.BackupHooks{Resources: []velerov1api.BackupResourceHookSpec{hook("quiesce", "backup")}}).Result(),
+			policies: []*velerov1api.BackupHookPolicy{
+				builder.ForBackupHookPolicy(velerov1api.DefaultNamespace, "policy-1").Hooks(hook("quiesce", "policy-1"), hook("sync", "policy-1")).Result(),
+				builder.ForBackupHookPolicy(velerov1api.DefaultNamespace, "policy-2").Hooks(hook("sync", "policy-2")).Result(),
+			},
+			expectedHooks:    []velerov1api.BackupResourceHookSpec{hook("quiesce", "backup"), hook("sync", "policy-1")},
+			expectedPolicies: []string{"policy-1", "policy-2"},
+		},
+		{
+			name:   "hooks of the enforced policies take precedence over the ones of the backup",
+			backup: defaultBackup().StorageLocation("loc-1").Hooks(velerov1api.BackupHooks{Resources: []velerov1api.BackupResourceHookSpec{hook("quiesce", "backup"), hook("", "backup")}}).Result(),
+			policies: []*velerov1api.BackupHookPolicy{
+				builder.ForBackupHookPolicy(velerov1api.DefaultNamespace, "policy-1").Hooks(hook("sync", "policy-1")).Result(),
+				builder.ForBackupHookPolicy(velerov1api.DefaultNamespace, "policy-2").Enforced(true).Hooks(hook("quiesce", "policy-2"), hook("", "policy-2")).Result(),
+			},
+			expectedHooks:    []velerov1api.BackupResourceHookSpec{hook("quiesce", "policy-2"), hook("", "policy-2"), hook("", "backup"), hook("sync", "policy-1")},
+			expectedPolicies: []string{"policy-1", "policy-2"},
+		},
+		{
+			name:   "invalid backup selector",
+			backup: defaultBackup().StorageLocation("loc-1").Result(),
+			policies: []*velerov1api.BackupHookPolicy{
+				builder.ForBackupHookPolicy(velerov1api.DefaultNamespace, "policy-1").
+					BackupSelector(&metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Unknown"}}}).
+					Hooks(hook("sync", "policy-1")).Result(),
+			},
+			expectedValidationErrors: []string{`invalid backup selector of backup hook policy policy-1: "Unknown" is not a valid pod selector operator`},
+		},
+	}
+
+	for _, test := range tests {
+		formatFlag := logging.FormatText
+		logger := logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+
+		t.Run(test.name, func(t *testing.T) {
+			apiServer := velerotest.NewAPIServer(t)
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+			require.NoError(t, err)
+
+			objs := []runtime.Object{location}
+			for _, policy := range test.policies {
+				objs = append(objs, policy)
+			}
+
+			c := &backupReconciler{
+				logger:          logger,
+				discoveryHelper: discoveryHelper,
+				kbClient:        velerotest.NewFakeControllerRuntimeClient(t, objs...),
+				clock:           testclocks.NewFakeClock(time.Now()),
+				formatFlag:      formatFlag,
+			}
+
+			res := c.prepareBackupRequest(test.backup.DeepCopy(), logger)
+			require.NotNil(t, res)
+			assert.Equal(t, test.expectedValidationErrors, res.Status.ValidationErrors)
+			if test.expectedValidationErrors != nil {
+				return
+			}
+			assert.Equal(t, test.expectedHooks, res.Spec.Hooks.Resources)
+			assert.Equal(t, test.expectedPolicies, res.Status.HookPolicies)
+		})
+	}
+}
+
 func TestDefaultVolumesToResticDeprecation(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -627,7 +975,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				Status: velerov1api.BackupStatus{
 					Phase:          velerov1api.BackupPhaseFinalizing,
 					Version:        1,
-					FormatVersion:  "1.1.0",
+					FormatVersion:  "1.1.1",
 					StartTimestamp: &timestamp,
 					Expiration:     &timestamp,
 				},
@@ -664,7 +1012,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				Status: velerov1api.BackupStatus{
 					Phase:          velerov1api.BackupPhaseFinalizing,
 					Version:        1,
-					FormatVersion:  "1.1.0",
+					FormatVersion:  "1.1.1",
 					StartTimestamp: &timestamp,
 					Expiration:     &timestamp,
 				},
@@ -704,7 +1052,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				Status: velerov1api.BackupStatus{
 					Phase:          velerov1api.BackupPhaseFinalizing,
 					Version:        1,
-					FormatVersion:  "1.1.0",
+					FormatVersion:  "1.1.1",
 					StartTimestamp: &timestamp,
 					Expiration:     &timestamp,
 				},
@@ -742,7 +1090,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				Status: velerov1api.BackupStatus{
 					Phase:          velerov1api.BackupPhaseFinalizing,
 					Version:        1,
-					FormatVersion:  "1.1.0",
+					FormatVersion:  "1.1.1",
 					Expiration:     &metav1.Time{Time: now.Add(10 * time.Minute)},
 					StartTimestamp: &timestamp,
 				},
@@ -780,7 +1128,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				Status: velerov1api.BackupStatus{
 					Phase:          velerov1api.BackupPhaseFinalizing,
 					Version:        1,
-					FormatVersion:  "1.1.0",
+					FormatVersion:  "1.1.1",
 					StartTimestamp: &timestamp,
 					Expiration:     &timestamp,
 				},
@@ -819,7 +1167,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				Status: velerov1api.BackupStatus{
 					Phase:          velerov1api.BackupPhaseFinalizing,
 					Version:        1,
-					FormatVersion:  "1.1.0",
+					FormatVersion:  "1.1.1",
 					StartTimestamp: &timestamp,
 					Expiration:     &timestamp,
 				},
@@ -858,7 +1206,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				Status: velerov1api.BackupStatus{
 					Phase:          velerov1api.BackupPhaseFinalizing,
 					Version:        1,
-					FormatVersion:  "1.1.0",
+					FormatVersion:  "1.1.1",
 					StartTimestamp: &timestamp,
 					Expiration:     &timestamp,
 				},
@@ -897,7 +1245,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				Status: velerov1api.BackupStatus{
 					Phase:          velerov1api.BackupPhaseFinalizing,
 					Version:        1,
-					FormatVersion:  "1.1.0",
+					FormatVersion:  "1.1.1",
 					StartTimestamp: &timestamp,
 					Expiration:     &timestamp,
 				},
@@ -936,7 +1284,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				Status: velerov1api.BackupStatus{
 					Phase:          velerov1api.BackupPhaseFinalizing,
 					Version:        1,
-					FormatVersion:  "1.1.0",
+					FormatVersion:  "1.1.1",
 					StartTimestamp: &timestamp,
 					Expiration:     &timestamp,
 				},
@@ -977,7 +1325,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Phase:               velerov1api.BackupPhaseFailed,
 					FailureReason:       "backup already exists in object storage",
 					Version:             1,
-					FormatVersion:       "1.1.0",
+					FormatVersion:       "1.1.1",
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
@@ -1017,7 +1365,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Phase:               velerov1api.BackupPhaseFailed,
 					FailureReason:       "error checking if backup already exists in object storage: Backup already exists in object storage",
 					Version:             1,
-					FormatVersion:       "1.1.0",
+					FormatVersion:       "1.1.1",
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
@@ -1055,7 +1403,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				Status: velerov1api.BackupStatus{
 					Phase:                       velerov1api.BackupPhaseFinalizing,
 					Version:                     1,
-					FormatVersion:               "1.1.0",
+					FormatVersion:               "1.1.1",
 					StartTimestamp:              &timestamp,
 					Expiration:                  &timestamp,
 					CSIVolumeSnapshotsAttempted: 0,
@@ -1096,7 +1444,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				Status: velerov1api.BackupStatus{
 					Phase:                       velerov1api.BackupPhaseFinalizing,
 					Version:                     1,
-					FormatVersion:               "1.1.0",
+					FormatVersion:               "1.1.1",
 					StartTimestamp:              &timestamp,
 					Expiration:                  &timestamp,
 					CSIVolumeSnapshotsAttempted: 1,
@@ -1136,7 +1484,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				Status: velerov1api.BackupStatus{
 					Phase:                       velerov1api.BackupPhaseFinalizing,
 					Version:                     1,
-					FormatVersion:               "1.1.0",
+					FormatVersion:               "1.1.1",
 					StartTimestamp:              &timestamp,
 					Expiration:                  &timestamp,
 					CSIVolumeSnapshotsAttempted: 1,
@@ -1177,7 +1525,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				Status: velerov1api.BackupStatus{
 					Phase:                       velerov1api.BackupPhaseFinalizing,
 					Version:                     1,
-					FormatVersion:               "1.1.0",
+					FormatVersion:               "1.1.1",
 					StartTimestamp:              &timestamp,
 					Expiration:                  &timestamp,
 					CSIVolumeSnapshotsAttempted: 0,
@@ -1218,7 +1566,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				Status: velerov1api.BackupStatus{
 					Phase:                       velerov1api.BackupPhaseFinalizing,
 					Version:                     1,
-					FormatVersion:               "1.1.0",
+					FormatVersion:               "1.1.1",
 					StartTimestamp:              &timestamp,
 					Expiration:                  &timestamp,
 					CSIVolumeSnapshotsAttempted: 1,
@@ -1259,7 +1607,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				Status: velerov1api.BackupStatus{
 					Phase:                       velerov1api.BackupPhaseFinalizing,
 					Version:                     1,
-					FormatVersion:               "1.1.0",
+					FormatVersion:               "1.1.1",
 					StartTimestamp:              &timestamp,
 					Expiration:                  &timestamp,
 					CSIVolumeSnapshotsAttempted: 0,
@@ -1350,6 +1698,8 @@ func TestProcessBackupCompletions(t *testing.T) {
 						strings.Contains(buf.String(), `"completionTimestamp": "2006-01-02T22:04:05Z"`))
 			}
 			backupStore.On("PutBackup", mock.MatchedBy(hasNameAndCompletionTimestampIfCompleted)).Return(nil)
+			backupStore.On("PutBackupLogChunk", test.backup.Name, mock.Anything, mock.Anything).Return(nil).Maybe()
+			backupStore.On("DeleteBackupLogChunks", test.backup.Name).Return(nil).Maybe()
 
 			// add the test's backup to the informer/lister store
 			require.NotNil(t, test.backup)
@@ -1731,3 +2081,178 @@ func TestPatchResourceWorksWithStatus(t *testing.T) {
 
 	}
 }
+
+func TestUploadBackupLogChunks(t *testing.T) {
+	readChunk := func(log io.Reader) string {
+		gzr, err := gzip.NewReader(log)
+		require.NoError(t, err)
+		data, err := io.ReadAll(gzr)
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	backupStore := &persistencemocks.BackupStore{}
+	lock := sync.Mutex{}
+	uploaded := []string{}
+	backupStore.On("PutBackupLogChunk", "backup-1", 1, mock.Anything).Return(errors.New("fake-error")).Once()
+	backupStore.On("PutBackupLogChunk", "backup-1", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		lock.Lock()
+		defer lock.Unlock()
+		uploaded = append(uploaded, fmt.Sprintf("%d:%s", args.Int(1), readChunk(args.Get(2).(io.Reader))))
+	}).Return(nil)
+	getUploaded := func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]string{}, uploaded...)
+	}
+
+	chunks := &logging.LogChunkBuffer{}
+	chunks.Write([]byte("line-1\n"))
+
+	// the first upload fails and the logs are retried with the next upload
+	stop := uploadBackupLogChunks(backupStore, "backup-1", chunks, 10*time.Millisecond, velerotest.NewLogger())
+	require.Eventually(t, func() bool { return len(getUploaded()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// the remaining logs are uploaded as the last chunk when stopped
+	chunks.Write([]byte("line-2\n"))
+	stop()
+
+	assert.Equal(t, []string{"1:line-1\n", "2:line-2\n"}, getUploaded())
+}
+
+func TestCheckValidationPolicies(t *testing.T) {
+	cm := builder.ForConfigMap("velero", "policies").Data("policies.yaml", `version: v1
+validationPolicies:
+- name: require-volumes
+  condition: persistentVolumes == 0
+  action:
+    type: fail
+  message: no persistent volumes were backed up
+- name: empty-namespaces
+  condition: emptyNamespaces > 0
+  action:
+    type: warn
+- name: errors
+  condition: errors > 0
+  action:
+    type: fail`).Result()
+	policies, err := validationpolicies.GetValidationPoliciesFromConfig(cm)
+	require.NoError(t, err)
+
+	request := &pkgbackup.Request{
+		Backup:             defaultBackup().IncludedNamespaces("app", "app-*").Result(),
+		ValidationPolicies: policies,
+	}
+	logCounter := logging.NewLogHook()
+	logger := logrus.New()
+	logger.Out = io.Discard
+	logger.Hooks.Add(logCounter)
+
+	checkValidationPolicies(request, logCounter, logger)
+
+	assert.Equal(t, []string{
+		`validation policy "require-volumes" is violated: no persistent volumes were backed up`,
+		`validation policy "empty-namespaces" is violated`,
+	}, request.Status.PolicyViolations)
+	assert.Equal(t, 1, logCounter.GetCount(logrus.ErrorLevel))
+	assert.Equal(t, 1, logCounter.GetCount(logrus.WarnLevel))
+}
+
+func TestPrepareClusterResourcesDeduplication(t *testing.T) {
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	newScheduledBackup := func(name, location string, started time.Time, phase velerov1api.BackupPhase) *velerov1api.Backup {
+		return defaultBackup().ObjectMeta(builder.WithName(name), builder.WithLabels(velerov1api.ScheduleNameLabel, "daily")).
+			StorageLocation(location).StartTimestamp(started).Phase(phase).DeduplicateClusterResources(true).Result()
+	}
+	previous := archive.ClusterResources{
+		"resources/storageclasses.storage.k8s.io/cluster/sc-1.json": {ResourceVersion: "1", Backup: "daily-1"},
+	}
+
+	tests := []struct {
+		name                    string
+		backup                  *velerov1api.Backup
+		existing                []*velerov1api.Backup
+		expectedPrevious        string
+		expectClusterResources  bool
+		expectPreviousResources bool
+	}{
+		{
+			name: "backup not deduplicating the cluster resources",
+			backup: defaultBackup().ObjectMeta(builder.WithName("daily-3"), builder.WithLabels(velerov1api.ScheduleNameLabel, "daily")).
+				StorageLocation("default").Result(),
+		},
+		{
+			name:   "backup not created by a schedule",
+			backup: defaultBackup().StorageLocation("default").DeduplicateClusterResources(true).Result(),
+		},
+		{
+			name:                   "first backup of the schedule records its cluster resources",
+			backup:                 newScheduledBackup("daily-1", "default", now, velerov1api.BackupPhaseInProgress),
+			expectClusterResources: true,
+		},
+		{
+			name:   "latest completed backup in the same location is deduplicated against",
+			backup: newScheduledBackup("daily-3", "default", now, velerov1api.BackupPhaseInProgress),
+			existing: []*velerov1api.Backup{
+				newScheduledBackup("daily-0", "default", now.Add(-3*time.Hour), velerov1api.BackupPhaseCompleted),
+				newScheduledBackup("daily-1", "default", now.Add(-2*time.Hour), velerov1api.BackupPhaseCompleted),
+				newScheduledBackup("daily-2", "default", now.Add(-time.Hour), velerov1api.BackupPhasePartiallyFailed),
+				newScheduledBackup("other", "secondary", now.Add(-time.Minute), velerov1api.BackupPhaseCompleted),
+			},
+			expectedPrevious:        "daily-1",
+			expectClusterResources:  true,
+			expectPreviousResources: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var objs []runtime.Object
+			for _, backup := range test.existing {
+				objs = append(objs, backup)
+			}
+			backupStore := &persistencemocks.BackupStore{}
+			if test.expectedPrevious != "" {
+				backupStore.On("GetBackupClusterResources", test.expectedPrevious).Return(previous, nil)
+			}
+			reconciler := &backupReconciler{kbClient: velerotest.NewFakeControllerRuntimeClient(t, objs...)}
+			request := &pkgbackup.Request{Backup: test.backup}
+
+			reconciler.prepareClusterResourcesDeduplication(request, backupStore, velerotest.NewLogger())
+
+			assert.Equal(t, test.expectClusterResources, request.ClusterResources != nil)
+			if test.expectPreviousResources {
+				assert.Equal(t, previous, request.PreviousClusterResources)
+			} else {
+				assert.Nil(t, request.PreviousClusterResources)
+			}
+			backupStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRetainReferencedBackups(t *testing.T) {
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	request := &pkgbackup.Request{
+		Backup: defaultBackup().ObjectMeta(builder.WithName("daily-3")).Expiration(now.Add(720 * time.Hour)).Result(),
+		ClusterResources: archive.ClusterResources{
+			"resources/storageclasses.storage.k8s.io/cluster/sc-1.json": {ResourceVersion: "1", Backup: "daily-1"},
+			"resources/storageclasses.storage.k8s.io/cluster/sc-2.json": {ResourceVersion: "1", Backup: "daily-2"},
+			"resources/storageclasses.storage.k8s.io/cluster/sc-3.json": {ResourceVersion: "2", Backup: "daily-3"},
+		},
+	}
+	client := velerotest.NewFakeControllerRuntimeClient(t,
+		defaultBackup().ObjectMeta(builder.WithName("daily-1")).Expiration(now.Add(24*time.Hour)).Result(),
+		defaultBackup().ObjectMeta(builder.WithName("daily-2")).Expiration(now.Add(1000*time.Hour)).Result(),
+	)
+	reconciler := &backupReconciler{kbClient: client}
+
+	reconciler.retainReferencedBackups(request, velerotest.NewLogger())
+
+	// the expiration of the referenced backup expiring earlier is extended, the other is kept
+	backup := &velerov1api.Backup{}
+	require.NoError(t, client.Get(context.Background(), kbclient.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: "daily-1"}, backup))
+	assert.True(t, now.Add(720*time.Hour).Equal(backup.Status.Expiration.Time))
+	require.NoError(t, client.Get(context.Background(), kbclient.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: "daily-2"}, backup))
+	assert.True(t, now.Add(1000*time.Hour).Equal(backup.Status.Expiration.Time))
+}
@@ -0,0 +1,462 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/datamover"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/podvolume"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+const dataUploadsGroupResource = "datauploads.velero.io"
+
+type newSnapshotCopierFunc func(ctx context.Context, backupCopy *velerov2alpha1api.BackupCopy, sourceBSL string) (podvolume.SnapshotCopier, error)
+
+// BackupCopyReconciler copies the completed backups, including their metadata and the kopia
+// snapshots of their volumes, to other backup storage locations.
+type BackupCopyReconciler struct {
+	client            client.Client
+	namespace         string
+	clock             clocks.WithTickerAndDelayedExecution
+	logger            logrus.FieldLogger
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+	newSnapshotCopier newSnapshotCopierFunc
+}
+
+// NewBackupCopyReconciler constructs a new BackupCopyReconciler.
+func NewBackupCopyReconciler(namespace string, client client.Client, newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter, repoLocker *repository.RepoLocker, repoEnsurer *repository.Ensurer,
+	credentialGetter *credentials.CredentialGetter, logger logrus.FieldLogger) *BackupCopyReconciler {
+	r := &BackupCopyReconciler{
+		client:            client,
+		namespace:         namespace,
+		clock:             clocks.RealClock{},
+		logger:            logger,
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+	}
+
+	r.newSnapshotCopier = func(ctx context.Context, backupCopy *velerov2alpha1api.BackupCopy, sourceBSL string) (podvolume.SnapshotCopier, error) {
+		return podvolume.NewSnapshotCopier(ctx, client, repoLocker, repoEnsurer, credentialGetter, namespace,
+			sourceBSL, backupCopy.Spec.StorageLocation, logger.WithField("backupCopy", backupCopy.Name))
+	}
+
+	return r
+}
+
+func (r *BackupCopyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov2alpha1api.BackupCopy{}).
+		WithOptions(controllerOptions(BackupCopy)).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backupcopies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=backupcopies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get
+
+func (r *BackupCopyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("backupCopy", req.String())
+
+	backupCopy := &velerov2alpha1api.BackupCopy{}
+	if err := r.client.Get(ctx, req.NamespacedName, backupCopy); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find BackupCopy")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrap(err, "error getting BackupCopy")
+	}
+
+	// Only process new items. The in progress ones are the interrupted ones, they are marked as
+	// failed when the server starts.
+	if backupCopy.Status.Phase != "" && backupCopy.Status.Phase != velerov2alpha1api.BackupCopyPhaseNew {
+		log.Debug("BackupCopy is not new, not processing")
+		return ctrl.Result{}, nil
+	}
+
+	backup, source, target, validationErrs := r.validate(ctx, backupCopy)
+	if len(validationErrs) > 0 {
+		log.Infof("Invalid BackupCopy: %s", strings.Join(validationErrs, "; "))
+		return ctrl.Result{}, r.complete(ctx, backupCopy, velerov2alpha1api.BackupCopyPhaseFailedValidation, strings.Join(validationErrs, "; "))
+	}
+
+	log = log.WithFields(logrus.Fields{"backup": backup.Name, "storageLocation": target.Name})
+	log.Info("Copying backup")
+
+	if err := r.patchBackupCopy(ctx, backupCopy, func(c *velerov2alpha1api.BackupCopy) {
+		c.Status.Phase = velerov2alpha1api.BackupCopyPhaseInProgress
+		c.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.copyBackup(ctx, backupCopy, backup, source, target, log); err != nil {
+		log.WithError(err).Error("Error copying backup")
+		return ctrl.Result{}, r.complete(ctx, backupCopy, velerov2alpha1api.BackupCopyPhaseFailed, err.Error())
+	}
+
+	log.Info("Backup is copied")
+	return ctrl.Result{}, r.complete(ctx, backupCopy, velerov2alpha1api.BackupCopyPhaseCompleted, "")
+}
+
+// validate returns the backup to copy, the backup storage location of the backup and the one the
+// backup is copied to, or the validation errors of the BackupCopy.
+func (r *BackupCopyReconciler) validate(ctx context.Context, backupCopy *velerov2alpha1api.BackupCopy) (*velerov1api.Backup,
+	*velerov1api.BackupStorageLocation, *velerov1api.BackupStorageLocation, []string) {
+	backup := &velerov1api.Backup{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: backupCopy.Namespace, Name: backupCopy.Spec.BackupName}, backup); err != nil {
+		return nil, nil, nil, []string{fmt.Sprintf("error getting backup %s: %v", backupCopy.Spec.BackupName, err)}
+	}
+	if backup.Status.Phase != velerov1api.BackupPhaseCompleted {
+		return nil, nil, nil, []string{fmt.Sprintf("backup %s is not completed, its phase is %q", backup.Name, backup.Status.Phase)}
+	}
+	if backup.Spec.StorageLocation == backupCopy.Spec.StorageLocation {
+		return nil, nil, nil, []string{fmt.Sprintf("backup %s is already in backup storage location %s", backup.Name, backup.Spec.StorageLocation)}
+	}
+
+	var errs []string
+	source := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: backupCopy.Namespace, Name: backup.Spec.StorageLocation}, source); err != nil {
+		errs = append(errs, fmt.Sprintf("error getting backup storage location %s of backup: %v", backup.Spec.StorageLocation, err))
+	}
+
+	target := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: backupCopy.Namespace, Name: backupCopy.Spec.StorageLocation}, target); err != nil {
+		errs = append(errs, fmt.Sprintf("error getting backup storage location %s: %v", backupCopy.Spec.StorageLocation, err))
+	} else if target.Spec.IsReadOnly() {
+		errs = append(errs, fmt.Sprintf("backup storage location %s is in read-only mode", target.Name))
+	}
+
+	return backup, source, target, errs
+}
+
+// copyBackup copies the kopia snapshots of the volumes of the backup to the repositories of the
+// target backup storage location, and then writes the backup to the target backup storage location
+// with the references to the snapshots replaced by the copied ones.
+func (r *BackupCopyReconciler) copyBackup(ctx context.Context, backupCopy *velerov2alpha1api.BackupCopy, backup *velerov1api.Backup,
+	source, target *velerov1api.BackupStorageLocation, log logrus.FieldLogger) error {
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	sourceStore, err := r.backupStoreGetter.Get(source, pluginManager, log)
+	if err != nil {
+		return errors.Wrapf(err, "error getting backup store of backup storage location %s", source.Name)
+	}
+
+	targetStore, err := r.backupStoreGetter.Get(target, pluginManager, log)
+	if err != nil {
+		return errors.Wrapf(err, "error getting backup store of backup storage location %s", target.Name)
+	}
+
+	exists, err := targetStore.BackupExists(target.Spec.ObjectStorage.Bucket, backup.Name)
+	if err != nil {
+		return errors.Wrapf(err, "error checking if backup %s exists in backup storage location %s", backup.Name, target.Name)
+	}
+	if exists {
+		return errors.Errorf("backup %s already exists in backup storage location %s", backup.Name, target.Name)
+	}
+
+	info, err := sourceStore.GetBackupInfo(backup.Name)
+	if err != nil {
+		return errors.Wrap(err, "error getting backup files")
+	}
+
+	// the contents are read twice, to find the snapshots of the data uploads and to rewrite them
+	contents, err := downloadToTempFile(backup.Name, sourceStore, log)
+	if err != nil {
+		return errors.Wrap(err, "error downloading backup contents")
+	}
+	defer closeAndRemoveFile(contents, log)
+
+	var pvbs []*velerov1api.PodVolumeBackup
+	if info.PodVolumeBackups != nil {
+		if err := decodeJSONGzip(info.PodVolumeBackups, &pvbs); err != nil {
+			return errors.Wrap(err, "error decoding pod volume backups")
+		}
+	}
+
+	dataUploads, err := archive.ReadItems(contents, dataUploadsGroupResource)
+	if err != nil {
+		return errors.Wrap(err, "error reading data uploads from backup contents")
+	}
+
+	snapshots, err := getVolumeSnapshotsToCopy(pvbs, dataUploads)
+	if err != nil {
+		return err
+	}
+
+	if err := r.patchBackupCopy(ctx, backupCopy, func(c *velerov2alpha1api.BackupCopy) {
+		c.Status.TotalVolumeSnapshots = len(snapshots)
+	}); err != nil {
+		return err
+	}
+
+	copied := map[string]string{}
+	if len(snapshots) > 0 {
+		copier, err := r.newSnapshotCopier(ctx, backupCopy, source.Name)
+		if err != nil {
+			return errors.Wrap(err, "error preparing the repositories")
+		}
+		defer copier.Close(ctx)
+
+		for _, snapshot := range snapshots {
+			copyID, err := copier.CopySnapshot(ctx, snapshot.volumeNamespace, snapshot.snapshotID)
+			if err != nil {
+				return err
+			}
+			copied[snapshot.snapshotID] = copyID
+
+			if err := r.patchBackupCopy(ctx, backupCopy, func(c *velerov2alpha1api.BackupCopy) {
+				c.Status.CopiedVolumeSnapshots++
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return r.putBackupCopy(targetStore, info, contents, backup, pvbs, copied, target.Name, log)
+}
+
+// putBackupCopy writes the backup to the target backup store with the references to the volume
+// snapshots and to the backup storage location replaced.
+func (r *BackupCopyReconciler) putBackupCopy(targetStore persistence.BackupStore, info persistence.BackupInfo, contents *os.File,
+	backup *velerov1api.Backup, pvbs []*velerov1api.PodVolumeBackup, copied map[string]string, location string, log logrus.FieldLogger) error {
+	metadata := backup.DeepCopy()
+	metadata.Spec.StorageLocation = location
+	if metadata.Labels == nil {
+		metadata.Labels = map[string]string{}
+	}
+	metadata.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(location)
+	// the contents are written to the default storage class of the target backup storage location
+	metadata.Status.StorageClass = ""
+	metadata.Status.StorageClassTransitionTimestamp = nil
+	metadataJSON := new(bytes.Buffer)
+	if err := encode.To(metadata, "json", metadataJSON); err != nil {
+		return errors.Wrap(err, "error encoding backup metadata")
+	}
+	info.Metadata = metadataJSON
+
+	if info.PodVolumeBackups != nil {
+		for _, pvb := range pvbs {
+			pvb.Spec.BackupStorageLocation = location
+			if copyID, ok := copied[pvb.Status.SnapshotID]; ok {
+				pvb.Status.SnapshotID = copyID
+			}
+		}
+		encoded, errs := encode.ToJSONGzip(pvbs, "pod volume backups list")
+		if len(errs) > 0 {
+			return errors.Wrap(errs[0], "error encoding pod volume backups")
+		}
+		info.PodVolumeBackups = encoded
+	}
+
+	for i, page := range info.BackupVolumeInfo {
+		var volumeInfos *volume.VolumeInfos
+		if err := decodeJSONGzip(page, &volumeInfos); err != nil {
+			return errors.Wrap(err, "error decoding backup volumes information")
+		}
+		if volumeInfos != nil {
+			for j := range volumeInfos.VolumeInfos {
+				replaceVolumeInfoSnapshots(&volumeInfos.VolumeInfos[j], copied)
+			}
+		}
+		encoded, errs := encode.ToJSONGzip(volumeInfos, "backup volumes information")
+		if len(errs) > 0 {
+			return errors.Wrap(errs[0], "error encoding backup volumes information")
+		}
+		info.BackupVolumeInfo[i] = encoded
+	}
+
+	if _, err := contents.Seek(0, io.SeekStart); err != nil {
+		return errors.Wrap(err, "error seeking backup contents")
+	}
+	rewritten, err := os.CreateTemp("", "velero-backup-copy-")
+	if err != nil {
+		return errors.Wrap(err, "error creating temp file for backup contents")
+	}
+	defer closeAndRemoveFile(rewritten, log)
+
+	checksums, err := archive.RewriteItems(contents, rewritten, dataUploadsGroupResource, func(item []byte) ([]byte, error) {
+		return replaceDataUploadSnapshot(item, copied, location)
+	})
+	if err != nil {
+		return errors.Wrap(err, "error rewriting backup contents")
+	}
+	if _, err := rewritten.Seek(0, io.SeekStart); err != nil {
+		return errors.Wrap(err, "error seeking rewritten backup contents")
+	}
+	info.Contents = rewritten
+
+	// the backups taken before the checksums were introduced don't have the checksums file
+	if info.BackupChecksums != nil {
+		encoded, errs := encode.ToJSONGzip(checksums, "backup checksums")
+		if len(errs) > 0 {
+			return errors.Wrap(errs[0], "error encoding backup checksums")
+		}
+		info.BackupChecksums = encoded
+	}
+
+	if err := targetStore.PutBackup(info); err != nil {
+		return errors.Wrap(err, "error writing backup to the target backup storage location")
+	}
+
+	return nil
+}
+
+// volumeSnapshotToCopy is a kopia snapshot of a volume of the backup.
+type volumeSnapshotToCopy struct {
+	volumeNamespace string
+	snapshotID      string
+}
+
+// getVolumeSnapshotsToCopy returns the kopia snapshots of the pod volume backups and the data
+// uploads of the backup, an error is returned if any of the snapshots can't be copied.
+func getVolumeSnapshotsToCopy(pvbs []*velerov1api.PodVolumeBackup, dataUploads map[string][]byte) ([]volumeSnapshotToCopy, error) {
+	snapshots := map[string]volumeSnapshotToCopy{}
+	for _, pvb := range pvbs {
+		if pvb.Status.SnapshotID == "" {
+			continue
+		}
+		if repoType := podvolume.GetPvbRepositoryType(pvb); repoType != velerov1api.BackupRepositoryTypeKopia {
+			return nil, errors.Errorf("the snapshot of pod volume backup %s is in %s repository, only kopia snapshots can be copied", pvb.Name, repoType)
+		}
+		snapshots[pvb.Status.SnapshotID] = volumeSnapshotToCopy{volumeNamespace: pvb.Spec.Pod.Namespace, snapshotID: pvb.Status.SnapshotID}
+	}
+
+	// the data uploads backed up in multiple versions have the same snapshots
+	for path, item := range dataUploads {
+		du := &velerov2alpha1api.DataUpload{}
+		if err := json.Unmarshal(item, du); err != nil {
+			return nil, errors.Wrapf(err, "error decoding data upload %s", path)
+		}
+		if du.Status.SnapshotID == "" {
+			continue
+		}
+		if !datamover.IsBuiltInUploader(du.Spec.DataMover) {
+			return nil, errors.Errorf("the snapshot of data upload %s is moved by data mover %s, only the snapshots moved by the built-in data mover can be copied", du.Name, du.Spec.DataMover)
+		}
+		snapshots[du.Status.SnapshotID] = volumeSnapshotToCopy{volumeNamespace: du.Spec.SourceNamespace, snapshotID: du.Status.SnapshotID}
+	}
+
+	list := make([]volumeSnapshotToCopy, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		list = append(list, snapshot)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].snapshotID < list[j].snapshotID
+	})
+
+	return list, nil
+}
+
+// replaceDataUploadSnapshot replaces the snapshot and the backup storage location of the data
+// upload item, the other fields of the item are kept as they are.
+func replaceDataUploadSnapshot(item []byte, copied map[string]string, location string) ([]byte, error) {
+	du := &unstructured.Unstructured{}
+	if err := du.UnmarshalJSON(item); err != nil {
+		return nil, err
+	}
+
+	snapshotID, _, err := unstructured.NestedString(du.Object, "status", "snapshotID")
+	if err != nil {
+		return nil, err
+	}
+	copyID, ok := copied[snapshotID]
+	if !ok {
+		return item, nil
+	}
+
+	if err := unstructured.SetNestedField(du.Object, copyID, "status", "snapshotID"); err != nil {
+		return nil, err
+	}
+	if err := unstructured.SetNestedField(du.Object, location, "spec", "backupStorageLocation"); err != nil {
+		return nil, err
+	}
+
+	return du.MarshalJSON()
+}
+
+// replaceVolumeInfoSnapshots replaces the kopia snapshots of the volume information by the copied ones.
+func replaceVolumeInfoSnapshots(volumeInfo *volume.VolumeInfo, copied map[string]string) {
+	if copyID, ok := copied[volumeInfo.SnapshotDataMovementInfo.SnapshotHandle]; ok {
+		volumeInfo.SnapshotDataMovementInfo.SnapshotHandle = copyID
+	}
+	if copyID, ok := copied[volumeInfo.PVBInfo.SnapshotHandle]; ok {
+		volumeInfo.PVBInfo.SnapshotHandle = copyID
+	}
+}
+
+// decodeJSONGzip decodes the gzipped JSON file into the object pointed to by into.
+func decodeJSONGzip(r io.Reader, into interface{}) error {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer gzr.Close()
+
+	return errors.WithStack(json.NewDecoder(gzr).Decode(into))
+}
+
+func (r *BackupCopyReconciler) complete(ctx context.Context, backupCopy *velerov2alpha1api.BackupCopy,
+	phase velerov2alpha1api.BackupCopyPhase, message string) error {
+	return r.patchBackupCopy(ctx, backupCopy, func(c *velerov2alpha1api.BackupCopy) {
+		c.Status.Phase = phase
+		c.Status.Message = message
+		c.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	})
+}
+
+func (r *BackupCopyReconciler) patchBackupCopy(ctx context.Context, backupCopy *velerov2alpha1api.BackupCopy,
+	mutate func(*velerov2alpha1api.BackupCopy)) error {
+	original := backupCopy.DeepCopy()
+	mutate(backupCopy)
+	if err := r.client.Patch(ctx, backupCopy, client.MergeFrom(original)); err != nil {
+		return errors.Wrap(err, "error updating BackupCopy")
+	}
+
+	return nil
+}
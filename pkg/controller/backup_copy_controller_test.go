@@ -0,0 +1,271 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/podvolume"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+)
+
+type fakeSnapshotCopier struct {
+	err    error
+	copied []string
+	closed bool
+}
+
+func (c *fakeSnapshotCopier) CopySnapshot(ctx context.Context, volumeNamespace, snapshotID string) (string, error) {
+	if c.err != nil {
+		return "", c.err
+	}
+	c.copied = append(c.copied, volumeNamespace+"/"+snapshotID)
+	return "copy-" + snapshotID, nil
+}
+
+func (c *fakeSnapshotCopier) Close(ctx context.Context) {
+	c.closed = true
+}
+
+// copiedBackup is the backup written to the target backup store.
+type copiedBackup struct {
+	metadata  *velerov1api.Backup
+	pvbs      []*velerov1api.PodVolumeBackup
+	contents  []byte
+	checksums archive.Checksums
+}
+
+func TestBackupCopyReconcile(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	completedBackup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").Phase(velerov1api.BackupPhaseCompleted).Result()
+	locations := []runtime.Object{
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Bucket("staging").Result(),
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "archive").Bucket("archive").Result(),
+		builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "read-only").Bucket("read-only").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+	}
+	newCopy := func(location string) *velerov2alpha1api.BackupCopy {
+		return builder.ForBackupCopy(velerov1api.DefaultNamespace, "copy-1").BackupName("backup-1").StorageLocation(location).Result()
+	}
+
+	kopiaPVB := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").PodNamespace("ns-1").BackupStorageLocation("default").
+		SnapshotID("snapshot-1").UploaderType(uploader.KopiaType).Result()
+	resticPVB := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-2").PodNamespace("ns-1").BackupStorageLocation("default").
+		SnapshotID("snapshot-3").UploaderType(uploader.ResticType).Result()
+	dataUpload := builder.ForDataUpload(velerov1api.DefaultNamespace, "du-1").SourceNamespace("ns-2").BackupStorageLocation("default").
+		SnapshotID("snapshot-2").Result()
+
+	tests := []struct {
+		name             string
+		backupCopy       *velerov2alpha1api.BackupCopy
+		backup           *velerov1api.Backup
+		pvbs             []*velerov1api.PodVolumeBackup
+		existing         bool
+		copierErr        error
+		expectedCopied   []string
+		expectedPhase    velerov2alpha1api.BackupCopyPhase
+		expectedMessage  string
+		expectedSnapshot int
+	}{
+		{
+			name:          "not new copy is not processed",
+			backupCopy:    builder.ForBackupCopy(velerov1api.DefaultNamespace, "copy-1").BackupName("backup-1").StorageLocation("archive").Phase(velerov2alpha1api.BackupCopyPhaseInProgress).Result(),
+			backup:        completedBackup,
+			expectedPhase: velerov2alpha1api.BackupCopyPhaseInProgress,
+		},
+		{
+			name:            "backup doesn't exist",
+			backupCopy:      newCopy("archive"),
+			expectedPhase:   velerov2alpha1api.BackupCopyPhaseFailedValidation,
+			expectedMessage: `error getting backup backup-1: backups.velero.io "backup-1" not found`,
+		},
+		{
+			name:            "backup is not completed",
+			backupCopy:      newCopy("archive"),
+			backup:          builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").Phase(velerov1api.BackupPhasePartiallyFailed).Result(),
+			expectedPhase:   velerov2alpha1api.BackupCopyPhaseFailedValidation,
+			expectedMessage: `backup backup-1 is not completed, its phase is "PartiallyFailed"`,
+		},
+		{
+			name:            "backup is already in the storage location",
+			backupCopy:      newCopy("default"),
+			backup:          completedBackup,
+			expectedPhase:   velerov2alpha1api.BackupCopyPhaseFailedValidation,
+			expectedMessage: "backup backup-1 is already in backup storage location default",
+		},
+		{
+			name:            "storage location is read-only",
+			backupCopy:      newCopy("read-only"),
+			backup:          completedBackup,
+			expectedPhase:   velerov2alpha1api.BackupCopyPhaseFailedValidation,
+			expectedMessage: "backup storage location read-only is in read-only mode",
+		},
+		{
+			name:            "backup already exists in the storage location",
+			backupCopy:      newCopy("archive"),
+			backup:          completedBackup,
+			existing:        true,
+			expectedPhase:   velerov2alpha1api.BackupCopyPhaseFailed,
+			expectedMessage: "backup backup-1 already exists in backup storage location archive",
+		},
+		{
+			name:            "restic snapshots can't be copied",
+			backupCopy:      newCopy("archive"),
+			backup:          completedBackup,
+			pvbs:            []*velerov1api.PodVolumeBackup{kopiaPVB, resticPVB},
+			expectedPhase:   velerov2alpha1api.BackupCopyPhaseFailed,
+			expectedMessage: "the snapshot of pod volume backup pvb-2 is in restic repository, only kopia snapshots can be copied",
+		},
+		{
+			name:             "snapshot fails to be copied",
+			backupCopy:       newCopy("archive"),
+			backup:           completedBackup,
+			pvbs:             []*velerov1api.PodVolumeBackup{kopiaPVB},
+			copierErr:        errors.New("fake-error"),
+			expectedPhase:    velerov2alpha1api.BackupCopyPhaseFailed,
+			expectedMessage:  "fake-error",
+			expectedSnapshot: 2,
+		},
+		{
+			name:             "backup is copied",
+			backupCopy:       newCopy("archive"),
+			backup:           completedBackup,
+			pvbs:             []*velerov1api.PodVolumeBackup{kopiaPVB},
+			expectedCopied:   []string{"ns-1/snapshot-1", "ns-2/snapshot-2"},
+			expectedPhase:    velerov2alpha1api.BackupCopyPhaseCompleted,
+			expectedSnapshot: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objs := append([]runtime.Object{test.backupCopy}, locations...)
+			if test.backup != nil {
+				objs = append(objs, test.backup)
+			}
+			cli := velerotest.NewFakeControllerRuntimeClient(t, objs...)
+
+			sourceStore := &persistencemocks.BackupStore{}
+			targetStore := &persistencemocks.BackupStore{}
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return()
+
+			pvbs, errs := encode.ToJSONGzip(test.pvbs, "pod volume backups list")
+			require.Empty(t, errs)
+			contents := velerotest.NewTarWriter(t).AddItems("datauploads.velero.io", dataUpload).Done().Bytes()
+			checksums, err := archive.ComputeChecksums(bytes.NewReader(contents))
+			require.NoError(t, err)
+			encodedChecksums, errs := encode.ToJSONGzip(checksums, "backup checksums")
+			require.Empty(t, errs)
+
+			sourceStore.On("GetBackupInfo", "backup-1").Return(persistence.BackupInfo{
+				Name:             "backup-1",
+				PodVolumeBackups: pvbs,
+				BackupChecksums:  encodedChecksums,
+			}, nil)
+			sourceStore.On("GetBackupContents", "backup-1").Return(io.NopCloser(bytes.NewReader(contents)), nil)
+			targetStore.On("BackupExists", "archive", "backup-1").Return(test.existing, nil)
+
+			copied := &copiedBackup{}
+			targetStore.On("PutBackup", mock.Anything).Run(func(args mock.Arguments) {
+				info := args.Get(0).(persistence.BackupInfo)
+				copied.metadata = &velerov1api.Backup{}
+				require.NoError(t, json.NewDecoder(info.Metadata).Decode(copied.metadata))
+				require.NoError(t, decodeJSONGzip(info.PodVolumeBackups, &copied.pvbs))
+				require.NoError(t, decodeJSONGzip(info.BackupChecksums, &copied.checksums))
+				copied.contents, err = io.ReadAll(info.Contents)
+				require.NoError(t, err)
+			}).Return(nil)
+
+			copier := &fakeSnapshotCopier{err: test.copierErr}
+			r := NewBackupCopyReconciler(
+				velerov1api.DefaultNamespace,
+				cli,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"default": sourceStore, "archive": targetStore}),
+				nil, nil, nil,
+				velerotest.NewLogger(),
+			)
+			r.clock = testclocks.NewFakeClock(now)
+			r.newSnapshotCopier = func(ctx context.Context, backupCopy *velerov2alpha1api.BackupCopy, sourceBSL string) (podvolume.SnapshotCopier, error) {
+				assert.Equal(t, "default", sourceBSL)
+				return copier, nil
+			}
+
+			key := types.NamespacedName{Namespace: test.backupCopy.Namespace, Name: test.backupCopy.Name}
+			_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+			require.NoError(t, err)
+
+			backupCopy := &velerov2alpha1api.BackupCopy{}
+			require.NoError(t, cli.Get(context.Background(), key, backupCopy))
+			assert.Equal(t, test.expectedPhase, backupCopy.Status.Phase)
+			assert.Equal(t, test.expectedMessage, backupCopy.Status.Message)
+			assert.Equal(t, test.expectedSnapshot, backupCopy.Status.TotalVolumeSnapshots)
+			assert.Equal(t, len(test.expectedCopied), backupCopy.Status.CopiedVolumeSnapshots)
+			assert.Equal(t, test.expectedCopied, copier.copied)
+
+			if test.expectedPhase != velerov2alpha1api.BackupCopyPhaseCompleted {
+				targetStore.AssertNotCalled(t, "PutBackup", mock.Anything)
+				return
+			}
+			assert.True(t, copier.closed)
+
+			// the copied backup references the target storage location and the copied snapshots
+			assert.Equal(t, "archive", copied.metadata.Spec.StorageLocation)
+			require.Len(t, copied.pvbs, 1)
+			assert.Equal(t, "copy-snapshot-1", copied.pvbs[0].Status.SnapshotID)
+			assert.Equal(t, "archive", copied.pvbs[0].Spec.BackupStorageLocation)
+
+			items, err := archive.ReadItems(bytes.NewReader(copied.contents), "datauploads.velero.io")
+			require.NoError(t, err)
+			require.Len(t, items, 1)
+			du := &velerov2alpha1api.DataUpload{}
+			require.NoError(t, json.Unmarshal(items["resources/datauploads.velero.io/namespaces/velero/du-1.json"], du))
+			assert.Equal(t, "copy-snapshot-2", du.Status.SnapshotID)
+			assert.Equal(t, "archive", du.Spec.BackupStorageLocation)
+
+			checksums, err = archive.ComputeChecksums(bytes.NewReader(copied.contents))
+			require.NoError(t, err)
+			assert.Equal(t, checksums, copied.checksums)
+		})
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -42,6 +44,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/delete"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/audit"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
@@ -61,6 +64,17 @@ import (
 const (
 	snapshotDeleteTimeout     = time.Minute
 	deleteBackupRequestMaxAge = 24 * time.Hour
+	// the backup objects are written after the backup's completion timestamp is set,
+	// so wait a little longer than the retention period for them to be unlocked
+	objectLockExpiryBuffer = 10 * time.Minute
+
+	// referencedBackupRequeueInterval is how often the deletion of a backup referenced by the
+	// deduplicated cluster-scoped items of other backups is retried.
+	referencedBackupRequeueInterval = 5 * time.Minute
+
+	// immutableObjectsRequeueInterval is how often the deletion of a backup whose objects are
+	// immutable until an unknown time, e.g. under legal holds, is retried.
+	immutableObjectsRequeueInterval = time.Hour
 )
 
 type backupDeletionReconciler struct {
@@ -74,6 +88,7 @@ type backupDeletionReconciler struct {
 	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter persistence.ObjectBackupStoreGetter
 	credentialStore   credentials.FileStore
+	auditRecorder     *audit.Recorder
 }
 
 // NewBackupDeletionReconciler creates a new backup deletion reconciler.
@@ -87,6 +102,7 @@ func NewBackupDeletionReconciler(
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
 	credentialStore credentials.FileStore,
+	auditRecorder *audit.Recorder,
 ) *backupDeletionReconciler {
 	return &backupDeletionReconciler{
 		Client:            client,
@@ -99,6 +115,7 @@ func NewBackupDeletionReconciler(
 		newPluginManager:  newPluginManager,
 		backupStoreGetter: backupStoreGetter,
 		credentialStore:   credentialStore,
+		auditRecorder:     auditRecorder,
 	}
 }
 
@@ -108,6 +125,7 @@ func (r *backupDeletionReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&velerov1api.DeleteBackupRequest{}).
 		Watches(s, nil).
+		WithOptions(controllerOptions(BackupDeletion)).
 		Complete(r)
 }
 
@@ -189,6 +207,16 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, errors.Wrap(err, "error getting backup")
 	}
 
+	// Don't allow deleting the backups imported from other clusters, even if the access mode of
+	// their storage location is changed afterwards
+	if backup.Labels[velerov1api.ImportedBackupLabel] == "true" {
+		_, err := r.patchDeleteBackupRequest(ctx, dbr, func(r *velerov1api.DeleteBackupRequest) {
+			r.Status.Phase = velerov1api.DeleteBackupRequestPhaseProcessed
+			r.Status.Errors = append(r.Status.Errors, "cannot delete backup because it's imported from another cluster")
+		})
+		return ctrl.Result{}, err
+	}
+
 	// Don't allow deleting backups in read-only storage locations
 	location := &velerov1api.BackupStorageLocation{}
 	if err := r.Get(context.Background(), client.ObjectKey{
@@ -205,7 +233,7 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, errors.Wrap(err, "error getting backup storage location")
 	}
 
-	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+	if location.Spec.IsReadOnly() {
 		_, err := r.patchDeleteBackupRequest(ctx, dbr, func(r *velerov1api.DeleteBackupRequest) {
 			r.Status.Phase = velerov1api.DeleteBackupRequestPhaseProcessed
 			r.Status.Errors = append(r.Status.Errors, fmt.Sprintf("cannot delete backup because backup storage location %s is currently in read-only mode", location.Name))
@@ -213,13 +241,52 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err
 	}
 
+	// Defer the deletion until the backup's objects are unlocked in the storage location
+	if lockExpiry := objectLockExpiry(location, backup); lockExpiry.After(r.clock.Now()) {
+		log.Infof("Backup objects are locked by storage location %s until %s, defer the deletion", location.Name, lockExpiry)
+		return ctrl.Result{RequeueAfter: lockExpiry.Sub(r.clock.Now())}, nil
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error getting the backup store")
+	}
+
+	// Defer the deletion until the backup's objects made immutable natively by the object storage
+	// can be deleted
+	immutableObjects, err := backupStore.GetBackupImmutableObjects(backup.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(immutableObjects) > 0 {
+		if expiresOn := immutableObjectsExpiry(immutableObjects); expiresOn != nil {
+			log.Infof("Backup has %d immutable objects in storage location %s until %s, defer the deletion", len(immutableObjects), location.Name, expiresOn)
+			return ctrl.Result{RequeueAfter: expiresOn.Sub(r.clock.Now()) + objectLockExpiryBuffer}, nil
+		}
+		log.Infof("Backup has %d immutable objects under legal holds in storage location %s, defer the deletion", len(immutableObjects), location.Name)
+		return ctrl.Result{RequeueAfter: immutableObjectsRequeueInterval}, nil
+	}
+
+	// Defer the deletion until no other backup stores references to the backup's contents
+	referencing, err := r.referencingBackups(ctx, backup, backupStore)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(referencing) > 0 {
+		log.Infof("Backup contents store the cluster-scoped items deduplicated by backups %s, defer the deletion", strings.Join(referencing, ", "))
+		return ctrl.Result{RequeueAfter: referencedBackupRequeueInterval}, nil
+	}
+
 	// if the request object has no labels defined, initialize an empty map since
 	// we will be updating labels
 	if dbr.Labels == nil {
 		dbr.Labels = map[string]string{}
 	}
 	// Update status to InProgress and set backup-name and backup-uid label if needed
-	dbr, err := r.patchDeleteBackupRequest(ctx, dbr, func(r *velerov1api.DeleteBackupRequest) {
+	dbr, err = r.patchDeleteBackupRequest(ctx, dbr, func(r *velerov1api.DeleteBackupRequest) {
 		r.Status.Phase = velerov1api.DeleteBackupRequestPhaseInProgress
 
 		if r.Labels[velerov1api.BackupNameLabel] == "" {
@@ -246,14 +313,6 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
 	r.metrics.RegisterBackupDeletionAttempt(backupScheduleName)
 
-	pluginManager := r.newPluginManager(log)
-	defer pluginManager.CleanupClients()
-
-	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
-	if err != nil {
-		return ctrl.Result{}, errors.Wrap(err, "error getting the backup store")
-	}
-
 	actions, err := pluginManager.GetDeleteItemActions()
 	log.Debugf("%d actions before invoking actions", len(actions))
 	if err != nil {
@@ -350,9 +409,13 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	if backupStore != nil {
-		log.Info("Removing backup from backup storage")
-		if err := backupStore.DeleteBackup(backup.Name); err != nil {
-			errs = append(errs, err.Error())
+		if expiredInLifecycleMode(location, backup, r.clock.Now()) {
+			log.Info("Skipping removing the expired backup from backup storage, it's removed by the lifecycle policies of the bucket")
+		} else {
+			log.Info("Removing backup from backup storage")
+			if err := backupStore.DeleteBackup(backup.Name); err != nil {
+				errs = append(errs, err.Error())
+			}
 		}
 	}
 
@@ -426,6 +489,7 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}); err != nil {
 		return ctrl.Result{}, err
 	}
+	r.auditRecorder.Record(backup.Namespace, backup.Spec.StorageLocation, audit.ForBackupDeletion(dbr, backup, errs))
 	// Everything deleted correctly, so we can delete all DeleteBackupRequests for this backup
 	if len(errs) == 0 {
 		labelSelector, err := labels.Parse(fmt.Sprintf("%s=%s,%s=%s", velerov1api.BackupNameLabel, label.GetValidName(backup.Name), velerov1api.BackupUIDLabel, backup.UID))
@@ -560,6 +624,89 @@ func (r *backupDeletionReconciler) deleteMovedSnapshots(ctx context.Context, bac
 	return errs
 }
 
+// objectLockExpiry returns the time when the backup's objects are unlocked in the
+// storage location. Zero time is returned if the objects are not locked.
+func objectLockExpiry(location *velerov1api.BackupStorageLocation, backup *velerov1api.Backup) time.Time {
+	if location.Spec.ObjectStorage == nil || location.Spec.ObjectStorage.ObjectLock == nil {
+		return time.Time{}
+	}
+
+	// the objects are not uploaded until the backup completes
+	if backup.Status.CompletionTimestamp == nil {
+		return time.Time{}
+	}
+
+	return backup.Status.CompletionTimestamp.Add(location.Spec.ObjectStorage.ObjectLock.RetentionPeriod.Duration + objectLockExpiryBuffer)
+}
+
+// referencingBackups returns the names of the other backups of the schedule which deduplicate
+// cluster-scoped items stored in the contents of the backup. The backups of the schedule whose
+// cluster-scoped items aren't persisted yet are returned as well, as they may reference the
+// backup once completed.
+func (r *backupDeletionReconciler) referencingBackups(ctx context.Context, backup *velerov1api.Backup, backupStore persistence.BackupStore) ([]string, error) {
+	schedule := backup.Labels[velerov1api.ScheduleNameLabel]
+	if schedule == "" {
+		return nil, nil
+	}
+
+	backups := &velerov1api.BackupList{}
+	if err := r.List(ctx, backups, client.InNamespace(backup.Namespace), client.MatchingLabels{velerov1api.ScheduleNameLabel: schedule}); err != nil {
+		return nil, errors.Wrap(err, "error listing the backups of the schedule")
+	}
+
+	var referencing []string
+	for i := range backups.Items {
+		other := &backups.Items[i]
+		if other.Name == backup.Name || other.Spec.StorageLocation != backup.Spec.StorageLocation ||
+			!boolptr.IsSetToTrue(other.Spec.DeduplicateClusterResources) {
+			continue
+		}
+
+		switch other.Status.Phase {
+		case "", velerov1api.BackupPhaseNew, velerov1api.BackupPhaseInProgress:
+			referencing = append(referencing, other.Name)
+			continue
+		}
+		if other.Status.ClusterResourcesDeduplicated == 0 {
+			continue
+		}
+
+		clusterResources, err := backupStore.GetBackupClusterResources(other.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting the cluster-scoped items of backup %s", other.Name)
+		}
+		if _, ok := clusterResources.ReferencedPaths(other.Name)[backup.Name]; ok {
+			referencing = append(referencing, other.Name)
+		}
+	}
+	sort.Strings(referencing)
+	return referencing, nil
+}
+
+// immutableObjectsExpiry returns the time when all the immutable objects can be deleted, nil is
+// returned if the time isn't known for any object.
+func immutableObjectsExpiry(objects []persistence.ImmutableObject) *time.Time {
+	var expiry *time.Time
+	for _, object := range objects {
+		if object.ExpiresOn == nil {
+			return nil
+		}
+		if expiry == nil || object.ExpiresOn.After(*expiry) {
+			expiry = object.ExpiresOn
+		}
+	}
+	return expiry
+}
+
+// expiredInLifecycleMode returns true if the backup is expired and its objects are removed by the
+// lifecycle policies of the bucket of the storage location.
+func expiredInLifecycleMode(location *velerov1api.BackupStorageLocation, backup *velerov1api.Backup, now time.Time) bool {
+	if location.Spec.ObjectStorage == nil || location.Spec.ObjectStorage.ExpirationMode != velerov1api.ObjectStorageExpirationModeLifecycle {
+		return false
+	}
+	return backup.Status.Expiration != nil && !backup.Status.Expiration.After(now)
+}
+
 func (r *backupDeletionReconciler) patchDeleteBackupRequest(ctx context.Context, req *velerov1api.DeleteBackupRequest, mutate func(*velerov1api.DeleteBackupRequest)) (*velerov1api.DeleteBackupRequest, error) {
 	original := req.DeepCopy()
 	mutate(req)
@@ -47,9 +47,11 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/volume"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
@@ -95,6 +97,7 @@ func setupBackupDeletionControllerTest(t *testing.T, req *velerov1api.DeleteBack
 			func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 			NewFakeSingleObjectBackupStoreGetter(backupStore),
 			velerotest.NewFakeCredentialsFileStore("", nil),
+			nil, // audit recorder
 		),
 		req: ctrl.Request{NamespacedName: types.NamespacedName{Namespace: req.Namespace, Name: req.Name}},
 	}
@@ -257,6 +260,134 @@ func TestBackupDeletionControllerReconcile(t *testing.T) {
 		assert.Equal(t, 1, len(res.Status.Errors))
 		assert.Equal(t, "cannot delete backup because backup storage location default is currently in read-only mode", res.Status.Errors[0])
 	})
+	t.Run("backup is imported from another cluster", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").ObjectMeta(builder.WithLabels(velerov1api.ImportedBackupLabel, "true")).Result()
+		location := builder.ForBackupStorageLocation("velero", "default").AccessMode(velerov1api.BackupStorageLocationAccessModeReadWrite).Result()
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), location, backup)
+
+		_, err := td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+
+		res := &velerov1api.DeleteBackupRequest{}
+		err = td.fakeClient.Get(ctx, td.req.NamespacedName, res)
+		require.NoError(t, err)
+		assert.Equal(t, "Processed", string(res.Status.Phase))
+		assert.Equal(t, []string{"cannot delete backup because it's imported from another cluster"}, res.Status.Errors)
+	})
+	t.Run("backup objects are locked by the storage location", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").CompletionTimestamp(time.Now()).Result()
+		location := builder.ForBackupStorageLocation("velero", "default").Bucket("bucket").ObjectLock(velerov1api.ObjectLockModeCompliance, 24*time.Hour).Result()
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), location, backup)
+
+		result, err := td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+		assert.Greater(t, result.RequeueAfter, 24*time.Hour)
+
+		res := &velerov1api.DeleteBackupRequest{}
+		err = td.fakeClient.Get(ctx, td.req.NamespacedName, res)
+		require.NoError(t, err)
+		assert.Empty(t, res.Status.Phase)
+
+		err = td.fakeClient.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, &velerov1api.Backup{})
+		require.NoError(t, err)
+	})
+	t.Run("backup objects are immutable in the storage location", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Result()
+		location := builder.ForBackupStorageLocation("velero", "default").Provider("azure").Bucket("bucket").Result()
+		expiresOn := time.Now().Add(48 * time.Hour)
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), location, backup)
+		td.backupStore.On("GetBackupImmutableObjects", backup.Name).Return([]persistence.ImmutableObject{
+			{Key: "backups/foo/foo.tar.gz", ExpiresOn: &expiresOn},
+			{Key: "backups/foo/velero-backup.json", ExpiresOn: &expiresOn},
+		}, nil)
+
+		result, err := td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+		assert.Greater(t, result.RequeueAfter, 47*time.Hour)
+
+		res := &velerov1api.DeleteBackupRequest{}
+		err = td.fakeClient.Get(ctx, td.req.NamespacedName, res)
+		require.NoError(t, err)
+		assert.Empty(t, res.Status.Phase)
+
+		err = td.fakeClient.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, &velerov1api.Backup{})
+		require.NoError(t, err)
+		td.backupStore.AssertNotCalled(t, "DeleteBackup", mock.Anything)
+	})
+	t.Run("backup objects are under legal holds in the storage location", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Result()
+		location := builder.ForBackupStorageLocation("velero", "default").Provider("azure").Bucket("bucket").Result()
+		expiresOn := time.Now().Add(48 * time.Hour)
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), location, backup)
+		td.backupStore.On("GetBackupImmutableObjects", backup.Name).Return([]persistence.ImmutableObject{
+			{Key: "backups/foo/foo.tar.gz", ExpiresOn: &expiresOn},
+			{Key: "backups/foo/velero-backup.json"},
+		}, nil)
+
+		result, err := td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+		assert.Equal(t, immutableObjectsRequeueInterval, result.RequeueAfter)
+
+		res := &velerov1api.DeleteBackupRequest{}
+		err = td.fakeClient.Get(ctx, td.req.NamespacedName, res)
+		require.NoError(t, err)
+		assert.Empty(t, res.Status.Phase)
+		td.backupStore.AssertNotCalled(t, "DeleteBackup", mock.Anything)
+	})
+	t.Run("backup contents store the cluster-scoped items deduplicated by other backups", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1")).
+			StorageLocation("default").DeduplicateClusterResources(true).Phase(velerov1api.BackupPhaseCompleted).Result()
+		referencing := builder.ForBackup(velerov1api.DefaultNamespace, "bar").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1")).
+			StorageLocation("default").DeduplicateClusterResources(true).Phase(velerov1api.BackupPhaseCompleted).ClusterResourcesDeduplicated(1).Result()
+		location := builder.ForBackupStorageLocation("velero", "default").Provider("objStoreProvider").Bucket("bucket").Result()
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), location, backup, referencing)
+		td.backupStore.On("GetBackupImmutableObjects", backup.Name).Return(nil, nil)
+		td.backupStore.On("GetBackupClusterResources", referencing.Name).Return(archive.ClusterResources{
+			"resources/namespaces/cluster/ns-1.json": {ResourceVersion: "1", Backup: "foo"},
+			"resources/namespaces/cluster/ns-2.json": {ResourceVersion: "2", Backup: "bar"},
+		}, nil)
+
+		result, err := td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+		assert.Equal(t, referencedBackupRequeueInterval, result.RequeueAfter)
+
+		res := &velerov1api.DeleteBackupRequest{}
+		err = td.fakeClient.Get(ctx, td.req.NamespacedName, res)
+		require.NoError(t, err)
+		assert.Empty(t, res.Status.Phase)
+
+		err = td.fakeClient.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, &velerov1api.Backup{})
+		require.NoError(t, err)
+		td.backupStore.AssertNotCalled(t, "DeleteBackup", mock.Anything)
+	})
+	t.Run("expired backup objects are removed by the lifecycle policies of the storage location", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Expiration(time.Now().Add(-time.Hour)).Result()
+		backup.UID = "uid"
+		location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Provider("objStoreProvider").Bucket("bucket").
+			ExpirationMode(velerov1api.ObjectStorageExpirationModeLifecycle).Result()
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), location, backup)
+
+		pluginManager := &pluginmocks.Manager{}
+		pluginManager.On("GetDeleteItemActions").Return(nil, nil)
+		pluginManager.On("CleanupClients")
+		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+
+		td.backupStore.On("GetBackupImmutableObjects", backup.Name).Return(nil, nil)
+		td.backupStore.On("GetBackupVolumeSnapshots", backup.Name).Return(nil, nil)
+
+		_, err := td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+
+		err = td.fakeClient.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, &velerov1api.Backup{})
+		assert.True(t, apierrors.IsNotFound(err), "Expected not found error, but actual value of error: %v", err)
+		td.backupStore.AssertNotCalled(t, "DeleteBackup", mock.Anything)
+	})
 	t.Run("full delete, no errors", func(t *testing.T) {
 
 		input := defaultTestDbr()
@@ -319,6 +450,7 @@ func TestBackupDeletionControllerReconcile(t *testing.T) {
 		pluginManager.On("CleanupClients")
 		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
+		td.backupStore.On("GetBackupImmutableObjects", input.Spec.BackupName).Return(nil, nil)
 		td.backupStore.On("GetBackupVolumeSnapshots", input.Spec.BackupName).Return(snapshots, nil)
 		td.backupStore.On("GetBackupContents", input.Spec.BackupName).Return(io.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
 		td.backupStore.On("DeleteBackup", input.Spec.BackupName).Return(nil)
@@ -436,6 +568,7 @@ func TestBackupDeletionControllerReconcile(t *testing.T) {
 		pluginManager.On("CleanupClients")
 		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
+		td.backupStore.On("GetBackupImmutableObjects", dbr.Spec.BackupName).Return(nil, nil)
 		td.backupStore.On("GetBackupVolumeSnapshots", dbr.Spec.BackupName).Return(snapshots, nil)
 		td.backupStore.On("GetBackupContents", dbr.Spec.BackupName).Return(io.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
 		td.backupStore.On("DeleteBackup", dbr.Spec.BackupName).Return(nil)
@@ -539,6 +672,7 @@ func TestBackupDeletionControllerReconcile(t *testing.T) {
 		pluginManager.On("CleanupClients")
 		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
+		td.backupStore.On("GetBackupImmutableObjects", input.Spec.BackupName).Return(nil, nil)
 		td.backupStore.On("GetBackupVolumeSnapshots", input.Spec.BackupName).Return(snapshots, nil)
 		td.backupStore.On("DeleteBackup", input.Spec.BackupName).Return(nil)
 
@@ -621,6 +755,7 @@ func TestBackupDeletionControllerReconcile(t *testing.T) {
 		pluginManager.On("CleanupClients")
 		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
+		td.backupStore.On("GetBackupImmutableObjects", input.Spec.BackupName).Return(nil, nil)
 		td.backupStore.On("GetBackupVolumeSnapshots", input.Spec.BackupName).Return(snapshots, nil)
 		td.backupStore.On("GetBackupContents", input.Spec.BackupName).Return(nil, fmt.Errorf("error downloading tarball"))
 		td.backupStore.On("DeleteBackup", input.Spec.BackupName).Return(nil)
@@ -19,12 +19,15 @@ package controller
 import (
 	"bytes"
 	"context"
+	"io"
 	"os"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	clocks "k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,12 +35,16 @@ import (
 	snapshotv1listers "github.com/kubernetes-csi/external-snapshotter/client/v4/listers/volumesnapshot/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/notification"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
+	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
 // backupFinalizerReconciler reconciles a Backup object
@@ -51,6 +58,7 @@ type backupFinalizerReconciler struct {
 	metrics              *metrics.ServerMetrics
 	backupStoreGetter    persistence.ObjectBackupStoreGetter
 	log                  logrus.FieldLogger
+	notifier             *notification.Dispatcher
 }
 
 // NewBackupFinalizerReconciler initializes and returns backupFinalizerReconciler struct.
@@ -64,6 +72,7 @@ func NewBackupFinalizerReconciler(
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
 	log logrus.FieldLogger,
 	metrics *metrics.ServerMetrics,
+	notifier *notification.Dispatcher,
 ) *backupFinalizerReconciler {
 	return &backupFinalizerReconciler{
 		client:            client,
@@ -74,6 +83,7 @@ func NewBackupFinalizerReconciler(
 		backupStoreGetter: backupStoreGetter,
 		log:               log,
 		metrics:           metrics,
+		notifier:          notifier,
 	}
 }
 
@@ -118,6 +128,9 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			log.WithError(err).Error("Error updating backup")
 			return
 		}
+		if eventType, ok := notification.BackupEventType(backup.Status.Phase); ok {
+			r.notifier.Notify(notification.ForBackup(eventType, backup))
+		}
 	}()
 
 	location := &velerov1api.BackupStorageLocation{}
@@ -206,12 +219,65 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		if err != nil {
 			return ctrl.Result{}, errors.Wrap(err, "error uploading backup final contents")
 		}
+
+		// the checksums change with the items updated by the finalizer
+		checksumsJSON, errs := encode.ToJSONGzip(backupRequest.Checksums, "backup checksums")
+		if errs != nil {
+			return ctrl.Result{}, errors.Wrap(kerrors.NewAggregate(errs), "error encoding backup checksums")
+		}
+		if err := backupStore.PutBackupChecksums(backup.Name, checksumsJSON); err != nil {
+			return ctrl.Result{}, errors.Wrap(err, "error uploading backup checksums")
+		}
+
+		// the data of the snapshots is moved by the async operations, so the volumes of the data
+		// uploads are added to the volume information persisted with the backup
+		if err := r.updateBackupVolumeInfos(ctx, backupStore, backup); err != nil {
+			return ctrl.Result{}, errors.Wrap(err, "error updating backup volumes information")
+		}
 	}
 	return ctrl.Result{}, nil
 }
 
+// updateBackupVolumeInfos adds the volume information of the completed data uploads of the backup
+// to the volume information in the backup store.
+func (r *backupFinalizerReconciler) updateBackupVolumeInfos(ctx context.Context, backupStore persistence.BackupStore, backup *velerov1api.Backup) error {
+	dataUploads := &velerov2alpha1api.DataUploadList{}
+	if err := r.client.List(ctx, dataUploads, &kbclient.ListOptions{
+		Namespace:     backup.Namespace,
+		LabelSelector: labels.SelectorFromSet(map[string]string{velerov1api.BackupNameLabel: label.GetValidName(backup.Name)}),
+	}); err != nil {
+		return errors.Wrap(err, "error listing DataUploads")
+	}
+
+	dataUploadInfos := pkgbackup.DataUploadVolumeInfos(dataUploads.Items)
+	if len(dataUploadInfos) == 0 {
+		return nil
+	}
+
+	volumeInfos, err := backupStore.GetBackupVolumeInfos(backup.Name)
+	if err != nil {
+		return errors.Wrap(err, "error getting backup volumes information")
+	}
+	if volumeInfos == nil {
+		volumeInfos = &volume.VolumeInfos{}
+	}
+	volumeInfos.VolumeInfos = append(volumeInfos.VolumeInfos, dataUploadInfos...)
+
+	var pages []io.Reader
+	for _, page := range pkgbackup.PaginateVolumeInfos(volumeInfos.VolumeInfos, pkgbackup.MetadataPageSize) {
+		encoded, errs := encode.ToJSONGzip(page, "backup volumes information")
+		if errs != nil {
+			return kerrors.NewAggregate(errs)
+		}
+		pages = append(pages, encoded)
+	}
+
+	return backupStore.PutBackupVolumeInfos(backup.Name, pages)
+}
+
 func (r *backupFinalizerReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&velerov1api.Backup{}).
+		WithOptions(controllerOptions(BackupFinalizer)).
 		Complete(r)
 }
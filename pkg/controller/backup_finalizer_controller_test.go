@@ -35,7 +35,9 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
@@ -45,6 +47,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	velerotestmocks "github.com/vmware-tanzu/velero/pkg/test/mocks"
+	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
 func mockBackupFinalizerReconciler(fakeClient kbclient.Client, fakeVolumeSnapshotLister snapshotv1listers.VolumeSnapshotLister, fakeClock *testclocks.FakeClock) (*backupFinalizerReconciler, *fakeBackupper) {
@@ -59,6 +62,7 @@ func mockBackupFinalizerReconciler(fakeClient kbclient.Client, fakeVolumeSnapsho
 		NewFakeSingleObjectBackupStoreGetter(backupStore),
 		logrus.StandardLogger(),
 		metrics.NewServerMetrics(),
+		nil,
 	), backupper
 }
 func TestBackupFinalizerReconcile(t *testing.T) {
@@ -72,6 +76,7 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 		backup           *velerov1api.Backup
 		backupOperations []*itemoperation.BackupOperation
 		backupLocation   *velerov1api.BackupStorageLocation
+		dataUploads      []*velerov2alpha1api.DataUpload
 		expectError      bool
 		expectPhase      velerov1api.BackupPhase
 	}{
@@ -83,7 +88,17 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 				StartTimestamp(fakeClock.Now()).
 				Phase(velerov1api.BackupPhaseFinalizing).Result(),
 			backupLocation: defaultBackupLocation,
-			expectPhase:    velerov1api.BackupPhaseCompleted,
+			dataUploads: []*velerov2alpha1api.DataUpload{
+				func() *velerov2alpha1api.DataUpload {
+					du := builder.ForDataUpload(velerov1api.DefaultNamespace, "du-1").
+						Labels(map[string]string{velerov1api.BackupNameLabel: "backup-1"}).
+						SourceNamespace("ns-1").SourcePVC("pvc-1").SnapshotID("snapshot-1").
+						Phase(velerov2alpha1api.DataUploadPhaseCompleted).Result()
+					du.Status.UploadStats = shared.UploadStats{LogicalBytes: 1024, UploadedBytes: 256}
+					return du
+				}(),
+			},
+			expectPhase: velerov1api.BackupPhaseCompleted,
 			backupOperations: []*itemoperation.BackupOperation{
 				{
 					Spec: itemoperation.BackupOperationSpec{
@@ -161,6 +176,9 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 			if test.backupLocation != nil {
 				initObjs = append(initObjs, test.backupLocation)
 			}
+			for _, du := range test.dataUploads {
+				initObjs = append(initObjs, du)
+			}
 
 			fakeClient := velerotest.NewFakeControllerRuntimeClient(t, initObjs...)
 
@@ -171,9 +189,19 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 			backupStore.On("GetBackupItemOperations", test.backup.Name).Return(test.backupOperations, nil)
 			backupStore.On("GetBackupContents", mock.Anything).Return(io.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
 			backupStore.On("PutBackupContents", mock.Anything, mock.Anything).Return(nil)
+			backupStore.On("PutBackupChecksums", mock.Anything, mock.Anything).Return(nil)
 			backupStore.On("PutBackupMetadata", mock.Anything, mock.Anything).Return(nil)
 			pluginManager.On("GetBackupItemActionsV2").Return(nil, nil)
 			backupper.On("FinalizeBackup", mock.Anything, mock.Anything, mock.Anything, mock.Anything, framework.BackupItemActionResolverV2{}, mock.Anything).Return(nil)
+			var volumeInfos *volume.VolumeInfos
+			if len(test.dataUploads) > 0 {
+				backupStore.On("GetBackupVolumeInfos", test.backup.Name).Return(nil, nil)
+				backupStore.On("PutBackupVolumeInfos", test.backup.Name, mock.Anything).Run(func(args mock.Arguments) {
+					pages := args.Get(1).([]io.Reader)
+					require.Len(t, pages, 1)
+					require.NoError(t, decodeJSONGzip(pages[0], &volumeInfos))
+				}).Return(nil)
+			}
 			_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: test.backup.Namespace, Name: test.backup.Name}})
 			gotErr := err != nil
 			assert.Equal(t, test.expectError, gotErr)
@@ -186,6 +214,14 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 
 			require.NoError(t, err)
 			assert.Equal(t, test.expectPhase, backupAfter.Status.Phase)
+
+			if len(test.dataUploads) > 0 {
+				require.NotNil(t, volumeInfos)
+				require.Len(t, volumeInfos.VolumeInfos, len(test.dataUploads))
+				assert.Equal(t, "pvc-1", volumeInfos.VolumeInfos[0].PVCName)
+				assert.Equal(t, "snapshot-1", volumeInfos.VolumeInfos[0].SnapshotDataMovementInfo.SnapshotHandle)
+				assert.Equal(t, &volume.UploadStats{LogicalBytes: 1024, UploadedBytes: 256, DedupRatio: 4}, volumeInfos.VolumeInfos[0].SnapshotDataMovementInfo.UploadStats)
+			}
 		})
 	}
 }
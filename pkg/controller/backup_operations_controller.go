@@ -38,6 +38,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
 
 const (
@@ -90,6 +91,7 @@ func (c *backupOperationsReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&velerov1api.Backup{}, builder.WithPredicates(kube.FalsePredicate{})).
 		Watches(s, nil, builder.WithPredicates(gp)).
+		WithOptions(controllerOptions(BackupOperations)).
 		Complete(c)
 }
 
@@ -145,7 +147,7 @@ func (c *backupOperationsReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, errors.Wrap(err, "error getting backup storage location")
 	}
 
-	if loc.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+	if loc.Spec.IsReadOnly() {
 		log.Infof("Cannot check progress on Backup operations because backup storage location %s is currently in read-only mode; marking backup PartiallyFailed", loc.Name)
 		backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
 
@@ -175,6 +177,7 @@ func (c *backupOperationsReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	// if len(errs)>0, need to update backup errors and error log
 	operations.ErrsSinceUpdate = append(operations.ErrsSinceUpdate, errs...)
 	backup.Status.Errors += len(operations.ErrsSinceUpdate)
+	backup.Status.ErrorCategories = addOperationErrorCategories(backup.Status.ErrorCategories, operations.ErrsSinceUpdate)
 	completionChanges := false
 	if backup.Status.BackupItemOperationsCompleted != opsCompleted || backup.Status.BackupItemOperationsFailed != opsFailed {
 		completionChanges = true
@@ -375,3 +378,19 @@ func getBackupItemOperationProgress(
 	}
 	return inProgressOperations, changes, completedCount, failedCount, errs
 }
+
+// addOperationErrorCategories adds the errors of the async item operations to the counts of the
+// error categories, the errors not in any other category are errors of the plugins.
+func addOperationErrorCategories(categories map[string]int, errs []string) map[string]int {
+	for _, err := range errs {
+		category := results.CategorizeMessage(err)
+		if category == results.ErrorCategoryOther {
+			category = results.ErrorCategoryPlugin
+		}
+		if categories == nil {
+			categories = make(map[string]int)
+		}
+		categories[string(category)]++
+	}
+	return categories
+}
@@ -35,7 +35,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/backupwindow"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/notification"
 	"github.com/vmware-tanzu/velero/pkg/repository"
 	repoconfig "github.com/vmware-tanzu/velero/pkg/repository/config"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
@@ -44,6 +46,9 @@ import (
 const (
 	repoSyncPeriod           = 5 * time.Minute
 	defaultMaintainFrequency = 7 * 24 * time.Hour
+
+	// maintenanceHistoryLength is the number of the most recent maintenance results kept in the status
+	maintenanceHistoryLength = 3
 )
 
 type BackupRepoReconciler struct {
@@ -52,18 +57,25 @@ type BackupRepoReconciler struct {
 	logger               logrus.FieldLogger
 	clock                clocks.WithTickerAndDelayedExecution
 	maintenanceFrequency time.Duration
+	tenantConfigMap      string
 	repositoryManager    repository.Manager
+	maintenanceRunner    repository.MaintenanceRunner
+	notifier             *notification.Dispatcher
 }
 
 func NewBackupRepoReconciler(namespace string, logger logrus.FieldLogger, client client.Client,
-	maintenanceFrequency time.Duration, repositoryManager repository.Manager) *BackupRepoReconciler {
+	maintenanceFrequency time.Duration, tenantConfigMap string, repositoryManager repository.Manager,
+	maintenanceRunner repository.MaintenanceRunner, notifier *notification.Dispatcher) *BackupRepoReconciler {
 	c := &BackupRepoReconciler{
 		client,
 		namespace,
 		logger,
 		clocks.RealClock{},
 		maintenanceFrequency,
+		tenantConfigMap,
 		repositoryManager,
+		maintenanceRunner,
+		notifier,
 	}
 
 	return c
@@ -77,6 +89,7 @@ func (r *BackupRepoReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(s, nil).
 		Watches(&source.Kind{Type: &velerov1api.BackupStorageLocation{}}, kube.EnqueueRequestsFromMapUpdateFunc(r.invalidateBackupReposForBSL),
 			builder.WithPredicates(kube.NewUpdateEventPredicate(r.needInvalidBackupRepo))).
+		WithOptions(controllerOptions(BackupRepo)).
 		Complete(r)
 }
 
@@ -186,6 +199,9 @@ func (r *BackupRepoReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	switch backupRepo.Status.Phase {
 	case velerov1api.BackupRepositoryPhaseReady:
+		if err := r.syncTenantConfig(ctx, backupRepo, log); err != nil {
+			log.WithError(err).Warn("Error syncing backup repository with the tenant config")
+		}
 		return ctrl.Result{}, r.runMaintenanceIfDue(ctx, backupRepo, log)
 	case velerov1api.BackupRepositoryPhaseNotReady:
 		return ctrl.Result{}, r.checkNotReadyRepo(ctx, backupRepo, log)
@@ -215,6 +231,18 @@ func (r *BackupRepoReconciler) getIdentiferByBSL(ctx context.Context, req *veler
 func (r *BackupRepoReconciler) initializeRepo(ctx context.Context, req *velerov1api.BackupRepository, log logrus.FieldLogger) error {
 	log.Info("Initializing backup repository")
 
+	// the repository must not be prepared with the common credential if its tenant config
+	// can't be got, so keep it in the New phase and retry
+	tenantConfig, err := r.getTenantConfig(ctx, req)
+	if err != nil {
+		if patchErr := r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
+			rr.Status.Message = err.Error()
+		}); patchErr != nil {
+			return patchErr
+		}
+		return err
+	}
+
 	// confirm the repo's BackupStorageLocation is valid
 	repoIdentifier, err := r.getIdentiferByBSL(ctx, req)
 	if err != nil {
@@ -223,17 +251,30 @@ func (r *BackupRepoReconciler) initializeRepo(ctx context.Context, req *velerov1
 			rr.Status.Phase = velerov1api.BackupRepositoryPhaseNotReady
 
 			if rr.Spec.MaintenanceFrequency.Duration <= 0 {
-				rr.Spec.MaintenanceFrequency = metav1.Duration{Duration: r.getRepositoryMaintenanceFrequency(req)}
+				rr.Spec.MaintenanceFrequency = metav1.Duration{Duration: r.getRepositoryMaintenanceFrequency(req, tenantConfig)}
+			}
+			if rr.Spec.RepositoryCredential == nil && tenantConfig != nil {
+				rr.Spec.RepositoryCredential = tenantConfig.RepositoryCredential
 			}
 		})
 	}
 
+	// the repositories in the locations importing the backups of other clusters are owned by
+	// the other clusters, so they're only restored from
+	imported := r.isImportedRepo(ctx, req)
+
 	// defaulting - if the patch fails, return an error so the item is returned to the queue
 	if err := r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
 		rr.Spec.ResticIdentifier = repoIdentifier
+		if imported {
+			rr.Spec.ReadOnly = true
+		}
 
 		if rr.Spec.MaintenanceFrequency.Duration <= 0 {
-			rr.Spec.MaintenanceFrequency = metav1.Duration{Duration: r.getRepositoryMaintenanceFrequency(req)}
+			rr.Spec.MaintenanceFrequency = metav1.Duration{Duration: r.getRepositoryMaintenanceFrequency(req, tenantConfig)}
+		}
+		if rr.Spec.RepositoryCredential == nil && tenantConfig != nil {
+			rr.Spec.RepositoryCredential = tenantConfig.RepositoryCredential
 		}
 	}); err != nil {
 		return err
@@ -249,7 +290,45 @@ func (r *BackupRepoReconciler) initializeRepo(ctx context.Context, req *velerov1
 	})
 }
 
-func (r *BackupRepoReconciler) getRepositoryMaintenanceFrequency(req *velerov1api.BackupRepository) time.Duration {
+// getTenantConfig returns the tenant config of the repository's volume namespace, nil is
+// returned if the backup repositories aren't configured per tenant.
+func (r *BackupRepoReconciler) getTenantConfig(ctx context.Context, req *velerov1api.BackupRepository) (*repository.TenantConfig, error) {
+	if r.tenantConfigMap == "" {
+		return nil, nil
+	}
+
+	return repository.GetTenantConfig(ctx, r.Client, r.namespace, r.tenantConfigMap, req.Spec.VolumeNamespace)
+}
+
+// syncTenantConfig updates the maintenance frequency of the repository if it's changed in the
+// tenant config. The credential of an existing repository can't be changed, since the repository
+// is encrypted with it.
+func (r *BackupRepoReconciler) syncTenantConfig(ctx context.Context, req *velerov1api.BackupRepository, log logrus.FieldLogger) error {
+	tenantConfig, err := r.getTenantConfig(ctx, req)
+	if err != nil || tenantConfig == nil {
+		return err
+	}
+
+	if tenantConfig.RepositoryCredential != nil && !reflect.DeepEqual(tenantConfig.RepositoryCredential, req.Spec.RepositoryCredential) {
+		log.Warn("The repository credential in the tenant config is ignored, the credential of an existing backup repository can't be changed")
+	}
+
+	if tenantConfig.MaintenanceFrequency == nil || tenantConfig.MaintenanceFrequency.Duration == req.Spec.MaintenanceFrequency.Duration {
+		return nil
+	}
+
+	log.WithField("frequency", tenantConfig.MaintenanceFrequency.Duration).Info("Update maintenance frequency according to the tenant config")
+	return r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
+		rr.Spec.MaintenanceFrequency = *tenantConfig.MaintenanceFrequency
+	})
+}
+
+func (r *BackupRepoReconciler) getRepositoryMaintenanceFrequency(req *velerov1api.BackupRepository, tenantConfig *repository.TenantConfig) time.Duration {
+	if tenantConfig != nil && tenantConfig.MaintenanceFrequency != nil {
+		r.logger.WithField("frequency", tenantConfig.MaintenanceFrequency.Duration).Info("Set maintenance frequency according to the tenant config")
+		return tenantConfig.MaintenanceFrequency.Duration
+	}
+
 	if r.maintenanceFrequency > 0 {
 		r.logger.WithField("frequency", r.maintenanceFrequency).Info("Set user defined maintenance frequency")
 		return r.maintenanceFrequency
@@ -268,6 +347,16 @@ func (r *BackupRepoReconciler) getRepositoryMaintenanceFrequency(req *velerov1ap
 
 // ensureRepo calls repo manager's PrepareRepo to ensure the repo is ready for use.
 // An error is returned if the repository can't be connected to or initialized.
+// isImportedRepo returns whether the repository is in a backup storage location importing the
+// backups of another cluster.
+func (r *BackupRepoReconciler) isImportedRepo(ctx context.Context, req *velerov1api.BackupRepository) bool {
+	loc := &velerov1api.BackupStorageLocation{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: req.Namespace, Name: req.Spec.BackupStorageLocation}, loc); err != nil {
+		return false
+	}
+	return loc.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeImport
+}
+
 func ensureRepo(repo *velerov1api.BackupRepository, repoManager repository.Manager) error {
 	return repoManager.PrepareRepo(repo)
 }
@@ -275,30 +364,93 @@ func ensureRepo(repo *velerov1api.BackupRepository, repoManager repository.Manag
 func (r *BackupRepoReconciler) runMaintenanceIfDue(ctx context.Context, req *velerov1api.BackupRepository, log logrus.FieldLogger) error {
 	log.Debug("backupRepositoryController.runMaintenanceIfDue")
 
+	// the maintenance of the repositories imported from other clusters is run by their owners
+	if r.isImportedRepo(ctx, req) {
+		log.Debug("repository is imported from another cluster, skip maintenance")
+		return nil
+	}
+
 	now := r.clock.Now()
 
-	if !dueForMaintenance(req, now) {
-		log.Debug("not due for maintenance")
-		return nil
+	// the requested maintenance runs right away regardless of the frequency and the window
+	_, requested := req.Annotations[velerov1api.MaintenanceRequestedAnnotation]
+	if !requested {
+		if !dueForMaintenance(req, now) {
+			log.Debug("not due for maintenance")
+			return nil
+		}
+
+		window, err := getMaintenanceWindow(req)
+		if err != nil {
+			log.WithError(err).Warn("Invalid maintenance window")
+			return r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
+				rr.Status.Message = err.Error()
+			})
+		}
+
+		if !window.IsOpen(now) {
+			log.Debugf("due for maintenance, waiting for the maintenance window %s", window)
+			return nil
+		}
 	}
 
-	log.Info("Running maintenance on backup repository")
+	log.WithField("full", requested).Info("Running maintenance on backup repository")
 
 	// prune failures should be displayed in the `.status.message` field but
 	// should not cause the repo to move to `NotReady`.
-	log.Debug("Pruning repo")
-	if err := r.repositoryManager.PruneRepo(req); err != nil {
+	freedBytes, err := r.maintenanceRunner.RunMaintenance(ctx, req, requested)
+	result := velerov1api.BackupRepositoryMaintenanceStatus{
+		StartTimestamp:    &metav1.Time{Time: now},
+		CompleteTimestamp: &metav1.Time{Time: r.clock.Now()},
+	}
+	if err != nil {
 		log.WithError(err).Warn("error pruning repository")
+		r.notifier.Notify(notification.ForBackupRepository(notification.EventRepoMaintenanceFailed, req, err.Error()))
+		result.Result = velerov1api.BackupRepositoryMaintenanceFailed
+		result.Message = err.Error()
 		return r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
 			rr.Status.Message = err.Error()
+			rr.Status.RecentMaintenance = appendMaintenanceHistory(rr.Status.RecentMaintenance, result)
+			delete(rr.Annotations, velerov1api.MaintenanceRequestedAnnotation)
 		})
 	}
 
+	result.Result = velerov1api.BackupRepositoryMaintenanceSucceeded
+	if freedBytes >= 0 {
+		result.FreedBytes = &freedBytes
+	}
 	return r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
 		rr.Status.LastMaintenanceTime = &metav1.Time{Time: now}
+		rr.Status.RecentMaintenance = appendMaintenanceHistory(rr.Status.RecentMaintenance, result)
+		delete(rr.Annotations, velerov1api.MaintenanceRequestedAnnotation)
 	})
 }
 
+// appendMaintenanceHistory appends the result to the maintenance history and keeps the most
+// recent maintenanceHistoryLength results.
+func appendMaintenanceHistory(history []velerov1api.BackupRepositoryMaintenanceStatus, result velerov1api.BackupRepositoryMaintenanceStatus) []velerov1api.BackupRepositoryMaintenanceStatus {
+	history = append(history, result)
+	if len(history) > maintenanceHistoryLength {
+		history = history[len(history)-maintenanceHistoryLength:]
+	}
+	return history
+}
+
+// getMaintenanceWindow returns the maintenance window of the repository, nil is returned
+// if the window isn't set and the maintenance is allowed to start at any time.
+func getMaintenanceWindow(req *velerov1api.BackupRepository) (*backupwindow.Window, error) {
+	window := req.Spec.MaintenanceWindow
+	if window == nil {
+		return nil, nil
+	}
+
+	w, err := backupwindow.New(window.Start, window.End, window.TimeZone)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid maintenance window")
+	}
+	return w, nil
+}
+
 func dueForMaintenance(req *velerov1api.BackupRepository, now time.Time) bool {
 	return req.Status.LastMaintenanceTime == nil || req.Status.LastMaintenanceTime.Add(req.Spec.MaintenanceFrequency.Duration).Before(now)
 }
@@ -21,11 +21,15 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/repository"
 	repomokes "github.com/vmware-tanzu/velero/pkg/repository/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -33,6 +37,18 @@ import (
 
 const testMaintenanceFrequency = 10 * time.Minute
 
+// fakeMaintenanceRunner records the maintenance runs instead of running them in jobs.
+type fakeMaintenanceRunner struct {
+	runs       []bool
+	freedBytes int64
+	err        error
+}
+
+func (r *fakeMaintenanceRunner) RunMaintenance(ctx context.Context, repo *velerov1api.BackupRepository, full bool) (int64, error) {
+	r.runs = append(r.runs, full)
+	return r.freedBytes, r.err
+}
+
 func mockBackupRepoReconciler(t *testing.T, rr *velerov1api.BackupRepository, mockOn string, arg interface{}, ret interface{}) *BackupRepoReconciler {
 	mgr := &repomokes.Manager{}
 	if mockOn != "" {
@@ -43,7 +59,10 @@ func mockBackupRepoReconciler(t *testing.T, rr *velerov1api.BackupRepository, mo
 		velerotest.NewLogger(),
 		velerotest.NewFakeControllerRuntimeClient(t),
 		testMaintenanceFrequency,
+		"",
 		mgr,
+		&fakeMaintenanceRunner{},
+		nil,
 	)
 }
 
@@ -101,7 +120,7 @@ func TestCheckNotReadyRepo(t *testing.T) {
 
 func TestRunMaintenanceIfDue(t *testing.T) {
 	rr := mockBackupRepositoryCR()
-	reconciler := mockBackupRepoReconciler(t, rr, "PruneRepo", rr, nil)
+	reconciler := mockBackupRepoReconciler(t, rr, "", nil, nil)
 	err := reconciler.Client.Create(context.TODO(), rr)
 	assert.NoError(t, err)
 	lastTm := rr.Status.LastMaintenanceTime
@@ -114,6 +133,144 @@ func TestRunMaintenanceIfDue(t *testing.T) {
 	err = reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
 	assert.NoError(t, err)
 	assert.Equal(t, rr.Status.LastMaintenanceTime, lastTm)
+	assert.Equal(t, []bool{false}, reconciler.maintenanceRunner.(*fakeMaintenanceRunner).runs)
+}
+
+func TestRunMaintenanceOfImportedRepo(t *testing.T) {
+	rr := mockBackupRepositoryCR()
+	rr.Spec.BackupStorageLocation = "import"
+	reconciler := mockBackupRepoReconciler(t, rr, "", nil, nil)
+	require.NoError(t, reconciler.Client.Create(context.TODO(), rr))
+	require.NoError(t, reconciler.Client.Create(context.TODO(), builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "import").AccessMode(velerov1api.BackupStorageLocationAccessModeImport).Result()))
+
+	// the maintenance of the repository is run by the cluster owning it
+	require.NoError(t, reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger))
+	assert.Nil(t, rr.Status.LastMaintenanceTime)
+	assert.Empty(t, reconciler.maintenanceRunner.(*fakeMaintenanceRunner).runs)
+}
+
+func TestRunMaintenanceWithSchedulingControls(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	lastMaintenance := &metav1.Time{Time: now.Add(-time.Hour)}
+
+	tests := []struct {
+		name            string
+		window          *velerov1api.MaintenanceWindow
+		lastMaintenance *metav1.Time
+		requested       bool
+		runErr          error
+		expectedRuns    []bool
+		expectedMessage string
+	}{
+		{
+			name:            "not due",
+			lastMaintenance: &metav1.Time{Time: now.Add(-time.Minute)},
+		},
+		{
+			name:            "due in the window",
+			window:          &velerov1api.MaintenanceWindow{Start: "11:00", End: "13:00"},
+			lastMaintenance: lastMaintenance,
+			expectedRuns:    []bool{false},
+		},
+		{
+			name:            "due out of the window",
+			window:          &velerov1api.MaintenanceWindow{Start: "22:00", End: "06:00", TimeZone: "Europe/Berlin"},
+			lastMaintenance: lastMaintenance,
+		},
+		{
+			name:            "invalid window",
+			window:          &velerov1api.MaintenanceWindow{Start: "22:00", End: "06:00", TimeZone: "Mars/Olympus"},
+			lastMaintenance: lastMaintenance,
+			expectedMessage: "invalid maintenance window: invalid timeZone: unknown time zone Mars/Olympus",
+		},
+		{
+			name:            "requested out of the window",
+			window:          &velerov1api.MaintenanceWindow{Start: "22:00", End: "06:00"},
+			lastMaintenance: &metav1.Time{Time: now.Add(-time.Minute)},
+			requested:       true,
+			expectedRuns:    []bool{true},
+		},
+		{
+			name:            "requested maintenance fails",
+			lastMaintenance: lastMaintenance,
+			requested:       true,
+			runErr:          errors.New("fake-maintenance-error"),
+			expectedRuns:    []bool{true},
+			expectedMessage: "fake-maintenance-error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rr := mockBackupRepositoryCR()
+			rr.Spec.MaintenanceWindow = test.window
+			rr.Status.LastMaintenanceTime = test.lastMaintenance
+			if test.requested {
+				rr.Annotations = map[string]string{velerov1api.MaintenanceRequestedAnnotation: "2024-01-01T11:59:00Z"}
+			}
+
+			reconciler := mockBackupRepoReconciler(t, rr, "", nil, nil)
+			reconciler.clock = testclocks.NewFakeClock(now)
+			runner := &fakeMaintenanceRunner{err: test.runErr}
+			reconciler.maintenanceRunner = runner
+			require.NoError(t, reconciler.Client.Create(context.TODO(), rr))
+
+			require.NoError(t, reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger))
+			assert.Equal(t, test.expectedRuns, runner.runs)
+			assert.Equal(t, test.expectedMessage, rr.Status.Message)
+			assert.NotContains(t, rr.Annotations, velerov1api.MaintenanceRequestedAnnotation)
+			expectedLastMaintenance := test.lastMaintenance.Time
+			if len(runner.runs) > 0 && test.runErr == nil {
+				expectedLastMaintenance = now
+			}
+			assert.True(t, expectedLastMaintenance.Equal(rr.Status.LastMaintenanceTime.Time))
+		})
+	}
+}
+
+func TestMaintenanceHistory(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	rr := mockBackupRepositoryCR()
+	rr.Annotations = map[string]string{velerov1api.MaintenanceRequestedAnnotation: "2024-01-01T11:59:00Z"}
+	rr.Status.RecentMaintenance = []velerov1api.BackupRepositoryMaintenanceStatus{
+		{Result: velerov1api.BackupRepositoryMaintenanceSucceeded, Message: "1"},
+		{Result: velerov1api.BackupRepositoryMaintenanceSucceeded, Message: "2"},
+		{Result: velerov1api.BackupRepositoryMaintenanceSucceeded, Message: "3"},
+	}
+
+	reconciler := mockBackupRepoReconciler(t, rr, "", nil, nil)
+	reconciler.clock = testclocks.NewFakeClock(now)
+	runner := &fakeMaintenanceRunner{err: errors.New("fake-maintenance-error")}
+	reconciler.maintenanceRunner = runner
+	require.NoError(t, reconciler.Client.Create(context.TODO(), rr))
+
+	// the oldest result is dropped once the history is full
+	require.NoError(t, reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger))
+	require.Len(t, rr.Status.RecentMaintenance, maintenanceHistoryLength)
+	assert.Equal(t, "2", rr.Status.RecentMaintenance[0].Message)
+	failed := rr.Status.RecentMaintenance[2]
+	assert.Equal(t, velerov1api.BackupRepositoryMaintenanceFailed, failed.Result)
+	assert.Equal(t, "fake-maintenance-error", failed.Message)
+	assert.True(t, now.Equal(failed.StartTimestamp.Time))
+	assert.True(t, now.Equal(failed.CompleteTimestamp.Time))
+	assert.Nil(t, failed.FreedBytes)
+
+	rr.Annotations = map[string]string{velerov1api.MaintenanceRequestedAnnotation: "2024-01-01T12:00:00Z"}
+	runner.err = nil
+	runner.freedBytes = 1024
+	require.NoError(t, reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger))
+	require.Len(t, rr.Status.RecentMaintenance, maintenanceHistoryLength)
+	succeeded := rr.Status.RecentMaintenance[2]
+	assert.Equal(t, velerov1api.BackupRepositoryMaintenanceSucceeded, succeeded.Result)
+	assert.Equal(t, int64(1024), *succeeded.FreedBytes)
+	assert.Empty(t, succeeded.Message)
+
+	// the unknown freed bytes aren't recorded
+	rr.Annotations = map[string]string{velerov1api.MaintenanceRequestedAnnotation: "2024-01-01T12:00:00Z"}
+	runner.freedBytes = -1
+	require.NoError(t, reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger))
+	assert.Nil(t, rr.Status.RecentMaintenance[2].FreedBytes)
 }
 
 func TestInitializeRepo(t *testing.T) {
@@ -139,6 +296,71 @@ func TestInitializeRepo(t *testing.T) {
 	assert.Equal(t, rr.Status.Phase, velerov1api.BackupRepositoryPhaseReady)
 }
 
+func TestInitializeRepoWithTenantConfig(t *testing.T) {
+	rr := mockBackupRepositoryCR()
+	rr.Spec.BackupStorageLocation = "default"
+	rr.Spec.VolumeNamespace = "tenant-a"
+	rr.Spec.MaintenanceFrequency = metav1.Duration{}
+	reconciler := mockBackupRepoReconciler(t, rr, "PrepareRepo", rr, nil)
+	reconciler.tenantConfigMap = "repo-tenants"
+	require.NoError(t, reconciler.Client.Create(context.TODO(), rr))
+	require.NoError(t, reconciler.Client.Create(context.TODO(), &velerov1api.BackupStorageLocation{
+		Spec: velerov1api.BackupStorageLocationSpec{
+			Config: map[string]string{"resticRepoPrefix": "s3:test.amazonaws.com/bucket/restic"},
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      rr.Spec.BackupStorageLocation,
+		},
+	}))
+
+	tenantConfig := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      "repo-tenants",
+		},
+		Data: map[string]string{
+			"tenant-a": "repositoryCredential:\n  name: tenant-a-repo-credentials\n  key: repository-password\nmaintenanceFrequency: 24h\n",
+		},
+	}
+	require.NoError(t, reconciler.Client.Create(context.TODO(), tenantConfig))
+
+	require.NoError(t, reconciler.initializeRepo(context.TODO(), rr, reconciler.logger))
+	assert.Equal(t, velerov1api.BackupRepositoryPhaseReady, rr.Status.Phase)
+	assert.Equal(t, builder.ForSecretKeySelector("tenant-a-repo-credentials", "repository-password").Result(), rr.Spec.RepositoryCredential)
+	assert.Equal(t, 24*time.Hour, rr.Spec.MaintenanceFrequency.Duration)
+
+	// the maintenance frequency is synced with the tenant config
+	tenantConfig.Data["tenant-a"] = "repositoryCredential:\n  name: tenant-a-repo-credentials\n  key: repository-password\nmaintenanceFrequency: 12h\n"
+	require.NoError(t, reconciler.Client.Update(context.TODO(), tenantConfig))
+
+	require.NoError(t, reconciler.syncTenantConfig(context.TODO(), rr, reconciler.logger))
+	assert.Equal(t, 12*time.Hour, rr.Spec.MaintenanceFrequency.Duration)
+}
+
+func TestInitializeRepoWithInvalidTenantConfig(t *testing.T) {
+	rr := mockBackupRepositoryCR()
+	rr.Spec.BackupStorageLocation = "default"
+	rr.Spec.VolumeNamespace = "tenant-a"
+	reconciler := mockBackupRepoReconciler(t, rr, "", nil, nil)
+	reconciler.tenantConfigMap = "repo-tenants"
+	require.NoError(t, reconciler.Client.Create(context.TODO(), rr))
+	require.NoError(t, reconciler.Client.Create(context.TODO(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      "repo-tenants",
+		},
+		Data: map[string]string{
+			"tenant-a": "repositoryCredential:\n  name: tenant-a-repo-credentials\n",
+		},
+	}))
+
+	err := reconciler.initializeRepo(context.TODO(), rr, reconciler.logger)
+	assert.EqualError(t, err, "invalid repository credential of namespace tenant-a in backup repository tenant configmap velero/repo-tenants, both name and key are required")
+	assert.Empty(t, rr.Status.Phase)
+	assert.Equal(t, err.Error(), rr.Status.Message)
+}
+
 func TestBackupRepoReconcile(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -211,6 +433,7 @@ func TestGetRepositoryMaintenanceFrequency(t *testing.T) {
 		freqReturn      time.Duration
 		freqError       error
 		userDefinedFreq time.Duration
+		tenantConfig    *repository.TenantConfig
 		expectFreq      time.Duration
 	}{
 		{
@@ -218,6 +441,12 @@ func TestGetRepositoryMaintenanceFrequency(t *testing.T) {
 			userDefinedFreq: time.Hour,
 			expectFreq:      time.Hour,
 		},
+		{
+			name:            "tenant config takes precedence",
+			userDefinedFreq: time.Hour,
+			tenantConfig:    &repository.TenantConfig{MaintenanceFrequency: &metav1.Duration{Duration: time.Hour * 3}},
+			expectFreq:      time.Hour * 3,
+		},
 		{
 			name:       "repo return valid",
 			freqReturn: time.Hour * 2,
@@ -244,10 +473,13 @@ func TestGetRepositoryMaintenanceFrequency(t *testing.T) {
 				velerotest.NewLogger(),
 				velerotest.NewFakeControllerRuntimeClient(t),
 				test.userDefinedFreq,
+				"",
 				&mgr,
+				nil,
+				nil,
 			)
 
-			freq := reconciler.getRepositoryMaintenanceFrequency(test.repo)
+			freq := reconciler.getRepositoryMaintenanceFrequency(test.repo, test.tenantConfig)
 			assert.Equal(t, test.expectFreq, freq)
 		})
 	}
@@ -371,7 +603,7 @@ func TestNeedInvalidBackupRepo(t *testing.T) {
 				velerov1api.DefaultNamespace,
 				velerotest.NewLogger(),
 				velerotest.NewFakeControllerRuntimeClient(t),
-				time.Duration(0), nil)
+				time.Duration(0), "", nil, nil, nil)
 
 			need := reconciler.needInvalidBackupRepo(test.oldBSL, test.newBSL)
 			assert.Equal(t, test.expect, need)
@@ -0,0 +1,195 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/podvolume"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+)
+
+type newMigratorFunc func(ctx context.Context, migration *velerov2alpha1api.BackupRepositoryMigration) (podvolume.Migrator, error)
+
+// BackupRepositoryMigrationReconciler migrates the restic snapshots of the pod volume backups of a
+// namespace into the kopia repository.
+type BackupRepositoryMigrationReconciler struct {
+	client      client.Client
+	namespace   string
+	clock       clocks.WithTickerAndDelayedExecution
+	logger      logrus.FieldLogger
+	newMigrator newMigratorFunc
+}
+
+// NewBackupRepositoryMigrationReconciler constructs a new BackupRepositoryMigrationReconciler.
+func NewBackupRepositoryMigrationReconciler(namespace string, client client.Client, repoLocker *repository.RepoLocker,
+	repoEnsurer *repository.Ensurer, credentialGetter *credentials.CredentialGetter, logger logrus.FieldLogger) *BackupRepositoryMigrationReconciler {
+	r := &BackupRepositoryMigrationReconciler{
+		client:    client,
+		namespace: namespace,
+		clock:     clocks.RealClock{},
+		logger:    logger,
+	}
+
+	r.newMigrator = func(ctx context.Context, migration *velerov2alpha1api.BackupRepositoryMigration) (podvolume.Migrator, error) {
+		return podvolume.NewMigrator(ctx, client, repoLocker, repoEnsurer, credentialGetter, namespace,
+			migration.Spec.VolumeNamespace, migration.Spec.BackupStorageLocation, logger.WithField("backupRepositoryMigration", migration.Name))
+	}
+
+	return r
+}
+
+func (r *BackupRepositoryMigrationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov2alpha1api.BackupRepositoryMigration{}).
+		WithOptions(controllerOptions(BackupRepoMigration)).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backuprepositorymigrations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=backuprepositorymigrations/status,verbs=get;update;patch
+
+func (r *BackupRepositoryMigrationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("backupRepositoryMigration", req.String())
+
+	migration := &velerov2alpha1api.BackupRepositoryMigration{}
+	if err := r.client.Get(ctx, req.NamespacedName, migration); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find BackupRepositoryMigration")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrap(err, "error getting BackupRepositoryMigration")
+	}
+
+	// Only process new items. The in progress ones are the interrupted ones, they are marked as
+	// failed when the server starts.
+	if migration.Status.Phase != "" && migration.Status.Phase != velerov2alpha1api.BackupRepositoryMigrationPhaseNew {
+		log.Debug("BackupRepositoryMigration is not new, not processing")
+		return ctrl.Result{}, nil
+	}
+
+	pvbs, err := r.getPodVolumeBackupsToMigrate(ctx, migration)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.Infof("Migrating %d pod volume backups", len(pvbs))
+
+	if err := r.patchBackupRepositoryMigration(ctx, migration, func(m *velerov2alpha1api.BackupRepositoryMigration) {
+		m.Status.Phase = velerov2alpha1api.BackupRepositoryMigrationPhaseInProgress
+		m.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+		m.Status.TotalPodVolumeBackups = len(pvbs)
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if len(pvbs) == 0 {
+		return ctrl.Result{}, r.complete(ctx, migration, velerov2alpha1api.BackupRepositoryMigrationPhaseCompleted, "no pod volume backup to migrate")
+	}
+
+	migrator, err := r.newMigrator(ctx, migration)
+	if err != nil {
+		log.WithError(err).Error("Error preparing the repositories")
+		return ctrl.Result{}, r.complete(ctx, migration, velerov2alpha1api.BackupRepositoryMigrationPhaseFailed, err.Error())
+	}
+	defer migrator.Close(ctx)
+
+	for i := range pvbs {
+		if err := migrator.MigratePodVolumeBackup(ctx, &pvbs[i]); err != nil {
+			log.WithError(err).WithField("podVolumeBackup", pvbs[i].Name).Error("Error migrating pod volume backup")
+			if err := r.patchBackupRepositoryMigration(ctx, migration, func(m *velerov2alpha1api.BackupRepositoryMigration) {
+				m.Status.FailedPodVolumeBackups = append(m.Status.FailedPodVolumeBackups, pvbs[i].Name)
+			}); err != nil {
+				return ctrl.Result{}, err
+			}
+			continue
+		}
+
+		if err := r.patchBackupRepositoryMigration(ctx, migration, func(m *velerov2alpha1api.BackupRepositoryMigration) {
+			m.Status.MigratedPodVolumeBackups++
+		}); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if failed := len(migration.Status.FailedPodVolumeBackups); failed > 0 {
+		return ctrl.Result{}, r.complete(ctx, migration, velerov2alpha1api.BackupRepositoryMigrationPhasePartiallyFailed,
+			fmt.Sprintf("%d of %d pod volume backups failed to be migrated", failed, len(pvbs)))
+	}
+
+	return ctrl.Result{}, r.complete(ctx, migration, velerov2alpha1api.BackupRepositoryMigrationPhaseCompleted, "")
+}
+
+// getPodVolumeBackupsToMigrate returns the completed pod volume backups of the volume namespace in
+// the backup storage location which have restic snapshots.
+func (r *BackupRepositoryMigrationReconciler) getPodVolumeBackupsToMigrate(ctx context.Context, migration *velerov2alpha1api.BackupRepositoryMigration) ([]velerov1api.PodVolumeBackup, error) {
+	list := &velerov1api.PodVolumeBackupList{}
+	if err := r.client.List(ctx, list, &client.ListOptions{Namespace: r.namespace}); err != nil {
+		return nil, errors.Wrap(err, "error listing PodVolumeBackups")
+	}
+
+	var pvbs []velerov1api.PodVolumeBackup
+	for _, pvb := range list.Items {
+		if pvb.Spec.Pod.Namespace != migration.Spec.VolumeNamespace ||
+			pvb.Spec.BackupStorageLocation != migration.Spec.BackupStorageLocation ||
+			pvb.Status.Phase != velerov1api.PodVolumeBackupPhaseCompleted ||
+			pvb.Status.SnapshotID == "" ||
+			podvolume.GetPvbRepositoryType(&pvb) != velerov1api.BackupRepositoryTypeRestic {
+			continue
+		}
+		pvbs = append(pvbs, pvb)
+	}
+
+	sort.Slice(pvbs, func(i, j int) bool {
+		return pvbs[i].Name < pvbs[j].Name
+	})
+
+	return pvbs, nil
+}
+
+func (r *BackupRepositoryMigrationReconciler) complete(ctx context.Context, migration *velerov2alpha1api.BackupRepositoryMigration,
+	phase velerov2alpha1api.BackupRepositoryMigrationPhase, message string) error {
+	return r.patchBackupRepositoryMigration(ctx, migration, func(m *velerov2alpha1api.BackupRepositoryMigration) {
+		m.Status.Phase = phase
+		m.Status.Message = message
+		m.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	})
+}
+
+func (r *BackupRepositoryMigrationReconciler) patchBackupRepositoryMigration(ctx context.Context, migration *velerov2alpha1api.BackupRepositoryMigration,
+	mutate func(*velerov2alpha1api.BackupRepositoryMigration)) error {
+	original := migration.DeepCopy()
+	mutate(migration)
+	if err := r.client.Patch(ctx, migration, client.MergeFrom(original)); err != nil {
+		return errors.Wrap(err, "error updating BackupRepositoryMigration")
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/podvolume"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+)
+
+type fakeMigrator struct {
+	failed   map[string]bool
+	migrated []string
+	closed   bool
+}
+
+func (m *fakeMigrator) MigratePodVolumeBackup(ctx context.Context, pvb *velerov1api.PodVolumeBackup) error {
+	if m.failed[pvb.Name] {
+		return errors.New("fake-error")
+	}
+	m.migrated = append(m.migrated, pvb.Name)
+	return nil
+}
+
+func (m *fakeMigrator) Close(ctx context.Context) {
+	m.closed = true
+}
+
+func TestBackupRepositoryMigrationReconcile(t *testing.T) {
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	pvbs := []runtime.Object{
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").PodNamespace("ns-1").BackupStorageLocation("default").
+			Phase(velerov1api.PodVolumeBackupPhaseCompleted).SnapshotID("snapshot-1").Result(),
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-2").PodNamespace("ns-1").BackupStorageLocation("default").
+			Phase(velerov1api.PodVolumeBackupPhaseCompleted).SnapshotID("snapshot-2").UploaderType(uploader.ResticType).Result(),
+		// kopia snapshot
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-3").PodNamespace("ns-1").BackupStorageLocation("default").
+			Phase(velerov1api.PodVolumeBackupPhaseCompleted).SnapshotID("snapshot-3").UploaderType(uploader.KopiaType).Result(),
+		// other namespace
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-4").PodNamespace("ns-2").BackupStorageLocation("default").
+			Phase(velerov1api.PodVolumeBackupPhaseCompleted).SnapshotID("snapshot-4").Result(),
+		// other backup storage location
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-5").PodNamespace("ns-1").BackupStorageLocation("other").
+			Phase(velerov1api.PodVolumeBackupPhaseCompleted).SnapshotID("snapshot-5").Result(),
+		// failed
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-6").PodNamespace("ns-1").BackupStorageLocation("default").
+			Phase(velerov1api.PodVolumeBackupPhaseFailed).Result(),
+		// empty volume
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-7").PodNamespace("ns-1").BackupStorageLocation("default").
+			Phase(velerov1api.PodVolumeBackupPhaseCompleted).Result(),
+	}
+
+	tests := []struct {
+		name             string
+		migration        *velerov2alpha1api.BackupRepositoryMigration
+		pvbs             []runtime.Object
+		migrator         *fakeMigrator
+		migratorErr      error
+		expectedMigrated []string
+		expectedStatus   velerov2alpha1api.BackupRepositoryMigrationStatus
+	}{
+		{
+			name:      "not new migration is not processed",
+			migration: builder.ForBackupRepositoryMigration(velerov1api.DefaultNamespace, "migration").VolumeNamespace("ns-1").BackupStorageLocation("default").Phase(velerov2alpha1api.BackupRepositoryMigrationPhaseInProgress).Result(),
+			pvbs:      pvbs,
+			migrator:  &fakeMigrator{},
+			expectedStatus: velerov2alpha1api.BackupRepositoryMigrationStatus{
+				Phase: velerov2alpha1api.BackupRepositoryMigrationPhaseInProgress,
+			},
+		},
+		{
+			name:      "no pod volume backup to migrate",
+			migration: builder.ForBackupRepositoryMigration(velerov1api.DefaultNamespace, "migration").VolumeNamespace("ns-3").BackupStorageLocation("default").Result(),
+			pvbs:      pvbs,
+			migrator:  &fakeMigrator{},
+			expectedStatus: velerov2alpha1api.BackupRepositoryMigrationStatus{
+				Phase:   velerov2alpha1api.BackupRepositoryMigrationPhaseCompleted,
+				Message: "no pod volume backup to migrate",
+			},
+		},
+		{
+			name:        "fail to prepare the repositories",
+			migration:   builder.ForBackupRepositoryMigration(velerov1api.DefaultNamespace, "migration").VolumeNamespace("ns-1").BackupStorageLocation("default").Result(),
+			pvbs:        pvbs,
+			migratorErr: errors.New("fake-error"),
+			expectedStatus: velerov2alpha1api.BackupRepositoryMigrationStatus{
+				Phase:                 velerov2alpha1api.BackupRepositoryMigrationPhaseFailed,
+				Message:               "fake-error",
+				TotalPodVolumeBackups: 2,
+			},
+		},
+		{
+			name:             "all pod volume backups are migrated",
+			migration:        builder.ForBackupRepositoryMigration(velerov1api.DefaultNamespace, "migration").VolumeNamespace("ns-1").BackupStorageLocation("default").Result(),
+			pvbs:             pvbs,
+			migrator:         &fakeMigrator{},
+			expectedMigrated: []string{"pvb-1", "pvb-2"},
+			expectedStatus: velerov2alpha1api.BackupRepositoryMigrationStatus{
+				Phase:                    velerov2alpha1api.BackupRepositoryMigrationPhaseCompleted,
+				TotalPodVolumeBackups:    2,
+				MigratedPodVolumeBackups: 2,
+			},
+		},
+		{
+			name:             "some pod volume backups fail to be migrated",
+			migration:        builder.ForBackupRepositoryMigration(velerov1api.DefaultNamespace, "migration").VolumeNamespace("ns-1").BackupStorageLocation("default").Result(),
+			pvbs:             pvbs,
+			migrator:         &fakeMigrator{failed: map[string]bool{"pvb-1": true}},
+			expectedMigrated: []string{"pvb-2"},
+			expectedStatus: velerov2alpha1api.BackupRepositoryMigrationStatus{
+				Phase:                    velerov2alpha1api.BackupRepositoryMigrationPhasePartiallyFailed,
+				Message:                  "1 of 2 pod volume backups failed to be migrated",
+				TotalPodVolumeBackups:    2,
+				MigratedPodVolumeBackups: 1,
+				FailedPodVolumeBackups:   []string{"pvb-1"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cli := velerotest.NewFakeControllerRuntimeClient(t, append(test.pvbs, test.migration)...)
+
+			r := NewBackupRepositoryMigrationReconciler(velerov1api.DefaultNamespace, cli, nil, nil, nil, velerotest.NewLogger())
+			r.clock = testclocks.NewFakeClock(now)
+			r.newMigrator = func(context.Context, *velerov2alpha1api.BackupRepositoryMigration) (podvolume.Migrator, error) {
+				if test.migratorErr != nil {
+					return nil, test.migratorErr
+				}
+				return test.migrator, nil
+			}
+
+			key := types.NamespacedName{Namespace: test.migration.Namespace, Name: test.migration.Name}
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+			require.NoError(t, err)
+
+			migration := &velerov2alpha1api.BackupRepositoryMigration{}
+			require.NoError(t, cli.Get(context.Background(), key, migration))
+			migration.Status.StartTimestamp = nil
+			migration.Status.CompletionTimestamp = nil
+			assert.Equal(t, test.expectedStatus, migration.Status)
+
+			if test.migrator != nil {
+				assert.Equal(t, test.expectedMigrated, test.migrator.migrated)
+				assert.Equal(t, len(test.expectedMigrated) > 0 || len(test.migrator.failed) > 0, test.migrator.closed)
+			}
+		})
+	}
+}
@@ -0,0 +1,333 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/repository/provider"
+)
+
+// orphanedSnapshotMinAge is the minimum age of an unreferenced snapshot to be treated as
+// orphaned, the snapshots written recently may belong to the backups whose pod volume backups
+// and data uploads haven't been updated with the snapshot IDs yet.
+const orphanedSnapshotMinAge = time.Hour
+
+// BackupRepoOrphanReconciler scans the kopia repositories on request for the snapshots which
+// aren't referenced by any pod volume backup or data upload, e.g. the snapshots left by the
+// crashed backups, and deletes them if requested.
+type BackupRepoOrphanReconciler struct {
+	client            client.Client
+	namespace         string
+	clock             clocks.WithTickerAndDelayedExecution
+	logger            logrus.FieldLogger
+	repositoryManager repository.Manager
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+}
+
+// NewBackupRepoOrphanReconciler constructs a new BackupRepoOrphanReconciler.
+func NewBackupRepoOrphanReconciler(namespace string, client client.Client, repositoryManager repository.Manager,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager, backupStoreGetter persistence.ObjectBackupStoreGetter,
+	logger logrus.FieldLogger) *BackupRepoOrphanReconciler {
+	return &BackupRepoOrphanReconciler{
+		client:            client,
+		namespace:         namespace,
+		clock:             clocks.RealClock{},
+		logger:            logger,
+		repositoryManager: repositoryManager,
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+	}
+}
+
+func (r *BackupRepoOrphanReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("backup-repository-orphan").
+		For(&velerov1api.BackupRepository{}).
+		WithOptions(controllerOptions(BackupRepoOrphan)).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backuprepositories,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=podvolumebackups,verbs=get;list
+// +kubebuilder:rbac:groups=velero.io,resources=datauploads,verbs=get;list
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get
+
+func (r *BackupRepoOrphanReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("backupRepo", req.String())
+
+	repo := &velerov1api.BackupRepository{}
+	if err := r.client.Get(ctx, req.NamespacedName, repo); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find BackupRepository")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrap(err, "error getting BackupRepository")
+	}
+
+	mode, requested := repo.Annotations[velerov1api.OrphanScanRequestedAnnotation]
+	if !requested {
+		return ctrl.Result{}, nil
+	}
+
+	scan := &velerov1api.BackupRepositoryOrphanScan{
+		Mode:      mode,
+		Timestamp: &metav1.Time{Time: r.clock.Now()},
+	}
+
+	if mode != velerov1api.OrphanScanModeList && mode != velerov1api.OrphanScanModePrune {
+		scan.Message = fmt.Sprintf("unknown orphan scan mode %q, it must be %s or %s", mode, velerov1api.OrphanScanModeList, velerov1api.OrphanScanModePrune)
+		return ctrl.Result{}, r.completeScan(ctx, repo, scan)
+	}
+
+	if repo.Spec.RepositoryType != velerov1api.BackupRepositoryTypeKopia {
+		scan.Message = fmt.Sprintf("the orphaned snapshots can only be scanned in kopia repositories, the repository type is %q", repo.Spec.RepositoryType)
+		return ctrl.Result{}, r.completeScan(ctx, repo, scan)
+	}
+
+	// the new repositories are being prepared by the backup repository controller, the scan
+	// runs once they're ready
+	switch repo.Status.Phase {
+	case velerov1api.BackupRepositoryPhaseReady:
+	case velerov1api.BackupRepositoryPhaseNotReady:
+		scan.Message = fmt.Sprintf("the backup repository isn't ready: %s", repo.Status.Message)
+		return ctrl.Result{}, r.completeScan(ctx, repo, scan)
+	default:
+		log.Debug("Backup repository isn't ready yet, waiting to scan the orphaned snapshots")
+		return ctrl.Result{}, nil
+	}
+
+	log.WithField("mode", mode).Info("Scanning the orphaned snapshots of backup repository")
+
+	orphans, err := r.findOrphanedSnapshots(ctx, repo, log)
+	if err != nil {
+		log.WithError(err).Error("Error scanning the orphaned snapshots")
+		scan.Message = err.Error()
+		return ctrl.Result{}, r.completeScan(ctx, repo, scan)
+	}
+
+	if mode == velerov1api.OrphanScanModeList {
+		for _, snapshot := range orphans {
+			scan.OrphanedSnapshots = append(scan.OrphanedSnapshots, orphanedSnapshotStatus(snapshot))
+		}
+		return ctrl.Result{}, r.completeScan(ctx, repo, scan)
+	}
+
+	failed := 0
+	for _, snapshot := range orphans {
+		if err := r.repositoryManager.Forget(ctx, repository.SnapshotIdentifier{
+			VolumeNamespace:       repo.Spec.VolumeNamespace,
+			BackupStorageLocation: repo.Spec.BackupStorageLocation,
+			SnapshotID:            snapshot.ID,
+			RepositoryType:        repo.Spec.RepositoryType,
+		}); err != nil {
+			log.WithError(err).WithField("snapshotID", snapshot.ID).Warn("Error deleting orphaned snapshot")
+			scan.OrphanedSnapshots = append(scan.OrphanedSnapshots, orphanedSnapshotStatus(snapshot))
+			failed++
+			continue
+		}
+		scan.PrunedSnapshots++
+	}
+	if failed > 0 {
+		scan.Message = fmt.Sprintf("%d of %d orphaned snapshots failed to be deleted, check the Velero server logs for more details", failed, len(orphans))
+	}
+
+	log.Infof("Deleted %d orphaned snapshots", scan.PrunedSnapshots)
+
+	return ctrl.Result{}, r.completeScan(ctx, repo, scan)
+}
+
+// findOrphanedSnapshots returns the snapshots in the repository which aren't referenced by the
+// pod volume backups and the data uploads in the cluster or by the backups in the backup storage
+// location of the repository, and are older than orphanedSnapshotMinAge.
+func (r *BackupRepoOrphanReconciler) findOrphanedSnapshots(ctx context.Context, repo *velerov1api.BackupRepository, log logrus.FieldLogger) ([]provider.SnapshotInfo, error) {
+	snapshots, err := r.repositoryManager.ListSnapshots(repo)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing the snapshots of the backup repository")
+	}
+
+	referenced, err := r.getReferencedSnapshots(ctx, repo.Spec.BackupStorageLocation, log)
+	if err != nil {
+		return nil, err
+	}
+
+	// the snapshot IDs are unique across the repositories, the snapshots referenced by the
+	// other repositories of the backup storage location never match
+	var orphans []provider.SnapshotInfo
+	cutoff := r.clock.Now().Add(-orphanedSnapshotMinAge)
+	for _, snapshot := range snapshots {
+		if referenced[snapshot.ID] || snapshot.StartTime.After(cutoff) {
+			continue
+		}
+		orphans = append(orphans, snapshot)
+	}
+
+	sort.Slice(orphans, func(i, j int) bool {
+		if !orphans[i].StartTime.Equal(orphans[j].StartTime) {
+			return orphans[i].StartTime.Before(orphans[j].StartTime)
+		}
+		return orphans[i].ID < orphans[j].ID
+	})
+
+	return orphans, nil
+}
+
+// getReferencedSnapshots returns the IDs of the snapshots referenced by the pod volume backups
+// and the data uploads of the backup storage location, both the ones in the cluster, which
+// include the ones of the backups in progress, and the ones of all the backups in the backup
+// store, which include the ones of the backups taken by the other clusters.
+func (r *BackupRepoOrphanReconciler) getReferencedSnapshots(ctx context.Context, location string, log logrus.FieldLogger) (map[string]bool, error) {
+	referenced := map[string]bool{}
+
+	pvbs := &velerov1api.PodVolumeBackupList{}
+	if err := r.client.List(ctx, pvbs, &client.ListOptions{Namespace: r.namespace}); err != nil {
+		return nil, errors.Wrap(err, "error listing PodVolumeBackups")
+	}
+	for _, pvb := range pvbs.Items {
+		if pvb.Spec.BackupStorageLocation == location && pvb.Status.SnapshotID != "" {
+			referenced[pvb.Status.SnapshotID] = true
+		}
+	}
+
+	dataUploads := &velerov2alpha1api.DataUploadList{}
+	if err := r.client.List(ctx, dataUploads, &client.ListOptions{Namespace: r.namespace}); err != nil {
+		return nil, errors.Wrap(err, "error listing DataUploads")
+	}
+	for _, du := range dataUploads.Items {
+		if du.Spec.BackupStorageLocation == location && du.Status.SnapshotID != "" {
+			referenced[du.Status.SnapshotID] = true
+		}
+	}
+
+	bsl := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: r.namespace, Name: location}, bsl); err != nil {
+		return nil, errors.Wrapf(err, "error getting backup storage location %s", location)
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(bsl, pluginManager, log)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting backup store of backup storage location %s", location)
+	}
+
+	backups, err := backupStore.ListBackups()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing backups in backup storage location %s", location)
+	}
+
+	for _, backup := range backups {
+		if err := addBackupSnapshots(referenced, backupStore, backup); err != nil {
+			return nil, errors.Wrapf(err, "error getting the snapshots of backup %s", backup)
+		}
+	}
+
+	return referenced, nil
+}
+
+// addBackupSnapshots adds the IDs of the snapshots of the pod volume backups and the data uploads
+// of the backup in the backup store to referenced.
+func addBackupSnapshots(referenced map[string]bool, backupStore persistence.BackupStore, backup string) error {
+	pvbs, err := backupStore.GetPodVolumeBackups(backup)
+	if err != nil {
+		return err
+	}
+	for _, pvb := range pvbs {
+		if pvb.Status.SnapshotID != "" {
+			referenced[pvb.Status.SnapshotID] = true
+		}
+	}
+
+	volumeInfos, err := backupStore.GetBackupVolumeInfos(backup)
+	if err != nil {
+		return err
+	}
+	if volumeInfos != nil {
+		for _, info := range volumeInfos.VolumeInfos {
+			if info.SnapshotDataMoved && info.SnapshotDataMovementInfo.SnapshotHandle != "" {
+				referenced[info.SnapshotDataMovementInfo.SnapshotHandle] = true
+			}
+		}
+		return nil
+	}
+
+	// the backups taken before the volume infos are introduced only have the data uploads in
+	// the backup contents
+	contents, err := backupStore.GetBackupContents(backup)
+	if err != nil {
+		return err
+	}
+	defer contents.Close()
+
+	dataUploads, err := archive.ReadItems(contents, dataUploadsGroupResource)
+	if err != nil {
+		return err
+	}
+	for path, item := range dataUploads {
+		du := &velerov2alpha1api.DataUpload{}
+		if err := json.Unmarshal(item, du); err != nil {
+			return errors.Wrapf(err, "error decoding data upload %s", path)
+		}
+		if du.Status.SnapshotID != "" {
+			referenced[du.Status.SnapshotID] = true
+		}
+	}
+
+	return nil
+}
+
+func orphanedSnapshotStatus(snapshot provider.SnapshotInfo) velerov1api.BackupRepositoryOrphanedSnapshot {
+	status := velerov1api.BackupRepositoryOrphanedSnapshot{
+		SnapshotID: snapshot.ID,
+		Source:     snapshot.Source,
+	}
+	if !snapshot.StartTime.IsZero() {
+		status.StartTimestamp = &metav1.Time{Time: snapshot.StartTime}
+	}
+	return status
+}
+
+// completeScan records the result of the scan and removes the annotation requesting it.
+func (r *BackupRepoOrphanReconciler) completeScan(ctx context.Context, repo *velerov1api.BackupRepository, scan *velerov1api.BackupRepositoryOrphanScan) error {
+	original := repo.DeepCopy()
+	repo.Status.OrphanScan = scan
+	delete(repo.Annotations, velerov1api.OrphanScanRequestedAnnotation)
+	if err := r.client.Patch(ctx, repo, client.MergeFrom(original)); err != nil {
+		return errors.Wrap(err, "error patching BackupRepository")
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	repomocks "github.com/vmware-tanzu/velero/pkg/repository/mocks"
+	"github.com/vmware-tanzu/velero/pkg/repository/provider"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+func TestBackupRepoOrphanReconcile(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-2 * time.Hour)
+
+	newRepo := func(repoType string, phase velerov1api.BackupRepositoryPhase, mode string) *velerov1api.BackupRepository {
+		repo := &velerov1api.BackupRepository{
+			ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "repo-1"},
+			Spec: velerov1api.BackupRepositorySpec{
+				VolumeNamespace:       "ns-1",
+				BackupStorageLocation: "default",
+				RepositoryType:        repoType,
+			},
+			Status: velerov1api.BackupRepositoryStatus{Phase: phase, Message: "fake-message"},
+		}
+		if mode != "" {
+			repo.Annotations = map[string]string{velerov1api.OrphanScanRequestedAnnotation: mode}
+		}
+		return repo
+	}
+
+	snapshots := []provider.SnapshotInfo{
+		// referenced by the pod volume backup in the cluster
+		{ID: "snapshot-1", StartTime: old},
+		// referenced by the data upload in the cluster
+		{ID: "snapshot-2", StartTime: old},
+		// referenced by the pod volume backup of backup-1 in the backup store
+		{ID: "snapshot-3", StartTime: old},
+		// referenced by the volume infos of backup-1 in the backup store
+		{ID: "snapshot-4", StartTime: old},
+		// referenced by the data upload in the contents of backup-2 in the backup store
+		{ID: "snapshot-5", StartTime: old},
+		// orphaned
+		{ID: "snapshot-6", Source: "default@default:/host_pods/pod-1/volumes/vol-1", StartTime: old.Add(time.Minute)},
+		// orphaned but written recently
+		{ID: "snapshot-7", StartTime: now.Add(-time.Minute)},
+		// orphaned
+		{ID: "snapshot-8", Source: "default@default:/ns-1/pvc-1", StartTime: old},
+	}
+
+	tests := []struct {
+		name              string
+		repo              *velerov1api.BackupRepository
+		listErr           error
+		forgetErrs        map[string]error
+		expectedScan      *velerov1api.BackupRepositoryOrphanScan
+		expectedRequested bool
+		expectedForgotten []string
+	}{
+		{
+			name: "scan isn't requested",
+			repo: newRepo(velerov1api.BackupRepositoryTypeKopia, velerov1api.BackupRepositoryPhaseReady, ""),
+		},
+		{
+			name: "scan mode is unknown",
+			repo: newRepo(velerov1api.BackupRepositoryTypeKopia, velerov1api.BackupRepositoryPhaseReady, "delete"),
+			expectedScan: &velerov1api.BackupRepositoryOrphanScan{
+				Mode:    "delete",
+				Message: "unknown orphan scan mode \"delete\", it must be list or prune",
+			},
+		},
+		{
+			name: "repository isn't kopia",
+			repo: newRepo(velerov1api.BackupRepositoryTypeRestic, velerov1api.BackupRepositoryPhaseReady, velerov1api.OrphanScanModeList),
+			expectedScan: &velerov1api.BackupRepositoryOrphanScan{
+				Mode:    velerov1api.OrphanScanModeList,
+				Message: "the orphaned snapshots can only be scanned in kopia repositories, the repository type is \"restic\"",
+			},
+		},
+		{
+			name:              "repository is new",
+			repo:              newRepo(velerov1api.BackupRepositoryTypeKopia, velerov1api.BackupRepositoryPhaseNew, velerov1api.OrphanScanModeList),
+			expectedRequested: true,
+		},
+		{
+			name: "repository isn't ready",
+			repo: newRepo(velerov1api.BackupRepositoryTypeKopia, velerov1api.BackupRepositoryPhaseNotReady, velerov1api.OrphanScanModeList),
+			expectedScan: &velerov1api.BackupRepositoryOrphanScan{
+				Mode:    velerov1api.OrphanScanModeList,
+				Message: "the backup repository isn't ready: fake-message",
+			},
+		},
+		{
+			name:    "snapshots fail to be listed",
+			repo:    newRepo(velerov1api.BackupRepositoryTypeKopia, velerov1api.BackupRepositoryPhaseReady, velerov1api.OrphanScanModeList),
+			listErr: errors.New("fake-error"),
+			expectedScan: &velerov1api.BackupRepositoryOrphanScan{
+				Mode:    velerov1api.OrphanScanModeList,
+				Message: "error listing the snapshots of the backup repository: fake-error",
+			},
+		},
+		{
+			name: "orphaned snapshots are listed",
+			repo: newRepo(velerov1api.BackupRepositoryTypeKopia, velerov1api.BackupRepositoryPhaseReady, velerov1api.OrphanScanModeList),
+			expectedScan: &velerov1api.BackupRepositoryOrphanScan{
+				Mode: velerov1api.OrphanScanModeList,
+				OrphanedSnapshots: []velerov1api.BackupRepositoryOrphanedSnapshot{
+					{SnapshotID: "snapshot-8", Source: "default@default:/ns-1/pvc-1", StartTimestamp: &metav1.Time{Time: old}},
+					{SnapshotID: "snapshot-6", Source: "default@default:/host_pods/pod-1/volumes/vol-1", StartTimestamp: &metav1.Time{Time: old.Add(time.Minute)}},
+				},
+			},
+		},
+		{
+			name:       "orphaned snapshots are pruned",
+			repo:       newRepo(velerov1api.BackupRepositoryTypeKopia, velerov1api.BackupRepositoryPhaseReady, velerov1api.OrphanScanModePrune),
+			forgetErrs: map[string]error{"snapshot-8": errors.New("fake-error")},
+			expectedScan: &velerov1api.BackupRepositoryOrphanScan{
+				Mode: velerov1api.OrphanScanModePrune,
+				OrphanedSnapshots: []velerov1api.BackupRepositoryOrphanedSnapshot{
+					{SnapshotID: "snapshot-8", Source: "default@default:/ns-1/pvc-1", StartTimestamp: &metav1.Time{Time: old}},
+				},
+				PrunedSnapshots: 1,
+				Message:         "1 of 2 orphaned snapshots failed to be deleted, check the Velero server logs for more details",
+			},
+			expectedForgotten: []string{"snapshot-8", "snapshot-6"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cli := velerotest.NewFakeControllerRuntimeClient(t,
+				test.repo,
+				builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+				builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").BackupStorageLocation("default").SnapshotID("snapshot-1").Result(),
+				builder.ForDataUpload(velerov1api.DefaultNamespace, "du-1").BackupStorageLocation("default").SnapshotID("snapshot-2").Result(),
+				// the snapshots of the other backup storage locations don't count
+				builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-2").BackupStorageLocation("other").SnapshotID("snapshot-6").Result(),
+			)
+
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("ListBackups").Return([]string{"backup-1", "backup-2"}, nil)
+			backupStore.On("GetPodVolumeBackups", "backup-1").Return([]*velerov1api.PodVolumeBackup{
+				builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-3").SnapshotID("snapshot-3").Result(),
+			}, nil)
+			backupStore.On("GetBackupVolumeInfos", "backup-1").Return(&volume.VolumeInfos{VolumeInfos: []volume.VolumeInfo{
+				{SnapshotDataMoved: true, SnapshotDataMovementInfo: volume.SnapshotDataMovementInfo{SnapshotHandle: "snapshot-4"}},
+				{BackupMethod: volume.CSISnapshot, CSISnapshotInfo: volume.CSISnapshotInfo{SnapshotHandle: "snapshot-8"}},
+			}}, nil)
+			backupStore.On("GetPodVolumeBackups", "backup-2").Return(nil, nil)
+			backupStore.On("GetBackupVolumeInfos", "backup-2").Return(nil, nil)
+			contents := velerotest.NewTarWriter(t).AddItems("datauploads.velero.io",
+				builder.ForDataUpload(velerov1api.DefaultNamespace, "du-2").SnapshotID("snapshot-5").Result()).Done().Bytes()
+			backupStore.On("GetBackupContents", "backup-2").Return(io.NopCloser(bytes.NewReader(contents)), nil)
+
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return()
+
+			var forgotten []string
+			repoManager := &repomocks.Manager{}
+			repoManager.On("ListSnapshots", mock.Anything).Return(snapshots, test.listErr)
+			repoManager.On("Forget", mock.Anything, mock.Anything).Return(func(ctx context.Context, snapshot repository.SnapshotIdentifier) error {
+				assert.Equal(t, "ns-1", snapshot.VolumeNamespace)
+				assert.Equal(t, "default", snapshot.BackupStorageLocation)
+				assert.Equal(t, velerov1api.BackupRepositoryTypeKopia, snapshot.RepositoryType)
+				forgotten = append(forgotten, snapshot.SnapshotID)
+				return test.forgetErrs[snapshot.SnapshotID]
+			})
+
+			r := NewBackupRepoOrphanReconciler(
+				velerov1api.DefaultNamespace,
+				cli,
+				repoManager,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"default": backupStore}),
+				velerotest.NewLogger(),
+			)
+			r.clock = testclocks.NewFakeClock(now)
+
+			key := types.NamespacedName{Namespace: test.repo.Namespace, Name: test.repo.Name}
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+			require.NoError(t, err)
+
+			repo := &velerov1api.BackupRepository{}
+			require.NoError(t, cli.Get(context.Background(), key, repo))
+			_, requested := repo.Annotations[velerov1api.OrphanScanRequestedAnnotation]
+			assert.Equal(t, test.expectedRequested, requested)
+			assert.Equal(t, test.expectedForgotten, forgotten)
+
+			if test.expectedScan == nil {
+				assert.Nil(t, repo.Status.OrphanScan)
+				repoManager.AssertNotCalled(t, "ListSnapshots", mock.Anything)
+				return
+			}
+			require.NotNil(t, repo.Status.OrphanScan)
+			test.expectedScan.Timestamp = &metav1.Time{Time: now}
+			assert.Equal(t, test.expectedScan.Mode, repo.Status.OrphanScan.Mode)
+			assert.Equal(t, test.expectedScan.Message, repo.Status.OrphanScan.Message)
+			assert.Equal(t, test.expectedScan.PrunedSnapshots, repo.Status.OrphanScan.PrunedSnapshots)
+			assert.True(t, test.expectedScan.Timestamp.Equal(repo.Status.OrphanScan.Timestamp))
+			require.Len(t, repo.Status.OrphanScan.OrphanedSnapshots, len(test.expectedScan.OrphanedSnapshots))
+			for i, expected := range test.expectedScan.OrphanedSnapshots {
+				actual := repo.Status.OrphanScan.OrphanedSnapshots[i]
+				assert.Equal(t, expected.SnapshotID, actual.SnapshotID)
+				assert.Equal(t, expected.Source, actual.Source)
+				assert.True(t, expected.StartTimestamp.Equal(actual.StartTimestamp))
+			}
+		})
+	}
+}
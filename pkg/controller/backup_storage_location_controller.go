@@ -30,6 +30,7 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/audit"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
@@ -51,6 +52,7 @@ type backupStorageLocationReconciler struct {
 	// replaced with fakes for testing.
 	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter persistence.ObjectBackupStoreGetter
+	auditRecorder     *audit.Recorder
 
 	log logrus.FieldLogger
 }
@@ -62,6 +64,7 @@ func NewBackupStorageLocationReconciler(
 	defaultBackupLocationInfo storage.DefaultBackupLocationInfo,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	auditRecorder *audit.Recorder,
 	log logrus.FieldLogger) *backupStorageLocationReconciler {
 	return &backupStorageLocationReconciler{
 		ctx:                       ctx,
@@ -69,6 +72,7 @@ func NewBackupStorageLocationReconciler(
 		defaultBackupLocationInfo: defaultBackupLocationInfo,
 		newPluginManager:          newPluginManager,
 		backupStoreGetter:         backupStoreGetter,
+		auditRecorder:             auditRecorder,
 		log:                       log,
 	}
 }
@@ -104,6 +108,8 @@ func (r *backupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 
 	if location.Name == "" || location.Namespace == "" {
 		log.WithError(err).Error("BackupStorageLocation is not found")
+		// the deleted location is recorded into the default location
+		r.auditRecorder.Record(req.Namespace, "", audit.ForBackupStorageLocationDeletion(req.Namespace, req.Name))
 		return ctrl.Result{}, nil
 	}
 
@@ -123,6 +129,7 @@ func (r *backupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 		original := location.DeepCopy()
 		defer func() {
 			location.Status.LastValidationTime = &metav1.Time{Time: time.Now().UTC()}
+			r.recordAudit(&location)
 			if err != nil {
 				log.Info("BackupStorageLocation is invalid, marking as unavailable")
 				err = errors.Wrapf(err, "BackupStorageLocation %q is unavailable", location.Name)
@@ -161,6 +168,21 @@ func (r *backupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 	return ctrl.Result{}, nil
 }
 
+// recordAudit records the creation or the spec changes of the location into the audit log if
+// the generation of the location isn't recorded yet.
+func (r *backupStorageLocationReconciler) recordAudit(location *velerov1api.BackupStorageLocation) {
+	if r.auditRecorder == nil || location.Generation == location.Status.AuditedGeneration {
+		return
+	}
+
+	action := audit.ActionBackupStorageLocationUpdate
+	if location.Status.AuditedGeneration == 0 {
+		action = audit.ActionBackupStorageLocationCreate
+	}
+	r.auditRecorder.Record(location.Namespace, location.Name, audit.ForBackupStorageLocation(action, location))
+	location.Status.AuditedGeneration = location.Generation
+}
+
 func (r *backupStorageLocationReconciler) logReconciledPhase(defaultFound bool, locationList velerov1api.BackupStorageLocationList, errs []string) {
 	var availableBSLs []*velerov1api.BackupStorageLocation
 	var unAvailableBSLs []*velerov1api.BackupStorageLocation
@@ -214,5 +236,6 @@ func (r *backupStorageLocationReconciler) SetupWithManager(mgr ctrl.Manager) err
 		// As the "status.LastValidationTime" field is always updated, this triggers new reconciling process, skip the update event that include no spec change to avoid the reconcile loop
 		For(&velerov1api.BackupStorageLocation{}, builder.WithPredicates(kube.SpecChangePredicate{})).
 		Watches(g, nil, builder.WithPredicates(gp)).
+		WithOptions(controllerOptions(BackupStorageLocation)).
 		Complete(r)
 }
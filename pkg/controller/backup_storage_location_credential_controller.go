@@ -0,0 +1,112 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// backupStorageLocationCredentialReconciler refreshes the credentials files of the backup storage
+// locations when the Secrets they reference change, e.g. the temporary keys are refreshed by an
+// external operator. The object stores initialized with the files reinitialize themselves once
+// the files are modified, so the rotated credentials are used without restarting the server.
+type backupStorageLocationCredentialReconciler struct {
+	client              client.Client
+	credentialFileStore credentials.FileStore
+	logger              logrus.FieldLogger
+}
+
+// NewBackupStorageLocationCredentialReconciler constructs a new backupStorageLocationCredentialReconciler.
+func NewBackupStorageLocationCredentialReconciler(
+	client client.Client,
+	credentialFileStore credentials.FileStore,
+	logger logrus.FieldLogger,
+) *backupStorageLocationCredentialReconciler {
+	return &backupStorageLocationCredentialReconciler{
+		client:              client,
+		credentialFileStore: credentialFileStore,
+		logger:              logger,
+	}
+}
+
+// Only the created Secrets and the updates changing the data of the Secrets are reconciled, the
+// files of the deleted Secrets are kept until the Secrets are recreated.
+func (r *backupStorageLocationCredentialReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1api.Secret{}, builder.WithPredicates(predicate.Funcs{
+			UpdateFunc: func(ue event.UpdateEvent) bool {
+				oldSecret, ok := ue.ObjectOld.(*corev1api.Secret)
+				if !ok {
+					return false
+				}
+				newSecret, ok := ue.ObjectNew.(*corev1api.Secret)
+				if !ok {
+					return false
+				}
+				return !reflect.DeepEqual(oldSecret.Data, newSecret.Data)
+			},
+			DeleteFunc: func(de event.DeleteEvent) bool {
+				return false
+			},
+			GenericFunc: func(ge event.GenericEvent) bool {
+				return false
+			},
+		})).
+		WithOptions(controllerOptions(BackupStorageLocationCredential)).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get;list
+
+func (r *backupStorageLocationCredentialReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("controller", BackupStorageLocationCredential).WithField("secret", req.String())
+
+	locations := &velerov1api.BackupStorageLocationList{}
+	if err := r.client.List(ctx, locations, client.InNamespace(req.Namespace)); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error listing backup storage locations")
+	}
+
+	for i := range locations.Items {
+		location := &locations.Items[i]
+		if location.Spec.Credential == nil || location.Spec.Credential.Name != req.Name {
+			continue
+		}
+
+		locationLog := log.WithField("backupStorageLocation", location.Name)
+		if _, err := r.credentialFileStore.Path(location.Spec.Credential); err != nil {
+			locationLog.WithError(err).Warn("Failed to refresh the credentials of the backup storage location")
+			continue
+		}
+		locationLog.Debug("Refreshed the credentials of the backup storage location")
+	}
+
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupStorageLocationCredentialReconcile(t *testing.T) {
+	secret := builder.ForSecret("velero", "cloud-credentials").Data(map[string][]byte{"cloud": []byte("key-1")}).Result()
+	client := velerotest.NewFakeControllerRuntimeClient(t,
+		secret,
+		builder.ForBackupStorageLocation("velero", "default").Credential(builder.ForSecretKeySelector("cloud-credentials", "cloud").Result()).Result(),
+		builder.ForBackupStorageLocation("velero", "other").Credential(builder.ForSecretKeySelector("other-credentials", "cloud").Result()).Result(),
+		builder.ForBackupStorageLocation("velero", "no-credential").Result(),
+	)
+
+	fs := velerotest.NewFakeFileSystem()
+	fileStore, err := credentials.NewNamespacedFileStore(client, "velero", "/credentials", fs)
+	require.NoError(t, err)
+
+	r := NewBackupStorageLocationCredentialReconciler(client, fileStore, logrus.New())
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "velero", Name: "cloud-credentials"}}
+
+	_, err = r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	contents, err := fs.ReadFile("/credentials/velero/cloud-credentials-cloud")
+	require.NoError(t, err)
+	require.Equal(t, "key-1", string(contents))
+
+	// the file is refreshed once the secret is rotated
+	secret.Data["cloud"] = []byte("key-2")
+	require.NoError(t, client.Update(context.Background(), secret))
+	_, err = r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	contents, err = fs.ReadFile("/credentials/velero/cloud-credentials-cloud")
+	require.NoError(t, err)
+	require.Equal(t, "key-2", string(contents))
+
+	// the files of the other locations aren't written
+	_, err = fs.Stat("/credentials/velero/other-credentials-cloud")
+	require.Error(t, err)
+}
@@ -19,6 +19,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
@@ -32,6 +33,9 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/features"
@@ -46,6 +50,13 @@ import (
 
 const (
 	backupSyncReconcilePeriod = time.Minute
+
+	// backupSyncFullListingPeriod is how often all the backups of the locations with a change log
+	// are listed, the changed backups are only listed by the change log in between.
+	backupSyncFullListingPeriod = time.Hour
+	// backupChangesClockSkew is the max clock skew between the Velero servers recording the
+	// changes of the backups and the one listing them.
+	backupChangesClockSkew = 5 * time.Minute
 )
 
 type backupSyncReconciler struct {
@@ -55,6 +66,16 @@ type backupSyncReconciler struct {
 	newPluginManager        func(logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter       persistence.ObjectBackupStoreGetter
 	logger                  logrus.FieldLogger
+
+	// notifiedBackups are the names of the backups created in or removed from the locations which
+	// are reported by the object store notifications but not synced yet, keyed by the location name.
+	notifiedBackups     map[string]sets.String
+	notifiedBackupsLock sync.Mutex
+	notifications       chan event.GenericEvent
+
+	// lastFullListings are the last times all the backups of the locations were listed, keyed by
+	// the location name.
+	lastFullListings map[string]time.Time
 }
 
 // NewBackupSyncReconciler is used to generate BackupSync reconciler structure.
@@ -72,6 +93,9 @@ func NewBackupSyncReconciler(
 		newPluginManager:        newPluginManager,
 		backupStoreGetter:       backupStoreGetter,
 		logger:                  logger,
+		notifiedBackups:         make(map[string]sets.String),
+		notifications:           make(chan event.GenericEvent, backupSyncNotificationQueueSize),
+		lastFullListings:        make(map[string]time.Time),
 	}
 }
 
@@ -102,6 +126,31 @@ func (b *backupSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, nil
 	}
 
+	// only sync the backups reported by the object store notifications until the next full sync
+	// of the location is due, the full sync covers the notified backups as well.
+	if backupNames := b.takeNotifiedBackups(location.Name); backupNames.Len() > 0 && !b.locationFilterFunc(location) {
+		b.syncChangedBackups(ctx, location, backupStore, backupNames, log)
+		return ctrl.Result{}, nil
+	}
+
+	// only list the backups changed since the last sync by the change log of the location, all
+	// the backups are listed periodically to sync the ones missing from the change log, e.g. the
+	// ones removed by the lifecycle policies of the bucket.
+	if b.changeLogListing(location) {
+		backupNames, err := backupStore.ListChangedBackups(location.Status.LastSyncedTime.Add(-backupChangesClockSkew))
+		if err == nil {
+			b.syncChangedBackups(ctx, location, backupStore, sets.NewString(backupNames...), log)
+
+			statusPatch := client.MergeFrom(location.DeepCopy())
+			location.Status.LastSyncedTime = &metav1.Time{Time: time.Now().UTC()}
+			if err := b.client.Patch(ctx, location, statusPatch); err != nil {
+				log.WithError(errors.WithStack(err)).Error("Error patching backup location's last-synced time")
+			}
+			return ctrl.Result{}, nil
+		}
+		log.WithError(err).Warn("Error listing the changed backups, listing all the backups instead")
+	}
+
 	// get a list of all the backups that are stored in the backup storage location
 	res, err := backupStore.ListBackups()
 	if err != nil {
@@ -140,164 +189,282 @@ func (b *backupSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	// sync each backup
 	for backupName := range backupsToSync {
-		log = log.WithField("backup", backupName)
-		log.Info("Attempting to sync backup into cluster")
+		b.syncBackup(ctx, location, backupStore, backupName, log)
+	}
 
-		exist, err := backupStore.BackupExists(location.Spec.ObjectStorage.Bucket, backupName)
-		if err != nil {
-			log.WithError(errors.WithStack(err)).Error("Error checking backup exist from backup store")
-			continue
-		}
-		if !exist {
-			log.Debugf("backup %s doesn't exist in backup store, skip", backupName)
-			continue
-		}
+	b.deleteOrphanedBackups(ctx, location.Name, backupStoreBackups, log)
+	b.recordFullListing(location, backupStore, log)
 
-		backup, err := backupStore.GetBackupMetadata(backupName)
-		if err != nil {
-			log.WithError(errors.WithStack(err)).Error("Error getting backup metadata from backup store")
-			continue
+	// update the location's last-synced time and usage fields
+	statusPatch := client.MergeFrom(location.DeepCopy())
+	location.Status.LastSyncedTime = &metav1.Time{Time: time.Now().UTC()}
+	location.Status.Usage = getLocationUsage(backupStore, len(backupStoreBackups), log)
+	if err := b.client.Patch(ctx, location, statusPatch); err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error patching backup location's last-synced time")
+		return ctrl.Result{}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// syncBackup syncs the backup and its pod volume backups and CSI snapshot objects from the backup
+// store of the location into the cluster.
+func (b *backupSyncReconciler) syncBackup(ctx context.Context, location *velerov1api.BackupStorageLocation, backupStore persistence.BackupStore, backupName string, log logrus.FieldLogger) {
+	log = log.WithField("backup", backupName)
+	log.Info("Attempting to sync backup into cluster")
+
+	exist, err := backupStore.BackupExists(location.Spec.ObjectStorage.Bucket, backupName)
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error checking backup exist from backup store")
+		return
+	}
+	if !exist {
+		log.Debugf("backup %s doesn't exist in backup store, skip", backupName)
+		return
+	}
+
+	backup, err := backupStore.GetBackupMetadata(backupName)
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error getting backup metadata from backup store")
+		return
+	}
+
+	if expiredInLifecycleMode(location, backup, time.Now()) {
+		log.Debug("Skipping expired backup, it's removed by the lifecycle policies of the bucket")
+		return
+	}
+
+	if backup.Status.Phase == velerov1api.BackupPhaseWaitingForPluginOperations ||
+		backup.Status.Phase == velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed ||
+		backup.Status.Phase == velerov1api.BackupPhaseFinalizing ||
+		backup.Status.Phase == velerov1api.BackupPhaseFinalizingPartiallyFailed {
+		if backup.Status.Expiration == nil || backup.Status.Expiration.After(time.Now()) {
+			log.Debugf("Skipping non-expired incomplete backup %v", backup.Name)
+			return
 		}
+		log.Debugf("%v Backup is past expiration, syncing for garbage collection", backup.Status.Phase)
+		backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
+	}
+	backup.Namespace = b.namespace
+	backup.ResourceVersion = ""
+
+	// update the StorageLocation field and label since the name of the location
+	// may be different in this cluster than in the cluster that created the
+	// backup.
+	backup.Spec.StorageLocation = location.Name
+	if backup.Labels == nil {
+		backup.Labels = make(map[string]string)
+	}
+	backup.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(backup.Spec.StorageLocation)
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeImport {
+		backup.Labels[velerov1api.ImportedBackupLabel] = "true"
+	}
 
-		if backup.Status.Phase == velerov1api.BackupPhaseWaitingForPluginOperations ||
-			backup.Status.Phase == velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed ||
-			backup.Status.Phase == velerov1api.BackupPhaseFinalizing ||
-			backup.Status.Phase == velerov1api.BackupPhaseFinalizingPartiallyFailed {
-			if backup.Status.Expiration == nil || backup.Status.Expiration.After(time.Now()) {
-				log.Debugf("Skipping non-expired incomplete backup %v", backup.Name)
-				continue
+	//check for the ownership references. If they do not exist, remove them.
+	backup.ObjectMeta.OwnerReferences = b.filterBackupOwnerReferences(ctx, backup, log)
+
+	// attempt to create backup custom resource via API
+	err = b.client.Create(ctx, backup, &client.CreateOptions{})
+	switch {
+	case err != nil && apierrors.IsAlreadyExists(err):
+		log.Debug("Backup already exists in cluster")
+		return
+	case err != nil && !apierrors.IsAlreadyExists(err):
+		log.WithError(errors.WithStack(err)).Error("Error syncing backup into cluster")
+		return
+	default:
+		log.Info("Successfully synced backup into cluster")
+	}
+
+	// process the pod volume backups from object store, if any
+	podVolumeBackups, err := backupStore.GetPodVolumeBackups(backupName)
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error getting pod volume backups for this backup from backup store")
+		return
+	}
+
+	for _, podVolumeBackup := range podVolumeBackups {
+		log := log.WithField("podVolumeBackup", podVolumeBackup.Name)
+		log.Debug("Checking this pod volume backup to see if it needs to be synced into the cluster")
+
+		for i, ownerRef := range podVolumeBackup.OwnerReferences {
+			if ownerRef.APIVersion == velerov1api.SchemeGroupVersion.String() && ownerRef.Kind == "Backup" && ownerRef.Name == backup.Name {
+				log.WithField("uid", backup.UID).Debugf("Updating pod volume backup's owner reference UID")
+				podVolumeBackup.OwnerReferences[i].UID = backup.UID
 			}
-			log.Debugf("%v Backup is past expiration, syncing for garbage collection", backup.Status.Phase)
-			backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
 		}
-		backup.Namespace = b.namespace
-		backup.ResourceVersion = ""
-
-		// update the StorageLocation field and label since the name of the location
-		// may be different in this cluster than in the cluster that created the
-		// backup.
-		backup.Spec.StorageLocation = location.Name
-		if backup.Labels == nil {
-			backup.Labels = make(map[string]string)
+
+		if _, ok := podVolumeBackup.Labels[velerov1api.BackupUIDLabel]; ok {
+			podVolumeBackup.Labels[velerov1api.BackupUIDLabel] = string(backup.UID)
 		}
-		backup.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(backup.Spec.StorageLocation)
 
-		//check for the ownership references. If they do not exist, remove them.
-		backup.ObjectMeta.OwnerReferences = b.filterBackupOwnerReferences(ctx, backup, log)
+		podVolumeBackup.Namespace = backup.Namespace
+		podVolumeBackup.ResourceVersion = ""
+		podVolumeBackup.Spec.BackupStorageLocation = location.Name
 
-		// attempt to create backup custom resource via API
-		err = b.client.Create(ctx, backup, &client.CreateOptions{})
+		err = b.client.Create(ctx, podVolumeBackup, &client.CreateOptions{})
 		switch {
 		case err != nil && apierrors.IsAlreadyExists(err):
-			log.Debug("Backup already exists in cluster")
+			log.Debug("Pod volume backup already exists in cluster")
 			continue
 		case err != nil && !apierrors.IsAlreadyExists(err):
-			log.WithError(errors.WithStack(err)).Error("Error syncing backup into cluster")
+			log.WithError(errors.WithStack(err)).Error("Error syncing pod volume backup into cluster")
 			continue
 		default:
-			log.Info("Successfully synced backup into cluster")
+			log.Debug("Synced pod volume backup into cluster")
 		}
+	}
 
-		// process the pod volume backups from object store, if any
-		podVolumeBackups, err := backupStore.GetPodVolumeBackups(backupName)
+	if features.IsEnabled(velerov1api.CSIFeatureFlag) {
+		// we are syncing these objects only to ensure that the storage snapshots are cleaned up
+		// on backup deletion or expiry.
+		log.Info("Syncing CSI VolumeSnapshotClasses in backup")
+		vsClasses, err := backupStore.GetCSIVolumeSnapshotClasses(backupName)
 		if err != nil {
-			log.WithError(errors.WithStack(err)).Error("Error getting pod volume backups for this backup from backup store")
-			continue
+			log.WithError(errors.WithStack(err)).Error("Error getting CSI VolumeSnapClasses for this backup from backup store")
+			return
 		}
-
-		for _, podVolumeBackup := range podVolumeBackups {
-			log := log.WithField("podVolumeBackup", podVolumeBackup.Name)
-			log.Debug("Checking this pod volume backup to see if it needs to be synced into the cluster")
-
-			for i, ownerRef := range podVolumeBackup.OwnerReferences {
-				if ownerRef.APIVersion == velerov1api.SchemeGroupVersion.String() && ownerRef.Kind == "Backup" && ownerRef.Name == backup.Name {
-					log.WithField("uid", backup.UID).Debugf("Updating pod volume backup's owner reference UID")
-					podVolumeBackup.OwnerReferences[i].UID = backup.UID
-				}
-			}
-
-			if _, ok := podVolumeBackup.Labels[velerov1api.BackupUIDLabel]; ok {
-				podVolumeBackup.Labels[velerov1api.BackupUIDLabel] = string(backup.UID)
+		for _, vsClass := range vsClasses {
+			vsClass.ResourceVersion = ""
+			err := b.client.Create(ctx, vsClass, &client.CreateOptions{})
+			switch {
+			case err != nil && apierrors.IsAlreadyExists(err):
+				log.Debugf("VolumeSnapshotClass %s already exists in cluster", vsClass.Name)
+				continue
+			case err != nil && !apierrors.IsAlreadyExists(err):
+				log.WithError(errors.WithStack(err)).Errorf("Error syncing VolumeSnapshotClass %s into cluster", vsClass.Name)
+				continue
+			default:
+				log.Infof("Created CSI VolumeSnapshotClass %s", vsClass.Name)
 			}
+		}
 
-			podVolumeBackup.Namespace = backup.Namespace
-			podVolumeBackup.ResourceVersion = ""
-			podVolumeBackup.Spec.BackupStorageLocation = location.Name
+		log.Info("Syncing CSI volumesnapshotcontents in backup")
+		snapConts, err := backupStore.GetCSIVolumeSnapshotContents(backupName)
+		if err != nil {
+			log.WithError(errors.WithStack(err)).Error("Error getting CSI volumesnapshotcontents for this backup from backup store")
+			return
+		}
 
-			err = b.client.Create(ctx, podVolumeBackup, &client.CreateOptions{})
+		log.Infof("Syncing %d CSI volumesnapshotcontents in backup", len(snapConts))
+		for _, snapCont := range snapConts {
+			// TODO: Reset ResourceVersion prior to persisting VolumeSnapshotContents
+			snapCont.ResourceVersion = ""
+			err := b.client.Create(ctx, snapCont, &client.CreateOptions{})
 			switch {
 			case err != nil && apierrors.IsAlreadyExists(err):
-				log.Debug("Pod volume backup already exists in cluster")
+				log.Debugf("volumesnapshotcontent %s already exists in cluster", snapCont.Name)
 				continue
 			case err != nil && !apierrors.IsAlreadyExists(err):
-				log.WithError(errors.WithStack(err)).Error("Error syncing pod volume backup into cluster")
+				log.WithError(errors.WithStack(err)).Errorf("Error syncing volumesnapshotcontent %s into cluster", snapCont.Name)
 				continue
 			default:
-				log.Debug("Synced pod volume backup into cluster")
+				log.Infof("Created CSI volumesnapshotcontent %s", snapCont.Name)
 			}
 		}
+	}
+}
 
-		if features.IsEnabled(velerov1api.CSIFeatureFlag) {
-			// we are syncing these objects only to ensure that the storage snapshots are cleaned up
-			// on backup deletion or expiry.
-			log.Info("Syncing CSI VolumeSnapshotClasses in backup")
-			vsClasses, err := backupStore.GetCSIVolumeSnapshotClasses(backupName)
-			if err != nil {
-				log.WithError(errors.WithStack(err)).Error("Error getting CSI VolumeSnapClasses for this backup from backup store")
-				continue
-			}
-			for _, vsClass := range vsClasses {
-				vsClass.ResourceVersion = ""
-				err := b.client.Create(ctx, vsClass, &client.CreateOptions{})
-				switch {
-				case err != nil && apierrors.IsAlreadyExists(err):
-					log.Debugf("VolumeSnapshotClass %s already exists in cluster", vsClass.Name)
-					continue
-				case err != nil && !apierrors.IsAlreadyExists(err):
-					log.WithError(errors.WithStack(err)).Errorf("Error syncing VolumeSnapshotClass %s into cluster", vsClass.Name)
-					continue
-				default:
-					log.Infof("Created CSI VolumeSnapshotClass %s", vsClass.Name)
-				}
-			}
+// changeLogListing returns whether to only list the changed backups of the location by its
+// change log rather than all the backups.
+func (b *backupSyncReconciler) changeLogListing(location *velerov1api.BackupStorageLocation) bool {
+	if !location.Spec.ObjectStorage.ChangeLog || location.Status.LastSyncedTime == nil {
+		return false
+	}
 
-			log.Info("Syncing CSI volumesnapshotcontents in backup")
-			snapConts, err := backupStore.GetCSIVolumeSnapshotContents(backupName)
-			if err != nil {
-				log.WithError(errors.WithStack(err)).Error("Error getting CSI volumesnapshotcontents for this backup from backup store")
-				continue
-			}
+	lastFullListing, ok := b.lastFullListings[location.Name]
+	return ok && time.Since(lastFullListing) < backupSyncFullListingPeriod
+}
 
-			log.Infof("Syncing %d CSI volumesnapshotcontents in backup", len(snapConts))
-			for _, snapCont := range snapConts {
-				// TODO: Reset ResourceVersion prior to persisting VolumeSnapshotContents
-				snapCont.ResourceVersion = ""
-				err := b.client.Create(ctx, snapCont, &client.CreateOptions{})
-				switch {
-				case err != nil && apierrors.IsAlreadyExists(err):
-					log.Debugf("volumesnapshotcontent %s already exists in cluster", snapCont.Name)
-					continue
-				case err != nil && !apierrors.IsAlreadyExists(err):
-					log.WithError(errors.WithStack(err)).Errorf("Error syncing volumesnapshotcontent %s into cluster", snapCont.Name)
-					continue
-				default:
-					log.Infof("Created CSI volumesnapshotcontent %s", snapCont.Name)
-				}
-			}
+// recordFullListing records the full listing of the backups of the location, and prunes the
+// expired changes of its change log.
+func (b *backupSyncReconciler) recordFullListing(location *velerov1api.BackupStorageLocation, backupStore persistence.BackupStore, log logrus.FieldLogger) {
+	if !location.Spec.ObjectStorage.ChangeLog {
+		return
+	}
+
+	if b.lastFullListings == nil {
+		b.lastFullListings = make(map[string]time.Time)
+	}
+	b.lastFullListings[location.Name] = time.Now()
+
+	if location.Spec.IsReadOnly() {
+		return
+	}
+	if err := backupStore.PruneBackupChanges(time.Now().Add(-persistence.BackupChangesRetention)); err != nil {
+		log.WithError(err).Warn("Error pruning the backup changes")
+	}
+}
+
+// syncChangedBackups syncs the changed backups, e.g. the ones reported by the object store
+// notifications, without listing all the backups of the location. The backups which don't exist
+// in the cluster are synced into it, and the ones removed from the backup store are deleted from
+// the cluster the same as the orphaned backups found by the full sync.
+func (b *backupSyncReconciler) syncChangedBackups(ctx context.Context, location *velerov1api.BackupStorageLocation, backupStore persistence.BackupStore, backupNames sets.String, log logrus.FieldLogger) {
+	log.WithField("backupCount", backupNames.Len()).Debug("Syncing the changed backups")
+
+	for _, backupName := range backupNames.List() {
+		backup := &velerov1api.Backup{}
+		err := b.client.Get(ctx, client.ObjectKey{Namespace: b.namespace, Name: backupName}, backup)
+		if apierrors.IsNotFound(err) {
+			b.syncBackup(ctx, location, backupStore, backupName, log)
+			continue
+		}
+		if err != nil {
+			log.WithError(errors.WithStack(err)).WithField("backup", backupName).Error("Error getting backup from cluster")
+			continue
+		}
+
+		if backup.Spec.StorageLocation != location.Name ||
+			!(backup.Status.Phase == velerov1api.BackupPhaseCompleted || backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed) {
+			continue
+		}
+
+		exist, err := backupStore.BackupExists(location.Spec.ObjectStorage.Bucket, backupName)
+		if err != nil {
+			log.WithError(errors.WithStack(err)).WithField("backup", backupName).Error("Error checking backup exist from backup store")
+			continue
+		}
+		if !exist {
+			b.deleteRemovedBackup(ctx, backup, log)
 		}
 	}
+}
 
-	b.deleteOrphanedBackups(ctx, location.Name, backupStoreBackups, log)
+func (b *backupSyncReconciler) deleteRemovedBackup(ctx context.Context, backup *velerov1api.Backup, log logrus.FieldLogger) {
+	log = log.WithField("backup", backup.Name)
 
-	// update the location's last-synced time field
-	statusPatch := client.MergeFrom(location.DeepCopy())
-	location.Status.LastSyncedTime = &metav1.Time{Time: time.Now().UTC()}
-	if err := b.client.Patch(ctx, location, statusPatch); err != nil {
-		log.WithError(errors.WithStack(err)).Error("Error patching backup location's last-synced time")
-		return ctrl.Result{}, nil
+	if err := b.client.Delete(ctx, backup, &client.DeleteOptions{}); err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error deleting orphaned backup from cluster")
+		return
 	}
+	log.Debug("Deleted orphaned backup from cluster")
+	b.deleteCSISnapshotsByBackup(ctx, backup.Name, log)
+}
 
-	return ctrl.Result{}, nil
+// getLocationUsage returns the approximate storage consumed by the backup store, only the backup
+// count is reported if the object store isn't able to list the stats of the objects.
+func getLocationUsage(backupStore persistence.BackupStore, backupCount int, log logrus.FieldLogger) *velerov1api.BackupStorageLocationUsage {
+	usage := &velerov1api.BackupStorageLocationUsage{BackupCount: backupCount}
+
+	storageUsage, err := backupStore.GetStorageUsage()
+	if err != nil {
+		log.WithError(err).Warn("Error getting storage usage of backup location")
+		return usage
+	}
+	if storageUsage == nil {
+		return usage
+	}
+
+	usage.Bytes = &storageUsage.Bytes
+	if !storageUsage.OldestBackup.IsZero() {
+		usage.OldestBackupTime = &metav1.Time{Time: storageUsage.OldestBackup.UTC()}
+	}
+	if !storageUsage.NewestBackup.IsZero() {
+		usage.NewestBackupTime = &metav1.Time{Time: storageUsage.NewestBackup.UTC()}
+	}
+
+	return usage
 }
 
 func (b *backupSyncReconciler) filterBackupOwnerReferences(ctx context.Context, backup *velerov1api.Backup, log logrus.FieldLogger) []metav1.OwnerReference {
@@ -350,6 +517,8 @@ func (b *backupSyncReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		// Filter all BSL events, because this controller is supposed to run periodically, not by event.
 		For(&velerov1api.BackupStorageLocation{}, builder.WithPredicates(kube.FalsePredicate{})).
 		Watches(backupSyncSource, nil, builder.WithPredicates(gp)).
+		Watches(&source.Channel{Source: b.notifications}, &handler.EnqueueRequestForObject{}).
+		WithOptions(controllerOptions(BackupSync)).
 		Complete(b)
 }
 
@@ -19,12 +19,17 @@ package controller
 import (
 	"context"
 	"fmt"
+	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,6 +46,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
@@ -432,6 +438,7 @@ var _ = Describe("Backup Sync Reconciler", func() {
 					backupStore.On("BackupExists", "bucket-1", backup.backup.Name).Return(true, nil)
 				}
 				backupStore.On("ListBackups").Return(backupNames, nil)
+				backupStore.On("GetStorageUsage").Return(nil, nil)
 			}
 
 			for _, existingBackup := range test.existingBackups {
@@ -451,6 +458,10 @@ var _ = Describe("Backup Sync Reconciler", func() {
 			Expect(actualResult).To(BeEquivalentTo(ctrl.Result{}))
 			Expect(err).To(BeNil())
 
+			location := &velerov1api.BackupStorageLocation{}
+			Expect(client.Get(ctx, types.NamespacedName{Namespace: test.location.Namespace, Name: test.location.Name}, location)).To(Succeed())
+			Expect(location.Status.Usage).To(Equal(&velerov1api.BackupStorageLocationUsage{BackupCount: len(test.cloudBackups)}))
+
 			// process the cloud backups
 			for _, cloudBackupData := range test.cloudBackups {
 				obj := &velerov1api.Backup{}
@@ -896,3 +907,145 @@ var _ = Describe("Backup Sync Reconciler", func() {
 		}
 	})
 })
+
+func TestGetLocationUsage(t *testing.T) {
+	oldest := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newest := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	bytes := int64(1024)
+
+	tests := []struct {
+		name         string
+		storageUsage *persistence.StorageUsage
+		err          error
+		expected     *velerov1api.BackupStorageLocationUsage
+	}{
+		{
+			name:     "object store isn't able to list the stats of the objects",
+			expected: &velerov1api.BackupStorageLocationUsage{BackupCount: 2},
+		},
+		{
+			name:     "error getting the storage usage",
+			err:      errors.New("fake-error"),
+			expected: &velerov1api.BackupStorageLocationUsage{BackupCount: 2},
+		},
+		{
+			name:         "storage usage is reported",
+			storageUsage: &persistence.StorageUsage{Bytes: bytes, OldestBackup: oldest, NewestBackup: newest},
+			expected: &velerov1api.BackupStorageLocationUsage{
+				Bytes:            &bytes,
+				BackupCount:      2,
+				OldestBackupTime: &metav1.Time{Time: oldest},
+				NewestBackupTime: &metav1.Time{Time: newest},
+			},
+		},
+		{
+			name:         "location without backups",
+			storageUsage: &persistence.StorageUsage{Bytes: bytes},
+			expected:     &velerov1api.BackupStorageLocationUsage{Bytes: &bytes, BackupCount: 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("GetStorageUsage").Return(test.storageUsage, test.err)
+
+			assert.Equal(t, test.expected, getLocationUsage(backupStore, 2, velerotest.NewLogger()))
+		})
+	}
+}
+
+func TestSyncBackupsByChangeLog(t *testing.T) {
+	tests := []struct {
+		name            string
+		lastFullListing time.Time
+		fullListing     bool
+	}{
+		{
+			name:            "only the changed backups are listed",
+			lastFullListing: time.Now().Add(-time.Minute),
+		},
+		{
+			name:        "all the backups are listed after the server restarts",
+			fullListing: true,
+		},
+		{
+			name:            "all the backups are listed periodically",
+			lastFullListing: time.Now().Add(-2 * backupSyncFullListingPeriod),
+			fullListing:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			location := defaultLocation(velerov1api.DefaultNamespace)
+			location.Spec.ObjectStorage.ChangeLog = true
+			location.Status.LastSyncedTime = &metav1.Time{Time: time.Now().UTC().Add(-time.Minute).Truncate(time.Second)}
+			client := velerotest.NewFakeControllerRuntimeClient(t, location)
+
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("ListChangedBackups", mock.Anything).Return([]string{"backup-1"}, nil)
+			backupStore.On("ListBackups").Return([]string{"backup-1"}, nil)
+			backupStore.On("GetStorageUsage").Return(nil, nil)
+			backupStore.On("PruneBackupChanges", mock.Anything).Return(nil)
+			backupStore.On("BackupExists", "bucket-1", "backup-1").Return(true, nil)
+			backupStore.On("GetBackupMetadata", "backup-1").Return(builder.ForBackup("ns-1", "backup-1").Phase(velerov1api.BackupPhaseCompleted).Result(), nil)
+			backupStore.On("GetPodVolumeBackups", "backup-1").Return(nil, nil)
+
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+
+			r := NewBackupSyncReconciler(
+				client,
+				velerov1api.DefaultNamespace,
+				time.Minute,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{location.Name: backupStore}),
+				velerotest.NewLogger(),
+			)
+			if !test.lastFullListing.IsZero() {
+				r.lastFullListings[location.Name] = test.lastFullListing
+			}
+
+			_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: location.Namespace, Name: location.Name}})
+			require.NoError(t, err)
+
+			if test.fullListing {
+				backupStore.AssertNotCalled(t, "ListChangedBackups", mock.Anything)
+				backupStore.AssertCalled(t, "ListBackups")
+				backupStore.AssertCalled(t, "PruneBackupChanges", mock.Anything)
+				assert.WithinDuration(t, time.Now(), r.lastFullListings[location.Name], time.Minute)
+			} else {
+				since := location.Status.LastSyncedTime.Add(-backupChangesClockSkew)
+				backupStore.AssertCalled(t, "ListChangedBackups", mock.MatchedBy(func(t time.Time) bool { return t.Equal(since) }))
+				backupStore.AssertNotCalled(t, "ListBackups")
+			}
+
+			require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "backup-1"}, &velerov1api.Backup{}))
+
+			updated := &velerov1api.BackupStorageLocation{}
+			require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: location.Namespace, Name: location.Name}, updated))
+			assert.True(t, updated.Status.LastSyncedTime.After(location.Status.LastSyncedTime.Time))
+		})
+	}
+}
+
+func TestSyncImportedBackup(t *testing.T) {
+	ctx := context.Background()
+	location := defaultLocation(velerov1api.DefaultNamespace)
+	location.Spec.AccessMode = velerov1api.BackupStorageLocationAccessModeImport
+	client := velerotest.NewFakeControllerRuntimeClient(t, location)
+
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("BackupExists", "bucket-1", "backup-1").Return(true, nil)
+	backupStore.On("GetBackupMetadata", "backup-1").Return(builder.ForBackup("ns-1", "backup-1").Phase(velerov1api.BackupPhaseCompleted).Result(), nil)
+	backupStore.On("GetPodVolumeBackups", "backup-1").Return(nil, nil)
+
+	r := NewBackupSyncReconciler(client, velerov1api.DefaultNamespace, time.Minute, nil, nil, velerotest.NewLogger())
+	r.syncBackup(ctx, location, backupStore, "backup-1", velerotest.NewLogger())
+
+	synced := &velerov1api.Backup{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "backup-1"}, synced))
+	assert.Equal(t, "true", synced.Labels[velerov1api.ImportedBackupLabel])
+}
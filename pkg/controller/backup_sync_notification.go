@@ -0,0 +1,208 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+const (
+	// BackupSyncNotificationPath is the path of the endpoint receiving the object store notifications.
+	BackupSyncNotificationPath = "/backup-sync/notifications"
+
+	backupSyncNotificationQueueSize = 100
+	backupMetadataFile              = "velero-backup.json"
+
+	// the object stores send a few records per notification, so a much larger body isn't a notification
+	backupSyncNotificationMaxBodySize = 1 << 20
+)
+
+// objectStoreNotification is the S3 event notification sent by the object store, e.g. by the
+// webhook targets of MinIO, only the fields used by the backup sync are decoded.
+type objectStoreNotification struct {
+	Records []objectStoreNotificationRecord `json:"Records"`
+}
+
+type objectStoreNotificationRecord struct {
+	EventName string `json:"eventName"`
+	S3        struct {
+		Bucket struct {
+			Name string `json:"name"`
+		} `json:"bucket"`
+		Object struct {
+			Key string `json:"key"`
+		} `json:"object"`
+	} `json:"s3"`
+}
+
+// NotificationHandler returns the handler of the object store notifications, the backups created in
+// or removed from the backup storage locations are synced as soon as they're reported instead of
+// waiting for the next periodic sync of the locations. If the authToken isn't empty, the notifications
+// are accepted only if they carry the token in the Authorization header, e.g. the auth_token of the
+// webhook targets of MinIO.
+func (b *backupSyncReconciler) NotificationHandler(authToken string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the object stores check if the endpoint is reachable before sending the notifications
+		if r.Method == http.MethodHead || r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		if authToken != "" && !notificationAuthorized(r.Header.Get("Authorization"), authToken) {
+			b.logger.Warnf("Rejected the unauthorized object store notification from %s", r.RemoteAddr)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		notification := objectStoreNotification{}
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, backupSyncNotificationMaxBodySize)).Decode(&notification); err != nil {
+			b.logger.WithError(err).Warn("Error decoding the object store notification")
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				w.WriteHeader(http.StatusRequestEntityTooLarge)
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if err := b.handleNotification(r.Context(), &notification); err != nil {
+			b.logger.WithError(err).Error("Error handling the object store notification")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// notificationAuthorized returns whether the Authorization header carries the token, either as is or
+// as a bearer token.
+func notificationAuthorized(authorization, authToken string) bool {
+	token := strings.TrimPrefix(authorization, "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(token), []byte(authToken)) == 1
+}
+
+// handleNotification records the backups reported by the notification for the locations they're
+// stored in, and triggers the sync of the locations.
+func (b *backupSyncReconciler) handleNotification(ctx context.Context, notification *objectStoreNotification) error {
+	var records []objectStoreNotificationRecord
+	for _, record := range notification.Records {
+		if strings.Contains(record.EventName, "ObjectCreated") || strings.Contains(record.EventName, "ObjectRemoved") {
+			records = append(records, record)
+		}
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	locations := &velerov1api.BackupStorageLocationList{}
+	if err := b.client.List(ctx, locations, &client.ListOptions{Namespace: b.namespace}); err != nil {
+		return errors.Wrap(err, "error listing backup storage locations")
+	}
+
+	for i := range locations.Items {
+		location := &locations.Items[i]
+		if location.Spec.ObjectStorage == nil {
+			continue
+		}
+
+		backupNames := sets.NewString()
+		for _, record := range records {
+			if record.S3.Bucket.Name != location.Spec.ObjectStorage.Bucket {
+				continue
+			}
+			if backupName, ok := backupNameFromNotificationKey(location.Spec.ObjectStorage.Prefix, record.S3.Object.Key); ok {
+				backupNames.Insert(backupName)
+			}
+		}
+		if backupNames.Len() == 0 {
+			continue
+		}
+
+		b.logger.WithField("backupLocation", location.Name).Debugf("Got object store notification of backups %v", backupNames.List())
+		b.addNotifiedBackups(location.Name, backupNames)
+
+		select {
+		case b.notifications <- event.GenericEvent{Object: location}:
+		default:
+			// the notified backups are synced by the next sync of the location
+			b.logger.WithField("backupLocation", location.Name).Warn("The queue of the object store notifications is full")
+		}
+	}
+
+	return nil
+}
+
+// backupNameFromNotificationKey returns the name of the backup whose metadata file is the object of
+// the key, the key is URL encoded in the notifications.
+func backupNameFromNotificationKey(prefix, key string) (string, bool) {
+	key, err := url.QueryUnescape(key)
+	if err != nil {
+		return "", false
+	}
+
+	backupsDir := path.Join(prefix, "backups") + "/"
+	if !strings.HasPrefix(key, backupsDir) {
+		return "", false
+	}
+
+	parts := strings.Split(strings.TrimPrefix(key, backupsDir), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] != backupMetadataFile {
+		return "", false
+	}
+	return parts[0], true
+}
+
+func (b *backupSyncReconciler) addNotifiedBackups(locationName string, backupNames sets.String) {
+	b.notifiedBackupsLock.Lock()
+	defer b.notifiedBackupsLock.Unlock()
+
+	if b.notifiedBackups == nil {
+		b.notifiedBackups = make(map[string]sets.String)
+	}
+	if _, ok := b.notifiedBackups[locationName]; !ok {
+		b.notifiedBackups[locationName] = sets.NewString()
+	}
+	b.notifiedBackups[locationName].Insert(backupNames.UnsortedList()...)
+}
+
+// takeNotifiedBackups returns and clears the notified backups of the location.
+func (b *backupSyncReconciler) takeNotifiedBackups(locationName string) sets.String {
+	b.notifiedBackupsLock.Lock()
+	defer b.notifiedBackupsLock.Unlock()
+
+	backupNames := b.notifiedBackups[locationName]
+	delete(b.notifiedBackups, locationName)
+	return backupNames
+}
@@ -0,0 +1,269 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupNameFromNotificationKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   string
+		key      string
+		expected string
+		ok       bool
+	}{
+		{
+			name:     "metadata file of a backup",
+			key:      "backups/backup-1/velero-backup.json",
+			expected: "backup-1",
+			ok:       true,
+		},
+		{
+			name:     "metadata file of a backup under the prefix",
+			prefix:   "cluster-1",
+			key:      "cluster-1%2Fbackups%2Fbackup-1%2Fvelero-backup.json",
+			expected: "backup-1",
+			ok:       true,
+		},
+		{
+			name:   "metadata file outside of the prefix",
+			prefix: "cluster-1",
+			key:    "cluster-2/backups/backup-1/velero-backup.json",
+		},
+		{
+			name: "other file of a backup",
+			key:  "backups/backup-1/backup-1.tar.gz",
+		},
+		{
+			name: "file of a restore",
+			key:  "restores/restore-1/velero-backup.json",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backupName, ok := backupNameFromNotificationKey(test.prefix, test.key)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, backupName)
+		})
+	}
+}
+
+func TestBackupSyncNotificationHandler(t *testing.T) {
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Bucket("bucket-1").Prefix("cluster-1").Result()
+	otherLocation := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "other").Bucket("bucket-2").Result()
+
+	tests := []struct {
+		name            string
+		method          string
+		body            string
+		authToken       string
+		authorization   string
+		expectedCode    int
+		expectedBackups map[string]sets.String
+	}{
+		{
+			name:         "reachability check",
+			method:       http.MethodHead,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "invalid notification",
+			method:       http.MethodPost,
+			body:         "{",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "backups created and removed",
+			method: http.MethodPost,
+			body: `{"EventName":"s3:ObjectCreated:Put","Records":[
+				{"eventName":"s3:ObjectCreated:Put","s3":{"bucket":{"name":"bucket-1"},"object":{"key":"cluster-1%2Fbackups%2Fbackup-1%2Fvelero-backup.json"}}},
+				{"eventName":"s3:ObjectCreated:Put","s3":{"bucket":{"name":"bucket-1"},"object":{"key":"cluster-1%2Fbackups%2Fbackup-1%2Fbackup-1.tar.gz"}}},
+				{"eventName":"s3:ObjectRemoved:Delete","s3":{"bucket":{"name":"bucket-2"},"object":{"key":"backups%2Fbackup-2%2Fvelero-backup.json"}}},
+				{"eventName":"s3:ObjectAccessed:Get","s3":{"bucket":{"name":"bucket-2"},"object":{"key":"backups%2Fbackup-3%2Fvelero-backup.json"}}}
+			]}`,
+			expectedCode: http.StatusOK,
+			expectedBackups: map[string]sets.String{
+				"default": sets.NewString("backup-1"),
+				"other":   sets.NewString("backup-2"),
+			},
+		},
+		{
+			name:         "notification too large",
+			method:       http.MethodPost,
+			body:         `{"Records":[` + strings.Repeat(" ", backupSyncNotificationMaxBodySize) + `]}`,
+			expectedCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:   "notification without the token",
+			method: http.MethodPost,
+			body: `{"Records":[
+				{"eventName":"s3:ObjectCreated:Put","s3":{"bucket":{"name":"bucket-2"},"object":{"key":"backups%2Fbackup-1%2Fvelero-backup.json"}}}
+			]}`,
+			authToken:    "token-1",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:   "notification with a wrong token",
+			method: http.MethodPost,
+			body: `{"Records":[
+				{"eventName":"s3:ObjectCreated:Put","s3":{"bucket":{"name":"bucket-2"},"object":{"key":"backups%2Fbackup-1%2Fvelero-backup.json"}}}
+			]}`,
+			authToken:     "token-1",
+			authorization: "Bearer token-2",
+			expectedCode:  http.StatusUnauthorized,
+		},
+		{
+			name:   "notification with the token",
+			method: http.MethodPost,
+			body: `{"Records":[
+				{"eventName":"s3:ObjectCreated:Put","s3":{"bucket":{"name":"bucket-2"},"object":{"key":"backups%2Fbackup-1%2Fvelero-backup.json"}}}
+			]}`,
+			authToken:     "token-1",
+			authorization: "Bearer token-1",
+			expectedCode:  http.StatusOK,
+			expectedBackups: map[string]sets.String{
+				"other": sets.NewString("backup-1"),
+			},
+		},
+		{
+			name:   "bucket not used by any location",
+			method: http.MethodPost,
+			body: `{"Records":[
+				{"eventName":"s3:ObjectCreated:Put","s3":{"bucket":{"name":"bucket-3"},"object":{"key":"backups%2Fbackup-1%2Fvelero-backup.json"}}}
+			]}`,
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := NewBackupSyncReconciler(
+				velerotest.NewFakeControllerRuntimeClient(t, location, otherLocation),
+				velerov1api.DefaultNamespace,
+				time.Minute,
+				nil,
+				nil,
+				velerotest.NewLogger(),
+			)
+
+			req := httptest.NewRequest(test.method, BackupSyncNotificationPath, strings.NewReader(test.body))
+			if test.authorization != "" {
+				req.Header.Set("Authorization", test.authorization)
+			}
+			resp := httptest.NewRecorder()
+			r.NotificationHandler(test.authToken).ServeHTTP(resp, req)
+			assert.Equal(t, test.expectedCode, resp.Code)
+
+			for locationName, backupNames := range test.expectedBackups {
+				assert.Equal(t, backupNames, r.takeNotifiedBackups(locationName))
+			}
+			assert.Len(t, r.notifications, len(test.expectedBackups))
+		})
+	}
+}
+
+func TestSyncNotifiedBackups(t *testing.T) {
+	ctx := context.Background()
+	location := defaultLocation(velerov1api.DefaultNamespace)
+	location.Status.LastSyncedTime = &metav1.Time{Time: time.Now()}
+
+	completed := builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").StorageLocation(location.Name).Phase(velerov1api.BackupPhaseCompleted).Result()
+	inProgress := builder.ForBackup(velerov1api.DefaultNamespace, "backup-3").StorageLocation(location.Name).Phase(velerov1api.BackupPhaseInProgress).Result()
+	client := velerotest.NewFakeControllerRuntimeClient(t, location, completed, inProgress)
+
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("BackupExists", "bucket-1", "backup-1").Return(true, nil)
+	backupStore.On("BackupExists", "bucket-1", "backup-2").Return(false, nil)
+	backupStore.On("BackupExists", "bucket-1", "backup-3").Return(false, nil)
+	backupStore.On("GetBackupMetadata", "backup-1").Return(builder.ForBackup("ns-1", "backup-1").Phase(velerov1api.BackupPhaseCompleted).Result(), nil)
+	backupStore.On("GetPodVolumeBackups", "backup-1").Return(nil, nil)
+
+	pluginManager := &pluginmocks.Manager{}
+	pluginManager.On("CleanupClients").Return(nil)
+
+	r := NewBackupSyncReconciler(
+		client,
+		velerov1api.DefaultNamespace,
+		time.Hour,
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{location.Name: backupStore}),
+		velerotest.NewLogger(),
+	)
+	r.addNotifiedBackups(location.Name, sets.NewString("backup-1", "backup-2", "backup-3"))
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: location.Namespace, Name: location.Name}})
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result)
+
+	// the notified backups are synced without listing the backups of the location
+	backupStore.AssertNotCalled(t, "ListBackups")
+	assert.Empty(t, r.takeNotifiedBackups(location.Name))
+
+	synced := &velerov1api.Backup{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "backup-1"}, synced))
+	assert.Equal(t, location.Name, synced.Spec.StorageLocation)
+
+	err = client.Get(ctx, types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "backup-2"}, &velerov1api.Backup{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	// only the completed backups are deleted
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "backup-3"}, &velerov1api.Backup{}))
+
+	// the last synced time isn't updated as the full sync isn't run
+	updated := &velerov1api.BackupStorageLocation{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: location.Namespace, Name: location.Name}, updated))
+	assert.Equal(t, location.Status.LastSyncedTime.Unix(), updated.Status.LastSyncedTime.Unix())
+}
+
+func TestBackupSyncNotificationQueueFull(t *testing.T) {
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Bucket("bucket-1").Result()
+	r := NewBackupSyncReconciler(velerotest.NewFakeControllerRuntimeClient(t, location), velerov1api.DefaultNamespace, time.Minute, nil, nil, velerotest.NewLogger())
+	r.notifications = make(chan event.GenericEvent)
+
+	notification := &objectStoreNotification{Records: []objectStoreNotificationRecord{{EventName: "s3:ObjectCreated:Put"}}}
+	notification.Records[0].S3.Bucket.Name = "bucket-1"
+	notification.Records[0].S3.Object.Key = "backups/backup-1/velero-backup.json"
+
+	// the notification isn't blocked, the backup is synced by the next sync of the location
+	require.NoError(t, r.handleNotification(context.Background(), notification))
+	assert.Equal(t, sets.NewString("backup-1"), r.takeNotifiedBackups(location.Name))
+}
@@ -0,0 +1,169 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const defaultBackupTieringFrequency = time.Hour
+
+// backupTieringReconciler transitions the contents of the completed backups to the storage
+// classes of their tiering as the backups age.
+type backupTieringReconciler struct {
+	client.Client
+	logger            logrus.FieldLogger
+	clock             clocks.WithTickerAndDelayedExecution
+	frequency         time.Duration
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+}
+
+// NewBackupTieringReconciler constructs a new backupTieringReconciler.
+func NewBackupTieringReconciler(
+	logger logrus.FieldLogger,
+	client client.Client,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+) *backupTieringReconciler {
+	return &backupTieringReconciler{
+		Client:            client,
+		logger:            logger,
+		clock:             clocks.RealClock{},
+		frequency:         defaultBackupTieringFrequency,
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+	}
+}
+
+// The backups are checked periodically as the transitions are due by the age of the backups,
+// the update events are filtered in the same way as the GC controller.
+func (r *backupTieringReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	s := kube.NewPeriodicalEnqueueSource(r.logger, mgr.GetClient(), &velerov1api.BackupList{}, r.frequency, kube.PeriodicalEnqueueSourceOption{})
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.Backup{}, builder.WithPredicates(predicate.Funcs{
+			UpdateFunc: func(ue event.UpdateEvent) bool {
+				return false
+			},
+			DeleteFunc: func(de event.DeleteEvent) bool {
+				return false
+			},
+			GenericFunc: func(ge event.GenericEvent) bool {
+				return false
+			},
+		})).
+		Watches(s, nil).
+		WithOptions(controllerOptions(BackupTiering)).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get
+
+func (r *backupTieringReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("backup", req.String())
+
+	backup := &velerov1api.Backup{}
+	if err := r.Get(ctx, req.NamespacedName, backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Backup not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting backup %s", req.String())
+	}
+
+	if backup.Spec.Tiering == nil || backup.Status.CompletionTimestamp == nil {
+		return ctrl.Result{}, nil
+	}
+	if backup.Status.Phase != velerov1api.BackupPhaseCompleted && backup.Status.Phase != velerov1api.BackupPhasePartiallyFailed {
+		return ctrl.Result{}, nil
+	}
+
+	storageClass := dueStorageClass(backup.Spec.Tiering, backup.Status.CompletionTimestamp.Time, r.clock.Now())
+	if storageClass == "" || storageClass == backup.Status.StorageClass {
+		return ctrl.Result{}, nil
+	}
+	log = log.WithField("storageClass", storageClass)
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: req.Namespace, Name: backup.Spec.StorageLocation}, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Warnf("Backup cannot be transitioned because backup storage location %s does not exist", backup.Spec.StorageLocation)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrap(err, "error getting backup storage location")
+	}
+	if location.Spec.IsReadOnly() {
+		log.Infof("Backup cannot be transitioned because backup storage location %s is currently in read-only mode", location.Name)
+		return ctrl.Result{}, nil
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error getting backup store")
+	}
+
+	log.Info("Transitioning backup contents to storage class")
+	if err := backupStore.TransitionBackup(backup.Name, storageClass); err != nil {
+		// the transition is retried when the backup is checked next time
+		log.WithError(err).Error("Error transitioning backup contents to storage class")
+		return ctrl.Result{}, nil
+	}
+
+	original := backup.DeepCopy()
+	backup.Status.StorageClass = storageClass
+	backup.Status.StorageClassTransitionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	if err := kube.PatchResource(original, backup, r.Client); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error updating backup status")
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// dueStorageClass returns the storage class of the transition with the most days elapsed since
+// the completion of the backup, empty is returned if no transition is due.
+func dueStorageClass(tiering *velerov1api.BackupTiering, completion, now time.Time) string {
+	storageClass, days := "", -1
+	for _, transition := range tiering.Transitions {
+		if transition.Days <= days || completion.Add(time.Duration(transition.Days)*24*time.Hour).After(now) {
+			continue
+		}
+		storageClass, days = transition.StorageClass, transition.Days
+	}
+
+	return storageClass
+}
@@ -0,0 +1,141 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestDueStorageClass(t *testing.T) {
+	completion := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tiering := &velerov1api.BackupTiering{
+		Transitions: []velerov1api.BackupTierTransition{
+			{Days: 90, StorageClass: "GLACIER"},
+			{Days: 30, StorageClass: "STANDARD_IA"},
+		},
+	}
+
+	assert.Equal(t, "", dueStorageClass(tiering, completion, completion.Add(29*24*time.Hour)))
+	assert.Equal(t, "STANDARD_IA", dueStorageClass(tiering, completion, completion.Add(30*24*time.Hour)))
+	assert.Equal(t, "GLACIER", dueStorageClass(tiering, completion, completion.Add(100*24*time.Hour)))
+}
+
+func TestBackupTieringReconcile(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	completion := now.Add(-40 * 24 * time.Hour)
+	transitions := []velerov1api.BackupTierTransition{{Days: 30, StorageClass: "STANDARD_IA"}}
+
+	tests := []struct {
+		name                 string
+		backup               *velerov1api.Backup
+		location             *velerov1api.BackupStorageLocation
+		transitionErr        error
+		expectedTransition   bool
+		expectedStorageClass string
+	}{
+		{
+			name:   "backup without tiering is skipped",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseCompleted).CompletionTimestamp(completion).Result(),
+		},
+		{
+			name:   "backup in progress is skipped",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseInProgress).Tiering(transitions...).Result(),
+		},
+		{
+			name: "backup already transitioned is skipped",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Tiering(transitions...).WithStatus(velerov1api.BackupStatus{
+				Phase:               velerov1api.BackupPhaseCompleted,
+				CompletionTimestamp: &metav1.Time{Time: completion},
+				StorageClass:        "STANDARD_IA",
+			}).Result(),
+			expectedStorageClass: "STANDARD_IA",
+		},
+		{
+			name:     "backup storage location is read-only",
+			backup:   builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").Phase(velerov1api.BackupPhaseCompleted).CompletionTimestamp(completion).Tiering(transitions...).Result(),
+			location: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+		},
+		{
+			name:                 "backup contents are transitioned",
+			backup:               builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").Phase(velerov1api.BackupPhaseCompleted).CompletionTimestamp(completion).Tiering(transitions...).Result(),
+			location:             builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+			expectedTransition:   true,
+			expectedStorageClass: "STANDARD_IA",
+		},
+		{
+			name:               "transition fails",
+			backup:             builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").Phase(velerov1api.BackupPhaseCompleted).CompletionTimestamp(completion).Tiering(transitions...).Result(),
+			location:           builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+			transitionErr:      errors.New("fake-error"),
+			expectedTransition: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t, test.backup)
+			if test.location != nil {
+				require.NoError(t, fakeClient.Create(context.Background(), test.location))
+			}
+
+			backupStore := &persistencemocks.BackupStore{}
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return()
+			if test.expectedTransition {
+				backupStore.On("TransitionBackup", "backup-1", "STANDARD_IA").Return(test.transitionErr)
+			}
+
+			r := NewBackupTieringReconciler(
+				velerotest.NewLogger(),
+				fakeClient,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeSingleObjectBackupStoreGetter(backupStore),
+			)
+			r.clock = testclocks.NewFakeClock(now)
+
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: test.backup.Namespace, Name: test.backup.Name}})
+			require.NoError(t, err)
+			backupStore.AssertExpectations(t)
+
+			backup := &velerov1api.Backup{}
+			require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Namespace: test.backup.Namespace, Name: test.backup.Name}, backup))
+			assert.Equal(t, test.expectedStorageClass, backup.Status.StorageClass)
+			if test.expectedStorageClass != "" && test.expectedTransition {
+				require.NotNil(t, backup.Status.StorageClassTransitionTimestamp)
+				assert.True(t, backup.Status.StorageClassTransitionTimestamp.Time.Equal(now))
+			}
+		})
+	}
+}
@@ -17,35 +17,47 @@ limitations under the License.
 package controller
 
 const (
-	Backup                = "backup"
-	BackupOperations      = "backup-operations"
-	BackupDeletion        = "backup-deletion"
-	BackupFinalizer       = "backup-finalizer"
-	BackupRepo            = "backup-repo"
-	BackupStorageLocation = "backup-storage-location"
-	BackupSync            = "backup-sync"
-	DownloadRequest       = "download-request"
-	GarbageCollection     = "gc"
-	PodVolumeBackup       = "pod-volume-backup"
-	PodVolumeRestore      = "pod-volume-restore"
-	Restore               = "restore"
-	RestoreOperations     = "restore-operations"
-	Schedule              = "schedule"
-	ServerStatusRequest   = "server-status-request"
+	Backup                          = "backup"
+	BackupCopy                      = "backup-copy"
+	BackupOperations                = "backup-operations"
+	BackupDeletion                  = "backup-deletion"
+	BackupFinalizer                 = "backup-finalizer"
+	BackupRepo                      = "backup-repo"
+	BackupRepoMigration             = "backup-repo-migration"
+	BackupRepoOrphan                = "backup-repo-orphan"
+	BackupStorageLocation           = "backup-storage-location"
+	BackupStorageLocationCredential = "backup-storage-location-credential"
+	BackupSync                      = "backup-sync"
+	BackupTiering                   = "backup-tiering"
+	DownloadRequest                 = "download-request"
+	GarbageCollection               = "gc"
+	PodVolumeBackup                 = "pod-volume-backup"
+	PodVolumeRestore                = "pod-volume-restore"
+	Restore                         = "restore"
+	RestoreOperations               = "restore-operations"
+	RestoreSchedule                 = "restore-schedule"
+	Schedule                        = "schedule"
+	ServerStatusRequest             = "server-status-request"
 )
 
 // DisableableControllers is a list of controllers that can be disabled
 var DisableableControllers = []string{
 	Backup,
+	BackupCopy,
 	BackupOperations,
 	BackupDeletion,
 	BackupFinalizer,
+	BackupStorageLocationCredential,
 	BackupSync,
+	BackupTiering,
 	DownloadRequest,
 	GarbageCollection,
 	BackupRepo,
+	BackupRepoMigration,
+	BackupRepoOrphan,
 	Restore,
 	RestoreOperations,
+	RestoreSchedule,
 	Schedule,
 	ServerStatusRequest,
 }
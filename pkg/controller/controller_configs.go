@@ -0,0 +1,133 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+)
+
+const (
+	defaultWorkQueueBaseDelay = 5 * time.Millisecond
+	defaultWorkQueueMaxDelay  = 1000 * time.Second
+	defaultWorkQueueQPS       = 10
+	defaultWorkQueueBurst     = 100
+)
+
+// ControllerConfig is the config of a controller of the Velero server, which overrides the global
+// client settings for the controller so that a busy controller doesn't starve the others.
+type ControllerConfig struct {
+	// ClientQPS is the maximum number of requests per second sent by the controller to the
+	// Kubernetes API once the burst limit has been reached, the global client QPS is used if it's 0.
+	ClientQPS float32 `json:"clientQPS,omitempty"`
+
+	// ClientBurst is the maximum number of requests sent by the controller to the Kubernetes API
+	// in a short period of time, the global client burst is used if it's 0.
+	ClientBurst int `json:"clientBurst,omitempty"`
+
+	// WorkQueue is the rate limiter of the work queue of the controller.
+	WorkQueue *WorkQueueConfig `json:"workQueue,omitempty"`
+}
+
+// WorkQueueConfig is the rate limiter of the work queue of a controller, the requeued items are
+// delayed exponentially from the base delay to the max delay, and their overall rate is limited by
+// a token bucket of the QPS and burst. The default value is used for the fields which aren't set.
+type WorkQueueConfig struct {
+	BaseDelay metav1.Duration `json:"baseDelay,omitempty"`
+	MaxDelay  metav1.Duration `json:"maxDelay,omitempty"`
+	QPS       float64         `json:"qps,omitempty"`
+	Burst     int             `json:"burst,omitempty"`
+}
+
+// ConfigurableControllers are the controllers of the Velero server which can be configured by
+// the ControllerConfig.
+var ConfigurableControllers = append([]string{BackupStorageLocation}, DisableableControllers...)
+
+// workQueueConfigs are the work queue configs keyed by the controller name, they're set when
+// the server starts before setting up the controllers.
+var workQueueConfigs = map[string]*WorkQueueConfig{}
+
+// Validate checks the config of the controller.
+func (c *ControllerConfig) Validate() error {
+	if c.ClientQPS < 0 {
+		return errors.New("clientQPS must not be negative")
+	}
+	if c.ClientBurst < 0 {
+		return errors.New("clientBurst must not be negative")
+	}
+	if c.WorkQueue == nil {
+		return nil
+	}
+	if c.WorkQueue.BaseDelay.Duration < 0 || c.WorkQueue.MaxDelay.Duration < 0 {
+		return errors.New("the delays of the work queue must not be negative")
+	}
+	if c.WorkQueue.QPS < 0 || c.WorkQueue.Burst < 0 {
+		return errors.New("the qps and burst of the work queue must not be negative")
+	}
+	return nil
+}
+
+// SetWorkQueueConfigs sets the work queue configs of the controllers.
+func SetWorkQueueConfigs(configs map[string]ControllerConfig) {
+	workQueueConfigs = map[string]*WorkQueueConfig{}
+	for name, config := range configs {
+		if config.WorkQueue != nil {
+			workQueueConfigs[name] = config.WorkQueue
+		}
+	}
+}
+
+// controllerOptions returns the options of the controller with the rate limiter of its work
+// queue config, the default rate limiter is used if the controller doesn't have one.
+func controllerOptions(name string) controller.Options {
+	options := controller.Options{}
+	if config, ok := workQueueConfigs[name]; ok {
+		options.RateLimiter = config.rateLimiter()
+	}
+	return options
+}
+
+func (c *WorkQueueConfig) rateLimiter() workqueue.RateLimiter {
+	baseDelay, maxDelay := c.BaseDelay.Duration, c.MaxDelay.Duration
+	if baseDelay == 0 {
+		baseDelay = defaultWorkQueueBaseDelay
+	}
+	if maxDelay == 0 {
+		maxDelay = defaultWorkQueueMaxDelay
+	}
+	if maxDelay < baseDelay {
+		maxDelay = baseDelay
+	}
+
+	qps, burst := c.QPS, c.Burst
+	if qps == 0 {
+		qps = defaultWorkQueueQPS
+	}
+	if burst == 0 {
+		burst = defaultWorkQueueBurst
+	}
+
+	return workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(baseDelay, maxDelay),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(qps), burst)},
+	)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestControllerConfigValidate(t *testing.T) {
+	assert.NoError(t, (&ControllerConfig{ClientQPS: 10, ClientBurst: 20, WorkQueue: &WorkQueueConfig{QPS: 1}}).Validate())
+	assert.EqualError(t, (&ControllerConfig{ClientQPS: -1}).Validate(), "clientQPS must not be negative")
+	assert.EqualError(t, (&ControllerConfig{ClientBurst: -1}).Validate(), "clientBurst must not be negative")
+	assert.EqualError(t, (&ControllerConfig{WorkQueue: &WorkQueueConfig{MaxDelay: metav1.Duration{Duration: -time.Second}}}).Validate(), "the delays of the work queue must not be negative")
+	assert.EqualError(t, (&ControllerConfig{WorkQueue: &WorkQueueConfig{Burst: -1}}).Validate(), "the qps and burst of the work queue must not be negative")
+}
+
+func TestControllerOptions(t *testing.T) {
+	defer SetWorkQueueConfigs(nil)
+
+	SetWorkQueueConfigs(map[string]ControllerConfig{
+		Restore: {ClientQPS: 50},
+		BackupSync: {WorkQueue: &WorkQueueConfig{
+			BaseDelay: metav1.Duration{Duration: time.Second},
+			MaxDelay:  metav1.Duration{Duration: 4 * time.Second},
+		}},
+	})
+
+	assert.Nil(t, controllerOptions(Restore).RateLimiter)
+	assert.Nil(t, controllerOptions(Backup).RateLimiter)
+
+	limiter := controllerOptions(BackupSync).RateLimiter
+	assert.NotNil(t, limiter)
+	assert.Equal(t, time.Second, limiter.When("item"))
+	assert.Equal(t, 2*time.Second, limiter.When("item"))
+	assert.Equal(t, 4*time.Second, limiter.When("item"))
+	assert.Equal(t, 4*time.Second, limiter.When("item"))
+	limiter.Forget("item")
+	assert.Equal(t, time.Second, limiter.When("item"))
+}
@@ -233,6 +233,11 @@ func (r *DataDownloadReconciler) Reconcile(ctx context.Context, req ctrl.Request
 			return ctrl.Result{}, nil
 		}
 
+		if dd.Spec.Paused {
+			r.OnDataDownloadCancelled(ctx, dd.GetNamespace(), dd.GetName())
+			return ctrl.Result{}, nil
+		}
+
 		fsRestore := r.dataPathMgr.GetAsyncBR(dd.Name)
 
 		if fsRestore != nil {
@@ -258,7 +263,7 @@ func (r *DataDownloadReconciler) Reconcile(ctx context.Context, req ctrl.Request
 			OnProgress:  r.OnDataDownloadProgress,
 		}
 
-		fsRestore, err = r.dataPathMgr.CreateFileSystemBR(dd.Name, dataUploadDownloadRequestor, ctx, r.client, dd.Namespace, callbacks, log)
+		fsRestore, err = r.dataPathMgr.CreateFileSystemBR(dd.Name, dataUploadDownloadRequestor, datapath.TaskTypeRestore, ctx, r.client, dd.Namespace, dd.Spec.TargetVolume.Namespace, callbacks, log)
 		if err != nil {
 			if err == datapath.ConcurrentLimitExceed {
 				log.Info("Data path instance is concurrent limited requeue later")
@@ -303,6 +308,39 @@ func (r *DataDownloadReconciler) Reconcile(ctx context.Context, req ctrl.Request
 			}
 			fsRestore.Cancel()
 			return ctrl.Result{}, nil
+		} else if dd.Spec.Paused {
+			log.Info("Data download is being paused")
+			fsRestore := r.dataPathMgr.GetAsyncBR(dd.Name)
+			if fsRestore == nil {
+				r.OnDataDownloadCancelled(ctx, dd.GetNamespace(), dd.GetName())
+				return ctrl.Result{}, nil
+			}
+
+			// OnDataDownloadCancelled will mark the DataDownload as paused once the data path is canceled
+			fsRestore.Cancel()
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, nil
+	} else if dd.Status.Phase == velerov2alpha1api.DataDownloadPhasePaused {
+		if dd.Spec.Cancel {
+			log.Info("Paused data download is being canceled")
+			r.OnDataDownloadCancelled(ctx, dd.GetNamespace(), dd.GetName())
+			return ctrl.Result{}, nil
+		}
+
+		if !dd.Spec.Paused {
+			log.Info("Data download is resumed")
+
+			// move the DataDownload back to Prepared as the restore PVC is still exposed, the data path
+			// is then restarted on the node where the restore pod is running and skips the files restored before
+			original := dd.DeepCopy()
+			dd.Status.Phase = velerov2alpha1api.DataDownloadPhasePrepared
+			dd.Status.Message = ""
+			if err := r.client.Patch(ctx, dd, client.MergeFrom(original)); err != nil {
+				log.WithError(err).Error("error updating data download into prepared status")
+				return ctrl.Result{}, err
+			}
 		}
 
 		return ctrl.Result{}, nil
@@ -403,6 +441,16 @@ func (r *DataDownloadReconciler) OnDataDownloadCancelled(ctx context.Context, na
 	var dd velerov2alpha1api.DataDownload
 	if getErr := r.client.Get(ctx, types.NamespacedName{Name: ddName, Namespace: namespace}, &dd); getErr != nil {
 		log.WithError(getErr).Warn("Failed to get datadownload on cancel")
+	} else if dd.Spec.Paused && !dd.Spec.Cancel {
+		// keep the exposed restore PVC, so the data download could be resumed later
+		original := dd.DeepCopy()
+		dd.Status.Phase = velerov2alpha1api.DataDownloadPhasePaused
+		dd.Status.Message = "data download is paused"
+		if err := r.client.Patch(ctx, &dd, client.MergeFrom(original)); err != nil {
+			log.WithError(err).Error("error updating data download status")
+		} else {
+			log.Info("Data download paused")
+		}
 	} else {
 		// cleans up any objects generated during the snapshot expose
 		r.restoreExposer.CleanUp(ctx, getDataDownloadOwnerObject(&dd))
@@ -143,7 +143,7 @@ func initDataDownloadReconcilerWithError(objects []runtime.Object, needError ...
 		return nil, err
 	}
 
-	dataPathMgr := datapath.NewManager(1)
+	dataPathMgr := datapath.NewManager(1, nil, uploader.BandwidthLimits{})
 
 	return NewDataDownloadReconciler(fakeClient, fakeKubeClient, dataPathMgr, nil, &credentials.CredentialGetter{FromFile: credentialFileStore}, "test_node", time.Minute*5, velerotest.NewLogger(), metrics.NewServerMetrics()), nil
 }
@@ -208,11 +208,37 @@ func TestDataDownloadReconcile(t *testing.T) {
 			needCreateFSBR: true,
 			mockCancel:     true,
 		},
+		{
+			name:           "Pause data download in progress",
+			dd:             dataDownloadBuilder().Phase(velerov2alpha1api.DataDownloadPhaseInProgress).Paused(true).Result(),
+			targetPVC:      builder.ForPersistentVolumeClaim("test-ns", "test-pvc").Result(),
+			needCreateFSBR: true,
+			mockCancel:     true,
+			expected:       dataDownloadBuilder().Phase(velerov2alpha1api.DataDownloadPhaseInProgress).Result(),
+		},
+		{
+			name:      "Pause prepared data download",
+			dd:        dataDownloadBuilder().Phase(velerov2alpha1api.DataDownloadPhasePrepared).Paused(true).Result(),
+			targetPVC: builder.ForPersistentVolumeClaim("test-ns", "test-pvc").Result(),
+			expected:  dataDownloadBuilder().Phase(velerov2alpha1api.DataDownloadPhasePaused).Result(),
+		},
+		{
+			name:      "Resume paused data download",
+			dd:        dataDownloadBuilder().Phase(velerov2alpha1api.DataDownloadPhasePaused).Paused(false).Result(),
+			targetPVC: builder.ForPersistentVolumeClaim("test-ns", "test-pvc").Result(),
+			expected:  dataDownloadBuilder().Phase(velerov2alpha1api.DataDownloadPhasePrepared).Result(),
+		},
+		{
+			name:      "Cancel paused data download",
+			dd:        dataDownloadBuilder().Phase(velerov2alpha1api.DataDownloadPhasePaused).Paused(true).Cancel(true).Result(),
+			targetPVC: builder.ForPersistentVolumeClaim("test-ns", "test-pvc").Result(),
+			expected:  dataDownloadBuilder().Phase(velerov2alpha1api.DataDownloadPhaseCanceled).Result(),
+		},
 		{
 			name:           "Error in data path is concurrent limited",
 			dd:             dataDownloadBuilder().Phase(velerov2alpha1api.DataDownloadPhasePrepared).Result(),
 			targetPVC:      builder.ForPersistentVolumeClaim("test-ns", "test-pvc").Result(),
-			dataMgr:        datapath.NewManager(0),
+			dataMgr:        datapath.NewManager(0, nil, uploader.BandwidthLimits{}),
 			notNilExpose:   true,
 			notMockCleanUp: true,
 			expectedResult: &ctrl.Result{Requeue: true, RequeueAfter: time.Minute},
@@ -349,10 +375,10 @@ func TestDataDownloadReconcile(t *testing.T) {
 			if test.dataMgr != nil {
 				r.dataPathMgr = test.dataMgr
 			} else {
-				r.dataPathMgr = datapath.NewManager(1)
+				r.dataPathMgr = datapath.NewManager(1, nil, uploader.BandwidthLimits{})
 			}
 
-			datapath.FSBRCreator = func(string, string, kbclient.Client, string, datapath.Callbacks, logrus.FieldLogger) datapath.AsyncBR {
+			datapath.FSBRCreator = func(string, string, kbclient.Client, string, uploader.BandwidthLimits, uploader.CacheOptions, datapath.Callbacks, logrus.FieldLogger) datapath.AsyncBR {
 				fsBR := datapathmockes.NewAsyncBR(t)
 				if test.mockCancel {
 					fsBR.On("Cancel").Return()
@@ -391,7 +417,7 @@ func TestDataDownloadReconcile(t *testing.T) {
 
 			if test.needCreateFSBR {
 				if fsBR := r.dataPathMgr.GetAsyncBR(test.dd.Name); fsBR == nil {
-					_, err := r.dataPathMgr.CreateFileSystemBR(test.dd.Name, pVBRRequestor, ctx, r.client, velerov1api.DefaultNamespace, datapath.Callbacks{OnCancelled: r.OnDataDownloadCancelled}, velerotest.NewLogger())
+					_, err := r.dataPathMgr.CreateFileSystemBR(test.dd.Name, pVBRRequestor, datapath.TaskTypeRestore, ctx, r.client, velerov1api.DefaultNamespace, "", datapath.Callbacks{OnCancelled: r.OnDataDownloadCancelled}, velerotest.NewLogger())
 					require.NoError(t, err)
 				}
 			}
@@ -498,6 +524,21 @@ func TestOnDataDownloadCancelled(t *testing.T) {
 	}
 }
 
+func TestOnDataDownloadPaused(t *testing.T) {
+	ctx := context.TODO()
+	r, err := initDataDownloadReconciler(nil)
+	require.NoError(t, err)
+
+	dd := dataDownloadBuilder().Phase(velerov2alpha1api.DataDownloadPhaseInProgress).Paused(true).Result()
+	assert.NoError(t, r.client.Create(ctx, dd))
+	r.OnDataDownloadCancelled(ctx, dd.Namespace, dd.Name)
+
+	updatedDD := &velerov2alpha1api.DataDownload{}
+	assert.NoError(t, r.client.Get(ctx, types.NamespacedName{Name: dd.Name, Namespace: dd.Namespace}, updatedDD))
+	assert.Equal(t, velerov2alpha1api.DataDownloadPhasePaused, updatedDD.Status.Phase)
+	assert.True(t, updatedDD.Status.CompletionTimestamp.IsZero())
+}
+
 func TestOnDataDownloadCompleted(t *testing.T) {
 	tests := []struct {
 		name            string
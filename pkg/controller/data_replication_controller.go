@@ -0,0 +1,354 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/datamover"
+	"github.com/vmware-tanzu/velero/pkg/datapath"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+)
+
+const dataReplicationRequestor = "snapshot-data-replication"
+
+// DataReplicationReconciler reconciles a DataReplication object
+type DataReplicationReconciler struct {
+	client            client.Client
+	logger            logrus.FieldLogger
+	credentialGetter  *credentials.CredentialGetter
+	Clock             clock.WithTickerAndDelayedExecution
+	nodeName          string
+	repositoryEnsurer *repository.Ensurer
+	dataPathMgr       *datapath.Manager
+}
+
+func NewDataReplicationReconciler(client client.Client, dataPathMgr *datapath.Manager, repoEnsurer *repository.Ensurer,
+	credentialGetter *credentials.CredentialGetter, nodeName string, logger logrus.FieldLogger) *DataReplicationReconciler {
+	return &DataReplicationReconciler{
+		client:            client,
+		logger:            logger.WithField("controller", "DataReplication"),
+		credentialGetter:  credentialGetter,
+		Clock:             &clock.RealClock{},
+		nodeName:          nodeName,
+		repositoryEnsurer: repoEnsurer,
+		dataPathMgr:       dataPathMgr,
+	}
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=datareplications,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=datareplications/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get;update;patch
+
+func (r *DataReplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithFields(logrus.Fields{
+		"controller":      "datareplication",
+		"datareplication": req.NamespacedName,
+	})
+
+	dr := &velerov2alpha1api.DataReplication{}
+	if err := r.client.Get(ctx, req.NamespacedName, dr); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("DataReplication not found, skip")
+			return ctrl.Result{}, nil
+		}
+		log.WithError(err).Error("Unable to get the DataReplication")
+		return ctrl.Result{}, err
+	}
+
+	if !datamover.IsBuiltInUploader(dr.Spec.DataMover) {
+		log.WithField("data mover", dr.Spec.DataMover).Info("it is not one built-in data mover which is not supported by Velero")
+		return ctrl.Result{}, nil
+	}
+
+	switch dr.Status.Phase {
+	case "", velerov2alpha1api.DataReplicationPhaseNew:
+		log.Info("Data replication starting")
+
+		accepted, err := r.acceptDataReplication(ctx, dr)
+		if err != nil {
+			return ctrl.Result{}, r.updateStatusToFailed(ctx, dr, err, "error to accept the data replication", log)
+		}
+
+		if !accepted {
+			log.Debug("Data replication is not accepted")
+			return ctrl.Result{}, nil
+		}
+
+		log.Info("Data replication is accepted")
+
+		return r.runCancelableDataPath(ctx, dr, log)
+	case velerov2alpha1api.DataReplicationPhaseAccepted:
+		// the data path couldn't be started when the data replication was accepted, e.g., the concurrent limit was exceeded
+		if dr.Status.Node != r.nodeName || r.dataPathMgr.GetAsyncBR(dr.Name) != nil {
+			return ctrl.Result{}, nil
+		}
+
+		return r.runCancelableDataPath(ctx, dr, log)
+	case velerov2alpha1api.DataReplicationPhaseInProgress:
+		if dr.Status.Node != r.nodeName || r.dataPathMgr.GetAsyncBR(dr.Name) != nil {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, r.updateStatusToFailed(ctx, dr, errors.New("data path is not found"), "data replication is in progress without a running data path", log)
+	default:
+		return ctrl.Result{}, nil
+	}
+}
+
+func (r *DataReplicationReconciler) runCancelableDataPath(ctx context.Context, dr *velerov2alpha1api.DataReplication, log logrus.FieldLogger) (ctrl.Result, error) {
+	callbacks := datapath.Callbacks{
+		OnCompleted: r.OnDataReplicationCompleted,
+		OnFailed:    r.OnDataReplicationFailed,
+		OnCancelled: r.OnDataReplicationCancelled,
+		OnProgress:  r.OnDataReplicationProgress,
+	}
+
+	// the replication uploads the snapshot like the backup, so it is limited by the backup concurrency
+	fsReplicate, err := r.dataPathMgr.CreateFileSystemBR(dr.Name, dataReplicationRequestor, datapath.TaskTypeBackup, ctx, r.client, dr.Namespace, dr.Spec.SourceNamespace, callbacks, log)
+	if err != nil {
+		if err == datapath.ConcurrentLimitExceed {
+			log.Info("Data path instance is concurrent limited requeue later")
+			return ctrl.Result{Requeue: true, RequeueAfter: time.Minute}, nil
+		}
+		return ctrl.Result{}, r.updateStatusToFailed(ctx, dr, err, "error to create data path", log)
+	}
+
+	original := dr.DeepCopy()
+	dr.Status.Phase = velerov2alpha1api.DataReplicationPhaseInProgress
+	if err := r.client.Patch(ctx, dr, client.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("Unable to update status to in progress")
+		r.closeDataPath(ctx, dr.Name)
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Data replication is marked as in progress")
+
+	if err := fsReplicate.Init(ctx, dr.Spec.BackupStorageLocation, dr.Spec.SourceNamespace, datamover.GetUploaderType(dr.Spec.DataMover),
+		velerov1api.BackupRepositoryTypeKopia, "", r.repositoryEnsurer, r.credentialGetter); err != nil {
+		defer r.closeDataPath(ctx, dr.Name)
+		return ctrl.Result{}, r.updateStatusToFailed(ctx, dr, err, "error to initialize data path", log)
+	}
+
+	if err := fsReplicate.StartReplicate(dr.Spec.SnapshotID, dr.Spec.TargetBackupStorageLocation, r.repositoryEnsurer, r.credentialGetter); err != nil {
+		defer r.closeDataPath(ctx, dr.Name)
+		return ctrl.Result{}, r.updateStatusToFailed(ctx, dr, err, fmt.Sprintf("error starting data path to replicate snapshot %s", dr.Spec.SnapshotID), log)
+	}
+
+	log.Info("Async fs replicate data path started")
+	return ctrl.Result{}, nil
+}
+
+func (r *DataReplicationReconciler) OnDataReplicationCompleted(ctx context.Context, namespace string, drName string, result datapath.Result) {
+	defer r.closeDataPath(ctx, drName)
+
+	log := r.logger.WithField("datareplication", drName)
+	log.Info("Async fs replicate data path completed")
+
+	var dr velerov2alpha1api.DataReplication
+	if err := r.client.Get(ctx, types.NamespacedName{Name: drName, Namespace: namespace}, &dr); err != nil {
+		log.WithError(err).Warn("Failed to get datareplication on completion")
+		return
+	}
+
+	original := dr.DeepCopy()
+	dr.Status.Phase = velerov2alpha1api.DataReplicationPhaseCompleted
+	dr.Status.SnapshotID = result.Replicate.SnapshotID
+	dr.Status.CompletionTimestamp = &metav1.Time{Time: r.Clock.Now()}
+	if err := r.client.Patch(ctx, &dr, client.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("error updating data replication status")
+		return
+	}
+
+	log.Infof("Data replication is marked as %s", dr.Status.Phase)
+
+	recordVolumeReplication(ctx, r.client, &dr, log)
+}
+
+func (r *DataReplicationReconciler) OnDataReplicationFailed(ctx context.Context, namespace string, drName string, err error) {
+	defer r.closeDataPath(ctx, drName)
+
+	log := r.logger.WithField("datareplication", drName)
+
+	log.WithError(err).Error("Async fs replicate data path failed")
+
+	var dr velerov2alpha1api.DataReplication
+	if getErr := r.client.Get(ctx, types.NamespacedName{Name: drName, Namespace: namespace}, &dr); getErr != nil {
+		log.WithError(getErr).Warn("Failed to get data replication on failure")
+	} else {
+		_ = r.updateStatusToFailed(ctx, &dr, err, "data path replicate failed", log)
+	}
+}
+
+func (r *DataReplicationReconciler) OnDataReplicationCancelled(ctx context.Context, namespace string, drName string) {
+	defer r.closeDataPath(ctx, drName)
+
+	log := r.logger.WithField("datareplication", drName)
+
+	log.Warn("Async fs replicate data path canceled")
+
+	var dr velerov2alpha1api.DataReplication
+	if getErr := r.client.Get(ctx, types.NamespacedName{Name: drName, Namespace: namespace}, &dr); getErr != nil {
+		log.WithError(getErr).Warn("Failed to get data replication on cancel")
+	} else {
+		_ = r.updateStatusToFailed(ctx, &dr, errors.New("data path is canceled"), "data path replicate failed", log)
+	}
+}
+
+func (r *DataReplicationReconciler) OnDataReplicationProgress(ctx context.Context, namespace string, drName string, progress *uploader.Progress) {
+	log := r.logger.WithField("datareplication", drName)
+
+	var dr velerov2alpha1api.DataReplication
+	if err := r.client.Get(ctx, types.NamespacedName{Name: drName, Namespace: namespace}, &dr); err != nil {
+		log.WithError(err).Warn("Failed to get data replication on progress")
+		return
+	}
+
+	original := dr.DeepCopy()
+	dr.Status.Progress = shared.DataMoveOperationProgress{TotalBytes: progress.TotalBytes, BytesDone: progress.BytesDone}
+
+	if err := r.client.Patch(ctx, &dr, client.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("Failed to update replicate snapshot progress")
+	}
+}
+
+// SetupWithManager registers the DataReplication controller.
+func (r *DataReplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov2alpha1api.DataReplication{}).
+		Complete(r)
+}
+
+func (r *DataReplicationReconciler) updateStatusToFailed(ctx context.Context, dr *velerov2alpha1api.DataReplication, err error, msg string, log logrus.FieldLogger) error {
+	if patchErr := UpdateDataReplicationStatusToFailed(ctx, r.client, dr, errors.WithMessage(err, msg).Error(), r.Clock.Now(), log); patchErr == nil {
+		recordVolumeReplication(ctx, r.client, dr, log)
+	}
+
+	return err
+}
+
+func (r *DataReplicationReconciler) acceptDataReplication(ctx context.Context, dr *velerov2alpha1api.DataReplication) (bool, error) {
+	r.logger.Infof("Accepting data replication %s", dr.Name)
+
+	// the data replication controller in each node-agent tries to update the CR, only one of them succeeds and handles the rest logic
+	updated := dr.DeepCopy()
+
+	updateFunc := func(dataReplication *velerov2alpha1api.DataReplication) {
+		dataReplication.Status.Phase = velerov2alpha1api.DataReplicationPhaseAccepted
+		dataReplication.Status.StartTimestamp = &metav1.Time{Time: r.Clock.Now()}
+		dataReplication.Status.Node = r.nodeName
+		labels := dataReplication.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[acceptNodeLabelKey] = r.nodeName
+		dataReplication.SetLabels(labels)
+	}
+
+	updateFunc(updated)
+
+	if err := r.client.Update(ctx, updated); err != nil {
+		if apierrors.IsConflict(err) {
+			r.logger.WithField("DataReplication", dr.Name).Info("This datareplication has been accepted by others")
+			return false, nil
+		}
+		return false, err
+	}
+
+	*dr = *updated
+	r.logger.WithField("DataReplication", dr.Name).Infof("This datareplication has been accepted by %s", r.nodeName)
+	return true, nil
+}
+
+func (r *DataReplicationReconciler) closeDataPath(ctx context.Context, drName string) {
+	fsReplicate := r.dataPathMgr.GetAsyncBR(drName)
+	if fsReplicate != nil {
+		fsReplicate.Close(ctx)
+	}
+
+	r.dataPathMgr.RemoveAsyncBR(drName)
+}
+
+// UpdateDataReplicationStatusToFailed marks the DataReplication as failed with the given message
+func UpdateDataReplicationStatusToFailed(ctx context.Context, c client.Client, dr *velerov2alpha1api.DataReplication, errString string, time time.Time, log logrus.FieldLogger) error {
+	original := dr.DeepCopy()
+	dr.Status.Phase = velerov2alpha1api.DataReplicationPhaseFailed
+	dr.Status.Message = errString
+	dr.Status.CompletionTimestamp = &metav1.Time{Time: time}
+
+	err := c.Patch(ctx, dr, client.MergeFrom(original))
+	if err != nil {
+		log.WithError(err).Error("error updating DataReplication status")
+	}
+
+	return err
+}
+
+// recordVolumeReplication records the result of the finished DataReplication into the status of its backup,
+// the previous result of the same volume snapshot is replaced
+func recordVolumeReplication(ctx context.Context, c client.Client, dr *velerov2alpha1api.DataReplication, log logrus.FieldLogger) {
+	replication := velerov1api.BackupVolumeReplication{
+		DataUpload:            dr.Spec.DataUpload,
+		PVC:                   fmt.Sprintf("%s/%s", dr.Spec.SourceNamespace, dr.Spec.SourcePVC),
+		BackupStorageLocation: dr.Spec.TargetBackupStorageLocation,
+		SnapshotID:            dr.Status.SnapshotID,
+		Phase:                 velerov1api.BackupVolumeReplicationPhaseFailed,
+		Message:               dr.Status.Message,
+		CompletionTimestamp:   dr.Status.CompletionTimestamp,
+	}
+	if dr.Status.Phase == velerov2alpha1api.DataReplicationPhaseCompleted {
+		replication.Phase = velerov1api.BackupVolumeReplicationPhaseReplicated
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		backup := &velerov1api.Backup{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: dr.Namespace, Name: dr.Spec.BackupName}, backup); err != nil {
+			return err
+		}
+
+		original := backup.DeepCopy()
+		replications := []velerov1api.BackupVolumeReplication{}
+		for _, v := range backup.Status.VolumeReplications {
+			if v.DataUpload != replication.DataUpload {
+				replications = append(replications, v)
+			}
+		}
+		backup.Status.VolumeReplications = append(replications, replication)
+
+		return c.Patch(ctx, backup, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{}))
+	})
+	if err != nil {
+		log.WithError(err).Warnf("Failed to record the replication result to backup %s", dr.Spec.BackupName)
+	}
+}
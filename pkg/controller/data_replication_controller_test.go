@@ -0,0 +1,216 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/datapath"
+	datapathmocks "github.com/vmware-tanzu/velero/pkg/datapath/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+)
+
+const dataReplicationName = "datareplication-1"
+
+func dataReplicationBuilder() *builder.DataReplicationBuilder {
+	return builder.ForDataReplication(velerov1api.DefaultNamespace, dataReplicationName).
+		BackupName("backup-1").
+		DataUpload("dataupload-1").
+		SourcePVC("pvc-1").
+		SourceNamespace("ns-1").
+		BackupStorageLocation("bsl-1").
+		DataMover("velero").
+		TargetBackupStorageLocation("bsl-2").
+		SnapshotID("snapshot-1")
+}
+
+func initDataReplicationReconciler(t *testing.T, objects ...runtime.Object) *DataReplicationReconciler {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, velerov1api.AddToScheme(scheme))
+	require.NoError(t, velerov2alpha1api.AddToScheme(scheme))
+
+	r := NewDataReplicationReconciler(fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objects...).Build(),
+		datapath.NewManager(1, nil, uploader.BandwidthLimits{}), nil, nil, "test-node", velerotest.NewLogger())
+	r.Clock = testclocks.NewFakeClock(time.Now())
+
+	return r
+}
+
+func TestDataReplicationReconcile(t *testing.T) {
+	tests := []struct {
+		name                string
+		dr                  *velerov2alpha1api.DataReplication
+		limitExceeded       bool
+		initErr             error
+		startErr            error
+		expectStart         bool
+		expectClose         bool
+		expectedPhase       velerov2alpha1api.DataReplicationPhase
+		expectedResult      ctrl.Result
+		expectedReplication velerov1api.BackupVolumeReplicationPhase
+	}{
+		{
+			name:          "new data replication is accepted and started",
+			dr:            dataReplicationBuilder().Result(),
+			expectStart:   true,
+			expectedPhase: velerov2alpha1api.DataReplicationPhaseInProgress,
+		},
+		{
+			name:           "data replication is requeued when the concurrent limit is exceeded",
+			dr:             dataReplicationBuilder().Result(),
+			limitExceeded:  true,
+			expectedPhase:  velerov2alpha1api.DataReplicationPhaseAccepted,
+			expectedResult: ctrl.Result{Requeue: true, RequeueAfter: time.Minute},
+		},
+		{
+			name:          "accepted data replication of this node is started",
+			dr:            dataReplicationBuilder().Phase(velerov2alpha1api.DataReplicationPhaseAccepted).Node("test-node").Result(),
+			expectStart:   true,
+			expectedPhase: velerov2alpha1api.DataReplicationPhaseInProgress,
+		},
+		{
+			name:          "accepted data replication of other node is skipped",
+			dr:            dataReplicationBuilder().Phase(velerov2alpha1api.DataReplicationPhaseAccepted).Node("other-node").Result(),
+			expectedPhase: velerov2alpha1api.DataReplicationPhaseAccepted,
+		},
+		{
+			name:                "data path fails to initialize",
+			dr:                  dataReplicationBuilder().Result(),
+			initErr:             errors.New("fake-init-error"),
+			expectClose:         true,
+			expectedPhase:       velerov2alpha1api.DataReplicationPhaseFailed,
+			expectedReplication: velerov1api.BackupVolumeReplicationPhaseFailed,
+		},
+		{
+			name:                "data path fails to start",
+			dr:                  dataReplicationBuilder().Result(),
+			startErr:            errors.New("fake-start-error"),
+			expectStart:         true,
+			expectClose:         true,
+			expectedPhase:       velerov2alpha1api.DataReplicationPhaseFailed,
+			expectedReplication: velerov1api.BackupVolumeReplicationPhaseFailed,
+		},
+		{
+			name:                "in progress data replication of this node without data path is failed",
+			dr:                  dataReplicationBuilder().Phase(velerov2alpha1api.DataReplicationPhaseInProgress).Node("test-node").Result(),
+			expectedPhase:       velerov2alpha1api.DataReplicationPhaseFailed,
+			expectedReplication: velerov1api.BackupVolumeReplicationPhaseFailed,
+		},
+		{
+			name:          "in progress data replication of other node is skipped",
+			dr:            dataReplicationBuilder().Phase(velerov2alpha1api.DataReplicationPhaseInProgress).Node("other-node").Result(),
+			expectedPhase: velerov2alpha1api.DataReplicationPhaseInProgress,
+		},
+		{
+			name:          "data replication of non built-in data mover is skipped",
+			dr:            dataReplicationBuilder().DataMover("other-data-mover").Result(),
+			expectedPhase: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := initDataReplicationReconciler(t, test.dr, builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result())
+			if test.limitExceeded {
+				r.dataPathMgr = datapath.NewManager(0, nil, uploader.BandwidthLimits{})
+			}
+
+			datapath.FSBRCreator = func(string, string, kbclient.Client, string, uploader.BandwidthLimits, uploader.CacheOptions, datapath.Callbacks, logrus.FieldLogger) datapath.AsyncBR {
+				fsBR := datapathmocks.NewAsyncBR(t)
+				fsBR.On("Init", mock.Anything, "bsl-1", "ns-1", "kopia", velerov1api.BackupRepositoryTypeKopia, "", mock.Anything, mock.Anything).Return(test.initErr)
+				if test.expectStart {
+					fsBR.On("StartReplicate", "snapshot-1", "bsl-2", mock.Anything, mock.Anything).Return(test.startErr)
+				}
+				if test.expectClose {
+					fsBR.On("Close", mock.Anything).Return()
+				}
+				return fsBR
+			}
+
+			result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: dataReplicationName}})
+			if test.initErr != nil || test.startErr != nil {
+				assert.Error(t, err)
+			} else if test.expectedPhase == velerov2alpha1api.DataReplicationPhaseFailed {
+				assert.EqualError(t, err, "data path is not found")
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedResult, result)
+
+			dr := &velerov2alpha1api.DataReplication{}
+			require.NoError(t, r.client.Get(context.Background(), types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: dataReplicationName}, dr))
+			assert.Equal(t, test.expectedPhase, dr.Status.Phase)
+
+			backup := &velerov1api.Backup{}
+			require.NoError(t, r.client.Get(context.Background(), types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "backup-1"}, backup))
+			if test.expectedReplication == "" {
+				assert.Empty(t, backup.Status.VolumeReplications)
+			} else {
+				require.Len(t, backup.Status.VolumeReplications, 1)
+				assert.Equal(t, test.expectedReplication, backup.Status.VolumeReplications[0].Phase)
+			}
+		})
+	}
+}
+
+func TestOnDataReplicationCompleted(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+	backup.Status.VolumeReplications = []velerov1api.BackupVolumeReplication{
+		{DataUpload: "dataupload-1", Phase: velerov1api.BackupVolumeReplicationPhaseFailed},
+		{DataUpload: "dataupload-2", Phase: velerov1api.BackupVolumeReplicationPhaseReplicated},
+	}
+
+	r := initDataReplicationReconciler(t, dataReplicationBuilder().Phase(velerov2alpha1api.DataReplicationPhaseInProgress).Node("test-node").Result(), backup)
+
+	r.OnDataReplicationCompleted(context.Background(), velerov1api.DefaultNamespace, dataReplicationName, datapath.Result{Replicate: datapath.ReplicateResult{SnapshotID: "replica-1"}})
+
+	dr := &velerov2alpha1api.DataReplication{}
+	require.NoError(t, r.client.Get(context.Background(), types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: dataReplicationName}, dr))
+	assert.Equal(t, velerov2alpha1api.DataReplicationPhaseCompleted, dr.Status.Phase)
+	assert.Equal(t, "replica-1", dr.Status.SnapshotID)
+	assert.NotNil(t, dr.Status.CompletionTimestamp)
+
+	updated := &velerov1api.Backup{}
+	require.NoError(t, r.client.Get(context.Background(), types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "backup-1"}, updated))
+	require.Len(t, updated.Status.VolumeReplications, 2)
+	assert.Equal(t, "dataupload-2", updated.Status.VolumeReplications[0].DataUpload)
+	assert.Equal(t, "dataupload-1", updated.Status.VolumeReplications[1].DataUpload)
+	assert.Equal(t, "ns-1/pvc-1", updated.Status.VolumeReplications[1].PVC)
+	assert.Equal(t, "bsl-2", updated.Status.VolumeReplications[1].BackupStorageLocation)
+	assert.Equal(t, "replica-1", updated.Status.VolumeReplications[1].SnapshotID)
+	assert.Equal(t, velerov1api.BackupVolumeReplicationPhaseReplicated, updated.Status.VolumeReplications[1].Phase)
+}
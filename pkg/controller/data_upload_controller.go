@@ -45,10 +45,13 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/backupwindow"
 	"github.com/vmware-tanzu/velero/pkg/datamover"
 	"github.com/vmware-tanzu/velero/pkg/datapath"
 	"github.com/vmware-tanzu/velero/pkg/exposer"
+	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	"github.com/vmware-tanzu/velero/pkg/repository"
 	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
@@ -64,38 +67,44 @@ const (
 
 // DataUploadReconciler reconciles a DataUpload object
 type DataUploadReconciler struct {
-	client              client.Client
-	kubeClient          kubernetes.Interface
-	csiSnapshotClient   snapshotter.SnapshotV1Interface
-	repoEnsurer         *repository.Ensurer
-	Clock               clocks.WithTickerAndDelayedExecution
-	credentialGetter    *credentials.CredentialGetter
-	nodeName            string
-	fileSystem          filesystem.Interface
-	logger              logrus.FieldLogger
-	snapshotExposerList map[velerov2alpha1api.SnapshotType]exposer.SnapshotExposer
-	dataPathMgr         *datapath.Manager
-	preparingTimeout    time.Duration
-	metrics             *metrics.ServerMetrics
+	client                client.Client
+	kubeClient            kubernetes.Interface
+	csiSnapshotClient     snapshotter.SnapshotV1Interface
+	repoEnsurer           *repository.Ensurer
+	Clock                 clocks.WithTickerAndDelayedExecution
+	credentialGetter      *credentials.CredentialGetter
+	nodeName              string
+	fileSystem            filesystem.Interface
+	logger                logrus.FieldLogger
+	snapshotExposerList   map[velerov2alpha1api.SnapshotType]exposer.SnapshotExposer
+	dataPathMgr           *datapath.Manager
+	preparingTimeout      time.Duration
+	snapshotReadyTimeout  time.Duration
+	backupWindowConfigMap string
+	backupPodConfig       *nodeagent.BackupPodConfig
+	metrics               *metrics.ServerMetrics
 }
 
 func NewDataUploadReconciler(client client.Client, kubeClient kubernetes.Interface, csiSnapshotClient snapshotter.SnapshotV1Interface,
 	dataPathMgr *datapath.Manager, repoEnsurer *repository.Ensurer, clock clocks.WithTickerAndDelayedExecution,
-	cred *credentials.CredentialGetter, nodeName string, fs filesystem.Interface, preparingTimeout time.Duration, log logrus.FieldLogger, metrics *metrics.ServerMetrics) *DataUploadReconciler {
+	cred *credentials.CredentialGetter, nodeName string, fs filesystem.Interface, preparingTimeout time.Duration, snapshotReadyTimeout time.Duration, backupWindowConfigMap string, backupPodConfig *nodeagent.BackupPodConfig, log logrus.FieldLogger, metrics *metrics.ServerMetrics) *DataUploadReconciler {
 	return &DataUploadReconciler{
-		client:              client,
-		kubeClient:          kubeClient,
-		csiSnapshotClient:   csiSnapshotClient,
-		Clock:               clock,
-		credentialGetter:    cred,
-		nodeName:            nodeName,
-		fileSystem:          fs,
-		logger:              log,
-		repoEnsurer:         repoEnsurer,
-		snapshotExposerList: map[velerov2alpha1api.SnapshotType]exposer.SnapshotExposer{velerov2alpha1api.SnapshotTypeCSI: exposer.NewCSISnapshotExposer(kubeClient, csiSnapshotClient, log)},
-		dataPathMgr:         dataPathMgr,
-		preparingTimeout:    preparingTimeout,
-		metrics:             metrics,
+		client:                client,
+		kubeClient:            kubeClient,
+		csiSnapshotClient:     csiSnapshotClient,
+		Clock:                 clock,
+		credentialGetter:      cred,
+		nodeName:              nodeName,
+		fileSystem:            fs,
+		logger:                log,
+		repoEnsurer:           repoEnsurer,
+		snapshotExposerList:   map[velerov2alpha1api.SnapshotType]exposer.SnapshotExposer{velerov2alpha1api.SnapshotTypeCSI: exposer.NewCSISnapshotExposer(kubeClient, csiSnapshotClient, log)},
+		dataPathMgr:           dataPathMgr,
+		preparingTimeout:      preparingTimeout,
+		snapshotReadyTimeout:  snapshotReadyTimeout,
+		backupWindowConfigMap: backupWindowConfigMap,
+		backupPodConfig:       backupPodConfig,
+		metrics:               metrics,
 	}
 }
 
@@ -241,11 +250,25 @@ func (r *DataUploadReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			return ctrl.Result{}, nil
 		}
 
+		if du.Spec.Paused {
+			r.OnDataUploadCancelled(ctx, du.GetNamespace(), du.GetName())
+			return ctrl.Result{}, nil
+		}
+
 		fsBackup := r.dataPathMgr.GetAsyncBR(du.Name)
 		if fsBackup != nil {
 			log.Info("Cancellable data path is already started")
 			return ctrl.Result{}, nil
 		}
+
+		window, err := backupwindow.Get(ctx, r.client, du.Namespace, r.backupWindowConfigMap)
+		if err != nil {
+			log.WithError(err).Warn("Failed to get the backup window, the data upload isn't queued for it")
+		} else if interval := window.QueueInterval(r.Clock.Now()); interval > 0 {
+			log.Debugf("Data upload is queued until the backup window %s opens", window)
+			return ctrl.Result{RequeueAfter: interval}, nil
+		}
+
 		waitExposePara := r.setupWaitExposePara(du)
 		res, err := ep.GetExposed(ctx, getOwnerObject(du), du.Spec.OperationTimeout.Duration, waitExposePara)
 		if err != nil {
@@ -265,7 +288,7 @@ func (r *DataUploadReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			OnProgress:  r.OnDataUploadProgress,
 		}
 
-		fsBackup, err = r.dataPathMgr.CreateFileSystemBR(du.Name, dataUploadDownloadRequestor, ctx, r.client, du.Namespace, callbacks, log)
+		fsBackup, err = r.dataPathMgr.CreateFileSystemBR(du.Name, dataUploadDownloadRequestor, datapath.TaskTypeBackup, ctx, r.client, du.Namespace, du.Spec.SourceNamespace, callbacks, log)
 		if err != nil {
 			if err == datapath.ConcurrentLimitExceed {
 				log.Info("Data path instance is concurrent limited requeue later")
@@ -309,6 +332,40 @@ func (r *DataUploadReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			}
 			fsBackup.Cancel()
 			return ctrl.Result{}, nil
+		} else if du.Spec.Paused {
+			log.Info("Data upload is being paused")
+
+			fsBackup := r.dataPathMgr.GetAsyncBR(du.Name)
+			if fsBackup == nil {
+				r.OnDataUploadCancelled(ctx, du.GetNamespace(), du.GetName())
+				return ctrl.Result{}, nil
+			}
+
+			// the data path is canceled and the data uploaded so far is saved as an incomplete snapshot,
+			// OnDataUploadCancelled will then mark the DataUpload as paused
+			fsBackup.Cancel()
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, nil
+	} else if du.Status.Phase == velerov2alpha1api.DataUploadPhasePaused {
+		if du.Spec.Cancel {
+			log.Info("Paused data upload is being canceled")
+			r.OnDataUploadCancelled(ctx, du.GetNamespace(), du.GetName())
+			return ctrl.Result{}, nil
+		}
+
+		if !du.Spec.Paused {
+			log.Info("Data upload is resumed")
+
+			// move the DataUpload back to Prepared as the snapshot is still exposed, the data path
+			// is then restarted on the node where the snapshot is exposed
+			original := du.DeepCopy()
+			du.Status.Phase = velerov2alpha1api.DataUploadPhasePrepared
+			du.Status.Message = ""
+			if err := r.client.Patch(ctx, du, client.MergeFrom(original)); err != nil {
+				log.WithError(err).Error("error updating data upload into prepared status")
+				return ctrl.Result{}, err
+			}
 		}
 		return ctrl.Result{}, nil
 	} else {
@@ -383,6 +440,10 @@ func (r *DataUploadReconciler) OnDataUploadCompleted(ctx context.Context, namesp
 	du.Status.Path = result.Backup.Source.ByPath
 	du.Status.Phase = velerov2alpha1api.DataUploadPhaseCompleted
 	du.Status.SnapshotID = result.Backup.SnapshotID
+	du.Status.UploadStats = shared.UploadStats{
+		LogicalBytes:  result.Backup.Size,
+		UploadedBytes: result.Backup.UploadedBytes,
+	}
 	du.Status.CompletionTimestamp = &metav1.Time{Time: r.Clock.Now()}
 	if result.Backup.EmptySnapshot {
 		du.Status.Message = "volume was empty so no data was upload"
@@ -393,7 +454,74 @@ func (r *DataUploadReconciler) OnDataUploadCompleted(ctx context.Context, namesp
 	} else {
 		log.Info("Data upload completed")
 		r.metrics.RegisterDataUploadSuccess(r.nodeName)
+		r.replicateDataUpload(ctx, &du, log)
+	}
+}
+
+// replicateDataUpload creates a DataReplication to replicate the snapshot of the completed DataUpload
+// to the snapshot move replica location of its backup, if the backup has one
+func (r *DataUploadReconciler) replicateDataUpload(ctx context.Context, du *velerov2alpha1api.DataUpload, log logrus.FieldLogger) {
+	if du.Status.SnapshotID == "" {
+		return
+	}
+
+	var owner *metav1.OwnerReference
+	for i := range du.OwnerReferences {
+		if du.OwnerReferences[i].Kind == "Backup" {
+			owner = &du.OwnerReferences[i]
+			break
+		}
+	}
+	if owner == nil {
+		return
+	}
+
+	backup := &velerov1api.Backup{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: du.Namespace, Name: owner.Name}, backup); err != nil {
+		log.WithError(err).Warnf("Failed to get backup %s to replicate the snapshot", owner.Name)
+		return
+	}
+
+	target := backup.Spec.SnapshotMoveReplicaLocation
+	if target == "" || target == du.Spec.BackupStorageLocation {
+		return
+	}
+
+	dr := &velerov2alpha1api.DataReplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: du.Namespace,
+			Name:      du.Name,
+			Labels: map[string]string{
+				velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
+				velerov1api.DataUploadLabel: du.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: velerov1api.SchemeGroupVersion.String(),
+					Kind:       "Backup",
+					Name:       backup.Name,
+					UID:        backup.UID,
+				},
+			},
+		},
+		Spec: velerov2alpha1api.DataReplicationSpec{
+			BackupName:                  backup.Name,
+			DataUpload:                  du.Name,
+			SourcePVC:                   du.Spec.SourcePVC,
+			SourceNamespace:             du.Spec.SourceNamespace,
+			BackupStorageLocation:       du.Spec.BackupStorageLocation,
+			TargetBackupStorageLocation: target,
+			DataMover:                   du.Spec.DataMover,
+			SnapshotID:                  du.Status.SnapshotID,
+		},
 	}
+
+	if err := r.client.Create(ctx, dr); err != nil && !apierrors.IsAlreadyExists(err) {
+		log.WithError(err).Warnf("Failed to create data replication to backup storage location %s", target)
+		return
+	}
+
+	log.Infof("Data replication to backup storage location %s is created", target)
 }
 
 func (r *DataUploadReconciler) OnDataUploadFailed(ctx context.Context, namespace string, duName string, err error) {
@@ -423,6 +551,16 @@ func (r *DataUploadReconciler) OnDataUploadCancelled(ctx context.Context, namesp
 	du := &velerov2alpha1api.DataUpload{}
 	if getErr := r.client.Get(ctx, types.NamespacedName{Name: duName, Namespace: namespace}, du); getErr != nil {
 		log.WithError(getErr).Warn("Failed to get dataupload on cancel")
+	} else if du.Spec.Paused && !du.Spec.Cancel {
+		// keep the exposed snapshot, so the data upload could be resumed later
+		original := du.DeepCopy()
+		du.Status.Phase = velerov2alpha1api.DataUploadPhasePaused
+		du.Status.Message = "data upload is paused"
+		if err := r.client.Patch(ctx, du, client.MergeFrom(original)); err != nil {
+			log.WithError(err).Error("error updating DataUpload status")
+		} else {
+			log.Info("Data upload paused")
+		}
 	} else {
 		// cleans up any objects generated during the snapshot expose
 		r.cleanUp(ctx, du, log)
@@ -496,6 +634,12 @@ func (r *DataUploadReconciler) OnDataUploadProgress(ctx context.Context, namespa
 	if err := r.client.Patch(ctx, &du, client.MergeFrom(original)); err != nil {
 		log.WithError(err).Error("Failed to update progress")
 	}
+
+	if start, exist := r.dataPathMgr.GetStartTime(duName); exist {
+		if elapsed := time.Since(start).Seconds(); elapsed > 0 {
+			r.metrics.SetDataUploadThroughput(r.nodeName, duName, float64(progress.BytesDone)/elapsed)
+		}
+	}
 }
 
 // SetupWithManager registers the DataUpload controller.
@@ -738,6 +882,7 @@ func (r *DataUploadReconciler) closeDataPath(ctx context.Context, duName string)
 	}
 
 	r.dataPathMgr.RemoveAsyncBR(duName)
+	r.metrics.RemoveDataUploadThroughput(r.nodeName, duName)
 }
 
 func (r *DataUploadReconciler) setupExposeParam(du *velerov2alpha1api.DataUpload) (interface{}, error) {
@@ -758,14 +903,16 @@ func (r *DataUploadReconciler) setupExposeParam(du *velerov2alpha1api.DataUpload
 		}
 
 		return &exposer.CSISnapshotExposeParam{
-			SnapshotName:     du.Spec.CSISnapshot.VolumeSnapshot,
-			SourceNamespace:  du.Spec.SourceNamespace,
-			StorageClass:     du.Spec.CSISnapshot.StorageClass,
-			HostingPodLabels: map[string]string{velerov1api.DataUploadLabel: du.Name},
-			AccessMode:       accessMode,
-			OperationTimeout: du.Spec.OperationTimeout.Duration,
-			ExposeTimeout:    r.preparingTimeout,
-			VolumeSize:       pvc.Spec.Resources.Requests[corev1.ResourceStorage],
+			SnapshotName:         du.Spec.CSISnapshot.VolumeSnapshot,
+			SourceNamespace:      du.Spec.SourceNamespace,
+			StorageClass:         du.Spec.CSISnapshot.StorageClass,
+			HostingPodLabels:     map[string]string{velerov1api.DataUploadLabel: du.Name},
+			AccessMode:           accessMode,
+			OperationTimeout:     du.Spec.OperationTimeout.Duration,
+			ExposeTimeout:        r.preparingTimeout,
+			SnapshotReadyTimeout: r.snapshotReadyTimeout,
+			VolumeSize:           pvc.Spec.Resources.Requests[corev1.ResourceStorage],
+			BackupPodConfig:      r.backupPodConfig,
 		}, nil
 	}
 	return nil, nil
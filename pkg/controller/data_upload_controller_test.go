@@ -45,6 +45,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -162,7 +163,7 @@ func initDataUploaderReconcilerWithError(needError ...error) (*DataUploadReconci
 		Spec: appsv1.DaemonSetSpec{},
 	}
 
-	dataPathMgr := datapath.NewManager(1)
+	dataPathMgr := datapath.NewManager(1, nil, uploader.BandwidthLimits{})
 
 	now, err := time.Parse(time.RFC1123, time.RFC1123)
 	if err != nil {
@@ -220,7 +221,7 @@ func initDataUploaderReconcilerWithError(needError ...error) (*DataUploadReconci
 		return nil, err
 	}
 	return NewDataUploadReconciler(fakeClient, fakeKubeClient, fakeSnapshotClient.SnapshotV1(), dataPathMgr, nil,
-		testclocks.NewFakeClock(now), &credentials.CredentialGetter{FromFile: credentialFileStore}, "test_node", fakeFS, time.Minute*5, velerotest.NewLogger(), metrics.NewServerMetrics()), nil
+		testclocks.NewFakeClock(now), &credentials.CredentialGetter{FromFile: credentialFileStore}, "test_node", fakeFS, time.Minute*5, 0, "", nil, velerotest.NewLogger(), metrics.NewServerMetrics()), nil
 }
 
 func dataUploadBuilder() *builder.DataUploadBuilder {
@@ -297,6 +298,14 @@ func (f *fakeDataUploadFSBR) StartRestore(snapshotID string, target datapath.Acc
 	return nil
 }
 
+func (f *fakeDataUploadFSBR) StartVerify(snapshotID string, filesPercent int) error {
+	return nil
+}
+
+func (f *fakeDataUploadFSBR) StartReplicate(snapshotID string, targetBSLName string, repositoryEnsurer *repository.Ensurer, credentialGetter *credentials.CredentialGetter) error {
+	return nil
+}
+
 func (b *fakeDataUploadFSBR) Cancel() {
 }
 
@@ -393,9 +402,41 @@ func TestReconcile(t *testing.T) {
 			expected:          dataUploadBuilder().Phase(velerov2alpha1api.DataUploadPhaseCanceling).Result(),
 			expectedRequeue:   ctrl.Result{},
 		},
+		{
+			name:              "Prepared dataupload should be paused",
+			pod:               builder.ForPod(velerov1api.DefaultNamespace, dataUploadName).Volumes(&corev1.Volume{Name: "dataupload-1"}).Result(),
+			du:                dataUploadBuilder().Phase(velerov2alpha1api.DataUploadPhasePrepared).SnapshotType(fakeSnapshotType).Paused(true).Result(),
+			expectedProcessed: false,
+			expected:          dataUploadBuilder().Phase(velerov2alpha1api.DataUploadPhasePaused).Result(),
+			expectedRequeue:   ctrl.Result{},
+		},
+		{
+			name:              "Paused dataupload should be resumed",
+			pod:               builder.ForPod(velerov1api.DefaultNamespace, dataUploadName).Volumes(&corev1.Volume{Name: "dataupload-1"}).Result(),
+			du:                dataUploadBuilder().Phase(velerov2alpha1api.DataUploadPhasePaused).SnapshotType(fakeSnapshotType).Paused(false).Result(),
+			expectedProcessed: false,
+			expected:          dataUploadBuilder().Phase(velerov2alpha1api.DataUploadPhasePrepared).Result(),
+			expectedRequeue:   ctrl.Result{},
+		},
+		{
+			name:              "Paused dataupload should stay paused",
+			pod:               builder.ForPod(velerov1api.DefaultNamespace, dataUploadName).Volumes(&corev1.Volume{Name: "dataupload-1"}).Result(),
+			du:                dataUploadBuilder().Phase(velerov2alpha1api.DataUploadPhasePaused).SnapshotType(fakeSnapshotType).Paused(true).Result(),
+			expectedProcessed: false,
+			expected:          dataUploadBuilder().Phase(velerov2alpha1api.DataUploadPhasePaused).Result(),
+			expectedRequeue:   ctrl.Result{},
+		},
+		{
+			name:              "Paused dataupload should be canceled",
+			pod:               builder.ForPod(velerov1api.DefaultNamespace, dataUploadName).Volumes(&corev1.Volume{Name: "dataupload-1"}).Result(),
+			du:                dataUploadBuilder().Phase(velerov2alpha1api.DataUploadPhasePaused).SnapshotType(fakeSnapshotType).Paused(true).Cancel(true).Result(),
+			expectedProcessed: true,
+			expected:          dataUploadBuilder().Phase(velerov2alpha1api.DataUploadPhaseCanceled).Result(),
+			expectedRequeue:   ctrl.Result{},
+		},
 		{
 			name:              "runCancelableDataUpload is concurrent limited",
-			dataMgr:           datapath.NewManager(0),
+			dataMgr:           datapath.NewManager(0, nil, uploader.BandwidthLimits{}),
 			pod:               builder.ForPod(velerov1api.DefaultNamespace, dataUploadName).Volumes(&corev1.Volume{Name: "dataupload-1"}).Result(),
 			du:                dataUploadBuilder().Phase(velerov2alpha1api.DataUploadPhasePrepared).SnapshotType(fakeSnapshotType).Result(),
 			expectedProcessed: false,
@@ -469,7 +510,7 @@ func TestReconcile(t *testing.T) {
 			if test.dataMgr != nil {
 				r.dataPathMgr = test.dataMgr
 			} else {
-				r.dataPathMgr = datapath.NewManager(1)
+				r.dataPathMgr = datapath.NewManager(1, nil, uploader.BandwidthLimits{})
 			}
 
 			if test.du.Spec.SnapshotType == fakeSnapshotType {
@@ -478,7 +519,7 @@ func TestReconcile(t *testing.T) {
 				r.snapshotExposerList = map[velerov2alpha1api.SnapshotType]exposer.SnapshotExposer{velerov2alpha1api.SnapshotTypeCSI: exposer.NewCSISnapshotExposer(r.kubeClient, r.csiSnapshotClient, velerotest.NewLogger())}
 			}
 
-			datapath.FSBRCreator = func(string, string, kbclient.Client, string, datapath.Callbacks, logrus.FieldLogger) datapath.AsyncBR {
+			datapath.FSBRCreator = func(string, string, kbclient.Client, string, uploader.BandwidthLimits, uploader.CacheOptions, datapath.Callbacks, logrus.FieldLogger) datapath.AsyncBR {
 				return &fakeDataUploadFSBR{
 					du:         test.du,
 					kubeClient: r.client,
@@ -488,7 +529,7 @@ func TestReconcile(t *testing.T) {
 
 			if test.du.Status.Phase == velerov2alpha1api.DataUploadPhaseInProgress {
 				if fsBR := r.dataPathMgr.GetAsyncBR(test.du.Name); fsBR == nil {
-					_, err := r.dataPathMgr.CreateFileSystemBR(test.du.Name, pVBRRequestor, ctx, r.client, velerov1api.DefaultNamespace, datapath.Callbacks{OnCancelled: r.OnDataUploadCancelled}, velerotest.NewLogger())
+					_, err := r.dataPathMgr.CreateFileSystemBR(test.du.Name, pVBRRequestor, datapath.TaskTypeBackup, ctx, r.client, velerov1api.DefaultNamespace, "", datapath.Callbacks{OnCancelled: r.OnDataUploadCancelled}, velerotest.NewLogger())
 					require.NoError(t, err)
 				}
 			}
@@ -555,6 +596,20 @@ func TestOnDataUploadCancelled(t *testing.T) {
 	assert.Equal(t, updatedDu.Status.StartTimestamp.IsZero(), false)
 }
 
+func TestOnDataUploadPaused(t *testing.T) {
+	ctx := context.TODO()
+	r, err := initDataUploaderReconciler()
+	require.NoError(t, err)
+	du := dataUploadBuilder().Phase(velerov2alpha1api.DataUploadPhaseInProgress).Paused(true).Result()
+	assert.NoError(t, r.client.Create(ctx, du))
+
+	r.OnDataUploadCancelled(ctx, du.Namespace, du.Name)
+	updatedDu := &velerov2alpha1api.DataUpload{}
+	assert.NoError(t, r.client.Get(ctx, types.NamespacedName{Name: du.Name, Namespace: du.Namespace}, updatedDu))
+	assert.Equal(t, velerov2alpha1api.DataUploadPhasePaused, updatedDu.Status.Phase)
+	assert.True(t, updatedDu.Status.CompletionTimestamp.IsZero())
+}
+
 func TestOnDataUploadProgress(t *testing.T) {
 	totalBytes := int64(1024)
 	bytesDone := int64(512)
@@ -657,6 +712,64 @@ func TestOnDataUploadCompleted(t *testing.T) {
 	assert.Equal(t, updatedDu.Status.CompletionTimestamp.IsZero(), false)
 }
 
+func TestOnDataUploadCompletedReplicate(t *testing.T) {
+	tests := []struct {
+		name            string
+		replicaLocation string
+		expectedCreated bool
+	}{
+		{
+			name: "backup without replica location",
+		},
+		{
+			name:            "replica location is the backup storage location of the snapshot",
+			replicaLocation: "bsl-loc",
+		},
+		{
+			name:            "data replication is created",
+			replicaLocation: "bsl-replica",
+			expectedCreated: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.TODO()
+			r, err := initDataUploaderReconciler()
+			require.NoError(t, err)
+			r.snapshotExposerList = map[velerov2alpha1api.SnapshotType]exposer.SnapshotExposer{velerov2alpha1api.SnapshotTypeCSI: exposer.NewCSISnapshotExposer(r.kubeClient, r.csiSnapshotClient, velerotest.NewLogger())}
+
+			backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+			backup.Spec.SnapshotMoveReplicaLocation = test.replicaLocation
+			require.NoError(t, r.client.Create(ctx, backup))
+
+			du := dataUploadBuilder().Result()
+			du.OwnerReferences = []metav1.OwnerReference{{APIVersion: velerov1api.SchemeGroupVersion.String(), Kind: "Backup", Name: "backup-1"}}
+			require.NoError(t, r.client.Create(ctx, du))
+
+			r.OnDataUploadCompleted(ctx, du.Namespace, du.Name, datapath.Result{Backup: datapath.BackupResult{SnapshotID: "snapshot-1", Size: 1024, UploadedBytes: 256}})
+
+			completed := &velerov2alpha1api.DataUpload{}
+			require.NoError(t, r.client.Get(ctx, types.NamespacedName{Name: du.Name, Namespace: du.Namespace}, completed))
+			assert.Equal(t, shared.UploadStats{LogicalBytes: 1024, UploadedBytes: 256}, completed.Status.UploadStats)
+
+			dr := &velerov2alpha1api.DataReplication{}
+			err = r.client.Get(ctx, types.NamespacedName{Name: du.Name, Namespace: du.Namespace}, dr)
+			if !test.expectedCreated {
+				assert.True(t, apierrors.IsNotFound(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "backup-1", dr.Spec.BackupName)
+			assert.Equal(t, du.Name, dr.Spec.DataUpload)
+			assert.Equal(t, "bsl-loc", dr.Spec.BackupStorageLocation)
+			assert.Equal(t, "bsl-replica", dr.Spec.TargetBackupStorageLocation)
+			assert.Equal(t, "snapshot-1", dr.Spec.SnapshotID)
+			assert.Equal(t, "backup-1", dr.Labels[velerov1api.BackupNameLabel])
+		})
+	}
+}
+
 func TestFindDataUploadForPod(t *testing.T) {
 	r, err := initDataUploaderReconciler()
 	require.NoError(t, err)
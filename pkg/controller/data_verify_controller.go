@@ -0,0 +1,363 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/datamover"
+	"github.com/vmware-tanzu/velero/pkg/datapath"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+)
+
+const dataVerifyRequestor = "snapshot-data-verify"
+
+// DataVerifyReconciler reconciles a DataVerify object
+type DataVerifyReconciler struct {
+	client            client.Client
+	logger            logrus.FieldLogger
+	credentialGetter  *credentials.CredentialGetter
+	Clock             clock.WithTickerAndDelayedExecution
+	nodeName          string
+	repositoryEnsurer *repository.Ensurer
+	dataPathMgr       *datapath.Manager
+}
+
+func NewDataVerifyReconciler(client client.Client, dataPathMgr *datapath.Manager, repoEnsurer *repository.Ensurer,
+	credentialGetter *credentials.CredentialGetter, nodeName string, logger logrus.FieldLogger) *DataVerifyReconciler {
+	return &DataVerifyReconciler{
+		client:            client,
+		logger:            logger.WithField("controller", "DataVerify"),
+		credentialGetter:  credentialGetter,
+		Clock:             &clock.RealClock{},
+		nodeName:          nodeName,
+		repositoryEnsurer: repoEnsurer,
+		dataPathMgr:       dataPathMgr,
+	}
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=dataverifies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=dataverifies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get;update;patch
+
+func (r *DataVerifyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithFields(logrus.Fields{
+		"controller": "dataverify",
+		"dataverify": req.NamespacedName,
+	})
+
+	dv := &velerov2alpha1api.DataVerify{}
+	if err := r.client.Get(ctx, req.NamespacedName, dv); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("DataVerify not found, skip")
+			return ctrl.Result{}, nil
+		}
+		log.WithError(err).Error("Unable to get the DataVerify")
+		return ctrl.Result{}, err
+	}
+
+	if !datamover.IsBuiltInUploader(dv.Spec.DataMover) {
+		log.WithField("data mover", dv.Spec.DataMover).Info("it is not one built-in data mover which is not supported by Velero")
+		return ctrl.Result{}, nil
+	}
+
+	switch dv.Status.Phase {
+	case "", velerov2alpha1api.DataVerifyPhaseNew:
+		log.Info("Data verify starting")
+
+		accepted, err := r.acceptDataVerify(ctx, dv)
+		if err != nil {
+			return ctrl.Result{}, r.updateStatusToFailed(ctx, dv, err, "error to accept the data verify", log)
+		}
+
+		if !accepted {
+			log.Debug("Data verify is not accepted")
+			return ctrl.Result{}, nil
+		}
+
+		log.Info("Data verify is accepted")
+
+		return r.runCancelableDataPath(ctx, dv, log)
+	case velerov2alpha1api.DataVerifyPhaseAccepted:
+		// the data path couldn't be started when the data verify was accepted, e.g., the concurrent limit was exceeded
+		if dv.Status.Node != r.nodeName || r.dataPathMgr.GetAsyncBR(dv.Name) != nil {
+			return ctrl.Result{}, nil
+		}
+
+		return r.runCancelableDataPath(ctx, dv, log)
+	case velerov2alpha1api.DataVerifyPhaseInProgress:
+		if dv.Status.Node != r.nodeName || r.dataPathMgr.GetAsyncBR(dv.Name) != nil {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, r.updateStatusToFailed(ctx, dv, errors.New("data path is not found"), "data verify is in progress without a running data path", log)
+	default:
+		return ctrl.Result{}, nil
+	}
+}
+
+func (r *DataVerifyReconciler) runCancelableDataPath(ctx context.Context, dv *velerov2alpha1api.DataVerify, log logrus.FieldLogger) (ctrl.Result, error) {
+	callbacks := datapath.Callbacks{
+		OnCompleted: r.OnDataVerifyCompleted,
+		OnFailed:    r.OnDataVerifyFailed,
+		OnCancelled: r.OnDataVerifyCancelled,
+		OnProgress:  r.OnDataVerifyProgress,
+	}
+
+	// the verification is a routine task following the backups, so it is limited by the backup concurrency
+	fsVerify, err := r.dataPathMgr.CreateFileSystemBR(dv.Name, dataVerifyRequestor, datapath.TaskTypeBackup, ctx, r.client, dv.Namespace, dv.Spec.SourceNamespace, callbacks, log)
+	if err != nil {
+		if err == datapath.ConcurrentLimitExceed {
+			log.Info("Data path instance is concurrent limited requeue later")
+			return ctrl.Result{Requeue: true, RequeueAfter: time.Minute}, nil
+		}
+		return ctrl.Result{}, r.updateStatusToFailed(ctx, dv, err, "error to create data path", log)
+	}
+
+	original := dv.DeepCopy()
+	dv.Status.Phase = velerov2alpha1api.DataVerifyPhaseInProgress
+	if err := r.client.Patch(ctx, dv, client.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("Unable to update status to in progress")
+		r.closeDataPath(ctx, dv.Name)
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Data verify is marked as in progress")
+
+	if err := fsVerify.Init(ctx, dv.Spec.BackupStorageLocation, dv.Spec.SourceNamespace, datamover.GetUploaderType(dv.Spec.DataMover),
+		velerov1api.BackupRepositoryTypeKopia, "", r.repositoryEnsurer, r.credentialGetter); err != nil {
+		defer r.closeDataPath(ctx, dv.Name)
+		return ctrl.Result{}, r.updateStatusToFailed(ctx, dv, err, "error to initialize data path", log)
+	}
+
+	if err := fsVerify.StartVerify(dv.Spec.SnapshotID, dv.Spec.VerifyFilesPercent); err != nil {
+		defer r.closeDataPath(ctx, dv.Name)
+		return ctrl.Result{}, r.updateStatusToFailed(ctx, dv, err, fmt.Sprintf("error starting data path to verify snapshot %s", dv.Spec.SnapshotID), log)
+	}
+
+	log.Info("Async fs verify data path started")
+	return ctrl.Result{}, nil
+}
+
+func (r *DataVerifyReconciler) OnDataVerifyCompleted(ctx context.Context, namespace string, dvName string, result datapath.Result) {
+	defer r.closeDataPath(ctx, dvName)
+
+	log := r.logger.WithField("dataverify", dvName)
+	log.Info("Async fs verify data path completed")
+
+	var dv velerov2alpha1api.DataVerify
+	if err := r.client.Get(ctx, types.NamespacedName{Name: dvName, Namespace: namespace}, &dv); err != nil {
+		log.WithError(err).Warn("Failed to get dataverify on completion")
+		return
+	}
+
+	original := dv.DeepCopy()
+	if len(result.Verify.Errors) == 0 {
+		dv.Status.Phase = velerov2alpha1api.DataVerifyPhaseCompleted
+	} else {
+		dv.Status.Phase = velerov2alpha1api.DataVerifyPhaseFailed
+		dv.Status.Message = fmt.Sprintf("snapshot is corrupted, %d entries failed to be verified", len(result.Verify.Errors))
+		dv.Status.Errors = result.Verify.Errors
+	}
+	dv.Status.CompletionTimestamp = &metav1.Time{Time: r.Clock.Now()}
+	if err := r.client.Patch(ctx, &dv, client.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("error updating data verify status")
+		return
+	}
+
+	log.Infof("Data verify is marked as %s", dv.Status.Phase)
+
+	recordVolumeVerification(ctx, r.client, &dv, log)
+}
+
+func (r *DataVerifyReconciler) OnDataVerifyFailed(ctx context.Context, namespace string, dvName string, err error) {
+	defer r.closeDataPath(ctx, dvName)
+
+	log := r.logger.WithField("dataverify", dvName)
+
+	log.WithError(err).Error("Async fs verify data path failed")
+
+	var dv velerov2alpha1api.DataVerify
+	if getErr := r.client.Get(ctx, types.NamespacedName{Name: dvName, Namespace: namespace}, &dv); getErr != nil {
+		log.WithError(getErr).Warn("Failed to get data verify on failure")
+	} else {
+		_ = r.updateStatusToFailed(ctx, &dv, err, "data path verify failed", log)
+	}
+}
+
+func (r *DataVerifyReconciler) OnDataVerifyCancelled(ctx context.Context, namespace string, dvName string) {
+	defer r.closeDataPath(ctx, dvName)
+
+	log := r.logger.WithField("dataverify", dvName)
+
+	log.Warn("Async fs verify data path canceled")
+
+	var dv velerov2alpha1api.DataVerify
+	if getErr := r.client.Get(ctx, types.NamespacedName{Name: dvName, Namespace: namespace}, &dv); getErr != nil {
+		log.WithError(getErr).Warn("Failed to get data verify on cancel")
+	} else {
+		_ = r.updateStatusToFailed(ctx, &dv, errors.New("data path is canceled"), "data path verify failed", log)
+	}
+}
+
+func (r *DataVerifyReconciler) OnDataVerifyProgress(ctx context.Context, namespace string, dvName string, progress *uploader.Progress) {
+	log := r.logger.WithField("dataverify", dvName)
+
+	var dv velerov2alpha1api.DataVerify
+	if err := r.client.Get(ctx, types.NamespacedName{Name: dvName, Namespace: namespace}, &dv); err != nil {
+		log.WithError(err).Warn("Failed to get data verify on progress")
+		return
+	}
+
+	original := dv.DeepCopy()
+	dv.Status.Progress = shared.DataMoveOperationProgress{TotalBytes: progress.TotalBytes, BytesDone: progress.BytesDone}
+
+	if err := r.client.Patch(ctx, &dv, client.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("Failed to update verify snapshot progress")
+	}
+}
+
+// SetupWithManager registers the DataVerify controller.
+func (r *DataVerifyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov2alpha1api.DataVerify{}).
+		Complete(r)
+}
+
+func (r *DataVerifyReconciler) updateStatusToFailed(ctx context.Context, dv *velerov2alpha1api.DataVerify, err error, msg string, log logrus.FieldLogger) error {
+	if patchErr := UpdateDataVerifyStatusToFailed(ctx, r.client, dv, errors.WithMessage(err, msg).Error(), r.Clock.Now(), log); patchErr == nil {
+		recordVolumeVerification(ctx, r.client, dv, log)
+	}
+
+	return err
+}
+
+func (r *DataVerifyReconciler) acceptDataVerify(ctx context.Context, dv *velerov2alpha1api.DataVerify) (bool, error) {
+	r.logger.Infof("Accepting data verify %s", dv.Name)
+
+	// the data verify controller in each node-agent tries to update the CR, only one of them succeeds and handles the rest logic
+	updated := dv.DeepCopy()
+
+	updateFunc := func(dataVerify *velerov2alpha1api.DataVerify) {
+		dataVerify.Status.Phase = velerov2alpha1api.DataVerifyPhaseAccepted
+		dataVerify.Status.StartTimestamp = &metav1.Time{Time: r.Clock.Now()}
+		dataVerify.Status.Node = r.nodeName
+		labels := dataVerify.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[acceptNodeLabelKey] = r.nodeName
+		dataVerify.SetLabels(labels)
+	}
+
+	updateFunc(updated)
+
+	if err := r.client.Update(ctx, updated); err != nil {
+		if apierrors.IsConflict(err) {
+			r.logger.WithField("DataVerify", dv.Name).Info("This dataverify has been accepted by others")
+			return false, nil
+		}
+		return false, err
+	}
+
+	*dv = *updated
+	r.logger.WithField("DataVerify", dv.Name).Infof("This dataverify has been accepted by %s", r.nodeName)
+	return true, nil
+}
+
+func (r *DataVerifyReconciler) closeDataPath(ctx context.Context, dvName string) {
+	fsVerify := r.dataPathMgr.GetAsyncBR(dvName)
+	if fsVerify != nil {
+		fsVerify.Close(ctx)
+	}
+
+	r.dataPathMgr.RemoveAsyncBR(dvName)
+}
+
+// UpdateDataVerifyStatusToFailed marks the DataVerify as failed with the given message
+func UpdateDataVerifyStatusToFailed(ctx context.Context, c client.Client, dv *velerov2alpha1api.DataVerify, errString string, time time.Time, log logrus.FieldLogger) error {
+	original := dv.DeepCopy()
+	dv.Status.Phase = velerov2alpha1api.DataVerifyPhaseFailed
+	dv.Status.Message = errString
+	dv.Status.CompletionTimestamp = &metav1.Time{Time: time}
+
+	err := c.Patch(ctx, dv, client.MergeFrom(original))
+	if err != nil {
+		log.WithError(err).Error("error updating DataVerify status")
+	}
+
+	return err
+}
+
+// recordVolumeVerification records the result of the finished DataVerify into the status of its backup,
+// the previous result of the same volume snapshot is replaced
+func recordVolumeVerification(ctx context.Context, c client.Client, dv *velerov2alpha1api.DataVerify, log logrus.FieldLogger) {
+	verification := velerov1api.BackupVolumeVerification{
+		DataUpload:          dv.Spec.DataUpload,
+		PVC:                 fmt.Sprintf("%s/%s", dv.Spec.SourceNamespace, dv.Spec.SourcePVC),
+		SnapshotID:          dv.Spec.SnapshotID,
+		Message:             dv.Status.Message,
+		CompletionTimestamp: dv.Status.CompletionTimestamp,
+	}
+
+	switch {
+	case dv.Status.Phase == velerov2alpha1api.DataVerifyPhaseCompleted:
+		verification.Phase = velerov1api.BackupVolumeVerificationPhaseVerified
+	case len(dv.Status.Errors) > 0:
+		verification.Phase = velerov1api.BackupVolumeVerificationPhaseCorrupted
+	default:
+		verification.Phase = velerov1api.BackupVolumeVerificationPhaseFailed
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		backup := &velerov1api.Backup{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: dv.Namespace, Name: dv.Spec.BackupName}, backup); err != nil {
+			return err
+		}
+
+		original := backup.DeepCopy()
+		verifications := []velerov1api.BackupVolumeVerification{}
+		for _, v := range backup.Status.VolumeVerifications {
+			if v.DataUpload != verification.DataUpload {
+				verifications = append(verifications, v)
+			}
+		}
+		backup.Status.VolumeVerifications = append(verifications, verification)
+
+		return c.Patch(ctx, backup, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{}))
+	})
+	if err != nil {
+		log.WithError(err).Warnf("Failed to record the verification result to backup %s", dv.Spec.BackupName)
+	}
+}
@@ -0,0 +1,238 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/datapath"
+	datapathmocks "github.com/vmware-tanzu/velero/pkg/datapath/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+)
+
+const dataVerifyName = "dataverify-1"
+
+func dataVerifyBuilder() *builder.DataVerifyBuilder {
+	return builder.ForDataVerify(velerov1api.DefaultNamespace, dataVerifyName).
+		BackupName("backup-1").
+		DataUpload("dataupload-1").
+		SourcePVC("pvc-1").
+		SourceNamespace("ns-1").
+		BackupStorageLocation("bsl-1").
+		DataMover("velero").
+		SnapshotID("snapshot-1").
+		VerifyFilesPercent(10)
+}
+
+func initDataVerifyReconciler(t *testing.T, objects ...runtime.Object) *DataVerifyReconciler {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, velerov1api.AddToScheme(scheme))
+	require.NoError(t, velerov2alpha1api.AddToScheme(scheme))
+
+	r := NewDataVerifyReconciler(fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objects...).Build(),
+		datapath.NewManager(1, nil, uploader.BandwidthLimits{}), nil, nil, "test-node", velerotest.NewLogger())
+	r.Clock = testclocks.NewFakeClock(time.Now())
+
+	return r
+}
+
+func TestDataVerifyReconcile(t *testing.T) {
+	tests := []struct {
+		name                 string
+		dv                   *velerov2alpha1api.DataVerify
+		limitExceeded        bool
+		initErr              error
+		startErr             error
+		expectStart          bool
+		expectClose          bool
+		expectedPhase        velerov2alpha1api.DataVerifyPhase
+		expectedResult       ctrl.Result
+		expectedVerification velerov1api.BackupVolumeVerificationPhase
+	}{
+		{
+			name:          "new data verify is accepted and started",
+			dv:            dataVerifyBuilder().Result(),
+			expectStart:   true,
+			expectedPhase: velerov2alpha1api.DataVerifyPhaseInProgress,
+		},
+		{
+			name:           "data verify is requeued when the concurrent limit is exceeded",
+			dv:             dataVerifyBuilder().Result(),
+			limitExceeded:  true,
+			expectedPhase:  velerov2alpha1api.DataVerifyPhaseAccepted,
+			expectedResult: ctrl.Result{Requeue: true, RequeueAfter: time.Minute},
+		},
+		{
+			name:          "accepted data verify of this node is started",
+			dv:            dataVerifyBuilder().Phase(velerov2alpha1api.DataVerifyPhaseAccepted).Node("test-node").Result(),
+			expectStart:   true,
+			expectedPhase: velerov2alpha1api.DataVerifyPhaseInProgress,
+		},
+		{
+			name:          "accepted data verify of other node is skipped",
+			dv:            dataVerifyBuilder().Phase(velerov2alpha1api.DataVerifyPhaseAccepted).Node("other-node").Result(),
+			expectedPhase: velerov2alpha1api.DataVerifyPhaseAccepted,
+		},
+		{
+			name:                 "data path fails to initialize",
+			dv:                   dataVerifyBuilder().Result(),
+			initErr:              errors.New("fake-init-error"),
+			expectClose:          true,
+			expectedPhase:        velerov2alpha1api.DataVerifyPhaseFailed,
+			expectedVerification: velerov1api.BackupVolumeVerificationPhaseFailed,
+		},
+		{
+			name:                 "data path fails to start",
+			dv:                   dataVerifyBuilder().Result(),
+			startErr:             errors.New("fake-start-error"),
+			expectStart:          true,
+			expectClose:          true,
+			expectedPhase:        velerov2alpha1api.DataVerifyPhaseFailed,
+			expectedVerification: velerov1api.BackupVolumeVerificationPhaseFailed,
+		},
+		{
+			name:                 "in progress data verify of this node without data path is failed",
+			dv:                   dataVerifyBuilder().Phase(velerov2alpha1api.DataVerifyPhaseInProgress).Node("test-node").Result(),
+			expectedPhase:        velerov2alpha1api.DataVerifyPhaseFailed,
+			expectedVerification: velerov1api.BackupVolumeVerificationPhaseFailed,
+		},
+		{
+			name:          "in progress data verify of other node is skipped",
+			dv:            dataVerifyBuilder().Phase(velerov2alpha1api.DataVerifyPhaseInProgress).Node("other-node").Result(),
+			expectedPhase: velerov2alpha1api.DataVerifyPhaseInProgress,
+		},
+		{
+			name:          "data verify of non built-in data mover is skipped",
+			dv:            dataVerifyBuilder().DataMover("other-data-mover").Result(),
+			expectedPhase: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := initDataVerifyReconciler(t, test.dv, builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result())
+			if test.limitExceeded {
+				r.dataPathMgr = datapath.NewManager(0, nil, uploader.BandwidthLimits{})
+			}
+
+			datapath.FSBRCreator = func(string, string, kbclient.Client, string, uploader.BandwidthLimits, uploader.CacheOptions, datapath.Callbacks, logrus.FieldLogger) datapath.AsyncBR {
+				fsBR := datapathmocks.NewAsyncBR(t)
+				fsBR.On("Init", mock.Anything, "bsl-1", "ns-1", "kopia", velerov1api.BackupRepositoryTypeKopia, "", mock.Anything, mock.Anything).Return(test.initErr)
+				if test.expectStart {
+					fsBR.On("StartVerify", "snapshot-1", 10).Return(test.startErr)
+				}
+				if test.expectClose {
+					fsBR.On("Close", mock.Anything).Return()
+				}
+				return fsBR
+			}
+
+			result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: dataVerifyName}})
+			if test.initErr != nil || test.startErr != nil {
+				assert.Error(t, err)
+			} else if test.expectedPhase == velerov2alpha1api.DataVerifyPhaseFailed {
+				assert.EqualError(t, err, "data path is not found")
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedResult, result)
+
+			dv := &velerov2alpha1api.DataVerify{}
+			require.NoError(t, r.client.Get(context.Background(), types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: dataVerifyName}, dv))
+			assert.Equal(t, test.expectedPhase, dv.Status.Phase)
+
+			backup := &velerov1api.Backup{}
+			require.NoError(t, r.client.Get(context.Background(), types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "backup-1"}, backup))
+			if test.expectedVerification == "" {
+				assert.Empty(t, backup.Status.VolumeVerifications)
+			} else {
+				require.Len(t, backup.Status.VolumeVerifications, 1)
+				assert.Equal(t, test.expectedVerification, backup.Status.VolumeVerifications[0].Phase)
+			}
+		})
+	}
+}
+
+func TestOnDataVerifyCompleted(t *testing.T) {
+	tests := []struct {
+		name                 string
+		result               datapath.Result
+		expectedPhase        velerov2alpha1api.DataVerifyPhase
+		expectedVerification velerov1api.BackupVolumeVerificationPhase
+	}{
+		{
+			name:                 "snapshot is verified",
+			expectedPhase:        velerov2alpha1api.DataVerifyPhaseCompleted,
+			expectedVerification: velerov1api.BackupVolumeVerificationPhaseVerified,
+		},
+		{
+			name:                 "snapshot is corrupted",
+			result:               datapath.Result{Verify: datapath.VerifyResult{Errors: []string{"file-1: error reading file: invalid checksum"}}},
+			expectedPhase:        velerov2alpha1api.DataVerifyPhaseFailed,
+			expectedVerification: velerov1api.BackupVolumeVerificationPhaseCorrupted,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+			backup.Status.VolumeVerifications = []velerov1api.BackupVolumeVerification{
+				{DataUpload: "dataupload-1", Phase: velerov1api.BackupVolumeVerificationPhaseFailed},
+				{DataUpload: "dataupload-2", Phase: velerov1api.BackupVolumeVerificationPhaseVerified},
+			}
+
+			r := initDataVerifyReconciler(t, dataVerifyBuilder().Phase(velerov2alpha1api.DataVerifyPhaseInProgress).Node("test-node").Result(), backup)
+
+			r.OnDataVerifyCompleted(context.Background(), velerov1api.DefaultNamespace, dataVerifyName, test.result)
+
+			dv := &velerov2alpha1api.DataVerify{}
+			require.NoError(t, r.client.Get(context.Background(), types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: dataVerifyName}, dv))
+			assert.Equal(t, test.expectedPhase, dv.Status.Phase)
+			assert.Equal(t, test.result.Verify.Errors, dv.Status.Errors)
+			assert.NotNil(t, dv.Status.CompletionTimestamp)
+
+			updated := &velerov1api.Backup{}
+			require.NoError(t, r.client.Get(context.Background(), types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "backup-1"}, updated))
+			require.Len(t, updated.Status.VolumeVerifications, 2)
+			assert.Equal(t, "dataupload-2", updated.Status.VolumeVerifications[0].DataUpload)
+			assert.Equal(t, "dataupload-1", updated.Status.VolumeVerifications[1].DataUpload)
+			assert.Equal(t, "ns-1/pvc-1", updated.Status.VolumeVerifications[1].PVC)
+			assert.Equal(t, "snapshot-1", updated.Status.VolumeVerifications[1].SnapshotID)
+			assert.Equal(t, test.expectedVerification, updated.Status.VolumeVerifications[1].Phase)
+		})
+	}
+}
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/pkg/errors"
@@ -30,6 +31,8 @@ import (
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/audit"
 	"github.com/vmware-tanzu/velero/pkg/itemoperationmap"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
@@ -53,6 +56,8 @@ type downloadRequestReconciler struct {
 	backupItemOperationsMap *itemoperationmap.BackupItemOperationsMap
 	// used to force update of async restore item operations before processing download request
 	restoreItemOperationsMap *itemoperationmap.RestoreItemOperationsMap
+	// returns whether the restore item operations map is populated by this replica, nil means always
+	restoreItemOperationsLeading func() bool
 
 	log logrus.FieldLogger
 }
@@ -105,6 +110,12 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			// Delete any request that is expired, regardless of the phase: it is not
 			// worth proceeding and trying/retrying to find it.
 			log.Debug("DownloadRequest has expired - deleting")
+			if downloadRequest.Spec.Target.HasContentsFilter() && downloadRequest.Status.Phase == velerov1api.DownloadRequestPhaseProcessed {
+				r.deleteBackupContentsExtract(ctx, downloadRequest, log)
+			}
+			if downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindAuditLog && downloadRequest.Status.Phase == velerov1api.DownloadRequestPhaseProcessed {
+				r.deleteAuditLog(ctx, downloadRequest, log)
+			}
 			if err := r.client.Delete(ctx, downloadRequest); err != nil {
 				log.WithError(err).Error("Error deleting an expired download request")
 				return ctrl.Result{}, errors.WithStack(err)
@@ -131,6 +142,10 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		// Update the expiration.
 		downloadRequest.Status.Expiration = &metav1.Time{Time: r.clock.Now().Add(persistence.DownloadURLTTL)}
 
+		if downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindAuditLog {
+			return r.processAuditLog(ctx, downloadRequest, log)
+		}
+
 		if downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreLog ||
 			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreResults ||
 			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreResourceList ||
@@ -197,13 +212,25 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		// If this is a request for restore item operations, force upload of in-memory operations that
 		// are not yet uploaded (if there are any)
 		if downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreItemOperations &&
-			r.restoreItemOperationsMap != nil {
+			r.restoreItemOperationsMap != nil && (r.restoreItemOperationsLeading == nil || r.restoreItemOperationsLeading()) {
 			// ignore errors here. If we can't upload anything here, process the download as usual
 			_ = r.restoreItemOperationsMap.UpdateForRestore(backupStore, downloadRequest.Spec.Target.Name)
 		}
 
-		if downloadRequest.Status.DownloadURL, err = backupStore.GetDownloadURL(downloadRequest.Spec.Target); err != nil {
-			log.Warnf("fail to get Backup metadata file's download URL %s, retry later: %s", downloadRequest.Spec.Target, err)
+		// If only part of the backup contents is requested, extract it into a separate
+		// tarball and download that instead of the whole backup contents
+		if downloadRequest.Spec.Target.HasContentsFilter() {
+			if err := extractBackupContents(backupStore, backupName, downloadRequest); err != nil {
+				log.Warnf("fail to extract the backup contents, retry later: %s", err)
+				return ctrl.Result{}, errors.WithStack(err)
+			}
+
+			if downloadRequest.Status.DownloadURL, err = backupStore.GetBackupContentsExtractDownloadURL(backupName, downloadRequest.Name); err != nil {
+				log.Warnf("fail to get the download URL of the extracted backup contents, retry later: %s", err)
+				return ctrl.Result{}, errors.WithStack(err)
+			}
+		} else if downloadRequest.Status.DownloadURL, err = backupStore.GetDownloadURL(downloadRequest.Spec.Target); err != nil {
+			log.Warnf("fail to get Backup metadata file's download URL %v, retry later: %s", downloadRequest.Spec.Target, err)
 			return ctrl.Result{}, errors.WithStack(err)
 		}
 
@@ -216,6 +243,139 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	return ctrl.Result{}, nil
 }
 
+// extractBackupContents filters the items of the backup contents by the target of the download
+// request and stores them into the backup store as the extract of the download request.
+func extractBackupContents(backupStore persistence.BackupStore, backupName string, downloadRequest *velerov1api.DownloadRequest) error {
+	contents, err := backupStore.GetBackupContents(backupName)
+	if err != nil {
+		return errors.Wrap(err, "error getting backup contents")
+	}
+	defer contents.Close()
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(archive.Filter(contents, writer, downloadRequest.Spec.Target.IncludedResources, downloadRequest.Spec.Target.IncludedNamespaces))
+	}()
+	// make sure the filter goroutine exits if the extract isn't fully read
+	defer reader.Close()
+
+	if err := backupStore.PutBackupContentsExtract(backupName, downloadRequest.Name, reader); err != nil {
+		return errors.Wrap(err, "error putting extracted backup contents")
+	}
+
+	return nil
+}
+
+// deleteBackupContentsExtract deletes the extract of the expired download request from the
+// backup store, the errors are only logged as the extract is deleted with the backup anyway.
+func (r *downloadRequestReconciler) deleteBackupContentsExtract(ctx context.Context, downloadRequest *velerov1api.DownloadRequest, log logrus.FieldLogger) {
+	backup := &velerov1api.Backup{}
+	if err := r.client.Get(ctx, kbclient.ObjectKey{
+		Namespace: downloadRequest.Namespace,
+		Name:      downloadRequest.Spec.Target.Name,
+	}, backup); err != nil {
+		log.WithError(err).Warn("fail to get backup to delete the extracted backup contents")
+		return
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, kbclient.ObjectKey{
+		Namespace: backup.Namespace,
+		Name:      backup.Spec.StorageLocation,
+	}, location); err != nil {
+		log.WithError(err).Warn("fail to get BSL to delete the extracted backup contents")
+		return
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		log.WithError(err).Warn("fail to get backup store to delete the extracted backup contents")
+		return
+	}
+
+	if err := backupStore.DeleteBackupContentsExtract(backup.Name, downloadRequest.Name); err != nil {
+		log.WithError(err).Warn("fail to delete the extracted backup contents")
+	}
+}
+
+// processAuditLog aggregates the entries of the audit log of the backup storage location named
+// by the target into the audit log of the download request, and gets its download URL.
+func (r *downloadRequestReconciler) processAuditLog(ctx context.Context, downloadRequest *velerov1api.DownloadRequest, log logrus.FieldLogger) (ctrl.Result, error) {
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, kbclient.ObjectKey{
+		Namespace: downloadRequest.Namespace,
+		Name:      downloadRequest.Spec.Target.Name,
+	}, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Errorf("BSL for DownloadRequest cannot be found")
+			return ctrl.Result{}, nil
+		}
+		log.Warnf("fail to get BSL for DownloadRequest: %s", err.Error())
+		return ctrl.Result{}, errors.WithStack(err)
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		log.WithError(err).Error("Error getting a backup store")
+		return ctrl.Result{}, nil
+	}
+
+	if err := audit.ExportLog(backupStore, downloadRequest.Name); err != nil {
+		log.Warnf("fail to export the audit log, retry later: %s", err)
+		return ctrl.Result{}, errors.WithStack(err)
+	}
+
+	if downloadRequest.Status.DownloadURL, err = backupStore.GetAuditLogDownloadURL(downloadRequest.Name); err != nil {
+		log.Warnf("fail to get the download URL of the audit log, retry later: %s", err)
+		return ctrl.Result{}, errors.WithStack(err)
+	}
+
+	downloadRequest.Status.Phase = velerov1api.DownloadRequestPhaseProcessed
+	downloadRequest.Status.Expiration = &metav1.Time{Time: r.clock.Now().Add(persistence.DownloadURLTTL)}
+
+	return ctrl.Result{}, nil
+}
+
+// deleteAuditLog deletes the audit log exported for the expired download request from the
+// backup store, the errors are only logged.
+func (r *downloadRequestReconciler) deleteAuditLog(ctx context.Context, downloadRequest *velerov1api.DownloadRequest, log logrus.FieldLogger) {
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, kbclient.ObjectKey{
+		Namespace: downloadRequest.Namespace,
+		Name:      downloadRequest.Spec.Target.Name,
+	}, location); err != nil {
+		log.WithError(err).Warn("fail to get BSL to delete the exported audit log")
+		return
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		log.WithError(err).Warn("fail to get backup store to delete the exported audit log")
+		return
+	}
+
+	if err := backupStore.DeleteAuditLog(downloadRequest.Name); err != nil {
+		log.WithError(err).Warn("fail to delete the exported audit log")
+	}
+}
+
+// WithRestoreItemOperationsLeading sets the func returning whether the restore item operations map is
+// populated by this replica, i.e., whether the replica holds the lease of the restore group. The map
+// is skipped when it isn't, as the restores are run by another replica.
+func (r *downloadRequestReconciler) WithRestoreItemOperationsLeading(leading func() bool) *downloadRequestReconciler {
+	r.restoreItemOperationsLeading = leading
+	return r
+}
+
 func (r *downloadRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	downloadRequestSource := kube.NewPeriodicalEnqueueSource(r.log, mgr.GetClient(),
 		&velerov1api.DownloadRequestList{}, defaultDownloadRequestSyncPeriod, kube.PeriodicalEnqueueSourceOption{})
@@ -230,5 +390,6 @@ func (r *downloadRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&velerov1api.DownloadRequest{}).
 		Watches(downloadRequestSource, nil, builder.WithPredicates(downloadRequestPredicates)).
+		WithOptions(controllerOptions(DownloadRequest)).
 		Complete(r)
 }
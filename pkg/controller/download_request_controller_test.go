@@ -17,13 +17,21 @@ limitations under the License.
 package controller
 
 import (
+	"archive/tar"
+	"compress/gzip"
 	"context"
+	"io"
+	"strings"
+	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -35,6 +43,8 @@ import (
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/itemoperationmap"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
@@ -263,3 +273,120 @@ var _ = Describe("Download Request Reconciler", func() {
 		}),
 	)
 })
+
+func TestExtractBackupContents(t *testing.T) {
+	contents := velerotest.NewTarWriter(t).
+		AddItems("configmaps", builder.ForConfigMap("ns-1", "cm-1").Result(), builder.ForConfigMap("ns-2", "cm-2").Result()).
+		AddItems("secrets", builder.ForSecret("ns-1", "secret-1").Result()).
+		Done()
+
+	downloadRequest := builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").
+		Target(velerov1api.DownloadTargetKindBackupContents, "a-backup").
+		ContentsFilter([]string{"configmaps"}, []string{"ns-1"}).Result()
+
+	var extracted []string
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("GetBackupContents", "a-backup").Return(io.NopCloser(contents), nil)
+	backupStore.On("PutBackupContentsExtract", "a-backup", "a-download-request", mock.Anything).Run(func(args mock.Arguments) {
+		gzr, err := gzip.NewReader(args.Get(2).(io.Reader))
+		require.NoError(t, err)
+		tr := tar.NewReader(gzr)
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			extracted = append(extracted, header.Name)
+		}
+	}).Return(nil)
+
+	require.NoError(t, extractBackupContents(backupStore, "a-backup", downloadRequest))
+	assert.Equal(t, []string{"resources/configmaps/namespaces/ns-1/cm-1.json"}, extracted)
+}
+
+func TestProcessAuditLog(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	downloadRequest := builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").
+		Target(velerov1api.DownloadTargetKindAuditLog, "a-location").Result()
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").Result()
+
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("ListAuditEntries").Return([]string{"entry-1"}, nil)
+	backupStore.On("GetAuditEntry", "entry-1").Return(io.NopCloser(strings.NewReader(`{"action":"BackupCreate"}`)), nil)
+	backupStore.On("PutAuditLog", "a-download-request", mock.Anything).Return(nil)
+	backupStore.On("GetAuditLogDownloadURL", "a-download-request").Return("a-url", nil)
+
+	pluginManager := &pluginmocks.Manager{}
+	pluginManager.On("CleanupClients").Return(nil)
+
+	r := NewDownloadRequestReconciler(
+		velerotest.NewFakeControllerRuntimeClient(t, downloadRequest, location),
+		testclocks.NewFakeClock(now),
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewFakeSingleObjectBackupStoreGetter(backupStore),
+		velerotest.NewLogger(),
+		nil,
+		nil,
+	)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "a-download-request"}})
+	require.NoError(t, err)
+
+	instance := &velerov1api.DownloadRequest{}
+	require.NoError(t, r.client.Get(context.Background(), kbclient.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: "a-download-request"}, instance))
+	assert.Equal(t, velerov1api.DownloadRequestPhaseProcessed, instance.Status.Phase)
+	assert.Equal(t, "a-url", instance.Status.DownloadURL)
+	backupStore.AssertExpectations(t)
+
+	// the exported audit log is deleted once the request expires
+	backupStore.On("DeleteAuditLog", "a-download-request").Return(nil)
+	r.clock = testclocks.NewFakeClock(now.Add(time.Hour))
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "a-download-request"}})
+	require.NoError(t, err)
+	backupStore.AssertCalled(t, "DeleteAuditLog", "a-download-request")
+}
+
+func TestProcessRestoreItemOperationsLeading(t *testing.T) {
+	for _, leading := range []bool{true, false} {
+		downloadRequest := builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").
+			Target(velerov1api.DownloadTargetKindRestoreItemOperations, "a-restore").Result()
+		restore := builder.ForRestore(velerov1api.DefaultNamespace, "a-restore").Backup("a-backup").Result()
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "a-backup").StorageLocation("a-location").Result()
+		location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").Result()
+
+		backupStore := &persistencemocks.BackupStore{}
+		backupStore.On("PutRestoreItemOperations", "a-restore", mock.Anything).Return(nil)
+		backupStore.On("GetDownloadURL", downloadRequest.Spec.Target).Return("a-url", nil)
+
+		pluginManager := &pluginmocks.Manager{}
+		pluginManager.On("CleanupClients").Return(nil)
+
+		restoreOpsMap := itemoperationmap.NewRestoreItemOperationsMap()
+		restoreOpsMap.PutOperationsForRestore(&itemoperationmap.OperationsForRestore{
+			Operations:         []*itemoperation.RestoreOperation{{}},
+			ChangesSinceUpdate: true,
+		}, "a-restore")
+
+		r := NewDownloadRequestReconciler(
+			velerotest.NewFakeControllerRuntimeClient(t, downloadRequest, restore, backup, location),
+			testclocks.NewFakeClock(time.Now()),
+			func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			NewFakeSingleObjectBackupStoreGetter(backupStore),
+			velerotest.NewLogger(),
+			nil,
+			restoreOpsMap,
+		).WithRestoreItemOperationsLeading(func() bool { return leading })
+
+		_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "a-download-request"}})
+		require.NoError(t, err)
+
+		// the restore item operations map is only uploaded by the replica holding the lease of the restore group
+		if leading {
+			backupStore.AssertCalled(t, "PutRestoreItemOperations", "a-restore", mock.Anything)
+		} else {
+			backupStore.AssertNotCalled(t, "PutRestoreItemOperations", "a-restore", mock.Anything)
+		}
+		backupStore.AssertCalled(t, "GetDownloadURL", downloadRequest.Spec.Target)
+	}
+}
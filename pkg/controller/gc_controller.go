@@ -43,6 +43,7 @@ const (
 	gcFailureBSLNotFound     = "BSLNotFound"
 	gcFailureBSLCannotGet    = "BSLCannotGet"
 	gcFailureBSLReadOnly     = "BSLReadOnly"
+	gcFailureBackupImported  = "BackupImported"
 )
 
 // gcReconciler creates DeleteBackupRequests for expired backups.
@@ -89,6 +90,7 @@ func (c *gcReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			},
 		})).
 		Watches(s, nil).
+		WithOptions(controllerOptions(GarbageCollection)).
 		Complete(c)
 }
 
@@ -131,6 +133,15 @@ func (c *gcReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 		backup.Labels = make(map[string]string)
 	}
 
+	if backup.Labels[velerov1api.ImportedBackupLabel] == "true" {
+		log.Info("Backup cannot be garbage-collected because it's imported from another cluster")
+		backup.Labels[garbageCollectionFailure] = gcFailureBackupImported
+		if err := c.Update(ctx, backup); err != nil {
+			log.WithError(err).Error("error updating backup labels")
+		}
+		return ctrl.Result{}, nil
+	}
+
 	loc := &velerov1api.BackupStorageLocation{}
 	if err := c.Get(ctx, client.ObjectKey{
 		Namespace: req.Namespace,
@@ -148,7 +159,7 @@ func (c *gcReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 		return ctrl.Result{}, errors.Wrap(err, "error getting backup storage location")
 	}
 
-	if loc.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+	if loc.Spec.IsReadOnly() {
 		log.Infof("Backup cannot be garbage-collected because backup storage location %s is currently in read-only mode", loc.Name)
 		backup.Labels[garbageCollectionFailure] = gcFailureBSLReadOnly
 		if err := c.Update(ctx, backup); err != nil {
@@ -68,6 +68,16 @@ func TestGCReconcile(t *testing.T) {
 			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).StorageLocation("read-only").Result(),
 			backupLocation: builder.ForBackupStorageLocation("velero", "read-only").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
 		},
+		{
+			name:           "expired backup in import storage location is not deleted",
+			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).StorageLocation("import").Result(),
+			backupLocation: builder.ForBackupStorageLocation("velero", "import").AccessMode(velerov1api.BackupStorageLocationAccessModeImport).Result(),
+		},
+		{
+			name:           "expired imported backup in read-write storage location is not deleted",
+			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).StorageLocation("read-write").ObjectMeta(builder.WithLabels(velerov1api.ImportedBackupLabel, "true")).Result(),
+			backupLocation: builder.ForBackupStorageLocation("velero", "read-write").AccessMode(velerov1api.BackupStorageLocationAccessModeReadWrite).Result(),
+		},
 		{
 			name:           "expired backup in read-write storage location is deleted",
 			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).StorageLocation("read-write").Result(),
@@ -0,0 +1,212 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coordinationv1client "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// leaderElectionGroups maps the controllers sharing in-memory state with another controller,
+// e.g. the backup tracker or the item operations maps, to the controller they share it with.
+// The controllers of a group are always run by the same replica. The download request controller
+// also reads the restore item operations map of the restore group, which is only populated when the
+// replica holds the lease of the restore group too, so the item operations of the restores run by
+// another replica are downloaded as last uploaded by that replica.
+var leaderElectionGroups = map[string]string{
+	BackupDeletion:    Backup,
+	BackupFinalizer:   Backup,
+	BackupOperations:  Backup,
+	DownloadRequest:   Backup,
+	RestoreOperations: Restore,
+}
+
+// LeaderElectionGroup returns the leader election group of the controller.
+func LeaderElectionGroup(controller string) string {
+	if group, ok := leaderElectionGroups[controller]; ok {
+		return group
+	}
+	return controller
+}
+
+// LeaderElectionConfig is the configuration of the leader election of the controller groups.
+type LeaderElectionConfig struct {
+	// Namespace is the namespace of the leases.
+	Namespace string
+	// Identity is the unique identity of the replica.
+	Identity      string
+	LeaseDuration time.Duration
+	RenewDeadline time.Duration
+	RetryPeriod   time.Duration
+}
+
+// +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;create;update
+
+// LeaderElectedManager wraps the controller manager so that the controllers set up with it are
+// started only when the replica holds the lease of their group. Each group has its own lease, so
+// the replicas of the Velero server share the work by running different groups.
+type LeaderElectedManager struct {
+	ctrl.Manager
+	group *leaderElectionGroup
+}
+
+// NewLeaderElectedManager creates the lease "velero-<group>" for the group and adds the group to
+// the manager. The onStartedLeading func, if any, is called before starting the controllers each
+// time the lease is acquired.
+func NewLeaderElectedManager(mgr ctrl.Manager, group string, client coordinationv1client.LeasesGetter, config LeaderElectionConfig,
+	onStartedLeading func(context.Context), logger logrus.FieldLogger) (*LeaderElectedManager, error) {
+	g := &leaderElectionGroup{
+		name: group,
+		lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Namespace: config.Namespace,
+				Name:      "velero-" + group,
+			},
+			Client:     client,
+			LockConfig: resourcelock.ResourceLockConfig{Identity: config.Identity},
+		},
+		config:           config,
+		onStartedLeading: onStartedLeading,
+		logger:           logger.WithField("leaderElectionGroup", group),
+	}
+	if err := mgr.Add(g); err != nil {
+		return nil, errors.Wrapf(err, "error adding leader election group %s", group)
+	}
+
+	return &LeaderElectedManager{
+		Manager: mgr,
+		group:   g,
+	}, nil
+}
+
+// Add adds the runnable to the group instead of the manager, the runnable is started when the
+// lease of the group is acquired.
+func (m *LeaderElectedManager) Add(r manager.Runnable) error {
+	if err := m.Manager.SetFields(r); err != nil {
+		return err
+	}
+	m.group.runnables = append(m.group.runnables, r)
+	return nil
+}
+
+// Leading returns whether the replica holds the lease of the group.
+func (m *LeaderElectedManager) Leading() bool {
+	return m.group.leading.Load()
+}
+
+// leaderElectionGroup is the runnable of a group of controllers sharing a lease.
+type leaderElectionGroup struct {
+	name             string
+	lock             resourcelock.Interface
+	leading          atomic.Bool
+	config           LeaderElectionConfig
+	runnables        []manager.Runnable
+	onStartedLeading func(context.Context)
+	logger           logrus.FieldLogger
+}
+
+// NeedLeaderElection returns false as the group does its own leader election.
+func (g *leaderElectionGroup) NeedLeaderElection() bool {
+	return false
+}
+
+// Start runs the leader election of the group until the context is done. The controllers of the
+// group are started once the lease is acquired, and an error is returned if the lease is lost so
+// that the server exits and another replica takes over the group.
+func (g *leaderElectionGroup) Start(ctx context.Context) error {
+	errCh := make(chan error, 2)
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            g.lock,
+		LeaseDuration:   g.config.LeaseDuration,
+		RenewDeadline:   g.config.RenewDeadline,
+		RetryPeriod:     g.config.RetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            g.name,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(leaderCtx context.Context) {
+				g.logger.Info("Acquired the lease, starting the controllers")
+				g.leading.Store(true)
+				if g.onStartedLeading != nil {
+					g.onStartedLeading(leaderCtx)
+				}
+				if err := g.startRunnables(leaderCtx); err != nil {
+					errCh <- err
+				}
+			},
+			OnStoppedLeading: func() {
+				g.leading.Store(false)
+				if ctx.Err() != nil {
+					return
+				}
+				errCh <- errors.Errorf("lost the lease of leader election group %s", g.name)
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error creating leader elector of group %s", g.name)
+	}
+
+	g.logger.Info("Waiting for the lease")
+	go elector.Run(ctx)
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-errCh:
+		return err
+	}
+}
+
+// startRunnables starts the runnables of the group and waits until all of them return. If one
+// of them fails, the others are stopped and the error is returned.
+func (g *leaderElectionGroup) startRunnables(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	for _, r := range g.runnables {
+		wg.Add(1)
+		go func(r manager.Runnable) {
+			defer wg.Done()
+			if err := r.Start(ctx); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(r)
+	}
+	wg.Wait()
+
+	return firstErr
+}
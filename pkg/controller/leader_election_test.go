@@ -0,0 +1,144 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type fakeRunnable struct {
+	started chan struct{}
+	err     error
+}
+
+func newFakeRunnable(err error) *fakeRunnable {
+	return &fakeRunnable{started: make(chan struct{}), err: err}
+}
+
+func (r *fakeRunnable) Start(ctx context.Context) error {
+	close(r.started)
+	if r.err != nil {
+		return r.err
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func newTestLeaderElectionGroup(client *fake.Clientset, identity string, runnables ...manager.Runnable) (*leaderElectionGroup, chan struct{}) {
+	leading := make(chan struct{}, 1)
+	return &leaderElectionGroup{
+		name: Backup,
+		lock: &resourcelock.LeaseLock{
+			LeaseMeta:  metav1.ObjectMeta{Namespace: "velero", Name: "velero-" + Backup},
+			Client:     client.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+		},
+		config: LeaderElectionConfig{
+			LeaseDuration: 2 * time.Second,
+			RenewDeadline: time.Second,
+			RetryPeriod:   100 * time.Millisecond,
+		},
+		runnables: runnables,
+		onStartedLeading: func(context.Context) {
+			leading <- struct{}{}
+		},
+		logger: velerotest.NewLogger(),
+	}, leading
+}
+
+func assertStarted(t *testing.T, r *fakeRunnable) {
+	t.Helper()
+	select {
+	case <-r.started:
+	case <-time.After(10 * time.Second):
+		t.Fatal("runnable isn't started")
+	}
+}
+
+func assertNotStarted(t *testing.T, r *fakeRunnable) {
+	t.Helper()
+	select {
+	case <-r.started:
+		t.Fatal("runnable is started")
+	case <-time.After(500 * time.Millisecond):
+	}
+}
+
+func TestLeaderElectionGroup(t *testing.T) {
+	assert.Equal(t, Backup, LeaderElectionGroup(BackupDeletion))
+	assert.Equal(t, Backup, LeaderElectionGroup(DownloadRequest))
+	assert.Equal(t, Restore, LeaderElectionGroup(RestoreOperations))
+	assert.Equal(t, BackupSync, LeaderElectionGroup(BackupSync))
+	assert.Equal(t, GarbageCollection, LeaderElectionGroup(GarbageCollection))
+}
+
+func TestLeaderElectionGroupStart(t *testing.T) {
+	client := fake.NewSimpleClientset()
+
+	runnable1 := newFakeRunnable(nil)
+	group1, leading1 := newTestLeaderElectionGroup(client, "replica-1", runnable1)
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	done1 := make(chan error)
+	go func() { done1 <- group1.Start(ctx1) }()
+
+	assertStarted(t, runnable1)
+	assert.Len(t, leading1, 1)
+	assert.True(t, group1.leading.Load())
+
+	// the other replica waits for the lease
+	runnable2 := newFakeRunnable(nil)
+	group2, _ := newTestLeaderElectionGroup(client, "replica-2", runnable2)
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	go func() { _ = group2.Start(ctx2) }()
+
+	assertNotStarted(t, runnable2)
+	assert.False(t, group2.leading.Load())
+
+	// the lease is released when the first replica stops, then the other replica takes over
+	cancel1()
+	require.NoError(t, <-done1)
+	assertStarted(t, runnable2)
+	assert.True(t, group2.leading.Load())
+}
+
+func TestLeaderElectionGroupStartRunnableFails(t *testing.T) {
+	client := fake.NewSimpleClientset()
+
+	runnable1 := newFakeRunnable(nil)
+	runnable2 := newFakeRunnable(errors.New("fake-error"))
+	group, _ := newTestLeaderElectionGroup(client, "replica-1", runnable1, runnable2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := group.Start(ctx)
+	assert.EqualError(t, err, "fake-error")
+	assertStarted(t, runnable1)
+}
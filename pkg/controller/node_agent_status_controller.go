@@ -0,0 +1,174 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/datapath"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const (
+	nodeAgentStatusFrequency = 30 * time.Second
+)
+
+// NodeAgentStatusReconciler reports the data path state of the node-agent into the NodeAgentStatus
+// named after the node the node-agent runs on.
+type NodeAgentStatusReconciler struct {
+	client      client.Client
+	dataPathMgr *datapath.Manager
+	nodeName    string
+	clock       clocks.WithTickerAndDelayedExecution
+	logger      logrus.FieldLogger
+}
+
+// NewNodeAgentStatusReconciler constructs a new NodeAgentStatusReconciler.
+func NewNodeAgentStatusReconciler(client client.Client, dataPathMgr *datapath.Manager, nodeName string, logger logrus.FieldLogger) *NodeAgentStatusReconciler {
+	return &NodeAgentStatusReconciler{
+		client:      client,
+		dataPathMgr: dataPathMgr,
+		nodeName:    nodeName,
+		clock:       clocks.RealClock{},
+		logger:      logger.WithField("node", nodeName),
+	}
+}
+
+// SetupWithManager registers the NodeAgentStatus controller. The status is refreshed periodically
+// instead of watching all the CRs using the data path.
+func (r *NodeAgentStatusReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	s := kube.NewPeriodicalEnqueueSource(r.logger, mgr.GetClient(), &velerov2alpha1api.NodeAgentStatusList{}, nodeAgentStatusFrequency, kube.PeriodicalEnqueueSourceOption{})
+	gp := kube.NewGenericEventPredicate(func(object client.Object) bool {
+		return object.GetName() == r.nodeName
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov2alpha1api.NodeAgentStatus{}, builder.WithPredicates(kube.FalsePredicate{})).
+		Watches(s, nil, builder.WithPredicates(gp)).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=nodeagentstatuses,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=nodeagentstatuses/status,verbs=get;update;patch
+
+func (r *NodeAgentStatusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("nodeagentstatus", req.String())
+
+	if req.Name != r.nodeName {
+		return ctrl.Result{}, nil
+	}
+
+	status := &velerov2alpha1api.NodeAgentStatus{}
+	if err := r.client.Get(ctx, req.NamespacedName, status); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find NodeAgentStatus")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrap(err, "error getting NodeAgentStatus")
+	}
+
+	original := status.DeepCopy()
+	if err := r.updateStatus(ctx, req.Namespace, status); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.client.Patch(ctx, status, client.MergeFrom(original)); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error updating NodeAgentStatus")
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *NodeAgentStatusReconciler) updateStatus(ctx context.Context, namespace string, status *velerov2alpha1api.NodeAgentStatus) error {
+	limit, perNamespaceLimits := r.dataPathMgr.ConcurrentLimits()
+	status.Status.ConcurrentLimit = limit
+	status.Status.PerNamespaceConcurrentLimits = perNamespaceLimits
+	status.Status.RunningDataPaths = r.dataPathMgr.GetRunningJobs()
+
+	dataUploads := &velerov2alpha1api.DataUploadList{}
+	if err := r.client.List(ctx, dataUploads, &client.ListOptions{Namespace: namespace}); err != nil {
+		return errors.Wrap(err, "error listing DataUploads")
+	}
+
+	status.Status.QueuedDataUploads = nil
+	for _, du := range dataUploads.Items {
+		if du.Status.Phase == velerov2alpha1api.DataUploadPhasePrepared && du.Status.Node == r.nodeName {
+			status.Status.QueuedDataUploads = append(status.Status.QueuedDataUploads, du.Name)
+		}
+	}
+
+	dataDownloads := &velerov2alpha1api.DataDownloadList{}
+	if err := r.client.List(ctx, dataDownloads, &client.ListOptions{Namespace: namespace}); err != nil {
+		return errors.Wrap(err, "error listing DataDownloads")
+	}
+
+	status.Status.QueuedDataDownloads = nil
+	for _, dd := range dataDownloads.Items {
+		if dd.Status.Phase == velerov2alpha1api.DataDownloadPhasePrepared && dd.Status.Node == r.nodeName {
+			status.Status.QueuedDataDownloads = append(status.Status.QueuedDataDownloads, dd.Name)
+		}
+	}
+
+	pvbs := &velerov1api.PodVolumeBackupList{}
+	if err := r.client.List(ctx, pvbs, &client.ListOptions{Namespace: namespace}); err != nil {
+		return errors.Wrap(err, "error listing PodVolumeBackups")
+	}
+
+	status.Status.QueuedPodVolumeBackups = nil
+	for _, pvb := range pvbs.Items {
+		if (pvb.Status.Phase == "" || pvb.Status.Phase == velerov1api.PodVolumeBackupPhaseNew) && pvb.Spec.Node == r.nodeName {
+			status.Status.QueuedPodVolumeBackups = append(status.Status.QueuedPodVolumeBackups, pvb.Name)
+		}
+	}
+
+	sort.Strings(status.Status.QueuedDataUploads)
+	sort.Strings(status.Status.QueuedDataDownloads)
+	sort.Strings(status.Status.QueuedPodVolumeBackups)
+	status.Status.LastUpdateTime = &metav1.Time{Time: r.clock.Now()}
+
+	return nil
+}
+
+// EnsureNodeAgentStatus creates the NodeAgentStatus of the node if it doesn't exist, so that the
+// NodeAgentStatus controller could report the data path state into it.
+func EnsureNodeAgentStatus(ctx context.Context, cli client.Client, namespace, nodeName string) error {
+	status := &velerov2alpha1api.NodeAgentStatus{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      nodeName,
+		},
+	}
+
+	if err := cli.Create(ctx, status); err != nil && !apierrors.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "error creating NodeAgentStatus %s/%s", namespace, nodeName)
+	}
+
+	return nil
+}
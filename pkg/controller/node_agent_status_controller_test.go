@@ -0,0 +1,86 @@
+/*
+Copyright 2017 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/datapath"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+)
+
+func TestNodeAgentStatusReconcile(t *testing.T) {
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	objs := []runtime.Object{
+		builder.ForDataUpload(velerov1api.DefaultNamespace, "du-1").Phase(velerov2alpha1api.DataUploadPhasePrepared).Node("node-1").Result(),
+		builder.ForDataUpload(velerov1api.DefaultNamespace, "du-2").Phase(velerov2alpha1api.DataUploadPhasePrepared).Node("node-2").Result(),
+		builder.ForDataUpload(velerov1api.DefaultNamespace, "du-3").Phase(velerov2alpha1api.DataUploadPhaseInProgress).Node("node-1").Result(),
+		builder.ForDataDownload(velerov1api.DefaultNamespace, "dd-1").Phase(velerov2alpha1api.DataDownloadPhasePrepared).Node("node-1").Result(),
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-2").Node("node-1").Result(),
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").Node("node-1").Phase(velerov1api.PodVolumeBackupPhaseNew).Result(),
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-3").Node("node-1").Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result(),
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-4").Node("node-2").Result(),
+	}
+	cli := velerotest.NewFakeControllerRuntimeClient(t, objs...)
+	require.NoError(t, EnsureNodeAgentStatus(context.Background(), cli, velerov1api.DefaultNamespace, "node-1"))
+	require.NoError(t, EnsureNodeAgentStatus(context.Background(), cli, velerov1api.DefaultNamespace, "node-1"))
+
+	dataPathMgr := datapath.NewManager(2, map[string]int{"ns-1": 1}, uploader.BandwidthLimits{})
+	_, err := dataPathMgr.CreateFileSystemBR("du-3", "test", datapath.TaskTypeBackup, context.Background(), cli, velerov1api.DefaultNamespace, "ns-1", datapath.Callbacks{}, velerotest.NewLogger())
+	require.NoError(t, err)
+	_, err = dataPathMgr.CreateFileSystemBR("pvb-3", "test", datapath.TaskTypeBackup, context.Background(), cli, velerov1api.DefaultNamespace, "ns-2", datapath.Callbacks{}, velerotest.NewLogger())
+	require.NoError(t, err)
+
+	r := NewNodeAgentStatusReconciler(cli, dataPathMgr, "node-1", velerotest.NewLogger())
+	r.clock = testclocks.NewFakeClock(now)
+
+	key := types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "node-1"}
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	status := &velerov2alpha1api.NodeAgentStatus{}
+	require.NoError(t, cli.Get(context.Background(), key, status))
+	require.NotNil(t, status.Status.LastUpdateTime)
+	assert.True(t, now.Equal(status.Status.LastUpdateTime.Time))
+	status.Status.LastUpdateTime = nil
+	assert.Equal(t, velerov2alpha1api.NodeAgentDataPathStatus{
+		ConcurrentLimit:              2,
+		PerNamespaceConcurrentLimits: map[string]int{"ns-1": 1},
+		RunningDataPaths:             []string{"du-3", "pvb-3"},
+		QueuedDataUploads:            []string{"du-1"},
+		QueuedDataDownloads:          []string{"dd-1"},
+		QueuedPodVolumeBackups:       []string{"pvb-1", "pvb-2"},
+	}, status.Status)
+
+	// the status of other nodes is not touched
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "node-2"}})
+	require.NoError(t, err)
+}
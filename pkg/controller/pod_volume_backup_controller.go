@@ -28,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	clocks "k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,6 +36,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	veleroapishared "github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/backupwindow"
 	"github.com/vmware-tanzu/velero/pkg/datapath"
 	"github.com/vmware-tanzu/velero/pkg/exposer"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
@@ -42,39 +44,53 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/repository"
 	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
-const pVBRRequestor string = "pod-volume-backup-restore"
+const (
+	pVBRRequestor string = "pod-volume-backup-restore"
+
+	// pvbHostingPodAnnotation records the pod created to mount the volume of a terminated pod
+	// or the PVC not mounted by any pod
+	pvbHostingPodAnnotation = "velero.io/hosting-pod"
+
+	// pvcHostingVolume is the name of the volume in the hosting pod for the PVC not mounted by any pod
+	pvcHostingVolume = "pvc"
+)
 
 // NewPodVolumeBackupReconciler creates the PodVolumeBackupReconciler instance
-func NewPodVolumeBackupReconciler(client client.Client, dataPathMgr *datapath.Manager, ensurer *repository.Ensurer, credentialGetter *credentials.CredentialGetter,
-	nodeName string, scheme *runtime.Scheme, metrics *metrics.ServerMetrics, logger logrus.FieldLogger) *PodVolumeBackupReconciler {
+func NewPodVolumeBackupReconciler(client client.Client, kubeClient kubernetes.Interface, dataPathMgr *datapath.Manager, ensurer *repository.Ensurer, credentialGetter *credentials.CredentialGetter,
+	nodeName string, backupWindowConfigMap string, scheme *runtime.Scheme, metrics *metrics.ServerMetrics, logger logrus.FieldLogger) *PodVolumeBackupReconciler {
 	return &PodVolumeBackupReconciler{
-		Client:            client,
-		logger:            logger.WithField("controller", "PodVolumeBackup"),
-		repositoryEnsurer: ensurer,
-		credentialGetter:  credentialGetter,
-		nodeName:          nodeName,
-		fileSystem:        filesystem.NewFileSystem(),
-		clock:             &clocks.RealClock{},
-		scheme:            scheme,
-		metrics:           metrics,
-		dataPathMgr:       dataPathMgr,
+		Client:                client,
+		kubeClient:            kubeClient,
+		logger:                logger.WithField("controller", "PodVolumeBackup"),
+		repositoryEnsurer:     ensurer,
+		credentialGetter:      credentialGetter,
+		nodeName:              nodeName,
+		backupWindowConfigMap: backupWindowConfigMap,
+		fileSystem:            filesystem.NewFileSystem(),
+		clock:                 &clocks.RealClock{},
+		scheme:                scheme,
+		metrics:               metrics,
+		dataPathMgr:           dataPathMgr,
 	}
 }
 
 // PodVolumeBackupReconciler reconciles a PodVolumeBackup object
 type PodVolumeBackupReconciler struct {
 	client.Client
-	scheme            *runtime.Scheme
-	clock             clocks.WithTickerAndDelayedExecution
-	metrics           *metrics.ServerMetrics
-	credentialGetter  *credentials.CredentialGetter
-	repositoryEnsurer *repository.Ensurer
-	nodeName          string
-	fileSystem        filesystem.Interface
-	logger            logrus.FieldLogger
-	dataPathMgr       *datapath.Manager
+	kubeClient            kubernetes.Interface
+	scheme                *runtime.Scheme
+	clock                 clocks.WithTickerAndDelayedExecution
+	metrics               *metrics.ServerMetrics
+	credentialGetter      *credentials.CredentialGetter
+	repositoryEnsurer     *repository.Ensurer
+	nodeName              string
+	backupWindowConfigMap string
+	fileSystem            filesystem.Interface
+	logger                logrus.FieldLogger
+	dataPathMgr           *datapath.Manager
 }
 
 // +kubebuilder:rbac:groups=velero.io,resources=podvolumebackups,verbs=get;list;watch;create;update;patch;delete
@@ -114,6 +130,57 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, nil
 	}
 
+	window, err := backupwindow.Get(ctx, r.Client, pvb.Namespace, r.backupWindowConfigMap)
+	if err != nil {
+		log.WithError(err).Warn("Failed to get the backup window, the PodVolumeBackup isn't queued for it")
+	} else if interval := window.QueueInterval(r.clock.Now()); interval > 0 {
+		log.Debugf("PodVolumeBackup is queued until the backup window %s opens", window)
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+
+	var pod corev1.Pod
+	hostingPod := &pod
+	volumeName := pvb.Spec.Volume
+	if pvb.Spec.Pod.Kind == podvolume.PVCSourceKind {
+		// The PVC isn't mounted by any pod, so it's mounted by a hosting pod on the node and the data
+		// is backed up from the host path of the hosting pod.
+		var err error
+		volumeName = pvcHostingVolume
+		hostingPod, err = exposer.EnsurePVCHostingPod(ctx, r.kubeClient, pvb.Spec.Pod.Namespace, pvb.Spec.Pod.Name, volumeName, pvb.Name, pvb.Namespace, r.nodeName, log)
+		if err != nil {
+			return r.errorOut(ctx, &pvb, err, "error creating hosting pod for PVC", log)
+		}
+	} else {
+		podNamespacedName := client.ObjectKey{
+			Namespace: pvb.Spec.Pod.Namespace,
+			Name:      pvb.Spec.Pod.Name,
+		}
+		if err := r.Client.Get(ctx, podNamespacedName, &pod); err != nil {
+			return r.errorOut(ctx, &pvb, err, fmt.Sprintf("getting pod %s/%s", pvb.Spec.Pod.Namespace, pvb.Spec.Pod.Name), log)
+		}
+
+		// The volumes of a terminated pod are unmounted from the node, so the PVC is mounted by a
+		// hosting pod on the node and the data is backed up from the host path of the hosting pod.
+		if kube.IsPodTerminated(&pod) {
+			var err error
+			hostingPod, err = exposer.EnsureHostingPod(ctx, r.kubeClient, &pod, pvb.Spec.Volume, pvb.Name, pvb.Namespace, r.nodeName, log)
+			if err != nil {
+				return r.errorOut(ctx, &pvb, err, "error creating hosting pod for volume of terminated pod", log)
+			}
+		}
+	}
+
+	if hostingPod != &pod {
+		if kube.IsPodTerminated(hostingPod) {
+			exposer.DeleteHostingPod(ctx, r.kubeClient, hostingPod.Namespace, hostingPod.Name, log)
+			return r.errorOut(ctx, &pvb, errors.Errorf("hosting pod %s/%s is in phase %s", hostingPod.Namespace, hostingPod.Name, hostingPod.Status.Phase), "error mounting volume", log)
+		}
+		if err := kube.IsPodRunning(hostingPod); err != nil {
+			log.WithField("hosting pod", hostingPod.Name).Debug("Hosting pod is not running yet")
+			return ctrl.Result{Requeue: true, RequeueAfter: 5 * time.Second}, nil
+		}
+	}
+
 	log.Info("PodVolumeBackup starting")
 
 	callbacks := datapath.Callbacks{
@@ -123,7 +190,7 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		OnProgress:  r.OnDataPathProgress,
 	}
 
-	fsBackup, err := r.dataPathMgr.CreateFileSystemBR(pvb.Name, pVBRRequestor, ctx, r.Client, pvb.Namespace, callbacks, log)
+	fsBackup, err := r.dataPathMgr.CreateFileSystemBR(pvb.Name, pVBRRequestor, datapath.TaskTypeBackup, ctx, r.Client, pvb.Namespace, pvb.Spec.Pod.Namespace, callbacks, log)
 	if err != nil {
 		if err == datapath.ConcurrentLimitExceed {
 			return ctrl.Result{Requeue: true, RequeueAfter: time.Minute}, nil
@@ -138,20 +205,17 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	original := pvb.DeepCopy()
 	pvb.Status.Phase = velerov1api.PodVolumeBackupPhaseInProgress
 	pvb.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+	if hostingPod != &pod {
+		if pvb.Annotations == nil {
+			pvb.Annotations = map[string]string{}
+		}
+		pvb.Annotations[pvbHostingPodAnnotation] = hostingPod.Name
+	}
 	if err := r.Client.Patch(ctx, &pvb, client.MergeFrom(original)); err != nil {
 		return r.errorOut(ctx, &pvb, err, "error updating PodVolumeBackup status", log)
 	}
 
-	var pod corev1.Pod
-	podNamespacedName := client.ObjectKey{
-		Namespace: pvb.Spec.Pod.Namespace,
-		Name:      pvb.Spec.Pod.Name,
-	}
-	if err := r.Client.Get(ctx, podNamespacedName, &pod); err != nil {
-		return r.errorOut(ctx, &pvb, err, fmt.Sprintf("getting pod %s/%s", pvb.Spec.Pod.Namespace, pvb.Spec.Pod.Name), log)
-	}
-
-	path, err := exposer.GetPodVolumeHostPath(ctx, &pod, pvb.Spec.Volume, r.Client, r.fileSystem, log)
+	path, err := exposer.GetPodVolumeHostPath(ctx, hostingPod, volumeName, r.Client, r.fileSystem, log)
 	if err != nil {
 		return r.errorOut(ctx, &pvb, err, "error exposing host path for pod volume", log)
 	}
@@ -168,8 +232,13 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	// if the pod using the PVC (and therefore the directory path under /host_pods/) has
 	// changed since the PVC's last backup, for backup, it will not be able to identify a suitable
 	// parent snapshot to use, and will have to do a full rescan of the contents of the PVC.
+	// The incremental base specified explicitly takes precedence over the most recent
+	// completed pod volume backup.
 	var parentSnapshotID string
-	if pvcUID, ok := pvb.Labels[velerov1api.PVCUIDLabel]; ok {
+	if pvb.Spec.IncrementalBase != "" {
+		parentSnapshotID = pvb.Spec.IncrementalBase
+		log.WithField("parentSnapshotID", parentSnapshotID).Info("Based on the specified incremental base for this backup")
+	} else if pvcUID, ok := pvb.Labels[velerov1api.PVCUIDLabel]; ok {
 		parentSnapshotID = r.getParentSnapshot(ctx, log, pvcUID, &pvb)
 		if parentSnapshotID == "" {
 			log.Info("No parent snapshot found for PVC, not based on parent snapshot for this backup")
@@ -205,6 +274,10 @@ func (r *PodVolumeBackupReconciler) OnDataPathCompleted(ctx context.Context, nam
 	pvb.Status.Path = result.Backup.Source.ByPath
 	pvb.Status.Phase = velerov1api.PodVolumeBackupPhaseCompleted
 	pvb.Status.SnapshotID = result.Backup.SnapshotID
+	pvb.Status.UploadStats = veleroapishared.UploadStats{
+		LogicalBytes:  result.Backup.Size,
+		UploadedBytes: result.Backup.UploadedBytes,
+	}
 	pvb.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
 	if result.Backup.EmptySnapshot {
 		pvb.Status.Message = "volume was empty so no snapshot was taken"
@@ -214,6 +287,8 @@ func (r *PodVolumeBackupReconciler) OnDataPathCompleted(ctx context.Context, nam
 		log.WithError(err).Error("error updating PodVolumeBackup status")
 	}
 
+	r.deleteHostingPod(ctx, &pvb, log)
+
 	latencyDuration := pvb.Status.CompletionTimestamp.Time.Sub(pvb.Status.StartTimestamp.Time)
 	latencySeconds := float64(latencyDuration / time.Second)
 	backupName := fmt.Sprintf("%s/%s", pvb.Namespace, pvb.OwnerReferences[0].Name)
@@ -346,8 +421,16 @@ func (r *PodVolumeBackupReconciler) closeDataPath(ctx context.Context, pvbName s
 	r.dataPathMgr.RemoveAsyncBR(pvbName)
 }
 
+// deleteHostingPod deletes the pod created to mount the volume of the terminated pod, if any.
+func (r *PodVolumeBackupReconciler) deleteHostingPod(ctx context.Context, pvb *velerov1api.PodVolumeBackup, log logrus.FieldLogger) {
+	if name := pvb.Annotations[pvbHostingPodAnnotation]; name != "" {
+		exposer.DeleteHostingPod(ctx, r.kubeClient, pvb.Spec.Pod.Namespace, name, log)
+	}
+}
+
 func (r *PodVolumeBackupReconciler) errorOut(ctx context.Context, pvb *velerov1api.PodVolumeBackup, err error, msg string, log logrus.FieldLogger) (ctrl.Result, error) {
 	r.closeDataPath(ctx, pvb.Name)
+	r.deleteHostingPod(ctx, pvb, log)
 	_ = UpdatePVBStatusToFailed(ctx, r.Client, pvb, errors.WithMessage(err, msg).Error(), r.clock.Now(), log)
 
 	return ctrl.Result{}, err
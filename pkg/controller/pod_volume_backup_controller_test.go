@@ -43,6 +43,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/repository"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 )
 
 const name = "pvb-1"
@@ -119,6 +120,14 @@ func (b *fakeFSBR) StartRestore(snapshotID string, target datapath.AccessPoint)
 	return nil
 }
 
+func (b *fakeFSBR) StartVerify(snapshotID string, filesPercent int) error {
+	return nil
+}
+
+func (b *fakeFSBR) StartReplicate(snapshotID string, targetBSLName string, repositoryEnsurer *repository.Ensurer, credentialGetter *credentials.CredentialGetter) error {
+	return nil
+}
+
 func (b *fakeFSBR) Cancel() {
 }
 
@@ -176,10 +185,10 @@ var _ = Describe("PodVolumeBackup Reconciler", func() {
 			Expect(err).To(BeNil())
 
 			if test.dataMgr == nil {
-				test.dataMgr = datapath.NewManager(1)
+				test.dataMgr = datapath.NewManager(1, nil, uploader.BandwidthLimits{})
 			}
 
-			datapath.FSBRCreator = func(string, string, kbclient.Client, string, datapath.Callbacks, logrus.FieldLogger) datapath.AsyncBR {
+			datapath.FSBRCreator = func(string, string, kbclient.Client, string, uploader.BandwidthLimits, uploader.CacheOptions, datapath.Callbacks, logrus.FieldLogger) datapath.AsyncBR {
 				return &fakeFSBR{
 					pvb:    test.pvb,
 					client: fakeClient,
@@ -378,7 +387,7 @@ var _ = Describe("PodVolumeBackup Reconciler", func() {
 			pod:               podBuilder().Result(),
 			bsl:               bslBuilder().Result(),
 			backupRepo:        buildBackupRepo(),
-			dataMgr:           datapath.NewManager(0),
+			dataMgr:           datapath.NewManager(0, nil, uploader.BandwidthLimits{}),
 			expectedProcessed: false,
 			expected: builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").
 				Phase("").
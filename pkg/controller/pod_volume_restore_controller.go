@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -38,6 +39,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/internal/hook"
 	veleroapishared "github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/datapath"
@@ -60,6 +62,7 @@ func NewPodVolumeRestoreReconciler(client client.Client, dataPathMgr *datapath.M
 		fileSystem:        filesystem.NewFileSystem(),
 		clock:             &clocks.RealClock{},
 		dataPathMgr:       dataPathMgr,
+		hostHookExecutor:  hook.NewHostHookExecutor(),
 	}
 }
 
@@ -71,6 +74,7 @@ type PodVolumeRestoreReconciler struct {
 	fileSystem        filesystem.Interface
 	clock             clocks.WithTickerAndDelayedExecution
 	dataPathMgr       *datapath.Manager
+	hostHookExecutor  hook.HostHookExecutor
 }
 
 // +kubebuilder:rbac:groups=velero.io,resources=podvolumerestores,verbs=get;list;watch;create;update;patch;delete
@@ -119,7 +123,7 @@ func (c *PodVolumeRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		OnProgress:  c.OnDataPathProgress,
 	}
 
-	fsRestore, err := c.dataPathMgr.CreateFileSystemBR(pvr.Name, pVBRRequestor, ctx, c.Client, pvr.Namespace, callbacks, log)
+	fsRestore, err := c.dataPathMgr.CreateFileSystemBR(pvr.Name, pVBRRequestor, datapath.TaskTypeRestore, ctx, c.Client, pvr.Namespace, pvr.Spec.Pod.Namespace, callbacks, log)
 	if err != nil {
 		if err == datapath.ConcurrentLimitExceed {
 			return ctrl.Result{Requeue: true, RequeueAfter: time.Minute}, nil
@@ -285,6 +289,14 @@ func (c *PodVolumeRestoreReconciler) OnDataPathCompleted(ctx context.Context, na
 		log.WithError(err).Warnf("error removing .velero directory from directory %s", volumePath)
 	}
 
+	// Execute the host hooks before writing the done file, so they complete before
+	// the containers of the pod start.
+	hookMessage, err := c.executeHostHooks(ctx, &pvr, volumePath, log)
+	if err != nil {
+		_, _ = c.errorOut(ctx, &pvr, err, "error executing host hook", log)
+		return
+	}
+
 	var restoreUID types.UID
 	for _, owner := range pvr.OwnerReferences {
 		if boolptr.IsSetToTrue(owner.Controller) {
@@ -310,6 +322,7 @@ func (c *PodVolumeRestoreReconciler) OnDataPathCompleted(ctx context.Context, na
 
 	original := pvr.DeepCopy()
 	pvr.Status.Phase = velerov1api.PodVolumeRestorePhaseCompleted
+	pvr.Status.Message = hookMessage
 	pvr.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
 	if err := c.Patch(ctx, &pvr, client.MergeFrom(original)); err != nil {
 		log.WithError(err).Error("error updating PodVolumeRestore status")
@@ -318,6 +331,29 @@ func (c *PodVolumeRestoreReconciler) OnDataPathCompleted(ctx context.Context, na
 	log.Info("Restore completed")
 }
 
+// executeHostHooks executes the host hooks of the PVR on the restored volume. The error of a hook
+// with the Fail error mode is returned and the following hooks are not executed, the errors of the
+// hooks with the Continue error mode are returned as a message.
+func (c *PodVolumeRestoreReconciler) executeHostHooks(ctx context.Context, pvr *velerov1api.PodVolumeRestore, volumePath string, log logrus.FieldLogger) (string, error) {
+	var failures []string
+	for i := range pvr.Spec.HostHooks {
+		hostHook := &pvr.Spec.HostHooks[i]
+		err := c.hostHookExecutor.ExecuteHostHook(ctx, log, volumePath, hostHook)
+		if err == nil {
+			continue
+		}
+
+		if hostHook.OnError != velerov1api.HookErrorModeContinue {
+			return "", errors.Wrapf(err, "host hook %v failed", hostHook.Command)
+		}
+
+		log.WithError(err).Warnf("Host hook %v failed, continuing", hostHook.Command)
+		failures = append(failures, fmt.Sprintf("host hook %v failed: %v", hostHook.Command, err))
+	}
+
+	return strings.Join(failures, "; "), nil
+}
+
 func (c *PodVolumeRestoreReconciler) OnDataPathFailed(ctx context.Context, namespace string, pvrName string, err error) {
 	defer c.closeDataPath(ctx, pvrName)
 
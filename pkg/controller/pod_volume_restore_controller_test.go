@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -518,3 +519,80 @@ func TestFindVolumeRestoresForPod(t *testing.T) {
 	requests = reconciler.findVolumeRestoresForPod(pod)
 	assert.Len(t, requests, 1)
 }
+
+type fakeHostHookExecutor struct {
+	errs     map[string]error
+	executed []string
+}
+
+func (e *fakeHostHookExecutor) ExecuteHostHook(ctx context.Context, log logrus.FieldLogger, volumePath string, hook *velerov1api.HostRestoreHook) error {
+	e.executed = append(e.executed, hook.Command[0])
+	return e.errs[hook.Command[0]]
+}
+
+func TestExecuteHostHooks(t *testing.T) {
+	tests := []struct {
+		name             string
+		hooks            []velerov1api.HostRestoreHook
+		errs             map[string]error
+		expectedExecuted []string
+		expectedMessage  string
+		expectedErr      string
+	}{
+		{
+			name: "no hooks",
+		},
+		{
+			name: "all hooks succeed",
+			hooks: []velerov1api.HostRestoreHook{
+				{Command: []string{"chown"}},
+				{Command: []string{"restorecon"}},
+			},
+			expectedExecuted: []string{"chown", "restorecon"},
+		},
+		{
+			name: "failed hook with continue error mode",
+			hooks: []velerov1api.HostRestoreHook{
+				{Command: []string{"chown"}, OnError: velerov1api.HookErrorModeContinue},
+				{Command: []string{"restorecon"}},
+			},
+			errs:             map[string]error{"chown": errors.New("exit status 1")},
+			expectedExecuted: []string{"chown", "restorecon"},
+			expectedMessage:  "host hook [chown] failed: exit status 1",
+		},
+		{
+			name: "failed hook with fail error mode",
+			hooks: []velerov1api.HostRestoreHook{
+				{Command: []string{"chown"}},
+				{Command: []string{"restorecon"}},
+			},
+			errs:             map[string]error{"chown": errors.New("exit status 1")},
+			expectedExecuted: []string{"chown"},
+			expectedErr:      "host hook [chown] failed: exit status 1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			executor := &fakeHostHookExecutor{errs: test.errs}
+			reconciler := &PodVolumeRestoreReconciler{
+				logger:           logrus.New(),
+				hostHookExecutor: executor,
+			}
+			pvr := &velerov1api.PodVolumeRestore{
+				Spec: velerov1api.PodVolumeRestoreSpec{
+					HostHooks: test.hooks,
+				},
+			}
+
+			message, err := reconciler.executeHostHooks(context.Background(), pvr, "/host_pods/pod-uid/volumes/volume", reconciler.logger)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedMessage, message)
+			assert.Equal(t, test.expectedExecuted, executor.executed)
+		})
+	}
+}
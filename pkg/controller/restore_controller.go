@@ -31,11 +31,13 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	corev1api "k8s.io/api/core/v1"
+	storagev1api "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -45,13 +47,18 @@ import (
 	"github.com/vmware-tanzu/velero/internal/hook"
 	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/audit"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/notification"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
@@ -91,6 +98,10 @@ var nonRestorableResources = []string{
 
 var ExternalResourcesFinalizer = "restores.velero.io/external-resources-finalizer"
 
+// backupRetrievalCheckPeriod is how often the retrieval of the backup contents from an archive
+// storage class is checked, the retrieval usually takes hours.
+const backupRetrievalCheckPeriod = 5 * time.Minute
+
 type restoreReconciler struct {
 	ctx                         context.Context
 	namespace                   string
@@ -103,9 +114,14 @@ type restoreReconciler struct {
 	clock                       clock.WithTickerAndDelayedExecution
 	defaultItemOperationTimeout time.Duration
 	disableInformerCache        bool
+	// streamingBufferSize is the size of the read-ahead buffer used to stream the backup
+	// contents into the restore, the backup contents are downloaded to a temp file if it's 0.
+	streamingBufferSize int
 
 	newPluginManager  func(logger logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter persistence.ObjectBackupStoreGetter
+	notifier          *notification.Dispatcher
+	auditRecorder     *audit.Recorder
 }
 
 type backupInfo struct {
@@ -126,6 +142,9 @@ func NewRestoreReconciler(
 	logFormat logging.Format,
 	defaultItemOperationTimeout time.Duration,
 	disableInformerCache bool,
+	streamingBufferSize int,
+	notifier *notification.Dispatcher,
+	auditRecorder *audit.Recorder,
 ) *restoreReconciler {
 	r := &restoreReconciler{
 		ctx:                         ctx,
@@ -139,11 +158,14 @@ func NewRestoreReconciler(
 		clock:                       &clock.RealClock{},
 		defaultItemOperationTimeout: defaultItemOperationTimeout,
 		disableInformerCache:        disableInformerCache,
+		streamingBufferSize:         streamingBufferSize,
 
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
 		newPluginManager:  newPluginManager,
 		backupStoreGetter: backupStoreGetter,
+		notifier:          notifier,
+		auditRecorder:     auditRecorder,
 	}
 
 	// Move the periodical backup and restore metrics computing logic from controllers to here.
@@ -207,8 +229,8 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	}
 
 	switch restore.Status.Phase {
-	case "", api.RestorePhaseNew:
-		// only process new restores
+	case "", api.RestorePhaseNew, api.RestorePhaseWaitingForBackupRetrieval:
+		// only process new restores and the restores waiting for the backup retrieval
 	default:
 		r.logger.WithFields(logrus.Fields{
 			"restore": kubeutil.NamespaceAndName(restore),
@@ -221,7 +243,22 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	original := restore.DeepCopy()
 
 	// Validate the restore and fetch the backup
-	info, resourceModifiers := r.validateAndComplete(restore)
+	info, resourceModifiers, resourcePriorities := r.validateAndComplete(restore)
+
+	// the backup contents transitioned to an archive storage class are retrieved before the restore runs
+	if len(restore.Status.ValidationErrors) == 0 && info.backup.Status.StorageClass != "" {
+		retrieved, err := r.retrieveBackup(restore, info, log)
+		if err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors,
+				fmt.Sprintf("Error retrieving the backup contents from storage class %s: %v", info.backup.Status.StorageClass, err))
+		} else if !retrieved {
+			restore.Status.Phase = api.RestorePhaseWaitingForBackupRetrieval
+			if err := kubeutil.PatchResource(original, restore, r.kbClient); err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "error updating Restore phase to %s", restore.Status.Phase)
+			}
+			return ctrl.Result{RequeueAfter: backupRetrievalCheckPeriod}, nil
+		}
+	}
 
 	// Register attempts after validation so we don't have to fetch the backup multiple times
 	backupScheduleName := restore.Spec.ScheduleName
@@ -251,11 +288,19 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	// store ref to just-updated item for creating patch
 	original = restore.DeepCopy()
 
+	// the restores failing the validation are recorded into the default backup storage location
+	auditLocation := ""
+	if info.location != nil {
+		auditLocation = info.location.Name
+	}
+
 	if restore.Status.Phase == api.RestorePhaseFailedValidation {
+		r.notifier.Notify(notification.ForRestore(notification.EventRestoreFailed, restore))
+		r.auditRecorder.Record(restore.Namespace, auditLocation, audit.ForRestore(restore))
 		return ctrl.Result{}, nil
 	}
 
-	if err := r.runValidatedRestore(restore, info, resourceModifiers); err != nil {
+	if err := r.runValidatedRestore(restore, info, resourceModifiers, resourcePriorities); err != nil {
 		log.WithError(err).Debug("Restore failed")
 		restore.Status.Phase = api.RestorePhaseFailed
 		restore.Status.FailureReason = err.Error()
@@ -265,7 +310,8 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	// mark completion if in terminal phase
 	if restore.Status.Phase == api.RestorePhaseFailed ||
 		restore.Status.Phase == api.RestorePhasePartiallyFailed ||
-		restore.Status.Phase == api.RestorePhaseCompleted {
+		restore.Status.Phase == api.RestorePhaseCompleted ||
+		restore.Status.Phase == api.RestorePhaseDryRunCompleted {
 		restore.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
 	}
 	log.Debug("Updating restore's final status")
@@ -275,6 +321,11 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		// No need to re-enqueue here, because restore's already set to InProgress before.
 		// Controller only handle New restore.
 	}
+	// the restores waiting for the async operations are notified by the restore operations controller
+	if eventType, ok := notification.RestoreEventType(restore.Status.Phase); ok {
+		r.notifier.Notify(notification.ForRestore(eventType, restore))
+	}
+	r.auditRecorder.Record(restore.Namespace, auditLocation, audit.ForRestore(restore))
 
 	return ctrl.Result{}, nil
 }
@@ -282,10 +333,11 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 func (r *restoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&api.Restore{}).
+		WithOptions(controllerOptions(Restore)).
 		Complete(r)
 }
 
-func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInfo, *resourcemodifiers.ResourceModifiers) {
+func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInfo, *resourcemodifiers.ResourceModifiers, *pkgrestore.Priorities) {
 	// add non-restorable resources to restore's excluded resources
 	excludedResources := sets.NewString(restore.Spec.ExcludedResources...)
 	for _, nonrestorable := range nonRestorableResources {
@@ -295,7 +347,11 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 	}
 
 	// validate that included resources don't contain any non-restorable resources
-	includedResources := sets.NewString(restore.Spec.IncludedResources...)
+	includedResources := sets.NewString()
+	for _, item := range restore.Spec.IncludedResources {
+		resource, _ := pkgrestore.ParseResourceNamePattern(item)
+		includedResources.Insert(resource)
+	}
 	for _, nonRestorableResource := range nonRestorableResources {
 		if includedResources.Has(nonRestorableResource) {
 			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("%v are non-restorable resources", nonRestorableResource))
@@ -307,6 +363,11 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded resource lists: %v", err))
 	}
 
+	// validate the name globs of included/excluded resources
+	for _, err := range pkgrestore.ValidateResourceNamePatterns(restore.Spec.IncludedResources, restore.Spec.ExcludedResources) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded resource lists: %v", err))
+	}
+
 	// validate included/excluded namespaces
 	for _, err := range collections.ValidateIncludesExcludes(restore.Spec.IncludedNamespaces, restore.Spec.ExcludedNamespaces) {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
@@ -317,10 +378,28 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "encountered labelSelector as well as orLabelSelectors in restore spec, only one can be specified")
 	}
 
+	// validate the storage class mappings and zone mappings
+	restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, r.validateTopologyMappings(restore)...)
+
+	// validate the scaling of the restored workloads
+	restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, validateScaling(restore)...)
+
+	// validate the readiness gates of the restored items
+	restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, validateReadinessGates(restore)...)
+
+	// validate the size overrides of the restored PVCs
+	restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, validatePVCSizeOverrides(restore)...)
+
+	// validate the pre-restore and post-restore job hooks
+	restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, validateJobHooks(restore)...)
+
+	// validate the retry policy of the restored items
+	restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, validateItemRetryPolicy(restore)...)
+
 	// validate that exactly one of BackupName and ScheduleName have been specified
 	if !backupXorScheduleProvided(restore) {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "Either a backup or schedule must be specified as a source for the restore, but not both")
-		return backupInfo{}, nil
+		return backupInfo{}, nil, nil
 	}
 
 	// validate Restore Init Hook's InitContainers
@@ -349,9 +428,9 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 		}))
 
 		backupList := &api.BackupList{}
-		if err := r.kbClient.List(context.Background(), backupList, &client.ListOptions{LabelSelector: selector}); err != nil {
+		if err := r.kbClient.List(context.Background(), backupList, &client.ListOptions{Namespace: r.namespace, LabelSelector: selector}); err != nil {
 			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "Unable to list backups for schedule")
-			return backupInfo{}, nil
+			return backupInfo{}, nil, nil
 		}
 		if len(backupList.Items) == 0 {
 			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "No backups found for schedule")
@@ -361,14 +440,14 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 			restore.Spec.BackupName = backup.Name
 		} else {
 			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "No completed backups found for schedule")
-			return backupInfo{}, nil
+			return backupInfo{}, nil, nil
 		}
 	}
 
 	info, err := r.fetchBackupInfo(restore.Spec.BackupName)
 	if err != nil {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Error retrieving backup: %v", err))
-		return backupInfo{}, nil
+		return backupInfo{}, nil, nil
 	}
 
 	// Fill in the ScheduleName so it's easier to consume for metrics.
@@ -382,20 +461,176 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 		err := r.kbClient.Get(context.Background(), client.ObjectKey{Namespace: restore.Namespace, Name: restore.Spec.ResourceModifier.Name}, ResourceModifierConfigMap)
 		if err != nil {
 			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("failed to get resource modifiers configmap %s/%s", restore.Namespace, restore.Spec.ResourceModifier.Name))
-			return backupInfo{}, nil
+			return backupInfo{}, nil, nil
 		}
 		resourceModifiers, err = resourcemodifiers.GetResourceModifiersFromConfig(ResourceModifierConfigMap)
 		if err != nil {
 			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, errors.Wrapf(err, fmt.Sprintf("Error in parsing resource modifiers provided in configmap %s/%s", restore.Namespace, restore.Spec.ResourceModifier.Name)).Error())
-			return backupInfo{}, nil
+			return backupInfo{}, nil, nil
 		} else if err = resourceModifiers.Validate(); err != nil {
 			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, errors.Wrapf(err, fmt.Sprintf("Validation error in resource modifiers provided in configmap %s/%s", restore.Namespace, restore.Spec.ResourceModifier.Name)).Error())
-			return backupInfo{}, nil
+			return backupInfo{}, nil, nil
 		}
 		r.logger.Infof("Retrieved Resource modifiers provided in configmap %s/%s", restore.Namespace, restore.Spec.ResourceModifier.Name)
 	}
 
-	return info, resourceModifiers
+	var resourcePriorities *pkgrestore.Priorities
+	if restore.Spec.ResourcePriorities != nil && strings.EqualFold(restore.Spec.ResourcePriorities.Kind, resourcemodifiers.ConfigmapRefType) {
+		resourcePrioritiesConfigMap := &corev1api.ConfigMap{}
+		err := r.kbClient.Get(context.Background(), client.ObjectKey{Namespace: restore.Namespace, Name: restore.Spec.ResourcePriorities.Name}, resourcePrioritiesConfigMap)
+		if err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("failed to get resource priorities configmap %s/%s", restore.Namespace, restore.Spec.ResourcePriorities.Name))
+			return backupInfo{}, nil, nil
+		}
+		resourcePriorities, err = pkgrestore.GetPrioritiesFromConfigMap(resourcePrioritiesConfigMap)
+		if err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, errors.Wrapf(err, "Error in parsing resource priorities provided in configmap %s/%s", restore.Namespace, restore.Spec.ResourcePriorities.Name).Error())
+			return backupInfo{}, nil, nil
+		}
+		r.logger.Infof("Retrieved resource priorities provided in configmap %s/%s", restore.Namespace, restore.Spec.ResourcePriorities.Name)
+	}
+
+	return info, resourceModifiers, resourcePriorities
+}
+
+// validateTopologyMappings validates the restore's storage class mappings and zone mappings,
+// the target storage classes must exist in the cluster.
+func (r *restoreReconciler) validateTopologyMappings(restore *api.Restore) []string {
+	var validationErrors []string
+
+	for _, source := range sets.StringKeySet(restore.Spec.StorageClassMappings).List() {
+		target := restore.Spec.StorageClassMappings[source]
+		if source == "" || target == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf("Invalid storage class mapping %q:%q, the storage class names must not be empty", source, target))
+			continue
+		}
+
+		if err := r.kbClient.Get(context.Background(), client.ObjectKey{Name: target}, &storagev1api.StorageClass{}); err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("Error getting storage class %s mapped from storage class %s: %v", target, source, err))
+		}
+	}
+
+	for _, source := range sets.StringKeySet(restore.Spec.ZoneMappings).List() {
+		if target := restore.Spec.ZoneMappings[source]; source == "" || target == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf("Invalid zone mapping %q:%q, the zone names must not be empty", source, target))
+		}
+	}
+
+	return validationErrors
+}
+
+// validateScaling validates the restore's scaling, the replicas must not be negative
+// and only the scalable workload resources can be included.
+func validateScaling(restore *api.Restore) []string {
+	scaling := restore.Spec.Scaling
+	if scaling == nil {
+		return nil
+	}
+
+	var validationErrors []string
+	if scaling.Replicas < 0 {
+		validationErrors = append(validationErrors, fmt.Sprintf("Invalid scaling replicas %d, the replicas must not be negative", scaling.Replicas))
+	}
+	for _, resource := range scaling.IncludedResources {
+		if !pkgrestore.IsScalableResource(resource) {
+			validationErrors = append(validationErrors, fmt.Sprintf("Invalid scaling resource %s, only deployments and statefulsets can be scaled", resource))
+		}
+	}
+
+	return validationErrors
+}
+
+// validateReadinessGates validates the restore's readiness gates, only the resources whose
+// readiness can be checked can be included and the timeout must not be negative.
+func validateReadinessGates(restore *api.Restore) []string {
+	gates := restore.Spec.ReadinessGates
+	if gates == nil {
+		return nil
+	}
+
+	var validationErrors []string
+	for _, resource := range gates.IncludedResources {
+		if !pkgrestore.IsReadinessGatedResource(resource) {
+			validationErrors = append(validationErrors, fmt.Sprintf("Invalid readiness gate resource %s, only customresourcedefinitions, namespaces, mutatingwebhookconfigurations and validatingwebhookconfigurations can be waited for", resource))
+		}
+	}
+	if gates.Timeout.Duration < 0 {
+		validationErrors = append(validationErrors, fmt.Sprintf("Invalid readiness gate timeout %s, the timeout must not be negative", gates.Timeout.Duration))
+	}
+
+	return validationErrors
+}
+
+// validatePVCSizeOverrides validates the restore's PVC size overrides, the growth percentage
+// must not be negative and the sizes must be positive sizes of PVCs named as "<namespace>/<name>".
+func validatePVCSizeOverrides(restore *api.Restore) []string {
+	overrides := restore.Spec.PVCSizeOverrides
+	if overrides == nil {
+		return nil
+	}
+
+	var validationErrors []string
+	if overrides.GrowthPercentage < 0 {
+		validationErrors = append(validationErrors, fmt.Sprintf("Invalid PVC size growth percentage %d, the percentage must not be negative", overrides.GrowthPercentage))
+	}
+	for _, pvc := range sets.StringKeySet(overrides.Sizes).List() {
+		if namespace, name, ok := strings.Cut(pvc, "/"); !ok || namespace == "" || name == "" || strings.Contains(name, "/") {
+			validationErrors = append(validationErrors, fmt.Sprintf("Invalid PVC %q of size override, the PVC must be in the format <namespace>/<name>", pvc))
+		}
+		if size := overrides.Sizes[pvc]; size.Sign() <= 0 {
+			validationErrors = append(validationErrors, fmt.Sprintf("Invalid size %s of PVC %s, the size must be positive", size.String(), pvc))
+		}
+	}
+
+	return validationErrors
+}
+
+// validateJobHooks validates the restore's job hooks, the hooks must have unique names which are
+// valid DNS labels, and run their commands in the images in the namespaces.
+func validateJobHooks(restore *api.Restore) []string {
+	var validationErrors []string
+	names := sets.NewString()
+	for _, jobHook := range restore.Spec.Hooks.Jobs {
+		if errs := validation.IsDNS1123Label(jobHook.Name); len(errs) > 0 {
+			validationErrors = append(validationErrors, fmt.Sprintf("Invalid job hook name %q: %s", jobHook.Name, strings.Join(errs, "; ")))
+		} else if names.Has(jobHook.Name) {
+			validationErrors = append(validationErrors, fmt.Sprintf("Duplicate job hook name %q", jobHook.Name))
+		}
+		names.Insert(jobHook.Name)
+
+		if jobHook.Phase != api.RestoreJobHookPhasePre && jobHook.Phase != api.RestoreJobHookPhasePost {
+			validationErrors = append(validationErrors, fmt.Sprintf("Invalid phase %q of job hook %s, the phase must be Pre or Post", jobHook.Phase, jobHook.Name))
+		}
+		if jobHook.Namespace == "" || jobHook.Image == "" || len(jobHook.Command) == 0 {
+			validationErrors = append(validationErrors, fmt.Sprintf("Invalid job hook %s, the namespace, image and command must be specified", jobHook.Name))
+		}
+		if jobHook.Timeout.Duration < 0 {
+			validationErrors = append(validationErrors, fmt.Sprintf("Invalid timeout %s of job hook %s, the timeout must not be negative", jobHook.Timeout.Duration, jobHook.Name))
+		}
+	}
+
+	return validationErrors
+}
+
+// validateItemRetryPolicy validates the restore's item retry policy, the retry budget and the
+// backoffs must not be negative and the initial backoff must not exceed the maximum backoff.
+func validateItemRetryPolicy(restore *api.Restore) []string {
+	policy := restore.Spec.ItemRetryPolicy
+	if policy == nil {
+		return nil
+	}
+
+	var validationErrors []string
+	if policy.MaxRetries < 0 {
+		validationErrors = append(validationErrors, fmt.Sprintf("Invalid item max retries %d, the retries must not be negative", policy.MaxRetries))
+	}
+	if policy.InitialBackoff.Duration < 0 || policy.MaxBackoff.Duration < 0 {
+		validationErrors = append(validationErrors, fmt.Sprintf("Invalid item retry backoff %s/%s, the backoffs must not be negative", policy.InitialBackoff.Duration, policy.MaxBackoff.Duration))
+	} else if policy.MaxBackoff.Duration > 0 && policy.InitialBackoff.Duration > policy.MaxBackoff.Duration {
+		validationErrors = append(validationErrors, fmt.Sprintf("Invalid item retry backoff %s/%s, the initial backoff must not exceed the max backoff", policy.InitialBackoff.Duration, policy.MaxBackoff.Duration))
+	}
+
+	return validationErrors
 }
 
 // backupXorScheduleProvided returns true if exactly one of BackupName and
@@ -437,6 +672,41 @@ func mostRecentCompletedBackup(backups []api.Backup) api.Backup {
 	return api.Backup{}
 }
 
+// retrieveBackup starts the retrieval of the backup contents transitioned to an archive storage
+// class and records it in the status of the restore, it returns whether the contents are readable.
+func (r *restoreReconciler) retrieveBackup(restore *api.Restore, info backupInfo, log logrus.FieldLogger) (bool, error) {
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(info.location, pluginManager, log)
+	if err != nil {
+		return false, err
+	}
+
+	retrieved, err := backupStore.RetrieveBackup(info.backup.Name)
+	if err != nil {
+		return false, err
+	}
+
+	if restore.Status.BackupRetrieval == nil {
+		if retrieved {
+			// the storage class doesn't require the retrieval
+			return true, nil
+		}
+
+		log.Infof("Retrieving the backup contents from storage class %s", info.backup.Status.StorageClass)
+		restore.Status.BackupRetrieval = &api.BackupRetrievalStatus{
+			StorageClass:   info.backup.Status.StorageClass,
+			StartTimestamp: &metav1.Time{Time: r.clock.Now()},
+		}
+	}
+	if retrieved && restore.Status.BackupRetrieval.CompletionTimestamp == nil {
+		restore.Status.BackupRetrieval.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	}
+
+	return retrieved, nil
+}
+
 // fetchBackupInfo checks the backup lister for a backup that matches the given name. If it doesn't
 // find it, it returns an error.
 func (r *restoreReconciler) fetchBackupInfo(backupName string) (backupInfo, error) {
@@ -468,7 +738,7 @@ func fetchBackupInfoInternal(kbClient client.Client, namespace, backupName strin
 // The log and results files are uploaded to backup storage. Any error returned from this function
 // means that the restore failed. This function updates the restore API object with warning and error
 // counts, but *does not* update its phase or patch it via the API.
-func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backupInfo, resourceModifiers *resourcemodifiers.ResourceModifiers) error {
+func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backupInfo, resourceModifiers *resourcemodifiers.ResourceModifiers, resourcePriorities *pkgrestore.Priorities) error {
 	// instantiate the per-restore logger that will output both to a temp file
 	// (for upload to object storage) and to stdout.
 	restoreLog, err := logging.NewTempFileLogger(r.restoreLogLevel, r.logFormat, nil, logrus.Fields{"restore": kubeutil.NamespaceAndName(restore)})
@@ -491,11 +761,22 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 	}
 	actionsResolver := framework.NewRestoreItemActionResolverV2(actions)
 
-	backupFile, err := downloadToTempFile(restore.Spec.BackupName, backupStore, restoreLog)
+	// the v3 restore item actions finalize the items they were executed on once the restore has
+	// created all the items
+	finalizeActions, err := pluginManager.GetRestoreItemActionsV3()
+	if err != nil {
+		return errors.Wrap(err, "error getting v3 restore item actions")
+	}
+	finalizeActionNames := sets.NewString()
+	for _, action := range finalizeActions {
+		finalizeActionNames.Insert(action.Name())
+	}
+
+	backupContents, err := r.openBackupContents(restore.Spec.BackupName, backupStore, restoreLog)
 	if err != nil {
 		return errors.Wrap(err, "error downloading backup")
 	}
-	defer closeAndRemoveFile(backupFile, r.logger)
+	defer backupContents.Close()
 
 	listOpts := &client.ListOptions{
 		LabelSelector: labels.Set(map[string]string{
@@ -520,6 +801,30 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 		return errors.Wrap(err, "fail to fetch CSI VolumeSnapshots metadata")
 	}
 
+	checksums, err := backupStore.GetBackupChecksums(restore.Spec.BackupName)
+	if err != nil {
+		return errors.Wrap(err, "error fetching backup checksums")
+	}
+	if checksums == nil {
+		if !pkgbackup.ChecksumsRecorded(info.backup.Status.FormatVersion) {
+			restoreLog.Warn("The backup doesn't have checksums, the backup contents won't be verified")
+		} else if restore.Annotations[api.IgnoreChecksumMismatchAnnotation] == "true" {
+			restoreLog.Warnf("The checksums of the backup with format version %s are missing, the backup contents won't be verified", info.backup.Status.FormatVersion)
+		} else {
+			return errors.Errorf("the checksums of the backup with format version %s are missing, the backup contents may be tampered with", info.backup.Status.FormatVersion)
+		}
+	}
+
+	var referencedBackups []pkgrestore.ReferencedBackup
+	if boolptr.IsSetToTrue(info.backup.Spec.DeduplicateClusterResources) {
+		var closeReferencedBackups func()
+		referencedBackups, closeReferencedBackups, err = r.openReferencedBackups(restore.Spec.BackupName, backupStore, restoreLog)
+		if err != nil {
+			return errors.Wrap(err, "error fetching the deduplicated cluster-scoped items of the backup")
+		}
+		defer closeReferencedBackups()
+	}
+
 	restoreLog.Info("starting restore")
 
 	var podVolumeBackups []*api.PodVolumeBackup
@@ -533,10 +838,14 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 		Backup:               info.backup,
 		PodVolumeBackups:     podVolumeBackups,
 		VolumeSnapshots:      volumeSnapshots,
-		BackupReader:         backupFile,
+		BackupReader:         backupContents,
 		ResourceModifiers:    resourceModifiers,
+		ResourcePriorities:   resourcePriorities,
 		DisableInformerCache: r.disableInformerCache,
 		CSIVolumeSnapshots:   csiVolumeSnapshots,
+		Checksums:            checksums,
+		ReferencedBackups:    referencedBackups,
+		FinalizeActions:      finalizeActionNames,
 	}
 	restoreWarnings, restoreErrors := r.restorer.RestoreWithResolvers(restoreReq, actionsResolver, pluginManager)
 
@@ -544,10 +853,18 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 	// Any errors on operations at this point should be added to restore errors.
 	// If any operations are still not complete, then restore will not be set to
 	// Completed yet.
-	inProgressOperations, _, opsCompleted, opsFailed, errs := getRestoreItemOperationProgress(restoreReq.Restore, pluginManager, *restoreReq.GetItemOperationsList())
+	inProgressOperations, _, opsCompleted, opsFailed, errs := getRestoreItemOperationProgress(restoreReq.Restore, pluginManager, r.kbClient, *restoreReq.GetItemOperationsList())
 	if len(errs) > 0 {
 		for _, err := range errs {
-			restoreErrors.Velero = append(restoreErrors.Velero, fmt.Sprintf("error from restore item operation: %v", err))
+			restoreErrors.AddVeleroError(errors.Errorf("error from restore item operation: %v", err))
+		}
+	}
+
+	// The restored items are finalized once the operations finish, by the restore operations
+	// controller if they're still in progress.
+	if !inProgressOperations {
+		for _, err := range pkgrestore.FinalizeRestoredItems(restore, finalizeActions, restoreReq.FinalizeItems, restoreLog) {
+			restoreErrors.AddVeleroError(err)
 		}
 	}
 
@@ -580,6 +897,16 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 	}
 	restoreLog.Info("restore completed")
 
+	if boolptr.IsSetToTrue(restore.Spec.DryRun) {
+		// nothing is persisted to the backup storage location for a dry-run
+		restore.Status.Warnings = countResults(restoreWarnings)
+		restore.Status.Errors = countResults(restoreErrors)
+		restore.Status.ErrorCategories = restoreErrors.CategoryCounts()
+		restore.Status.DryRunResult = restoreReq.DryRunResult
+		restore.Status.Phase = api.RestorePhaseDryRunCompleted
+		return nil
+	}
+
 	restoreLog.DoneForPersist(r.logger)
 
 	// re-instantiate the backup store because credentials could have changed since the original
@@ -590,25 +917,20 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 	}
 
 	if logReader, err := restoreLog.GetPersistFile(); err != nil {
-		restoreErrors.Velero = append(restoreErrors.Velero, fmt.Sprintf("error getting restore log reader: %v", err))
+		restoreErrors.AddVeleroError(errors.Wrap(err, "error getting restore log reader"))
 	} else {
 		if err := backupStore.PutRestoreLog(restore.Spec.BackupName, restore.Name, logReader); err != nil {
-			restoreErrors.Velero = append(restoreErrors.Velero, fmt.Sprintf("error uploading log file to backup storage: %v", err))
+			restoreErrors.AddVeleroError(errors.Wrap(err, "error uploading log file to backup storage"))
 		}
 	}
 
 	// At this point, no further logs should be written to restoreLog since it's been uploaded
 	// to object storage.
 
-	restore.Status.Warnings = len(restoreWarnings.Velero) + len(restoreWarnings.Cluster)
-	for _, w := range restoreWarnings.Namespaces {
-		restore.Status.Warnings += len(w)
-	}
-
-	restore.Status.Errors = len(restoreErrors.Velero) + len(restoreErrors.Cluster)
-	for _, e := range restoreErrors.Namespaces {
-		restore.Status.Errors += len(e)
-	}
+	restore.Status.Warnings = countResults(restoreWarnings)
+	restore.Status.Errors = countResults(restoreErrors)
+	restore.Status.ErrorCategories = restoreErrors.CategoryCounts()
+	r.metrics.RegisterRestoreErrorCategories(restore.Spec.ScheduleName, restore.Status.ErrorCategories)
 
 	m := map[string]results.Result{
 		"warnings": restoreWarnings,
@@ -627,6 +949,12 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 		r.logger.WithError(err).Error("Error uploading restore item action operation resource list to backup storage")
 	}
 
+	if inProgressOperations && len(restoreReq.FinalizeItems) > 0 {
+		if err := putRestoreFinalizeItems(restore, restoreReq.FinalizeItems, backupStore); err != nil {
+			r.logger.WithError(err).Error("Error uploading the restored items to finalize to backup storage")
+		}
+	}
+
 	if restore.Status.Errors > 0 {
 		if inProgressOperations {
 			r.logger.Debug("Restore WaitingForPluginOperationsPartiallyFailed")
@@ -649,6 +977,15 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 	return nil
 }
 
+// countResults returns the number of the messages in the result.
+func countResults(result results.Result) int {
+	count := len(result.Velero) + len(result.Cluster)
+	for _, msgs := range result.Namespaces {
+		count += len(msgs)
+	}
+	return count
+}
+
 // updateTotalRestoreMetric update the velero_restore_total metric every minute.
 func (r *restoreReconciler) updateTotalRestoreMetric() {
 	go func() {
@@ -765,6 +1102,89 @@ func putOperationsForRestore(restore *api.Restore, operations []*itemoperation.R
 	return nil
 }
 
+func putRestoreFinalizeItems(restore *api.Restore, items map[string][]velero.RestoredItem, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(items); err != nil {
+		return errors.Wrap(err, "error encoding the restored items to finalize to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return backupStore.PutRestoreFinalizeItems(restore.Name, buf)
+}
+
+// openBackupContents returns the reader of the backup contents. The backup contents are streamed
+// from the backup storage location if streaming is enabled, otherwise they're downloaded to a temp
+// file which is removed when the reader is closed. Either way the restorer extracts the whole
+// contents before restoring the items.
+func (r *restoreReconciler) openBackupContents(backupName string, backupStore persistence.BackupStore, logger logrus.FieldLogger) (io.ReadCloser, error) {
+	if r.streamingBufferSize <= 0 {
+		file, err := downloadToTempFile(backupName, backupStore, logger)
+		if err != nil {
+			return nil, err
+		}
+		return &tempFileReadCloser{File: file, log: r.logger}, nil
+	}
+
+	readCloser, err := backupStore.GetBackupContents(backupName)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.WithField("bufferSize", r.streamingBufferSize).Info("Streaming backup contents from the backup storage location")
+	return persistence.NewReadAheadReader(readCloser, r.streamingBufferSize), nil
+}
+
+// openReferencedBackups opens the contents of the backups storing the cluster-scoped items deduplicated
+// by the backup. The backups whose contents can't be opened, e.g. because they were deleted, are
+// returned without the contents, so that the restore reports the items it can't restore as errors.
+// The returned function closes the contents.
+func (r *restoreReconciler) openReferencedBackups(backupName string, backupStore persistence.BackupStore, logger logrus.FieldLogger) ([]pkgrestore.ReferencedBackup, func(), error) {
+	clusterResources, err := backupStore.GetBackupClusterResources(backupName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var referencedBackups []pkgrestore.ReferencedBackup
+	var contents []io.Closer
+	for name, paths := range clusterResources.ReferencedPaths(backupName) {
+		referenced := pkgrestore.ReferencedBackup{Name: name, Paths: paths}
+		if reader, err := r.openBackupContents(name, backupStore, logger); err != nil {
+			logger.WithError(err).Errorf("Error opening the contents of backup %s storing the cluster-scoped items deduplicated by the backup", name)
+		} else {
+			referenced.Reader = reader
+			contents = append(contents, reader)
+			if referenced.Checksums, err = backupStore.GetBackupChecksums(name); err != nil {
+				logger.WithError(err).Warnf("Error fetching the checksums of backup %s, the deduplicated cluster-scoped items won't be verified", name)
+			}
+		}
+		referencedBackups = append(referencedBackups, referenced)
+	}
+	sort.Slice(referencedBackups, func(i, j int) bool { return referencedBackups[i].Name < referencedBackups[j].Name })
+
+	return referencedBackups, func() {
+		for _, c := range contents {
+			c.Close()
+		}
+	}, nil
+}
+
+// tempFileReadCloser removes the temp file when it's closed.
+type tempFileReadCloser struct {
+	*os.File
+	log logrus.FieldLogger
+}
+
+func (f *tempFileReadCloser) Close() error {
+	closeAndRemoveFile(f.File, f.log)
+	return nil
+}
+
 func downloadToTempFile(backupName string, backupStore persistence.BackupStore, logger logrus.FieldLogger) (*os.File, error) {
 	readCloser, err := backupStore.GetBackupContents(backupName)
 	if err != nil {
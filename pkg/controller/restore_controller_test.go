@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"os"
 	"testing"
 	"time"
 
@@ -36,6 +37,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1 "k8s.io/api/core/v1"
+	storagev1api "k8s.io/api/storage/v1"
 
 	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -116,6 +118,9 @@ func TestFetchBackupInfo(t *testing.T) {
 				formatFlag,
 				60*time.Minute,
 				false,
+				0,
+				nil,
+				nil,
 			)
 
 			if test.backupStoreError == nil {
@@ -194,6 +199,9 @@ func TestProcessQueueItemSkips(t *testing.T) {
 				formatFlag,
 				60*time.Minute,
 				false,
+				0,
+				nil,
+				nil,
 			)
 
 			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{
@@ -449,6 +457,9 @@ func TestRestoreReconcile(t *testing.T) {
 				formatFlag,
 				60*time.Minute,
 				false,
+				0,
+				nil,
+				nil,
 			)
 
 			r.clock = clocktesting.NewFakeClock(now)
@@ -473,6 +484,7 @@ func TestRestoreReconcile(t *testing.T) {
 			if test.expectedRestorerCall != nil {
 				backupStore.On("GetBackupContents", test.backup.Name).Return(io.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
 				backupStore.On("GetCSIVolumeSnapshots", test.backup.Name).Return([]*snapshotv1api.VolumeSnapshot{}, nil)
+				backupStore.On("GetBackupChecksums", test.backup.Name).Return(nil, nil)
 
 				restorer.On("RestoreWithResolvers", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 					mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(warnings, errors)
@@ -506,6 +518,7 @@ func TestRestoreReconcile(t *testing.T) {
 
 			if test.restore != nil {
 				pluginManager.On("GetRestoreItemActionsV2").Return(nil, nil)
+				pluginManager.On("GetRestoreItemActionsV3").Return(nil, nil)
 				pluginManager.On("CleanupClients")
 			}
 
@@ -599,6 +612,243 @@ func TestRestoreReconcile(t *testing.T) {
 	}
 }
 
+func TestRestoreReconcileDryRun(t *testing.T) {
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+	now = now.Local()
+
+	var (
+		fakeClient    = velerotest.NewFakeControllerRuntimeClientBuilder(t).Build()
+		dryRunResult  = &velerov1api.RestoreDryRunResult{Items: []velerov1api.RestoreDryRunItem{{Resource: "pods", Namespace: "ns-1", Name: "pod-1", State: velerov1api.RestoreDryRunItemStateMissing}}}
+		restorer      = &fakeRestorer{kbClient: fakeClient, dryRunResult: dryRunResult}
+		pluginManager = &pluginmocks.Manager{}
+		backupStore   = &persistencemocks.BackupStore{}
+		location      = builder.ForBackupStorageLocation("velero", "default").Provider("myCloud").Bucket("bucket").Result()
+		backup        = defaultBackup().StorageLocation("default").Result()
+		restore       = NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).DryRun(true).Result()
+	)
+	defer restorer.AssertExpectations(t)
+	defer backupStore.AssertExpectations(t)
+
+	r := NewRestoreReconciler(
+		context.Background(),
+		velerov1api.DefaultNamespace,
+		restorer,
+		fakeClient,
+		velerotest.NewLogger(),
+		logrus.InfoLevel,
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewFakeSingleObjectBackupStoreGetter(backupStore),
+		metrics.NewServerMetrics(),
+		logging.FormatText,
+		60*time.Minute,
+		false,
+		0,
+		nil,
+		nil,
+	)
+	r.clock = clocktesting.NewFakeClock(now)
+
+	require.NoError(t, fakeClient.Create(context.Background(), location))
+	require.NoError(t, fakeClient.Create(context.Background(), backup))
+	require.NoError(t, fakeClient.Create(context.Background(), restore))
+
+	pluginManager.On("GetRestoreItemActionsV2").Return(nil, nil)
+	pluginManager.On("GetRestoreItemActionsV3").Return(nil, nil)
+	pluginManager.On("CleanupClients")
+	backupStore.On("GetBackupContents", backup.Name).Return(io.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
+	backupStore.On("GetBackupVolumeSnapshots", backup.Name).Return([]*volume.Snapshot{}, nil)
+	backupStore.On("GetCSIVolumeSnapshots", backup.Name).Return([]*snapshotv1api.VolumeSnapshot{}, nil)
+	backupStore.On("GetBackupChecksums", backup.Name).Return(nil, nil)
+	restorer.On("RestoreWithResolvers", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(results.Result{}, results.Result{})
+
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: restore.Namespace, Name: restore.Name}})
+	require.NoError(t, err)
+
+	// nothing is persisted to the backup storage location for a dry-run
+	backupStore.AssertNotCalled(t, "PutRestoreLog", mock.Anything, mock.Anything, mock.Anything)
+	backupStore.AssertNotCalled(t, "PutRestoreResults", mock.Anything, mock.Anything, mock.Anything)
+
+	updated := &velerov1api.Restore{}
+	require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Namespace: restore.Namespace, Name: restore.Name}, updated))
+	assert.Equal(t, velerov1api.RestorePhaseDryRunCompleted, updated.Status.Phase)
+	assert.Equal(t, dryRunResult, updated.Status.DryRunResult)
+	require.NotNil(t, updated.Status.CompletionTimestamp)
+}
+
+func TestRestoreReconcileMissingChecksums(t *testing.T) {
+	tests := []struct {
+		name           string
+		formatVersion  string
+		annotations    map[string]string
+		expectedPhase  velerov1api.RestorePhase
+		expectedReason string
+	}{
+		{
+			name:          "backup taken before the checksums were recorded is restored",
+			formatVersion: "1.1.0",
+			expectedPhase: velerov1api.RestorePhaseCompleted,
+		},
+		{
+			name:           "backup whose checksums are removed fails the restore",
+			formatVersion:  "1.1.1",
+			expectedPhase:  velerov1api.RestorePhaseFailed,
+			expectedReason: "the checksums of the backup with format version 1.1.1 are missing, the backup contents may be tampered with",
+		},
+		{
+			name:          "backup whose checksums are removed is restored when the restore ignores the checksum mismatch",
+			formatVersion: "1.1.1",
+			annotations:   map[string]string{velerov1api.IgnoreChecksumMismatchAnnotation: "true"},
+			expectedPhase: velerov1api.RestorePhaseCompleted,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				fakeClient    = velerotest.NewFakeControllerRuntimeClientBuilder(t).Build()
+				restorer      = &fakeRestorer{kbClient: fakeClient}
+				pluginManager = &pluginmocks.Manager{}
+				backupStore   = &persistencemocks.BackupStore{}
+				location      = builder.ForBackupStorageLocation("velero", "default").Provider("myCloud").Bucket("bucket").Result()
+				backup        = defaultBackup().StorageLocation("default").Result()
+				restore       = NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).Result()
+			)
+			backup.Status.FormatVersion = tc.formatVersion
+			restore.Annotations = tc.annotations
+
+			r := NewRestoreReconciler(
+				context.Background(),
+				velerov1api.DefaultNamespace,
+				restorer,
+				fakeClient,
+				velerotest.NewLogger(),
+				logrus.InfoLevel,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeSingleObjectBackupStoreGetter(backupStore),
+				metrics.NewServerMetrics(),
+				logging.FormatText,
+				60*time.Minute,
+				false,
+				0,
+				nil,
+				nil,
+			)
+
+			require.NoError(t, fakeClient.Create(context.Background(), location))
+			require.NoError(t, fakeClient.Create(context.Background(), backup))
+			require.NoError(t, fakeClient.Create(context.Background(), restore))
+
+			pluginManager.On("GetRestoreItemActionsV2").Return(nil, nil)
+			pluginManager.On("GetRestoreItemActionsV3").Return(nil, nil)
+			pluginManager.On("CleanupClients")
+			backupStore.On("GetBackupContents", backup.Name).Return(io.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
+			backupStore.On("GetBackupVolumeSnapshots", backup.Name).Return([]*volume.Snapshot{}, nil)
+			backupStore.On("GetCSIVolumeSnapshots", backup.Name).Return([]*snapshotv1api.VolumeSnapshot{}, nil)
+			backupStore.On("GetBackupChecksums", backup.Name).Return(nil, nil)
+			backupStore.On("PutRestoreLog", backup.Name, restore.Name, mock.Anything).Return(nil).Maybe()
+			backupStore.On("PutRestoreResults", backup.Name, restore.Name, mock.Anything).Return(nil).Maybe()
+			backupStore.On("PutRestoredResourceList", restore.Name, mock.Anything).Return(nil).Maybe()
+			backupStore.On("PutRestoreItemOperations", mock.Anything, mock.Anything).Return(nil).Maybe()
+			restorer.On("RestoreWithResolvers", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+				mock.Anything, mock.Anything, mock.Anything).Return(results.Result{}, results.Result{}).Maybe()
+
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: restore.Namespace, Name: restore.Name}})
+			require.NoError(t, err)
+
+			updated := &velerov1api.Restore{}
+			require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Namespace: restore.Namespace, Name: restore.Name}, updated))
+			assert.Equal(t, tc.expectedPhase, updated.Status.Phase)
+			assert.Equal(t, tc.expectedReason, updated.Status.FailureReason)
+			if tc.expectedPhase == velerov1api.RestorePhaseFailed {
+				restorer.AssertNotCalled(t, "RestoreWithResolvers", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestRestoreReconcileBackupRetrieval(t *testing.T) {
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+	now = now.Local()
+
+	var (
+		fakeClient    = velerotest.NewFakeControllerRuntimeClientBuilder(t).Build()
+		restorer      = &fakeRestorer{kbClient: fakeClient, dryRunResult: &velerov1api.RestoreDryRunResult{}}
+		pluginManager = &pluginmocks.Manager{}
+		backupStore   = &persistencemocks.BackupStore{}
+		location      = builder.ForBackupStorageLocation("velero", "default").Provider("myCloud").Bucket("bucket").Result()
+		backup        = defaultBackup().StorageLocation("default").Result()
+		restore       = NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).DryRun(true).Result()
+		key           = types.NamespacedName{Namespace: restore.Namespace, Name: restore.Name}
+	)
+	defer backupStore.AssertExpectations(t)
+	backup.Status.StorageClass = "GLACIER"
+
+	r := NewRestoreReconciler(
+		context.Background(),
+		velerov1api.DefaultNamespace,
+		restorer,
+		fakeClient,
+		velerotest.NewLogger(),
+		logrus.InfoLevel,
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewFakeSingleObjectBackupStoreGetter(backupStore),
+		metrics.NewServerMetrics(),
+		logging.FormatText,
+		60*time.Minute,
+		false,
+		0,
+		nil,
+		nil,
+	)
+	fakeClock := clocktesting.NewFakeClock(now)
+	r.clock = fakeClock
+
+	require.NoError(t, fakeClient.Create(context.Background(), location))
+	require.NoError(t, fakeClient.Create(context.Background(), backup))
+	require.NoError(t, fakeClient.Create(context.Background(), restore))
+
+	pluginManager.On("GetRestoreItemActionsV2").Return(nil, nil)
+	pluginManager.On("GetRestoreItemActionsV3").Return(nil, nil)
+	pluginManager.On("CleanupClients")
+	backupStore.On("RetrieveBackup", backup.Name).Return(false, nil).Once()
+	backupStore.On("RetrieveBackup", backup.Name).Return(true, nil).Once()
+	backupStore.On("GetBackupContents", backup.Name).Return(io.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
+	backupStore.On("GetBackupVolumeSnapshots", backup.Name).Return([]*volume.Snapshot{}, nil)
+	backupStore.On("GetCSIVolumeSnapshots", backup.Name).Return([]*snapshotv1api.VolumeSnapshot{}, nil)
+	backupStore.On("GetBackupChecksums", backup.Name).Return(nil, nil)
+	restorer.On("RestoreWithResolvers", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(results.Result{}, results.Result{})
+
+	// the restore waits for the backup contents to be retrieved
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	assert.Equal(t, backupRetrievalCheckPeriod, result.RequeueAfter)
+
+	updated := &velerov1api.Restore{}
+	require.NoError(t, fakeClient.Get(context.Background(), key, updated))
+	assert.Equal(t, velerov1api.RestorePhaseWaitingForBackupRetrieval, updated.Status.Phase)
+	require.NotNil(t, updated.Status.BackupRetrieval)
+	assert.Equal(t, "GLACIER", updated.Status.BackupRetrieval.StorageClass)
+	assert.True(t, updated.Status.BackupRetrieval.StartTimestamp.Time.Equal(now))
+	assert.Nil(t, updated.Status.BackupRetrieval.CompletionTimestamp)
+	restorer.AssertNotCalled(t, "RestoreWithResolvers", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// the restore runs once the backup contents are retrieved
+	fakeClock.Step(time.Hour)
+	result, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+
+	require.NoError(t, fakeClient.Get(context.Background(), key, updated))
+	assert.Equal(t, velerov1api.RestorePhaseDryRunCompleted, updated.Status.Phase)
+	require.NotNil(t, updated.Status.BackupRetrieval.CompletionTimestamp)
+	assert.True(t, updated.Status.BackupRetrieval.CompletionTimestamp.Time.Equal(now.Add(time.Hour)))
+}
+
 func TestValidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 	formatFlag := logging.FormatText
 
@@ -622,6 +872,9 @@ func TestValidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 		formatFlag,
 		60*time.Minute,
 		false,
+		0,
+		nil,
+		nil,
 	)
 
 	restore := &velerov1api.Restore{
@@ -675,6 +928,16 @@ func TestValidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 			Result(),
 	))
 
+	// the backups of a same named schedule of another Velero namespace are ignored
+	require.NoError(t, r.kbClient.Create(context.Background(),
+		builder.ForBackup("velero-2", "bar").
+			ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1")).
+			StorageLocation("default").
+			Phase(velerov1api.BackupPhaseCompleted).
+			StartTimestamp(now.Add(time.Hour)).
+			Result(),
+	))
+
 	location := builder.ForBackupStorageLocation("velero", "default").Provider("myCloud").Bucket("bucket").Result()
 	require.NoError(t, r.kbClient.Create(context.Background(), location))
 
@@ -715,6 +978,9 @@ func TestValidateAndCompleteWithResourceModifierSpecified(t *testing.T) {
 		formatFlag,
 		60*time.Minute,
 		false,
+		0,
+		nil,
+		nil,
 	)
 
 	restore := &velerov1api.Restore{
@@ -833,6 +1099,303 @@ func TestValidateAndCompleteWithResourceModifierSpecified(t *testing.T) {
 	assert.Contains(t, restore3.Status.ValidationErrors[0], "Validation error in resource modifiers provided in configmap")
 }
 
+func TestValidateAndCompleteWithResourcePrioritiesSpecified(t *testing.T) {
+	formatFlag := logging.FormatText
+
+	var (
+		logger        = velerotest.NewLogger()
+		pluginManager = &pluginmocks.Manager{}
+		fakeClient    = velerotest.NewFakeControllerRuntimeClient(t)
+		backupStore   = &persistencemocks.BackupStore{}
+	)
+
+	r := NewRestoreReconciler(
+		context.Background(),
+		velerov1api.DefaultNamespace,
+		nil,
+		fakeClient,
+		logger,
+		logrus.DebugLevel,
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewFakeSingleObjectBackupStoreGetter(backupStore),
+		metrics.NewServerMetrics(),
+		formatFlag,
+		60*time.Minute,
+		false,
+		0,
+		nil,
+		nil,
+	)
+
+	location := builder.ForBackupStorageLocation("velero", "default").Provider("myCloud").Bucket("bucket").Result()
+	require.NoError(t, r.kbClient.Create(context.Background(), location))
+
+	require.NoError(t, r.kbClient.Create(
+		context.Background(),
+		defaultBackup().
+			ObjectMeta(
+				builder.WithName("backup-1"),
+			).StorageLocation("default").
+			Phase(velerov1api.BackupPhaseCompleted).
+			Result(),
+	))
+
+	newRestore := func(configMap string) *velerov1api.Restore {
+		return &velerov1api.Restore{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: velerov1api.DefaultNamespace,
+				Name:      "restore-1",
+			},
+			Spec: velerov1api.RestoreSpec{
+				BackupName: "backup-1",
+				ResourcePriorities: &corev1.TypedLocalObjectReference{
+					Kind: resourcemodifiers.ConfigmapRefType,
+					Name: configMap,
+				},
+			},
+		}
+	}
+
+	restore := newRestore("test-priorities")
+	_, _, priorities := r.validateAndComplete(restore)
+	assert.Nil(t, priorities)
+	assert.Contains(t, restore.Status.ValidationErrors[0], "failed to get resource priorities configmap")
+
+	require.NoError(t, r.kbClient.Create(context.Background(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-priorities",
+			Namespace: velerov1api.DefaultNamespace,
+		},
+		Data: map[string]string{
+			"priorities.yaml": "highPriorities:\n- customresourcedefinitions\n- deployments.apps\nlowPriorities:\n- foos.example.com\n",
+		},
+	}))
+
+	restore = newRestore("test-priorities")
+	_, _, priorities = r.validateAndComplete(restore)
+	assert.Nil(t, restore.Status.ValidationErrors)
+	assert.Equal(t, &pkgrestore.Priorities{
+		HighPriorities: []string{"customresourcedefinitions", "deployments.apps"},
+		LowPriorities:  []string{"foos.example.com"},
+	}, priorities)
+
+	require.NoError(t, r.kbClient.Create(context.Background(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-priorities-invalid",
+			Namespace: velerov1api.DefaultNamespace,
+		},
+		Data: map[string]string{
+			"priorities.yaml": "highPriority:\n- customresourcedefinitions\n",
+		},
+	}))
+
+	restore = newRestore("test-priorities-invalid")
+	_, _, priorities = r.validateAndComplete(restore)
+	assert.Nil(t, priorities)
+	assert.Contains(t, restore.Status.ValidationErrors[0], "Error in parsing resource priorities provided in configmap")
+}
+
+func TestValidateTopologyMappings(t *testing.T) {
+	storageClass := &storagev1api.StorageClass{
+		ObjectMeta:  metav1.ObjectMeta{Name: "gp3"},
+		Provisioner: "ebs.csi.aws.com",
+	}
+
+	r := &restoreReconciler{
+		kbClient: velerotest.NewFakeControllerRuntimeClient(t, storageClass),
+	}
+
+	tests := []struct {
+		name     string
+		restore  *velerov1api.Restore
+		expected []string
+	}{
+		{
+			name:    "no mappings",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+		},
+		{
+			name:    "valid mappings",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").StorageClassMappings("standard", "gp3").ZoneMappings("us-west-1a", "us-east-1a").Result(),
+		},
+		{
+			name:    "target storage class doesn't exist",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").StorageClassMappings("standard", "gp2").Result(),
+			expected: []string{
+				`Error getting storage class gp2 mapped from storage class standard: storageclasses.storage.k8s.io "gp2" not found`,
+			},
+		},
+		{
+			name:    "empty names",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").StorageClassMappings("standard", "").ZoneMappings("", "us-east-1a").Result(),
+			expected: []string{
+				`Invalid storage class mapping "standard":"", the storage class names must not be empty`,
+				`Invalid zone mapping "":"us-east-1a", the zone names must not be empty`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, r.validateTopologyMappings(test.restore))
+		})
+	}
+}
+
+func TestValidateScaling(t *testing.T) {
+	tests := []struct {
+		name     string
+		restore  *velerov1api.Restore
+		expected []string
+	}{
+		{
+			name:    "no scaling",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+		},
+		{
+			name:    "valid scaling",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Scaling(0, "deployments", "statefulsets.apps").Result(),
+		},
+		{
+			name:    "invalid scaling",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Scaling(-1, "daemonsets").Result(),
+			expected: []string{
+				"Invalid scaling replicas -1, the replicas must not be negative",
+				"Invalid scaling resource daemonsets, only deployments and statefulsets can be scaled",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, validateScaling(test.restore))
+		})
+	}
+}
+
+func TestValidateReadinessGates(t *testing.T) {
+	tests := []struct {
+		name     string
+		restore  *velerov1api.Restore
+		expected []string
+	}{
+		{
+			name:    "no readiness gates",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+		},
+		{
+			name:    "valid readiness gates",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ReadinessGates(time.Minute, "namespaces", "customresourcedefinitions.apiextensions.k8s.io").Result(),
+		},
+		{
+			name:    "invalid readiness gates",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ReadinessGates(-time.Minute, "deployments").Result(),
+			expected: []string{
+				"Invalid readiness gate resource deployments, only customresourcedefinitions, namespaces, mutatingwebhookconfigurations and validatingwebhookconfigurations can be waited for",
+				"Invalid readiness gate timeout -1m0s, the timeout must not be negative",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, validateReadinessGates(test.restore))
+		})
+	}
+}
+
+func TestValidateJobHooks(t *testing.T) {
+	validHook := velerov1api.RestoreJobHook{
+		Name:      "hook-1",
+		Phase:     velerov1api.RestoreJobHookPhasePre,
+		Namespace: "ns-1",
+		Image:     "busybox",
+		Command:   []string{"/bin/true"},
+	}
+
+	tests := []struct {
+		name     string
+		hooks    []velerov1api.RestoreJobHook
+		expected []string
+	}{
+		{
+			name: "no job hooks",
+		},
+		{
+			name: "valid job hooks",
+			hooks: []velerov1api.RestoreJobHook{
+				validHook,
+				{Name: "hook-2", Phase: velerov1api.RestoreJobHookPhasePost, Namespace: "ns-1", Image: "busybox", Command: []string{"/bin/true"}, Timeout: metav1.Duration{Duration: time.Minute}},
+			},
+		},
+		{
+			name: "invalid job hooks",
+			hooks: []velerov1api.RestoreJobHook{
+				validHook,
+				validHook,
+				{Name: "Hook_3", Phase: "During", Timeout: metav1.Duration{Duration: -time.Minute}},
+			},
+			expected: []string{
+				`Duplicate job hook name "hook-1"`,
+				`Invalid job hook name "Hook_3": a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')`,
+				`Invalid phase "During" of job hook Hook_3, the phase must be Pre or Post`,
+				"Invalid job hook Hook_3, the namespace, image and command must be specified",
+				"Invalid timeout -1m0s of job hook Hook_3, the timeout must not be negative",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result()
+			restore.Spec.Hooks.Jobs = test.hooks
+			assert.Equal(t, test.expected, validateJobHooks(restore))
+		})
+	}
+}
+
+func TestValidateItemRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		restore  *velerov1api.Restore
+		expected []string
+	}{
+		{
+			name:    "no item retry policy",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+		},
+		{
+			name:    "valid item retry policy",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ItemRetryPolicy(5, time.Second, time.Minute).Result(),
+		},
+		{
+			name:    "valid item retry policy with default backoffs",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ItemRetryPolicy(5, 0, 0).Result(),
+		},
+		{
+			name:    "negative retries and backoffs",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ItemRetryPolicy(-1, -time.Second, 0).Result(),
+			expected: []string{
+				"Invalid item max retries -1, the retries must not be negative",
+				"Invalid item retry backoff -1s/0s, the backoffs must not be negative",
+			},
+		},
+		{
+			name:    "initial backoff exceeding max backoff",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ItemRetryPolicy(5, time.Minute, time.Second).Result(),
+			expected: []string{
+				"Invalid item retry backoff 1m0s/1s, the initial backoff must not exceed the max backoff",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, validateItemRetryPolicy(test.restore))
+		})
+	}
+}
+
 func TestBackupXorScheduleProvided(t *testing.T) {
 	r := &velerov1api.Restore{}
 	assert.False(t, backupXorScheduleProvided(r))
@@ -942,6 +1505,7 @@ type fakeRestorer struct {
 	mock.Mock
 	calledWithArg velerov1api.Restore
 	kbClient      client.Client
+	dryRunResult  *velerov1api.RestoreDryRunResult
 }
 
 func (r *fakeRestorer) Restore(
@@ -964,6 +1528,82 @@ func (r *fakeRestorer) RestoreWithResolvers(req *pkgrestore.Request,
 		r.kbClient, volumeSnapshotterGetter)
 
 	r.calledWithArg = *req.Restore
+	req.DryRunResult = r.dryRunResult
 
 	return res.Get(0).(results.Result), res.Get(1).(results.Result)
 }
+
+func TestOpenBackupContents(t *testing.T) {
+	tests := []struct {
+		name                string
+		streamingBufferSize int
+	}{
+		{
+			name: "backup contents are downloaded to a temp file",
+		},
+		{
+			name:                "backup contents are streamed",
+			streamingBufferSize: 1024,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("GetBackupContents", "backup-1").Return(io.NopCloser(bytes.NewReader([]byte("backup contents"))), nil)
+
+			r := &restoreReconciler{
+				logger:              velerotest.NewLogger(),
+				streamingBufferSize: test.streamingBufferSize,
+			}
+
+			contents, err := r.openBackupContents("backup-1", backupStore, r.logger)
+			require.NoError(t, err)
+
+			file, isFile := contents.(*tempFileReadCloser)
+			assert.Equal(t, test.streamingBufferSize == 0, isFile)
+
+			data, err := io.ReadAll(contents)
+			require.NoError(t, err)
+			assert.Equal(t, "backup contents", string(data))
+
+			require.NoError(t, contents.Close())
+			if isFile {
+				_, err := os.Stat(file.Name())
+				assert.True(t, os.IsNotExist(err))
+			}
+		})
+	}
+}
+
+func TestValidatePVCSizeOverrides(t *testing.T) {
+	tests := []struct {
+		name     string
+		restore  *velerov1api.Restore
+		expected []string
+	}{
+		{
+			name:    "no PVC size overrides",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+		},
+		{
+			name:    "valid PVC size overrides",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").PVCSizeOverrides(20, "ns-1/pvc-1", "100Gi").Result(),
+		},
+		{
+			name:    "invalid PVC size overrides",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").PVCSizeOverrides(-1, "pvc-1", "0", "ns-1/pvc-2", "10Gi").Result(),
+			expected: []string{
+				"Invalid PVC size growth percentage -1, the percentage must not be negative",
+				`Invalid PVC "pvc-1" of size override, the PVC must be in the format <namespace>/<name>`,
+				"Invalid size 0 of PVC pvc-1, the size must be positive",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, validatePVCSizeOverrides(test.restore))
+		})
+	}
+}
@@ -29,12 +29,16 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/itemoperationmap"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/notification"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -52,6 +56,7 @@ type restoreOperationsReconciler struct {
 	newPluginManager  func(logger logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter persistence.ObjectBackupStoreGetter
 	metrics           *metrics.ServerMetrics
+	notifier          *notification.Dispatcher
 }
 
 func NewRestoreOperationsReconciler(
@@ -63,6 +68,7 @@ func NewRestoreOperationsReconciler(
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
 	metrics *metrics.ServerMetrics,
 	itemOperationsMap *itemoperationmap.RestoreItemOperationsMap,
+	notifier *notification.Dispatcher,
 ) *restoreOperationsReconciler {
 	abor := &restoreOperationsReconciler{
 		Client:            client,
@@ -74,6 +80,7 @@ func NewRestoreOperationsReconciler(
 		newPluginManager:  newPluginManager,
 		backupStoreGetter: backupStoreGetter,
 		metrics:           metrics,
+		notifier:          notifier,
 	}
 	if abor.frequency <= 0 {
 		abor.frequency = defaultRestoreOperationsFrequency
@@ -91,11 +98,13 @@ func (r *restoreOperationsReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&velerov1api.Restore{}, builder.WithPredicates(kube.FalsePredicate{})).
 		Watches(s, nil, builder.WithPredicates(gp)).
+		WithOptions(controllerOptions(RestoreOperations)).
 		Complete(r)
 }
 
 // +kubebuilder:rbac:groups=velero.io,resources=restores,verbs=get;list;watch;update
 // +kubebuilder:rbac:groups=velero.io,resources=restores/status,verbs=get
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=create;delete;get;list;watch
 
 func (r *restoreOperationsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := r.logger.WithField("restore operations for restore", req.String())
@@ -135,6 +144,8 @@ func (r *restoreOperationsReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		err2 := r.updateRestoreAndOperationsJSON(ctx, original, restore, nil, &itemoperationmap.OperationsForRestore{ErrsSinceUpdate: []string{err.Error()}}, false, false)
 		if err2 != nil {
 			log.WithError(err2).Error("error updating Restore")
+		} else {
+			r.notifier.Notify(notification.ForRestore(notification.EventRestorePartiallyFailed, restore))
 		}
 		return ctrl.Result{}, errors.Wrap(err, "error getting backup info")
 	}
@@ -154,10 +165,15 @@ func (r *restoreOperationsReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		}
 		return ctrl.Result{}, errors.Wrap(err, "error getting restore operations")
 	}
-	stillInProgress, changes, opsCompleted, opsFailed, errs := getRestoreItemOperationProgress(restore, pluginManager, operations.Operations)
+	stillInProgress, changes, opsCompleted, opsFailed, errs := getRestoreItemOperationProgress(restore, pluginManager, r.Client, operations.Operations)
+	// the restored items are finalized once all the operations finished
+	if !stillInProgress {
+		errs = append(errs, finalizeRestoredItems(restore, pluginManager, backupStore, log)...)
+	}
 	// if len(errs)>0, need to update restore errors and error log
 	operations.ErrsSinceUpdate = append(operations.ErrsSinceUpdate, errs...)
 	restore.Status.Errors += len(operations.ErrsSinceUpdate)
+	restore.Status.ErrorCategories = addOperationErrorCategories(restore.Status.ErrorCategories, operations.ErrsSinceUpdate)
 	completionChanges := false
 	if restore.Status.RestoreItemOperationsCompleted != opsCompleted || restore.Status.RestoreItemOperationsFailed != opsFailed {
 		completionChanges = true
@@ -193,9 +209,35 @@ func (r *restoreOperationsReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	if err != nil {
 		return ctrl.Result{}, errors.Wrap(err, "error updating Restore")
 	}
+	if eventType, ok := notification.RestoreEventType(restore.Status.Phase); ok {
+		r.notifier.Notify(notification.ForRestore(eventType, restore))
+	}
 	return ctrl.Result{}, nil
 }
 
+// finalizeRestoredItems finalizes the items created by the restore by the v3 restore item actions
+// executed on them, the errors are returned as messages.
+func finalizeRestoredItems(restore *velerov1api.Restore, pluginManager clientmgmt.Manager, backupStore persistence.BackupStore, log logrus.FieldLogger) []string {
+	items, err := backupStore.GetRestoreFinalizeItems(restore.Name)
+	if err != nil {
+		return []string{errors.Wrap(err, "error getting the restored items to finalize").Error()}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	actions, err := pluginManager.GetRestoreItemActionsV3()
+	if err != nil {
+		return []string{errors.Wrap(err, "error getting v3 restore item actions").Error()}
+	}
+
+	var errs []string
+	for _, err := range pkgrestore.FinalizeRestoredItems(restore, actions, items, log) {
+		errs = append(errs, err.Error())
+	}
+	return errs
+}
+
 // fetchBackupInfo checks the backup lister for a backup that matches the given name. If it doesn't
 // find it, it returns an error.
 func (r *restoreOperationsReconciler) fetchBackupInfo(backupName string) (backupInfo, error) {
@@ -252,9 +294,24 @@ func (r *restoreOperationsReconciler) updateRestoreAndOperationsJSON(
 	return nil
 }
 
+// restoreOperationProgressor tracks the progress of the restore item operations, the operations
+// are tracked by the RestoreItemAction plugins except those of the restore job hooks.
+type restoreOperationProgressor interface {
+	Progress(operationID string, restore *velerov1api.Restore) (velero.OperationProgress, error)
+	Cancel(operationID string, restore *velerov1api.Restore) error
+}
+
+func getRestoreOperationProgressor(pluginManager clientmgmt.Manager, kbClient client.Client, operation *itemoperation.RestoreOperation) (restoreOperationProgressor, error) {
+	if operation.Spec.RestoreItemAction == hook.RestoreJobHookAction {
+		return hook.NewRestoreJobHookTracker(kbClient), nil
+	}
+	return pluginManager.GetRestoreItemActionV2(operation.Spec.RestoreItemAction)
+}
+
 func getRestoreItemOperationProgress(
 	restore *velerov1api.Restore,
 	pluginManager clientmgmt.Manager,
+	kbClient client.Client,
 	operationsList []*itemoperation.RestoreOperation) (bool, bool, int, int, []string) {
 	inProgressOperations := false
 	changes := false
@@ -264,7 +321,7 @@ func getRestoreItemOperationProgress(
 	for _, operation := range operationsList {
 		if operation.Status.Phase == itemoperation.OperationPhaseNew ||
 			operation.Status.Phase == itemoperation.OperationPhaseInProgress {
-			ria, err := pluginManager.GetRestoreItemActionV2(operation.Spec.RestoreItemAction)
+			ria, err := getRestoreOperationProgressor(pluginManager, kbClient, operation)
 			if err != nil {
 				operation.Status.Phase = itemoperation.OperationPhaseFailed
 				operation.Status.Error = err.Error()
@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -43,6 +44,8 @@ import (
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	riav2mocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks/restoreitemaction/v2"
+	riav3mocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks/restoreitemaction/v3"
+	riav3 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v3"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -62,6 +65,7 @@ func mockRestoreOperationsReconciler(fakeClient kbclient.Client, fakeClock *test
 		NewFakeSingleObjectBackupStoreGetter(restoreBackupStore),
 		metrics.NewServerMetrics(),
 		itemoperationmap.NewRestoreItemOperationsMap(),
+		nil,
 	)
 	abor.clock = fakeClock
 	return abor
@@ -81,6 +85,8 @@ func TestRestoreOperationsReconcile(t *testing.T) {
 		backupLocation    *velerov1api.BackupStorageLocation
 		operationComplete bool
 		operationErr      string
+		finalizeItems     map[string][]velero.RestoredItem
+		finalizeErr       error
 		expectError       bool
 		expectPhase       velerov1api.RestorePhase
 	}{
@@ -272,6 +278,85 @@ func TestRestoreOperationsReconcile(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "WaitingForPluginOperations restore with completed operations is finalized and Completed",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-17").
+				Backup("backup-1").
+				ItemOperationTimeout(60 * time.Minute).
+				ObjectMeta(builder.WithUID("foo-17")).
+				Phase(velerov1api.RestorePhaseWaitingForPluginOperations).Result(),
+			backup:            defaultBackup().StorageLocation("default").Result(),
+			backupLocation:    defaultBackupLocation,
+			operationComplete: true,
+			finalizeItems: map[string][]velero.RestoredItem{
+				"velero.io/finalizer": {
+					{
+						ResourceIdentifier: velero.ResourceIdentifier{GroupResource: kuberesource.Pods, Namespace: "ns-1", Name: "pod-1"},
+						UID:                "uid-1",
+					},
+				},
+			},
+			expectPhase: velerov1api.RestorePhaseCompleted,
+			restoreOperations: []*itemoperation.RestoreOperation{
+				{
+					Spec: itemoperation.RestoreOperationSpec{
+						RestoreName:       "restore-17",
+						RestoreUID:        "foo-17",
+						RestoreItemAction: "foo-17",
+						ResourceIdentifier: velero.ResourceIdentifier{
+							GroupResource: kuberesource.Pods,
+							Namespace:     "ns-1",
+							Name:          "pod-1",
+						},
+						OperationID: "operation-17",
+					},
+					Status: itemoperation.OperationStatus{
+						Phase:   itemoperation.OperationPhaseInProgress,
+						Created: &metav1Now,
+					},
+				},
+			},
+		},
+		{
+			name: "WaitingForPluginOperations restore failing to be finalized is PartiallyFailed",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-18").
+				Backup("backup-1").
+				ItemOperationTimeout(60 * time.Minute).
+				ObjectMeta(builder.WithUID("foo-18")).
+				Phase(velerov1api.RestorePhaseWaitingForPluginOperations).Result(),
+			backup:            defaultBackup().StorageLocation("default").Result(),
+			backupLocation:    defaultBackupLocation,
+			operationComplete: true,
+			finalizeItems: map[string][]velero.RestoredItem{
+				"velero.io/finalizer": {
+					{
+						ResourceIdentifier: velero.ResourceIdentifier{GroupResource: kuberesource.Pods, Namespace: "ns-1", Name: "pod-1"},
+						UID:                "uid-1",
+					},
+				},
+			},
+			finalizeErr: errors.New("failed"),
+			expectPhase: velerov1api.RestorePhasePartiallyFailed,
+			restoreOperations: []*itemoperation.RestoreOperation{
+				{
+					Spec: itemoperation.RestoreOperationSpec{
+						RestoreName:       "restore-18",
+						RestoreUID:        "foo-18",
+						RestoreItemAction: "foo-18",
+						ResourceIdentifier: velero.ResourceIdentifier{
+							GroupResource: kuberesource.Pods,
+							Namespace:     "ns-1",
+							Name:          "pod-1",
+						},
+						OperationID: "operation-18",
+					},
+					Status: itemoperation.OperationStatus{
+						Phase:   itemoperation.OperationPhaseInProgress,
+						Created: &metav1Now,
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -294,6 +379,16 @@ func TestRestoreOperationsReconcile(t *testing.T) {
 			restoreBackupStore.On("GetRestoreItemOperations", test.restore.Name).Return(test.restoreOperations, nil)
 			restoreBackupStore.On("PutRestoreItemOperations", mock.Anything, mock.Anything).Return(nil)
 			restoreBackupStore.On("PutRestoreMetadata", mock.Anything, mock.Anything).Return(nil)
+			restoreBackupStore.On("GetRestoreFinalizeItems", test.restore.Name).Return(test.finalizeItems, nil)
+			if test.finalizeItems != nil {
+				finalizer := &riav3mocks.RestoreItemAction{}
+				defer finalizer.AssertExpectations(t)
+				finalizer.On("Name").Return("velero.io/finalizer")
+				finalizer.On("Finalize", mock.MatchedBy(func(input *velero.RestoreItemActionFinalizeInput) bool {
+					return input.Restore.Name == test.restore.Name && assert.ObjectsAreEqual(test.finalizeItems["velero.io/finalizer"], input.RestoredItems)
+				})).Return(test.finalizeErr)
+				restorePluginManager.On("GetRestoreItemActionsV3").Return([]riav3.RestoreItemAction{finalizer}, nil).Once()
+			}
 			for _, operation := range test.restoreOperations {
 				ria.On("Progress", operation.Spec.OperationID, mock.Anything).
 					Return(velero.OperationProgress{
@@ -0,0 +1,315 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	bld "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const (
+	restoreScheduleSyncPeriod = time.Minute
+)
+
+type restoreScheduleReconciler struct {
+	client.Client
+	namespace string
+	logger    logrus.FieldLogger
+	clock     clocks.WithTickerAndDelayedExecution
+	metrics   *metrics.ServerMetrics
+}
+
+func NewRestoreScheduleReconciler(
+	namespace string,
+	logger logrus.FieldLogger,
+	client client.Client,
+	metrics *metrics.ServerMetrics,
+) *restoreScheduleReconciler {
+	return &restoreScheduleReconciler{
+		Client:    client,
+		namespace: namespace,
+		logger:    logger,
+		clock:     clocks.RealClock{},
+		metrics:   metrics,
+	}
+}
+
+func (c *restoreScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	s := kube.NewPeriodicalEnqueueSource(c.logger, mgr.GetClient(), &velerov1.RestoreScheduleList{}, restoreScheduleSyncPeriod, kube.PeriodicalEnqueueSourceOption{})
+	return ctrl.NewControllerManagedBy(mgr).
+		// global predicate, works for both For and Watch
+		WithEventFilter(kube.NewAllEventPredicate(func(obj client.Object) bool {
+			schedule := obj.(*velerov1.RestoreSchedule)
+			if pause := schedule.Spec.Paused; pause {
+				c.logger.Infof("restore schedule %s is paused, skip", schedule.Name)
+				return false
+			}
+			return true
+		})).
+		For(&velerov1.RestoreSchedule{}, bld.WithPredicates(kube.SpecChangePredicate{})).
+		Watches(s, nil).
+		WithOptions(controllerOptions(RestoreSchedule)).
+		Complete(c)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=restoreschedules,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=restoreschedules/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=restores,verbs=create;delete
+
+func (c *restoreScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := c.logger.WithField("restoreSchedule", req.String())
+
+	log.Debug("Getting restore schedule")
+	schedule := &velerov1.RestoreSchedule{}
+	if err := c.Get(ctx, req.NamespacedName, schedule); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.WithError(err).Error("restore schedule not found")
+			c.metrics.RemoveRestoreSchedule(req.Name)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting restore schedule %s", req.String())
+	}
+
+	original := schedule.DeepCopy()
+
+	restores, err := c.listRestores(ctx, schedule)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "error listing restores of restore schedule %s", req.String())
+	}
+
+	c.recordLastRestore(schedule, restores)
+
+	if err := c.pruneRestores(ctx, schedule, restores); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "error deleting the old restores of restore schedule %s", req.String())
+	}
+
+	// validation - even if the item is Enabled, we can't trust it
+	// so re-validate
+	cronSchedule, errs := validateRestoreSchedule(schedule, log)
+	if len(errs) > 0 {
+		schedule.Status.Phase = velerov1.SchedulePhaseFailedValidation
+		schedule.Status.ValidationErrors = errs
+	} else {
+		schedule.Status.Phase = velerov1.SchedulePhaseEnabled
+		schedule.Status.ValidationErrors = nil
+	}
+
+	if schedule.Status.Phase == velerov1.SchedulePhaseEnabled && c.ifDue(schedule, cronSchedule, log) {
+		if restoreInProgress(restores) {
+			// skip the restore to avoid running overlap restores of the same backup
+			log.Info("Restore schedule is due, but the previous restore is still running, skip")
+		} else if err := c.submitRestore(ctx, schedule); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error submit restore for restore schedule %s", req.String())
+		}
+	}
+
+	if !equality.Semantic.DeepEqual(original.Status, schedule.Status) {
+		if err := c.Patch(ctx, schedule, client.MergeFrom(original)); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error updating status of restore schedule %s", req.String())
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// validateRestoreSchedule validates the restore schedule and returns the parsed Cron expression.
+func validateRestoreSchedule(schedule *velerov1.RestoreSchedule, log logrus.FieldLogger) (cron.Schedule, []string) {
+	cronSchedule, validationErrors := parseCronExpression(schedule.Spec.Schedule, log)
+
+	if schedule.Spec.Template.ScheduleName == "" {
+		validationErrors = append(validationErrors, "template.scheduleName must be set to the schedule whose most recent backup is restored")
+	}
+
+	if schedule.Spec.Template.BackupName != "" {
+		validationErrors = append(validationErrors, "template.backupName must not be set, the most recent backup of the schedule is restored")
+	}
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return cronSchedule, nil
+}
+
+// listRestores lists the restores created by this restore schedule, sorted from the most
+// recent to the least recent
+func (c *restoreScheduleReconciler) listRestores(ctx context.Context, schedule *velerov1.RestoreSchedule) ([]velerov1.Restore, error) {
+	restoreList := &velerov1.RestoreList{}
+	options := &client.ListOptions{
+		Namespace: schedule.Namespace,
+		LabelSelector: labels.Set(map[string]string{
+			velerov1.RestoreScheduleNameLabel: schedule.Name,
+		}).AsSelector(),
+	}
+
+	if err := c.List(ctx, restoreList, options); err != nil {
+		return nil, err
+	}
+
+	restores := restoreList.Items
+	sort.Slice(restores, func(i, j int) bool {
+		return restores[i].CreationTimestamp.After(restores[j].CreationTimestamp.Time)
+	})
+
+	return restores, nil
+}
+
+// recordLastRestore records the last finished restore and the last successful restore of the
+// restore schedule in its status and in the metrics
+func (c *restoreScheduleReconciler) recordLastRestore(schedule *velerov1.RestoreSchedule, restores []velerov1.Restore) {
+	lastFinished := false
+	for i := range restores {
+		restore := &restores[i]
+		if !restoreFinished(restore) {
+			continue
+		}
+
+		succeeded := restoreSucceeded(restore)
+		if !lastFinished {
+			lastFinished = true
+			schedule.Status.LastRestoreName = restore.Name
+			schedule.Status.LastRestorePhase = restore.Status.Phase
+			if succeeded {
+				c.metrics.SetRestoreScheduleLastRestoreStatus(schedule.Name, metrics.BackupLastStatusSucc)
+			} else {
+				c.metrics.SetRestoreScheduleLastRestoreStatus(schedule.Name, metrics.BackupLastStatusFailure)
+			}
+		}
+
+		if succeeded {
+			completion := restore.CreationTimestamp
+			if restore.Status.CompletionTimestamp != nil {
+				completion = *restore.Status.CompletionTimestamp
+			}
+			schedule.Status.LastSuccessfulRestore = &completion
+			c.metrics.SetRestoreScheduleLastSuccessfulRestoreTimestamp(schedule.Name, completion.Time)
+			return
+		}
+	}
+
+	// the deleted restores no longer tell when the last successful restore was, keep the recorded one
+	if schedule.Status.LastSuccessfulRestore != nil {
+		c.metrics.SetRestoreScheduleLastSuccessfulRestoreTimestamp(schedule.Name, schedule.Status.LastSuccessfulRestore.Time)
+	}
+}
+
+// pruneRestores deletes the finished restores of the restore schedule beyond the number of
+// restores to keep. Deleting a restore doesn't delete the restored resources.
+func (c *restoreScheduleReconciler) pruneRestores(ctx context.Context, schedule *velerov1.RestoreSchedule, restores []velerov1.Restore) error {
+	if schedule.Spec.RestoresToKeep == nil {
+		return nil
+	}
+
+	kept := 0
+	for i := range restores {
+		restore := &restores[i]
+		if !restoreFinished(restore) || !restore.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		kept++
+		if kept <= int(*schedule.Spec.RestoresToKeep) {
+			continue
+		}
+
+		c.logger.WithField("restoreSchedule", kube.NamespaceAndName(schedule)).Infof("Deleting restore %s beyond the restores to keep", kube.NamespaceAndName(restore))
+		if err := c.Delete(ctx, restore); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error deleting restore %s", kube.NamespaceAndName(restore))
+		}
+	}
+
+	return nil
+}
+
+// ifDue check whether restore schedule is due to create a new restore.
+func (c *restoreScheduleReconciler) ifDue(schedule *velerov1.RestoreSchedule, cronSchedule cron.Schedule, log logrus.FieldLogger) bool {
+	lastRestoreTime := schedule.CreationTimestamp.Time
+	if schedule.Status.LastRestore != nil {
+		lastRestoreTime = schedule.Status.LastRestore.Time
+	}
+
+	nextRunTime := cronSchedule.Next(lastRestoreTime)
+	if !c.clock.Now().After(nextRunTime) {
+		log.WithField("nextRunTime", nextRunTime).Debug("Restore schedule is not due, skipping")
+		return false
+	}
+
+	return true
+}
+
+// submitRestore create a restore from restore schedule.
+func (c *restoreScheduleReconciler) submitRestore(ctx context.Context, schedule *velerov1.RestoreSchedule) error {
+	c.logger.WithField("restoreSchedule", kube.NamespaceAndName(schedule)).Info("Restore schedule is due, going to submit restore.")
+
+	now := c.clock.Now()
+	// Don't attempt to "catch up" if there are any missed or failed runs - simply
+	// trigger a Restore if it's time.
+	restore := builder.ForRestore(schedule.Namespace, schedule.TimestampedName(now)).FromRestoreSchedule(schedule).Result()
+	if err := c.Create(ctx, restore); err != nil {
+		return errors.Wrap(err, "error creating Restore")
+	}
+
+	schedule.Status.LastRestore = &metav1.Time{Time: now}
+
+	return nil
+}
+
+// restoreInProgress returns true if any of the restores isn't finished yet.
+func restoreInProgress(restores []velerov1.Restore) bool {
+	for i := range restores {
+		if !restoreFinished(&restores[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// restoreFinished returns true if the restore is in a terminal phase.
+func restoreFinished(restore *velerov1.Restore) bool {
+	switch restore.Status.Phase {
+	case velerov1.RestorePhaseCompleted,
+		velerov1.RestorePhasePartiallyFailed,
+		velerov1.RestorePhaseFailed,
+		velerov1.RestorePhaseFailedValidation,
+		velerov1.RestorePhaseDryRunCompleted:
+		return true
+	}
+	return false
+}
+
+// restoreSucceeded returns true if the restore finished without any errors.
+func restoreSucceeded(restore *velerov1.Restore) bool {
+	return restore.Status.Phase == velerov1.RestorePhaseCompleted || restore.Status.Phase == velerov1.RestorePhaseDryRunCompleted
+}
@@ -0,0 +1,168 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestReconcileOfRestoreSchedule(t *testing.T) {
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	template := builder.ForRestore("", "").Schedule("daily").NamespaceMappings("app", "app-restore-test").Result().Spec
+
+	newRestore := func(name string, created time.Time, phase velerov1.RestorePhase) *velerov1.Restore {
+		return builder.ForRestore("velero", name).
+			ObjectMeta(
+				builder.WithLabels(velerov1.RestoreScheduleNameLabel, "restore-test"),
+				builder.WithCreationTimestamp(created),
+			).
+			Phase(phase).
+			CompletionTimestamp(created.Add(10 * time.Minute)).
+			Result()
+	}
+
+	tests := []struct {
+		name                          string
+		schedule                      *velerov1.RestoreSchedule
+		restores                      []*velerov1.Restore
+		expectedPhase                 velerov1.SchedulePhase
+		expectedValidationErrors      []string
+		expectedRestoreCreated        bool
+		expectedLastRestoreName       string
+		expectedLastRestorePhase      velerov1.RestorePhase
+		expectedLastSuccessfulRestore *time.Time
+		expectedRestores              []string
+	}{
+		{
+			name: "schedule without backup schedule fails validation",
+			schedule: builder.ForRestoreSchedule("velero", "restore-test").CronSchedule("0 * * * *").
+				Template(builder.ForRestore("", "").Backup("backup-1").Result().Spec).Result(),
+			expectedPhase: velerov1.SchedulePhaseFailedValidation,
+			expectedValidationErrors: []string{
+				"template.scheduleName must be set to the schedule whose most recent backup is restored",
+				"template.backupName must not be set, the most recent backup of the schedule is restored",
+			},
+		},
+		{
+			name:                   "due schedule creates a restore",
+			schedule:               builder.ForRestoreSchedule("velero", "restore-test").CronSchedule("0 * * * *").Template(template).LastRestoreTime(now.Add(-2 * time.Hour)).Result(),
+			expectedPhase:          velerov1.SchedulePhaseEnabled,
+			expectedRestoreCreated: true,
+			expectedRestores:       []string{"restore-test-20240102120000"},
+		},
+		{
+			name:          "schedule not due doesn't create a restore",
+			schedule:      builder.ForRestoreSchedule("velero", "restore-test").CronSchedule("0 0 * * *").Template(template).LastRestoreTime(now.Add(-time.Hour)).Result(),
+			expectedPhase: velerov1.SchedulePhaseEnabled,
+		},
+		{
+			name:             "due schedule doesn't create a restore while the previous restore is running",
+			schedule:         builder.ForRestoreSchedule("velero", "restore-test").CronSchedule("0 * * * *").Template(template).LastRestoreTime(now.Add(-2 * time.Hour)).Result(),
+			restores:         []*velerov1.Restore{newRestore("restore-test-1", now.Add(-2*time.Hour), velerov1.RestorePhaseInProgress)},
+			expectedPhase:    velerov1.SchedulePhaseEnabled,
+			expectedRestores: []string{"restore-test-1"},
+		},
+		{
+			name:     "last restore and last successful restore are recorded and old restores are pruned",
+			schedule: builder.ForRestoreSchedule("velero", "restore-test").CronSchedule("0 0 * * *").Template(template).LastRestoreTime(now.Add(-time.Hour)).RestoresToKeep(2).Result(),
+			restores: []*velerov1.Restore{
+				newRestore("restore-test-1", now.Add(-3*time.Hour), velerov1.RestorePhaseCompleted),
+				newRestore("restore-test-2", now.Add(-2*time.Hour), velerov1.RestorePhaseCompleted),
+				newRestore("restore-test-3", now.Add(-time.Hour), velerov1.RestorePhasePartiallyFailed),
+			},
+			expectedPhase:                 velerov1.SchedulePhaseEnabled,
+			expectedLastRestoreName:       "restore-test-3",
+			expectedLastRestorePhase:      velerov1.RestorePhasePartiallyFailed,
+			expectedLastSuccessfulRestore: func() *time.Time { t := now.Add(-2 * time.Hour).Add(10 * time.Minute); return &t }(),
+			expectedRestores:              []string{"restore-test-2", "restore-test-3"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objs := []runtime.Object{test.schedule}
+			for _, restore := range test.restores {
+				objs = append(objs, restore)
+			}
+			client := velerotest.NewFakeControllerRuntimeClient(t, objs...)
+
+			reconciler := NewRestoreScheduleReconciler("velero", velerotest.NewLogger(), client, metrics.NewServerMetrics())
+			reconciler.clock = testclocks.NewFakeClock(now)
+
+			_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "velero", Name: "restore-test"}})
+			require.NoError(t, err)
+
+			schedule := &velerov1.RestoreSchedule{}
+			require.NoError(t, client.Get(context.Background(), types.NamespacedName{Namespace: "velero", Name: "restore-test"}, schedule))
+			assert.Equal(t, test.expectedPhase, schedule.Status.Phase)
+			assert.Equal(t, test.expectedValidationErrors, schedule.Status.ValidationErrors)
+			assert.Equal(t, test.expectedLastRestoreName, schedule.Status.LastRestoreName)
+			assert.Equal(t, test.expectedLastRestorePhase, schedule.Status.LastRestorePhase)
+			if test.expectedLastSuccessfulRestore == nil {
+				assert.Nil(t, schedule.Status.LastSuccessfulRestore)
+			} else {
+				require.NotNil(t, schedule.Status.LastSuccessfulRestore)
+				assert.True(t, test.expectedLastSuccessfulRestore.Equal(schedule.Status.LastSuccessfulRestore.Time))
+			}
+			if test.expectedRestoreCreated {
+				require.NotNil(t, schedule.Status.LastRestore)
+				assert.True(t, now.Equal(schedule.Status.LastRestore.Time))
+			}
+
+			restores := &velerov1.RestoreList{}
+			require.NoError(t, client.List(context.Background(), restores))
+			var names []string
+			for _, restore := range restores.Items {
+				names = append(names, restore.Name)
+				if restore.Name == "restore-test-20240102120000" {
+					assert.Equal(t, "restore-test", restore.Labels[velerov1.RestoreScheduleNameLabel])
+					assert.Equal(t, "daily", restore.Spec.ScheduleName)
+					assert.Equal(t, map[string]string{"app": "app-restore-test"}, restore.Spec.NamespaceMapping)
+				}
+			}
+			assert.ElementsMatch(t, test.expectedRestores, names)
+		})
+	}
+}
+
+func TestRestoreScheduleIgnoresRestoresOfOtherSchedules(t *testing.T) {
+	schedule := builder.ForRestoreSchedule("velero", "restore-test").Result()
+	other := builder.ForRestore("velero", "other").ObjectMeta(builder.WithLabels(velerov1.RestoreScheduleNameLabel, "other")).Result()
+	reconciler := NewRestoreScheduleReconciler("velero", velerotest.NewLogger(), velerotest.NewFakeControllerRuntimeClient(t, schedule, other), metrics.NewServerMetrics())
+
+	restores, err := reconciler.listRestores(context.Background(), schedule)
+	require.NoError(t, err)
+	assert.Empty(t, restores)
+	assert.False(t, restoreInProgress(restores))
+	assert.True(t, restoreInProgress([]velerov1.Restore{*other}))
+	assert.False(t, restoreFinished(&velerov1.Restore{Status: velerov1.RestoreStatus{Phase: velerov1.RestorePhaseWaitingForPluginOperations}}))
+	assert.True(t, restoreSucceeded(&velerov1.Restore{Status: velerov1.RestoreStatus{Phase: velerov1.RestorePhaseCompleted}}))
+}
@@ -19,6 +19,9 @@ package controller
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -27,6 +30,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
 	clocks "k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	bld "sigs.k8s.io/controller-runtime/pkg/builder"
@@ -42,26 +46,40 @@ const (
 	scheduleSyncPeriod = time.Minute
 )
 
+// backupNameTemplateValidationTime is the time the name templates of the backups are rendered at
+// to validate them, the length of the rendered names doesn't depend on the time.
+var backupNameTemplateValidationTime = time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+
 type scheduleReconciler struct {
 	client.Client
-	namespace string
-	logger    logrus.FieldLogger
-	clock     clocks.WithTickerAndDelayedExecution
-	metrics   *metrics.ServerMetrics
+	namespace   string
+	clusterName string
+	logger      logrus.FieldLogger
+	clock       clocks.WithTickerAndDelayedExecution
+	metrics     *metrics.ServerMetrics
+
+	// recordedSchedules are the schedules whose last successful backup has been recorded in the metrics from the
+	// existing backups since the server started, the later successes are recorded when the backups complete
+	recordedSchedules     map[string]struct{}
+	recordedSchedulesLock sync.Mutex
 }
 
 func NewScheduleReconciler(
 	namespace string,
+	clusterName string,
 	logger logrus.FieldLogger,
 	client client.Client,
 	metrics *metrics.ServerMetrics,
 ) *scheduleReconciler {
 	return &scheduleReconciler{
-		Client:    client,
-		namespace: namespace,
-		logger:    logger,
-		clock:     clocks.RealClock{},
-		metrics:   metrics,
+		Client:      client,
+		namespace:   namespace,
+		clusterName: clusterName,
+		logger:      logger,
+		clock:       clocks.RealClock{},
+		metrics:     metrics,
+
+		recordedSchedules: map[string]struct{}{},
 	}
 }
 
@@ -79,6 +97,7 @@ func (c *scheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		})).
 		For(&velerov1.Schedule{}, bld.WithPredicates(kube.SpecChangePredicate{})).
 		Watches(s, nil).
+		WithOptions(controllerOptions(Schedule)).
 		Complete(c)
 }
 
@@ -95,12 +114,16 @@ func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		if apierrors.IsNotFound(err) {
 			log.WithError(err).Error("schedule not found")
 			c.metrics.RemoveSchedule(req.Name)
+			c.recordedSchedulesLock.Lock()
+			delete(c.recordedSchedules, req.Name)
+			c.recordedSchedulesLock.Unlock()
 			return ctrl.Result{}, nil
 		}
 		return ctrl.Result{}, errors.Wrapf(err, "error getting schedule %s", req.String())
 	}
 
 	c.metrics.InitSchedule(schedule.Name)
+	c.recordLastSuccessfulBackup(ctx, schedule)
 
 	original := schedule.DeepCopy()
 
@@ -109,6 +132,11 @@ func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	currentPhase := schedule.Status.Phase
 
 	cronSchedule, errs := parseCronSchedule(schedule, c.logger)
+	nameErrs, err := c.validateBackupNameTemplate(ctx, schedule)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "error validating backup name template of schedule %s", req.String())
+	}
+	errs = append(errs, nameErrs...)
 	if len(errs) > 0 {
 		schedule.Status.Phase = velerov1.SchedulePhaseFailedValidation
 		schedule.Status.ValidationErrors = errs
@@ -129,70 +157,139 @@ func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	}
 
 	// Check for the schedule being due to run.
-	// If there are backup created by this schedule still in New or InProgress state,
-	// skip current backup creation to avoid running overlap backups.
 	// As the schedule must be validated before checking whether it's due, we cannot put the checking log in Predicate
-	if c.ifDue(schedule, cronSchedule) && !c.checkIfBackupInNewOrProgress(schedule) {
-		if err := c.submitBackup(ctx, schedule); err != nil {
-			return ctrl.Result{}, errors.Wrapf(err, "error submit backup for schedule %s", req.String())
+	if !c.ifDue(schedule, cronSchedule) {
+		return ctrl.Result{}, nil
+	}
+
+	switch schedule.Spec.ConcurrencyPolicy {
+	case velerov1.ConcurrencyPolicyAllow:
+	case velerov1.ConcurrencyPolicyReplace:
+		if err := c.replaceBackupsInNew(ctx, schedule); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error replacing backups of schedule %s", req.String())
 		}
+	default:
+		// If there are backup created by this schedule still in New or InProgress state,
+		// skip current backup creation to avoid running overlap backups.
+		if c.checkIfBackupInNewOrProgress(schedule) {
+			return ctrl.Result{}, nil
+		}
+	}
+
+	if err := c.submitBackup(ctx, schedule); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "error submit backup for schedule %s", req.String())
 	}
 
 	return ctrl.Result{}, nil
 }
 
 func parseCronSchedule(itm *velerov1.Schedule, logger logrus.FieldLogger) (cron.Schedule, []string) {
+	schedule, validationErrors := parseCronExpression(itm.Spec.Schedule, logger.WithField("schedule", kube.NamespaceAndName(itm)))
+
+	if itm.Spec.Jitter != nil && itm.Spec.Jitter.Duration < 0 {
+		validationErrors = append(validationErrors, "jitter must not be negative")
+	}
+
+	if itm.Spec.StartingDeadline != nil && itm.Spec.StartingDeadline.Duration < 0 {
+		validationErrors = append(validationErrors, "starting deadline must not be negative")
+	}
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	return schedule, nil
+}
+
+// parseCronExpression parses the Cron expression of a schedule, the errors are returned as validation errors.
+func parseCronExpression(expression string, log logrus.FieldLogger) (cron.Schedule, []string) {
 	var validationErrors []string
 	var schedule cron.Schedule
 
 	// cron.Parse panics if schedule is empty
-	if len(itm.Spec.Schedule) == 0 {
+	if len(expression) == 0 {
 		validationErrors = append(validationErrors, "Schedule must be a non-empty valid Cron expression")
 		return nil, validationErrors
 	}
 
-	log := logger.WithField("schedule", kube.NamespaceAndName(itm))
-
 	// adding a recover() around cron.Parse because it panics on empty string and is possible
 	// that it panics under other scenarios as well.
 	func() {
 		defer func() {
 			if r := recover(); r != nil {
 				log.WithFields(logrus.Fields{
-					"schedule": itm.Spec.Schedule,
+					"schedule": expression,
 					"recover":  r,
 				}).Debug("Panic parsing schedule")
 				validationErrors = append(validationErrors, fmt.Sprintf("invalid schedule: %v", r))
 			}
 		}()
 
-		if res, err := cron.ParseStandard(itm.Spec.Schedule); err != nil {
-			log.WithError(errors.WithStack(err)).WithField("schedule", itm.Spec.Schedule).Debug("Error parsing schedule")
+		if res, err := cron.ParseStandard(expression); err != nil {
+			log.WithError(errors.WithStack(err)).WithField("schedule", expression).Debug("Error parsing schedule")
 			validationErrors = append(validationErrors, fmt.Sprintf("invalid schedule: %v", err))
 		} else {
 			schedule = res
 		}
 	}()
 
+	return schedule, validationErrors
+}
+
+// validateBackupNameTemplate validates the name template of the schedule's backups, the names must be
+// valid object names, and must not conflict with the names of the backups created by the other schedules
+// or at other times. The errors are returned as validation errors.
+func (c *scheduleReconciler) validateBackupNameTemplate(ctx context.Context, schedule *velerov1.Schedule) ([]string, error) {
+	template := schedule.Spec.Template.NameTemplate
+	if template == "" {
+		return nil, nil
+	}
+
+	var validationErrors []string
+	if !strings.Contains(template, velerov1.BackupNameTokenTimestamp) &&
+		!(strings.Contains(template, velerov1.BackupNameTokenDate) && strings.Contains(template, velerov1.BackupNameTokenTime)) {
+		validationErrors = append(validationErrors, fmt.Sprintf("backup name template must contain the %s token, or both the %s and %s tokens",
+			velerov1.BackupNameTokenTimestamp, velerov1.BackupNameTokenDate, velerov1.BackupNameTokenTime))
+	}
+	if strings.Contains(template, velerov1.BackupNameTokenCluster) && c.clusterName == "" {
+		validationErrors = append(validationErrors, fmt.Sprintf("backup name template contains the %s token but the cluster name of the server isn't set", velerov1.BackupNameTokenCluster))
+	}
 	if len(validationErrors) > 0 {
-		return nil, validationErrors
+		return validationErrors, nil
 	}
 
-	return schedule, nil
+	name := schedule.BackupName(backupNameTemplateValidationTime, c.clusterName)
+	if strings.Contains(name, "{{") {
+		return []string{fmt.Sprintf("backup name template %q contains unknown tokens", template)}, nil
+	}
+	for _, msg := range validation.IsDNS1123Subdomain(name) {
+		validationErrors = append(validationErrors, fmt.Sprintf("invalid backup name %q rendered from the template: %s", name, msg))
+	}
+	if len(validationErrors) > 0 {
+		return validationErrors, nil
+	}
+
+	schedules := &velerov1.ScheduleList{}
+	if err := c.List(ctx, schedules, &client.ListOptions{Namespace: schedule.Namespace}); err != nil {
+		return nil, errors.Wrap(err, "error listing schedules")
+	}
+	for i := range schedules.Items {
+		other := &schedules.Items[i]
+		if other.Name == schedule.Name {
+			continue
+		}
+		if other.BackupName(backupNameTemplateValidationTime, c.clusterName) == name {
+			validationErrors = append(validationErrors, fmt.Sprintf("backup names rendered from the template conflict with the backups of schedule %s", other.Name))
+		}
+	}
+
+	return validationErrors, nil
 }
 
 // checkIfBackupInNewOrProgress check whether there are backups created by this schedule still in New or InProgress state
 func (c *scheduleReconciler) checkIfBackupInNewOrProgress(schedule *velerov1.Schedule) bool {
 	log := c.logger.WithField("schedule", kube.NamespaceAndName(schedule))
-	backupList := &velerov1.BackupList{}
-	options := &client.ListOptions{
-		Namespace: schedule.Namespace,
-		LabelSelector: labels.Set(map[string]string{
-			velerov1.ScheduleNameLabel: schedule.Name,
-		}).AsSelector(),
-	}
-
-	err := c.List(context.Background(), backupList, options)
+	backupList, err := c.listBackups(context.Background(), schedule)
 	if err != nil {
 		log.Errorf("fail to list backup for schedule %s/%s: %s", schedule.Namespace, schedule.Name, err.Error())
 		return true
@@ -207,6 +304,75 @@ func (c *scheduleReconciler) checkIfBackupInNewOrProgress(schedule *velerov1.Sch
 	return false
 }
 
+// replaceBackupsInNew fails the backups created by this schedule which are still in New state, so
+// they're replaced by the new backup. The backups in InProgress state can't be cancelled and keep running.
+func (c *scheduleReconciler) replaceBackupsInNew(ctx context.Context, schedule *velerov1.Schedule) error {
+	log := c.logger.WithField("schedule", kube.NamespaceAndName(schedule))
+	backupList, err := c.listBackups(ctx, schedule)
+	if err != nil {
+		return errors.Wrap(err, "error listing backups")
+	}
+
+	for i := range backupList.Items {
+		backup := &backupList.Items[i]
+		switch backup.Status.Phase {
+		case velerov1.BackupPhaseNew:
+			original := backup.DeepCopy()
+			backup.Status.Phase = velerov1.BackupPhaseFailed
+			backup.Status.FailureReason = "replaced by a newer backup of the schedule"
+			backup.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+			// the optimistic lock makes sure the backup isn't started in the meantime
+			if err := c.Patch(ctx, backup, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{})); err != nil {
+				return errors.Wrapf(err, "error failing backup %s", kube.NamespaceAndName(backup))
+			}
+			log.Infof("Backup %s in New state is replaced", kube.NamespaceAndName(backup))
+		case velerov1.BackupPhaseInProgress:
+			log.Infof("Backup %s is still InProgress and can't be cancelled, keep it running", kube.NamespaceAndName(backup))
+		}
+	}
+
+	return nil
+}
+
+// recordLastSuccessfulBackup records the completion time of the last successful backup of the schedule in the metrics
+// once after the server starts, so that the metric is available for the existing backups
+func (c *scheduleReconciler) recordLastSuccessfulBackup(ctx context.Context, schedule *velerov1.Schedule) {
+	c.recordedSchedulesLock.Lock()
+	defer c.recordedSchedulesLock.Unlock()
+
+	if _, recorded := c.recordedSchedules[schedule.Name]; recorded {
+		return
+	}
+
+	backupList, err := c.listBackups(ctx, schedule)
+	if err != nil {
+		c.logger.WithField("schedule", kube.NamespaceAndName(schedule)).WithError(err).Warn("Failed to list backups to record the last successful backup")
+		return
+	}
+
+	if timestamp, ok := getLastSuccessBySchedule(backupList.Items)[schedule.Name]; ok {
+		c.metrics.SetScheduleLastSuccessfulBackupTimestamp(schedule.Name, timestamp)
+	}
+	c.recordedSchedules[schedule.Name] = struct{}{}
+}
+
+// listBackups lists the backups created by this schedule
+func (c *scheduleReconciler) listBackups(ctx context.Context, schedule *velerov1.Schedule) (*velerov1.BackupList, error) {
+	backupList := &velerov1.BackupList{}
+	options := &client.ListOptions{
+		Namespace: schedule.Namespace,
+		LabelSelector: labels.Set(map[string]string{
+			velerov1.ScheduleNameLabel: schedule.Name,
+		}).AsSelector(),
+	}
+
+	if err := c.List(ctx, backupList, options); err != nil {
+		return nil, err
+	}
+
+	return backupList, nil
+}
+
 // ifDue check whether schedule is due to create a new backup.
 func (c *scheduleReconciler) ifDue(schedule *velerov1.Schedule, cronSchedule cron.Schedule) bool {
 	isDue, nextRunTime := getNextRunTime(schedule, cronSchedule, c.clock.Now())
@@ -217,6 +383,11 @@ func (c *scheduleReconciler) ifDue(schedule *velerov1.Schedule, cronSchedule cro
 		return false
 	}
 
+	if deadline := schedule.Spec.StartingDeadline; deadline != nil && c.clock.Now().After(nextRunTime.Add(deadline.Duration)) {
+		log.WithField("nextRunTime", nextRunTime).Infof("Schedule missed the starting deadline %s, skipping", deadline.Duration)
+		return false
+	}
+
 	return true
 }
 
@@ -227,7 +398,7 @@ func (c *scheduleReconciler) submitBackup(ctx context.Context, schedule *velerov
 	now := c.clock.Now()
 	// Don't attempt to "catch up" if there are any missed or failed runs - simply
 	// trigger a Backup if it's time.
-	backup := getBackup(schedule, now)
+	backup := getBackup(schedule, now, c.clusterName)
 	if err := c.Create(ctx, backup); err != nil {
 		return errors.Wrap(err, "error creating Backup")
 	}
@@ -252,11 +423,35 @@ func getNextRunTime(schedule *velerov1.Schedule, cronSchedule cron.Schedule, asO
 
 	nextRunTime := cronSchedule.Next(lastBackupTime)
 
+	// With a starting deadline, only the most recent missed run is considered
+	// as the earlier ones are past their deadlines anyway.
+	if schedule.Spec.StartingDeadline != nil {
+		for next := cronSchedule.Next(nextRunTime); !next.After(asOf); next = cronSchedule.Next(next) {
+			nextRunTime = next
+		}
+	}
+
+	nextRunTime = nextRunTime.Add(getJitter(schedule, nextRunTime))
+
 	return asOf.After(nextRunTime), nextRunTime
 }
 
-func getBackup(item *velerov1.Schedule, timestamp time.Time) *velerov1.Backup {
-	name := item.TimestampedName(timestamp)
+// getJitter returns the delay added to the run time of the schedule. The delay is derived
+// from the schedule and the run time rather than generated randomly, so that it keeps the
+// same across the reconciles and the restarts of the server.
+func getJitter(schedule *velerov1.Schedule, runTime time.Time) time.Duration {
+	if schedule.Spec.Jitter == nil || schedule.Spec.Jitter.Duration <= 0 {
+		return 0
+	}
+
+	hash := fnv.New64a()
+	hash.Write([]byte(fmt.Sprintf("%s/%s/%s/%d", schedule.Namespace, schedule.Name, schedule.UID, runTime.Unix())))
+
+	return time.Duration(hash.Sum64() % uint64(schedule.Spec.Jitter.Duration))
+}
+
+func getBackup(item *velerov1.Schedule, timestamp time.Time, clusterName string) *velerov1.Backup {
+	name := item.BackupName(timestamp, clusterName)
 	return builder.
 		ForBackup(item.Namespace, name).
 		FromSchedule(item).
@@ -17,9 +17,14 @@ limitations under the License.
 package controller
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/robfig/cron"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,6 +33,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	testclocks "k8s.io/utils/clock/testing"
 	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -120,7 +126,7 @@ func TestReconcileOfSchedule(t *testing.T) {
 				err      error
 			)
 
-			reconciler := NewScheduleReconciler("namespace", logger, client, metrics.NewServerMetrics())
+			reconciler := NewScheduleReconciler("namespace", "", logger, client, metrics.NewServerMetrics())
 
 			if test.fakeClockTime != "" {
 				testTime, err = time.Parse("2006-01-02 15:04:05", test.fakeClockTime)
@@ -176,6 +182,78 @@ func TestReconcileOfSchedule(t *testing.T) {
 	}
 }
 
+func TestReconcileOfScheduleConcurrencyPolicy(t *testing.T) {
+	require.Nil(t, velerov1.AddToScheme(scheme.Scheme))
+
+	tests := []struct {
+		name           string
+		policy         velerov1.ConcurrencyPolicy
+		backupPhase    velerov1.BackupPhase
+		expectedPhases map[string]velerov1.BackupPhase
+	}{
+		{
+			name:        "Forbid skips the backup if a previous one is InProgress",
+			policy:      velerov1.ConcurrencyPolicyForbid,
+			backupPhase: velerov1.BackupPhaseInProgress,
+			expectedPhases: map[string]velerov1.BackupPhase{
+				"name-20220905120000": velerov1.BackupPhaseInProgress,
+			},
+		},
+		{
+			name:        "Allow submits the backup if a previous one is InProgress",
+			policy:      velerov1.ConcurrencyPolicyAllow,
+			backupPhase: velerov1.BackupPhaseInProgress,
+			expectedPhases: map[string]velerov1.BackupPhase{
+				"name-20220905120000": velerov1.BackupPhaseInProgress,
+				"name-20230101120000": "",
+			},
+		},
+		{
+			name:        "Replace fails the previous backup in New and submits the backup",
+			policy:      velerov1.ConcurrencyPolicyReplace,
+			backupPhase: velerov1.BackupPhaseNew,
+			expectedPhases: map[string]velerov1.BackupPhase{
+				"name-20220905120000": velerov1.BackupPhaseFailed,
+				"name-20230101120000": "",
+			},
+		},
+		{
+			name:        "Replace keeps the previous backup InProgress running and submits the backup",
+			policy:      velerov1.ConcurrencyPolicyReplace,
+			backupPhase: velerov1.BackupPhaseInProgress,
+			expectedPhases: map[string]velerov1.BackupPhase{
+				"name-20220905120000": velerov1.BackupPhaseInProgress,
+				"name-20230101120000": "",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := (&fake.ClientBuilder{}).Build()
+			reconciler := NewScheduleReconciler("namespace", "", velerotest.NewLogger(), client, metrics.NewServerMetrics())
+			reconciler.clock = testclocks.NewFakeClock(parseTime("2023-01-01 12:00:00"))
+
+			schedule := builder.ForSchedule("ns", "name").Phase(velerov1.SchedulePhaseEnabled).CronSchedule("@every 5m").
+				LastBackupTime("2022-09-05 12:00:00").ConcurrencyPolicy(test.policy).Result()
+			require.Nil(t, client.Create(ctx, schedule))
+			backup := builder.ForBackup("ns", "name-20220905120000").ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "name")).Phase(test.backupPhase).Result()
+			require.Nil(t, client.Create(ctx, backup))
+
+			_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "name"}})
+			require.Nil(t, err)
+
+			backups := &velerov1.BackupList{}
+			require.Nil(t, client.List(ctx, backups))
+			phases := map[string]velerov1.BackupPhase{}
+			for _, backup := range backups.Items {
+				phases[backup.Name] = backup.Status.Phase
+			}
+			assert.Equal(t, test.expectedPhases, phases)
+		})
+	}
+}
+
 func parseTime(timeString string) time.Time {
 	res, _ := time.Parse("2006-01-02 15:04:05", timeString)
 	return res
@@ -268,6 +346,45 @@ func TestGetNextRunTime(t *testing.T) {
 	}
 }
 
+func TestGetNextRunTimeWithJitterAndStartingDeadline(t *testing.T) {
+	lastBackup := time.Date(2017, 8, 10, 9, 0, 0, 0, time.UTC)
+	c, err := cron.ParseStandard("0 9 * * *")
+	require.NoError(t, err)
+
+	// the jitter is within the window and keeps the same for the same run
+	s := builder.ForSchedule("velero", "schedule-1").CronSchedule("0 9 * * *").Jitter(30 * time.Minute).LastBackupTime(lastBackup.Format("2006-01-02 15:04:05")).Result()
+	due, next := getNextRunTime(s, c, lastBackup.Add(time.Hour))
+	assert.False(t, due)
+	runTime := time.Date(2017, 8, 11, 9, 0, 0, 0, time.UTC)
+	assert.False(t, next.Before(runTime))
+	assert.True(t, next.Before(runTime.Add(30*time.Minute)))
+	_, again := getNextRunTime(s, c, lastBackup.Add(2*time.Hour))
+	assert.Equal(t, next, again)
+
+	due, _ = getNextRunTime(s, c, runTime.Add(30*time.Minute))
+	assert.True(t, due)
+
+	// the jitters of the schedules with the same cron expression differ
+	other := builder.ForSchedule("velero", "schedule-2").CronSchedule("0 9 * * *").Jitter(30 * time.Minute).LastBackupTime(lastBackup.Format("2006-01-02 15:04:05")).Result()
+	_, otherNext := getNextRunTime(other, c, lastBackup.Add(time.Hour))
+	assert.NotEqual(t, next, otherNext)
+
+	// only the most recent missed run is considered with a starting deadline
+	s = builder.ForSchedule("velero", "schedule-1").CronSchedule("0 9 * * *").StartingDeadline(time.Hour).LastBackupTime(lastBackup.Format("2006-01-02 15:04:05")).Result()
+	now := time.Date(2017, 8, 13, 9, 30, 0, 0, time.UTC)
+	due, next = getNextRunTime(s, c, now)
+	assert.True(t, due)
+	assert.Equal(t, time.Date(2017, 8, 13, 9, 0, 0, 0, time.UTC), next)
+
+	reconciler := NewScheduleReconciler("velero", "", velerotest.NewLogger(), nil, metrics.NewServerMetrics())
+	reconciler.clock = testclocks.NewFakeClock(now)
+	assert.True(t, reconciler.ifDue(s, c))
+
+	// the backup isn't started after the starting deadline
+	reconciler.clock = testclocks.NewFakeClock(time.Date(2017, 8, 13, 10, 30, 0, 0, time.UTC))
+	assert.False(t, reconciler.ifDue(s, c))
+}
+
 func TestParseCronSchedule(t *testing.T) {
 	// From https://github.com/vmware-tanzu/velero/issues/30, where we originally were using cron.Parse(),
 	// which treats the first field as seconds, and not minutes. We want to use cron.ParseStandard()
@@ -316,6 +433,7 @@ func TestGetBackup(t *testing.T) {
 	tests := []struct {
 		name           string
 		schedule       *velerov1.Schedule
+		clusterName    string
 		testClockTime  string
 		expectedBackup *velerov1.Backup
 	}{
@@ -367,6 +485,18 @@ func TestGetBackup(t *testing.T) {
 			testClockTime:  "2017-07-25 14:15:00",
 			expectedBackup: builder.ForBackup("foo", "bar-20170725141500").ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "bar"), builder.WithAnnotations("bar", "baz", "foo", "bar")).Result(),
 		},
+		{
+			name: "ensure name is rendered from the name template",
+			schedule: builder.ForSchedule("foo", "bar").
+				Template(velerov1.BackupSpec{Metadata: velerov1.Metadata{NameTemplate: "{{cluster}}-{{schedule}}-{{date}}-{{time}}"}}).
+				Result(),
+			clusterName:   "prod",
+			testClockTime: "2017-07-25 14:15:00",
+			expectedBackup: builder.ForBackup("foo", "prod-bar-20170725-141500").
+				ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "bar")).
+				NameTemplate("{{cluster}}-{{schedule}}-{{date}}-{{time}}").
+				Result(),
+		},
 	}
 
 	for _, test := range tests {
@@ -374,7 +504,7 @@ func TestGetBackup(t *testing.T) {
 			testTime, err := time.Parse("2006-01-02 15:04:05", test.testClockTime)
 			require.NoError(t, err, "unable to parse test.testClockTime: %v", err)
 
-			backup := getBackup(test.schedule, testclocks.NewFakeClock(testTime).Now())
+			backup := getBackup(test.schedule, testclocks.NewFakeClock(testTime).Now(), test.clusterName)
 
 			assert.Equal(t, test.expectedBackup.Namespace, backup.Namespace)
 			assert.Equal(t, test.expectedBackup.Name, backup.Name)
@@ -385,6 +515,78 @@ func TestGetBackup(t *testing.T) {
 	}
 }
 
+func TestValidateBackupNameTemplate(t *testing.T) {
+	withTemplate := func(name, template string) *velerov1.Schedule {
+		return builder.ForSchedule("ns", name).Template(velerov1.BackupSpec{Metadata: velerov1.Metadata{NameTemplate: template}}).Result()
+	}
+
+	tests := []struct {
+		name           string
+		schedule       *velerov1.Schedule
+		clusterName    string
+		existing       []*velerov1.Schedule
+		expectedErrors []string
+	}{
+		{
+			name:     "no name template",
+			schedule: builder.ForSchedule("ns", "daily").Result(),
+		},
+		{
+			name:        "valid name template",
+			schedule:    withTemplate("daily", "{{cluster}}-{{schedule}}-{{timestamp}}"),
+			clusterName: "prod",
+			existing:    []*velerov1.Schedule{builder.ForSchedule("ns", "weekly").Result(), withTemplate("hourly", "{{cluster}}-{{schedule}}-{{timestamp}}")},
+		},
+		{
+			name:           "name template without the time",
+			schedule:       withTemplate("daily", "{{schedule}}-{{date}}"),
+			expectedErrors: []string{"backup name template must contain the {{timestamp}} token, or both the {{date}} and {{time}} tokens"},
+		},
+		{
+			name:           "cluster name isn't set",
+			schedule:       withTemplate("daily", "{{cluster}}-{{schedule}}-{{timestamp}}"),
+			expectedErrors: []string{"backup name template contains the {{cluster}} token but the cluster name of the server isn't set"},
+		},
+		{
+			name:           "unknown token",
+			schedule:       withTemplate("daily", "{{namespace}}-{{timestamp}}"),
+			expectedErrors: []string{`backup name template "{{namespace}}-{{timestamp}}" contains unknown tokens`},
+		},
+		{
+			name:     "invalid name",
+			schedule: withTemplate("daily", "Daily_{{timestamp}}"),
+			expectedErrors: []string{
+				`invalid backup name "Daily_20060102150405" rendered from the template: a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`,
+			},
+		},
+		{
+			name:           "name too long",
+			schedule:       withTemplate("daily", strings.Repeat("a", 240)+"-{{timestamp}}"),
+			expectedErrors: []string{fmt.Sprintf("invalid backup name %q rendered from the template: must be no more than 253 characters", strings.Repeat("a", 240)+"-20060102150405")},
+		},
+		{
+			name:           "conflict with the backups of another schedule",
+			schedule:       withTemplate("daily", "hourly-{{timestamp}}"),
+			existing:       []*velerov1.Schedule{builder.ForSchedule("ns", "hourly").Result()},
+			expectedErrors: []string{"backup names rendered from the template conflict with the backups of schedule hourly"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t)
+			for _, schedule := range append(test.existing, test.schedule) {
+				require.NoError(t, client.Create(ctx, schedule))
+			}
+
+			reconciler := NewScheduleReconciler("ns", test.clusterName, velerotest.NewLogger(), client, metrics.NewServerMetrics())
+			errs, err := reconciler.validateBackupNameTemplate(ctx, test.schedule)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedErrors, errs)
+		})
+	}
+}
+
 func TestCheckIfBackupInNewOrProgress(t *testing.T) {
 	require.Nil(t, velerov1.AddToScheme(scheme.Scheme))
 
@@ -403,7 +605,7 @@ func TestCheckIfBackupInNewOrProgress(t *testing.T) {
 	err = client.Create(ctx, newBackup)
 	require.NoError(t, err, "fail to create backup in New phase in TestCheckIfBackupInNewOrProgress: %v", err)
 
-	reconciler := NewScheduleReconciler("ns", logger, client, metrics.NewServerMetrics())
+	reconciler := NewScheduleReconciler("ns", "", logger, client, metrics.NewServerMetrics())
 	result := reconciler.checkIfBackupInNewOrProgress(testSchedule)
 	assert.True(t, result)
 
@@ -418,7 +620,52 @@ func TestCheckIfBackupInNewOrProgress(t *testing.T) {
 	err = client.Create(ctx, inProgressBackup)
 	require.NoError(t, err, "fail to create backup in InProgress phase in TestCheckIfBackupInNewOrProgress: %v", err)
 
-	reconciler = NewScheduleReconciler("namespace", logger, client, metrics.NewServerMetrics())
+	reconciler = NewScheduleReconciler("namespace", "", logger, client, metrics.NewServerMetrics())
 	result = reconciler.checkIfBackupInNewOrProgress(testSchedule)
 	assert.True(t, result)
 }
+
+// listCountingClient counts the List calls of the client
+type listCountingClient struct {
+	kbclient.Client
+	lists int
+}
+
+func (c *listCountingClient) List(ctx context.Context, list kbclient.ObjectList, opts ...kbclient.ListOption) error {
+	c.lists++
+	return c.Client.List(ctx, list, opts...)
+}
+
+func TestRecordLastSuccessfulBackup(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	defaultRegisterer := prometheus.DefaultRegisterer
+	prometheus.DefaultRegisterer = registry
+	defer func() { prometheus.DefaultRegisterer = defaultRegisterer }()
+
+	serverMetrics := metrics.NewServerMetrics()
+	serverMetrics.RegisterAllMetrics()
+
+	completion := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule := builder.ForSchedule("ns", "schedule-1").Result()
+	client := &listCountingClient{Client: velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForBackup("ns", "backup-1").ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "schedule-1")).
+			Phase(velerov1.BackupPhaseCompleted).CompletionTimestamp(completion.Add(-time.Hour)).Result(),
+		builder.ForBackup("ns", "backup-2").ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "schedule-1")).
+			Phase(velerov1.BackupPhaseCompleted).CompletionTimestamp(completion).Result(),
+		builder.ForBackup("ns", "backup-3").ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "schedule-1")).
+			Phase(velerov1.BackupPhaseFailed).CompletionTimestamp(completion.Add(time.Hour)).Result(),
+	)}
+	reconciler := NewScheduleReconciler("ns", "", velerotest.NewLogger(), client, serverMetrics)
+
+	// the last successful backup is recorded from the existing backups once after the server starts
+	reconciler.recordLastSuccessfulBackup(context.Background(), schedule)
+	reconciler.recordLastSuccessfulBackup(context.Background(), schedule)
+	assert.Equal(t, 1, client.lists)
+
+	expected := fmt.Sprintf(`
+# HELP velero_schedule_last_successful_backup_timestamp Last time a backup of the schedule completed successfully, Unix timestamp in seconds
+# TYPE velero_schedule_last_successful_backup_timestamp gauge
+velero_schedule_last_successful_backup_timestamp{schedule="schedule-1"} %d
+`, completion.Unix())
+	require.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected), "velero_schedule_last_successful_backup_timestamp"))
+}
@@ -27,7 +27,6 @@ import (
 	clocks "k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	"github.com/vmware-tanzu/velero/internal/velero"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -136,10 +135,10 @@ func (r *serverStatusRequestReconciler) Reconcile(ctx context.Context, req ctrl.
 }
 
 func (r *serverStatusRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	options := controllerOptions(ServerStatusRequest)
+	options.MaxConcurrentReconciles = 10
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&velerov1api.ServerStatusRequest{}).
-		WithOptions(controller.Options{
-			MaxConcurrentReconciles: 10,
-		}).
+		WithOptions(options).
 		Complete(r)
 }
@@ -34,28 +34,37 @@ import (
 )
 
 type fileSystemBR struct {
-	ctx            context.Context
-	cancel         context.CancelFunc
-	backupRepo     *velerov1api.BackupRepository
-	uploaderProv   provider.Provider
-	log            logrus.FieldLogger
-	client         client.Client
-	backupLocation *velerov1api.BackupStorageLocation
-	namespace      string
-	initialized    bool
-	callbacks      Callbacks
-	jobName        string
-	requestorType  string
+	ctx             context.Context
+	cancel          context.CancelFunc
+	backupRepo      *velerov1api.BackupRepository
+	uploaderProv    provider.Provider
+	log             logrus.FieldLogger
+	client          client.Client
+	backupLocation  *velerov1api.BackupStorageLocation
+	namespace       string
+	bandwidthLimits uploader.BandwidthLimits
+	cacheOptions    uploader.CacheOptions
+	initialized     bool
+	callbacks       Callbacks
+	jobName         string
+	requestorType   string
+	sourceNamespace string
+	uploaderType    string
+	repositoryType  string
+	replicaProv     provider.Provider
 }
 
-func newFileSystemBR(jobName string, requestorType string, client client.Client, namespace string, callbacks Callbacks, log logrus.FieldLogger) AsyncBR {
+func newFileSystemBR(jobName string, requestorType string, client client.Client, namespace string, bandwidthLimits uploader.BandwidthLimits,
+	cacheOptions uploader.CacheOptions, callbacks Callbacks, log logrus.FieldLogger) AsyncBR {
 	fs := &fileSystemBR{
-		jobName:       jobName,
-		requestorType: requestorType,
-		client:        client,
-		namespace:     namespace,
-		callbacks:     callbacks,
-		log:           log,
+		jobName:         jobName,
+		requestorType:   requestorType,
+		client:          client,
+		namespace:       namespace,
+		bandwidthLimits: bandwidthLimits,
+		cacheOptions:    cacheOptions,
+		callbacks:       callbacks,
+		log:             log,
 	}
 
 	return fs
@@ -87,17 +96,20 @@ func (fs *fileSystemBR) Init(ctx context.Context, bslName string, sourceNamespac
 		return errors.Wrapf(err, "error to ensure backup repository %s-%s-%s", bslName, sourceNamespace, repositoryType)
 	}
 
-	err = fs.boostRepoConnect(ctx, repositoryType, credentialGetter)
+	err = fs.boostRepoConnect(ctx, repositoryType, credentialGetter, fs.backupLocation, fs.backupRepo)
 	if err != nil {
 		return errors.Wrapf(err, "error to boost backup repository connection %s-%s-%s", bslName, sourceNamespace, repositoryType)
 	}
 
 	fs.uploaderProv, err = provider.NewUploaderProvider(ctx, fs.client, uploaderType, fs.requestorType, repoIdentifier,
-		fs.backupLocation, fs.backupRepo, credentialGetter, repokey.RepoKeySelector(), fs.log)
+		fs.backupLocation, fs.backupRepo, credentialGetter, repokey.RepoKeySelectorForRepo(fs.backupRepo), fs.bandwidthLimits, fs.cacheOptions, fs.log)
 	if err != nil {
 		return errors.Wrapf(err, "error creating uploader %s", uploaderType)
 	}
 
+	fs.sourceNamespace = sourceNamespace
+	fs.uploaderType = uploaderType
+	fs.repositoryType = repositoryType
 	fs.initialized = true
 
 	fs.log.WithFields(
@@ -121,6 +133,14 @@ func (fs *fileSystemBR) Close(ctx context.Context) {
 		fs.uploaderProv = nil
 	}
 
+	if fs.replicaProv != nil {
+		if err := fs.replicaProv.Close(ctx); err != nil {
+			fs.log.Errorf("failed to close replica uploader provider with error %v", err)
+		}
+
+		fs.replicaProv = nil
+	}
+
 	if fs.cancel != nil {
 		fs.cancel()
 		fs.cancel = nil
@@ -134,8 +154,12 @@ func (fs *fileSystemBR) StartBackup(source AccessPoint, realSource string, paren
 		return errors.New("file system data path is not initialized")
 	}
 
+	if err := repository.EnsureWritable(fs.backupRepo); err != nil {
+		return err
+	}
+
 	go func() {
-		snapshotID, emptySnapshot, err := fs.uploaderProv.RunBackup(fs.ctx, source.ByPath, realSource, tags, forceFull,
+		snapshotInfo, emptySnapshot, err := fs.uploaderProv.RunBackup(fs.ctx, source.ByPath, realSource, tags, forceFull,
 			parentSnapshot, source.VolMode, fs)
 
 		if err == provider.ErrorCanceled {
@@ -143,7 +167,13 @@ func (fs *fileSystemBR) StartBackup(source AccessPoint, realSource string, paren
 		} else if err != nil {
 			fs.callbacks.OnFailed(context.Background(), fs.namespace, fs.jobName, err)
 		} else {
-			fs.callbacks.OnCompleted(context.Background(), fs.namespace, fs.jobName, Result{Backup: BackupResult{snapshotID, emptySnapshot, source}})
+			result := BackupResult{EmptySnapshot: emptySnapshot, Source: source}
+			if snapshotInfo != nil {
+				result.SnapshotID = snapshotInfo.ID
+				result.Size = snapshotInfo.Size
+				result.UploadedBytes = snapshotInfo.UploadedBytes
+			}
+			fs.callbacks.OnCompleted(context.Background(), fs.namespace, fs.jobName, Result{Backup: result})
 		}
 	}()
 
@@ -170,6 +200,73 @@ func (fs *fileSystemBR) StartRestore(snapshotID string, target AccessPoint) erro
 	return nil
 }
 
+func (fs *fileSystemBR) StartVerify(snapshotID string, filesPercent int) error {
+	if !fs.initialized {
+		return errors.New("file system data path is not initialized")
+	}
+
+	go func() {
+		verifyErrors, err := fs.uploaderProv.RunVerify(fs.ctx, snapshotID, filesPercent, fs)
+
+		if err == provider.ErrorCanceled {
+			fs.callbacks.OnCancelled(context.Background(), fs.namespace, fs.jobName)
+		} else if err != nil {
+			fs.callbacks.OnFailed(context.Background(), fs.namespace, fs.jobName, err)
+		} else {
+			fs.callbacks.OnCompleted(context.Background(), fs.namespace, fs.jobName, Result{Verify: VerifyResult{Errors: verifyErrors}})
+		}
+	}()
+
+	return nil
+}
+
+func (fs *fileSystemBR) StartReplicate(snapshotID string, targetBSLName string, repositoryEnsurer *repository.Ensurer, credentialGetter *credentials.CredentialGetter) error {
+	if !fs.initialized {
+		return errors.New("file system data path is not initialized")
+	}
+
+	if fs.repositoryType != velerov1api.BackupRepositoryTypeKopia {
+		return errors.Errorf("replicate is not supported by %s repository", fs.repositoryType)
+	}
+
+	targetLocation := &velerov1api.BackupStorageLocation{}
+	if err := fs.client.Get(fs.ctx, client.ObjectKey{
+		Namespace: fs.namespace,
+		Name:      targetBSLName,
+	}, targetLocation); err != nil {
+		return errors.Wrapf(err, "error getting backup storage location %s", targetBSLName)
+	}
+
+	targetRepo, err := repositoryEnsurer.EnsureRepo(fs.ctx, fs.namespace, fs.sourceNamespace, targetBSLName, fs.repositoryType)
+	if err != nil {
+		return errors.Wrapf(err, "error to ensure backup repository %s-%s-%s", targetBSLName, fs.sourceNamespace, fs.repositoryType)
+	}
+
+	if err := fs.boostRepoConnect(fs.ctx, fs.repositoryType, credentialGetter, targetLocation, targetRepo); err != nil {
+		return errors.Wrapf(err, "error to boost backup repository connection %s-%s-%s", targetBSLName, fs.sourceNamespace, fs.repositoryType)
+	}
+
+	fs.replicaProv, err = provider.NewUploaderProvider(fs.ctx, fs.client, fs.uploaderType, fs.requestorType, "",
+		targetLocation, targetRepo, credentialGetter, repokey.RepoKeySelectorForRepo(targetRepo), fs.bandwidthLimits, fs.cacheOptions, fs.log)
+	if err != nil {
+		return errors.Wrapf(err, "error creating uploader %s for backup storage location %s", fs.uploaderType, targetBSLName)
+	}
+
+	go func() {
+		replicaID, err := fs.uploaderProv.RunReplicate(fs.ctx, snapshotID, fs.replicaProv, fs)
+
+		if err == provider.ErrorCanceled {
+			fs.callbacks.OnCancelled(context.Background(), fs.namespace, fs.jobName)
+		} else if err != nil {
+			fs.callbacks.OnFailed(context.Background(), fs.namespace, fs.jobName, err)
+		} else {
+			fs.callbacks.OnCompleted(context.Background(), fs.namespace, fs.jobName, Result{Replicate: ReplicateResult{SnapshotID: replicaID}})
+		}
+	}()
+
+	return nil
+}
+
 // UpdateProgress which implement ProgressUpdater interface to update progress status
 func (fs *fileSystemBR) UpdateProgress(p *uploader.Progress) {
 	if fs.callbacks.OnProgress != nil {
@@ -182,13 +279,14 @@ func (fs *fileSystemBR) Cancel() {
 	fs.log.WithField("user", fs.jobName).Info("FileSystemBR is canceled")
 }
 
-func (fs *fileSystemBR) boostRepoConnect(ctx context.Context, repositoryType string, credentialGetter *credentials.CredentialGetter) error {
+func (fs *fileSystemBR) boostRepoConnect(ctx context.Context, repositoryType string, credentialGetter *credentials.CredentialGetter,
+	backupLocation *velerov1api.BackupStorageLocation, backupRepo *velerov1api.BackupRepository) error {
 	if repositoryType == velerov1api.BackupRepositoryTypeKopia {
-		if err := repoProvider.NewUnifiedRepoProvider(*credentialGetter, repositoryType, fs.log).BoostRepoConnect(ctx, repoProvider.RepoParam{BackupLocation: fs.backupLocation, BackupRepo: fs.backupRepo}); err != nil {
+		if err := repoProvider.NewUnifiedRepoProvider(*credentialGetter, repositoryType, fs.log).BoostRepoConnect(ctx, repoProvider.RepoParam{BackupLocation: backupLocation, BackupRepo: backupRepo}); err != nil {
 			return err
 		}
 	} else {
-		if err := repoProvider.NewResticRepositoryProvider(credentialGetter.FromFile, filesystem.NewFileSystem(), fs.log).BoostRepoConnect(ctx, repoProvider.RepoParam{BackupLocation: fs.backupLocation, BackupRepo: fs.backupRepo}); err != nil {
+		if err := repoProvider.NewResticRepositoryProvider(credentialGetter.FromFile, filesystem.NewFileSystem(), fs.log).BoostRepoConnect(ctx, repoProvider.RepoParam{BackupLocation: backupLocation, BackupRepo: backupRepo}); err != nil {
 			return err
 		}
 	}
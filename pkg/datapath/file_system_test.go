@@ -25,7 +25,9 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/uploader/provider"
 	providerMock "github.com/vmware-tanzu/velero/pkg/uploader/provider/mocks"
 )
@@ -85,6 +87,8 @@ func TestAsyncBackup(t *testing.T) {
 					SnapshotID:    "fake-snapshot",
 					EmptySnapshot: false,
 					Source:        AccessPoint{ByPath: "fake-path"},
+					Size:          1024,
+					UploadedBytes: 256,
 				},
 			},
 			path: "fake-path",
@@ -93,10 +97,15 @@ func TestAsyncBackup(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			fs := newFileSystemBR("job-1", "test", nil, "velero", Callbacks{}, velerotest.NewLogger()).(*fileSystemBR)
+			fs := newFileSystemBR("job-1", "test", nil, "velero", uploader.BandwidthLimits{}, uploader.CacheOptions{}, Callbacks{}, velerotest.NewLogger()).(*fileSystemBR)
 			mockProvider := providerMock.NewProvider(t)
-			mockProvider.On("RunBackup", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(test.result.Backup.SnapshotID, test.result.Backup.EmptySnapshot, test.err)
+			mockProvider.On("RunBackup", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&uploader.SnapshotInfo{
+				ID:            test.result.Backup.SnapshotID,
+				Size:          test.result.Backup.Size,
+				UploadedBytes: test.result.Backup.UploadedBytes,
+			}, test.result.Backup.EmptySnapshot, test.err)
 			fs.uploaderProv = mockProvider
+			fs.backupRepo = &velerov1api.BackupRepository{}
 			fs.initialized = true
 			fs.callbacks = test.callbacks
 
@@ -113,6 +122,17 @@ func TestAsyncBackup(t *testing.T) {
 	close(finish)
 }
 
+func TestBackupToReadOnlyRepo(t *testing.T) {
+	fs := newFileSystemBR("job-1", "test", nil, "velero", uploader.BandwidthLimits{}, uploader.CacheOptions{}, Callbacks{}, velerotest.NewLogger()).(*fileSystemBR)
+	fs.uploaderProv = providerMock.NewProvider(t)
+	fs.backupRepo = &velerov1api.BackupRepository{Spec: velerov1api.BackupRepositorySpec{ReadOnly: true}}
+	fs.backupRepo.Name = "repo-1"
+	fs.initialized = true
+
+	err := fs.StartBackup(AccessPoint{ByPath: "fake-path"}, "", "", false, nil)
+	assert.EqualError(t, err, "backup repository repo-1 is read-only, new backups to it are refused")
+}
+
 func TestAsyncRestore(t *testing.T) {
 	var asyncErr error
 	var asyncResult Result
@@ -176,7 +196,7 @@ func TestAsyncRestore(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			fs := newFileSystemBR("job-1", "test", nil, "velero", Callbacks{}, velerotest.NewLogger()).(*fileSystemBR)
+			fs := newFileSystemBR("job-1", "test", nil, "velero", uploader.BandwidthLimits{}, uploader.CacheOptions{}, Callbacks{}, velerotest.NewLogger()).(*fileSystemBR)
 			mockProvider := providerMock.NewProvider(t)
 			mockProvider.On("RunRestore", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(test.err)
 			fs.uploaderProv = mockProvider
@@ -195,3 +215,113 @@ func TestAsyncRestore(t *testing.T) {
 
 	close(finish)
 }
+
+func TestAsyncVerify(t *testing.T) {
+	var asyncErr error
+	var asyncResult Result
+	finish := make(chan struct{})
+
+	tests := []struct {
+		name         string
+		callbacks    Callbacks
+		verifyErrors []string
+		err          error
+		result       Result
+	}{
+		{
+			name: "async verify fail",
+			callbacks: Callbacks{
+				OnFailed: func(ctx context.Context, namespace string, job string, err error) {
+					asyncErr = err
+					asyncResult = Result{}
+					finish <- struct{}{}
+				},
+			},
+			err: errors.New("fake-error"),
+		},
+		{
+			name: "async verify cancel",
+			callbacks: Callbacks{
+				OnCancelled: func(ctx context.Context, namespace string, job string) {
+					asyncErr = provider.ErrorCanceled
+					asyncResult = Result{}
+					finish <- struct{}{}
+				},
+			},
+			err: provider.ErrorCanceled,
+		},
+		{
+			name: "async verify complete",
+			callbacks: Callbacks{
+				OnCompleted: func(ctx context.Context, namespace string, job string, result Result) {
+					asyncResult = result
+					asyncErr = nil
+					finish <- struct{}{}
+				},
+			},
+			verifyErrors: []string{"fake-file: fake-error"},
+			result: Result{
+				Verify: VerifyResult{Errors: []string{"fake-file: fake-error"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fs := newFileSystemBR("job-1", "test", nil, "velero", uploader.BandwidthLimits{}, uploader.CacheOptions{}, Callbacks{}, velerotest.NewLogger()).(*fileSystemBR)
+			mockProvider := providerMock.NewProvider(t)
+			mockProvider.On("RunVerify", mock.Anything, "fake-snapshot", 10, mock.Anything).Return(test.verifyErrors, test.err)
+			fs.uploaderProv = mockProvider
+			fs.initialized = true
+			fs.callbacks = test.callbacks
+
+			err := fs.StartVerify("fake-snapshot", 10)
+			require.Equal(t, nil, err)
+
+			<-finish
+
+			assert.Equal(t, asyncErr, test.err)
+			assert.Equal(t, asyncResult, test.result)
+		})
+	}
+
+	close(finish)
+}
+
+func TestStartReplicate(t *testing.T) {
+	tests := []struct {
+		name           string
+		initialized    bool
+		repositoryType string
+		expectedErr    string
+	}{
+		{
+			name:        "not initialized",
+			expectedErr: "file system data path is not initialized",
+		},
+		{
+			name:           "restic repository",
+			initialized:    true,
+			repositoryType: velerov1api.BackupRepositoryTypeRestic,
+			expectedErr:    "replicate is not supported by restic repository",
+		},
+		{
+			name:           "target backup storage location isn't found",
+			initialized:    true,
+			repositoryType: velerov1api.BackupRepositoryTypeKopia,
+			expectedErr:    "error getting backup storage location bsl-2: backupstoragelocations.velero.io \"bsl-2\" not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fs := newFileSystemBR("job-1", "test", velerotest.NewFakeControllerRuntimeClient(t), "velero", uploader.BandwidthLimits{}, uploader.CacheOptions{}, Callbacks{}, velerotest.NewLogger()).(*fileSystemBR)
+			fs.ctx = context.Background()
+			fs.initialized = test.initialized
+			fs.repositoryType = test.repositoryType
+
+			err := fs.StartReplicate("fake-snapshot", "bsl-2", nil, nil)
+			assert.EqualError(t, err, test.expectedErr)
+		})
+	}
+}
@@ -18,50 +18,292 @@ package datapath
 
 import (
 	"context"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 )
 
 var ConcurrentLimitExceed error = errors.New("Concurrent number exceeds")
 var FSBRCreator = newFileSystemBR
 
+// TaskTypeBackup and TaskTypeRestore are the directions of the data moved by the data path instances. The instances of
+// each direction are limited separately if the concurrent number of the direction is set
+const (
+	TaskTypeBackup  = "backup"
+	TaskTypeRestore = "restore"
+)
+
+// queuedExpiration is how long a data path rejected by the concurrency limit is counted as queued without being retried,
+// the requestors retry much more often, so a data path not retried for so long has been canceled or deleted
+const queuedExpiration = 5 * time.Minute
+
 type Manager struct {
-	cocurrentNum int
-	trackerLock  sync.Mutex
-	tracker      map[string]AsyncBR
+	cocurrentNum             int
+	perNamespaceCocurrentNum map[string]int
+	taskTypeCocurrentNum     map[string]int
+	bandwidthLimits          uploader.BandwidthLimits
+	cacheOptions             uploader.CacheOptions
+	throttledCocurrentNum    int
+	trackerLock              sync.Mutex
+	tracker                  map[string]AsyncBR
+	namespaceTracker         map[string]string
+	taskTypeTracker          map[string]string
+	requestorTracker         map[string]string
+	startTracker             map[string]time.Time
+	queued                   map[string]queuedDataPath
+	requestors               map[string]struct{}
+	nodeName                 string
+	metrics                  *metrics.ServerMetrics
+	clock                    clock.Clock
+	probeLock                sync.Mutex
+	probeStart               time.Time
 }
 
-// NewManager creates the data path manager to manage concurrent data path instances
-func NewManager(cocurrentNum int) *Manager {
+// queuedDataPath is a data path rejected by the concurrency limit and waiting to be retried
+type queuedDataPath struct {
+	requestorType string
+	lastRetry     time.Time
+}
+
+// NewManager creates the data path manager to manage concurrent data path instances.
+// perNamespaceCocurrentNum optionally caps the concurrent data path instances for specific source namespaces.
+// bandwidthLimits are the bandwidth limits of the node, which are shared by all the data path instances
+func NewManager(cocurrentNum int, perNamespaceCocurrentNum map[string]int, bandwidthLimits uploader.BandwidthLimits) *Manager {
 	return &Manager{
-		cocurrentNum: cocurrentNum,
-		tracker:      map[string]AsyncBR{},
+		cocurrentNum:             cocurrentNum,
+		perNamespaceCocurrentNum: perNamespaceCocurrentNum,
+		bandwidthLimits:          bandwidthLimits,
+		tracker:                  map[string]AsyncBR{},
+		namespaceTracker:         map[string]string{},
+		taskTypeTracker:          map[string]string{},
+		requestorTracker:         map[string]string{},
+		startTracker:             map[string]time.Time{},
+		queued:                   map[string]queuedDataPath{},
+		requestors:               map[string]struct{}{},
+		clock:                    clock.RealClock{},
+	}
+}
+
+// SetMetrics sets the metrics to record the running and queued data path instances of the node
+func (m *Manager) SetMetrics(nodeName string, metrics *metrics.ServerMetrics) {
+	m.trackerLock.Lock()
+	defer m.trackerLock.Unlock()
+
+	m.nodeName = nodeName
+	m.metrics = metrics
+}
+
+// SetTaskTypeConcurrentNum sets separate concurrent numbers for the backup and restore data path instances, e.g., so
+// that a restore could use all the bandwidth of the node while the backups remain capped. A direction whose number
+// isn't positive is limited by the configured concurrent number. Once any of the numbers is set, the instances of the
+// two directions no longer share the configured concurrent number
+func (m *Manager) SetTaskTypeConcurrentNum(backupNum int, restoreNum int) {
+	m.trackerLock.Lock()
+	defer m.trackerLock.Unlock()
+
+	m.taskTypeCocurrentNum = map[string]int{}
+	if backupNum > 0 {
+		m.taskTypeCocurrentNum[TaskTypeBackup] = backupNum
+	}
+	if restoreNum > 0 {
+		m.taskTypeCocurrentNum[TaskTypeRestore] = restoreNum
 	}
 }
 
-// CreateFileSystemBR creates a new file system backup/restore data path instance
-func (m *Manager) CreateFileSystemBR(jobName string, requestorType string, ctx context.Context, client client.Client, namespace string, callbacks Callbacks, log logrus.FieldLogger) (AsyncBR, error) {
+// SetCacheOptions sets the kopia cache options of the node, which are applied to the data path instances created afterwards
+func (m *Manager) SetCacheOptions(cacheOptions uploader.CacheOptions) {
+	m.trackerLock.Lock()
+	defer m.trackerLock.Unlock()
+
+	m.cacheOptions = cacheOptions
+}
+
+// CreateFileSystemBR creates a new file system backup/restore data path instance.
+// taskType is the direction of the data being moved, i.e., TaskTypeBackup or TaskTypeRestore, which is used to enforce
+// the backup and restore concurrency.
+// sourceNamespace is the namespace of the workload whose data is being moved, which is used to enforce the per-namespace concurrency
+func (m *Manager) CreateFileSystemBR(jobName string, requestorType string, taskType string, ctx context.Context, client client.Client, namespace string, sourceNamespace string, callbacks Callbacks, log logrus.FieldLogger) (AsyncBR, error) {
 	m.trackerLock.Lock()
 	defer m.trackerLock.Unlock()
 
-	if len(m.tracker) == m.cocurrentNum {
+	if m.concurrentLimitExceeded(sourceNamespace, taskType) {
+		m.queued[jobName] = queuedDataPath{requestorType: requestorType, lastRetry: m.clock.Now()}
+		if m.metrics != nil {
+			m.metrics.RegisterDataPathRetry(m.nodeName, requestorType)
+		}
+		m.updateMetrics(requestorType)
+
 		return nil, ConcurrentLimitExceed
 	}
 
-	m.tracker[jobName] = FSBRCreator(jobName, requestorType, client, namespace, callbacks, log)
+	m.tracker[jobName] = FSBRCreator(jobName, requestorType, client, namespace, m.dataPathBandwidthLimits(), m.cacheOptions, callbacks, log)
+	m.namespaceTracker[jobName] = sourceNamespace
+	m.taskTypeTracker[jobName] = taskType
+	m.requestorTracker[jobName] = requestorType
+	m.startTracker[jobName] = m.clock.Now()
+	delete(m.queued, jobName)
+	m.updateMetrics(requestorType)
 
 	return m.tracker[jobName], nil
 }
 
+// dataPathBandwidthLimits returns the share of the node's bandwidth limits for a single data path instance. The limits
+// are applied when an instance opens the repository and can't be changed afterwards, so the node's limits are divided by
+// the maximum number of the instances that could run concurrently to keep the total bandwidth of the node under the limits
+func (m *Manager) dataPathBandwidthLimits() uploader.BandwidthLimits {
+	maxRunning := m.cocurrentNum
+	if len(m.taskTypeCocurrentNum) > 0 {
+		maxRunning = 0
+		for _, taskType := range []string{TaskTypeBackup, TaskTypeRestore} {
+			limit, exist := m.taskTypeCocurrentNum[taskType]
+			if !exist {
+				limit = m.cocurrentNum
+			}
+			maxRunning += limit
+		}
+	}
+
+	if maxRunning <= 1 {
+		return m.bandwidthLimits
+	}
+
+	return uploader.BandwidthLimits{
+		UploadBytesPerSecond:   divideBandwidth(m.bandwidthLimits.UploadBytesPerSecond, maxRunning),
+		DownloadBytesPerSecond: divideBandwidth(m.bandwidthLimits.DownloadBytesPerSecond, maxRunning),
+	}
+}
+
+// divideBandwidth divides the bandwidth limit, the result is at least 1 so that a limited bandwidth never turns unlimited
+func divideBandwidth(bandwidth int64, num int) int64 {
+	if bandwidth <= 0 {
+		return bandwidth
+	}
+
+	if divided := bandwidth / int64(num); divided > 0 {
+		return divided
+	}
+
+	return 1
+}
+
+func (m *Manager) concurrentLimitExceeded(sourceNamespace string, taskType string) bool {
+	if len(m.taskTypeCocurrentNum) > 0 {
+		limit, exist := m.taskTypeCocurrentNum[taskType]
+		if !exist {
+			limit = m.cocurrentNum
+		}
+
+		if m.taskTypeRunning(taskType) >= limit {
+			return true
+		}
+	} else if len(m.tracker) >= m.cocurrentNum {
+		return true
+	}
+
+	if m.throttledCocurrentNum > 0 && len(m.tracker) >= m.throttledCocurrentNum {
+		return true
+	}
+
+	if limit, exist := m.perNamespaceCocurrentNum[sourceNamespace]; exist && m.namespaceRunning(sourceNamespace) >= limit {
+		return true
+	}
+
+	return false
+}
+
+// updateMetrics records the running and queued numbers of all the requestors seen so far, so that the numbers of a
+// requestor drop to 0 once it has nothing running or queued. The queued data paths not retried recently are dropped
+func (m *Manager) updateMetrics(requestorType string) {
+	now := m.clock.Now()
+	for jobName, queued := range m.queued {
+		if now.Sub(queued.lastRetry) > queuedExpiration {
+			delete(m.queued, jobName)
+		}
+	}
+
+	if m.metrics == nil {
+		return
+	}
+
+	m.requestors[requestorType] = struct{}{}
+
+	active, queued := map[string]int{}, map[string]int{}
+	for _, requestor := range m.requestorTracker {
+		active[requestor]++
+	}
+	for _, q := range m.queued {
+		queued[q.requestorType]++
+	}
+
+	for requestor := range m.requestors {
+		m.metrics.SetDataPathActive(m.nodeName, requestor, active[requestor])
+		m.metrics.SetDataPathQueued(m.nodeName, requestor, queued[requestor])
+	}
+}
+
+func (m *Manager) namespaceRunning(sourceNamespace string) int {
+	running := 0
+	for _, ns := range m.namespaceTracker {
+		if ns == sourceNamespace {
+			running++
+		}
+	}
+
+	return running
+}
+
+func (m *Manager) taskTypeRunning(taskType string) int {
+	running := 0
+	for _, t := range m.taskTypeTracker {
+		if t == taskType {
+			running++
+		}
+	}
+
+	return running
+}
+
 // RemoveAsyncBR removes a file system backup/restore data path instance
 func (m *Manager) RemoveAsyncBR(jobName string) {
 	m.trackerLock.Lock()
 	defer m.trackerLock.Unlock()
 
+	requestorType, running := m.requestorTracker[jobName]
+	if running && m.metrics != nil {
+		m.metrics.ObserveDataPathDuration(m.nodeName, requestorType, m.clock.Since(m.startTracker[jobName]).Seconds())
+	}
+	if queued, exist := m.queued[jobName]; exist {
+		requestorType = queued.requestorType
+	}
+
 	delete(m.tracker, jobName)
+	delete(m.namespaceTracker, jobName)
+	delete(m.taskTypeTracker, jobName)
+	delete(m.requestorTracker, jobName)
+	delete(m.startTracker, jobName)
+	delete(m.queued, jobName)
+
+	if requestorType != "" {
+		m.updateMetrics(requestorType)
+	}
+}
+
+// GetStartTime returns the time when the file system backup/restore data path instance for the specified job name was
+// created, it returns false if the instance doesn't exist
+func (m *Manager) GetStartTime(jobName string) (time.Time, bool) {
+	m.trackerLock.Lock()
+	defer m.trackerLock.Unlock()
+
+	start, exist := m.startTracker[jobName]
+	return start, exist
 }
 
 // GetAsyncBR returns the file system backup/restore data path instance for the specified job name
@@ -75,3 +317,87 @@ func (m *Manager) GetAsyncBR(jobName string) AsyncBR {
 		return nil
 	}
 }
+
+// ConcurrentLimits returns the configured concurrent number of data path instances and the per-namespace concurrent numbers
+func (m *Manager) ConcurrentLimits() (int, map[string]int) {
+	return m.cocurrentNum, m.perNamespaceCocurrentNum
+}
+
+// TaskTypeConcurrentLimits returns the concurrent numbers of the backup and restore data path instances which are set
+// separately, it is empty if the two directions share the configured concurrent number
+func (m *Manager) TaskTypeConcurrentLimits() map[string]int {
+	m.trackerLock.Lock()
+	defer m.trackerLock.Unlock()
+
+	return m.taskTypeCocurrentNum
+}
+
+// SetThrottledConcurrentNum reduces the effective concurrent number of data path instances, e.g., when the node-agent
+// is under memory pressure. The running instances are not affected, but no new instance is created until the running
+// number drops below the throttled number. 0 removes the throttling
+func (m *Manager) SetThrottledConcurrentNum(num int) {
+	m.trackerLock.Lock()
+	defer m.trackerLock.Unlock()
+
+	m.throttledCocurrentNum = num
+}
+
+// ThrottledConcurrentNum returns the throttled concurrent number of data path instances, 0 means it is not throttled
+func (m *Manager) ThrottledConcurrentNum() int {
+	m.trackerLock.Lock()
+	defer m.trackerLock.Unlock()
+
+	return m.throttledCocurrentNum
+}
+
+// ProbeSlotAcquisition starts a probe of the slot acquisition of the data path instances, i.e., acquires and releases
+// the lock guarding the slots in the background. A new probe isn't started while the previous one is still pending,
+// so that a deadlocked manager doesn't pile up the probes
+func (m *Manager) ProbeSlotAcquisition() {
+	m.probeLock.Lock()
+	defer m.probeLock.Unlock()
+
+	if !m.probeStart.IsZero() {
+		return
+	}
+	m.probeStart = m.clock.Now()
+
+	go func() {
+		// the lock is only acquired to check it isn't held forever
+		m.trackerLock.Lock()
+		m.trackerLock.Unlock()
+
+		m.probeLock.Lock()
+		defer m.probeLock.Unlock()
+		m.probeStart = time.Time{}
+	}()
+}
+
+// CheckSlotAcquisition returns an error if the probe of the slot acquisition has been pending for longer than the
+// timeout, which means the manager is deadlocked and no data path instance can be created or removed
+func (m *Manager) CheckSlotAcquisition(timeout time.Duration) error {
+	m.probeLock.Lock()
+	defer m.probeLock.Unlock()
+
+	if m.probeStart.IsZero() {
+		return nil
+	}
+	if pending := m.clock.Since(m.probeStart); pending > timeout {
+		return errors.Errorf("slot acquisition of data path has been blocked for %v", pending.Round(time.Second))
+	}
+	return nil
+}
+
+// GetRunningJobs returns the sorted job names of the running file system backup/restore data path instances
+func (m *Manager) GetRunningJobs() []string {
+	m.trackerLock.Lock()
+	defer m.trackerLock.Unlock()
+
+	jobs := make([]string, 0, len(m.tracker))
+	for jobName := range m.tracker {
+		jobs = append(jobs, jobName)
+	}
+	sort.Strings(jobs)
+
+	return jobs
+}
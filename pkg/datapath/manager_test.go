@@ -19,20 +19,29 @@ package datapath
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	testclocks "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 )
 
 func TestManager(t *testing.T) {
-	m := NewManager(2)
+	m := NewManager(2, nil, uploader.BandwidthLimits{})
 
-	async_job_1, err := m.CreateFileSystemBR("job-1", "test", context.TODO(), nil, "velero", Callbacks{}, nil)
+	async_job_1, err := m.CreateFileSystemBR("job-1", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
 	assert.NoError(t, err)
 
-	_, err = m.CreateFileSystemBR("job-2", "test", context.TODO(), nil, "velero", Callbacks{}, nil)
+	_, err = m.CreateFileSystemBR("job-2", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
 	assert.NoError(t, err)
 
-	_, err = m.CreateFileSystemBR("job-3", "test", context.TODO(), nil, "velero", Callbacks{}, nil)
+	_, err = m.CreateFileSystemBR("job-3", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
 	assert.Equal(t, ConcurrentLimitExceed, err)
 
 	ret := m.GetAsyncBR("job-0")
@@ -50,3 +59,273 @@ func TestManager(t *testing.T) {
 	ret = m.GetAsyncBR("job-1")
 	assert.Equal(t, nil, ret)
 }
+
+func TestManagerPerNamespaceConcurrency(t *testing.T) {
+	m := NewManager(3, map[string]int{"ns-1": 1}, uploader.BandwidthLimits{})
+
+	_, err := m.CreateFileSystemBR("job-1", "test", TaskTypeBackup, context.TODO(), nil, "velero", "ns-1", Callbacks{}, nil)
+	assert.NoError(t, err)
+
+	_, err = m.CreateFileSystemBR("job-2", "test", TaskTypeBackup, context.TODO(), nil, "velero", "ns-1", Callbacks{}, nil)
+	assert.Equal(t, ConcurrentLimitExceed, err)
+
+	_, err = m.CreateFileSystemBR("job-3", "test", TaskTypeBackup, context.TODO(), nil, "velero", "ns-2", Callbacks{}, nil)
+	assert.NoError(t, err)
+
+	m.RemoveAsyncBR("job-1")
+	assert.Equal(t, 1, len(m.namespaceTracker))
+
+	_, err = m.CreateFileSystemBR("job-2", "test", TaskTypeBackup, context.TODO(), nil, "velero", "ns-1", Callbacks{}, nil)
+	assert.NoError(t, err)
+
+	_, err = m.CreateFileSystemBR("job-4", "test", TaskTypeBackup, context.TODO(), nil, "velero", "ns-2", Callbacks{}, nil)
+	assert.NoError(t, err)
+
+	_, err = m.CreateFileSystemBR("job-5", "test", TaskTypeBackup, context.TODO(), nil, "velero", "ns-2", Callbacks{}, nil)
+	assert.Equal(t, ConcurrentLimitExceed, err)
+}
+
+func TestManagerThrottledConcurrency(t *testing.T) {
+	m := NewManager(3, nil, uploader.BandwidthLimits{})
+
+	_, err := m.CreateFileSystemBR("job-1", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	assert.NoError(t, err)
+
+	_, err = m.CreateFileSystemBR("job-2", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	assert.NoError(t, err)
+
+	m.SetThrottledConcurrentNum(1)
+	assert.Equal(t, 1, m.ThrottledConcurrentNum())
+
+	_, err = m.CreateFileSystemBR("job-3", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	assert.Equal(t, ConcurrentLimitExceed, err)
+
+	m.RemoveAsyncBR("job-1")
+
+	_, err = m.CreateFileSystemBR("job-3", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	assert.Equal(t, ConcurrentLimitExceed, err)
+
+	m.RemoveAsyncBR("job-2")
+
+	_, err = m.CreateFileSystemBR("job-3", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	assert.NoError(t, err)
+
+	_, err = m.CreateFileSystemBR("job-4", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	assert.Equal(t, ConcurrentLimitExceed, err)
+
+	m.SetThrottledConcurrentNum(0)
+
+	_, err = m.CreateFileSystemBR("job-4", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	assert.NoError(t, err)
+}
+
+func TestManagerTaskTypeConcurrency(t *testing.T) {
+	m := NewManager(2, nil, uploader.BandwidthLimits{})
+	m.SetTaskTypeConcurrentNum(1, 3)
+	assert.Equal(t, map[string]int{TaskTypeBackup: 1, TaskTypeRestore: 3}, m.TaskTypeConcurrentLimits())
+
+	_, err := m.CreateFileSystemBR("job-1", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	assert.NoError(t, err)
+
+	_, err = m.CreateFileSystemBR("job-2", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	assert.Equal(t, ConcurrentLimitExceed, err)
+
+	// the restores are not limited by the running backups or the shared number
+	for _, job := range []string{"job-3", "job-4", "job-5"} {
+		_, err = m.CreateFileSystemBR(job, "test", TaskTypeRestore, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+		assert.NoError(t, err)
+	}
+
+	_, err = m.CreateFileSystemBR("job-6", "test", TaskTypeRestore, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	assert.Equal(t, ConcurrentLimitExceed, err)
+
+	m.RemoveAsyncBR("job-1")
+	_, err = m.CreateFileSystemBR("job-2", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	assert.NoError(t, err)
+
+	// the direction without a number is limited by the configured number
+	m.SetTaskTypeConcurrentNum(0, 4)
+	assert.Equal(t, map[string]int{TaskTypeRestore: 4}, m.TaskTypeConcurrentLimits())
+
+	_, err = m.CreateFileSystemBR("job-7", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	assert.NoError(t, err)
+
+	_, err = m.CreateFileSystemBR("job-8", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	assert.Equal(t, ConcurrentLimitExceed, err)
+
+	_, err = m.CreateFileSystemBR("job-6", "test", TaskTypeRestore, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	assert.NoError(t, err)
+}
+
+func TestManagerBandwidthLimits(t *testing.T) {
+	tests := []struct {
+		name           string
+		concurrentNum  int
+		backupNum      int
+		restoreNum     int
+		limits         uploader.BandwidthLimits
+		expectedLimits uploader.BandwidthLimits
+	}{
+		{
+			name:           "unlimited",
+			concurrentNum:  4,
+			expectedLimits: uploader.BandwidthLimits{},
+		},
+		{
+			name:           "single data path takes all the bandwidth",
+			concurrentNum:  1,
+			limits:         uploader.BandwidthLimits{UploadBytesPerSecond: 1000, DownloadBytesPerSecond: 2000},
+			expectedLimits: uploader.BandwidthLimits{UploadBytesPerSecond: 1000, DownloadBytesPerSecond: 2000},
+		},
+		{
+			name:           "bandwidth is divided by the concurrent number",
+			concurrentNum:  4,
+			limits:         uploader.BandwidthLimits{UploadBytesPerSecond: 1000},
+			expectedLimits: uploader.BandwidthLimits{UploadBytesPerSecond: 250},
+		},
+		{
+			name:           "bandwidth is divided by the backup and restore numbers",
+			concurrentNum:  2,
+			backupNum:      3,
+			limits:         uploader.BandwidthLimits{UploadBytesPerSecond: 1000, DownloadBytesPerSecond: 2000},
+			expectedLimits: uploader.BandwidthLimits{UploadBytesPerSecond: 200, DownloadBytesPerSecond: 400},
+		},
+		{
+			name:           "divided bandwidth is never unlimited",
+			concurrentNum:  4,
+			limits:         uploader.BandwidthLimits{DownloadBytesPerSecond: 3},
+			expectedLimits: uploader.BandwidthLimits{DownloadBytesPerSecond: 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var limits uploader.BandwidthLimits
+			FSBRCreator = func(jobName string, requestorType string, client client.Client, namespace string, bandwidthLimits uploader.BandwidthLimits,
+				cacheOptions uploader.CacheOptions, callbacks Callbacks, log logrus.FieldLogger) AsyncBR {
+				limits = bandwidthLimits
+				return nil
+			}
+			defer func() { FSBRCreator = newFileSystemBR }()
+
+			m := NewManager(test.concurrentNum, nil, test.limits)
+			m.SetTaskTypeConcurrentNum(test.backupNum, test.restoreNum)
+
+			_, err := m.CreateFileSystemBR("job-1", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedLimits, limits)
+		})
+	}
+}
+
+func TestManagerStatus(t *testing.T) {
+	m := NewManager(3, map[string]int{"ns-1": 1}, uploader.BandwidthLimits{})
+
+	limit, perNamespaceLimits := m.ConcurrentLimits()
+	assert.Equal(t, 3, limit)
+	assert.Equal(t, map[string]int{"ns-1": 1}, perNamespaceLimits)
+
+	assert.Empty(t, m.GetRunningJobs())
+
+	_, err := m.CreateFileSystemBR("job-2", "test", TaskTypeBackup, context.TODO(), nil, "velero", "ns-1", Callbacks{}, nil)
+	assert.NoError(t, err)
+
+	_, err = m.CreateFileSystemBR("job-1", "test", TaskTypeBackup, context.TODO(), nil, "velero", "ns-2", Callbacks{}, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"job-1", "job-2"}, m.GetRunningJobs())
+
+	m.RemoveAsyncBR("job-2")
+	assert.Equal(t, []string{"job-1"}, m.GetRunningJobs())
+}
+
+func TestManagerSlotAcquisition(t *testing.T) {
+	m := NewManager(1, nil, uploader.BandwidthLimits{})
+	clock := testclocks.NewFakeClock(time.Now())
+	m.clock = clock
+
+	assert.NoError(t, m.CheckSlotAcquisition(time.Minute))
+
+	// the probe is blocked while the slots are held
+	m.trackerLock.Lock()
+	m.ProbeSlotAcquisition()
+	assert.NoError(t, m.CheckSlotAcquisition(time.Minute))
+
+	clock.Step(2 * time.Minute)
+	m.ProbeSlotAcquisition()
+	assert.EqualError(t, m.CheckSlotAcquisition(time.Minute), "slot acquisition of data path has been blocked for 2m0s")
+
+	m.trackerLock.Unlock()
+	assert.Eventually(t, func() bool {
+		return m.CheckSlotAcquisition(time.Minute) == nil
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestManagerMetrics(t *testing.T) {
+	m := NewManager(1, nil, uploader.BandwidthLimits{})
+	clock := testclocks.NewFakeClock(time.Now())
+	m.clock = clock
+
+	nodeMetrics := metrics.NewNodeMetrics()
+	nodeMetrics.RegisterAllMetrics()
+	m.SetMetrics("node-1", nodeMetrics)
+
+	_, err := m.CreateFileSystemBR("job-1", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	require.NoError(t, err)
+
+	_, err = m.CreateFileSystemBR("job-2", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	assert.Equal(t, ConcurrentLimitExceed, err)
+
+	_, err = m.CreateFileSystemBR("job-2", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	assert.Equal(t, ConcurrentLimitExceed, err)
+
+	start, exist := m.GetStartTime("job-1")
+	assert.True(t, exist)
+	assert.Equal(t, clock.Now(), start)
+	_, exist = m.GetStartTime("job-2")
+	assert.False(t, exist)
+
+	assert.Equal(t, 1.0, gatherMetric(t, "podVolume_data_path_active"))
+	assert.Equal(t, 1.0, gatherMetric(t, "podVolume_data_path_queued"))
+	assert.Equal(t, 2.0, gatherMetric(t, "podVolume_data_path_retry_total"))
+
+	clock.Step(time.Minute)
+	m.RemoveAsyncBR("job-1")
+	assert.Equal(t, 0.0, gatherMetric(t, "podVolume_data_path_active"))
+	assert.Equal(t, 1.0, gatherMetric(t, "podVolume_data_path_queued"))
+	assert.Equal(t, 1.0, gatherMetric(t, "podVolume_data_path_duration_seconds"))
+
+	// the queued data path which isn't retried anymore expires
+	clock.Step(queuedExpiration + time.Second)
+	_, err = m.CreateFileSystemBR("job-3", "test", TaskTypeBackup, context.TODO(), nil, "velero", "", Callbacks{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, gatherMetric(t, "podVolume_data_path_active"))
+	assert.Equal(t, 0.0, gatherMetric(t, "podVolume_data_path_queued"))
+}
+
+// gatherMetric returns the value of the gauge or counter, or the sample count of the histogram, with the name
+func gatherMetric(t *testing.T, name string) float64 {
+	t.Helper()
+
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		require.Len(t, family.GetMetric(), 1)
+		metric := family.GetMetric()[0]
+		switch {
+		case metric.GetGauge() != nil:
+			return metric.GetGauge().GetValue()
+		case metric.GetCounter() != nil:
+			return metric.GetCounter().GetValue()
+		case metric.GetHistogram() != nil:
+			return float64(metric.GetHistogram().GetSampleCount())
+		}
+	}
+
+	require.Failf(t, "metric not found", "metric %s is not found", name)
+	return 0
+}
@@ -56,6 +56,20 @@ func (_m *AsyncBR) StartBackup(source datapath.AccessPoint, realSource string, p
 	return r0
 }
 
+// StartReplicate provides a mock function with given fields: snapshotID, targetBSLName, repositoryEnsurer, credentialGetter
+func (_m *AsyncBR) StartReplicate(snapshotID string, targetBSLName string, repositoryEnsurer *repository.Ensurer, credentialGetter *credentials.CredentialGetter) error {
+	ret := _m.Called(snapshotID, targetBSLName, repositoryEnsurer, credentialGetter)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, *repository.Ensurer, *credentials.CredentialGetter) error); ok {
+		r0 = rf(snapshotID, targetBSLName, repositoryEnsurer, credentialGetter)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // StartRestore provides a mock function with given fields: snapshotID, target
 func (_m *AsyncBR) StartRestore(snapshotID string, target datapath.AccessPoint) error {
 	ret := _m.Called(snapshotID, target)
@@ -70,6 +84,20 @@ func (_m *AsyncBR) StartRestore(snapshotID string, target datapath.AccessPoint)
 	return r0
 }
 
+// StartVerify provides a mock function with given fields: snapshotID, filesPercent
+func (_m *AsyncBR) StartVerify(snapshotID string, filesPercent int) error {
+	ret := _m.Called(snapshotID, filesPercent)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int) error); ok {
+		r0 = rf(snapshotID, filesPercent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewAsyncBR interface {
 	mock.TestingT
 	Cleanup(func())
@@ -24,10 +24,12 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/uploader"
 )
 
-// Result represents the result of a backup/restore
+// Result represents the result of a backup/restore/verify/replicate
 type Result struct {
-	Backup  BackupResult
-	Restore RestoreResult
+	Backup    BackupResult
+	Restore   RestoreResult
+	Verify    VerifyResult
+	Replicate ReplicateResult
 }
 
 // BackupResult represents the result of a backup
@@ -35,6 +37,11 @@ type BackupResult struct {
 	SnapshotID    string
 	EmptySnapshot bool
 	Source        AccessPoint
+	// Size is the logical size of the data of the snapshot
+	Size int64
+	// UploadedBytes is the size of the data written to the backup repository for the snapshot after
+	// deduplication and compression, it's 0 if the uploader doesn't report it
+	UploadedBytes int64
 }
 
 // RestoreResult represents the result of a restore
@@ -42,6 +49,18 @@ type RestoreResult struct {
 	Target AccessPoint
 }
 
+// VerifyResult represents the result of a verify
+type VerifyResult struct {
+	// Errors are the errors of the corrupted entries of the snapshot
+	Errors []string
+}
+
+// ReplicateResult represents the result of a replicate
+type ReplicateResult struct {
+	// SnapshotID is the ID of the replica of the snapshot in the target repository
+	SnapshotID string
+}
+
 // Callbacks defines the collection of callbacks during backup/restore
 type Callbacks struct {
 	OnCompleted func(context.Context, string, string, Result)
@@ -67,6 +86,13 @@ type AsyncBR interface {
 	// StartRestore starts an asynchronous data path instance for restore
 	StartRestore(snapshotID string, target AccessPoint) error
 
+	// StartVerify starts an asynchronous data path instance for verifying a snapshot
+	StartVerify(snapshotID string, filesPercent int) error
+
+	// StartReplicate starts an asynchronous data path instance for replicating a snapshot to the backup repository
+	// of another backup storage location
+	StartReplicate(snapshotID string, targetBSLName string, repositoryEnsurer *repository.Ensurer, credentialGetter *credentials.CredentialGetter) error
+
 	// Cancel cancels an asynchronous data path instance
 	Cancel()
 
@@ -23,6 +23,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 
 	corev1 "k8s.io/api/core/v1"
@@ -65,8 +66,15 @@ type CSISnapshotExposeParam struct {
 	// ExposeTimeout specifies the timeout for the entire expose process
 	ExposeTimeout time.Duration
 
+	// SnapshotReadyTimeout specifies the time wait for the VolumeSnapshot to be ready in Expose, ExposeTimeout is used
+	// if it is not set
+	SnapshotReadyTimeout time.Duration
+
 	// VolumeSize specifies the size of the source volume
 	VolumeSize resource.Quantity
+
+	// BackupPodConfig specifies the node selector, tolerations and affinity of the backup pod
+	BackupPodConfig *nodeagent.BackupPodConfig
 }
 
 // CSISnapshotExposeWaitParam define the input param for WaitExposed of CSI snapshots
@@ -100,7 +108,11 @@ func (e *csiSnapshotExposer) Expose(ctx context.Context, ownerObject corev1.Obje
 
 	curLog.Info("Exposing CSI snapshot")
 
-	volumeSnapshot, err := csi.WaitVolumeSnapshotReady(ctx, e.csiSnapshotClient, csiExposeParam.SnapshotName, csiExposeParam.SourceNamespace, csiExposeParam.ExposeTimeout, curLog)
+	snapshotReadyTimeout := csiExposeParam.SnapshotReadyTimeout
+	if snapshotReadyTimeout <= 0 {
+		snapshotReadyTimeout = csiExposeParam.ExposeTimeout
+	}
+	volumeSnapshot, err := csi.WaitVolumeSnapshotReady(ctx, e.csiSnapshotClient, csiExposeParam.SnapshotName, csiExposeParam.SourceNamespace, snapshotReadyTimeout, curLog)
 	if err != nil {
 		return errors.Wrapf(err, "error wait volume snapshot ready")
 	}
@@ -190,7 +202,7 @@ func (e *csiSnapshotExposer) Expose(ctx context.Context, ownerObject corev1.Obje
 		}
 	}()
 
-	backupPod, err := e.createBackupPod(ctx, ownerObject, backupPVC, csiExposeParam.HostingPodLabels)
+	backupPod, err := e.createBackupPod(ctx, ownerObject, backupPVC, csiExposeParam.HostingPodLabels, csiExposeParam.BackupPodConfig)
 	if err != nil {
 		return errors.Wrap(err, "error to create backup pod")
 	}
@@ -382,7 +394,8 @@ func (e *csiSnapshotExposer) createBackupPVC(ctx context.Context, ownerObject co
 	return created, err
 }
 
-func (e *csiSnapshotExposer) createBackupPod(ctx context.Context, ownerObject corev1.ObjectReference, backupPVC *corev1.PersistentVolumeClaim, label map[string]string) (*corev1.Pod, error) {
+func (e *csiSnapshotExposer) createBackupPod(ctx context.Context, ownerObject corev1.ObjectReference, backupPVC *corev1.PersistentVolumeClaim, label map[string]string,
+	podConfig *nodeagent.BackupPodConfig) (*corev1.Pod, error) {
 	podName := ownerObject.Name
 
 	volumeName := string(ownerObject.UID)
@@ -453,5 +466,11 @@ func (e *csiSnapshotExposer) createBackupPod(ctx context.Context, ownerObject co
 		},
 	}
 
+	if podConfig != nil {
+		pod.Spec.NodeSelector = podConfig.NodeSelector
+		pod.Spec.Tolerations = podConfig.Tolerations
+		pod.Spec.Affinity = podConfig.Affinity
+	}
+
 	return e.kubeClient.CoreV1().Pods(ownerObject.Namespace).Create(ctx, pod, metav1.CreateOptions{})
 }
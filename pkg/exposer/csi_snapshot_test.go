@@ -35,6 +35,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 
@@ -139,6 +140,26 @@ func TestExpose(t *testing.T) {
 		Spec: appsv1.DaemonSetSpec{},
 	}
 
+	backupPodConfig := &nodeagent.BackupPodConfig{
+		NodeSelector: map[string]string{"kubernetes.io/os": "linux"},
+		Tolerations: []corev1.Toleration{
+			{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "backup", Effect: corev1.TaintEffectNoSchedule},
+		},
+		Affinity: &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{
+						{
+							MatchExpressions: []corev1.NodeSelectorRequirement{
+								{Key: "node.kubernetes.io/gpu", Operator: corev1.NodeSelectorOpDoesNotExist},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
 	tests := []struct {
 		name               string
 		snapshotClientObj  []runtime.Object
@@ -149,6 +170,7 @@ func TestExpose(t *testing.T) {
 		kubeReactors       []reactor
 		err                string
 		expectedVolumeSize *resource.Quantity
+		expectedPodConfig  *nodeagent.BackupPodConfig
 	}{
 		{
 			name:        "wait vs ready fail",
@@ -375,6 +397,26 @@ func TestExpose(t *testing.T) {
 			},
 			expectedVolumeSize: resource.NewQuantity(567890, ""),
 		},
+		{
+			name:        "backup pod config",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				BackupPodConfig:  backupPodConfig,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedPodConfig: backupPodConfig,
+		},
 	}
 
 	for _, test := range tests {
@@ -411,9 +453,18 @@ func TestExpose(t *testing.T) {
 			if err == nil {
 				assert.NoError(t, err)
 
-				_, err = exposer.kubeClient.CoreV1().Pods(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+				backupPod, err := exposer.kubeClient.CoreV1().Pods(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
 				assert.NoError(t, err)
 
+				if test.expectedPodConfig != nil {
+					assert.Equal(t, test.expectedPodConfig.NodeSelector, backupPod.Spec.NodeSelector)
+					assert.Equal(t, test.expectedPodConfig.Tolerations, backupPod.Spec.Tolerations)
+					assert.Equal(t, test.expectedPodConfig.Affinity, backupPod.Spec.Affinity)
+				} else {
+					assert.Nil(t, backupPod.Spec.NodeSelector)
+					assert.Nil(t, backupPod.Spec.Affinity)
+				}
+
 				backupPVC, err := exposer.kubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
 				assert.NoError(t, err)
 
@@ -23,10 +23,15 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/pkg/datapath"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
@@ -57,7 +62,7 @@ func GetPodVolumeHostPath(ctx context.Context, pod *corev1.Pod, volumeName strin
 		volSubDir = "volumeDevices"
 	}
 
-	pathGlob := fmt.Sprintf("/host_pods/%s/%s/*/%s", string(pod.GetUID()), volSubDir, volDir)
+	pathGlob := fmt.Sprintf("%s/%s/%s/*/%s", nodeagent.HostPodsPath(), string(pod.GetUID()), volSubDir, volDir)
 	logger.WithField("pathGlob", pathGlob).Debug("Looking for path matching glob")
 
 	path, err := singlePathMatch(pathGlob, fs, logger)
@@ -72,3 +77,88 @@ func GetPodVolumeHostPath(ctx context.Context, pod *corev1.Pod, volumeName strin
 		VolMode: volMode,
 	}, nil
 }
+
+// EnsureHostingPod makes sure a pod named hostingPodName is created on the node to mount the PVC
+// of the volume of the source pod, so that the data of the volume can be accessed from the host
+// path of the hosting pod after the source pod terminated and its volumes were unmounted.
+func EnsureHostingPod(ctx context.Context, kubeClient kubernetes.Interface, sourcePod *corev1.Pod, volumeName string,
+	hostingPodName string, veleroNamespace string, nodeName string, log logrus.FieldLogger) (*corev1.Pod, error) {
+	var claimName string
+	for _, volume := range sourcePod.Spec.Volumes {
+		if volume.Name == volumeName && volume.PersistentVolumeClaim != nil {
+			claimName = volume.PersistentVolumeClaim.ClaimName
+		}
+	}
+	if claimName == "" {
+		return nil, errors.Errorf("volume %s in pod %s/%s is not a PVC", volumeName, sourcePod.Namespace, sourcePod.Name)
+	}
+
+	return EnsurePVCHostingPod(ctx, kubeClient, sourcePod.Namespace, claimName, volumeName, hostingPodName, veleroNamespace, nodeName, log)
+}
+
+// EnsurePVCHostingPod makes sure a pod named hostingPodName is created on the node to mount the PVC
+// as the volume volumeName, it's used for the PVCs that are not mounted by any pod.
+func EnsurePVCHostingPod(ctx context.Context, kubeClient kubernetes.Interface, namespace string, claimName string, volumeName string,
+	hostingPodName string, veleroNamespace string, nodeName string, log logrus.FieldLogger) (*corev1.Pod, error) {
+	pod, err := kubeClient.CoreV1().Pods(namespace).Get(ctx, hostingPodName, metav1.GetOptions{})
+	if err == nil {
+		return pod, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "error getting hosting pod %s/%s", namespace, hostingPodName)
+	}
+
+	podInfo, err := getInheritedPodInfo(ctx, kubeClient, veleroNamespace)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to get inherited pod info from node-agent")
+	}
+
+	var gracePeriod int64 = 0
+	volumeMounts, _ := kube.MakePodPVCAttachment(volumeName, nil)
+
+	pod = &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      hostingPodName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				podGroupLabel: podGroupPodVolume,
+			},
+		},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+			Containers: []corev1.Container{
+				{
+					Name:            "hosting",
+					Image:           podInfo.image,
+					ImagePullPolicy: corev1.PullNever,
+					Command:         []string{"/velero-helper", "pause"},
+					VolumeMounts:    volumeMounts,
+				},
+			},
+			AutomountServiceAccountToken:  boolptr.False(),
+			TerminationGracePeriodSeconds: &gracePeriod,
+			Volumes: []corev1.Volume{{
+				Name: volumeName,
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: claimName,
+						ReadOnly:  true,
+					},
+				},
+			}},
+		},
+	}
+
+	log.WithField("hosting pod", hostingPodName).Infof("Creating hosting pod for PVC %s/%s", namespace, claimName)
+
+	return kubeClient.CoreV1().Pods(namespace).Create(ctx, pod, metav1.CreateOptions{})
+}
+
+// DeleteHostingPod deletes the hosting pod created by EnsureHostingPod, it's not an error if the pod doesn't exist.
+func DeleteHostingPod(ctx context.Context, kubeClient kubernetes.Interface, namespace, name string, log logrus.FieldLogger) {
+	var gracePeriod int64 = 0
+	err := kubeClient.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{GracePeriodSeconds: &gracePeriod})
+	if err != nil && !apierrors.IsNotFound(err) {
+		log.WithError(err).Warnf("Failed to delete hosting pod %s/%s", namespace, name)
+	}
+}
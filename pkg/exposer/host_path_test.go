@@ -23,7 +23,11 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -102,3 +106,59 @@ func TestGetPodVolumeHostPath(t *testing.T) {
 		})
 	}
 }
+
+func TestEnsureHostingPod(t *testing.T) {
+	daemonSet := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      "node-agent",
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "node-agent", Image: "velero/velero:main"}},
+				},
+			},
+		},
+	}
+
+	sourcePod := builder.ForPod("fake-ns", "fake-job-pod").
+		Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("fake-pvc").Result()).
+		Phase(corev1.PodSucceeded).Result()
+
+	kubeClient := fake.NewSimpleClientset(daemonSet)
+
+	_, err := EnsureHostingPod(context.Background(), kubeClient, sourcePod, "cache", "fake-pvb", velerov1api.DefaultNamespace, "fake-node", velerotest.NewLogger())
+	assert.EqualError(t, err, "volume cache in pod fake-ns/fake-job-pod is not a PVC")
+
+	pod, err := EnsureHostingPod(context.Background(), kubeClient, sourcePod, "data", "fake-pvb", velerov1api.DefaultNamespace, "fake-node", velerotest.NewLogger())
+	require.NoError(t, err)
+	assert.Equal(t, "fake-ns", pod.Namespace)
+	assert.Equal(t, "fake-node", pod.Spec.NodeName)
+	assert.Equal(t, "velero/velero:main", pod.Spec.Containers[0].Image)
+	assert.Equal(t, "data", pod.Spec.Volumes[0].Name)
+	assert.Equal(t, "fake-pvc", pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, podGroupPodVolume, pod.Labels[podGroupLabel])
+
+	// the existing hosting pod is returned
+	pod.Status.Phase = corev1.PodRunning
+	_, err = kubeClient.CoreV1().Pods("fake-ns").UpdateStatus(context.Background(), pod, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	pod, err = EnsureHostingPod(context.Background(), kubeClient, sourcePod, "data", "fake-pvb", velerov1api.DefaultNamespace, "fake-node", velerotest.NewLogger())
+	require.NoError(t, err)
+	assert.Equal(t, corev1.PodRunning, pod.Status.Phase)
+
+	// the PVC not mounted by any pod is mounted as the specified volume
+	pod, err = EnsurePVCHostingPod(context.Background(), kubeClient, "fake-ns", "unmounted-pvc", "pvc", "fake-pvb-2", velerov1api.DefaultNamespace, "fake-node", velerotest.NewLogger())
+	require.NoError(t, err)
+	assert.Equal(t, "pvc", pod.Spec.Volumes[0].Name)
+	assert.Equal(t, "unmounted-pvc", pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, "pvc", pod.Spec.Containers[0].VolumeMounts[0].Name)
+
+	DeleteHostingPod(context.Background(), kubeClient, "fake-ns", "fake-pvb", velerotest.NewLogger())
+	DeleteHostingPod(context.Background(), kubeClient, "fake-ns", "fake-pvb", velerotest.NewLogger())
+	DeleteHostingPod(context.Background(), kubeClient, "fake-ns", "fake-pvb-2", velerotest.NewLogger())
+	pods, err := kubeClient.CoreV1().Pods("fake-ns").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, pods.Items)
+}
@@ -25,6 +25,7 @@ const (
 	AccessModeBlock      = "by-block-device"
 	podGroupLabel        = "velero.io/exposer-pod-group"
 	podGroupSnapshot     = "snapshot-exposer"
+	podGroupPodVolume    = "pod-volume-exposer"
 )
 
 // ExposeResult defines the result of expose.
@@ -0,0 +1,388 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	mediaTypeOCIIndex           = "application/vnd.oci.image.index.v1+json"
+	mediaTypeOCIManifest        = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+
+	ociLayoutVersion = "1.0.0"
+)
+
+// ociDescriptor is the descriptor of a content in an OCI image layout.
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Platform    *ociPlatform      `json:"platform,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type ociPlatform struct {
+	OS           string `json:"os"`
+	Architecture string `json:"architecture"`
+	Variant      string `json:"variant,omitempty"`
+}
+
+// ociIndex is an image index, or a Docker manifest list which has the same structure.
+type ociIndex struct {
+	SchemaVersion int             `json:"schemaVersion"`
+	MediaType     string          `json:"mediaType,omitempty"`
+	Manifests     []ociDescriptor `json:"manifests"`
+}
+
+// ociManifest is an image manifest, or a Docker image manifest which has the same structure.
+type ociManifest struct {
+	MediaType string          `json:"mediaType,omitempty"`
+	Config    ociDescriptor   `json:"config"`
+	Layers    []ociDescriptor `json:"layers"`
+}
+
+// ImageBundler pulls images from the registries and writes them into a tarball of an OCI
+// image layout, which can be copied into a registry of an air-gapped environment by tools
+// like skopeo, e.g. "skopeo copy oci-archive:bundle.tar:IMAGE docker://IMAGE".
+type ImageBundler struct {
+	client *http.Client
+	// scheme of the registry API, tests use plain HTTP
+	scheme string
+	tokens map[string]string
+}
+
+// NewImageBundler returns an ImageBundler.
+func NewImageBundler() *ImageBundler {
+	return &ImageBundler{
+		client: &http.Client{Timeout: 10 * time.Minute},
+		scheme: "https",
+		tokens: map[string]string{},
+	}
+}
+
+// WriteBundle writes the images for the platform, e.g. "linux/amd64", into the tarball. The
+// images are named in the bundle by mirroring them to the mirror registry.
+func (b *ImageBundler) WriteBundle(ctx context.Context, images []string, platform, mirror string, w io.Writer) error {
+	target, err := parsePlatform(platform)
+	if err != nil {
+		return err
+	}
+
+	tarWriter := tar.NewWriter(w)
+	written := map[string]bool{}
+
+	layout, _ := json.Marshal(map[string]string{"imageLayoutVersion": ociLayoutVersion})
+	if err := writeTarFile(tarWriter, "oci-layout", layout); err != nil {
+		return err
+	}
+
+	index := ociIndex{SchemaVersion: 2, MediaType: mediaTypeOCIIndex}
+	for _, image := range images {
+		descriptor, err := b.writeImage(ctx, tarWriter, parseImageReference(image), target, written)
+		if err != nil {
+			return errors.Wrapf(err, "error bundling image %s", image)
+		}
+
+		name := MirrorImage(image, mirror)
+		descriptor.Annotations = map[string]string{
+			annotationImageName: name,
+			annotationRefName:   name,
+		}
+		index.Manifests = append(index.Manifests, *descriptor)
+	}
+
+	indexJSON, err := json.Marshal(index)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := writeTarFile(tarWriter, "index.json", indexJSON); err != nil {
+		return err
+	}
+
+	return errors.WithStack(tarWriter.Close())
+}
+
+// writeImage writes the manifest of the image for the platform and the blobs it references,
+// the descriptor of the manifest is returned.
+func (b *ImageBundler) writeImage(ctx context.Context, tarWriter *tar.Writer, ref imageReference, target *ociPlatform, written map[string]bool) (*ociDescriptor, error) {
+	data, mediaType, err := b.getManifest(ctx, ref, ref.reference())
+	if err != nil {
+		return nil, err
+	}
+
+	// pick the manifest of the platform from a multi-platform image
+	if mediaType == mediaTypeOCIIndex || mediaType == mediaTypeDockerManifestList {
+		index := ociIndex{}
+		if err := json.Unmarshal(data, &index); err != nil {
+			return nil, errors.Wrap(err, "error decoding image index")
+		}
+
+		var digest string
+		for _, manifest := range index.Manifests {
+			if manifest.Platform != nil && manifest.Platform.OS == target.OS && manifest.Platform.Architecture == target.Architecture &&
+				(target.Variant == "" || manifest.Platform.Variant == target.Variant) {
+				digest = manifest.Digest
+				break
+			}
+		}
+		if digest == "" {
+			return nil, errors.Errorf("no manifest is found for platform %s/%s", target.OS, target.Architecture)
+		}
+
+		if data, mediaType, err = b.getManifest(ctx, ref, digest); err != nil {
+			return nil, err
+		}
+	}
+
+	if mediaType != mediaTypeOCIManifest && mediaType != mediaTypeDockerManifest {
+		return nil, errors.Errorf("unsupported manifest media type %q", mediaType)
+	}
+
+	manifest := ociManifest{}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, errors.Wrap(err, "error decoding image manifest")
+	}
+
+	for _, blob := range append([]ociDescriptor{manifest.Config}, manifest.Layers...) {
+		if written[blob.Digest] {
+			continue
+		}
+		if err := b.writeBlob(ctx, tarWriter, ref, blob); err != nil {
+			return nil, err
+		}
+		written[blob.Digest] = true
+	}
+
+	digest := sha256Digest(data)
+	if !written[digest] {
+		if err := writeTarFile(tarWriter, blobPath(digest), data); err != nil {
+			return nil, err
+		}
+		written[digest] = true
+	}
+
+	return &ociDescriptor{MediaType: mediaType, Digest: digest, Size: int64(len(data))}, nil
+}
+
+func (b *ImageBundler) getManifest(ctx context.Context, ref imageReference, reference string) ([]byte, string, error) {
+	resp, err := b.get(ctx, ref, "manifests/"+reference,
+		strings.Join([]string{mediaTypeOCIIndex, mediaTypeOCIManifest, mediaTypeDockerManifestList, mediaTypeDockerManifest}, ","))
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "error reading manifest")
+	}
+
+	mediaType := resp.Header.Get("Content-Type")
+	if i := strings.Index(mediaType, ";"); i >= 0 {
+		mediaType = mediaType[:i]
+	}
+	// fall back to the media type in the manifest if the registry doesn't return it
+	if mediaType == "" || mediaType == "application/json" {
+		manifest := struct {
+			MediaType string `json:"mediaType"`
+		}{}
+		if err := json.Unmarshal(data, &manifest); err == nil {
+			mediaType = manifest.MediaType
+		}
+	}
+
+	return data, mediaType, nil
+}
+
+func (b *ImageBundler) writeBlob(ctx context.Context, tarWriter *tar.Writer, ref imageReference, blob ociDescriptor) error {
+	resp, err := b.get(ctx, ref, "blobs/"+blob.Digest, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := tarWriter.WriteHeader(&tar.Header{Name: blobPath(blob.Digest), Mode: 0644, Size: blob.Size, Typeflag: tar.TypeReg}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tarWriter, hash), io.LimitReader(resp.Body, blob.Size)); err != nil {
+		return errors.Wrapf(err, "error writing blob %s", blob.Digest)
+	}
+	if digest := "sha256:" + hex.EncodeToString(hash.Sum(nil)); digest != blob.Digest {
+		return errors.Errorf("digest mismatch of blob %s: %s", blob.Digest, digest)
+	}
+
+	return nil
+}
+
+// get sends a GET request of the registry API of the image repository, a token is requested
+// for the anonymous access if the registry requires.
+func (b *ImageBundler) get(ctx context.Context, ref imageReference, path, accept string) (*http.Response, error) {
+	registry := ref.registry
+	if registry == defaultRegistry {
+		registry = "registry-1.docker.io"
+	}
+	endpoint := fmt.Sprintf("%s://%s/v2/%s/%s", b.scheme, registry, ref.repositoryPath(), path)
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		if token := b.tokens[registry+"/"+ref.repositoryPath()]; token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		resp, err := b.client.Do(req)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error requesting %s", endpoint)
+		}
+
+		if resp.StatusCode == http.StatusUnauthorized && attempt == 0 {
+			challenge := resp.Header.Get("WWW-Authenticate")
+			resp.Body.Close()
+
+			token, err := b.getToken(ctx, challenge)
+			if err != nil {
+				return nil, err
+			}
+			b.tokens[registry+"/"+ref.repositoryPath()] = token
+			continue
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, errors.Errorf("error requesting %s: %s", endpoint, resp.Status)
+		}
+
+		return resp, nil
+	}
+}
+
+// getToken requests an anonymous token by the bearer challenge of the registry.
+func (b *ImageBundler) getToken(ctx context.Context, challenge string) (string, error) {
+	params := parseChallenge(challenge)
+	if params["realm"] == "" {
+		return "", errors.Errorf("unsupported authentication challenge %q", challenge)
+	}
+
+	query := url.Values{}
+	for _, key := range []string{"service", "scope"} {
+		if params[key] != "" {
+			query.Set(key, params[key])
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, params["realm"]+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "error requesting registry token")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("error requesting registry token: %s", resp.Status)
+	}
+
+	token := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", errors.Wrap(err, "error decoding registry token")
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+
+	return token.AccessToken, nil
+}
+
+// parseChallenge parses the parameters of a bearer challenge, e.g.
+// Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:velero/velero:pull"
+func parseChallenge(challenge string) map[string]string {
+	params := map[string]string{}
+
+	challenge = strings.TrimSpace(challenge)
+	if !strings.HasPrefix(strings.ToLower(challenge), "bearer ") {
+		return params
+	}
+
+	for _, param := range strings.Split(challenge[len("bearer "):], ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+		if found {
+			params[strings.ToLower(key)] = strings.Trim(value, `"`)
+		}
+	}
+
+	return params
+}
+
+func parsePlatform(platform string) (*ociPlatform, error) {
+	parts := strings.Split(platform, "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return nil, errors.Errorf("invalid platform %q, it must be in the format of os/arch[/variant]", platform)
+	}
+
+	target := &ociPlatform{OS: parts[0], Architecture: parts[1]}
+	if len(parts) == 3 {
+		target.Variant = parts[2]
+	}
+
+	return target, nil
+}
+
+func sha256Digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func blobPath(digest string) string {
+	return "blobs/" + strings.Replace(digest, ":", "/", 1)
+}
+
+func writeTarFile(tarWriter *tar.Writer, name string, data []byte) error {
+	if err := tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), Typeflag: tar.TypeReg}); err != nil {
+		return errors.WithStack(err)
+	}
+	_, err := io.Copy(tarWriter, bytes.NewReader(data))
+	return errors.WithStack(err)
+}
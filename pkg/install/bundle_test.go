@@ -0,0 +1,149 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRegistry serves a multi-platform image velero/velero:v1 and requires a bearer token.
+func newTestRegistry(t *testing.T) (*httptest.Server, map[string][]byte) {
+	t.Helper()
+
+	config := []byte(`{"architecture":"amd64","os":"linux"}`)
+	layer := []byte("layer content")
+	manifest, _ := json.Marshal(ociManifest{
+		MediaType: mediaTypeOCIManifest,
+		Config:    ociDescriptor{MediaType: "application/vnd.oci.image.config.v1+json", Digest: sha256Digest(config), Size: int64(len(config))},
+		Layers:    []ociDescriptor{{MediaType: "application/vnd.oci.image.layer.v1.tar+gzip", Digest: sha256Digest(layer), Size: int64(len(layer))}},
+	})
+	index, _ := json.Marshal(ociIndex{
+		SchemaVersion: 2,
+		MediaType:     mediaTypeOCIIndex,
+		Manifests: []ociDescriptor{
+			{MediaType: mediaTypeOCIManifest, Digest: "sha256:arm64", Size: 1, Platform: &ociPlatform{OS: "linux", Architecture: "arm64"}},
+			{MediaType: mediaTypeOCIManifest, Digest: sha256Digest(manifest), Size: int64(len(manifest)), Platform: &ociPlatform{OS: "linux", Architecture: "amd64"}},
+		},
+	})
+
+	blobs := map[string][]byte{
+		sha256Digest(config):   config,
+		sha256Digest(layer):    layer,
+		sha256Digest(manifest): manifest,
+	}
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			assert.Equal(t, "repository:velero/velero:pull", r.URL.Query().Get("scope"))
+			_, _ = w.Write([]byte(`{"token":"secret"}`))
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:velero/velero:pull"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.URL.Path == "/v2/velero/velero/manifests/v1":
+			w.Header().Set("Content-Type", mediaTypeOCIIndex)
+			_, _ = w.Write(index)
+		case r.URL.Path == "/v2/velero/velero/manifests/"+sha256Digest(manifest):
+			w.Header().Set("Content-Type", mediaTypeOCIManifest)
+			_, _ = w.Write(manifest)
+		case strings.HasPrefix(r.URL.Path, "/v2/velero/velero/blobs/"):
+			blob, ok := blobs[strings.TrimPrefix(r.URL.Path, "/v2/velero/velero/blobs/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(blob)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, blobs
+}
+
+func TestWriteBundle(t *testing.T) {
+	server, blobs := newTestRegistry(t)
+	registry := strings.TrimPrefix(server.URL, "http://")
+
+	bundler := NewImageBundler()
+	bundler.scheme = "http"
+
+	buf := &bytes.Buffer{}
+	err := bundler.WriteBundle(context.Background(), []string{registry + "/velero/velero:v1"}, "linux/amd64", "registry.example.com", buf)
+	require.NoError(t, err)
+
+	files := map[string][]byte{}
+	tarReader := tar.NewReader(buf)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tarReader)
+		require.NoError(t, err)
+		files[header.Name] = data
+	}
+
+	assert.JSONEq(t, `{"imageLayoutVersion":"1.0.0"}`, string(files["oci-layout"]))
+	for digest, blob := range blobs {
+		assert.Equal(t, blob, files[blobPath(digest)])
+	}
+
+	index := ociIndex{}
+	require.NoError(t, json.Unmarshal(files["index.json"], &index))
+	require.Len(t, index.Manifests, 1)
+	assert.Equal(t, mediaTypeOCIManifest, index.Manifests[0].MediaType)
+	assert.Equal(t, "registry.example.com/velero/velero:v1", index.Manifests[0].Annotations[annotationRefName])
+
+	// the platform isn't in the image
+	err = bundler.WriteBundle(context.Background(), []string{registry + "/velero/velero:v1"}, "linux/s390x", "", &bytes.Buffer{})
+	assert.ErrorContains(t, err, "no manifest is found for platform linux/s390x")
+
+	err = bundler.WriteBundle(context.Background(), nil, "linux", "", &bytes.Buffer{})
+	assert.ErrorContains(t, err, "invalid platform")
+}
+
+func TestParseChallenge(t *testing.T) {
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.docker.io/token",
+		"service": "registry.docker.io",
+		"scope":   "repository:velero/velero:pull",
+	}, parseChallenge(`Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:velero/velero:pull"`))
+
+	assert.Empty(t, parseChallenge(`Basic realm="registry"`))
+}
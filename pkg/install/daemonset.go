@@ -18,18 +18,22 @@ package install
 
 import (
 	"fmt"
+	"path"
 	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/vmware-tanzu/velero/internal/velero"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 )
 
 func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 	c := &podTemplateConfig{
-		image: velero.DefaultVeleroImage(),
+		image:          velero.DefaultVeleroImage(),
+		kubeletRootDir: nodeagent.DefaultKubeletRootDir,
 	}
 
 	for _, opt := range opts {
@@ -50,6 +54,13 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 		daemonSetArgs = append(daemonSetArgs, fmt.Sprintf("--features=%s", strings.Join(c.features, ",")))
 	}
 
+	// the plugins directory is mounted to the same path as on the host, so that the symlinks of the block volumes
+	// can be resolved
+	hostPluginsPath := path.Join(c.kubeletRootDir, "plugins")
+	if hostPluginsPath != nodeagent.DefaultHostPluginsPath {
+		daemonSetArgs = append(daemonSetArgs, fmt.Sprintf("--host-plugins-path=%s", hostPluginsPath))
+	}
+
 	userID := int64(0)
 	mountPropagationMode := corev1.MountPropagationHostToContainer
 
@@ -73,6 +84,10 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 					Annotations: c.annotations,
 				},
 				Spec: corev1.PodSpec{
+					// the node-agent for the Windows nodes is deployed separately
+					NodeSelector: map[string]string{
+						corev1.LabelOSStable: nodeagent.NodeOSLinux,
+					},
 					ServiceAccountName: c.serviceAccountName,
 					SecurityContext: &corev1.PodSecurityContext{
 						RunAsUser: &userID,
@@ -82,7 +97,7 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 							Name: "host-pods",
 							VolumeSource: corev1.VolumeSource{
 								HostPath: &corev1.HostPathVolumeSource{
-									Path: "/var/lib/kubelet/pods",
+									Path: path.Join(c.kubeletRootDir, "pods"),
 								},
 							},
 						},
@@ -90,7 +105,7 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 							Name: "host-plugins",
 							VolumeSource: corev1.VolumeSource{
 								HostPath: &corev1.HostPathVolumeSource{
-									Path: "/var/lib/kubelet/plugins",
+									Path: hostPluginsPath,
 								},
 							},
 						},
@@ -117,12 +132,12 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 							VolumeMounts: []corev1.VolumeMount{
 								{
 									Name:             "host-pods",
-									MountPath:        "/host_pods",
+									MountPath:        nodeagent.DefaultHostPodsPath,
 									MountPropagation: &mountPropagationMode,
 								},
 								{
 									Name:             "host-plugins",
-									MountPath:        "/var/lib/kubelet/plugins",
+									MountPath:        hostPluginsPath,
 									MountPropagation: &mountPropagationMode,
 								},
 								{
@@ -152,7 +167,9 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 									Value: "/scratch",
 								},
 							},
-							Resources: c.resources,
+							Resources:      c.resources,
+							LivenessProbe:  nodeAgentProbe(nodeagent.LivenessPath, 30),
+							ReadinessProbe: nodeAgentProbe(nodeagent.ReadinessPath, 10),
 						},
 					},
 				},
@@ -203,5 +220,22 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 
 	daemonSet.Spec.Template.Spec.Containers[0].Env = append(daemonSet.Spec.Template.Spec.Containers[0].Env, c.envVars...)
 
+	c.applyScheduling(&daemonSet.Spec.Template.Spec, daemonSet.Spec.Selector.MatchLabels)
+
 	return daemonSet
 }
+
+// nodeAgentProbe returns the probe of the node-agent container on the health endpoint at the metrics port
+func nodeAgentProbe(path string, periodSeconds int32) *corev1.Probe {
+	return &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: path,
+				Port: intstr.FromString("metrics"),
+			},
+		},
+		PeriodSeconds:    periodSeconds,
+		TimeoutSeconds:   5,
+		FailureThreshold: 3,
+	}
+}
@@ -28,6 +28,9 @@ func TestDaemonSet(t *testing.T) {
 
 	assert.Equal(t, "node-agent", ds.Spec.Template.Spec.Containers[0].Name)
 	assert.Equal(t, "velero", ds.ObjectMeta.Namespace)
+	assert.Equal(t, "/healthz", ds.Spec.Template.Spec.Containers[0].LivenessProbe.HTTPGet.Path)
+	assert.Equal(t, "metrics", ds.Spec.Template.Spec.Containers[0].LivenessProbe.HTTPGet.Port.String())
+	assert.Equal(t, "/readyz", ds.Spec.Template.Spec.Containers[0].ReadinessProbe.HTTPGet.Path)
 
 	ds = DaemonSet("velero", WithImage("velero/velero:v0.11"))
 	assert.Equal(t, "velero/velero:v0.11", ds.Spec.Template.Spec.Containers[0].Image)
@@ -41,6 +44,25 @@ func TestDaemonSet(t *testing.T) {
 	assert.Len(t, ds.Spec.Template.Spec.Containers[0].Args, 3)
 	assert.Equal(t, "--features=foo,bar,baz", ds.Spec.Template.Spec.Containers[0].Args[2])
 
+	ds = DaemonSet("velero")
+	assert.Equal(t, map[string]string{"kubernetes.io/os": "linux"}, ds.Spec.Template.Spec.NodeSelector)
+	assert.Equal(t, "/var/lib/kubelet/pods", ds.Spec.Template.Spec.Volumes[0].HostPath.Path)
+	assert.Equal(t, "/var/lib/kubelet/plugins", ds.Spec.Template.Spec.Volumes[1].HostPath.Path)
+	assert.Equal(t, "/var/lib/kubelet/plugins", ds.Spec.Template.Spec.Containers[0].VolumeMounts[1].MountPath)
+	assert.Equal(t, []string{"node-agent", "server"}, ds.Spec.Template.Spec.Containers[0].Args)
+
+	ds = DaemonSet("velero", WithKubeletRootDir("/var/snap/microk8s/common/var/lib/kubelet"))
+	assert.Equal(t, "/var/snap/microk8s/common/var/lib/kubelet/pods", ds.Spec.Template.Spec.Volumes[0].HostPath.Path)
+	assert.Equal(t, "/var/snap/microk8s/common/var/lib/kubelet/plugins", ds.Spec.Template.Spec.Volumes[1].HostPath.Path)
+	assert.Equal(t, "/host_pods", ds.Spec.Template.Spec.Containers[0].VolumeMounts[0].MountPath)
+	assert.Equal(t, "/var/snap/microk8s/common/var/lib/kubelet/plugins", ds.Spec.Template.Spec.Containers[0].VolumeMounts[1].MountPath)
+	assert.Equal(t, "--host-plugins-path=/var/snap/microk8s/common/var/lib/kubelet/plugins", ds.Spec.Template.Spec.Containers[0].Args[2])
+
 	ds = DaemonSet("velero", WithServiceAccountName("test-sa"))
 	assert.Equal(t, "test-sa", ds.Spec.Template.Spec.ServiceAccountName)
+
+	tolerations := []corev1.Toleration{{Key: "node-role", Operator: corev1.TolerationOpEqual, Value: "storage", Effect: corev1.TaintEffectNoSchedule}}
+	ds = DaemonSet("velero", WithTolerations(tolerations), WithPriorityClassName("system-node-critical"))
+	assert.Equal(t, tolerations, ds.Spec.Template.Spec.Tolerations)
+	assert.Equal(t, "system-node-critical", ds.Spec.Template.Spec.PriorityClassName)
 }
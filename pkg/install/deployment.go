@@ -49,6 +49,10 @@ type podTemplateConfig struct {
 	defaultSnapshotMoveData         bool
 	privilegedNodeAgent             bool
 	disableInformerCache            bool
+	tolerations                     []corev1.Toleration
+	topologySpreadConstraints       []corev1.TopologySpreadConstraint
+	priorityClassName               string
+	kubeletRootDir                  string
 }
 
 func WithImage(image string) podTemplateOption {
@@ -163,6 +167,45 @@ func WithPrivilegedNodeAgent() podTemplateOption {
 	}
 }
 
+func WithTolerations(tolerations []corev1.Toleration) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.tolerations = tolerations
+	}
+}
+
+// WithTopologySpreadConstraints sets the topology spread constraints of the pods, the label
+// selectors of the constraints default to the labels selecting the pods.
+func WithTopologySpreadConstraints(constraints []corev1.TopologySpreadConstraint) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.topologySpreadConstraints = constraints
+	}
+}
+
+func WithKubeletRootDir(dir string) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.kubeletRootDir = dir
+	}
+}
+
+func WithPriorityClassName(name string) podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.priorityClassName = name
+	}
+}
+
+// applyScheduling sets the scheduling options of the config into the pod spec.
+func (c *podTemplateConfig) applyScheduling(spec *corev1.PodSpec, selector map[string]string) {
+	spec.Tolerations = c.tolerations
+	spec.PriorityClassName = c.priorityClassName
+
+	for _, constraint := range c.topologySpreadConstraints {
+		if constraint.LabelSelector == nil {
+			constraint.LabelSelector = &metav1.LabelSelector{MatchLabels: selector}
+		}
+		spec.TopologySpreadConstraints = append(spec.TopologySpreadConstraints, constraint)
+	}
+}
+
 func Deployment(namespace string, opts ...podTemplateOption) *appsv1.Deployment {
 	// TODO: Add support for server args
 	c := &podTemplateConfig{
@@ -331,6 +374,8 @@ func Deployment(namespace string, opts ...podTemplateOption) *appsv1.Deployment
 
 	deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env, c.envVars...)
 
+	c.applyScheduling(&deployment.Spec.Template.Spec, deployment.Spec.Selector.MatchLabels)
+
 	if len(c.plugins) > 0 {
 		for _, image := range c.plugins {
 			container := *builder.ForPluginContainer(image, pullPolicy).Result()
@@ -22,6 +22,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestDeployment(t *testing.T) {
@@ -68,4 +69,17 @@ func TestDeployment(t *testing.T) {
 	deploy = Deployment("velero", WithDisableInformerCache())
 	assert.Len(t, deploy.Spec.Template.Spec.Containers[0].Args, 2)
 	assert.Equal(t, "--disable-informer-cache=true", deploy.Spec.Template.Spec.Containers[0].Args[1])
+
+	tolerations := []corev1.Toleration{{Key: "node-role", Operator: corev1.TolerationOpExists}}
+	deploy = Deployment("velero", WithTolerations(tolerations), WithPriorityClassName("velero-critical"))
+	assert.Equal(t, tolerations, deploy.Spec.Template.Spec.Tolerations)
+	assert.Equal(t, "velero-critical", deploy.Spec.Template.Spec.PriorityClassName)
+
+	deploy = Deployment("velero", WithTopologySpreadConstraints([]corev1.TopologySpreadConstraint{
+		{TopologyKey: "kubernetes.io/hostname", MaxSkew: 1, WhenUnsatisfiable: corev1.ScheduleAnyway},
+		{TopologyKey: "topology.kubernetes.io/zone", MaxSkew: 2, WhenUnsatisfiable: corev1.DoNotSchedule, LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}},
+	}))
+	assert.Len(t, deploy.Spec.Template.Spec.TopologySpreadConstraints, 2)
+	assert.Equal(t, map[string]string{"deploy": "velero"}, deploy.Spec.Template.Spec.TopologySpreadConstraints[0].LabelSelector.MatchLabels)
+	assert.Equal(t, map[string]string{"foo": "bar"}, deploy.Spec.Template.Spec.TopologySpreadConstraints[1].LabelSelector.MatchLabels)
 }
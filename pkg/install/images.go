@@ -0,0 +1,189 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultRegistry = "docker.io"
+
+	// annotations of the images in an OCI image layout
+	annotationImageName = "io.containerd.image.name"
+	annotationRefName   = "org.opencontainers.image.ref.name"
+)
+
+// imageReference is a parsed container image reference.
+type imageReference struct {
+	registry   string
+	repository string
+	// tag or digest, the digest is prefixed by "@" and the tag by ":"
+	suffix string
+}
+
+func parseImageReference(image string) imageReference {
+	ref := imageReference{registry: defaultRegistry}
+
+	remainder := image
+	if i := strings.Index(remainder, "/"); i > 0 {
+		domain := remainder[:i]
+		if strings.ContainsAny(domain, ".:") || domain == "localhost" {
+			ref.registry = domain
+			remainder = remainder[i+1:]
+		}
+	}
+
+	if i := strings.Index(remainder, "@"); i >= 0 {
+		ref.repository, ref.suffix = remainder[:i], remainder[i:]
+	} else if i := strings.LastIndex(remainder, ":"); i > strings.LastIndex(remainder, "/") {
+		ref.repository, ref.suffix = remainder[:i], remainder[i:]
+	} else {
+		ref.repository, ref.suffix = remainder, ":latest"
+	}
+
+	return ref
+}
+
+// reference returns the tag or the digest of the image.
+func (r imageReference) reference() string {
+	return r.suffix[1:]
+}
+
+// repositoryPath returns the path of the repository in the registry API, the official
+// images of Docker Hub are under "library".
+func (r imageReference) repositoryPath() string {
+	if r.registry == defaultRegistry && !strings.Contains(r.repository, "/") {
+		return "library/" + r.repository
+	}
+	return r.repository
+}
+
+// MirrorImage returns the image referenced from the mirror registry, the registry of the
+// image is replaced by the mirror. The image is returned as is if the mirror is empty.
+func MirrorImage(image, mirror string) string {
+	mirror = strings.TrimSuffix(mirror, "/")
+	if mirror == "" {
+		return image
+	}
+
+	ref := parseImageReference(image)
+	return mirror + "/" + ref.repository + ref.suffix
+}
+
+// ImagesFromTarball returns the names of the images in the tarball, which is either an
+// OCI image layout or an archive created by "docker save", optionally gzipped.
+func ImagesFromTarball(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if gzipReader, err := gzip.NewReader(file); err == nil {
+		defer gzipReader.Close()
+		reader = gzipReader
+	} else if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var images []string
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading tarball %s", path)
+		}
+
+		switch strings.TrimPrefix(header.Name, "./") {
+		case "index.json":
+			names, err := imagesFromOCIIndex(tarReader)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error reading index.json of tarball %s", path)
+			}
+			images = append(images, names...)
+		case "manifest.json":
+			names, err := imagesFromDockerManifest(tarReader)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error reading manifest.json of tarball %s", path)
+			}
+			images = append(images, names...)
+		}
+	}
+
+	if len(images) == 0 {
+		return nil, errors.Errorf("no named image is found in tarball %s", path)
+	}
+
+	// an archive might have both index.json and manifest.json for the same images
+	sort.Strings(images)
+	unique := images[:1]
+	for _, image := range images[1:] {
+		if image != unique[len(unique)-1] {
+			unique = append(unique, image)
+		}
+	}
+
+	return unique, nil
+}
+
+func imagesFromOCIIndex(reader io.Reader) ([]string, error) {
+	index := ociIndex{}
+	if err := json.NewDecoder(reader).Decode(&index); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var images []string
+	for _, manifest := range index.Manifests {
+		if name := manifest.Annotations[annotationImageName]; name != "" {
+			images = append(images, name)
+		} else if name := manifest.Annotations[annotationRefName]; strings.ContainsAny(name, "/:") {
+			// the ref name might be a tag only, which doesn't identify the image
+			images = append(images, name)
+		}
+	}
+
+	return images, nil
+}
+
+func imagesFromDockerManifest(reader io.Reader) ([]string, error) {
+	manifests := []struct {
+		RepoTags []string `json:"RepoTags"`
+	}{}
+	if err := json.NewDecoder(reader).Decode(&manifests); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var images []string
+	for _, manifest := range manifests {
+		images = append(images, manifest.RepoTags...)
+	}
+
+	return images, nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMirrorImage(t *testing.T) {
+	tests := []struct {
+		image    string
+		mirror   string
+		expected string
+	}{
+		{
+			image:    "velero/velero:v1.12.0",
+			expected: "velero/velero:v1.12.0",
+		},
+		{
+			image:    "velero/velero:v1.12.0",
+			mirror:   "registry.example.com/mirror/",
+			expected: "registry.example.com/mirror/velero/velero:v1.12.0",
+		},
+		{
+			image:    "docker.io/velero/velero-plugin-for-aws:v1.8.0",
+			mirror:   "registry.example.com",
+			expected: "registry.example.com/velero/velero-plugin-for-aws:v1.8.0",
+		},
+		{
+			image:    "localhost:5000/velero/velero",
+			mirror:   "registry.example.com",
+			expected: "registry.example.com/velero/velero:latest",
+		},
+		{
+			image:    "gcr.io/project/plugin@sha256:0123456789abcdef",
+			mirror:   "registry.example.com:8443",
+			expected: "registry.example.com:8443/project/plugin@sha256:0123456789abcdef",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.image+" "+test.mirror, func(t *testing.T) {
+			assert.Equal(t, test.expected, MirrorImage(test.image, test.mirror))
+		})
+	}
+}
+
+func writeTestTarball(t *testing.T, gzipped bool, files map[string]string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "images.tar")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var w io.Writer = file
+	if gzipped {
+		gzipWriter := gzip.NewWriter(file)
+		defer gzipWriter.Close()
+		w = gzipWriter
+	}
+
+	tarWriter := tar.NewWriter(w)
+	defer tarWriter.Close()
+	for name, content := range files {
+		require.NoError(t, writeTarFile(tarWriter, name, []byte(content)))
+	}
+
+	return path
+}
+
+func TestImagesFromTarball(t *testing.T) {
+	tests := []struct {
+		name        string
+		gzipped     bool
+		files       map[string]string
+		expected    []string
+		expectedErr string
+	}{
+		{
+			name: "OCI image layout",
+			files: map[string]string{
+				"oci-layout": `{"imageLayoutVersion":"1.0.0"}`,
+				"index.json": `{"schemaVersion":2,"manifests":[
+					{"digest":"sha256:1","annotations":{"io.containerd.image.name":"example.io/plugin-b:v1"}},
+					{"digest":"sha256:2","annotations":{"org.opencontainers.image.ref.name":"example.io/plugin-a:v1"}},
+					{"digest":"sha256:3","annotations":{"org.opencontainers.image.ref.name":"v1"}}
+				]}`,
+			},
+			expected: []string{"example.io/plugin-a:v1", "example.io/plugin-b:v1"},
+		},
+		{
+			name:    "gzipped docker save archive",
+			gzipped: true,
+			files: map[string]string{
+				"manifest.json": `[{"RepoTags":["example.io/plugin-a:v1"]},{"RepoTags":["example.io/plugin-a:v1","example.io/plugin-a:latest"]}]`,
+			},
+			expected: []string{"example.io/plugin-a:latest", "example.io/plugin-a:v1"},
+		},
+		{
+			name:        "no named image",
+			files:       map[string]string{"index.json": `{"schemaVersion":2,"manifests":[]}`},
+			expectedErr: "no named image is found in tarball",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			images, err := ImagesFromTarball(writeTestTarball(t, test.gzipped, test.files))
+			if test.expectedErr != "" {
+				assert.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, images)
+		})
+	}
+}
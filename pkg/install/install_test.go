@@ -31,7 +31,7 @@ func TestInstall(t *testing.T) {
 	c := fake.NewClientBuilder().WithObjects(
 		&apiextv1.CustomResourceDefinition{
 			ObjectMeta: v1.ObjectMeta{
-				Name: "backuprepositories.velero.io",
+				Name: "backuphookpolicies.velero.io",
 			},
 
 			Status: apiextv1.CustomResourceDefinitionStatus{
@@ -60,7 +60,7 @@ func Test_crdsAreReady(t *testing.T) {
 	c := fake.NewClientBuilder().WithObjects(
 		&apiextv1beta1.CustomResourceDefinition{
 			ObjectMeta: v1.ObjectMeta{
-				Name: "backuprepositories.velero.io",
+				Name: "backuphookpolicies.velero.io",
 			},
 
 			Status: apiextv1beta1.CustomResourceDefinitionStatus{
@@ -84,7 +84,7 @@ func Test_crdsAreReady(t *testing.T) {
 			APIVersion: "v1beta1",
 		},
 		ObjectMeta: v1.ObjectMeta{
-			Name: "backuprepositories.velero.io",
+			Name: "backuphookpolicies.velero.io",
 		},
 	}
 	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(crd)
@@ -56,9 +56,17 @@ func Labels() map[string]string {
 	}
 }
 
+// resourceLabels returns the labels set on all the installed resources, which are the labels
+// selecting the Velero resources plus the recommended Kubernetes application label.
+func resourceLabels() map[string]string {
+	labels := Labels()
+	labels["app.kubernetes.io/name"] = "velero"
+	return labels
+}
+
 func podLabels(userLabels ...map[string]string) map[string]string {
 	// Use the default labels as a starting point
-	base := Labels()
+	base := resourceLabels()
 
 	// Merge base labels with user labels to enforce CLI precedence
 	for _, labels := range userLabels {
@@ -99,7 +107,7 @@ func objectMeta(namespace, name string) metav1.ObjectMeta {
 	return metav1.ObjectMeta{
 		Name:      name,
 		Namespace: namespace,
-		Labels:    Labels(),
+		Labels:    resourceLabels(),
 	}
 }
 
@@ -254,6 +262,25 @@ type VeleroOptions struct {
 	UploaderType                    string
 	DefaultSnapshotMoveData         bool
 	DisableInformerCache            bool
+	VeleroPodScheduling             PodScheduling
+	NodeAgentPodScheduling          PodScheduling
+	KubeletRootDir                  string
+}
+
+// PodScheduling is the scheduling settings of the pods of the Velero Deployment or the
+// node-agent DaemonSet.
+type PodScheduling struct {
+	Tolerations               []corev1.Toleration
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint
+	PriorityClassName         string
+}
+
+func (s PodScheduling) options() []podTemplateOption {
+	return []podTemplateOption{
+		WithTolerations(s.Tolerations),
+		WithTopologySpreadConstraints(s.TopologySpreadConstraints),
+		WithPriorityClassName(s.PriorityClassName),
+	}
 }
 
 func AllCRDs() *unstructured.UnstructuredList {
@@ -262,14 +289,14 @@ func AllCRDs() *unstructured.UnstructuredList {
 	resources.SetGroupVersionKind(schema.GroupVersionKind{Group: "", Version: "v1", Kind: "List"})
 
 	for _, crd := range v1crds.CRDs {
-		crd.SetLabels(Labels())
+		crd.SetLabels(resourceLabels())
 		if err := appendUnstructured(resources, crd); err != nil {
 			fmt.Printf("error appending v1 CRD %s: %s\n", crd.GetName(), err.Error())
 		}
 	}
 
 	for _, crd := range v2alpha1crds.CRDs {
-		crd.SetLabels(Labels())
+		crd.SetLabels(resourceLabels())
 		if err := appendUnstructured(resources, crd); err != nil {
 			fmt.Printf("error appending v2alpha1 CRD %s: %s\n", crd.GetName(), err.Error())
 		}
@@ -337,6 +364,7 @@ func AllResources(o *VeleroOptions) *unstructured.UnstructuredList {
 		WithGarbageCollectionFrequency(o.GarbageCollectionFrequency),
 		WithUploaderType(o.UploaderType),
 	}
+	deployOpts = append(deployOpts, o.VeleroPodScheduling.options()...)
 
 	if len(o.Features) > 0 {
 		deployOpts = append(deployOpts, WithFeatures(o.Features))
@@ -377,12 +405,16 @@ func AllResources(o *VeleroOptions) *unstructured.UnstructuredList {
 			WithSecret(secretPresent),
 			WithServiceAccountName(serviceAccountName),
 		}
+		dsOpts = append(dsOpts, o.NodeAgentPodScheduling.options()...)
 		if len(o.Features) > 0 {
 			dsOpts = append(dsOpts, WithFeatures(o.Features))
 		}
 		if o.PrivilegedNodeAgent {
 			dsOpts = append(dsOpts, WithPrivilegedNodeAgent())
 		}
+		if o.KubeletRootDir != "" {
+			dsOpts = append(dsOpts, WithKubeletRootDir(o.KubeletRootDir))
+		}
 		ds := DaemonSet(o.Namespace, dsOpts...)
 		if err := appendUnstructured(resources, ds); err != nil {
 			fmt.Printf("error appending DaemonSet %s: %s\n", ds.GetName(), err.Error())
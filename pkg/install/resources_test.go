@@ -71,8 +71,8 @@ func TestResources(t *testing.T) {
 
 func TestAllCRDs(t *testing.T) {
 	list := AllCRDs()
-	assert.Len(t, list.Items, 13)
-	assert.Equal(t, Labels(), list.Items[0].GetLabels())
+	assert.Len(t, list.Items, 21)
+	assert.Equal(t, map[string]string{"component": "velero", "app.kubernetes.io/name": "velero"}, list.Items[0].GetLabels())
 }
 
 func TestAllResources(t *testing.T) {
@@ -113,4 +113,8 @@ func TestAllResources(t *testing.T) {
 
 	_, exist = objects["DaemonSet"]
 	assert.True(t, exist)
+
+	for _, item := range list.Items {
+		assert.Equal(t, "velero", item.GetLabels()["app.kubernetes.io/name"], "%s %s", item.GetKind(), item.GetName())
+	}
 }
@@ -0,0 +1,90 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package itemgraph
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// ResourceResolver resolves a kind to its group resource and whether it's
+// namespaced.
+type ResourceResolver func(gvk schema.GroupVersionKind) (schema.GroupResource, bool, error)
+
+// DependenciesOf returns the known dependencies of the item:
+//   - the owners of the item depend on it
+//   - a PVC depends on the PV it's bound to
+//   - a pod depends on the PVCs it mounts
+//   - a service depends on the endpoints with the same name
+//
+// The owners which can't be resolved by the resolver are skipped.
+func DependenciesOf(item Item, obj runtime.Unstructured, resolve ResourceResolver) []Dependency {
+	var dependencies []Dependency
+
+	if metadata, err := meta.Accessor(obj); err == nil {
+		for _, owner := range metadata.GetOwnerReferences() {
+			gr, namespaced, err := resolve(schema.FromAPIVersionAndKind(owner.APIVersion, owner.Kind))
+			if err != nil {
+				continue
+			}
+			ownerItem := Item{Resource: gr.String(), Name: owner.Name}
+			if namespaced {
+				ownerItem.Namespace = item.Namespace
+			}
+			dependencies = append(dependencies, Dependency{Item: ownerItem, DependsOn: item, Reason: ReasonOwnerReference})
+		}
+	}
+
+	content := obj.UnstructuredContent()
+	switch item.Resource {
+	case kuberesource.PersistentVolumeClaims.String():
+		if volumeName, _, _ := unstructured.NestedString(content, "spec", "volumeName"); volumeName != "" {
+			dependencies = append(dependencies, Dependency{
+				Item:      item,
+				DependsOn: Item{Resource: kuberesource.PersistentVolumes.String(), Name: volumeName},
+				Reason:    ReasonPersistentVolume,
+			})
+		}
+	case kuberesource.Pods.String():
+		volumes, _, _ := unstructured.NestedSlice(content, "spec", "volumes")
+		for _, volume := range volumes {
+			volumeMap, ok := volume.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if claimName, _, _ := unstructured.NestedString(volumeMap, "persistentVolumeClaim", "claimName"); claimName != "" {
+				dependencies = append(dependencies, Dependency{
+					Item:      item,
+					DependsOn: Item{Resource: kuberesource.PersistentVolumeClaims.String(), Namespace: item.Namespace, Name: claimName},
+					Reason:    ReasonPersistentVolumeClaim,
+				})
+			}
+		}
+	case kuberesource.Services.String():
+		dependencies = append(dependencies, Dependency{
+			Item:      item,
+			DependsOn: Item{Resource: kuberesource.Endpoints.String(), Namespace: item.Namespace, Name: item.Name},
+			Reason:    ReasonEndpoints,
+		})
+	}
+
+	return dependencies
+}
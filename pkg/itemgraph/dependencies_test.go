@@ -0,0 +1,124 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package itemgraph
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestDependenciesOf(t *testing.T) {
+	resolve := func(gvk schema.GroupVersionKind) (schema.GroupResource, bool, error) {
+		switch gvk.Kind {
+		case "ReplicaSet":
+			return schema.GroupResource{Group: "apps", Resource: "replicasets"}, true, nil
+		case "Node":
+			return schema.GroupResource{Resource: "nodes"}, false, nil
+		}
+		return schema.GroupResource{}, false, errors.New("unknown kind")
+	}
+
+	tests := []struct {
+		name     string
+		item     Item
+		obj      metav1.Object
+		expected []Dependency
+	}{
+		{
+			name: "owners depend on the item",
+			item: Item{Resource: "pods", Namespace: "ns", Name: "pod"},
+			obj: builder.ForPod("ns", "pod").ObjectMeta(builder.WithOwnerReference([]metav1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs"},
+				{APIVersion: "v1", Kind: "Node", Name: "node"},
+				{APIVersion: "example.io/v1", Kind: "Unknown", Name: "unknown"},
+			})).Result(),
+			expected: []Dependency{
+				{
+					Item:      Item{Resource: "replicasets.apps", Namespace: "ns", Name: "rs"},
+					DependsOn: Item{Resource: "pods", Namespace: "ns", Name: "pod"},
+					Reason:    ReasonOwnerReference,
+				},
+				{
+					Item:      Item{Resource: "nodes", Name: "node"},
+					DependsOn: Item{Resource: "pods", Namespace: "ns", Name: "pod"},
+					Reason:    ReasonOwnerReference,
+				},
+			},
+		},
+		{
+			name: "pvc depends on its pv",
+			item: Item{Resource: "persistentvolumeclaims", Namespace: "ns", Name: "pvc"},
+			obj:  builder.ForPersistentVolumeClaim("ns", "pvc").VolumeName("pv").Result(),
+			expected: []Dependency{
+				{
+					Item:      Item{Resource: "persistentvolumeclaims", Namespace: "ns", Name: "pvc"},
+					DependsOn: Item{Resource: "persistentvolumes", Name: "pv"},
+					Reason:    ReasonPersistentVolume,
+				},
+			},
+		},
+		{
+			name: "unbound pvc has no dependency",
+			item: Item{Resource: "persistentvolumeclaims", Namespace: "ns", Name: "pvc"},
+			obj:  builder.ForPersistentVolumeClaim("ns", "pvc").Result(),
+		},
+		{
+			name: "pod depends on its pvcs",
+			item: Item{Resource: "pods", Namespace: "ns", Name: "pod"},
+			obj: builder.ForPod("ns", "pod").Volumes(
+				builder.ForVolume("data").PersistentVolumeClaimSource("pvc").Result(),
+				builder.ForVolume("empty").Result(),
+			).Result(),
+			expected: []Dependency{
+				{
+					Item:      Item{Resource: "pods", Namespace: "ns", Name: "pod"},
+					DependsOn: Item{Resource: "persistentvolumeclaims", Namespace: "ns", Name: "pvc"},
+					Reason:    ReasonPersistentVolumeClaim,
+				},
+			},
+		},
+		{
+			name: "service depends on its endpoints",
+			item: Item{Resource: "services", Namespace: "ns", Name: "svc"},
+			obj:  builder.ForService("ns", "svc").Result(),
+			expected: []Dependency{
+				{
+					Item:      Item{Resource: "services", Namespace: "ns", Name: "svc"},
+					DependsOn: Item{Resource: "endpoints", Namespace: "ns", Name: "svc"},
+					Reason:    ReasonEndpoints,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(test.obj)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, DependenciesOf(test.item, &unstructured.Unstructured{Object: content}, resolve))
+		})
+	}
+}
@@ -0,0 +1,305 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package itemgraph records the dependencies between the items of a backup and
+// uses them to order the items during restore.
+package itemgraph
+
+import (
+	"container/heap"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// FileName is the name of the file in the metadata directory of the backup
+// tarball which contains the dependency graph of the backed up items.
+const FileName = "dependencies.json"
+
+// The reasons of the dependencies.
+const (
+	// ReasonOwnerReference means the owner depends on the item it owns, so
+	// that the item is restored explicitly before being adopted by the owner.
+	ReasonOwnerReference = "OwnerReference"
+	// ReasonPersistentVolume means a PVC depends on the PV it's bound to.
+	ReasonPersistentVolume = "PersistentVolume"
+	// ReasonPersistentVolumeClaim means a pod depends on the PVCs it mounts.
+	ReasonPersistentVolumeClaim = "PersistentVolumeClaim"
+	// ReasonEndpoints means a service depends on its endpoints, so that no
+	// new endpoints are created for the restored service.
+	ReasonEndpoints = "Endpoints"
+)
+
+// Item identifies an item of a backup.
+type Item struct {
+	// Resource is the group resource of the item, e.g. "deployments.apps".
+	Resource string `json:"resource"`
+	// Namespace is the namespace of the item, empty for cluster-scoped items.
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the item.
+	Name string `json:"name"`
+}
+
+func (i Item) String() string {
+	if i.Namespace == "" {
+		return fmt.Sprintf("%s/%s", i.Resource, i.Name)
+	}
+	return fmt.Sprintf("%s/%s/%s", i.Resource, i.Namespace, i.Name)
+}
+
+// Dependency records that Item should be restored after DependsOn.
+type Dependency struct {
+	Item      Item   `json:"item"`
+	DependsOn Item   `json:"dependsOn"`
+	Reason    string `json:"reason"`
+}
+
+// Graph is the dependency graph of the items of a backup.
+type Graph struct {
+	items        map[Item]struct{}
+	dependencies map[Dependency]struct{}
+}
+
+// graphFile is the format of the dependency graph in the backup tarball.
+type graphFile struct {
+	Dependencies []Dependency `json:"dependencies"`
+}
+
+// NewGraph returns an empty Graph.
+func NewGraph() *Graph {
+	return &Graph{
+		items:        map[Item]struct{}{},
+		dependencies: map[Dependency]struct{}{},
+	}
+}
+
+// AddItem adds the item and the dependencies found for it to the graph.
+func (g *Graph) AddItem(item Item, dependencies ...Dependency) {
+	g.items[item] = struct{}{}
+	for _, dependency := range dependencies {
+		g.dependencies[dependency] = struct{}{}
+	}
+}
+
+// Dependencies returns the sorted dependencies between the items of the graph.
+// The dependencies on or of items which aren't in the graph are dropped.
+func (g *Graph) Dependencies() []Dependency {
+	if g == nil {
+		return nil
+	}
+
+	var dependencies []Dependency
+	for dependency := range g.dependencies {
+		_, itemExists := g.items[dependency.Item]
+		_, dependsOnExists := g.items[dependency.DependsOn]
+		if itemExists && dependsOnExists {
+			dependencies = append(dependencies, dependency)
+		}
+	}
+
+	sort.Slice(dependencies, func(i, j int) bool {
+		if dependencies[i].Item != dependencies[j].Item {
+			return dependencies[i].Item.String() < dependencies[j].Item.String()
+		}
+		return dependencies[i].DependsOn.String() < dependencies[j].DependsOn.String()
+	})
+
+	return dependencies
+}
+
+// Write writes the dependencies of the graph to the writer as JSON.
+func (g *Graph) Write(w io.Writer) error {
+	if err := json.NewEncoder(w).Encode(graphFile{Dependencies: g.Dependencies()}); err != nil {
+		return errors.Wrap(err, "error encoding dependency graph")
+	}
+	return nil
+}
+
+// Read decodes a dependency graph written by Write.
+func Read(data []byte) (*Graph, error) {
+	file := graphFile{}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, errors.Wrap(err, "error decoding dependency graph")
+	}
+
+	g := NewGraph()
+	for _, dependency := range file.Dependencies {
+		g.AddItem(dependency.Item)
+		g.AddItem(dependency.DependsOn, dependency)
+	}
+
+	return g, nil
+}
+
+// OrderResources returns the resources ordered so that a resource comes after
+// the resources its items depend on. The resources which don't depend on each
+// other keep their relative order, and a dependency cycle is broken at the
+// resource which comes first in the original order.
+func (g *Graph) OrderResources(resources []string) []string {
+	if g == nil || len(g.dependencies) == 0 {
+		return resources
+	}
+
+	index := make(map[string]int, len(resources))
+	for i, resource := range resources {
+		index[resource] = i
+	}
+
+	var edges [][2]int
+	for dependency := range g.dependencies {
+		from, fromExists := index[dependency.DependsOn.Resource]
+		to, toExists := index[dependency.Item.Resource]
+		if fromExists && toExists && from != to {
+			edges = append(edges, [2]int{from, to})
+		}
+	}
+
+	return reorder(resources, edges)
+}
+
+// OrderItems returns the names of the items of the resource in the namespace
+// ordered so that an item comes after the items it depends on, in the same way
+// as OrderResources.
+func (g *Graph) OrderItems(resource, namespace string, names []string) []string {
+	if g == nil || len(g.dependencies) == 0 {
+		return names
+	}
+
+	index := make(map[string]int, len(names))
+	for i, name := range names {
+		index[name] = i
+	}
+
+	var edges [][2]int
+	for dependency := range g.dependencies {
+		if dependency.Item.Resource != resource || dependency.Item.Namespace != namespace ||
+			dependency.DependsOn.Resource != resource || dependency.DependsOn.Namespace != namespace {
+			continue
+		}
+		from, fromExists := index[dependency.DependsOn.Name]
+		to, toExists := index[dependency.Item.Name]
+		if fromExists && toExists && from != to {
+			edges = append(edges, [2]int{from, to})
+		}
+	}
+
+	return reorder(names, edges)
+}
+
+// Dependents returns the sorted items which depend on the item directly, e.g.
+// the pods which mount a PVC. The items which aren't in the graph are dropped.
+func (g *Graph) Dependents(item Item) []Item {
+	if g == nil {
+		return nil
+	}
+
+	var dependents []Item
+	for dependency := range g.dependencies {
+		if dependency.DependsOn != item {
+			continue
+		}
+		if _, exists := g.items[dependency.Item]; exists {
+			dependents = append(dependents, dependency.Item)
+		}
+	}
+
+	sort.Slice(dependents, func(i, j int) bool {
+		return dependents[i].String() < dependents[j].String()
+	})
+	return dependents
+}
+
+// reorder sorts the values topologically by the edges between their indexes,
+// an edge {i, j} means values[i] comes before values[j].
+func reorder(values []string, edges [][2]int) []string {
+	if len(edges) == 0 {
+		return values
+	}
+
+	ordered := make([]string, 0, len(values))
+	for _, i := range stableTopologicalSort(len(values), edges) {
+		ordered = append(ordered, values[i])
+	}
+	return ordered
+}
+
+// stableTopologicalSort returns the indexes 0..n-1 sorted topologically by the
+// edges, preferring the lower index whenever more than one is available.
+func stableTopologicalSort(n int, edges [][2]int) []int {
+	successors := make([][]int, n)
+	inDegree := make([]int, n)
+	for _, edge := range edges {
+		successors[edge[0]] = append(successors[edge[0]], edge[1])
+		inDegree[edge[1]]++
+	}
+
+	ready := &intHeap{}
+	for i := 0; i < n; i++ {
+		if inDegree[i] == 0 {
+			heap.Push(ready, i)
+		}
+	}
+
+	sorted := make([]int, 0, n)
+	visited := make([]bool, n)
+	next := 0
+	for len(sorted) < n {
+		if ready.Len() == 0 {
+			// the remaining indexes are in cycles, break them at the lowest one
+			for visited[next] {
+				next++
+			}
+			heap.Push(ready, next)
+		}
+
+		i := heap.Pop(ready).(int)
+		if visited[i] {
+			continue
+		}
+		visited[i] = true
+		sorted = append(sorted, i)
+
+		for _, j := range successors[i] {
+			inDegree[j]--
+			if inDegree[j] == 0 && !visited[j] {
+				heap.Push(ready, j)
+			}
+		}
+	}
+
+	return sorted
+}
+
+type intHeap []int
+
+func (h intHeap) Len() int           { return len(h) }
+func (h intHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h intHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *intHeap) Push(x interface{}) {
+	*h = append(*h, x.(int))
+}
+
+func (h *intHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
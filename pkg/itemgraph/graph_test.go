@@ -0,0 +1,136 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package itemgraph
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func dependency(itemResource, itemName, dependsOnResource, dependsOnName string) Dependency {
+	return Dependency{
+		Item:      Item{Resource: itemResource, Namespace: "ns", Name: itemName},
+		DependsOn: Item{Resource: dependsOnResource, Namespace: "ns", Name: dependsOnName},
+	}
+}
+
+func newGraph(dependencies ...Dependency) *Graph {
+	g := NewGraph()
+	for _, d := range dependencies {
+		g.AddItem(d.Item)
+		g.AddItem(d.DependsOn, d)
+	}
+	return g
+}
+
+func TestDependencies(t *testing.T) {
+	g := NewGraph()
+	g.AddItem(Item{Resource: "pods", Namespace: "ns", Name: "pod"},
+		dependency("pods", "pod", "persistentvolumeclaims", "pvc"),
+		dependency("pods", "pod", "persistentvolumeclaims", "not-backed-up"))
+	g.AddItem(Item{Resource: "persistentvolumeclaims", Namespace: "ns", Name: "pvc"})
+	g.AddItem(Item{Resource: "deployments.apps", Namespace: "ns", Name: "deploy"},
+		dependency("deployments.apps", "deploy", "pods", "pod"))
+
+	expected := []Dependency{
+		dependency("deployments.apps", "deploy", "pods", "pod"),
+		dependency("pods", "pod", "persistentvolumeclaims", "pvc"),
+	}
+	assert.Equal(t, expected, g.Dependencies())
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, g.Write(buf))
+
+	read, err := Read(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, expected, read.Dependencies())
+
+	_, err = Read([]byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestOrderResources(t *testing.T) {
+	tests := []struct {
+		name      string
+		graph     *Graph
+		resources []string
+		expected  []string
+	}{
+		{
+			name:      "nil graph keeps the order",
+			resources: []string{"a", "b", "c"},
+			expected:  []string{"a", "b", "c"},
+		},
+		{
+			name:      "resources are ordered after the resources they depend on",
+			graph:     newGraph(dependency("a", "1", "c", "1"), dependency("c", "1", "d", "1")),
+			resources: []string{"a", "b", "c", "d"},
+			expected:  []string{"b", "d", "c", "a"},
+		},
+		{
+			name:      "dependencies within a resource and on other resources are ignored",
+			graph:     newGraph(dependency("a", "1", "a", "2"), dependency("a", "1", "x", "1")),
+			resources: []string{"a", "b"},
+			expected:  []string{"a", "b"},
+		},
+		{
+			name:      "cycle is broken at the first resource",
+			graph:     newGraph(dependency("a", "1", "b", "1"), dependency("b", "1", "a", "1"), dependency("a", "1", "c", "1")),
+			resources: []string{"a", "b", "c"},
+			expected:  []string{"c", "a", "b"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.graph.OrderResources(test.resources))
+		})
+	}
+}
+
+func TestOrderItems(t *testing.T) {
+	g := newGraph(
+		dependency("mysqlclusters.example.io", "cluster-1", "mysqlclusters.example.io", "cluster-3"),
+		dependency("mysqlclusters.example.io", "cluster-2", "mysqlclusters.example.io", "cluster-1"),
+		dependency("mysqlclusters.example.io", "cluster-3", "pods", "pod"),
+	)
+
+	assert.Equal(t, []string{"cluster-3", "cluster-1", "cluster-2"},
+		g.OrderItems("mysqlclusters.example.io", "ns", []string{"cluster-1", "cluster-2", "cluster-3"}))
+	assert.Equal(t, []string{"cluster-1", "cluster-2", "cluster-3"},
+		g.OrderItems("mysqlclusters.example.io", "other-ns", []string{"cluster-1", "cluster-2", "cluster-3"}))
+}
+
+func TestDependents(t *testing.T) {
+	g := newGraph(
+		dependency("pods", "pod-1", "persistentvolumeclaims", "pvc"),
+		dependency("pods", "pod-2", "persistentvolumeclaims", "pvc"),
+		dependency("replicasets.apps", "rs", "pods", "pod-1"),
+	)
+
+	assert.Equal(t, []Item{
+		{Resource: "pods", Namespace: "ns", Name: "pod-1"},
+		{Resource: "pods", Namespace: "ns", Name: "pod-2"},
+	}, g.Dependents(Item{Resource: "persistentvolumeclaims", Namespace: "ns", Name: "pvc"}))
+	assert.Empty(t, g.Dependents(Item{Resource: "pods", Namespace: "ns", Name: "pod-2"}))
+
+	var nilGraph *Graph
+	assert.Empty(t, nilGraph.Dependents(Item{Resource: "pods", Namespace: "ns", Name: "pod-1"}))
+}
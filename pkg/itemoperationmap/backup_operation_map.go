@@ -17,11 +17,12 @@ limitations under the License.
 package itemoperationmap
 
 import (
-	"bytes"
+	"io"
 	"sync"
 
 	"github.com/pkg/errors"
 
+	"github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
@@ -150,10 +151,13 @@ func (m *OperationsForBackup) DeepCopyInto(out *OperationsForBackup) {
 
 func (m *OperationsForBackup) uploadProgress(backupStore persistence.BackupStore, backupName string) error {
 	if len(m.Operations) > 0 {
-		var backupItemOperations *bytes.Buffer
-		backupItemOperations, errs := encode.ToJSONGzip(m.Operations, "backup item operations list")
-		if errs != nil {
-			return errors.Wrap(errs[0], "error encoding item operations json")
+		var backupItemOperations []io.Reader
+		for _, page := range backup.PaginateItemOperations(m.Operations, backup.MetadataPageSize) {
+			encoded, errs := encode.ToJSONGzip(page, "backup item operations list")
+			if errs != nil {
+				return errors.Wrap(errs[0], "error encoding item operations json")
+			}
+			backupItemOperations = append(backupItemOperations, encoded)
 		}
 		err := backupStore.PutBackupItemOperations(backupName, backupItemOperations)
 		if err != nil {
@@ -21,18 +21,27 @@ import (
 )
 
 var (
-	ClusterRoleBindings       = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterrolebindings"}
-	ClusterRoles              = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterroles"}
-	CustomResourceDefinitions = schema.GroupResource{Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"}
-	Jobs                      = schema.GroupResource{Group: "batch", Resource: "jobs"}
-	Namespaces                = schema.GroupResource{Group: "", Resource: "namespaces"}
-	PersistentVolumeClaims    = schema.GroupResource{Group: "", Resource: "persistentvolumeclaims"}
-	PersistentVolumes         = schema.GroupResource{Group: "", Resource: "persistentvolumes"}
-	Pods                      = schema.GroupResource{Group: "", Resource: "pods"}
-	ServiceAccounts           = schema.GroupResource{Group: "", Resource: "serviceaccounts"}
-	Secrets                   = schema.GroupResource{Group: "", Resource: "secrets"}
-	VolumeSnapshotClasses     = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshotclasses"}
-	VolumeSnapshots           = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshots"}
-	VolumeSnapshotContents    = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshotcontents"}
-	PriorityClasses           = schema.GroupResource{Group: "scheduling.k8s.io", Resource: "priorityclasses"}
+	APIServices                     = schema.GroupResource{Group: "apiregistration.k8s.io", Resource: "apiservices"}
+	ClusterRoleBindings             = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterrolebindings"}
+	ClusterRoles                    = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterroles"}
+	CustomResourceDefinitions       = schema.GroupResource{Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"}
+	Deployments                     = schema.GroupResource{Group: "apps", Resource: "deployments"}
+	Endpoints                       = schema.GroupResource{Group: "", Resource: "endpoints"}
+	Jobs                            = schema.GroupResource{Group: "batch", Resource: "jobs"}
+	MutatingWebhookConfigurations   = schema.GroupResource{Group: "admissionregistration.k8s.io", Resource: "mutatingwebhookconfigurations"}
+	Namespaces                      = schema.GroupResource{Group: "", Resource: "namespaces"}
+	PersistentVolumeClaims          = schema.GroupResource{Group: "", Resource: "persistentvolumeclaims"}
+	PersistentVolumes               = schema.GroupResource{Group: "", Resource: "persistentvolumes"}
+	Pods                            = schema.GroupResource{Group: "", Resource: "pods"}
+	ReplicaSets                     = schema.GroupResource{Group: "apps", Resource: "replicasets"}
+	ResourceQuotas                  = schema.GroupResource{Group: "", Resource: "resourcequotas"}
+	ServiceAccounts                 = schema.GroupResource{Group: "", Resource: "serviceaccounts"}
+	Secrets                         = schema.GroupResource{Group: "", Resource: "secrets"}
+	Services                        = schema.GroupResource{Group: "", Resource: "services"}
+	StatefulSets                    = schema.GroupResource{Group: "apps", Resource: "statefulsets"}
+	ValidatingWebhookConfigurations = schema.GroupResource{Group: "admissionregistration.k8s.io", Resource: "validatingwebhookconfigurations"}
+	VolumeSnapshotClasses           = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshotclasses"}
+	VolumeSnapshots                 = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshots"}
+	VolumeSnapshotContents          = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshotcontents"}
+	PriorityClasses                 = schema.GroupResource{Group: "scheduling.k8s.io", Resource: "priorityclasses"}
 )
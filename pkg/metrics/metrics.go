@@ -43,9 +43,11 @@ const (
 	backupDeletionSuccessTotal    = "backup_deletion_success_total"
 	backupDeletionFailureTotal    = "backup_deletion_failure_total"
 	backupLastSuccessfulTimestamp = "backup_last_successful_timestamp"
+	scheduleLastSuccessfulBackup  = "schedule_last_successful_backup_timestamp"
 	backupItemsTotalGauge         = "backup_items_total"
 	backupItemsErrorsGauge        = "backup_items_errors"
 	backupWarningTotal            = "backup_warning_total"
+	backupErrorCategoryTotal      = "backup_error_category_total"
 	backupLastStatus              = "backup_last_status"
 	restoreTotal                  = "restore_total"
 	restoreAttemptTotal           = "restore_attempt_total"
@@ -53,6 +55,7 @@ const (
 	restoreSuccessTotal           = "restore_success_total"
 	restorePartialFailureTotal    = "restore_partial_failure_total"
 	restoreFailedTotal            = "restore_failed_total"
+	restoreErrorCategoryTotal     = "restore_error_category_total"
 	volumeSnapshotAttemptTotal    = "volume_snapshot_attempt_total"
 	volumeSnapshotSuccessTotal    = "volume_snapshot_success_total"
 	volumeSnapshotFailureTotal    = "volume_snapshot_failure_total"
@@ -60,6 +63,10 @@ const (
 	csiSnapshotSuccessTotal       = "csi_snapshot_success_total"
 	csiSnapshotFailureTotal       = "csi_snapshot_failure_total"
 
+	// restore schedule metrics
+	restoreScheduleLastSuccessfulRestore = "restore_schedule_last_successful_restore_timestamp"
+	restoreScheduleLastRestoreStatus     = "restore_schedule_last_restore_status"
+
 	// pod volume metrics
 	podVolumeBackupEnqueueTotal           = "pod_volume_backup_enqueue_count"
 	podVolumeBackupDequeueTotal           = "pod_volume_backup_dequeue_count"
@@ -74,12 +81,26 @@ const (
 	DataDownloadFailureTotal = "data_download_failure_total"
 	DataDownloadCancelTotal  = "data_download_cancel_total"
 
+	// data path metrics
+	dataPathActive                = "data_path_active"
+	dataPathQueued                = "data_path_queued"
+	dataPathRetryTotal            = "data_path_retry_total"
+	dataPathDurationSeconds       = "data_path_duration_seconds"
+	dataUploadThroughputBytes     = "data_upload_throughput_bytes_per_second"
+	dataPathKopiaCacheHitRatio    = "data_path_kopia_cache_hit_ratio"
+	kopiaCacheHitTotalMetricName  = "kopia_cache_hit_total"
+	kopiaCacheMissTotalMetricName = "kopia_cache_miss_total"
+
 	// Labels
 	nodeMetricLabel         = "node"
 	podVolumeOperationLabel = "operation"
 	pvbNameLabel            = "pod_volume_backup"
 	scheduleLabel           = "schedule"
+	restoreScheduleLabel    = "restore_schedule"
 	backupNameLabel         = "backupName"
+	requestorLabel          = "requestor"
+	dataUploadLabel         = "data_upload"
+	errorCategoryLabel      = "category"
 
 	// metrics values
 	BackupLastStatusSucc    int64 = 1
@@ -106,6 +127,14 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			scheduleLastSuccessfulBackup: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      scheduleLastSuccessfulBackup,
+					Help:      "Last time a backup of the schedule completed successfully, Unix timestamp in seconds",
+				},
+				[]string{scheduleLabel},
+			),
 			backupTotal: prometheus.NewGauge(
 				prometheus.GaugeOpts{
 					Namespace: metricNamespace,
@@ -220,6 +249,14 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			backupErrorCategoryTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      backupErrorCategoryTotal,
+					Help:      "Total number of errors of backups by category",
+				},
+				[]string{scheduleLabel, errorCategoryLabel},
+			),
 			backupLastStatus: prometheus.NewGaugeVec(
 				prometheus.GaugeOpts{
 					Namespace: metricNamespace,
@@ -228,6 +265,22 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			restoreScheduleLastSuccessfulRestore: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      restoreScheduleLastSuccessfulRestore,
+					Help:      "Last time a restore of the restore schedule completed successfully, Unix timestamp in seconds",
+				},
+				[]string{restoreScheduleLabel},
+			),
+			restoreScheduleLastRestoreStatus: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      restoreScheduleLastRestoreStatus,
+					Help:      "Last status of the restore of the restore schedule. A value of 1 is success, 0 is failure",
+				},
+				[]string{restoreScheduleLabel},
+			),
 			restoreTotal: prometheus.NewGauge(
 				prometheus.GaugeOpts{
 					Namespace: metricNamespace,
@@ -267,6 +320,14 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			restoreErrorCategoryTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      restoreErrorCategoryTotal,
+					Help:      "Total number of errors of restores by category",
+				},
+				[]string{scheduleLabel, errorCategoryLabel},
+			),
 			restoreValidationFailedTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
 					Namespace: metricNamespace,
@@ -421,6 +482,65 @@ func NewNodeMetrics() *ServerMetrics {
 				},
 				[]string{nodeMetricLabel},
 			),
+			dataPathActive: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: podVolumeMetricsNamespace,
+					Name:      dataPathActive,
+					Help:      "Number of running data paths",
+				},
+				[]string{nodeMetricLabel, requestorLabel},
+			),
+			dataPathQueued: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: podVolumeMetricsNamespace,
+					Name:      dataPathQueued,
+					Help:      "Number of data paths waiting for the concurrency limit",
+				},
+				[]string{nodeMetricLabel, requestorLabel},
+			),
+			dataPathRetryTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: podVolumeMetricsNamespace,
+					Name:      dataPathRetryTotal,
+					Help:      "Total number of data paths retried because the concurrency limit was exceeded",
+				},
+				[]string{nodeMetricLabel, requestorLabel},
+			),
+			dataPathDurationSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: podVolumeMetricsNamespace,
+					Name:      dataPathDurationSeconds,
+					Help:      "Time taken by the data paths, in seconds",
+					Buckets: []float64{
+						toSeconds(1 * time.Minute),
+						toSeconds(5 * time.Minute),
+						toSeconds(10 * time.Minute),
+						toSeconds(15 * time.Minute),
+						toSeconds(30 * time.Minute),
+						toSeconds(1 * time.Hour),
+						toSeconds(2 * time.Hour),
+						toSeconds(3 * time.Hour),
+						toSeconds(4 * time.Hour),
+					},
+				},
+				[]string{nodeMetricLabel, requestorLabel},
+			),
+			dataUploadThroughputBytes: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: podVolumeMetricsNamespace,
+					Name:      dataUploadThroughputBytes,
+					Help:      "Average throughput, in bytes per second, of the running data uploads",
+				},
+				[]string{nodeMetricLabel, dataUploadLabel},
+			),
+			dataPathKopiaCacheHitRatio: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: podVolumeMetricsNamespace,
+					Name:      dataPathKopiaCacheHitRatio,
+					Help:      "Ratio of the kopia cache hits in the kopia cache lookups of the data paths",
+				},
+				[]string{nodeMetricLabel},
+			),
 		},
 	}
 }
@@ -510,6 +630,9 @@ func (m *ServerMetrics) RemoveSchedule(scheduleName string) {
 	if g, ok := m.metrics[backupTarballSizeBytesGauge].(*prometheus.GaugeVec); ok {
 		g.DeleteLabelValues(scheduleName)
 	}
+	if g, ok := m.metrics[scheduleLastSuccessfulBackup].(*prometheus.GaugeVec); ok {
+		g.DeleteLabelValues(scheduleName)
+	}
 	if c, ok := m.metrics[backupAttemptTotal].(*prometheus.CounterVec); ok {
 		c.DeleteLabelValues(scheduleName)
 	}
@@ -549,6 +672,9 @@ func (m *ServerMetrics) RemoveSchedule(scheduleName string) {
 	if c, ok := m.metrics[backupWarningTotal].(*prometheus.CounterVec); ok {
 		c.DeleteLabelValues(scheduleName)
 	}
+	if c, ok := m.metrics[backupErrorCategoryTotal].(*prometheus.CounterVec); ok {
+		c.DeletePartialMatch(prometheus.Labels{scheduleLabel: scheduleName})
+	}
 	if c, ok := m.metrics[backupLastStatus].(*prometheus.GaugeVec); ok {
 		c.DeleteLabelValues(scheduleName)
 	}
@@ -567,6 +693,9 @@ func (m *ServerMetrics) RemoveSchedule(scheduleName string) {
 	if c, ok := m.metrics[restoreValidationFailedTotal].(*prometheus.CounterVec); ok {
 		c.DeleteLabelValues(scheduleName)
 	}
+	if c, ok := m.metrics[restoreErrorCategoryTotal].(*prometheus.CounterVec); ok {
+		c.DeletePartialMatch(prometheus.Labels{scheduleLabel: scheduleName})
+	}
 	if c, ok := m.metrics[volumeSnapshotSuccessTotal].(*prometheus.CounterVec); ok {
 		c.DeleteLabelValues(scheduleName)
 	}
@@ -671,6 +800,78 @@ func (m *ServerMetrics) RegisterDataDownloadCancel(node string) {
 	}
 }
 
+// SetDataPathActive records the number of running data paths of the requestor.
+func (m *ServerMetrics) SetDataPathActive(node, requestor string, num int) {
+	if g, ok := m.metrics[dataPathActive].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(node, requestor).Set(float64(num))
+	}
+}
+
+// SetDataPathQueued records the number of data paths of the requestor waiting for the concurrency limit.
+func (m *ServerMetrics) SetDataPathQueued(node, requestor string, num int) {
+	if g, ok := m.metrics[dataPathQueued].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(node, requestor).Set(float64(num))
+	}
+}
+
+// RegisterDataPathRetry records a data path retried because the concurrency limit was exceeded.
+func (m *ServerMetrics) RegisterDataPathRetry(node, requestor string) {
+	if c, ok := m.metrics[dataPathRetryTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(node, requestor).Inc()
+	}
+}
+
+// ObserveDataPathDuration records the number of seconds a data path took.
+func (m *ServerMetrics) ObserveDataPathDuration(node, requestor string, seconds float64) {
+	if h, ok := m.metrics[dataPathDurationSeconds].(*prometheus.HistogramVec); ok {
+		h.WithLabelValues(node, requestor).Observe(seconds)
+	}
+}
+
+// SetDataUploadThroughput records the average throughput, in bytes per second, of a running data upload.
+func (m *ServerMetrics) SetDataUploadThroughput(node, dataUpload string, bytesPerSecond float64) {
+	if g, ok := m.metrics[dataUploadThroughputBytes].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(node, dataUpload).Set(bytesPerSecond)
+	}
+}
+
+// RemoveDataUploadThroughput removes the throughput of a data upload which is not running anymore.
+func (m *ServerMetrics) RemoveDataUploadThroughput(node, dataUpload string) {
+	if g, ok := m.metrics[dataUploadThroughputBytes].(*prometheus.GaugeVec); ok {
+		g.DeleteLabelValues(node, dataUpload)
+	}
+}
+
+// UpdateKopiaCacheHitRatio records the ratio of the kopia cache hits from the cache counters which kopia
+// registers in the gatherer. Nothing is recorded until the kopia caches are looked up.
+func (m *ServerMetrics) UpdateKopiaCacheHitRatio(node string, gatherer prometheus.Gatherer) error {
+	families, err := gatherer.Gather()
+	if err != nil {
+		return err
+	}
+
+	var hits, misses float64
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			switch family.GetName() {
+			case kopiaCacheHitTotalMetricName:
+				hits += metric.GetCounter().GetValue()
+			case kopiaCacheMissTotalMetricName:
+				misses += metric.GetCounter().GetValue()
+			}
+		}
+	}
+
+	if hits+misses == 0 {
+		return nil
+	}
+
+	if g, ok := m.metrics[dataPathKopiaCacheHitRatio].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(node).Set(hits / (hits + misses))
+	}
+	return nil
+}
+
 // ObservePodVolumeOpLatency records the number of seconds a pod volume operation took.
 func (m *ServerMetrics) ObservePodVolumeOpLatency(node, pvbName, opName, backupName string, seconds float64) {
 	if h, ok := m.metrics[podVolumeOperationLatencySeconds].(*prometheus.HistogramVec); ok {
@@ -699,6 +900,38 @@ func (m *ServerMetrics) SetBackupLastSuccessfulTimestamp(backupSchedule string,
 	}
 }
 
+// SetScheduleLastSuccessfulBackupTimestamp records the last time a backup of the schedule completed successfully,
+// Unix timestamp in seconds
+func (m *ServerMetrics) SetScheduleLastSuccessfulBackupTimestamp(scheduleName string, completion time.Time) {
+	if g, ok := m.metrics[scheduleLastSuccessfulBackup].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(scheduleName).Set(float64(completion.Unix()))
+	}
+}
+
+// SetRestoreScheduleLastSuccessfulRestoreTimestamp records the completion time of the last successful restore of the restore schedule.
+func (m *ServerMetrics) SetRestoreScheduleLastSuccessfulRestoreTimestamp(restoreScheduleName string, time time.Time) {
+	if g, ok := m.metrics[restoreScheduleLastSuccessfulRestore].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(restoreScheduleName).Set(float64(time.Unix()))
+	}
+}
+
+// SetRestoreScheduleLastRestoreStatus records the status of the last finished restore of the restore schedule.
+func (m *ServerMetrics) SetRestoreScheduleLastRestoreStatus(restoreScheduleName string, lastStatus int64) {
+	if g, ok := m.metrics[restoreScheduleLastRestoreStatus].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(restoreScheduleName).Set(float64(lastStatus))
+	}
+}
+
+// RemoveRestoreSchedule removes metrics associated with a specified restore schedule.
+func (m *ServerMetrics) RemoveRestoreSchedule(restoreScheduleName string) {
+	if g, ok := m.metrics[restoreScheduleLastSuccessfulRestore].(*prometheus.GaugeVec); ok {
+		g.DeleteLabelValues(restoreScheduleName)
+	}
+	if g, ok := m.metrics[restoreScheduleLastRestoreStatus].(*prometheus.GaugeVec); ok {
+		g.DeleteLabelValues(restoreScheduleName)
+	}
+}
+
 // SetBackupTotal records the current number of existent backups.
 func (m *ServerMetrics) SetBackupTotal(numberOfBackups int64) {
 	if g, ok := m.metrics[backupTotal].(prometheus.Gauge); ok {
@@ -719,6 +952,9 @@ func (m *ServerMetrics) RegisterBackupSuccess(backupSchedule string) {
 		c.WithLabelValues(backupSchedule).Inc()
 	}
 	m.SetBackupLastSuccessfulTimestamp(backupSchedule, time.Now())
+	if backupSchedule != "" {
+		m.SetScheduleLastSuccessfulBackupTimestamp(backupSchedule, time.Now())
+	}
 }
 
 // RegisterBackupPartialFailure records a partially failed backup.
@@ -792,6 +1028,15 @@ func (m *ServerMetrics) RegisterBackupWarning(backupSchedule string) {
 	}
 }
 
+// RegisterBackupErrorCategories records the errors of a backup by category.
+func (m *ServerMetrics) RegisterBackupErrorCategories(backupSchedule string, categories map[string]int) {
+	if c, ok := m.metrics[backupErrorCategoryTotal].(*prometheus.CounterVec); ok {
+		for category, count := range categories {
+			c.WithLabelValues(backupSchedule, category).Add(float64(count))
+		}
+	}
+}
+
 // RegisterBackupLastStatus records the last status of the backup.
 func (m *ServerMetrics) RegisterBackupLastStatus(backupSchedule string, lastStatus int64) {
 	if g, ok := m.metrics[backupLastStatus].(*prometheus.GaugeVec); ok {
@@ -840,6 +1085,15 @@ func (m *ServerMetrics) RegisterRestoreFailed(backupSchedule string) {
 	}
 }
 
+// RegisterRestoreErrorCategories records the errors of a restore by category.
+func (m *ServerMetrics) RegisterRestoreErrorCategories(backupSchedule string, categories map[string]int) {
+	if c, ok := m.metrics[restoreErrorCategoryTotal].(*prometheus.CounterVec); ok {
+		for category, count := range categories {
+			c.WithLabelValues(backupSchedule, category).Add(float64(count))
+		}
+	}
+}
+
 // RegisterRestoreValidationFailed records a restore that failed validation.
 func (m *ServerMetrics) RegisterRestoreValidationFailed(backupSchedule string) {
 	if c, ok := m.metrics[restoreValidationFailedTotal].(*prometheus.CounterVec); ok {
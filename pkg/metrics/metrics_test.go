@@ -0,0 +1,78 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduleLastSuccessfulBackupTimestamp(t *testing.T) {
+	m := NewServerMetrics()
+	gauge := m.metrics[scheduleLastSuccessfulBackup].(*prometheus.GaugeVec)
+
+	completion := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	m.SetScheduleLastSuccessfulBackupTimestamp("schedule-1", completion)
+	assert.Equal(t, float64(completion.Unix()), testutil.ToFloat64(gauge.WithLabelValues("schedule-1")))
+
+	// the ad-hoc backups don't belong to any schedule
+	m.RegisterBackupSuccess("")
+	assert.Equal(t, 1, testutil.CollectAndCount(gauge))
+
+	m.RegisterBackupSuccess("schedule-1")
+	assert.Greater(t, testutil.ToFloat64(gauge.WithLabelValues("schedule-1")), float64(completion.Unix()))
+
+	m.RemoveSchedule("schedule-1")
+	assert.Equal(t, 0, testutil.CollectAndCount(gauge))
+}
+
+func TestRestoreScheduleMetrics(t *testing.T) {
+	m := NewServerMetrics()
+	lastSuccessful := m.metrics[restoreScheduleLastSuccessfulRestore].(*prometheus.GaugeVec)
+	lastStatus := m.metrics[restoreScheduleLastRestoreStatus].(*prometheus.GaugeVec)
+
+	completion := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	m.SetRestoreScheduleLastSuccessfulRestoreTimestamp("restore-schedule-1", completion)
+	m.SetRestoreScheduleLastRestoreStatus("restore-schedule-1", BackupLastStatusFailure)
+	assert.Equal(t, float64(completion.Unix()), testutil.ToFloat64(lastSuccessful.WithLabelValues("restore-schedule-1")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(lastStatus.WithLabelValues("restore-schedule-1")))
+
+	m.RemoveRestoreSchedule("restore-schedule-1")
+	assert.Equal(t, 0, testutil.CollectAndCount(lastSuccessful))
+	assert.Equal(t, 0, testutil.CollectAndCount(lastStatus))
+}
+
+func TestErrorCategoryMetrics(t *testing.T) {
+	m := NewServerMetrics()
+	backupErrors := m.metrics[backupErrorCategoryTotal].(*prometheus.CounterVec)
+	restoreErrors := m.metrics[restoreErrorCategoryTotal].(*prometheus.CounterVec)
+
+	m.RegisterBackupErrorCategories("schedule-1", map[string]int{"timeout": 2, "plugin": 1})
+	m.RegisterBackupErrorCategories("schedule-1", map[string]int{"timeout": 1})
+	m.RegisterRestoreErrorCategories("schedule-1", map[string]int{"apiConflict": 3})
+	assert.Equal(t, float64(3), testutil.ToFloat64(backupErrors.WithLabelValues("schedule-1", "timeout")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(backupErrors.WithLabelValues("schedule-1", "plugin")))
+	assert.Equal(t, float64(3), testutil.ToFloat64(restoreErrors.WithLabelValues("schedule-1", "apiConflict")))
+
+	m.RemoveSchedule("schedule-1")
+	assert.Equal(t, 0, testutil.CollectAndCount(backupErrors))
+	assert.Equal(t, 0, testutil.CollectAndCount(restoreErrors))
+}
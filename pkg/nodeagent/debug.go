@@ -0,0 +1,90 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeagent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// DebugPProfPath is the path of the pprof profiles on the HTTP server of the node-agent
+	DebugPProfPath = "/debug/pprof/"
+
+	// DebugInfoPath is the path of the debug info on the HTTP server of the node-agent
+	DebugInfoPath = "/debug/info"
+
+	// DefaultHTTPPort is the default port of the HTTP server of the node-agent, which serves the metrics and the
+	// debug endpoints
+	DefaultHTTPPort = 8085
+)
+
+// DebugInfo is the debug info of a node-agent, i.e., the node-agent configs and the effective concurrency of the
+// data path on its node
+type DebugInfo struct {
+	// NodeName is the name of the node where the node-agent runs
+	NodeName string `json:"nodeName"`
+
+	// Configs is the content of the node-agent ConfigMap, it is empty if the ConfigMap doesn't exist
+	Configs *Configs `json:"configs,omitempty"`
+
+	// ConfigsError is the error of getting the node-agent ConfigMap
+	ConfigsError string `json:"configsError,omitempty"`
+
+	// ConcurrentNum is the effective concurrent number of the data path instances on the node
+	ConcurrentNum int `json:"concurrentNum"`
+
+	// PerNamespaceConcurrentNum is the effective concurrent numbers of the data path instances per namespace
+	PerNamespaceConcurrentNum map[string]int `json:"perNamespaceConcurrentNum,omitempty"`
+
+	// TaskTypeConcurrentNum is the effective concurrent numbers of the backup and restore data path instances
+	TaskTypeConcurrentNum map[string]int `json:"taskTypeConcurrentNum,omitempty"`
+
+	// ThrottledConcurrentNum is the throttled concurrent number of the data path instances, 0 means not throttled
+	ThrottledConcurrentNum int `json:"throttledConcurrentNum,omitempty"`
+
+	// RunningJobs is the names of the running data path instances
+	RunningJobs []string `json:"runningJobs,omitempty"`
+}
+
+// GetPods returns the pods of the node agent daemonset
+func GetPods(ctx context.Context, kubeClient kubernetes.Interface, namespace string) ([]v1.Pod, error) {
+	pods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: fmt.Sprintf("name=%s", daemonSet)})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list daemonset pods")
+	}
+
+	return pods.Items, nil
+}
+
+// GetHTTPPort returns the port of the HTTP server of the node-agent pod by its container port named "metrics"
+func GetHTTPPort(pod *v1.Pod) int {
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			if port.Name == "metrics" {
+				return int(port.ContainerPort)
+			}
+		}
+	}
+
+	return DefaultHTTPPort
+}
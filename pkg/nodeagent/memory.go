@@ -0,0 +1,149 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeagent
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+const (
+	cgroupV2MemoryCurrent = "/sys/fs/cgroup/memory.current"
+	cgroupV2MemoryMax     = "/sys/fs/cgroup/memory.max"
+	cgroupV2MemoryStat    = "/sys/fs/cgroup/memory.stat"
+
+	cgroupV1MemoryUsage = "/sys/fs/cgroup/memory/memory.usage_in_bytes"
+	cgroupV1MemoryLimit = "/sys/fs/cgroup/memory/memory.limit_in_bytes"
+	cgroupV1MemoryStat  = "/sys/fs/cgroup/memory/memory.stat"
+
+	// cgroup v1 reports an unlimited memory as the max int64 value rounded down to the page size,
+	// any limit beyond this is treated as unlimited
+	cgroupV1UnlimitedMemory = int64(1) << 62
+)
+
+// MemoryUsage is the memory usage of the node-agent container read from its memory cgroup
+type MemoryUsage struct {
+	// WorkingSet is the memory usage excluding the inactive file cache, which is what the
+	// kubelet compares against the memory limit to evict or OOMKill the container
+	WorkingSet int64
+
+	// Limit is the memory limit of the container, 0 means unlimited
+	Limit int64
+}
+
+// Percent returns the percentage of the working set in the memory limit, it returns 0 if the memory is unlimited
+func (m *MemoryUsage) Percent() int {
+	if m.Limit <= 0 {
+		return 0
+	}
+
+	return int(m.WorkingSet * 100 / m.Limit)
+}
+
+// GetMemoryUsage reads the memory usage of the current container from the cgroup v2 or v1 memory controller
+func GetMemoryUsage(fs filesystem.Interface) (*MemoryUsage, error) {
+	if fileExists(fs, cgroupV2MemoryCurrent) {
+		return readMemoryUsage(fs, cgroupV2MemoryCurrent, cgroupV2MemoryMax, cgroupV2MemoryStat, "inactive_file")
+	}
+
+	if fileExists(fs, cgroupV1MemoryUsage) {
+		return readMemoryUsage(fs, cgroupV1MemoryUsage, cgroupV1MemoryLimit, cgroupV1MemoryStat, "total_inactive_file")
+	}
+
+	return nil, errors.New("memory cgroup is not found")
+}
+
+func readMemoryUsage(fs filesystem.Interface, usageFile, limitFile, statFile, inactiveFileKey string) (*MemoryUsage, error) {
+	usage, err := readMemoryValue(fs, usageFile)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := readMemoryValue(fs, limitFile)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= cgroupV1UnlimitedMemory {
+		limit = 0
+	}
+
+	inactiveFile, err := readMemoryStat(fs, statFile, inactiveFileKey)
+	if err != nil {
+		return nil, err
+	}
+
+	workingSet := usage - inactiveFile
+	if workingSet < 0 {
+		workingSet = 0
+	}
+
+	return &MemoryUsage{WorkingSet: workingSet, Limit: limit}, nil
+}
+
+// readMemoryValue reads the value of a memory cgroup file, "max" means unlimited and is returned as 0
+func readMemoryValue(fs filesystem.Interface, file string) (int64, error) {
+	data, err := fs.ReadFile(file)
+	if err != nil {
+		return 0, errors.Wrapf(err, "error reading %s", file)
+	}
+
+	value := strings.TrimSpace(string(data))
+	if value == "max" {
+		return 0, nil
+	}
+
+	num, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "error parsing %s", file)
+	}
+
+	return num, nil
+}
+
+func readMemoryStat(fs filesystem.Interface, file, key string) (int64, error) {
+	data, err := fs.ReadFile(file)
+	if err != nil {
+		return 0, errors.Wrapf(err, "error reading %s", file)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || fields[0] != key {
+			continue
+		}
+
+		num, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "error parsing %s in %s", key, file)
+		}
+		return num, nil
+	}
+
+	return 0, nil
+}
+
+func fileExists(fs filesystem.Interface, file string) bool {
+	_, err := fs.Stat(file)
+	return err == nil
+}
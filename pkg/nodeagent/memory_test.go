@@ -0,0 +1,95 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeagent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetMemoryUsage(t *testing.T) {
+	tests := []struct {
+		name          string
+		fs            *velerotest.FakeFileSystem
+		expectUsage   *MemoryUsage
+		expectPercent int
+		expectErr     string
+	}{
+		{
+			name:      "memory cgroup is not found",
+			fs:        velerotest.NewFakeFileSystem(),
+			expectErr: "memory cgroup is not found",
+		},
+		{
+			name: "cgroup v2",
+			fs: velerotest.NewFakeFileSystem().
+				WithFile(cgroupV2MemoryCurrent, []byte("1000\n")).
+				WithFile(cgroupV2MemoryMax, []byte("2000\n")).
+				WithFile(cgroupV2MemoryStat, []byte("anon 600\nfile 400\ninactive_file 200\nactive_file 200\n")),
+			expectUsage:   &MemoryUsage{WorkingSet: 800, Limit: 2000},
+			expectPercent: 40,
+		},
+		{
+			name: "cgroup v2 without limit",
+			fs: velerotest.NewFakeFileSystem().
+				WithFile(cgroupV2MemoryCurrent, []byte("1000\n")).
+				WithFile(cgroupV2MemoryMax, []byte("max\n")).
+				WithFile(cgroupV2MemoryStat, []byte("inactive_file 200\n")),
+			expectUsage: &MemoryUsage{WorkingSet: 800},
+		},
+		{
+			name: "cgroup v1",
+			fs: velerotest.NewFakeFileSystem().
+				WithFile(cgroupV1MemoryUsage, []byte("1000\n")).
+				WithFile(cgroupV1MemoryLimit, []byte("1000\n")).
+				WithFile(cgroupV1MemoryStat, []byte("inactive_file 100\ntotal_inactive_file 100\n")),
+			expectUsage:   &MemoryUsage{WorkingSet: 900, Limit: 1000},
+			expectPercent: 90,
+		},
+		{
+			name: "cgroup v1 without limit",
+			fs: velerotest.NewFakeFileSystem().
+				WithFile(cgroupV1MemoryUsage, []byte("1000\n")).
+				WithFile(cgroupV1MemoryLimit, []byte("9223372036854771712\n")).
+				WithFile(cgroupV1MemoryStat, []byte("total_inactive_file 100\n")),
+			expectUsage: &MemoryUsage{WorkingSet: 900},
+		},
+		{
+			name: "invalid value",
+			fs: velerotest.NewFakeFileSystem().
+				WithFile(cgroupV2MemoryCurrent, []byte("invalid\n")),
+			expectErr: "error parsing /sys/fs/cgroup/memory.current: strconv.ParseInt: parsing \"invalid\": invalid syntax",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			usage, err := GetMemoryUsage(test.fs)
+			if test.expectErr != "" {
+				assert.EqualError(t, err, test.expectErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectUsage, usage)
+			assert.Equal(t, test.expectPercent, usage.Percent())
+		})
+	}
+}
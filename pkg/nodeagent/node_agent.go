@@ -20,12 +20,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -37,6 +39,33 @@ const (
 	daemonSet             = "node-agent"
 	configName            = "node-agent-configs"
 	dataPathConConfigName = "data-path-concurrency"
+
+	// DefaultKubeletRootDir is the default root directory of the kubelet on the nodes
+	DefaultKubeletRootDir = "/var/lib/kubelet"
+
+	// DefaultHostPodsPath is the default path in the node-agent where the pods directory of the kubelet is mounted
+	DefaultHostPodsPath = "/host_pods"
+
+	// DefaultHostPluginsPath is the default path in the node-agent where the plugins directory of the kubelet is
+	// mounted, it's the same as the path on the host so that the symlinks of the block volumes can be resolved
+	DefaultHostPluginsPath = DefaultKubeletRootDir + "/plugins"
+
+	// DefaultWindowsKubeletRootDir is the default root directory of the kubelet on the Windows nodes, the node-agent
+	// runs as a HostProcess container on the Windows nodes, so the directory is accessed from the host directly
+	DefaultWindowsKubeletRootDir = `C:\var\lib\kubelet`
+
+	// LivenessPath and ReadinessPath are the paths of the health endpoints on the HTTP server of the node-agent
+	LivenessPath  = "/healthz"
+	ReadinessPath = "/readyz"
+
+	// NodeOSLinux and NodeOSWindows are the OS of the nodes as indicated by the well-known label "kubernetes.io/os"
+	NodeOSLinux   = "linux"
+	NodeOSWindows = "windows"
+)
+
+var (
+	hostPodsPath    = DefaultHostPodsPath
+	hostPluginsPath = DefaultHostPluginsPath
 )
 
 var (
@@ -49,6 +78,18 @@ type DataPathConcurrency struct {
 
 	// PerNodeConfig specifies the concurrency number to nodes matched by rules
 	PerNodeConfig []RuledConfigs `json:"perNodeConfig,omitempty"`
+
+	// PerNamespaceConfig specifies the concurrency number to data path requests from namespaces matched by rules
+	PerNamespaceConfig []RuledNamespaceConfigs `json:"perNamespaceConfig,omitempty"`
+
+	// BackupConcurrency specifies the concurrency number of the backup data paths, i.e., the pod volume backups, data
+	// uploads and data verifications, on each node. Once it or RestoreConcurrency is specified, the backup and restore
+	// data paths are limited separately, and the global or per-node number is used for the one not specified
+	BackupConcurrency int `json:"backupConcurrency,omitempty"`
+
+	// RestoreConcurrency specifies the concurrency number of the restore data paths, i.e., the pod volume restores and
+	// data downloads, on each node
+	RestoreConcurrency int `json:"restoreConcurrency,omitempty"`
 }
 
 type RuledConfigs struct {
@@ -59,9 +100,169 @@ type RuledConfigs struct {
 	Number int `json:"number"`
 }
 
+type RuledNamespaceConfigs struct {
+	// Namespaces specifies the source namespaces of the data path requests to match
+	Namespaces []string `json:"namespaces"`
+
+	// Number specifies the number value associated to the matched namespaces
+	Number int `json:"number"`
+}
+
+type DataPathThrottling struct {
+	// GlobalConfig specifies the bandwidth limits to all nodes for which per-node config is not specified
+	GlobalConfig Bandwidth `json:"globalConfig,omitempty"`
+
+	// PerNodeConfig specifies the bandwidth limits to nodes matched by rules
+	PerNodeConfig []RuledBandwidth `json:"perNodeConfig,omitempty"`
+}
+
+type Bandwidth struct {
+	// UploadMBps specifies the maximum upload bandwidth in MiB per second, 0 means unlimited
+	UploadMBps int `json:"uploadMBps,omitempty"`
+
+	// DownloadMBps specifies the maximum download bandwidth in MiB per second, 0 means unlimited
+	DownloadMBps int `json:"downloadMBps,omitempty"`
+}
+
+type RuledBandwidth struct {
+	// NodeSelector specifies the label selector to match nodes
+	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+
+	// Bandwidth specifies the bandwidth limits associated to the matched nodes
+	Bandwidth
+}
+
+type MemoryWatermark struct {
+	// HighPercent specifies the percentage of the memory limit of the node-agent, once the memory usage crosses it,
+	// the concurrency of the data path is reduced to ThrottledConcurrency
+	HighPercent int `json:"highPercent"`
+
+	// LowPercent specifies the percentage of the memory limit of the node-agent, once the memory usage drops below it,
+	// the configured concurrency of the data path is resumed. It is HighPercent - 10 if not specified
+	LowPercent int `json:"lowPercent,omitempty"`
+
+	// ThrottledConcurrency specifies the concurrency number of the data path while the memory usage is above the
+	// watermark. It is 1 if not specified
+	ThrottledConcurrency int `json:"throttledConcurrency,omitempty"`
+}
+
+type BackupPodConfig struct {
+	// NodeSelector specifies the labels of the nodes the data mover backup pods could be scheduled to
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations specifies the taints of the nodes tolerated by the data mover backup pods
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+
+	// Affinity specifies the affinity and anti-affinity of the data mover backup pods, e.g., a node affinity with the
+	// NotIn operator keeps the pods off the GPU or spot nodes
+	Affinity *v1.Affinity `json:"affinity,omitempty"`
+}
+
+type KopiaCache struct {
+	// CacheDir specifies the root directory of the kopia content and metadata caches, e.g., a directory on an NVMe disk
+	// mounted to the node-agent, each repository has its own cache under it. The default directory under the home
+	// directory of the node-agent is used if not specified
+	CacheDir string `json:"cacheDir,omitempty"`
+
+	// ContentCacheLimitMB specifies the size limit in MiB of the kopia content cache
+	ContentCacheLimitMB int64 `json:"contentCacheLimitMB,omitempty"`
+
+	// MetadataCacheLimitMB specifies the size limit in MiB of the kopia metadata cache
+	MetadataCacheLimitMB int64 `json:"metadataCacheLimitMB,omitempty"`
+
+	// MinEvictionAge specifies the minimum age of the cached items before they can be evicted once the caches exceed
+	// the size limits, e.g., "10m". The kopia default is used if not specified
+	MinEvictionAge *metav1.Duration `json:"minEvictionAge,omitempty"`
+}
+
+type RuledKopiaCache struct {
+	// NodeSelector specifies the label selector to match nodes
+	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+
+	// KopiaCache specifies the kopia cache config associated to the matched nodes
+	KopiaCache
+}
+
+type KopiaCacheConfigs struct {
+	// GlobalConfig specifies the kopia cache config to all nodes for which per-node config is not specified
+	GlobalConfig KopiaCache `json:"globalConfig,omitempty"`
+
+	// PerNodeConfig specifies the kopia cache config to nodes matched by rules, the first matched rule is used
+	PerNodeConfig []RuledKopiaCache `json:"perNodeConfig,omitempty"`
+}
+
+// ResourceTimeouts is the timeouts of the node-agent waiting for the resources per operation type, which override the
+// timeouts set by the flags of the node-agent
+type ResourceTimeouts struct {
+	// VolumeSnapshotReady specifies how long to wait for the VolumeSnapshot of a data upload to be ready, e.g., "30m"
+	VolumeSnapshotReady *metav1.Duration `json:"volumeSnapshotReady,omitempty"`
+
+	// DataMoverExpose specifies how long to wait for a data upload or data download to be exposed, e.g., "1h"
+	DataMoverExpose *metav1.Duration `json:"dataMoverExpose,omitempty"`
+}
+
 type Configs struct {
 	// DataPathConcurrency is the config for data path concurrency per node.
 	DataPathConcurrency *DataPathConcurrency `json:"dataPathConcurrency,omitempty"`
+
+	// DataPathThrottling is the config for data path bandwidth limits per node.
+	DataPathThrottling *DataPathThrottling `json:"dataPathThrottling,omitempty"`
+
+	// MemoryWatermark is the config for throttling the data path concurrency when the node-agent is under memory pressure.
+	MemoryWatermark *MemoryWatermark `json:"memoryWatermark,omitempty"`
+
+	// BackupPodConfig is the config for scheduling the data mover backup pods.
+	BackupPodConfig *BackupPodConfig `json:"backupPodConfig,omitempty"`
+
+	// KopiaCache is the config for the kopia content and metadata caches of the data path per node.
+	KopiaCache *KopiaCacheConfigs `json:"kopiaCache,omitempty"`
+
+	// ResourceTimeouts is the config for the timeouts of waiting for the resources per operation type.
+	ResourceTimeouts *ResourceTimeouts `json:"resourceTimeouts,omitempty"`
+}
+
+// SetHostPaths sets the paths in the node-agent where the pods and plugins directories of the kubelet are mounted,
+// e.g., for the distros whose kubelet root directory isn't the default one
+func SetHostPaths(podsPath string, pluginsPath string) {
+	hostPodsPath = filepath.Clean(podsPath)
+	hostPluginsPath = filepath.Clean(pluginsPath)
+}
+
+// DefaultHostPaths returns the default paths in the node-agent where the pods and plugins directories of the kubelet
+// are mounted for the node OS
+func DefaultHostPaths(nodeOS string) (string, string) {
+	if nodeOS == NodeOSWindows {
+		return DefaultWindowsKubeletRootDir + `\pods`, DefaultWindowsKubeletRootDir + `\plugins`
+	}
+	return DefaultHostPodsPath, DefaultHostPluginsPath
+}
+
+// GetNodeOS returns the OS of the node by the label "kubernetes.io/os", the nodes without the label are regarded as
+// Linux nodes
+func GetNodeOS(node *v1.Node) string {
+	if nodeOS, ok := node.Labels[v1.LabelOSStable]; ok && nodeOS != "" {
+		return nodeOS
+	}
+	return NodeOSLinux
+}
+
+// GetNodeOSByName returns the OS of the node with the specified name
+func GetNodeOSByName(ctx context.Context, nodeName string, crClient ctrlclient.Client) (string, error) {
+	node := &v1.Node{}
+	if err := crClient.Get(ctx, ctrlclient.ObjectKey{Name: nodeName}, node); err != nil {
+		return "", errors.Wrapf(err, "error getting node %s", nodeName)
+	}
+	return GetNodeOS(node), nil
+}
+
+// HostPodsPath returns the path in the node-agent where the pods directory of the kubelet is mounted
+func HostPodsPath() string {
+	return hostPodsPath
+}
+
+// HostPluginsPath returns the path in the node-agent where the plugins directory of the kubelet is mounted
+func HostPluginsPath() string {
+	return hostPluginsPath
 }
 
 // IsRunning checks if the node agent daemonset is running properly. If not, return the error found
@@ -105,6 +306,26 @@ func IsRunningInNode(ctx context.Context, namespace string, nodeName string, crC
 	return errors.Errorf("daemonset pod not found in running state in node %s", nodeName)
 }
 
+// GetRunningNodes returns the sorted names of the nodes in which the node agent pod is running properly
+func GetRunningNodes(ctx context.Context, namespace string, crClient ctrlclient.Client) ([]string, error) {
+	pods := new(v1.PodList)
+	err := crClient.List(ctx, pods, ctrlclient.InNamespace(namespace), ctrlclient.MatchingLabels{"name": daemonSet})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list daemonset pods")
+	}
+
+	nodes := sets.NewString()
+	for i := range pods.Items {
+		if kube.IsPodRunning(&pods.Items[i]) != nil {
+			continue
+		}
+
+		nodes.Insert(pods.Items[i].Spec.NodeName)
+	}
+
+	return nodes.List(), nil
+}
+
 func GetPodSpec(ctx context.Context, kubeClient kubernetes.Interface, namespace string) (*v1.PodSpec, error) {
 	ds, err := kubeClient.AppsV1().DaemonSets(namespace).Get(ctx, daemonSet, metav1.GetOptions{})
 	if err != nil {
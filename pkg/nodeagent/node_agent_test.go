@@ -22,6 +22,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -183,6 +184,57 @@ func TestIsRunningInNode(t *testing.T) {
 	}
 }
 
+func TestGetRunningNodes(t *testing.T) {
+	scheme := runtime.NewScheme()
+	corev1.AddToScheme(scheme)
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(
+		builder.ForPod("velero", "fake-pod").NodeName("node-1").Phase(corev1.PodRunning).Result(),
+		builder.ForPod("velero", "node-agent-1").Labels(map[string]string{"name": "node-agent"}).NodeName("node-3").Phase(corev1.PodRunning).Result(),
+		builder.ForPod("velero", "node-agent-2").Labels(map[string]string{"name": "node-agent"}).NodeName("node-2").Phase(corev1.PodRunning).Result(),
+		builder.ForPod("velero", "node-agent-3").Labels(map[string]string{"name": "node-agent"}).NodeName("node-4").Phase(corev1.PodPending).Result(),
+		builder.ForPod("other-ns", "node-agent-4").Labels(map[string]string{"name": "node-agent"}).NodeName("node-5").Phase(corev1.PodRunning).Result(),
+	).Build()
+
+	nodes, err := GetRunningNodes(context.TODO(), "velero", fakeClient)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"node-2", "node-3"}, nodes)
+}
+
+func TestGetNodeOSByName(t *testing.T) {
+	scheme := runtime.NewScheme()
+	corev1.AddToScheme(scheme)
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(
+		builder.ForNode("linux-node").Labels(map[string]string{corev1.LabelOSStable: "linux"}).Result(),
+		builder.ForNode("windows-node").Labels(map[string]string{corev1.LabelOSStable: "windows"}).Result(),
+		builder.ForNode("unlabeled-node").Result(),
+	).Build()
+
+	nodeOS, err := GetNodeOSByName(context.TODO(), "linux-node", fakeClient)
+	require.NoError(t, err)
+	assert.Equal(t, NodeOSLinux, nodeOS)
+
+	nodeOS, err = GetNodeOSByName(context.TODO(), "windows-node", fakeClient)
+	require.NoError(t, err)
+	assert.Equal(t, NodeOSWindows, nodeOS)
+
+	nodeOS, err = GetNodeOSByName(context.TODO(), "unlabeled-node", fakeClient)
+	require.NoError(t, err)
+	assert.Equal(t, NodeOSLinux, nodeOS)
+
+	_, err = GetNodeOSByName(context.TODO(), "fake-node", fakeClient)
+	assert.Error(t, err)
+
+	podsPath, pluginsPath := DefaultHostPaths(NodeOSWindows)
+	assert.Equal(t, `C:\var\lib\kubelet\pods`, podsPath)
+	assert.Equal(t, `C:\var\lib\kubelet\plugins`, pluginsPath)
+
+	podsPath, pluginsPath = DefaultHostPaths(NodeOSLinux)
+	assert.Equal(t, DefaultHostPodsPath, podsPath)
+	assert.Equal(t, DefaultHostPluginsPath, pluginsPath)
+}
+
 func TestGetPodSpec(t *testing.T) {
 	podSpec := corev1.PodSpec{
 		NodeName: "fake-node",
@@ -294,7 +346,7 @@ func TestGetConfigs(t *testing.T) {
 			kubeClientObj: []runtime.Object{
 				cmWithoutCocurrentData,
 			},
-			expectResult: &Configs{nil},
+			expectResult: &Configs{},
 		},
 		{
 			name:      "success",
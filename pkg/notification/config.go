@@ -0,0 +1,153 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// Format is the payload format of the events sent to a webhook.
+type Format string
+
+const (
+	// FormatJSON sends the event as a plain JSON object.
+	FormatJSON Format = "json"
+
+	// FormatSlack sends the summary of the event as a Slack compatible message.
+	FormatSlack Format = "slack"
+
+	// FormatCloudEvents sends the event as a CloudEvent in the structured content mode.
+	FormatCloudEvents Format = "cloudevents"
+)
+
+// Webhook is the configuration of a webhook the events are sent to.
+type Webhook struct {
+	// URL is the URL the events are POSTed to.
+	URL string `json:"url,omitempty"`
+
+	// URLSecret is the reference to the secret key in the Velero namespace holding the
+	// URL, it is used instead of URL when the URL contains a token.
+	URLSecret *corev1api.SecretKeySelector `json:"urlSecret,omitempty"`
+
+	// Format is the payload format, json is used by default.
+	Format Format `json:"format,omitempty"`
+
+	// Events are the types of the events sent to the webhook, all events are sent if
+	// it's empty.
+	Events []EventType `json:"events,omitempty"`
+}
+
+// accepts returns true if the event type is sent to the webhook.
+func (w *Webhook) accepts(eventType EventType) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+
+	for _, e := range w.Events {
+		if e == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (w *Webhook) validate() error {
+	if (w.URL == "") == (w.URLSecret == nil) {
+		return errors.New("exactly one of url and urlSecret is required")
+	}
+
+	if w.URLSecret != nil && (w.URLSecret.Name == "" || w.URLSecret.Key == "") {
+		return errors.New("both name and key are required for urlSecret")
+	}
+
+	switch w.Format {
+	case "", FormatJSON, FormatSlack, FormatCloudEvents:
+	default:
+		return errors.Errorf("invalid format %q, it accepts only %s, %s, %s", w.Format, FormatJSON, FormatSlack, FormatCloudEvents)
+	}
+
+	for _, e := range w.Events {
+		if !isValidEventType(e) {
+			return errors.Errorf("invalid event type %q", e)
+		}
+	}
+
+	return nil
+}
+
+// namedWebhook is a webhook with the key of its entry in the configmap.
+type namedWebhook struct {
+	name string
+	Webhook
+}
+
+// getWebhooks returns the webhooks in the notification configmap, the configmap has one
+// entry per webhook. Nil is returned if the configmap doesn't exist.
+func getWebhooks(ctx context.Context, cli client.Client, namespace, configMapName string) ([]namedWebhook, error) {
+	configMap := &corev1api.ConfigMap{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: configMapName}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "error getting notification configmap %s/%s", namespace, configMapName)
+	}
+
+	webhooks := []namedWebhook{}
+	for name, data := range configMap.Data {
+		webhook := namedWebhook{name: name}
+		if err := yaml.UnmarshalStrict([]byte(data), &webhook.Webhook); err != nil {
+			return nil, errors.Wrapf(err, "error parsing webhook %s in notification configmap %s/%s", name, namespace, configMapName)
+		}
+
+		if err := webhook.validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid webhook %s in notification configmap %s/%s", name, namespace, configMapName)
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	sort.Slice(webhooks, func(i, j int) bool { return webhooks[i].name < webhooks[j].name })
+
+	return webhooks, nil
+}
+
+// getWebhookURL returns the URL of the webhook, reading it from the secret if it's configured.
+func getWebhookURL(ctx context.Context, cli client.Client, namespace string, webhook *namedWebhook) (string, error) {
+	if webhook.URLSecret == nil {
+		return webhook.URL, nil
+	}
+
+	secret := &corev1api.Secret{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: webhook.URLSecret.Name}, secret); err != nil {
+		return "", errors.Wrapf(err, "error getting URL secret %s/%s of webhook %s", namespace, webhook.URLSecret.Name, webhook.name)
+	}
+
+	url, ok := secret.Data[webhook.URLSecret.Key]
+	if !ok {
+		return "", errors.Errorf("key %s is not found in URL secret %s/%s of webhook %s", webhook.URLSecret.Key, namespace, webhook.URLSecret.Name, webhook.name)
+	}
+
+	return string(url), nil
+}
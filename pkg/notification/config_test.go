@@ -0,0 +1,130 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func notificationConfigMap(data map[string]string) *corev1api.ConfigMap {
+	return &corev1api.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "notifications",
+		},
+		Data: data,
+	}
+}
+
+func TestGetWebhooks(t *testing.T) {
+	tests := []struct {
+		name        string
+		objects     []runtime.Object
+		expected    []namedWebhook
+		expectedErr string
+	}{
+		{
+			name: "configmap doesn't exist",
+		},
+		{
+			name: "webhooks are sorted by name",
+			objects: []runtime.Object{notificationConfigMap(map[string]string{
+				"slack":  "urlSecret:\n  name: slack-webhook\n  key: url\nformat: slack\nevents:\n- BackupFailed\n",
+				"alerts": "url: http://alerts.example.com\n",
+			})},
+			expected: []namedWebhook{
+				{
+					name:    "alerts",
+					Webhook: Webhook{URL: "http://alerts.example.com"},
+				},
+				{
+					name: "slack",
+					Webhook: Webhook{
+						URLSecret: builder.ForSecretKeySelector("slack-webhook", "url").Result(),
+						Format:    FormatSlack,
+						Events:    []EventType{EventBackupFailed},
+					},
+				},
+			},
+		},
+		{
+			name:        "unknown field",
+			objects:     []runtime.Object{notificationConfigMap(map[string]string{"webhook": "uri: http://example.com\n"})},
+			expectedErr: "error parsing webhook webhook in notification configmap velero/notifications",
+		},
+		{
+			name:        "neither url nor url secret",
+			objects:     []runtime.Object{notificationConfigMap(map[string]string{"webhook": "format: json\n"})},
+			expectedErr: "invalid webhook webhook in notification configmap velero/notifications: exactly one of url and urlSecret is required",
+		},
+		{
+			name:        "both url and url secret",
+			objects:     []runtime.Object{notificationConfigMap(map[string]string{"webhook": "url: http://example.com\nurlSecret:\n  name: webhook\n  key: url\n"})},
+			expectedErr: "invalid webhook webhook in notification configmap velero/notifications: exactly one of url and urlSecret is required",
+		},
+		{
+			name:        "url secret without key",
+			objects:     []runtime.Object{notificationConfigMap(map[string]string{"webhook": "urlSecret:\n  name: webhook\n"})},
+			expectedErr: "invalid webhook webhook in notification configmap velero/notifications: both name and key are required for urlSecret",
+		},
+		{
+			name:        "invalid format",
+			objects:     []runtime.Object{notificationConfigMap(map[string]string{"webhook": "url: http://example.com\nformat: xml\n"})},
+			expectedErr: "invalid webhook webhook in notification configmap velero/notifications: invalid format \"xml\", it accepts only json, slack, cloudevents",
+		},
+		{
+			name:        "invalid event type",
+			objects:     []runtime.Object{notificationConfigMap(map[string]string{"webhook": "url: http://example.com\nevents:\n- BackupDeleted\n"})},
+			expectedErr: "invalid webhook webhook in notification configmap velero/notifications: invalid event type \"BackupDeleted\"",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cli := velerotest.NewFakeControllerRuntimeClient(t, test.objects...)
+
+			webhooks, err := getWebhooks(context.Background(), cli, "velero", "notifications")
+			if test.expectedErr != "" {
+				assert.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, webhooks)
+		})
+	}
+}
+
+func TestWebhookAccepts(t *testing.T) {
+	all := &Webhook{URL: "http://example.com"}
+	assert.True(t, all.accepts(EventBackupStarted))
+	assert.True(t, all.accepts(EventRepoMaintenanceFailed))
+
+	failures := &Webhook{URL: "http://example.com", Events: []EventType{EventBackupFailed, EventRestoreFailed}}
+	assert.True(t, failures.accepts(EventRestoreFailed))
+	assert.False(t, failures.accepts(EventBackupCompleted))
+}
@@ -0,0 +1,224 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultRequestTimeout = 10 * time.Second
+	defaultRetryInterval  = 5 * time.Second
+	defaultMaxAttempts    = 3
+
+	cloudEventsSpecVersion = "1.0"
+	cloudEventsContentType = "application/cloudevents+json"
+)
+
+// cloudEventTypes are the reverse-DNS types of the events sent in the CloudEvents format.
+var cloudEventTypes = map[EventType]string{
+	EventBackupStarted:          "io.velero.backup.started",
+	EventBackupCompleted:        "io.velero.backup.completed",
+	EventBackupPartiallyFailed:  "io.velero.backup.partiallyfailed",
+	EventBackupFailed:           "io.velero.backup.failed",
+	EventRestoreCompleted:       "io.velero.restore.completed",
+	EventRestorePartiallyFailed: "io.velero.restore.partiallyfailed",
+	EventRestoreFailed:          "io.velero.restore.failed",
+	EventRepoMaintenanceFailed:  "io.velero.backuprepository.maintenancefailed",
+}
+
+// Dispatcher sends the notification events to the webhooks configured in the notification
+// configmap. The configmap is read for every event, so the changes to the webhooks take
+// effect without restarting the server.
+type Dispatcher struct {
+	client        client.Client
+	namespace     string
+	configMapName string
+	httpClient    *http.Client
+	maxAttempts   int
+	retryInterval time.Duration
+	log           logrus.FieldLogger
+}
+
+// NewDispatcher creates a dispatcher reading the webhooks from the configmap in the namespace.
+// Nil is returned if the configmap name is empty, the notification is disabled in that case.
+func NewDispatcher(cli client.Client, namespace, configMapName string, log logrus.FieldLogger) *Dispatcher {
+	if configMapName == "" {
+		return nil
+	}
+
+	return &Dispatcher{
+		client:        cli,
+		namespace:     namespace,
+		configMapName: configMapName,
+		httpClient:    &http.Client{Timeout: defaultRequestTimeout},
+		maxAttempts:   defaultMaxAttempts,
+		retryInterval: defaultRetryInterval,
+		log:           log.WithField("notification-configmap", configMapName),
+	}
+}
+
+// Notify sends the event to the webhooks asynchronously, the delivery failures are logged
+// without affecting the caller. It's a no-op for a nil dispatcher.
+func (d *Dispatcher) Notify(event Event) {
+	if d == nil {
+		return
+	}
+
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+
+	go d.dispatch(context.Background(), event)
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context, event Event) {
+	log := d.log.WithFields(logrus.Fields{
+		"event": event.Type,
+		"kind":  event.Kind,
+		"name":  event.Namespace + "/" + event.Name,
+	})
+
+	webhooks, err := getWebhooks(ctx, d.client, d.namespace, d.configMapName)
+	if err != nil {
+		log.WithError(err).Error("Error getting notification webhooks")
+		return
+	}
+
+	for i := range webhooks {
+		webhook := &webhooks[i]
+		if !webhook.accepts(event.Type) {
+			continue
+		}
+
+		if err := d.send(ctx, webhook, &event); err != nil {
+			log.WithError(err).WithField("webhook", webhook.name).Error("Error sending notification")
+			continue
+		}
+
+		log.WithField("webhook", webhook.name).Debug("Notification is sent")
+	}
+}
+
+func (d *Dispatcher) send(ctx context.Context, webhook *namedWebhook, event *Event) error {
+	url, err := getWebhookURL(ctx, d.client, d.namespace, webhook)
+	if err != nil {
+		return err
+	}
+
+	body, contentType, err := encode(webhook.Format, d.namespace, event)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 1; ; attempt++ {
+		err = d.post(ctx, url, contentType, body)
+		if err == nil || attempt >= d.maxAttempts {
+			return err
+		}
+
+		d.log.WithError(err).WithField("webhook", webhook.name).Warnf("Error sending notification on attempt %d, retrying", attempt)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(d.retryInterval):
+		}
+	}
+}
+
+func (d *Dispatcher) post(ctx context.Context, url, contentType string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "error creating request")
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "error posting event")
+	}
+	defer resp.Body.Close()
+
+	// drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("webhook returned status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// slackMessage is the payload of a Slack compatible incoming webhook.
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// cloudEvent is a CloudEvent in the structured content mode.
+type cloudEvent struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            *Event    `json:"data"`
+}
+
+// encode returns the payload and the content type of the event in the format.
+func encode(format Format, namespace string, event *Event) ([]byte, string, error) {
+	var payload interface{}
+	contentType := "application/json"
+
+	switch format {
+	case FormatSlack:
+		payload = &slackMessage{Text: event.Summary()}
+	case FormatCloudEvents:
+		payload = &cloudEvent{
+			SpecVersion:     cloudEventsSpecVersion,
+			ID:              uuid.New().String(),
+			Source:          "/velero/" + namespace,
+			Type:            cloudEventTypes[event.Type],
+			Subject:         event.Namespace + "/" + event.Name,
+			Time:            event.Time,
+			DataContentType: "application/json",
+			Data:            event,
+		}
+		contentType = cloudEventsContentType
+	default:
+		payload = event
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "error marshaling event")
+	}
+
+	return body, contentType, nil
+}
@@ -0,0 +1,216 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type request struct {
+	path        string
+	contentType string
+	body        map[string]interface{}
+}
+
+// webhookServer records the requests it receives, the first failures requests are
+// responded with an internal server error.
+type webhookServer struct {
+	*httptest.Server
+	lock     sync.Mutex
+	failures int
+	requests []request
+}
+
+func newWebhookServer(t *testing.T, failures int) *webhookServer {
+	t.Helper()
+
+	s := &webhookServer{failures: failures}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		if s.failures > 0 {
+			s.failures--
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		body := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(data, &body))
+
+		s.requests = append(s.requests, request{path: r.URL.Path, contentType: r.Header.Get("Content-Type"), body: body})
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func newTestDispatcher(t *testing.T, objects ...runtime.Object) *Dispatcher {
+	t.Helper()
+
+	d := NewDispatcher(velerotest.NewFakeControllerRuntimeClient(t, objects...), "velero", "notifications", velerotest.NewLogger())
+	d.retryInterval = time.Millisecond
+
+	return d
+}
+
+func TestNewDispatcher(t *testing.T) {
+	assert.Nil(t, NewDispatcher(nil, "velero", "", velerotest.NewLogger()))
+
+	// a nil dispatcher is a no-op
+	var d *Dispatcher
+	d.Notify(Event{Type: EventBackupStarted})
+}
+
+func TestDispatch(t *testing.T) {
+	server := newWebhookServer(t, 0)
+
+	secret := builder.ForSecret("velero", "slack-webhook").Data(map[string][]byte{"url": []byte(server.URL + "/slack")}).Result()
+	configMap := notificationConfigMap(map[string]string{
+		"json":        "url: " + server.URL + "/json\n",
+		"slack":       "urlSecret:\n  name: slack-webhook\n  key: url\nformat: slack\nevents:\n- BackupFailed\n- BackupPartiallyFailed\n",
+		"cloudevents": "url: " + server.URL + "/cloudevents\nformat: cloudevents\nevents:\n- BackupFailed\n",
+	})
+
+	backup := builder.ForBackup("velero", "backup-1").
+		ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "daily")).
+		Phase(velerov1api.BackupPhaseFailed).Result()
+	backup.Status.FailureReason = "error getting backup storage location"
+
+	event := ForBackup(EventBackupFailed, backup)
+	event.Time = time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	d := newTestDispatcher(t, configMap, secret)
+	d.dispatch(context.Background(), event)
+
+	require.Len(t, server.requests, 3)
+
+	cloudEvent := server.requests[0]
+	assert.Equal(t, "/cloudevents", cloudEvent.path)
+	assert.Equal(t, "application/cloudevents+json", cloudEvent.contentType)
+	assert.Equal(t, "1.0", cloudEvent.body["specversion"])
+	assert.Equal(t, "io.velero.backup.failed", cloudEvent.body["type"])
+	assert.Equal(t, "/velero/velero", cloudEvent.body["source"])
+	assert.Equal(t, "velero/backup-1", cloudEvent.body["subject"])
+	assert.Equal(t, "2023-10-01T00:00:00Z", cloudEvent.body["time"])
+	assert.NotEmpty(t, cloudEvent.body["id"])
+	assert.Equal(t, "BackupFailed", cloudEvent.body["data"].(map[string]interface{})["type"])
+
+	plain := server.requests[1]
+	assert.Equal(t, "/json", plain.path)
+	assert.Equal(t, "application/json", plain.contentType)
+	assert.Equal(t, map[string]interface{}{
+		"type":      "BackupFailed",
+		"time":      "2023-10-01T00:00:00Z",
+		"kind":      "Backup",
+		"namespace": "velero",
+		"name":      "backup-1",
+		"schedule":  "daily",
+		"phase":     "Failed",
+		"message":   "error getting backup storage location",
+	}, plain.body)
+
+	slack := server.requests[2]
+	assert.Equal(t, "/slack", slack.path)
+	assert.Equal(t, map[string]interface{}{
+		"text": "Velero Backup velero/backup-1 failed: error getting backup storage location",
+	}, slack.body)
+
+	// only the webhook without event filter receives the other events
+	d.dispatch(context.Background(), ForBackup(EventBackupCompleted, backup))
+	require.Len(t, server.requests, 4)
+	assert.Equal(t, "/json", server.requests[3].path)
+}
+
+func TestDispatchRetry(t *testing.T) {
+	tests := []struct {
+		name             string
+		failures         int
+		expectedRequests int
+	}{
+		{
+			name:             "succeeded after retry",
+			failures:         2,
+			expectedRequests: 1,
+		},
+		{
+			name:             "failed after max attempts",
+			failures:         3,
+			expectedRequests: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newWebhookServer(t, test.failures)
+
+			repo := &velerov1api.BackupRepository{ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "repo-1"}}
+
+			d := newTestDispatcher(t, notificationConfigMap(map[string]string{"webhook": "url: " + server.URL + "\n"}))
+			d.dispatch(context.Background(), ForBackupRepository(EventRepoMaintenanceFailed, repo, "error pruning repository"))
+
+			assert.Len(t, server.requests, test.expectedRequests)
+		})
+	}
+}
+
+func TestEventSummary(t *testing.T) {
+	restore := builder.ForRestore("velero", "restore-1").Phase(velerov1api.RestorePhasePartiallyFailed).Result()
+	restore.Status.Errors = 2
+	restore.Status.Warnings = 1
+	event := ForRestore(EventRestorePartiallyFailed, restore)
+	assert.Equal(t, "Velero Restore velero/restore-1 partially failed with 2 errors and 1 warnings", event.Summary())
+
+	backup := builder.ForBackup("velero", "backup-1").Result()
+	backup.Status.ValidationErrors = []string{"invalid included namespace"}
+	event = ForBackup(EventBackupFailed, backup)
+	assert.Equal(t, "Velero Backup velero/backup-1 failed: validation errors: [invalid included namespace]", event.Summary())
+}
+
+func TestGetWebhookURLFromSecret(t *testing.T) {
+	webhook := &namedWebhook{name: "webhook", Webhook: Webhook{URLSecret: &corev1api.SecretKeySelector{
+		LocalObjectReference: corev1api.LocalObjectReference{Name: "webhook"},
+		Key:                  "url",
+	}}}
+
+	_, err := getWebhookURL(context.Background(), velerotest.NewFakeControllerRuntimeClient(t), "velero", webhook)
+	assert.ErrorContains(t, err, "error getting URL secret velero/webhook of webhook webhook")
+
+	secret := &corev1api.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "webhook"}}
+	_, err = getWebhookURL(context.Background(), velerotest.NewFakeControllerRuntimeClient(t, secret), "velero", webhook)
+	assert.EqualError(t, err, "key url is not found in URL secret velero/webhook of webhook webhook")
+}
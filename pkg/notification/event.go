@@ -0,0 +1,183 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"fmt"
+	"time"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// EventType is the type of a notification event.
+type EventType string
+
+const (
+	EventBackupStarted          EventType = "BackupStarted"
+	EventBackupCompleted        EventType = "BackupCompleted"
+	EventBackupPartiallyFailed  EventType = "BackupPartiallyFailed"
+	EventBackupFailed           EventType = "BackupFailed"
+	EventRestoreCompleted       EventType = "RestoreCompleted"
+	EventRestorePartiallyFailed EventType = "RestorePartiallyFailed"
+	EventRestoreFailed          EventType = "RestoreFailed"
+	EventRepoMaintenanceFailed  EventType = "RepositoryMaintenanceFailed"
+)
+
+var eventTypes = []EventType{
+	EventBackupStarted,
+	EventBackupCompleted,
+	EventBackupPartiallyFailed,
+	EventBackupFailed,
+	EventRestoreCompleted,
+	EventRestorePartiallyFailed,
+	EventRestoreFailed,
+	EventRepoMaintenanceFailed,
+}
+
+func isValidEventType(eventType EventType) bool {
+	for _, e := range eventTypes {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is a notification of a change in the lifecycle of a backup, a restore or a backup repository.
+type Event struct {
+	Type      EventType `json:"type"`
+	Time      time.Time `json:"time"`
+	Kind      string    `json:"kind"`
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	Schedule  string    `json:"schedule,omitempty"`
+	Phase     string    `json:"phase,omitempty"`
+	Message   string    `json:"message,omitempty"`
+	Errors    int       `json:"errors,omitempty"`
+	Warnings  int       `json:"warnings,omitempty"`
+}
+
+// Summary returns a human readable description of the event.
+func (e *Event) Summary() string {
+	summary := fmt.Sprintf("Velero %s %s/%s", e.Kind, e.Namespace, e.Name)
+	switch e.Type {
+	case EventBackupStarted:
+		summary += " started"
+	case EventBackupCompleted, EventRestoreCompleted:
+		summary += " completed"
+	case EventBackupPartiallyFailed, EventRestorePartiallyFailed:
+		summary += " partially failed"
+	case EventBackupFailed, EventRestoreFailed:
+		summary += " failed"
+	case EventRepoMaintenanceFailed:
+		summary += " maintenance failed"
+	}
+
+	if e.Errors > 0 || e.Warnings > 0 {
+		summary += fmt.Sprintf(" with %d errors and %d warnings", e.Errors, e.Warnings)
+	}
+
+	if e.Message != "" {
+		summary += ": " + e.Message
+	}
+
+	return summary
+}
+
+// BackupEventType returns the event type of the terminal backup phase, false is returned if the
+// phase isn't terminal.
+func BackupEventType(phase velerov1api.BackupPhase) (EventType, bool) {
+	switch phase {
+	case velerov1api.BackupPhaseCompleted:
+		return EventBackupCompleted, true
+	case velerov1api.BackupPhasePartiallyFailed:
+		return EventBackupPartiallyFailed, true
+	case velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseFailedValidation:
+		return EventBackupFailed, true
+	}
+
+	return "", false
+}
+
+// RestoreEventType returns the event type of the terminal restore phase, false is returned if the
+// phase isn't terminal.
+func RestoreEventType(phase velerov1api.RestorePhase) (EventType, bool) {
+	switch phase {
+	case velerov1api.RestorePhaseCompleted:
+		return EventRestoreCompleted, true
+	case velerov1api.RestorePhasePartiallyFailed:
+		return EventRestorePartiallyFailed, true
+	case velerov1api.RestorePhaseFailed, velerov1api.RestorePhaseFailedValidation:
+		return EventRestoreFailed, true
+	}
+
+	return "", false
+}
+
+// ForBackup returns the event of the backup.
+func ForBackup(eventType EventType, backup *velerov1api.Backup) Event {
+	event := Event{
+		Type:      eventType,
+		Kind:      "Backup",
+		Namespace: backup.Namespace,
+		Name:      backup.Name,
+		Schedule:  backup.Labels[velerov1api.ScheduleNameLabel],
+		Phase:     string(backup.Status.Phase),
+		Message:   backup.Status.FailureReason,
+		Errors:    backup.Status.Errors,
+		Warnings:  backup.Status.Warnings,
+	}
+
+	if len(backup.Status.ValidationErrors) > 0 {
+		event.Message = fmt.Sprintf("validation errors: %v", backup.Status.ValidationErrors)
+	}
+
+	return event
+}
+
+// ForRestore returns the event of the restore.
+func ForRestore(eventType EventType, restore *velerov1api.Restore) Event {
+	event := Event{
+		Type:      eventType,
+		Kind:      "Restore",
+		Namespace: restore.Namespace,
+		Name:      restore.Name,
+		Schedule:  restore.Spec.ScheduleName,
+		Phase:     string(restore.Status.Phase),
+		Message:   restore.Status.FailureReason,
+		Errors:    restore.Status.Errors,
+		Warnings:  restore.Status.Warnings,
+	}
+
+	if len(restore.Status.ValidationErrors) > 0 {
+		event.Message = fmt.Sprintf("validation errors: %v", restore.Status.ValidationErrors)
+	}
+
+	return event
+}
+
+// ForBackupRepository returns the event of the backup repository.
+func ForBackupRepository(eventType EventType, repo *velerov1api.BackupRepository, message string) Event {
+	return Event{
+		Type:      eventType,
+		Kind:      "BackupRepository",
+		Namespace: repo.Namespace,
+		Name:      repo.Name,
+		Phase:     string(repo.Status.Phase),
+		Message:   message,
+	}
+}
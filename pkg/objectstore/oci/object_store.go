@@ -0,0 +1,300 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package oci implements the object store of Oracle Cloud Infrastructure Object Storage on top
+// of its Amazon S3 Compatibility API.
+package oci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+)
+
+const (
+	regionKey          = "region"
+	namespaceKey       = "namespace"
+	s3URLKey           = "s3Url"
+	profileKey         = "profile"
+	credentialsFileKey = "credentialsFile"
+	uploadPartSizeKey  = "uploadPartSize"
+	caCertKey          = "caCert"
+
+	// defaultUploadPartSize is the size of the parts of the multipart uploads, the objects
+	// smaller than it are uploaded in a single request.
+	defaultUploadPartSize = 64 * 1024 * 1024
+)
+
+// s3API is the subset of the S3 client used by the object store.
+type s3API interface {
+	manager.UploadAPIClient
+	s3.ListObjectsV2APIClient
+	s3.HeadObjectAPIClient
+	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+	DeleteObject(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+	DeleteObjects(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error)
+}
+
+// presigner creates the pre-authenticated URLs of the objects.
+type presigner interface {
+	PresignGetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error)
+}
+
+// ObjectStore is the object store of Oracle Cloud Infrastructure Object Storage. The buckets
+// are accessed through the Amazon S3 Compatibility API of the namespace with a customer
+// secret key, which is read from an AWS style credentials file.
+type ObjectStore struct {
+	log       logrus.FieldLogger
+	client    s3API
+	presigner presigner
+	partSize  int64
+}
+
+// NewObjectStore returns a new OCI object store.
+func NewObjectStore(log logrus.FieldLogger) *ObjectStore {
+	return &ObjectStore{log: log}
+}
+
+func (o *ObjectStore) Init(config map[string]string) error {
+	if err := framework.ValidateObjectStoreConfigKeys(config,
+		regionKey,
+		namespaceKey,
+		s3URLKey,
+		profileKey,
+		credentialsFileKey,
+		uploadPartSizeKey,
+	); err != nil {
+		return err
+	}
+
+	region := config[regionKey]
+	if region == "" {
+		return pkgerrors.Errorf("missing %s in object store configuration", regionKey)
+	}
+
+	endpoint, err := getEndpoint(config)
+	if err != nil {
+		return err
+	}
+
+	partSize := int64(defaultUploadPartSize)
+	if value := config[uploadPartSizeKey]; value != "" {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return pkgerrors.Wrapf(err, "invalid %s %s", uploadPartSizeKey, value)
+		}
+		if quantity.Value() < manager.MinUploadPartSize {
+			return pkgerrors.Errorf("%s %s is smaller than the minimum part size %d", uploadPartSizeKey, value, manager.MinUploadPartSize)
+		}
+		partSize = quantity.Value()
+	}
+
+	opts := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(region)}
+	if credentialsFile := config[credentialsFileKey]; credentialsFile != "" {
+		opts = append(opts, awsconfig.WithSharedCredentialsFiles([]string{credentialsFile}))
+	}
+	if profile := config[profileKey]; profile != "" {
+		opts = append(opts, awsconfig.WithSharedConfigProfile(profile))
+	}
+	if caCert := config[caCertKey]; caCert != "" {
+		opts = append(opts, awsconfig.WithCustomCABundle(strings.NewReader(caCert)))
+	}
+
+	cfg, err := awsconfig.LoadDefaultConfig(context.Background(), opts...)
+	if err != nil {
+		return pkgerrors.Wrap(err, "error loading OCI credentials")
+	}
+
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.BaseEndpoint = aws.String(endpoint)
+		// the S3 Compatibility API only supports the path style addressing
+		o.UsePathStyle = true
+	})
+
+	o.client = client
+	o.presigner = s3.NewPresignClient(client)
+	o.partSize = partSize
+
+	return nil
+}
+
+// getEndpoint returns the endpoint of the S3 Compatibility API, which is derived from the
+// namespace and the region if it isn't specified.
+func getEndpoint(config map[string]string) (string, error) {
+	if s3URL := config[s3URLKey]; s3URL != "" {
+		return strings.TrimSuffix(s3URL, "/"), nil
+	}
+
+	namespace := config[namespaceKey]
+	if namespace == "" {
+		return "", pkgerrors.Errorf("one of %s and %s must be specified in object store configuration", namespaceKey, s3URLKey)
+	}
+
+	return S3CompatibilityEndpoint(namespace, config[regionKey]), nil
+}
+
+// S3CompatibilityEndpoint returns the endpoint of the Amazon S3 Compatibility API of the
+// Object Storage namespace in the region.
+func S3CompatibilityEndpoint(namespace, region string) string {
+	return fmt.Sprintf("https://%s.compat.objectstorage.%s.oraclecloud.com", namespace, region)
+}
+
+// PutObject uploads the object, the objects larger than the upload part size are uploaded
+// with multipart uploads.
+func (o *ObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	uploader := manager.NewUploader(o.client, func(u *manager.Uploader) {
+		u.PartSize = o.partSize
+	})
+
+	_, err := uploader.Upload(context.Background(), &s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   body,
+	})
+	return pkgerrors.Wrapf(err, "error putting object %s", key)
+}
+
+func (o *ObjectStore) ObjectExists(bucket, key string) (bool, error) {
+	_, err := o.client.HeadObject(context.Background(), &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err == nil {
+		return true, nil
+	}
+
+	var notFound *types.NotFound
+	if errors.As(err, &notFound) {
+		return false, nil
+	}
+
+	return false, pkgerrors.Wrapf(err, "error checking if object %s exists", key)
+}
+
+func (o *ObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	output, err := o.client.GetObject(context.Background(), &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "error getting object %s", key)
+	}
+
+	return output.Body, nil
+}
+
+func (o *ObjectStore) ListCommonPrefixes(bucket, prefix, delimiter string) ([]string, error) {
+	var prefixes []string
+	paginator := s3.NewListObjectsV2Paginator(o.client, &s3.ListObjectsV2Input{
+		Bucket:    aws.String(bucket),
+		Prefix:    aws.String(prefix),
+		Delimiter: aws.String(delimiter),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "error listing common prefixes of %s", prefix)
+		}
+		for _, commonPrefix := range page.CommonPrefixes {
+			prefixes = append(prefixes, aws.ToString(commonPrefix.Prefix))
+		}
+	}
+
+	return prefixes, nil
+}
+
+func (o *ObjectStore) ListObjects(bucket, prefix string) ([]string, error) {
+	var keys []string
+	paginator := s3.NewListObjectsV2Paginator(o.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "error listing objects of %s", prefix)
+		}
+		for _, object := range page.Contents {
+			keys = append(keys, aws.ToString(object.Key))
+		}
+	}
+
+	return keys, nil
+}
+
+func (o *ObjectStore) DeleteObject(bucket, key string) error {
+	_, err := o.client.DeleteObject(context.Background(), &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	return pkgerrors.Wrapf(err, "error deleting object %s", key)
+}
+
+// DeleteObjects deletes the objects with the multi-object delete of the S3 Compatibility API,
+// the objects failed to delete are reported in the error.
+func (o *ObjectStore) DeleteObjects(bucket string, keys []string) error {
+	objects := make([]types.ObjectIdentifier, 0, len(keys))
+	for _, key := range keys {
+		objects = append(objects, types.ObjectIdentifier{Key: aws.String(key)})
+	}
+
+	output, err := o.client.DeleteObjects(context.Background(), &s3.DeleteObjectsInput{
+		Bucket: aws.String(bucket),
+		Delete: &types.Delete{Objects: objects, Quiet: true},
+	})
+	if err != nil {
+		return pkgerrors.Wrapf(err, "error deleting %d objects", len(keys))
+	}
+
+	if len(output.Errors) > 0 {
+		var failed []string
+		for _, e := range output.Errors {
+			failed = append(failed, fmt.Sprintf("%s: %s", aws.ToString(e.Key), aws.ToString(e.Message)))
+		}
+		return pkgerrors.Errorf("error deleting %d of %d objects: %s", len(output.Errors), len(keys), strings.Join(failed, "; "))
+	}
+
+	return nil
+}
+
+// CreateSignedURL creates a pre-authenticated URL to download the object, which expires
+// after the ttl.
+func (o *ObjectStore) CreateSignedURL(bucket, key string, ttl time.Duration) (string, error) {
+	req, err := o.presigner.PresignGetObject(context.Background(), &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}, s3.WithPresignExpires(ttl))
+	if err != nil {
+		return "", pkgerrors.Wrapf(err, "error creating signed URL of object %s", key)
+	}
+
+	return req.URL, nil
+}
@@ -0,0 +1,298 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// fakeS3 is an in-memory S3 Compatibility API of a single bucket.
+type fakeS3 struct {
+	objects map[string][]byte
+	uploads map[string]map[int32][]byte
+	// multipartUploads is the number of the completed multipart uploads
+	multipartUploads int
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{
+		objects: make(map[string][]byte),
+		uploads: make(map[string]map[int32][]byte),
+	}
+}
+
+func (f *fakeS3) PutObject(_ context.Context, params *s3.PutObjectInput, _ ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+	data, err := io.ReadAll(params.Body)
+	if err != nil {
+		return nil, err
+	}
+	f.objects[aws.ToString(params.Key)] = data
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (f *fakeS3) CreateMultipartUpload(_ context.Context, params *s3.CreateMultipartUploadInput, _ ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error) {
+	id := fmt.Sprintf("upload-%d", len(f.uploads))
+	f.uploads[id] = make(map[int32][]byte)
+	return &s3.CreateMultipartUploadOutput{UploadId: aws.String(id), Key: params.Key}, nil
+}
+
+func (f *fakeS3) UploadPart(_ context.Context, params *s3.UploadPartInput, _ ...func(*s3.Options)) (*s3.UploadPartOutput, error) {
+	data, err := io.ReadAll(params.Body)
+	if err != nil {
+		return nil, err
+	}
+	f.uploads[aws.ToString(params.UploadId)][params.PartNumber] = data
+	return &s3.UploadPartOutput{ETag: aws.String(fmt.Sprintf("etag-%d", params.PartNumber))}, nil
+}
+
+func (f *fakeS3) CompleteMultipartUpload(_ context.Context, params *s3.CompleteMultipartUploadInput, _ ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error) {
+	parts := f.uploads[aws.ToString(params.UploadId)]
+	var data []byte
+	for _, part := range params.MultipartUpload.Parts {
+		data = append(data, parts[part.PartNumber]...)
+	}
+	f.objects[aws.ToString(params.Key)] = data
+	f.multipartUploads++
+	return &s3.CompleteMultipartUploadOutput{}, nil
+}
+
+func (f *fakeS3) AbortMultipartUpload(_ context.Context, params *s3.AbortMultipartUploadInput, _ ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error) {
+	delete(f.uploads, aws.ToString(params.UploadId))
+	return &s3.AbortMultipartUploadOutput{}, nil
+}
+
+func (f *fakeS3) ListObjectsV2(_ context.Context, params *s3.ListObjectsV2Input, _ ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+	var keys []string
+	for key := range f.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	output := &s3.ListObjectsV2Output{}
+	prefixes := make(map[string]bool)
+	for _, key := range keys {
+		if !strings.HasPrefix(key, aws.ToString(params.Prefix)) {
+			continue
+		}
+		delimiter := aws.ToString(params.Delimiter)
+		if delimiter != "" {
+			rest := strings.TrimPrefix(key, aws.ToString(params.Prefix))
+			if i := strings.Index(rest, delimiter); i >= 0 {
+				prefix := aws.ToString(params.Prefix) + rest[:i+len(delimiter)]
+				if !prefixes[prefix] {
+					prefixes[prefix] = true
+					output.CommonPrefixes = append(output.CommonPrefixes, types.CommonPrefix{Prefix: aws.String(prefix)})
+				}
+				continue
+			}
+		}
+		output.Contents = append(output.Contents, types.Object{Key: aws.String(key)})
+	}
+	return output, nil
+}
+
+func (f *fakeS3) HeadObject(_ context.Context, params *s3.HeadObjectInput, _ ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+	if _, ok := f.objects[aws.ToString(params.Key)]; !ok {
+		return nil, &types.NotFound{}
+	}
+	return &s3.HeadObjectOutput{}, nil
+}
+
+func (f *fakeS3) GetObject(_ context.Context, params *s3.GetObjectInput, _ ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	data, ok := f.objects[aws.ToString(params.Key)]
+	if !ok {
+		return nil, &types.NoSuchKey{}
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(data))}, nil
+}
+
+func (f *fakeS3) DeleteObject(_ context.Context, params *s3.DeleteObjectInput, _ ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
+	delete(f.objects, aws.ToString(params.Key))
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+// DeleteObjects fails to delete the objects under the "locked/" prefix
+func (f *fakeS3) DeleteObjects(_ context.Context, params *s3.DeleteObjectsInput, _ ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
+	output := &s3.DeleteObjectsOutput{}
+	for _, object := range params.Delete.Objects {
+		if strings.HasPrefix(aws.ToString(object.Key), "locked/") {
+			output.Errors = append(output.Errors, types.Error{Key: object.Key, Message: aws.String("access denied")})
+			continue
+		}
+		delete(f.objects, aws.ToString(object.Key))
+	}
+	return output, nil
+}
+
+type fakePresigner struct{}
+
+func (fakePresigner) PresignGetObject(_ context.Context, params *s3.GetObjectInput, optFns ...func(*s3.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+	options := &s3.PresignOptions{}
+	for _, fn := range optFns {
+		fn(options)
+	}
+	return &v4.PresignedHTTPRequest{
+		URL: fmt.Sprintf("https://objectstorage/%s/%s?expires=%s", aws.ToString(params.Bucket), aws.ToString(params.Key), options.Expires),
+	}, nil
+}
+
+func TestInit(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      map[string]string
+		expectedErr string
+	}{
+		{
+			name:   "namespace and region",
+			config: map[string]string{"namespace": "ns", "region": "us-ashburn-1", "uploadPartSize": "16Mi"},
+		},
+		{
+			name:   "s3Url and region",
+			config: map[string]string{"s3Url": "https://ns.compat.objectstorage.us-ashburn-1.oraclecloud.com", "region": "us-ashburn-1"},
+		},
+		{
+			name:        "missing region",
+			config:      map[string]string{"namespace": "ns"},
+			expectedErr: "missing region in object store configuration",
+		},
+		{
+			name:        "missing namespace and s3Url",
+			config:      map[string]string{"region": "us-ashburn-1"},
+			expectedErr: "one of namespace and s3Url must be specified in object store configuration",
+		},
+		{
+			name:        "too small upload part size",
+			config:      map[string]string{"namespace": "ns", "region": "us-ashburn-1", "uploadPartSize": "1Mi"},
+			expectedErr: "uploadPartSize 1Mi is smaller than the minimum part size 5242880",
+		},
+		{
+			name:        "invalid key",
+			config:      map[string]string{"namespace": "ns", "region": "us-ashburn-1", "compartment": "c"},
+			expectedErr: "config has invalid keys [compartment]; valid keys are [region namespace s3Url profile credentialsFile uploadPartSize bucket prefix caCert]",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := NewObjectStore(velerotest.NewLogger()).Init(test.config)
+			if test.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestGetEndpoint(t *testing.T) {
+	endpoint, err := getEndpoint(map[string]string{"namespace": "ns", "region": "us-ashburn-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://ns.compat.objectstorage.us-ashburn-1.oraclecloud.com", endpoint)
+
+	endpoint, err = getEndpoint(map[string]string{"namespace": "ns", "region": "us-ashburn-1", "s3Url": "https://objectstorage.example.com/"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://objectstorage.example.com", endpoint)
+}
+
+func TestObjectStore(t *testing.T) {
+	client := newFakeS3()
+	store := &ObjectStore{
+		log:       velerotest.NewLogger(),
+		client:    client,
+		presigner: fakePresigner{},
+		partSize:  manager.MinUploadPartSize,
+	}
+
+	// small objects are uploaded in a single request
+	require.NoError(t, store.PutObject("bucket", "backups/backup-1/velero-backup.json", strings.NewReader("metadata")))
+	assert.Equal(t, 0, client.multipartUploads)
+
+	// large objects are uploaded with multipart uploads
+	contents := bytes.Repeat([]byte("a"), int(2*manager.MinUploadPartSize+1))
+	require.NoError(t, store.PutObject("bucket", "backups/backup-1/backup-1.tar.gz", bytes.NewReader(contents)))
+	assert.Equal(t, 1, client.multipartUploads)
+	assert.Equal(t, contents, client.objects["backups/backup-1/backup-1.tar.gz"])
+
+	require.NoError(t, store.PutObject("bucket", "backups/backup-2/velero-backup.json", strings.NewReader("metadata")))
+
+	exists, err := store.ObjectExists("bucket", "backups/backup-1/velero-backup.json")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	rc, err := store.GetObject("bucket", "backups/backup-1/velero-backup.json")
+	require.NoError(t, err)
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "metadata", string(data))
+
+	prefixes, err := store.ListCommonPrefixes("bucket", "backups/", "/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backups/backup-1/", "backups/backup-2/"}, prefixes)
+
+	keys, err := store.ListObjects("bucket", "backups/backup-1/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backups/backup-1/backup-1.tar.gz", "backups/backup-1/velero-backup.json"}, keys)
+
+	url, err := store.CreateSignedURL("bucket", "backups/backup-1/backup-1.tar.gz", 10*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "https://objectstorage/bucket/backups/backup-1/backup-1.tar.gz?expires=10m0s", url)
+
+	require.NoError(t, store.DeleteObject("bucket", "backups/backup-1/velero-backup.json"))
+	exists, err = store.ObjectExists("bucket", "backups/backup-1/velero-backup.json")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = store.GetObject("bucket", "backups/backup-1/velero-backup.json")
+	assert.Error(t, err)
+}
+
+func TestDeleteObjects(t *testing.T) {
+	client := newFakeS3()
+	store := &ObjectStore{
+		log:    velerotest.NewLogger(),
+		client: client,
+	}
+
+	for _, key := range []string{"backups/backup-1/a", "backups/backup-1/b", "locked/c"} {
+		require.NoError(t, store.PutObject("bucket", key, strings.NewReader("data")))
+	}
+
+	require.NoError(t, store.DeleteObjects("bucket", []string{"backups/backup-1/a", "backups/backup-1/b"}))
+	keys, err := store.ListObjects("bucket", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"locked/c"}, keys)
+
+	err = store.DeleteObjects("bucket", []string{"locked/c"})
+	assert.EqualError(t, err, "error deleting 1 of 1 objects: locked/c: access denied")
+}
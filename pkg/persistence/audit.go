@@ -0,0 +1,66 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+func (s *objectBackupStore) PutAuditEntry(entry string, data io.Reader) error {
+	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getAuditEntryKey(entry), data)
+}
+
+// ListAuditEntries returns the names of the entries of the audit log, sorted by name.
+func (s *objectBackupStore) ListAuditEntries() ([]string, error) {
+	dir := s.layout.getAuditEntriesDir()
+	keys, err := s.objectStore.ListObjects(s.bucket, dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing audit entries")
+	}
+
+	entries := make([]string, 0, len(keys))
+	for _, key := range keys {
+		name, ok := strings.CutSuffix(strings.TrimPrefix(key, dir), ".json")
+		if !ok || name == "" || strings.Contains(name, "/") {
+			continue
+		}
+		entries = append(entries, name)
+	}
+	sort.Strings(entries)
+
+	return entries, nil
+}
+
+func (s *objectBackupStore) GetAuditEntry(entry string) (io.ReadCloser, error) {
+	return s.objectStore.GetObject(s.bucket, s.layout.getAuditEntryKey(entry))
+}
+
+func (s *objectBackupStore) PutAuditLog(log string, data io.Reader) error {
+	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getAuditLogKey(log), data)
+}
+
+func (s *objectBackupStore) GetAuditLogDownloadURL(log string) (string, error) {
+	return s.objectStore.CreateSignedURL(s.bucket, s.layout.getAuditLogKey(log), DownloadURLTTL)
+}
+
+func (s *objectBackupStore) DeleteAuditLog(log string) error {
+	return s.objectStore.DeleteObject(s.bucket, s.layout.getAuditLogKey(log))
+}
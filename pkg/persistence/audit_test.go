@@ -0,0 +1,55 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditEntries(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "velero")
+
+	entries, err := harness.ListAuditEntries()
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	require.NoError(t, harness.PutAuditEntry("20240102T000000Z-b", strings.NewReader("entry-2")))
+	require.NoError(t, harness.PutAuditEntry("20240101T000000Z-a", strings.NewReader("entry-1")))
+	// the aggregated audit logs aren't entries
+	require.NoError(t, harness.PutAuditLog("log-1", strings.NewReader("log")))
+
+	assert.Contains(t, harness.objectStore.Data["test-bucket"], "velero/audit/entries/20240101T000000Z-a.json")
+	assert.Contains(t, harness.objectStore.Data["test-bucket"], "velero/audit/logs/log-1.json.gz")
+
+	entries, err = harness.ListAuditEntries()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"20240101T000000Z-a", "20240102T000000Z-b"}, entries)
+
+	rc, err := harness.GetAuditEntry("20240101T000000Z-a")
+	require.NoError(t, err)
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "entry-1", string(data))
+
+	require.NoError(t, harness.DeleteAuditLog("log-1"))
+	assert.NotContains(t, harness.objectStore.Data["test-bucket"], "velero/audit/logs/log-1.json.gz")
+}
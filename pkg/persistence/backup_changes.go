@@ -0,0 +1,97 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// BackupChangesRetention is how long the changes of the backups are kept in the backup change
+// log, the changes recorded before are pruned by PruneBackupChanges.
+const BackupChangesRetention = 24 * time.Hour
+
+// the changes of the backups are recorded in hourly partitions of the backup change log, so that
+// only the partitions since the last sync are listed. The change of a backup is an empty object
+// named after the backup, e.g. "metadata/backup-changes/2024-01-01T10/backup-1".
+const backupChangesPartitionFormat = "2006-01-02T15"
+
+// recordBackupChange records the put or deletion of the backup in the backup change log if the
+// location has one, it's best-effort as the backups missing from the change log are synced by
+// the full listings.
+func (s *objectBackupStore) recordBackupChange(backup string) {
+	if !s.changeLog {
+		return
+	}
+
+	partition := time.Now().UTC().Format(backupChangesPartitionFormat)
+	if err := s.objectStore.PutObject(s.bucket, s.layout.getBackupChangeKey(partition, backup), bytes.NewReader(nil)); err != nil {
+		s.logger.WithError(err).WithField("backup", backup).Warn("Error recording the change of the backup")
+	}
+}
+
+func (s *objectBackupStore) ListChangedBackups(since time.Time) ([]string, error) {
+	now := time.Now().UTC()
+	if since.Before(now.Add(-BackupChangesRetention)) {
+		return nil, errors.Errorf("the backup changes since %s may be pruned", since.UTC().Format(time.RFC3339))
+	}
+
+	backups := sets.NewString()
+	for partition := since.UTC().Truncate(time.Hour); !partition.After(now); partition = partition.Add(time.Hour) {
+		dir := s.layout.getBackupChangesPartitionDir(partition.Format(backupChangesPartitionFormat))
+		keys, err := s.objectStore.ListObjects(s.bucket, dir)
+		if err != nil {
+			return nil, errors.Wrap(err, "error listing backup changes")
+		}
+		for _, key := range keys {
+			if backup := strings.TrimPrefix(key, dir); backup != "" && !strings.Contains(backup, "/") {
+				backups.Insert(backup)
+			}
+		}
+	}
+
+	return backups.List(), nil
+}
+
+func (s *objectBackupStore) PruneBackupChanges(before time.Time) error {
+	dir := s.layout.getBackupChangesDir()
+	prefixes, err := s.objectStore.ListCommonPrefixes(s.bucket, dir, "/")
+	if err != nil {
+		return errors.Wrap(err, "error listing backup change partitions")
+	}
+
+	var keys []string
+	for _, prefix := range prefixes {
+		partition, err := time.Parse(backupChangesPartitionFormat, path.Base(prefix))
+		if err != nil || !partition.Add(time.Hour).Before(before) {
+			continue
+		}
+
+		objects, err := s.objectStore.ListObjects(s.bucket, prefix)
+		if err != nil {
+			return errors.Wrap(err, "error listing backup changes")
+		}
+		keys = append(keys, objects...)
+	}
+
+	return s.deleteObjects(keys)
+}
@@ -0,0 +1,64 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupChanges(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "velero")
+	since := time.Now().Add(-time.Minute)
+
+	// the changes aren't recorded if the location doesn't have a change log
+	require.NoError(t, harness.PutBackupMetadata("backup-0", strings.NewReader("metadata")))
+	backups, err := harness.ListChangedBackups(since)
+	require.NoError(t, err)
+	assert.Empty(t, backups)
+
+	harness.changeLog = true
+	require.NoError(t, harness.PutBackup(BackupInfo{Name: "backup-1", Metadata: strings.NewReader("metadata"), Contents: strings.NewReader("contents")}))
+	require.NoError(t, harness.PutBackupMetadata("backup-2", strings.NewReader("metadata")))
+	require.NoError(t, harness.PutBackupMetadata("backup-3", strings.NewReader("metadata")))
+	require.NoError(t, harness.DeleteBackup("backup-3"))
+
+	backups, err = harness.ListChangedBackups(since)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backup-1", "backup-2", "backup-3"}, backups)
+
+	// the changes recorded before the last sync aren't listed
+	old := time.Now().UTC().Add(-3 * time.Hour).Format(backupChangesPartitionFormat)
+	harness.objectStore.Data["test-bucket"][harness.layout.getBackupChangeKey(old, "backup-4")] = nil
+	backups, err = harness.ListChangedBackups(since)
+	require.NoError(t, err)
+	assert.NotContains(t, backups, "backup-4")
+
+	// the changes may be pruned
+	_, err = harness.ListChangedBackups(time.Now().Add(-2 * BackupChangesRetention))
+	assert.Error(t, err)
+
+	require.NoError(t, harness.PruneBackupChanges(time.Now().Add(-time.Hour)))
+	assert.NotContains(t, harness.objectStore.Data["test-bucket"], harness.layout.getBackupChangeKey(old, "backup-4"))
+	backups, err = harness.ListChangedBackups(since)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backup-1", "backup-2", "backup-3"}, backups)
+}
@@ -0,0 +1,378 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package encryption implements the client-side encryption of the objects
+// Velero uploads to the backup storage locations.
+//
+// Each object is encrypted with a random data key by AES-256-GCM in chunks, and
+// the data key is encrypted with the key identified by the key secret and key ID.
+// The key secret, key ID and the encrypted data key are stored in the header of
+// the object, so the object can be decrypted after the key is rotated, as long as
+// the key is still available.
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// KeySize is the size of the keys in bytes.
+	KeySize = 32
+
+	// chunkSize is the size of the plaintext encrypted in each chunk.
+	chunkSize = 64 * 1024
+
+	// maxHeaderSize is the maximum size of the header accepted when decrypting.
+	maxHeaderSize = 4 * 1024
+
+	nonceSize  = 12
+	formatV1   = 1
+	chunkLast  = 1
+	chunkOther = 0
+)
+
+// magic identifies the encrypted objects.
+var magic = []byte("VELEROENC")
+
+// Key is a key used to encrypt the objects.
+type Key struct {
+	// Secret is the name of the secret holding the key.
+	Secret string
+	// ID is the ID of the key in the secret.
+	ID string
+	// Value is the AES-256 key.
+	Value []byte
+}
+
+// KeyGetter returns the value of the key identified by the secret name and key ID.
+type KeyGetter func(secret, id string) ([]byte, error)
+
+// header is stored in clear text at the beginning of the encrypted objects.
+type header struct {
+	KeySecret   string `json:"keySecret"`
+	KeyID       string `json:"keyID"`
+	DataKey     []byte `json:"dataKey"`
+	NoncePrefix []byte `json:"noncePrefix"`
+}
+
+// ValidateKey checks if the value is a valid key.
+func ValidateKey(value []byte) error {
+	if len(value) != KeySize {
+		return errors.Errorf("invalid key size %d, the key must be %d bytes", len(value), KeySize)
+	}
+
+	return nil
+}
+
+// NewEncryptReader returns a reader that reads the data from r encrypted by the key.
+func NewEncryptReader(r io.Reader, key *Key) (io.Reader, error) {
+	if err := ValidateKey(key.Value); err != nil {
+		return nil, err
+	}
+
+	dataKey := make([]byte, KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, errors.Wrap(err, "error generating data key")
+	}
+
+	wrappedKey, err := seal(key.Value, dataKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "error encrypting data key")
+	}
+
+	noncePrefix := make([]byte, nonceSize-4)
+	if _, err := rand.Read(noncePrefix); err != nil {
+		return nil, errors.Wrap(err, "error generating nonce")
+	}
+
+	headerData, err := json.Marshal(&header{
+		KeySecret:   key.Secret,
+		KeyID:       key.ID,
+		DataKey:     wrappedKey,
+		NoncePrefix: noncePrefix,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshaling header")
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	buf.Write(magic)
+	buf.WriteByte(formatV1)
+	_ = binary.Write(buf, binary.BigEndian, uint32(len(headerData)))
+	buf.Write(headerData)
+
+	return &encryptReader{
+		source:      bufio.NewReaderSize(r, chunkSize),
+		aead:        aead,
+		noncePrefix: noncePrefix,
+		plain:       make([]byte, chunkSize),
+		buf:         buf,
+	}, nil
+}
+
+// NewDecryptReader returns a reader that reads the data from r decrypted by the key
+// returned by getKey. If the data isn't encrypted, the data is read as it is, so
+// the objects uploaded before the encryption is enabled are still readable.
+func NewDecryptReader(r io.Reader, getKey KeyGetter) (io.Reader, error) {
+	source := bufio.NewReader(r)
+
+	if !isEncrypted(source) {
+		return source, nil
+	}
+
+	if _, err := source.Discard(len(magic)); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	version, err := source.ReadByte()
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading format version")
+	}
+	if version != formatV1 {
+		return nil, errors.Errorf("unsupported encryption format version %d", version)
+	}
+
+	var headerSize uint32
+	if err := binary.Read(source, binary.BigEndian, &headerSize); err != nil {
+		return nil, errors.Wrap(err, "error reading header size")
+	}
+	if headerSize > maxHeaderSize {
+		return nil, errors.Errorf("invalid header size %d", headerSize)
+	}
+
+	headerData := make([]byte, headerSize)
+	if _, err := io.ReadFull(source, headerData); err != nil {
+		return nil, errors.Wrap(err, "error reading header")
+	}
+
+	h := &header{}
+	if err := json.Unmarshal(headerData, h); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling header")
+	}
+	if len(h.NoncePrefix) != nonceSize-4 {
+		return nil, errors.Errorf("invalid nonce size %d", len(h.NoncePrefix))
+	}
+
+	if getKey == nil {
+		return nil, errors.Errorf("the data is encrypted by key %s in secret %s, but no key is available", h.KeyID, h.KeySecret)
+	}
+
+	keyValue, err := getKey(h.KeySecret, h.KeyID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting key %s in secret %s", h.KeyID, h.KeySecret)
+	}
+	if err := ValidateKey(keyValue); err != nil {
+		return nil, err
+	}
+
+	dataKey, err := open(keyValue, h.DataKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decrypting data key with key %s in secret %s", h.KeyID, h.KeySecret)
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &decryptReader{
+		source:      source,
+		aead:        aead,
+		noncePrefix: h.NoncePrefix,
+		buf:         new(bytes.Buffer),
+	}, nil
+}
+
+func isEncrypted(r *bufio.Reader) bool {
+	prefix, err := r.Peek(len(magic))
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(prefix, magic)
+}
+
+type encryptReader struct {
+	source      *bufio.Reader
+	aead        cipher.AEAD
+	noncePrefix []byte
+	counter     uint32
+	plain       []byte
+	buf         *bytes.Buffer
+	done        bool
+}
+
+func (e *encryptReader) Read(p []byte) (int, error) {
+	for e.buf.Len() == 0 {
+		if e.done {
+			return 0, io.EOF
+		}
+
+		if err := e.nextChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	return e.buf.Read(p)
+}
+
+// nextChunk encrypts the next chunk of the source into buf. Each chunk is written as
+// a flag indicating whether it's the last one, the size of the sealed data and the
+// sealed data. The flag is authenticated so the truncation of the data is detected.
+func (e *encryptReader) nextChunk() error {
+	n, err := io.ReadFull(e.source, e.plain)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return errors.WithStack(err)
+	}
+
+	flag := byte(chunkOther)
+	if _, peekErr := e.source.Peek(1); peekErr == io.EOF {
+		flag = chunkLast
+		e.done = true
+	}
+
+	sealed := e.aead.Seal(nil, chunkNonce(e.noncePrefix, e.counter), e.plain[:n], []byte{flag})
+	e.counter++
+
+	e.buf.WriteByte(flag)
+	_ = binary.Write(e.buf, binary.BigEndian, uint32(len(sealed)))
+	e.buf.Write(sealed)
+
+	return nil
+}
+
+type decryptReader struct {
+	source      *bufio.Reader
+	aead        cipher.AEAD
+	noncePrefix []byte
+	counter     uint32
+	buf         *bytes.Buffer
+	done        bool
+}
+
+func (d *decryptReader) Read(p []byte) (int, error) {
+	for d.buf.Len() == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+
+		if err := d.nextChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	return d.buf.Read(p)
+}
+
+func (d *decryptReader) nextChunk() error {
+	flag, err := d.source.ReadByte()
+	if err == io.EOF {
+		return errors.New("encrypted data is truncated")
+	} else if err != nil {
+		return errors.WithStack(err)
+	}
+
+	var size uint32
+	if err := binary.Read(d.source, binary.BigEndian, &size); err != nil {
+		return errors.Wrap(err, "error reading chunk size")
+	}
+	if size > chunkSize+aes.BlockSize {
+		return errors.Errorf("invalid chunk size %d", size)
+	}
+
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(d.source, sealed); err != nil {
+		return errors.Wrap(err, "error reading chunk")
+	}
+
+	plain, err := d.aead.Open(nil, chunkNonce(d.noncePrefix, d.counter), sealed, []byte{flag})
+	if err != nil {
+		return errors.Wrap(err, "error decrypting chunk")
+	}
+	d.counter++
+
+	if flag == chunkLast {
+		d.done = true
+	}
+
+	d.buf.Write(plain)
+
+	return nil
+}
+
+func chunkNonce(prefix []byte, counter uint32) []byte {
+	nonce := make([]byte, nonceSize)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[len(prefix):], counter)
+	return nonce
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return aead, nil
+}
+
+// seal encrypts the data by the key, the nonce is prepended to the result.
+func seal(key, data []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return aead.Seal(nonce, nonce, data, nil), nil
+}
+
+// open decrypts the data sealed by seal.
+func open(key, data []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < nonceSize {
+		return nil, errors.New("encrypted data is too short")
+	}
+
+	return aead.Open(nil, data[:nonceSize], data[nonceSize:], nil)
+}
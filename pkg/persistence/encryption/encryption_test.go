@@ -0,0 +1,167 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newKey(id string, fill byte) *Key {
+	return &Key{Secret: "velero-encryption", ID: id, Value: bytes.Repeat([]byte{fill}, KeySize)}
+}
+
+func keyGetter(keys ...*Key) KeyGetter {
+	return func(secret, id string) ([]byte, error) {
+		for _, key := range keys {
+			if key.Secret == secret && key.ID == id {
+				return key.Value, nil
+			}
+		}
+		return nil, errors.New("key not found")
+	}
+}
+
+func encrypt(t *testing.T, data []byte, key *Key) []byte {
+	t.Helper()
+
+	r, err := NewEncryptReader(bytes.NewReader(data), key)
+	require.NoError(t, err)
+
+	encrypted, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	return encrypted
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	key := newKey("key-1", 1)
+
+	tests := []struct {
+		name string
+		size int
+	}{
+		{
+			name: "empty data",
+			size: 0,
+		},
+		{
+			name: "data smaller than a chunk",
+			size: 100,
+		},
+		{
+			name: "data of exactly one chunk",
+			size: chunkSize,
+		},
+		{
+			name: "data of multiple chunks",
+			size: 3*chunkSize + 123,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := make([]byte, test.size)
+			for i := range data {
+				data[i] = byte(i % 251)
+			}
+
+			encrypted := encrypt(t, data, key)
+			if test.size > 0 {
+				assert.NotContains(t, string(encrypted), string(data))
+			}
+
+			r, err := NewDecryptReader(bytes.NewReader(encrypted), keyGetter(key))
+			require.NoError(t, err)
+
+			decrypted, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, data, decrypted)
+		})
+	}
+}
+
+func TestDecryptWithRotatedKey(t *testing.T) {
+	oldKey := newKey("key-1", 1)
+	currentKey := newKey("key-2", 2)
+
+	encrypted := encrypt(t, []byte("backup data"), oldKey)
+
+	r, err := NewDecryptReader(bytes.NewReader(encrypted), keyGetter(currentKey, oldKey))
+	require.NoError(t, err)
+
+	decrypted, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "backup data", string(decrypted))
+
+	_, err = NewDecryptReader(bytes.NewReader(encrypted), keyGetter(currentKey))
+	assert.EqualError(t, err, "error getting key key-1 in secret velero-encryption: key not found")
+}
+
+func TestDecryptNotEncryptedData(t *testing.T) {
+	r, err := NewDecryptReader(bytes.NewReader([]byte("plain data")), nil)
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "plain data", string(data))
+}
+
+func TestDecryptErrors(t *testing.T) {
+	key := newKey("key-1", 1)
+	encrypted := encrypt(t, make([]byte, 2*chunkSize), key)
+
+	t.Run("no key getter", func(t *testing.T) {
+		_, err := NewDecryptReader(bytes.NewReader(encrypted), nil)
+		assert.EqualError(t, err, "the data is encrypted by key key-1 in secret velero-encryption, but no key is available")
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		wrongKey := newKey("key-1", 2)
+		_, err := NewDecryptReader(bytes.NewReader(encrypted), keyGetter(wrongKey))
+		assert.ErrorContains(t, err, "error decrypting data key with key key-1 in secret velero-encryption")
+	})
+
+	t.Run("last chunk is truncated", func(t *testing.T) {
+		r, err := NewDecryptReader(bytes.NewReader(encrypted[:len(encrypted)-(5+chunkSize+16)]), keyGetter(key))
+		require.NoError(t, err)
+
+		_, err = io.ReadAll(r)
+		assert.EqualError(t, err, "encrypted data is truncated")
+	})
+
+	t.Run("tampered data", func(t *testing.T) {
+		tampered := append([]byte{}, encrypted...)
+		tampered[len(tampered)-1] ^= 0xff
+
+		r, err := NewDecryptReader(bytes.NewReader(tampered), keyGetter(key))
+		require.NoError(t, err)
+
+		_, err = io.ReadAll(r)
+		assert.ErrorContains(t, err, "error decrypting chunk")
+	})
+}
+
+func TestNewEncryptReaderInvalidKey(t *testing.T) {
+	_, err := NewEncryptReader(bytes.NewReader(nil), &Key{Secret: "velero-encryption", ID: "key-1", Value: []byte("short")})
+	assert.EqualError(t, err, "invalid key size 5, the key must be 32 bytes")
+}
@@ -0,0 +1,136 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/azure"
+)
+
+// ImmutableObject is an object which can't be deleted by any means because of the native
+// immutability of the object storage.
+type ImmutableObject struct {
+	Key string
+	// ExpiresOn is when the object can be deleted, nil if it isn't known, e.g. the object
+	// is under a legal hold which can be removed at any time.
+	ExpiresOn *time.Time
+}
+
+// immutableStorage checks the native immutability of the objects in the object storage.
+type immutableStorage interface {
+	// validate checks the immutability configured on the bucket allows Velero to update
+	// the objects it writes.
+	validate() error
+
+	// listImmutableObjects returns the immutable objects with the prefix.
+	listImmutableObjects(prefix string) ([]ImmutableObject, error)
+}
+
+// newImmutableStorage returns the immutableStorage of the location, nil is returned if
+// the immutability of the objects isn't checked natively for the provider of the location.
+// The immutability is configured on the bucket, so it's checked regardless of the object
+// lock of the location.
+func newImmutableStorage(location *velerov1api.BackupStorageLocation, bucket string, config map[string]string, log logrus.FieldLogger) immutableStorage {
+	switch location.Spec.Provider {
+	case "azure", "velero.io/azure":
+		return &azureImmutableStorage{
+			container: bucket,
+			config:    config,
+			log:       log,
+			now:       time.Now,
+		}
+	default:
+		return nil
+	}
+}
+
+// azureImmutableStorage checks the version-level immutability policies and the legal holds
+// of the blobs in an Azure blob container.
+type azureImmutableStorage struct {
+	container string
+	config    map[string]string
+	log       logrus.FieldLogger
+	now       func() time.Time
+}
+
+func (s *azureImmutableStorage) validate() error {
+	client, _, err := azure.NewStorageClient(s.log, s.config)
+	if err != nil {
+		return err
+	}
+
+	immutability, err := azure.GetContainerImmutability(context.Background(), client, s.container)
+	if err != nil {
+		return err
+	}
+
+	// the container-level immutability prevents Velero from updating the backup metadata,
+	// only the version-level immutability keeps the old versions and allows the updates
+	if (immutability.ImmutabilityPolicy || immutability.LegalHold) && !immutability.VersionLevelImmutability {
+		return errors.Errorf("container %s has a container-level immutability policy or legal hold, but the version-level immutability support isn't enabled for it", s.container)
+	}
+
+	return nil
+}
+
+func (s *azureImmutableStorage) listImmutableObjects(prefix string) ([]ImmutableObject, error) {
+	client, _, err := azure.NewStorageClient(s.log, s.config)
+	if err != nil {
+		return nil, err
+	}
+
+	blobs, err := azure.ListImmutableBlobs(context.Background(), client, s.container, prefix, s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	return immutableObjectsOf(blobs), nil
+}
+
+// immutableObjectsOf merges the immutable versions of the blobs into the immutable objects,
+// a blob can be deleted when all its versions can be deleted.
+func immutableObjectsOf(blobs []azure.ImmutableBlob) []ImmutableObject {
+	var objects []ImmutableObject
+	indexes := make(map[string]int)
+	for _, blob := range blobs {
+		// the expiry of the legal holds and the container-level immutability policies isn't known
+		expiresOn := blob.ImmutabilityPolicyExpiresOn
+		if blob.LegalHold || blob.ContainerImmutabilityPolicy {
+			expiresOn = nil
+		}
+
+		i, ok := indexes[blob.Name]
+		if !ok {
+			indexes[blob.Name] = len(objects)
+			objects = append(objects, ImmutableObject{Key: blob.Name, ExpiresOn: expiresOn})
+			continue
+		}
+
+		// the blob can be deleted when its last version expires
+		if object := &objects[i]; object.ExpiresOn != nil && (expiresOn == nil || expiresOn.After(*object.ExpiresOn)) {
+			object.ExpiresOn = expiresOn
+		}
+	}
+
+	return objects
+}
@@ -0,0 +1,165 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// ExpiresAtMetadataKey is the key of the object metadata holding the expiration time, in
+// RFC 3339 format, of the backup the object belongs to.
+const ExpiresAtMetadataKey = "expires-at"
+
+// ObjectMetadataPutter is implemented by the object stores which are able to attach metadata
+// to the objects they write, e.g. S3 object tags or GCS custom metadata.
+type ObjectMetadataPutter interface {
+	// PutObjectWithMetadata creates a new object using the data in body within the specified
+	// object storage bucket with the given key, and attaches the metadata to it.
+	PutObjectWithMetadata(bucket, key string, body io.Reader, metadata map[string]string) error
+}
+
+// lifecycleObjectStore attaches the expiration time of the backups to the objects written
+// under their directories, so that the lifecycle policies of the bucket can remove the objects
+// once the backups expire.
+type lifecycleObjectStore struct {
+	velero.ObjectStore
+	putter ObjectMetadataPutter
+	layout *ObjectStoreLayout
+
+	lock sync.Mutex
+	// expirations caches the expiration time of the backups, a nil value means the backup
+	// doesn't expire
+	expirations map[string]*time.Time
+}
+
+// newLifecycleObjectStore returns the lifecycleObjectStore wrapping the object store, nil is
+// returned if the object store isn't able to attach metadata to the objects.
+func newLifecycleObjectStore(objectStore velero.ObjectStore, layout *ObjectStoreLayout) *lifecycleObjectStore {
+	putter, ok := objectStore.(ObjectMetadataPutter)
+	if !ok {
+		return nil
+	}
+
+	return &lifecycleObjectStore{
+		ObjectStore: objectStore,
+		putter:      putter,
+		layout:      layout,
+		expirations: make(map[string]*time.Time),
+	}
+}
+
+// PutObject writes the object with the expiration time of its backup, the objects which
+// don't belong to a backup are written as they are.
+func (s *lifecycleObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	backup := s.backupOf(key)
+	if backup == "" {
+		return s.ObjectStore.PutObject(bucket, key, body)
+	}
+
+	expiration, err := s.getExpiration(bucket, backup)
+	if err != nil {
+		return err
+	}
+	if expiration == nil {
+		return s.ObjectStore.PutObject(bucket, key, body)
+	}
+
+	return s.putter.PutObjectWithMetadata(bucket, key, body, map[string]string{
+		ExpiresAtMetadataKey: expiration.UTC().Format(time.RFC3339),
+	})
+}
+
+// recordExpiration records the expiration time of the backup from its metadata before it's
+// written, the returned reader has the same content as the metadata.
+func (s *lifecycleObjectStore) recordExpiration(backup string, metadata io.Reader) (io.Reader, error) {
+	if metadata == nil {
+		return nil, nil
+	}
+
+	if err := seekToBeginning(metadata); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	data, err := io.ReadAll(metadata)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	backupObj, err := decodeBackupMetadata(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding metadata of backup %s", backup)
+	}
+
+	var expiration *time.Time
+	if backupObj.Status.Expiration != nil {
+		expiration = &backupObj.Status.Expiration.Time
+	}
+
+	s.lock.Lock()
+	s.expirations[backup] = expiration
+	s.lock.Unlock()
+
+	return bytes.NewReader(data), nil
+}
+
+// getExpiration returns the expiration time of the backup, the metadata of the backup is read
+// from the bucket if it isn't recorded yet.
+func (s *lifecycleObjectStore) getExpiration(bucket, backup string) (*time.Time, error) {
+	s.lock.Lock()
+	expiration, ok := s.expirations[backup]
+	s.lock.Unlock()
+	if ok {
+		return expiration, nil
+	}
+
+	res, err := s.ObjectStore.GetObject(bucket, s.layout.getBackupMetadataKey(backup))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting metadata of backup %s", backup)
+	}
+	defer res.Close()
+
+	if _, err := s.recordExpiration(backup, res); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.expirations[backup], nil
+}
+
+// backupOf returns the name of the backup whose directory contains the key, an empty string
+// is returned if the key isn't in a backup directory.
+func (s *lifecycleObjectStore) backupOf(key string) string {
+	prefix := s.layout.subdirs["backups"]
+	if !strings.HasPrefix(key, prefix) {
+		return ""
+	}
+
+	backup, _, found := strings.Cut(strings.TrimPrefix(key, prefix), "/")
+	if !found {
+		return ""
+	}
+	return backup
+}
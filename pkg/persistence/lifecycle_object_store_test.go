@@ -0,0 +1,112 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// metadataObjectStore is an in-memory object store which records the metadata of the objects.
+type metadataObjectStore struct {
+	*inMemoryObjectStore
+	metadata map[string]map[string]string
+}
+
+func (o *metadataObjectStore) PutObjectWithMetadata(bucket, key string, body io.Reader, metadata map[string]string) error {
+	o.metadata[key] = metadata
+	return o.inMemoryObjectStore.PutObject(bucket, key, body)
+}
+
+func newLifecycleTestBackupStore(objectStore *metadataObjectStore) *objectBackupStore {
+	layout := NewObjectStoreLayout("")
+	lifecycle := newLifecycleObjectStore(objectStore, layout)
+	return &objectBackupStore{
+		objectStore:    lifecycle,
+		bucket:         "test-bucket",
+		layout:         layout,
+		logger:         velerotest.NewLogger(),
+		expirationMode: velerov1api.ObjectStorageExpirationModeLifecycle,
+		lifecycle:      lifecycle,
+	}
+}
+
+func backupMetadata(t *testing.T, backup *velerov1api.Backup) io.Reader {
+	t.Helper()
+	data, err := json.Marshal(backup)
+	require.NoError(t, err)
+	return bytes.NewBuffer(data)
+}
+
+func TestLifecycleObjectStore(t *testing.T) {
+	expiration := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	objectStore := &metadataObjectStore{
+		inMemoryObjectStore: newInMemoryObjectStore("test-bucket"),
+		metadata:            make(map[string]map[string]string),
+	}
+	expected := map[string]string{ExpiresAtMetadataKey: "2024-01-02T03:04:05Z"}
+
+	store := newLifecycleTestBackupStore(objectStore)
+	require.NoError(t, store.IsValid())
+
+	// the objects of the backup are written with its expiration time
+	require.NoError(t, store.PutBackup(BackupInfo{
+		Name:     "backup-1",
+		Metadata: backupMetadata(t, builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Expiration(expiration).Result()),
+		Contents: newStringReadSeeker("contents"),
+		Log:      newStringReadSeeker("log"),
+	}))
+	assert.Equal(t, expected, objectStore.metadata["backups/backup-1/velero-backup.json"])
+	assert.Equal(t, expected, objectStore.metadata["backups/backup-1/backup-1.tar.gz"])
+	assert.Equal(t, expected, objectStore.metadata["backups/backup-1/backup-1-logs.gz"])
+
+	// the expiration time is read from the bucket by a new backup store
+	store = newLifecycleTestBackupStore(objectStore)
+	require.NoError(t, store.PutBackupItemOperations("backup-1", []io.Reader{newStringReadSeeker("operations")}))
+	assert.Equal(t, expected, objectStore.metadata["backups/backup-1/backup-1-itemoperations.json.gz"])
+
+	// the objects of the backups without expiration time and of the restores are written as they are
+	require.NoError(t, store.PutBackupMetadata("backup-2", backupMetadata(t, builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").Result())))
+	require.NoError(t, store.PutRestoreLog("backup-1", "restore-1", newStringReadSeeker("log")))
+	assert.Contains(t, objectStore.Data["test-bucket"], "backups/backup-2/velero-backup.json")
+	assert.NotContains(t, objectStore.metadata, "backups/backup-2/velero-backup.json")
+	assert.Contains(t, objectStore.Data["test-bucket"], "restores/restore-1/restore-restore-1-logs.gz")
+	assert.NotContains(t, objectStore.metadata, "restores/restore-1/restore-restore-1-logs.gz")
+
+	// the expiration time of a backup can't be read from the bucket
+	assert.Error(t, store.PutBackupItemOperations("backup-3", []io.Reader{newStringReadSeeker("operations")}))
+
+	// the log chunks are written before the metadata of the backup, without expiration time
+	require.NoError(t, store.PutBackupLogChunk("backup-3", 1, newStringReadSeeker("log")))
+	assert.Contains(t, objectStore.Data["test-bucket"], "backups/backup-3/backup-3-logs-chunk-1.gz")
+	assert.NotContains(t, objectStore.metadata, "backups/backup-3/backup-3-logs-chunk-1.gz")
+
+	// the object store isn't able to attach metadata to the objects
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	harness.expirationMode = velerov1api.ObjectStorageExpirationModeLifecycle
+	assert.EqualError(t, harness.IsValid(), "expiration mode Lifecycle is configured but the object store isn't able to attach metadata to the objects")
+}
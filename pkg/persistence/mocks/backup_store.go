@@ -19,12 +19,17 @@ package mocks
 
 import (
 	io "io"
+	time "time"
+
+	archive "github.com/vmware-tanzu/velero/pkg/archive"
 
 	mock "github.com/stretchr/testify/mock"
 	itemoperation "github.com/vmware-tanzu/velero/pkg/itemoperation"
 
 	persistence "github.com/vmware-tanzu/velero/pkg/persistence"
 
+	velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
+
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 
 	volume "github.com/vmware-tanzu/velero/pkg/volume"
@@ -58,6 +63,20 @@ func (_m *BackupStore) BackupExists(bucket string, backupName string) (bool, err
 	return r0, r1
 }
 
+// DeleteAuditLog provides a mock function with given fields: log
+func (_m *BackupStore) DeleteAuditLog(log string) error {
+	ret := _m.Called(log)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(log)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteBackup provides a mock function with given fields: name
 func (_m *BackupStore) DeleteBackup(name string) error {
 	ret := _m.Called(name)
@@ -72,6 +91,34 @@ func (_m *BackupStore) DeleteBackup(name string) error {
 	return r0
 }
 
+// DeleteBackupContentsExtract provides a mock function with given fields: backup, extract
+func (_m *BackupStore) DeleteBackupContentsExtract(backup string, extract string) error {
+	ret := _m.Called(backup, extract)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(backup, extract)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteBackupLogChunks provides a mock function with given fields: backup
+func (_m *BackupStore) DeleteBackupLogChunks(backup string) error {
+	ret := _m.Called(backup)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(backup)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteRestore provides a mock function with given fields: name
 func (_m *BackupStore) DeleteRestore(name string) error {
 	ret := _m.Called(name)
@@ -86,6 +133,96 @@ func (_m *BackupStore) DeleteRestore(name string) error {
 	return r0
 }
 
+// GetAuditEntry provides a mock function with given fields: entry
+func (_m *BackupStore) GetAuditEntry(entry string) (io.ReadCloser, error) {
+	ret := _m.Called(entry)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(entry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAuditLogDownloadURL provides a mock function with given fields: log
+func (_m *BackupStore) GetAuditLogDownloadURL(log string) (string, error) {
+	ret := _m.Called(log)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(log)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(log)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupChecksums provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupChecksums(name string) (archive.Checksums, error) {
+	ret := _m.Called(name)
+
+	var r0 archive.Checksums
+	if rf, ok := ret.Get(0).(func(string) archive.Checksums); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(archive.Checksums)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupClusterResources provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupClusterResources(name string) (archive.ClusterResources, error) {
+	ret := _m.Called(name)
+
+	var r0 archive.ClusterResources
+	if rf, ok := ret.Get(0).(func(string) archive.ClusterResources); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(archive.ClusterResources)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupContents provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
 	ret := _m.Called(name)
@@ -109,6 +246,48 @@ func (_m *BackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
 	return r0, r1
 }
 
+// GetBackupContentsExtractDownloadURL provides a mock function with given fields: backup, extract
+func (_m *BackupStore) GetBackupContentsExtractDownloadURL(backup string, extract string) (string, error) {
+	ret := _m.Called(backup, extract)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(backup, extract)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(backup, extract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupInfo provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupInfo(name string) (persistence.BackupInfo, error) {
+	ret := _m.Called(name)
+
+	var r0 persistence.BackupInfo
+	if rf, ok := ret.Get(0).(func(string) persistence.BackupInfo); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(persistence.BackupInfo)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupItemOperations provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupItemOperations(name string) ([]*itemoperation.BackupOperation, error) {
 	ret := _m.Called(name)
@@ -155,6 +334,52 @@ func (_m *BackupStore) GetBackupMetadata(name string) (*v1.Backup, error) {
 	return r0, r1
 }
 
+// GetBackupImmutableObjects provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupImmutableObjects(name string) ([]persistence.ImmutableObject, error) {
+	ret := _m.Called(name)
+
+	var r0 []persistence.ImmutableObject
+	if rf, ok := ret.Get(0).(func(string) []persistence.ImmutableObject); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]persistence.ImmutableObject)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupVolumeInfos provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupVolumeInfos(name string) (*volume.VolumeInfos, error) {
+	ret := _m.Called(name)
+
+	var r0 *volume.VolumeInfos
+	if rf, ok := ret.Get(0).(func(string) *volume.VolumeInfos); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*volume.VolumeInfos)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupVolumeSnapshots provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error) {
 	ret := _m.Called(name)
@@ -291,6 +516,29 @@ func (_m *BackupStore) GetPodVolumeBackups(name string) ([]*v1.PodVolumeBackup,
 	return r0, r1
 }
 
+// GetRestoreFinalizeItems provides a mock function with given fields: name
+func (_m *BackupStore) GetRestoreFinalizeItems(name string) (map[string][]velero.RestoredItem, error) {
+	ret := _m.Called(name)
+
+	var r0 map[string][]velero.RestoredItem
+	if rf, ok := ret.Get(0).(func(string) map[string][]velero.RestoredItem); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]velero.RestoredItem)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRestoreItemOperations provides a mock function with given fields: name
 func (_m *BackupStore) GetRestoreItemOperations(name string) ([]*itemoperation.RestoreOperation, error) {
 	ret := _m.Called(name)
@@ -314,6 +562,29 @@ func (_m *BackupStore) GetRestoreItemOperations(name string) ([]*itemoperation.R
 	return r0, r1
 }
 
+// GetStorageUsage provides a mock function with given fields:
+func (_m *BackupStore) GetStorageUsage() (*persistence.StorageUsage, error) {
+	ret := _m.Called()
+
+	var r0 *persistence.StorageUsage
+	if rf, ok := ret.Get(0).(func() *persistence.StorageUsage); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.StorageUsage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsValid provides a mock function with given fields:
 func (_m *BackupStore) IsValid() error {
 	ret := _m.Called()
@@ -328,6 +599,29 @@ func (_m *BackupStore) IsValid() error {
 	return r0
 }
 
+// ListAuditEntries provides a mock function with given fields:
+func (_m *BackupStore) ListAuditEntries() ([]string, error) {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListBackups provides a mock function with given fields:
 func (_m *BackupStore) ListBackups() ([]string, error) {
 	ret := _m.Called()
@@ -351,6 +645,71 @@ func (_m *BackupStore) ListBackups() ([]string, error) {
 	return r0, r1
 }
 
+// ListChangedBackups provides a mock function with given fields: since
+func (_m *BackupStore) ListChangedBackups(since time.Time) ([]string, error) {
+	ret := _m.Called(since)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(time.Time) []string); ok {
+		r0 = rf(since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PruneBackupChanges provides a mock function with given fields: before
+func (_m *BackupStore) PruneBackupChanges(before time.Time) error {
+	ret := _m.Called(before)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Time) error); ok {
+		r0 = rf(before)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutAuditEntry provides a mock function with given fields: entry, data
+func (_m *BackupStore) PutAuditEntry(entry string, data io.Reader) error {
+	ret := _m.Called(entry, data)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(entry, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutAuditLog provides a mock function with given fields: log, data
+func (_m *BackupStore) PutAuditLog(log string, data io.Reader) error {
+	ret := _m.Called(log, data)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(log, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutBackup provides a mock function with given fields: info
 func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	ret := _m.Called(info)
@@ -365,6 +724,20 @@ func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	return r0
 }
 
+// PutBackupChecksums provides a mock function with given fields: backup, checksums
+func (_m *BackupStore) PutBackupChecksums(backup string, checksums io.Reader) error {
+	ret := _m.Called(backup, checksums)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(backup, checksums)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutBackupContents provides a mock function with given fields: backup, backupContents
 func (_m *BackupStore) PutBackupContents(backup string, backupContents io.Reader) error {
 	ret := _m.Called(backup, backupContents)
@@ -379,12 +752,26 @@ func (_m *BackupStore) PutBackupContents(backup string, backupContents io.Reader
 	return r0
 }
 
+// PutBackupContentsExtract provides a mock function with given fields: backup, extract, contents
+func (_m *BackupStore) PutBackupContentsExtract(backup string, extract string, contents io.Reader) error {
+	ret := _m.Called(backup, extract, contents)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader) error); ok {
+		r0 = rf(backup, extract, contents)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutBackupItemOperations provides a mock function with given fields: backup, backupItemOperations
-func (_m *BackupStore) PutBackupItemOperations(backup string, backupItemOperations io.Reader) error {
+func (_m *BackupStore) PutBackupItemOperations(backup string, backupItemOperations []io.Reader) error {
 	ret := _m.Called(backup, backupItemOperations)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+	if rf, ok := ret.Get(0).(func(string, []io.Reader) error); ok {
 		r0 = rf(backup, backupItemOperations)
 	} else {
 		r0 = ret.Error(0)
@@ -393,6 +780,34 @@ func (_m *BackupStore) PutBackupItemOperations(backup string, backupItemOperatio
 	return r0
 }
 
+// PutBackupVolumeInfos provides a mock function with given fields: name, volumeInfos
+func (_m *BackupStore) PutBackupVolumeInfos(name string, volumeInfos []io.Reader) error {
+	ret := _m.Called(name, volumeInfos)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []io.Reader) error); ok {
+		r0 = rf(name, volumeInfos)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutBackupLogChunk provides a mock function with given fields: backup, chunk, log
+func (_m *BackupStore) PutBackupLogChunk(backup string, chunk int, log io.Reader) error {
+	ret := _m.Called(backup, chunk, log)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, io.Reader) error); ok {
+		r0 = rf(backup, chunk, log)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutBackupMetadata provides a mock function with given fields: backup, backupMetadata
 func (_m *BackupStore) PutBackupMetadata(backup string, backupMetadata io.Reader) error {
 	ret := _m.Called(backup, backupMetadata)
@@ -407,6 +822,20 @@ func (_m *BackupStore) PutBackupMetadata(backup string, backupMetadata io.Reader
 	return r0
 }
 
+// PutRestoreFinalizeItems provides a mock function with given fields: restore, items
+func (_m *BackupStore) PutRestoreFinalizeItems(restore string, items io.Reader) error {
+	ret := _m.Called(restore, items)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(restore, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutRestoreItemOperations provides a mock function with given fields: restore, restoreItemOperations
 func (_m *BackupStore) PutRestoreItemOperations(restore string, restoreItemOperations io.Reader) error {
 	ret := _m.Called(restore, restoreItemOperations)
@@ -463,6 +892,41 @@ func (_m *BackupStore) PutRestoredResourceList(restore string, results io.Reader
 	return r0
 }
 
+// RetrieveBackup provides a mock function with given fields: name
+func (_m *BackupStore) RetrieveBackup(name string) (bool, error) {
+	ret := _m.Called(name)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransitionBackup provides a mock function with given fields: name, storageClass
+func (_m *BackupStore) TransitionBackup(name string, storageClass string) error {
+	ret := _m.Called(name, storageClass)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(name, storageClass)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewBackupStore interface {
 	mock.TestingT
 	Cleanup(func())
@@ -17,22 +17,28 @@ limitations under the License.
 package persistence
 
 import (
+	"bytes"
 	"compress/gzip"
 	"encoding/json"
 	"io"
+	"os"
 	"strings"
+	"sync"
 	"time"
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/persistence/encryption"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/util"
 	"github.com/vmware-tanzu/velero/pkg/volume"
@@ -46,12 +52,18 @@ type BackupInfo struct {
 	BackupResults,
 	PodVolumeBackups,
 	VolumeSnapshots,
-	BackupItemOperations,
-	BackupResourceList,
 	CSIVolumeSnapshots,
 	CSIVolumeSnapshotContents,
 	CSIVolumeSnapshotClasses,
-	BackupVolumeInfo io.Reader
+	BackupChecksums,
+	ClusterResources io.Reader
+
+	// the pages of the per-resource metadata, which is split into multiple files for the
+	// backups with many items
+	BackupItemOperations,
+	BackupResourceList,
+	BackupSkippedItems,
+	BackupVolumeInfo []io.Reader
 }
 
 // BackupStore defines operations for creating, retrieving, and deleting
@@ -60,16 +72,53 @@ type BackupStore interface {
 	IsValid() error
 
 	ListBackups() ([]string, error)
+	// ListChangedBackups returns the names of the backups put or deleted since the time by the
+	// backup change log, which is listed instead of all the backups by the backup sync. The
+	// changes are only recorded by the Velero versions writing the change log.
+	ListChangedBackups(since time.Time) ([]string, error)
+	// PruneBackupChanges deletes the changes recorded before the time from the backup change log.
+	PruneBackupChanges(before time.Time) error
 
 	PutBackup(info BackupInfo) error
 	PutBackupMetadata(backup string, backupMetadata io.Reader) error
-	PutBackupItemOperations(backup string, backupItemOperations io.Reader) error
+	PutBackupItemOperations(backup string, backupItemOperations []io.Reader) error
 	PutBackupContents(backup string, backupContents io.Reader) error
+	// PutBackupLogChunk stores a chunk of the log of the backup uploaded while the backup is in
+	// progress, the chunks are numbered from 1 and removed by DeleteBackupLogChunks once the
+	// whole log is stored with the backup.
+	PutBackupLogChunk(backup string, chunk int, log io.Reader) error
+	DeleteBackupLogChunks(backup string) error
+	// TransitionBackup transitions the contents of the backup to the storage class of the
+	// object storage.
+	TransitionBackup(name, storageClass string) error
+	// RetrieveBackup starts the retrieval of the contents of the backup transitioned to an
+	// archive storage class, and returns whether the contents are readable.
+	RetrieveBackup(name string) (bool, error)
+	// GetStorageUsage returns the approximate storage consumed by the backup store, nil is
+	// returned if the object store isn't able to list the stats of the objects.
+	GetStorageUsage() (*StorageUsage, error)
+	// PutBackupChecksums stores the checksums of the files in the backup contents, which are
+	// written when the backup contents are rebuilt by the backup finalizer.
+	PutBackupChecksums(backup string, checksums io.Reader) error
 	GetBackupMetadata(name string) (*velerov1api.Backup, error)
 	GetBackupItemOperations(name string) ([]*itemoperation.BackupOperation, error)
 	GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error)
 	GetPodVolumeBackups(name string) ([]*velerov1api.PodVolumeBackup, error)
+	GetBackupVolumeInfos(name string) (*volume.VolumeInfos, error)
+	// PutBackupVolumeInfos replaces the pages of the volume information of the backup, e.g. to
+	// add the volumes whose data is uploaded after the backup is persisted.
+	PutBackupVolumeInfos(name string, volumeInfos []io.Reader) error
 	GetBackupContents(name string) (io.ReadCloser, error)
+	// GetBackupInfo returns the files of the backup except the contents, which are read by
+	// GetBackupContents, e.g. to write the backup to another backup store by PutBackup. The
+	// files are decrypted and read into memory, the files the backup doesn't have are nil.
+	GetBackupInfo(name string) (BackupInfo, error)
+	// GetBackupChecksums returns the checksums of the files in the backup contents, nil is
+	// returned if the backup doesn't have checksums.
+	GetBackupChecksums(name string) (archive.Checksums, error)
+	// GetBackupClusterResources returns the cluster-scoped items recorded by the backup which
+	// deduplicates them, nil is returned if the backup doesn't deduplicate the items.
+	GetBackupClusterResources(name string) (archive.ClusterResources, error)
 	GetCSIVolumeSnapshots(name string) ([]*snapshotv1api.VolumeSnapshot, error)
 	GetCSIVolumeSnapshotContents(name string) ([]*snapshotv1api.VolumeSnapshotContent, error)
 	GetCSIVolumeSnapshotClasses(name string) ([]*snapshotv1api.VolumeSnapshotClass, error)
@@ -78,15 +127,38 @@ type BackupStore interface {
 	BackupExists(bucket, backupName string) (bool, error)
 
 	DeleteBackup(name string) error
+	// GetBackupImmutableObjects returns the objects of the backup which can't be deleted
+	// because of the native immutability of the object storage, nil is returned if the
+	// immutability isn't checked for the object storage.
+	GetBackupImmutableObjects(name string) ([]ImmutableObject, error)
 
 	PutRestoreLog(backup, restore string, log io.Reader) error
 	PutRestoreResults(backup, restore string, results io.Reader) error
 	PutRestoredResourceList(restore string, results io.Reader) error
 	PutRestoreItemOperations(restore string, restoreItemOperations io.Reader) error
 	GetRestoreItemOperations(name string) ([]*itemoperation.RestoreOperation, error)
+	PutRestoreFinalizeItems(restore string, items io.Reader) error
+	GetRestoreFinalizeItems(name string) (map[string][]velero.RestoredItem, error)
 	DeleteRestore(name string) error
 
 	GetDownloadURL(target velerov1api.DownloadTarget) (string, error)
+
+	// PutBackupContentsExtract stores the contents extracted from the backup, which are
+	// downloaded by GetBackupContentsExtractDownloadURL and removed by DeleteBackupContentsExtract.
+	PutBackupContentsExtract(backup, extract string, contents io.Reader) error
+	GetBackupContentsExtractDownloadURL(backup, extract string) (string, error)
+	DeleteBackupContentsExtract(backup, extract string) error
+
+	// PutAuditEntry stores an entry of the audit log, the entries are never modified once
+	// they're stored.
+	PutAuditEntry(entry string, data io.Reader) error
+	ListAuditEntries() ([]string, error)
+	GetAuditEntry(entry string) (io.ReadCloser, error)
+	// PutAuditLog stores the audit entries aggregated into a single file, which is downloaded
+	// by GetAuditLogDownloadURL and removed by DeleteAuditLog.
+	PutAuditLog(log string, data io.Reader) error
+	GetAuditLogDownloadURL(log string) (string, error)
+	DeleteAuditLog(log string) error
 }
 
 // DownloadURLTTL is how long a download URL is valid for.
@@ -97,6 +169,23 @@ type objectBackupStore struct {
 	bucket      string
 	layout      *ObjectStoreLayout
 	logger      logrus.FieldLogger
+	// encryptionKey is the key to encrypt the backup data, nil means the
+	// backup data isn't encrypted
+	encryptionKey *encryption.Key
+	// getKey returns the keys to decrypt the encrypted backup data
+	getKey encryption.KeyGetter
+	// immutableStorage checks the native immutability of the objects, nil means
+	// the immutability isn't checked
+	immutableStorage immutableStorage
+	// deleteConcurrency is the max number of the object deletions running in parallel
+	deleteConcurrency int
+	// expirationMode is the expiration mode of the location
+	expirationMode velerov1api.ObjectStorageExpirationMode
+	// lifecycle tags the objects of the backups with their expiration time, nil means the
+	// objects aren't tagged
+	lifecycle *lifecycleObjectStore
+	// changeLog records the puts and deletions of the backups in the backup change log
+	changeLog bool
 }
 
 // ObjectStoreGetter is a type that can get a velero.ObjectStore
@@ -112,12 +201,14 @@ type ObjectBackupStoreGetter interface {
 }
 
 type objectBackupStoreGetter struct {
-	credentialStore credentials.FileStore
+	credentialStore   credentials.FileStore
+	deleteConcurrency int
 }
 
 // NewObjectBackupStoreGetter returns a ObjectBackupStoreGetter that can get a velero.BackupStore.
-func NewObjectBackupStoreGetter(credentialStore credentials.FileStore) ObjectBackupStoreGetter {
-	return &objectBackupStoreGetter{credentialStore: credentialStore}
+// The deleteConcurrency is the max number of the object deletions the backup stores run in parallel.
+func NewObjectBackupStoreGetter(credentialStore credentials.FileStore, deleteConcurrency int) ObjectBackupStoreGetter {
+	return &objectBackupStoreGetter{credentialStore: credentialStore, deleteConcurrency: deleteConcurrency}
 }
 
 func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocation, objectStoreGetter ObjectStoreGetter, logger logrus.FieldLogger) (BackupStore, error) {
@@ -161,9 +252,16 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		objectStoreConfig["caCert"] = string(location.Spec.ObjectStorage.CACert)
 	}
 
+	// The preference of the ambient identity is handled here, it's not a config of the plugins.
+	delete(objectStoreConfig, credentials.PreferAmbientIdentityConfigKey)
+
 	// If the BSL specifies a credential, fetch its path on disk and pass to
-	// plugin via the config.
-	if location.Spec.Credential != nil {
+	// plugin via the config, unless the BSL prefers the ambient identity of the
+	// pod and it's available, in which case the plugin resolves the identity
+	// by the default credential chain of the provider.
+	if identity := credentials.GetAmbientIdentity(location.Spec.Provider, location.Spec.Config); identity != credentials.AmbientIdentityNone {
+		logger.WithField("backupStorageLocation", location.Name).Debugf("Using the ambient identity %s instead of the credentials file", identity)
+	} else if location.Spec.Credential != nil {
 		credsFile, err := b.credentialStore.Path(location.Spec.Credential)
 		if err != nil {
 			return nil, errors.Wrap(err, "unable to get credentials")
@@ -172,6 +270,22 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		objectStoreConfig["credentialsFile"] = credsFile
 	}
 
+	getKey := b.encryptionKeyGetter()
+
+	var encryptionKey *encryption.Key
+	if enc := location.Spec.ObjectStorage.Encryption; enc != nil {
+		value, err := getKey(enc.KeySecret, enc.KeyID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get encryption key %s in secret %s", enc.KeyID, enc.KeySecret)
+		}
+
+		if err := encryption.ValidateKey(value); err != nil {
+			return nil, errors.Wrapf(err, "invalid encryption key %s in secret %s", enc.KeyID, enc.KeySecret)
+		}
+
+		encryptionKey = &encryption.Key{Secret: enc.KeySecret, ID: enc.KeyID, Value: value}
+	}
+
 	objectStore, err := objectStoreGetter.GetObjectStore(location.Spec.Provider)
 	if err != nil {
 		return nil, err
@@ -186,12 +300,54 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		"prefix": prefix,
 	}))
 
-	return &objectBackupStore{
-		objectStore: objectStore,
-		bucket:      bucket,
-		layout:      NewObjectStoreLayout(prefix),
-		logger:      log,
-	}, nil
+	store := &objectBackupStore{
+		objectStore:       objectStore,
+		bucket:            bucket,
+		layout:            NewObjectStoreLayout(prefix),
+		logger:            log,
+		encryptionKey:     encryptionKey,
+		getKey:            getKey,
+		immutableStorage:  newImmutableStorage(location, bucket, objectStoreConfig, log),
+		deleteConcurrency: b.deleteConcurrency,
+		expirationMode:    location.Spec.ObjectStorage.ExpirationMode,
+		changeLog:         location.Spec.ObjectStorage.ChangeLog,
+	}
+
+	// tag the objects of the backups with their expiration time so that the lifecycle
+	// policies of the bucket remove them once the backups expire
+	if store.expirationMode == velerov1api.ObjectStorageExpirationModeLifecycle {
+		if lifecycle := newLifecycleObjectStore(objectStore, store.layout); lifecycle != nil {
+			store.objectStore = lifecycle
+			store.lifecycle = lifecycle
+		}
+	}
+
+	return store, nil
+}
+
+// encryptionKeyGetter returns a KeyGetter which reads the encryption keys from the
+// secrets in the Velero namespace.
+func (b *objectBackupStoreGetter) encryptionKeyGetter() encryption.KeyGetter {
+	return func(secret, id string) ([]byte, error) {
+		if b.credentialStore == nil {
+			return nil, errors.New("no credential store is available to get the key")
+		}
+
+		keyFile, err := b.credentialStore.Path(&corev1api.SecretKeySelector{
+			LocalObjectReference: corev1api.LocalObjectReference{Name: secret},
+			Key:                  id,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		return value, nil
+	}
 }
 
 func (s *objectBackupStore) IsValid() error {
@@ -216,6 +372,16 @@ func (s *objectBackupStore) IsValid() error {
 		return errors.Errorf("Backup store contains invalid top-level directories: %v", invalid)
 	}
 
+	if s.immutableStorage != nil {
+		if err := s.immutableStorage.validate(); err != nil {
+			return err
+		}
+	}
+
+	if s.expirationMode == velerov1api.ObjectStorageExpirationModeLifecycle && s.lifecycle == nil {
+		return errors.New("expiration mode Lifecycle is configured but the object store isn't able to attach metadata to the objects")
+	}
+
 	return nil
 }
 
@@ -244,7 +410,15 @@ func (s *objectBackupStore) ListBackups() ([]string, error) {
 }
 
 func (s *objectBackupStore) PutBackup(info BackupInfo) error {
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupLogKey(info.Name), info.Log); err != nil {
+	if s.lifecycle != nil {
+		metadata, err := s.lifecycle.recordExpiration(info.Name, info.Metadata)
+		if err != nil {
+			return err
+		}
+		info.Metadata = metadata
+	}
+
+	if err := s.seekAndPutEncryptedObject(s.layout.getBackupLogKey(info.Name), info.Log); err != nil {
 		// Uploading the log file is best-effort; if it fails, we log the error but it doesn't impact the
 		// backup's status.
 		s.logger.WithError(err).WithField("backup", info.Name).Error("Error uploading log file")
@@ -255,7 +429,7 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		return err
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(info.Name), info.Contents); err != nil {
+	if err := s.seekAndPutEncryptedObject(s.layout.getBackupContentsKey(info.Name), info.Contents); err != nil {
 		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
 		return kerrors.NewAggregate([]error{err, deleteErr})
 	}
@@ -265,17 +439,46 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 	var backupObjs = map[string]io.Reader{
 		s.layout.getPodVolumeBackupsKey(info.Name):          info.PodVolumeBackups,
 		s.layout.getBackupVolumeSnapshotsKey(info.Name):     info.VolumeSnapshots,
-		s.layout.getBackupItemOperationsKey(info.Name):      info.BackupItemOperations,
-		s.layout.getBackupResourceListKey(info.Name):        info.BackupResourceList,
 		s.layout.getCSIVolumeSnapshotKey(info.Name):         info.CSIVolumeSnapshots,
 		s.layout.getCSIVolumeSnapshotContentsKey(info.Name): info.CSIVolumeSnapshotContents,
 		s.layout.getCSIVolumeSnapshotClassesKey(info.Name):  info.CSIVolumeSnapshotClasses,
 		s.layout.getBackupResultsKey(info.Name):             info.BackupResults,
-		s.layout.getBackupVolumeInfoKey(info.Name):          info.BackupVolumeInfo,
+		s.layout.getBackupChecksumsKey(info.Name):           info.BackupChecksums,
+		s.layout.getBackupClusterResourcesKey(info.Name):    info.ClusterResources,
+	}
+
+	// the resource list, the checksums and the cluster resources are encrypted as they expose
+	// the resources in the backup
+	encrypted := map[string]bool{
+		s.layout.getBackupChecksumsKey(info.Name):        true,
+		s.layout.getBackupClusterResourcesKey(info.Name): true,
+	}
+	for i, page := range info.BackupResourceList {
+		key := getMetadataPageKey(s.layout.getBackupResourceListKey(info.Name), i+1)
+		backupObjs[key] = page
+		encrypted[key] = true
+	}
+	for i, page := range info.BackupSkippedItems {
+		key := getMetadataPageKey(s.layout.getBackupSkippedItemsKey(info.Name), i+1)
+		backupObjs[key] = page
+		encrypted[key] = true
+	}
+	for i, page := range info.BackupItemOperations {
+		backupObjs[getMetadataPageKey(s.layout.getBackupItemOperationsKey(info.Name), i+1)] = page
+	}
+	for i, page := range info.BackupVolumeInfo {
+		backupObjs[getMetadataPageKey(s.layout.getBackupVolumeInfoKey(info.Name), i+1)] = page
 	}
 
 	for key, reader := range backupObjs {
-		if err := seekAndPutObject(s.objectStore, s.bucket, key, reader); err != nil {
+		var err error
+		if encrypted[key] {
+			err = s.seekAndPutEncryptedObject(key, reader)
+		} else {
+			err = seekAndPutObject(s.objectStore, s.bucket, key, reader)
+		}
+
+		if err != nil {
 			errs := []error{err}
 
 			// attempt to clean up the backup contents and metadata if we fail to upload and of the extra files.
@@ -288,6 +491,7 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		}
 	}
 
+	s.recordBackupChange(info.Name)
 	return nil
 }
 
@@ -305,6 +509,15 @@ func (s *objectBackupStore) GetBackupMetadata(name string) (*velerov1api.Backup,
 		return nil, errors.WithStack(err)
 	}
 
+	backupObj, err := decodeBackupMetadata(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding %s/%s", s.bucket, metadataKey)
+	}
+
+	return backupObj, nil
+}
+
+func decodeBackupMetadata(data []byte) (*velerov1api.Backup, error) {
 	codecFactory := serializer.NewCodecFactory(util.VeleroScheme)
 
 	decoder := codecFactory.UniversalDecoder(velerov1api.SchemeGroupVersion)
@@ -315,14 +528,27 @@ func (s *objectBackupStore) GetBackupMetadata(name string) (*velerov1api.Backup,
 
 	backupObj, ok := obj.(*velerov1api.Backup)
 	if !ok {
-		return nil, errors.Errorf("unexpected type for %s/%s: %T", s.bucket, metadataKey, obj)
+		return nil, errors.Errorf("unexpected type %T", obj)
 	}
 
 	return backupObj, nil
 }
 
 func (s *objectBackupStore) PutBackupMetadata(backup string, backupMetadata io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(backup), backupMetadata)
+	if s.lifecycle != nil {
+		metadata, err := s.lifecycle.recordExpiration(backup, backupMetadata)
+		if err != nil {
+			return err
+		}
+		backupMetadata = metadata
+	}
+
+	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(backup), backupMetadata); err != nil {
+		return err
+	}
+
+	s.recordBackupChange(backup)
+	return nil
 }
 
 func (s *objectBackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error) {
@@ -350,17 +576,16 @@ func (s *objectBackupStore) GetBackupItemOperations(name string) ([]*itemoperati
 	// if the itemoperations file doesn't exist, we don't want to return an error, since
 	// a legacy backup or a backup with no async operations would not have this file, so check for
 	// its existence before attempting to get its contents.
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupItemOperationsKey(name))
-	if err != nil {
-		return nil, err
-	}
-	if res == nil {
-		return nil, nil
-	}
-	defer res.Close()
-
 	var backupItemOperations []*itemoperation.BackupOperation
-	if err := decode(res, &backupItemOperations); err != nil {
+	err := s.forEachMetadataPage(s.layout.getBackupItemOperationsKey(name), func(page io.Reader) error {
+		var operations []*itemoperation.BackupOperation
+		if err := decode(page, &operations); err != nil {
+			return err
+		}
+		backupItemOperations = append(backupItemOperations, operations...)
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -388,6 +613,66 @@ func (s *objectBackupStore) GetRestoreItemOperations(name string) ([]*itemoperat
 	return restoreItemOperations, nil
 }
 
+func (s *objectBackupStore) GetRestoreFinalizeItems(name string) (map[string][]velero.RestoredItem, error) {
+	// the file only exists for the restores which have the items to finalize after their
+	// async operations finish, so check for its existence before attempting to get its contents.
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getRestoreFinalizeItemsKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	var items map[string][]velero.RestoredItem
+	if err := decode(res, &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// forEachMetadataPage calls fn with the pages of the paginated metadata file in order, it
+// stops at the first page which doesn't exist.
+func (s *objectBackupStore) forEachMetadataPage(key string, fn func(page io.Reader) error) error {
+	for page := 1; ; page++ {
+		res, err := tryGet(s.objectStore, s.bucket, getMetadataPageKey(key, page))
+		if err != nil {
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+
+		err = fn(res)
+		res.Close()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// deleteMetadataPagesFrom deletes the pages of the paginated metadata file from the given page on,
+// e.g. the pages left over from a previous upload of the file with more pages, so that they're not
+// read by forEachMetadataPage. The pages are deleted in order, so that an interrupted deletion
+// doesn't leave a gap before the remaining ones.
+func (s *objectBackupStore) deleteMetadataPagesFrom(key string, from int) error {
+	for page := from; ; page++ {
+		pageKey := getMetadataPageKey(key, page)
+		exists, err := s.objectStore.ObjectExists(s.bucket, pageKey)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if !exists {
+			return nil
+		}
+		if err := s.objectStore.DeleteObject(s.bucket, pageKey); err != nil {
+			return errors.Wrapf(err, "error deleting stale metadata page %s", pageKey)
+		}
+	}
+}
+
 // tryGet returns the object with the given key if it exists, nil if it does not exist,
 // or an error if it was unable to check existence or get the object.
 func tryGet(objectStore velero.ObjectStore, bucket, key string) (io.ReadCloser, error) {
@@ -496,24 +781,159 @@ func (s *objectBackupStore) GetPodVolumeBackups(name string) ([]*velerov1api.Pod
 func (s *objectBackupStore) GetBackupVolumeInfos(name string) (*volume.VolumeInfos, error) {
 	var volumeInfos *volume.VolumeInfos
 
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupVolumeInfoKey(name))
+	err := s.forEachMetadataPage(s.layout.getBackupVolumeInfoKey(name), func(page io.Reader) error {
+		var pageInfos *volume.VolumeInfos
+		if err := decode(page, &pageInfos); err != nil {
+			return err
+		}
+
+		if volumeInfos == nil {
+			volumeInfos = pageInfos
+		} else if pageInfos != nil {
+			volumeInfos.VolumeInfos = append(volumeInfos.VolumeInfos, pageInfos.VolumeInfos...)
+		}
+		return nil
+	})
+
+	return volumeInfos, err
+}
+
+func (s *objectBackupStore) PutBackupVolumeInfos(name string, volumeInfos []io.Reader) error {
+	for i, page := range volumeInfos {
+		key := getMetadataPageKey(s.layout.getBackupVolumeInfoKey(name), i+1)
+		if err := seekAndPutObject(s.objectStore, s.bucket, key, page); err != nil {
+			return err
+		}
+	}
+	return s.deleteMetadataPagesFrom(s.layout.getBackupVolumeInfoKey(name), len(volumeInfos)+1)
+}
+
+func (s *objectBackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
+	return s.getDecryptedObject(s.layout.getBackupContentsKey(name))
+}
+
+func (s *objectBackupStore) GetBackupInfo(name string) (BackupInfo, error) {
+	info := BackupInfo{Name: name}
+
+	metadata, err := s.readObject(s.layout.getBackupMetadataKey(name))
 	if err != nil {
-		return volumeInfos, err
+		return info, err
 	}
-	if res == nil {
-		return volumeInfos, nil
+	if metadata == nil {
+		return info, errors.Errorf("backup %s doesn't exist in the backup store", name)
+	}
+	info.Metadata = metadata
+
+	files := map[string]*io.Reader{
+		s.layout.getBackupLogKey(name):                 &info.Log,
+		s.layout.getBackupResultsKey(name):             &info.BackupResults,
+		s.layout.getPodVolumeBackupsKey(name):          &info.PodVolumeBackups,
+		s.layout.getBackupVolumeSnapshotsKey(name):     &info.VolumeSnapshots,
+		s.layout.getCSIVolumeSnapshotKey(name):         &info.CSIVolumeSnapshots,
+		s.layout.getCSIVolumeSnapshotContentsKey(name): &info.CSIVolumeSnapshotContents,
+		s.layout.getCSIVolumeSnapshotClassesKey(name):  &info.CSIVolumeSnapshotClasses,
+		s.layout.getBackupChecksumsKey(name):           &info.BackupChecksums,
+		s.layout.getBackupClusterResourcesKey(name):    &info.ClusterResources,
+	}
+	for key, file := range files {
+		if *file, err = s.readObject(key); err != nil {
+			return info, err
+		}
+	}
+
+	pages := map[string]*[]io.Reader{
+		s.layout.getBackupItemOperationsKey(name): &info.BackupItemOperations,
+		s.layout.getBackupResourceListKey(name):   &info.BackupResourceList,
+		s.layout.getBackupSkippedItemsKey(name):   &info.BackupSkippedItems,
+		s.layout.getBackupVolumeInfoKey(name):     &info.BackupVolumeInfo,
+	}
+	for key, file := range pages {
+		for page := 1; ; page++ {
+			data, err := s.readObject(getMetadataPageKey(key, page))
+			if err != nil {
+				return info, err
+			}
+			if data == nil {
+				break
+			}
+			*file = append(*file, data)
+		}
+	}
+
+	return info, nil
+}
+
+// readObject reads the decrypted object into memory, nil is returned if the object doesn't exist.
+func (s *objectBackupStore) readObject(key string) (io.Reader, error) {
+	exists, err := s.objectStore.ObjectExists(s.bucket, key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	res, err := s.getDecryptedObject(key)
+	if err != nil {
+		return nil, err
 	}
 	defer res.Close()
 
-	if err := decode(res, &volumeInfos); err != nil {
-		return volumeInfos, err
+	data, err := io.ReadAll(res)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading object %s", key)
 	}
 
-	return volumeInfos, nil
+	return bytes.NewReader(data), nil
 }
 
-func (s *objectBackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
-	return s.objectStore.GetObject(s.bucket, s.layout.getBackupContentsKey(name))
+func (s *objectBackupStore) GetBackupChecksums(name string) (archive.Checksums, error) {
+	key := s.layout.getBackupChecksumsKey(name)
+	// the backups taken before the checksums were introduced don't have the checksums file
+	exists, err := s.objectStore.ObjectExists(s.bucket, key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	res, err := s.getDecryptedObject(key)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	var checksums archive.Checksums
+	if err := decode(res, &checksums); err != nil {
+		return nil, err
+	}
+
+	return checksums, nil
+}
+
+func (s *objectBackupStore) GetBackupClusterResources(name string) (archive.ClusterResources, error) {
+	key := s.layout.getBackupClusterResourcesKey(name)
+	exists, err := s.objectStore.ObjectExists(s.bucket, key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	res, err := s.getDecryptedObject(key)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	var clusterResources archive.ClusterResources
+	if err := decode(res, &clusterResources); err != nil {
+		return nil, err
+	}
+
+	return clusterResources, nil
 }
 
 func (s *objectBackupStore) BackupExists(bucket, backupName string) (bool, error) {
@@ -526,17 +946,25 @@ func (s *objectBackupStore) DeleteBackup(name string) error {
 		return err
 	}
 
-	var errs []error
-	for _, key := range objects {
-		s.logger.WithFields(logrus.Fields{
-			"key": key,
-		}).Debug("Trying to delete object")
-		if err := s.objectStore.DeleteObject(s.bucket, key); err != nil {
-			errs = append(errs, err)
-		}
+	if err := s.deleteObjects(objects); err != nil {
+		return err
 	}
 
-	return errors.WithStack(kerrors.NewAggregate(errs))
+	s.recordBackupChange(name)
+	return nil
+}
+
+func (s *objectBackupStore) GetBackupImmutableObjects(name string) ([]ImmutableObject, error) {
+	if s.immutableStorage == nil {
+		return nil, nil
+	}
+
+	objects, err := s.immutableStorage.listImmutableObjects(s.layout.getBackupDir(name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing the immutable objects of backup %s", name)
+	}
+
+	return objects, nil
 }
 
 func (s *objectBackupStore) DeleteRestore(name string) error {
@@ -545,21 +973,99 @@ func (s *objectBackupStore) DeleteRestore(name string) error {
 		return err
 	}
 
-	var errs []error
-	for _, key := range objects {
-		s.logger.WithFields(logrus.Fields{
-			"key": key,
-		}).Debug("Trying to delete object")
-		if err := s.objectStore.DeleteObject(s.bucket, key); err != nil {
-			errs = append(errs, err)
+	return s.deleteObjects(objects)
+}
+
+// deleteBatchSize is the max number of the objects deleted in a single request by the
+// object stores implementing velero.BatchObjectDeleter
+const deleteBatchSize = 1000
+
+// deleteObjects deletes the objects and runs up to deleteConcurrency deletions in parallel.
+// The objects are deleted in batches if the object store supports it, one by one otherwise.
+func (s *objectBackupStore) deleteObjects(keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	if deleter, ok := s.objectStore.(velero.BatchObjectDeleter); ok {
+		var batches [][]string
+		for start := 0; start < len(keys); start += deleteBatchSize {
+			end := start + deleteBatchSize
+			if end > len(keys) {
+				end = len(keys)
+			}
+			batches = append(batches, keys[start:end])
+		}
+
+		// the first batch tells whether the object store supports the batch deletion, e.g. the
+		// plugins built before it was added don't, so the objects are deleted one by one instead
+		err := s.deleteBatch(deleter, batches[0])
+		if !errors.Is(err, velero.ErrBatchDeleteNotSupported) {
+			errs := s.runInParallel(len(batches)-1, func(i int) error {
+				return s.deleteBatch(deleter, batches[i+1])
+			})
+			if err != nil {
+				errs = append([]error{err}, errs...)
+			}
+			return errors.WithStack(kerrors.NewAggregate(errs))
 		}
+		s.logger.Debug("Batch deletion is not supported by the object store, deleting the objects one by one")
 	}
 
+	errs := s.runInParallel(len(keys), func(i int) error {
+		s.logger.WithFields(logrus.Fields{
+			"key": keys[i],
+		}).Debug("Trying to delete object")
+		return s.objectStore.DeleteObject(s.bucket, keys[i])
+	})
 	return errors.WithStack(kerrors.NewAggregate(errs))
 }
 
+func (s *objectBackupStore) deleteBatch(deleter velero.BatchObjectDeleter, keys []string) error {
+	s.logger.WithFields(logrus.Fields{
+		"count": len(keys),
+		"first": keys[0],
+	}).Debug("Trying to delete objects")
+	return deleter.DeleteObjects(s.bucket, keys)
+}
+
+// runInParallel runs fn for the indexes from 0 to n-1 with up to deleteConcurrency calls in
+// parallel and returns the errors of the calls.
+func (s *objectBackupStore) runInParallel(n int, fn func(i int) error) []error {
+	concurrency := s.deleteConcurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	var (
+		wg   sync.WaitGroup
+		lock sync.Mutex
+		errs []error
+	)
+	sem := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := fn(i); err != nil {
+				lock.Lock()
+				errs = append(errs, err)
+				lock.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	return errs
+}
+
 func (s *objectBackupStore) PutRestoreLog(backup string, restore string, log io.Reader) error {
-	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreLogKey(restore), log)
+	return s.putEncryptedObject(s.layout.getRestoreLogKey(restore), log)
 }
 
 func (s *objectBackupStore) PutRestoreResults(backup string, restore string, results io.Reader) error {
@@ -567,19 +1073,57 @@ func (s *objectBackupStore) PutRestoreResults(backup string, restore string, res
 }
 
 func (s *objectBackupStore) PutRestoredResourceList(restore string, list io.Reader) error {
-	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreResourceListKey(restore), list)
+	return s.putEncryptedObject(s.layout.getRestoreResourceListKey(restore), list)
 }
 
 func (s *objectBackupStore) PutRestoreItemOperations(restore string, restoreItemOperations io.Reader) error {
 	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getRestoreItemOperationsKey(restore), restoreItemOperations)
 }
 
-func (s *objectBackupStore) PutBackupItemOperations(backup string, backupItemOperations io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupItemOperationsKey(backup), backupItemOperations)
+func (s *objectBackupStore) PutRestoreFinalizeItems(restore string, items io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreFinalizeItemsKey(restore), items)
+}
+
+func (s *objectBackupStore) PutBackupItemOperations(backup string, backupItemOperations []io.Reader) error {
+	for i, page := range backupItemOperations {
+		key := getMetadataPageKey(s.layout.getBackupItemOperationsKey(backup), i+1)
+		if err := seekAndPutObject(s.objectStore, s.bucket, key, page); err != nil {
+			return err
+		}
+	}
+	return s.deleteMetadataPagesFrom(s.layout.getBackupItemOperationsKey(backup), len(backupItemOperations)+1)
 }
 
 func (s *objectBackupStore) PutBackupContents(backup string, backupContents io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(backup), backupContents)
+	return s.seekAndPutEncryptedObject(s.layout.getBackupContentsKey(backup), backupContents)
+}
+
+func (s *objectBackupStore) PutBackupLogChunk(backup string, chunk int, log io.Reader) error {
+	// the chunks are written before the metadata of the backup, so they can't be tagged with
+	// the expiration time of the backup
+	objectStore := s.objectStore
+	if s.lifecycle != nil {
+		objectStore = s.lifecycle.ObjectStore
+	}
+
+	data, err := s.encrypt(s.layout.getBackupLogChunkKey(backup, chunk), log)
+	if err != nil {
+		return err
+	}
+	return objectStore.PutObject(s.bucket, s.layout.getBackupLogChunkKey(backup, chunk), data)
+}
+
+func (s *objectBackupStore) DeleteBackupLogChunks(backup string) error {
+	objects, err := s.objectStore.ListObjects(s.bucket, s.layout.getBackupLogChunkPrefix(backup))
+	if err != nil {
+		return err
+	}
+
+	return s.deleteObjects(objects)
+}
+
+func (s *objectBackupStore) PutBackupChecksums(backup string, checksums io.Reader) error {
+	return s.seekAndPutEncryptedObject(s.layout.getBackupChecksumsKey(backup), checksums)
 }
 
 func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (string, error) {
@@ -591,11 +1135,15 @@ func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (s
 	case velerov1api.DownloadTargetKindBackupVolumeSnapshots:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupVolumeSnapshotsKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindBackupItemOperations:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupItemOperationsKey(target.Name), DownloadURLTTL)
+		return s.objectStore.CreateSignedURL(s.bucket, getMetadataPageKey(s.layout.getBackupItemOperationsKey(target.Name), target.Page), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindRestoreItemOperations:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreItemOperationsKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupVolumeInfos:
+		return s.objectStore.CreateSignedURL(s.bucket, getMetadataPageKey(s.layout.getBackupVolumeInfoKey(target.Name), target.Page), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindBackupResourceList:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResourceListKey(target.Name), DownloadURLTTL)
+		return s.objectStore.CreateSignedURL(s.bucket, getMetadataPageKey(s.layout.getBackupResourceListKey(target.Name), target.Page), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupSkippedItems:
+		return s.objectStore.CreateSignedURL(s.bucket, getMetadataPageKey(s.layout.getBackupSkippedItemsKey(target.Name), target.Page), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindRestoreLog:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreLogKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindRestoreResults:
@@ -608,11 +1156,28 @@ func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (s
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getCSIVolumeSnapshotContentsKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindBackupResults:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResultsKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupChecksums:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupChecksumsKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupLogChunk:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupLogChunkKey(target.Name, target.Page), DownloadURLTTL)
 	default:
 		return "", errors.Errorf("unsupported download target kind %q", target.Kind)
 	}
 }
 
+func (s *objectBackupStore) PutBackupContentsExtract(backup, extract string, contents io.Reader) error {
+	// the extract is encrypted in the same way as the backup contents
+	return s.putEncryptedObject(s.layout.getBackupContentsExtractKey(backup, extract), contents)
+}
+
+func (s *objectBackupStore) GetBackupContentsExtractDownloadURL(backup, extract string) (string, error) {
+	return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupContentsExtractKey(backup, extract), DownloadURLTTL)
+}
+
+func (s *objectBackupStore) DeleteBackupContentsExtract(backup, extract string) error {
+	return s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsExtractKey(backup, extract))
+}
+
 func seekToBeginning(r io.Reader) error {
 	seeker, ok := r.(io.Seeker)
 	if !ok {
@@ -634,3 +1199,63 @@ func seekAndPutObject(objectStore velero.ObjectStore, bucket, key string, file i
 
 	return objectStore.PutObject(bucket, key, file)
 }
+
+// putEncryptedObject encrypts the data if encryption is enabled for the backup
+// storage location and uploads it to the object store.
+func (s *objectBackupStore) putEncryptedObject(key string, data io.Reader) error {
+	encrypted, err := s.encrypt(key, data)
+	if err != nil {
+		return err
+	}
+
+	return s.objectStore.PutObject(s.bucket, key, encrypted)
+}
+
+// encrypt returns the reader of the encrypted data if encryption is enabled for the backup
+// storage location, the data is returned as it is otherwise.
+func (s *objectBackupStore) encrypt(key string, data io.Reader) (io.Reader, error) {
+	if s.encryptionKey == nil {
+		return data, nil
+	}
+
+	encrypted, err := encryption.NewEncryptReader(data, s.encryptionKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error encrypting object %s", key)
+	}
+
+	return encrypted, nil
+}
+
+func (s *objectBackupStore) seekAndPutEncryptedObject(key string, file io.Reader) error {
+	if file == nil {
+		return nil
+	}
+
+	if err := seekToBeginning(file); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return s.putEncryptedObject(key, file)
+}
+
+// getDecryptedObject gets the object from the object store and decrypts it if it's
+// encrypted. The objects which aren't encrypted are returned as they are.
+func (s *objectBackupStore) getDecryptedObject(key string) (io.ReadCloser, error) {
+	res, err := s.objectStore.GetObject(s.bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted, err := encryption.NewDecryptReader(res, s.getKey)
+	if err != nil {
+		res.Close()
+		return nil, errors.Wrapf(err, "error decrypting object %s", key)
+	}
+
+	return &readCloser{Reader: decrypted, Closer: res}, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -41,6 +41,7 @@ func NewObjectStoreLayout(prefix string) *ObjectStoreLayout {
 		"metadata": path.Join(prefix, "metadata") + "/",
 		"plugins":  path.Join(prefix, "plugins") + "/",
 		"kopia":    path.Join(prefix, "kopia") + "/",
+		"audit":    path.Join(prefix, "audit") + "/",
 	}
 
 	return &ObjectStoreLayout{
@@ -77,10 +78,24 @@ func (l *ObjectStoreLayout) getBackupContentsKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s.tar.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getBackupContentsExtractKey(backup, extract string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-extract-%s.tar.gz", backup, extract))
+}
+
 func (l *ObjectStoreLayout) getBackupLogKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-logs.gz", backup))
 }
 
+// getBackupLogChunkPrefix returns the prefix of the keys of the chunks of the log uploaded
+// while the backup is in progress.
+func (l *ObjectStoreLayout) getBackupLogChunkPrefix(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-logs-chunk-", backup))
+}
+
+func (l *ObjectStoreLayout) getBackupLogChunkKey(backup string, chunk int) string {
+	return fmt.Sprintf("%s%d.gz", l.getBackupLogChunkPrefix(backup), chunk)
+}
+
 func (l *ObjectStoreLayout) getPodVolumeBackupsKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-podvolumebackups.json.gz", backup))
 }
@@ -97,6 +112,14 @@ func (l *ObjectStoreLayout) getBackupResourceListKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-resource-list.json.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getBackupChecksumsKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-checksums.json.gz", backup))
+}
+
+func (l *ObjectStoreLayout) getBackupClusterResourcesKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-cluster-resources.json.gz", backup))
+}
+
 func (l *ObjectStoreLayout) getRestoreLogKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-logs.gz", restore))
 }
@@ -113,6 +136,10 @@ func (l *ObjectStoreLayout) getRestoreItemOperationsKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-itemoperations.json.gz", restore))
 }
 
+func (l *ObjectStoreLayout) getRestoreFinalizeItemsKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-finalize-items.json.gz", restore))
+}
+
 func (l *ObjectStoreLayout) getCSIVolumeSnapshotKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-csi-volumesnapshots.json.gz", backup))
 }
@@ -129,6 +156,44 @@ func (l *ObjectStoreLayout) getBackupResultsKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-results.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getBackupSkippedItemsKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-skipped-items.json.gz", backup))
+}
+
 func (l *ObjectStoreLayout) getBackupVolumeInfoKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-volumeinfos.json.gz", backup))
 }
+
+func (l *ObjectStoreLayout) getAuditEntriesDir() string {
+	return path.Join(l.subdirs["audit"], "entries") + "/"
+}
+
+func (l *ObjectStoreLayout) getAuditEntryKey(entry string) string {
+	return path.Join(l.subdirs["audit"], "entries", fmt.Sprintf("%s.json", entry))
+}
+
+func (l *ObjectStoreLayout) getAuditLogKey(log string) string {
+	return path.Join(l.subdirs["audit"], "logs", fmt.Sprintf("%s.json.gz", log))
+}
+
+func (l *ObjectStoreLayout) getBackupChangesDir() string {
+	return path.Join(l.subdirs["metadata"], "backup-changes") + "/"
+}
+
+func (l *ObjectStoreLayout) getBackupChangesPartitionDir(partition string) string {
+	return path.Join(l.subdirs["metadata"], "backup-changes", partition) + "/"
+}
+
+func (l *ObjectStoreLayout) getBackupChangeKey(partition, backup string) string {
+	return path.Join(l.subdirs["metadata"], "backup-changes", partition, backup)
+}
+
+// getMetadataPageKey returns the key of a page of the paginated metadata file with the given
+// key. The first page is stored with the key of the file and the following pages with the page
+// number suffixed, e.g. "backup-1-resource-list-page-2.json.gz".
+func getMetadataPageKey(key string, page int) string {
+	if page <= 1 {
+		return key
+	}
+	return fmt.Sprintf("%s-page-%d.json.gz", strings.TrimSuffix(key, ".json.gz"), page)
+}
@@ -23,9 +23,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	"github.com/stretchr/testify/assert"
@@ -35,12 +39,15 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/persistence/encryption"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/azure"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -225,9 +232,9 @@ func TestPutBackup(t *testing.T) {
 		log                  io.Reader
 		podVolumeBackup      io.Reader
 		snapshots            io.Reader
-		backupItemOperations io.Reader
-		resourceList         io.Reader
-		backupVolumeInfo     io.Reader
+		backupItemOperations []io.Reader
+		resourceList         []io.Reader
+		backupVolumeInfo     []io.Reader
 		expectedErr          string
 		expectedKeys         []string
 	}{
@@ -238,9 +245,9 @@ func TestPutBackup(t *testing.T) {
 			log:                  newStringReadSeeker("log"),
 			podVolumeBackup:      newStringReadSeeker("podVolumeBackup"),
 			snapshots:            newStringReadSeeker("snapshots"),
-			backupItemOperations: newStringReadSeeker("backupItemOperations"),
-			resourceList:         newStringReadSeeker("resourceList"),
-			backupVolumeInfo:     newStringReadSeeker("backupVolumeInfo"),
+			backupItemOperations: []io.Reader{newStringReadSeeker("backupItemOperations")},
+			resourceList:         []io.Reader{newStringReadSeeker("resourceList")},
+			backupVolumeInfo:     []io.Reader{newStringReadSeeker("backupVolumeInfo")},
 			expectedErr:          "",
 			expectedKeys: []string{
 				"backups/backup-1/velero-backup.json",
@@ -261,9 +268,9 @@ func TestPutBackup(t *testing.T) {
 			log:                  newStringReadSeeker("log"),
 			podVolumeBackup:      newStringReadSeeker("podVolumeBackup"),
 			snapshots:            newStringReadSeeker("snapshots"),
-			backupItemOperations: newStringReadSeeker("backupItemOperations"),
-			resourceList:         newStringReadSeeker("resourceList"),
-			backupVolumeInfo:     newStringReadSeeker("backupVolumeInfo"),
+			backupItemOperations: []io.Reader{newStringReadSeeker("backupItemOperations")},
+			resourceList:         []io.Reader{newStringReadSeeker("resourceList")},
+			backupVolumeInfo:     []io.Reader{newStringReadSeeker("backupVolumeInfo")},
 			expectedErr:          "",
 			expectedKeys: []string{
 				"prefix-1/backups/backup-1/velero-backup.json",
@@ -276,6 +283,27 @@ func TestPutBackup(t *testing.T) {
 				"prefix-1/backups/backup-1/backup-1-volumeinfos.json.gz",
 			},
 		},
+		{
+			name:                 "paginated metadata",
+			metadata:             newStringReadSeeker("metadata"),
+			contents:             newStringReadSeeker("contents"),
+			log:                  newStringReadSeeker("log"),
+			backupItemOperations: []io.Reader{newStringReadSeeker("backupItemOperations-1"), newStringReadSeeker("backupItemOperations-2")},
+			resourceList:         []io.Reader{newStringReadSeeker("resourceList-1"), newStringReadSeeker("resourceList-2"), newStringReadSeeker("resourceList-3")},
+			backupVolumeInfo:     []io.Reader{newStringReadSeeker("backupVolumeInfo")},
+			expectedErr:          "",
+			expectedKeys: []string{
+				"backups/backup-1/velero-backup.json",
+				"backups/backup-1/backup-1.tar.gz",
+				"backups/backup-1/backup-1-logs.gz",
+				"backups/backup-1/backup-1-itemoperations.json.gz",
+				"backups/backup-1/backup-1-itemoperations-page-2.json.gz",
+				"backups/backup-1/backup-1-resource-list.json.gz",
+				"backups/backup-1/backup-1-resource-list-page-2.json.gz",
+				"backups/backup-1/backup-1-resource-list-page-3.json.gz",
+				"backups/backup-1/backup-1-volumeinfos.json.gz",
+			},
+		},
 		{
 			name:                 "error on metadata upload does not upload data",
 			metadata:             new(errorReader),
@@ -283,9 +311,9 @@ func TestPutBackup(t *testing.T) {
 			log:                  newStringReadSeeker("log"),
 			podVolumeBackup:      newStringReadSeeker("podVolumeBackup"),
 			snapshots:            newStringReadSeeker("snapshots"),
-			backupItemOperations: newStringReadSeeker("backupItemOperations"),
-			resourceList:         newStringReadSeeker("resourceList"),
-			backupVolumeInfo:     newStringReadSeeker("backupVolumeInfo"),
+			backupItemOperations: []io.Reader{newStringReadSeeker("backupItemOperations")},
+			resourceList:         []io.Reader{newStringReadSeeker("resourceList")},
+			backupVolumeInfo:     []io.Reader{newStringReadSeeker("backupVolumeInfo")},
 			expectedErr:          "error readers return errors",
 			expectedKeys:         []string{"backups/backup-1/backup-1-logs.gz"},
 		},
@@ -295,9 +323,9 @@ func TestPutBackup(t *testing.T) {
 			contents:             new(errorReader),
 			log:                  newStringReadSeeker("log"),
 			snapshots:            newStringReadSeeker("snapshots"),
-			backupItemOperations: newStringReadSeeker("backupItemOperations"),
-			resourceList:         newStringReadSeeker("resourceList"),
-			backupVolumeInfo:     newStringReadSeeker("backupVolumeInfo"),
+			backupItemOperations: []io.Reader{newStringReadSeeker("backupItemOperations")},
+			resourceList:         []io.Reader{newStringReadSeeker("resourceList")},
+			backupVolumeInfo:     []io.Reader{newStringReadSeeker("backupVolumeInfo")},
 			expectedErr:          "error readers return errors",
 			expectedKeys:         []string{"backups/backup-1/backup-1-logs.gz"},
 		},
@@ -308,9 +336,9 @@ func TestPutBackup(t *testing.T) {
 			log:                  new(errorReader),
 			podVolumeBackup:      newStringReadSeeker("podVolumeBackup"),
 			snapshots:            newStringReadSeeker("snapshots"),
-			backupItemOperations: newStringReadSeeker("backupItemOperations"),
-			resourceList:         newStringReadSeeker("resourceList"),
-			backupVolumeInfo:     newStringReadSeeker("backupVolumeInfo"),
+			backupItemOperations: []io.Reader{newStringReadSeeker("backupItemOperations")},
+			resourceList:         []io.Reader{newStringReadSeeker("resourceList")},
+			backupVolumeInfo:     []io.Reader{newStringReadSeeker("backupVolumeInfo")},
 			expectedErr:          "",
 			expectedKeys: []string{
 				"backups/backup-1/velero-backup.json",
@@ -329,8 +357,8 @@ func TestPutBackup(t *testing.T) {
 			log:              newStringReadSeeker("log"),
 			podVolumeBackup:  newStringReadSeeker("podVolumeBackup"),
 			snapshots:        newStringReadSeeker("snapshots"),
-			resourceList:     newStringReadSeeker("resourceList"),
-			backupVolumeInfo: newStringReadSeeker("backupVolumeInfo"),
+			resourceList:     []io.Reader{newStringReadSeeker("resourceList")},
+			backupVolumeInfo: []io.Reader{newStringReadSeeker("backupVolumeInfo")},
 			expectedErr:      "",
 			expectedKeys: []string{
 				"backups/backup-1/backup-1.tar.gz",
@@ -504,6 +532,90 @@ func TestGetBackupItemOperations(t *testing.T) {
 	res, err = harness.GetBackupItemOperations("test-backup")
 	assert.NoError(t, err)
 	assert.EqualValues(t, operations, res)
+
+	// the pages of the itemoperations file should be concatenated
+	for i, key := range []string{"backups/test-backup/test-backup-itemoperations.json.gz", "backups/test-backup/test-backup-itemoperations-page-2.json.gz"} {
+		obj := new(bytes.Buffer)
+		gzw := gzip.NewWriter(obj)
+		require.NoError(t, json.NewEncoder(gzw).Encode(operations[i:i+1]))
+		require.NoError(t, gzw.Close())
+		require.NoError(t, harness.objectStore.PutObject(harness.bucket, key, obj))
+	}
+
+	res, err = harness.GetBackupItemOperations("test-backup")
+	assert.NoError(t, err)
+	assert.EqualValues(t, operations, res)
+}
+
+// encodeMetadataPage returns the gzipped json of the object as a page of a paginated metadata file
+func encodeMetadataPage(t *testing.T, obj interface{}) io.Reader {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	require.NoError(t, json.NewEncoder(gzw).Encode(obj))
+	require.NoError(t, gzw.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestPutBackupItemOperationsDeletesStalePages(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	var operations []*itemoperation.BackupOperation
+	for i := 1; i <= 3; i++ {
+		operations = append(operations, &itemoperation.BackupOperation{
+			Spec: itemoperation.BackupOperationSpec{
+				BackupName:  "test-backup",
+				OperationID: fmt.Sprintf("operation-%d", i),
+			},
+		})
+	}
+
+	var pages []io.Reader
+	for i := range operations {
+		pages = append(pages, encodeMetadataPage(t, operations[i:i+1]))
+	}
+	require.NoError(t, harness.PutBackupItemOperations("test-backup", pages))
+	res, err := harness.GetBackupItemOperations("test-backup")
+	require.NoError(t, err)
+	assert.Equal(t, operations, res)
+
+	// the list shrinks to a single page, the stale pages shouldn't be read
+	require.NoError(t, harness.PutBackupItemOperations("test-backup", []io.Reader{encodeMetadataPage(t, operations[:1])}))
+	res, err = harness.GetBackupItemOperations("test-backup")
+	require.NoError(t, err)
+	assert.Equal(t, operations[:1], res)
+	assert.NotContains(t, harness.objectStore.Data[harness.bucket], "backups/test-backup/test-backup-itemoperations-page-2.json.gz")
+	assert.NotContains(t, harness.objectStore.Data[harness.bucket], "backups/test-backup/test-backup-itemoperations-page-3.json.gz")
+}
+
+func TestPutBackupVolumeInfosDeletesStalePages(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	var infos []volume.VolumeInfo
+	for i := 1; i <= 3; i++ {
+		infos = append(infos, volume.VolumeInfo{PVCName: fmt.Sprintf("pvc-%d", i)})
+	}
+
+	var pages []io.Reader
+	for i := range infos {
+		pages = append(pages, encodeMetadataPage(t, &volume.VolumeInfos{VolumeInfos: infos[i : i+1]}))
+	}
+	require.NoError(t, harness.PutBackupVolumeInfos("test-backup", pages))
+	res, err := harness.GetBackupVolumeInfos("test-backup")
+	require.NoError(t, err)
+	assert.Equal(t, infos, res.VolumeInfos)
+
+	// the list shrinks to two pages, the stale page shouldn't be read
+	pages = []io.Reader{
+		encodeMetadataPage(t, &volume.VolumeInfos{VolumeInfos: infos[:1]}),
+		encodeMetadataPage(t, &volume.VolumeInfos{VolumeInfos: infos[1:2]}),
+	}
+	require.NoError(t, harness.PutBackupVolumeInfos("test-backup", pages))
+	res, err = harness.GetBackupVolumeInfos("test-backup")
+	require.NoError(t, err)
+	assert.Equal(t, infos[:2], res.VolumeInfos)
+	assert.NotContains(t, harness.objectStore.Data[harness.bucket], "backups/test-backup/test-backup-volumeinfos-page-3.json.gz")
 }
 
 func TestGetRestoreItemOperations(t *testing.T) {
@@ -555,6 +667,36 @@ func TestGetRestoreItemOperations(t *testing.T) {
 	assert.EqualValues(t, operations, res)
 }
 
+func TestGetRestoreFinalizeItems(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// the restores without the items to finalize don't have the file
+	res, err := harness.GetRestoreFinalizeItems("test-restore")
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	items := map[string][]velero.RestoredItem{
+		"velero.io/finalizer": {
+			{
+				ResourceIdentifier: velero.ResourceIdentifier{GroupResource: kuberesource.Pods, Namespace: "ns", Name: "item-1"},
+				UID:                "uid-1",
+			},
+		},
+	}
+
+	obj := new(bytes.Buffer)
+	gzw := gzip.NewWriter(obj)
+
+	require.NoError(t, json.NewEncoder(gzw).Encode(items))
+	require.NoError(t, gzw.Close())
+	require.NoError(t, harness.PutRestoreFinalizeItems("test-restore", obj))
+	assert.Contains(t, harness.objectStore.Data[harness.bucket], "restores/test-restore/restore-test-restore-finalize-items.json.gz")
+
+	res, err = harness.GetRestoreFinalizeItems("test-restore")
+	assert.NoError(t, err)
+	assert.Equal(t, items, res)
+}
+
 func TestGetBackupContents(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
@@ -569,6 +711,199 @@ func TestGetBackupContents(t *testing.T) {
 	assert.Equal(t, "foo", string(data))
 }
 
+func TestGetBackupInfo(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	key := &encryption.Key{Secret: "velero-encryption", ID: "key-1", Value: bytes.Repeat([]byte{1}, encryption.KeySize)}
+	harness.encryptionKey = key
+	harness.getKey = func(secret, id string) ([]byte, error) {
+		return key.Value, nil
+	}
+
+	_, err := harness.GetBackupInfo("backup-1")
+	assert.EqualError(t, err, "backup backup-1 doesn't exist in the backup store")
+
+	require.NoError(t, harness.PutBackup(BackupInfo{
+		Name:               "backup-1",
+		Metadata:           newStringReadSeeker("metadata"),
+		Contents:           newStringReadSeeker("contents"),
+		Log:                newStringReadSeeker("log"),
+		PodVolumeBackups:   newStringReadSeeker("podVolumeBackups"),
+		BackupResourceList: []io.Reader{newStringReadSeeker("resourceList-1"), newStringReadSeeker("resourceList-2")},
+	}))
+
+	info, err := harness.GetBackupInfo("backup-1")
+	require.NoError(t, err)
+	assert.Equal(t, "backup-1", info.Name)
+	assert.Nil(t, info.Contents)
+	assert.Nil(t, info.VolumeSnapshots)
+	assert.Nil(t, info.BackupItemOperations)
+
+	read := func(r io.Reader) string {
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		return string(data)
+	}
+	assert.Equal(t, "metadata", read(info.Metadata))
+	assert.Equal(t, "log", read(info.Log))
+	assert.Equal(t, "podVolumeBackups", read(info.PodVolumeBackups))
+	require.Len(t, info.BackupResourceList, 2)
+	assert.Equal(t, "resourceList-1", read(info.BackupResourceList[0]))
+	assert.Equal(t, "resourceList-2", read(info.BackupResourceList[1]))
+}
+
+func TestBackupEncryption(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	key := &encryption.Key{Secret: "velero-encryption", ID: "key-1", Value: bytes.Repeat([]byte{1}, encryption.KeySize)}
+	harness.encryptionKey = key
+	harness.getKey = func(secret, id string) ([]byte, error) {
+		if secret == key.Secret && id == key.ID {
+			return key.Value, nil
+		}
+		return nil, errors.New("key not found")
+	}
+
+	err := harness.PutBackup(BackupInfo{
+		Name:               "backup-1",
+		Metadata:           newStringReadSeeker("metadata"),
+		Contents:           newStringReadSeeker("contents"),
+		Log:                newStringReadSeeker("log"),
+		BackupResourceList: []io.Reader{newStringReadSeeker("resourceList")},
+		VolumeSnapshots:    newStringReadSeeker("snapshots"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, harness.PutRestoreLog("backup-1", "restore-1", newStringReadSeeker("restoreLog")))
+	require.NoError(t, harness.PutRestoredResourceList("restore-1", newStringReadSeeker("restoredResourceList")))
+
+	// the backup metadata is required by the backup sync, so it's never encrypted
+	bucketData := harness.objectStore.Data[harness.bucket]
+	assert.Equal(t, "metadata", string(bucketData["backups/backup-1/velero-backup.json"]))
+	assert.Equal(t, "snapshots", string(bucketData["backups/backup-1/backup-1-volumesnapshots.json.gz"]))
+	for key, plain := range map[string]string{
+		"backups/backup-1/backup-1.tar.gz":                           "contents",
+		"backups/backup-1/backup-1-logs.gz":                          "log",
+		"backups/backup-1/backup-1-resource-list.json.gz":            "resourceList",
+		"restores/restore-1/restore-restore-1-logs.gz":               "restoreLog",
+		"restores/restore-1/restore-restore-1-resource-list.json.gz": "restoredResourceList",
+	} {
+		require.Contains(t, bucketData, key)
+		assert.NotContains(t, string(bucketData[key]), plain, "object %s is not encrypted", key)
+	}
+
+	rc, err := harness.GetBackupContents("backup-1")
+	require.NoError(t, err)
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(data))
+
+	// the encrypted objects can be read after the encryption is disabled
+	harness.encryptionKey = nil
+	rc, err = harness.GetBackupContents("backup-1")
+	require.NoError(t, err)
+	data, err = io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(data))
+
+	harness.getKey = nil
+	_, err = harness.GetBackupContents("backup-1")
+	assert.EqualError(t, err, "error decrypting object backups/backup-1/backup-1.tar.gz: the data is encrypted by key key-1 in secret velero-encryption, but no key is available")
+}
+
+func TestBackupChecksums(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	key := &encryption.Key{Secret: "velero-encryption", ID: "key-1", Value: bytes.Repeat([]byte{1}, encryption.KeySize)}
+	harness.encryptionKey = key
+	harness.getKey = func(secret, id string) ([]byte, error) {
+		return key.Value, nil
+	}
+
+	// the backups taken before the checksums were introduced don't have checksums
+	checksums, err := harness.GetBackupChecksums("backup-1")
+	require.NoError(t, err)
+	assert.Nil(t, checksums)
+
+	expected := archive.Checksums{"resources/pods/namespaces/ns-1/pod-1.json": "abc"}
+	obj, errs := encode.ToJSONGzip(expected, "checksums")
+	require.Empty(t, errs)
+	require.NoError(t, harness.PutBackup(BackupInfo{
+		Name:            "backup-1",
+		Metadata:        newStringReadSeeker("metadata"),
+		Contents:        newStringReadSeeker("contents"),
+		BackupChecksums: obj,
+	}))
+
+	// the checksums are encrypted as they expose the resources in the backup
+	_, err = gzip.NewReader(bytes.NewReader(harness.objectStore.Data[harness.bucket]["backups/backup-1/backup-1-checksums.json.gz"]))
+	assert.Error(t, err)
+
+	checksums, err = harness.GetBackupChecksums("backup-1")
+	require.NoError(t, err)
+	assert.Equal(t, expected, checksums)
+
+	// the checksums are replaced when the backup contents are rebuilt by the finalizer
+	expected = archive.Checksums{"resources/pods/namespaces/ns-1/pod-1.json": "def"}
+	obj, errs = encode.ToJSONGzip(expected, "checksums")
+	require.Empty(t, errs)
+	require.NoError(t, harness.PutBackupChecksums("backup-1", obj))
+
+	checksums, err = harness.GetBackupChecksums("backup-1")
+	require.NoError(t, err)
+	assert.Equal(t, expected, checksums)
+}
+
+func TestBackupClusterResources(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// the backups not deduplicating the cluster-scoped items don't have the cluster resources
+	clusterResources, err := harness.GetBackupClusterResources("backup-1")
+	require.NoError(t, err)
+	assert.Nil(t, clusterResources)
+
+	expected := archive.ClusterResources{
+		"resources/storageclasses.storage.k8s.io/cluster/sc-1.json": {ResourceVersion: "1", Backup: "backup-0"},
+		"resources/storageclasses.storage.k8s.io/cluster/sc-2.json": {ResourceVersion: "2", Backup: "backup-1"},
+	}
+	obj, errs := encode.ToJSONGzip(expected, "cluster resources")
+	require.Empty(t, errs)
+	require.NoError(t, harness.PutBackup(BackupInfo{
+		Name:             "backup-1",
+		Metadata:         newStringReadSeeker("metadata"),
+		Contents:         newStringReadSeeker("contents"),
+		ClusterResources: obj,
+	}))
+	assert.Contains(t, harness.objectStore.Data[harness.bucket], "backups/backup-1/backup-1-cluster-resources.json.gz")
+
+	clusterResources, err = harness.GetBackupClusterResources("backup-1")
+	require.NoError(t, err)
+	assert.Equal(t, expected, clusterResources)
+}
+
+func TestBackupLogChunks(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	require.NoError(t, harness.PutBackupLogChunk("backup-1", 1, newStringReadSeeker("chunk-1")))
+	require.NoError(t, harness.PutBackupLogChunk("backup-1", 2, newStringReadSeeker("chunk-2")))
+	require.NoError(t, harness.PutBackup(BackupInfo{
+		Name:     "backup-1",
+		Metadata: newStringReadSeeker("metadata"),
+		Contents: newStringReadSeeker("contents"),
+		Log:      newStringReadSeeker("log"),
+	}))
+	assert.Equal(t, "chunk-2", string(harness.objectStore.Data[harness.bucket]["backups/backup-1/backup-1-logs-chunk-2.gz"]))
+
+	url, err := harness.GetDownloadURL(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupLogChunk, Name: "backup-1", Page: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "a-url", url)
+
+	// only the chunks are deleted once the whole log is stored
+	require.NoError(t, harness.DeleteBackupLogChunks("backup-1"))
+	keys, err := harness.objectStore.ListObjects(harness.bucket, "backups/backup-1/")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"backups/backup-1/velero-backup.json",
+		"backups/backup-1/backup-1.tar.gz",
+		"backups/backup-1/backup-1-logs.gz",
+	}, keys)
+}
+
 func TestDeleteBackup(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -621,6 +956,211 @@ func TestDeleteBackup(t *testing.T) {
 	}
 }
 
+func TestDeleteBackupInParallel(t *testing.T) {
+	var objects []string
+	for i := 0; i < 100; i++ {
+		objects = append(objects, fmt.Sprintf("backups/bak/volume-%d", i))
+	}
+
+	objectStore := new(providermocks.ObjectStore)
+	backupStore := &objectBackupStore{
+		objectStore:       objectStore,
+		bucket:            "test-bucket",
+		layout:            NewObjectStoreLayout(""),
+		logger:            velerotest.NewLogger(),
+		deleteConcurrency: 10,
+	}
+	defer objectStore.AssertExpectations(t)
+
+	objectStore.On("ListObjects", backupStore.bucket, "backups/bak/").Return(objects, nil)
+	for _, obj := range objects {
+		objectStore.On("DeleteObject", backupStore.bucket, obj).Return(nil).Once()
+	}
+
+	require.NoError(t, backupStore.DeleteBackup("bak"))
+}
+
+// batchObjectStore is an object store deleting the objects in batches, it fails the batches
+// containing failKey and returns batchErr for every batch if set
+type batchObjectStore struct {
+	*providermocks.ObjectStore
+
+	lock     sync.Mutex
+	batches  [][]string
+	failKey  string
+	batchErr error
+}
+
+func (s *batchObjectStore) DeleteObjects(bucket string, keys []string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.batchErr != nil {
+		return s.batchErr
+	}
+	s.batches = append(s.batches, keys)
+	for _, key := range keys {
+		if key == s.failKey {
+			return fmt.Errorf("error deleting %s", key)
+		}
+	}
+	return nil
+}
+
+func TestDeleteBackupInBatches(t *testing.T) {
+	var objects []string
+	for i := 0; i < 2500; i++ {
+		objects = append(objects, fmt.Sprintf("backups/bak/volume-%d", i))
+	}
+
+	tests := []struct {
+		name            string
+		failKey         string
+		expectedBatches []int
+		expectedErr     string
+	}{
+		{
+			name:            "objects are deleted in batches",
+			expectedBatches: []int{500, 1000, 1000},
+		},
+		{
+			name:            "error deleting a batch is returned",
+			failKey:         "backups/bak/volume-1500",
+			expectedBatches: []int{500, 1000, 1000},
+			expectedErr:     "error deleting backups/bak/volume-1500",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objectStore := &batchObjectStore{ObjectStore: new(providermocks.ObjectStore), failKey: test.failKey}
+			backupStore := &objectBackupStore{
+				objectStore:       objectStore,
+				bucket:            "test-bucket",
+				layout:            NewObjectStoreLayout(""),
+				logger:            velerotest.NewLogger(),
+				deleteConcurrency: 10,
+			}
+			defer objectStore.AssertExpectations(t)
+
+			objectStore.On("ListObjects", backupStore.bucket, "backups/bak/").Return(objects, nil)
+
+			err := backupStore.DeleteBackup("bak")
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			var (
+				sizes   []int
+				deleted []string
+			)
+			for _, batch := range objectStore.batches {
+				sizes = append(sizes, len(batch))
+				deleted = append(deleted, batch...)
+			}
+			sort.Ints(sizes)
+			sort.Strings(deleted)
+			expected := append([]string(nil), objects...)
+			sort.Strings(expected)
+			assert.Equal(t, test.expectedBatches, sizes)
+			assert.Equal(t, expected, deleted)
+		})
+	}
+}
+
+func TestDeleteBackupBatchNotSupported(t *testing.T) {
+	objects := []string{"backups/bak/bak.tar.gz", "backups/bak/bak-logs.gz"}
+
+	objectStore := &batchObjectStore{ObjectStore: new(providermocks.ObjectStore), batchErr: velero.ErrBatchDeleteNotSupported}
+	backupStore := &objectBackupStore{
+		objectStore:       objectStore,
+		bucket:            "test-bucket",
+		layout:            NewObjectStoreLayout(""),
+		logger:            velerotest.NewLogger(),
+		deleteConcurrency: 10,
+	}
+	defer objectStore.AssertExpectations(t)
+
+	objectStore.On("ListObjects", backupStore.bucket, "backups/bak/").Return(objects, nil)
+	for _, obj := range objects {
+		objectStore.On("DeleteObject", backupStore.bucket, obj).Return(nil).Once()
+	}
+
+	require.NoError(t, backupStore.DeleteBackup("bak"))
+	assert.Empty(t, objectStore.batches)
+}
+
+type fakeImmutableStorage struct {
+	objects map[string][]ImmutableObject
+	err     error
+}
+
+func (s *fakeImmutableStorage) validate() error {
+	return s.err
+}
+
+func (s *fakeImmutableStorage) listImmutableObjects(prefix string) ([]ImmutableObject, error) {
+	return s.objects[prefix], s.err
+}
+
+func TestGetBackupImmutableObjects(t *testing.T) {
+	tests := []struct {
+		name             string
+		immutableStorage immutableStorage
+		expected         []ImmutableObject
+		expectedErr      string
+	}{
+		{
+			name: "immutability isn't checked",
+		},
+		{
+			name: "immutable objects are returned",
+			immutableStorage: &fakeImmutableStorage{
+				objects: map[string][]ImmutableObject{"backups/bak/": {{Key: "backups/bak/bak.tar.gz"}}},
+			},
+			expected: []ImmutableObject{{Key: "backups/bak/bak.tar.gz"}},
+		},
+		{
+			name:             "error listing immutable objects",
+			immutableStorage: &fakeImmutableStorage{err: errors.New("a")},
+			expectedErr:      "error listing the immutable objects of backup bak: a",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			harness := newObjectBackupStoreTestHarness("test-bucket", "")
+			harness.immutableStorage = test.immutableStorage
+
+			objects, err := harness.GetBackupImmutableObjects("bak")
+
+			velerotest.AssertErrorMatches(t, test.expectedErr, err)
+			assert.Equal(t, test.expected, objects)
+		})
+	}
+}
+
+func TestImmutableObjectsOf(t *testing.T) {
+	day1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	objects := immutableObjectsOf([]azure.ImmutableBlob{
+		{Name: "backups/bak/bak.tar.gz", VersionID: "1", ImmutabilityPolicyExpiresOn: &day2},
+		{Name: "backups/bak/bak.tar.gz", VersionID: "2", ImmutabilityPolicyExpiresOn: &day1},
+		{Name: "backups/bak/velero-backup.json", VersionID: "1", ImmutabilityPolicyExpiresOn: &day1},
+		{Name: "backups/bak/velero-backup.json", VersionID: "2", LegalHold: true},
+		{Name: "backups/bak/bak-logs.gz", VersionID: "1", ContainerImmutabilityPolicy: true},
+	})
+
+	assert.Equal(t, []ImmutableObject{
+		{Key: "backups/bak/bak.tar.gz", ExpiresOn: &day2},
+		{Key: "backups/bak/velero-backup.json"},
+		{Key: "backups/bak/bak-logs.gz"},
+	}, objects)
+}
+
 func TestDeleteRestore(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -689,6 +1229,7 @@ func TestGetDownloadURL(t *testing.T) {
 				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "backups/my-backup/my-backup-volumesnapshots.json.gz",
 				velerov1api.DownloadTargetKindBackupItemOperations:  "backups/my-backup/my-backup-itemoperations.json.gz",
 				velerov1api.DownloadTargetKindBackupResourceList:    "backups/my-backup/my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupChecksums:       "backups/my-backup/my-backup-checksums.json.gz",
 			},
 		},
 		{
@@ -973,7 +1514,7 @@ func TestNewObjectBackupStoreGetter(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			getter := NewObjectBackupStoreGetter(tc.credFileStore)
+			getter := NewObjectBackupStoreGetter(tc.credFileStore, 1)
 			res, err := getter.Get(tc.location, tc.objectStoreGetter, velerotest.NewLogger())
 			if tc.wantErr != "" {
 				require.EqualError(t, err, tc.wantErr)
@@ -1001,12 +1542,13 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 		location       *velerov1api.BackupStorageLocation
 		getter         ObjectBackupStoreGetter
 		credentialPath string
+		env            map[string]string
 		wantConfig     map[string]string
 	}{
 		{
 			name:     "location with bucket but no prefix has config initialized with bucket and empty prefix",
 			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).Result(),
-			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil)),
+			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil), 1),
 			wantConfig: map[string]string{
 				"bucket": "bucket",
 				"prefix": "",
@@ -1015,7 +1557,7 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 		{
 			name:     "location with bucket and prefix has config initialized with bucket and prefix",
 			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).Prefix("prefix").Result(),
-			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil)),
+			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil), 1),
 			wantConfig: map[string]string{
 				"bucket": "bucket",
 				"prefix": "prefix",
@@ -1024,19 +1566,59 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 		{
 			name:     "location with CACert is initialized with caCert",
 			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).CACert([]byte("cacert-data")).Result(),
-			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil)),
+			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil), 1),
 			wantConfig: map[string]string{
 				"bucket": "bucket",
 				"prefix": "",
 				"caCert": "cacert-data",
 			},
 		},
+		{
+			name:     "location with ObjectLock is initialized without the object lock, which is applied by the bucket",
+			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).ObjectLock(velerov1api.ObjectLockModeCompliance, 720*time.Hour).Result(),
+			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil), 1),
+			wantConfig: map[string]string{
+				"bucket": "bucket",
+				"prefix": "",
+			},
+		},
 		{
 			name: "location with Credential is initialized with path of serialized secret",
 			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).Credential(
 				builder.ForSecretKeySelector("does-not-exist", "does-not-exist").Result(),
 			).Result(),
-			getter: NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("/tmp/credentials/secret-file", nil)),
+			getter: NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("/tmp/credentials/secret-file", nil), 1),
+			wantConfig: map[string]string{
+				"bucket":          "bucket",
+				"prefix":          "",
+				"credentialsFile": "/tmp/credentials/secret-file",
+			},
+		},
+		{
+			name: "location preferring the available ambient identity isn't initialized with the credential",
+			location: builder.ForBackupStorageLocation("", "").Provider("aws").Bucket(bucket).Config(map[string]string{"preferAmbientIdentity": "true"}).Credential(
+				builder.ForSecretKeySelector("does-not-exist", "does-not-exist").Result(),
+			).Result(),
+			getter: NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("/tmp/credentials/secret-file", nil), 1),
+			env: map[string]string{
+				"AWS_ROLE_ARN":                "arn:aws:iam::123456789012:role/velero",
+				"AWS_WEB_IDENTITY_TOKEN_FILE": "/var/run/secrets/eks.amazonaws.com/serviceaccount/token",
+			},
+			wantConfig: map[string]string{
+				"bucket": "bucket",
+				"prefix": "",
+			},
+		},
+		{
+			name: "location preferring the unavailable ambient identity is initialized with the credential",
+			location: builder.ForBackupStorageLocation("", "").Provider("aws").Bucket(bucket).Config(map[string]string{"preferAmbientIdentity": "true"}).Credential(
+				builder.ForSecretKeySelector("does-not-exist", "does-not-exist").Result(),
+			).Result(),
+			getter: NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("/tmp/credentials/secret-file", nil), 1),
+			env: map[string]string{
+				"AWS_ROLE_ARN":                "",
+				"AWS_WEB_IDENTITY_TOKEN_FILE": "",
+			},
 			wantConfig: map[string]string{
 				"bucket":          "bucket",
 				"prefix":          "",
@@ -1047,8 +1629,12 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+
 			objStore := newInMemoryObjectStore(bucket)
-			objStoreGetter := &objectStoreGetter{provider: objStore}
+			objStoreGetter := &objectStoreGetter{tc.location.Spec.Provider: objStore}
 
 			_, err := tc.getter.Get(tc.location, objStoreGetter, velerotest.NewLogger())
 			require.NoError(t, err)
@@ -1057,6 +1643,55 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 	}
 }
 
+func TestNewObjectBackupStoreGetterEncryption(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(keyFile, bytes.Repeat([]byte{1}, encryption.KeySize), 0600))
+	invalidKeyFile := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(invalidKeyFile, []byte("invalid"), 0600))
+
+	tests := []struct {
+		name    string
+		getter  ObjectBackupStoreGetter
+		wantErr string
+	}{
+		{
+			name:   "encryption key is loaded from the secret",
+			getter: NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore(keyFile, nil), 1),
+		},
+		{
+			name:    "encryption key can't be got",
+			getter:  NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", errors.New("secret not found")), 1),
+			wantErr: "unable to get encryption key key-1 in secret velero-encryption: secret not found",
+		},
+		{
+			name:    "encryption key is invalid",
+			getter:  NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore(invalidKeyFile, nil), 1),
+			wantErr: "invalid encryption key key-1 in secret velero-encryption: invalid key size 7, the key must be 32 bytes",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			location := builder.ForBackupStorageLocation("", "").Provider("provider").Bucket("bucket").Result()
+			location.Spec.ObjectStorage.Encryption = &velerov1api.ObjectStorageEncryption{KeySecret: "velero-encryption", KeyID: "key-1"}
+			objStoreGetter := &objectStoreGetter{"provider": newInMemoryObjectStore("bucket")}
+
+			res, err := tc.getter.Get(location, objStoreGetter, velerotest.NewLogger())
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			store, ok := res.(*objectBackupStore)
+			require.True(t, ok)
+			require.NotNil(t, store.encryptionKey)
+			assert.Equal(t, "key-1", store.encryptionKey.ID)
+			assert.Equal(t, "velero-encryption", store.encryptionKey.Secret)
+		})
+	}
+}
+
 func TestGetBackupVolumeInfos(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -0,0 +1,128 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"io"
+	"sync"
+)
+
+// readAheadChunkSize is the size of the chunks read from the source by the read-ahead reader.
+const readAheadChunkSize = 1024 * 1024
+
+type readAheadReader struct {
+	src       io.ReadCloser
+	chunks    chan []byte
+	free      chan []byte
+	done      chan struct{}
+	closeOnce sync.Once
+
+	// err is the error which stopped reading the source, it's set before
+	// chunks is closed.
+	err error
+
+	current []byte
+	buf     []byte
+}
+
+// NewReadAheadReader returns a reader streaming the data of src. The data is read from src in
+// a separate goroutine ahead of the consumer, into at most bufferSize bytes of buffers, so the
+// reads from the object store overlap with the processing of the data without downloading all
+// of it first.
+func NewReadAheadReader(src io.ReadCloser, bufferSize int) io.ReadCloser {
+	count := bufferSize / readAheadChunkSize
+	if count < 1 {
+		count = 1
+	}
+
+	r := &readAheadReader{
+		src:    src,
+		chunks: make(chan []byte, count),
+		free:   make(chan []byte, count),
+		done:   make(chan struct{}),
+	}
+
+	for i := 0; i < count; i++ {
+		r.free <- make([]byte, readAheadChunkSize)
+	}
+
+	go r.fill()
+
+	return r
+}
+
+func (r *readAheadReader) fill() {
+	defer close(r.chunks)
+
+	for {
+		var buf []byte
+		select {
+		case buf = <-r.free:
+		case <-r.done:
+			return
+		}
+
+		n, err := io.ReadFull(r.src, buf)
+		if n > 0 {
+			select {
+			case r.chunks <- buf[:n]:
+			case <-r.done:
+				return
+			}
+		}
+
+		if err != nil {
+			if err == io.ErrUnexpectedEOF {
+				err = io.EOF
+			}
+			r.err = err
+			return
+		}
+	}
+}
+
+func (r *readAheadReader) Read(p []byte) (int, error) {
+	for len(r.current) == 0 {
+		if r.buf != nil {
+			r.free <- r.buf[:cap(r.buf)]
+			r.buf = nil
+		}
+
+		chunk, ok := <-r.chunks
+		if !ok {
+			return 0, r.err
+		}
+
+		r.current = chunk
+		r.buf = chunk
+	}
+
+	n := copy(p, r.current)
+	r.current = r.current[n:]
+
+	return n, nil
+}
+
+func (r *readAheadReader) Close() error {
+	var err error
+	r.closeOnce.Do(func() {
+		close(r.done)
+		err = r.src.Close()
+	})
+
+	return err
+}
@@ -0,0 +1,93 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeReadCloser struct {
+	io.Reader
+	closed bool
+}
+
+func (f *fakeReadCloser) Close() error {
+	f.closed = true
+	return nil
+}
+
+func TestReadAheadReader(t *testing.T) {
+	tests := []struct {
+		name       string
+		size       int
+		bufferSize int
+	}{
+		{
+			name:       "empty data",
+			size:       0,
+			bufferSize: 4 * readAheadChunkSize,
+		},
+		{
+			name:       "data smaller than a chunk",
+			size:       100,
+			bufferSize: 4 * readAheadChunkSize,
+		},
+		{
+			name:       "data larger than the buffer",
+			size:       10*readAheadChunkSize + 123,
+			bufferSize: 2 * readAheadChunkSize,
+		},
+		{
+			name:       "buffer smaller than a chunk",
+			size:       3*readAheadChunkSize + 1,
+			bufferSize: 10,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := make([]byte, test.size)
+			for i := range data {
+				data[i] = byte(i % 251)
+			}
+
+			src := &fakeReadCloser{Reader: bytes.NewReader(data)}
+			r := NewReadAheadReader(src, test.bufferSize)
+
+			read, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, data, read)
+
+			require.NoError(t, r.Close())
+			assert.True(t, src.closed)
+		})
+	}
+}
+
+func TestReadAheadReaderError(t *testing.T) {
+	src := &fakeReadCloser{Reader: io.MultiReader(bytes.NewReader([]byte("partial data")), &errorReader{})}
+	r := NewReadAheadReader(src, readAheadChunkSize)
+
+	read, err := io.ReadAll(r)
+	assert.EqualError(t, err, "error readers return errors")
+	assert.Equal(t, "partial data", string(read))
+}
@@ -0,0 +1,68 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"github.com/pkg/errors"
+)
+
+// ObjectStorageClassTransitioner is implemented by the object stores which are able to
+// transition the objects to other storage classes, e.g. the S3 Standard-IA and Glacier
+// storage classes or the Azure Blob Storage Cool and Archive access tiers.
+type ObjectStorageClassTransitioner interface {
+	// TransitionObject transitions the object with the key in the bucket to the storage class.
+	TransitionObject(bucket, key, storageClass string) error
+
+	// RetrieveObject starts the asynchronous retrieval of the object with the key in the
+	// bucket if it's in an archive storage class, and returns whether the object is readable.
+	// Calling it again while the object is being retrieved doesn't start another retrieval.
+	RetrieveObject(bucket, key string) (bool, error)
+}
+
+// storageClassTransitioner returns the object store as an ObjectStorageClassTransitioner,
+// false is returned if the object store isn't able to transition the objects.
+func (s *objectBackupStore) storageClassTransitioner() (ObjectStorageClassTransitioner, bool) {
+	objectStore := s.objectStore
+	if s.lifecycle != nil {
+		objectStore = s.lifecycle.ObjectStore
+	}
+
+	transitioner, ok := objectStore.(ObjectStorageClassTransitioner)
+	return transitioner, ok
+}
+
+// TransitionBackup transitions the backup contents to the storage class. The other files of
+// the backup stay in the default storage class as they're read without restoring the backup,
+// e.g. by the backup sync and `velero backup describe`.
+func (s *objectBackupStore) TransitionBackup(name, storageClass string) error {
+	transitioner, ok := s.storageClassTransitioner()
+	if !ok {
+		return errors.New("the object store isn't able to transition the objects to other storage classes")
+	}
+
+	return transitioner.TransitionObject(s.bucket, s.layout.getBackupContentsKey(name), storageClass)
+}
+
+func (s *objectBackupStore) RetrieveBackup(name string) (bool, error) {
+	transitioner, ok := s.storageClassTransitioner()
+	if !ok {
+		// the backup contents can't be transitioned to any archive storage class
+		return true, nil
+	}
+
+	return transitioner.RetrieveObject(s.bucket, s.layout.getBackupContentsKey(name))
+}
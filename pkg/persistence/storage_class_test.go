@@ -0,0 +1,82 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// storageClassObjectStore is an in-memory object store which records the storage classes of
+// the objects, the objects in the archive storage class are retrieved on the second request.
+type storageClassObjectStore struct {
+	*metadataObjectStore
+	storageClasses map[string]string
+	retrievals     map[string]int
+}
+
+func (o *storageClassObjectStore) TransitionObject(bucket, key, storageClass string) error {
+	o.storageClasses[key] = storageClass
+	return nil
+}
+
+func (o *storageClassObjectStore) RetrieveObject(bucket, key string) (bool, error) {
+	if o.storageClasses[key] != "ARCHIVE" {
+		return true, nil
+	}
+
+	o.retrievals[key]++
+	return o.retrievals[key] > 1, nil
+}
+
+func TestTransitionBackup(t *testing.T) {
+	// the object store isn't able to transition the objects
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	assert.EqualError(t, harness.TransitionBackup("backup-1", "ARCHIVE"), "the object store isn't able to transition the objects to other storage classes")
+	retrieved, err := harness.RetrieveBackup("backup-1")
+	require.NoError(t, err)
+	assert.True(t, retrieved)
+
+	objectStore := &storageClassObjectStore{
+		metadataObjectStore: &metadataObjectStore{
+			inMemoryObjectStore: newInMemoryObjectStore("test-bucket"),
+			metadata:            make(map[string]map[string]string),
+		},
+		storageClasses: make(map[string]string),
+		retrievals:     make(map[string]int),
+	}
+	// the object store is wrapped when the expiration mode is Lifecycle
+	store := newLifecycleTestBackupStore(objectStore.metadataObjectStore)
+	store.lifecycle.ObjectStore = objectStore
+
+	// only the backup contents are transitioned
+	require.NoError(t, store.TransitionBackup("backup-1", "ARCHIVE"))
+	assert.Equal(t, map[string]string{"backups/backup-1/backup-1.tar.gz": "ARCHIVE"}, objectStore.storageClasses)
+
+	retrieved, err = store.RetrieveBackup("backup-1")
+	require.NoError(t, err)
+	assert.False(t, retrieved)
+	retrieved, err = store.RetrieveBackup("backup-1")
+	require.NoError(t, err)
+	assert.True(t, retrieved)
+
+	retrieved, err = store.RetrieveBackup("backup-2")
+	require.NoError(t, err)
+	assert.True(t, retrieved)
+}
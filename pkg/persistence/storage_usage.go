@@ -0,0 +1,96 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"strings"
+	"time"
+)
+
+// ObjectStat is the size and the last modified time of an object returned by the listing.
+type ObjectStat struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// ObjectStatLister is implemented by the object stores which are able to return the sizes and
+// the last modified time of the objects when listing them, e.g. S3 ListObjectsV2 or Azure List
+// Blobs, so that the storage consumed by a backup storage location can be reported.
+type ObjectStatLister interface {
+	// ListObjectStats gets the stats of all the objects in the bucket with keys starting with
+	// the prefix.
+	ListObjectStats(bucket, prefix string) ([]ObjectStat, error)
+}
+
+// StorageUsage is the approximate storage consumed by a backup store, it's computed from the
+// listing of the objects, so the objects being written aren't counted.
+type StorageUsage struct {
+	// Bytes is the total size of the objects under the prefix of the backup store, including
+	// the backup repositories.
+	Bytes int64
+	// OldestBackup and NewestBackup are the last modified time of the metadata of the oldest
+	// and the newest backups, they're zero if the backup store has no backups.
+	OldestBackup time.Time
+	NewestBackup time.Time
+}
+
+func (s *objectBackupStore) GetStorageUsage() (*StorageUsage, error) {
+	objectStore := s.objectStore
+	if s.lifecycle != nil {
+		objectStore = s.lifecycle.ObjectStore
+	}
+
+	lister, ok := objectStore.(ObjectStatLister)
+	if !ok {
+		return nil, nil
+	}
+
+	stats, err := lister.ListObjectStats(s.bucket, s.layout.rootPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &StorageUsage{}
+	for _, stat := range stats {
+		usage.Bytes += stat.Size
+
+		if !s.isBackupMetadataKey(stat.Key) {
+			continue
+		}
+		if usage.OldestBackup.IsZero() || stat.LastModified.Before(usage.OldestBackup) {
+			usage.OldestBackup = stat.LastModified
+		}
+		if stat.LastModified.After(usage.NewestBackup) {
+			usage.NewestBackup = stat.LastModified
+		}
+	}
+
+	return usage, nil
+}
+
+// isBackupMetadataKey returns whether the key is the metadata file of a backup, each backup has
+// exactly one.
+func (s *objectBackupStore) isBackupMetadataKey(key string) bool {
+	rel, ok := strings.CutPrefix(key, s.layout.subdirs["backups"])
+	if !ok {
+		return false
+	}
+
+	backup, _, _ := strings.Cut(rel, "/")
+	return key == s.layout.getBackupMetadataKey(backup)
+}
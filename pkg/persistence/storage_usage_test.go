@@ -0,0 +1,85 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// statObjectStore is an in-memory object store which records the last modified time of the
+// objects.
+type statObjectStore struct {
+	*inMemoryObjectStore
+	lastModified map[string]time.Time
+}
+
+func (o *statObjectStore) ListObjectStats(bucket, prefix string) ([]ObjectStat, error) {
+	var stats []ObjectStat
+	for key, data := range o.Data[bucket] {
+		if strings.HasPrefix(key, prefix) {
+			stats = append(stats, ObjectStat{Key: key, Size: int64(len(data)), LastModified: o.lastModified[key]})
+		}
+	}
+	return stats, nil
+}
+
+func TestGetStorageUsage(t *testing.T) {
+	// the object store isn't able to list the stats of the objects
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	usage, err := harness.GetStorageUsage()
+	require.NoError(t, err)
+	assert.Nil(t, usage)
+
+	oldest := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newest := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	objectStore := &statObjectStore{
+		inMemoryObjectStore: newInMemoryObjectStore("test-bucket"),
+		lastModified: map[string]time.Time{
+			"velero/backups/backup-1/velero-backup.json": oldest,
+			"velero/backups/backup-2/velero-backup.json": newest,
+			// the other files of the backups and the repositories aren't backups
+			"velero/backups/backup-1/backup-1.tar.gz": newest.Add(time.Hour),
+			"velero/kopia/ns-1/kopia.repository":      oldest.Add(-time.Hour),
+		},
+	}
+	objectStore.Data["test-bucket"] = BucketData{
+		"velero/backups/backup-1/velero-backup.json": []byte("12345"),
+		"velero/backups/backup-1/backup-1.tar.gz":    []byte("1234567890"),
+		"velero/backups/backup-2/velero-backup.json": []byte("12345"),
+		"velero/kopia/ns-1/kopia.repository":         []byte("123"),
+		// the objects out of the prefix aren't counted
+		"other/backups/backup-3/velero-backup.json": []byte("12345"),
+	}
+	harness = newObjectBackupStoreTestHarness("test-bucket", "velero")
+	harness.objectBackupStore.objectStore = objectStore
+
+	usage, err = harness.GetStorageUsage()
+	require.NoError(t, err)
+	assert.Equal(t, &StorageUsage{Bytes: 23, OldestBackup: oldest, NewestBackup: newest}, usage)
+
+	// the location has no backups
+	delete(objectStore.Data["test-bucket"], "velero/backups/backup-1/velero-backup.json")
+	delete(objectStore.Data["test-bucket"], "velero/backups/backup-2/velero-backup.json")
+	usage, err = harness.GetStorageUsage()
+	require.NoError(t, err)
+	assert.Equal(t, &StorageUsage{Bytes: 13}, usage)
+}
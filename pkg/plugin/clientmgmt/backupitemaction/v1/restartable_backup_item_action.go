@@ -99,12 +99,26 @@ func (r *RestartableBackupItemAction) AppliesTo() (velero.ResourceSelector, erro
 	return delegate.AppliesTo()
 }
 
-// Execute restarts the plugin's process if needed, then delegates the call.
+// Execute restarts the plugin's process if needed, then delegates the call. The call is retried
+// once if the plugin process crashed while executing the item.
 func (r *RestartableBackupItemAction) Execute(item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
 	delegate, err := r.getDelegate()
 	if err != nil {
 		return nil, nil, err
 	}
 
+	updatedItem, additionalItems, err := delegate.Execute(item, backup)
+	if !process.IsUnavailable(err) {
+		return updatedItem, additionalItems, err
+	}
+
+	// the plugin process crashed while executing the item, restart it and retry the item
+	if err := r.SharedPluginProcess.ResetIfUnhealthy(); err != nil {
+		return nil, nil, err
+	}
+	if delegate, err = r.getBackupItemAction(); err != nil {
+		return nil, nil, err
+	}
+
 	return delegate.Execute(item, backup)
 }
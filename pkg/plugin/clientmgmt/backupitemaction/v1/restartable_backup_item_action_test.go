@@ -22,7 +22,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/vmware-tanzu/velero/internal/restartabletest"
@@ -148,3 +151,74 @@ func TestRestartableBackupItemActionDelegatedFunctions(t *testing.T) {
 		},
 	)
 }
+
+func TestRestartableBackupItemActionExecuteRetry(t *testing.T) {
+	b := new(v1.Backup)
+	item := &unstructured.Unstructured{Object: map[string]interface{}{"color": "blue"}}
+	updated := &unstructured.Unstructured{Object: map[string]interface{}{"color": "green"}}
+	unavailable := status.Error(codes.Unavailable, "error reading from server: EOF")
+
+	tests := []struct {
+		name          string
+		resetErr      error
+		retryErr      error
+		expected      runtime.Unstructured
+		expectedError string
+	}{
+		{
+			name:     "item is retried after the plugin process is restarted",
+			expected: updated,
+		},
+		{
+			name:          "error restarting the plugin process",
+			resetErr:      errors.New("reset error"),
+			expectedError: "reset error",
+		},
+		{
+			name:          "plugin process crashes again",
+			retryErr:      unavailable,
+			expectedError: unavailable.Error(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := new(restartabletest.MockRestartableProcess)
+			p.Test(t)
+			defer p.AssertExpectations(t)
+
+			name := "pod"
+			key := process.KindAndName{Kind: common.PluginKindBackupItemAction, Name: name}
+
+			crashed := new(mocks.BackupItemAction)
+			crashed.Test(t)
+			defer crashed.AssertExpectations(t)
+			crashed.On("Execute", item, b).Return(nil, nil, unavailable)
+
+			p.On("ResetIfNeeded").Return(nil)
+			p.On("GetByKindAndName", key).Return(crashed, nil).Once()
+			p.On("ResetIfUnhealthy").Return(tc.resetErr)
+
+			if tc.resetErr == nil {
+				restarted := new(mocks.BackupItemAction)
+				restarted.Test(t)
+				defer restarted.AssertExpectations(t)
+				if tc.retryErr != nil {
+					restarted.On("Execute", item, b).Return(nil, nil, tc.retryErr)
+				} else {
+					restarted.On("Execute", item, b).Return(updated, nil, nil)
+				}
+				p.On("GetByKindAndName", key).Return(restarted, nil).Once()
+			}
+
+			r := NewRestartableBackupItemAction(name, p)
+			actual, _, err := r.Execute(item, b)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -44,6 +44,16 @@ func AdaptedBackupItemActions() []AdaptedBackupItemAction {
 				return NewRestartableBackupItemAction(name, restartableProcess)
 			},
 		},
+		{
+			// v3 backup item actions implement the v2 API, only the items are streamed in chunks
+			Kind: common.PluginKindBackupItemActionV3,
+			GetRestartable: func(name string, restartableProcess process.RestartableProcess) biav2.BackupItemAction {
+				return &RestartableBackupItemAction{
+					Key:                 process.KindAndName{Kind: common.PluginKindBackupItemActionV3, Name: name},
+					SharedPluginProcess: restartableProcess,
+				}
+			},
+		},
 		{
 			Kind: common.PluginKindBackupItemAction,
 			GetRestartable: func(name string, restartableProcess process.RestartableProcess) biav2.BackupItemAction {
@@ -111,13 +121,27 @@ func (r *RestartableBackupItemAction) AppliesTo() (velero.ResourceSelector, erro
 	return delegate.AppliesTo()
 }
 
-// Execute restarts the plugin's process if needed, then delegates the call.
+// Execute restarts the plugin's process if needed, then delegates the call. The call is retried
+// once if the plugin process crashed while executing the item.
 func (r *RestartableBackupItemAction) Execute(item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, string, []velero.ResourceIdentifier, error) {
 	delegate, err := r.getDelegate()
 	if err != nil {
 		return nil, nil, "", nil, err
 	}
 
+	updatedItem, additionalItems, operationID, postOperationItems, err := delegate.Execute(item, backup)
+	if !process.IsUnavailable(err) {
+		return updatedItem, additionalItems, operationID, postOperationItems, err
+	}
+
+	// the plugin process crashed while executing the item, restart it and retry the item
+	if err := r.SharedPluginProcess.ResetIfUnhealthy(); err != nil {
+		return nil, nil, "", nil, err
+	}
+	if delegate, err = r.getBackupItemAction(); err != nil {
+		return nil, nil, "", nil, err
+	}
+
 	return delegate.Execute(item, backup)
 }
 
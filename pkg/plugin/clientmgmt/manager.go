@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
 	riav1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/restoreitemaction/v1"
 	riav2cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/restoreitemaction/v2"
+	riav3cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/restoreitemaction/v3"
 	vsv1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/volumesnapshotter/v1"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
@@ -36,6 +38,7 @@ import (
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
 	riav1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v1"
 	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2"
+	riav3 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v3"
 	vsv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/volumesnapshotter/v1"
 )
 
@@ -71,6 +74,12 @@ type Manager interface {
 	// GetRestoreItemActionV2 returns the restore item action plugin for name.
 	GetRestoreItemActionV2(name string) (riav2.RestoreItemAction, error)
 
+	// GetRestoreItemActionsV3 returns all v3 restore item action plugins.
+	GetRestoreItemActionsV3() ([]riav3.RestoreItemAction, error)
+
+	// GetRestoreItemActionV3 returns the v3 restore item action plugin for name.
+	GetRestoreItemActionV3(name string) (riav3.RestoreItemAction, error)
+
 	// GetDeleteItemActions returns all delete item action plugins.
 	GetDeleteItemActions() ([]velero.DeleteItemAction, error)
 
@@ -97,14 +106,15 @@ type manager struct {
 	restartableProcesses map[string]process.RestartableProcess
 }
 
-// NewManager constructs a manager for getting plugins.
-func NewManager(logger logrus.FieldLogger, level logrus.Level, registry process.Registry) Manager {
+// NewManager constructs a manager for getting plugins. The calls to the item action plugins time
+// out after callTimeout if it's set.
+func NewManager(logger logrus.FieldLogger, level logrus.Level, registry process.Registry, callTimeout time.Duration) Manager {
 	return &manager{
 		logger:   logger,
 		logLevel: level,
 		registry: registry,
 
-		restartableProcessFactory: process.NewRestartableProcessFactory(),
+		restartableProcessFactory: process.NewRestartableProcessFactory(callTimeout),
 
 		restartableProcesses: make(map[string]process.RestartableProcess),
 	}
@@ -341,6 +351,39 @@ func (m *manager) GetRestoreItemActionV2(name string) (riav2.RestoreItemAction,
 	return nil, fmt.Errorf("unable to get valid RestoreItemActionV2 for %q", name)
 }
 
+// GetRestoreItemActionsV3 returns all v3 restore item actions as restartableRestoreItemActions.
+func (m *manager) GetRestoreItemActionsV3() ([]riav3.RestoreItemAction, error) {
+	list := m.registry.List(common.PluginKindRestoreItemActionV3)
+
+	actions := make([]riav3.RestoreItemAction, 0, len(list))
+
+	for i := range list {
+		id := list[i]
+
+		r, err := m.GetRestoreItemActionV3(id.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		actions = append(actions, r)
+	}
+
+	return actions, nil
+}
+
+// GetRestoreItemActionV3 returns a v3 restartableRestoreItemAction for name. Unlike v2, the older
+// restore item actions aren't adapted since they can't finalize the restored items.
+func (m *manager) GetRestoreItemActionV3(name string) (riav3.RestoreItemAction, error) {
+	name = sanitizeName(name)
+
+	restartableProcess, err := m.getRestartableProcess(common.PluginKindRestoreItemActionV3, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return riav3cli.NewRestartableRestoreItemAction(name, restartableProcess), nil
+}
+
 // GetDeleteItemActions returns all delete item actions as restartableDeleteItemActions.
 func (m *manager) GetDeleteItemActions() ([]velero.DeleteItemAction, error) {
 	list := m.registry.List(common.PluginKindDeleteItemAction)
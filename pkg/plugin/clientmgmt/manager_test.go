@@ -32,6 +32,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
 	riav1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/restoreitemaction/v1"
 	riav2cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/restoreitemaction/v2"
+	riav3cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/restoreitemaction/v3"
 	vsv1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/volumesnapshotter/v1"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
@@ -72,7 +73,7 @@ func TestNewManager(t *testing.T) {
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(logger, logLevel, registry, 0).(*manager)
 	assert.Equal(t, logger, m.logger)
 	assert.Equal(t, logLevel, m.logLevel)
 	assert.Equal(t, registry, m.registry)
@@ -100,7 +101,7 @@ func TestGetRestartableProcess(t *testing.T) {
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(logger, logLevel, registry, 0).(*manager)
 	factory := &mockRestartableProcessFactory{}
 	defer factory.AssertExpectations(t)
 	m.restartableProcessFactory = factory
@@ -146,7 +147,7 @@ func TestCleanupClients(t *testing.T) {
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(logger, logLevel, registry, 0).(*manager)
 
 	for i := 0; i < 5; i++ {
 		rp := &restartabletest.MockRestartableProcess{}
@@ -226,6 +227,39 @@ func TestGetBackupItemActionV2(t *testing.T) {
 	)
 }
 
+func TestGetBackupItemActionV2FromV3(t *testing.T) {
+	logger := test.NewLogger()
+	logLevel := logrus.InfoLevel
+
+	registry := &mockRegistry{}
+	defer registry.AssertExpectations(t)
+
+	m := NewManager(logger, logLevel, registry, 0).(*manager)
+	factory := &mockRestartableProcessFactory{}
+	defer factory.AssertExpectations(t)
+	m.restartableProcessFactory = factory
+
+	name := "velero.io/configmap"
+	pluginID := framework.PluginIdentifier{
+		Command: "/command",
+		Kind:    common.PluginKindBackupItemActionV3,
+		Name:    name,
+	}
+	registry.On("Get", common.PluginKindBackupItemActionV2, name).Return(framework.PluginIdentifier{}, &process.PluginNotFoundError{})
+	registry.On("Get", common.PluginKindBackupItemActionV3, name).Return(pluginID, nil)
+
+	restartableProcess := &restartabletest.MockRestartableProcess{}
+	defer restartableProcess.AssertExpectations(t)
+	factory.On("NewRestartableProcess", pluginID.Command, logger, logLevel).Return(restartableProcess, nil)
+
+	actual, err := m.GetBackupItemActionV2(name)
+	require.NoError(t, err)
+	assert.Equal(t, &biav2cli.RestartableBackupItemAction{
+		Key:                 process.KindAndName{Kind: common.PluginKindBackupItemActionV3, Name: name},
+		SharedPluginProcess: restartableProcess,
+	}, actual)
+}
+
 func TestGetRestoreItemAction(t *testing.T) {
 	getPluginTest(t,
 		common.PluginKindRestoreItemAction,
@@ -260,6 +294,53 @@ func TestGetRestoreItemActionV2(t *testing.T) {
 	)
 }
 
+func TestGetRestoreItemActionV3(t *testing.T) {
+	getPluginTest(t,
+		common.PluginKindRestoreItemActionV3,
+		"velero.io/pod",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetRestoreItemActionV3(name)
+		},
+		func(name string, sharedPluginProcess process.RestartableProcess) interface{} {
+			return riav3cli.NewRestartableRestoreItemAction(name, sharedPluginProcess)
+		},
+		false,
+	)
+}
+
+func TestGetRestoreItemActionV2FromV3(t *testing.T) {
+	logger := test.NewLogger()
+	logLevel := logrus.InfoLevel
+
+	registry := &mockRegistry{}
+	defer registry.AssertExpectations(t)
+
+	m := NewManager(logger, logLevel, registry, 0).(*manager)
+	factory := &mockRestartableProcessFactory{}
+	defer factory.AssertExpectations(t)
+	m.restartableProcessFactory = factory
+
+	name := "velero.io/pod"
+	pluginID := framework.PluginIdentifier{
+		Command: "/command",
+		Kind:    common.PluginKindRestoreItemActionV3,
+		Name:    name,
+	}
+	registry.On("Get", common.PluginKindRestoreItemActionV2, name).Return(framework.PluginIdentifier{}, &process.PluginNotFoundError{})
+	registry.On("Get", common.PluginKindRestoreItemActionV3, name).Return(pluginID, nil)
+
+	restartableProcess := &restartabletest.MockRestartableProcess{}
+	defer restartableProcess.AssertExpectations(t)
+	factory.On("NewRestartableProcess", pluginID.Command, logger, logLevel).Return(restartableProcess, nil)
+
+	actual, err := m.GetRestoreItemActionV2(name)
+	require.NoError(t, err)
+	assert.Equal(t, &riav2cli.RestartableRestoreItemAction{
+		Key:                 process.KindAndName{Kind: common.PluginKindRestoreItemActionV3, Name: name},
+		SharedPluginProcess: restartableProcess,
+	}, actual)
+}
+
 func getPluginTest(
 	t *testing.T,
 	kind common.PluginKind,
@@ -274,7 +355,7 @@ func getPluginTest(
 	registry := &mockRegistry{}
 	defer registry.AssertExpectations(t)
 
-	m := NewManager(logger, logLevel, registry).(*manager)
+	m := NewManager(logger, logLevel, registry, 0).(*manager)
 	factory := &mockRestartableProcessFactory{}
 	defer factory.AssertExpectations(t)
 	m.restartableProcessFactory = factory
@@ -341,7 +422,7 @@ func TestGetBackupItemActions(t *testing.T) {
 			registry := &mockRegistry{}
 			defer registry.AssertExpectations(t)
 
-			m := NewManager(logger, logLevel, registry).(*manager)
+			m := NewManager(logger, logLevel, registry, 0).(*manager)
 			factory := &mockRestartableProcessFactory{}
 			defer factory.AssertExpectations(t)
 			m.restartableProcessFactory = factory
@@ -433,7 +514,7 @@ func TestGetBackupItemActionsV2(t *testing.T) {
 			registry := &mockRegistry{}
 			defer registry.AssertExpectations(t)
 
-			m := NewManager(logger, logLevel, registry).(*manager)
+			m := NewManager(logger, logLevel, registry, 0).(*manager)
 			factory := &mockRestartableProcessFactory{}
 			defer factory.AssertExpectations(t)
 			m.restartableProcessFactory = factory
@@ -525,7 +606,7 @@ func TestGetRestoreItemActions(t *testing.T) {
 			registry := &mockRegistry{}
 			defer registry.AssertExpectations(t)
 
-			m := NewManager(logger, logLevel, registry).(*manager)
+			m := NewManager(logger, logLevel, registry, 0).(*manager)
 			factory := &mockRestartableProcessFactory{}
 			defer factory.AssertExpectations(t)
 			m.restartableProcessFactory = factory
@@ -617,7 +698,7 @@ func TestGetRestoreItemActionsV2(t *testing.T) {
 			registry := &mockRegistry{}
 			defer registry.AssertExpectations(t)
 
-			m := NewManager(logger, logLevel, registry).(*manager)
+			m := NewManager(logger, logLevel, registry, 0).(*manager)
 			factory := &mockRestartableProcessFactory{}
 			defer factory.AssertExpectations(t)
 			m.restartableProcessFactory = factory
@@ -726,7 +807,7 @@ func TestGetDeleteItemActions(t *testing.T) {
 			registry := &mockRegistry{}
 			defer registry.AssertExpectations(t)
 
-			m := NewManager(logger, logLevel, registry).(*manager)
+			m := NewManager(logger, logLevel, registry, 0).(*manager)
 			factory := &mockRestartableProcessFactory{}
 			defer factory.AssertExpectations(t)
 			m.restartableProcessFactory = factory
@@ -20,6 +20,7 @@ package process
 import (
 	"os"
 	"os/exec"
+	"time"
 
 	hclog "github.com/hashicorp/go-hclog"
 	hcplugin "github.com/hashicorp/go-plugin"
@@ -28,8 +29,10 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/backupitemaction/v2"
+	biav3 "github.com/vmware-tanzu/velero/pkg/plugin/framework/backupitemaction/v3"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/restoreitemaction/v2"
+	riav3 "github.com/vmware-tanzu/velero/pkg/plugin/framework/restoreitemaction/v3"
 )
 
 // clientBuilder builds go-plugin Clients.
@@ -38,15 +41,18 @@ type clientBuilder struct {
 	commandArgs  []string
 	clientLogger logrus.FieldLogger
 	pluginLogger hclog.Logger
+	// callTimeout is the timeout of the calls to the item action plugins
+	callTimeout time.Duration
 }
 
 // newClientBuilder returns a new clientBuilder with commandName to name. If the command matches the currently running
 // process (i.e. velero), this also sets commandArgs to the internal Velero command to run plugins.
-func newClientBuilder(command string, logger logrus.FieldLogger, logLevel logrus.Level) *clientBuilder {
+func newClientBuilder(command string, logger logrus.FieldLogger, logLevel logrus.Level, callTimeout time.Duration) *clientBuilder {
 	b := &clientBuilder{
 		commandName:  command,
 		clientLogger: logger,
 		pluginLogger: newLogrusAdapter(logger, logLevel),
+		callTimeout:  callTimeout,
 	}
 	if command == os.Args[0] {
 		// For plugins compiled into the velero executable, we need to run "velero run-plugins"
@@ -70,13 +76,15 @@ func (b *clientBuilder) clientConfig() *hcplugin.ClientConfig {
 		HandshakeConfig:  framework.Handshake(),
 		AllowedProtocols: []hcplugin.Protocol{hcplugin.ProtocolGRPC},
 		Plugins: map[string]hcplugin.Plugin{
-			string(common.PluginKindBackupItemAction):    framework.NewBackupItemActionPlugin(common.ClientLogger(b.clientLogger)),
-			string(common.PluginKindBackupItemActionV2):  biav2.NewBackupItemActionPlugin(common.ClientLogger(b.clientLogger)),
+			string(common.PluginKindBackupItemAction):    framework.NewBackupItemActionPlugin(common.ClientLogger(b.clientLogger), common.CallTimeout(b.callTimeout)),
+			string(common.PluginKindBackupItemActionV2):  biav2.NewBackupItemActionPlugin(common.ClientLogger(b.clientLogger), common.CallTimeout(b.callTimeout)),
+			string(common.PluginKindBackupItemActionV3):  biav3.NewBackupItemActionPlugin(common.ClientLogger(b.clientLogger), common.CallTimeout(b.callTimeout)),
 			string(common.PluginKindVolumeSnapshotter):   framework.NewVolumeSnapshotterPlugin(common.ClientLogger(b.clientLogger)),
 			string(common.PluginKindObjectStore):         framework.NewObjectStorePlugin(common.ClientLogger(b.clientLogger)),
 			string(common.PluginKindPluginLister):        &framework.PluginListerPlugin{},
-			string(common.PluginKindRestoreItemAction):   framework.NewRestoreItemActionPlugin(common.ClientLogger(b.clientLogger)),
-			string(common.PluginKindRestoreItemActionV2): riav2.NewRestoreItemActionPlugin(common.ClientLogger(b.clientLogger)),
+			string(common.PluginKindRestoreItemAction):   framework.NewRestoreItemActionPlugin(common.ClientLogger(b.clientLogger), common.CallTimeout(b.callTimeout)),
+			string(common.PluginKindRestoreItemActionV2): riav2.NewRestoreItemActionPlugin(common.ClientLogger(b.clientLogger), common.CallTimeout(b.callTimeout)),
+			string(common.PluginKindRestoreItemActionV3): riav3.NewRestoreItemActionPlugin(common.ClientLogger(b.clientLogger), common.CallTimeout(b.callTimeout)),
 			string(common.PluginKindDeleteItemAction):    framework.NewDeleteItemActionPlugin(common.ClientLogger(b.clientLogger)),
 		},
 		Logger: b.pluginLogger,
@@ -20,6 +20,7 @@ import (
 	"os"
 	"os/exec"
 	"testing"
+	"time"
 
 	hcplugin "github.com/hashicorp/go-plugin"
 	"github.com/sirupsen/logrus"
@@ -28,26 +29,28 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/backupitemaction/v2"
+	biav3 "github.com/vmware-tanzu/velero/pkg/plugin/framework/backupitemaction/v3"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/restoreitemaction/v2"
+	riav3 "github.com/vmware-tanzu/velero/pkg/plugin/framework/restoreitemaction/v3"
 	"github.com/vmware-tanzu/velero/pkg/test"
 )
 
 func TestNewClientBuilder(t *testing.T) {
 	logger := test.NewLogger()
 	logLevel := logrus.InfoLevel
-	cb := newClientBuilder("velero", logger, logLevel)
+	cb := newClientBuilder("velero", logger, logLevel, 0)
 	assert.Equal(t, cb.commandName, "velero")
 	assert.Equal(t, []string{"--log-level", "info"}, cb.commandArgs)
 	assert.Equal(t, newLogrusAdapter(logger, logLevel), cb.pluginLogger)
 
-	cb = newClientBuilder(os.Args[0], logger, logLevel)
+	cb = newClientBuilder(os.Args[0], logger, logLevel, 0)
 	assert.Equal(t, cb.commandName, os.Args[0])
 	assert.Equal(t, []string{"run-plugins", "--log-level", "info"}, cb.commandArgs)
 	assert.Equal(t, newLogrusAdapter(logger, logLevel), cb.pluginLogger)
 
 	features.NewFeatureFlagSet("feature1", "feature2")
-	cb = newClientBuilder(os.Args[0], logger, logLevel)
+	cb = newClientBuilder(os.Args[0], logger, logLevel, 0)
 	assert.Equal(t, []string{"run-plugins", "--log-level", "info", "--features", "feature1,feature2"}, cb.commandArgs)
 	// Clear the features list in case other tests run in the same process.
 	features.NewFeatureFlagSet()
@@ -57,19 +60,21 @@ func TestNewClientBuilder(t *testing.T) {
 func TestClientConfig(t *testing.T) {
 	logger := test.NewLogger()
 	logLevel := logrus.InfoLevel
-	cb := newClientBuilder("velero", logger, logLevel)
+	cb := newClientBuilder("velero", logger, logLevel, time.Minute)
 
 	expected := &hcplugin.ClientConfig{
 		HandshakeConfig:  framework.Handshake(),
 		AllowedProtocols: []hcplugin.Protocol{hcplugin.ProtocolGRPC},
 		Plugins: map[string]hcplugin.Plugin{
-			string(common.PluginKindBackupItemAction):    framework.NewBackupItemActionPlugin(common.ClientLogger(logger)),
-			string(common.PluginKindBackupItemActionV2):  biav2.NewBackupItemActionPlugin(common.ClientLogger(logger)),
+			string(common.PluginKindBackupItemAction):    framework.NewBackupItemActionPlugin(common.ClientLogger(logger), common.CallTimeout(time.Minute)),
+			string(common.PluginKindBackupItemActionV2):  biav2.NewBackupItemActionPlugin(common.ClientLogger(logger), common.CallTimeout(time.Minute)),
+			string(common.PluginKindBackupItemActionV3):  biav3.NewBackupItemActionPlugin(common.ClientLogger(logger), common.CallTimeout(time.Minute)),
 			string(common.PluginKindVolumeSnapshotter):   framework.NewVolumeSnapshotterPlugin(common.ClientLogger(logger)),
 			string(common.PluginKindObjectStore):         framework.NewObjectStorePlugin(common.ClientLogger(logger)),
 			string(common.PluginKindPluginLister):        &framework.PluginListerPlugin{},
-			string(common.PluginKindRestoreItemAction):   framework.NewRestoreItemActionPlugin(common.ClientLogger(logger)),
-			string(common.PluginKindRestoreItemActionV2): riav2.NewRestoreItemActionPlugin(common.ClientLogger(logger)),
+			string(common.PluginKindRestoreItemAction):   framework.NewRestoreItemActionPlugin(common.ClientLogger(logger), common.CallTimeout(time.Minute)),
+			string(common.PluginKindRestoreItemActionV2): riav2.NewRestoreItemActionPlugin(common.ClientLogger(logger), common.CallTimeout(time.Minute)),
+			string(common.PluginKindRestoreItemActionV3): riav3.NewRestoreItemActionPlugin(common.ClientLogger(logger), common.CallTimeout(time.Minute)),
 			string(common.PluginKindDeleteItemAction):    framework.NewDeleteItemActionPlugin(common.ClientLogger(logger)),
 		},
 		Logger: cb.pluginLogger,
@@ -18,6 +18,7 @@ package process
 
 import (
 	"strings"
+	"time"
 
 	plugin "github.com/hashicorp/go-plugin"
 	"github.com/pkg/errors"
@@ -27,7 +28,7 @@ import (
 )
 
 type Factory interface {
-	newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error)
+	newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level, callTimeout time.Duration) (Process, error)
 }
 
 type processFactory struct {
@@ -37,13 +38,14 @@ func newProcessFactory() Factory {
 	return &processFactory{}
 }
 
-func (pf *processFactory) newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error) {
-	return newProcess(command, logger, logLevel)
+func (pf *processFactory) newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level, callTimeout time.Duration) (Process, error) {
+	return newProcess(command, logger, logLevel, callTimeout)
 }
 
 type Process interface {
 	dispense(key KindAndName) (interface{}, error)
 	exited() bool
+	ping() error
 	kill()
 }
 
@@ -52,8 +54,8 @@ type process struct {
 	protocolClient plugin.ClientProtocol
 }
 
-func newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error) {
-	builder := newClientBuilder(command, logger.WithField("cmd", command), logLevel)
+func newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level, callTimeout time.Duration) (Process, error) {
+	builder := newClientBuilder(command, logger.WithField("cmd", command), logLevel, callTimeout)
 
 	// This creates a new go-plugin Client that has its own unique exec.Cmd for launching the plugin process.
 	client := builder.client()
@@ -148,6 +150,11 @@ func (r *process) exited() bool {
 	return r.client.Exited()
 }
 
+// ping health checks the plugin process through the gRPC health service.
+func (r *process) ping() error {
+	return r.protocolClient.Ping()
+}
+
 func (r *process) kill() {
 	r.client.Kill()
 }
@@ -186,7 +186,7 @@ func executable(info os.FileInfo) bool {
 
 // listPlugins executes command, queries it for registered plugins, and returns the list of PluginIdentifiers.
 func (r *registry) listPlugins(command string) ([]framework.PluginIdentifier, error) {
-	process, err := r.processFactory.newProcess(command, r.logger, r.logLevel)
+	process, err := r.processFactory.newProcess(command, r.logger, r.logLevel, 0)
 	if err != nil {
 		return nil, err
 	}
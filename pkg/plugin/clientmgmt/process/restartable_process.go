@@ -18,41 +18,56 @@ package process
 
 import (
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// healthCheckInterval is how often the plugin process is health checked.
+const healthCheckInterval = 30 * time.Second
+
 type RestartableProcessFactory interface {
 	NewRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error)
 }
 
 type restartableProcessFactory struct {
+	callTimeout time.Duration
 }
 
-func NewRestartableProcessFactory() RestartableProcessFactory {
-	return &restartableProcessFactory{}
+// NewRestartableProcessFactory returns a factory of the restartable processes, the calls to the
+// item action plugins in the processes time out after callTimeout if it's set.
+func NewRestartableProcessFactory(callTimeout time.Duration) RestartableProcessFactory {
+	return &restartableProcessFactory{callTimeout: callTimeout}
 }
 
 func (rpf *restartableProcessFactory) NewRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
-	return newRestartableProcess(command, logger, logLevel)
+	return newRestartableProcess(command, logger, logLevel, rpf.callTimeout)
 }
 
 type RestartableProcess interface {
 	AddReinitializer(key KindAndName, r Reinitializer)
 	Reset() error
 	ResetIfNeeded() error
+	ResetIfUnhealthy() error
 	GetByKindAndName(key KindAndName) (interface{}, error)
 	Stop()
 }
 
 // restartableProcess encapsulates the lifecycle for all plugins contained in a single executable file. It is able
 // to restart a plugin process if it is terminated for any reason. If this happens, all plugins are reinitialized using
-// the original configuration data.
+// the original configuration data. The plugin process is health checked periodically, so it's also restarted if it
+// stops responding.
 type restartableProcess struct {
-	command  string
-	logger   logrus.FieldLogger
-	logLevel logrus.Level
+	command        string
+	logger         logrus.FieldLogger
+	logLevel       logrus.Level
+	callTimeout    time.Duration
+	processFactory Factory
+	stopCh         chan struct{}
+	stopOnce       sync.Once
 
 	// lock guards all of the fields below
 	lock           sync.RWMutex
@@ -69,17 +84,23 @@ type Reinitializer interface {
 }
 
 // newRestartableProcess creates a new restartableProcess for the given command and options.
-func newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
+func newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level, callTimeout time.Duration) (RestartableProcess, error) {
 	p := &restartableProcess{
 		command:        command,
 		logger:         logger,
 		logLevel:       logLevel,
+		callTimeout:    callTimeout,
+		processFactory: newProcessFactory(),
+		stopCh:         make(chan struct{}),
 		plugins:        make(map[KindAndName]interface{}),
 		reinitializers: make(map[KindAndName]Reinitializer),
 	}
 
 	// This launches the process
 	err := p.Reset()
+	if err == nil {
+		go p.runHealthChecks(healthCheckInterval)
+	}
 
 	return p, err
 }
@@ -109,7 +130,7 @@ func (p *restartableProcess) resetLH() error {
 		return errors.Errorf("unable to restart plugin process: exceeded maximum number of reset failures")
 	}
 
-	process, err := newProcess(p.command, p.logger, p.logLevel)
+	process, err := p.processFactory.newProcess(p.command, p.logger, p.logLevel, p.callTimeout)
 	if err != nil {
 		p.resetFailures++
 		return err
@@ -159,6 +180,44 @@ func (p *restartableProcess) ResetIfNeeded() error {
 	return nil
 }
 
+// ResetIfUnhealthy checks if the plugin process has exited or doesn't respond to the health check,
+// and resets p if so. The unresponsive process is killed before it's reset.
+func (p *restartableProcess) ResetIfUnhealthy() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.process.exited() {
+		p.logger.Info("Plugin process exited - restarting.")
+		return p.resetLH()
+	}
+
+	if err := p.process.ping(); err != nil {
+		p.logger.WithError(err).Warn("Plugin process failed the health check - restarting.")
+		p.process.kill()
+		return p.resetLH()
+	}
+
+	return nil
+}
+
+// runHealthChecks health checks the plugin process periodically until p is stopped, so that a
+// crashed or hung plugin process is restarted before the next call to it.
+func (p *restartableProcess) runHealthChecks(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stopCh:
+			return
+		case <-ticker.C:
+			if err := p.ResetIfUnhealthy(); err != nil {
+				p.logger.WithError(err).Error("Error restarting unhealthy plugin process")
+			}
+		}
+	}
+}
+
 // GetByKindAndName acquires the lock and calls getByKindAndNameLH.
 func (p *restartableProcess) GetByKindAndName(key KindAndName) (interface{}, error) {
 	p.lock.Lock()
@@ -185,7 +244,19 @@ func (p *restartableProcess) getByKindAndNameLH(key KindAndName) (interface{}, e
 
 // stop terminates the plugin process.
 func (p *restartableProcess) Stop() {
+	p.stopOnce.Do(func() { close(p.stopCh) })
+
 	p.lock.Lock()
 	p.process.kill()
 	p.lock.Unlock()
 }
+
+// IsUnavailable returns true if the error returned by a plugin call is caused by the plugin
+// process being unavailable, e.g. the process crashed in the middle of the call.
+func IsUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return status.Code(errors.Cause(err)) == codes.Unavailable
+}
@@ -0,0 +1,198 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package process
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type mockProcess struct {
+	mock.Mock
+}
+
+func (p *mockProcess) dispense(key KindAndName) (interface{}, error) {
+	args := p.Called(key)
+	return args.Get(0), args.Error(1)
+}
+
+func (p *mockProcess) exited() bool {
+	args := p.Called()
+	return args.Bool(0)
+}
+
+func (p *mockProcess) ping() error {
+	args := p.Called()
+	return args.Error(0)
+}
+
+func (p *mockProcess) kill() {
+	p.Called()
+}
+
+type mockProcessFactory struct {
+	mock.Mock
+}
+
+func (f *mockProcessFactory) newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level, callTimeout time.Duration) (Process, error) {
+	args := f.Called(command)
+	return args.Get(0).(Process), args.Error(1)
+}
+
+type mockReinitializer struct {
+	mock.Mock
+}
+
+func (r *mockReinitializer) Reinitialize(dispensed interface{}) error {
+	args := r.Called(dispensed)
+	return args.Error(0)
+}
+
+func TestResetIfUnhealthy(t *testing.T) {
+	key := KindAndName{Kind: common.PluginKindBackupItemAction, Name: "pod"}
+
+	tests := []struct {
+		name          string
+		exited        bool
+		pingErr       error
+		expectRestart bool
+	}{
+		{
+			name: "healthy process isn't restarted",
+		},
+		{
+			name:          "exited process is restarted",
+			exited:        true,
+			expectRestart: true,
+		},
+		{
+			name:          "unresponsive process is killed and restarted",
+			pingErr:       errors.New("rpc error: code = Unavailable desc = connection refused"),
+			expectRestart: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			current := new(mockProcess)
+			current.Test(t)
+			defer current.AssertExpectations(t)
+
+			current.On("exited").Return(tc.exited)
+			if !tc.exited {
+				current.On("ping").Return(tc.pingErr)
+			}
+			if tc.pingErr != nil {
+				current.On("kill").Return()
+			}
+
+			factory := new(mockProcessFactory)
+			factory.Test(t)
+			defer factory.AssertExpectations(t)
+
+			reinitializer := new(mockReinitializer)
+			reinitializer.Test(t)
+			defer reinitializer.AssertExpectations(t)
+
+			if tc.expectRestart {
+				restarted := new(mockProcess)
+				restarted.On("dispense", key).Return("redispensed", nil)
+				factory.On("newProcess", "velero-plugins").Return(restarted, nil)
+				reinitializer.On("Reinitialize", "redispensed").Return(nil)
+			}
+
+			p := &restartableProcess{
+				command:        "velero-plugins",
+				logger:         test.NewLogger(),
+				processFactory: factory,
+				stopCh:         make(chan struct{}),
+				process:        current,
+				plugins:        map[KindAndName]interface{}{key: "dispensed"},
+				reinitializers: map[KindAndName]Reinitializer{key: reinitializer},
+			}
+
+			require.NoError(t, p.ResetIfUnhealthy())
+
+			dispensed, err := p.GetByKindAndName(key)
+			require.NoError(t, err)
+			if tc.expectRestart {
+				assert.Equal(t, "redispensed", dispensed)
+			} else {
+				assert.Equal(t, "dispensed", dispensed)
+			}
+		})
+	}
+}
+
+func TestRunHealthChecks(t *testing.T) {
+	current := new(mockProcess)
+	current.On("exited").Return(false)
+	current.On("ping").Return(nil)
+	current.On("kill").Return()
+
+	p := &restartableProcess{
+		logger:  test.NewLogger(),
+		stopCh:  make(chan struct{}),
+		process: current,
+		plugins: map[KindAndName]interface{}{},
+	}
+
+	done := make(chan struct{})
+	go func() {
+		p.runHealthChecks(time.Millisecond)
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool {
+		p.lock.Lock()
+		defer p.lock.Unlock()
+		for _, call := range current.Calls {
+			if call.Method == "ping" {
+				return true
+			}
+		}
+		return false
+	}, time.Second, time.Millisecond)
+
+	// the health checks stop with the process
+	p.Stop()
+	p.Stop()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("health checks aren't stopped")
+	}
+}
+
+func TestIsUnavailable(t *testing.T) {
+	assert.False(t, IsUnavailable(nil))
+	assert.False(t, IsUnavailable(errors.New("plugin error")))
+	assert.False(t, IsUnavailable(status.Error(codes.Unknown, "plugin error")))
+	assert.True(t, IsUnavailable(status.Error(codes.Unavailable, "error reading from server: EOF")))
+	assert.True(t, IsUnavailable(errors.WithStack(status.Error(codes.Unavailable, "transport is closing"))))
+}
@@ -18,6 +18,8 @@ package clientmgmt
 
 import (
 	"io"
+	"os"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -37,6 +39,13 @@ type restartableObjectStore struct {
 	// config contains the data used to initialize the plugin. It is used to reinitialize the plugin in the event its
 	// sharedPluginProcess gets restarted.
 	config map[string]string
+
+	// lock guards credentialsModTime
+	lock sync.Mutex
+	// credentialsModTime is the modification time of the credentials file in config when the plugin was initialized.
+	// The plugin is reinitialized once the file is modified, so that the rotated credentials are used without
+	// restarting the server.
+	credentialsModTime time.Time
 }
 
 // NewRestartableObjectStore returns a new restartableObjectStore.
@@ -80,12 +89,61 @@ func (r *restartableObjectStore) getObjectStore() (velero.ObjectStore, error) {
 }
 
 // getDelegate restarts the plugin process (if needed) and returns the object store for this restartableObjectStore.
+// The object store is reinitialized if its credentials file is modified since it was initialized.
 func (r *restartableObjectStore) getDelegate() (velero.ObjectStore, error) {
 	if err := r.sharedPluginProcess.ResetIfNeeded(); err != nil {
 		return nil, err
 	}
 
-	return r.getObjectStore()
+	objectStore, err := r.getObjectStore()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.reinitializeIfCredentialsRotated(objectStore); err != nil {
+		return nil, err
+	}
+
+	return objectStore, nil
+}
+
+// reinitializeIfCredentialsRotated reinitializes objectStore with config if the credentials file in config is
+// modified since the last initialization.
+func (r *restartableObjectStore) reinitializeIfCredentialsRotated(objectStore velero.ObjectStore) error {
+	if r.config == nil {
+		return nil
+	}
+
+	modTime, ok := credentialsFileModTime(r.config)
+	if !ok {
+		return nil
+	}
+
+	r.lock.Lock()
+	rotated := !modTime.Equal(r.credentialsModTime)
+	r.lock.Unlock()
+
+	if !rotated {
+		return nil
+	}
+
+	return errors.Wrap(r.init(objectStore, r.config), "error reinitializing object store with the rotated credentials")
+}
+
+// credentialsFileModTime returns the modification time of the credentials file in config, false is returned if config
+// doesn't have a credentials file or the file can't be read.
+func credentialsFileModTime(config map[string]string) (time.Time, bool) {
+	credentialsFile := config["credentialsFile"]
+	if credentialsFile == "" {
+		return time.Time{}, false
+	}
+
+	info, err := os.Stat(credentialsFile)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return info.ModTime(), true
 }
 
 // Init initializes the object store instance using config. If this is the first invocation, r stores config for future
@@ -109,7 +167,17 @@ func (r *restartableObjectStore) Init(config map[string]string) error {
 // init calls Init on objectStore with config. This is split out from Init() so that both Init() and reinitialize() may
 // call it using a specific ObjectStore.
 func (r *restartableObjectStore) init(objectStore velero.ObjectStore, config map[string]string) error {
-	return objectStore.Init(config)
+	// the modification time is read before the initialization, a rotation in between is caught by the next call
+	modTime, _ := credentialsFileModTime(config)
+	if err := objectStore.Init(config); err != nil {
+		return err
+	}
+
+	r.lock.Lock()
+	r.credentialsModTime = modTime
+	r.lock.Unlock()
+
+	return nil
 }
 
 // PutObject restarts the plugin's process if needed, then delegates the call.
@@ -166,6 +234,20 @@ func (r *restartableObjectStore) DeleteObject(bucket string, key string) error {
 	return delegate.DeleteObject(bucket, key)
 }
 
+// DeleteObjects restarts the plugin's process if needed, then delegates the call if the plugin
+// supports the batch deletion.
+func (r *restartableObjectStore) DeleteObjects(bucket string, keys []string) error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+	batchDeleter, ok := delegate.(velero.BatchObjectDeleter)
+	if !ok {
+		return velero.ErrBatchDeleteNotSupported
+	}
+	return batchDeleter.DeleteObjects(bucket, keys)
+}
+
 // CreateSignedURL restarts the plugin's process if needed, then delegates the call.
 func (r *restartableObjectStore) CreateSignedURL(bucket string, key string, ttl time.Duration) (string, error) {
 	delegate, err := r.getDelegate()
@@ -18,6 +18,8 @@ package clientmgmt
 
 import (
 	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -29,6 +31,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/restartabletest"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 )
 
@@ -185,6 +188,92 @@ func TestRestartableObjectStoreInit(t *testing.T) {
 	assert.EqualError(t, err, "already initialized")
 }
 
+func TestRestartableObjectStoreCredentialsRotation(t *testing.T) {
+	p := new(restartabletest.MockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	credentialsFile := filepath.Join(t.TempDir(), "credentials")
+	require.NoError(t, os.WriteFile(credentialsFile, []byte("key-1"), 0644))
+
+	name := "aws"
+	key := process.KindAndName{Kind: common.PluginKindObjectStore, Name: name}
+	r := &restartableObjectStore{
+		key:                 key,
+		sharedPluginProcess: p,
+	}
+
+	config := map[string]string{
+		"credentialsFile": credentialsFile,
+	}
+	objectStore := new(providermocks.ObjectStore)
+	objectStore.Test(t)
+	defer objectStore.AssertExpectations(t)
+	p.On("ResetIfNeeded").Return(nil)
+	p.On("GetByKindAndName", key).Return(objectStore, nil)
+	objectStore.On("Init", config).Return(nil).Once()
+	require.NoError(t, r.Init(config))
+
+	// the object store isn't reinitialized if the credentials aren't rotated
+	objectStore.On("ObjectExists", "bucket", "key").Return(true, nil)
+	_, err := r.ObjectExists("bucket", "key")
+	require.NoError(t, err)
+
+	// the object store is reinitialized once after the credentials are rotated
+	require.NoError(t, os.WriteFile(credentialsFile, []byte("key-2"), 0644))
+	rotated := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(credentialsFile, rotated, rotated))
+	objectStore.On("Init", config).Return(nil).Once()
+	_, err = r.ObjectExists("bucket", "key")
+	require.NoError(t, err)
+	_, err = r.ObjectExists("bucket", "key")
+	require.NoError(t, err)
+
+	// the reinitialization error is returned
+	rotated = rotated.Add(time.Minute)
+	require.NoError(t, os.Chtimes(credentialsFile, rotated, rotated))
+	objectStore.On("Init", config).Return(errors.Errorf("Init error")).Once()
+	_, err = r.ObjectExists("bucket", "key")
+	assert.EqualError(t, err, "error reinitializing object store with the rotated credentials: Init error")
+}
+
+type batchObjectStore struct {
+	*providermocks.ObjectStore
+	keys []string
+}
+
+func (s *batchObjectStore) DeleteObjects(bucket string, keys []string) error {
+	s.keys = append(s.keys, keys...)
+	return nil
+}
+
+func TestRestartableObjectStoreDeleteObjects(t *testing.T) {
+	key := process.KindAndName{Kind: common.PluginKindObjectStore, Name: "aws"}
+
+	// the plugin doesn't support the batch deletion
+	p := new(restartabletest.MockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+	p.On("ResetIfNeeded").Return(nil)
+	p.On("GetByKindAndName", key).Return(new(providermocks.ObjectStore), nil)
+	r := &restartableObjectStore{
+		key:                 key,
+		sharedPluginProcess: p,
+	}
+	assert.ErrorIs(t, r.DeleteObjects("bucket", []string{"a", "b"}), velero.ErrBatchDeleteNotSupported)
+
+	// the plugin supports the batch deletion
+	p = new(restartabletest.MockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+	objectStore := &batchObjectStore{ObjectStore: new(providermocks.ObjectStore)}
+	p.On("ResetIfNeeded").Return(nil)
+	p.On("GetByKindAndName", key).Return(objectStore, nil)
+	r.sharedPluginProcess = p
+	require.NoError(t, r.DeleteObjects("bucket", []string{"a", "b"}))
+	assert.Equal(t, []string{"a", "b"}, objectStore.keys)
+}
+
 func TestRestartableObjectStoreDelegatedFunctions(t *testing.T) {
 	restartabletest.RunRestartableDelegateTests(
 		t,
@@ -97,12 +97,26 @@ func (r RestartableRestoreItemAction) AppliesTo() (velero.ResourceSelector, erro
 	return delegate.AppliesTo()
 }
 
-// Execute restarts the plugin's process if needed, then delegates the call.
+// Execute restarts the plugin's process if needed, then delegates the call. The call is retried
+// once if the plugin process crashed while executing the item.
 func (r *RestartableRestoreItemAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
 	delegate, err := r.getDelegate()
 	if err != nil {
 		return nil, err
 	}
 
+	output, err := delegate.Execute(input)
+	if !process.IsUnavailable(err) {
+		return output, err
+	}
+
+	// the plugin process crashed while executing the item, restart it and retry the item
+	if err := r.SharedPluginProcess.ResetIfUnhealthy(); err != nil {
+		return nil, err
+	}
+	if delegate, err = r.getRestoreItemAction(); err != nil {
+		return nil, err
+	}
+
 	return delegate.Execute(input)
 }
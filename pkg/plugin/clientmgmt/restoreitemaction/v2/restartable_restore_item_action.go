@@ -43,6 +43,16 @@ func AdaptedRestoreItemActions() []AdaptedRestoreItemAction {
 				return NewRestartableRestoreItemAction(name, restartableProcess)
 			},
 		},
+		{
+			// v3 restore item actions implement the v2 API, the items are finalized separately
+			Kind: common.PluginKindRestoreItemActionV3,
+			GetRestartable: func(name string, restartableProcess process.RestartableProcess) riav2.RestoreItemAction {
+				return &RestartableRestoreItemAction{
+					Key:                 process.KindAndName{Kind: common.PluginKindRestoreItemActionV3, Name: name},
+					SharedPluginProcess: restartableProcess,
+				}
+			},
+		},
 		{
 			Kind: common.PluginKindRestoreItemAction,
 			GetRestartable: func(name string, restartableProcess process.RestartableProcess) riav2.RestoreItemAction {
@@ -110,13 +120,27 @@ func (r RestartableRestoreItemAction) AppliesTo() (velero.ResourceSelector, erro
 	return delegate.AppliesTo()
 }
 
-// Execute restarts the plugin's process if needed, then delegates the call.
+// Execute restarts the plugin's process if needed, then delegates the call. The call is retried
+// once if the plugin process crashed while executing the item.
 func (r *RestartableRestoreItemAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
 	delegate, err := r.getDelegate()
 	if err != nil {
 		return nil, err
 	}
 
+	output, err := delegate.Execute(input)
+	if !process.IsUnavailable(err) {
+		return output, err
+	}
+
+	// the plugin process crashed while executing the item, restart it and retry the item
+	if err := r.SharedPluginProcess.ResetIfUnhealthy(); err != nil {
+		return nil, err
+	}
+	if delegate, err = r.getRestoreItemAction(); err != nil {
+		return nil, err
+	}
+
 	return delegate.Execute(input)
 }
 
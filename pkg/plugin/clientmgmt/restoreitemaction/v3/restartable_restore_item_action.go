@@ -0,0 +1,73 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v3
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
+	riav2cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/restoreitemaction/v2"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	riav3 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v3"
+)
+
+// RestartableRestoreItemAction is a v3 restore item action for a given implementation. The calls of the
+// v2 API are delegated the same way as the v2 RestartableRestoreItemAction does, the plugin process is
+// restarted if needed before each call.
+type RestartableRestoreItemAction struct {
+	riav2cli.RestartableRestoreItemAction
+}
+
+// NewRestartableRestoreItemAction returns a new RestartableRestoreItemAction.
+func NewRestartableRestoreItemAction(name string, sharedPluginProcess process.RestartableProcess) *RestartableRestoreItemAction {
+	return &RestartableRestoreItemAction{
+		RestartableRestoreItemAction: riav2cli.RestartableRestoreItemAction{
+			Key:                 process.KindAndName{Kind: common.PluginKindRestoreItemActionV3, Name: name},
+			SharedPluginProcess: sharedPluginProcess,
+		},
+	}
+}
+
+// getDelegate restarts the plugin process (if needed) and returns the restore item action for this RestartableRestoreItemAction.
+func (r *RestartableRestoreItemAction) getDelegate() (riav3.RestoreItemAction, error) {
+	if err := r.SharedPluginProcess.ResetIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	plugin, err := r.SharedPluginProcess.GetByKindAndName(r.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	restoreItemAction, ok := plugin.(riav3.RestoreItemAction)
+	if !ok {
+		return nil, errors.Errorf("plugin %T is not a RestoreItemActionV3", plugin)
+	}
+
+	return restoreItemAction, nil
+}
+
+// Finalize restarts the plugin's process if needed, then delegates the call.
+func (r *RestartableRestoreItemAction) Finalize(input *velero.RestoreItemActionFinalizeInput) error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+
+	return delegate.Finalize(input)
+}
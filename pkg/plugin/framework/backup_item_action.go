@@ -35,7 +35,7 @@ type BackupItemActionPlugin struct {
 
 // GRPCClient returns a clientDispenser for BackupItemAction gRPC clients.
 func (p *BackupItemActionPlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, clientConn *grpc.ClientConn) (interface{}, error) {
-	return common.NewClientDispenser(p.ClientLogger, clientConn, newBackupItemActionGRPCClient), nil
+	return common.NewClientDispenser(p.ClientLogger, clientConn, newBackupItemActionGRPCClient).WithCallTimeout(p.CallTimeout), nil
 }
 
 // GRPCServer registers a BackupItemAction gRPC server.
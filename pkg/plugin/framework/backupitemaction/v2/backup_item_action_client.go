@@ -20,7 +20,6 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -58,7 +57,10 @@ func (c *BackupItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error
 		Plugin: c.Plugin,
 	}
 
-	res, err := c.grpcClient.AppliesTo(context.Background(), req)
+	ctx, cancel := c.CallContext()
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, req)
 	if err != nil {
 		return velero.ResourceSelector{}, common.FromGRPCError(err)
 	}
@@ -93,7 +95,10 @@ func (c *BackupItemActionGRPCClient) Execute(item runtime.Unstructured, backup *
 		Backup: backupJSON,
 	}
 
-	res, err := c.grpcClient.Execute(context.Background(), req)
+	ctx, cancel := c.CallContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Execute(ctx, req)
 	if err != nil {
 		return nil, nil, "", nil, common.FromGRPCError(err)
 	}
@@ -147,7 +152,10 @@ func (c *BackupItemActionGRPCClient) Progress(operationID string, backup *api.Ba
 		Backup:      backupJSON,
 	}
 
-	res, err := c.grpcClient.Progress(context.Background(), req)
+	ctx, cancel := c.CallContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Progress(ctx, req)
 	if err != nil {
 		return velero.OperationProgress{}, common.FromGRPCError(err)
 	}
@@ -175,7 +183,10 @@ func (c *BackupItemActionGRPCClient) Cancel(operationID string, backup *api.Back
 		Backup:      backupJSON,
 	}
 
-	_, err = c.grpcClient.Cancel(context.Background(), req)
+	ctx, cancel := c.CallContext()
+	defer cancel()
+
+	_, err = c.grpcClient.Cancel(ctx, req)
 	if err != nil {
 		return common.FromGRPCError(err)
 	}
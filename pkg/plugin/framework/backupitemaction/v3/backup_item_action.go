@@ -0,0 +1,61 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v3
+
+import (
+	plugin "github.com/hashicorp/go-plugin"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	protobiav3 "github.com/vmware-tanzu/velero/pkg/plugin/generated/backupitemaction/v3"
+)
+
+// itemChunkSize is the maximum size of the item chunks streamed between Velero and the plugin,
+// which is well below the default maximum gRPC message size of 4MB.
+const itemChunkSize = 1024 * 1024
+
+// BackupItemActionPlugin is an implementation of go-plugin's Plugin
+// interface with support for gRPC for the backup/ItemAction
+// interface. Unlike v2, the items are streamed in chunks, so their
+// size isn't limited by the maximum gRPC message size.
+type BackupItemActionPlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+	*common.PluginBase
+}
+
+// GRPCClient returns a clientDispenser for BackupItemAction gRPC clients.
+func (p *BackupItemActionPlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, clientConn *grpc.ClientConn) (interface{}, error) {
+	return common.NewClientDispenser(p.ClientLogger, clientConn, newBackupItemActionGRPCClient).WithCallTimeout(p.CallTimeout), nil
+}
+
+// GRPCServer registers a BackupItemAction gRPC server.
+func (p *BackupItemActionPlugin) GRPCServer(_ *plugin.GRPCBroker, server *grpc.Server) error {
+	protobiav3.RegisterBackupItemActionServer(server, &BackupItemActionGRPCServer{mux: p.ServerMux})
+	return nil
+}
+
+// splitChunks splits the data into chunks of the size, at least one chunk is returned even
+// if the data is empty.
+func splitChunks(data []byte, size int) [][]byte {
+	chunks := [][]byte{}
+	for len(data) > size {
+		chunks = append(chunks, data[:size])
+		data = data[size:]
+	}
+	return append(chunks, data)
+}
@@ -0,0 +1,238 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v3
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	protobiav3 "github.com/vmware-tanzu/velero/pkg/plugin/generated/backupitemaction/v3"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// NewBackupItemActionPlugin constructs a BackupItemActionPlugin.
+func NewBackupItemActionPlugin(options ...common.PluginOption) *BackupItemActionPlugin {
+	return &BackupItemActionPlugin{
+		PluginBase: common.NewPluginBase(options...),
+	}
+}
+
+// BackupItemActionGRPCClient implements the backup/ItemAction interface and uses a
+// gRPC client to make calls to the plugin server.
+type BackupItemActionGRPCClient struct {
+	*common.ClientBase
+	grpcClient protobiav3.BackupItemActionClient
+}
+
+func newBackupItemActionGRPCClient(base *common.ClientBase, clientConn *grpc.ClientConn) interface{} {
+	return &BackupItemActionGRPCClient{
+		ClientBase: base,
+		grpcClient: protobiav3.NewBackupItemActionClient(clientConn),
+	}
+}
+
+func (c *BackupItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
+	req := &protobiav3.BackupItemActionAppliesToRequest{
+		Plugin: c.Plugin,
+	}
+
+	ctx, cancel := c.CallContext()
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, req)
+	if err != nil {
+		return velero.ResourceSelector{}, common.FromGRPCError(err)
+	}
+
+	if res.ResourceSelector == nil {
+		return velero.ResourceSelector{}, nil
+	}
+
+	return velero.ResourceSelector{
+		IncludedNamespaces: res.ResourceSelector.IncludedNamespaces,
+		ExcludedNamespaces: res.ResourceSelector.ExcludedNamespaces,
+		IncludedResources:  res.ResourceSelector.IncludedResources,
+		ExcludedResources:  res.ResourceSelector.ExcludedResources,
+		LabelSelector:      res.ResourceSelector.Selector,
+	}, nil
+}
+
+// Execute streams the item to the plugin in chunks and assembles the updated item from the
+// chunks streamed back, so the size of the item isn't limited by the maximum gRPC message size.
+func (c *BackupItemActionGRPCClient) Execute(item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, string, []velero.ResourceIdentifier, error) {
+	itemJSON, err := json.Marshal(item.UnstructuredContent())
+	if err != nil {
+		return nil, nil, "", nil, errors.WithStack(err)
+	}
+
+	backupJSON, err := json.Marshal(backup)
+	if err != nil {
+		return nil, nil, "", nil, errors.WithStack(err)
+	}
+
+	ctx, cancel := c.CallContext()
+	defer cancel()
+
+	stream, err := c.grpcClient.Execute(ctx)
+	if err != nil {
+		return nil, nil, "", nil, common.FromGRPCError(err)
+	}
+
+	for i, chunk := range splitChunks(itemJSON, itemChunkSize) {
+		req := &protobiav3.ExecuteRequest{
+			ItemChunk: chunk,
+		}
+		if i == 0 {
+			req.Plugin = c.Plugin
+			req.Backup = backupJSON
+		}
+
+		// io.EOF means the server ended the stream, its status is returned by Recv
+		if err := stream.Send(req); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, nil, "", nil, common.FromGRPCError(err)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, nil, "", nil, common.FromGRPCError(err)
+	}
+
+	var res *protobiav3.ExecuteResponse
+	var updatedItemJSON []byte
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, "", nil, common.FromGRPCError(err)
+		}
+
+		if res == nil {
+			res = chunk
+		}
+		updatedItemJSON = append(updatedItemJSON, chunk.ItemChunk...)
+	}
+	if res == nil {
+		return nil, nil, "", nil, errors.New("no response is received from the plugin")
+	}
+
+	var updatedItem unstructured.Unstructured
+	if err := json.Unmarshal(updatedItemJSON, &updatedItem); err != nil {
+		return nil, nil, "", nil, errors.WithStack(err)
+	}
+
+	var additionalItems []velero.ResourceIdentifier
+
+	for _, itm := range res.AdditionalItems {
+		newItem := velero.ResourceIdentifier{
+			GroupResource: schema.GroupResource{
+				Group:    itm.Group,
+				Resource: itm.Resource,
+			},
+			Namespace: itm.Namespace,
+			Name:      itm.Name,
+		}
+
+		additionalItems = append(additionalItems, newItem)
+	}
+
+	var postOperationItems []velero.ResourceIdentifier
+
+	for _, itm := range res.PostOperationItems {
+		newItem := velero.ResourceIdentifier{
+			GroupResource: schema.GroupResource{
+				Group:    itm.Group,
+				Resource: itm.Resource,
+			},
+			Namespace: itm.Namespace,
+			Name:      itm.Name,
+		}
+
+		postOperationItems = append(postOperationItems, newItem)
+	}
+
+	return &updatedItem, additionalItems, res.OperationID, postOperationItems, nil
+}
+
+func (c *BackupItemActionGRPCClient) Progress(operationID string, backup *api.Backup) (velero.OperationProgress, error) {
+	backupJSON, err := json.Marshal(backup)
+	if err != nil {
+		return velero.OperationProgress{}, errors.WithStack(err)
+	}
+	req := &protobiav3.BackupItemActionProgressRequest{
+		Plugin:      c.Plugin,
+		OperationID: operationID,
+		Backup:      backupJSON,
+	}
+
+	ctx, cancel := c.CallContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Progress(ctx, req)
+	if err != nil {
+		return velero.OperationProgress{}, common.FromGRPCError(err)
+	}
+
+	return velero.OperationProgress{
+		Completed:      res.Progress.Completed,
+		Err:            res.Progress.Err,
+		NCompleted:     res.Progress.NCompleted,
+		NTotal:         res.Progress.NTotal,
+		OperationUnits: res.Progress.OperationUnits,
+		Description:    res.Progress.Description,
+		Started:        res.Progress.Started.AsTime(),
+		Updated:        res.Progress.Updated.AsTime(),
+	}, nil
+}
+
+func (c *BackupItemActionGRPCClient) Cancel(operationID string, backup *api.Backup) error {
+	backupJSON, err := json.Marshal(backup)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req := &protobiav3.BackupItemActionCancelRequest{
+		Plugin:      c.Plugin,
+		OperationID: operationID,
+		Backup:      backupJSON,
+	}
+
+	ctx, cancel := c.CallContext()
+	defer cancel()
+
+	_, err = c.grpcClient.Cancel(ctx, req)
+	if err != nil {
+		return common.FromGRPCError(err)
+	}
+
+	return nil
+}
+
+// This shouldn't be called on the GRPC client since the RestartableBackupItemAction won't delegate
+// this method
+func (c *BackupItemActionGRPCClient) Name() string {
+	return ""
+}
@@ -0,0 +1,150 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v3
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	protobiav3 "github.com/vmware-tanzu/velero/pkg/plugin/generated/backupitemaction/v3"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	mocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks/backupitemaction/v2"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// newTestClient serves the item action over an in-memory connection and returns a client of it.
+func newTestClient(t *testing.T, itemAction *mocks.BackupItemAction) *BackupItemActionGRPCClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	protobiav3.RegisterBackupItemActionServer(server, &BackupItemActionGRPCServer{mux: &common.ServerMux{
+		ServerLog: velerotest.NewLogger(),
+		Handlers: map[string]interface{}{
+			"xyz": itemAction,
+		},
+	}})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return newBackupItemActionGRPCClient(&common.ClientBase{Plugin: "xyz", Logger: velerotest.NewLogger()}, conn).(*BackupItemActionGRPCClient)
+}
+
+func TestBackupItemActionExecute(t *testing.T) {
+	// the item exceeds the default maximum gRPC message size of 4MB
+	item := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"namespace": "myns",
+			"name":      "myconfigmap",
+		},
+		"data": map[string]interface{}{
+			"key": strings.Repeat("a", 5*1024*1024),
+		},
+	}}
+	updatedItem := item.DeepCopy()
+	updatedItem.SetLabels(map[string]string{"updated": "true"})
+
+	backup := builder.ForBackup("velero", "backup-1").Result()
+
+	additionalItems := []velero.ResourceIdentifier{
+		{
+			GroupResource: schema.GroupResource{Resource: "pods"},
+			Namespace:     "myns",
+			Name:          "mypod",
+		},
+	}
+
+	tests := []struct {
+		name            string
+		implUpdatedItem *unstructured.Unstructured
+		implError       error
+		expectedItem    *unstructured.Unstructured
+		expectedErr     string
+	}{
+		{
+			name:         "nil updatedItem",
+			expectedItem: item,
+		},
+		{
+			name:            "different updatedItem",
+			implUpdatedItem: updatedItem,
+			expectedItem:    updatedItem,
+		},
+		{
+			name:        "error running impl",
+			implError:   errors.New("impl error"),
+			expectedErr: "impl error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			itemAction := &mocks.BackupItemAction{}
+			defer itemAction.AssertExpectations(t)
+
+			var implUpdatedItem interface{}
+			if test.implUpdatedItem != nil {
+				implUpdatedItem = test.implUpdatedItem
+			}
+			itemAction.On("Execute", item, mock.AnythingOfType("*v1.Backup")).Return(implUpdatedItem, additionalItems, "operation-1", nil, test.implError)
+
+			client := newTestClient(t, itemAction)
+
+			actual, actualAdditionalItems, operationID, postOperationItems, err := client.Execute(item, backup)
+			if test.expectedErr != "" {
+				assert.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedItem, actual)
+			assert.Equal(t, additionalItems, actualAdditionalItems)
+			assert.Equal(t, "operation-1", operationID)
+			assert.Nil(t, postOperationItems)
+		})
+	}
+}
+
+func TestSplitChunks(t *testing.T) {
+	assert.Equal(t, [][]byte{{}}, splitChunks([]byte{}, 2))
+	assert.Equal(t, [][]byte{[]byte("ab")}, splitChunks([]byte("ab"), 2))
+	assert.Equal(t, [][]byte{[]byte("ab"), []byte("cd"), []byte("e")}, splitChunks([]byte("abcde"), 2))
+}
@@ -17,14 +17,40 @@ limitations under the License.
 package common
 
 import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // ClientBase implements client and contains shared fields common to all clients.
 type ClientBase struct {
-	Plugin string
-	Logger logrus.FieldLogger
+	Plugin      string
+	Logger      logrus.FieldLogger
+	CallTimeout time.Duration
+}
+
+// CallContext returns the context of a call to the plugin server. The context is canceled after
+// the call timeout if it's set, the cancellation is propagated to the plugin server over gRPC.
+func (c *ClientBase) CallContext() (context.Context, context.CancelFunc) {
+	if c.CallTimeout <= 0 {
+		return context.WithCancel(context.Background())
+	}
+
+	return context.WithTimeout(context.Background(), c.CallTimeout)
+}
+
+// IsCallTimeout returns true if the error returned by a plugin call is caused by the call timeout.
+func IsCallTimeout(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return status.Code(errors.Cause(err)) == codes.DeadlineExceeded
 }
 
 type ClientDispenser interface {
@@ -42,6 +68,8 @@ type clientDispenser struct {
 	initFunc clientInitFunc
 	// clients keeps track of all the initialized implementations.
 	clients map[string]interface{}
+	// callTimeout is the timeout of the calls made by the clients.
+	callTimeout time.Duration
 }
 
 type clientInitFunc func(base *ClientBase, clientConn *grpc.ClientConn) interface{}
@@ -56,6 +84,12 @@ func NewClientDispenser(logger logrus.FieldLogger, clientConn *grpc.ClientConn,
 	}
 }
 
+// WithCallTimeout sets the timeout of the calls made by the clients dispensed by cd.
+func (cd *clientDispenser) WithCallTimeout(timeout time.Duration) *clientDispenser {
+	cd.callTimeout = timeout
+	return cd
+}
+
 // ClientFor returns a gRPC client stub for the implementation of a plugin named name. If the client stub does not
 // currently exist, clientFor creates it.
 func (cd *clientDispenser) ClientFor(name string) interface{} {
@@ -64,8 +98,9 @@ func (cd *clientDispenser) ClientFor(name string) interface{} {
 	}
 
 	base := &ClientBase{
-		Plugin: name,
-		Logger: cd.logger,
+		Plugin:      name,
+		Logger:      cd.logger,
+		CallTimeout: cd.callTimeout,
 	}
 	// Initialize the plugin (e.g. newBackupItemActionGRPCClient())
 	client := cd.initFunc(base, cd.clientConn)
@@ -17,10 +17,14 @@ package common
 
 import (
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -79,3 +83,33 @@ func TestClientFor(t *testing.T) {
 	typed = actual.(*fakeClient)
 	assert.Equal(t, 1, count)
 }
+
+func TestClientForWithCallTimeout(t *testing.T) {
+	initFunc := func(base *ClientBase, clientConn *grpc.ClientConn) interface{} {
+		return base
+	}
+
+	cd := NewClientDispenser(test.NewLogger(), new(grpc.ClientConn), initFunc).WithCallTimeout(time.Minute)
+	base := cd.ClientFor("pod").(*ClientBase)
+	assert.Equal(t, time.Minute, base.CallTimeout)
+
+	ctx, cancel := base.CallContext()
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, 5*time.Second)
+
+	// no deadline without call timeout
+	ctx, cancel = (&ClientBase{}).CallContext()
+	defer cancel()
+	_, ok = ctx.Deadline()
+	assert.False(t, ok)
+}
+
+func TestIsCallTimeout(t *testing.T) {
+	assert.False(t, IsCallTimeout(nil))
+	assert.False(t, IsCallTimeout(errors.New("plugin error")))
+	assert.False(t, IsCallTimeout(status.Error(codes.Unavailable, "transport is closing")))
+	assert.True(t, IsCallTimeout(status.Error(codes.DeadlineExceeded, "context deadline exceeded")))
+	assert.True(t, IsCallTimeout(errors.Wrap(status.Error(codes.DeadlineExceeded, "context deadline exceeded"), "error executing custom action")))
+}
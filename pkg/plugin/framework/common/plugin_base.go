@@ -17,11 +17,15 @@ limitations under the License.
 package common
 
 import (
+	"time"
+
 	"github.com/sirupsen/logrus"
 )
 
 type PluginBase struct {
 	ClientLogger logrus.FieldLogger
+	// CallTimeout is the timeout of the calls to the plugin server, no timeout if it's 0
+	CallTimeout time.Duration
 	*ServerMux
 }
 
@@ -41,6 +45,13 @@ func ClientLogger(logger logrus.FieldLogger) PluginOption {
 	}
 }
 
+// CallTimeout sets the timeout of the calls made by the plugin client to the plugin server.
+func CallTimeout(timeout time.Duration) PluginOption {
+	return func(base *PluginBase) {
+		base.CallTimeout = timeout
+	}
+}
+
 func ServerLogger(logger logrus.FieldLogger) PluginOption {
 	return func(base *PluginBase) {
 		base.ServerMux = NewServerMux(logger)
@@ -38,12 +38,20 @@ const (
 	// PluginKindBackupItemActionV2 represents a v2 backup item action plugin.
 	PluginKindBackupItemActionV2 PluginKind = "BackupItemActionV2"
 
+	// PluginKindBackupItemActionV3 represents a v3 backup item action plugin, which streams
+	// the items in chunks.
+	PluginKindBackupItemActionV3 PluginKind = "BackupItemActionV3"
+
 	// PluginKindRestoreItemAction represents a restore item action plugin.
 	PluginKindRestoreItemAction PluginKind = "RestoreItemAction"
 
 	// PluginKindRestoreItemActionV2 represents a v2 restore item action plugin.
 	PluginKindRestoreItemActionV2 PluginKind = "RestoreItemActionV2"
 
+	// PluginKindRestoreItemActionV3 represents a v3 restore item action plugin, which finalizes
+	// the restored items.
+	PluginKindRestoreItemActionV3 PluginKind = "RestoreItemActionV3"
+
 	// PluginKindDeleteItemAction represents a delete item action plugin.
 	PluginKindDeleteItemAction PluginKind = "DeleteItemAction"
 
@@ -55,8 +63,10 @@ const (
 // The older (adaptable) version is the key, and the value is the full list of newer
 // plugin kinds that are capable of adapting it.
 var PluginKindsAdaptableTo = map[PluginKind][]PluginKind{
-	PluginKindBackupItemAction:  {PluginKindBackupItemActionV2},
-	PluginKindRestoreItemAction: {PluginKindRestoreItemActionV2},
+	PluginKindBackupItemAction:    {PluginKindBackupItemActionV2},
+	PluginKindBackupItemActionV3:  {PluginKindBackupItemActionV2},
+	PluginKindRestoreItemAction:   {PluginKindRestoreItemActionV2},
+	PluginKindRestoreItemActionV3: {PluginKindRestoreItemActionV2},
 }
 
 // AllPluginKinds contains all the valid plugin kinds that Velero supports, excluding PluginLister because that is not a
@@ -67,8 +77,10 @@ func AllPluginKinds() map[string]PluginKind {
 	allPluginKinds[PluginKindVolumeSnapshotter.String()] = PluginKindVolumeSnapshotter
 	allPluginKinds[PluginKindBackupItemAction.String()] = PluginKindBackupItemAction
 	allPluginKinds[PluginKindBackupItemActionV2.String()] = PluginKindBackupItemActionV2
+	allPluginKinds[PluginKindBackupItemActionV3.String()] = PluginKindBackupItemActionV3
 	allPluginKinds[PluginKindRestoreItemAction.String()] = PluginKindRestoreItemAction
 	allPluginKinds[PluginKindRestoreItemActionV2.String()] = PluginKindRestoreItemActionV2
+	allPluginKinds[PluginKindRestoreItemActionV3.String()] = PluginKindRestoreItemActionV3
 	allPluginKinds[PluginKindDeleteItemAction.String()] = PluginKindDeleteItemAction
 	return allPluginKinds
 }
@@ -23,9 +23,12 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 const byteChunkSize = 16384
@@ -205,6 +208,25 @@ func (c *ObjectStoreGRPCClient) DeleteObject(bucket, key string) error {
 	return nil
 }
 
+// DeleteObjects removes the objects with the specified keys from the given bucket in a single
+// request. It returns velero.ErrBatchDeleteNotSupported if the plugin doesn't support it.
+func (c *ObjectStoreGRPCClient) DeleteObjects(bucket string, keys []string) error {
+	req := &proto.DeleteObjectsRequest{
+		Plugin: c.Plugin,
+		Bucket: bucket,
+		Keys:   keys,
+	}
+
+	if _, err := c.grpcClient.DeleteObjects(context.Background(), req); err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return velero.ErrBatchDeleteNotSupported
+		}
+		return common.FromGRPCError(err)
+	}
+
+	return nil
+}
+
 // CreateSignedURL creates a pre-signed URL for the given bucket and key that expires after ttl.
 func (c *ObjectStoreGRPCClient) CreateSignedURL(bucket, key string, ttl time.Duration) (string, error) {
 	req := &proto.CreateSignedURLRequest{
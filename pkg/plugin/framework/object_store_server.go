@@ -22,6 +22,8 @@ import (
 
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
@@ -251,6 +253,36 @@ func (s *ObjectStoreGRPCServer) DeleteObject(ctx context.Context, req *proto.Del
 	return &proto.Empty{}, nil
 }
 
+// DeleteObjects removes the objects with the specified keys from the given bucket in a single
+// request. The Unimplemented code is returned if the implementation doesn't support it, so that
+// the client falls back to deleting the objects one by one.
+func (s *ObjectStoreGRPCServer) DeleteObjects(ctx context.Context, req *proto.DeleteObjectsRequest) (response *proto.Empty, err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	batchDeleter, ok := impl.(velero.BatchObjectDeleter)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "object store %s doesn't support batch deletion", req.Plugin)
+	}
+
+	if err := batchDeleter.DeleteObjects(req.Bucket, req.Keys); err != nil {
+		if errors.Is(err, velero.ErrBatchDeleteNotSupported) {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+		return nil, common.NewGRPCError(err)
+	}
+
+	return &proto.Empty{}, nil
+}
+
 // CreateSignedURL creates a pre-signed URL for the given bucket and key that expires after ttl.
 func (s *ObjectStoreGRPCServer) CreateSignedURL(ctx context.Context, req *proto.CreateSignedURLRequest) (response *proto.CreateSignedURLResponse, err error) {
 	defer func() {
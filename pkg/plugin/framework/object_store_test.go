@@ -0,0 +1,122 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// newTestObjectStoreClient serves the object store over an in-memory connection and returns a client of it.
+func newTestObjectStoreClient(t *testing.T, objectStore velero.ObjectStore) *ObjectStoreGRPCClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	proto.RegisterObjectStoreServer(server, &ObjectStoreGRPCServer{mux: &common.ServerMux{
+		ServerLog: velerotest.NewLogger(),
+		Handlers: map[string]interface{}{
+			"xyz": objectStore,
+		},
+	}})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return newObjectStoreGRPCClient(&common.ClientBase{Plugin: "xyz", Logger: velerotest.NewLogger()}, conn).(*ObjectStoreGRPCClient)
+}
+
+type batchObjectStore struct {
+	*providermocks.ObjectStore
+	keys []string
+	err  error
+}
+
+func (s *batchObjectStore) DeleteObjects(bucket string, keys []string) error {
+	s.keys = append(s.keys, keys...)
+	return s.err
+}
+
+func TestObjectStoreDeleteObjects(t *testing.T) {
+	tests := []struct {
+		name         string
+		objectStore  velero.ObjectStore
+		expectedKeys []string
+		expectedErr  error
+	}{
+		{
+			name:        "batch deletion isn't implemented by the plugin",
+			objectStore: new(providermocks.ObjectStore),
+			expectedErr: velero.ErrBatchDeleteNotSupported,
+		},
+		{
+			name:         "batch deletion isn't supported by the plugin",
+			objectStore:  &batchObjectStore{ObjectStore: new(providermocks.ObjectStore), err: velero.ErrBatchDeleteNotSupported},
+			expectedKeys: []string{"a", "b"},
+			expectedErr:  velero.ErrBatchDeleteNotSupported,
+		},
+		{
+			name:         "objects are deleted in batch",
+			objectStore:  &batchObjectStore{ObjectStore: new(providermocks.ObjectStore)},
+			expectedKeys: []string{"a", "b"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newTestObjectStoreClient(t, test.objectStore)
+
+			err := client.DeleteObjects("bucket", []string{"a", "b"})
+			if test.expectedErr != nil {
+				assert.ErrorIs(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			if store, ok := test.objectStore.(*batchObjectStore); ok {
+				assert.Equal(t, test.expectedKeys, store.keys)
+			}
+		})
+	}
+
+	// the error of the plugin is returned
+	client := newTestObjectStoreClient(t, &batchObjectStore{ObjectStore: new(providermocks.ObjectStore), err: errors.New("delete error")})
+	err := client.DeleteObjects("bucket", []string{"a"})
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, velero.ErrBatchDeleteNotSupported)
+	assert.Contains(t, err.Error(), "delete error")
+}
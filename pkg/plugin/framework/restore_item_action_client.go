@@ -20,7 +20,6 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -55,7 +54,10 @@ func newRestoreItemActionGRPCClient(base *common.ClientBase, clientConn *grpc.Cl
 }
 
 func (c *RestoreItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
-	res, err := c.grpcClient.AppliesTo(context.Background(), &proto.RestoreItemActionAppliesToRequest{Plugin: c.Plugin})
+	ctx, cancel := c.CallContext()
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, &proto.RestoreItemActionAppliesToRequest{Plugin: c.Plugin})
 	if err != nil {
 		return velero.ResourceSelector{}, common.FromGRPCError(err)
 	}
@@ -96,7 +98,10 @@ func (c *RestoreItemActionGRPCClient) Execute(input *velero.RestoreItemActionExe
 		Restore:        restoreJSON,
 	}
 
-	res, err := c.grpcClient.Execute(context.Background(), req)
+	ctx, cancel := c.CallContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Execute(ctx, req)
 	if err != nil {
 		return nil, common.FromGRPCError(err)
 	}
@@ -35,7 +35,7 @@ type RestoreItemActionPlugin struct {
 
 // GRPCClient returns a RestoreItemAction gRPC client.
 func (p *RestoreItemActionPlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, clientConn *grpc.ClientConn) (interface{}, error) {
-	return common.NewClientDispenser(p.ClientLogger, clientConn, newRestoreItemActionGRPCClient), nil
+	return common.NewClientDispenser(p.ClientLogger, clientConn, newRestoreItemActionGRPCClient).WithCallTimeout(p.CallTimeout), nil
 }
 
 // GRPCServer registers a RestoreItemAction gRPC server.
@@ -20,7 +20,6 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
-	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -56,7 +55,10 @@ func newRestoreItemActionGRPCClient(base *common.ClientBase, clientConn *grpc.Cl
 }
 
 func (c *RestoreItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
-	res, err := c.grpcClient.AppliesTo(context.Background(), &protoriav2.RestoreItemActionAppliesToRequest{Plugin: c.Plugin})
+	ctx, cancel := c.CallContext()
+	defer cancel()
+
+	res, err := c.grpcClient.AppliesTo(ctx, &protoriav2.RestoreItemActionAppliesToRequest{Plugin: c.Plugin})
 	if err != nil {
 		return velero.ResourceSelector{}, common.FromGRPCError(err)
 	}
@@ -97,7 +99,10 @@ func (c *RestoreItemActionGRPCClient) Execute(input *velero.RestoreItemActionExe
 		Restore:        restoreJSON,
 	}
 
-	res, err := c.grpcClient.Execute(context.Background(), req)
+	ctx, cancel := c.CallContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Execute(ctx, req)
 	if err != nil {
 		return nil, common.FromGRPCError(err)
 	}
@@ -142,7 +147,10 @@ func (c *RestoreItemActionGRPCClient) Progress(operationID string, restore *api.
 		Restore:     restoreJSON,
 	}
 
-	res, err := c.grpcClient.Progress(context.Background(), req)
+	ctx, cancel := c.CallContext()
+	defer cancel()
+
+	res, err := c.grpcClient.Progress(ctx, req)
 	if err != nil {
 		return velero.OperationProgress{}, common.FromGRPCError(err)
 	}
@@ -170,7 +178,10 @@ func (c *RestoreItemActionGRPCClient) Cancel(operationID string, restore *api.Re
 		Restore:     restoreJSON,
 	}
 
-	_, err = c.grpcClient.Cancel(context.Background(), req)
+	ctx, cancel := c.CallContext()
+	defer cancel()
+
+	_, err = c.grpcClient.Cancel(ctx, req)
 	if err != nil {
 		return common.FromGRPCError(err)
 	}
@@ -192,7 +203,10 @@ func (c *RestoreItemActionGRPCClient) AreAdditionalItemsReady(additionalItems []
 		req.AdditionalItems = append(req.AdditionalItems, restoreResourceIdentifierToProto(item))
 	}
 
-	res, err := c.grpcClient.AreAdditionalItemsReady(context.Background(), req)
+	ctx, cancel := c.CallContext()
+	defer cancel()
+
+	res, err := c.grpcClient.AreAdditionalItemsReady(ctx, req)
 	if err != nil {
 		return false, common.FromGRPCError(err)
 	}
@@ -0,0 +1,93 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v3
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	protoriav3 "github.com/vmware-tanzu/velero/pkg/plugin/generated/restoreitemaction/v3"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	mocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks/restoreitemaction/v3"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// newTestClient serves the item action over an in-memory connection and returns a client of it.
+func newTestClient(t *testing.T, itemAction *mocks.RestoreItemAction) *RestoreItemActionGRPCClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	protoriav3.RegisterRestoreItemActionServer(server, &RestoreItemActionGRPCServer{mux: &common.ServerMux{
+		ServerLog: velerotest.NewLogger(),
+		Handlers: map[string]interface{}{
+			"xyz": itemAction,
+		},
+	}})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return newRestoreItemActionGRPCClient(&common.ClientBase{Plugin: "xyz", Logger: velerotest.NewLogger()}, conn).(*RestoreItemActionGRPCClient)
+}
+
+func TestRestoreItemActionFinalize(t *testing.T) {
+	restore := builder.ForRestore("velero", "restore-1").Result()
+	restoredItems := []velero.RestoredItem{
+		{
+			ResourceIdentifier: velero.ResourceIdentifier{GroupResource: kuberesource.PersistentVolumeClaims, Namespace: "ns-1", Name: "pvc-1"},
+			UID:                "uid-1",
+		},
+		{
+			ResourceIdentifier: velero.ResourceIdentifier{GroupResource: kuberesource.PersistentVolumes, Name: "pv-1"},
+			UID:                "uid-2",
+		},
+	}
+
+	itemAction := &mocks.RestoreItemAction{}
+	defer itemAction.AssertExpectations(t)
+	itemAction.On("Finalize", mock.MatchedBy(func(input *velero.RestoreItemActionFinalizeInput) bool {
+		return input.Restore.Name == "restore-1" && assert.ObjectsAreEqual(restoredItems, input.RestoredItems)
+	})).Return(nil).Once()
+	itemAction.On("Finalize", mock.Anything).Return(errors.New("failed to finalize")).Once()
+
+	client := newTestClient(t, itemAction)
+	require.NoError(t, client.Finalize(&velero.RestoreItemActionFinalizeInput{Restore: restore, RestoredItems: restoredItems}))
+
+	err := client.Finalize(&velero.RestoreItemActionFinalizeInput{Restore: restore, RestoredItems: restoredItems})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to finalize")
+}
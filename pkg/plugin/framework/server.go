@@ -26,8 +26,10 @@ import (
 	"github.com/spf13/pflag"
 
 	biav2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/backupitemaction/v2"
+	biav3 "github.com/vmware-tanzu/velero/pkg/plugin/framework/backupitemaction/v3"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/framework/restoreitemaction/v2"
+	riav3 "github.com/vmware-tanzu/velero/pkg/plugin/framework/restoreitemaction/v3"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
 
@@ -55,6 +57,15 @@ type Server interface {
 	// RegisterBackupItemActionsV2 registers multiple v2 backup item actions.
 	RegisterBackupItemActionsV2(map[string]common.HandlerInitializer) Server
 
+	// RegisterBackupItemActionV3 registers a v3 backup item action. A v3 backup item action
+	// implements the same interface as v2, but the items are streamed in chunks, so it supports
+	// the items exceeding the maximum gRPC message size. Accepted format for the plugin name is
+	// <DNS subdomain>/<non-empty name>.
+	RegisterBackupItemActionV3(pluginName string, initializer common.HandlerInitializer) Server
+
+	// RegisterBackupItemActionsV3 registers multiple v3 backup item actions.
+	RegisterBackupItemActionsV3(map[string]common.HandlerInitializer) Server
+
 	// RegisterVolumeSnapshotter registers a volume snapshotter. Accepted format
 	// for the plugin name is <DNS subdomain>/<non-empty name>.
 	RegisterVolumeSnapshotter(pluginName string, initializer common.HandlerInitializer) Server
@@ -83,6 +94,14 @@ type Server interface {
 	// RegisterRestoreItemActionsV2 registers multiple v2 restore item actions.
 	RegisterRestoreItemActionsV2(map[string]common.HandlerInitializer) Server
 
+	// RegisterRestoreItemActionV3 registers a v3 restore item action. A v3 restore item action
+	// implements the v2 interface and finalizes the restored items once the restore has created
+	// them all. Accepted format for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterRestoreItemActionV3(pluginName string, initializer common.HandlerInitializer) Server
+
+	// RegisterRestoreItemActionsV3 registers multiple v3 restore item actions.
+	RegisterRestoreItemActionsV3(map[string]common.HandlerInitializer) Server
+
 	// RegisterDeleteItemAction registers a delete item action. Accepted format
 	// for the plugin name is <DNS subdomain>/<non-empty name>.
 	RegisterDeleteItemAction(pluginName string, initializer common.HandlerInitializer) Server
@@ -101,10 +120,12 @@ type server struct {
 	flagSet             *pflag.FlagSet
 	backupItemAction    *BackupItemActionPlugin
 	backupItemActionV2  *biav2.BackupItemActionPlugin
+	backupItemActionV3  *biav3.BackupItemActionPlugin
 	volumeSnapshotter   *VolumeSnapshotterPlugin
 	objectStore         *ObjectStorePlugin
 	restoreItemAction   *RestoreItemActionPlugin
 	restoreItemActionV2 *riav2.RestoreItemActionPlugin
+	restoreItemActionV3 *riav3.RestoreItemActionPlugin
 	deleteItemAction    *DeleteItemActionPlugin
 }
 
@@ -117,10 +138,12 @@ func NewServer() Server {
 		logLevelFlag:        logging.LogLevelFlag(log.Level),
 		backupItemAction:    NewBackupItemActionPlugin(common.ServerLogger(log)),
 		backupItemActionV2:  biav2.NewBackupItemActionPlugin(common.ServerLogger(log)),
+		backupItemActionV3:  biav3.NewBackupItemActionPlugin(common.ServerLogger(log)),
 		volumeSnapshotter:   NewVolumeSnapshotterPlugin(common.ServerLogger(log)),
 		objectStore:         NewObjectStorePlugin(common.ServerLogger(log)),
 		restoreItemAction:   NewRestoreItemActionPlugin(common.ServerLogger(log)),
 		restoreItemActionV2: riav2.NewRestoreItemActionPlugin(common.ServerLogger(log)),
+		restoreItemActionV3: riav3.NewRestoreItemActionPlugin(common.ServerLogger(log)),
 		deleteItemAction:    NewDeleteItemActionPlugin(common.ServerLogger(log)),
 	}
 }
@@ -157,6 +180,18 @@ func (s *server) RegisterBackupItemActionsV2(m map[string]common.HandlerInitiali
 	return s
 }
 
+func (s *server) RegisterBackupItemActionV3(name string, initializer common.HandlerInitializer) Server {
+	s.backupItemActionV3.Register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterBackupItemActionsV3(m map[string]common.HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterBackupItemActionV3(name, m[name])
+	}
+	return s
+}
+
 func (s *server) RegisterVolumeSnapshotter(name string, initializer common.HandlerInitializer) Server {
 	s.volumeSnapshotter.Register(name, initializer)
 	return s
@@ -205,6 +240,18 @@ func (s *server) RegisterRestoreItemActionsV2(m map[string]common.HandlerInitial
 	return s
 }
 
+func (s *server) RegisterRestoreItemActionV3(name string, initializer common.HandlerInitializer) Server {
+	s.restoreItemActionV3.Register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterRestoreItemActionsV3(m map[string]common.HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterRestoreItemActionV3(name, m[name])
+	}
+	return s
+}
+
 func (s *server) RegisterDeleteItemAction(name string, initializer common.HandlerInitializer) Server {
 	s.deleteItemAction.Register(name, initializer)
 	return s
@@ -246,10 +293,12 @@ func (s *server) Serve() {
 	var pluginIdentifiers []PluginIdentifier
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindBackupItemAction, s.backupItemAction)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindBackupItemActionV2, s.backupItemActionV2)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindBackupItemActionV3, s.backupItemActionV3)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindVolumeSnapshotter, s.volumeSnapshotter)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindObjectStore, s.objectStore)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindRestoreItemAction, s.restoreItemAction)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindRestoreItemActionV2, s.restoreItemActionV2)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindRestoreItemActionV3, s.restoreItemActionV3)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, common.PluginKindDeleteItemAction, s.deleteItemAction)...)
 
 	pluginLister := NewPluginLister(pluginIdentifiers...)
@@ -259,11 +308,13 @@ func (s *server) Serve() {
 		Plugins: map[string]plugin.Plugin{
 			string(common.PluginKindBackupItemAction):    s.backupItemAction,
 			string(common.PluginKindBackupItemActionV2):  s.backupItemActionV2,
+			string(common.PluginKindBackupItemActionV3):  s.backupItemActionV3,
 			string(common.PluginKindVolumeSnapshotter):   s.volumeSnapshotter,
 			string(common.PluginKindObjectStore):         s.objectStore,
 			string(common.PluginKindPluginLister):        NewPluginListerPlugin(pluginLister),
 			string(common.PluginKindRestoreItemAction):   s.restoreItemAction,
 			string(common.PluginKindRestoreItemActionV2): s.restoreItemActionV2,
+			string(common.PluginKindRestoreItemActionV3): s.restoreItemActionV3,
 			string(common.PluginKindDeleteItemAction):    s.deleteItemAction,
 		},
 		GRPCServer: plugin.DefaultGRPCServer,
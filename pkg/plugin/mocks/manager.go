@@ -8,6 +8,8 @@ import (
 
 	restoreitemactionv2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2"
 
+	restoreitemactionv3 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v3"
+
 	v1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v1"
 
 	v2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v2"
@@ -234,6 +236,29 @@ func (_m *Manager) GetRestoreItemActionV2(name string) (restoreitemactionv2.Rest
 	return r0, r1
 }
 
+// GetRestoreItemActionV3 provides a mock function with given fields: name
+func (_m *Manager) GetRestoreItemActionV3(name string) (restoreitemactionv3.RestoreItemAction, error) {
+	ret := _m.Called(name)
+
+	var r0 restoreitemactionv3.RestoreItemAction
+	if rf, ok := ret.Get(0).(func(string) restoreitemactionv3.RestoreItemAction); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(restoreitemactionv3.RestoreItemAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRestoreItemActions provides a mock function with given fields:
 func (_m *Manager) GetRestoreItemActions() ([]restoreitemactionv1.RestoreItemAction, error) {
 	ret := _m.Called()
@@ -280,6 +305,29 @@ func (_m *Manager) GetRestoreItemActionsV2() ([]restoreitemactionv2.RestoreItemA
 	return r0, r1
 }
 
+// GetRestoreItemActionsV3 provides a mock function with given fields:
+func (_m *Manager) GetRestoreItemActionsV3() ([]restoreitemactionv3.RestoreItemAction, error) {
+	ret := _m.Called()
+
+	var r0 []restoreitemactionv3.RestoreItemAction
+	if rf, ok := ret.Get(0).(func() []restoreitemactionv3.RestoreItemAction); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]restoreitemactionv3.RestoreItemAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetVolumeSnapshotter provides a mock function with given fields: name
 func (_m *Manager) GetVolumeSnapshotter(name string) (volumesnapshotterv1.VolumeSnapshotter, error) {
 	ret := _m.Called(name)
@@ -17,6 +17,7 @@ limitations under the License.
 package velero
 
 import (
+	"errors"
 	"io"
 	"time"
 )
@@ -63,3 +64,17 @@ type ObjectStore interface {
 	// CreateSignedURL creates a pre-signed URL for the given bucket and key that expires after ttl.
 	CreateSignedURL(bucket, key string, ttl time.Duration) (string, error)
 }
+
+// ErrBatchDeleteNotSupported is returned by BatchObjectDeleter.DeleteObjects if the objects can't
+// be deleted in batch, e.g. by a plugin built before the batch deletion was added, in which case
+// the objects should be deleted one by one.
+var ErrBatchDeleteNotSupported = errors.New("batch deletion is not supported by the object store")
+
+// BatchObjectDeleter is optionally implemented by the object stores which are able to delete
+// multiple objects in a single request, e.g. with the S3 DeleteObjects API or the GCS batch requests.
+type BatchObjectDeleter interface {
+	// DeleteObjects removes the objects with the specified keys from the given bucket, the keys
+	// could be up to 1000. It returns ErrBatchDeleteNotSupported if the objects can't be deleted
+	// in batch.
+	DeleteObjects(bucket string, keys []string) error
+}
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
@@ -94,3 +95,19 @@ func (r *RestoreItemActionExecuteOutput) WithItemsWait() *RestoreItemActionExecu
 	r.WaitForAdditionalItems = true
 	return r
 }
+
+// RestoredItem is an item created by the restore, identified along with its UID in the cluster.
+type RestoredItem struct {
+	ResourceIdentifier
+	// UID is the UID of the item created in the cluster, which differs from the UID of the
+	// backed up item.
+	UID types.UID
+}
+
+// RestoreItemActionFinalizeInput contains the input parameters for the ItemAction's Finalize function.
+type RestoreItemActionFinalizeInput struct {
+	// Restore is the representation of the restore resource processed by Velero.
+	Restore *api.Restore
+	// RestoredItems are the items created by the restore which the ItemAction was executed on.
+	RestoredItems []RestoredItem
+}
@@ -0,0 +1,38 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v3
+
+import (
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2"
+)
+
+// RestoreItemAction is an actor that performs an operation on an individual item being restored,
+// and finalizes the restored items once the restore has created them all.
+type RestoreItemAction interface {
+	riav2.RestoreItemAction
+
+	// Finalize allows the ItemAction to update the restored items once all the items of the restore
+	// are created and the asynchronous operations of the restore finished, e.g. to patch the
+	// references which only exist after the creation like re-binding volumes or updating status
+	// fields. It is called once per restore with the items created by the restore which the
+	// ItemAction was executed on, along with their UIDs in the cluster. It isn't called if the
+	// ItemAction wasn't executed on any created item, and may be called again if Velero fails to
+	// update the restore, so it should be idempotent. An error is returned if the action fails,
+	// which fails the restore partially.
+	Finalize(input *velero.RestoreItemActionFinalizeInput) error
+}
@@ -65,7 +65,8 @@ func NewPodCommandExecutor(restClientConfig *rest.Config, restClient poster) Pod
 // ExecutePodCommand uses the pod exec API to execute a command in a container in a pod. If the
 // command takes longer than the specified timeout, an error is returned (NOTE: it is not currently
 // possible to ensure the command is terminated when the timeout occurs, so it may continue to run
-// in the background).
+// in the background). A failed or timed out command is retried up to the hook's retries, waiting
+// the hook's retry interval between the attempts.
 func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) error {
 	if item == nil {
 		return errors.New("item is required")
@@ -114,6 +115,10 @@ func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, it
 		localHook.Timeout.Duration = defaultTimeout
 	}
 
+	if localHook.Retries < 0 {
+		localHook.Retries = 0
+	}
+
 	hookLog := log.WithFields(
 		logrus.Fields{
 			"hookName":      hookName,
@@ -121,6 +126,7 @@ func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, it
 			"hookCommand":   localHook.Command,
 			"hookOnError":   localHook.OnError,
 			"hookTimeout":   localHook.Timeout,
+			"hookRetries":   localHook.Retries,
 		},
 	)
 
@@ -129,8 +135,24 @@ func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, it
 		return nil
 	}
 
-	hookLog.Info("running exec hook")
+	for attempt := 0; ; attempt++ {
+		hookLog.WithField("attempt", attempt+1).Info("running exec hook")
+
+		err := e.executeCommand(hookLog, namespace, name, &localHook)
+		if err == nil || attempt >= localHook.Retries {
+			return err
+		}
+
+		hookLog.WithError(err).Warnf("Exec hook failed, retrying in %v", localHook.RetryInterval.Duration)
+		if localHook.RetryInterval.Duration > 0 {
+			time.Sleep(localHook.RetryInterval.Duration)
+		}
+	}
+}
 
+// executeCommand runs one attempt of the hook command in the container, the hook's timeout
+// applies to the attempt.
+func (e *defaultPodCommandExecutor) executeCommand(hookLog logrus.FieldLogger, namespace, name string, localHook *api.ExecHook) error {
 	req := e.restClient.Post().
 		Resource("pods").
 		Namespace(namespace).
@@ -156,11 +178,11 @@ func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, it
 		Stderr: &stderr,
 	}
 
-	errCh := make(chan error)
+	// buffered so the goroutine of a timed out attempt doesn't leak
+	errCh := make(chan error, 1)
 
 	go func() {
-		err = executor.Stream(streamOptions)
-		errCh <- err
+		errCh <- executor.Stream(streamOptions)
 	}()
 
 	var timeoutCh <-chan time.Time
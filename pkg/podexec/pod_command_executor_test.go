@@ -244,6 +244,100 @@ func TestExecutePodCommand(t *testing.T) {
 	}
 }
 
+func TestExecutePodCommandRetry(t *testing.T) {
+	tests := []struct {
+		name             string
+		retries          int
+		timeout          time.Duration
+		streamResults    []error
+		streamDelay      time.Duration
+		expectedAttempts int
+		expectedError    string
+	}{
+		{
+			name:             "succeed after retries",
+			retries:          2,
+			streamResults:    []error{errors.New("hook error"), errors.New("hook error"), nil},
+			expectedAttempts: 3,
+		},
+		{
+			name:             "fail after all retries",
+			retries:          1,
+			streamResults:    []error{errors.New("hook error 1"), errors.New("hook error 2")},
+			expectedAttempts: 2,
+			expectedError:    "hook error 2",
+		},
+		{
+			name:             "no retries",
+			streamResults:    []error{errors.New("hook error")},
+			expectedAttempts: 1,
+			expectedError:    "hook error",
+		},
+		{
+			name:             "timed out attempt is retried",
+			retries:          1,
+			timeout:          10 * time.Millisecond,
+			streamResults:    []error{nil, nil},
+			streamDelay:      time.Second,
+			expectedAttempts: 2,
+			expectedError:    "timed out after 10ms",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hook := v1.ExecHook{
+				Command:       []string{"some", "command"},
+				Timeout:       metav1.Duration{Duration: test.timeout},
+				Retries:       test.retries,
+				RetryInterval: metav1.Duration{Duration: time.Millisecond},
+			}
+
+			pod, err := velerotest.GetAsMap(`
+{
+	"metadata": {
+		"namespace": "namespace",
+		"name": "name"
+	},
+	"spec": {
+		"containers": [
+			{"name": "foo"}
+		]
+	}
+}`)
+			require.NoError(t, err)
+
+			clientConfig := &rest.Config{}
+			poster := &mockPoster{}
+			podCommandExecutor := NewPodCommandExecutor(clientConfig, poster).(*defaultPodCommandExecutor)
+
+			streamExecutorFactory := &mockStreamExecutorFactory{}
+			podCommandExecutor.streamExecutorFactory = streamExecutorFactory
+
+			baseURL, _ := url.Parse("https://some.server")
+			contentConfig := rest.ClientContentConfig{
+				GroupVersion: schema.GroupVersion{Group: "", Version: "v1"},
+			}
+			poster.On("Post").Return(rest.NewRequestWithClient(baseURL, "/api/v1", contentConfig, nil))
+
+			streamExecutor := &mockStreamExecutor{}
+			streamExecutorFactory.On("NewSPDYExecutor", clientConfig, "POST", mock.Anything).Return(streamExecutor, nil)
+			for _, result := range test.streamResults {
+				streamExecutor.On("Stream", mock.Anything).After(test.streamDelay).Return(result).Once()
+			}
+
+			err = podCommandExecutor.ExecutePodCommand(velerotest.NewLogger(), pod, "namespace", "name", "hookName", &hook)
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			streamExecutor.AssertNumberOfCalls(t, "Stream", test.expectedAttempts)
+		})
+	}
+}
+
 func TestEnsureContainerExists(t *testing.T) {
 	pod := &corev1api.Pod{
 		Spec: corev1api.PodSpec{
@@ -36,6 +36,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
@@ -44,6 +45,10 @@ import (
 type Backupper interface {
 	// BackupPodVolumes backs up all specified volumes in a pod.
 	BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.Pod, volumesToBackup []string, resPolicies *resourcepolicies.Policies, log logrus.FieldLogger) ([]*velerov1api.PodVolumeBackup, *PVCBackupSummary, []error)
+
+	// BackupPVC backs up the data of a bound PVC which isn't mounted by any pod, the PVC is mounted
+	// by a hosting pod created by the node-agent for the backup. Nil is returned if the PVC is skipped.
+	BackupPVC(backup *velerov1api.Backup, pvc *corev1api.PersistentVolumeClaim, resPolicies *resourcepolicies.Policies, log logrus.FieldLogger) (*velerov1api.PodVolumeBackup, error)
 }
 
 type backupper struct {
@@ -175,6 +180,15 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		return nil, nil, []error{err}
 	}
 
+	// restic isn't supported by the node-agent in the Windows nodes
+	if b.uploaderType == uploader.ResticType {
+		if nodeOS, err := nodeagent.GetNodeOSByName(b.ctx, pod.Spec.NodeName, b.crClient); err != nil {
+			log.WithError(err).Warnf("Failed to get the OS of node %s", pod.Spec.NodeName)
+		} else if nodeOS == nodeagent.NodeOSWindows {
+			return nil, nil, []error{errors.Errorf("pod %s/%s is running in the Windows node %s, which doesn't support the restic uploader", pod.Namespace, pod.Name, pod.Spec.NodeName)}
+		}
+	}
+
 	repositoryType := getRepositoryType(b.uploaderType)
 	if repositoryType == "" {
 		err := errors.Errorf("empty repository type, uploader %s", b.uploaderType)
@@ -186,6 +200,10 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		return nil, nil, []error{err}
 	}
 
+	if err := repository.EnsureWritable(repo); err != nil {
+		return nil, nil, []error{err}
+	}
+
 	// get a single non-exclusive lock since we'll wait for all individual
 	// backups to be complete before releasing it.
 	b.repoLocker.Lock(repo.Name)
@@ -220,7 +238,10 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		}
 	}
 
-	if err := kube.IsPodRunning(pod); err != nil {
+	// the PVCs of the terminated pods, e.g. the pods of the completed jobs, are mounted by the node-agent
+	// into hosting pods for backup, while the other volumes are skipped if the pod isn't running
+	podTerminated := kube.IsPodTerminated(pod)
+	if err := kube.IsPodRunning(pod); err != nil && !podTerminated {
 		for _, volumeName := range volumesToBackup {
 			err := errors.Wrapf(err, "backup for volume %s is skipped", volumeName)
 			log.WithError(err).Warn("Skip pod volume")
@@ -259,6 +280,13 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 			}
 		}
 
+		if podTerminated && (pvc == nil || pvc.Spec.VolumeName == "") {
+			msg := fmt.Sprintf("volume %s of the terminated pod %s/%s is not a bound PVC, skipping", volumeName, pod.Namespace, pod.Name)
+			log.Warn(msg)
+			pvcSummary.addSkipped(volumeName, msg)
+			continue
+		}
+
 		// hostPath volumes are not supported because they're not mounted into /var/lib/kubelet/pods, so our
 		// daemonset pod has no way to access their data.
 		isHostPath, err := isHostPathVolume(&volume, pvc, b.crClient)
@@ -301,6 +329,9 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		}
 
 		volumeBackup := newPodVolumeBackup(backup, pod, volume, repoIdentifier, b.uploaderType, pvc)
+		if baseBackup := backup.Annotations[velerov1api.PodVolumeIncrementalBaseAnnotation]; baseBackup != "" {
+			volumeBackup.Spec.IncrementalBase = b.getIncrementalBase(baseBackup, volumeBackup, log)
+		}
 		if err := veleroclient.CreateRetryGenerateName(b.crClient, b.ctx, volumeBackup); err != nil {
 			errs = append(errs, err)
 			continue
@@ -333,6 +364,119 @@ ForEachVolume:
 	return podVolumeBackups, pvcSummary, errs
 }
 
+func (b *backupper) BackupPVC(backup *velerov1api.Backup, pvc *corev1api.PersistentVolumeClaim, resPolicies *resourcepolicies.Policies, log logrus.FieldLogger) (*velerov1api.PodVolumeBackup, error) {
+	log = log.WithField("pvc", fmt.Sprintf("%s/%s", pvc.Namespace, pvc.Name))
+
+	if pvc.Spec.VolumeMode != nil && *pvc.Spec.VolumeMode == corev1api.PersistentVolumeBlock {
+		log.Warn("PVC is a block volume. Block volumes are not supported for fs backup, skipping")
+		return nil, nil
+	}
+
+	pv := new(corev1api.PersistentVolume)
+	if err := b.crClient.Get(b.ctx, ctrlclient.ObjectKey{Name: pvc.Spec.VolumeName}, pv); err != nil {
+		return nil, errors.Wrapf(err, "error getting pv for pvc %s/%s", pvc.Namespace, pvc.Name)
+	}
+
+	// see BackupPodVolumes for why hostPath volumes are not supported
+	if pv.Spec.HostPath != nil {
+		log.Warnf("PVC is bound to hostPath volume %s which is not supported for pod volume backup, skipping", pv.Name)
+		return nil, nil
+	}
+
+	if resPolicies != nil {
+		if action, err := resPolicies.GetMatchAction(pv); err != nil {
+			return nil, errors.Wrapf(err, "error getting matched resource policies for pv %s", pv.Name)
+		} else if action != nil && action.Type == resourcepolicies.Skip {
+			log.Info("skip backup of PVC for the matched resource policies")
+			return nil, nil
+		}
+	}
+
+	nodeName, err := b.selectNodeForPV(backup.Namespace, pv)
+	if err != nil {
+		return nil, err
+	}
+
+	repositoryType := getRepositoryType(b.uploaderType)
+	if repositoryType == "" {
+		return nil, errors.Errorf("empty repository type, uploader %s", b.uploaderType)
+	}
+
+	repo, err := b.repoEnsurer.EnsureRepo(b.ctx, backup.Namespace, pvc.Namespace, backup.Spec.StorageLocation, repositoryType)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repository.EnsureWritable(repo); err != nil {
+		return nil, err
+	}
+
+	b.repoLocker.Lock(repo.Name)
+	defer b.repoLocker.Unlock(repo.Name)
+
+	resultsChan := make(chan *velerov1api.PodVolumeBackup)
+
+	b.resultsLock.Lock()
+	b.results[resultsKey(pvc.Namespace, pvc.Name)] = resultsChan
+	b.resultsLock.Unlock()
+
+	defer func() {
+		b.resultsLock.Lock()
+		delete(b.results, resultsKey(pvc.Namespace, pvc.Name))
+		b.resultsLock.Unlock()
+	}()
+
+	repoIdentifier := ""
+	if repositoryType == velerov1api.BackupRepositoryTypeRestic {
+		repoIdentifier = repo.Spec.ResticIdentifier
+	}
+
+	volumeBackup := newPVCVolumeBackup(backup, pvc, nodeName, repoIdentifier, b.uploaderType)
+	if baseBackup := backup.Annotations[velerov1api.PodVolumeIncrementalBaseAnnotation]; baseBackup != "" {
+		volumeBackup.Spec.IncrementalBase = b.getIncrementalBase(baseBackup, volumeBackup, log)
+	}
+	if err := veleroclient.CreateRetryGenerateName(b.crClient, b.ctx, volumeBackup); err != nil {
+		return nil, err
+	}
+	log.Infof("Backing up PVC in node %s", nodeName)
+
+	select {
+	case <-b.ctx.Done():
+		return nil, errors.New("timed out waiting for PodVolumeBackup to complete")
+	case res := <-resultsChan:
+		if res.Status.Phase == velerov1api.PodVolumeBackupPhaseFailed {
+			return res, errors.Errorf("pod volume backup failed: %s", res.Status.Message)
+		}
+		return res, nil
+	}
+}
+
+// selectNodeForPV returns the first node, by the order of the names, in which the node-agent is
+// running and the PV is accessible.
+func (b *backupper) selectNodeForPV(namespace string, pv *corev1api.PersistentVolume) (string, error) {
+	nodes, err := nodeagent.GetRunningNodes(b.ctx, namespace, b.crClient)
+	if err != nil {
+		return "", err
+	}
+
+	for _, nodeName := range nodes {
+		node := new(corev1api.Node)
+		if err := b.crClient.Get(b.ctx, ctrlclient.ObjectKey{Name: nodeName}, node); err != nil {
+			return "", errors.Wrapf(err, "error getting node %s", nodeName)
+		}
+
+		accessible, err := kube.IsPVAccessibleFromNode(pv, node)
+		if err != nil {
+			return "", err
+		}
+		if accessible {
+			return nodeName, nil
+		}
+	}
+
+	return "", errors.Errorf("no node with node-agent running is found to access pv %s", pv.Name)
+}
+
 // isHostPathVolume returns true if the volume is either a hostPath pod volume or a persistent
 // volume claim on a hostPath persistent volume, or false otherwise.
 func isHostPathVolume(volume *corev1api.Volume, pvc *corev1api.PersistentVolumeClaim, crClient ctrlclient.Client) (bool, error) {
@@ -353,6 +497,51 @@ func isHostPathVolume(volume *corev1api.Volume, pvc *corev1api.PersistentVolumeC
 	return pv.Spec.HostPath != nil, nil
 }
 
+// getIncrementalBase finds the completed pod volume backup of the same volume in the specified
+// base backup and returns its snapshot ID. An empty string is returned if it is not found, in
+// which case the node-agent uses the most recent pod volume backup of the volume as the base.
+func (b *backupper) getIncrementalBase(baseBackup string, podVolumeBackup *velerov1api.PodVolumeBackup, log logrus.FieldLogger) string {
+	log = log.WithFields(logrus.Fields{
+		"baseBackup": baseBackup,
+		"volume":     podVolumeBackup.Spec.Volume,
+	})
+
+	pvbList := new(velerov1api.PodVolumeBackupList)
+	if err := b.crClient.List(b.ctx, pvbList, ctrlclient.InNamespace(podVolumeBackup.Namespace),
+		ctrlclient.MatchingLabels{velerov1api.BackupNameLabel: label.GetValidName(baseBackup)}); err != nil {
+		log.WithError(err).Warn("Failed to list pod volume backups of the incremental base backup, the most recent one is used as the base")
+		return ""
+	}
+
+	pvcUID := podVolumeBackup.Labels[velerov1api.PVCUIDLabel]
+	for _, pvb := range pvbList.Items {
+		if pvb.Status.Phase != velerov1api.PodVolumeBackupPhaseCompleted || pvb.Status.SnapshotID == "" {
+			continue
+		}
+
+		// the snapshot is only valid for the same backup repository
+		if pvb.Spec.UploaderType != podVolumeBackup.Spec.UploaderType || pvb.Spec.BackupStorageLocation != podVolumeBackup.Spec.BackupStorageLocation ||
+			pvb.Spec.RepoIdentifier != podVolumeBackup.Spec.RepoIdentifier {
+			continue
+		}
+
+		if pvcUID != "" {
+			if pvb.Labels[velerov1api.PVCUIDLabel] != pvcUID {
+				continue
+			}
+		} else if pvb.Spec.Pod.Namespace != podVolumeBackup.Spec.Pod.Namespace || pvb.Spec.Pod.Name != podVolumeBackup.Spec.Pod.Name ||
+			pvb.Spec.Volume != podVolumeBackup.Spec.Volume {
+			continue
+		}
+
+		log.WithField("incrementalBase", pvb.Status.SnapshotID).Infof("Found pod volume backup %s as the incremental base", pvb.Name)
+		return pvb.Status.SnapshotID
+	}
+
+	log.Warn("No completed pod volume backup found for the volume in the incremental base backup, the most recent one is used as the base")
+	return ""
+}
+
 func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume corev1api.Volume, repoIdentifier, uploaderType string, pvc *corev1api.PersistentVolumeClaim) *velerov1api.PodVolumeBackup {
 	pvb := &velerov1api.PodVolumeBackup{
 		ObjectMeta: metav1.ObjectMeta{
@@ -412,3 +601,23 @@ func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume c
 
 	return pvb
 }
+
+// newPVCVolumeBackup returns the pod volume backup of the PVC which isn't mounted by any pod, the
+// source of the backup is the PVC itself.
+func newPVCVolumeBackup(backup *velerov1api.Backup, pvc *corev1api.PersistentVolumeClaim, nodeName, repoIdentifier, uploaderType string) *velerov1api.PodVolumeBackup {
+	source := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: pvc.Namespace,
+			Name:      pvc.Name,
+			UID:       pvc.UID,
+		},
+		Spec: corev1api.PodSpec{NodeName: nodeName},
+	}
+
+	pvb := newPodVolumeBackup(backup, source, corev1api.Volume{Name: pvc.Name}, repoIdentifier, uploaderType, pvc)
+	pvb.Spec.Pod.Kind = PVCSourceKind
+	delete(pvb.Spec.Tags, "pod")
+	delete(pvb.Spec.Tags, "pod-uid")
+
+	return pvb
+}
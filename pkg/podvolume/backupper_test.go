@@ -314,6 +314,16 @@ func TestBackupPodVolumes(t *testing.T) {
 	failedPVB := createPVBObj(true, false, 1, "")
 	completedPVB := createPVBObj(false, false, 1, "")
 
+	terminatedPod := createPodObj(false, true, true, 1)
+	terminatedPod.Status.Phase = corev1api.PodSucceeded
+	terminatedPodWithEmptyDir := createPodObj(false, false, false, 0)
+	terminatedPodWithEmptyDir.Status.Phase = corev1api.PodSucceeded
+	terminatedPodWithEmptyDir.Spec.Volumes = []corev1api.Volume{{Name: "fake-volume-1", VolumeSource: corev1api.VolumeSource{EmptyDir: &corev1api.EmptyDirVolumeSource{}}}}
+
+	readOnlyBackupRepo := createBackupRepoObj()
+	readOnlyBackupRepo.Name = "fake-repo"
+	readOnlyBackupRepo.Spec.ReadOnly = true
+
 	tests := []struct {
 		name            string
 		ctx             context.Context
@@ -361,6 +371,22 @@ func TestBackupPodVolumes(t *testing.T) {
 				"daemonset pod not found in running state in node fake-node-name",
 			},
 		},
+		{
+			name: "restic isn't supported in windows node",
+			volumes: []string{
+				"fake-volume-1",
+				"fake-volume-2",
+			},
+			sourcePod: createPodObj(true, false, false, 2),
+			kubeClientObj: []runtime.Object{
+				createNodeAgentPodObj(true),
+				builder.ForNode("fake-node-name").Labels(map[string]string{corev1api.LabelOSStable: "windows"}).Result(),
+			},
+			uploaderType: "restic",
+			errs: []string{
+				"pod fake-ns/fake-pod is running in the Windows node fake-node-name, which doesn't support the restic uploader",
+			},
+		},
 		{
 			name: "wrong repository type",
 			volumes: []string{
@@ -391,6 +417,26 @@ func TestBackupPodVolumes(t *testing.T) {
 				"wrong parameters, namespace \"fake-ns\", backup storage location \"\", repository type \"kopia\"",
 			},
 		},
+		{
+			name: "repository is read-only",
+			volumes: []string{
+				"fake-volume-1",
+				"fake-volume-2",
+			},
+			sourcePod: createPodObj(true, false, false, 2),
+			kubeClientObj: []runtime.Object{
+				createNodeAgentPodObj(true),
+			},
+			ctlClientObj: []runtime.Object{
+				readOnlyBackupRepo,
+			},
+			runtimeScheme: scheme,
+			uploaderType:  "kopia",
+			bsl:           "fake-bsl",
+			errs: []string{
+				"backup repository fake-repo is read-only, new backups to it are refused",
+			},
+		},
 		{
 			name: "volume not found in pod",
 			volumes: []string{
@@ -567,6 +613,46 @@ func TestBackupPodVolumes(t *testing.T) {
 				completedPVB,
 			},
 		},
+		{
+			name: "return completed pvbs of terminated pod",
+			volumes: []string{
+				"fake-volume-1",
+			},
+			sourcePod: terminatedPod,
+			kubeClientObj: []runtime.Object{
+				createNodeAgentPodObj(true),
+				createPVCObj(1),
+				createPVObj(1, false),
+			},
+			ctlClientObj: []runtime.Object{
+				createBackupRepoObj(),
+			},
+			runtimeScheme: scheme,
+			uploaderType:  "kopia",
+			bsl:           "fake-bsl",
+			retPVBs: []*velerov1api.PodVolumeBackup{
+				completedPVB,
+			},
+			pvbs: []*velerov1api.PodVolumeBackup{
+				completedPVB,
+			},
+		},
+		{
+			name: "non-PVC volume of terminated pod should be skipped",
+			volumes: []string{
+				"fake-volume-1",
+			},
+			sourcePod: terminatedPodWithEmptyDir,
+			kubeClientObj: []runtime.Object{
+				createNodeAgentPodObj(true),
+			},
+			ctlClientObj: []runtime.Object{
+				createBackupRepoObj(),
+			},
+			runtimeScheme: scheme,
+			uploaderType:  "kopia",
+			bsl:           "fake-bsl",
+		},
 	}
 	// TODO add more verification around PVCBackupSummary returned by "BackupPodVolumes"
 	for _, test := range tests {
@@ -635,6 +721,116 @@ func TestBackupPodVolumes(t *testing.T) {
 	}
 }
 
+func TestBackupPVC(t *testing.T) {
+	scheme := runtime.NewScheme()
+	velerov1api.AddToScheme(scheme)
+	corev1api.AddToScheme(scheme)
+
+	blockMode := corev1api.PersistentVolumeBlock
+	blockPVC := createPVCObj(1)
+	blockPVC.Spec.VolumeMode = &blockMode
+
+	pvWithAffinity := createPVObj(1, false)
+	pvWithAffinity.Spec.NodeAffinity = &corev1api.VolumeNodeAffinity{
+		Required: builder.ForNodeSelector(*builder.NewNodeSelectorTermBuilder().WithMatchField("metadata.name", "In", "other-node").Result()).Result(),
+	}
+
+	tests := []struct {
+		name        string
+		pvc         *corev1api.PersistentVolumeClaim
+		objects     []runtime.Object
+		retPVB      *velerov1api.PodVolumeBackup
+		expectPVB   bool
+		expectedErr string
+	}{
+		{
+			name: "block volume is skipped",
+			pvc:  blockPVC,
+		},
+		{
+			name:    "hostPath volume is skipped",
+			pvc:     createPVCObj(1),
+			objects: []runtime.Object{createPVObj(1, true)},
+		},
+		{
+			name:        "no node is found to access the volume",
+			pvc:         createPVCObj(1),
+			objects:     []runtime.Object{pvWithAffinity, createNodeAgentPodObj(true), builder.ForNode("fake-node-name").Result()},
+			expectedErr: "no node with node-agent running is found to access pv fake-pv-1",
+		},
+		{
+			name:        "pod volume backup failed",
+			pvc:         createPVCObj(1),
+			objects:     []runtime.Object{createPVObj(1, false), createNodeAgentPodObj(true), builder.ForNode("fake-node-name").Result(), createBackupRepoObj()},
+			retPVB:      createPVBObj(true, false, 1, "kopia"),
+			expectPVB:   true,
+			expectedErr: "pod volume backup failed: fake-message",
+		},
+		{
+			name:      "succeed",
+			pvc:       createPVCObj(1),
+			objects:   []runtime.Object{createPVObj(1, false), createNodeAgentPodObj(true), builder.ForNode("fake-node-name").Result(), createBackupRepoObj()},
+			retPVB:    createPVBObj(false, true, 1, "kopia"),
+			expectPVB: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeCtrlClient := ctrlfake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(test.objects...).Build()
+
+			backupObj := builder.ForBackup(velerov1api.DefaultNamespace, "fake-backup").StorageLocation("fake-bsl").Result()
+
+			b := &backupper{
+				ctx:          context.Background(),
+				repoLocker:   repository.NewRepoLocker(),
+				repoEnsurer:  repository.NewEnsurer(fakeCtrlClient, velerotest.NewLogger(), time.Millisecond),
+				crClient:     fakeCtrlClient,
+				uploaderType: "kopia",
+				results:      make(map[string]chan *velerov1api.PodVolumeBackup),
+			}
+
+			if test.retPVB != nil {
+				go func() {
+					for {
+						b.resultsLock.Lock()
+						resChan, ok := b.results[resultsKey(test.pvc.Namespace, test.pvc.Name)]
+						b.resultsLock.Unlock()
+						if ok {
+							resChan <- test.retPVB
+							return
+						}
+						time.Sleep(10 * time.Millisecond)
+					}
+				}()
+			}
+
+			pvb, err := b.BackupPVC(backupObj, test.pvc, nil, velerotest.NewLogger())
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if !test.expectPVB {
+				assert.Nil(t, pvb)
+				return
+			}
+			assert.Equal(t, test.retPVB, pvb)
+
+			pvbList := new(velerov1api.PodVolumeBackupList)
+			require.NoError(t, fakeCtrlClient.List(context.Background(), pvbList))
+			require.Len(t, pvbList.Items, 1)
+			assert.Equal(t, PVCSourceKind, pvbList.Items[0].Spec.Pod.Kind)
+			assert.Equal(t, "fake-pvc-1", pvbList.Items[0].Spec.Pod.Name)
+			assert.Equal(t, "fake-pvc-1", pvbList.Items[0].Spec.Volume)
+			assert.Equal(t, "fake-node-name", pvbList.Items[0].Spec.Node)
+			assert.Equal(t, "fake-pvc-1", pvbList.Items[0].Annotations[PVCNameAnnotation])
+			assert.NotContains(t, pvbList.Items[0].Spec.Tags, "pod")
+		})
+	}
+}
+
 func TestPVCBackupSummary(t *testing.T) {
 	pbs := NewPVCBackupSummary()
 	pbs.pvcMap["vol-1"] = builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result()
@@ -661,3 +857,78 @@ func TestPVCBackupSummary(t *testing.T) {
 	assert.Equal(t, 0, len(pbs.Skipped))
 	assert.Equal(t, 2, len(pbs.Backedup))
 }
+
+func TestGetIncrementalBase(t *testing.T) {
+	scheme := runtime.NewScheme()
+	velerov1api.AddToScheme(scheme)
+
+	basePVB := func(name string, backupName string, index int, uploaderType string) *velerov1api.PodVolumeBackup {
+		pvb := createPVBObj(false, true, index, uploaderType)
+		pvb.Name = name
+		pvb.Labels = map[string]string{velerov1api.BackupNameLabel: backupName}
+		return pvb
+	}
+
+	pvbInBaseBackup := basePVB("pvb-1", "base-backup", 1, "kopia")
+	pvbWithOtherUploader := basePVB("pvb-2", "base-backup", 2, "restic")
+	pvbInOtherBackup := basePVB("pvb-3", "other-backup", 3, "kopia")
+	pvbWithoutSnapshot := createPVBObj(false, false, 4, "kopia")
+	pvbWithoutSnapshot.Labels = map[string]string{velerov1api.BackupNameLabel: "base-backup"}
+	pvbForPVC := basePVB("pvb-5", "base-backup", 5, "kopia")
+	pvbForPVC.Labels[velerov1api.PVCUIDLabel] = "fake-pvc-uid"
+
+	tests := []struct {
+		name       string
+		baseBackup string
+		pvb        *velerov1api.PodVolumeBackup
+		pvcUID     string
+		expected   string
+	}{
+		{
+			name:       "found by pod and volume",
+			baseBackup: "base-backup",
+			pvb:        createPVBObj(false, false, 1, "kopia"),
+			expected:   "fake-snapshot-id-1",
+		},
+		{
+			name:       "uploader type doesn't match",
+			baseBackup: "base-backup",
+			pvb:        createPVBObj(false, false, 2, "kopia"),
+		},
+		{
+			name:       "not in the base backup",
+			baseBackup: "base-backup",
+			pvb:        createPVBObj(false, false, 3, "kopia"),
+		},
+		{
+			name:       "base pvb has no snapshot",
+			baseBackup: "base-backup",
+			pvb:        createPVBObj(false, false, 4, "kopia"),
+		},
+		{
+			name:       "found by pvc",
+			baseBackup: "base-backup",
+			pvb:        createPVBObj(false, false, 6, "kopia"),
+			pvcUID:     "fake-pvc-uid",
+			expected:   "fake-snapshot-id-5",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeClient := ctrlfake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(pvbInBaseBackup, pvbWithOtherUploader, pvbInOtherBackup, pvbWithoutSnapshot, pvbForPVC).Build()
+
+			b := &backupper{
+				ctx:      context.Background(),
+				crClient: fakeClient,
+			}
+
+			if test.pvcUID != "" {
+				test.pvb.Labels = map[string]string{velerov1api.PVCUIDLabel: test.pvcUID}
+			}
+
+			assert.Equal(t, test.expected, b.getIncrementalBase(test.baseBackup, test.pvb, velerotest.NewLogger()))
+		})
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podvolume
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	repokey "github.com/vmware-tanzu/velero/pkg/repository/keys"
+	repoprovider "github.com/vmware-tanzu/velero/pkg/repository/provider"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/uploader/provider"
+)
+
+const copierRequestor = "backup-copy"
+
+// SnapshotCopier copies the kopia snapshots of volumes from the repositories of a backup storage
+// location to the repositories of another one.
+type SnapshotCopier interface {
+	// CopySnapshot copies the snapshot from the repository of the volume namespace in the source
+	// backup storage location to the one in the target backup storage location, and returns the
+	// ID of the copied snapshot.
+	CopySnapshot(ctx context.Context, volumeNamespace, snapshotID string) (string, error)
+
+	// Close releases the repositories opened by the copier.
+	Close(ctx context.Context)
+}
+
+// copierRepo is a repository of a volume namespace opened by the copier.
+type copierRepo struct {
+	repo     *velerov1api.BackupRepository
+	provider provider.Provider
+}
+
+type snapshotCopier struct {
+	crClient         ctrlclient.Client
+	namespace        string
+	repoLocker       *repository.RepoLocker
+	repoEnsurer      *repository.Ensurer
+	credentialGetter *credentials.CredentialGetter
+	source           *velerov1api.BackupStorageLocation
+	target           *velerov1api.BackupStorageLocation
+	// repos are the opened repositories keyed by the backup storage location and the volume namespace
+	repos map[string]*copierRepo
+	log   logrus.FieldLogger
+}
+
+// NewSnapshotCopier returns a SnapshotCopier copying the kopia snapshots from the repositories of
+// the source backup storage location to the ones of the target backup storage location. The
+// repositories are ensured and connected when the first snapshot of their namespaces is copied.
+func NewSnapshotCopier(ctx context.Context, crClient ctrlclient.Client, repoLocker *repository.RepoLocker, repoEnsurer *repository.Ensurer,
+	credentialGetter *credentials.CredentialGetter, namespace, sourceBSL, targetBSL string, log logrus.FieldLogger) (SnapshotCopier, error) {
+	c := &snapshotCopier{
+		crClient:         crClient,
+		namespace:        namespace,
+		repoLocker:       repoLocker,
+		repoEnsurer:      repoEnsurer,
+		credentialGetter: credentialGetter,
+		repos:            map[string]*copierRepo{},
+		log:              log,
+	}
+
+	for name, bsl := range map[string]**velerov1api.BackupStorageLocation{sourceBSL: &c.source, targetBSL: &c.target} {
+		*bsl = &velerov1api.BackupStorageLocation{}
+		if err := crClient.Get(ctx, ctrlclient.ObjectKey{Namespace: namespace, Name: name}, *bsl); err != nil {
+			return nil, errors.Wrapf(err, "error getting backup storage location %s", name)
+		}
+	}
+
+	return c, nil
+}
+
+func (c *snapshotCopier) CopySnapshot(ctx context.Context, volumeNamespace, snapshotID string) (string, error) {
+	source, err := c.getRepo(ctx, c.source, volumeNamespace)
+	if err != nil {
+		return "", err
+	}
+
+	target, err := c.getRepo(ctx, c.target, volumeNamespace)
+	if err != nil {
+		return "", err
+	}
+
+	c.repoLocker.Lock(source.repo.Name)
+	defer c.repoLocker.Unlock(source.repo.Name)
+
+	c.repoLocker.Lock(target.repo.Name)
+	defer c.repoLocker.Unlock(target.repo.Name)
+
+	copyID, err := source.provider.RunReplicate(ctx, snapshotID, target.provider, c)
+	if err != nil {
+		return "", errors.Wrapf(err, "error copying snapshot %s of namespace %s", snapshotID, volumeNamespace)
+	}
+
+	c.log.WithFields(logrus.Fields{
+		"volumeNamespace": volumeNamespace,
+		"snapshotID":      snapshotID,
+		"copySnapshotID":  copyID,
+	}).Info("Snapshot is copied to the target backup storage location")
+
+	return copyID, nil
+}
+
+// getRepo returns the opened kopia repository of the volume namespace in the backup storage location.
+func (c *snapshotCopier) getRepo(ctx context.Context, bsl *velerov1api.BackupStorageLocation, volumeNamespace string) (*copierRepo, error) {
+	key := bsl.Name + "/" + volumeNamespace
+	if repo, ok := c.repos[key]; ok {
+		return repo, nil
+	}
+
+	repo, err := c.repoEnsurer.EnsureRepo(ctx, c.namespace, volumeNamespace, bsl.Name, velerov1api.BackupRepositoryTypeKopia)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error to ensure kopia repository of namespace %s in backup storage location %s", volumeNamespace, bsl.Name)
+	}
+
+	param := repoprovider.RepoParam{BackupLocation: bsl, BackupRepo: repo}
+	if err := repoprovider.NewUnifiedRepoProvider(*c.credentialGetter, velerov1api.BackupRepositoryTypeKopia, c.log).BoostRepoConnect(ctx, param); err != nil {
+		return nil, errors.Wrapf(err, "error to connect kopia repository %s", repo.Name)
+	}
+
+	prov, err := provider.NewUploaderProvider(ctx, c.crClient, uploader.KopiaType, copierRequestor, "",
+		bsl, repo, c.credentialGetter, repokey.RepoKeySelectorForRepo(repo), uploader.BandwidthLimits{}, uploader.CacheOptions{}, c.log)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating kopia uploader for repository %s", repo.Name)
+	}
+
+	c.repos[key] = &copierRepo{repo: repo, provider: prov}
+	return c.repos[key], nil
+}
+
+// UpdateProgress implements the ProgressUpdater interface, the progress of the copy is reported
+// per snapshot instead
+func (c *snapshotCopier) UpdateProgress(p *uploader.Progress) {}
+
+func (c *snapshotCopier) Close(ctx context.Context) {
+	for key, repo := range c.repos {
+		if err := repo.provider.Close(ctx); err != nil {
+			c.log.WithError(err).Errorf("Failed to close kopia uploader of %s", key)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podvolume
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	repokey "github.com/vmware-tanzu/velero/pkg/repository/keys"
+	repoprovider "github.com/vmware-tanzu/velero/pkg/repository/provider"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/uploader/provider"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+const (
+	// MigratedResticSnapshotAnnotation is the key for the annotation added to pod volume
+	// backups whose restic snapshots are migrated to the kopia repository, it records the
+	// ID of the original restic snapshot.
+	MigratedResticSnapshotAnnotation = "velero.io/migrated-restic-snapshot-id"
+
+	migrationRequestor = "repo-migration"
+)
+
+// Migrator migrates the restic snapshots of pod volume backups into the kopia repository.
+type Migrator interface {
+	// MigratePodVolumeBackup rewrites the restic snapshot of the pod volume backup into the
+	// kopia repository, and updates the pod volume backup to reference the kopia snapshot.
+	MigratePodVolumeBackup(ctx context.Context, pvb *velerov1api.PodVolumeBackup) error
+
+	// Close releases the repositories opened by the migrator.
+	Close(ctx context.Context)
+}
+
+type migrator struct {
+	crClient       ctrlclient.Client
+	repoLocker     *repository.RepoLocker
+	resticRepo     *velerov1api.BackupRepository
+	kopiaRepo      *velerov1api.BackupRepository
+	resticProvider provider.Provider
+	kopiaProvider  provider.Provider
+	log            logrus.FieldLogger
+}
+
+// NewMigrator ensures and connects the restic and the kopia repositories of the volume namespace in
+// the backup storage location, and returns a Migrator migrating snapshots between them.
+func NewMigrator(ctx context.Context, crClient ctrlclient.Client, repoLocker *repository.RepoLocker, repoEnsurer *repository.Ensurer,
+	credentialGetter *credentials.CredentialGetter, namespace, volumeNamespace, bslName string, log logrus.FieldLogger) (Migrator, error) {
+	bsl := &velerov1api.BackupStorageLocation{}
+	if err := crClient.Get(ctx, ctrlclient.ObjectKey{Namespace: namespace, Name: bslName}, bsl); err != nil {
+		return nil, errors.Wrapf(err, "error getting backup storage location %s", bslName)
+	}
+
+	m := &migrator{
+		crClient:   crClient,
+		repoLocker: repoLocker,
+		log:        log,
+	}
+
+	var err error
+	m.resticRepo, err = repoEnsurer.EnsureRepo(ctx, namespace, volumeNamespace, bslName, velerov1api.BackupRepositoryTypeRestic)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to ensure restic repository")
+	}
+
+	m.kopiaRepo, err = repoEnsurer.EnsureRepo(ctx, namespace, volumeNamespace, bslName, velerov1api.BackupRepositoryTypeKopia)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to ensure kopia repository")
+	}
+
+	param := repoprovider.RepoParam{BackupLocation: bsl, BackupRepo: m.resticRepo}
+	if err := repoprovider.NewResticRepositoryProvider(credentialGetter.FromFile, filesystem.NewFileSystem(), log).BoostRepoConnect(ctx, param); err != nil {
+		return nil, errors.Wrap(err, "error to connect restic repository")
+	}
+
+	param = repoprovider.RepoParam{BackupLocation: bsl, BackupRepo: m.kopiaRepo}
+	if err := repoprovider.NewUnifiedRepoProvider(*credentialGetter, velerov1api.BackupRepositoryTypeKopia, log).BoostRepoConnect(ctx, param); err != nil {
+		return nil, errors.Wrap(err, "error to connect kopia repository")
+	}
+
+	m.resticProvider, err = provider.NewUploaderProvider(ctx, crClient, uploader.ResticType, migrationRequestor, m.resticRepo.Spec.ResticIdentifier,
+		bsl, m.resticRepo, credentialGetter, repokey.RepoKeySelectorForRepo(m.resticRepo), uploader.BandwidthLimits{}, uploader.CacheOptions{}, log)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating restic uploader")
+	}
+
+	m.kopiaProvider, err = provider.NewUploaderProvider(ctx, crClient, uploader.KopiaType, migrationRequestor, "",
+		bsl, m.kopiaRepo, credentialGetter, repokey.RepoKeySelectorForRepo(m.kopiaRepo), uploader.BandwidthLimits{}, uploader.CacheOptions{}, log)
+	if err != nil {
+		m.Close(ctx)
+		return nil, errors.Wrap(err, "error creating kopia uploader")
+	}
+
+	return m, nil
+}
+
+func (m *migrator) MigratePodVolumeBackup(ctx context.Context, pvb *velerov1api.PodVolumeBackup) error {
+	if pvb.Status.SnapshotID == "" {
+		return errors.Errorf("pod volume backup %s has no snapshot", pvb.Name)
+	}
+
+	log := m.log.WithFields(logrus.Fields{
+		"podVolumeBackup": pvb.Name,
+		"snapshotID":      pvb.Status.SnapshotID,
+	})
+
+	m.repoLocker.Lock(m.resticRepo.Name)
+	defer m.repoLocker.Unlock(m.resticRepo.Name)
+
+	m.repoLocker.Lock(m.kopiaRepo.Name)
+	defer m.repoLocker.Unlock(m.kopiaRepo.Name)
+
+	// the restic snapshot is restored into a temporary directory, which is then uploaded into the kopia repository
+	dir, err := os.MkdirTemp("", "velero-repo-migration-")
+	if err != nil {
+		return errors.Wrap(err, "error creating temporary directory")
+	}
+	defer os.RemoveAll(dir)
+
+	if err := m.resticProvider.RunRestore(ctx, pvb.Status.SnapshotID, dir, uploader.PersistentVolumeFilesystem, m); err != nil {
+		return errors.Wrapf(err, "error restoring restic snapshot %s", pvb.Status.SnapshotID)
+	}
+
+	realSource := fmt.Sprintf("%s/%s/%s", pvb.Spec.Pod.Namespace, pvb.Spec.Pod.Name, pvb.Spec.Volume)
+	snapshotInfo, _, err := m.kopiaProvider.RunBackup(ctx, dir, realSource, pvb.Spec.Tags, false, "", uploader.PersistentVolumeFilesystem, m)
+	if err != nil {
+		return errors.Wrapf(err, "error uploading restic snapshot %s to kopia repository", pvb.Status.SnapshotID)
+	}
+	var snapshotID string
+	if snapshotInfo != nil {
+		snapshotID = snapshotInfo.ID
+	}
+
+	original := pvb.DeepCopy()
+	if pvb.Annotations == nil {
+		pvb.Annotations = map[string]string{}
+	}
+	pvb.Annotations[MigratedResticSnapshotAnnotation] = pvb.Status.SnapshotID
+	pvb.Spec.UploaderType = uploader.KopiaType
+	pvb.Spec.RepoIdentifier = ""
+	pvb.Status.SnapshotID = snapshotID
+	if err := m.crClient.Patch(ctx, pvb, ctrlclient.MergeFrom(original)); err != nil {
+		return errors.Wrapf(err, "error updating pod volume backup %s", pvb.Name)
+	}
+
+	log.WithField("kopiaSnapshotID", snapshotID).Info("Restic snapshot is migrated to kopia repository")
+
+	return nil
+}
+
+// UpdateProgress implements the ProgressUpdater interface, the progress of the migration
+// is reported per pod volume backup instead
+func (m *migrator) UpdateProgress(p *uploader.Progress) {}
+
+func (m *migrator) Close(ctx context.Context) {
+	if m.resticProvider != nil {
+		if err := m.resticProvider.Close(ctx); err != nil {
+			m.log.WithError(err).Error("Failed to close restic uploader")
+		}
+	}
+
+	if m.kopiaProvider != nil {
+		if err := m.kopiaProvider.Close(ctx); err != nil {
+			m.log.WithError(err).Error("Failed to close kopia uploader")
+		}
+	}
+}
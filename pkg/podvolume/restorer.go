@@ -45,6 +45,8 @@ type RestoreData struct {
 	Pod                             *corev1api.Pod
 	PodVolumeBackups                []*velerov1api.PodVolumeBackup
 	SourceNamespace, BackupLocation string
+	// HostHooks are the host restore hooks to execute on the restored volumes, grouped by volume name.
+	HostHooks map[string][]velerov1api.HostRestoreHook
 }
 
 // Restorer can execute pod volume restores of volumes in a pod.
@@ -59,6 +61,8 @@ type restorer struct {
 	repoEnsurer *repository.Ensurer
 	kubeClient  kubernetes.Interface
 	crClient    ctrlclient.Client
+	// podWaitTimeout is how long to wait for the restored pods to be scheduled
+	podWaitTimeout time.Duration
 
 	resultsLock    sync.Mutex
 	results        map[string]chan *velerov1api.PodVolumeRestore
@@ -73,15 +77,17 @@ func newRestorer(
 	pvrInformer ctrlcache.Informer,
 	kubeClient kubernetes.Interface,
 	crClient ctrlclient.Client,
+	podWaitTimeout time.Duration,
 	restore *velerov1api.Restore,
 	log logrus.FieldLogger,
 ) *restorer {
 	r := &restorer{
-		ctx:         ctx,
-		repoLocker:  repoLocker,
-		repoEnsurer: repoEnsurer,
-		kubeClient:  kubeClient,
-		crClient:    crClient,
+		ctx:            ctx,
+		repoLocker:     repoLocker,
+		repoEnsurer:    repoEnsurer,
+		kubeClient:     kubeClient,
+		crClient:       crClient,
+		podWaitTimeout: podWaitTimeout,
 
 		results: make(map[string]chan *velerov1api.PodVolumeRestore),
 		log:     log,
@@ -177,6 +183,7 @@ func (r *restorer) RestorePodVolumes(data RestoreData) []error {
 		}
 
 		volumeRestore := newPodVolumeRestore(data.Restore, data.Pod, data.BackupLocation, volume, backupInfo.snapshotID, repoIdentifier, backupInfo.uploaderType, data.SourceNamespace, pvc)
+		volumeRestore.Spec.HostHooks = data.HostHooks[volume]
 
 		if err := veleroclient.CreateRetryGenerateName(r.crClient, r.ctx, volumeRestore); err != nil {
 			errs = append(errs, errors.WithStack(err))
@@ -204,7 +211,7 @@ func (r *restorer) RestorePodVolumes(data RestoreData) []error {
 			return true, nil
 		}
 
-		err := wait.PollWithContext(checkCtx, time.Millisecond*500, time.Minute*10, checkFunc)
+		err := wait.PollWithContext(checkCtx, time.Millisecond*500, r.podWaitTimeout, checkFunc)
 		if err == wait.ErrWaitTimeout {
 			r.log.WithError(err).Error("Restoring pod is not scheduled until timeout or cancel, disengage")
 		} else if err != nil {
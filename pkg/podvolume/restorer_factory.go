@@ -18,6 +18,7 @@ package podvolume
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -41,28 +42,31 @@ func NewRestorerFactory(repoLocker *repository.RepoLocker,
 	kubeClient kubernetes.Interface,
 	crClient ctrlclient.Client,
 	pvrInformer ctrlcache.Informer,
+	podWaitTimeout time.Duration,
 	log logrus.FieldLogger) RestorerFactory {
 	return &restorerFactory{
-		repoLocker:  repoLocker,
-		repoEnsurer: repoEnsurer,
-		kubeClient:  kubeClient,
-		crClient:    crClient,
-		pvrInformer: pvrInformer,
-		log:         log,
+		repoLocker:     repoLocker,
+		repoEnsurer:    repoEnsurer,
+		kubeClient:     kubeClient,
+		crClient:       crClient,
+		pvrInformer:    pvrInformer,
+		podWaitTimeout: podWaitTimeout,
+		log:            log,
 	}
 }
 
 type restorerFactory struct {
-	repoLocker  *repository.RepoLocker
-	repoEnsurer *repository.Ensurer
-	kubeClient  kubernetes.Interface
-	crClient    ctrlclient.Client
-	pvrInformer ctrlcache.Informer
-	log         logrus.FieldLogger
+	repoLocker     *repository.RepoLocker
+	repoEnsurer    *repository.Ensurer
+	kubeClient     kubernetes.Interface
+	crClient       ctrlclient.Client
+	pvrInformer    ctrlcache.Informer
+	podWaitTimeout time.Duration
+	log            logrus.FieldLogger
 }
 
 func (rf *restorerFactory) NewRestorer(ctx context.Context, restore *velerov1api.Restore) (Restorer, error) {
-	r := newRestorer(ctx, rf.repoLocker, rf.repoEnsurer, rf.pvrInformer, rf.kubeClient, rf.crClient, restore, rf.log)
+	r := newRestorer(ctx, rf.repoLocker, rf.repoEnsurer, rf.pvrInformer, rf.kubeClient, rf.crClient, rf.podWaitTimeout, restore, rf.log)
 
 	if !cache.WaitForCacheSync(ctx.Done(), rf.pvrInformer.HasSynced) {
 		return nil, errors.New("timed out waiting for cache to sync")
@@ -395,7 +395,7 @@ func TestRestorePodVolumes(t *testing.T) {
 			restoreObj := builder.ForRestore(velerov1api.DefaultNamespace, "fake-restore").Result()
 
 			factory := NewRestorerFactory(repository.NewRepoLocker(), ensurer, kubeClient,
-				fakeCRClient, pvrInformer, velerotest.NewLogger())
+				fakeCRClient, pvrInformer, time.Minute*10, velerotest.NewLogger())
 			rs, err := factory.NewRestorer(ctx, restoreObj)
 
 			require.NoError(t, err)
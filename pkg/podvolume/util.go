@@ -32,6 +32,10 @@ const (
 	// pod volume backups when they're for a PVC.
 	PVCNameAnnotation = "velero.io/pvc-name"
 
+	// PVCSourceKind is the kind of the source of the pod volume backups for the
+	// PVCs that aren't mounted by any pod, the source is the PVC itself.
+	PVCSourceKind = "PersistentVolumeClaim"
+
 	// Deprecated.
 	//
 	// TODO(2.0): remove
@@ -168,7 +172,7 @@ func getRepositoryType(uploaderType string) string {
 }
 
 func isPVBMatchPod(pvb *velerov1api.PodVolumeBackup, podName string, namespace string) bool {
-	return podName == pvb.Spec.Pod.Name && namespace == pvb.Spec.Pod.Namespace
+	return pvb.Spec.Pod.Kind != PVCSourceKind && podName == pvb.Spec.Pod.Name && namespace == pvb.Spec.Pod.Namespace
 }
 
 // volumeHasNonRestorableSource checks if the given volume exists in the list of podVolumes
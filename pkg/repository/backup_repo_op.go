@@ -106,6 +106,15 @@ func NewBackupRepository(namespace string, key BackupRepositoryKey) *velerov1api
 	}
 }
 
+// EnsureWritable returns an error if the backup repository is read-only, the new backups to a
+// read-only repository are refused.
+func EnsureWritable(repo *velerov1api.BackupRepository) error {
+	if repo.Spec.ReadOnly {
+		return errors.Errorf("backup repository %s is read-only, new backups to it are refused", repo.Name)
+	}
+	return nil
+}
+
 func isBackupRepositoryNotFoundError(err error) bool {
 	return err == errBackupRepoNotFound
 }
@@ -26,6 +26,8 @@ import (
 	s3manager "github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
 )
 
 const (
@@ -76,6 +78,12 @@ func GetS3Credentials(config map[string]string) (*aws.Credentials, error) {
 		return nil, nil
 	}
 
+	// the AWS SDK doesn't support the authorization token file of the EKS Pod Identity agent yet
+	if credentials.GetAmbientIdentity(string(AWSBackend), config) == credentials.AmbientIdentityAWSPodIdentity {
+		creds, err := credentials.GetAWSPodIdentityCredentials(context.Background())
+		return &creds, err
+	}
+
 	var opts []func(*awsconfig.LoadOptions) error
 	credentialsFile := config[CredentialsFileKey]
 	if credentialsFile == "" {
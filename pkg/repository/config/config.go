@@ -24,6 +24,7 @@ import (
 	"github.com/pkg/errors"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/objectstore/oci"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 )
 
@@ -35,6 +36,10 @@ const (
 	GCPBackend   BackendType = "velero.io/gcp"
 	FSBackend    BackendType = "velero.io/fs"
 
+	// ociProvider is the provider of OCI Object Storage, which is accessed through
+	// its Amazon S3 Compatibility API
+	ociProvider = "velero.io/oci"
+
 	// CredentialsFileKey is the key within a BSL config that is checked to see if
 	// the BSL is using its own credentials, rather than those in the environment
 	CredentialsFileKey = "credentialsFile"
@@ -66,7 +71,7 @@ func getRepoPrefix(location *velerov1api.BackupStorageLocation) (string, error)
 	case AWSBackend:
 		var url string
 		// non-AWS, S3-compatible object store
-		if s3Url := location.Spec.Config["s3Url"]; s3Url != "" {
+		if s3Url := GetS3URL(location.Spec.Provider, location.Spec.Config); s3Url != "" {
 			url = strings.TrimSuffix(s3Url, "/")
 		} else {
 			var err error
@@ -93,6 +98,7 @@ func getRepoPrefix(location *velerov1api.BackupStorageLocation) (string, error)
 // GetBackendType returns a backend type that is known by Velero.
 // If the provider doesn't indicate a known backend type, but the endpoint is
 // specified, Velero regards it as a S3 compatible object store and return AWSBackend as the type.
+// OCI Object Storage is accessed through its S3 Compatibility API, so AWSBackend is returned for it too.
 func GetBackendType(provider string, config map[string]string) BackendType {
 	if !strings.Contains(provider, "/") {
 		provider = "velero.io/" + provider
@@ -101,13 +107,30 @@ func GetBackendType(provider string, config map[string]string) BackendType {
 	bt := BackendType(provider)
 	if IsBackendTypeValid(bt) {
 		return bt
-	} else if config != nil && config["s3Url"] != "" {
+	} else if provider == ociProvider || config != nil && config["s3Url"] != "" {
 		return AWSBackend
 	} else {
 		return bt
 	}
 }
 
+// GetS3URL returns the URL of the S3 compatible object store specified by "s3Url" in the config.
+// For OCI Object Storage, it's derived from the namespace and the region if it isn't specified.
+func GetS3URL(provider string, config map[string]string) string {
+	if s3URL := config["s3Url"]; s3URL != "" {
+		return s3URL
+	}
+
+	if !strings.Contains(provider, "/") {
+		provider = "velero.io/" + provider
+	}
+	if provider == ociProvider && config["namespace"] != "" {
+		return oci.S3CompatibilityEndpoint(config["namespace"], config["region"])
+	}
+
+	return ""
+}
+
 func IsBackendTypeValid(backendType BackendType) bool {
 	return (backendType == AWSBackend || backendType == AzureBackend || backendType == GCPBackend || backendType == FSBackend)
 }
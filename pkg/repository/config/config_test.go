@@ -88,6 +88,26 @@ func TestGetRepoIdentifier(t *testing.T) {
 			repoName: "repo-1",
 			expected: "s3:s3Url/bucket/prefix/restic/repo-1",
 		},
+		{
+			name: "S3 Compatibility API URL is derived from the namespace and region for OCI BSL",
+			bsl: &velerov1api.BackupStorageLocation{
+				Spec: velerov1api.BackupStorageLocationSpec{
+					Provider: "oci",
+					Config: map[string]string{
+						"namespace": "ns",
+						"region":    "us-ashburn-1",
+					},
+					StorageType: velerov1api.StorageType{
+						ObjectStorage: &velerov1api.ObjectStorageLocation{
+							Bucket: "bucket",
+							Prefix: "prefix",
+						},
+					},
+				},
+			},
+			repoName: "repo-1",
+			expected: "s3:https://ns.compat.objectstorage.us-ashburn-1.oraclecloud.com/bucket/prefix/restic/repo-1",
+		},
 		{
 			name: "s3.amazonaws.com URL format is used if region cannot be determined for AWS BSL",
 			bsl: &velerov1api.BackupStorageLocation{
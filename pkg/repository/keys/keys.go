@@ -26,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 )
 
@@ -74,3 +75,14 @@ func RepoKeySelector() *corev1api.SecretKeySelector {
 	// for that repo.
 	return builder.ForSecretKeySelector(credentialsSecretName, credentialsKey).Result()
 }
+
+// RepoKeySelectorForRepo returns the SecretKeySelector which can be used to fetch
+// the key of the backup repository. The repository's own key is selected if it has
+// one, otherwise the key shared by all the backup repos is selected.
+func RepoKeySelectorForRepo(repo *velerov1api.BackupRepository) *corev1api.SecretKeySelector {
+	if repo != nil && repo.Spec.RepositoryCredential != nil {
+		return repo.Spec.RepositoryCredential
+	}
+
+	return RepoKeySelector()
+}
@@ -20,6 +20,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
 )
 
 func TestRepoKeySelector(t *testing.T) {
@@ -28,3 +31,17 @@ func TestRepoKeySelector(t *testing.T) {
 	require.Equal(t, credentialsSecretName, selector.Name)
 	require.Equal(t, credentialsKey, selector.Key)
 }
+
+func TestRepoKeySelectorForRepo(t *testing.T) {
+	selector := RepoKeySelectorForRepo(&velerov1api.BackupRepository{})
+
+	require.Equal(t, credentialsSecretName, selector.Name)
+	require.Equal(t, credentialsKey, selector.Key)
+
+	repoKey := builder.ForSecretKeySelector("tenant-a-repo-credentials", "repository-password").Result()
+	selector = RepoKeySelectorForRepo(&velerov1api.BackupRepository{
+		Spec: velerov1api.BackupRepositorySpec{RepositoryCredential: repoKey},
+	})
+
+	require.Equal(t, repoKey, selector)
+}
@@ -0,0 +1,252 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+const (
+	// the maintenance jobs run with the pod template of the container of the Velero server
+	veleroServerDeployment = "velero"
+	veleroServerContainer  = "velero"
+
+	maintenanceJobContainer = "velero-repo-maintenance"
+
+	// maintenanceJobTTL is how long the finished maintenance jobs are kept for troubleshooting
+	maintenanceJobTTL = 24 * time.Hour
+
+	maintenanceJobPollInterval = 10 * time.Second
+)
+
+// MaintenanceRunner runs the maintenance of the backup repositories.
+type MaintenanceRunner interface {
+	// RunMaintenance runs the maintenance of the repository and waits for it to complete, a full
+	// maintenance is run if full is true, otherwise the repository decides the mode. It returns
+	// the bytes freed from the storage, udmrepo.UnknownFreedBytes if they are unknown.
+	RunMaintenance(ctx context.Context, repo *velerov1api.BackupRepository, full bool) (int64, error)
+}
+
+// MaintenanceResult is the result of a maintenance written by the maintenance job as the
+// termination message of its pod.
+type MaintenanceResult struct {
+	FreedBytes int64 `json:"freedBytes"`
+}
+
+type maintenanceJobRunner struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+	timeout    time.Duration
+	log        logrus.FieldLogger
+}
+
+// NewMaintenanceJobRunner returns the runner running the maintenance of each repository in a job
+// created from the pod template of the Velero server, so that the resources consumed by the
+// maintenance are limited per repository and not accounted to the server.
+func NewMaintenanceJobRunner(kubeClient kubernetes.Interface, namespace string, timeout time.Duration, log logrus.FieldLogger) MaintenanceRunner {
+	return &maintenanceJobRunner{
+		kubeClient: kubeClient,
+		namespace:  namespace,
+		timeout:    timeout,
+		log:        log,
+	}
+}
+
+func (r *maintenanceJobRunner) RunMaintenance(ctx context.Context, repo *velerov1api.BackupRepository, full bool) (int64, error) {
+	deployment, err := r.kubeClient.AppsV1().Deployments(r.namespace).Get(ctx, veleroServerDeployment, metav1.GetOptions{})
+	if err != nil {
+		return udmrepo.UnknownFreedBytes, errors.Wrapf(err, "error getting deployment %s/%s", r.namespace, veleroServerDeployment)
+	}
+
+	job, err := buildMaintenanceJob(repo, full, &deployment.Spec.Template.Spec)
+	if err != nil {
+		return udmrepo.UnknownFreedBytes, err
+	}
+
+	job, err = r.kubeClient.BatchV1().Jobs(r.namespace).Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		return udmrepo.UnknownFreedBytes, errors.Wrap(err, "error creating maintenance job")
+	}
+
+	log := r.log.WithFields(logrus.Fields{"backupRepo": repo.Name, "job": job.Name})
+	log.Info("Maintenance job is created")
+
+	message, err := r.waitMaintenanceJob(ctx, job.Name)
+	if err != nil {
+		return udmrepo.UnknownFreedBytes, err
+	}
+
+	result := MaintenanceResult{FreedBytes: udmrepo.UnknownFreedBytes}
+	if err := json.Unmarshal([]byte(message), &result); err != nil {
+		log.WithError(err).Warn("Failed to parse the result of the maintenance job")
+	}
+
+	log.WithField("freedBytes", result.FreedBytes).Info("Maintenance job is completed")
+	return result.FreedBytes, nil
+}
+
+// waitMaintenanceJob waits for the maintenance job to complete and returns the termination
+// message of its pod, which is returned as the error if the job fails.
+func (r *maintenanceJobRunner) waitMaintenanceJob(ctx context.Context, name string) (string, error) {
+	var failed bool
+	err := wait.PollImmediate(maintenanceJobPollInterval, r.timeout, func() (bool, error) {
+		job, err := r.kubeClient.BatchV1().Jobs(r.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, errors.Wrapf(err, "error getting maintenance job %s", name)
+		}
+
+		failed = job.Status.Failed > 0
+		return job.Status.Succeeded > 0 || failed, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return "", errors.Errorf("maintenance job %s is not completed in %s", name, r.timeout)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	message, err := r.getTerminationMessage(ctx, name)
+	if err != nil {
+		if failed {
+			return "", errors.Wrapf(err, "maintenance job %s failed", name)
+		}
+		return "", err
+	}
+
+	if failed {
+		if message == "" {
+			return "", errors.Errorf("maintenance job %s failed", name)
+		}
+		return "", errors.Errorf("maintenance job %s failed: %s", name, message)
+	}
+
+	return message, nil
+}
+
+func (r *maintenanceJobRunner) getTerminationMessage(ctx context.Context, jobName string) (string, error) {
+	pods, err := r.kubeClient.CoreV1().Pods(r.namespace).List(ctx, metav1.ListOptions{LabelSelector: "job-name=" + jobName})
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting the pods of maintenance job %s", jobName)
+	}
+
+	for _, pod := range pods.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Terminated != nil && status.State.Terminated.Message != "" {
+				return strings.TrimSpace(status.State.Terminated.Message), nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// buildMaintenanceJob returns the job running the maintenance of the repository with the image,
+// environment, volumes and service account of the Velero server in its pod spec.
+func buildMaintenanceJob(repo *velerov1api.BackupRepository, full bool, serverPodSpec *corev1api.PodSpec) (*batchv1api.Job, error) {
+	var server *corev1api.Container
+	for i := range serverPodSpec.Containers {
+		if serverPodSpec.Containers[i].Name == veleroServerContainer {
+			server = &serverPodSpec.Containers[i]
+			break
+		}
+	}
+	if server == nil {
+		return nil, errors.Errorf("container %s isn't found in deployment %s", veleroServerContainer, veleroServerDeployment)
+	}
+
+	args := []string{"repo-maintenance", "--repo-name=" + repo.Name, fmt.Sprintf("--full=%t", full)}
+	for _, arg := range server.Args {
+		if strings.HasPrefix(arg, "--log-level") || strings.HasPrefix(arg, "--log-format") {
+			args = append(args, arg)
+		}
+	}
+
+	container := corev1api.Container{
+		Name:                     maintenanceJobContainer,
+		Image:                    server.Image,
+		ImagePullPolicy:          server.ImagePullPolicy,
+		Command:                  server.Command,
+		Args:                     args,
+		Env:                      server.Env,
+		EnvFrom:                  server.EnvFrom,
+		VolumeMounts:             server.VolumeMounts,
+		SecurityContext:          server.SecurityContext,
+		TerminationMessagePolicy: corev1api.TerminationMessageFallbackToLogsOnError,
+	}
+	if repo.Spec.MaintenanceJobResources != nil {
+		container.Resources = *repo.Spec.MaintenanceJobResources.DeepCopy()
+	}
+
+	labels := map[string]string{velerov1api.RepositoryNameLabel: label.GetValidName(repo.Name)}
+	backoffLimit := int32(0)
+	ttl := int32(maintenanceJobTTL.Seconds())
+
+	return &batchv1api.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-maintain-", label.GetValidName(repo.Name)),
+			Namespace:    repo.Namespace,
+			Labels:       labels,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: velerov1api.SchemeGroupVersion.String(),
+					Kind:       "BackupRepository",
+					Name:       repo.Name,
+					UID:        repo.UID,
+					Controller: boolptr.True(),
+				},
+			},
+		},
+		Spec: batchv1api.JobSpec{
+			BackoffLimit:            &backoffLimit,
+			TTLSecondsAfterFinished: &ttl,
+			Template: corev1api.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1api.PodSpec{
+					RestartPolicy:      corev1api.RestartPolicyNever,
+					Containers:         []corev1api.Container{container},
+					Volumes:            serverPodSpec.Volumes,
+					ServiceAccountName: serverPodSpec.ServiceAccountName,
+					ImagePullSecrets:   serverPodSpec.ImagePullSecrets,
+					SecurityContext:    serverPodSpec.SecurityContext,
+					NodeSelector:       serverPodSpec.NodeSelector,
+					Tolerations:        serverPodSpec.Tolerations,
+					Affinity:           serverPodSpec.Affinity,
+					PriorityClassName:  serverPodSpec.PriorityClassName,
+				},
+			},
+		},
+	}, nil
+}
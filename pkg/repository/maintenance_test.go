@@ -0,0 +1,166 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1api "k8s.io/api/apps/v1"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clientgotesting "k8s.io/client-go/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func serverDeployment() *appsv1api.Deployment {
+	return &appsv1api.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "velero"},
+		Spec: appsv1api.DeploymentSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					ServiceAccountName: "velero-sa",
+					Containers: []corev1api.Container{
+						{
+							Name:    "velero",
+							Image:   "velero/velero:main",
+							Command: []string{"/velero"},
+							Args:    []string{"server", "--log-level=debug", "--uploader-type=kopia"},
+							Env:     []corev1api.EnvVar{{Name: "VELERO_SCRATCH_DIR", Value: "/scratch"}},
+						},
+					},
+					Volumes: []corev1api.Volume{{Name: "scratch"}},
+				},
+			},
+		},
+	}
+}
+
+func TestBuildMaintenanceJob(t *testing.T) {
+	repo := &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "app-default-kopia-abcde", UID: "repo-uid"},
+		Spec: velerov1api.BackupRepositorySpec{
+			MaintenanceJobResources: &corev1api.ResourceRequirements{
+				Limits: corev1api.ResourceList{corev1api.ResourceMemory: resource.MustParse("1Gi")},
+			},
+		},
+	}
+
+	job, err := buildMaintenanceJob(repo, true, &serverDeployment().Spec.Template.Spec)
+	require.NoError(t, err)
+
+	assert.Equal(t, "app-default-kopia-abcde-maintain-", job.GenerateName)
+	assert.Equal(t, "app-default-kopia-abcde", job.Labels[velerov1api.RepositoryNameLabel])
+	assert.Equal(t, "repo-uid", string(job.OwnerReferences[0].UID))
+	assert.Equal(t, int32(0), *job.Spec.BackoffLimit)
+
+	podSpec := job.Spec.Template.Spec
+	assert.Equal(t, corev1api.RestartPolicyNever, podSpec.RestartPolicy)
+	assert.Equal(t, "velero-sa", podSpec.ServiceAccountName)
+	assert.Equal(t, "scratch", podSpec.Volumes[0].Name)
+
+	container := podSpec.Containers[0]
+	assert.Equal(t, "velero/velero:main", container.Image)
+	assert.Equal(t, []string{"/velero"}, container.Command)
+	assert.Equal(t, []string{"repo-maintenance", "--repo-name=app-default-kopia-abcde", "--full=true", "--log-level=debug"}, container.Args)
+	assert.Equal(t, "/scratch", container.Env[0].Value)
+	assert.Equal(t, resource.MustParse("1Gi"), container.Resources.Limits[corev1api.ResourceMemory])
+
+	_, err = buildMaintenanceJob(repo, false, &corev1api.PodSpec{})
+	assert.EqualError(t, err, "container velero isn't found in deployment velero")
+}
+
+func TestRunMaintenance(t *testing.T) {
+	repo := &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "repo"},
+	}
+
+	terminatedPod := func(message string) *corev1api.Pod {
+		return &corev1api.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "repo-maintain-1-abcde", Labels: map[string]string{"job-name": "repo-maintain-1"}},
+			Status: corev1api.PodStatus{
+				ContainerStatuses: []corev1api.ContainerStatus{
+					{State: corev1api.ContainerState{Terminated: &corev1api.ContainerStateTerminated{Message: message}}},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name               string
+		succeeded          bool
+		pods               []runtime.Object
+		expectedFreedBytes int64
+		expectedErr        string
+	}{
+		{
+			name:               "job succeeds",
+			succeeded:          true,
+			pods:               []runtime.Object{terminatedPod(`{"freedBytes":1024}`)},
+			expectedFreedBytes: 1024,
+		},
+		{
+			name:               "job succeeds without result",
+			succeeded:          true,
+			expectedFreedBytes: -1,
+		},
+		{
+			name:        "job fails",
+			pods:        []runtime.Object{terminatedPod("error running maintenance\n")},
+			expectedErr: "maintenance job repo-maintain-1 failed: error running maintenance",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kubeClient := fake.NewSimpleClientset(append(test.pods, serverDeployment())...)
+			// act as the job controller, the jobs are completed once created
+			kubeClient.PrependReactor("create", "jobs", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				job := action.(clientgotesting.CreateAction).GetObject().(*batchv1api.Job)
+				job.Name = job.GenerateName + "1"
+				if test.succeeded {
+					job.Status.Succeeded = 1
+				} else {
+					job.Status.Failed = 1
+				}
+				return false, nil, nil
+			})
+
+			runner := NewMaintenanceJobRunner(kubeClient, velerov1api.DefaultNamespace, time.Minute, velerotest.NewLogger())
+			freedBytes, err := runner.RunMaintenance(context.Background(), repo, false)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedFreedBytes, freedBytes)
+
+			jobs, err := kubeClient.BatchV1().Jobs(velerov1api.DefaultNamespace).List(context.Background(), metav1.ListOptions{})
+			require.NoError(t, err)
+			assert.Len(t, jobs.Items, 1)
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/repository/provider"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
 
@@ -63,8 +64,11 @@ type Manager interface {
 	// repo is not initialized, it turns to initialize the repo
 	PrepareRepo(repo *velerov1api.BackupRepository) error
 
-	// PruneRepo deletes unused data from a repo.
-	PruneRepo(repo *velerov1api.BackupRepository) error
+	// PruneRepo deletes unused data from a repo, a full maintenance is run
+	// if full is true, otherwise the repo decides the mode of the maintenance.
+	// It returns the bytes freed from the storage, udmrepo.UnknownFreedBytes if
+	// they are unknown.
+	PruneRepo(repo *velerov1api.BackupRepository, full bool) (int64, error)
 
 	// UnlockRepo removes stale locks from a repo.
 	UnlockRepo(repo *velerov1api.BackupRepository) error
@@ -72,6 +76,10 @@ type Manager interface {
 	// Forget removes a snapshot from the list of
 	// available snapshots in a repo.
 	Forget(context.Context, SnapshotIdentifier) error
+
+	// ListSnapshots lists all the snapshots in a repo.
+	ListSnapshots(repo *velerov1api.BackupRepository) ([]provider.SnapshotInfo, error)
+
 	// DefaultMaintenanceFrequency returns the default maintenance frequency from the specific repo
 	DefaultMaintenanceFrequency(repo *velerov1api.BackupRepository) (time.Duration, error)
 }
@@ -160,24 +168,24 @@ func (m *manager) PrepareRepo(repo *velerov1api.BackupRepository) error {
 	return prd.PrepareRepo(context.Background(), param)
 }
 
-func (m *manager) PruneRepo(repo *velerov1api.BackupRepository) error {
+func (m *manager) PruneRepo(repo *velerov1api.BackupRepository, full bool) (int64, error) {
 	m.repoLocker.LockExclusive(repo.Name)
 	defer m.repoLocker.UnlockExclusive(repo.Name)
 
 	prd, err := m.getRepositoryProvider(repo)
 	if err != nil {
-		return errors.WithStack(err)
+		return udmrepo.UnknownFreedBytes, errors.WithStack(err)
 	}
 	param, err := m.assembleRepoParam(repo)
 	if err != nil {
-		return errors.WithStack(err)
+		return udmrepo.UnknownFreedBytes, errors.WithStack(err)
 	}
 
 	if err := prd.BoostRepoConnect(context.Background(), param); err != nil {
-		return errors.WithStack(err)
+		return udmrepo.UnknownFreedBytes, errors.WithStack(err)
 	}
 
-	return prd.PruneRepo(context.Background(), param)
+	return prd.PruneRepo(context.Background(), param, full)
 }
 
 func (m *manager) UnlockRepo(repo *velerov1api.BackupRepository) error {
@@ -220,6 +228,26 @@ func (m *manager) Forget(ctx context.Context, snapshot SnapshotIdentifier) error
 	return prd.Forget(context.Background(), snapshot.SnapshotID, param)
 }
 
+func (m *manager) ListSnapshots(repo *velerov1api.BackupRepository) ([]provider.SnapshotInfo, error) {
+	m.repoLocker.Lock(repo.Name)
+	defer m.repoLocker.Unlock(repo.Name)
+
+	prd, err := m.getRepositoryProvider(repo)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	param, err := m.assembleRepoParam(repo)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := prd.BoostRepoConnect(context.Background(), param); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return prd.ListSnapshots(context.Background(), param)
+}
+
 func (m *manager) DefaultMaintenanceFrequency(repo *velerov1api.BackupRepository) (time.Duration, error) {
 	prd, err := m.getRepositoryProvider(repo)
 	if err != nil {
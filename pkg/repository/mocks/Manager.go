@@ -6,6 +6,9 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	provider "github.com/vmware-tanzu/velero/pkg/repository/provider"
+
 	repository "github.com/vmware-tanzu/velero/pkg/repository"
 
 	time "time"
@@ -81,22 +84,31 @@ func (_m *Manager) InitRepo(repo *v1.BackupRepository) error {
 	return r0
 }
 
-// PrepareRepo provides a mock function with given fields: repo
-func (_m *Manager) PrepareRepo(repo *v1.BackupRepository) error {
+// ListSnapshots provides a mock function with given fields: repo
+func (_m *Manager) ListSnapshots(repo *v1.BackupRepository) ([]provider.SnapshotInfo, error) {
 	ret := _m.Called(repo)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*v1.BackupRepository) error); ok {
+	var r0 []provider.SnapshotInfo
+	if rf, ok := ret.Get(0).(func(*v1.BackupRepository) []provider.SnapshotInfo); ok {
 		r0 = rf(repo)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]provider.SnapshotInfo)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*v1.BackupRepository) error); ok {
+		r1 = rf(repo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// PruneRepo provides a mock function with given fields: repo
-func (_m *Manager) PruneRepo(repo *v1.BackupRepository) error {
+// PrepareRepo provides a mock function with given fields: repo
+func (_m *Manager) PrepareRepo(repo *v1.BackupRepository) error {
 	ret := _m.Called(repo)
 
 	var r0 error
@@ -109,6 +121,27 @@ func (_m *Manager) PruneRepo(repo *v1.BackupRepository) error {
 	return r0
 }
 
+// PruneRepo provides a mock function with given fields: repo, full
+func (_m *Manager) PruneRepo(repo *v1.BackupRepository, full bool) (int64, error) {
+	ret := _m.Called(repo, full)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*v1.BackupRepository, bool) int64); ok {
+		r0 = rf(repo, full)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*v1.BackupRepository, bool) error); ok {
+		r1 = rf(repo, full)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnlockRepo provides a mock function with given fields: repo
 func (_m *Manager) UnlockRepo(repo *v1.BackupRepository) error {
 	ret := _m.Called(repo)
@@ -29,6 +29,16 @@ type RepoParam struct {
 	BackupRepo     *velerov1api.BackupRepository
 }
 
+// SnapshotInfo describes a snapshot in a backup repository
+type SnapshotInfo struct {
+	// ID is the ID of the snapshot
+	ID string
+	// Source is the source the snapshot is taken from
+	Source string
+	// StartTime is the time the snapshot is written
+	StartTime time.Time
+}
+
 // Provider defines the methods to manipulate a backup repository
 type Provider interface {
 	// InitRepo is to initialize a repository from a new storage place
@@ -48,8 +58,11 @@ type Provider interface {
 	// scenarios, for example, pod restart
 	BoostRepoConnect(ctx context.Context, param RepoParam) error
 
-	// PruneRepo does a full prune/maintenance of the repository
-	PruneRepo(ctx context.Context, param RepoParam) error
+	// PruneRepo does a prune/maintenance of the repository, the full maintenance
+	// is always run if full is true, otherwise the repository decides the mode.
+	// It returns the bytes freed from the storage, udmrepo.UnknownFreedBytes if
+	// they are unknown
+	PruneRepo(ctx context.Context, param RepoParam, full bool) (int64, error)
 
 	// EnsureUnlockRepo esures to remove any stale file locks in the storage
 	EnsureUnlockRepo(ctx context.Context, param RepoParam) error
@@ -57,6 +70,9 @@ type Provider interface {
 	// Forget is to delete a snapshot from the repository
 	Forget(ctx context.Context, snapshotID string, param RepoParam) error
 
+	// ListSnapshots lists all the snapshots in the repository, including the incomplete ones
+	ListSnapshots(ctx context.Context, param RepoParam) ([]SnapshotInfo, error)
+
 	// DefaultMaintenanceFrequency returns the default frequency to run maintenance
 	DefaultMaintenanceFrequency(ctx context.Context, param RepoParam) time.Duration
 }
@@ -21,10 +21,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/pkg/repository/restic"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
 
@@ -39,6 +41,10 @@ type resticRepositoryProvider struct {
 }
 
 func (r *resticRepositoryProvider) InitRepo(ctx context.Context, param RepoParam) error {
+	// a read-only repository is only for restoring from the existing data, so it's never created
+	if param.BackupRepo.Spec.ReadOnly {
+		return errors.Errorf("restic repository %s is read-only and can't be initialized", param.BackupRepo.Name)
+	}
 	return r.svc.InitRepo(param.BackupLocation, param.BackupRepo)
 }
 
@@ -66,8 +72,9 @@ func (r *resticRepositoryProvider) BoostRepoConnect(ctx context.Context, param R
 	return nil
 }
 
-func (r *resticRepositoryProvider) PruneRepo(ctx context.Context, param RepoParam) error {
-	return r.svc.PruneRepo(param.BackupLocation, param.BackupRepo)
+func (r *resticRepositoryProvider) PruneRepo(ctx context.Context, param RepoParam, full bool) (int64, error) {
+	// the freed bytes are only in the human readable output of restic prune
+	return udmrepo.UnknownFreedBytes, r.svc.PruneRepo(param.BackupLocation, param.BackupRepo)
 }
 
 func (r *resticRepositoryProvider) EnsureUnlockRepo(ctx context.Context, param RepoParam) error {
@@ -78,6 +85,10 @@ func (r *resticRepositoryProvider) Forget(ctx context.Context, snapshotID string
 	return r.svc.Forget(param.BackupLocation, param.BackupRepo, snapshotID)
 }
 
+func (r *resticRepositoryProvider) ListSnapshots(ctx context.Context, param RepoParam) ([]SnapshotInfo, error) {
+	return nil, errors.New("listing the snapshots of restic repository isn't supported")
+}
+
 func (r *resticRepositoryProvider) DefaultMaintenanceFrequency(ctx context.Context, param RepoParam) time.Duration {
 	return r.svc.DefaultMaintenanceFrequency()
 }
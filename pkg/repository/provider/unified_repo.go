@@ -27,6 +27,8 @@ import (
 	"time"
 
 	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/compression"
+	"github.com/kopia/kopia/repo/splitter"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
@@ -65,8 +67,12 @@ var funcTable = localFuncTable{
 const (
 	repoOpDescMaintain = "repo maintenance"
 	repoOpDescForget   = "forget"
+	repoOpDescList     = "list snapshots"
 
 	repoConnectDesc = "unified repo"
+
+	snapshotTypeLabel    = "type"
+	snapshotManifestType = "snapshot"
 )
 
 // NewUnifiedRepoProvider creates the service provider for Unified Repo
@@ -95,6 +101,11 @@ func (urp *unifiedRepoProvider) InitRepo(ctx context.Context, param RepoParam) e
 
 	log.Debug("Start to init repo")
 
+	// a read-only repository is only for restoring from the existing data, so it's never created
+	if param.BackupRepo.Spec.ReadOnly {
+		return errors.Errorf("backup repository %s is read-only and can't be initialized", param.BackupRepo.Name)
+	}
+
 	repoOption, err := udmrepo.NewRepoOptions(
 		udmrepo.WithPassword(urp, param),
 		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)),
@@ -105,6 +116,7 @@ func (urp *unifiedRepoProvider) InitRepo(ctx context.Context, param RepoParam) e
 			},
 		),
 		udmrepo.WithStoreOptions(urp, param),
+		withKopiaParameters(param),
 		udmrepo.WithDescription(repoConnectDesc),
 	)
 
@@ -141,6 +153,7 @@ func (urp *unifiedRepoProvider) ConnectToRepo(ctx context.Context, param RepoPar
 			},
 		),
 		udmrepo.WithStoreOptions(urp, param),
+		withKopiaParameters(param),
 		udmrepo.WithDescription(repoConnectDesc),
 	)
 
@@ -177,6 +190,7 @@ func (urp *unifiedRepoProvider) PrepareRepo(ctx context.Context, param RepoParam
 			},
 		),
 		udmrepo.WithStoreOptions(urp, param),
+		withKopiaParameters(param),
 		udmrepo.WithDescription(repoConnectDesc),
 	)
 
@@ -193,6 +207,10 @@ func (urp *unifiedRepoProvider) PrepareRepo(ctx context.Context, param RepoParam
 		return errors.Wrap(err, "error to connect to backup repo")
 	}
 
+	if param.BackupRepo.Spec.ReadOnly {
+		return errors.Errorf("backup repository %s is read-only and can't be initialized", param.BackupRepo.Name)
+	}
+
 	err = urp.repoService.Init(ctx, *repoOption, true)
 	if err != nil {
 		return errors.Wrap(err, "error to create backup repo")
@@ -234,7 +252,7 @@ func (urp *unifiedRepoProvider) BoostRepoConnect(ctx context.Context, param Repo
 	return urp.ConnectToRepo(ctx, param)
 }
 
-func (urp *unifiedRepoProvider) PruneRepo(ctx context.Context, param RepoParam) error {
+func (urp *unifiedRepoProvider) PruneRepo(ctx context.Context, param RepoParam, full bool) (int64, error) {
 	log := urp.log.WithFields(logrus.Fields{
 		"BSL name":  param.BackupLocation.Name,
 		"repo name": param.BackupRepo.Name,
@@ -243,24 +261,30 @@ func (urp *unifiedRepoProvider) PruneRepo(ctx context.Context, param RepoParam)
 
 	log.Debug("Start to prune repo")
 
+	genOptions := map[string]string{}
+	if full {
+		genOptions[udmrepo.GenOptionMaintainMode] = udmrepo.GenOptionMaintainFull
+	}
+
 	repoOption, err := udmrepo.NewRepoOptions(
 		udmrepo.WithPassword(urp, param),
 		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)),
+		udmrepo.WithGenOptions(genOptions),
 		udmrepo.WithDescription(repoOpDescMaintain),
 	)
 
 	if err != nil {
-		return errors.Wrap(err, "error to get repo options")
+		return udmrepo.UnknownFreedBytes, errors.Wrap(err, "error to get repo options")
 	}
 
-	err = urp.repoService.Maintain(ctx, *repoOption)
+	freedBytes, err := urp.repoService.Maintain(ctx, *repoOption)
 	if err != nil {
-		return errors.Wrap(err, "error to prune backup repo")
+		return udmrepo.UnknownFreedBytes, errors.Wrap(err, "error to prune backup repo")
 	}
 
-	log.Debug("Prune repo complete")
+	log.WithField("freedBytes", freedBytes).Debug("Prune repo complete")
 
-	return nil
+	return freedBytes, nil
 }
 
 func (urp *unifiedRepoProvider) EnsureUnlockRepo(ctx context.Context, param RepoParam) error {
@@ -314,17 +338,68 @@ func (urp *unifiedRepoProvider) Forget(ctx context.Context, snapshotID string, p
 	return nil
 }
 
+func (urp *unifiedRepoProvider) ListSnapshots(ctx context.Context, param RepoParam) ([]SnapshotInfo, error) {
+	log := urp.log.WithFields(logrus.Fields{
+		"BSL name":  param.BackupLocation.Name,
+		"repo name": param.BackupRepo.Name,
+		"repo UID":  param.BackupRepo.UID,
+	})
+
+	log.Debug("Start to list snapshots")
+
+	repoOption, err := udmrepo.NewRepoOptions(
+		udmrepo.WithPassword(urp, param),
+		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)),
+		udmrepo.WithDescription(repoOpDescList),
+	)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error to get repo options")
+	}
+
+	bkRepo, err := urp.repoService.Open(ctx, *repoOption)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to open backup repo")
+	}
+
+	defer func() {
+		c := bkRepo.Close(ctx)
+		if c != nil {
+			log.WithError(c).Error("Failed to close repo")
+		}
+	}()
+
+	// the snapshot manifests are labeled with the type and the source of the snapshots
+	manifests, err := bkRepo.FindManifests(ctx, udmrepo.ManifestFilter{Labels: map[string]string{snapshotTypeLabel: snapshotManifestType}})
+	if err != nil {
+		return nil, errors.Wrap(err, "error to find snapshot manifests")
+	}
+
+	snapshots := make([]SnapshotInfo, 0, len(manifests))
+	for _, manifest := range manifests {
+		snapshots = append(snapshots, SnapshotInfo{
+			ID:        string(manifest.ID),
+			Source:    fmt.Sprintf("%s@%s:%s", manifest.Labels["username"], manifest.Labels["hostname"], manifest.Labels["path"]),
+			StartTime: manifest.ModTime,
+		})
+	}
+
+	log.WithField("snapshots", len(snapshots)).Debug("List snapshots complete")
+
+	return snapshots, nil
+}
+
 func (urp *unifiedRepoProvider) DefaultMaintenanceFrequency(ctx context.Context, param RepoParam) time.Duration {
 	return urp.repoService.DefaultMaintenanceFrequency()
 }
 
 func (urp *unifiedRepoProvider) GetPassword(param interface{}) (string, error) {
-	_, ok := param.(RepoParam)
+	repoParam, ok := param.(RepoParam)
 	if !ok {
 		return "", errors.Errorf("invalid parameter, expect %T, actual %T", RepoParam{}, param)
 	}
 
-	repoPassword, err := getRepoPassword(urp.credentialGetter.FromSecret)
+	repoPassword, err := getRepoPassword(urp.credentialGetter.FromSecret, repoParam.BackupRepo)
 	if err != nil {
 		return "", errors.Wrap(err, "error to get repo password")
 	}
@@ -369,12 +444,43 @@ func (urp *unifiedRepoProvider) GetStoreOptions(param interface{}) (map[string]s
 	return storeOptions, nil
 }
 
-func getRepoPassword(secretStore credentials.SecretStore) (string, error) {
+// withKopiaParameters sets the kopia parameters of the backup repository to the GeneralOptions
+// of RepoOptions, so that they are applied when the repository is created or connected
+func withKopiaParameters(param RepoParam) func(*udmrepo.RepoOptions) error {
+	return func(options *udmrepo.RepoOptions) error {
+		if param.BackupRepo == nil || param.BackupRepo.Spec.KopiaParameters == nil {
+			return nil
+		}
+
+		params := param.BackupRepo.Spec.KopiaParameters
+		if params.SplitterAlgorithm != "" {
+			if splitter.GetFactory(params.SplitterAlgorithm) == nil {
+				return errors.Errorf("unsupported splitter algorithm %s, supported algorithms are: %s", params.SplitterAlgorithm, strings.Join(splitter.SupportedAlgorithms(), ", "))
+			}
+			options.GeneralOptions[udmrepo.StoreOptionGenSplitAlgo] = params.SplitterAlgorithm
+		}
+
+		if params.Compression != "" {
+			if _, exist := compression.ByName[compression.Name(params.Compression)]; !exist {
+				return errors.Errorf("unsupported compression algorithm %s", params.Compression)
+			}
+			options.GeneralOptions[udmrepo.GenOptionCompression] = params.Compression
+		}
+
+		if params.CacheLimitMB != nil {
+			options.GeneralOptions[udmrepo.GenOptionCacheLimitMB] = strconv.FormatInt(*params.CacheLimitMB, 10)
+		}
+
+		return nil
+	}
+}
+
+func getRepoPassword(secretStore credentials.SecretStore, backupRepo *velerov1api.BackupRepository) (string, error) {
 	if secretStore == nil {
 		return "", errors.New("invalid credentials interface")
 	}
 
-	rawPass, err := secretStore.Get(repokey.RepoKeySelector())
+	rawPass, err := secretStore.Get(repokey.RepoKeySelectorForRepo(backupRepo))
 	if err != nil {
 		return "", errors.Wrap(err, "error to get password")
 	}
@@ -417,7 +523,8 @@ func getStorageCredentials(backupLocation *velerov1api.BackupStorageLocation, cr
 		config = map[string]string{}
 	}
 
-	if backupLocation.Spec.Credential != nil {
+	// the credentials file isn't used if the ambient identity of the pod is preferred and available
+	if backupLocation.Spec.Credential != nil && credentials.GetAmbientIdentity(backupLocation.Spec.Provider, config) == credentials.AmbientIdentityNone {
 		config[repoconfig.CredentialsFileKey], err = credentialsFileStore.Path(backupLocation.Spec.Credential)
 		if err != nil {
 			return map[string]string{}, errors.Wrap(err, "error get credential file in bsl")
@@ -460,7 +567,7 @@ func getStorageVariables(backupLocation *velerov1api.BackupStorageLocation, repo
 	if config == nil {
 		config = map[string]string{}
 	}
-	if backupLocation.Spec.Credential != nil {
+	if backupLocation.Spec.Credential != nil && credentials.GetAmbientIdentity(backupLocation.Spec.Provider, config) == credentials.AmbientIdentityNone {
 		credsFile, err := credentialFileStore.Path(backupLocation.Spec.Credential)
 		if err != nil {
 			return map[string]string{}, errors.WithStack(err)
@@ -480,7 +587,7 @@ func getStorageVariables(backupLocation *velerov1api.BackupStorageLocation, repo
 	region := config["region"]
 
 	if backendType == repoconfig.AWSBackend {
-		s3URL := config["s3Url"]
+		s3URL := repoconfig.GetS3URL(backupLocation.Spec.Provider, config)
 		disableTLS := false
 
 		var err error
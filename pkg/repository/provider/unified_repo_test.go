@@ -21,6 +21,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/kopia/kopia/repo"
@@ -47,6 +48,7 @@ func TestGetStorageCredentials(t *testing.T) {
 		credStorePath     string
 		getS3Credentials  func(map[string]string) (*aws.Credentials, error)
 		getGCPCredentials func(map[string]string) string
+		env               map[string]string
 		expected          map[string]string
 		expectedErr       string
 	}{
@@ -128,6 +130,36 @@ func TestGetStorageCredentials(t *testing.T) {
 				"sessionToken":    "",
 			},
 		},
+		{
+			name: "aws, ambient identity preferred over Credential section in BSL",
+			backupLocation: velerov1api.BackupStorageLocation{
+				Spec: velerov1api.BackupStorageLocationSpec{
+					Provider: "velero.io/aws",
+					Config: map[string]string{
+						"preferAmbientIdentity": "true",
+					},
+					Credential: &corev1api.SecretKeySelector{},
+				},
+			},
+			credFileStore: new(credmock.FileStore),
+			credStorePath: "credentials-from-credential-key",
+			env: map[string]string{
+				"AWS_ROLE_ARN":                "arn:aws:iam::123456789012:role/velero",
+				"AWS_WEB_IDENTITY_TOKEN_FILE": "/var/run/secrets/eks.amazonaws.com/serviceaccount/token",
+			},
+			getS3Credentials: func(config map[string]string) (*aws.Credentials, error) {
+				return &aws.Credentials{
+					AccessKeyID: "from: " + config["credentialsFile"],
+				}, nil
+			},
+
+			expected: map[string]string{
+				"accessKeyID":     "from: ",
+				"providerName":    "",
+				"secretAccessKey": "",
+				"sessionToken":    "",
+			},
+		},
 		{
 			name: "aws, get credentials fail",
 			backupLocation: velerov1api.BackupStorageLocation{
@@ -192,6 +224,10 @@ func TestGetStorageCredentials(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+
 			getS3Credentials = tc.getS3Credentials
 			getGCPCredentials = tc.getGCPCredentials
 
@@ -497,7 +533,7 @@ func TestGetRepoPassword(t *testing.T) {
 				},
 			}
 
-			password, err := getRepoPassword(urp.credentialGetter.FromSecret)
+			password, err := getRepoPassword(urp.credentialGetter.FromSecret, nil)
 
 			require.Equal(t, tc.expected, password)
 
@@ -576,6 +612,95 @@ func TestGetStoreOptions(t *testing.T) {
 	}
 }
 
+func TestWithKopiaParameters(t *testing.T) {
+	cacheLimit := int64(500)
+
+	testCases := []struct {
+		name        string
+		repoParam   RepoParam
+		expected    map[string]string
+		expectedErr string
+	}{
+		{
+			name:      "no backup repo",
+			repoParam: RepoParam{},
+			expected:  map[string]string{},
+		},
+		{
+			name: "no kopia parameters",
+			repoParam: RepoParam{
+				BackupRepo: &velerov1api.BackupRepository{},
+			},
+			expected: map[string]string{},
+		},
+		{
+			name: "invalid splitter algorithm",
+			repoParam: RepoParam{
+				BackupRepo: &velerov1api.BackupRepository{
+					Spec: velerov1api.BackupRepositorySpec{
+						KopiaParameters: &velerov1api.KopiaRepositoryParameters{
+							SplitterAlgorithm: "fake-splitter",
+						},
+					},
+				},
+			},
+			expected:    map[string]string{},
+			expectedErr: "unsupported splitter algorithm fake-splitter",
+		},
+		{
+			name: "invalid compression algorithm",
+			repoParam: RepoParam{
+				BackupRepo: &velerov1api.BackupRepository{
+					Spec: velerov1api.BackupRepositorySpec{
+						KopiaParameters: &velerov1api.KopiaRepositoryParameters{
+							Compression: "fake-compression",
+						},
+					},
+				},
+			},
+			expected:    map[string]string{},
+			expectedErr: "unsupported compression algorithm fake-compression",
+		},
+		{
+			name: "succeed",
+			repoParam: RepoParam{
+				BackupRepo: &velerov1api.BackupRepository{
+					Spec: velerov1api.BackupRepositorySpec{
+						KopiaParameters: &velerov1api.KopiaRepositoryParameters{
+							SplitterAlgorithm: "FIXED-4M",
+							Compression:       "zstd-fastest",
+							CacheLimitMB:      &cacheLimit,
+						},
+					},
+				},
+			},
+			expected: map[string]string{
+				udmrepo.StoreOptionGenSplitAlgo: "FIXED-4M",
+				udmrepo.GenOptionCompression:    "zstd-fastest",
+				udmrepo.GenOptionCacheLimitMB:   "500",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			options := &udmrepo.RepoOptions{
+				GeneralOptions: map[string]string{},
+			}
+
+			err := withKopiaParameters(tc.repoParam)(options)
+
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expectedErr)
+			}
+
+			assert.Equal(t, tc.expected, options.GeneralOptions)
+		})
+	}
+}
+
 func TestPrepareRepo(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -858,6 +983,100 @@ func TestForget(t *testing.T) {
 	}
 }
 
+func TestListSnapshots(t *testing.T) {
+	modTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name              string
+		openErr           error
+		findErr           error
+		manifests         []*udmrepo.ManifestEntryMetadata
+		expectedSnapshots []SnapshotInfo
+		expectedErr       string
+	}{
+		{
+			name:        "repo open fail",
+			openErr:     errors.New("fake-error-1"),
+			expectedErr: "error to open backup repo: fake-error-1",
+		},
+		{
+			name:        "find manifests fail",
+			findErr:     errors.New("fake-error-2"),
+			expectedErr: "error to find snapshot manifests: fake-error-2",
+		},
+		{
+			name:              "no snapshots",
+			expectedSnapshots: []SnapshotInfo{},
+		},
+		{
+			name: "snapshots are listed",
+			manifests: []*udmrepo.ManifestEntryMetadata{
+				{
+					ID:      "snapshot-1",
+					Labels:  map[string]string{"type": "snapshot", "username": "default", "hostname": "default", "path": "/host_pods/pod-1/volumes/vol-1"},
+					ModTime: modTime,
+				},
+				{
+					ID:      "snapshot-2",
+					Labels:  map[string]string{"type": "snapshot", "username": "default", "hostname": "default", "path": "/ns-1/pvc-1"},
+					ModTime: modTime.Add(time.Hour),
+				},
+			},
+			expectedSnapshots: []SnapshotInfo{
+				{ID: "snapshot-1", Source: "default@default:/host_pods/pod-1/volumes/vol-1", StartTime: modTime},
+				{ID: "snapshot-2", Source: "default@default:/ns-1/pvc-1", StartTime: modTime.Add(time.Hour)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			funcTable = localFuncTable{
+				getStorageVariables: func(*velerov1api.BackupStorageLocation, string, string, velerocredentials.FileStore) (map[string]string, error) {
+					return map[string]string{}, nil
+				},
+				getStorageCredentials: func(*velerov1api.BackupStorageLocation, velerocredentials.FileStore) (map[string]string, error) {
+					return map[string]string{}, nil
+				},
+			}
+
+			secretStore := new(credmock.SecretStore)
+			secretStore.On("Get", mock.Anything, mock.Anything).Return("fake-password", nil)
+
+			backupRepo := new(reposervicenmocks.BackupRepo)
+			backupRepo.On("FindManifests", mock.Anything, udmrepo.ManifestFilter{Labels: map[string]string{"type": "snapshot"}}).Return(tc.manifests, tc.findErr)
+			backupRepo.On("Close", mock.Anything).Return(nil)
+
+			repoService := new(reposervicenmocks.BackupRepoService)
+			if tc.openErr != nil {
+				repoService.On("Open", mock.Anything, mock.Anything).Return(nil, tc.openErr)
+			} else {
+				repoService.On("Open", mock.Anything, mock.Anything).Return(backupRepo, nil)
+			}
+
+			urp := unifiedRepoProvider{
+				credentialGetter: velerocredentials.CredentialGetter{
+					FromSecret: secretStore,
+				},
+				repoService: repoService,
+				log:         velerotest.NewLogger(),
+			}
+
+			snapshots, err := urp.ListSnapshots(context.Background(), RepoParam{
+				BackupLocation: &velerov1api.BackupStorageLocation{},
+				BackupRepo:     &velerov1api.BackupRepository{},
+			})
+
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedSnapshots, snapshots)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestInitRepo(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -1249,16 +1468,17 @@ func TestPruneRepo(t *testing.T) {
 			}
 
 			if tc.repoService != nil {
-				tc.repoService.On("Maintain", mock.Anything, mock.Anything).Return(tc.retFuncMaintain)
+				tc.repoService.On("Maintain", mock.Anything, mock.Anything).Return(int64(1024), tc.retFuncMaintain)
 			}
 
-			err := urp.PruneRepo(context.Background(), RepoParam{
+			freedBytes, err := urp.PruneRepo(context.Background(), RepoParam{
 				BackupLocation: &velerov1api.BackupStorageLocation{},
 				BackupRepo:     &velerov1api.BackupRepository{},
-			})
+			}, false)
 
 			if tc.expectedErr == "" {
 				assert.NoError(t, err)
+				assert.Equal(t, int64(1024), freedBytes)
 			} else {
 				assert.EqualError(t, err, tc.expectedErr)
 			}
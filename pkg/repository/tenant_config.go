@@ -0,0 +1,73 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// TenantConfig is the configuration of the backup repositories of a volume namespace
+// when the backup repositories are configured per tenant.
+type TenantConfig struct {
+	// RepositoryCredential is the reference to the secret key in the Velero namespace
+	// holding the password of the repositories of the namespace.
+	RepositoryCredential *corev1api.SecretKeySelector `json:"repositoryCredential,omitempty"`
+
+	// MaintenanceFrequency is how often maintenance is run for the repositories of the
+	// namespace.
+	MaintenanceFrequency *metav1.Duration `json:"maintenanceFrequency,omitempty"`
+}
+
+// GetTenantConfig returns the backup repository configuration of the volume namespace
+// from the tenant configmap, the configmap has one entry per volume namespace. Nil is
+// returned if the configmap doesn't exist or has no entry for the volume namespace.
+func GetTenantConfig(ctx context.Context, cli client.Client, namespace, configMapName, volumeNamespace string) (*TenantConfig, error) {
+	configMap := &corev1api.ConfigMap{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: configMapName}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "error getting backup repository tenant configmap %s/%s", namespace, configMapName)
+	}
+
+	data, ok := configMap.Data[volumeNamespace]
+	if !ok {
+		return nil, nil
+	}
+
+	config := &TenantConfig{}
+	if err := yaml.UnmarshalStrict([]byte(data), config); err != nil {
+		return nil, errors.Wrapf(err, "error parsing the config of namespace %s in backup repository tenant configmap %s/%s", volumeNamespace, namespace, configMapName)
+	}
+
+	if config.RepositoryCredential != nil && (config.RepositoryCredential.Name == "" || config.RepositoryCredential.Key == "") {
+		return nil, errors.Errorf("invalid repository credential of namespace %s in backup repository tenant configmap %s/%s, both name and key are required", volumeNamespace, namespace, configMapName)
+	}
+
+	if config.MaintenanceFrequency != nil && config.MaintenanceFrequency.Duration <= 0 {
+		return nil, errors.Errorf("invalid maintenance frequency %s of namespace %s in backup repository tenant configmap %s/%s", config.MaintenanceFrequency.Duration, volumeNamespace, namespace, configMapName)
+	}
+
+	return config, nil
+}
@@ -0,0 +1,122 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetTenantConfig(t *testing.T) {
+	configMap := &corev1api.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "repo-tenants",
+		},
+		Data: map[string]string{
+			"tenant-a":     "repositoryCredential:\n  name: tenant-a-repo-credentials\n  key: repository-password\nmaintenanceFrequency: 24h\n",
+			"tenant-b":     "maintenanceFrequency: 1h\n",
+			"invalid-yaml": "unknownField: value\n",
+			"invalid-cred": "repositoryCredential:\n  key: repository-password\n",
+			"invalid-freq": "maintenanceFrequency: 0s\n",
+			"empty-config": "",
+		},
+	}
+
+	tests := []struct {
+		name            string
+		objects         []runtime.Object
+		volumeNamespace string
+		expected        *TenantConfig
+		expectedErr     string
+	}{
+		{
+			name:            "configmap doesn't exist",
+			volumeNamespace: "tenant-a",
+		},
+		{
+			name:            "namespace isn't configured",
+			objects:         []runtime.Object{configMap},
+			volumeNamespace: "tenant-c",
+		},
+		{
+			name:            "credential and maintenance frequency are configured",
+			objects:         []runtime.Object{configMap},
+			volumeNamespace: "tenant-a",
+			expected: &TenantConfig{
+				RepositoryCredential: builder.ForSecretKeySelector("tenant-a-repo-credentials", "repository-password").Result(),
+				MaintenanceFrequency: &metav1.Duration{Duration: 24 * time.Hour},
+			},
+		},
+		{
+			name:            "only maintenance frequency is configured",
+			objects:         []runtime.Object{configMap},
+			volumeNamespace: "tenant-b",
+			expected: &TenantConfig{
+				MaintenanceFrequency: &metav1.Duration{Duration: time.Hour},
+			},
+		},
+		{
+			name:            "empty config",
+			objects:         []runtime.Object{configMap},
+			volumeNamespace: "empty-config",
+			expected:        &TenantConfig{},
+		},
+		{
+			name:            "unknown field",
+			objects:         []runtime.Object{configMap},
+			volumeNamespace: "invalid-yaml",
+			expectedErr:     `error parsing the config of namespace invalid-yaml in backup repository tenant configmap velero/repo-tenants: error unmarshaling JSON: while decoding JSON: json: unknown field "unknownField"`,
+		},
+		{
+			name:            "credential without name",
+			objects:         []runtime.Object{configMap},
+			volumeNamespace: "invalid-cred",
+			expectedErr:     "invalid repository credential of namespace invalid-cred in backup repository tenant configmap velero/repo-tenants, both name and key are required",
+		},
+		{
+			name:            "invalid maintenance frequency",
+			objects:         []runtime.Object{configMap},
+			volumeNamespace: "invalid-freq",
+			expectedErr:     "invalid maintenance frequency 0s of namespace invalid-freq in backup repository tenant configmap velero/repo-tenants",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cli := velerotest.NewFakeControllerRuntimeClient(t, test.objects...)
+
+			config, err := GetTenantConfig(context.Background(), cli, "velero", "repo-tenants", test.volumeNamespace)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, config)
+		})
+	}
+}
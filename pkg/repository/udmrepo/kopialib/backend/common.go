@@ -69,8 +69,8 @@ func SetupNewRepositoryOptions(ctx context.Context, flags map[string]string) rep
 func SetupConnectOptions(ctx context.Context, repoOptions udmrepo.RepoOptions) repo.ConnectOptions {
 	return repo.ConnectOptions{
 		CachingOptions: content.CachingOptions{
-			ContentCacheSizeBytes:  maxDataCacheMB << 20,
-			MetadataCacheSizeBytes: maxMetadataCacheMB << 20,
+			ContentCacheSizeBytes:  optionalHaveInt64WithDefault(ctx, udmrepo.GenOptionCacheLimitMB, repoOptions.GeneralOptions, maxDataCacheMB) << 20,
+			MetadataCacheSizeBytes: optionalHaveInt64WithDefault(ctx, udmrepo.GenOptionCacheLimitMB, repoOptions.GeneralOptions, maxMetadataCacheMB) << 20,
 			MaxListCacheDuration:   content.DurationSeconds(time.Duration(maxCacheDurationSecond) * time.Second),
 		},
 		ClientOptions: repo.ClientOptions{
@@ -187,6 +187,22 @@ func TestSetupConnectOptions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with cache limit",
+			repoOptions: udmrepo.RepoOptions{
+				GeneralOptions: map[string]string{
+					udmrepo.GenOptionCacheLimitMB: "500",
+				},
+			},
+			expected: repo.ConnectOptions{
+				CachingOptions: content.CachingOptions{
+					ContentCacheSizeBytes:  500 << 20,
+					MetadataCacheSizeBytes: 500 << 20,
+					MaxListCacheDuration:   content.DurationSeconds(time.Duration(30) * time.Second),
+				},
+				ClientOptions: repo.ClientOptions{},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
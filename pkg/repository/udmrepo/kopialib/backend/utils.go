@@ -65,6 +65,19 @@ func optionalHaveFloat64(ctx context.Context, key string, flags map[string]strin
 	return 0
 }
 
+func optionalHaveInt64WithDefault(ctx context.Context, key string, flags map[string]string, defValue int64) int64 {
+	if value, exist := flags[key]; exist {
+		ret, err := strconv.ParseInt(value, 10, 64)
+		if err == nil {
+			return ret
+		}
+
+		backendLog()(ctx).Errorf("Ignore %s, value [%s] is invalid, err %v", key, value, err)
+	}
+
+	return defValue
+}
+
 func optionalHaveStringWithDefault(key string, flags map[string]string, defValue string) string {
 	if value, exist := flags[key]; exist {
 		return value
@@ -19,12 +19,16 @@ package kopialib
 import (
 	"context"
 	"os"
+	"reflect"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
 
 	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/blob"
 	"github.com/kopia/kopia/repo/compression"
+	"github.com/kopia/kopia/repo/content"
 	"github.com/kopia/kopia/repo/content/index"
 	"github.com/kopia/kopia/repo/maintenance"
 	"github.com/kopia/kopia/repo/manifest"
@@ -45,6 +49,7 @@ type kopiaRepository struct {
 	rawRepo     repo.Repository
 	rawWriter   repo.RepositoryWriter
 	description string
+	compressor  compression.Name
 	uploaded    int64
 	openTime    time.Time
 	throttle    logThrottle
@@ -115,15 +120,28 @@ func (ks *kopiaRepoService) Open(ctx context.Context, repoOption udmrepo.RepoOpt
 
 	repoCtx := kopia.SetupKopiaLog(ctx, ks.logger)
 
+	if err := setCachingOptions(repoCtx, repoConfig, repoOption.GeneralOptions); err != nil {
+		return nil, errors.Wrap(err, "error to set caching options")
+	}
+
 	r, err := openKopiaRepo(repoCtx, repoConfig, repoOption.RepoPassword)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := setBandwidthLimits(r, repoOption.GeneralOptions); err != nil {
+		if e := r.Close(repoCtx); e != nil {
+			ks.logger.WithError(e).Error("Failed to close raw repository on error")
+		}
+
+		return nil, errors.Wrap(err, "error to set bandwidth limits")
+	}
+
 	kr := kopiaRepository{
 		rawRepo:     r,
 		openTime:    time.Now(),
 		description: repoOption.Description,
+		compressor:  compression.Name(repoOption.GeneralOptions[udmrepo.GenOptionCompression]),
 		throttle: logThrottle{
 			interval: defaultLogInterval,
 		},
@@ -146,21 +164,21 @@ func (ks *kopiaRepoService) Open(ctx context.Context, repoOption udmrepo.RepoOpt
 	return &kr, nil
 }
 
-func (ks *kopiaRepoService) Maintain(ctx context.Context, repoOption udmrepo.RepoOptions) error {
+func (ks *kopiaRepoService) Maintain(ctx context.Context, repoOption udmrepo.RepoOptions) (int64, error) {
 	repoConfig := repoOption.ConfigFilePath
 	if repoConfig == "" {
-		return errors.New("invalid config file path")
+		return udmrepo.UnknownFreedBytes, errors.New("invalid config file path")
 	}
 
 	if _, err := os.Stat(repoConfig); os.IsNotExist(err) {
-		return errors.Wrapf(err, "repo config %s doesn't exist", repoConfig)
+		return udmrepo.UnknownFreedBytes, errors.Wrapf(err, "repo config %s doesn't exist", repoConfig)
 	}
 
 	repoCtx := kopia.SetupKopiaLog(ctx, ks.logger)
 
 	r, err := openKopiaRepo(repoCtx, repoConfig, repoOption.RepoPassword)
 	if err != nil {
-		return err
+		return udmrepo.UnknownFreedBytes, err
 	}
 
 	defer func() {
@@ -187,6 +205,8 @@ func (ks *kopiaRepoService) Maintain(ctx context.Context, repoOption udmrepo.Rep
 		}
 	}
 
+	sizeBefore, sizeErr := getStorageSize(repoCtx, r.(repo.DirectRepository))
+
 	err = repo.DirectWriteSession(repoCtx, r.(repo.DirectRepository), repo.WriteSessionOptions{
 		Purpose:  "UdmRepoMaintenance",
 		OnUpload: km.maintainProgress,
@@ -195,10 +215,30 @@ func (ks *kopiaRepoService) Maintain(ctx context.Context, repoOption udmrepo.Rep
 	})
 
 	if err != nil {
-		return errors.Wrap(err, "error to maintain repo")
+		return udmrepo.UnknownFreedBytes, errors.Wrap(err, "error to maintain repo")
 	}
 
-	return nil
+	sizeAfter, err := getStorageSize(repoCtx, r.(repo.DirectRepository))
+	if sizeErr == nil {
+		sizeErr = err
+	}
+	if sizeErr != nil {
+		ks.logger.WithError(sizeErr).Warn("Failed to get the storage size, the freed bytes of the maintenance are unknown")
+		return udmrepo.UnknownFreedBytes, nil
+	}
+
+	return sizeBefore - sizeAfter, nil
+}
+
+// getStorageSize returns the total size of the blobs in the storage of the repository
+var getStorageSize = func(ctx context.Context, rep repo.DirectRepository) (int64, error) {
+	var size int64
+	err := rep.BlobReader().ListBlobs(ctx, "", func(bm blob.Metadata) error {
+		size += bm.Length
+		return nil
+	})
+
+	return size, err
 }
 
 func (ks *kopiaRepoService) DefaultMaintenanceFrequency() time.Duration {
@@ -284,6 +324,10 @@ func (kr *kopiaRepository) Time() time.Time {
 	return kr.rawRepo.Time()
 }
 
+func (kr *kopiaRepository) UploadedBytes() int64 {
+	return atomic.LoadInt64(&kr.uploaded)
+}
+
 func (kr *kopiaRepository) Close(ctx context.Context) error {
 	if kr.rawWriter != nil {
 		err := kr.rawWriter.Close(kopia.SetupKopiaLog(ctx, kr.logger))
@@ -315,7 +359,7 @@ func (kr *kopiaRepository) NewObjectWriter(ctx context.Context, opt udmrepo.Obje
 		Description: opt.Description,
 		Prefix:      index.IDPrefix(opt.Prefix),
 		AsyncWrites: opt.AsyncWrites,
-		Compressor:  getCompressorForObject(opt),
+		Compressor:  kr.getCompressorForObject(opt),
 	})
 
 	if writer == nil {
@@ -473,9 +517,14 @@ func (kow *kopiaObjectWriter) Close() error {
 	return nil
 }
 
-// getCompressorForObject returns the compressor for an object, at present, we don't support compression
-func getCompressorForObject(opt udmrepo.ObjectWriteOptions) compression.Name {
-	return ""
+// getCompressorForObject returns the compressor for an object, the data objects are compressed with the
+// compressor of the repository if any, the metadata objects are not compressed
+func (kr *kopiaRepository) getCompressorForObject(opt udmrepo.ObjectWriteOptions) compression.Name {
+	if opt.DataType != udmrepo.ObjectDataTypeData {
+		return ""
+	}
+
+	return kr.compressor
 }
 
 func getManifestEntryFromKopia(mani *manifest.EntryMetadata) *udmrepo.ManifestEntryMetadata {
@@ -526,6 +575,107 @@ func openKopiaRepo(ctx context.Context, configFile string, password string) (rep
 	return r, nil
 }
 
+// setBandwidthLimits applies the upload/download bandwidth limits in the general options to the throttler
+// of the repository, the other limits of the throttler are kept as is
+func setBandwidthLimits(r repo.Repository, genOptions map[string]string) error {
+	upload, uploadExist := genOptions[udmrepo.ThrottleOptionUploadBytes]
+	download, downloadExist := genOptions[udmrepo.ThrottleOptionDownloadBytes]
+	if !uploadExist && !downloadExist {
+		return nil
+	}
+
+	dr, ok := r.(repo.DirectRepository)
+	if !ok {
+		return nil
+	}
+
+	throttler := dr.Throttler()
+	if throttler == nil {
+		return nil
+	}
+
+	curLimits := throttler.Limits()
+	newLimits := curLimits
+
+	if uploadExist {
+		value, err := strconv.ParseFloat(upload, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid upload bandwidth limit %s", upload)
+		}
+		newLimits.UploadBytesPerSecond = value
+	}
+
+	if downloadExist {
+		value, err := strconv.ParseFloat(download, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid download bandwidth limit %s", download)
+		}
+		newLimits.DownloadBytesPerSecond = value
+	}
+
+	// the limits are persisted to the repo config file by the throttler, so skip the update if nothing changes
+	if newLimits == curLimits {
+		return nil
+	}
+
+	return throttler.SetLimits(newLimits)
+}
+
+// setCachingOptions applies the cache options in the general options to the caching options in the repo
+// config file, so that they take effect when the repo is opened, the other caching options are kept as is
+func setCachingOptions(ctx context.Context, configFile string, genOptions map[string]string) error {
+	cacheDir, cacheDirExist := genOptions[udmrepo.GenOptionCacheDir]
+	contentLimit, contentLimitExist := genOptions[udmrepo.GenOptionContentCacheLimitMB]
+	metadataLimit, metadataLimitExist := genOptions[udmrepo.GenOptionMetadataCacheLimitMB]
+	minEvictionAge, minEvictionAgeExist := genOptions[udmrepo.GenOptionCacheMinEvictionAge]
+	if !cacheDirExist && !contentLimitExist && !metadataLimitExist && !minEvictionAgeExist {
+		return nil
+	}
+
+	curOptions, err := repo.GetCachingOptions(ctx, configFile)
+	if err != nil {
+		return errors.Wrap(err, "error to get caching options")
+	}
+
+	newOptions := curOptions.CloneOrDefault()
+
+	if cacheDirExist {
+		newOptions.CacheDirectory = cacheDir
+	}
+
+	if contentLimitExist {
+		value, err := strconv.ParseInt(contentLimit, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid content cache limit %s", contentLimit)
+		}
+		newOptions.ContentCacheSizeBytes = value << 20
+	}
+
+	if metadataLimitExist {
+		value, err := strconv.ParseInt(metadataLimit, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid metadata cache limit %s", metadataLimit)
+		}
+		newOptions.MetadataCacheSizeBytes = value << 20
+	}
+
+	if minEvictionAgeExist {
+		value, err := time.ParseDuration(minEvictionAge)
+		if err != nil {
+			return errors.Wrapf(err, "invalid cache min eviction age %s", minEvictionAge)
+		}
+		newOptions.MinContentSweepAge = content.DurationSeconds(value.Seconds())
+		newOptions.MinMetadataSweepAge = content.DurationSeconds(value.Seconds())
+	}
+
+	// the config file is shared by the data paths of the repo, so skip rewriting it if nothing changes
+	if reflect.DeepEqual(curOptions, newOptions) {
+		return nil
+	}
+
+	return repo.SetCachingOptions(ctx, configFile, newOptions)
+}
+
 func writeInitParameters(ctx context.Context, repoOption udmrepo.RepoOptions, logger logrus.FieldLogger) error {
 	r, err := openKopiaRepo(ctx, repoOption.ConfigFilePath, repoOption.RepoPassword)
 	if err != nil {
@@ -20,10 +20,13 @@ import (
 	"context"
 	"math"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/blob/throttling"
+	"github.com/kopia/kopia/repo/content"
 	"github.com/kopia/kopia/repo/manifest"
 	"github.com/kopia/kopia/repo/object"
 	"github.com/pkg/errors"
@@ -235,6 +238,10 @@ func TestMaintain(t *testing.T) {
 				kopiaRepoOpen = tc.repoOpen
 			}
 
+			getStorageSize = func(context.Context, repo.DirectRepository) (int64, error) {
+				return 0, nil
+			}
+
 			if tc.returnRepo != nil {
 				directRpo = tc.returnRepo
 			}
@@ -251,7 +258,7 @@ func TestMaintain(t *testing.T) {
 				tc.returnRepoWriter.On("FindManifests", mock.Anything, mock.Anything).Return(nil, tc.findManifestError)
 			}
 
-			err := service.Maintain(ctx, tc.repoOptions)
+			_, err := service.Maintain(ctx, tc.repoOptions)
 
 			if tc.expectedErr == "" {
 				assert.NoError(t, err)
@@ -1198,3 +1205,188 @@ func TestMaintainProgress(t *testing.T) {
 		})
 	}
 }
+
+func TestSetBandwidthLimits(t *testing.T) {
+	testCases := []struct {
+		name           string
+		curLimits      throttling.Limits
+		genOptions     map[string]string
+		nilThrottler   bool
+		expectedLimits throttling.Limits
+		expectedErr    string
+	}{
+		{
+			name:       "no throttle options",
+			curLimits:  throttling.Limits{UploadBytesPerSecond: 100},
+			genOptions: map[string]string{},
+			expectedLimits: throttling.Limits{
+				UploadBytesPerSecond: 100,
+			},
+		},
+		{
+			name:         "throttler is nil",
+			nilThrottler: true,
+			genOptions: map[string]string{
+				udmrepo.ThrottleOptionUploadBytes: "1024",
+			},
+		},
+		{
+			name: "invalid upload limit",
+			genOptions: map[string]string{
+				udmrepo.ThrottleOptionUploadBytes: "fake-value",
+			},
+			expectedErr: "invalid upload bandwidth limit fake-value: strconv.ParseFloat: parsing \"fake-value\": invalid syntax",
+		},
+		{
+			name: "invalid download limit",
+			genOptions: map[string]string{
+				udmrepo.ThrottleOptionDownloadBytes: "fake-value",
+			},
+			expectedErr: "invalid download bandwidth limit fake-value: strconv.ParseFloat: parsing \"fake-value\": invalid syntax",
+		},
+		{
+			name:      "set limits",
+			curLimits: throttling.Limits{ReadsPerSecond: 10},
+			genOptions: map[string]string{
+				udmrepo.ThrottleOptionUploadBytes:   "1024",
+				udmrepo.ThrottleOptionDownloadBytes: "2048",
+			},
+			expectedLimits: throttling.Limits{
+				ReadsPerSecond:         10,
+				UploadBytesPerSecond:   1024,
+				DownloadBytesPerSecond: 2048,
+			},
+		},
+		{
+			name: "unset limits",
+			curLimits: throttling.Limits{
+				UploadBytesPerSecond:   1024,
+				DownloadBytesPerSecond: 2048,
+			},
+			genOptions: map[string]string{
+				udmrepo.ThrottleOptionUploadBytes:   "0",
+				udmrepo.ThrottleOptionDownloadBytes: "0",
+			},
+			expectedLimits: throttling.Limits{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rawRepo := repomocks.NewDirectRepository(t)
+
+			var throttler throttling.SettableThrottler
+			if !tc.nilThrottler {
+				var err error
+				throttler, err = throttling.NewThrottler(tc.curLimits, time.Second, 0)
+				require.NoError(t, err)
+			}
+
+			if len(tc.genOptions) > 0 {
+				rawRepo.On("Throttler").Return(throttler)
+			}
+
+			err := setBandwidthLimits(rawRepo, tc.genOptions)
+
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+
+			if throttler != nil && tc.expectedErr == "" {
+				assert.Equal(t, tc.expectedLimits, throttler.Limits())
+			}
+		})
+	}
+}
+
+func TestSetCachingOptions(t *testing.T) {
+	testCases := []struct {
+		name            string
+		genOptions      map[string]string
+		expectedOptions content.CachingOptions
+		expectedErr     string
+	}{
+		{
+			name:       "no cache options",
+			genOptions: map[string]string{},
+			expectedOptions: content.CachingOptions{
+				CacheDirectory:         "cache",
+				ContentCacheSizeBytes:  2000 << 20,
+				MetadataCacheSizeBytes: 2000 << 20,
+			},
+		},
+		{
+			name: "invalid content cache limit",
+			genOptions: map[string]string{
+				udmrepo.GenOptionContentCacheLimitMB: "fake-value",
+			},
+			expectedErr: "invalid content cache limit fake-value: strconv.ParseInt: parsing \"fake-value\": invalid syntax",
+		},
+		{
+			name: "invalid metadata cache limit",
+			genOptions: map[string]string{
+				udmrepo.GenOptionMetadataCacheLimitMB: "fake-value",
+			},
+			expectedErr: "invalid metadata cache limit fake-value: strconv.ParseInt: parsing \"fake-value\": invalid syntax",
+		},
+		{
+			name: "invalid min eviction age",
+			genOptions: map[string]string{
+				udmrepo.GenOptionCacheMinEvictionAge: "fake-value",
+			},
+			expectedErr: "invalid cache min eviction age fake-value: time: invalid duration \"fake-value\"",
+		},
+		{
+			name: "set cache options",
+			genOptions: map[string]string{
+				udmrepo.GenOptionCacheDir:             "/nvme/kopia",
+				udmrepo.GenOptionContentCacheLimitMB:  "10000",
+				udmrepo.GenOptionMetadataCacheLimitMB: "500",
+				udmrepo.GenOptionCacheMinEvictionAge:  "10m",
+			},
+			expectedOptions: content.CachingOptions{
+				CacheDirectory:         "/nvme/kopia",
+				ContentCacheSizeBytes:  10000 << 20,
+				MetadataCacheSizeBytes: 500 << 20,
+				MinContentSweepAge:     600,
+				MinMetadataSweepAge:    600,
+			},
+		},
+		{
+			name: "keep the options not specified",
+			genOptions: map[string]string{
+				udmrepo.GenOptionMetadataCacheLimitMB: "500",
+			},
+			expectedOptions: content.CachingOptions{
+				CacheDirectory:         "cache",
+				ContentCacheSizeBytes:  2000 << 20,
+				MetadataCacheSizeBytes: 500 << 20,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configDir := t.TempDir()
+			configFile := filepath.Join(configDir, "repo.config")
+			require.NoError(t, os.WriteFile(configFile, []byte(`{"caching":{"cacheDirectory":"cache","maxCacheSize":2097152000,"maxMetadataCacheSize":2097152000}}`), 0600))
+
+			err := setCachingOptions(context.Background(), configFile, tc.genOptions)
+
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			if !filepath.IsAbs(tc.expectedOptions.CacheDirectory) {
+				tc.expectedOptions.CacheDirectory = filepath.Join(configDir, tc.expectedOptions.CacheDirectory)
+			}
+			options, err := repo.GetCachingOptions(context.Background(), configFile)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedOptions, *options)
+		})
+	}
+}
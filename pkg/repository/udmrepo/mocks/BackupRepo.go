@@ -169,6 +169,20 @@ func (_m *BackupRepo) Time() time.Time {
 	return r0
 }
 
+// UploadedBytes provides a mock function with given fields:
+func (_m *BackupRepo) UploadedBytes() int64 {
+	ret := _m.Called()
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewBackupRepo interface {
 	mock.TestingT
 	Cleanup(func())
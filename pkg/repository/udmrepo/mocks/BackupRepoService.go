@@ -45,17 +45,24 @@ func (_m *BackupRepoService) Init(ctx context.Context, repoOption udmrepo.RepoOp
 }
 
 // Maintain provides a mock function with given fields: ctx, repoOption
-func (_m *BackupRepoService) Maintain(ctx context.Context, repoOption udmrepo.RepoOptions) error {
+func (_m *BackupRepoService) Maintain(ctx context.Context, repoOption udmrepo.RepoOptions) (int64, error) {
 	ret := _m.Called(ctx, repoOption)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, udmrepo.RepoOptions) error); ok {
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, udmrepo.RepoOptions) int64); ok {
 		r0 = rf(ctx, repoOption)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, udmrepo.RepoOptions) error); ok {
+		r1 = rf(ctx, repoOption)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // Open provides a mock function with given fields: ctx, repoOption
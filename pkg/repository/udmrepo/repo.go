@@ -58,6 +58,9 @@ const (
 	ObjectDataBackupModeUnknown int = 0
 	ObjectDataBackupModeFull    int = 1
 	ObjectDataBackupModeInc     int = 2
+
+	// UnknownFreedBytes is returned as the freed bytes of a maintenance when they can't be measured
+	UnknownFreedBytes int64 = -1
 )
 
 // ObjectWriteOptions defines the options when creating an object for write
@@ -82,9 +85,10 @@ type BackupRepoService interface {
 	// repoOption: options to open the backup repository and the underlying storage.
 	Open(ctx context.Context, repoOption RepoOptions) (BackupRepo, error)
 
-	// Maintain is periodically called to maintain the backup repository to eliminate redundant data.
+	// Maintain is periodically called to maintain the backup repository to eliminate redundant data,
+	// it returns the number of bytes freed from the storage, UnknownFreedBytes if it can't be measured.
 	// repoOption: options to maintain the backup repository.
-	Maintain(ctx context.Context, repoOption RepoOptions) error
+	Maintain(ctx context.Context, repoOption RepoOptions) (int64, error)
 
 	// DefaultMaintenanceFrequency returns the defgault frequency of maintenance, callers refer this
 	// frequency to maintain the backup repository to get the best maintenance performance
@@ -119,6 +123,9 @@ type BackupRepo interface {
 	// Time returns the local time of the backup repository. It may be different from the time of the caller
 	Time() time.Time
 
+	// UploadedBytes returns the number of bytes written to the storage of the backup repository since it's opened
+	UploadedBytes() int64
+
 	// Close closes the backup repository
 	Close(ctx context.Context) error
 }
@@ -35,6 +35,14 @@ const (
 	GenOptionOwnerName   = "username"
 	GenOptionOwnerDomain = "domainname"
 
+	GenOptionCompression  = "compression"
+	GenOptionCacheLimitMB = "cacheLimitMB"
+
+	GenOptionCacheDir             = "cacheDir"
+	GenOptionContentCacheLimitMB  = "contentCacheLimitMB"
+	GenOptionMetadataCacheLimitMB = "metadataCacheLimitMB"
+	GenOptionCacheMinEvictionAge  = "cacheMinEvictionAge"
+
 	StoreOptionS3KeyID            = "accessKeyID"
 	StoreOptionS3Provider         = "providerName"
 	StoreOptionS3SecretKey        = "secretAccessKey"
@@ -100,7 +100,8 @@ func CmdEnv(backupLocation *velerov1api.BackupStorageLocation, credentialFileSto
 		config = map[string]string{}
 	}
 
-	if backupLocation.Spec.Credential != nil {
+	// the credentials file isn't used if the ambient identity of the pod is preferred and available
+	if backupLocation.Spec.Credential != nil && credentials.GetAmbientIdentity(backupLocation.Spec.Provider, config) == credentials.AmbientIdentityNone {
 		credsFile, err := credentialFileStore.Path(backupLocation.Spec.Credential)
 		if err != nil {
 			return []string{}, errors.WithStack(err)
@@ -0,0 +1,179 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/kube-aggregator/pkg/controllers/autoregister"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// isAggregatedAPIService returns whether the APIService is served by an aggregated API server
+// behind a service, rather than by the kube-apiserver itself.
+func isAggregatedAPIService(apiService *unstructured.Unstructured) bool {
+	if _, ok := apiService.GetLabels()[autoregister.AutoRegisterManagedLabel]; ok {
+		return false
+	}
+
+	service, found, err := unstructured.NestedMap(apiService.Object, "spec", "service")
+	return err == nil && found && service != nil
+}
+
+// splitAggregatedAPIResources returns the resources in the backup except the APIServices of the
+// aggregated APIs and the resources served by them, which are returned separately. The latter are
+// restored after everything else, so that the services and the deployments of the aggregated API
+// servers are restored before the APIServices are waited for, and the APIServices are available
+// before the resources served by them are restored.
+func (ctx *restoreContext) splitAggregatedAPIResources(backupResources map[string]*archive.ResourceItems) (map[string]*archive.ResourceItems, map[string]*archive.ResourceItems) {
+	apiServices := backupResources[kuberesource.APIServices.String()]
+	if apiServices == nil {
+		return backupResources, nil
+	}
+
+	groups := sets.NewString()
+	for _, name := range apiServices.ItemsByNamespace[""] {
+		path := archive.GetItemFilePath(ctx.restoreDir, kuberesource.APIServices.String(), "", name)
+		obj, err := archive.Unmarshal(ctx.fileSystem, path)
+		if err != nil {
+			ctx.log.WithError(err).WithField("apiService", name).Warn("Error decoding APIService, it's restored in the order of the priorities")
+			continue
+		}
+		if !isAggregatedAPIService(obj) {
+			continue
+		}
+
+		group, _, _ := unstructured.NestedString(obj.Object, "spec", "group")
+		groups.Insert(group)
+	}
+	if groups.Len() == 0 {
+		return backupResources, nil
+	}
+
+	resources := map[string]*archive.ResourceItems{}
+	aggregatedResources := map[string]*archive.ResourceItems{
+		kuberesource.APIServices.String(): apiServices,
+	}
+	for resource, items := range backupResources {
+		switch {
+		case resource == kuberesource.APIServices.String():
+		case groups.Has(schema.ParseGroupResource(resource).Group):
+			aggregatedResources[resource] = items
+		default:
+			resources[resource] = items
+		}
+	}
+
+	ctx.log.Infof("The resources of the aggregated API groups %v are restored after the other resources", groups.List())
+
+	return resources, aggregatedResources
+}
+
+// restoreAggregatedAPIResources restores the APIServices of the aggregated APIs, which are waited
+// for to be available once they are created, then the resources served by them.
+func (ctx *restoreContext) restoreAggregatedAPIResources(
+	aggregatedResources map[string]*archive.ResourceItems,
+	processedResources sets.String,
+	totalItems int,
+	processedItems int,
+	existingNamespaces sets.String,
+	update chan progressUpdate,
+) (int, int, results.Result, results.Result) {
+	warnings, errs := results.Result{}, results.Result{}
+
+	// the resources served by the aggregated APIs can only be resolved via discovery once the
+	// APIServices are available, discovery is refreshed after restoring the APIServices
+	for _, priorities := range []struct {
+		resourcePriorities  Priorities
+		includeAllResources bool
+	}{
+		{Priorities{HighPriorities: []string{kuberesource.APIServices.String()}}, false},
+		{Priorities{}, true},
+	} {
+		collection, _, w, e := ctx.getOrderedResourceCollection(
+			aggregatedResources,
+			make([]restoreableResource, 0),
+			processedResources,
+			priorities.resourcePriorities,
+			priorities.includeAllResources,
+		)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+
+		for _, selectedResource := range collection {
+			totalItems += selectedResource.totalItems
+		}
+
+		for _, selectedResource := range collection {
+			var w, e results.Result
+			processedItems, w, e = ctx.processSelectedResource(
+				selectedResource,
+				totalItems,
+				processedItems,
+				existingNamespaces,
+				update,
+			)
+			warnings.Merge(&w)
+			errs.Merge(&e)
+		}
+	}
+
+	return totalItems, processedItems, warnings, errs
+}
+
+// apiServiceAvailable waits for an APIService to be available, so that the resources served by
+// it can be restored, before letting the restore continue.
+func (ctx *restoreContext) apiServiceAvailable(name string, apiServiceClient client.Dynamic) (bool, error) {
+	apiServiceLogger := ctx.log.WithField("apiServiceName", name)
+
+	var available bool
+
+	err := wait.PollImmediate(time.Second, ctx.resourceTimeout, func() (bool, error) {
+		unstructuredAPIService, err := apiServiceClient.Get(name, metav1.GetOptions{})
+		if err != nil {
+			return true, err
+		}
+		available, err = kube.IsAPIServiceAvailable(unstructuredAPIService)
+		if err != nil {
+			return true, err
+		}
+
+		if !available {
+			apiServiceLogger.Debug("APIService not yet available")
+		}
+
+		return available, nil
+	})
+
+	if err == wait.ErrWaitTimeout {
+		apiServiceLogger.Debug("timeout reached waiting for APIService to be available")
+		err = nil
+	}
+
+	return available, errors.WithStack(err)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+)
+
+// clusterLister lists the objects from the target cluster for the cluster lookups of the
+// resource modifiers. The objects are listed once per restore, so the lookups evaluate to
+// the same values for all the items of the restore.
+type clusterLister struct {
+	dynamicFactory  client.DynamicFactory
+	discoveryHelper discovery.Helper
+	objects         map[string][]unstructured.Unstructured
+}
+
+func newClusterLister(dynamicFactory client.DynamicFactory, discoveryHelper discovery.Helper) *clusterLister {
+	return &clusterLister{
+		dynamicFactory:  dynamicFactory,
+		discoveryHelper: discoveryHelper,
+		objects:         make(map[string][]unstructured.Unstructured),
+	}
+}
+
+func (l *clusterLister) List(groupResource, namespace string, selector labels.Selector) ([]unstructured.Unstructured, error) {
+	key := groupResource + "/" + namespace + "?" + selector.String()
+	if objects, ok := l.objects[key]; ok {
+		return objects, nil
+	}
+
+	gvr, resource, err := l.discoveryHelper.ResourceFor(schema.ParseGroupResource(groupResource).WithVersion(""))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting resource %s", groupResource)
+	}
+	if !resource.Namespaced {
+		namespace = ""
+	}
+
+	resourceClient, err := l.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting client for %s", groupResource)
+	}
+
+	list, err := resourceClient.List(metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing %s", groupResource)
+	}
+
+	l.objects[key] = list.Items
+	return list.Items, nil
+}
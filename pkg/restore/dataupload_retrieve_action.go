@@ -79,6 +79,9 @@ func (d *DataUploadRetrieveAction) Execute(input *velero.RestoreItemActionExecut
 		SourceNamespace:       dataUpload.Spec.SourceNamespace,
 		DataMoverResult:       dataUpload.Status.DataMoverResult,
 	}
+	if dataUpload.Spec.CSISnapshot != nil {
+		dataUploadResult.VolumeGroup = dataUpload.Spec.CSISnapshot.VolumeGroup
+	}
 
 	jsonBytes, err := json.Marshal(dataUploadResult)
 	if err != nil {
@@ -0,0 +1,154 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"sort"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// ensureNamespace ensures the namespace exists and is ready, true is returned if the
+// namespace is created. For a dry-run, the namespace is only compared with the cluster.
+func (ctx *restoreContext) ensureNamespace(ns *v1.Namespace) (bool, error) {
+	if ctx.dryRun {
+		return false, ctx.previewNamespace(ns)
+	}
+
+	_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(ns, ctx.namespaceClient, ctx.resourceTerminatingTimeout)
+	if nsCreated {
+		ctx.addReadinessItem(kuberesource.Namespaces, "", ns.Name, nil)
+	}
+	return nsCreated, err
+}
+
+// previewNamespace records the namespace in the dry-run result if it doesn't exist in the
+// cluster. The existing namespaces are not updated by the restore, so they're not recorded.
+func (ctx *restoreContext) previewNamespace(ns *v1.Namespace) error {
+	if ctx.previewedNamespaces.Has(ns.Name) {
+		return nil
+	}
+
+	if _, err := ctx.namespaceClient.Get(go_context.Background(), ns.Name, metav1.GetOptions{}); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error getting namespace %s", ns.Name)
+		}
+
+		ctx.dryRunResult.Items = append(ctx.dryRunResult.Items, velerov1api.RestoreDryRunItem{
+			Resource: kuberesource.Namespaces.String(),
+			Name:     ns.Name,
+			State:    velerov1api.RestoreDryRunItemStateMissing,
+		})
+	}
+
+	ctx.previewedNamespaces.Insert(ns.Name)
+	return nil
+}
+
+// previewItem compares the item that would be restored with the one in the cluster and
+// records the result in the dry-run result, nothing is created or updated in the cluster.
+func (ctx *restoreContext) previewItem(obj *unstructured.Unstructured, groupResource schema.GroupResource, resourceClient client.Dynamic) error {
+	item := velerov1api.RestoreDryRunItem{
+		Resource:  groupResource.String(),
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+
+	fromCluster, err := resourceClient.Get(obj.GetName(), metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		item.State = velerov1api.RestoreDryRunItemStateMissing
+	case err != nil:
+		return errors.Wrapf(err, "error getting %s from the cluster", getResourceID(groupResource, obj.GetNamespace(), obj.GetName()))
+	default:
+		// Remove insubstantial metadata, and copy the restore labels as the regular restore
+		// does before comparing the objects.
+		if fromCluster, err = resetMetadataAndStatus(fromCluster); err != nil {
+			return errors.Wrapf(err, "error resetting metadata of %s", getResourceID(groupResource, obj.GetNamespace(), obj.GetName()))
+		}
+		labels := obj.GetLabels()
+		addRestoreLabels(fromCluster, labels[velerov1api.RestoreNameLabel], labels[velerov1api.BackupNameLabel])
+
+		item.ChangedFields = changedFields(fromCluster.Object, obj.Object, "")
+		if len(item.ChangedFields) == 0 {
+			item.State = velerov1api.RestoreDryRunItemStateExists
+		} else {
+			item.State = velerov1api.RestoreDryRunItemStateChanged
+		}
+	}
+
+	ctx.log.Infof("Previewed %s: %s", getResourceID(groupResource, obj.GetNamespace(), obj.GetName()), item.State)
+	ctx.dryRunResult.Items = append(ctx.dryRunResult.Items, item)
+	return nil
+}
+
+// changedFields returns the sorted paths of the fields which are different between the
+// objects, the objects are compared recursively and the lists are compared as a whole.
+func changedFields(fromCluster, desired map[string]interface{}, prefix string) []string {
+	var fields []string
+	for key := range fromCluster {
+		if _, exist := desired[key]; !exist {
+			fields = append(fields, prefix+key)
+		}
+	}
+
+	for key, desiredValue := range desired {
+		clusterValue, exist := fromCluster[key]
+		if !exist {
+			fields = append(fields, prefix+key)
+			continue
+		}
+
+		clusterMap, clusterIsMap := clusterValue.(map[string]interface{})
+		desiredMap, desiredIsMap := desiredValue.(map[string]interface{})
+		if clusterIsMap && desiredIsMap {
+			fields = append(fields, changedFields(clusterMap, desiredMap, prefix+key+".")...)
+			continue
+		}
+
+		if !equality.Semantic.DeepEqual(clusterValue, desiredValue) {
+			fields = append(fields, prefix+key)
+		}
+	}
+
+	sort.Strings(fields)
+	return fields
+}
+
+func sortDryRunItems(items []velerov1api.RestoreDryRunItem) {
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Resource != items[j].Resource {
+			return items[i].Resource < items[j].Resource
+		}
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+}
@@ -0,0 +1,117 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// TestRestoreDryRun runs a dry-run restore and verifies the items are compared with the
+// cluster without creating anything.
+func TestRestoreDryRun(t *testing.T) {
+	h := newHarness(t)
+	h.AddItems(t, test.Pods(builder.ForPod("ns-1", "pod-1").Result()))
+	h.AddItems(t, test.PVCs(builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("standard").Result()))
+	_, err := h.KubeClient.CoreV1().Namespaces().Create(context.TODO(), builder.ForNamespace("ns-1").Result(), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	data := &Request{
+		Log:     h.log,
+		Restore: defaultRestore().DryRun(true).Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("pods",
+				builder.ForPod("ns-1", "pod-1").Result(),
+				builder.ForPod("ns-2", "pod-2").Result(),
+			).
+			AddItems("persistentvolumeclaims", builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("gp3").Result()).
+			Done(),
+	}
+	warnings, errs := h.restorer.Restore(data, nil, nil)
+	assertEmptyResults(t, warnings, errs)
+
+	require.NotNil(t, data.DryRunResult)
+	assert.Equal(t, []velerov1api.RestoreDryRunItem{
+		{Resource: "namespaces", Name: "ns-2", State: velerov1api.RestoreDryRunItemStateMissing},
+		{Resource: "persistentvolumeclaims", Namespace: "ns-1", Name: "pvc-1", State: velerov1api.RestoreDryRunItemStateChanged, ChangedFields: []string{"spec.storageClassName"}},
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-1", State: velerov1api.RestoreDryRunItemStateExists},
+		{Resource: "pods", Namespace: "ns-2", Name: "pod-2", State: velerov1api.RestoreDryRunItemStateMissing},
+	}, data.DryRunResult.Items)
+
+	// nothing is created in the cluster
+	assertAPIContents(t, h, map[*test.APIResource][]string{
+		test.Pods(): {"ns-1/pod-1"},
+		test.PVCs(): {"ns-1/pvc-1"},
+	})
+	_, err = h.KubeClient.CoreV1().Namespaces().Get(context.TODO(), "ns-2", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestChangedFields(t *testing.T) {
+	tests := []struct {
+		name        string
+		fromCluster map[string]interface{}
+		desired     map[string]interface{}
+		expected    []string
+	}{
+		{
+			name:        "same objects",
+			fromCluster: map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(1)}},
+			desired:     map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(1)}},
+		},
+		{
+			name: "nested fields are changed, added and removed",
+			fromCluster: map[string]interface{}{
+				"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "a", "tier": "web"}},
+				"spec":     map[string]interface{}{"replicas": int64(3)},
+			},
+			desired: map[string]interface{}{
+				"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "b"}},
+				"spec":     map[string]interface{}{"replicas": int64(1), "paused": true},
+			},
+			expected: []string{"metadata.labels.app", "metadata.labels.tier", "spec.paused", "spec.replicas"},
+		},
+		{
+			name:        "lists are compared as a whole",
+			fromCluster: map[string]interface{}{"spec": map[string]interface{}{"ports": []interface{}{int64(80), int64(443)}}},
+			desired:     map[string]interface{}{"spec": map[string]interface{}{"ports": []interface{}{int64(80)}}},
+			expected:    []string{"spec.ports"},
+		},
+		{
+			name:        "field type is changed",
+			fromCluster: map[string]interface{}{"data": map[string]interface{}{"key": "value"}},
+			desired:     map[string]interface{}{"data": "value"},
+			expected:    []string{"data"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, changedFields(tc.fromCluster, tc.desired, ""))
+		})
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	riav3 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v3"
+)
+
+// FinalizeRestoredItems calls the Finalize method of the v3 restore item actions with the items
+// created by the restore which they were executed on, the actions which weren't executed on any
+// created item aren't called. It's called once all the items of the restore are created and its
+// async operations finished, the errors of the actions are returned.
+func FinalizeRestoredItems(restore *velerov1api.Restore, actions []riav3.RestoreItemAction, items map[string][]velero.RestoredItem, log logrus.FieldLogger) []error {
+	var errs []error
+	for _, action := range actions {
+		restoredItems := items[action.Name()]
+		if len(restoredItems) == 0 {
+			continue
+		}
+
+		log.WithField("action", action.Name()).Infof("Finalizing %d restored items", len(restoredItems))
+		if err := action.Finalize(&velero.RestoreItemActionFinalizeInput{
+			Restore:       restore,
+			RestoredItems: restoredItems,
+		}); err != nil {
+			errs = append(errs, errors.Wrapf(err, "error finalizing the restored items by restore item action %s", action.Name()))
+		}
+	}
+	return errs
+}
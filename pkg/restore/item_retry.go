@@ -0,0 +1,96 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+const (
+	defaultItemRetryInitialBackoff = time.Second
+	defaultItemRetryMaxBackoff     = 30 * time.Second
+)
+
+// itemRetrier retries the API calls restoring an item on the transient errors of the API
+// server, with exponential backoff and within the retry budget of the item.
+type itemRetrier struct {
+	remaining  int32
+	backoff    time.Duration
+	maxBackoff time.Duration
+	log        logrus.FieldLogger
+}
+
+// newItemRetrier returns the retrier of an item for the restore's item retry policy, the API
+// calls aren't retried if the restore doesn't have one.
+func newItemRetrier(policy *velerov1api.RestoreItemRetryPolicy, log logrus.FieldLogger) *itemRetrier {
+	r := &itemRetrier{log: log}
+	if policy == nil {
+		return r
+	}
+
+	r.remaining = policy.MaxRetries
+	r.backoff = policy.InitialBackoff.Duration
+	if r.backoff <= 0 {
+		r.backoff = defaultItemRetryInitialBackoff
+	}
+	r.maxBackoff = policy.MaxBackoff.Duration
+	if r.maxBackoff <= 0 {
+		r.maxBackoff = defaultItemRetryMaxBackoff
+	}
+	return r
+}
+
+// do calls fn until it succeeds, fails with an error which isn't transient, or the retry
+// budget of the item is used up.
+func (r *itemRetrier) do(operation string, fn func() error) error {
+	for {
+		err := fn()
+		if err == nil || r.remaining <= 0 || !isTransientAPIError(err) {
+			return err
+		}
+
+		r.remaining--
+		r.log.WithError(err).Warnf("Transient error %s, retrying in %s", operation, r.backoff)
+		time.Sleep(r.backoff)
+		if r.backoff *= 2; r.backoff > r.maxBackoff {
+			r.backoff = r.maxBackoff
+		}
+	}
+}
+
+// isTransientAPIError returns whether the error of the API server is likely to go away on
+// retry, i.e. throttling, timeouts, conflicts and the unavailable etcd or webhooks.
+func isTransientAPIError(err error) bool {
+	switch {
+	case apierrors.IsTooManyRequests(err), apierrors.IsServerTimeout(err), apierrors.IsTimeout(err),
+		apierrors.IsServiceUnavailable(err), apierrors.IsConflict(err):
+		return true
+	case apierrors.IsInternalError(err):
+		// the etcd timeouts and the webhooks which can't be called are reported as internal errors
+		msg := err.Error()
+		return strings.Contains(msg, "etcdserver: request timed out") ||
+			strings.Contains(msg, "etcdserver: leader changed") ||
+			strings.Contains(msg, "failed calling webhook")
+	}
+	return false
+}
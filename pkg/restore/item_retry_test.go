@@ -0,0 +1,141 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestIsTransientAPIError(t *testing.T) {
+	pods := schema.GroupResource{Resource: "pods"}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "throttled",
+			err:  apierrors.NewTooManyRequests("too many requests", 1),
+			want: true,
+		},
+		{
+			name: "server timeout",
+			err:  apierrors.NewServerTimeout(pods, "create", 1),
+			want: true,
+		},
+		{
+			name: "conflict",
+			err:  apierrors.NewConflict(pods, "pod-1", errors.New("the object has been modified")),
+			want: true,
+		},
+		{
+			name: "etcd timeout",
+			err:  apierrors.NewInternalError(errors.New("etcdserver: request timed out")),
+			want: true,
+		},
+		{
+			name: "unavailable webhook",
+			err:  apierrors.NewInternalError(errors.New(`failed calling webhook "validate.example.com": connection refused`)),
+			want: true,
+		},
+		{
+			name: "other internal error",
+			err:  apierrors.NewInternalError(errors.New("foo")),
+		},
+		{
+			name: "invalid object",
+			err:  apierrors.NewInvalid(schema.GroupKind{Kind: "Pod"}, "pod-1", nil),
+		},
+		{
+			name: "not an API error",
+			err:  errors.New("foo"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, isTransientAPIError(tc.err))
+		})
+	}
+}
+
+func TestItemRetrier(t *testing.T) {
+	throttled := apierrors.NewTooManyRequests("too many requests", 1)
+	policy := &velerov1api.RestoreItemRetryPolicy{
+		MaxRetries:     3,
+		InitialBackoff: metav1.Duration{Duration: time.Millisecond},
+		MaxBackoff:     metav1.Duration{Duration: 2 * time.Millisecond},
+	}
+
+	// the calls aren't retried without a policy
+	calls := 0
+	err := newItemRetrier(nil, velerotest.NewLogger()).do("creating pod", func() error {
+		calls++
+		return throttled
+	})
+	assert.Equal(t, throttled, err)
+	assert.Equal(t, 1, calls)
+
+	// the transient errors are retried
+	calls = 0
+	err = newItemRetrier(policy, velerotest.NewLogger()).do("creating pod", func() error {
+		if calls++; calls < 3 {
+			return throttled
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+
+	// the other errors aren't retried
+	calls = 0
+	invalid := apierrors.NewInvalid(schema.GroupKind{Kind: "Pod"}, "pod-1", nil)
+	err = newItemRetrier(policy, velerotest.NewLogger()).do("creating pod", func() error {
+		calls++
+		return invalid
+	})
+	assert.Equal(t, invalid, err)
+	assert.Equal(t, 1, calls)
+
+	// the retry budget is shared by the calls of an item
+	calls = 0
+	retrier := newItemRetrier(policy, velerotest.NewLogger())
+	err = retrier.do("creating pod", func() error {
+		if calls++; calls < 3 {
+			return throttled
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	err = retrier.do("patching pod", func() error {
+		calls++
+		return throttled
+	})
+	assert.Equal(t, throttled, err)
+	assert.Equal(t, 5, calls)
+	assert.Equal(t, 2*time.Millisecond, retrier.backoff)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/velero/internal/hook"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// runPreRestoreJobHooks runs the pre-restore job hooks one by one and waits for their Jobs to
+// finish. It returns false if a hook whose error mode is Fail fails, so that the restore stops
+// before restoring any items.
+func (ctx *restoreContext) runPreRestoreJobHooks(warnings, errs *results.Result) bool {
+	for i := range ctx.restore.Spec.Hooks.Jobs {
+		jobHook := &ctx.restore.Spec.Hooks.Jobs[i]
+		if jobHook.Phase != velerov1api.RestoreJobHookPhasePre {
+			continue
+		}
+		if ctx.dryRun {
+			ctx.log.Infof("Skipping pre-restore job hook %s in dry-run mode", jobHook.Name)
+			continue
+		}
+
+		ctx.log.Infof("Running pre-restore job hook %s", jobHook.Name)
+		if err := hook.RunRestoreJobHook(go_context.Background(), ctx.kbClient, ctx.restore, jobHook, ctx.log); err != nil {
+			if jobHook.OnError == velerov1api.HookErrorModeContinue {
+				warnings.AddVeleroError(err)
+				continue
+			}
+			errs.AddVeleroError(err)
+			return false
+		}
+	}
+	return true
+}
+
+// startPostRestoreJobHooks creates the Jobs of the post-restore job hooks, and tracks them as the
+// async operations of the restore, so that the restore completes when the Jobs finish.
+func (ctx *restoreContext) startPostRestoreJobHooks(errs *results.Result) {
+	for i := range ctx.restore.Spec.Hooks.Jobs {
+		jobHook := &ctx.restore.Spec.Hooks.Jobs[i]
+		if jobHook.Phase != velerov1api.RestoreJobHookPhasePost {
+			continue
+		}
+		if ctx.dryRun {
+			ctx.log.Infof("Skipping post-restore job hook %s in dry-run mode", jobHook.Name)
+			continue
+		}
+
+		ctx.log.Infof("Starting post-restore job hook %s", jobHook.Name)
+		job, err := hook.CreateRestoreJobHookJob(go_context.Background(), ctx.kbClient, ctx.restore, jobHook)
+		if err != nil {
+			errs.AddVeleroError(err)
+			continue
+		}
+
+		now := metav1.Now()
+		*ctx.itemOperationsList = append(*ctx.itemOperationsList, &itemoperation.RestoreOperation{
+			Spec: itemoperation.RestoreOperationSpec{
+				RestoreName:       ctx.restore.Name,
+				RestoreUID:        string(ctx.restore.UID),
+				RestoreItemAction: hook.RestoreJobHookAction,
+				ResourceIdentifier: velero.ResourceIdentifier{
+					GroupResource: kuberesource.Jobs,
+					Namespace:     job.Namespace,
+					Name:          job.Name,
+				},
+				OperationID: hook.RestoreJobHookOperationID(job),
+			},
+			Status: itemoperation.OperationStatus{
+				Phase:   itemoperation.OperationPhaseNew,
+				Created: &now,
+			},
+		})
+	}
+}
@@ -19,6 +19,10 @@ package restore
 import (
 	"fmt"
 	"strings"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
 )
 
 const (
@@ -90,3 +94,46 @@ func (p *Priorities) Set(s string) error {
 func (p *Priorities) Type() string {
 	return "stringArray"
 }
+
+type prioritiesConfig struct {
+	HighPriorities []string `json:"highPriorities,omitempty"`
+	LowPriorities  []string `json:"lowPriorities,omitempty"`
+}
+
+// GetPrioritiesFromConfigMap parses the restore resource priorities from the configmap.
+// The configmap should contain only one data entry, which is a YAML document with the
+// highPriorities and lowPriorities lists.
+func GetPrioritiesFromConfigMap(cm *corev1api.ConfigMap) (*Priorities, error) {
+	if cm == nil {
+		return nil, errors.New("could not parse resource priorities from nil configmap")
+	}
+	if len(cm.Data) != 1 {
+		return nil, errors.Errorf("illegal resource priorities %s/%s configmap", cm.Namespace, cm.Name)
+	}
+
+	var yamlData string
+	for _, v := range cm.Data {
+		yamlData = v
+	}
+
+	config := &prioritiesConfig{}
+	if err := yaml.UnmarshalStrict([]byte(yamlData), config); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling resource priorities")
+	}
+
+	seen := make(map[string]bool)
+	for _, resource := range append(append([]string{}, config.HighPriorities...), config.LowPriorities...) {
+		if resource == "" || resource == prioritySeparator {
+			return nil, errors.Errorf("invalid resource %q in resource priorities", resource)
+		}
+		if seen[resource] {
+			return nil, errors.Errorf("resource %s is specified multiple times in resource priorities", resource)
+		}
+		seen[resource] = true
+	}
+
+	return &Priorities{
+		HighPriorities: config.HighPriorities,
+		LowPriorities:  config.LowPriorities,
+	}, nil
+}
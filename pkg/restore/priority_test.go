@@ -21,6 +21,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
 )
 
 func TestStringOfPriorities(t *testing.T) {
@@ -108,3 +111,64 @@ func TestSetOfPriority(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPrioritiesFromConfigMap(t *testing.T) {
+	cases := []struct {
+		name       string
+		cm         *corev1api.ConfigMap
+		priorities *Priorities
+		err        string
+	}{
+		{
+			name: "nil configmap",
+			err:  "could not parse resource priorities from nil configmap",
+		},
+		{
+			name: "configmap with multiple data entries",
+			cm:   builder.ForConfigMap("velero", "priorities").Data("a", "", "b", "").Result(),
+			err:  "illegal resource priorities velero/priorities configmap",
+		},
+		{
+			name: "unknown field",
+			cm:   builder.ForConfigMap("velero", "priorities").Data("priorities", "highPriority:\n- pods\n").Result(),
+			err:  `error unmarshaling resource priorities: error unmarshaling JSON: while decoding JSON: json: unknown field "highPriority"`,
+		},
+		{
+			name: "separator in priorities",
+			cm:   builder.ForConfigMap("velero", "priorities").Data("priorities", "highPriorities:\n- pods\n- \"-\"\n").Result(),
+			err:  `invalid resource "-" in resource priorities`,
+		},
+		{
+			name: "duplicated resources",
+			cm:   builder.ForConfigMap("velero", "priorities").Data("priorities", "highPriorities:\n- pods\nlowPriorities:\n- pods\n").Result(),
+			err:  "resource pods is specified multiple times in resource priorities",
+		},
+		{
+			name: "high and low priorities",
+			cm:   builder.ForConfigMap("velero", "priorities").Data("priorities", "highPriorities:\n- customresourcedefinitions\n- deployments.apps\nlowPriorities:\n- foos.example.com\n").Result(),
+			priorities: &Priorities{
+				HighPriorities: []string{"customresourcedefinitions", "deployments.apps"},
+				LowPriorities:  []string{"foos.example.com"},
+			},
+		},
+		{
+			name: "only high priorities",
+			cm:   builder.ForConfigMap("velero", "priorities").Data("priorities", "highPriorities:\n- customresourcedefinitions\n").Result(),
+			priorities: &Priorities{
+				HighPriorities: []string{"customresourcedefinitions"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			priorities, err := GetPrioritiesFromConfigMap(c.cm)
+			if c.err != "" {
+				require.EqualError(t, err, c.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.priorities, priorities)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"fmt"
+
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	storagev1api "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// annIsDefaultStorageClass is the annotation marking the default storage class.
+const annIsDefaultStorageClass = "storageclass.kubernetes.io/is-default-class"
+
+// applyPVCSizeOverrides grows the requested storage of the PVC restored from a CSI volume
+// snapshot according to the restore's PVC size overrides. The override which can't be applied,
+// e.g. the storage class doesn't allow volume expansion, is returned as a warning.
+func (ctx *restoreContext) applyPVCSizeOverrides(obj *unstructured.Unstructured, groupResource schema.GroupResource) (string, error) {
+	overrides := ctx.restore.Spec.PVCSizeOverrides
+	if overrides == nil || groupResource != kuberesource.PersistentVolumeClaims {
+		return "", nil
+	}
+
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
+		return "", errors.Wrap(err, "error converting item to PersistentVolumeClaim")
+	}
+	if !restoredFromVolumeSnapshot(pvc) {
+		return "", nil
+	}
+
+	// the item is still in the namespace of the backup
+	key := pvc.Namespace + "/" + pvc.Name
+	requested := pvc.Spec.Resources.Requests[corev1api.ResourceStorage]
+	size, explicit := overrides.Sizes[key]
+	if !explicit {
+		if overrides.GrowthPercentage == 0 || requested.IsZero() {
+			return "", nil
+		}
+		size = growQuantity(requested, overrides.GrowthPercentage)
+	}
+
+	switch size.Cmp(requested) {
+	case 0:
+		return "", nil
+	case -1:
+		return fmt.Sprintf("size %s of persistent volume claim %s is smaller than its requested storage %s, the volume restored from a snapshot can't be shrunk", size.String(), key, requested.String()), nil
+	}
+
+	storageClass := pvc.Spec.StorageClassName
+	if storageClass == nil || *storageClass == "" {
+		if annotated := pvc.Annotations[annStorageClass]; annotated != "" {
+			storageClass = &annotated
+		}
+	}
+	expandable, err := ctx.allowsVolumeExpansion(storageClass)
+	if err != nil {
+		return "", err
+	}
+	if !expandable {
+		return fmt.Sprintf("storage class of persistent volume claim %s doesn't allow volume expansion, its requested storage isn't changed to %s", key, size.String()), nil
+	}
+
+	ctx.log.Infof("Updating requested storage of persistent volume claim %s from %s to %s", key, requested.String(), size.String())
+	return "", errors.Wrap(unstructured.SetNestedField(obj.Object, size.String(), "spec", "resources", "requests", "storage"), "error setting spec.resources.requests.storage")
+}
+
+// restoredFromVolumeSnapshot returns true if the PVC is provisioned from a CSI volume snapshot.
+func restoredFromVolumeSnapshot(pvc *corev1api.PersistentVolumeClaim) bool {
+	if ref := pvc.Spec.DataSource; ref != nil && isVolumeSnapshot(ref.APIGroup, ref.Kind) {
+		return true
+	}
+	if ref := pvc.Spec.DataSourceRef; ref != nil && isVolumeSnapshot(ref.APIGroup, ref.Kind) {
+		return true
+	}
+	return false
+}
+
+func isVolumeSnapshot(apiGroup *string, kind string) bool {
+	return apiGroup != nil && *apiGroup == snapshotv1api.GroupName && kind == "VolumeSnapshot"
+}
+
+// growQuantity grows the quantity by the percentage, rounded up to a whole MiB.
+func growQuantity(q resource.Quantity, percentage int32) resource.Quantity {
+	const mib = int64(1024 * 1024)
+
+	value := q.Value()
+	grown := value + (value*int64(percentage)+99)/100
+	grown = (grown + mib - 1) / mib * mib
+	return *resource.NewQuantity(grown, resource.BinarySI)
+}
+
+// allowsVolumeExpansion returns true if the storage class allows volume expansion, the default
+// storage class is checked if the storage class isn't set.
+func (ctx *restoreContext) allowsVolumeExpansion(storageClass *string) (bool, error) {
+	name := ""
+	if storageClass != nil {
+		if *storageClass == "" {
+			// an empty storage class disables the dynamic provisioning
+			return false, nil
+		}
+		name = *storageClass
+	}
+
+	if ctx.volumeExpansionStorageClasses == nil {
+		ctx.volumeExpansionStorageClasses = make(map[string]bool)
+	}
+	if expandable, ok := ctx.volumeExpansionStorageClasses[name]; ok {
+		return expandable, nil
+	}
+
+	var sc *storagev1api.StorageClass
+	if name != "" {
+		sc = &storagev1api.StorageClass{}
+		err := ctx.kbClient.Get(go_context.Background(), crclient.ObjectKey{Name: name}, sc)
+		if apierrors.IsNotFound(err) {
+			sc = nil
+		} else if err != nil {
+			return false, errors.Wrapf(err, "error getting storage class %s", name)
+		}
+	} else {
+		list := &storagev1api.StorageClassList{}
+		if err := ctx.kbClient.List(go_context.Background(), list); err != nil {
+			return false, errors.Wrap(err, "error listing storage classes")
+		}
+		for i := range list.Items {
+			if list.Items[i].Annotations[annIsDefaultStorageClass] == "true" {
+				sc = &list.Items[i]
+				break
+			}
+		}
+	}
+
+	expandable := sc != nil && sc.AllowVolumeExpansion != nil && *sc.AllowVolumeExpansion
+	ctx.volumeExpansionStorageClasses[name] = expandable
+	return expandable, nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestApplyPVCSizeOverrides(t *testing.T) {
+	pvc := func(storageClass, dataSource, storage string) string {
+		return `{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"name":"pvc-1","namespace":"ns-1"},"spec":{` +
+			storageClass + dataSource + `"resources":{"requests":{"storage":"` + storage + `"}}}}`
+	}
+	const (
+		expandable   = `"storageClassName":"expandable",`
+		fixed        = `"storageClassName":"fixed",`
+		snapshot     = `"dataSource":{"apiGroup":"snapshot.storage.k8s.io","kind":"VolumeSnapshot","name":"snapshot-1"},`
+		snapshotRef  = `"dataSourceRef":{"apiGroup":"snapshot.storage.k8s.io","kind":"VolumeSnapshot","name":"snapshot-1"},`
+		otherVolume  = `"dataSource":{"kind":"PersistentVolumeClaim","name":"pvc-2"},`
+		noDataSource = ``
+	)
+
+	tests := []struct {
+		name            string
+		restore         *velerov1api.Restore
+		groupResource   schema.GroupResource
+		item            string
+		expected        string
+		expectedWarning string
+	}{
+		{
+			name:          "no overrides",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+			groupResource: kuberesource.PersistentVolumeClaims,
+			item:          pvc(expandable, snapshot, "10Gi"),
+			expected:      pvc(expandable, snapshot, "10Gi"),
+		},
+		{
+			name:          "PVC is grown by the percentage",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").PVCSizeOverrides(20).Result(),
+			groupResource: kuberesource.PersistentVolumeClaims,
+			item:          pvc(expandable, snapshot, "10Gi"),
+			expected:      pvc(expandable, snapshot, "12Gi"),
+		},
+		{
+			name:          "explicit size takes precedence over the percentage",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").PVCSizeOverrides(20, "ns-1/pvc-1", "100Gi").Result(),
+			groupResource: kuberesource.PersistentVolumeClaims,
+			item:          pvc(expandable, snapshotRef, "10Gi"),
+			expected:      pvc(expandable, snapshotRef, "100Gi"),
+		},
+		{
+			name:          "PVC of the default storage class is grown",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").PVCSizeOverrides(50).Result(),
+			groupResource: kuberesource.PersistentVolumeClaims,
+			item:          pvc("", snapshot, "1Gi"),
+			expected:      pvc("", snapshot, "1536Mi"),
+		},
+		{
+			name:          "PVC not restored from a volume snapshot isn't changed",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").PVCSizeOverrides(20).Result(),
+			groupResource: kuberesource.PersistentVolumeClaims,
+			item:          pvc(expandable, otherVolume, "10Gi"),
+			expected:      pvc(expandable, otherVolume, "10Gi"),
+		},
+		{
+			name:          "PVC without data source isn't changed",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").PVCSizeOverrides(20).Result(),
+			groupResource: kuberesource.PersistentVolumeClaims,
+			item:          pvc(expandable, noDataSource, "10Gi"),
+			expected:      pvc(expandable, noDataSource, "10Gi"),
+		},
+		{
+			name:            "storage class not allowing volume expansion",
+			restore:         builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").PVCSizeOverrides(20).Result(),
+			groupResource:   kuberesource.PersistentVolumeClaims,
+			item:            pvc(fixed, snapshot, "10Gi"),
+			expected:        pvc(fixed, snapshot, "10Gi"),
+			expectedWarning: "storage class of persistent volume claim ns-1/pvc-1 doesn't allow volume expansion, its requested storage isn't changed to 12Gi",
+		},
+		{
+			name:            "PVC isn't shrunk",
+			restore:         builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").PVCSizeOverrides(0, "ns-1/pvc-1", "5Gi").Result(),
+			groupResource:   kuberesource.PersistentVolumeClaims,
+			item:            pvc(expandable, snapshot, "10Gi"),
+			expected:        pvc(expandable, snapshot, "10Gi"),
+			expectedWarning: "size 5Gi of persistent volume claim ns-1/pvc-1 is smaller than its requested storage 10Gi, the volume restored from a snapshot can't be shrunk",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			allowed, disallowed := true, false
+			expandableClass := builder.ForStorageClass("expandable").Result()
+			expandableClass.AllowVolumeExpansion = &allowed
+			fixedClass := builder.ForStorageClass("fixed").Result()
+			fixedClass.AllowVolumeExpansion = &disallowed
+			defaultClass := builder.ForStorageClass("default").ObjectMeta(builder.WithAnnotations(annIsDefaultStorageClass, "true")).Result()
+			defaultClass.AllowVolumeExpansion = &allowed
+
+			ctx := &restoreContext{
+				restore:  test.restore,
+				kbClient: velerotest.NewFakeControllerRuntimeClient(t, expandableClass, fixedClass, defaultClass),
+				log:      velerotest.NewLogger(),
+			}
+
+			obj := &unstructured.Unstructured{}
+			require.NoError(t, obj.UnmarshalJSON([]byte(test.item)))
+			warning, err := ctx.applyPVCSizeOverrides(obj, test.groupResource)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedWarning, warning)
+
+			expected := &unstructured.Unstructured{}
+			require.NoError(t, expected.UnmarshalJSON([]byte(test.expected)))
+			assert.Equal(t, expected, obj)
+		})
+	}
+}
+
+func TestGrowQuantity(t *testing.T) {
+	grown := growQuantity(resource.MustParse("10Gi"), 20)
+	assert.Equal(t, "12Gi", grown.String())
+
+	// rounded up to a whole MiB
+	grown = growQuantity(resource.MustParse("1000M"), 10)
+	assert.Equal(t, "1050Mi", grown.String())
+}
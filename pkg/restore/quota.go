@@ -0,0 +1,297 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// quotaWorkloadResources are the resources whose pods are counted against the resource quotas
+// of their namespaces. The ReplicaSets and the pods controlled by another workload aren't
+// counted, they're counted as part of the workload controlling them.
+var quotaWorkloadResources = []schema.GroupResource{
+	kuberesource.Deployments,
+	kuberesource.StatefulSets,
+	kuberesource.ReplicaSets,
+	kuberesource.Pods,
+}
+
+// validateQuotas compares the aggregate requests of the restored workloads with what the resource
+// quotas of their target namespaces have available, before anything is restored. The exceeded
+// quotas are returned as warnings, or as errors if the restore has strict quota. The workloads
+// which already exist in the cluster are not counted, as they're not created by the restore.
+func (ctx *restoreContext) validateQuotas(backupResources map[string]*archive.ResourceItems) (results.Result, results.Result) {
+	warnings, errs := results.Result{}, results.Result{}
+
+	var resources []string
+	for _, groupResource := range quotaWorkloadResources {
+		resources = append(resources, groupResource.String())
+	}
+	// the errors of the selected items are reported when they're restored
+	collection, _, _, _ := ctx.getOrderedResourceCollection(
+		backupResources,
+		make([]restoreableResource, 0),
+		sets.NewString(),
+		Priorities{HighPriorities: resources},
+		false,
+	)
+
+	usages := map[string]corev1api.ResourceList{}
+	sourceNamespaces := map[string]string{}
+	for _, selectedResource := range collection {
+		groupResource := schema.ParseGroupResource(selectedResource.resource)
+		for namespace, selectedItems := range selectedResource.selectedItemsByNamespace {
+			for _, selectedItem := range selectedItems {
+				obj, err := archive.Unmarshal(ctx.fileSystem, selectedItem.path)
+				if err != nil {
+					continue
+				}
+
+				usage, err := ctx.workloadQuotaUsage(obj, groupResource, selectedItem.targetNamespace)
+				if err != nil {
+					warnings.Add(selectedItem.targetNamespace, errors.Wrapf(err, "error getting the quota usage of %s %s", groupResource, selectedItem.name))
+					continue
+				}
+				if usage == nil {
+					continue
+				}
+
+				if usages[selectedItem.targetNamespace] == nil {
+					usages[selectedItem.targetNamespace] = corev1api.ResourceList{}
+				}
+				addResourceList(usages[selectedItem.targetNamespace], usage)
+				sourceNamespaces[selectedItem.targetNamespace] = namespace
+			}
+		}
+	}
+
+	for _, namespace := range sets.StringKeySet(usages).List() {
+		quotas, err := ctx.getResourceQuotas(namespace, sourceNamespaces[namespace], backupResources)
+		if err != nil {
+			warnings.Add(namespace, err)
+			continue
+		}
+
+		for _, quota := range quotas {
+			var names []string
+			for name := range quota.Spec.Hard {
+				names = append(names, string(name))
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				requested, found := usages[namespace][corev1api.ResourceName(name)]
+				if !found {
+					continue
+				}
+
+				available := quota.Spec.Hard[corev1api.ResourceName(name)].DeepCopy()
+				if used, found := quota.Status.Used[corev1api.ResourceName(name)]; found {
+					available.Sub(used)
+				}
+				if requested.Cmp(available) <= 0 {
+					continue
+				}
+
+				ctx.log.WithFields(map[string]interface{}{
+					"namespace":     namespace,
+					"resourceQuota": quota.Name,
+					"resource":      name,
+					"requested":     requested.String(),
+					"available":     available.String(),
+				}).Warn("The restored workloads exceed the resource quota")
+
+				err := fmt.Errorf("the restored workloads request %s of %s in total, exceeding the %s available in resource quota %s", requested.String(), name, available.String(), quota.Name)
+				if boolptr.IsSetToTrue(ctx.restore.Spec.StrictQuota) {
+					errs.Add(namespace, err)
+				} else {
+					warnings.Add(namespace, err)
+				}
+			}
+		}
+	}
+
+	return warnings, errs
+}
+
+// workloadQuotaUsage returns the usage of the quota resources by the pods of the workload once
+// it's restored, nil is returned if the workload isn't counted.
+func (ctx *restoreContext) workloadQuotaUsage(obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string) (corev1api.ResourceList, error) {
+	if groupResource == kuberesource.ReplicaSets || groupResource == kuberesource.Pods {
+		if controller := metav1.GetControllerOf(obj); controller != nil {
+			return nil, nil
+		}
+	}
+	if complete, err := isCompleted(obj, groupResource); err != nil || complete {
+		return nil, err
+	}
+
+	// the workloads are scaled when they're restored
+	obj = obj.DeepCopy()
+	if err := ctx.applyScaling(obj, groupResource); err != nil {
+		return nil, err
+	}
+
+	podSpecFields := []string{"spec"}
+	replicas := int64(1)
+	if groupResource != kuberesource.Pods {
+		podSpecFields = []string{"spec", "template", "spec"}
+		value, found, err := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting spec.replicas")
+		}
+		if found {
+			replicas = value
+		}
+	}
+	if replicas == 0 {
+		return nil, nil
+	}
+
+	podSpecMap, found, err := unstructured.NestedMap(obj.Object, podSpecFields...)
+	if err != nil || !found {
+		return nil, err
+	}
+	podSpec := new(corev1api.PodSpec)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(podSpecMap, podSpec); err != nil {
+		return nil, errors.Wrap(err, "error converting the pod spec")
+	}
+
+	resourceClient, err := ctx.getResourceClient(groupResource, obj, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := resourceClient.Get(obj.GetName(), metav1.GetOptions{}); err == nil {
+		return nil, nil
+	} else if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	podUsage := podQuotaUsage(podSpec)
+	usage := corev1api.ResourceList{}
+	for i := int64(0); i < replicas; i++ {
+		addResourceList(usage, podUsage)
+	}
+	return usage, nil
+}
+
+// getResourceQuotas returns the resource quotas of the namespace in the cluster, or the ones
+// restored into it from the backup if it doesn't have any yet. The scoped resource quotas,
+// which only apply to some of the pods, are skipped.
+func (ctx *restoreContext) getResourceQuotas(namespace, sourceNamespace string, backupResources map[string]*archive.ResourceItems) ([]corev1api.ResourceQuota, error) {
+	list := new(corev1api.ResourceQuotaList)
+	if err := ctx.kbClient.List(go_context.Background(), list, crclient.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrapf(err, "error listing the resource quotas of namespace %s", namespace)
+	}
+	quotas := list.Items
+
+	if len(quotas) == 0 && ctx.resourceIncludesExcludes.ShouldInclude(kuberesource.ResourceQuotas.String()) {
+		if items := backupResources[kuberesource.ResourceQuotas.String()]; items != nil {
+			for _, name := range items.ItemsByNamespace[sourceNamespace] {
+				path := archive.GetItemFilePath(ctx.restoreDir, kuberesource.ResourceQuotas.String(), sourceNamespace, name)
+				obj, err := archive.Unmarshal(ctx.fileSystem, path)
+				if err != nil {
+					continue
+				}
+				quota := corev1api.ResourceQuota{}
+				if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &quota); err != nil {
+					continue
+				}
+				// nothing is used by the restored resource quota yet
+				quota.Status = corev1api.ResourceQuotaStatus{}
+				quotas = append(quotas, quota)
+			}
+		}
+	}
+
+	var unscoped []corev1api.ResourceQuota
+	for _, quota := range quotas {
+		if len(quota.Spec.Scopes) == 0 && quota.Spec.ScopeSelector == nil {
+			unscoped = append(unscoped, quota)
+		}
+	}
+	sort.Slice(unscoped, func(i, j int) bool {
+		return unscoped[i].Name < unscoped[j].Name
+	})
+	return unscoped, nil
+}
+
+// podQuotaUsage returns the usage of the quota resources by a pod with the spec. The requests
+// and limits of the init containers count if they're higher than the ones of the containers.
+func podQuotaUsage(podSpec *corev1api.PodSpec) corev1api.ResourceList {
+	requests, limits := corev1api.ResourceList{}, corev1api.ResourceList{}
+	for _, container := range podSpec.Containers {
+		addResourceList(requests, container.Resources.Requests)
+		addResourceList(limits, container.Resources.Limits)
+	}
+	for _, container := range podSpec.InitContainers {
+		maxResourceList(requests, container.Resources.Requests)
+		maxResourceList(limits, container.Resources.Limits)
+	}
+
+	usage := corev1api.ResourceList{
+		corev1api.ResourcePods: *resource.NewQuantity(1, resource.DecimalSI),
+	}
+	for name, quantity := range requests {
+		usage[corev1api.ResourceName("requests."+string(name))] = quantity
+		// the quotas of the standard resources without prefix are the quotas of the requests
+		if name == corev1api.ResourceCPU || name == corev1api.ResourceMemory || name == corev1api.ResourceEphemeralStorage {
+			usage[name] = quantity
+		}
+	}
+	for name, quantity := range limits {
+		usage[corev1api.ResourceName("limits."+string(name))] = quantity
+	}
+	return usage
+}
+
+func addResourceList(list, other corev1api.ResourceList) {
+	for name, quantity := range other {
+		if value, found := list[name]; found {
+			value.Add(quantity)
+			list[name] = value
+		} else {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+func maxResourceList(list, other corev1api.ResourceList) {
+	for name, quantity := range other {
+		if value, found := list[name]; !found || quantity.Cmp(value) > 0 {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1api "k8s.io/api/apps/v1"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestPodQuotaUsage(t *testing.T) {
+	podSpec := &corev1api.PodSpec{
+		InitContainers: []corev1api.Container{
+			{
+				Resources: corev1api.ResourceRequirements{
+					Requests: corev1api.ResourceList{corev1api.ResourceCPU: resource.MustParse("2")},
+				},
+			},
+		},
+		Containers: []corev1api.Container{
+			{
+				Resources: corev1api.ResourceRequirements{
+					Requests: corev1api.ResourceList{
+						corev1api.ResourceCPU:    resource.MustParse("500m"),
+						corev1api.ResourceMemory: resource.MustParse("1Gi"),
+					},
+					Limits: corev1api.ResourceList{corev1api.ResourceMemory: resource.MustParse("2Gi")},
+				},
+			},
+			{
+				Resources: corev1api.ResourceRequirements{
+					Requests: corev1api.ResourceList{corev1api.ResourceMemory: resource.MustParse("1Gi")},
+				},
+			},
+		},
+	}
+
+	usage := podQuotaUsage(podSpec)
+
+	expected := map[string]string{
+		"pods":            "1",
+		"cpu":             "2",
+		"requests.cpu":    "2",
+		"memory":          "2Gi",
+		"requests.memory": "2Gi",
+		"limits.memory":   "2Gi",
+	}
+	actual := map[string]string{}
+	for name, quantity := range usage {
+		actual[string(name)] = quantity.String()
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestRestoreQuotas(t *testing.T) {
+	deployment := func(name string, replicas int32) *appsv1api.Deployment {
+		d := builder.ForDeployment("ns-1", name).Result()
+		d.Spec.Replicas = &replicas
+		d.Spec.Template.Spec.Containers = []corev1api.Container{
+			{
+				Name:  "app",
+				Image: "app",
+				Resources: corev1api.ResourceRequirements{
+					Requests: corev1api.ResourceList{corev1api.ResourceCPU: resource.MustParse("500m")},
+				},
+			},
+		}
+		return d
+	}
+	quota := func(name, hard, used string) *corev1api.ResourceQuota {
+		q := &corev1api.ResourceQuota{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ResourceQuota"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: name},
+			Spec: corev1api.ResourceQuotaSpec{
+				Hard: corev1api.ResourceList{corev1api.ResourceRequestsCPU: resource.MustParse(hard)},
+			},
+		}
+		if used != "" {
+			q.Status.Used = corev1api.ResourceList{corev1api.ResourceRequestsCPU: resource.MustParse(used)}
+		}
+		return q
+	}
+	exceeded := "the restored workloads request 2 of requests.cpu in total, exceeding the 1500m available in resource quota quota-1"
+
+	tests := []struct {
+		name             string
+		restore          *velerov1api.Restore
+		clusterQuotas    []*corev1api.ResourceQuota
+		backupQuotas     []*corev1api.ResourceQuota
+		existing         []*appsv1api.Deployment
+		expectedCreated  bool
+		expectedWarnings []string
+		expectedErrs     []string
+	}{
+		{
+			name:            "workloads within the quota",
+			restore:         defaultRestore().Result(),
+			clusterQuotas:   []*corev1api.ResourceQuota{quota("quota-1", "2", "")},
+			expectedCreated: true,
+		},
+		{
+			name:             "exceeded quota is warned",
+			restore:          defaultRestore().Result(),
+			clusterQuotas:    []*corev1api.ResourceQuota{quota("quota-1", "2", "500m")},
+			expectedCreated:  true,
+			expectedWarnings: []string{exceeded},
+		},
+		{
+			name:          "exceeded quota fails the restore with strict quota",
+			restore:       defaultRestore().StrictQuota(true).Result(),
+			clusterQuotas: []*corev1api.ResourceQuota{quota("quota-1", "2", "500m")},
+			expectedErrs:  []string{exceeded},
+		},
+		{
+			name:             "quota in the backup is used if the namespace has none",
+			restore:          defaultRestore().Result(),
+			backupQuotas:     []*corev1api.ResourceQuota{quota("quota-1", "1500m", "1")},
+			expectedCreated:  true,
+			expectedWarnings: []string{exceeded},
+		},
+		{
+			name:            "existing workloads aren't counted",
+			restore:         defaultRestore().Result(),
+			clusterQuotas:   []*corev1api.ResourceQuota{quota("quota-1", "2", "500m")},
+			existing:        []*appsv1api.Deployment{deployment("deploy-2", 1)},
+			expectedCreated: true,
+		},
+		{
+			name:    "scoped quota is skipped",
+			restore: defaultRestore().StrictQuota(true).Result(),
+			clusterQuotas: func() []*corev1api.ResourceQuota {
+				q := quota("quota-1", "1", "")
+				q.Spec.Scopes = []corev1api.ResourceQuotaScope{corev1api.ResourceQuotaScopeBestEffort}
+				return []*corev1api.ResourceQuota{q}
+			}(),
+			expectedCreated: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			for _, q := range tc.clusterQuotas {
+				require.NoError(t, h.restorer.kbClient.Create(context.Background(), q))
+			}
+
+			h.DiscoveryClient.WithAPIResource(test.Deployments())
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+			for _, d := range tc.existing {
+				h.AddItems(t, test.Deployments(d))
+			}
+
+			recorder := &createRecorder{t: t}
+			h.DynamicClient.PrependReactor("create", "deployments", recorder.reactor())
+
+			tarWriter := test.NewTarWriter(t).
+				AddItems("deployments.apps", deployment("deploy-1", 3), deployment("deploy-2", 1))
+			for _, q := range tc.backupQuotas {
+				tarWriter.AddItems("resourcequotas", q)
+			}
+
+			data := &Request{
+				Log:                  h.log,
+				Restore:              tc.restore,
+				Backup:               defaultBackup().Result(),
+				DisableInformerCache: true,
+				BackupReader:         tarWriter.Done(),
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // restoreItemActions
+				nil, // volume snapshotter getter
+			)
+
+			assert.Equal(t, tc.expectedWarnings, warnings.Namespaces["ns-1"])
+			assert.Equal(t, tc.expectedErrs, errs.Namespaces["ns-1"])
+			assert.Equal(t, tc.expectedCreated, len(recorder.resources) > 0)
+		})
+	}
+}
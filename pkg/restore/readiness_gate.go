@@ -0,0 +1,245 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// ReadinessGatedResources are the resources whose restored items can be waited for to be ready
+// before restoring the items depending on them.
+var ReadinessGatedResources = []schema.GroupResource{
+	kuberesource.CustomResourceDefinitions,
+	kuberesource.Namespaces,
+	kuberesource.MutatingWebhookConfigurations,
+	kuberesource.ValidatingWebhookConfigurations,
+}
+
+// IsReadinessGatedResource returns true if the resource name, with or without the group,
+// refers to one of the ReadinessGatedResources.
+func IsReadinessGatedResource(resource string) bool {
+	for _, groupResource := range ReadinessGatedResources {
+		if matchesGroupResource(resource, groupResource) {
+			return true
+		}
+	}
+	return false
+}
+
+// readinessItem is a restored item the restore waits for to be ready.
+type readinessItem struct {
+	groupResource schema.GroupResource
+	namespace     string
+	name          string
+	ready         func() (bool, error)
+}
+
+// readinessGated returns whether the restored items of the resource are waited for to be
+// ready according to the restore's readiness gates.
+func (ctx *restoreContext) readinessGated(groupResource schema.GroupResource) bool {
+	gates := ctx.restore.Spec.ReadinessGates
+	if gates == nil || ctx.dryRun {
+		return false
+	}
+
+	gated := false
+	for _, gatedResource := range ReadinessGatedResources {
+		if groupResource == gatedResource {
+			gated = true
+			break
+		}
+	}
+	if !gated || len(gates.IncludedResources) == 0 {
+		return gated
+	}
+
+	for _, resource := range gates.IncludedResources {
+		if matchesGroupResource(resource, groupResource) {
+			return true
+		}
+	}
+	return false
+}
+
+// addReadinessItem adds the restored item to the items waited for to be ready if its resource
+// is gated by the restore's readiness gates.
+func (ctx *restoreContext) addReadinessItem(groupResource schema.GroupResource, namespace, name string, resourceClient client.Dynamic) {
+	if !ctx.readinessGated(groupResource) {
+		return
+	}
+
+	item := readinessItem{
+		groupResource: groupResource,
+		namespace:     namespace,
+		name:          name,
+	}
+
+	switch groupResource {
+	case kuberesource.CustomResourceDefinitions:
+		item.ready = func() (bool, error) {
+			crd, err := resourceClient.Get(name, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			return kube.IsCRDReady(crd)
+		}
+	case kuberesource.Namespaces:
+		item.ready = func() (bool, error) {
+			ns, err := ctx.namespaceClient.Get(go_context.Background(), name, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			return ns.Status.Phase == v1.NamespaceActive, nil
+		}
+	default:
+		item.ready = func() (bool, error) {
+			webhookConfiguration, err := resourceClient.Get(name, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			return ctx.webhooksServed(webhookConfiguration)
+		}
+	}
+
+	ctx.readinessItems = append(ctx.readinessItems, item)
+}
+
+// webhooksServed returns whether the services of the webhooks in the webhook configuration have
+// ready endpoints. The webhooks called by URL are always considered served.
+func (ctx *restoreContext) webhooksServed(webhookConfiguration *unstructured.Unstructured) (bool, error) {
+	webhooks, _, err := unstructured.NestedSlice(webhookConfiguration.Object, "webhooks")
+	if err != nil {
+		return false, errors.Wrap(err, "error getting webhooks")
+	}
+
+	for _, webhook := range webhooks {
+		webhookMap, ok := webhook.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		service, found, err := unstructured.NestedStringMap(webhookMap, "clientConfig", "service")
+		if err != nil || !found {
+			continue
+		}
+
+		key := crclient.ObjectKey{Namespace: service["namespace"], Name: service["name"]}
+		// the restore is blocked while waiting, a service not restored yet won't show up
+		if err := ctx.kbClient.Get(go_context.Background(), key, &v1.Service{}); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, errors.Errorf("the service %s of the webhooks doesn't exist, restore it before the webhooks with the resource priorities", key)
+			}
+			return false, errors.Wrapf(err, "error getting service %s", key)
+		}
+
+		endpoints := &v1.Endpoints{}
+		if err := ctx.kbClient.Get(go_context.Background(), key, endpoints); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, errors.Wrapf(err, "error getting endpoints %s", key)
+		}
+
+		ready := false
+		for _, subset := range endpoints.Subsets {
+			if len(subset.Addresses) > 0 {
+				ready = true
+				break
+			}
+		}
+		if !ready {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// waitForReadiness waits for the restored items added by addReadinessItem to be ready, within
+// what is left of the readiness gates' timeout. The items not ready in time are reported as
+// warnings, the restore goes on without waiting for them.
+func (ctx *restoreContext) waitForReadiness(update chan progressUpdate, totalItems int) results.Result {
+	warnings := results.Result{}
+	if len(ctx.readinessItems) == 0 {
+		return warnings
+	}
+
+	ctx.log.Infof("Waiting for %d restored items to be ready before restoring the items depending on them", len(ctx.readinessItems))
+
+	allReady := func() (bool, error) {
+		var pending []readinessItem
+		for _, item := range ctx.readinessItems {
+			ready, err := item.ready()
+			if err != nil {
+				warnings.Add(item.namespace, errors.Wrapf(err, "error checking the readiness of %s %s", item.groupResource, item.name))
+				continue
+			}
+			if !ready {
+				ctx.log.Debugf("%s %s not yet ready", item.groupResource, item.name)
+				pending = append(pending, item)
+			}
+		}
+		ctx.readinessItems = pending
+
+		if update != nil {
+			update <- progressUpdate{
+				totalItems:               totalItems,
+				itemsRestored:            len(ctx.restoredItems),
+				itemsWaitingForReadiness: len(pending),
+			}
+		}
+
+		return len(pending) == 0, nil
+	}
+
+	start := time.Now()
+	var err error
+	if ctx.readinessTimeout > 0 {
+		err = wait.PollImmediate(time.Second, ctx.readinessTimeout, allReady)
+	} else if ready, _ := allReady(); !ready {
+		// the timeout is already exceeded, only the items ready by now are not reported
+		err = wait.ErrWaitTimeout
+	}
+	ctx.readinessTimeout -= time.Since(start)
+	if ctx.readinessTimeout < 0 {
+		ctx.readinessTimeout = 0
+	}
+
+	if err == wait.ErrWaitTimeout {
+		for _, item := range ctx.readinessItems {
+			warnings.Add(item.namespace, fmt.Errorf("the restored %s %s isn't ready within the timeout of the readiness gates, the items depending on it may fail to be restored", item.groupResource, item.name))
+		}
+	}
+	ctx.readinessItems = nil
+
+	return warnings
+}
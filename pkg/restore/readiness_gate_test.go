@@ -0,0 +1,171 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRestoreReadinessGates(t *testing.T) {
+	tests := []struct {
+		name             string
+		restore          *velerov1api.Restore
+		active           bool
+		expectedWarnings []string
+	}{
+		{
+			name:    "active namespaces are waited for",
+			restore: defaultRestore().ReadinessGates(time.Minute).Result(),
+			active:  true,
+		},
+		{
+			name:    "inactive namespaces are warned once the timeout is exceeded",
+			restore: defaultRestore().ReadinessGates(10*time.Millisecond, "namespaces").Result(),
+			expectedWarnings: []string{
+				"the restored namespaces ns-1 isn't ready within the timeout of the readiness gates, the items depending on it may fail to be restored",
+				"the restored namespaces ns-2 isn't ready within the timeout of the readiness gates, the items depending on it may fail to be restored",
+			},
+		},
+		{
+			name:    "namespaces aren't waited for if not included",
+			restore: defaultRestore().ReadinessGates(time.Minute, "customresourcedefinitions").Result(),
+		},
+		{
+			name:    "namespaces aren't waited for without readiness gates",
+			restore: defaultRestore().Result(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			if tc.active {
+				h.KubeClient.PrependReactor("create", "namespaces", func(action kubetesting.Action) (bool, runtime.Object, error) {
+					ns := action.(kubetesting.CreateAction).GetObject().(*corev1api.Namespace)
+					ns.Status.Phase = corev1api.NamespaceActive
+					return false, nil, nil
+				})
+			}
+
+			h.DiscoveryClient.WithAPIResource(velerotest.Pods())
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+			data := &Request{
+				Log:                  h.log,
+				Restore:              tc.restore,
+				Backup:               defaultBackup().Result(),
+				DisableInformerCache: true,
+				BackupReader: velerotest.NewTarWriter(t).
+					AddItems("pods",
+						builder.ForPod("ns-1", "pod-1").Result(),
+						builder.ForPod("ns-2", "pod-2").Result(),
+					).
+					Done(),
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // restoreItemActions
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, errs)
+			assert.ElementsMatch(t, tc.expectedWarnings, warnings.Cluster)
+		})
+	}
+}
+
+func TestWebhooksServed(t *testing.T) {
+	webhookConfiguration := func(clientConfig map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "admissionregistration.k8s.io/v1",
+			"kind":       "ValidatingWebhookConfiguration",
+			"metadata":   map[string]interface{}{"name": "webhook-1"},
+			"webhooks": []interface{}{
+				map[string]interface{}{"name": "webhook.example.com", "clientConfig": clientConfig},
+			},
+		}}
+	}
+	service := map[string]interface{}{
+		"service": map[string]interface{}{"namespace": "ns-1", "name": "webhook"},
+	}
+	endpoints := func(addresses ...corev1api.EndpointAddress) *corev1api.Endpoints {
+		return &corev1api.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "webhook"},
+			Subsets:    []corev1api.EndpointSubset{{Addresses: addresses}},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		webhook     *unstructured.Unstructured
+		objects     []runtime.Object
+		expected    bool
+		expectedErr string
+	}{
+		{
+			name:     "webhook called by URL is served",
+			webhook:  webhookConfiguration(map[string]interface{}{"url": "https://webhook.example.com"}),
+			expected: true,
+		},
+		{
+			name:        "service of the webhook doesn't exist",
+			webhook:     webhookConfiguration(service),
+			expectedErr: "the service ns-1/webhook of the webhooks doesn't exist, restore it before the webhooks with the resource priorities",
+		},
+		{
+			name:    "service of the webhook has no ready endpoints",
+			webhook: webhookConfiguration(service),
+			objects: []runtime.Object{builder.ForService("ns-1", "webhook").Result(), endpoints()},
+		},
+		{
+			name:     "service of the webhook has ready endpoints",
+			webhook:  webhookConfiguration(service),
+			objects:  []runtime.Object{builder.ForService("ns-1", "webhook").Result(), endpoints(corev1api.EndpointAddress{IP: "10.0.0.1"})},
+			expected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := &restoreContext{
+				kbClient: velerotest.NewFakeControllerRuntimeClient(t, tc.objects...),
+			}
+
+			served, err := ctx.webhooksServed(tc.webhook)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, served)
+		})
+	}
+}
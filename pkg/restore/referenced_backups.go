@@ -0,0 +1,89 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// resolveReferencedItems copies the cluster-scoped items deduplicated by the backup from the
+// contents of the backups storing them into the directory the backup contents are extracted to,
+// so that they're restored as if they were stored in the backup contents. The items of the backups
+// whose contents aren't available are reported as errors.
+func (ctx *restoreContext) resolveReferencedItems(dir string, errs *results.Result) {
+	for _, referenced := range ctx.referencedBackups {
+		if referenced.Reader == nil {
+			errs.AddVeleroError(errors.Errorf("the contents of backup %s storing %d cluster-scoped items deduplicated by the backup aren't available, the items aren't restored", referenced.Name, len(referenced.Paths)))
+			continue
+		}
+		if err := ctx.copyReferencedItems(dir, referenced); err != nil {
+			errs.AddVeleroError(errors.Wrapf(err, "error reading the cluster-scoped items deduplicated by the backup from backup %s", referenced.Name))
+		}
+	}
+}
+
+func (ctx *restoreContext) copyReferencedItems(dir string, referenced ReferencedBackup) error {
+	extractor := archive.NewExtractor(ctx.log, ctx.fileSystem)
+	referencedDir, err := extractor.UnzipAndExtractBackup(referenced.Reader)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := ctx.fileSystem.RemoveAll(referencedDir); err != nil {
+			ctx.log.Errorf("error removing temporary directory %s: %s", referencedDir, err.Error())
+		}
+	}()
+
+	actual := extractor.Checksums()
+	for _, path := range referenced.Paths {
+		checksum, ok := actual[path]
+		if !ok {
+			return errors.Errorf("file %s is missing from the backup contents", path)
+		}
+		if expected, ok := referenced.Checksums[path]; referenced.Checksums != nil && (!ok || expected != checksum) {
+			return errors.Errorf("file %s of the backup contents doesn't match its checksum", path)
+		}
+
+		data, err := ctx.fileSystem.ReadFile(filepath.Join(referencedDir, path))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		target := filepath.Join(dir, path)
+		if err := ctx.fileSystem.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return errors.WithStack(err)
+		}
+		file, err := ctx.fileSystem.Create(target)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		_, err = file.Write(data)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	ctx.log.Infof("Read %d cluster-scoped items deduplicated by the backup from backup %s", len(referenced.Paths), referenced.Name)
+	return nil
+}
@@ -24,10 +24,13 @@ import (
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -52,16 +55,44 @@ func resourceKey(obj runtime.Object) string {
 type Request struct {
 	*velerov1api.Restore
 
-	Log                  logrus.FieldLogger
-	Backup               *velerov1api.Backup
-	PodVolumeBackups     []*velerov1api.PodVolumeBackup
-	VolumeSnapshots      []*volume.Snapshot
-	BackupReader         io.Reader
-	RestoredItems        map[itemKey]restoredItemStatus
-	itemOperationsList   *[]*itemoperation.RestoreOperation
-	ResourceModifiers    *resourcemodifiers.ResourceModifiers
+	Log                logrus.FieldLogger
+	Backup             *velerov1api.Backup
+	PodVolumeBackups   []*velerov1api.PodVolumeBackup
+	VolumeSnapshots    []*volume.Snapshot
+	BackupReader       io.Reader
+	RestoredItems      map[itemKey]restoredItemStatus
+	itemOperationsList *[]*itemoperation.RestoreOperation
+	ResourceModifiers  *resourcemodifiers.ResourceModifiers
+	// ResourcePriorities overrides the restorer's resource priorities if it's not nil
+	ResourcePriorities   *Priorities
 	DisableInformerCache bool
 	CSIVolumeSnapshots   []*snapshotv1api.VolumeSnapshot
+	// Checksums are the checksums of the files in the backup contents, the backup contents
+	// aren't verified if it's nil
+	Checksums archive.Checksums
+	// ReferencedBackups are the backups storing the cluster-scoped items deduplicated by the
+	// backup, the items are read from their contents
+	ReferencedBackups []ReferencedBackup
+	// DryRunResult is set by the restorer with the comparison of the items with the cluster
+	// if the restore is a dry-run
+	DryRunResult *velerov1api.RestoreDryRunResult
+	// FinalizeActions are the names of the v3 restore item actions, the items created by the
+	// restore are recorded for the ones executed on them
+	FinalizeActions sets.String
+	// FinalizeItems is set by the restorer with the items created by the restore which the
+	// FinalizeActions were executed on, keyed by the names of the actions
+	FinalizeItems map[string][]velero.RestoredItem
+}
+
+// ReferencedBackup is a backup storing cluster-scoped items deduplicated by the restored backup.
+type ReferencedBackup struct {
+	Name string
+	// Reader reads the backup contents, it's nil if the contents aren't available
+	Reader io.Reader
+	// Checksums are the checksums of the backup contents, the items aren't verified if it's nil
+	Checksums archive.Checksums
+	// Paths are the paths of the deduplicated items in the backup contents
+	Paths []string
 }
 
 type restoredItemStatus struct {
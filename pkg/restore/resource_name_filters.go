@@ -0,0 +1,128 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"strings"
+
+	"github.com/gobwas/glob"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+)
+
+// resourceNamePatternSeparator separates the resource and the name glob of an item of the
+// included resources of a restore, e.g. "deployments.apps/web-*".
+const resourceNamePatternSeparator = "/"
+
+// ParseResourceNamePattern splits an item of the included resources of a restore into the
+// resource and the name glob, the name glob is empty if the item doesn't have one.
+func ParseResourceNamePattern(item string) (string, string) {
+	resource, pattern, _ := strings.Cut(item, resourceNamePatternSeparator)
+	return resource, pattern
+}
+
+// ValidateResourceNamePatterns checks the name globs of the included and excluded resources
+// of a restore.
+func ValidateResourceNamePatterns(includes, excludes []string) []error {
+	var errs []error
+
+	for _, item := range includes {
+		if !strings.Contains(item, resourceNamePatternSeparator) {
+			continue
+		}
+		resource, pattern := ParseResourceNamePattern(item)
+		if resource == "" || pattern == "" {
+			errs = append(errs, errors.Errorf("%q must be in the format of <resource>/<name glob>", item))
+			continue
+		}
+		if resource == "*" {
+			errs = append(errs, errors.Errorf("%q is invalid, name globs can't be used with '*'", item))
+			continue
+		}
+		if _, err := glob.Compile(pattern); err != nil {
+			errs = append(errs, errors.Wrapf(err, "invalid name glob in %q", item))
+		}
+	}
+
+	for _, item := range excludes {
+		if strings.Contains(item, resourceNamePatternSeparator) {
+			errs = append(errs, errors.Errorf("%q is invalid, name globs are only supported in the included resources", item))
+		}
+	}
+
+	return errs
+}
+
+// resourceNameFilters are the name globs of the included resources keyed by the group resource,
+// the items of a group resource in it are only restored if their names match one of the globs.
+type resourceNameFilters map[string][]glob.Glob
+
+// shouldInclude returns whether the item with the name of the group resource should be restored.
+func (f resourceNameFilters) shouldInclude(groupResource, name string) bool {
+	globs, ok := f[groupResource]
+	if !ok {
+		return true
+	}
+	for _, g := range globs {
+		if g.Match(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitResourceNamePatterns splits the name globs out of the included resources of a restore.
+// It returns the included resources without the name globs and the name filters keyed by the
+// group resource resolved via discovery. A group resource which is also included without a
+// name glob isn't filtered by name.
+func splitResourceNamePatterns(helper discovery.Helper, includes []string) ([]string, resourceNameFilters, error) {
+	var resources []string
+	filters := resourceNameFilters{}
+	unfiltered := sets.NewString()
+
+	for _, item := range includes {
+		resource, pattern := ParseResourceNamePattern(item)
+		if !sets.NewString(resources...).Has(resource) {
+			resources = append(resources, resource)
+		}
+
+		groupResource := resource
+		if gvr, _, err := helper.ResourceFor(schema.ParseGroupResource(resource).WithVersion("")); err == nil {
+			groupResource = gvr.GroupResource().String()
+		}
+
+		if pattern == "" {
+			unfiltered.Insert(groupResource)
+			continue
+		}
+
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid name glob in %q", item)
+		}
+		filters[groupResource] = append(filters[groupResource], g)
+	}
+
+	for groupResource := range unfiltered {
+		delete(filters, groupResource)
+	}
+
+	return resources, filters, nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateResourceNamePatterns(t *testing.T) {
+	tests := []struct {
+		name     string
+		includes []string
+		excludes []string
+		wantErrs []string
+	}{
+		{
+			name:     "no name globs",
+			includes: []string{"pods", "deployments.apps"},
+			excludes: []string{"secrets"},
+		},
+		{
+			name:     "valid name globs",
+			includes: []string{"deployments.apps/web-*", "pods/pod-[0-9]"},
+		},
+		{
+			name:     "missing resource or name glob",
+			includes: []string{"/web-*", "deployments.apps/"},
+			wantErrs: []string{
+				`"/web-*" must be in the format of <resource>/<name glob>`,
+				`"deployments.apps/" must be in the format of <resource>/<name glob>`,
+			},
+		},
+		{
+			name:     "name glob with '*' resource",
+			includes: []string{"*/web-*"},
+			wantErrs: []string{`"*/web-*" is invalid, name globs can't be used with '*'`},
+		},
+		{
+			name:     "name glob in excluded resources",
+			excludes: []string{"deployments.apps/web-*"},
+			wantErrs: []string{`"deployments.apps/web-*" is invalid, name globs are only supported in the included resources`},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateResourceNamePatterns(tc.includes, tc.excludes)
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+			assert.Equal(t, tc.wantErrs, got)
+		})
+	}
+}
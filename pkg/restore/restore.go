@@ -58,6 +58,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/itemgraph"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/label"
@@ -116,6 +117,7 @@ type kubernetesRestorer struct {
 	credentialFileStore        credentials.FileStore
 	kbClient                   crclient.Client
 	featureVerifier            features.Verifier
+	secretsEncryptedAtRest     bool
 }
 
 // NewKubernetesRestorer creates a new kubernetesRestorer.
@@ -134,6 +136,7 @@ func NewKubernetesRestorer(
 	credentialStore credentials.FileStore,
 	kbClient crclient.Client,
 	featureVerifier features.Verifier,
+	secretsEncryptedAtRest bool,
 ) (Restorer, error) {
 	return &kubernetesRestorer{
 		discoveryHelper:            discoveryHelper,
@@ -153,12 +156,13 @@ func NewKubernetesRestorer(
 			veleroCloneName := "velero-clone-" + veleroCloneUUID.String()
 			return veleroCloneName, nil
 		},
-		fileSystem:          filesystem.NewFileSystem(),
-		podCommandExecutor:  podCommandExecutor,
-		podGetter:           podGetter,
-		credentialFileStore: credentialStore,
-		kbClient:            kbClient,
-		featureVerifier:     featureVerifier,
+		fileSystem:             filesystem.NewFileSystem(),
+		podCommandExecutor:     podCommandExecutor,
+		podGetter:              podGetter,
+		credentialFileStore:    credentialStore,
+		kbClient:               kbClient,
+		featureVerifier:        featureVerifier,
+		secretsEncryptedAtRest: secretsEncryptedAtRest,
 	}, nil
 }
 
@@ -204,10 +208,16 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		return results.Result{}, results.Result{Velero: []string{err.Error()}}
 	}
 
+	// Split the name globs out of the included resources, e.g. "deployments.apps/web-*".
+	includedResources, resourceNameFilters, err := splitResourceNamePatterns(kr.discoveryHelper, req.Restore.Spec.IncludedResources)
+	if err != nil {
+		return results.Result{}, results.Result{Velero: []string{err.Error()}}
+	}
+
 	// Get resource includes-excludes.
 	resourceIncludesExcludes := collections.GetResourceIncludesExcludes(
 		kr.discoveryHelper,
-		req.Restore.Spec.IncludedResources,
+		includedResources,
 		req.Restore.Spec.ExcludedResources,
 	)
 
@@ -280,12 +290,27 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 	}
 
 	req.RestoredItems = make(map[itemKey]restoredItemStatus)
+	req.FinalizeItems = make(map[string][]velero.RestoredItem)
+
+	dryRun := boolptr.IsSetToTrue(req.Restore.Spec.DryRun)
+	if dryRun {
+		req.DryRunResult = &velerov1api.RestoreDryRunResult{}
+	}
+
+	resourcePriorities := kr.resourcePriorities
+	if req.ResourcePriorities != nil {
+		req.Log.Infof("Using the resource priorities specified by the restore: %s", req.ResourcePriorities.String())
+		resourcePriorities = *req.ResourcePriorities
+	}
 
 	restoreCtx := &restoreContext{
 		backup:                         req.Backup,
 		backupReader:                   req.BackupReader,
+		checksums:                      req.Checksums,
+		referencedBackups:              req.ReferencedBackups,
 		restore:                        req.Restore,
 		resourceIncludesExcludes:       resourceIncludesExcludes,
+		resourceNameFilters:            resourceNameFilters,
 		resourceStatusIncludesExcludes: restoreStatusIncludesExcludes,
 		namespaceIncludesExcludes:      namespaceIncludesExcludes,
 		resourceMustHave:               sets.NewString(resourceMustHave...),
@@ -312,9 +337,10 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		resourceInformers:              make(map[resourceClientKey]informers.GenericInformer),
 		restoredItems:                  req.RestoredItems,
 		renamedPVs:                     make(map[string]string),
+		storageClassProvisioners:       make(map[string]string),
 		pvRenamer:                      kr.pvRenamer,
 		discoveryHelper:                kr.discoveryHelper,
-		resourcePriorities:             kr.resourcePriorities,
+		resourcePriorities:             resourcePriorities,
 		resourceRestoreHooks:           resourceRestoreHooks,
 		hooksErrs:                      make(chan hook.HookErrInfo),
 		waitExecHookHandler:            waitExecHookHandler,
@@ -323,19 +349,37 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		kbClient:                       kr.kbClient,
 		itemOperationsList:             req.GetItemOperationsList(),
 		resourceModifiers:              req.ResourceModifiers,
+		clusterLister:                  newClusterLister(kr.dynamicFactory, kr.discoveryHelper),
 		disableInformerCache:           req.DisableInformerCache,
 		featureVerifier:                kr.featureVerifier,
+		dryRun:                         dryRun,
+		dryRunResult:                   req.DryRunResult,
+		previewedNamespaces:            sets.NewString(),
+		readinessTimeout:               kr.resourceTimeout,
+		finalizeActions:                req.FinalizeActions,
+		finalizeItems:                  req.FinalizeItems,
+		secretsEncryptedAtRest:         kr.secretsEncryptedAtRest,
+	}
+	if gates := req.Restore.Spec.ReadinessGates; gates != nil && gates.Timeout.Duration > 0 {
+		restoreCtx.readinessTimeout = gates.Timeout.Duration
 	}
 
-	return restoreCtx.execute()
+	warnings, errs := restoreCtx.execute()
+	if req.DryRunResult != nil {
+		sortDryRunItems(req.DryRunResult.Items)
+	}
+	return warnings, errs
 }
 
 type restoreContext struct {
 	backup                         *velerov1api.Backup
 	backupReader                   io.Reader
+	checksums                      archive.Checksums
+	referencedBackups              []ReferencedBackup
 	restore                        *velerov1api.Restore
 	restoreDir                     string
 	resourceIncludesExcludes       *collections.IncludesExcludes
+	resourceNameFilters            resourceNameFilters
 	resourceStatusIncludesExcludes *collections.IncludesExcludes
 	namespaceIncludesExcludes      *collections.IncludesExcludes
 	resourceMustHave               sets.String
@@ -363,9 +407,12 @@ type restoreContext struct {
 	resourceInformers              map[resourceClientKey]informers.GenericInformer
 	restoredItems                  map[itemKey]restoredItemStatus
 	renamedPVs                     map[string]string
+	storageClassProvisioners       map[string]string
+	volumeExpansionStorageClasses  map[string]bool
 	pvRenamer                      func(string) (string, error)
 	discoveryHelper                discovery.Helper
 	resourcePriorities             Priorities
+	dependencyGraph                *itemgraph.Graph
 	hooksWaitGroup                 sync.WaitGroup
 	hooksErrs                      chan hook.HookErrInfo
 	resourceRestoreHooks           []hook.ResourceRestoreHook
@@ -375,8 +422,17 @@ type restoreContext struct {
 	kbClient                       crclient.Client
 	itemOperationsList             *[]*itemoperation.RestoreOperation
 	resourceModifiers              *resourcemodifiers.ResourceModifiers
+	clusterLister                  resourcemodifiers.ClusterLister
 	disableInformerCache           bool
 	featureVerifier                features.Verifier
+	dryRun                         bool
+	dryRunResult                   *velerov1api.RestoreDryRunResult
+	previewedNamespaces            sets.String
+	readinessItems                 []readinessItem
+	readinessTimeout               time.Duration
+	finalizeActions                sets.String
+	finalizeItems                  map[string][]velero.RestoredItem
+	secretsEncryptedAtRest         bool
 }
 
 type resourceClientKey struct {
@@ -394,8 +450,10 @@ type informerFactoryWithContext struct {
 // based on the provided resource priorities and backup contents. The returned list
 // begins with all of the high prioritized resources (in order), ends with all of
 // the low prioritized resources(in order), and an alphabetized list of resources
-// in the backup(pick out the prioritized resources) is put in the middle.
-func getOrderedResources(resourcePriorities Priorities, backupResources map[string]*archive.ResourceItems) []string {
+// in the backup(pick out the prioritized resources) is put in the middle. The
+// resources in the middle are then ordered by the dependency graph of the backup
+// if there is one, so that they are restored after the resources they depend on.
+func getOrderedResources(resourcePriorities Priorities, backupResources map[string]*archive.ResourceItems, dependencyGraph *itemgraph.Graph) []string {
 	priorities := map[string]struct{}{}
 	for _, priority := range resourcePriorities.HighPriorities {
 		priorities[priority] = struct{}{}
@@ -414,13 +472,14 @@ func getOrderedResources(resourcePriorities Priorities, backupResources map[stri
 	}
 	// alphabetize resources in the backup
 	sort.Strings(orderedBackupResources)
+	orderedBackupResources = dependencyGraph.OrderResources(orderedBackupResources)
 
 	list := append(resourcePriorities.HighPriorities, orderedBackupResources...)
 	return append(list, resourcePriorities.LowPriorities...)
 }
 
 type progressUpdate struct {
-	totalItems, itemsRestored int
+	totalItems, itemsRestored, itemsWaitingForReadiness int
 }
 
 func (ctx *restoreContext) execute() (results.Result, results.Result) {
@@ -428,7 +487,8 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 
 	ctx.log.Infof("Starting restore of backup %s", kube.NamespaceAndName(ctx.backup))
 
-	dir, err := archive.NewExtractor(ctx.log, ctx.fileSystem).UnzipAndExtractBackup(ctx.backupReader)
+	extractor := archive.NewExtractor(ctx.log, ctx.fileSystem)
+	dir, err := extractor.UnzipAndExtractBackup(ctx.backupReader)
 	if err != nil {
 		ctx.log.Infof("error unzipping and extracting: %v", err)
 		errs.AddVeleroError(err)
@@ -440,6 +500,12 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 		}
 	}()
 
+	if ctx.checksums != nil && !ctx.verifyChecksums(extractor.Checksums(), &warnings, &errs) {
+		return warnings, errs
+	}
+
+	ctx.resolveReferencedItems(dir, &errs)
+
 	// Need to stop all informers if enabled
 	if !ctx.disableInformerCache {
 		defer func() {
@@ -466,6 +532,13 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 		return warnings, errs
 	}
 
+	// Only the backups taken by the newer versions of Velero contain the dependency graph,
+	// the resources are ordered by the priorities only if it doesn't exist.
+	ctx.dependencyGraph, err = ctx.readDependencyGraph()
+	if err != nil {
+		warnings.AddVeleroError(errors.Wrap(err, "error reading the dependency graph of the backup, the resources are restored in the order of the priorities"))
+	}
+
 	// TODO: Remove outer feature flag check to make this feature a default in Velero.
 	if features.IsEnabled(velerov1api.APIGroupVersionsFeatureFlag) {
 		if ctx.backup.Status.FormatVersion >= "1.1.0" {
@@ -476,6 +549,30 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 		}
 	}
 
+	// Validate the restored workloads against the resource quotas of their namespaces
+	// before restoring anything, so that a restore with strict quota fails fast.
+	quotaWarnings, quotaErrs := ctx.validateQuotas(backupResources)
+	warnings.Merge(&quotaWarnings)
+	errs.Merge(&quotaErrs)
+	if !quotaErrs.IsEmpty() {
+		return warnings, errs
+	}
+
+	// Verify the target cluster encrypts the secrets at rest before restoring anything,
+	// so that the sensitive secrets are never written to an unencrypted etcd.
+	encryptionWarnings, encryptionErrs := ctx.validateSecretEncryption(backupResources)
+	warnings.Merge(&encryptionWarnings)
+	errs.Merge(&encryptionErrs)
+	if !encryptionErrs.IsEmpty() {
+		return warnings, errs
+	}
+
+	// run the pre-restore job hooks before restoring anything, e.g. to disable the sync of GitOps
+	// tools which would otherwise fight the restore
+	if !ctx.runPreRestoreJobHooks(&warnings, &errs) {
+		return warnings, errs
+	}
+
 	update := make(chan progressUpdate)
 
 	quit := make(chan struct{})
@@ -498,6 +595,7 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 					}
 					updated.Status.Progress.TotalItems = lastUpdate.totalItems
 					updated.Status.Progress.ItemsRestored = lastUpdate.itemsRestored
+					updated.Status.Progress.ItemsWaitingForReadiness = lastUpdate.itemsWaitingForReadiness
 					err = kube.PatchResource(ctx.restore, updated, ctx.kbClient)
 					if err != nil {
 						ctx.log.WithError(errors.WithStack((err))).
@@ -544,6 +642,9 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 		errs.Merge(&e)
 	}
 
+	// The APIServices of the aggregated APIs and the resources served by them are restored last
+	backupResources, aggregatedResources := ctx.splitAggregatedAPIResources(backupResources)
+
 	// Restore everything else
 	selectedResourceCollection, _, w, e := ctx.getOrderedResourceCollection(
 		backupResources,
@@ -602,6 +703,19 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 		errs.Merge(&e)
 	}
 
+	if aggregatedResources != nil {
+		totalItems, processedItems, w, e = ctx.restoreAggregatedAPIResources(
+			aggregatedResources,
+			processedResources,
+			totalItems,
+			processedItems,
+			existingNamespaces,
+			update,
+		)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+	}
+
 	// Close the progress update channel.
 	quit <- struct{}{}
 
@@ -652,7 +766,7 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 		// TODO: not ideal to be adding these to Velero-level errors
 		// rather than a specific namespace, but don't have a way
 		// to track the namespace right now.
-		errs.Velero = append(errs.Velero, err.Error())
+		errs.AddCategorizedVeleroError(err, results.ErrorCategoryDataPath)
 	}
 	ctx.log.Info("Done waiting for all pod volume restores to complete")
 
@@ -668,9 +782,46 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 	}
 	ctx.log.Info("Done waiting for all post-restore exec hooks to complete")
 
+	ctx.startPostRestoreJobHooks(&errs)
+
 	return warnings, errs
 }
 
+// verifyChecksums verifies the files extracted from the backup contents against the checksums
+// recorded when the backup was taken. The modified, missing and added files fail the restore unless
+// the restore has the IgnoreChecksumMismatchAnnotation, false is returned if the restore fails.
+func (ctx *restoreContext) verifyChecksums(actual archive.Checksums, warnings, errs *results.Result) bool {
+	verification := archive.VerifyChecksums(ctx.checksums, actual)
+	if !verification.Corrupted() {
+		ctx.log.Infof("Verified the checksums of %d files of the backup contents", len(ctx.checksums))
+		return true
+	}
+
+	var corruptions []error
+	for _, name := range verification.Mismatched {
+		corruptions = append(corruptions, errors.Errorf("file %s of the backup contents doesn't match its checksum", name))
+	}
+	for _, name := range verification.Missing {
+		corruptions = append(corruptions, errors.Errorf("file %s is missing from the backup contents", name))
+	}
+	for _, name := range verification.Unexpected {
+		corruptions = append(corruptions, errors.Errorf("file %s of the backup contents doesn't have a checksum", name))
+	}
+
+	if ctx.restore.Annotations[velerov1api.IgnoreChecksumMismatchAnnotation] == "true" {
+		for _, err := range corruptions {
+			warnings.AddVeleroError(err)
+		}
+		return true
+	}
+
+	for _, err := range corruptions {
+		errs.AddVeleroError(err)
+	}
+	errs.AddVeleroError(errors.New("the backup contents are corrupted or tampered with"))
+	return false
+}
+
 // Process and restore one restoreableResource from the backup and update restore progress
 // metadata. At this point, the resource has already been validated and counted for inclusion
 // in the expected total restore count.
@@ -698,11 +849,7 @@ func (ctx *restoreContext) processSelectedResource(
 					archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", namespace),
 					selectedItem.targetNamespace,
 				)
-				_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(
-					ns,
-					ctx.namespaceClient,
-					ctx.resourceTerminatingTimeout,
-				)
+				nsCreated, err := ctx.ensureNamespace(ns)
 				if err != nil {
 					errs.AddVeleroError(err)
 					continue
@@ -721,6 +868,11 @@ func (ctx *restoreContext) processSelectedResource(
 				// Keep track of namespaces that we know exist so we don't
 				// have to try to create them multiple times.
 				existingNamespaces.Insert(selectedItem.targetNamespace)
+
+				// Wait for the namespace to be ready before restoring the items in it
+				// if it's gated by the readiness gates.
+				w := ctx.waitForReadiness(update, len(ctx.restoredItems)+(totalItems-processedItems))
+				warnings.Merge(&w)
 			}
 
 			obj, err := archive.Unmarshal(ctx.fileSystem, selectedItem.path)
@@ -762,6 +914,11 @@ func (ctx *restoreContext) processSelectedResource(
 		}
 	}
 
+	// Wait for the restored items gated by the readiness gates to be ready
+	// before restoring the items of the next resources.
+	w := ctx.waitForReadiness(update, len(ctx.restoredItems)+(totalItems-processedItems))
+	warnings.Merge(&w)
+
 	// If we just restored custom resource definitions (CRDs), refresh
 	// discovery because the restored CRDs may have created new APIs that
 	// didn't previously exist in the cluster, and we want to be able to
@@ -771,6 +928,12 @@ func (ctx *restoreContext) processSelectedResource(
 			warnings.Add("", errors.Wrap(err, "refresh discovery after restoring CRDs"))
 		}
 	}
+	// The same applies to the APIServices of the aggregated APIs.
+	if groupResource == kuberesource.APIServices {
+		if err := ctx.discoveryHelper.Refresh(); err != nil {
+			warnings.Add("", errors.Wrap(err, "refresh discovery after restoring APIServices"))
+		}
+	}
 	return processedItems, warnings, errs
 }
 
@@ -1129,7 +1292,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		// namespace into which the resource is being restored into exists.
 		// This is the *remapped* namespace that we are ensuring exists.
 		nsToEnsure := getNamespace(ctx.log, archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", obj.GetNamespace()), namespace)
-		_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(nsToEnsure, ctx.namespaceClient, ctx.resourceTerminatingTimeout)
+		nsCreated, err := ctx.ensureNamespace(nsToEnsure)
 		if err != nil {
 			errs.AddVeleroError(err)
 			return warnings, errs, itemExists
@@ -1251,19 +1414,22 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				// associate it with the restored PVC.
 				obj = resetVolumeBindingInfo(obj)
 
-				// Even if we're renaming the PV, obj still has the old name here, because the pvRestorer
-				// uses the original name to look up metadata about the snapshot.
-				ctx.log.Infof("Restoring persistent volume from snapshot.")
-				updatedObj, err := ctx.pvRestorer.executePVAction(obj)
-				if err != nil {
-					errs.Add(namespace, fmt.Errorf("error executing PVAction for %s: %v", resourceID, err))
-					return warnings, errs, itemExists
-				}
-				obj = updatedObj
+				// The volume isn't created from the snapshot for a dry-run.
+				if !ctx.dryRun {
+					// Even if we're renaming the PV, obj still has the old name here, because the pvRestorer
+					// uses the original name to look up metadata about the snapshot.
+					ctx.log.Infof("Restoring persistent volume from snapshot.")
+					updatedObj, err := ctx.pvRestorer.executePVAction(obj)
+					if err != nil {
+						errs.Add(namespace, fmt.Errorf("error executing PVAction for %s: %v", resourceID, err))
+						return warnings, errs, itemExists
+					}
+					obj = updatedObj
 
-				// VolumeSnapshotter has modified the PV name, we should rename the PV.
-				if oldName != obj.GetName() {
-					shouldRenamePV = true
+					// VolumeSnapshotter has modified the PV name, we should rename the PV.
+					if oldName != obj.GetName() {
+						shouldRenamePV = true
+					}
 				}
 			}
 
@@ -1387,7 +1553,16 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 	ctx.log.Infof("restore status includes excludes: %+v", ctx.resourceStatusIncludesExcludes)
 
-	for _, action := range ctx.getApplicableActions(groupResource, namespace) {
+	// The restore item actions may change the cluster, e.g. create the volumes or the resources
+	// of the plugins, so they aren't invoked for a dry-run.
+	var actions []framework.RestoreItemResolvedActionV2
+	if !ctx.dryRun {
+		actions = ctx.getApplicableActions(groupResource, namespace)
+	}
+	// the names of the v3 actions executed on the item, which finalize the item once it's created
+	var finalizeActions []string
+
+	for _, action := range actions {
 		if !action.Selector.Matches(labels.Set(obj.GetLabels())) {
 			continue
 		}
@@ -1434,6 +1609,9 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			itemOperList := ctx.itemOperationsList
 			*itemOperList = append(*itemOperList, &newOperation)
 		}
+		if ctx.finalizeActions.Has(action.Name()) {
+			finalizeActions = append(finalizeActions, action.Name())
+		}
 		if executeOutput.SkipRestore {
 			ctx.log.Infof("Skipping restore of %s: %v because a registered plugin discarded it", obj.GroupVersionKind().Kind, name)
 			return warnings, errs, itemExists
@@ -1527,14 +1705,31 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		}
 	}
 
+	if err := ctx.applyTopologyMappings(obj, groupResource); err != nil {
+		errs.Add(namespace, errors.Wrapf(err, "error applying storage class and zone mappings to %s", resourceID))
+		return warnings, errs, itemExists
+	}
+
+	if warning, err := ctx.applyPVCSizeOverrides(obj, groupResource); err != nil {
+		errs.Add(namespace, errors.Wrapf(err, "error overriding the size of %s", resourceID))
+		return warnings, errs, itemExists
+	} else if warning != "" {
+		warnings.Add(namespace, errors.New(warning))
+	}
+
 	if ctx.resourceModifiers != nil {
-		if errList := ctx.resourceModifiers.ApplyResourceModifierRules(obj, groupResource.String(), ctx.kbClient.Scheme(), ctx.log); errList != nil {
+		if errList := ctx.resourceModifiers.ApplyResourceModifierRules(obj, groupResource.String(), ctx.kbClient.Scheme(), ctx.clusterLister, ctx.log); errList != nil {
 			for _, err := range errList {
 				errs.Add(namespace, err)
 			}
 		}
 	}
 
+	if err := ctx.applyScaling(obj, groupResource); err != nil {
+		errs.Add(namespace, errors.Wrapf(err, "error scaling %s", resourceID))
+		return warnings, errs, itemExists
+	}
+
 	// Necessary because we may have remapped the namespace if the namespace is
 	// blank, don't create the key.
 	originalNamespace := obj.GetNamespace()
@@ -1556,12 +1751,20 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		return warnings, errs, itemExists
 	}
 
+	if ctx.dryRun {
+		if err := ctx.previewItem(obj, groupResource, resourceClient); err != nil {
+			errs.Add(namespace, err)
+		}
+		return warnings, errs, itemExists
+	}
+
 	ctx.log.Infof("Attempting to restore %s: %v", obj.GroupVersionKind().Kind, name)
 
 	// check if we want to treat the error as a warning, in some cases the creation call might not get executed due to object API validations
 	// and Velero might not get the already exists error type but in reality the object already exists
 	var fromCluster, createdObj *unstructured.Unstructured
 	var restoreErr error
+	retrier := newItemRetrier(ctx.restore.Spec.ItemRetryPolicy, ctx.log.WithField("item", resourceID))
 
 	// only attempt Get before Create if using informer cache, otherwise this will slow down restore into
 	// new namespace
@@ -1572,7 +1775,11 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	if err != nil || fromCluster == nil {
 		// couldn't find the resource, attempt to create
 		ctx.log.Debugf("Creating %s: %v", obj.GroupVersionKind().Kind, name)
-		createdObj, restoreErr = resourceClient.Create(obj)
+		restoreErr = retrier.do("creating "+resourceID, func() error {
+			var err error
+			createdObj, err = resourceClient.Create(obj)
+			return err
+		})
 		if restoreErr == nil {
 			itemExists = true
 			ctx.restoredItems[itemKey] = restoredItemStatus{action: itemRestoreResultCreated, itemExists: itemExists}
@@ -1601,6 +1808,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		}
 	}
 
+	recreated := false
 	if fromCluster != nil {
 		itemExists = true
 		itemStatus := ctx.restoredItems[itemKey]
@@ -1647,7 +1855,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				if err != nil {
 					warnings.Add(namespace, err)
 					// check if there is existingResourcePolicy and if it is set to update policy
-					if len(ctx.restore.Spec.ExistingResourcePolicy) > 0 && ctx.restore.Spec.ExistingResourcePolicy == velerov1api.PolicyTypeUpdate {
+					if ctx.getExistingResourcePolicy(groupResource) == velerov1api.PolicyTypeUpdate {
 						// remove restore labels so that we apply the latest backup/restore names on the object via patch
 						removeRestoreLabels(fromCluster)
 						//try patching just the backup/restore labels
@@ -1662,8 +1870,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				}
 			default:
 				// check for the presence of existingResourcePolicy
-				if len(ctx.restore.Spec.ExistingResourcePolicy) > 0 {
-					resourcePolicy := ctx.restore.Spec.ExistingResourcePolicy
+				if resourcePolicy := ctx.getExistingResourcePolicy(groupResource); len(resourcePolicy) > 0 {
 					ctx.log.Infof("restore API has resource policy defined %s , executing restore workflow accordingly for changed resource %s %s", resourcePolicy, fromCluster.GroupVersionKind().Kind, kube.NamespaceAndName(fromCluster))
 
 					// existingResourcePolicy is set as none, add warning
@@ -1681,6 +1888,18 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 						}
 						warnings.Merge(&warningsFromUpdateRP)
 						errs.Merge(&errsFromUpdateRP)
+					} else if resourcePolicy == velerov1api.PolicyTypeRecreate {
+						// processing recreate as existingResourcePolicy, the recreated resource goes through the rest
+						// of the restore workflow as a newly created one
+						createdObj, restoreErr = ctx.processRecreateResourcePolicy(fromCluster, obj, groupResource, originalNamespace, resourceClient)
+						if restoreErr != nil {
+							ctx.log.Errorf("error recreating %s: %+v", name, restoreErr)
+							errs.Add(namespace, fmt.Errorf("error recreating %s: %v", resourceID, restoreErr))
+						} else {
+							recreated = true
+							itemStatus.action = itemRestoreResultCreated
+							ctx.restoredItems[itemKey] = itemStatus
+						}
 					}
 				} else {
 					// Preserved Velero behavior when existingResourcePolicy is not specified by the user
@@ -1689,23 +1908,24 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 					warnings.Add(namespace, e)
 				}
 			}
-			return warnings, errs, itemExists
-		}
+			if !recreated {
+				return warnings, errs, itemExists
+			}
+		} else {
+			//update backup/restore labels on the unchanged resources if existingResourcePolicy is set as update or recreate
+			if resourcePolicy := ctx.getExistingResourcePolicy(groupResource); resourcePolicy == velerov1api.PolicyTypeUpdate || resourcePolicy == velerov1api.PolicyTypeRecreate {
+				ctx.log.Infof("restore API has resource policy defined %s , executing restore workflow accordingly for unchanged resource %s %s ", resourcePolicy, obj.GroupVersionKind().Kind, kube.NamespaceAndName(fromCluster))
+				// remove restore labels so that we apply the latest backup/restore names on the object via patch
+				removeRestoreLabels(fromCluster)
+				// try updating the backup/restore labels for the in-cluster object
+				warningsFromUpdate, errsFromUpdate := ctx.updateBackupRestoreLabels(fromCluster, obj, namespace, resourceClient)
+				warnings.Merge(&warningsFromUpdate)
+				errs.Merge(&errsFromUpdate)
+			}
 
-		//update backup/restore labels on the unchanged resources if existingResourcePolicy is set as update
-		if ctx.restore.Spec.ExistingResourcePolicy == velerov1api.PolicyTypeUpdate {
-			resourcePolicy := ctx.restore.Spec.ExistingResourcePolicy
-			ctx.log.Infof("restore API has resource policy defined %s , executing restore workflow accordingly for unchanged resource %s %s ", resourcePolicy, obj.GroupVersionKind().Kind, kube.NamespaceAndName(fromCluster))
-			// remove restore labels so that we apply the latest backup/restore names on the object via patch
-			removeRestoreLabels(fromCluster)
-			// try updating the backup/restore labels for the in-cluster object
-			warningsFromUpdate, errsFromUpdate := ctx.updateBackupRestoreLabels(fromCluster, obj, namespace, resourceClient)
-			warnings.Merge(&warningsFromUpdate)
-			errs.Merge(&errsFromUpdate)
+			ctx.log.Infof("Restore of %s, %v skipped: it already exists in the cluster and is the same as the backed up version", obj.GroupVersionKind().Kind, name)
+			return warnings, errs, itemExists
 		}
-
-		ctx.log.Infof("Restore of %s, %v skipped: it already exists in the cluster and is the same as the backed up version", obj.GroupVersionKind().Kind, name)
-		return warnings, errs, itemExists
 	}
 
 	// Error was something other than an AlreadyExists.
@@ -1731,7 +1951,12 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			return warnings, errs, itemExists
 		}
 		obj.SetResourceVersion(createdObj.GetResourceVersion())
-		updated, err := resourceClient.UpdateStatus(obj, metav1.UpdateOptions{})
+		var updated *unstructured.Unstructured
+		err := retrier.do("updating status of "+resourceID, func() error {
+			var err error
+			updated, err = resourceClient.UpdateStatus(obj, metav1.UpdateOptions{})
+			return err
+		})
 		if err != nil {
 			ctx.log.Infof("status field update failed %s: %v", kube.NamespaceAndName(obj), err)
 			warnings.Add(namespace, err)
@@ -1740,6 +1965,17 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		}
 	}
 
+	for _, action := range finalizeActions {
+		ctx.finalizeItems[action] = append(ctx.finalizeItems[action], velero.RestoredItem{
+			ResourceIdentifier: velero.ResourceIdentifier{
+				GroupResource: groupResource,
+				Namespace:     createdObj.GetNamespace(),
+				Name:          createdObj.GetName(),
+			},
+			UID: createdObj.GetUID(),
+		})
+	}
+
 	// restore the managedFields
 	withoutManagedFields := createdObj.DeepCopy()
 	createdObj.SetManagedFields(obj.GetManagedFields())
@@ -1750,7 +1986,10 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		return warnings, errs, itemExists
 	}
 	if patchBytes != nil {
-		if _, err = resourceClient.Patch(name, patchBytes); err != nil {
+		if err = retrier.do("patching managed fields of "+resourceID, func() error {
+			_, err := resourceClient.Patch(name, patchBytes)
+			return err
+		}); err != nil {
 			ctx.log.Errorf("error patch for managed fields %s: %v", kube.NamespaceAndName(obj), err)
 			if !apierrors.IsNotFound(err) {
 				errs.Add(namespace, err)
@@ -1791,6 +2030,19 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		}
 	}
 
+	// Wait for an aggregated APIService to be available for restoring the resources served by it
+	// before continuing.
+	if groupResource == kuberesource.APIServices && isAggregatedAPIService(obj) {
+		available, err := ctx.apiServiceAvailable(name, resourceClient)
+		if err != nil {
+			warnings.Add(namespace, errors.Wrapf(err, "error verifying APIService is available"))
+		} else if !available {
+			warnings.Add(namespace, fmt.Errorf("the APIService %s is not available, the resources served by it may fail to be restored", name))
+		}
+	}
+
+	ctx.addReadinessItem(groupResource, namespace, name, resourceClient)
+
 	return warnings, errs, itemExists
 }
 
@@ -1926,12 +2178,20 @@ func restorePodVolumeBackups(ctx *restoreContext, createdObj *unstructured.Unstr
 				return
 			}
 
+			hostHooks, err := hook.GroupRestoreHostHooks(ctx.resourceRestoreHooks, pod)
+			if err != nil {
+				ctx.log.WithError(err).Errorf("error getting host hooks for pod %s/%s", pod.Namespace, pod.Name)
+				ctx.podVolumeErrs <- err
+				return
+			}
+
 			data := podvolume.RestoreData{
 				Restore:          ctx.restore,
 				Pod:              pod,
 				PodVolumeBackups: ctx.podVolumeBackups,
 				SourceNamespace:  originalNamespace,
 				BackupLocation:   ctx.backup.Spec.StorageLocation,
+				HostHooks:        hostHooks,
 			}
 			if errs := ctx.podVolumeRestorer.RestorePodVolumes(data); errs != nil {
 				ctx.log.WithError(kubeerrs.NewAggregate(errs)).Error("unable to successfully complete pod volume restores of pod's volumes")
@@ -2191,6 +2451,20 @@ type restoreableItem struct {
 	version         string // used for initializing informer cache
 }
 
+// readDependencyGraph reads the dependency graph of the backup from the metadata
+// directory, it returns nil if the backup doesn't have one.
+func (ctx *restoreContext) readDependencyGraph() (*itemgraph.Graph, error) {
+	data, err := ctx.fileSystem.ReadFile(filepath.Join(ctx.restoreDir, velerov1api.MetadataDir, itemgraph.FileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return itemgraph.Read(data)
+}
+
 // getOrderedResourceCollection iterates over list of ordered resource
 // identifiers, applies resource include/exclude criteria, and Kubernetes
 // selectors to make a list of resources to be actually restored preserving the
@@ -2219,7 +2493,7 @@ func (ctx *restoreContext) getOrderedResourceCollection(
 	// ordered list twice.
 	var resourceList []string
 	if includeAllResources {
-		resourceList = getOrderedResources(resourcePriorities, backupResources)
+		resourceList = getOrderedResources(resourcePriorities, backupResources, ctx.dependencyGraph)
 	} else {
 		resourceList = resourcePriorities.HighPriorities
 	}
@@ -2286,6 +2560,9 @@ func (ctx *restoreContext) getOrderedResourceCollection(
 				continue
 			}
 
+			// restore the items after the items of the same resource they depend on
+			items = ctx.dependencyGraph.OrderItems(groupResource.String(), namespace, items)
+
 			res, w, e := ctx.getSelectedRestoreableItems(groupResource.String(), targetNamespace, namespace, items)
 			warnings.Merge(&w)
 			errs.Merge(&e)
@@ -2328,6 +2605,12 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource, targetNamespace
 	// chosenGrpVersToRestore map would only be populated if
 	// APIGroupVersionsFeatureFlag was enabled for restore and the minimum
 	// required backup format version has been met.
+	// the PVCs of a volume group are snapshotted together, so they're restored together as well
+	var groupMembers *volumeGroupMembers
+	if resource == kuberesource.PersistentVolumeClaims.String() && ctx.backup.Spec.VolumeGroupSnapshotLabelKey != "" {
+		groupMembers = newVolumeGroupMembers(ctx.backup.Spec.VolumeGroupSnapshotLabelKey)
+	}
+
 	cgv, ok := ctx.chosenGrpVersToRestore[resource]
 	if ok {
 		resource = filepath.Join(resource, cgv.Dir)
@@ -2349,7 +2632,14 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource, targetNamespace
 			continue
 		}
 
+		if !ctx.resourceNameFilters.shouldInclude(restorable.resource, obj.GetName()) {
+			ctx.log.Debugf("Skipping restore of item %s because its name doesn't match the name globs of resource %s", item, restorable.resource)
+			groupMembers.add(obj, itemPath, item)
+			continue
+		}
+
 		if !ctx.selector.Matches(labels.Set(obj.GetLabels())) {
+			groupMembers.add(obj, itemPath, item)
 			continue
 		}
 
@@ -2371,9 +2661,11 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource, targetNamespace
 
 		if skipItem {
 			ctx.log.Infof("restore orSelector labels did not match, skipping restore of item: %s", skipItem, item)
+			groupMembers.add(obj, itemPath, item)
 			continue
 		}
 
+		groupMembers.add(obj, itemPath, item)
 		selectedItem := restoreableItem{
 			path:            itemPath,
 			name:            item,
@@ -2384,6 +2676,12 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource, targetNamespace
 			append(restorable.selectedItemsByNamespace[originalNamespace], selectedItem)
 		restorable.totalItems++
 	}
+
+	if groupMembers != nil {
+		restorable.selectedItemsByNamespace[originalNamespace] =
+			groupMembers.restoreTogether(ctx.log, restorable.selectedItemsByNamespace[originalNamespace], targetNamespace)
+		restorable.totalItems = len(restorable.selectedItemsByNamespace[originalNamespace])
+	}
 	return restorable, warnings, errs
 }
 
@@ -2429,6 +2727,131 @@ func (ctx *restoreContext) updateBackupRestoreLabels(fromCluster, fromClusterWit
 	return warnings, errs
 }
 
+// getExistingResourcePolicy returns the existing resource policy for the resource, the policy specified for the
+// resource in existingResourcePolicies takes precedence over the existingResourcePolicy of the whole restore
+func (ctx *restoreContext) getExistingResourcePolicy(groupResource schema.GroupResource) velerov1api.PolicyType {
+	if policy, ok := ctx.restore.Spec.ExistingResourcePolicies[groupResource.String()]; ok {
+		return policy
+	}
+
+	if policy, ok := ctx.restore.Spec.ExistingResourcePolicies[groupResource.Resource]; ok {
+		return policy
+	}
+
+	return ctx.restore.Spec.ExistingResourcePolicy
+}
+
+// processRecreateResourcePolicy deletes the in-cluster resource, waits for it to be gone and creates the backed-up one.
+// The items of the backup depending on the resource, e.g. the pods mounting a PVC, are deleted before the resource if
+// their existing resource policy is recreate as well, so that they don't block the deletion, and are created again
+// when they are restored after the resource.
+func (ctx *restoreContext) processRecreateResourcePolicy(fromCluster, obj *unstructured.Unstructured, groupResource schema.GroupResource, originalNamespace string, resourceClient client.Dynamic) (*unstructured.Unstructured, error) {
+	ctx.log.Infof("restore API has existingResourcePolicy defined as recreate, deleting changed resource %s %s", obj.GroupVersionKind().Kind, kube.NamespaceAndName(fromCluster))
+
+	item := itemgraph.Item{Resource: groupResource.String(), Namespace: originalNamespace, Name: obj.GetName()}
+	if err := ctx.deleteRecreatedDependents(item, map[itemgraph.Item]struct{}{item: {}}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.deleteAndWait(fromCluster, resourceClient); err != nil {
+		return nil, err
+	}
+
+	createdObj, err := resourceClient.Create(obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating resource")
+	}
+
+	ctx.log.Infof("%s %s successfully recreated", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj))
+	return createdObj, nil
+}
+
+// deleteRecreatedDependents deletes the in-cluster items which depend on the item in the backup's dependency graph and
+// are to be recreated by the restore, the dependents of the dependents are deleted first
+func (ctx *restoreContext) deleteRecreatedDependents(item itemgraph.Item, visited map[itemgraph.Item]struct{}) error {
+	for _, dependent := range ctx.dependencyGraph.Dependents(item) {
+		if _, ok := visited[dependent]; ok {
+			continue
+		}
+		visited[dependent] = struct{}{}
+
+		groupResource := schema.ParseGroupResource(dependent.Resource)
+		if ctx.getExistingResourcePolicy(groupResource) != velerov1api.PolicyTypeRecreate ||
+			!ctx.resourceIncludesExcludes.ShouldInclude(dependent.Resource) ||
+			(dependent.Namespace != "" && !ctx.namespaceIncludesExcludes.ShouldInclude(dependent.Namespace)) {
+			continue
+		}
+
+		if err := ctx.deleteRecreatedDependents(dependent, visited); err != nil {
+			return err
+		}
+
+		namespace := dependent.Namespace
+		if target, ok := ctx.restore.Spec.NamespaceMapping[namespace]; ok {
+			namespace = target
+		}
+
+		gvr, apiResource, err := ctx.discoveryHelper.ResourceFor(groupResource.WithVersion(""))
+		if err != nil {
+			return errors.Wrapf(err, "error getting resource for dependent %s", dependent)
+		}
+		resourceClient, err := ctx.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), apiResource, namespace)
+		if err != nil {
+			return errors.Wrapf(err, "error getting client for dependent %s", dependent)
+		}
+
+		fromCluster, err := resourceClient.Get(dependent.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "error getting dependent %s", dependent)
+		}
+
+		ctx.log.Infof("Deleting dependent %s to recreate the resource it depends on", dependent)
+		if err := ctx.deleteAndWait(fromCluster, resourceClient); err != nil {
+			return errors.Wrapf(err, "error deleting dependent %s", dependent)
+		}
+	}
+
+	return nil
+}
+
+// deleteAndWait deletes the in-cluster resource with the foreground propagation and waits for it to be gone. The
+// resource being terminated already isn't deleted again, the wait covers its grace period and finalizers and fails
+// with the remaining finalizers after the resource terminating timeout
+func (ctx *restoreContext) deleteAndWait(fromCluster *unstructured.Unstructured, resourceClient client.Dynamic) error {
+	name := fromCluster.GetName()
+
+	if fromCluster.GetDeletionTimestamp() == nil {
+		propagation := metav1.DeletePropagationForeground
+		if err := resourceClient.Delete(name, metav1.DeleteOptions{PropagationPolicy: &propagation}); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrap(err, "error deleting in-cluster resource")
+		}
+	}
+
+	var finalizers []string
+	err := wait.PollImmediate(time.Second, ctx.resourceTerminatingTimeout, func() (bool, error) {
+		inCluster, err := resourceClient.Get(name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		finalizers = inCluster.GetFinalizers()
+		return false, nil
+	})
+	if err == wait.ErrWaitTimeout && len(finalizers) > 0 {
+		return errors.Errorf("timed out waiting for in-cluster resource to be deleted, it is blocked by the finalizers %v", finalizers)
+	}
+	if err != nil {
+		return errors.Wrap(err, "error waiting for in-cluster resource to be deleted")
+	}
+
+	return nil
+}
+
 // function to process existingResourcePolicy as update, tries to patch the diff between in-cluster and restore obj first
 // if the patch fails then tries to update the backup/restore labels for the in-cluster version
 func (ctx *restoreContext) processUpdateResourcePolicy(fromCluster, fromClusterWithLabels, obj *unstructured.Unstructured, namespace string, resourceClient client.Dynamic) (warnings, errs results.Result) {
@@ -17,6 +17,7 @@ limitations under the License.
 package restore
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -42,12 +43,14 @@ import (
 	"k8s.io/client-go/dynamic"
 	kubetesting "k8s.io/client-go/testing"
 
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	verifiermocks "github.com/vmware-tanzu/velero/pkg/features/mocks"
+	"github.com/vmware-tanzu/velero/pkg/itemgraph"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
@@ -121,6 +124,51 @@ func TestRestoreResourceFiltering(t *testing.T) {
 				test.Pods(): {"ns-1/pod-1", "ns-2/pod-2"},
 			},
 		},
+		{
+			name:    "included resources with name globs only restores items with matching names",
+			restore: defaultRestore().IncludedResources("deployments.apps/web-*", "pods/pod-1").Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+				).
+				AddItems("deployments.apps",
+					builder.ForDeployment("ns-1", "web-1").Result(),
+					builder.ForDeployment("ns-1", "web-2").Result(),
+					builder.ForDeployment("ns-1", "db-1").Result(),
+				).
+				AddItems("persistentvolumes",
+					builder.ForPersistentVolume("pv-1").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+				test.Deployments(),
+				test.PVs(),
+			},
+			want: map[*test.APIResource][]string{
+				test.Pods():        {"ns-1/pod-1"},
+				test.Deployments(): {"ns-1/web-1", "ns-1/web-2"},
+			},
+		},
+		{
+			name:    "included resources without name globs override the name globs of the same resources",
+			restore: defaultRestore().IncludedResources("deployments/web-*", "deployments.apps").Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("deployments.apps",
+					builder.ForDeployment("ns-1", "web-1").Result(),
+					builder.ForDeployment("ns-1", "db-1").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Deployments(),
+			},
+			want: map[*test.APIResource][]string{
+				test.Deployments(): {"ns-1/db-1", "ns-1/web-1"},
+			},
+		},
 		{
 			name:    "excluded resources filter only restores resources not of those types",
 			restore: defaultRestore().ExcludedResources("pvs").Result(),
@@ -543,6 +591,25 @@ func TestRestoreResourceFiltering(t *testing.T) {
 				test.PVs():  {"/pv-1", "/pv-2"},
 			},
 		},
+		{
+			name:    "PVCs of a volume group are restored together",
+			restore: defaultRestore().LabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}).Result(),
+			backup:  defaultBackup().VolumeGroupSnapshotLabelKey(velerov1api.DefaultVGSLabelKey).Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("persistentvolumeclaims",
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-data").ObjectMeta(builder.WithLabels("app", "db", velerov1api.DefaultVGSLabelKey, "db")).Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-log").ObjectMeta(builder.WithLabels(velerov1api.DefaultVGSLabelKey, "db")).Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-cache").ObjectMeta(builder.WithLabels(velerov1api.DefaultVGSLabelKey, "cache")).Result(),
+					builder.ForPersistentVolumeClaim("ns-2", "pvc-log").ObjectMeta(builder.WithLabels(velerov1api.DefaultVGSLabelKey, "db")).Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.PVCs(),
+			},
+			want: map[*test.APIResource][]string{
+				test.PVCs(): {"ns-1/pvc-data", "ns-1/pvc-log"},
+			},
+		},
 		{
 			name:         "mirror pods are not restored",
 			restore:      defaultRestore().Result(),
@@ -670,6 +737,60 @@ func TestRestoreNamespaceMapping(t *testing.T) {
 	}
 }
 
+// TestRestoreResourceModifiersWithClusterLookups runs a restore with a resource modifier
+// looking up the default storage class of the cluster, and verifies the storage class of
+// the restored PVC is set to it.
+func TestRestoreResourceModifiersWithClusterLookups(t *testing.T) {
+	h := newHarness(t)
+	h.AddItems(t, test.StorageClasses(
+		builder.ForStorageClass("standard").Result(),
+		builder.ForStorageClass("gp3").ObjectMeta(builder.WithAnnotations("storageclass.kubernetes.io/is-default-class", "true")).Result(),
+	))
+	h.DiscoveryClient.WithAPIResource(test.PVCs())
+	require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+	modifiers := &resourcemodifiers.ResourceModifiers{
+		Version: resourcemodifiers.ResourceModifierSupportedVersionV1,
+		ResourceModifierRules: []resourcemodifiers.ResourceModifierRule{
+			{
+				Conditions: resourcemodifiers.Conditions{
+					GroupResource: "persistentvolumeclaims",
+					ClusterLookups: []resourcemodifiers.ClusterLookup{
+						{
+							Name:          "default-storage-class",
+							GroupResource: "storageclasses.storage.k8s.io",
+							Matches: []resourcemodifiers.MatchRule{
+								{Path: "/metadata/annotations/storageclass.kubernetes.io~1is-default-class", Value: "true"},
+							},
+						},
+					},
+				},
+				Patches: []resourcemodifiers.JSONPatch{
+					{Operation: "replace", Path: "/spec/storageClassName", Value: "{{ default-storage-class }}"},
+				},
+			},
+		},
+	}
+
+	data := &Request{
+		Log:               h.log,
+		Restore:           defaultRestore().Result(),
+		Backup:            defaultBackup().Result(),
+		ResourceModifiers: modifiers,
+		BackupReader: test.NewTarWriter(t).
+			AddItems("persistentvolumeclaims", builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("standard").Result()).
+			Done(),
+	}
+	warnings, errs := h.restorer.Restore(data, nil, nil)
+	assertEmptyResults(t, warnings, errs)
+
+	pvc, err := h.DynamicClient.Resource(test.PVCs().GVR()).Namespace("ns-1").Get(context.TODO(), "pvc-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	storageClass, _, err := unstructured.NestedString(pvc.Object, "spec", "storageClassName")
+	require.NoError(t, err)
+	assert.Equal(t, "gp3", storageClass)
+}
+
 // TestRestoreResourcePriorities runs restores with resource priorities specified,
 // and verifies that the set of items created in the API are created in the expected
 // order. Validation is done by adding a Reactor to the fake dynamic client that records
@@ -682,6 +803,8 @@ func TestRestoreResourcePriorities(t *testing.T) {
 		apiResources       []*test.APIResource
 		tarball            io.Reader
 		resourcePriorities Priorities
+		requestPriorities  *Priorities
+		expectedOrder      []string
 	}{
 		{
 			name:    "resources are restored according to the specified resource priorities",
@@ -719,6 +842,71 @@ func TestRestoreResourcePriorities(t *testing.T) {
 				HighPriorities: []string{"persistentvolumes", "persistentvolumeclaims", "serviceaccounts"},
 				LowPriorities:  []string{"deployments.apps"},
 			},
+			expectedOrder: []string{"persistentvolumes", "persistentvolumeclaims", "serviceaccounts", "pods", "deployments.apps"},
+		},
+		{
+			name:    "resources are restored according to the resource priorities of the restore request",
+			restore: defaultRestore().Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+				).
+				AddItems("persistentvolumes",
+					builder.ForPersistentVolume("pv-1").Result(),
+				).
+				AddItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").Result(),
+				).
+				AddItems("serviceaccounts",
+					builder.ForServiceAccount("ns-1", "sa-1").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+				test.PVs(),
+				test.Deployments(),
+				test.ServiceAccounts(),
+			},
+			resourcePriorities: Priorities{
+				HighPriorities: []string{"persistentvolumes", "serviceaccounts"},
+				LowPriorities:  []string{"deployments.apps"},
+			},
+			requestPriorities: &Priorities{
+				HighPriorities: []string{"deployments.apps", "serviceaccounts"},
+				LowPriorities:  []string{"persistentvolumes"},
+			},
+			expectedOrder: []string{"deployments.apps", "serviceaccounts", "pods", "persistentvolumes"},
+		},
+		{
+			name:    "resources are restored after the resources they depend on according to the dependency graph of the backup",
+			restore: defaultRestore().Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+				).
+				AddItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").Result(),
+				).
+				AddItems("serviceaccounts",
+					builder.ForServiceAccount("ns-1", "sa-1").Result(),
+				).
+				Add("metadata/"+itemgraph.FileName, dependencyGraphData(t, itemgraph.Dependency{
+					Item:      itemgraph.Item{Resource: "deployments.apps", Namespace: "ns-1", Name: "deploy-1"},
+					DependsOn: itemgraph.Item{Resource: "pods", Namespace: "ns-1", Name: "pod-1"},
+					Reason:    itemgraph.ReasonOwnerReference,
+				})).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+				test.Deployments(),
+				test.ServiceAccounts(),
+			},
+			resourcePriorities: Priorities{
+				HighPriorities: []string{"serviceaccounts"},
+			},
+			expectedOrder: []string{"serviceaccounts", "pods", "deployments.apps"},
 		},
 	}
 
@@ -735,12 +923,13 @@ func TestRestoreResourcePriorities(t *testing.T) {
 		require.NoError(t, h.restorer.discoveryHelper.Refresh())
 
 		data := &Request{
-			Log:              h.log,
-			Restore:          tc.restore,
-			Backup:           tc.backup,
-			PodVolumeBackups: nil,
-			VolumeSnapshots:  nil,
-			BackupReader:     tc.tarball,
+			Log:                h.log,
+			Restore:            tc.restore,
+			Backup:             tc.backup,
+			PodVolumeBackups:   nil,
+			VolumeSnapshots:    nil,
+			BackupReader:       tc.tarball,
+			ResourcePriorities: tc.requestPriorities,
 		}
 		warnings, errs := h.restorer.Restore(
 			data,
@@ -749,7 +938,135 @@ func TestRestoreResourcePriorities(t *testing.T) {
 		)
 
 		assertEmptyResults(t, warnings, errs)
-		assertResourceCreationOrder(t, []string{"persistentvolumes", "persistentvolumeclaims", "serviceaccounts", "pods", "deployments.apps"}, recorder.resources)
+		assertResourceCreationOrder(t, tc.expectedOrder, recorder.resources)
+	}
+}
+
+// TestRestoreAggregatedAPIs runs restores of backups containing aggregated APIs, and verifies that
+// the APIServices and the resources served by them are restored after everything else, with the
+// APIServices waited for to be available.
+func TestRestoreAggregatedAPIs(t *testing.T) {
+	apiService := func(name string, labels map[string]string, service map[string]interface{}) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apiregistration.k8s.io/v1",
+			"kind":       "APIService",
+			"metadata":   map[string]interface{}{"name": name},
+			"spec":       map[string]interface{}{"group": "metrics.example.com", "version": "v1"},
+		}}
+		obj.SetLabels(labels)
+		if service != nil {
+			require.NoError(t, unstructured.SetNestedMap(obj.Object, service, "spec", "service"))
+		}
+		return obj
+	}
+	widget := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "metrics.example.com/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]interface{}{"namespace": "ns-1", "name": "widget-1"},
+	}}
+	widgets := &test.APIResource{
+		Group:      "metrics.example.com",
+		Version:    "v1",
+		Name:       "widgets",
+		Namespaced: true,
+	}
+
+	tests := []struct {
+		name             string
+		apiService       *unstructured.Unstructured
+		available        bool
+		expectedOrder    []string
+		expectedWarnings []string
+	}{
+		{
+			name:       "aggregated API is restored after the aggregated API server",
+			apiService: apiService("v1.metrics.example.com", nil, map[string]interface{}{"namespace": "ns-1", "name": "metrics"}),
+			available:  true,
+			expectedOrder: []string{
+				"deployments.apps",
+				"services",
+				"apiservices.apiregistration.k8s.io",
+				"widgets.metrics.example.com",
+			},
+		},
+		{
+			name:       "unavailable aggregated API is warned",
+			apiService: apiService("v1.metrics.example.com", nil, map[string]interface{}{"namespace": "ns-1", "name": "metrics"}),
+			expectedOrder: []string{
+				"deployments.apps",
+				"services",
+				"apiservices.apiregistration.k8s.io",
+				"widgets.metrics.example.com",
+			},
+			expectedWarnings: []string{"the APIService v1.metrics.example.com is not available, the resources served by it may fail to be restored"},
+		},
+		{
+			name:       "local API is restored in the order of the priorities",
+			apiService: apiService("v1.metrics.example.com", nil, nil),
+			expectedOrder: []string{
+				"apiservices.apiregistration.k8s.io",
+				"deployments.apps",
+				"services",
+				"widgets.metrics.example.com",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.restorer.resourceTimeout = time.Second
+
+			recorder := &createRecorder{t: t}
+			h.DynamicClient.PrependReactor("create", "*", recorder.reactor())
+			if tc.available {
+				h.DynamicClient.PrependReactor("create", "apiservices", func(action kubetesting.Action) (bool, runtime.Object, error) {
+					obj := action.(kubetesting.CreateAction).GetObject().(*unstructured.Unstructured)
+					require.NoError(t, unstructured.SetNestedSlice(obj.Object, []interface{}{
+						map[string]interface{}{"type": "Available", "status": "True"},
+					}, "status", "conditions"))
+					return false, nil, nil
+				})
+			}
+
+			for _, r := range []*test.APIResource{test.APIServices(), test.Deployments(), test.Services(), widgets} {
+				h.DiscoveryClient.WithAPIResource(r)
+			}
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+			data := &Request{
+				Log:                  h.log,
+				Restore:              defaultRestore().Result(),
+				Backup:               defaultBackup().Result(),
+				DisableInformerCache: true,
+				BackupReader: test.NewTarWriter(t).
+					AddItems("apiservices.apiregistration.k8s.io", tc.apiService).
+					AddItems("deployments.apps", builder.ForDeployment("ns-1", "metrics").Result()).
+					AddItems("services", builder.ForService("ns-1", "metrics").Result()).
+					AddItems("widgets.metrics.example.com", widget).
+					Done(),
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // restoreItemActions
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, errs)
+			if len(tc.expectedWarnings) == 0 {
+				assertEmptyResults(t, warnings)
+			} else {
+				assert.Equal(t, tc.expectedWarnings, warnings.Cluster)
+			}
+
+			var order []string
+			for _, r := range recorder.resources {
+				if len(order) == 0 || order[len(order)-1] != r.groupResource {
+					order = append(order, r.groupResource)
+				}
+			}
+			assert.Equal(t, tc.expectedOrder, order)
+		})
 	}
 }
 
@@ -831,6 +1148,192 @@ func TestInvalidTarballContents(t *testing.T) {
 	}
 }
 
+// TestRestoreChecksums verifies that the backup contents are verified against the checksums
+// of the backup before any item is restored.
+func TestRestoreChecksums(t *testing.T) {
+	newTarball := func() *bytes.Buffer {
+		return test.NewTarWriter(t).
+			AddItems("pods",
+				builder.ForPod("ns-1", "pod-1").Result(),
+				builder.ForPod("ns-1", "pod-2").Result(),
+			).
+			Done()
+	}
+	checksums, err := archive.ComputeChecksums(newTarball())
+	require.NoError(t, err)
+
+	corrupted := archive.Checksums{}
+	for name, checksum := range checksums {
+		corrupted[name] = checksum
+	}
+	corrupted["resources/pods/namespaces/ns-1/pod-1.json"] = "modified"
+	corrupted["resources/pods/namespaces/ns-1/pod-3.json"] = "missing"
+	delete(corrupted, "resources/pods/namespaces/ns-1/pod-2.json")
+
+	tests := []struct {
+		name         string
+		restore      *velerov1api.Restore
+		checksums    archive.Checksums
+		want         map[*test.APIResource][]string
+		wantErrs     Result
+		wantWarnings Result
+	}{
+		{
+			name:      "backup contents matching the checksums are restored",
+			restore:   defaultRestore().Result(),
+			checksums: checksums,
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"ns-1/pod-1", "ns-1/pod-2"},
+			},
+		},
+		{
+			name:      "corrupted backup contents fail the restore",
+			restore:   defaultRestore().Result(),
+			checksums: corrupted,
+			want: map[*test.APIResource][]string{
+				test.Pods(): {},
+			},
+			wantErrs: Result{
+				Velero: []string{
+					"file resources/pods/namespaces/ns-1/pod-1.json of the backup contents doesn't match its checksum",
+					"file resources/pods/namespaces/ns-1/pod-3.json is missing from the backup contents",
+					"file resources/pods/namespaces/ns-1/pod-2.json of the backup contents doesn't have a checksum",
+					"the backup contents are corrupted or tampered with",
+				},
+			},
+		},
+		{
+			name:      "corrupted backup contents are reported as warnings when the restore ignores the checksum mismatch",
+			restore:   defaultRestore().ObjectMeta(builder.WithAnnotations(velerov1api.IgnoreChecksumMismatchAnnotation, "true")).Result(),
+			checksums: corrupted,
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"ns-1/pod-1", "ns-1/pod-2"},
+			},
+			wantWarnings: Result{
+				Velero: []string{
+					"file resources/pods/namespaces/ns-1/pod-1.json of the backup contents doesn't match its checksum",
+					"file resources/pods/namespaces/ns-1/pod-3.json is missing from the backup contents",
+					"file resources/pods/namespaces/ns-1/pod-2.json of the backup contents doesn't have a checksum",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.DiscoveryClient.WithAPIResource(test.Pods())
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+			data := &Request{
+				Log:          h.log,
+				Restore:      tc.restore,
+				Backup:       defaultBackup().Result(),
+				BackupReader: newTarball(),
+				Checksums:    tc.checksums,
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // restoreItemActions
+				nil, // volume snapshotter getter
+			)
+			assertWantErrsOrWarnings(t, tc.wantWarnings, warnings)
+			assertWantErrsOrWarnings(t, tc.wantErrs, errs)
+			assertAPIContents(t, h, tc.want)
+		})
+	}
+}
+
+// TestRestoreReferencedBackups verifies that the cluster-scoped items deduplicated by the backup
+// are read from the contents of the backups storing them.
+func TestRestoreReferencedBackups(t *testing.T) {
+	scPath := "resources/storageclasses.storage.k8s.io/cluster/sc-1.json"
+	newReferencedTarball := func() *bytes.Buffer {
+		return test.NewTarWriter(t).
+			AddItems("storageclasses.storage.k8s.io", builder.ForStorageClass("sc-1").Result()).
+			Done()
+	}
+
+	tests := []struct {
+		name         string
+		referenced   []ReferencedBackup
+		want         map[*test.APIResource][]string
+		wantErrs     Result
+		wantWarnings Result
+	}{
+		{
+			name:       "referenced items are restored",
+			referenced: []ReferencedBackup{{Name: "backup-0", Reader: newReferencedTarball(), Paths: []string{scPath}}},
+			want: map[*test.APIResource][]string{
+				test.Pods():           {"ns-1/pod-1"},
+				test.StorageClasses(): {"/sc-1"},
+			},
+		},
+		{
+			name:       "referenced items of unavailable backups are reported as errors",
+			referenced: []ReferencedBackup{{Name: "backup-0", Paths: []string{scPath}}},
+			want: map[*test.APIResource][]string{
+				test.Pods():           {"ns-1/pod-1"},
+				test.StorageClasses(): {},
+			},
+			wantErrs: Result{
+				Velero: []string{"the contents of backup backup-0 storing 1 cluster-scoped items deduplicated by the backup aren't available, the items aren't restored"},
+			},
+		},
+		{
+			name: "referenced items not matching the checksums aren't restored",
+			referenced: []ReferencedBackup{{
+				Name:      "backup-0",
+				Reader:    newReferencedTarball(),
+				Checksums: archive.Checksums{scPath: "modified"},
+				Paths:     []string{scPath},
+			}},
+			want: map[*test.APIResource][]string{
+				test.Pods():           {"ns-1/pod-1"},
+				test.StorageClasses(): {},
+			},
+			wantErrs: Result{
+				Velero: []string{"error reading the cluster-scoped items deduplicated by the backup from backup backup-0: file " + scPath + " of the backup contents doesn't match its checksum"},
+			},
+		},
+		{
+			name:       "referenced items missing from the referenced backup aren't restored",
+			referenced: []ReferencedBackup{{Name: "backup-0", Reader: newReferencedTarball(), Paths: []string{"resources/storageclasses.storage.k8s.io/cluster/sc-2.json"}}},
+			want: map[*test.APIResource][]string{
+				test.Pods():           {"ns-1/pod-1"},
+				test.StorageClasses(): {},
+			},
+			wantErrs: Result{
+				Velero: []string{"error reading the cluster-scoped items deduplicated by the backup from backup backup-0: file resources/storageclasses.storage.k8s.io/cluster/sc-2.json is missing from the backup contents"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.DiscoveryClient.WithAPIResource(test.Pods()).WithAPIResource(test.StorageClasses())
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+			data := &Request{
+				Log:               h.log,
+				Restore:           defaultRestore().Result(),
+				Backup:            defaultBackup().Result(),
+				BackupReader:      test.NewTarWriter(t).AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).Done(),
+				ReferencedBackups: tc.referenced,
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // restoreItemActions
+				nil, // volume snapshotter getter
+			)
+			assertWantErrsOrWarnings(t, tc.wantWarnings, warnings)
+			assertWantErrsOrWarnings(t, tc.wantErrs, errs)
+			assertAPIContents(t, h, tc.want)
+		})
+	}
+}
+
 func assertWantErrsOrWarnings(t *testing.T, wantRes Result, res Result) {
 	t.Helper()
 	if wantRes.Velero != nil {
@@ -1077,6 +1580,76 @@ func TestRestoreItems(t *testing.T) {
 				test.Pods(builder.ForPod("ns-1", "sa-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Result()),
 			},
 		},
+		{
+			name:    "update configmap and do not update secret labels when both exist in cluster, existing resource policy of secrets overrides the update policy",
+			restore: defaultRestore().ExistingResourcePolicy("update").ExistingResourcePolicies(map[string]velerov1api.PolicyType{"secrets": velerov1api.PolicyTypeNone}).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("configmaps", builder.ForConfigMap("ns-1", "cm-1").Data("key-1", "value-1").Result()).
+				AddItems("secrets", builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"key-1": []byte("value-1")}).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.ConfigMaps(builder.ForConfigMap("ns-1", "cm-1").Data("foo", "bar").Result()),
+				test.Secrets(builder.ForSecret("ns-1", "secret-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "foo", "velero.io/restore-name", "bar")).Data(map[string][]byte{"key-1": []byte("value-1")}).Result()),
+			},
+			disableInformer: true,
+			want: []*test.APIResource{
+				test.ConfigMaps(builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Data("key-1", "value-1").Result()),
+				test.Secrets(builder.ForSecret("ns-1", "secret-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "foo", "velero.io/restore-name", "bar")).Data(map[string][]byte{"key-1": []byte("value-1")}).Result()),
+			},
+			expectedRestoreItems: map[itemKey]restoredItemStatus{
+				{resource: "v1/Namespace", namespace: "", name: "ns-1"}:      {action: "created", itemExists: true},
+				{resource: "v1/ConfigMap", namespace: "ns-1", name: "cm-1"}:  {action: "updated", itemExists: true},
+				{resource: "v1/Secret", namespace: "ns-1", name: "secret-1"}: {action: "skipped", itemExists: true},
+			},
+		},
+		{
+			name:    "recreate secret when secret exists in cluster and is not identical to the backed up one, existing resource policy of secrets is recreate",
+			restore: defaultRestore().ExistingResourcePolicies(map[string]velerov1api.PolicyType{"secrets": velerov1api.PolicyTypeRecreate}).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("secrets", builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"key-1": []byte("value-1")}).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Secrets(builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"foo": []byte("bar")}).Result()),
+			},
+			disableInformer: true,
+			want: []*test.APIResource{
+				test.Secrets(builder.ForSecret("ns-1", "secret-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Data(map[string][]byte{"key-1": []byte("value-1")}).Result()),
+			},
+			expectedRestoreItems: map[itemKey]restoredItemStatus{
+				{resource: "v1/Namespace", namespace: "", name: "ns-1"}:      {action: "created", itemExists: true},
+				{resource: "v1/Secret", namespace: "ns-1", name: "secret-1"}: {action: "created", itemExists: true},
+			},
+		},
+		{
+			name:    "recreate pvc and the pod mounting it when both exist in cluster, existing resource policy is recreate",
+			restore: defaultRestore().ExistingResourcePolicy("recreate").Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("persistentvolumeclaims", builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("gp3").Result()).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result()).Result()).
+				Add("metadata/"+itemgraph.FileName, dependencyGraphData(t, itemgraph.Dependency{
+					Item:      itemgraph.Item{Resource: "pods", Namespace: "ns-1", Name: "pod-1"},
+					DependsOn: itemgraph.Item{Resource: "persistentvolumeclaims", Namespace: "ns-1", Name: "pvc-1"},
+					Reason:    itemgraph.ReasonPersistentVolumeClaim,
+				})).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.PVCs(builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("standard").Result()),
+				test.Pods(builder.ForPod("ns-1", "pod-1").Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result()).Result()),
+			},
+			disableInformer: true,
+			want: []*test.APIResource{
+				test.PVCs(builder.ForPersistentVolumeClaim("ns-1", "pvc-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).StorageClass("gp3").Result()),
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result()).Result()),
+			},
+			expectedRestoreItems: map[itemKey]restoredItemStatus{
+				{resource: "v1/Namespace", namespace: "", name: "ns-1"}:                  {action: "created", itemExists: true},
+				{resource: "v1/PersistentVolumeClaim", namespace: "ns-1", name: "pvc-1"}: {action: "created", itemExists: true},
+				{resource: "v1/Pod", namespace: "ns-1", name: "pod-1"}:                   {action: "created", itemExists: true},
+			},
+		},
 		{
 			name:    "do not update pod labels when pod exists in cluster and is identical to the backed up one, existing resource policy is none",
 			restore: defaultRestore().ExistingResourcePolicy("none").Result(),
@@ -1474,6 +2047,60 @@ func TestRestoreActionsRunForCorrectItems(t *testing.T) {
 	}
 }
 
+// TestRestoreFinalizeItems runs restores with v3 restore item actions and verifies that the
+// created items are recorded for the actions executed on them.
+func TestRestoreFinalizeItems(t *testing.T) {
+	h := newHarness(t)
+	h.AddItems(t, test.Pods())
+	h.AddItems(t, test.PVs())
+
+	podAction := &namedAction{name: "velero.io/pods", pluggableAction: new(pluggableAction).addSelector(velero.ResourceSelector{IncludedResources: []string{"pods"}})}
+	pvAction := &namedAction{name: "velero.io/pvs", pluggableAction: new(pluggableAction).addSelector(velero.ResourceSelector{IncludedResources: []string{"persistentvolumes"}})}
+	skipAction := &namedAction{name: "velero.io/skip", pluggableAction: &pluggableAction{
+		selector: velero.ResourceSelector{IncludedNamespaces: []string{"ns-2"}},
+		executeFunc: func(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+			return velero.NewRestoreItemActionExecuteOutput(input.Item).WithoutRestore(), nil
+		},
+	}}
+
+	req := &Request{
+		Log:     h.log,
+		Restore: defaultRestore().Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("pods", builder.ForPod("ns-1", "pod-1").Result(), builder.ForPod("ns-2", "pod-2").Result()).
+			AddItems("persistentvolumes", builder.ForPersistentVolume("pv-1").Result()).
+			Done(),
+		// the v2 action executed on the PV isn't recorded
+		FinalizeActions: sets.NewString(podAction.name, skipAction.name),
+	}
+	warnings, errs := h.restorer.Restore(req, []riav2.RestoreItemAction{podAction, pvAction, skipAction}, nil)
+	assertEmptyResults(t, warnings, errs)
+
+	pod, err := h.DynamicClient.Resource(test.Pods().GVR()).Namespace("ns-1").Get(context.TODO(), "pod-1", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	// the item skipped by the action isn't created
+	assert.Equal(t, map[string][]velero.RestoredItem{
+		podAction.name: {
+			{
+				ResourceIdentifier: velero.ResourceIdentifier{GroupResource: kuberesource.Pods, Namespace: "ns-1", Name: "pod-1"},
+				UID:                pod.GetUID(),
+			},
+		},
+	}, req.FinalizeItems)
+}
+
+// namedAction is a pluggableAction with a name.
+type namedAction struct {
+	*pluggableAction
+	name string
+}
+
+func (a *namedAction) Name() string {
+	return a.name
+}
+
 // pluggableAction is a restore item action that can be plugged with an Execute
 // function body at runtime.
 type pluggableAction struct {
@@ -3248,6 +3875,7 @@ func TestRestoreWithPodVolume(t *testing.T) {
 					PodVolumeBackups: tc.podVolumeBackups,
 					SourceNamespace:  pod.Namespace,
 					BackupLocation:   "",
+					HostHooks:        map[string][]velerov1api.HostRestoreHook{},
 				}
 				restorer.
 					On("RestorePodVolumes", expectedArgs).
@@ -3417,6 +4045,7 @@ func Test_getOrderedResources(t *testing.T) {
 		name               string
 		resourcePriorities Priorities
 		backupResources    map[string]*archive.ResourceItems
+		dependencyGraph    *itemgraph.Graph
 		want               []string
 	}{
 		{
@@ -3458,15 +4087,56 @@ func Test_getOrderedResources(t *testing.T) {
 			},
 			want: []string{"prio-3", "prio-2", "prio-1", "backup-resource-1", "backup-resource-2", "backup-resource-3", "prio-0"},
 		},
+		{
+			name:               "when there is a dependency graph, the backup resources are ordered by it",
+			resourcePriorities: Priorities{HighPriorities: []string{"prio-2", "prio-1"}, LowPriorities: []string{"prio-0"}},
+			backupResources: map[string]*archive.ResourceItems{
+				"prio-2":            nil,
+				"prio-1":            nil,
+				"prio-0":            nil,
+				"backup-resource-3": nil,
+				"backup-resource-2": nil,
+				"backup-resource-1": nil,
+			},
+			dependencyGraph: newDependencyGraph(
+				// the dependencies on the prioritized resources don't change the order
+				itemgraph.Dependency{
+					Item:      itemgraph.Item{Resource: "prio-1", Name: "item"},
+					DependsOn: itemgraph.Item{Resource: "backup-resource-1", Name: "item"},
+				},
+				itemgraph.Dependency{
+					Item:      itemgraph.Item{Resource: "backup-resource-1", Name: "item"},
+					DependsOn: itemgraph.Item{Resource: "backup-resource-3", Name: "item"},
+				},
+			),
+			want: []string{"prio-2", "prio-1", "backup-resource-2", "backup-resource-3", "backup-resource-1", "prio-0"},
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			assert.Equal(t, tc.want, getOrderedResources(tc.resourcePriorities, tc.backupResources))
+			assert.Equal(t, tc.want, getOrderedResources(tc.resourcePriorities, tc.backupResources, tc.dependencyGraph))
 		})
 	}
 }
 
+func newDependencyGraph(dependencies ...itemgraph.Dependency) *itemgraph.Graph {
+	graph := itemgraph.NewGraph()
+	for _, dependency := range dependencies {
+		graph.AddItem(dependency.Item)
+		graph.AddItem(dependency.DependsOn, dependency)
+	}
+	return graph
+}
+
+func dependencyGraphData(t *testing.T, dependencies ...itemgraph.Dependency) []byte {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, newDependencyGraph(dependencies...).Write(buf))
+	return buf.Bytes()
+}
+
 // assertResourceCreationOrder ensures that resources were created in the expected
 // order. Any resources *not* in resourcePriorities are required to come *after* all
 // resources in any order.
@@ -3684,6 +4354,66 @@ func Test_resetVolumeBindingInfo(t *testing.T) {
 	}
 }
 
+func TestDeleteAndWait(t *testing.T) {
+	notFound := apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, "pod-1")
+	pod := &unstructured.Unstructured{}
+	pod.SetName("pod-1")
+	terminatingPod := pod.DeepCopy()
+	terminatingPod.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+	terminatingPod.SetFinalizers([]string{"example.io/protection"})
+
+	tests := []struct {
+		name         string
+		fromCluster  *unstructured.Unstructured
+		expectDelete bool
+		getResult    *unstructured.Unstructured
+		getErr       error
+		expectedErr  string
+	}{
+		{
+			name:         "resource is deleted",
+			fromCluster:  pod,
+			expectDelete: true,
+			getErr:       notFound,
+		},
+		{
+			name:        "terminating resource is not deleted again",
+			fromCluster: terminatingPod,
+			getErr:      notFound,
+		},
+		{
+			name:         "resource blocked by finalizers times out",
+			fromCluster:  pod,
+			expectDelete: true,
+			getResult:    terminatingPod,
+			expectedErr:  "timed out waiting for in-cluster resource to be deleted, it is blocked by the finalizers [example.io/protection]",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceClient := &test.FakeDynamicClient{}
+			if tc.expectDelete {
+				resourceClient.On("Delete", "pod-1", mock.Anything).Return(nil, nil)
+			}
+			resourceClient.On("Get", "pod-1", metav1.GetOptions{}).Return(tc.getResult, tc.getErr)
+
+			ctx := &restoreContext{
+				log:                        test.NewLogger(),
+				resourceTerminatingTimeout: 10 * time.Millisecond,
+			}
+
+			err := ctx.deleteAndWait(tc.fromCluster, resourceClient)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			resourceClient.AssertExpectations(t)
+		})
+	}
+}
+
 func TestIsAlreadyExistsError(t *testing.T) {
 	tests := []struct {
 		name        string
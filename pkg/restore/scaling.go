@@ -0,0 +1,95 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// ScalableResources are the workload resources whose replicas can be scaled during restore.
+var ScalableResources = []schema.GroupResource{kuberesource.Deployments, kuberesource.StatefulSets}
+
+// IsScalableResource returns true if the resource name, with or without the group,
+// refers to one of the ScalableResources.
+func IsScalableResource(resource string) bool {
+	for _, groupResource := range ScalableResources {
+		if matchesGroupResource(resource, groupResource) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesGroupResource(resource string, groupResource schema.GroupResource) bool {
+	return resource == groupResource.Resource || resource == groupResource.String()
+}
+
+// applyScaling sets the replicas of the restored Deployments and StatefulSets
+// according to the restore's scaling, the original replicas are kept in the
+// velero.io/original-replicas annotation.
+func (ctx *restoreContext) applyScaling(obj *unstructured.Unstructured, groupResource schema.GroupResource) error {
+	scaling := ctx.restore.Spec.Scaling
+	if scaling == nil {
+		return nil
+	}
+
+	if groupResource != kuberesource.Deployments && groupResource != kuberesource.StatefulSets {
+		return nil
+	}
+
+	if len(scaling.IncludedResources) > 0 {
+		included := false
+		for _, resource := range scaling.IncludedResources {
+			if matchesGroupResource(resource, groupResource) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return nil
+		}
+	}
+
+	replicas, found, err := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if err != nil {
+		return errors.Wrap(err, "error getting spec.replicas")
+	}
+	if !found {
+		// the replicas default to 1 if not specified
+		replicas = 1
+	}
+
+	// keep the annotation set by a previous restore, which holds the replicas before that restore
+	annotations := obj.GetAnnotations()
+	if _, ok := annotations[velerov1api.OriginalReplicasAnnotation]; !ok {
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[velerov1api.OriginalReplicasAnnotation] = strconv.FormatInt(replicas, 10)
+		obj.SetAnnotations(annotations)
+	}
+
+	ctx.log.Infof("Scaling %s %s/%s from %d to %d replicas", groupResource, obj.GetNamespace(), obj.GetName(), replicas, scaling.Replicas)
+	return errors.Wrap(unstructured.SetNestedField(obj.Object, int64(scaling.Replicas), "spec", "replicas"), "error setting spec.replicas")
+}
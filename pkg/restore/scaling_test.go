@@ -0,0 +1,109 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestApplyScaling(t *testing.T) {
+	tests := []struct {
+		name          string
+		restore       *velerov1api.Restore
+		groupResource schema.GroupResource
+		item          string
+		expected      string
+	}{
+		{
+			name:          "no scaling",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+			groupResource: kuberesource.Deployments,
+			item:          `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"deploy-1","namespace":"ns-1"},"spec":{"replicas":3}}`,
+			expected:      `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"deploy-1","namespace":"ns-1"},"spec":{"replicas":3}}`,
+		},
+		{
+			name:          "deployment is scaled to zero",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Scaling(0).Result(),
+			groupResource: kuberesource.Deployments,
+			item:          `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"deploy-1","namespace":"ns-1"},"spec":{"replicas":3}}`,
+			expected:      `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"deploy-1","namespace":"ns-1","annotations":{"velero.io/original-replicas":"3"}},"spec":{"replicas":0}}`,
+		},
+		{
+			name:          "stateful set without replicas is scaled",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Scaling(2, "statefulsets.apps").Result(),
+			groupResource: kuberesource.StatefulSets,
+			item:          `{"apiVersion":"apps/v1","kind":"StatefulSet","metadata":{"name":"sts-1","namespace":"ns-1"},"spec":{}}`,
+			expected:      `{"apiVersion":"apps/v1","kind":"StatefulSet","metadata":{"name":"sts-1","namespace":"ns-1","annotations":{"velero.io/original-replicas":"1"}},"spec":{"replicas":2}}`,
+		},
+		{
+			name:          "original replicas annotation of previous restore is kept",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Scaling(0).Result(),
+			groupResource: kuberesource.Deployments,
+			item:          `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"deploy-1","namespace":"ns-1","annotations":{"velero.io/original-replicas":"5"}},"spec":{"replicas":1}}`,
+			expected:      `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"deploy-1","namespace":"ns-1","annotations":{"velero.io/original-replicas":"5"}},"spec":{"replicas":0}}`,
+		},
+		{
+			name:          "resource not included isn't scaled",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Scaling(0, "statefulsets").Result(),
+			groupResource: kuberesource.Deployments,
+			item:          `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"deploy-1","namespace":"ns-1"},"spec":{"replicas":3}}`,
+			expected:      `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"deploy-1","namespace":"ns-1"},"spec":{"replicas":3}}`,
+		},
+		{
+			name:          "non-workload resource isn't scaled",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Scaling(0).Result(),
+			groupResource: schema.GroupResource{Group: "apps", Resource: "replicasets"},
+			item:          `{"apiVersion":"apps/v1","kind":"ReplicaSet","metadata":{"name":"rs-1","namespace":"ns-1"},"spec":{"replicas":3}}`,
+			expected:      `{"apiVersion":"apps/v1","kind":"ReplicaSet","metadata":{"name":"rs-1","namespace":"ns-1"},"spec":{"replicas":3}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := &restoreContext{
+				restore: test.restore,
+				log:     velerotest.NewLogger(),
+			}
+
+			obj := &unstructured.Unstructured{}
+			require.NoError(t, obj.UnmarshalJSON([]byte(test.item)))
+			require.NoError(t, ctx.applyScaling(obj, test.groupResource))
+
+			expected := &unstructured.Unstructured{}
+			require.NoError(t, expected.UnmarshalJSON([]byte(test.expected)))
+			assert.Equal(t, expected, obj)
+		})
+	}
+}
+
+func TestIsScalableResource(t *testing.T) {
+	assert.True(t, IsScalableResource("deployments"))
+	assert.True(t, IsScalableResource("deployments.apps"))
+	assert.True(t, IsScalableResource("statefulsets"))
+	assert.False(t, IsScalableResource("daemonsets"))
+	assert.False(t, IsScalableResource("deployments.extensions"))
+}
@@ -0,0 +1,149 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/secretpolicy"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+const (
+	// encryptionProviderConfigFlag is the flag of kube-apiserver enabling the encryption at rest
+	encryptionProviderConfigFlag = "--encryption-provider-config"
+	// kubeAPIServerLabel is the label of the kube-apiserver static pods created by kubeadm
+	kubeAPIServerLabel = "component"
+)
+
+// validateSecretEncryption verifies the target cluster encrypts the Secrets at rest before
+// anything is restored, if the restore includes sensitive Secrets. The failed verification
+// is returned as an error, or as a warning if the restore allows an unencrypted target.
+func (ctx *restoreContext) validateSecretEncryption(backupResources map[string]*archive.ResourceItems) (results.Result, results.Result) {
+	warnings, errs := results.Result{}, results.Result{}
+
+	secretEncryption := ctx.restore.Spec.SecretEncryption
+	if secretEncryption == nil {
+		return warnings, errs
+	}
+
+	count := ctx.countSensitiveSecrets(backupResources, sets.NewString(secretEncryption.SensitiveTypes...))
+	if count == 0 {
+		return warnings, errs
+	}
+
+	err := ctx.verifySecretEncryption()
+	if err == nil {
+		ctx.log.Infof("The target cluster is verified to encrypt the secrets at rest, restoring %d sensitive secrets", count)
+		return warnings, errs
+	}
+
+	if secretEncryption.AllowUnencryptedTarget {
+		warnings.AddVeleroError(errors.Wrapf(err, "restoring %d sensitive secrets to a target cluster not verified to encrypt the secrets at rest", count))
+	} else {
+		errs.AddVeleroError(errors.Wrapf(err, "refusing to restore %d sensitive secrets to a target cluster not verified to encrypt the secrets at rest", count))
+	}
+
+	return warnings, errs
+}
+
+// countSensitiveSecrets returns the number of the restored Secrets of the sensitive types, all
+// the Secrets are sensitive if the types are empty.
+func (ctx *restoreContext) countSensitiveSecrets(backupResources map[string]*archive.ResourceItems, sensitiveTypes sets.String) int {
+	// the errors of the selected items are reported when they're restored
+	collection, _, _, _ := ctx.getOrderedResourceCollection(
+		backupResources,
+		make([]restoreableResource, 0),
+		sets.NewString(),
+		Priorities{HighPriorities: []string{kuberesource.Secrets.String()}},
+		false,
+	)
+
+	count := 0
+	for _, selectedResource := range collection {
+		if selectedResource.resource != kuberesource.Secrets.String() {
+			continue
+		}
+		for _, selectedItems := range selectedResource.selectedItemsByNamespace {
+			for _, selectedItem := range selectedItems {
+				if sensitiveTypes.Len() > 0 {
+					obj, err := archive.Unmarshal(ctx.fileSystem, selectedItem.path)
+					if err != nil {
+						continue
+					}
+					// the Secrets without a type are opaque
+					secretType := secretpolicy.SecretType(obj)
+					if secretType == "" {
+						secretType = string(corev1api.SecretTypeOpaque)
+					}
+					if !sensitiveTypes.Has(secretType) {
+						continue
+					}
+				}
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+// verifySecretEncryption verifies the target cluster encrypts the Secrets at rest, i.e. the
+// server is started with --secrets-encrypted-at-rest, or all the kube-apiserver pods of the
+// cluster set the encryption provider config. The control planes of the managed clusters
+// can't be inspected, so they're only verified by the server flag.
+func (ctx *restoreContext) verifySecretEncryption() error {
+	if ctx.secretsEncryptedAtRest {
+		return nil
+	}
+
+	pods := new(corev1api.PodList)
+	if err := ctx.kbClient.List(go_context.Background(), pods, crclient.InNamespace(metav1.NamespaceSystem), crclient.MatchingLabels{kubeAPIServerLabel: "kube-apiserver"}); err != nil {
+		return errors.Wrap(err, "error listing the kube-apiserver pods")
+	}
+	if len(pods.Items) == 0 {
+		return errors.Errorf("no kube-apiserver pod is found in namespace %s", metav1.NamespaceSystem)
+	}
+
+	for i := range pods.Items {
+		if !hasEncryptionProviderConfig(&pods.Items[i]) {
+			return errors.Errorf("kube-apiserver pod %s doesn't set %s", pods.Items[i].Name, encryptionProviderConfigFlag)
+		}
+	}
+
+	return nil
+}
+
+func hasEncryptionProviderConfig(pod *corev1api.Pod) bool {
+	for _, container := range pod.Spec.Containers {
+		for _, arg := range append(container.Command, container.Args...) {
+			if arg == encryptionProviderConfigFlag || strings.HasPrefix(arg, encryptionProviderConfigFlag+"=") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRestoreSecretEncryption(t *testing.T) {
+	apiServer := func(args ...string) *corev1api.Pod {
+		return builder.ForPod("kube-system", "kube-apiserver-node-1").
+			ObjectMeta(builder.WithLabels("component", "kube-apiserver")).
+			Containers(&corev1api.Container{Name: "kube-apiserver", Command: append([]string{"kube-apiserver"}, args...)}).
+			Result()
+	}
+	notVerified := "kube-apiserver pod kube-apiserver-node-1 doesn't set --encryption-provider-config"
+
+	tests := []struct {
+		name                   string
+		restore                *velerov1api.Restore
+		apiServer              *corev1api.Pod
+		secretsEncryptedAtRest bool
+		expectedCreated        bool
+		expectedWarnings       []string
+		expectedErrs           []string
+	}{
+		{
+			name:            "secrets are restored without the verification",
+			restore:         defaultRestore().Result(),
+			apiServer:       apiServer(),
+			expectedCreated: true,
+		},
+		{
+			name:            "secrets are restored to the cluster setting the encryption provider config",
+			restore:         defaultRestore().SecretEncryption(false).Result(),
+			apiServer:       apiServer("--encryption-provider-config=/etc/kubernetes/encryption.yaml"),
+			expectedCreated: true,
+		},
+		{
+			name:                   "secrets are restored to the cluster acknowledged by the server",
+			restore:                defaultRestore().SecretEncryption(false).Result(),
+			secretsEncryptedAtRest: true,
+			expectedCreated:        true,
+		},
+		{
+			name:         "unencrypted cluster fails the restore",
+			restore:      defaultRestore().SecretEncryption(false).Result(),
+			apiServer:    apiServer(),
+			expectedErrs: []string{"refusing to restore 2 sensitive secrets to a target cluster not verified to encrypt the secrets at rest: " + notVerified},
+		},
+		{
+			name:         "cluster without kube-apiserver pods fails the restore",
+			restore:      defaultRestore().SecretEncryption(false).Result(),
+			expectedErrs: []string{"refusing to restore 2 sensitive secrets to a target cluster not verified to encrypt the secrets at rest: no kube-apiserver pod is found in namespace kube-system"},
+		},
+		{
+			name:             "unencrypted cluster is warned if it's allowed",
+			restore:          defaultRestore().SecretEncryption(true, "kubernetes.io/tls").Result(),
+			apiServer:        apiServer(),
+			expectedCreated:  true,
+			expectedWarnings: []string{"restoring 1 sensitive secrets to a target cluster not verified to encrypt the secrets at rest: " + notVerified},
+		},
+		{
+			name:            "secrets of the other types aren't sensitive",
+			restore:         defaultRestore().SecretEncryption(false, "kubernetes.io/basic-auth").Result(),
+			apiServer:       apiServer(),
+			expectedCreated: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.restorer.secretsEncryptedAtRest = tc.secretsEncryptedAtRest
+			if tc.apiServer != nil {
+				require.NoError(t, h.restorer.kbClient.Create(context.Background(), tc.apiServer))
+			}
+
+			h.DiscoveryClient.WithAPIResource(test.Secrets())
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+			recorder := &createRecorder{t: t}
+			h.DynamicClient.PrependReactor("create", "secrets", recorder.reactor())
+
+			data := &Request{
+				Log:                  h.log,
+				Restore:              tc.restore,
+				Backup:               defaultBackup().Result(),
+				DisableInformerCache: true,
+				BackupReader: test.NewTarWriter(t).
+					AddItems("secrets",
+						builder.ForSecret("ns-1", "secret-1").Type(corev1api.SecretTypeTLS).Result(),
+						builder.ForSecret("ns-1", "secret-2").Result(),
+					).
+					Done(),
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // restoreItemActions
+				nil, // volume snapshotter getter
+			)
+
+			assert.Equal(t, tc.expectedWarnings, warnings.Velero)
+			assert.Equal(t, tc.expectedErrs, errs.Velero)
+			assert.Equal(t, tc.expectedCreated, len(recorder.resources) > 0)
+		})
+	}
+}
@@ -0,0 +1,278 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	storagev1api "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const (
+	// annStorageClass is the deprecated annotation holding the storage class of PVCs.
+	annStorageClass = "volume.beta.kubernetes.io/storage-class"
+	// annStorageProvisioner and annBetaStorageProvisioner hold the provisioner of PVCs.
+	annStorageProvisioner     = "volume.kubernetes.io/storage-provisioner"
+	annBetaStorageProvisioner = "volume.beta.kubernetes.io/storage-provisioner"
+)
+
+// isZoneKey returns true if the label or node affinity key holds the topology zone.
+// Besides the well-known labels, the CSI drivers usually report the zone of the volumes
+// with their own topology keys, e.g. topology.ebs.csi.aws.com/zone.
+func isZoneKey(key string) bool {
+	return key == corev1api.LabelTopologyZone || key == corev1api.LabelFailureDomainBetaZone || strings.HasSuffix(key, "/zone")
+}
+
+// applyTopologyMappings remaps the storage classes, CSI drivers and zones of the item
+// according to the restore's storage class mappings and zone mappings.
+func (ctx *restoreContext) applyTopologyMappings(obj *unstructured.Unstructured, groupResource schema.GroupResource) error {
+	if len(ctx.restore.Spec.StorageClassMappings) == 0 && len(ctx.restore.Spec.ZoneMappings) == 0 {
+		return nil
+	}
+
+	switch groupResource {
+	case kuberesource.PersistentVolumes:
+		if err := ctx.mapPVStorageClass(obj); err != nil {
+			return err
+		}
+		return ctx.mapPVZones(obj)
+	case kuberesource.PersistentVolumeClaims:
+		return ctx.mapPVCStorageClass(obj)
+	case kuberesource.StatefulSets:
+		return ctx.mapStatefulSetStorageClass(obj)
+	}
+
+	return nil
+}
+
+// mapStorageClass returns the target storage class and its provisioner if the storage
+// class is mapped.
+func (ctx *restoreContext) mapStorageClass(storageClass string) (string, string, bool, error) {
+	if storageClass == "" {
+		return "", "", false, nil
+	}
+
+	target, ok := ctx.restore.Spec.StorageClassMappings[storageClass]
+	if !ok {
+		return "", "", false, nil
+	}
+
+	provisioner, ok := ctx.storageClassProvisioners[target]
+	if !ok {
+		sc := &storagev1api.StorageClass{}
+		if err := ctx.kbClient.Get(go_context.Background(), crclient.ObjectKey{Name: target}, sc); err != nil {
+			return "", "", false, errors.Wrapf(err, "error getting storage class %s", target)
+		}
+		provisioner = sc.Provisioner
+		ctx.storageClassProvisioners[target] = provisioner
+	}
+
+	return target, provisioner, true, nil
+}
+
+func (ctx *restoreContext) mapPVStorageClass(obj *unstructured.Unstructured) error {
+	storageClass, _, err := unstructured.NestedString(obj.Object, "spec", "storageClassName")
+	if err != nil {
+		return errors.Wrap(err, "error getting spec.storageClassName")
+	}
+
+	target, provisioner, mapped, err := ctx.mapStorageClass(storageClass)
+	if err != nil || !mapped {
+		return err
+	}
+
+	ctx.log.Infof("Updating storage class of persistent volume %s from %s to %s", obj.GetName(), storageClass, target)
+	if err := unstructured.SetNestedField(obj.Object, target, "spec", "storageClassName"); err != nil {
+		return errors.Wrap(err, "error setting spec.storageClassName")
+	}
+
+	driver, found, err := unstructured.NestedString(obj.Object, "spec", "csi", "driver")
+	if err != nil {
+		return errors.Wrap(err, "error getting spec.csi.driver")
+	}
+	if !found || driver == provisioner {
+		return nil
+	}
+
+	ctx.log.Infof("Updating CSI driver of persistent volume %s from %s to %s", obj.GetName(), driver, provisioner)
+	if err := unstructured.SetNestedField(obj.Object, provisioner, "spec", "csi", "driver"); err != nil {
+		return errors.Wrap(err, "error setting spec.csi.driver")
+	}
+
+	annotations := obj.GetAnnotations()
+	if _, ok := annotations[kube.KubeAnnDynamicallyProvisioned]; ok {
+		annotations[kube.KubeAnnDynamicallyProvisioned] = provisioner
+		obj.SetAnnotations(annotations)
+	}
+
+	return nil
+}
+
+func (ctx *restoreContext) mapPVZones(obj *unstructured.Unstructured) error {
+	zoneMappings := ctx.restore.Spec.ZoneMappings
+	if len(zoneMappings) == 0 {
+		return nil
+	}
+
+	labels := obj.GetLabels()
+	labelsMapped := false
+	for key, value := range labels {
+		if target, ok := zoneMappings[value]; ok && isZoneKey(key) {
+			labels[key] = target
+			labelsMapped = true
+		}
+	}
+	if labelsMapped {
+		obj.SetLabels(labels)
+	}
+
+	terms, found, err := unstructured.NestedSlice(obj.Object, "spec", "nodeAffinity", "required", "nodeSelectorTerms")
+	if err != nil {
+		return errors.Wrap(err, "error getting spec.nodeAffinity.required.nodeSelectorTerms")
+	}
+	if !found {
+		return nil
+	}
+
+	for _, term := range terms {
+		termMap, ok := term.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		expressions, _, err := unstructured.NestedSlice(termMap, "matchExpressions")
+		if err != nil {
+			return errors.Wrap(err, "error getting matchExpressions of node selector term")
+		}
+
+		for _, expression := range expressions {
+			expressionMap, ok := expression.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			key, _, _ := unstructured.NestedString(expressionMap, "key")
+			if !isZoneKey(key) {
+				continue
+			}
+
+			values, _, _ := unstructured.NestedSlice(expressionMap, "values")
+			for i, value := range values {
+				zone, ok := value.(string)
+				if !ok {
+					continue
+				}
+				if target, ok := zoneMappings[zone]; ok {
+					values[i] = target
+				}
+			}
+			expressionMap["values"] = values
+		}
+
+		if expressions != nil {
+			termMap["matchExpressions"] = expressions
+		}
+	}
+
+	ctx.log.Infof("Updating zones of persistent volume %s with the zone mappings", obj.GetName())
+	return unstructured.SetNestedSlice(obj.Object, terms, "spec", "nodeAffinity", "required", "nodeSelectorTerms")
+}
+
+func (ctx *restoreContext) mapPVCStorageClass(obj *unstructured.Unstructured) error {
+	annotations := obj.GetAnnotations()
+
+	storageClass, inSpec, err := unstructured.NestedString(obj.Object, "spec", "storageClassName")
+	if err != nil {
+		return errors.Wrap(err, "error getting spec.storageClassName")
+	}
+	if storageClass == "" {
+		inSpec = false
+		storageClass = annotations[annStorageClass]
+	}
+
+	target, provisioner, mapped, err := ctx.mapStorageClass(storageClass)
+	if err != nil || !mapped {
+		return err
+	}
+
+	ctx.log.Infof("Updating storage class of persistent volume claim %s/%s from %s to %s", obj.GetNamespace(), obj.GetName(), storageClass, target)
+	if inSpec {
+		if err := unstructured.SetNestedField(obj.Object, target, "spec", "storageClassName"); err != nil {
+			return errors.Wrap(err, "error setting spec.storageClassName")
+		}
+	}
+	if _, ok := annotations[annStorageClass]; ok {
+		annotations[annStorageClass] = target
+	}
+
+	for _, key := range []string{annStorageProvisioner, annBetaStorageProvisioner} {
+		if _, ok := annotations[key]; ok {
+			annotations[key] = provisioner
+		}
+	}
+	if annotations != nil {
+		obj.SetAnnotations(annotations)
+	}
+
+	return nil
+}
+
+func (ctx *restoreContext) mapStatefulSetStorageClass(obj *unstructured.Unstructured) error {
+	templates, found, err := unstructured.NestedSlice(obj.Object, "spec", "volumeClaimTemplates")
+	if err != nil {
+		return errors.Wrap(err, "error getting spec.volumeClaimTemplates")
+	}
+	if !found {
+		return nil
+	}
+
+	for _, template := range templates {
+		templateMap, ok := template.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		storageClass, _, err := unstructured.NestedString(templateMap, "spec", "storageClassName")
+		if err != nil {
+			return errors.Wrap(err, "error getting storageClassName of volume claim template")
+		}
+
+		target, _, mapped, err := ctx.mapStorageClass(storageClass)
+		if err != nil {
+			return err
+		}
+		if !mapped {
+			continue
+		}
+
+		ctx.log.Infof("Updating storage class of volume claim templates of stateful set %s/%s from %s to %s", obj.GetNamespace(), obj.GetName(), storageClass, target)
+		if err := unstructured.SetNestedField(templateMap, target, "spec", "storageClassName"); err != nil {
+			return errors.Wrap(err, "error setting storageClassName of volume claim template")
+		}
+	}
+
+	return unstructured.SetNestedSlice(obj.Object, templates, "spec", "volumeClaimTemplates")
+}
@@ -0,0 +1,128 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	storagev1api "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestApplyTopologyMappings(t *testing.T) {
+	storageClass := &storagev1api.StorageClass{
+		ObjectMeta:  metav1.ObjectMeta{Name: "gp3"},
+		Provisioner: "ebs.csi.aws.com",
+	}
+
+	tests := []struct {
+		name          string
+		restore       *velerov1api.Restore
+		groupResource schema.GroupResource
+		item          string
+		expected      string
+		expectedErr   string
+	}{
+		{
+			name:          "no mappings",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+			groupResource: kuberesource.PersistentVolumes,
+			item:          `{"apiVersion":"v1","kind":"PersistentVolume","metadata":{"name":"pv-1"},"spec":{"storageClassName":"standard"}}`,
+			expected:      `{"apiVersion":"v1","kind":"PersistentVolume","metadata":{"name":"pv-1"},"spec":{"storageClassName":"standard"}}`,
+		},
+		{
+			name:          "storage class, CSI driver and zones of PV are mapped",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").StorageClassMappings("standard", "gp3").ZoneMappings("zone-a", "us-east-1a").Result(),
+			groupResource: kuberesource.PersistentVolumes,
+			item: `{"apiVersion":"v1","kind":"PersistentVolume","metadata":{"name":"pv-1","annotations":{"pv.kubernetes.io/provisioned-by":"pd.csi.storage.gke.io"},"labels":{"topology.kubernetes.io/zone":"zone-a","app":"zone-a"}},
+				"spec":{"storageClassName":"standard","csi":{"driver":"pd.csi.storage.gke.io","volumeHandle":"vol-1"},
+				"nodeAffinity":{"required":{"nodeSelectorTerms":[{"matchExpressions":[{"key":"topology.gke.io/zone","operator":"In","values":["zone-a","zone-b"]},{"key":"kubernetes.io/hostname","operator":"In","values":["zone-a"]}]}]}}}}`,
+			expected: `{"apiVersion":"v1","kind":"PersistentVolume","metadata":{"name":"pv-1","annotations":{"pv.kubernetes.io/provisioned-by":"ebs.csi.aws.com"},"labels":{"topology.kubernetes.io/zone":"us-east-1a","app":"zone-a"}},
+				"spec":{"storageClassName":"gp3","csi":{"driver":"ebs.csi.aws.com","volumeHandle":"vol-1"},
+				"nodeAffinity":{"required":{"nodeSelectorTerms":[{"matchExpressions":[{"key":"topology.gke.io/zone","operator":"In","values":["us-east-1a","zone-b"]},{"key":"kubernetes.io/hostname","operator":"In","values":["zone-a"]}]}]}}}}`,
+		},
+		{
+			name:          "PV with unmapped storage class isn't changed",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").StorageClassMappings("standard", "gp3").Result(),
+			groupResource: kuberesource.PersistentVolumes,
+			item:          `{"apiVersion":"v1","kind":"PersistentVolume","metadata":{"name":"pv-1"},"spec":{"storageClassName":"premium","csi":{"driver":"pd.csi.storage.gke.io"}}}`,
+			expected:      `{"apiVersion":"v1","kind":"PersistentVolume","metadata":{"name":"pv-1"},"spec":{"storageClassName":"premium","csi":{"driver":"pd.csi.storage.gke.io"}}}`,
+		},
+		{
+			name:          "storage class and provisioner of PVC are mapped",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").StorageClassMappings("standard", "gp3").Result(),
+			groupResource: kuberesource.PersistentVolumeClaims,
+			item:          `{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"name":"pvc-1","namespace":"ns-1","annotations":{"volume.kubernetes.io/storage-provisioner":"pd.csi.storage.gke.io"}},"spec":{"storageClassName":"standard"}}`,
+			expected:      `{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"name":"pvc-1","namespace":"ns-1","annotations":{"volume.kubernetes.io/storage-provisioner":"ebs.csi.aws.com"}},"spec":{"storageClassName":"gp3"}}`,
+		},
+		{
+			name:          "deprecated storage class annotation of PVC is mapped",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").StorageClassMappings("standard", "gp3").Result(),
+			groupResource: kuberesource.PersistentVolumeClaims,
+			item:          `{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"name":"pvc-1","namespace":"ns-1","annotations":{"volume.beta.kubernetes.io/storage-class":"standard"}},"spec":{}}`,
+			expected:      `{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"name":"pvc-1","namespace":"ns-1","annotations":{"volume.beta.kubernetes.io/storage-class":"gp3"}},"spec":{}}`,
+		},
+		{
+			name:          "storage class of StatefulSet volume claim templates is mapped",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").StorageClassMappings("standard", "gp3").Result(),
+			groupResource: kuberesource.StatefulSets,
+			item:          `{"apiVersion":"apps/v1","kind":"StatefulSet","metadata":{"name":"sts-1","namespace":"ns-1"},"spec":{"volumeClaimTemplates":[{"spec":{"storageClassName":"standard"}},{"spec":{"storageClassName":"premium"}}]}}`,
+			expected:      `{"apiVersion":"apps/v1","kind":"StatefulSet","metadata":{"name":"sts-1","namespace":"ns-1"},"spec":{"volumeClaimTemplates":[{"spec":{"storageClassName":"gp3"}},{"spec":{"storageClassName":"premium"}}]}}`,
+		},
+		{
+			name:          "target storage class doesn't exist",
+			restore:       builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").StorageClassMappings("standard", "gp2").Result(),
+			groupResource: kuberesource.PersistentVolumeClaims,
+			item:          `{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"name":"pvc-1","namespace":"ns-1"},"spec":{"storageClassName":"standard"}}`,
+			expectedErr:   `error getting storage class gp2: storageclasses.storage.k8s.io "gp2" not found`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := &restoreContext{
+				restore:                  test.restore,
+				kbClient:                 velerotest.NewFakeControllerRuntimeClient(t, storageClass),
+				storageClassProvisioners: make(map[string]string),
+				log:                      velerotest.NewLogger(),
+			}
+
+			obj := &unstructured.Unstructured{}
+			require.NoError(t, obj.UnmarshalJSON([]byte(test.item)))
+
+			err := ctx.applyTopologyMappings(obj, test.groupResource)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			expected := &unstructured.Unstructured{}
+			require.NoError(t, expected.UnmarshalJSON([]byte(test.expected)))
+			assert.Equal(t, expected, obj)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// volumeGroupMembers collects the PVCs of the volume groups in a namespace of the backup, whose
+// snapshots were taken together by a VolumeGroupSnapshot.
+type volumeGroupMembers struct {
+	labelKey string
+	// members are the PVCs of the groups keyed by the group
+	members map[string][]restoreableItem
+	// groups are the groups of the PVCs keyed by the PVC names
+	groups map[string]string
+}
+
+func newVolumeGroupMembers(labelKey string) *volumeGroupMembers {
+	return &volumeGroupMembers{
+		labelKey: labelKey,
+		members:  map[string][]restoreableItem{},
+		groups:   map[string]string{},
+	}
+}
+
+// add records the PVC if it belongs to a volume group, whether it's selected by the restore or not.
+func (m *volumeGroupMembers) add(obj *unstructured.Unstructured, path, name string) {
+	if m == nil {
+		return
+	}
+
+	group := obj.GetLabels()[m.labelKey]
+	if group == "" {
+		return
+	}
+
+	m.groups[name] = group
+	m.members[group] = append(m.members[group], restoreableItem{
+		path:    path,
+		name:    name,
+		version: obj.GroupVersionKind().Version,
+	})
+}
+
+// restoreTogether returns the selected items with the other PVCs of their volume groups, the PVCs
+// of a group are restored one after another at the position of the first selected one.
+func (m *volumeGroupMembers) restoreTogether(log logrus.FieldLogger, selected []restoreableItem, targetNamespace string) []restoreableItem {
+	selectedNames := sets.NewString()
+	for _, item := range selected {
+		selectedNames.Insert(item.name)
+	}
+
+	var items []restoreableItem
+	restoredGroups := sets.NewString()
+	for _, item := range selected {
+		group, ok := m.groups[item.name]
+		if !ok {
+			items = append(items, item)
+			continue
+		}
+
+		if restoredGroups.Has(group) {
+			continue
+		}
+		restoredGroups.Insert(group)
+
+		for _, member := range m.members[group] {
+			if !selectedNames.Has(member.name) {
+				log.Infof("Restoring PVC %s because it's in volume group %s with PVC %s", member.name, group, item.name)
+			}
+			member.targetNamespace = targetNamespace
+			items = append(items, member)
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,172 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package secretpolicy implements the policy of excluding or redacting the Secrets of the
+// auto-generated types at backup time, the policy is configured by a configmap in the Velero
+// namespace.
+package secretpolicy
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// the keys of the secret policy configmap
+	keyExcludedTypes = "excludedTypes"
+	keyRedactedTypes = "redactedTypes"
+
+	// RedactedAnnotation is added to the Secrets whose data is stripped by the policy, so that
+	// it's clear the restored Secrets are empty on purpose.
+	RedactedAnnotation = "velero.io/secret-redacted"
+)
+
+// DefaultExcludedTypes are the types of the Secrets excluded if the configmap doesn't set the
+// excluded types, they're generated by Kubernetes and useless or harmful in another cluster.
+// The Helm release Secrets aren't excluded by default, since Helm can't manage the restored
+// releases without them.
+var DefaultExcludedTypes = []string{
+	string(corev1api.SecretTypeServiceAccountToken),
+	string(corev1api.SecretTypeBootstrapToken),
+}
+
+// Action is what the policy does with a Secret.
+type Action string
+
+const (
+	// ActionNone backs up the Secret as it is.
+	ActionNone Action = ""
+	// ActionExclude excludes the Secret from the backup.
+	ActionExclude Action = "exclude"
+	// ActionRedact backs up the Secret without its data.
+	ActionRedact Action = "redact"
+)
+
+// Policy is the secret policy. A nil policy backs up all the Secrets as they are.
+type Policy struct {
+	excludedTypes sets.String
+	redactedTypes sets.String
+}
+
+// Get returns the secret policy from the configmap in the namespace. Nil is returned if the
+// configmap name is empty, an error is returned if the configmap doesn't exist.
+func Get(ctx context.Context, cli client.Client, namespace, configMapName string) (*Policy, error) {
+	if configMapName == "" {
+		return nil, nil
+	}
+
+	configMap := &corev1api.ConfigMap{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: configMapName}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, errors.Errorf("secret policy configmap %s/%s doesn't exist", namespace, configMapName)
+		}
+		return nil, errors.Wrapf(err, "error getting secret policy configmap %s/%s", namespace, configMapName)
+	}
+
+	policy, err := parse(configMap.Data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid secret policy configmap %s/%s", namespace, configMapName)
+	}
+
+	return policy, nil
+}
+
+func parse(data map[string]string) (*Policy, error) {
+	excludedTypes := DefaultExcludedTypes
+	if excluded, ok := data[keyExcludedTypes]; ok {
+		excludedTypes = parseTypes(excluded)
+	}
+	return New(excludedTypes, parseTypes(data[keyRedactedTypes]))
+}
+
+// New returns the policy excluding and redacting the Secrets of the types.
+func New(excludedTypes, redactedTypes []string) (*Policy, error) {
+	policy := &Policy{
+		excludedTypes: sets.NewString(excludedTypes...),
+		redactedTypes: sets.NewString(redactedTypes...),
+	}
+
+	if both := policy.excludedTypes.Intersection(policy.redactedTypes); both.Len() > 0 {
+		return nil, errors.Errorf("types %s are both excluded and redacted", strings.Join(both.List(), ","))
+	}
+
+	return policy, nil
+}
+
+// parseTypes parses the types separated by commas or newlines.
+func parseTypes(value string) []string {
+	var types []string
+	for _, t := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '\n' }) {
+		if t = strings.TrimSpace(t); t != "" {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+// ActionFor returns the action of the policy on the Secret of the type.
+func (p *Policy) ActionFor(secretType string) Action {
+	if p == nil {
+		return ActionNone
+	}
+
+	// the Secrets without a type are opaque
+	if secretType == "" {
+		secretType = string(corev1api.SecretTypeOpaque)
+	}
+
+	switch {
+	case p.excludedTypes.Has(secretType):
+		return ActionExclude
+	case p.redactedTypes.Has(secretType):
+		return ActionRedact
+	default:
+		return ActionNone
+	}
+}
+
+// SecretType returns the type of the Secret item.
+func SecretType(item runtime.Unstructured) string {
+	secretType, _, _ := unstructured.NestedString(item.UnstructuredContent(), "type")
+	return secretType
+}
+
+// Redact strips the data of the Secret item, and marks it by the redacted annotation. The
+// last applied configuration annotation of kubectl is stripped too, as it contains the data.
+func Redact(item runtime.Unstructured) error {
+	content := item.UnstructuredContent()
+	unstructured.RemoveNestedField(content, "data")
+	unstructured.RemoveNestedField(content, "stringData")
+
+	annotations, _, err := unstructured.NestedStringMap(content, "metadata", "annotations")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	delete(annotations, corev1api.LastAppliedConfigAnnotation)
+	annotations[RedactedAnnotation] = "true"
+	return errors.WithStack(unstructured.SetNestedStringMap(content, annotations, "metadata", "annotations"))
+}
@@ -0,0 +1,142 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGet(t *testing.T) {
+	configMap := &corev1api.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "secret-policy"},
+		Data: map[string]string{
+			"redactedTypes": "helm.sh/release.v1",
+		},
+	}
+	cli := velerotest.NewFakeControllerRuntimeClient(t, configMap)
+
+	policy, err := Get(context.Background(), cli, "velero", "")
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+
+	_, err = Get(context.Background(), cli, "velero", "not-exist")
+	require.EqualError(t, err, "secret policy configmap velero/not-exist doesn't exist")
+
+	policy, err = Get(context.Background(), cli, "velero", "secret-policy")
+	require.NoError(t, err)
+	assert.Equal(t, ActionExclude, policy.ActionFor(string(corev1api.SecretTypeServiceAccountToken)))
+	assert.Equal(t, ActionRedact, policy.ActionFor("helm.sh/release.v1"))
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        map[string]string
+		expected    map[string]Action
+		expectedErr string
+	}{
+		{
+			name: "default excluded types",
+			data: map[string]string{},
+			expected: map[string]Action{
+				string(corev1api.SecretTypeServiceAccountToken): ActionExclude,
+				string(corev1api.SecretTypeBootstrapToken):      ActionExclude,
+				string(corev1api.SecretTypeOpaque):              ActionNone,
+				"":                                              ActionNone,
+			},
+		},
+		{
+			name: "excluded types overridden",
+			data: map[string]string{"excludedTypes": "Opaque,\n helm.sh/release.v1\n"},
+			expected: map[string]Action{
+				string(corev1api.SecretTypeServiceAccountToken): ActionNone,
+				string(corev1api.SecretTypeOpaque):              ActionExclude,
+				"helm.sh/release.v1":                            ActionExclude,
+				"":                                              ActionExclude,
+			},
+		},
+		{
+			name: "nothing excluded",
+			data: map[string]string{"excludedTypes": "", "redactedTypes": "kubernetes.io/service-account-token"},
+			expected: map[string]Action{
+				string(corev1api.SecretTypeServiceAccountToken): ActionRedact,
+				string(corev1api.SecretTypeBootstrapToken):      ActionNone,
+			},
+		},
+		{
+			name:        "both excluded and redacted",
+			data:        map[string]string{"redactedTypes": "bootstrap.kubernetes.io/token"},
+			expectedErr: "types bootstrap.kubernetes.io/token are both excluded and redacted",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policy, err := parse(test.data)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			for secretType, action := range test.expected {
+				assert.Equal(t, action, policy.ActionFor(secretType), secretType)
+			}
+		})
+	}
+}
+
+func TestNilPolicy(t *testing.T) {
+	var policy *Policy
+	assert.Equal(t, ActionNone, policy.ActionFor(string(corev1api.SecretTypeServiceAccountToken)))
+}
+
+func TestRedact(t *testing.T) {
+	item := &unstructured.Unstructured{
+		Object: map[string]any{
+			"metadata": map[string]any{
+				"name": "secret-1",
+				"annotations": map[string]any{
+					"foo":                                 "bar",
+					corev1api.LastAppliedConfigAnnotation: `{"apiVersion":"v1","kind":"Secret","stringData":{"release":"fake"}}`,
+				},
+			},
+			"type":       "helm.sh/release.v1",
+			"data":       map[string]any{"release": "ZmFrZQ=="},
+			"stringData": map[string]any{"release": "fake"},
+		},
+	}
+
+	require.NoError(t, Redact(item))
+	assert.Equal(t, "helm.sh/release.v1", SecretType(item))
+	assert.Equal(t, map[string]any{
+		"metadata": map[string]any{
+			"name":        "secret-1",
+			"annotations": map[string]any{"foo": "bar", RedactedAnnotation: "true"},
+		},
+		"type": "helm.sh/release.v1",
+	}, item.Object)
+}
@@ -153,6 +153,17 @@ func ConfigMaps(items ...metav1.Object) *APIResource {
 	}
 }
 
+func StorageClasses(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "storage.k8s.io",
+		Version:    "v1",
+		Name:       "storageclasses",
+		ShortName:  "sc",
+		Namespaced: false,
+		Items:      items,
+	}
+}
+
 func CRDs(items ...metav1.Object) *APIResource {
 	return &APIResource{
 		Group:      "apiextensions.k8s.io",
@@ -204,3 +215,13 @@ func DataUploads(items ...metav1.Object) *APIResource {
 		Items:      items,
 	}
 }
+
+func APIServices(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "apiregistration.k8s.io",
+		Version:    "v1",
+		Name:       "apiservices",
+		Namespaced: false,
+		Items:      items,
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"context"
+
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/manifest"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/pkg/kopia"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+)
+
+// Replicate copies the snapshot with given snapshotID from the source repository to the target repository. The
+// snapshot tree is uploaded to the target repository with the same source and tags as the original snapshot, so
+// the previous replicas of the same source are used as the parents and only the changed data is uploaded
+func Replicate(ctx context.Context, fsUploader SnapshotUploader, sourceRep repo.Repository, targetRep repo.RepositoryWriter,
+	snapshotID string, log logrus.FieldLogger) (*uploader.SnapshotInfo, error) {
+	if fsUploader == nil {
+		return nil, errors.New("get empty kopia uploader")
+	}
+
+	log.Info("Start to replicate...")
+
+	kopiaCtx := kopia.SetupKopiaLog(ctx, log)
+
+	snapshot, err := loadSnapshotFunc(kopiaCtx, sourceRep, manifest.ID(snapshotID))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to load snapshot %v", snapshotID)
+	}
+
+	log.Infof("Replicate snapshot %s, description %s, created time %v, tags %v", snapshotID, snapshot.Description, snapshot.EndTime.ToTime(), snapshot.Tags)
+
+	rootEntry, err := filesystemEntryFunc(kopiaCtx, sourceRep, snapshotID, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get filesystem entry for snapshot %v", snapshotID)
+	}
+
+	snapID, snapshotSize, err := SnapshotSource(kopiaCtx, targetRep, fsUploader, snapshot.Source, rootEntry, false, "", snapshot.Tags, log, snapshot.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return &uploader.SnapshotInfo{
+		ID:   snapID,
+		Size: snapshotSize,
+	}, nil
+}
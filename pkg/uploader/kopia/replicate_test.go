@@ -0,0 +1,132 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kopia/kopia/fs"
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/snapshot"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+)
+
+func TestReplicate(t *testing.T) {
+	originalListSnapshotsFunc, originalFilesystemEntryFunc := listSnapshotsFunc, filesystemEntryFunc
+	defer func() {
+		listSnapshotsFunc, filesystemEntryFunc = originalListSnapshotsFunc, originalFilesystemEntryFunc
+	}()
+
+	sourceInfo := snapshot.SourceInfo{UserName: "default", Host: "default", Path: "/fake-path"}
+	tags := map[string]string{uploader.SnapshotRequesterTag: "data-mover", uploader.SnapshotUploaderTag: uploader.KopiaType}
+	source := &snapshot.Manifest{ID: "snapshot-1", Source: sourceInfo, Tags: tags, Description: "Kopia Uploader", RootEntry: &snapshot.DirEntry{}}
+	replica := &snapshot.Manifest{ID: "replica-1", RootEntry: &snapshot.DirEntry{}}
+	root := newFakeDirectory("", nil, newFakeFile("file-1", 10, nil, nil))
+
+	tests := []struct {
+		name            string
+		args            []mockArgs
+		filesystemEntry error
+		expectedErr     string
+	}{
+		{
+			name: "snapshot isn't found",
+			args: []mockArgs{
+				{methodName: "LoadSnapshot", returns: []interface{}{nil, errors.New("manifest not found")}},
+				{methodName: "SaveSnapshot", returns: []interface{}{replica.ID, nil}},
+				{methodName: "TreeForSource", returns: []interface{}{nil, nil}},
+				{methodName: "ApplyRetentionPolicy", returns: []interface{}{nil, nil}},
+				{methodName: "SetPolicy", returns: []interface{}{nil}},
+				{methodName: "Upload", returns: []interface{}{replica, nil}},
+				{methodName: "Flush", returns: []interface{}{nil}},
+			},
+			expectedErr: "Unable to load snapshot snapshot-1: manifest not found",
+		},
+		{
+			name: "snapshot tree can't be read",
+			args: []mockArgs{
+				{methodName: "LoadSnapshot", returns: []interface{}{source, nil}},
+				{methodName: "SaveSnapshot", returns: []interface{}{replica.ID, nil}},
+				{methodName: "TreeForSource", returns: []interface{}{nil, nil}},
+				{methodName: "ApplyRetentionPolicy", returns: []interface{}{nil, nil}},
+				{methodName: "SetPolicy", returns: []interface{}{nil}},
+				{methodName: "Upload", returns: []interface{}{replica, nil}},
+				{methodName: "Flush", returns: []interface{}{nil}},
+			},
+			filesystemEntry: errors.New("object not found"),
+			expectedErr:     "Unable to get filesystem entry for snapshot snapshot-1: object not found",
+		},
+		{
+			name: "upload to the target repository fails",
+			args: []mockArgs{
+				{methodName: "LoadSnapshot", returns: []interface{}{source, nil}},
+				{methodName: "SaveSnapshot", returns: []interface{}{replica.ID, nil}},
+				{methodName: "TreeForSource", returns: []interface{}{nil, nil}},
+				{methodName: "ApplyRetentionPolicy", returns: []interface{}{nil, nil}},
+				{methodName: "SetPolicy", returns: []interface{}{nil}},
+				{methodName: "Upload", returns: []interface{}{replica, errors.New("storage unavailable")}},
+				{methodName: "Flush", returns: []interface{}{nil}},
+			},
+			expectedErr: "Failed to upload the kopia snapshot for si default@default:/fake-path: storage unavailable",
+		},
+		{
+			name: "snapshot is replicated",
+			args: []mockArgs{
+				{methodName: "LoadSnapshot", returns: []interface{}{source, nil}},
+				{methodName: "SaveSnapshot", returns: []interface{}{replica.ID, nil}},
+				{methodName: "TreeForSource", returns: []interface{}{nil, nil}},
+				{methodName: "ApplyRetentionPolicy", returns: []interface{}{nil, nil}},
+				{methodName: "SetPolicy", returns: []interface{}{nil}},
+				{methodName: "Upload", returns: []interface{}{replica, nil}},
+				{methodName: "Flush", returns: []interface{}{nil}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := injectSnapshotFuncs()
+			MockFuncs(s, tc.args)
+			listSnapshotsFunc = func(ctx context.Context, rep repo.Repository, si snapshot.SourceInfo) ([]*snapshot.Manifest, error) {
+				return nil, nil
+			}
+			filesystemEntryFunc = func(ctx context.Context, rep repo.Repository, rootID string, consistentAttributes bool) (fs.Entry, error) {
+				return root, tc.filesystemEntry
+			}
+
+			snapshotInfo, err := Replicate(context.Background(), s.uploderMock, nil, s.repoWriterMock, "snapshot-1", logrus.New())
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "replica-1", snapshotInfo.ID)
+
+			// the replica is uploaded with the source and the tags of the original snapshot
+			s.uploderMock.AssertCalled(t, "Upload", mock.Anything, root, mock.Anything, sourceInfo, mock.Anything)
+			assert.Equal(t, tags, replica.Tags)
+			assert.Equal(t, "Kopia Uploader", replica.Description)
+		})
+	}
+}
@@ -270,6 +270,13 @@ func SnapshotSource(
 
 	manifest.Tags = snapshotTags
 
+	if manifest.IncompleteReason != "" {
+		// the upload is canceled, e.g., the data movement is paused, save what has been uploaded as an incomplete
+		// snapshot, so that the data doesn't need to be uploaded again when the data movement is resumed
+		saveIncompleteSnapshot(ctx, rep, manifest, log)
+		return "", 0, errors.Errorf("kopia snapshot for si %v is incomplete, reason %s", sourceInfo, manifest.IncompleteReason)
+	}
+
 	manifest.Description = description
 	manifest.Pins = []string{"velero-pin"}
 
@@ -284,6 +291,23 @@ func SnapshotSource(
 	return reportSnapshotStatus(manifest, policyTree)
 }
 
+// saveIncompleteSnapshot saves the incomplete snapshot, the context may be canceled, so the snapshot is saved without cancelation.
+func saveIncompleteSnapshot(ctx context.Context, rep repo.RepositoryWriter, manifest *snapshot.Manifest, log logrus.FieldLogger) {
+	saveCtx := context.WithoutCancel(ctx)
+
+	if _, err := saveSnapshotFunc(saveCtx, rep, manifest); err != nil {
+		log.WithError(err).Warnf("Failed to save incomplete kopia manifest, reason %s", manifest.IncompleteReason)
+		return
+	}
+
+	if err := rep.Flush(saveCtx); err != nil {
+		log.WithError(err).Warn("Failed to flush kopia repository for incomplete snapshot")
+		return
+	}
+
+	log.Infof("Saved incomplete snapshot with root %v and ID %v, reason %s", manifest.RootObjectID(), manifest.ID, manifest.IncompleteReason)
+}
+
 func reportSnapshotStatus(manifest *snapshot.Manifest, policyTree *policy.Tree) (string, int64, error) {
 	manifestID := manifest.ID
 	snapSize := manifest.Stats.TotalFileSize
@@ -306,7 +330,8 @@ func reportSnapshotStatus(manifest *snapshot.Manifest, policyTree *policy.Tree)
 }
 
 // findPreviousSnapshotManifest returns the list of previous snapshots for a given source, including
-// last complete snapshot following it.
+// last complete snapshot and the last checkpoint or canceled snapshot following it, so that an
+// interrupted upload could be resumed.
 func findPreviousSnapshotManifest(ctx context.Context, rep repo.Repository, sourceInfo snapshot.SourceInfo, snapshotTags map[string]string, noLaterThan *fs.UTCTimestamp, log logrus.FieldLogger) ([]*snapshot.Manifest, error) {
 	man, err := listSnapshotsFunc(ctx, rep, sourceInfo)
 	if err != nil {
@@ -314,6 +339,7 @@ func findPreviousSnapshotManifest(ctx context.Context, rep repo.Repository, sour
 	}
 
 	var previousComplete *snapshot.Manifest
+	var previousIncomplete *snapshot.Manifest
 	var result []*snapshot.Manifest
 
 	for _, p := range man {
@@ -344,15 +370,28 @@ func findPreviousSnapshotManifest(ctx context.Context, rep repo.Repository, sour
 		if p.IncompleteReason == "" && (previousComplete == nil || p.StartTime.After(previousComplete.StartTime)) {
 			previousComplete = p
 		}
+
+		if isResumableSnapshot(p) && (previousIncomplete == nil || p.StartTime.After(previousIncomplete.StartTime)) {
+			previousIncomplete = p
+		}
 	}
 
 	if previousComplete != nil {
 		result = append(result, previousComplete)
 	}
 
+	if previousIncomplete != nil && (previousComplete == nil || previousIncomplete.StartTime.After(previousComplete.StartTime)) {
+		result = append(result, previousIncomplete)
+	}
+
 	return result, nil
 }
 
+// isResumableSnapshot returns true if the snapshot is left by an interrupted upload
+func isResumableSnapshot(man *snapshot.Manifest) bool {
+	return man.IncompleteReason == snapshotfs.IncompleteReasonCheckpoint || man.IncompleteReason == snapshotfs.IncompleteReasonCanceled
+}
+
 // Restore restore specific sourcePath with given snapshotID and update progress
 func Restore(ctx context.Context, rep repo.RepositoryWriter, progress *Progress, snapshotID, dest string, volMode uploader.PersistentVolumeMode,
 	log logrus.FieldLogger, cancleCh chan struct{}) (int64, int32, error) {
@@ -397,9 +436,13 @@ func Restore(ctx context.Context, rep repo.RepositoryWriter, progress *Progress,
 		}
 	}
 
+	// skip the files that have been restored completely, e.g., by a restore that was paused before
+	incremental := volMode != uploader.PersistentVolumeBlock
+
 	stat, err := restoreEntryFunc(kopiaCtx, rep, output, rootEntry, restore.Options{
 		Parallel:               runtime.NumCPU(),
 		RestoreDirEntryAtDepth: math.MaxInt32,
+		Incremental:            incremental,
 		Cancel:                 cancleCh,
 		ProgressCallback: func(ctx context.Context, stats restore.Stats) {
 			progress.ProgressBytes(stats.RestoredTotalFileSize, stats.EnqueuedTotalFileSize)
@@ -163,6 +163,19 @@ func TestSnapshotSource(t *testing.T) {
 			},
 			notError: false,
 		},
+		{
+			name: "upload is canceled",
+			args: []mockArgs{
+				{methodName: "LoadSnapshot", returns: []interface{}{manifest, nil}},
+				{methodName: "SaveSnapshot", returns: []interface{}{manifest.ID, nil}},
+				{methodName: "TreeForSource", returns: []interface{}{nil, nil}},
+				{methodName: "ApplyRetentionPolicy", returns: []interface{}{nil, nil}},
+				{methodName: "SetPolicy", returns: []interface{}{nil}},
+				{methodName: "Upload", returns: []interface{}{&snapshot.Manifest{ID: "test", RootEntry: &snapshot.DirEntry{}, IncompleteReason: snapshotfs.IncompleteReasonCanceled}, nil}},
+				{methodName: "Flush", returns: []interface{}{nil}},
+			},
+			notError: false,
+		},
 		{
 			name: "failed to flush repo",
 			args: []mockArgs{
@@ -493,6 +506,107 @@ func TestFindPreviousSnapshotManifest(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		// Checkpoint following the complete snapshot
+		{
+			name: "Checkpoint following the complete snapshot",
+			listSnapshotsFunc: func(ctx context.Context, rep repo.Repository, si snapshot.SourceInfo) ([]*snapshot.Manifest, error) {
+				return []*snapshot.Manifest{
+					{
+						Tags: map[string]string{
+							uploader.SnapshotRequesterTag: "user1",
+							uploader.SnapshotUploaderTag:  "uploader1",
+						},
+						StartTime: fs.UTCTimestampFromTime(time.Now().Add(-2 * time.Hour)),
+					},
+					{
+						Tags: map[string]string{
+							uploader.SnapshotRequesterTag: "user1",
+							uploader.SnapshotUploaderTag:  "uploader1",
+						},
+						StartTime:        fs.UTCTimestampFromTime(time.Now().Add(-time.Hour)),
+						IncompleteReason: snapshotfs.IncompleteReasonCheckpoint,
+					},
+				}, nil
+			},
+			expectedSnapshots: []*snapshot.Manifest{
+				{
+					Tags: map[string]string{
+						uploader.SnapshotRequesterTag: "user1",
+						uploader.SnapshotUploaderTag:  "uploader1",
+					},
+					StartTime: fs.UTCTimestampFromTime(time.Now().Add(-2 * time.Hour)),
+				},
+				{
+					Tags: map[string]string{
+						uploader.SnapshotRequesterTag: "user1",
+						uploader.SnapshotUploaderTag:  "uploader1",
+					},
+					StartTime:        fs.UTCTimestampFromTime(time.Now().Add(-time.Hour)),
+					IncompleteReason: snapshotfs.IncompleteReasonCheckpoint,
+				},
+			},
+			expectedError: nil,
+		},
+		// Canceled snapshot without complete snapshot
+		{
+			name: "Canceled snapshot without complete snapshot",
+			listSnapshotsFunc: func(ctx context.Context, rep repo.Repository, si snapshot.SourceInfo) ([]*snapshot.Manifest, error) {
+				return []*snapshot.Manifest{
+					{
+						Tags: map[string]string{
+							uploader.SnapshotRequesterTag: "user1",
+							uploader.SnapshotUploaderTag:  "uploader1",
+						},
+						StartTime:        fs.UTCTimestampFromTime(time.Now().Add(-time.Hour)),
+						IncompleteReason: snapshotfs.IncompleteReasonCanceled,
+					},
+				}, nil
+			},
+			expectedSnapshots: []*snapshot.Manifest{
+				{
+					Tags: map[string]string{
+						uploader.SnapshotRequesterTag: "user1",
+						uploader.SnapshotUploaderTag:  "uploader1",
+					},
+					StartTime:        fs.UTCTimestampFromTime(time.Now().Add(-time.Hour)),
+					IncompleteReason: snapshotfs.IncompleteReasonCanceled,
+				},
+			},
+			expectedError: nil,
+		},
+		// Checkpoint older than the complete snapshot
+		{
+			name: "Checkpoint older than the complete snapshot",
+			listSnapshotsFunc: func(ctx context.Context, rep repo.Repository, si snapshot.SourceInfo) ([]*snapshot.Manifest, error) {
+				return []*snapshot.Manifest{
+					{
+						Tags: map[string]string{
+							uploader.SnapshotRequesterTag: "user1",
+							uploader.SnapshotUploaderTag:  "uploader1",
+						},
+						StartTime:        fs.UTCTimestampFromTime(time.Now().Add(-2 * time.Hour)),
+						IncompleteReason: snapshotfs.IncompleteReasonCheckpoint,
+					},
+					{
+						Tags: map[string]string{
+							uploader.SnapshotRequesterTag: "user1",
+							uploader.SnapshotUploaderTag:  "uploader1",
+						},
+						StartTime: fs.UTCTimestampFromTime(time.Now().Add(-time.Hour)),
+					},
+				}, nil
+			},
+			expectedSnapshots: []*snapshot.Manifest{
+				{
+					Tags: map[string]string{
+						uploader.SnapshotRequesterTag: "user1",
+						uploader.SnapshotUploaderTag:  "uploader1",
+					},
+					StartTime: fs.UTCTimestampFromTime(time.Now().Add(-time.Hour)),
+				},
+			},
+			expectedError: nil,
+		},
 		// Snapshot with manifest SnapshotRequesterTag not found
 		{
 			name: "Snapshot with manifest SnapshotRequesterTag not found",
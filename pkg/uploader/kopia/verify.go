@@ -0,0 +1,160 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+
+	"github.com/kopia/kopia/fs"
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/manifest"
+	"github.com/kopia/kopia/repo/object"
+	"github.com/kopia/kopia/snapshot/snapshotfs"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/pkg/kopia"
+)
+
+// maxVerifyErrors is the maximum number of the errors reported by a verification,
+// the walking of the snapshot tree is stopped once so many directories can't be read
+const maxVerifyErrors = 100
+
+// snapshotVerifier checks the entries of a snapshot tree
+type snapshotVerifier struct {
+	progress     *Progress
+	filesPercent int
+	totalBytes   int64
+	// +checkatomic
+	verifiedBytes int64
+
+	mu        sync.Mutex
+	errors    []string
+	numErrors int
+}
+
+// Verify checks the integrity of the snapshot with given snapshotID without restoring it. All the directories of
+// the snapshot are read and all the files are opened from the repository, the contents of filesPercent percent of
+// the files are also read and validated. It returns the errors of the entries failed to be verified, which means
+// the snapshot is corrupted, and returns an error if the snapshot can't be verified
+func Verify(ctx context.Context, rep repo.Repository, progress *Progress, snapshotID string, filesPercent int, log logrus.FieldLogger) ([]string, error) {
+	log.Info("Start to verify...")
+
+	kopiaCtx := kopia.SetupKopiaLog(ctx, log)
+
+	snapshot, err := loadSnapshotFunc(kopiaCtx, rep, manifest.ID(snapshotID))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to load snapshot %v", snapshotID)
+	}
+
+	log.Infof("Verify snapshot %s, description %s, created time %v, tags %v", snapshotID, snapshot.Description, snapshot.EndTime.ToTime(), snapshot.Tags)
+
+	rootEntry, err := filesystemEntryFunc(kopiaCtx, rep, snapshotID, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get filesystem entry for snapshot %v", snapshotID)
+	}
+
+	v := &snapshotVerifier{
+		progress:     progress,
+		filesPercent: filesPercent,
+		totalBytes:   snapshot.Stats.TotalFileSize,
+	}
+
+	tw, err := snapshotfs.NewTreeWalker(kopiaCtx, snapshotfs.TreeWalkerOptions{
+		EntryCallback: v.verifyEntry,
+		MaxErrors:     maxVerifyErrors,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error to create tree walker")
+	}
+	defer tw.Close(kopiaCtx)
+
+	// the errors of the directories are reported by the tree walker, the errors of the files are recorded by the verifier
+	walkErr := tw.Process(kopiaCtx, rootEntry, "")
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	if walkErr != nil {
+		v.addError("", errors.Wrap(walkErr, "error reading directories"))
+	}
+
+	return v.result(), nil
+}
+
+func (v *snapshotVerifier) verifyEntry(ctx context.Context, entry fs.Entry, oid object.ID, entryPath string) error {
+	f, ok := entry.(fs.File)
+	if !ok || ctx.Err() != nil {
+		return nil
+	}
+
+	if err := v.verifyFile(ctx, f); err != nil {
+		v.addError(entryPath, err)
+	}
+
+	v.progress.ProgressBytes(atomic.AddInt64(&v.verifiedBytes, entry.Size()), v.totalBytes)
+
+	return nil
+}
+
+func (v *snapshotVerifier) verifyFile(ctx context.Context, f fs.File) error {
+	r, err := f.Open(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error opening file")
+	}
+	defer r.Close()
+
+	//nolint:gosec // the files to read are sampled, there is no security concern
+	if v.filesPercent >= 100 || rand.Intn(100) < v.filesPercent {
+		if _, err := io.Copy(io.Discard, r); err != nil {
+			return errors.Wrap(err, "error reading file")
+		}
+	}
+
+	return nil
+}
+
+func (v *snapshotVerifier) addError(entryPath string, err error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.numErrors++
+	if len(v.errors) < maxVerifyErrors {
+		if entryPath != "" {
+			v.errors = append(v.errors, fmt.Sprintf("%s: %v", entryPath, err))
+		} else {
+			v.errors = append(v.errors, err.Error())
+		}
+	}
+}
+
+func (v *snapshotVerifier) result() []string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.numErrors > len(v.errors) {
+		return append(v.errors, fmt.Sprintf("and %d more errors", v.numErrors-len(v.errors)))
+	}
+
+	return v.errors
+}
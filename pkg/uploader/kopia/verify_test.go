@@ -0,0 +1,213 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/kopia/kopia/fs"
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/manifest"
+	"github.com/kopia/kopia/repo/object"
+	"github.com/kopia/kopia/snapshot"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fakeObjectIDs int
+
+type fakeEntry struct {
+	name string
+	mode os.FileMode
+	size int64
+	oid  object.ID
+}
+
+func newFakeEntry(name string, mode os.FileMode, size int64) fakeEntry {
+	fakeObjectIDs++
+	oid, _ := object.ParseID(fmt.Sprintf("%032x", fakeObjectIDs))
+	return fakeEntry{name: name, mode: mode, size: size, oid: oid}
+}
+
+func (e fakeEntry) Name() string                { return e.name }
+func (e fakeEntry) Size() int64                 { return e.size }
+func (e fakeEntry) Mode() os.FileMode           { return e.mode }
+func (e fakeEntry) ModTime() time.Time          { return time.Time{} }
+func (e fakeEntry) IsDir() bool                 { return e.mode.IsDir() }
+func (e fakeEntry) Sys() interface{}            { return nil }
+func (e fakeEntry) Owner() fs.OwnerInfo         { return fs.OwnerInfo{} }
+func (e fakeEntry) Device() fs.DeviceInfo       { return fs.DeviceInfo{} }
+func (e fakeEntry) LocalFilesystemPath() string { return "" }
+func (e fakeEntry) Close()                      {}
+func (e fakeEntry) ObjectID() object.ID         { return e.oid }
+
+type fakeFile struct {
+	fakeEntry
+	openErr error
+	readErr error
+	read    *bool
+}
+
+type fakeReader struct {
+	io.ReadSeeker
+	file *fakeFile
+}
+
+func (r *fakeReader) Read(p []byte) (int, error) {
+	*r.file.read = true
+	if r.file.readErr != nil {
+		return 0, r.file.readErr
+	}
+	return r.ReadSeeker.Read(p)
+}
+
+func (r *fakeReader) Close() error             { return nil }
+func (r *fakeReader) Entry() (fs.Entry, error) { return r.file, nil }
+
+func newFakeFile(name string, size int64, openErr, readErr error) *fakeFile {
+	return &fakeFile{fakeEntry: newFakeEntry(name, 0644, size), openErr: openErr, readErr: readErr, read: new(bool)}
+}
+
+func (f *fakeFile) Open(ctx context.Context) (fs.Reader, error) {
+	if f.openErr != nil {
+		return nil, f.openErr
+	}
+	return &fakeReader{ReadSeeker: bytes.NewReader(make([]byte, f.size)), file: f}, nil
+}
+
+type fakeDirectory struct {
+	fakeEntry
+	children []fs.Entry
+	iterErr  error
+}
+
+func newFakeDirectory(name string, iterErr error, children ...fs.Entry) *fakeDirectory {
+	return &fakeDirectory{fakeEntry: newFakeEntry(name, os.ModeDir|0755, 0), children: children, iterErr: iterErr}
+}
+
+func (d *fakeDirectory) Child(ctx context.Context, name string) (fs.Entry, error) {
+	return fs.IterateEntriesAndFindChild(ctx, d, name)
+}
+
+func (d *fakeDirectory) Iterate(ctx context.Context) (fs.DirectoryIterator, error) {
+	if d.iterErr != nil {
+		return nil, d.iterErr
+	}
+	return fs.StaticIterator(d.children, nil), nil
+}
+
+func (d *fakeDirectory) SupportsMultipleIterations() bool { return true }
+
+func TestVerify(t *testing.T) {
+	originalLoadSnapshotFunc, originalFilesystemEntryFunc := loadSnapshotFunc, filesystemEntryFunc
+	defer func() {
+		loadSnapshotFunc, filesystemEntryFunc = originalLoadSnapshotFunc, originalFilesystemEntryFunc
+	}()
+
+	loadSnapshotFunc = func(ctx context.Context, rep repo.Repository, manifestID manifest.ID) (*snapshot.Manifest, error) {
+		if manifestID == "missing" {
+			return nil, errors.New("manifest not found")
+		}
+		return &snapshot.Manifest{Stats: snapshot.Stats{TotalFileSize: 30}}, nil
+	}
+
+	tests := []struct {
+		name           string
+		snapshotID     string
+		root           func() (fs.Entry, []*fakeFile)
+		filesPercent   int
+		expectedErrors []string
+		expectedRead   bool
+		expectedErr    string
+	}{
+		{
+			name:       "snapshot isn't found",
+			snapshotID: "missing",
+			root: func() (fs.Entry, []*fakeFile) {
+				return newFakeDirectory("", nil), nil
+			},
+			expectedErr: "Unable to load snapshot missing: manifest not found",
+		},
+		{
+			name:       "the metadata of all files is verified",
+			snapshotID: "snapshot-1",
+			root: func() (fs.Entry, []*fakeFile) {
+				files := []*fakeFile{newFakeFile("file-1", 10, nil, nil), newFakeFile("file-2", 20, nil, nil)}
+				return newFakeDirectory("", nil, files[0], newFakeDirectory("dir-1", nil, files[1])), files
+			},
+		},
+		{
+			name:       "the contents of all files are verified",
+			snapshotID: "snapshot-1",
+			root: func() (fs.Entry, []*fakeFile) {
+				files := []*fakeFile{newFakeFile("file-1", 10, nil, nil), newFakeFile("file-2", 20, nil, nil)}
+				return newFakeDirectory("", nil, files[0], newFakeDirectory("dir-1", nil, files[1])), files
+			},
+			filesPercent: 100,
+			expectedRead: true,
+		},
+		{
+			name:       "corrupted files and directories",
+			snapshotID: "snapshot-1",
+			root: func() (fs.Entry, []*fakeFile) {
+				return newFakeDirectory("", nil,
+					newFakeFile("file-1", 10, errors.New("content not found"), nil),
+					newFakeFile("file-2", 10, nil, errors.New("invalid checksum")),
+					newFakeFile("file-3", 10, nil, nil),
+					newFakeDirectory("dir-1", errors.New("object not found")),
+				), nil
+			},
+			filesPercent: 100,
+			expectedErrors: []string{
+				"file-1: error opening file: content not found",
+				"file-2: error reading file: invalid checksum",
+				"error reading directories: error reading directory: object not found",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			root, files := tc.root()
+			filesystemEntryFunc = func(ctx context.Context, rep repo.Repository, rootID string, consistentAttributes bool) (fs.Entry, error) {
+				return root, nil
+			}
+
+			progress := &Progress{Updater: &fakeProgressUpdater{}}
+
+			verifyErrors, err := Verify(context.Background(), nil, progress, tc.snapshotID, tc.filesPercent, logrus.New())
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.expectedErrors, verifyErrors)
+
+			for _, f := range files {
+				assert.Equal(t, tc.expectedRead, *f.read, f.name)
+			}
+		})
+	}
+}
@@ -19,12 +19,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"sync/atomic"
 
 	"github.com/kopia/kopia/snapshot/snapshotfs"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 
 	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/uploader/kopia"
@@ -39,15 +42,19 @@ import (
 // BackupFunc mainly used to make testing more convenient
 var BackupFunc = kopia.Backup
 var RestoreFunc = kopia.Restore
+var VerifyFunc = kopia.Verify
+var ReplicateFunc = kopia.Replicate
 var BackupRepoServiceCreateFunc = service.Create
 
 // kopiaProvider recorded info related with kopiaProvider
 type kopiaProvider struct {
-	requestorType string
-	bkRepo        udmrepo.BackupRepo
-	credGetter    *credentials.CredentialGetter
-	log           logrus.FieldLogger
-	canceling     int32
+	requestorType   string
+	bkRepo          udmrepo.BackupRepo
+	credGetter      *credentials.CredentialGetter
+	repoKey         *corev1api.SecretKeySelector
+	parallelUploads int
+	log             logrus.FieldLogger
+	canceling       int32
 }
 
 // NewKopiaUploaderProvider initialized with open or create a repository
@@ -56,19 +63,49 @@ func NewKopiaUploaderProvider(
 	ctx context.Context,
 	credGetter *credentials.CredentialGetter,
 	backupRepo *velerov1api.BackupRepository,
+	bandwidthLimits uploader.BandwidthLimits,
+	cacheOptions uploader.CacheOptions,
 	log logrus.FieldLogger,
 ) (Provider, error) {
 	kp := &kopiaProvider{
 		requestorType: requestorType,
 		log:           log,
 		credGetter:    credGetter,
+		repoKey:       repokeys.RepoKeySelectorForRepo(backupRepo),
 	}
+	genOptions := map[string]string{
+		udmrepo.ThrottleOptionUploadBytes:   strconv.FormatInt(bandwidthLimits.UploadBytesPerSecond, 10),
+		udmrepo.ThrottleOptionDownloadBytes: strconv.FormatInt(bandwidthLimits.DownloadBytesPerSecond, 10),
+	}
+	if cacheOptions.CacheDir != "" {
+		// each repo has its own cache under the cache dir
+		genOptions[udmrepo.GenOptionCacheDir] = filepath.Join(cacheOptions.CacheDir, string(backupRepo.GetUID()))
+	}
+	if cacheOptions.ContentCacheLimitBytes > 0 {
+		genOptions[udmrepo.GenOptionContentCacheLimitMB] = strconv.FormatInt(cacheOptions.ContentCacheLimitBytes>>20, 10)
+	}
+	if cacheOptions.MetadataCacheLimitBytes > 0 {
+		genOptions[udmrepo.GenOptionMetadataCacheLimitMB] = strconv.FormatInt(cacheOptions.MetadataCacheLimitBytes>>20, 10)
+	}
+	if cacheOptions.MinEvictionAge > 0 {
+		genOptions[udmrepo.GenOptionCacheMinEvictionAge] = cacheOptions.MinEvictionAge.String()
+	}
+	if params := backupRepo.Spec.KopiaParameters; params != nil {
+		if params.Compression != "" {
+			genOptions[udmrepo.GenOptionCompression] = params.Compression
+		}
+		if params.ParallelUploads != nil {
+			kp.parallelUploads = *params.ParallelUploads
+		}
+	}
+
 	//repoUID which is used to generate kopia repository config with unique directory path
 	repoUID := string(backupRepo.GetUID())
 	repoOpt, err := udmrepo.NewRepoOptions(
 		udmrepo.WithPassword(kp, ""),
 		udmrepo.WithConfigFile("", repoUID),
 		udmrepo.WithDescription("Initial kopia uploader provider"),
+		udmrepo.WithGenOptions(genOptions),
 	)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error to get repo options")
@@ -110,7 +147,7 @@ func (kp *kopiaProvider) Close(ctx context.Context) error {
 }
 
 // RunBackup which will backup specific path and update backup progress
-// return snapshotID, isEmptySnapshot, error
+// return snapshot info, isEmptySnapshot, error
 func (kp *kopiaProvider) RunBackup(
 	ctx context.Context,
 	path string,
@@ -119,13 +156,13 @@ func (kp *kopiaProvider) RunBackup(
 	forceFull bool,
 	parentSnapshot string,
 	volMode uploader.PersistentVolumeMode,
-	updater uploader.ProgressUpdater) (string, bool, error) {
+	updater uploader.ProgressUpdater) (*uploader.SnapshotInfo, bool, error) {
 	if updater == nil {
-		return "", false, errors.New("Need to initial backup progress updater first")
+		return nil, false, errors.New("Need to initial backup progress updater first")
 	}
 
 	if path == "" {
-		return "", false, errors.New("path is empty")
+		return nil, false, errors.New("path is empty")
 	}
 
 	log := kp.log.WithFields(logrus.Fields{
@@ -135,6 +172,8 @@ func (kp *kopiaProvider) RunBackup(
 	})
 	repoWriter := kopia.NewShimRepo(kp.bkRepo)
 	kpUploader := snapshotfs.NewUploader(repoWriter)
+	// the number of CPUs is used if it's not set
+	kpUploader.ParallelUploads = kp.parallelUploads
 	progress := new(kopia.Progress)
 	progress.InitThrottle(backupProgressCheckInterval)
 	progress.Updater = updater
@@ -154,6 +193,9 @@ func (kp *kopiaProvider) RunBackup(
 	tags[uploader.SnapshotRequesterTag] = kp.requestorType
 	tags[uploader.SnapshotUploaderTag] = uploader.KopiaType
 
+	// label the checkpoints with the same tags, so they could be found as the parent when the backup is resumed
+	kpUploader.CheckpointLabels = tags
+
 	if realSource != "" {
 		realSource = fmt.Sprintf("%s/%s/%s", kp.requestorType, uploader.KopiaType, realSource)
 	}
@@ -162,15 +204,15 @@ func (kp *kopiaProvider) RunBackup(
 	if err != nil {
 		if kpUploader.IsCanceled() {
 			log.Error("Kopia backup is canceled")
-			return "", false, ErrorCanceled
+			return nil, false, ErrorCanceled
 		} else {
-			return "", false, errors.Wrapf(err, "Failed to run kopia backup")
+			return nil, false, errors.Wrapf(err, "Failed to run kopia backup")
 		}
 	} else if isSnapshotEmpty {
 		log.Debugf("Kopia backup got empty dir with path %s", path)
-		return "", true, nil
+		return nil, true, nil
 	} else if snapshotInfo == nil {
-		return "", false, fmt.Errorf("failed to get kopia backup snapshot info for path %v", path)
+		return nil, false, fmt.Errorf("failed to get kopia backup snapshot info for path %v", path)
 	}
 
 	// which ensure that the statistic data of TotalBytes equal to BytesDone when finished
@@ -181,15 +223,17 @@ func (kp *kopiaProvider) RunBackup(
 		},
 	)
 
-	log.Debugf("Kopia backup finished, snapshot ID %s, backup size %d", snapshotInfo.ID, snapshotInfo.Size)
-	return snapshotInfo.ID, false, nil
+	snapshotInfo.UploadedBytes = kp.bkRepo.UploadedBytes()
+
+	log.Debugf("Kopia backup finished, snapshot ID %s, backup size %d, uploaded size %d", snapshotInfo.ID, snapshotInfo.Size, snapshotInfo.UploadedBytes)
+	return snapshotInfo, false, nil
 }
 
 func (kp *kopiaProvider) GetPassword(param interface{}) (string, error) {
 	if kp.credGetter.FromSecret == nil {
 		return "", errors.New("invalid credentials interface")
 	}
-	rawPass, err := kp.credGetter.FromSecret.Get(repokeys.RepoKeySelector())
+	rawPass, err := kp.credGetter.FromSecret.Get(kp.repoKey)
 	if err != nil {
 		return "", errors.Wrap(err, "error to get password")
 	}
@@ -249,3 +293,96 @@ func (kp *kopiaProvider) RunRestore(
 
 	return nil
 }
+
+// RunVerify which will verify specific snapshot and update verify progress
+// return the errors of the corrupted entries of the snapshot
+func (kp *kopiaProvider) RunVerify(
+	ctx context.Context,
+	snapshotID string,
+	filesPercent int,
+	updater uploader.ProgressUpdater) ([]string, error) {
+	log := kp.log.WithFields(logrus.Fields{
+		"snapshotID":   snapshotID,
+		"filesPercent": filesPercent,
+	})
+
+	repoWriter := kopia.NewShimRepo(kp.bkRepo)
+	progress := new(kopia.Progress)
+	progress.InitThrottle(restoreProgressCheckInterval)
+	progress.Updater = updater
+
+	log.Info("Starting verify")
+
+	verifyErrors, err := VerifyFunc(ctx, repoWriter, progress, snapshotID, filesPercent, log)
+	if ctx.Err() != nil {
+		log.Error("Kopia verify is canceled")
+		return nil, ErrorCanceled
+	}
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to run kopia verify")
+	}
+
+	log.Infof("Kopia verify finished, %d errors found", len(verifyErrors))
+
+	return verifyErrors, nil
+}
+
+// RunReplicate which will replicate specific snapshot to the repository of the target provider and update
+// replicate progress, return the snapshot ID of the replica
+func (kp *kopiaProvider) RunReplicate(
+	ctx context.Context,
+	snapshotID string,
+	target Provider,
+	updater uploader.ProgressUpdater) (string, error) {
+	if updater == nil {
+		return "", errors.New("Need to initial replicate progress updater first")
+	}
+
+	targetProvider, ok := target.(*kopiaProvider)
+	if !ok {
+		return "", errors.New("the target of the replication isn't a kopia uploader provider")
+	}
+
+	log := kp.log.WithFields(logrus.Fields{
+		"snapshotID": snapshotID,
+	})
+
+	sourceRepo := kopia.NewShimRepo(kp.bkRepo)
+	targetRepoWriter := kopia.NewShimRepo(targetProvider.bkRepo)
+	kpUploader := snapshotfs.NewUploader(targetRepoWriter)
+	// the number of CPUs is used if it's not set
+	kpUploader.ParallelUploads = targetProvider.parallelUploads
+	progress := new(kopia.Progress)
+	progress.InitThrottle(backupProgressCheckInterval)
+	progress.Updater = updater
+	progress.Log = log
+	kpUploader.Progress = progress
+	quit := make(chan struct{})
+	log.Info("Starting replicate")
+	go kp.CheckContext(ctx, quit, nil, kpUploader)
+
+	defer func() {
+		close(quit)
+	}()
+
+	snapshotInfo, err := ReplicateFunc(ctx, kpUploader, sourceRepo, targetRepoWriter, snapshotID, log)
+	if err != nil {
+		if kpUploader.IsCanceled() {
+			log.Error("Kopia replicate is canceled")
+			return "", ErrorCanceled
+		}
+		return "", errors.Wrapf(err, "Failed to run kopia replicate")
+	}
+
+	// which ensure that the statistic data of TotalBytes equal to BytesDone when finished
+	updater.UpdateProgress(
+		&uploader.Progress{
+			TotalBytes: snapshotInfo.Size,
+			BytesDone:  snapshotInfo.Size,
+		},
+	)
+
+	log.Infof("Kopia replicate finished, replica snapshot ID %s, size %d", snapshotInfo.ID, snapshotInfo.Size)
+	return snapshotInfo.ID, nil
+}
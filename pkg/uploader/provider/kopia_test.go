@@ -64,6 +64,9 @@ func (f *FakeRestoreProgressUpdater) UpdateProgress(p *uploader.Progress) {}
 func TestRunBackup(t *testing.T) {
 	var kp kopiaProvider
 	kp.log = logrus.New()
+	bkRepo := new(udmrepomocks.BackupRepo)
+	bkRepo.On("UploadedBytes").Return(int64(512))
+	kp.bkRepo = bkRepo
 	updater := FakeBackupProgressUpdater{PodVolumeBackup: &velerov1api.PodVolumeBackup{}, Log: kp.log, Ctx: context.Background(), Cli: fake.NewClientBuilder().WithScheme(util.VeleroScheme).Build()}
 
 	testCases := []struct {
@@ -75,7 +78,7 @@ func TestRunBackup(t *testing.T) {
 		{
 			name: "success to backup",
 			hookBackupFunc: func(ctx context.Context, fsUploader kopia.SnapshotUploader, repoWriter repo.RepositoryWriter, sourcePath string, realSource string, forceFull bool, parentSnapshot string, volMode uploader.PersistentVolumeMode, tags map[string]string, log logrus.FieldLogger) (*uploader.SnapshotInfo, bool, error) {
-				return &uploader.SnapshotInfo{}, false, nil
+				return &uploader.SnapshotInfo{ID: "snapshot-1", Size: 1024}, false, nil
 			},
 			notError: true,
 		},
@@ -108,9 +111,10 @@ func TestRunBackup(t *testing.T) {
 				tc.volMode = uploader.PersistentVolumeFilesystem
 			}
 			BackupFunc = tc.hookBackupFunc
-			_, _, err := kp.RunBackup(context.Background(), "var", "", nil, false, "", tc.volMode, &updater)
+			snapshotInfo, _, err := kp.RunBackup(context.Background(), "var", "", nil, false, "", tc.volMode, &updater)
 			if tc.notError {
 				assert.NoError(t, err)
+				assert.Equal(t, int64(512), snapshotInfo.UploadedBytes)
 			} else {
 				assert.Error(t, err)
 			}
@@ -169,6 +173,117 @@ func TestRunRestore(t *testing.T) {
 	}
 }
 
+func TestRunVerify(t *testing.T) {
+	var kp kopiaProvider
+	kp.log = logrus.New()
+	updater := FakeRestoreProgressUpdater{PodVolumeRestore: &velerov1api.PodVolumeRestore{}, Log: kp.log, Ctx: context.Background(), Cli: fake.NewClientBuilder().WithScheme(util.VeleroScheme).Build()}
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testCases := []struct {
+		name           string
+		ctx            context.Context
+		hookVerifyFunc func(ctx context.Context, rep repo.Repository, progress *kopia.Progress, snapshotID string, filesPercent int, log logrus.FieldLogger) ([]string, error)
+		expectedErrors []string
+		expectedErr    string
+	}{
+		{
+			name: "snapshot is intact",
+			ctx:  context.Background(),
+			hookVerifyFunc: func(ctx context.Context, rep repo.Repository, progress *kopia.Progress, snapshotID string, filesPercent int, log logrus.FieldLogger) ([]string, error) {
+				return nil, nil
+			},
+		},
+		{
+			name: "snapshot is corrupted",
+			ctx:  context.Background(),
+			hookVerifyFunc: func(ctx context.Context, rep repo.Repository, progress *kopia.Progress, snapshotID string, filesPercent int, log logrus.FieldLogger) ([]string, error) {
+				return []string{"file-1: error opening file"}, nil
+			},
+			expectedErrors: []string{"file-1: error opening file"},
+		},
+		{
+			name: "failed to verify",
+			ctx:  context.Background(),
+			hookVerifyFunc: func(ctx context.Context, rep repo.Repository, progress *kopia.Progress, snapshotID string, filesPercent int, log logrus.FieldLogger) ([]string, error) {
+				return nil, errors.New("failed to load snapshot")
+			},
+			expectedErr: "Failed to run kopia verify: failed to load snapshot",
+		},
+		{
+			name: "verify is canceled",
+			ctx:  canceledCtx,
+			hookVerifyFunc: func(ctx context.Context, rep repo.Repository, progress *kopia.Progress, snapshotID string, filesPercent int, log logrus.FieldLogger) ([]string, error) {
+				return nil, ctx.Err()
+			},
+			expectedErr: ErrorCanceled.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			VerifyFunc = tc.hookVerifyFunc
+			verifyErrors, err := kp.RunVerify(tc.ctx, "snapshot-1", 10, &updater)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+			assert.Equal(t, tc.expectedErrors, verifyErrors)
+		})
+	}
+}
+
+func TestRunReplicate(t *testing.T) {
+	var kp kopiaProvider
+	kp.log = logrus.New()
+	updater := FakeBackupProgressUpdater{PodVolumeBackup: &velerov1api.PodVolumeBackup{}, Log: kp.log, Ctx: context.Background(), Cli: fake.NewClientBuilder().WithScheme(util.VeleroScheme).Build()}
+
+	testCases := []struct {
+		name              string
+		target            Provider
+		hookReplicateFunc func(ctx context.Context, fsUploader kopia.SnapshotUploader, sourceRep repo.Repository, targetRep repo.RepositoryWriter, snapshotID string, log logrus.FieldLogger) (*uploader.SnapshotInfo, error)
+		expectedID        string
+		expectedErr       string
+	}{
+		{
+			name:   "success to replicate",
+			target: &kopiaProvider{log: logrus.New()},
+			hookReplicateFunc: func(ctx context.Context, fsUploader kopia.SnapshotUploader, sourceRep repo.Repository, targetRep repo.RepositoryWriter, snapshotID string, log logrus.FieldLogger) (*uploader.SnapshotInfo, error) {
+				return &uploader.SnapshotInfo{ID: "replica-1", Size: 10}, nil
+			},
+			expectedID: "replica-1",
+		},
+		{
+			name:   "failed to replicate",
+			target: &kopiaProvider{log: logrus.New()},
+			hookReplicateFunc: func(ctx context.Context, fsUploader kopia.SnapshotUploader, sourceRep repo.Repository, targetRep repo.RepositoryWriter, snapshotID string, log logrus.FieldLogger) (*uploader.SnapshotInfo, error) {
+				return nil, errors.New("failed to load snapshot")
+			},
+			expectedErr: "Failed to run kopia replicate: failed to load snapshot",
+		},
+		{
+			name:        "target isn't a kopia provider",
+			target:      &resticProvider{},
+			expectedErr: "the target of the replication isn't a kopia uploader provider",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ReplicateFunc = tc.hookReplicateFunc
+			snapshotID, err := kp.RunReplicate(context.Background(), "snapshot-1", tc.target, &updater)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+			assert.Equal(t, tc.expectedID, snapshotID)
+		})
+	}
+}
+
 func TestCheckContext(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -282,6 +397,7 @@ func TestGetPassword(t *testing.T) {
 
 			kp := &kopiaProvider{
 				credGetter: credGetter,
+				repoKey:    repoKeySelector,
 			}
 
 			password, err := kp.GetPassword(nil)
@@ -379,7 +495,7 @@ func TestNewKopiaUploaderProvider(t *testing.T) {
 				return tc.mockBackupRepoService
 			}
 			// Call the function being tested.
-			_, err := NewKopiaUploaderProvider(requestorType, ctx, credGetter, backupRepo, mockLog)
+			_, err := NewKopiaUploaderProvider(requestorType, ctx, credGetter, backupRepo, uploader.BandwidthLimits{}, uploader.CacheOptions{}, mockLog)
 
 			// Assertions
 			if tc.expectedError != "" {
@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	provider "github.com/vmware-tanzu/velero/pkg/uploader/provider"
+
 	uploader "github.com/vmware-tanzu/velero/pkg/uploader"
 )
 
@@ -30,19 +32,21 @@ func (_m *Provider) Close(ctx context.Context) error {
 }
 
 // RunBackup provides a mock function with given fields: ctx, path, realSource, tags, forceFull, parentSnapshot, updater
-func (_m *Provider) RunBackup(ctx context.Context, path string, realSource string, tags map[string]string, forceFull bool, parentSnapshot string, volMode uploader.PersistentVolumeMode, updater uploader.ProgressUpdater) (string, bool, error) {
+func (_m *Provider) RunBackup(ctx context.Context, path string, realSource string, tags map[string]string, forceFull bool, parentSnapshot string, volMode uploader.PersistentVolumeMode, updater uploader.ProgressUpdater) (*uploader.SnapshotInfo, bool, error) {
 	ret := _m.Called(ctx, path, realSource, tags, forceFull, parentSnapshot, volMode, updater)
 
-	var r0 string
+	var r0 *uploader.SnapshotInfo
 	var r1 bool
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, map[string]string, bool, string, uploader.ProgressUpdater) (string, bool, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, map[string]string, bool, string, uploader.ProgressUpdater) (*uploader.SnapshotInfo, bool, error)); ok {
 		return rf(ctx, path, realSource, tags, forceFull, parentSnapshot, updater)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, map[string]string, bool, string, uploader.ProgressUpdater) string); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, map[string]string, bool, string, uploader.ProgressUpdater) *uploader.SnapshotInfo); ok {
 		r0 = rf(ctx, path, realSource, tags, forceFull, parentSnapshot, updater)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uploader.SnapshotInfo)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string, string, map[string]string, bool, string, uploader.ProgressUpdater) bool); ok {
@@ -60,6 +64,30 @@ func (_m *Provider) RunBackup(ctx context.Context, path string, realSource strin
 	return r0, r1, r2
 }
 
+// RunReplicate provides a mock function with given fields: ctx, snapshotID, target, updater
+func (_m *Provider) RunReplicate(ctx context.Context, snapshotID string, target provider.Provider, updater uploader.ProgressUpdater) (string, error) {
+	ret := _m.Called(ctx, snapshotID, target, updater)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, provider.Provider, uploader.ProgressUpdater) (string, error)); ok {
+		return rf(ctx, snapshotID, target, updater)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, provider.Provider, uploader.ProgressUpdater) string); ok {
+		r0 = rf(ctx, snapshotID, target, updater)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, provider.Provider, uploader.ProgressUpdater) error); ok {
+		r1 = rf(ctx, snapshotID, target, updater)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RunRestore provides a mock function with given fields: ctx, snapshotID, volumePath, updater
 func (_m *Provider) RunRestore(ctx context.Context, snapshotID string, volumePath string, volMode uploader.PersistentVolumeMode, updater uploader.ProgressUpdater) error {
 	ret := _m.Called(ctx, snapshotID, volumePath, volMode, updater)
@@ -74,6 +102,32 @@ func (_m *Provider) RunRestore(ctx context.Context, snapshotID string, volumePat
 	return r0
 }
 
+// RunVerify provides a mock function with given fields: ctx, snapshotID, filesPercent, updater
+func (_m *Provider) RunVerify(ctx context.Context, snapshotID string, filesPercent int, updater uploader.ProgressUpdater) ([]string, error) {
+	ret := _m.Called(ctx, snapshotID, filesPercent, updater)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, uploader.ProgressUpdater) ([]string, error)); ok {
+		return rf(ctx, snapshotID, filesPercent, updater)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, uploader.ProgressUpdater) []string); ok {
+		r0 = rf(ctx, snapshotID, filesPercent, updater)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, uploader.ProgressUpdater) error); ok {
+		r1 = rf(ctx, snapshotID, filesPercent, updater)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewProvider interface {
 	mock.TestingT
 	Cleanup(func())
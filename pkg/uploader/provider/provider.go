@@ -18,6 +18,7 @@ package provider
 
 import (
 	"context"
+	"runtime"
 	"time"
 
 	"github.com/pkg/errors"
@@ -37,9 +38,12 @@ const backupProgressCheckInterval = 10 * time.Second
 
 var ErrorCanceled error = errors.New("uploader is canceled")
 
+// hostOS is the OS the uploader runs in, restic isn't supported on Windows
+var hostOS = runtime.GOOS
+
 // Provider which is designed for one pod volume to do the backup or restore
 type Provider interface {
-	// RunBackup which will do backup for one specific volume and return snapshotID, isSnapshotEmpty, error
+	// RunBackup which will do backup for one specific volume and return snapshot info, isSnapshotEmpty, error
 	// updater is used for updating backup progress which implement by third-party
 	RunBackup(
 		ctx context.Context,
@@ -49,7 +53,7 @@ type Provider interface {
 		forceFull bool,
 		parentSnapshot string,
 		volMode uploader.PersistentVolumeMode,
-		updater uploader.ProgressUpdater) (string, bool, error)
+		updater uploader.ProgressUpdater) (*uploader.SnapshotInfo, bool, error)
 	// RunRestore which will do restore for one specific volume with given snapshot id and return error
 	// updater is used for updating backup progress which implement by third-party
 	RunRestore(
@@ -58,6 +62,20 @@ type Provider interface {
 		volumePath string,
 		volMode uploader.PersistentVolumeMode,
 		updater uploader.ProgressUpdater) error
+	// RunVerify which will verify the integrity of the snapshot with given snapshot id without restoring it, and return
+	// the errors of the corrupted entries of the snapshot, the contents of filesPercent percent of the files are read
+	RunVerify(
+		ctx context.Context,
+		snapshotID string,
+		filesPercent int,
+		updater uploader.ProgressUpdater) ([]string, error)
+	// RunReplicate which will replicate the snapshot with given snapshot id to the repository of the target provider
+	// and return the snapshot id of the replica, updater is used for updating replicate progress
+	RunReplicate(
+		ctx context.Context,
+		snapshotID string,
+		target Provider,
+		updater uploader.ProgressUpdater) (string, error)
 	// Close which will close related repository
 	Close(ctx context.Context) error
 }
@@ -73,6 +91,8 @@ func NewUploaderProvider(
 	backupRepo *velerov1api.BackupRepository,
 	credGetter *credentials.CredentialGetter,
 	repoKeySelector *v1.SecretKeySelector,
+	bandwidthLimits uploader.BandwidthLimits,
+	cacheOptions uploader.CacheOptions,
 	log logrus.FieldLogger,
 ) (Provider, error) {
 	if requesterType == "" {
@@ -82,9 +102,12 @@ func NewUploaderProvider(
 	if credGetter.FromFile == nil {
 		return nil, errors.New("uninitialized FileStore credential is not supported")
 	}
+	if uploaderType == uploader.ResticType && hostOS == "windows" {
+		return nil, errors.New("restic uploader isn't supported on Windows, use kopia uploader instead")
+	}
 	if uploaderType == uploader.KopiaType {
-		return NewKopiaUploaderProvider(requesterType, ctx, credGetter, backupRepo, log)
+		return NewKopiaUploaderProvider(requesterType, ctx, credGetter, backupRepo, bandwidthLimits, cacheOptions, log)
 	} else {
-		return NewResticUploaderProvider(repoIdentifier, bsl, credGetter, repoKeySelector, log)
+		return NewResticUploaderProvider(repoIdentifier, bsl, credGetter, repoKeySelector, bandwidthLimits, log)
 	}
 }
@@ -18,6 +18,7 @@ package provider
 
 import (
 	"context"
+	"runtime"
 	"testing"
 
 	"github.com/sirupsen/logrus"
@@ -28,6 +29,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/credentials/mocks"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/util"
 )
 
@@ -37,6 +39,7 @@ type NewUploaderProviderTestCase struct {
 	RequestorType string
 	ExpectedError string
 	needFromFile  bool
+	hostOS        string
 }
 
 func TestNewUploaderProvider(t *testing.T) {
@@ -77,6 +80,14 @@ func TestNewUploaderProvider(t *testing.T) {
 			needFromFile:  true,
 			ExpectedError: "",
 		},
+		{
+			Description:   "When uploaderType is restic on Windows, it should return an error",
+			UploaderType:  "restic",
+			RequestorType: "requester",
+			needFromFile:  true,
+			hostOS:        "windows",
+			ExpectedError: "restic uploader isn't supported on Windows",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -87,7 +98,11 @@ func TestNewUploaderProvider(t *testing.T) {
 				credGetter.FromFile = mockFileGetter
 
 			}
-			_, err := NewUploaderProvider(ctx, client, testCase.UploaderType, testCase.RequestorType, repoIdentifier, bsl, backupRepo, credGetter, repoKeySelector, log)
+			if testCase.hostOS != "" {
+				hostOS = testCase.hostOS
+				defer func() { hostOS = runtime.GOOS }()
+			}
+			_, err := NewUploaderProvider(ctx, client, testCase.UploaderType, testCase.RequestorType, repoIdentifier, bsl, backupRepo, credGetter, repoKeySelector, uploader.BandwidthLimits{}, uploader.CacheOptions{}, log)
 			if testCase.ExpectedError == "" {
 				assert.Nil(t, err)
 			} else {
@@ -57,6 +57,7 @@ func NewResticUploaderProvider(
 	bsl *velerov1api.BackupStorageLocation,
 	credGetter *credentials.CredentialGetter,
 	repoKeySelector *v1.SecretKeySelector,
+	bandwidthLimits uploader.BandwidthLimits,
 	log logrus.FieldLogger,
 ) (Provider, error) {
 	provider := resticProvider{
@@ -92,6 +93,8 @@ func NewResticUploaderProvider(
 		provider.extraFlags = append(provider.extraFlags, skipTLSRet)
 	}
 
+	provider.extraFlags = append(provider.extraFlags, bandwidthLimitFlags(bandwidthLimits)...)
+
 	return &provider, nil
 }
 
@@ -113,7 +116,7 @@ func (rp *resticProvider) Close(ctx context.Context) error {
 }
 
 // RunBackup runs a `backup` command and watches the output to provide
-// progress updates to the caller and return snapshotID, isEmptySnapshot, error
+// progress updates to the caller and return snapshot info, isEmptySnapshot, error
 func (rp *resticProvider) RunBackup(
 	ctx context.Context,
 	path string,
@@ -122,21 +125,21 @@ func (rp *resticProvider) RunBackup(
 	forceFull bool,
 	parentSnapshot string,
 	volMode uploader.PersistentVolumeMode,
-	updater uploader.ProgressUpdater) (string, bool, error) {
+	updater uploader.ProgressUpdater) (*uploader.SnapshotInfo, bool, error) {
 	if updater == nil {
-		return "", false, errors.New("Need to initial backup progress updater first")
+		return nil, false, errors.New("Need to initial backup progress updater first")
 	}
 
 	if path == "" {
-		return "", false, errors.New("path is empty")
+		return nil, false, errors.New("path is empty")
 	}
 
 	if realSource != "" {
-		return "", false, errors.New("real source is not empty, this is not supported by restic uploader")
+		return nil, false, errors.New("real source is not empty, this is not supported by restic uploader")
 	}
 
 	if volMode == uploader.PersistentVolumeBlock {
-		return "", false, errors.New("unable to support block mode")
+		return nil, false, errors.New("unable to support block mode")
 	}
 
 	log := rp.log.WithFields(logrus.Fields{
@@ -159,9 +162,9 @@ func (rp *resticProvider) RunBackup(
 	if err != nil {
 		if strings.Contains(stderrBuf, "snapshot is empty") {
 			log.Debugf("Restic backup got empty dir with %s path", path)
-			return "", true, nil
+			return nil, true, nil
 		}
-		return "", false, errors.WithStack(fmt.Errorf("error running restic backup command %s with error: %v stderr: %v", backupCmd.String(), err, stderrBuf))
+		return nil, false, errors.WithStack(fmt.Errorf("error running restic backup command %s with error: %v stderr: %v", backupCmd.String(), err, stderrBuf))
 	}
 	// GetSnapshotID
 	snapshotIDCmd := resticGetSnapshotFunc(rp.repoIdentifier, rp.credentialsFile, tags)
@@ -172,10 +175,10 @@ func (rp *resticProvider) RunBackup(
 	}
 	snapshotID, err := resticGetSnapshotIDFunc(snapshotIDCmd)
 	if err != nil {
-		return "", false, errors.WithStack(fmt.Errorf("error getting snapshot id with error: %v", err))
+		return nil, false, errors.WithStack(fmt.Errorf("error getting snapshot id with error: %v", err))
 	}
 	log.Infof("Run command=%s, stdout=%s, stderr=%s", backupCmd.String(), summary, stderrBuf)
-	return snapshotID, false, nil
+	return &uploader.SnapshotInfo{ID: snapshotID}, false, nil
 }
 
 // RunRestore runs a `restore` command and monitors the volume size to
@@ -209,3 +212,42 @@ func (rp *resticProvider) RunRestore(
 	log.Infof("Run command=%s, stdout=%s, stderr=%s", restoreCmd.Command, stdout, stderr)
 	return err
 }
+
+// RunVerify is not supported by restic uploader, the restic snapshots are only
+// restored from the pod volume backups
+func (rp *resticProvider) RunVerify(
+	ctx context.Context,
+	snapshotID string,
+	filesPercent int,
+	updater uploader.ProgressUpdater) ([]string, error) {
+	return nil, errors.New("verify is not supported by restic uploader")
+}
+
+// RunReplicate is not supported by restic uploader, the restic snapshots are only
+// restored from the pod volume backups
+func (rp *resticProvider) RunReplicate(
+	ctx context.Context,
+	snapshotID string,
+	target Provider,
+	updater uploader.ProgressUpdater) (string, error) {
+	return "", errors.New("replicate is not supported by restic uploader")
+}
+
+// bandwidthLimitFlags returns the restic flags to limit the upload and download bandwidth,
+// restic takes the limits in KiB/s, so round up the limits to at least 1 KiB/s
+func bandwidthLimitFlags(limits uploader.BandwidthLimits) []string {
+	var flags []string
+	if limits.UploadBytesPerSecond > 0 {
+		flags = append(flags, fmt.Sprintf("--limit-upload=%d", toKiB(limits.UploadBytesPerSecond)))
+	}
+
+	if limits.DownloadBytesPerSecond > 0 {
+		flags = append(flags, fmt.Sprintf("--limit-download=%d", toKiB(limits.DownloadBytesPerSecond)))
+	}
+
+	return flags
+}
+
+func toKiB(bytes int64) int64 {
+	return (bytes + 1023) / 1024
+}
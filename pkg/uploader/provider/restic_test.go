@@ -406,7 +406,42 @@ func TestNewResticUploaderProvider(t *testing.T) {
 			if tc.resticTempCACertFileFunc != nil {
 				resticTempCACertFileFunc = tc.resticTempCACertFileFunc
 			}
-			tc.checkFunc(NewResticUploaderProvider(repoIdentifier, bsl, credGetter, repoKeySelector, log))
+			tc.checkFunc(NewResticUploaderProvider(repoIdentifier, bsl, credGetter, repoKeySelector, uploader.BandwidthLimits{}, log))
+		})
+	}
+}
+
+func TestBandwidthLimitFlags(t *testing.T) {
+	testCases := []struct {
+		name     string
+		limits   uploader.BandwidthLimits
+		expected []string
+	}{
+		{
+			name:     "unlimited",
+			limits:   uploader.BandwidthLimits{},
+			expected: nil,
+		},
+		{
+			name:     "upload only",
+			limits:   uploader.BandwidthLimits{UploadBytesPerSecond: 10 << 20},
+			expected: []string{"--limit-upload=10240"},
+		},
+		{
+			name:     "download only, rounded up to KiB",
+			limits:   uploader.BandwidthLimits{DownloadBytesPerSecond: 1025},
+			expected: []string{"--limit-download=2"},
+		},
+		{
+			name:     "both",
+			limits:   uploader.BandwidthLimits{UploadBytesPerSecond: 1024, DownloadBytesPerSecond: 2048},
+			expected: []string{"--limit-upload=1", "--limit-download=2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, bandwidthLimitFlags(tc.limits))
 		})
 	}
 }
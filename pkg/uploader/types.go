@@ -19,6 +19,7 @@ package uploader
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 const (
@@ -47,9 +48,28 @@ func ValidateUploaderType(t string) error {
 	return nil
 }
 
+// BandwidthLimits defines the bandwidth limits applied to the data transfer of an uploader,
+// the value 0 means unlimited
+type BandwidthLimits struct {
+	UploadBytesPerSecond   int64
+	DownloadBytesPerSecond int64
+}
+
+// CacheOptions defines the local cache options of the kopia uploader, the zero values mean the
+// defaults are kept
+type CacheOptions struct {
+	CacheDir                string
+	ContentCacheLimitBytes  int64
+	MetadataCacheLimitBytes int64
+	MinEvictionAge          time.Duration
+}
+
 type SnapshotInfo struct {
 	ID   string `json:"id"`
 	Size int64  `json:"Size"`
+	// UploadedBytes is the number of bytes written to the backup repository for the snapshot after
+	// deduplication and compression, it's 0 if the uploader doesn't report it
+	UploadedBytes int64 `json:"uploadedBytes,omitempty"`
 }
 
 // Progress which defined two variables to record progress
@@ -0,0 +1,133 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/pkg/errors"
+)
+
+// ContainerImmutability is the immutability configuration of a blob container.
+type ContainerImmutability struct {
+	// VersionLevelImmutability indicates whether the version-level immutability support is
+	// enabled, which is required to set immutability policies and legal holds on blob versions.
+	VersionLevelImmutability bool
+	// ImmutabilityPolicy indicates whether the container has a container-level immutability policy.
+	ImmutabilityPolicy bool
+	// LegalHold indicates whether the container has a container-level legal hold.
+	LegalHold bool
+}
+
+// ImmutableBlob is a blob version which can't be deleted because it's under a legal hold or
+// its immutability policy isn't expired.
+type ImmutableBlob struct {
+	Name      string
+	VersionID string
+	// LegalHold indicates whether the blob version is under a legal hold of the blob or the container.
+	LegalHold bool
+	// ImmutabilityPolicyExpiresOn is when the immutability policy of the blob version expires, nil
+	// if the blob version has no immutability policy or the policy has expired.
+	ImmutabilityPolicyExpiresOn *time.Time
+	// ContainerImmutabilityPolicy indicates whether the blob version is protected by the
+	// container-level immutability policy of a container without the version-level immutability
+	// support, the expiry of the policy isn't known from the blob properties.
+	ContainerImmutabilityPolicy bool
+}
+
+// Mutable returns true if the blob version can be deleted.
+func (b *ImmutableBlob) Mutable() bool {
+	return !b.LegalHold && b.ImmutabilityPolicyExpiresOn == nil && !b.ContainerImmutabilityPolicy
+}
+
+// GetContainerImmutability returns the immutability configuration of the container.
+func GetContainerImmutability(ctx context.Context, client *azblob.Client, containerName string) (*ContainerImmutability, error) {
+	props, err := client.ServiceClient().NewContainerClient(containerName).GetProperties(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the properties of container %s", containerName)
+	}
+
+	return &ContainerImmutability{
+		VersionLevelImmutability: isTrue(props.IsImmutableStorageWithVersioningEnabled),
+		ImmutabilityPolicy:       isTrue(props.HasImmutabilityPolicy),
+		LegalHold:                isTrue(props.HasLegalHold),
+	}, nil
+}
+
+// ListImmutableBlobs lists all the versions of the blobs with the prefix in the container and
+// returns the ones which are under a legal hold or have an immutability policy expiring after now.
+// All the blob versions are immutable if the container has a legal hold, or has a container-level
+// immutability policy without the version-level immutability support. The container-level policy
+// of a container with the version-level immutability support is inherited by the blob versions
+// and reported by their own immutability policies.
+func ListImmutableBlobs(ctx context.Context, client *azblob.Client, containerName, prefix string, now time.Time) ([]ImmutableBlob, error) {
+	immutability, err := GetContainerImmutability(ctx, client, containerName)
+	if err != nil {
+		return nil, err
+	}
+
+	pager := client.NewListBlobsFlatPager(containerName, &azblob.ListBlobsFlatOptions{
+		Prefix: &prefix,
+		Include: container.ListBlobsInclude{
+			Versions:           true,
+			LegalHold:          true,
+			ImmutabilityPolicy: true,
+		},
+	})
+
+	var blobs []ImmutableBlob
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list the blobs with prefix %s in container %s", prefix, containerName)
+		}
+
+		for _, item := range page.Segment.BlobItems {
+			if item == nil || item.Name == nil {
+				continue
+			}
+
+			blob := ImmutableBlob{
+				Name:                        *item.Name,
+				LegalHold:                   immutability.LegalHold,
+				ContainerImmutabilityPolicy: immutability.ImmutabilityPolicy && !immutability.VersionLevelImmutability,
+			}
+			if item.VersionID != nil {
+				blob.VersionID = *item.VersionID
+			}
+			if item.Properties != nil {
+				blob.LegalHold = blob.LegalHold || isTrue(item.Properties.LegalHold)
+				if expiresOn := item.Properties.ImmutabilityPolicyExpiresOn; expiresOn != nil && expiresOn.After(now) {
+					blob.ImmutabilityPolicyExpiresOn = expiresOn
+				}
+			}
+
+			if !blob.Mutable() {
+				blobs = append(blobs, blob)
+			}
+		}
+	}
+
+	return blobs, nil
+}
+
+func isTrue(value *bool) bool {
+	return value != nil && *value
+}
@@ -0,0 +1,229 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const listBlobsResponse = `<?xml version="1.0" encoding="utf-8"?>
+<EnumerationResults ServiceEndpoint="%[1]s" ContainerName="container">
+  <Prefix>backups/backup-1/</Prefix>
+  <Blobs>
+    <Blob>
+      <Name>backups/backup-1/backup-1.tar.gz</Name>
+      <VersionId>2024-01-01T00:00:00.0000000Z</VersionId>
+      <Properties><ImmutabilityPolicyUntilDate>Mon, 01 Jan 2024 00:00:00 GMT</ImmutabilityPolicyUntilDate></Properties>
+    </Blob>
+    <Blob>
+      <Name>backups/backup-1/backup-1.tar.gz</Name>
+      <VersionId>2024-02-01T00:00:00.0000000Z</VersionId>
+      <Properties><ImmutabilityPolicyUntilDate>Fri, 01 Mar 2024 00:00:00 GMT</ImmutabilityPolicyUntilDate></Properties>
+    </Blob>
+    <Blob>
+      <Name>backups/backup-1/velero-backup.json</Name>
+      <VersionId>2024-02-01T00:00:00.0000000Z</VersionId>
+      <Properties><LegalHold>true</LegalHold></Properties>
+    </Blob>
+    <Blob>
+      <Name>backups/backup-1/backup-1-logs.gz</Name>
+      <VersionId>2024-02-01T00:00:00.0000000Z</VersionId>
+      <Properties></Properties>
+    </Blob>
+  </Blobs>
+  <NextMarker />
+</EnumerationResults>`
+
+func newFakeBlobServer(t *testing.T, containerHeaders map[string]string) *azblob.Client {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Query().Get("comp") == "list":
+			w.Header().Set("Content-Type", "application/xml")
+			fmt.Fprintf(w, listBlobsResponse, server.URL)
+		case r.URL.Query().Get("restype") == "container":
+			for key, value := range containerHeaders {
+				w.Header().Set(key, value)
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := azblob.NewClientWithNoCredential(server.URL, &azblob.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Retry: policy.RetryOptions{MaxRetries: -1},
+		},
+	})
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestGetContainerImmutability(t *testing.T) {
+	client := newFakeBlobServer(t, map[string]string{
+		"x-ms-immutable-storage-with-versioning-enabled": "true",
+		"x-ms-has-immutability-policy":                   "false",
+		"x-ms-has-legal-hold":                            "true",
+	})
+
+	immutability, err := GetContainerImmutability(context.Background(), client, "container")
+	require.NoError(t, err)
+	assert.Equal(t, &ContainerImmutability{VersionLevelImmutability: true, LegalHold: true}, immutability)
+}
+
+func TestListImmutableBlobs(t *testing.T) {
+	now := time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)
+	expiresOn := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		containerHeaders map[string]string
+		expected         []ImmutableBlob
+	}{
+		{
+			name: "blob versions with legal hold or unexpired immutability policy",
+			containerHeaders: map[string]string{
+				"x-ms-immutable-storage-with-versioning-enabled": "true",
+			},
+			expected: []ImmutableBlob{
+				{
+					Name:                        "backups/backup-1/backup-1.tar.gz",
+					VersionID:                   "2024-02-01T00:00:00.0000000Z",
+					ImmutabilityPolicyExpiresOn: &expiresOn,
+				},
+				{
+					Name:      "backups/backup-1/velero-backup.json",
+					VersionID: "2024-02-01T00:00:00.0000000Z",
+					LegalHold: true,
+				},
+			},
+		},
+		{
+			name: "all blob versions are immutable with container-level immutability policy",
+			containerHeaders: map[string]string{
+				"x-ms-has-immutability-policy": "true",
+			},
+			expected: []ImmutableBlob{
+				{
+					Name:                        "backups/backup-1/backup-1.tar.gz",
+					VersionID:                   "2024-01-01T00:00:00.0000000Z",
+					ContainerImmutabilityPolicy: true,
+				},
+				{
+					Name:                        "backups/backup-1/backup-1.tar.gz",
+					VersionID:                   "2024-02-01T00:00:00.0000000Z",
+					ImmutabilityPolicyExpiresOn: &expiresOn,
+					ContainerImmutabilityPolicy: true,
+				},
+				{
+					Name:                        "backups/backup-1/velero-backup.json",
+					VersionID:                   "2024-02-01T00:00:00.0000000Z",
+					LegalHold:                   true,
+					ContainerImmutabilityPolicy: true,
+				},
+				{
+					Name:                        "backups/backup-1/backup-1-logs.gz",
+					VersionID:                   "2024-02-01T00:00:00.0000000Z",
+					ContainerImmutabilityPolicy: true,
+				},
+			},
+		},
+		{
+			name: "container-level immutability policy is inherited by the blob versions with version-level immutability",
+			containerHeaders: map[string]string{
+				"x-ms-immutable-storage-with-versioning-enabled": "true",
+				"x-ms-has-immutability-policy":                   "true",
+			},
+			expected: []ImmutableBlob{
+				{
+					Name:                        "backups/backup-1/backup-1.tar.gz",
+					VersionID:                   "2024-02-01T00:00:00.0000000Z",
+					ImmutabilityPolicyExpiresOn: &expiresOn,
+				},
+				{
+					Name:      "backups/backup-1/velero-backup.json",
+					VersionID: "2024-02-01T00:00:00.0000000Z",
+					LegalHold: true,
+				},
+			},
+		},
+		{
+			name: "all blob versions are immutable with container legal hold",
+			containerHeaders: map[string]string{
+				"x-ms-has-legal-hold": "true",
+			},
+			expected: []ImmutableBlob{
+				{
+					Name:      "backups/backup-1/backup-1.tar.gz",
+					VersionID: "2024-01-01T00:00:00.0000000Z",
+					LegalHold: true,
+				},
+				{
+					Name:                        "backups/backup-1/backup-1.tar.gz",
+					VersionID:                   "2024-02-01T00:00:00.0000000Z",
+					LegalHold:                   true,
+					ImmutabilityPolicyExpiresOn: &expiresOn,
+				},
+				{
+					Name:      "backups/backup-1/velero-backup.json",
+					VersionID: "2024-02-01T00:00:00.0000000Z",
+					LegalHold: true,
+				},
+				{
+					Name:      "backups/backup-1/backup-1-logs.gz",
+					VersionID: "2024-02-01T00:00:00.0000000Z",
+					LegalHold: true,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newFakeBlobServer(t, test.containerHeaders)
+
+			blobs, err := ListImmutableBlobs(context.Background(), client, "container", "backups/backup-1/", now)
+			require.NoError(t, err)
+			require.Len(t, blobs, len(test.expected))
+			for i := range test.expected {
+				assert.Equal(t, test.expected[i].Name, blobs[i].Name)
+				assert.Equal(t, test.expected[i].VersionID, blobs[i].VersionID)
+				assert.Equal(t, test.expected[i].LegalHold, blobs[i].LegalHold)
+				assert.Equal(t, test.expected[i].ContainerImmutabilityPolicy, blobs[i].ContainerImmutabilityPolicy)
+				if test.expected[i].ImmutabilityPolicyExpiresOn == nil {
+					assert.Nil(t, blobs[i].ImmutabilityPolicyExpiresOn)
+				} else {
+					require.NotNil(t, blobs[i].ImmutabilityPolicyExpiresOn)
+					assert.True(t, test.expected[i].ImmutabilityPolicyExpiresOn.Equal(*blobs[i].ImmutabilityPolicyExpiresOn))
+				}
+			}
+		})
+	}
+}
@@ -118,9 +118,10 @@ type IncludesExcludesInterface interface {
 }
 
 type GlobalIncludesExcludes struct {
-	resourceFilter          IncludesExcludes
-	includeClusterResources *bool
-	namespaceFilter         IncludesExcludes
+	resourceFilter             IncludesExcludes
+	includeClusterResources    *bool
+	namespaceFilter            IncludesExcludes
+	clusterScopedLabelSelector bool
 
 	helper discovery.Helper
 	logger logrus.FieldLogger
@@ -149,9 +150,10 @@ func (ie *GlobalIncludesExcludes) ShouldInclude(typeName string) bool {
 	// namespaces being backed up, some related cluster-scoped resources
 	// may still be backed up if triggered by a custom action (e.g. PVC->PV).
 	// If we're processing namespaces themselves, we will not skip here, they may be
-	// filtered out later.
+	// filtered out later. The cluster-scoped resources selected by their own label
+	// selectors are not skipped either.
 	if typeName != kuberesource.Namespaces.String() && !resource.Namespaced &&
-		ie.includeClusterResources == nil && !ie.namespaceFilter.IncludeEverything() {
+		ie.includeClusterResources == nil && !ie.namespaceFilter.IncludeEverything() && !ie.clusterScopedLabelSelector {
 		ie.logger.Infof("Skipping resource %s, because it's cluster-scoped and only specific namespaces or namespace scope types are included in the backup.", typeName)
 		return false
 	}
@@ -159,6 +161,13 @@ func (ie *GlobalIncludesExcludes) ShouldInclude(typeName string) bool {
 	return ie.resourceFilter.ShouldInclude(typeName)
 }
 
+// WithClusterScopedLabelSelector makes the cluster-scoped resources included regardless of the
+// namespace filters, as they are filtered by the cluster-scoped label selectors of the backup.
+func (ie *GlobalIncludesExcludes) WithClusterScopedLabelSelector() *GlobalIncludesExcludes {
+	ie.clusterScopedLabelSelector = true
+	return ie
+}
+
 // ShouldExclude returns whether the resource type should be excluded or not.
 func (ie *GlobalIncludesExcludes) ShouldExclude(typeName string) bool {
 	// if the type name is specified in excluded list, it's excluded.
@@ -192,6 +201,7 @@ type ScopeIncludesExcludes struct {
 	namespaceScopedResourceFilter IncludesExcludes // namespace-scoped resource filter
 	clusterScopedResourceFilter   IncludesExcludes // cluster-scoped resource filter
 	namespaceFilter               IncludesExcludes // namespace filter
+	clusterScopedLabelSelector    bool             // cluster-scoped resources are filtered by their own label selectors
 
 	helper discovery.Helper
 	logger logrus.FieldLogger
@@ -223,6 +233,12 @@ func (ie *ScopeIncludesExcludes) ShouldInclude(typeName string) bool {
 		return false
 	}
 
+	// when the cluster-scoped resources are selected by their own label selectors,
+	// all the cluster-scoped resources are included unless IncludedClusterScopedResources is specified.
+	if ie.clusterScopedLabelSelector && len(ie.clusterScopedResourceFilter.includes.List()) == 0 {
+		return true
+	}
+
 	// when IncludedClusterScopedResources and ExcludedClusterScopedResources are not specified,
 	// only directly back up cluster-scoped resources if we're doing a full-cluster
 	// (all namespaces and all namespace-scoped types) backup.
@@ -237,6 +253,13 @@ func (ie *ScopeIncludesExcludes) ShouldInclude(typeName string) bool {
 	return ie.clusterScopedResourceFilter.includes.Has("*") || ie.clusterScopedResourceFilter.includes.match(typeName) || typeName == kuberesource.Namespaces.String()
 }
 
+// WithClusterScopedLabelSelector makes the cluster-scoped resources included regardless of the
+// namespace filters, as they are filtered by the cluster-scoped label selectors of the backup.
+func (ie *ScopeIncludesExcludes) WithClusterScopedLabelSelector() *ScopeIncludesExcludes {
+	ie.clusterScopedLabelSelector = true
+	return ie
+}
+
 // ShouldExclude returns whether the resource type should be excluded or not.
 // For ScopeIncludesExcludes, if the resource type is specified in the exclude
 // list, it should be excluded.
@@ -895,6 +895,93 @@ func TestShouldExcluded(t *testing.T) {
 	}
 }
 
+func TestShouldIncludeWithClusterScopedLabelSelector(t *testing.T) {
+	tests := []struct {
+		name                       string
+		filterType                 string
+		clusterIncludes            []string
+		clusterExcludes            []string
+		clusterScopedLabelSelector bool
+		resourceName               string
+		want                       bool
+	}{
+		{
+			name:         "GlobalResourceIncludesExcludes: cluster-scoped resource is skipped when specific namespaces are included",
+			filterType:   "global",
+			resourceName: "persistentvolumes",
+			want:         false,
+		},
+		{
+			name:                       "GlobalResourceIncludesExcludes: cluster-scoped resource is included by the cluster-scoped label selector",
+			filterType:                 "global",
+			clusterScopedLabelSelector: true,
+			resourceName:               "persistentvolumes",
+			want:                       true,
+		},
+		{
+			name:                       "GlobalResourceIncludesExcludes: excluded cluster-scoped resource is not included by the cluster-scoped label selector",
+			filterType:                 "global",
+			clusterExcludes:            []string{"persistentvolumes"},
+			clusterScopedLabelSelector: true,
+			resourceName:               "persistentvolumes",
+			want:                       false,
+		},
+		{
+			name:         "ScopeResourceIncludesExcludes: cluster-scoped resource is skipped when specific namespaces are included",
+			filterType:   "scope",
+			resourceName: "persistentvolumes",
+			want:         false,
+		},
+		{
+			name:                       "ScopeResourceIncludesExcludes: cluster-scoped resource is included by the cluster-scoped label selector",
+			filterType:                 "scope",
+			clusterScopedLabelSelector: true,
+			resourceName:               "persistentvolumes",
+			want:                       true,
+		},
+		{
+			name:                       "ScopeResourceIncludesExcludes: excluded cluster-scoped resource is not included by the cluster-scoped label selector",
+			filterType:                 "scope",
+			clusterExcludes:            []string{"persistentvolumes"},
+			clusterScopedLabelSelector: true,
+			resourceName:               "persistentvolumes",
+			want:                       false,
+		},
+		{
+			name:                       "ScopeResourceIncludesExcludes: cluster-scoped resource not in the include list is not included by the cluster-scoped label selector",
+			filterType:                 "scope",
+			clusterIncludes:            []string{"storageclasses"},
+			clusterScopedLabelSelector: true,
+			resourceName:               "persistentvolumes",
+			want:                       false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := logrus.StandardLogger()
+			discoveryHelper := setupDiscoveryClientWithResources([]*test.APIResource{test.PVs()})
+			nsIncludeExclude := NewIncludesExcludes().Includes("default")
+
+			var ie IncludesExcludesInterface
+			if tc.filterType == "global" {
+				global := GetGlobalResourceIncludesExcludes(discoveryHelper, logger, tc.clusterIncludes, tc.clusterExcludes, nil, *nsIncludeExclude)
+				if tc.clusterScopedLabelSelector {
+					global.WithClusterScopedLabelSelector()
+				}
+				ie = global
+			} else if tc.filterType == "scope" {
+				scope := GetScopeResourceIncludesExcludes(discoveryHelper, logger, []string{}, []string{}, tc.clusterIncludes, tc.clusterExcludes, *nsIncludeExclude)
+				if tc.clusterScopedLabelSelector {
+					scope.WithClusterScopedLabelSelector()
+				}
+				ie = scope
+			}
+			assert.Equal(t, tc.want, ie.ShouldInclude(tc.resourceName))
+		})
+	}
+}
+
 func setupDiscoveryClientWithResources(APIResources []*test.APIResource) *test.FakeDiscoveryHelper {
 	resourcesMap := make(map[schema.GroupVersionResource]schema.GroupVersionResource)
 	resourceList := make([]*metav1.APIResourceList, 0)
@@ -0,0 +1,182 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"context"
+	"time"
+
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// The volume group snapshot API isn't in the external-snapshotter client used by Velero, so the
+// VolumeGroupSnapshots and their contents are handled as unstructured objects.
+var (
+	VolumeGroupSnapshotGVK        = schema.GroupVersionKind{Group: "groupsnapshot.storage.k8s.io", Version: "v1beta1", Kind: "VolumeGroupSnapshot"}
+	VolumeGroupSnapshotContentGVK = schema.GroupVersionKind{Group: "groupsnapshot.storage.k8s.io", Version: "v1beta1", Kind: "VolumeGroupSnapshotContent"}
+)
+
+// CreateVolumeGroupSnapshot creates a VolumeGroupSnapshot with the generate name in the namespace
+// for the PVCs matching the label selector, the group snapshot class isn't set so the default
+// class of the CSI driver is used.
+func CreateVolumeGroupSnapshot(ctx context.Context, client kbclient.Client, namespace, generateName string,
+	selector map[string]string, labels map[string]string) (*unstructured.Unstructured, error) {
+	matchLabels := map[string]interface{}{}
+	for key, value := range selector {
+		matchLabels[key] = value
+	}
+
+	vgs := &unstructured.Unstructured{}
+	vgs.SetGroupVersionKind(VolumeGroupSnapshotGVK)
+	vgs.SetNamespace(namespace)
+	vgs.SetGenerateName(generateName)
+	vgs.SetLabels(labels)
+	if err := unstructured.SetNestedMap(vgs.Object, map[string]interface{}{
+		"selector": map[string]interface{}{
+			"matchLabels": matchLabels,
+		},
+	}, "spec", "source"); err != nil {
+		return nil, errors.Wrap(err, "error setting the source of the volume group snapshot")
+	}
+
+	if err := client.Create(ctx, vgs); err != nil {
+		return nil, errors.Wrapf(err, "error creating volume group snapshot in namespace %s", namespace)
+	}
+
+	return vgs, nil
+}
+
+// WaitVolumeGroupSnapshotReady waits a VolumeGroupSnapshot to become ready to use until the timeout
+// reaches, it returns the names of the VolumeSnapshots of the group keyed by the names of the PVCs.
+func WaitVolumeGroupSnapshotReady(ctx context.Context, client kbclient.Client, namespace, name string,
+	timeout time.Duration, log logrus.FieldLogger) (map[string]string, error) {
+	var snapshots map[string]string
+	errMessage := sets.NewString()
+
+	err := wait.PollImmediate(waitInternal, timeout, func() (bool, error) {
+		vgs := &unstructured.Unstructured{}
+		vgs.SetGroupVersionKind(VolumeGroupSnapshotGVK)
+		if err := client.Get(ctx, kbclient.ObjectKey{Namespace: namespace, Name: name}, vgs); err != nil {
+			return false, errors.Wrapf(err, "error to get volume group snapshot %s/%s", namespace, name)
+		}
+
+		if message, found, _ := unstructured.NestedString(vgs.Object, "status", "error", "message"); found {
+			errMessage.Insert(message)
+		}
+
+		if ready, _, _ := unstructured.NestedBool(vgs.Object, "status", "readyToUse"); !ready {
+			return false, nil
+		}
+
+		refs, _, err := unstructured.NestedSlice(vgs.Object, "status", "pvcVolumeSnapshotRefList")
+		if err != nil {
+			return false, errors.Wrapf(err, "invalid status of volume group snapshot %s/%s", namespace, name)
+		}
+
+		snapshots = map[string]string{}
+		for _, ref := range refs {
+			refMap, ok := ref.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			pvc, _, _ := unstructured.NestedString(refMap, "persistentVolumeClaimRef", "name")
+			vs, _, _ := unstructured.NestedString(refMap, "volumeSnapshotRef", "name")
+			if pvc != "" && vs != "" {
+				snapshots[pvc] = vs
+			}
+		}
+		return true, nil
+	})
+
+	if err == wait.ErrWaitTimeout {
+		err = errors.Errorf("volume group snapshot is not ready until timeout, errors: %v", errMessage.List())
+	}
+
+	if errMessage.Len() > 0 {
+		log.Warnf("Some errors happened during waiting for ready volume group snapshot, errors: %v", errMessage.List())
+	}
+
+	return snapshots, err
+}
+
+// ReleaseVolumeGroupSnapshot deletes a ready VolumeGroupSnapshot and its content while keeping the
+// VolumeSnapshots of the group, which are then handled as the snapshots taken for the PVCs alone.
+func ReleaseVolumeGroupSnapshot(ctx context.Context, client kbclient.Client, namespace, name string,
+	volumeSnapshots []string, log logrus.FieldLogger) error {
+	vgs := &unstructured.Unstructured{}
+	vgs.SetGroupVersionKind(VolumeGroupSnapshotGVK)
+	if err := client.Get(ctx, kbclient.ObjectKey{Namespace: namespace, Name: name}, vgs); err != nil {
+		return errors.Wrapf(err, "error to get volume group snapshot %s/%s", namespace, name)
+	}
+
+	// retain the group snapshot on the storage when the content is deleted, the snapshots of the
+	// volumes are still referenced by the contents of the VolumeSnapshots
+	contentName, _, _ := unstructured.NestedString(vgs.Object, "status", "boundVolumeGroupSnapshotContentName")
+	if contentName != "" {
+		content := &unstructured.Unstructured{}
+		content.SetGroupVersionKind(VolumeGroupSnapshotContentGVK)
+		content.SetName(contentName)
+		patch := []byte(`{"spec":{"deletionPolicy":"Retain"}}`)
+		if err := client.Patch(ctx, content, kbclient.RawPatch("application/merge-patch+json", patch)); err != nil {
+			return errors.Wrapf(err, "error to retain volume group snapshot content %s", contentName)
+		}
+	}
+
+	// the VolumeSnapshots are owned by the group, they would be deleted with it otherwise
+	for _, vsName := range volumeSnapshots {
+		vs := &snapshotv1api.VolumeSnapshot{}
+		if err := client.Get(ctx, kbclient.ObjectKey{Namespace: namespace, Name: vsName}, vs); err != nil {
+			return errors.Wrapf(err, "error to get volume snapshot %s/%s", namespace, vsName)
+		}
+
+		original := vs.DeepCopy()
+		var ownerReferences []metav1.OwnerReference
+		for _, ref := range vs.OwnerReferences {
+			if ref.Kind != VolumeGroupSnapshotGVK.Kind {
+				ownerReferences = append(ownerReferences, ref)
+			}
+		}
+		vs.OwnerReferences = ownerReferences
+		if err := client.Patch(ctx, vs, kbclient.MergeFrom(original)); err != nil {
+			return errors.Wrapf(err, "error to remove the owner of volume snapshot %s/%s", namespace, vsName)
+		}
+	}
+
+	if err := client.Delete(ctx, vgs); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error to delete volume group snapshot %s/%s", namespace, name)
+	}
+
+	if contentName != "" {
+		content := &unstructured.Unstructured{}
+		content.SetGroupVersionKind(VolumeGroupSnapshotContentGVK)
+		content.SetName(contentName)
+		if err := client.Delete(ctx, content); err != nil && !apierrors.IsNotFound(err) {
+			log.WithError(err).Warnf("Failed to delete volume group snapshot content %s", contentName)
+		}
+	}
+
+	return nil
+}
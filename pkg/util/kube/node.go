@@ -0,0 +1,98 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// the only field supported by the node selector terms
+const nodeNameField = "metadata.name"
+
+// IsPVAccessibleFromNode returns true if the PV can be accessed from the node according to the
+// required node affinity of the PV, the PV without node affinity is accessible from any node.
+func IsPVAccessibleFromNode(pv *corev1api.PersistentVolume, node *corev1api.Node) (bool, error) {
+	if pv.Spec.NodeAffinity == nil || pv.Spec.NodeAffinity.Required == nil {
+		return true, nil
+	}
+
+	// the terms are ORed while the requirements of a term are ANDed
+	for _, term := range pv.Spec.NodeAffinity.Required.NodeSelectorTerms {
+		if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+			continue
+		}
+
+		matched, err := matchNodeSelectorRequirements(term.MatchExpressions, labels.Set(node.Labels))
+		if err != nil {
+			return false, errors.Wrapf(err, "error matching node %s with the node affinity of PV %s", node.Name, pv.Name)
+		}
+		if !matched {
+			continue
+		}
+
+		for _, field := range term.MatchFields {
+			if field.Key != nodeNameField {
+				return false, errors.Errorf("unsupported field %s in the node affinity of PV %s", field.Key, pv.Name)
+			}
+		}
+		matched, err = matchNodeSelectorRequirements(term.MatchFields, labels.Set{nodeNameField: node.Name})
+		if err != nil {
+			return false, errors.Wrapf(err, "error matching node %s with the node affinity of PV %s", node.Name, pv.Name)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func matchNodeSelectorRequirements(requirements []corev1api.NodeSelectorRequirement, set labels.Set) (bool, error) {
+	selector := labels.NewSelector()
+	for _, req := range requirements {
+		var op selection.Operator
+		switch req.Operator {
+		case corev1api.NodeSelectorOpIn:
+			op = selection.In
+		case corev1api.NodeSelectorOpNotIn:
+			op = selection.NotIn
+		case corev1api.NodeSelectorOpExists:
+			op = selection.Exists
+		case corev1api.NodeSelectorOpDoesNotExist:
+			op = selection.DoesNotExist
+		case corev1api.NodeSelectorOpGt:
+			op = selection.GreaterThan
+		case corev1api.NodeSelectorOpLt:
+			op = selection.LessThan
+		default:
+			return false, errors.Errorf("invalid operator %s", req.Operator)
+		}
+
+		requirement, err := labels.NewRequirement(req.Key, op, req.Values)
+		if err != nil {
+			return false, errors.Wrapf(err, "invalid requirement %s %s %s", req.Key, req.Operator, strings.Join(req.Values, ","))
+		}
+		selector = selector.Add(*requirement)
+	}
+
+	return selector.Matches(set), nil
+}
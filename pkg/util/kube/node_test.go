@@ -0,0 +1,104 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestIsPVAccessibleFromNode(t *testing.T) {
+	node := builder.ForNode("node-1").Labels(map[string]string{"topology.kubernetes.io/zone": "zone-a"}).Result()
+
+	tests := []struct {
+		name        string
+		terms       []corev1api.NodeSelectorTerm
+		expected    bool
+		expectedErr string
+	}{
+		{
+			name:     "no node affinity",
+			expected: true,
+		},
+		{
+			name: "matched label",
+			terms: []corev1api.NodeSelectorTerm{
+				*builder.NewNodeSelectorTermBuilder().WithMatchExpression("topology.kubernetes.io/zone", "In", "zone-a", "zone-b").Result(),
+			},
+			expected: true,
+		},
+		{
+			name: "unmatched label",
+			terms: []corev1api.NodeSelectorTerm{
+				*builder.NewNodeSelectorTermBuilder().WithMatchExpression("topology.kubernetes.io/zone", "NotIn", "zone-a").Result(),
+			},
+		},
+		{
+			name: "one of the terms is matched",
+			terms: []corev1api.NodeSelectorTerm{
+				*builder.NewNodeSelectorTermBuilder().WithMatchField("metadata.name", "In", "node-2").Result(),
+				*builder.NewNodeSelectorTermBuilder().WithMatchField("metadata.name", "In", "node-1").Result(),
+			},
+			expected: true,
+		},
+		{
+			name: "all the requirements of a term must be matched",
+			terms: []corev1api.NodeSelectorTerm{
+				*builder.NewNodeSelectorTermBuilder().
+					WithMatchExpression("topology.kubernetes.io/zone", "Exists").
+					WithMatchField("metadata.name", "In", "node-2").Result(),
+			},
+		},
+		{
+			name: "unsupported field",
+			terms: []corev1api.NodeSelectorTerm{
+				*builder.NewNodeSelectorTermBuilder().WithMatchField("metadata.uid", "In", "uid").Result(),
+			},
+			expectedErr: "unsupported field metadata.uid in the node affinity of PV pv-1",
+		},
+		{
+			name: "invalid operator",
+			terms: []corev1api.NodeSelectorTerm{
+				*builder.NewNodeSelectorTermBuilder().WithMatchExpression("topology.kubernetes.io/zone", "Equals", "zone-a").Result(),
+			},
+			expectedErr: "error matching node node-1 with the node affinity of PV pv-1: invalid operator Equals",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pvBuilder := builder.ForPersistentVolume("pv-1")
+			if test.terms != nil {
+				pvBuilder = pvBuilder.NodeAffinityRequired(builder.ForNodeSelector(test.terms...).Result())
+			}
+
+			accessible, err := IsPVAccessibleFromNode(pvBuilder.Result(), node)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, accessible)
+		})
+	}
+}
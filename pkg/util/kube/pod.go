@@ -40,6 +40,12 @@ func IsPodRunning(pod *corev1api.Pod) error {
 	})
 }
 
+// IsPodTerminated returns true if the pod has terminated, i.e. it succeeded or failed, so its
+// containers won't be restarted and its volumes are unmounted from the node.
+func IsPodTerminated(pod *corev1api.Pod) bool {
+	return pod.Status.Phase == corev1api.PodSucceeded || pod.Status.Phase == corev1api.PodFailed
+}
+
 // IsPodScheduled does a well-rounded check to make sure the specified pod has been scheduled into a node and in a stable status.
 // If not, return the error found
 func IsPodScheduled(pod *corev1api.Pod) error {
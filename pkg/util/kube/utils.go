@@ -33,6 +33,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+	apiregistrationv1helper "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1/helper"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/pkg/uploader"
@@ -303,3 +305,13 @@ func IsCRDReady(crd *unstructured.Unstructured) (bool, error) {
 		return false, fmt.Errorf("unable to handle CRD with version %s", ver)
 	}
 }
+
+// IsAPIServiceAvailable checks an APIService to see if it's available, with the Available condition,
+// the resources of its group version are served by the aggregated API server once it's available.
+func IsAPIServiceAvailable(apiService *unstructured.Unstructured) (bool, error) {
+	converted := &apiregistrationv1.APIService{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(apiService.Object, converted); err != nil {
+		return false, err
+	}
+	return apiregistrationv1helper.IsAPIServiceConditionTrue(converted, apiregistrationv1.Available), nil
+}
@@ -473,6 +473,48 @@ func TestIsCRDReady(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestIsAPIServiceAvailable(t *testing.T) {
+	tests := []struct {
+		name   string
+		status string
+		want   bool
+	}{
+		{
+			name: "APIService has no conditions - not available",
+			want: false,
+		},
+		{
+			name:   "APIService is not available",
+			status: "False",
+			want:   false,
+		},
+		{
+			name:   "APIService is available",
+			status: "True",
+			want:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			apiService := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "apiregistration.k8s.io/v1",
+				"kind":       "APIService",
+				"metadata":   map[string]interface{}{"name": "v1beta1.metrics.k8s.io"},
+			}}
+			if tc.status != "" {
+				require.NoError(t, unstructured.SetNestedSlice(apiService.Object, []interface{}{
+					map[string]interface{}{"type": "Available", "status": tc.status},
+				}, "status", "conditions"))
+			}
+
+			result, err := IsAPIServiceAvailable(apiService)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, result)
+		})
+	}
+}
+
 func TestSinglePathMatch(t *testing.T) {
 	fakeFS := velerotest.NewFakeFileSystem()
 	fakeFS.MkdirAll("testDir1/subpath", 0755)
@@ -29,6 +29,8 @@ import (
 // is a persist file, so that the log could be further transferred.
 type DualModeLogger interface {
 	logrus.FieldLogger
+	// TeeOutput writes the logs to w as well as to the persist file, until DoneForPersist
+	TeeOutput(w io.Writer)
 	// DoneForPersist stops outputting logs to the persist file
 	DoneForPersist(log logrus.FieldLogger)
 	// GetPersistFile moves the persist file pointer to beginning and returns it
@@ -68,6 +70,10 @@ func NewTempFileLogger(logLevel logrus.Level, logFormat Format, hook *LogHook, f
 	}, nil
 }
 
+func (p *tempFileLogger) TeeOutput(w io.Writer) {
+	p.logger.SetOutput(io.MultiWriter(os.Stdout, p.w, w))
+}
+
 func (p *tempFileLogger) DoneForPersist(log logrus.FieldLogger) {
 	p.logger.SetOutput(os.Stdout)
 
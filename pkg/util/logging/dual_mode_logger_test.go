@@ -73,3 +73,32 @@ func readLogString(file *os.File) (string, error) {
 
 	return string(buffer[:]), nil
 }
+
+func TestDualModeLoggerTeeOutput(t *testing.T) {
+	logger, err := NewTempFileLogger(logrus.DebugLevel, FormatText, nil, logrus.Fields{})
+	require.NoError(t, err)
+	defer logger.Dispose(velerotest.NewLogger())
+
+	chunks := &LogChunkBuffer{}
+	logger.TeeOutput(chunks)
+
+	logger.Info("first message")
+	first := string(chunks.TakeChunk())
+	assert.Contains(t, first, "first message")
+	assert.Nil(t, chunks.TakeChunk())
+
+	logger.Info("second message")
+	second := string(chunks.TakeChunk())
+	assert.NotContains(t, second, "first message")
+	assert.Contains(t, second, "second message")
+
+	logger.DoneForPersist(velerotest.NewLogger())
+	logger.Info("unexpected message")
+	assert.Nil(t, chunks.TakeChunk())
+
+	logFile, err := logger.GetPersistFile()
+	require.NoError(t, err)
+	logStr, err := readLogString(logFile)
+	require.NoError(t, err)
+	assert.Equal(t, first+second, strings.TrimRight(logStr, "\x00"))
+}
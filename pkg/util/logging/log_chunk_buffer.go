@@ -0,0 +1,54 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"bytes"
+	"sync"
+)
+
+// LogChunkBuffer is a thread safe writer which buffers the logs written since the last chunk
+// was taken, so that the logs of a running operation can be uploaded in chunks before the
+// whole log is persisted.
+type LogChunkBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+// Write appends the logs to the current chunk.
+func (b *LogChunkBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+// TakeChunk returns the logs written since the last chunk was taken, nil is returned if
+// nothing is written.
+func (b *LogChunkBuffer) TakeChunk() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.buf.Len() == 0 {
+		return nil
+	}
+
+	chunk := make([]byte, b.buf.Len())
+	copy(chunk, b.buf.Bytes())
+	b.buf.Reset()
+	return chunk
+}
@@ -74,10 +74,19 @@ func (h *LogHook) Fire(entry *logrus.Entry) error {
 		entryMessage = fmt.Sprintf("%s error: /%v", entryMessage, errorField)
 	}
 
+	// categorize the entry by the logged error rather than by the message, so that the
+	// well-known errors are categorized by their types
+	category := results.CategorizeMessage(entry.Message + entryMessage)
+	if err, ok := errorField.(error); ok {
+		if category = results.CategorizeError(err); category == results.ErrorCategoryOther {
+			category = results.CategorizeMessage(entry.Message)
+		}
+	}
+
 	if isNamespacePresent {
-		h.entries[entry.Level].Add(namespace.(string), errors.New(entryMessage))
+		h.entries[entry.Level].AddCategorized(namespace.(string), errors.New(entryMessage), category)
 	} else {
-		h.entries[entry.Level].AddVeleroError(errors.New(entryMessage))
+		h.entries[entry.Level].AddCategorizedVeleroError(errors.New(entryMessage), category)
 	}
 	return nil
 }
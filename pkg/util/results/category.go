@@ -0,0 +1,94 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// ErrorCategory is the category of a message of a Result, so that the automation can decide
+// whether a partially failed backup or restore is acceptable by the kinds of its errors.
+type ErrorCategory string
+
+const (
+	// ErrorCategoryPlugin is the category of the errors returned by the plugins.
+	ErrorCategoryPlugin ErrorCategory = "plugin"
+
+	// ErrorCategoryAPIConflict is the category of the conflicts with the objects of the API server,
+	// e.g. the objects which already exist or were modified concurrently.
+	ErrorCategoryAPIConflict ErrorCategory = "apiConflict"
+
+	// ErrorCategoryValidation is the category of the objects rejected as invalid by the API server
+	// or by the validation of Velero.
+	ErrorCategoryValidation ErrorCategory = "validation"
+
+	// ErrorCategoryDataPath is the category of the errors of moving the data of the volumes, e.g.
+	// the pod volume backups and restores, the snapshots and the data movements.
+	ErrorCategoryDataPath ErrorCategory = "dataPath"
+
+	// ErrorCategoryTimeout is the category of the operations which didn't finish in time.
+	ErrorCategoryTimeout ErrorCategory = "timeout"
+
+	// ErrorCategoryOther is the category of the errors not in any other category.
+	ErrorCategoryOther ErrorCategory = "other"
+)
+
+// the keywords of the messages of each category, the categories are checked in order so that
+// e.g. a plugin error caused by a timeout is categorized as a timeout.
+var categoryKeywords = []struct {
+	category ErrorCategory
+	keywords []string
+}{
+	{ErrorCategoryTimeout, []string{"timed out", "timeout", "deadline exceeded"}},
+	{ErrorCategoryAPIConflict, []string{"already exists", "the object has been modified", "conflict"}},
+	{ErrorCategoryValidation, []string{"is invalid", "validation", "invalid"}},
+	{ErrorCategoryDataPath, []string{"pod volume", "podvolume", "data upload", "data download", "data path", "datapath", "snapshot", "kopia", "restic", "repository"}},
+	{ErrorCategoryPlugin, []string{"plugin", "rpc error", "item action", "item operation"}},
+}
+
+// CategorizeError returns the category of the error, the well-known errors of the API server
+// and the timeouts are categorized by their types and the others by their messages.
+func CategorizeError(err error) ErrorCategory {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, wait.ErrWaitTimeout),
+		apierrors.IsTimeout(err), apierrors.IsServerTimeout(err):
+		return ErrorCategoryTimeout
+	case apierrors.IsConflict(err), apierrors.IsAlreadyExists(err):
+		return ErrorCategoryAPIConflict
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+		return ErrorCategoryValidation
+	}
+	return CategorizeMessage(err.Error())
+}
+
+// CategorizeMessage returns the category of the message of an error by its keywords.
+func CategorizeMessage(msg string) ErrorCategory {
+	msg = strings.ToLower(msg)
+	for _, c := range categoryKeywords {
+		for _, keyword := range c.keywords {
+			if strings.Contains(msg, keyword) {
+				return c.category
+			}
+		}
+	}
+	return ErrorCategoryOther
+}
@@ -32,6 +32,10 @@ type Result struct {
 	// Namespaces is a map of namespace name to slice of messages
 	// related to backup or restore namespace-scoped resources.
 	Namespaces map[string][]string `json:"namespaces,omitempty"`
+
+	// Categories is a map of the category of the messages to the
+	// number of messages in the category.
+	Categories map[ErrorCategory]int `json:"categories,omitempty"`
 }
 
 // Merge combines two Result objects into one
@@ -45,17 +49,33 @@ func (r *Result) Merge(other *Result) {
 		}
 		r.Namespaces[k] = append(r.Namespaces[k], v...)
 	}
+	for k, v := range other.Categories {
+		r.addCategory(k, v)
+	}
 }
 
 // AddVeleroError appends an error to the provided Result's Velero list.
 func (r *Result) AddVeleroError(err error) {
+	r.AddCategorizedVeleroError(err, CategorizeError(err))
+}
+
+// AddCategorizedVeleroError appends an error of the known category to the
+// provided Result's Velero list.
+func (r *Result) AddCategorizedVeleroError(err error, category ErrorCategory) {
 	r.Velero = append(r.Velero, err.Error())
+	r.addCategory(category, 1)
 }
 
 // Add appends an error to the provided Result, either within
 // the cluster-scoped list (if ns == "") or within the provided namespace's
 // entry.
 func (r *Result) Add(ns string, e error) {
+	r.AddCategorized(ns, e, CategorizeError(e))
+}
+
+// AddCategorized appends an error of the known category to the provided
+// Result like Add.
+func (r *Result) AddCategorized(ns string, e error, category ErrorCategory) {
 	if ns == "" {
 		r.Cluster = append(r.Cluster, e.Error())
 	} else {
@@ -64,6 +84,27 @@ func (r *Result) Add(ns string, e error) {
 		}
 		r.Namespaces[ns] = append(r.Namespaces[ns], e.Error())
 	}
+	r.addCategory(category, 1)
+}
+
+func (r *Result) addCategory(category ErrorCategory, count int) {
+	if r.Categories == nil {
+		r.Categories = make(map[ErrorCategory]int)
+	}
+	r.Categories[category] += count
+}
+
+// CategoryCounts returns the number of messages of each category, keyed by
+// the names of the categories.
+func (r *Result) CategoryCounts() map[string]int {
+	if len(r.Categories) == 0 {
+		return nil
+	}
+	counts := make(map[string]int, len(r.Categories))
+	for category, count := range r.Categories {
+		counts[string(category)] = count
+	}
+	return counts
 }
 
 // IsEmpty returns true if all collections of messages are empty
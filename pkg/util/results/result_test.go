@@ -17,10 +17,13 @@ limitations under the License.
 package results
 
 import (
+	"context"
 	"testing"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func TestMerge(t *testing.T) {
@@ -75,6 +78,7 @@ func TestMerge(t *testing.T) {
 					"ns-2": {"ns-2-err-1"},
 					"ns-3": {"ns-3-err-1"},
 				},
+				Categories: map[ErrorCategory]int{ErrorCategoryOther: 4},
 			},
 			other: &Result{
 				Cluster: []string{"cluster-err-2"},
@@ -83,6 +87,7 @@ func TestMerge(t *testing.T) {
 					"ns-2": {"ns-2-err-2"},
 					"ns-4": {"ns-4-err-1"},
 				},
+				Categories: map[ErrorCategory]int{ErrorCategoryOther: 3, ErrorCategoryTimeout: 1},
 			},
 			want: &Result{
 				Cluster: []string{"cluster-err-1", "cluster-err-2"},
@@ -92,6 +97,7 @@ func TestMerge(t *testing.T) {
 					"ns-3": {"ns-3-err-1"},
 					"ns-4": {"ns-4-err-1"},
 				},
+				Categories: map[ErrorCategory]int{ErrorCategoryOther: 7, ErrorCategoryTimeout: 1},
 			},
 		},
 	}
@@ -115,14 +121,14 @@ func TestAddVeleroError(t *testing.T) {
 			name:   "when AddVeleroError is called for a result with no velero errors, the result has the new error added properly",
 			result: &Result{},
 			err:    errors.New("foo"),
-			want:   &Result{Velero: []string{"foo"}},
+			want:   &Result{Velero: []string{"foo"}, Categories: map[ErrorCategory]int{ErrorCategoryOther: 1}},
 		},
 
 		{
 			name:   "when AddVeleroError is called for a result with existing velero errors, the result has the new error appended properly",
 			result: &Result{Velero: []string{"bar"}},
 			err:    errors.New("foo"),
-			want:   &Result{Velero: []string{"bar", "foo"}},
+			want:   &Result{Velero: []string{"bar", "foo"}, Categories: map[ErrorCategory]int{ErrorCategoryOther: 1}},
 		},
 	}
 
@@ -147,14 +153,14 @@ func TestAdd(t *testing.T) {
 			result: &Result{},
 			ns:     "",
 			err:    errors.New("foo"),
-			want:   &Result{Cluster: []string{"foo"}},
+			want:   &Result{Cluster: []string{"foo"}, Categories: map[ErrorCategory]int{ErrorCategoryOther: 1}},
 		},
 		{
 			name:   "when Add is called for a result with some existing errors and an empty namespace, the error is added to the cluster-scoped list",
 			result: &Result{Cluster: []string{"bar"}},
 			ns:     "",
 			err:    errors.New("foo"),
-			want:   &Result{Cluster: []string{"bar", "foo"}},
+			want:   &Result{Cluster: []string{"bar", "foo"}, Categories: map[ErrorCategory]int{ErrorCategoryOther: 1}},
 		},
 
 		{
@@ -166,6 +172,7 @@ func TestAdd(t *testing.T) {
 				Namespaces: map[string][]string{
 					"ns-1": {"foo"},
 				},
+				Categories: map[ErrorCategory]int{ErrorCategoryOther: 1},
 			},
 		},
 		{
@@ -183,6 +190,7 @@ func TestAdd(t *testing.T) {
 					"ns-1": {"bar", "foo"},
 					"ns-2": {"baz"},
 				},
+				Categories: map[ErrorCategory]int{ErrorCategoryOther: 1},
 			},
 		},
 	}
@@ -210,3 +218,58 @@ func TestIsEmpty(t *testing.T) {
 	}
 	assert.False(t, result.IsEmpty())
 }
+
+func TestCategorizeError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want ErrorCategory
+	}{
+		{
+			name: "API conflict",
+			err:  apierrors.NewConflict(schema.GroupResource{Resource: "pods"}, "pod-1", errors.New("the object has been modified")),
+			want: ErrorCategoryAPIConflict,
+		},
+		{
+			name: "already exists",
+			err:  errors.Wrap(apierrors.NewAlreadyExists(schema.GroupResource{Resource: "pods"}, "pod-1"), "error restoring pod"),
+			want: ErrorCategoryAPIConflict,
+		},
+		{
+			name: "invalid object",
+			err:  apierrors.NewInvalid(schema.GroupKind{Kind: "Pod"}, "pod-1", nil),
+			want: ErrorCategoryValidation,
+		},
+		{
+			name: "timeout",
+			err:  errors.Wrap(context.DeadlineExceeded, "error waiting for pod"),
+			want: ErrorCategoryTimeout,
+		},
+		{
+			name: "timeout of a plugin",
+			err:  errors.New("rpc error: code = Unknown desc = timed out waiting for the snapshot"),
+			want: ErrorCategoryTimeout,
+		},
+		{
+			name: "data path",
+			err:  errors.New("pod volume backup failed: error running kopia backup"),
+			want: ErrorCategoryDataPath,
+		},
+		{
+			name: "plugin",
+			err:  errors.New("error executing custom action (groupResource=pods, namespace=ns-1, name=pod-1): rpc error: code = Unknown desc = foo"),
+			want: ErrorCategoryPlugin,
+		},
+		{
+			name: "other",
+			err:  errors.New("foo"),
+			want: ErrorCategoryOther,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, CategorizeError(tc.err))
+		})
+	}
+}
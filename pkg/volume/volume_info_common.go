@@ -101,6 +101,9 @@ type SnapshotDataMovementInfo struct {
 
 	// It's the filesystem repository's snapshot ID.
 	SnapshotHandle string `json:"snapshotHandle"`
+
+	// The storage efficiency of the data uploaded for the volume, it's nil if the uploader doesn't report it.
+	UploadStats *UploadStats `json:"uploadStats,omitempty"`
 }
 
 // NativeSnapshotInfo is used for displaying the Velero native snapshot status.
@@ -144,4 +147,36 @@ type PodVolumeBackupInfo struct {
 
 	// The PVB-taken k8s node's name.
 	NodeName string `json:"nodeName"`
+
+	// The storage efficiency of the data uploaded for the volume, it's nil if the uploader doesn't report it.
+	UploadStats *UploadStats `json:"uploadStats,omitempty"`
+}
+
+// UploadStats is used for displaying the storage efficiency of the data uploaded by the file-system uploader.
+type UploadStats struct {
+	// The logical size of the data of the volume.
+	LogicalBytes int64 `json:"logicalBytes"`
+
+	// The size of the data written to the backup repository after deduplication and compression.
+	UploadedBytes int64 `json:"uploadedBytes"`
+
+	// The ratio of the logical size to the uploaded size, it's 0 if no data is uploaded.
+	DedupRatio float64 `json:"dedupRatio"`
+}
+
+// NewUploadStats returns the upload stats of the logical and uploaded sizes, nil is returned if
+// the logical size isn't reported.
+func NewUploadStats(logicalBytes, uploadedBytes int64) *UploadStats {
+	if logicalBytes == 0 {
+		return nil
+	}
+
+	stats := &UploadStats{
+		LogicalBytes:  logicalBytes,
+		UploadedBytes: uploadedBytes,
+	}
+	if uploadedBytes > 0 {
+		stats.DedupRatio = float64(logicalBytes) / float64(uploadedBytes)
+	}
+	return stats
 }